@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/gif"
+	_ "image/jpeg" // Most avatars are JPEGs
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// Where avatars are fetched from, with the osu! user ID on the end
+var avatarBaseURL = "https://a.ppy.sh/"
+
+// How long fetching an avatar can take before the card is drawn with the guest avatar
+var avatarTimeout = 5 * time.Second
+
+// Avatars bigger than this are refused. osu! itself limits uploads to well under this
+const maxAvatarBytes = 2 << 20
+
+// Avatars wider or taller than this are refused, so a small file can't decode into a huge image
+const maxAvatarSide = 2048
+
+// Cached avatars are used without asking osu! for this long, then revalidated
+const avatarFreshFor = time.Hour
+
+// Cached avatars that haven't been revalidated for this long are dropped from Redis
+const avatarCacheTTL = 14 * 24 * time.Hour
+
+const avatarCacheKeyPrefix = "prosu:avatar:"
+
+var avatarClient = &http.Client{}
+
+// setupAvatars reads the avatar settings. It runs from main, after the .env file is loaded
+func setupAvatars() {
+	if baseURL := os.Getenv("AVATAR_BASE_URL"); baseURL != "" {
+		if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+			panic(errors.New("AVATAR_BASE_URL must be an http:// or https:// URL"))
+		}
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		avatarBaseURL = baseURL
+	}
+	if seconds := os.Getenv("AVATAR_TIMEOUT_SECONDS"); seconds != "" {
+		n, err := strconv.Atoi(seconds)
+		if err != nil || n < 1 {
+			panic(errors.New("AVATAR_TIMEOUT_SECONDS must be a number above 0"))
+		}
+		avatarTimeout = time.Duration(n) * time.Second
+	}
+	avatarClient.Timeout = avatarTimeout
+}
+
+// cachedAvatar - An avatar as it's stored in Redis, along with what we need to ask osu! whether it has changed
+type cachedAvatar struct {
+	Body         []byte `redis:"body"`
+	ETag         string `redis:"etag"`
+	LastModified string `redis:"lastModified"`
+	CheckedAt    int64  `redis:"checkedAt"` // When osu! last told us this is the player's avatar
+}
+
+// Grab the user's avatar. The guest avatar is used if the player has none, or it can't be fetched and we have no older copy
+func getAvatar(userID string) (image.Image, error) {
+	url := avatarBaseURL + userID
+	cached, err := loadCachedAvatar(userID)
+	if err != nil {
+		gError("Failed to load the cached avatar for " + userID + ": " + err.Error())
+		cached = nil
+	}
+	if cached != nil && time.Since(time.Unix(cached.CheckedAt, 0)) < avatarFreshFor {
+		if img, err := decodeAvatar(cached.Body); err == nil {
+			return img, nil
+		}
+		cached = nil
+	}
+
+	fetched, err := fetchAvatar(url, cached)
+	if err != nil {
+		if cached != nil {
+			gError("Couldn't get the avatar at " + url + ", using the cached one: " + err.Error())
+			fetched = cached
+		} else {
+			log.Critical("Couldn't get users' avatar! Returning guest avatar. URL: " + url + " " + err.Error())
+			return guestAvatar, nil
+		}
+	}
+	img, err := decodeAvatar(fetched.Body)
+	if err != nil {
+		log.Critical("Failed to decode user's avatar! Returning guest avatar. URL: " + url + " " + err.Error())
+		return guestAvatar, nil
+	}
+	if err := saveCachedAvatar(userID, fetched); err != nil {
+		gError("Failed to cache the avatar for " + userID + ": " + err.Error())
+	}
+	return img, nil
+}
+
+// fetchAvatar downloads the avatar at the URL. If cached is set osu! is asked whether it changed, and cached is returned if it hasn't
+func fetchAvatar(url string, cached *cachedAvatar) (*cachedAvatar, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+	res, err := avatarClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		cached.CheckedAt = time.Now().Unix()
+		return cached, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.New("osu! responded with status " + strconv.Itoa(res.StatusCode))
+	}
+	if res.ContentLength > maxAvatarBytes {
+		return nil, errors.New("the avatar is " + strconv.FormatInt(res.ContentLength, 10) + " bytes, which is too big")
+	}
+	// Read one byte past the limit, to tell a body that's exactly the limit from one that's over it
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxAvatarBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxAvatarBytes {
+		return nil, errors.New("the avatar is over " + strconv.Itoa(maxAvatarBytes) + " bytes, which is too big")
+	}
+	return &cachedAvatar{
+		Body:         body,
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+		CheckedAt:    time.Now().Unix(),
+	}, nil
+}
+
+// decodeAvatar checks that the avatar is a PNG, JPEG or GIF of a sensible size and decodes it. Animated GIFs give their first frame
+func decodeAvatar(body []byte) (image.Image, error) {
+	switch contentType := http.DetectContentType(body); contentType {
+	case "image/png", "image/jpeg", "image/gif":
+	default:
+		return nil, errors.New("the avatar is " + contentType + ", not an image we can draw")
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width > maxAvatarSide || config.Height > maxAvatarSide {
+		return nil, errors.New("the avatar is " + strconv.Itoa(config.Width) + "x" + strconv.Itoa(config.Height) + ", which is too big")
+	}
+	if format == "gif" {
+		// Only decodes the first frame, rather than every frame like gif.DecodeAll
+		return gif.Decode(bytes.NewReader(body))
+	}
+	img, _, err := image.Decode(bytes.NewReader(body))
+	return img, err
+}
+
+// loadCachedAvatar returns the player's cached avatar, or nil if there isn't one
+func loadCachedAvatar(userID string) (*cachedAvatar, error) {
+	conn := sessionStore.Pool.Get()
+	defer conn.Close()
+	values, err := redis.Values(conn.Do("HGETALL", avatarCacheKeyPrefix+userID))
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	cached := &cachedAvatar{}
+	if err := redis.ScanStruct(values, cached); err != nil {
+		return nil, err
+	}
+	return cached, nil
+}
+
+// saveCachedAvatar stores the player's avatar, and keeps it around for another avatarCacheTTL
+func saveCachedAvatar(userID string, cached *cachedAvatar) error {
+	conn := sessionStore.Pool.Get()
+	defer conn.Close()
+	key := avatarCacheKeyPrefix + userID
+	conn.Send("MULTI")
+	conn.Send("HMSET", redis.Args{}.Add(key).AddFlat(cached)...)
+	conn.Send("EXPIRE", key, int64(avatarCacheTTL/time.Second))
+	_, err := conn.Do("EXEC")
+	return err
+}
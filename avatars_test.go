@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// encodedAvatar returns an avatar of the size in the format, as osu! would send it
+func encodedAvatar(t *testing.T, format string, width int, height int) []byte {
+	t.Helper()
+	img := image.NewPaletted(image.Rect(0, 0, width, height), []color.Color{color.Black, color.White})
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeAvatar(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    []byte
+		wantErr bool
+	}{
+		{name: "png", body: encodedAvatar(t, "png", 128, 128)},
+		{name: "jpeg", body: encodedAvatar(t, "jpeg", 128, 128)},
+		{name: "gif", body: encodedAvatar(t, "gif", 128, 128)},
+		{name: "largest allowed", body: encodedAvatar(t, "png", maxAvatarSide, maxAvatarSide)},
+		{name: "too wide", body: encodedAvatar(t, "png", maxAvatarSide+1, 1), wantErr: true},
+		{name: "too tall", body: encodedAvatar(t, "gif", 1, maxAvatarSide+1), wantErr: true},
+		{name: "html error page", body: []byte("<!DOCTYPE html><html><body>Not found</body></html>"), wantErr: true},
+		{name: "svg", body: []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="128" height="128"></svg>`), wantErr: true},
+		{name: "bmp", body: append([]byte("BM"), make([]byte, 64)...), wantErr: true},
+		{name: "empty", body: []byte{}, wantErr: true},
+		{name: "cut short", body: encodedAvatar(t, "png", 128, 128)[:20], wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			img, err := decodeAvatar(test.body)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got a %v image", img.Bounds())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if img == nil {
+				t.Fatal("got no image")
+			}
+		})
+	}
+}
+
+func TestFetchAvatar(t *testing.T) {
+	avatar := encodedAvatar(t, "png", 128, 128)
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		cached   *cachedAvatar
+		wantBody []byte
+		wantErr  bool
+	}{
+		{
+			name: "fetched",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("ETag", `"abc"`)
+				w.Write(avatar)
+			},
+			wantBody: avatar,
+		},
+		{
+			name: "exactly the size limit",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write(make([]byte, maxAvatarBytes))
+			},
+			wantBody: make([]byte, maxAvatarBytes),
+		},
+		{
+			name: "content length over the size limit",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Length", strconv.Itoa(maxAvatarBytes+1))
+				w.Write(make([]byte, maxAvatarBytes+1))
+			},
+			wantErr: true,
+		},
+		{
+			name: "body over the size limit without a content length",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				// Flushing first makes the response chunked, so it has no Content-Length
+				w.WriteHeader(http.StatusOK)
+				w.(http.Flusher).Flush()
+				w.Write(make([]byte, maxAvatarBytes+1))
+			},
+			wantErr: true,
+		},
+		{
+			name: "not found",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			wantErr: true,
+		},
+		{
+			name: "not modified",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("If-None-Match") != `"abc"` || r.Header.Get("If-Modified-Since") != "Thu, 10 Jun 2021 12:00:00 GMT" {
+					t.Errorf("asked without the cached avatar's validators: %v", r.Header)
+				}
+				w.WriteHeader(http.StatusNotModified)
+			},
+			cached:   &cachedAvatar{Body: avatar, ETag: `"abc"`, LastModified: "Thu, 10 Jun 2021 12:00:00 GMT"},
+			wantBody: avatar,
+		},
+		{
+			name: "not modified without a cached avatar",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotModified)
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(test.handler)
+			defer server.Close()
+			got, err := fetchAvatar(server.URL+"/2", test.cached)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %d bytes", len(got.Body))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got.Body, test.wantBody) {
+				t.Errorf("got %d bytes, want %d", len(got.Body), len(test.wantBody))
+			}
+			if got.CheckedAt == 0 {
+				t.Error("the avatar wasn't marked as checked")
+			}
+		})
+	}
+}
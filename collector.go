@@ -0,0 +1,142 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+// How often every tracked player is checked, regardless of when their users post. 0 turns the collector off
+var collectionInterval = 6 * time.Hour
+
+// Set while a collection run is going, so a slow run isn't overlapped by the next one
+var collecting int32
+
+// Set when shutting down, so a collection run in progress stops early
+var collectorStopping int32
+
+// Stops the collector's interval. nil if the collector isn't running
+var collectorStop chan bool
+
+func cLog(msg string) {
+	log.Info("[STAT COLLECTOR] " + msg)
+}
+
+func cError(msg string) {
+	log.Error("[STAT COLLECTOR] " + msg)
+}
+
+// trackedPlayer - A player and mode that at least one user posts tweets for
+type trackedPlayer struct {
+	Player bson.ObjectId
+	Mode   int
+}
+
+// setupCollector starts checking for players that are due a check. Only the leader collects, so each player is checked once
+func setupCollector() {
+	if hours := os.Getenv("STAT_COLLECTION_HOURS"); hours != "" {
+		n, err := strconv.Atoi(hours)
+		if err != nil || n < 0 {
+			panic(errors.New("STAT_COLLECTION_HOURS must be a number that isn't negative"))
+		}
+		collectionInterval = time.Duration(n) * time.Hour
+	}
+	if collectionInterval == 0 {
+		cLog("Stat collection is turned off")
+		return
+	}
+	collectorStop = setInterval(runCollection, 15*60*1000, true)
+}
+
+// stopCollector stops collection runs from starting, and waits until the deadline for one in progress to stop. Returns whether no
+// run is still going
+func stopCollector(deadline time.Time) bool {
+	atomic.StoreInt32(&collectorStopping, 1)
+	if collectorStop != nil {
+		close(collectorStop)
+		collectorStop = nil
+	}
+	for atomic.LoadInt32(&collecting) == 1 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return true
+}
+
+// runCollection saves a new check for every tracked player whose last check is older than the collection interval
+func runCollection() {
+	if isMaintenance || atomic.LoadInt32(&collectorStopping) == 1 || !leader.IsLeader() {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&collecting, 0, 1) {
+		cLog("The last collection run is still going")
+		return
+	}
+	defer atomic.StoreInt32(&collecting, 0)
+
+	players, err := findTrackedPlayers()
+	if err != nil {
+		cError("Failed to find the tracked players")
+		captureError(err)
+		return
+	}
+	collected := 0
+	for _, tracked := range players {
+		// Another replica has taken over, and will carry on from where we got to
+		if !leader.IsLeader() {
+			cLog("Lost the scheduler lease, stopping")
+			break
+		}
+		if atomic.LoadInt32(&collectorStopping) == 1 {
+			cLog("Shutting down, stopping")
+			break
+		}
+		saved, err := collectPlayer(tracked)
+		if err != nil {
+			cError("Failed to collect stats for player " + tracked.Player.Hex() + " in " + allOsuModes[tracked.Mode] + ": " + err.Error())
+			continue
+		}
+		if saved {
+			collected++
+		}
+	}
+	cLog("Collected stats for " + strconv.Itoa(collected) + " of " + strconv.Itoa(len(players)) + " tracked players")
+}
+
+// findTrackedPlayers finds every player and mode that a user with tweets turned on has set
+func findTrackedPlayers() ([]trackedPlayer, error) {
+	results := []struct {
+		ID trackedPlayer `bson:"_id"`
+	}{}
+	err := connection.Collection("usermodels").Collection().Pipe([]bson.M{
+		{"$match": bson.M{"osuSettings.enabled": true, "osuSettings.player": bson.M{"$exists": true}}},
+		{"$group": bson.M{"_id": bson.M{"player": "$osuSettings.player", "mode": "$osuSettings.mode"}}},
+	}).All(&results)
+	if err != nil {
+		return nil, err
+	}
+	players := []trackedPlayer{}
+	for _, result := range results {
+		if result.ID.Mode < 0 || result.ID.Mode >= len(modeCheckFields) {
+			continue
+		}
+		players = append(players, result.ID)
+	}
+	return players, nil
+}
+
+// collectPlayer fetches and saves a check for the player, unless they were checked within the collection interval. Returns whether a
+// check was saved
+func collectPlayer(tracked trackedPlayer) (bool, error) {
+	snap, err := snapshots.Latest(collectorAPI, tracked.Player, tracked.Mode, collectionInterval, false)
+	if err != nil {
+		return false, err
+	}
+	return snap.Fetched, nil
+}
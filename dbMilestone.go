@@ -0,0 +1,21 @@
+package main
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-bongo/bongo"
+)
+
+/* milestonemodels */
+
+// Milestone - A milestone rule that fired for a user. Saved once per user, player, mode and rule, so each milestone only fires once
+type Milestone struct {
+	bongo.DocumentBase `bson:",inline"`
+	Key                string        `bson:"key"` // Unique per user, player, mode and rule, from milestoneKey
+	User               bson.ObjectId `bson:"user"`
+	Player             bson.ObjectId `bson:"player"`
+	Mode               int           `bson:"mode"`
+	Kind               string        `bson:"kind"`
+	Value              int           `bson:"value"`
+	Check              bson.ObjectId `bson:"check"` // The OsuRequest that crossed the milestone
+	TweetID            string        `bson:"tweetId"`
+}
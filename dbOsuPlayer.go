@@ -26,3 +26,35 @@ type OsuModes struct {
 type OsuModeChecks struct {
 	Checks []bson.ObjectId `bson:"checks"`
 }
+
+// The field each mode's checks are stored under, by mode number
+var modeCheckFields = [4]string{"modes.standard.checks", "modes.taiko.checks", "modes.ctb.checks", "modes.mania.checks"}
+
+// ChecksForMode returns the player's checks for the mode number
+func (p *OsuPlayer) ChecksForMode(mode int) []bson.ObjectId {
+	switch mode {
+	case 0:
+		return p.Modes.Standard.Checks
+	case 1:
+		return p.Modes.Taiko.Checks
+	case 2:
+		return p.Modes.CTB.Checks
+	case 3:
+		return p.Modes.Mania.Checks
+	}
+	return nil
+}
+
+// SetChecksForMode replaces the player's checks for the mode number
+func (p *OsuPlayer) SetChecksForMode(mode int, checks []bson.ObjectId) {
+	switch mode {
+	case 0:
+		p.Modes.Standard.Checks = checks
+	case 1:
+		p.Modes.Taiko.Checks = checks
+	case 2:
+		p.Modes.CTB.Checks = checks
+	case 3:
+		p.Modes.Mania.Checks = checks
+	}
+}
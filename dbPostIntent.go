@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-bongo/bongo"
+)
+
+/* postintentmodels */
+
+// The states a PostIntent can be in
+const (
+	intentStatePending = "pending" // We may have started posting the tweet, but don't know if it went up
+	intentStatePosted  = "posted"  // The tweet is up, TweetID says which one
+)
+
+// PostIntent - Records that we are about to post a user's tweet for a slot, before anything is uploaded. A retry that finds an
+// intent knows the tweet may already be up, and looks for it instead of posting it again
+type PostIntent struct {
+	bongo.DocumentBase `bson:",inline"`
+	Key                string        `bson:"key"` // Unique per tweet, from postIntentKey or milestoneIntentKey
+	User               bson.ObjectId `bson:"user"`
+	Slot               int64         `bson:"slot"`
+	State              string        `bson:"state"`
+	StartedAt          int64         `bson:"startedAt"` // When the first attempt to post began
+	TweetID            string        `bson:"tweetId"`
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-bongo/bongo"
+)
+
+/* postjobmodels */
+
+// The kinds of PostJob
+const (
+	jobKindScheduled = "scheduled" // The user's normal tweet for a slot
+	jobKindMilestone = "milestone" // A milestone card for the milestones a check crossed
+	jobKindManual    = "manual"    // A tweet the user asked for with the post now button. It isn't retried
+)
+
+// The states a PostJob can be in
+const (
+	jobStatePending   = "pending"
+	jobStateRunning   = "running"
+	jobStateSucceeded = "succeeded"
+	jobStateFailed    = "failed"
+)
+
+// PostJob - A tweet that is due to be posted for a user. Jobs are saved before any work is done so they survive restarts
+type PostJob struct {
+	bongo.DocumentBase `bson:",inline"`
+	User               bson.ObjectId `bson:"user"`
+	Kind               string        `bson:"kind"`
+	Check              bson.ObjectId `bson:"check,omitempty"` // For milestone jobs, the OsuRequest that crossed the milestones
+	Period             int64         `bson:"period"`          // The slot the tweet was due in. For milestone jobs, when the check was made
+	Run                bson.ObjectId `bson:"run,omitempty"`   // For scheduled jobs, the SchedulerRun that queued the job
+	State              string        `bson:"state"`
+	Attempts           int           `bson:"attempts"`
+	NextAttemptAt      int64         `bson:"nextAttemptAt"`
+	LockedAt           int64         `bson:"lockedAt"`
+	LastError          string        `bson:"lastError"`
+}
@@ -11,25 +11,70 @@ import (
 
 // User - A user in prosu
 type User struct {
-	bongo.DocumentBase `bson:",inline"`
-	OsuSettings        OsuSettings `bson:"osuSettings"`
-	TweetHistory       []UserTweet `bson:"tweetHistory"`
-	Twitter            TwitterUser `bson:"twitter"`
+	bongo.DocumentBase     `bson:",inline"`
+	OsuSettings            OsuSettings `bson:"osuSettings"`
+	TweetHistory           []UserTweet `bson:"tweetHistory"`
+	Twitter                TwitterUser `bson:"twitter"`
+	NextPostAt             int64       `bson:"nextPostAt"`             // When the next scheduled tweet is due
+	LastPostNowAt          int64       `bson:"lastPostNowAt"`          // When the user last used the post now button
+	CredentialFailures     int         `bson:"credentialFailures"`     // Twitter calls in a row that failed because of the user's account
+	CredentialFailingSince int64       `bson:"credentialFailingSince"` // When the first of those calls failed
+	DisabledReason         string      `bson:"disabledReason"`         // Why we turned the user's tweets off, empty if we didn't
+	Notices                []Notice    `bson:"notices"`                // Shown on the settings page until the user dismisses them
+}
+
+// Notice - Something we need to tell the user about, like their tweets being turned off
+type Notice struct {
+	ID        bson.ObjectId `bson:"id"`
+	Kind      string        `bson:"kind"` // The DisabledReason the notice is about
+	CreatedAt int64         `bson:"createdAt"`
 }
 
 // OsuSettings - The osu-related settings for a user in Prosu
 type OsuSettings struct {
-	Player        bson.ObjectId `bson:"player,omitempty"`
-	Mode          int           `bson:"mode"`
-	Enabled       bool          `bson:"enabled"`
-	HourToPost    int           `bson:"hourToPost"`
-	PostFrequency int           `bson:"postFrequency"` // 0 = Daily, 1 = Weekly, 2 = Monthly
+	Player        bson.ObjectId   `bson:"player,omitempty"`
+	Mode          int             `bson:"mode"`
+	Enabled       bool            `bson:"enabled"`
+	HourToPost    int             `bson:"hourToPost"`    // In the user's time zone
+	TimeZone      string          `bson:"timeZone"`      // IANA time zone name, eg. "Europe/London"
+	PostFrequency int             `bson:"postFrequency"` // 0 = Daily, 1 = Weekly, 2 = Monthly, 3 = Chosen weekdays, 4 = Every few days
+	PostWeekday   int             `bson:"postWeekday"`   // Day weekly tweets are posted on, 0 = Sunday
+	PostMonthDay  int             `bson:"postMonthDay"`  // Day of the month monthly tweets are posted on. Short months post on their last day
+	PostSchedule  PostSchedule    `bson:"postSchedule"`
+	SkipUnchanged SkipUnchanged   `bson:"skipUnchanged"`
+	Milestones    []MilestoneRule `bson:"milestones"`
+	Theme         string          `bson:"theme"`       // ID of the card theme. Empty means the default theme
+	StatRows      []string        `bson:"statRows"`    // Keys of the stat rows on the card, in order. Empty means the theme's rows
+	ShowHistory   bool            `bson:"showHistory"` // Adds charts of the player's pp and rank over their latest checks to the card
+}
+
+// MilestoneRule - A threshold that gets its own tweet the first time the player crosses it
+type MilestoneRule struct {
+	Kind  string `bson:"kind"` // One of the milestoneKind constants
+	Value int    `bson:"value"`
+}
+
+// SkipUnchanged - Settings for only posting when the player's stats changed enough since their last tweet
+type SkipUnchanged struct {
+	Enabled  bool    `bson:"enabled"`
+	MinPlays int     `bson:"minPlays"` // Post if the play count went up by at least this much
+	MinPP    float64 `bson:"minPP"`    // Or if pp changed by at least this much. 0 means pp isn't looked at
+}
+
+// PostSchedule - The rules for the custom post frequencies
+type PostSchedule struct {
+	Weekdays  []int `bson:"weekdays"`  // Days tweets are posted on when posting on chosen weekdays, 0 = Sunday
+	EveryDays int   `bson:"everyDays"` // Number of days between tweets when posting every few days
+	StartDate int64 `bson:"startDate"` // When the every few days cycle started counting from
 }
 
 // UserTweet - A tweet object
 type UserTweet struct {
-	DatePosted  int64       `bson:"datePosted"`
-	TweetObject TweetObject `bson:"tweetObject"`
+	DatePosted  int64         `bson:"datePosted"`
+	TweetObject TweetObject   `bson:"tweetObject"`
+	Check       bson.ObjectId `bson:"check,omitempty"`  // The OsuRequest the tweet showed
+	Player      bson.ObjectId `bson:"player,omitempty"` // The osu! player the tweet was for
+	Mode        int           `bson:"mode"`
 }
 
 // TweetObject - The object inside the UserTweet containing the tweet ID
@@ -68,8 +113,24 @@ func findOrCreateUser(twitterUser anaconda.User, accessToken *oauth.AccessToken)
 					Enabled:       false,
 					PostFrequency: 0,
 					HourToPost:    0,
+					TimeZone:      "UTC",
+					PostWeekday:   0,
+					PostMonthDay:  1,
+					PostSchedule: PostSchedule{
+						Weekdays:  []int{},
+						EveryDays: 2,
+					},
+					SkipUnchanged: SkipUnchanged{
+						Enabled:  false,
+						MinPlays: 1,
+						MinPP:    0,
+					},
+					Milestones: []MilestoneRule{},
+					Theme:      defaultThemeID,
+					StatRows:   []string{},
 				},
 				TweetHistory: []UserTweet{},
+				Notices:      []Notice{},
 				Twitter: TwitterUser{
 					Token:       accessToken.Token,
 					TokenSecret: accessToken.Secret,
@@ -93,17 +154,19 @@ func findOrCreateUser(twitterUser anaconda.User, accessToken *oauth.AccessToken)
 		return *user, err
 	}
 	// We found a user in the database that matches
-	log.Debug("Found an existing user for @" + twitterUser.ScreenName + ": " + user.GetId().Hex() + ". Checking to see if the handle matches.")
-	if user.Twitter.Profile.Handle == twitterUser.ScreenName {
-		// Handle matches
-		log.Debug("Handle for @" + twitterUser.ScreenName + " matches")
+	log.Debug("Found an existing user for @" + twitterUser.ScreenName + ": " + user.GetId().Hex() + ". Checking to see if the handle and tokens match.")
+	if user.Twitter.Profile.Handle == twitterUser.ScreenName && user.Twitter.Token == accessToken.Token && user.Twitter.TokenSecret == accessToken.Secret {
+		// Handle and tokens match
+		log.Debug("Handle and tokens for @" + twitterUser.ScreenName + " match")
 		return *user, nil
 	}
-	// We need to update the handle in the database
+	// We need to update the handle and tokens in the database. Signing in again is how users fix expired tokens
 	user.Twitter.Profile.Handle = twitterUser.ScreenName
+	user.Twitter.Token = accessToken.Token
+	user.Twitter.TokenSecret = accessToken.Secret
 	if err := connection.Collection("usermodels").Save(user); err != nil {
 		// An error occurred when saving the doucument
-		log.Error("Error saving user after updating handle")
+		log.Error("Error saving user after updating handle and tokens")
 		return *user, err
 	}
 	// Successfully updated handle in database
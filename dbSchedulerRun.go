@@ -0,0 +1,49 @@
+package main
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-bongo/bongo"
+)
+
+/* schedulerrunmodels */
+
+// SchedulerRun - A record of one findAndGenerate run. The posted, skipped and failed counts go up as the run's jobs finish
+type SchedulerRun struct {
+	bongo.DocumentBase `bson:",inline"`
+	Hour               int64  `bson:"hour"` // The hour tweets were posted for
+	StartedAt          int64  `bson:"startedAt"`
+	FinishedAt         int64  `bson:"finishedAt"` // When every job was queued, 0 while the run is going
+	Candidates         int    `bson:"candidates"` // Users whose tweet was due
+	Filtered           int    `bson:"filtered"`   // Candidates that weren't queued, because they had no player or their tweet was stale
+	Queued             int    `bson:"queued"`
+	Posted             int    `bson:"posted"`
+	Skipped            int    `bson:"skipped"`
+	Failed             int    `bson:"failed"`
+	Error              string `bson:"error"` // Why the run stopped early, if it did
+}
+
+/* schedulerrunoutcomemodels */
+
+// The outcomes a RunOutcome can have
+const (
+	outcomeNoPlayer      = "no player"
+	outcomeStale         = "stale"
+	outcomeQueued        = "queued"
+	outcomeAlreadyQueued = "already queued"
+	outcomeQueueFailed   = "queue failed"
+	outcomeRetrying      = "retrying"
+	outcomePosted        = "posted"
+	outcomeSkipped       = "skipped"
+	outcomeFailed        = "failed"
+)
+
+// RunOutcome - What happened to one user in a SchedulerRun
+type RunOutcome struct {
+	bongo.DocumentBase `bson:",inline"`
+	Run                bson.ObjectId `bson:"run"`
+	User               bson.ObjectId `bson:"user"`
+	Handle             string        `bson:"handle"`
+	Slot               int64         `bson:"slot"`
+	Outcome            string        `bson:"outcome"`
+	Reason             string        `bson:"reason"`
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"github.com/go-bongo/bongo"
+)
+
+/* schedulerstatemodels */
+
+// SchedulerState - Progress of a scheduled task, kept so a restarted or newly elected replica knows where to pick up
+type SchedulerState struct {
+	bongo.DocumentBase `bson:",inline"`
+	Name               string `bson:"name"`
+	LastCompletedHour  int64  `bson:"lastCompletedHour"` // Start of the last hour the task finished for
+}
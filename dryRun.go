@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ChimeraCoder/anaconda"
+)
+
+func dLog(msg string) {
+	log.Info("[DRY RUN] " + msg)
+}
+
+func dError(msg string) {
+	log.Error("[DRY RUN] " + msg)
+}
+
+// dryRunReport - What a posting run would have done, written to report.json in the dry run directory
+type dryRunReport struct {
+	Period     time.Time     `json:"period"`
+	StartedAt  time.Time     `json:"startedAt"`
+	FinishedAt time.Time     `json:"finishedAt"`
+	WouldPost  []dryRunEntry `json:"wouldPost"`
+	Skipped    []dryRunEntry `json:"skipped"`
+}
+
+// dryRunEntry - A user the dry run looked at
+type dryRunEntry struct {
+	UserID  string    `json:"userId"`
+	Handle  string    `json:"handle"`
+	DueAt   time.Time `json:"dueAt"`
+	Player  string    `json:"player,omitempty"`
+	Image   string    `json:"image,omitempty"`
+	Caption string    `json:"caption,omitempty"`
+	Reason  string    `json:"reason,omitempty"`
+}
+
+// runDryRun goes through a posting run without queueing jobs, saving data, uploading media or posting tweets. Images are
+// written to DRY_RUN_DIR (default "./dry-run") along with a report of who would have been posted for. The run is for the
+// current hour unless DRY_RUN_AT is set to an RFC 3339 time
+func runDryRun() error {
+	dir := os.Getenv("DRY_RUN_DIR")
+	if dir == "" {
+		dir = "./dry-run"
+	}
+	period := time.Now().UTC()
+	if at := os.Getenv("DRY_RUN_AT"); at != "" {
+		parsed, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return errors.New("DRY_RUN_AT must be an RFC 3339 time, eg. 2018-09-01T15:00:00Z")
+		}
+		period = parsed.UTC()
+	}
+	period = period.Truncate(time.Hour)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	report := dryRunReport{
+		Period:    period,
+		StartedAt: time.Now(),
+		WouldPost: []dryRunEntry{},
+		Skipped:   []dryRunEntry{},
+	}
+	dLog("Starting a dry run for " + period.Format(time.RFC3339) + ". Writing images to " + dir)
+
+	staleBefore := period.Add(-time.Duration(schedulerMaxLookback) * time.Hour)
+	users, err := findDueUsers(period, staleBefore)
+	if err != nil {
+		return err
+	}
+	for _, user := range users.NoPlayer {
+		report.Skipped = append(report.Skipped, newDryRunEntry(user, "no osu! player set"))
+	}
+	for _, user := range users.Stale {
+		report.Skipped = append(report.Skipped, newDryRunEntry(user, "due too long ago, would be rescheduled"))
+	}
+	for _, user := range users.Due {
+		entry := dryRunPost(user, dir)
+		if entry.Reason != "" {
+			report.Skipped = append(report.Skipped, entry)
+		} else {
+			report.WouldPost = append(report.WouldPost, entry)
+		}
+	}
+	report.FinishedAt = time.Now()
+
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "report.json"), reportJSON, 0644); err != nil {
+		return err
+	}
+	dLog("Finished. " + strconv.Itoa(len(report.WouldPost)) + " tweets would have been posted and " + strconv.Itoa(len(report.Skipped)) + " users were skipped. See " + filepath.Join(dir, "report.json"))
+	return nil
+}
+
+func newDryRunEntry(user dueUser, reason string) dryRunEntry {
+	return dryRunEntry{
+		UserID: user.ID.Hex(),
+		Handle: user.Handle,
+		DueAt:  time.Unix(user.Slot, 0).UTC(),
+		Reason: reason,
+	}
+}
+
+// dryRunPost does everything updateAndPost does for the user up to uploading the image, and writes the image to dir instead
+func dryRunPost(due dueUser, dir string) (entry dryRunEntry) {
+	entry = newDryRunEntry(due, "")
+	defer func() {
+		if r := recover(); r != nil {
+			dError("Recovering from a failed dry run for @" + due.Handle)
+			entry.Reason = "panicked while generating the tweet"
+		}
+	}()
+	l := pLogger{
+		UserID: due.ID.Hex(),
+	}
+
+	prosuUser := &User{}
+	if err := connection.Collection("usermodels").FindById(due.ID, prosuUser); err != nil {
+		entry.Reason = "failed to grab the user: " + err.Error()
+		return
+	}
+	player, previousRequest, newRequest, err := refreshPlayerChecks(prosuUser, l, true)
+	if err != nil {
+		entry.Reason = "failed to get osu! data: " + err.Error()
+		return
+	}
+	entry.Player = player.PlayerName
+	previousRequest, skipReason := compareToLastPost(prosuUser, previousRequest, newRequest, l)
+	if skipReason != "" {
+		entry.Reason = "stats haven't changed enough: " + skipReason
+		return
+	}
+	postImage, err := generateImage(prosuUser, player, previousRequest, newRequest, l)
+	if err != nil {
+		entry.Reason = "failed to generate the image: " + err.Error()
+		return
+	}
+
+	imagePath := filepath.Join(dir, due.Handle+"-"+due.ID.Hex()+".png")
+	file, err := os.Create(imagePath)
+	if err != nil {
+		dError("Failed to create " + imagePath)
+		entry.Reason = "failed to write the image: " + err.Error()
+		return
+	}
+	defer file.Close()
+	if err := png.Encode(file, postImage); err != nil {
+		entry.Reason = "failed to write the image: " + err.Error()
+		return
+	}
+	entry.Image = imagePath
+	entry.Caption = tweetCaption(player)
+
+	// Checking the user's credentials doesn't post anything, and tells us whether the real run would have given up on them
+	prosuTwitter := anaconda.NewTwitterApiWithCredentials(prosuUser.Twitter.Token, prosuUser.Twitter.TokenSecret, consumerKey, consumerSecret)
+	ok, err := prosuTwitter.VerifyCredentials()
+	if err != nil {
+		entry.Reason = classifyTwitterError("verify credentials", err).Error()
+		return
+	}
+	if !ok {
+		entry.Reason = "Twitter credentials are invalid"
+	}
+	return
+}
@@ -0,0 +1,3 @@
+// Package fontdata holds the fallback fonts compiled into the binary, made by genFonts.go. They're in their own package so the
+// app's package doesn't have to be rebuilt with them every time it changes
+package fontdata
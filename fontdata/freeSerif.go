@@ -0,0 +1,141 @@
+// Code generated by genFonts.go; DO NOT EDIT.
+
+// GNU FreeSerif, for Thai. Part of GNU FreeFont (https://www.gnu.org/software/freefont/).
+// Licensed under the GNU General Public License, version 3 or later, with the GNU font exception.
+// Subset to 0E00-0E7F
+
+package fontdata
+
+// FreeSerif is a gzipped TrueType font, base64 encoded. Newlines are skipped when it's decoded
+const FreeSerif = `
+H4sIAAAAAAAC/7S8CXhjV304+jtHu6ztSrpXkiVLV7pabK22Vq8a2zP2jMcztmN7JvZksmhseZl4iyWPMwMJgZe2YUhLUwpfXtgCzVdomwRlCi3bB2noe4U+
+eC0QeEDYvz7IAwpJC4TyGOn/nXOvFnsmgf7b/+Sz9Dvn3nt++3J+5yqAAMAAD4AM7pqeSyR7M+OPAsBPAODs0mZhB4x9swDoGACkly6Vedmfyp8HQPcCQHJl
+Z3XT+OHEBwDQkwC4uFoo7UAHaADkVgAwrW5cXnn7+9gpALkfIK1bKxaWf/mHJz8F0NsNANm1tWJBtSjzAPSuAYB/bbN8759N/EcbQO/DAKi8sb1UAE1OB9AP
+BOdm4d4dzKoHAAYIfn6rsFn82TPvvRtg4FEAdndnu1Su/T4kAW7Rkes7u8Wdq4X2KYBbogDodkDoEfTHoADAV/EnIAEPk+/a7eg70IMwAMZKmUKuwDL5//u2
+p2vPwfWabMbZCQBwfOoMD8PA12oyvtYLIHsQRe9CqPadGoj/EMhACwA60NFxpzSPAQwIwEAZADAcATDcBgADtS4A9Bx+B0QBgxLc4IYgBCELgzABK3RuCs7A
+GTgPK7AKW1ACqEC0ApbJSnhmoXLy0mIFhCP2ijKyMLRI5+5b5J+vIEvcHqugKP+Nii4Sq+Do5OzCmLDojVVk0XU7XxmeWfBWhhdjFXmUPOoVvFcWvuX8wqJz
+cnZh4brzXxedgreiiCxUxi8t0guLi/ZYRRHVnz8Xqyijz/jQQzMLFf6h8+edFViMVVTRZ/x0argxpY6aGb4vEatoovx9BMnfO7+wyFdkgQmBr8iDJysws3C1
+eLXAE6DX6fUuOq/S0aw4Igi1InUmp8m7GKu0RfkvUXZ0UT5RUUXOL/D8cWG8cJFf4JcviEuQ+/QEM9+X4K/yx6+OF4Sr/FWBohPI4pXhXqd30SmQicpwkQwW
+YxUDxTT0VbvX6+S/enVydoG/KkzwFTgj0ealtxmjAv9VCbnAL0zOOb0VtLhwtSIPTghXBf7qxFWhQB4QHyFfsYqJqMEciVUYwgABzIcYuEq+hMLFu1o5IY9a
+ovxV/uofELGdXBauqir8zMKg89nFWMUa/WsYRsOjo2jyoyZYAvpJbj6zQD5nF4QLfAWEUecFvoKE0UW+Mjy7cA14OLo0eg3x6OjSaIVfqjiKrjouNlpBR5eE
+ChxdEmKi3cpAAxhYQLUayADDAgBexo+BDFQAKcbLBLyMdwHrqsOoXH0YP3Z9xYsXABFHRc9jI7gBUJJjGYFJBwWlyo1sBhkdZXNxFMojJDu3PzeTu6M3OKo3
+as2q6c5ue6zHvouNd01XH0dzU6N4EHc4FAodo6/+A2JDMR3ThrPjABjiAOhRbIEI5AACVqXgzaQy6TzOpIOCT+VGrFUp+IKZdDaV5FirAXWgVDKbYVIKcl3J
+WrlUMoseFRzVX2Xcfrdf8HA2r2B3CEO5/FFBGDWx89VXWBPDsoyJPeWJcV+PjHqjNi7E4KjbE4/x8bHxaCCVDL4QYNBntplAgLEEAhJdX8VmyMFRAAtBb5NI
+wHUSmEPUKlnhANG5FhLjE66AyeSNDHBObszEzgv26i9T7oDbYTTbWc5qZkWqC3VS0eP9vaHgSMIdc8TdlLbqUIN6K893uEMmiYMmzXV9CRADSKXjWBKbqDif
+0mpL5zFlQin4QqwlHaTkJbMPt7vbS2dvL8+NHzk2LagtMT7kDdnMzEmWZ1mePbqSPhW7Za28VP0s6rjtvpl7h9iuREe3W+gy/BRFLYGAhWng/y42Qhx6D8iM
+4G+IR8la3ciWzjbpENJxnGvQ0n8ql/U689mhuV6b1yo4u3p1bfpUNkgJmm7n2/9VJArNz60lk9TAXr8ecDgCc2Fn3M72zoh0jSynpxR10kBG9IlV2AxDMAGz
+N2rUjWxMihHishBrFXzBdDbXUOtraFVmTSWHkAHXtdtnc9nCt7nm5rRsoM8/me5OR6mOwzdTcZvH6/QoRUUPd1NFG1W9WfT+6y/z3YOd6ouz47N5rVHS+NAN
+Cq++0N0zpw62i5nJDoAK2AhGcFDuqNhlOQsj0SxJ95pwNjv9a9OPjJxduEsS5N/x8WSsOoPOMdWXUISJeRpiAww8APogNgIPYQALXTUdDHEtbmnLpONY8KlC
+eWRr4Pn6wv583Cv3Ou1Cl28k6+jqsq4OMY6L0yJObCxMVR+/9E6/ztDRHfP4YqPRrkG3fkHdd/QXdXsWdRbHRuiEBAxQ7ERRFPUQUqqEjJKqKkXm8ygnhgwS
+j5KZdIi1Blo0df3cfjTdkz55T/e0iXUI/nA067otPDmyNczYdlGPd6T6d3W9kKj1QNtQV+fx2Yv2J75tiuUTydhjmZzqnpHpRU3meLWr+7h+ruf75mDQzNTp
+RI/iJIQhCyOvHcWSnC07hFKsValiObaVQksmHZLCmxjPUh6/pyWebQ2rhfl44vZmOPuIIB899mpRLReynDOZ8n1amXL/DXWL+fRXzps8M7EXAmJWEGNcEnIw
+ClOtPtGgketAh5gx4EP+gDLpkFVJ6ZbdGPDCGaWfUN2Md8o2o7bFHdC8cHzMxP77wcDXG2BuN5mO9Go1l77aEvbkbe3Whh9UP//V2yy+qcQLAVRssZnaK/hh
+bIQBGIVTks1w1LfTQdG3M9S5RRZsBkUHaoSi4BCqpzQL9WylqmHQr5zbPx6em80Onkz3pKOTw3cOhI4Z5GaVTiE3cg5BiESzUtZ7wMuPzNXN/K7p+wwRVLz+
+8vCm8eLs8c6uobbSyHQeuR1YKVNrDJqPMzEP8b9P15NidbG7Z05/sc4R1GoQr/4M/yE2mkJwG7r+m8dNSpLBKa9u9CtshH4Yh1vqOToYMqCGa2YzKauKs9Hw
+r+JsxL4OOG42F8ehPGpVnK0JouBt+/O+VNuwPzUy1JtOKThll3smd2f/QoDTDyYCvODgjB0xi98ete0u1DX4VHe3N5x1eFOE++ufyncJXXGPO8WGrbYk24H+
+76nRPB7QWNkvJo70uDwx5h1CTGfUy2SZ49WMxPV7vS6zoyPYyuMRmISzdR5DKiF9EzZz/xVGJ1MRLqBJu7oyPbFwRMGpPBZ/+LcyeynY6fRFWWeXyOylS+mY
+N0DYdfmEJO9qv+MO9MINDPuihkMMv48yHKjVgK8+j/4C60xBOAdgUsKitPMgsXAIW2EQTgAEzK15XWWQqYRXrUIyLZlOyYZayybHickBX3rGwYTacdDrwfMu
+3sUZOJO16Z6SF8d7r9Y5LiHZe9J8dRi9hwvcoX7d5iX2Z5J3uj3NHEWc+EhP9Gz0qYYhy6AfAL8TG+EYzMCtoi6tJJ7EcZPIhrfG0SF3VbJCo3zIoxy9YmvV
+p+iySBFw+yP+M0jwzUQHeGc+mzsenpszZ7uo57rabV6rJhuKu+IabUDh8HmH65x18t4RtJrh2o8NLoTHS3f1FJaTyfPTbxQdOHTiqJZ6cF77rsFYwJGfHOyc
+mHGY49oo39F9um65xHlBikMvYiP0wTCclOJQNsekmEYCMyBWYBpxSHbzONRMZc04FD4+TXPZYHZ2JkfjkEnHKA5FIfSSd6R6ezMM/U3EgKLVL0p5zbg5TMpy
+EoeUCp1J/7EbopCY5PQN7YFU332Z5uM0QOqG8rKumThqlOuYtUiXiIYeQIKvdOb28tyJofQZV1yj8Lc5fN6gO+CP+E/WtXByt5Cd3hRrzXP3n5okQtYOsSjq
+6eiJe5wnR24Poyghy9yoOb+NjdANg69iUU36mvYj0thqPUjhDkhmsygQs8kNzXc4m7aSbrUUdEWihFjJHav1MnQg3jCL85TeqSad3bVX0D9iIwShu15HNZWP
+XiUJNbT+H3SDRXXtsRzSdKyp5Orjda22MfYbdZptaFOMJwhbgYcoQO7GeMK0bLtag8a3jk8OCOlbHEzAhUIeD5qWqMlMNQMEfm8jQMjftL7Pot+XSGEO7hU+
+iXXggwiVhy2ZzaUMMsFHCBADgVUZQZkUo1TlkYVEACaVzD7fsWxgHXq3QmvvctlucxznepNTq4K9JvAcPvfu3txJE4tVccS5re2dnHtIf/T0NU+MQzNy5Yml
+6tincuHqXzX2AzFa11JrtkimnMctPhqkVSaVBCQzNCKh1hKHKMEylHTME3cUohvdU0QY6L3DSr69+lzDWMT6+lOBDKdouGC486L9CSKX6ju625xB9RxaaMqm
+GwD9CJNKPtCk7MCekzm8E+kmtDxCNpaOaiVl5Fr2GRL+lcYmEu0cqvFFfD/6b8eH33P9ws3wDQOgn2AjEOsjuqfbQCZH435IJTAHPfP9YT9JvW98aH7+ocu3
+ZpcJDoILGxP5GFP90M9eRF++/vK//AuqxJJTTTQNPD/+78FT+dmL+Onr7M3wEFtyYSOw4G3Kz4kImjYk3CA7ajd7e/N33L2xcDPJvYvoCX3m+svoT6pb1Hca
+2Oq4fvzfigu/9zp7U1y1X9LaKwSp1g7QgQJLqq/y+MYCi5aP9QoqGxXFKzd4HIdqqJbIReuk78WSROLvMHHtVvWhQgmkOgJ9G5vACzHI1qXQgdz4hj38q23Y
++4lY2tNHLPMtW/UD8hkWd+fPESn9k5zLBND7DuzLP9AUVfVvGxtxDMO1V1AFMxCllFlJJDEgFRUXV/eoRowfQqICqWE2N+mP9CTVnIx398/3z118x8UBXzaW
+i0X8goNlJqU9+4bX16aQy+VIm8rFQrlcCD1RPd997Fh3ou+IWpf1BHN9Cd4TYwYO7OVrv0TvxzrohOSrx9ymTgWfqjUHffxQ6B135VKFobpe35SO4nO/L6Wi
+lgCsG5/4C1Gfb7lTf2KpOtSSg3prHvSlek5E/9mciFAzKTptr54Ur38ap8WkqLVyr5EUCT2/Qs9iI3RBqp6jM3Ek+JSHSTnQ9GhS9PLC/rx2NNrjrhNz8uht
+8UbrI93a+vhG90h+4BaRmOgbj81Opev9j2ruQM1V3//4ofu3+eDv6IEnb+J+1z+FM4fdb5y70fcw2Gu/Qu/BDLRDEAA1hEDDUHO/4WnNk+hUe7jTQmWQPbtP
+yAhyVoOsEYnOhAc7KOvnZqv/FksmPEKXIX/Y31/GUeAhAX0tnU1bNpdSqkSRhAgg9TXJhUa/03aoyznfp5GdsQY75lR8UE16ii6BSXDus6TxycamD3Q8k0J0
+sqd7pPp3qKMnaG8zW2jH84qDdy3M3DvEheOulkQg1hS92Agp2mVszaRDSCnUy2JKJJdK5pgUSxlgW3KsokFt6HAUf8TEatn+o5FOuyDYlUpXSKmb70BJPNVI
+wWiU5TmOZ793Yyp+PcaTCaEnlo9hD0bRnyaPbubfK+XmBVqlBoNvbuWlv/Yr9B0cgThk4IjY02rYPnHFutqbOmh1lVyGKqTZPkFq0R887WG7bjggGsPcgEIx
+bxbsTadZkVk9/Kqog3HJNTQutztEzCPijI71+G6rfrbuPB8Pe/RGg7XaaitEBxhzEBB7hrk8zgVpN9RKdsT1rcDB+rYZeVsbct/xd/s7bJHBcAfnnHVzXSOJ
+ecmvc98RKax+sS7ohfuuRHSO8Z4eIdyuC1ir/4BsXPDxv0Z/cKjwZZoilmh9Hf4K+CFG7BrRPWImZUCqZjLPNFM8HdgIealMOnjgAOQTXb3CIE531dO8mPXj
+8biesY6bq19v+PrznZ3mzoHoae76xxsZv6XoeLHHE/XbeEdvnvH95kCN0197BT2HjY3c0YFaLKLueZSYIXSTfvC/L+xfPDV5UYxBrGmgK9zfCIypZmD8BUlh
+74klu92emKlE8tqLjb5ws98q0tIHEcjCcKNvSXtAB8k6SFVIKfhyPgOiE7lg0zj77zk+POTKJRJNEq0MIZGbuX/mA4ZgLMHn04GolHr/3D4rCF2D4cQxSu1b
+ojRc3kWJzflDd8otRp2B57/brOfjAOhN2Ao+iAJYfMEmbYSQbK5182VAKmWoQdm7+zOiv8vVZmcfY3AyXEfXjNJo0Bn09rBE0NjUySRxbMPxEau+R6gOo52s
+P5gztN8vOTbtIdaqaBkbTCHoga9c/5BJRb6rPyX9xdoinoa3yniTEshpNqJnCf3YCHoAr5RtQpRG1D/v6+zPWebOR4+j1yW6Z9tePzoDdI2PAqA7ZWpTEM5f
++6hJCUnRbqpr2Fd7Gv8E2oAFQD6l4KMWzQZtNFyw1lSy+jlHTGa/dfhDd8+qJvoD3Y7X4buVNuH0P064U1MCY6brrGJv7WmZF0zQ3rqOLZNiuYOLtUdljoXh
+nvCA4+5Z9Qmy3mV8kaxnPflg19yEO31aXFNWXcN87WkZDxy4IXxwVcmqVRwbzBJtWLlkE4cb1Un22D12G++L3n3MyBmNXIdIf1dyAq8TjCq5g+dtfmPk7ok9
+ct34IGVI4QSJJxG/BTxN7DnC0Q1suVH1/3LGZI6z+X4rM+DYuVXkLJCYIph8p1F2SKE++WBsWuJP7gJc81efrz1b+zzowQGgkqpk4Yai+Ju+rlB4wGJuqX7f
+5EkkY//7FhPjzYGAWaofq0/R9b4k5n65tB4xU/G0IILojlnwtS5efYqszrQzA27bCZvbYrHn6xurd5KDlYt6htE/91fueNzdyZyuY0O1C7XF2udqz4IGQGVV
+SmcnOZ/f6w579uzt7XbO4wFQgKn2BhzC3wMfhCABOciTXGWQRRArZIRMHmWYdDCUSVF5Wg0yFcuF0iE2q8oQcXcgWzqOQ0xWxQQF1srhkNIVXRhoG/ppH8/3
+/XToCxZ7p838zvu3tv5KbZNZf2GLBNvf9GljyPaE0ZsMut74RjPypNC7U26Z+fIVw1s7Eg5WJcfeP1vhfqI3vIycX33Sn3TqFdVfGxmkQz+wGaG+R4CXqH85
+AHJi1cSkGCJPVYvoXiJRnPZXuLrIxkisRh+r3sPEPFI6qdXIeugFej4wCD+mXeNBigdqr6BHZA9CBDIkZrsxy4iRh+Aj5b1YqnGpA/sA1Ig+b0uNeNqMnMMn
+nM2+aUi4OBgQ7G4L2653KzX2LpdjwTXn6kFeMQzJFNauhFDVMtHheDKGo3fqx1YsOiPr5rgI68nrrg+31LUyStvrZQ9CEJKEWr9ElMi9rXHg35oCs6lka3Hb
+shN4vXA22+fzJjgsOOyCz905NjvQf8bIOT5TN+h/EoksUtoe6coPhdu9BoUnHvN4o3M9A7Nz/dffzOCEROL1R1tr8NpXaj9HV2QPgge6ACxSsdsiSSI2pUoW
+zKRTSVtTfCOOI11CRpKfk3G3qyOLSpVCge6XEt73z5zKeq/fIknsE2zwKPswkpuxSv2b/7NJAKr9pMYjvRiPFQfjsX7O19WXs8zcGR3/Lr6tGZDFfcPvYStk
+GhnSLbPlZVKdbkRCLo/r8hakMGOr56VgPXGT2rM/ojEaNYxcbmtrN8UTOkvilm69Rm1h9R1WFGT8Mcx4HYLgCAbu4XhqpejtvWNyjZKRyVm1OjNQveNUsmNi
+guUGMuiTbnvQ5ojleQsnxPKxi26RzyCNL1dwrvYl2SXIwQgAEt9VIG2onJUYpwFLFKaSLS1mpeAj1QZrtcVRs0tevaJz56PGPn1XxGlxxr1Od1fQ0o5YY4LV
+a21Ony/g7Y3a3G0GjUGTHURqSSEfs4VCNuzwK10haxtWWSN8yBUe0OAujy8y6IgdYR2sw2hXnfDVaxFUW8Hvr/2lbBrUADIrOZKKo8xZjb5Np9fKpk9Mc+bp
+U5S3p/GHas/KiuAAPwDQ3JJK5nEuRZ4JZZI5upHjmvZd/ddOV4fK7PVzKIc2y3b7oFsmt7hOmww6htEZZEVfeyqod+ejk3fL7D7kT4ZcHW69wQ24OoGfrj0r
+W4Y4rSpbzn8OoMw2jotueLtBjNwT/TaPTufs8NjVFl+AkHHX7WZL2qozsiafwWRud1msHdNmncFkMujM+KVEjPd0OkNmQtTEpsyu6zM6XLacHgt2h9/v0Ls7
+XG43secsfqb2OdkYtAHIBBqnSXxO5VLhbDacwi/mcqFsFnBNiZ+qvSS7HzjwAcjSou6zR5AB+eq7vQOJ7Nc9/eoOpceNOJm/K2v0ezhPYNVk0JtMeoMJ/zSQ
+Q2a3IiQotKawI9DFGjo6DEa3W6xf3PhjtadkMyYVnK/ZxbcAq1/EhdpTsgfBL2G3sVJ4UjVw5mzNM7bgD1y8L+O0sk5/W8DEsGaLTWvkYnwsxjvMCdmUMeRy
+q416ZsDuFkxM2GOJpQL+lLEHAOQwCoBnsAFUYAQbCAB+qezIkiCTzibN0jjXEhNRZXB+cHD+m49947HHvvGGN//9m9/8977TuezUVDZ3+lvkyuCXyZXHqo8+
+d/Xqc1d/LF45LfZeXkFfQ28EFTAAIOFo1uvoa1eevHLlye6pnampnSlkeKJUeqL0DxNLSxMnlpfF539Bn6e9kkBLqBZf01KFsmKHRFKNaN7ZFgTYG56J5M9f
+efKKayioH/V2hwypubiP87cZdA6hs090yZczoeTG/cdnS1dm+enjWnP3bvaWALN2bGIpGlDH8onTrb0JVPs5luEF6CXSzNXVUn/liSe7gmAo56a9OHFLQEKp
+cLDF09wM/tqfHE5FTZo21pjvv6P6yyXOyxyJzMwsjlk6AmpO7jKxnSq7Q/BqRVrvWYj1zoX0Ou362OvuOvvg8zPHzCb3hz82dTSZHXlDT3aST+SiqrYY70nM
+MH5/o+f0C9yO3ijGPJvvwMEVlWMeZaQtazAknV/hgxGwSfEPzOl8+K4jvR2BicgZx5GYPuCNtts7TSynM9rDcWfI22a1WF3kYPdURiRaYem8JTO3Oz11YV6Y
+nTVYzZsjx075unN6w1ouNu4ztSm6XJ5YzJMe2K7vJqis+2o1fBKfg6NwGiCXzqty2VxelskRqlUqg6T2FM2J4l7HjUW/EXzBZqDu0xrd9mhH1t3Rphg8ecRl
+Uml07daQvcfudHt7PSHWeNtJbliTP0s2QSoNc3TcZvcVvPcFTt2O3iJy8Kg94vPYbAarRiPHFk98MGxycVzQ7bJwOrNao9FxZreny94zavKfKsTycTlr83rb
+fN65rboWAGTQW6vheTxJT2vnKUfyXN2cVXXGZIJB9pqM1dtethZL7233HQmfNgXio3FlNO9qYxTB7HC3yXjXiaHbTrLD2ht5O30HmmGNBjbWPiSy+A5vrxAw
+d6jaI9543Guw6uPpLjPj5KLjXU6tJuwfCcSPHuLuLDpvZFmjV2X9VpNNwLUf1l5BH8C3QgxyhMvm5p1mWtHSiO9SR2GtqnpJ0PqKXm/c0056ULL4keTwcNIz
+YetiRqKy47cosr1TW1tT6VN+Y1mk/JFQd8LtiaHRpYmTF3in1d81vKNl1G84wdoCpSdKwVysvXlG2lv7N/wn+C5RA8itJG8g/Q72LxzyGnJgSIJPSNaMNq/L
+LfaFWZ3swUum1/CJaKv/CL6hvDl2+2hEKg7uUoW6j2TzPqb8lMb26m7S9KfOZZ/zvunEuQunTFW+6TyY2togPit2CVGSobaFaPnNWjkbNS03Skk1Qf0onZQW
+YqEjz9Aa9MDx9ccumlWWXFj4g8Bb/DZVr3N61sMwagUxLW+yPeBuC5wZNXoNwX4fp0IrE+5HZ+t7CpRHGqOQGtpa8O27+o0OY6a/j8HxfCyd9HZzmkhYYDsU
+fGcyZJ4udH2kNd721v5/zOKzMAjjhI8sdRKluI1wI5sQV4akypKzBV9FXS06+pTbY1Z7Q/qsgee4e3om9R1Ou81oQKzTFo6POq1ERZmu7gnBRFTE9ejTb5Y6
+IKXz+yY+NbnPJm6t8DJXJJENBnE2uJY7cVZuE4heItH4sX6W6EVf/c7hfqEfW0AGpDMCiBEYRTKPWzsyWNXMuKKrNF0B+2+p/pM3qPfzztiM0timV+jb7Kl6
+10apNHvqza3rL2PjE5l8JtwlNmjs3lBb++lgQGzdLDTtwwje2i8Uk5iD3yAF0iMrciE/iqI0GkTH0Gk0j+5Aq2gHAJF3nvIo1xCtyo3r4yTH0he1m0Nys3Rr
+AxahLAFz4h3ZAcSSwwWqp0w6FyIgAeIoZEB0nogiFzwwCimb8KEb4zikPPiY4IvLMrS4d2PRrhsMCL6QZM/1dw2tbhmtWcVbFKRUJdE1lKaekLWlQ0qWSzaG
+WdJ3Zq0hOsqk6WVr6sbrmdbnrUmUDkYQnbCkJQpUdQAnTEKbwqt2mA16s1VhN6vkvJo1cSbEqPxq7FGxBquhjdO3G/QazuBENn3YgDiDvM1s1iq0Ruf3f2hu
+0+s11rY2VhM0ykwybJardSa9WW6XW+RtBquB9Wq8DGNnHGpBqfIqTIydaVcyDoWmk2FdVkHt9b+jaOk5kha68sMuPpPjOyIhU9dAzKHRcSa5JxQMjy8Mtw/0
+BTzdg2l79vyRsL6LNQmoqpAjhSKtUak0U1olNvyxWqPWnFXINX+hNai2FFqN8ss6g0H3oFal/5HBYjZ+UGt2VX+gU2GrR/tkG6NWGvbb2nUqM/pgaGLU2Xbc
+HI3rLRa9kFELHSZVxuiLtZlMbWFBF9fLMgaPQ2swaF0u3pVw2TX6dosrjgJWzmG32rHXrnbozDKZxWRVG3RmlV2v0fFmPeO1Gm1WM6uyKeTtartLrdOpOV7r
+13jVLoGsZu8ctKT4ENnwOGP6hMaU1QRTZNSZNrgCKttsT8JgZfWOE9zI299uCPWvDI9tDHoMganezHTAFM2E+nQaVm9JeyLTi+dOdvQWjwzckQsYMueWcjPd
+JvZtCqVcrdUzWp1ez1xsY7Ra40OGNovB/JBMhaw6jfZvjHqb2WL4uMloNqk+b9TzLs9nZCaGbTcZX5aZLT5P+PsuR4fdhIQ2bbswMFj9dcDj55VaWUdn1qrR
+ivvwV8i7m+IpiuXAixUR1DztVP62085Xzu2fYtg5wf4t6cgTm+V6uQq/xstAf1N/46P+UhBSYqVSq1e/1ptBhOYQAHkHUXqP/6bnxEL64DvglJFWep91Lxka
+nSL7bY5x20D34rG5UR3p0HT5upMDx9NRdK6/9cSYdIrcQ4aTx6sv9HB2ErYDkWgyQ46N/59mfJTRdyTP135Ez0T7AWz1zmaI1AhKVZ2mnE1JXwq9oeHZ3L49
+S5qT/d1Bm4zxdnFeoT3QYbWyMosikmCsLf3QtzpcJjun61gmfdG/HRpy2ZLIa7P7HIG2kMKmHxtebrZKSw6bQc+RWkvqq4v7V0vzfdWWiivX2vB7K1q9pf9o
+JES6KXJ1R2ekD1+uZ2kZv3jvZCKQjHn4GPacvetUo6rDoMQfg7fLZujeEczSXo6T9otvD/dHIv06u8/h8Mn8+VgsH73d397ubxd/6QaAHp5Qld584k7j4C/w
+W2QvkunPfuJTivr3bx65/h3lD2V/DgBq6Qn6KXuw+jycU37tN49cZ5U/lOab/27Hn4QFbIV+9DWIYw7iBMY89OPjEEfvAzt6EXh8HuKN65+HOP4CxHGv+Ic+
+Bzy+D+L4BeiXkesW6Mdu6Mda6MYjEEfPQz++DeI4DN30LwrD9O88xPF5aR039KOXYBh9HHhshl4M0EvX/jbYsQf68QrEsR768SjE8dsgjjH0kz/0HMTRn0Ac
+oLaIroAdvZecb1TXAKqrze+aH6D6FEDtAt4AE3LDMNbAMPo0APpL8quJ2lfQ6dpP0P9B1qleAaitAFSfBqhOANSyADUlQM0NUP0ifgRGsQl66d8AIDwLvXgf
++vC90Iu+Xfsh/hz04jPQizPQi1chriiCl8gKfQ5CRE6ETngnKAEav1ckv7n0A6CvAeAFAPxzANnzAPIogPyDAIoZAKUaQPlNAFUFQPVZAPWTAJp3AWg+DKC9
+F6BtGUDXC6D7PIC+DGCQAxgeBDDKAYyPA5hMAKYHAEwvAjAzAMwnAcydAOZnASznASy/ArA+BMDaAdgnAbhBAK4CYFsAsPsB7N8EcDwE0J4GaH8WwLkD4Pw5
+gMsE4OIBXPMArg0A14cBOtQAHQ8BdLwI4O4FcD8B4P48gOcBAM8/A3heBOAfBPB2A3grAN5/AfA9CiCcAhDKAMJ3AfwPAPg/CBCwAgQ6AQJ/ChCUAwSPAQS/
+BhB6GKDzGECXFaDrNwDhRwEiDwBETwDEdACxxwF6NgCSPEDyWYDUGkDqn8lvYan1346+DsPKczAHGBBg4CRn0YMcAHrwbMNHxuDhhh9p4AcSjEEJVQmWQRDN
+SbActOiPJVgBTvRlCVaCBsskWA0yzEpwG0TwPRKsl39B1yfBBvAzt4AMkBwDBpO5SmEFhX9CYSVg0Jm/TWEVhd9FYTWF/4jCGgC4CiDBCMzwJQnGoIOXJFgG
+p5BCguVgRVckWAFZ9E4JVoIZ/UyC1aDEeglug7PYIcF6zWP43RJsgBOMisJagou5RuE2Sv9HKayjdP4lhQ0UfoDCJsBgMN9BYQtgMJv/kcJWek+UwixgcJkN
+FObovMijneL6Nwo7yDrM9ynsJHiZf6ZwB73nCQq7AYOaeYzCHjr/MIW9lM4tCvvo/P0U9tM1n6VwkOKdoXAIMFjN/RQOE9tgLlE4Ru63mOAobMMOXIYNKMIK
+lIGHJHRDNyQh2oBTLXCmBe5tgfsacA90Aw/jsAtFKAIPc7BNV96HAp0j17ZhD7ZgGQpQhnXYhi2IAxzd3rm8UVwp88nu7mSUfKboZ4Z+9tLPPvLZ082P7xaL
+/Nz2Snm/sFvkx7f3tpYL5fXtrTg0MM9BEXZhHVYAyN1zxd31FYDTUIRlWIc92AQ4XVxe39sEStAWlOn3LqxSIpMQp4z0H2Kmvqh4pQf66EsXsQbrML69VR7f
+3l0t8sl4N98vkUqw8/18T1+sL0Y4gJsuCs2bAc7S6ZIkIB78MAtFuATrjTmRgjhkIA299A4e4Gxxt7S+vcX7Z4uX1gnUz/fEM+le3s//NuEcpz8vJz9sB1iD
+MpRhB0rQDwlIQAkKcAkKsAVbUIA1iNMfoO9BXJJZAnZgF7bhIhRhCcpQggSsSNhWJPkmANbK5Z1SfyJRKlwqbG0V1uKrW3vx7d3VxM7u9sXiUrmUWNktFle2
+t8oJgHlYo9zysATbsAk7sAdlSjnfWJOX7tih5kXG21Q7Ii9N3dV1HAceeJhoeXKloYjSIVMl8r1MjZVQUKBa2JUMqARlKsELEk1kNbJmgRp2gkqFh03Ypnev
+wOXGHaLpi1yUYY0+K3K1SekR6a9fqfNxHIqwRe8qwAbwMAN7cAE2YB2WgIdT9LtI/5cARUqFKJP6PSW62jLwcAEut6z+n3VT4uRFWJeeJxxcOmSlqRs4OEhb
+lF7fpS/MF6DckPAund9pYOqSZEmo3aB33gxfHLSgbbET0QZXqZQ2Gxq+mb6W6dWtFjrXKP6iNFOQLIR87sM6bFCpX6DX9ygnK7AHG5QfcU0eboUJmIcTMA1n
+YB54GIEpWKTzIzALs3Q8D4swIK1ZpjjFZ4uUs1Z61qnFE+2J1Iqa2aVSKcPlhpxPwxjMwlE4Ia0/AqMwAacoLYsNaY/T8RSMwRzM0fE0zFIaiS0R+uZhAo7C
+GThFR2T2DMzCDEzDHIxJfjMnWct/zTpXWrxDtDXiD2UoUDmXJK0uSp5XkqS0QaWwRuNQUfLEJWqNlyQJFaRIsdMinf+qH21QO1tt0Zi45s3srR5bCN4t2IYy
+tY6SJLPBRkwVI+o+/e/GOLpxgAYSR/OSREagJHFZopa6BOsSF0W4lz6zc8BTxTzVjGBEYgUpWhWo1LdhiabCohRL9ylnS7DWsPLSAX7rUTfaiHQE9yb1i+Wb
+3lm3vz2aNzYkPxbv3qHX6hQfjH2HV1mn39stGj1MffRVniT3iXyIOqnHwHWapYqwAStSfN+TtF7HsEuf26NUr0tWcKPMRKrEyECs71ILhwej7f+MDRKbasa3
+G/V8M/6Iv+xLEWVXkt0limtdiubFlui63RLNRQspNfRB7OHya8ic+PA6rFLL/l8rg4kDtnwwr97cPpv3FuCyJLsyXfOgpd5MpqJOm5npcJY7GFtasdZzQR17
+PZvWdbMtcbkq6WG5gW2ZfhLZ38iveK2+xr6U0W98MirF0g0aT4u/lc8Sjbpl6sN1ja7QmLb5GvzHAebX1kv80vbmzl65uMuTeo1fL/E7hd0yv73Ck/81Eqkr
+SS0c5/kJepEUdnxJqtn7+cvbe/xSYYvfLS6vl8q76xf2ykV+vcwXtpYT27v85vby+splMrG3tVzc5ctrRb5c3N0skfXJgOA4Xtwq7hY2+Jm9CxvrS/yp9aXi
+VqnIF0r8DpkprRWX+QuX6e2vtmOI8sX18lpxl78k1c2pOgJptSi/vct3FsqE4F1+e4c81MUXti7zG4Vy87m4VktlsrO7vbpb2CQMN/la5te36Jpr2ztFvrxW
+KBPG9tc3NvgLRX6vVFzZ24jyF/bK/K0T8yemz8zzI1OL/K0js7MjU/OLA/z+enlte6/MFy8VxXXWN3c21ovL/H5hd7ewVb5MaD49Nnv0xMjU/MjoxKmJ+UVC
+9vjE/NTY3Bw/Pj3Lj/AzI7PzE0fPnBqZ5WfOzM5Mz43FeX6uWPxt4lyh6tgt8svFcmF9oxTXahe39/jS2vbexjK/VrhU5HeLS8X1S8VlvsAvbe9c/l10tLG9
+tUoZ48stciPWssJvbZejfKlY5AfJfqE/kdjf32/sFDbEFUqJfFyrHSnxBb60U1xaL2zwxXuXijuiUtdXRAPbLRbKRb7AL28v7W0Wt8r8/tr60hoReUnES0w3
+SoyOL25eKC43J4n89rYKG+XibnGZ39neJQtL1le/ZX2rvE0ZrS8fbbm4vF0sEU6IBa6XS8WNFX6psFcSxb1bLO1tlNe3VpuUlbeJMSxtXyruNs321SUY56m9
+NXhu4lvb3i9eKu7y61uXChvry1QAW5f5bWrmu8VCifCxv3b5AOX85vrqWvk/ScGEKGXJV1vkSWcLl/niveXiliTUJqXlbdGZ6i4nWYv4KPEC8jhxU8LN9oWN
+9dUC8aHyNr+8zZe2G3iXt+kd++ultcbFKL9c3CiWi4dxlsqFcpEyurK7vXkAfxx+x8ps6VCzJAGrtEKP0+c3YQPgJva6JPU1Eqs7G/G18uYGwCX3j6EAID8i
+H5ZPywflZ+V9ADBFEW3ShAgGpwEZWEP68PwNI3FbvkyTQ/Pq92CDpleQu+U98kn5cfmQfPJGLIdGJKlu0qJyixaIV2AfLsPdaBQKN9y71TLao6mhBEDbE8vI
+AhehdOiJIoCUZIq0lCJNhIP0AnxE9hHZZ2TPyD4kewZIr2adqqV4U77rI7GsAVpQENqXpQS4fIhGoKn3MtJDES7CFvx/APIheV4+LB+Ud8sH5Bn5EACM0C3W
+Hk2FBamHSv/V/jdIwk3+fRTVfq+C/hAmK+qZhWcQ+qPFZ8bJ/6WuYlqcrFhnF7yVBxY7KsrI+QWoyPmxiixyrKLlx67JV3GEDjT82MdB+4YaSGM1P3ZN0ycN
+VOSiunlRyY9dU/mkgYIfu6b8D2nQRtbslQY6fuxaW1Ia6PmxazqXOEAVg0SDlR+7ZsjiyDOy8UuRYxULP3bNKjSGZoLXQvFKMww/ds3saAxN/Ng1xtgYGsn9
+JiQ072fJjKF1BY7MsJhrztj4sWvcRGNo58eu2aL14WLFGgGA/zEAoVSfi4xSAAA=
+`
@@ -0,0 +1,418 @@
+//go:build ignore
+// +build ignore
+
+// genFonts turns a TrueType font into a Go file in this package, so it's compiled into the binary. Only the glyphs for the given
+// characters are kept, and the font is gzipped and base64 encoded to keep the file small. Run it from this directory with:
+//
+//	go run genFonts.go <constant name> <font.ttf> <output.go> <characters> <comment about where the font is from and its license>
+//
+// characters is a comma separated list of hex code point ranges, eg. 3040-30FF,FF00-FFEF, and names from legacyCharsets
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+func main() {
+	if len(os.Args) != 6 {
+		panic("usage: go run genFonts.go <constant name> <font.ttf> <output.go> <characters> <comment>")
+	}
+	name, input, output, rangeList, comment := os.Args[1], os.Args[2], os.Args[3], os.Args[4], os.Args[5]
+	data, err := ioutil.ReadFile(input)
+	if err != nil {
+		panic(err)
+	}
+	runes, err := parseRunes(rangeList)
+	if err != nil {
+		panic(err)
+	}
+	subset, err := subsetFont(data, runes)
+	if err != nil {
+		panic(err)
+	}
+	// Make sure the subset can be drawn with before it's written
+	if _, err := truetype.Parse(subset); err != nil {
+		panic(err)
+	}
+
+	var compressed bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&compressed, gzip.BestCompression)
+	if err != nil {
+		panic(err)
+	}
+	if _, err := zw.Write(subset); err != nil {
+		panic(err)
+	}
+	if err := zw.Close(); err != nil {
+		panic(err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(compressed.Bytes())
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by genFonts.go; DO NOT EDIT.\n\n")
+	for _, line := range strings.Split(comment, "\n") {
+		out.WriteString("// " + line + "\n")
+	}
+	out.WriteString("// Subset to " + rangeList + "\n")
+	out.WriteString("\npackage fontdata\n\n")
+	out.WriteString("// " + name + " is a gzipped TrueType font, base64 encoded. Newlines are skipped when it's decoded\n")
+	out.WriteString("const " + name + " = `\n")
+	for len(encoded) > 0 {
+		n := 120
+		if len(encoded) < n {
+			n = len(encoded)
+		}
+		out.WriteString(encoded[:n] + "\n")
+		encoded = encoded[n:]
+	}
+	out.WriteString("`\n")
+	if err := ioutil.WriteFile(output, out.Bytes(), 0644); err != nil {
+		panic(err)
+	}
+}
+
+// legacyCharsets - The most used characters of each CJK script, taken from the first levels of the old national encodings. The
+// full Han and Hangul blocks are tens of thousands of glyphs, most of which never show up in a username or country name
+var legacyCharsets = map[string]func() []rune{
+	// JIS X 0208 level 1 kanji, rows 16 to 47
+	"jis-level1": func() []rune { return decodeRows(japanese.EUCJP, 0xB0, 0xCF, 0xA1, 0xFE) },
+	// GB 2312 level 1 hanzi, rows 16 to 55
+	"gb2312-level1": func() []rune { return decodeRows(simplifiedchinese.GBK, 0xB0, 0xD7, 0xA1, 0xFE) },
+	// Big5 frequently used hanzi, A440 to C67E
+	"big5-frequent": func() []rune {
+		runes := append(decodeRows(traditionalchinese.Big5, 0xA4, 0xC5, 0x40, 0x7E), decodeRows(traditionalchinese.Big5, 0xA4, 0xC5, 0xA1, 0xFE)...)
+		return append(runes, decodeRows(traditionalchinese.Big5, 0xC6, 0xC6, 0x40, 0x7E)...)
+	},
+	// KS X 1001 hangul syllables, rows 16 to 40
+	"ksx1001-hangul": func() []rune { return decodeRows(korean.EUCKR, 0xB0, 0xC8, 0xA1, 0xFE) },
+}
+
+// decodeRows decodes every two byte code with a lead byte and a trail byte in the given ranges, skipping unassigned codes
+func decodeRows(charset encoding.Encoding, firstLead, lastLead, firstTrail, lastTrail byte) []rune {
+	runes := []rune{}
+	for lead := int(firstLead); lead <= int(lastLead); lead++ {
+		for trail := int(firstTrail); trail <= int(lastTrail); trail++ {
+			decoded, err := charset.NewDecoder().Bytes([]byte{byte(lead), byte(trail)})
+			if err != nil {
+				continue
+			}
+			if r := []rune(string(decoded)); len(r) == 1 && r[0] != utf8.RuneError {
+				runes = append(runes, r[0])
+			}
+		}
+	}
+	return runes
+}
+
+func parseRunes(list string) ([]rune, error) {
+	runes := []rune{}
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if charset, ok := legacyCharsets[part]; ok {
+			runes = append(runes, charset()...)
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		if len(bounds) == 1 {
+			bounds = append(bounds, bounds[0])
+		}
+		first, err := strconv.ParseUint(bounds[0], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		last, err := strconv.ParseUint(bounds[1], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		if last < first {
+			return nil, errors.New("bad range " + part)
+		}
+		for r := first; r <= last; r++ {
+			runes = append(runes, rune(r))
+		}
+	}
+	return runes, nil
+}
+
+// The tables kept as they are. Everything else, like kerning and OpenType layout, is dropped because the card drawing code doesn't
+// use it
+var copiedTables = []string{"OS/2", "cvt ", "fpgm", "gasp", "name", "prep"}
+
+// subsetFont returns a font with only the glyphs for the runes, the glyphs they're made from, and the missing glyph
+func subsetFont(font []byte, runes []rune) ([]byte, error) {
+	tables, err := readTables(font)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range []string{"cmap", "glyf", "head", "hhea", "hmtx", "loca", "maxp"} {
+		if tables[tag] == nil {
+			return nil, errors.New("the font has no " + tag + " table, only fonts with TrueType outlines can be subset")
+		}
+	}
+	parsed, err := truetype.Parse(font)
+	if err != nil {
+		return nil, err
+	}
+	head, hhea, hmtx, maxp := tables["head"], tables["hhea"], tables["hmtx"], tables["maxp"]
+	numGlyphs := int(u16(maxp, 4))
+	numHMetrics := int(u16(hhea, 34))
+	longLoca := u16(head, 50) == 1
+	glyphData := func(i int) []byte {
+		var start, end uint32
+		if longLoca {
+			start, end = u32(tables["loca"], 4*i), u32(tables["loca"], 4*i+4)
+		} else {
+			start, end = 2*uint32(u16(tables["loca"], 2*i)), 2*uint32(u16(tables["loca"], 2*i+2))
+		}
+		return tables["glyf"][start:end]
+	}
+
+	// Pick the glyphs, starting with the missing glyph
+	runeGlyphs := map[rune]int{}
+	keep := map[int]bool{0: true}
+	work := []int{}
+	for _, r := range runes {
+		if i := int(parsed.Index(r)); i != 0 {
+			runeGlyphs[r] = i
+			if !keep[i] {
+				keep[i] = true
+				work = append(work, i)
+			}
+		}
+	}
+	for len(work) > 0 {
+		i := work[len(work)-1]
+		work = work[:len(work)-1]
+		for _, component := range components(glyphData(i)) {
+			c := int(u16(glyphData(i), component))
+			if c >= numGlyphs {
+				return nil, errors.New("glyph " + strconv.Itoa(i) + " uses a glyph that doesn't exist")
+			}
+			if !keep[c] {
+				keep[c] = true
+				work = append(work, c)
+			}
+		}
+	}
+	order := []int{}
+	for i := range keep {
+		order = append(order, i)
+	}
+	sort.Ints(order)
+	newIndex := map[int]int{}
+	for n, i := range order {
+		newIndex[i] = n
+	}
+
+	// Outlines and metrics, renumbered
+	var glyf bytes.Buffer
+	loca := make([]byte, 4*(len(order)+1))
+	newHmtx := make([]byte, 4*len(order))
+	for n, i := range order {
+		binary.BigEndian.PutUint32(loca[4*n:], uint32(glyf.Len()))
+		g := append([]byte{}, glyphData(i)...)
+		for _, component := range components(g) {
+			binary.BigEndian.PutUint16(g[component:], uint16(newIndex[int(u16(g, component))]))
+		}
+		glyf.Write(g)
+		for glyf.Len()%4 != 0 {
+			glyf.WriteByte(0)
+		}
+		if i < numHMetrics {
+			copy(newHmtx[4*n:], hmtx[4*i:4*i+4])
+		} else {
+			copy(newHmtx[4*n:], hmtx[4*(numHMetrics-1):4*(numHMetrics-1)+2])
+			copy(newHmtx[4*n+2:], hmtx[4*numHMetrics+2*(i-numHMetrics):4*numHMetrics+2*(i-numHMetrics)+2])
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*len(order):], uint32(glyf.Len()))
+
+	newHead := append([]byte{}, head...)
+	binary.BigEndian.PutUint32(newHead[8:], 0) // checkSumAdjustment, filled in once the font is written
+	binary.BigEndian.PutUint16(newHead[50:], 1)
+	newHhea := append([]byte{}, hhea...)
+	binary.BigEndian.PutUint16(newHhea[34:], uint16(len(order)))
+	newMaxp := append([]byte{}, maxp...)
+	binary.BigEndian.PutUint16(newMaxp[4:], uint16(len(order)))
+
+	out := map[string][]byte{
+		"cmap": buildCmap(runeGlyphs, newIndex),
+		"glyf": glyf.Bytes(),
+		"head": newHead,
+		"hhea": newHhea,
+		"hmtx": newHmtx,
+		"loca": loca,
+		"maxp": newMaxp,
+	}
+	if post := tables["post"]; len(post) >= 32 {
+		// Version 3 has no glyph names, which would need renumbering
+		newPost := append([]byte{}, post[:32]...)
+		binary.BigEndian.PutUint32(newPost, 0x00030000)
+		out["post"] = newPost
+	}
+	for _, tag := range copiedTables {
+		if tables[tag] != nil {
+			out[tag] = tables[tag]
+		}
+	}
+	return writeFont(out), nil
+}
+
+// components returns where each component's glyph index is in a composite glyph. Simple glyphs have none
+func components(g []byte) []int {
+	if len(g) < 10 || int16(u16(g, 0)) >= 0 {
+		return nil
+	}
+	offsets := []int{}
+	for offset := 10; offset+4 <= len(g); {
+		flags := u16(g, offset)
+		offsets = append(offsets, offset+2)
+		offset += 4
+		if flags&0x0001 != 0 { // ARG_1_AND_2_ARE_WORDS
+			offset += 4
+		} else {
+			offset += 2
+		}
+		if flags&0x0008 != 0 { // WE_HAVE_A_SCALE
+			offset += 2
+		} else if flags&0x0040 != 0 { // WE_HAVE_AN_X_AND_Y_SCALE
+			offset += 4
+		} else if flags&0x0080 != 0 { // WE_HAVE_A_TWO_BY_TWO
+			offset += 8
+		}
+		if flags&0x0020 == 0 { // MORE_COMPONENTS
+			break
+		}
+	}
+	return offsets
+}
+
+// buildCmap maps the runes to their renumbered glyphs, as a single format 12 subtable
+func buildCmap(runeGlyphs map[rune]int, newIndex map[int]int) []byte {
+	runes := []int{}
+	for r := range runeGlyphs {
+		runes = append(runes, int(r))
+	}
+	sort.Ints(runes)
+	type group struct{ start, end, glyph int }
+	groups := []group{}
+	for _, r := range runes {
+		glyph := newIndex[runeGlyphs[rune(r)]]
+		if n := len(groups); n > 0 && groups[n-1].end == r-1 && groups[n-1].glyph+r-groups[n-1].start == glyph {
+			groups[n-1].end = r
+			continue
+		}
+		groups = append(groups, group{r, r, glyph})
+	}
+	cmap := make([]byte, 12+16+12*len(groups))
+	binary.BigEndian.PutUint16(cmap[2:], 1)   // One subtable
+	binary.BigEndian.PutUint16(cmap[4:], 3)   // Windows
+	binary.BigEndian.PutUint16(cmap[6:], 10)  // Unicode, all planes
+	binary.BigEndian.PutUint32(cmap[8:], 12)  // Offset of the subtable
+	binary.BigEndian.PutUint16(cmap[12:], 12) // Format
+	binary.BigEndian.PutUint32(cmap[16:], uint32(16+12*len(groups)))
+	binary.BigEndian.PutUint32(cmap[24:], uint32(len(groups)))
+	for i, g := range groups {
+		binary.BigEndian.PutUint32(cmap[28+12*i:], uint32(g.start))
+		binary.BigEndian.PutUint32(cmap[32+12*i:], uint32(g.end))
+		binary.BigEndian.PutUint32(cmap[36+12*i:], uint32(g.glyph))
+	}
+	return cmap
+}
+
+func readTables(font []byte) (map[string][]byte, error) {
+	if len(font) < 12 || (u32(font, 0) != 0x00010000 && string(font[:4]) != "true") {
+		return nil, errors.New("not a TrueType font")
+	}
+	numTables := int(u16(font, 4))
+	if len(font) < 12+16*numTables {
+		return nil, errors.New("the table directory is cut short")
+	}
+	tables := map[string][]byte{}
+	for i := 0; i < numTables; i++ {
+		record := 12 + 16*i
+		offset, length := int(u32(font, record+8)), int(u32(font, record+12))
+		if offset+length > len(font) {
+			return nil, errors.New("a table is cut short")
+		}
+		tables[string(font[record:record+4])] = font[offset : offset+length]
+	}
+	return tables, nil
+}
+
+// writeFont lays the tables out as a TrueType font, and fills in the head table's checksum adjustment
+func writeFont(tables map[string][]byte) []byte {
+	tags := []string{}
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	entrySelector := 0
+	for 1<<uint(entrySelector+1) <= len(tags) {
+		entrySelector++
+	}
+	searchRange := 16 << uint(entrySelector)
+
+	header := make([]byte, 12+16*len(tags))
+	binary.BigEndian.PutUint32(header, 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(len(tags)))
+	binary.BigEndian.PutUint16(header[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(16*len(tags)-searchRange))
+	var body bytes.Buffer
+	headOffset := 0
+	for i, tag := range tags {
+		data := tables[tag]
+		offset := len(header) + body.Len()
+		if tag == "head" {
+			headOffset = offset
+		}
+		record := header[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], checksum(data))
+		binary.BigEndian.PutUint32(record[8:], uint32(offset))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(data)))
+		body.Write(data)
+		for body.Len()%4 != 0 {
+			body.WriteByte(0)
+		}
+	}
+	font := append(header, body.Bytes()...)
+	binary.BigEndian.PutUint32(font[headOffset+8:], 0xB1B0AFBA-checksum(font))
+	return font
+}
+
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+func u16(b []byte, i int) uint16 {
+	return binary.BigEndian.Uint16(b[i:])
+}
+
+func u32(b []byte, i int) uint32 {
+	return binary.BigEndian.Uint32(b[i:])
+}
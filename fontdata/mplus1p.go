@@ -0,0 +1,317 @@
+// Code generated by genFonts.go; DO NOT EDIT.
+
+// M+ 1p Regular, for Japanese kana and full width letters. From the M+ FONTS PROJECT (http://mplus-fonts.osdn.jp).
+// These fonts are free software. Unlimited permission is granted to use, copy, and distribute them, with or without
+// modification, either commercially or noncommercially.
+// Subset to 3000-30FF,31F0-31FF,FF00-FFEF
+
+package fontdata
+
+// MPlus1pRegular is a gzipped TrueType font, base64 encoded. Newlines are skipped when it's decoded
+const MPlus1pRegular = `
+H4sIAAAAAAAC/8T9CXhT1dM4js+ckybd27RN09KFpGmb7luapPuWNm26b9CdLrTQQksLZSmUfZNdBGVRRFBERcCioKCIqCAIAqKgiHwEEaVuuFVEJTf/5y4t
+Bf287/t/nu/z/NA0N8k9M3PmzDmznJlzAQHAEeYDhYaissiYtLW/PwEAPwDA6LEdjV0gy30VADMBYPbY6VMV4vXkbwA8yrYa1zW+w2l/5HMA+CEAaRnf2N1l
+sQAFEMUBgPX49pnjDrw2xRtAlAbwUHNrS2OzzQt3DgOs3AEAutbWlkabTaQaYOUVAPBv7Zjac+aDnz0AVgGAeGp759hGmDm3GGDdVABxT0djTxe5hIsBNrH0
+KSY1drQ0ji/qBdhsD0AndHV2T7UshRiALT3s711TWrr6fg11A9iyCQAiAPEbXAtWAGQFOQwAq/h3vAbRSKzFxM6KEEIJkXwJtpYJ2ea/CYALAEBBqEIBaaCw
+WGitZSpUizSY1uCCb139A/h/CBRsAcAe7LnPXcL31gBRABA1krsHoqIAorQAoASISgSISgYANUBUOkDUBgAIAYjaDBBlAYD5ANE/A0Sz1zcBLAhgeQoA/gCw
+XAOwfAOAGyz+AMSaXCa3QElmAvRBWB+45vWFFFf25U6v6gNVqkefOLQyuYr7bk6V4kIfukZ4hPdhmOJyn31oeB8JyyutzFJVKcP7aFibh6IvrbhS2ZdWFd4n
+CmObKlXKWZX/8TpT5ZVXWllp9vqxykul7LMKrewzTq/ifqiq8gjvswpzqK0O7xOH7fPDZcWVfYpltbVefVAV3icJ2+fPfZU29JV1mItUER8Z3mcTppjDIjnm
+daZK0UcDTCpFnygwtw+KK1e0rGhUsBdxXkplldcK7lMp/4lFaMtT5+zlrKwK77MLU3zEdcc+TBHZJwmtrVQoslXGxgmKSkVzEw+Cvc+BxayIj1SsUGSvMDaq
+VihWqDh0KhZ4X1qcl7LKS8V+0ZfWwn6oCu9z5DAlf+KhVHopPlmRV1qpWKEyKfpglECbkrvNKUyl+ERArlJU5pV5KfuwqnJFnyjQpFqhUqwwrVA1sg34Juxb
+eJ8zOwwuoeF9UrYD7IXLAx1Ywb6pGic0DO8J29Q1TLFCseIhlm25zaoVkj5FcWWS19Gq8D63sFcgDdMyMjDvoDOMBe4ve/OoSvZvaaWqSdEHqgyvJkUfqjKq
+FH1ppZUvgwIMYzNeRgUaxmb0Kcb2ebZ4D+KShfWhYayqDwxjVeGCzFssQICAEgBDyONAQQIR+xAik16WiKx+jNkntrqS9DIlVj/GwD7Kfm3Ffv2yRCy+m/Qy
+st9rpEqpWiNVKdGm/8QJ8rh5nJJUAiAkWI5jKpxn56qVJFCtd8fU9HFjksdZa2bV6JsBAAgkW/ZhF9wACTgB6GJ1Me5uYr9AN/cYXWygX3L1/Kqq+b76Qp2u
+0I69rH67UK8v1AGBx8GFepJXwArsAALULq5yYsX9JZGmAxHFkeY/Ta9HFkW5qDAMS22YLXf90QU1NszrgOBrWUdP0RQoBAhQxaoDVX4SscxN7i5zk4hVfupA
+bSxoZH4Ssdxd7q6J0evUgex3uhi5VCN8k4zu7O2g0sbonNWBapkb11BNRP7Obgqlm4d/vIci3li6BG0/ODxngUg0x6Tx95CKROFBaU2u/lKVTOHl6ZGVvYS5
+/cHhv+aEBqRFRXu4OJ7w8Va4eSiD/ONDgz0Uo1ac+ABtFXMWHDw6x+Qh9faKDo0PT/NGhwCVzNMj53157pp3P2BuK/7CnjmhLoei0zxc5ACAkGU5ScOpJ7gD
+oN5drtMrxRK1zlUulihlYom7XBKIiTH5pc+FMttaHkv5T1u+rxc+HPrW6KyEHegWn5Dz8ivvbYy6hjrn6sTMM6eLCmJf5cdqsuUsfZTaghhGAKCfWKLTB8pZ
+cGqxRB2bQjQxcp1eLsYpxfWS+QczXpnakdze9s3q4mDT6NiaulcWxT+Ep94+ue24/4iKjZonSn8/JN90tTdO+8a02OkcfIPlNfos9eRlQaQIdNYp3J1FCp1z
+oELsTJ5jHmcYHMeYX8eQjz9mPvnYxH5imMcPfMx/AQBI/C3b6UM0kpU5G6KyQfoQ8xNKS5hb6EIjmXgmHk/gCUBotGzHY/x9rhobVGFjCbowt0pQOngb12dc
+MwTPToDI/8/DLWT/5LHAC+/HMPjO9avRsp1UD8LgsVEeZyFKmZ8KUdpTyCIv/CcErk+BYKDPUj1La4BKqpQpSacJ5zGnDGQgFQDBYNmM75DV7O+okSq1Spxp
+Yhagjqw226cCIQi+Qnt7ANQqpRopZeGopHQGc8hkisQdZvJWrjmTTDHXU8+d5tUgjMdmvEleFtqppCoZ21Sp1UjxoInB7Eim1kwWmdfkku/v3txJullamwR+
+SQCspEpHdHOXkyYMqG9rq6+na6p+Yn6qAhD4v1S4T5mCsYFqJS5lPmfvw4D6wfsITLL8h84i34KE5R0qtUqZUqpCrVKGp5gd1J7ZUc6+4VNGI5NC3jMa2TY9
+lku0i9zh1jKQS5VSlCqlPeSO2ZrcCcIw5gIOsH853jpbttBJNJa9E62kVrIAaQCdxPyAbszTOIb8XmfeVpc6jeydBoAwy7IFL/H3umqtpFbaAOksHMM8jW7k
+99Rp5tJpqXWkAQjRDPHA4R4XAp31KqmGaLB89Jgxo68vOXlykB/X8dQrdz/k5QQPDLUTuCJ2lmikKjzA7GbbYfkUtiXfjtG9QqOAYP9QH5wAAuRsH2QSaqVV
+S/VaK/LwVWYWLr3ag8HXrjGfPmzcw3Znj9E8UFdgxL+MBXUcn2dZthAYgiFVc/2zkgbI5FKJDKuu4lJmFlYwn1679jC+bNzD9naPGxlXV2BkxMYCfq78YNlO
+J9FIsAZnACtewjSyAKVWJdVolWREBC68coXZjYFXrjCX6+ma58xHn4s0ka0mDv92vMv13ZnjmUwjVcmsWFlTaZVSPMtcvnIFA5ndV67gwigaZTI3mSKfI2nP
+3Z3CW25J8Bvdjf8BVwBXnSaGXabZtYhdlVV+YklS05NTGopKEmojEvmrxJpILFtRkRObFM3+TQYgJJl00jVUz8sNylVqpVylJo/l6CagtUk3gepPnjx5EghU
+k058ceg+jUSl1khUWFY5QddaMUHH3cbxtNqyGV+ERv4+K7VGrlRr5FiWo5vA3DHpJoznwAECwDe0H7+DRAB/vwiijmDXVkfKX6q0Mb5E7osxOn0KHXapkbE3
+EWodGx4sdx0ZHBU80lUeHC588hjxb98Kn0Z4cO/fuAR6eYYEBIR4egW68Nemf37FX5u4N0CMsGwgc2giyACQZbDEEWVu7rwWiEBtrA4bW5qzJo+R2du5Nfca
+p7b4urjij5sP+H6wSuEhdZErHv/G59OnItUjPNUAQFl4dDFN5NZGJa+vJIGpyP4N+Ff4xJiuj0z3L02Pi84IYUz/QEbkftVVisyNqpoaZaHLf0GMsMsylXxL
+vuRWFTmhjihTxer0LuTp7I+PxfiXqtOOki/9mOXMpXzjiOCiBlQCZdvQR8iXHK1yAI5INUewmg6H8ShLYMZFlsCUrCF4WMfS1VHA0jUOZ98PHHiafiLf0lqO
+pgjUatzc5S6uEoLSNHWpf8yxj7PPk8UNRcEjjPnMJWa5H04FRKXlADlLQ0EFEBDLs0ifgtrYQLXeF2Vug/zjr9X+pDu9tCJveWXKti0jX32rbFRmuC6nftSz
+J9wwoE0eExqRqcoMkPu6Kydos5LWpIUkyl3dnJyDA2MLg1JH+eSEAABQmGi5SueQX4CAhPWXbFDJTnZpACpprHk/yTfPN1EXs57km/eTqeb9Jupy99Ycks/1
+cZplNf2QyEEBgH4SsTA91Zzx4B6j12lihgabKJ0UASkBCieHsNLErEylXBnl7e4dpVSlBPc8n/jCdJUfnlBHRKijJobUFck0I0dq0opufhfy09X0UJ7OvZZ3
+aALpBSUEQTRAAMsXtSOq/FhrTq9VaTVajUwpU8pYrCx/XFlTUKZTczzTxOhJWnlDte5Ec1rexLoXXsjERuap0IQlG2a3W3uo4sMm7E3OCvL38EXnutSGwE37
+CjJL5yu6kpqaksZYT2upmuuGC0W2X5X2jqiMDY2wAwqNlrfpQ1QFSgiGmP8zPdIUvEdQececuRl/zy6sXDLtk09M+BaTbsjY8frjD8kDI7JTMrqZ0vrYqEA1
+lfWULYx++3pd2bjt6kfT5s5NW+DwyJzOxz3xptiJ+TBqe47ftOy4ZGcgcMRymaaQIBCDBzf7pKpYEiAXc+h0erlAHVmd0ZmjZ/yzVVkqz0JVetC0mLQO4uJ+
+kfl8IVim2sxZ3by8pdZz8vwDCzbytsM0y1W6hhwCCXhycF1kLOAH4erJm0ZDTkGW+efQ2khFS7gx+uHkwsXhZLvHHObg3h2YsvCJfQv3LZjhtXLrtafe3AwE
+Tlk+ob6kCZzBnV/pOUapWVaq/CSp6K6R+Z06v2FO1/XzjgfffmHm5UbrV155Cts0tbkzNjunS2eUj4/5K83bwNK42HKJjqNEgOXPwRJZCbCsXPUsrMVI3tiy
+nPnaAi79d648w/w126b/o/fwg9QpVeuPyozuWzqWJaMuM8AIBF6xfEqTyDjwBX8AV6VMIufE2Rc1MZwqYoWak3ZUK3lKv8WBt9FaNELp5KAI1UQ7JCYbkp0c
+vNzk+a8H4wlkukqtD+15CvdnP4kkND7WyzHFOSxJE6Bdp8Nx5OVUn3SO1+Msl+kqagUKCPwf8DqSYYifIx130FbsFyxzCdanpzqY8opzXaX+Xs5uRdPnhxED
+mi1tNl9+9h4Jzv0Aia44098lwy0uPzNarK8bH4cfkysZ/lkAFHZaztOJpBf8IQRiWetWq5FpZKohEVbrtLHcrHITD8l5ALdmWglCrdMeO5Z1/vxIVc8jU7sc
+X5xQqRoZm5LTMb39GP4eVRirTWLW5Benq33kRDphQtI82p5bO9Eu1WVuZA49lqppSM/qkq/QBVSVKusOueSGB6R4jGTpqrCcp29Q1X10DZ9a/xe6ev8wITKW
+yNAN+x9e6fTFimmhkSm5FUsefegP0hZbmafPYjQtHZWxgQryxLJlaS9aLamZssTB4LEzdYyVJS9letHoVd6vJoXUd/tPvCRvToopVQbxc2OU5RJ9l4ZCFOhY
+S1LD6TlNAvqK3XhHUu+uiUlBbRJGiP1CkVC1i2uA3t1VQhrf9XbKcRxRHRAc4+Fr50StKjNHTffo0roHj6RoK3KzU8o8ZuTOzpyWi4eyOjOys4jM0FtpXdkt
+Frk4+HsnRYUdektniEp5dLxSES71kjiiiLnA/OSFFEcu9rxrARekAAAijsa3aBin6xIh/X7NbDWMZtFwmok2CQNjI0SDVCOnH8mipOiwJPXo5MSAlAizku/D
+6IioOLm3xKYqc9R0zy6ts8JbSm2tXNkeTOV6QMaljTOMyyBfK0rKfHLnKBuzfUft5HtjZ630yojXc30JMM0p9PONdPYWD+8MM2fPCZ/jB3i9Ot5yni6k/qAF
+4AniiB96kwSq/PjlVS5WKbSxrPmoD1SLVNoYfaBarNLGEJkNNYls5y+jL5U4yJ8uIjnr/NMMK3tPP7SxfY5N7wxm3bL1EzpRqWR+/ebvL92cftnxNtkVnyyK
+TxVZp1nT1FSxKFUkLm2ITO/KMbqlu53LHxtKkvKtUyXxRkkap6vqLCfoZVrC8fsBbgf8XykW36NYH0iO64ND9f4VcUERcWHMjFwra910qm93kI/ZQQq2+qdl
+rpx1/KFNE+fY9E5nHnlozYRODPa8Yfn1Mzenm08ePGpDVT6GUu+sel/DaO8ytShLQ8RpVJSeQq1TReKy+qGe5DWHkrwkCd8Tcbxgx7xMBqgvZyP+04DT6/Tk
+4gd7kkZ6x57/SHf2g+KQsNFvvEm+2TFZ7SN19vJf85ru5dX6iMCgyLjZGzne9Fqep4/QoH+zEem/wy8bZiMuOnwoUeGlPfZ5zIenikMiyt86dJ+JiM6Pzwny
+dZGOCFjxdvzBh+MiA4Oj4xds4n3PD+lL1AbcwZfzPfmVzc0RJeoUq2RUqsXOckmgsx47sdz0zDMRXVPkmmS9t0zM7F4cFZUXE0Oy33knY8Pax0f5Zji6B4aY
+qjM3/PIqU/yq7RHcf4SfZ42W9+hXdDTXN1cIHuydnJNTcNbLWRyu9+MWJaOS/BgXMiJ+xHqlf0Kw9925MXnR0XknMSb32DGWjOTMOA975iyNcKvJcDbElptk
+lSbzOdx/xPZ1pvJ1WzL1+HHDOo4uqU94bkvJOm5dWmi5SsvJ+0DBjV2XlDLXQTUtF/N6+wpJSEl5v3Tv6bVxs55alf9WClmSmWle4uLZXd+4KnzG0iJPFxBB
+j+UqfZ78h+uTEygG+yRI87/BJfMTw6OSohuT9UHJEebH/oFFrsit8altV9Tk+47anZlpnnwfRn6OX6KLqA+EPDDH2akilrkpeU0YQe6b3iTJTmQS2VU+Q4uf
+VtYapm8Y24kBk3vtpy0a91hpwIYlUzswzpsxX71D1qcZRaZUam0QiTcuDyorzOqTG+TB2aNiGifHYstcawM/h8/SL2g512/dAyvm/52mk8K81frFhzGH7cUm
+sX3Fdpr3tGrUvxKo9WL+uvYnDeSma4NPRbp32dzsMtGobI7YNXMfJHbMZGsDIJyznKZzqBhcAZDI3HzJfWYZBtvLtca4tr0JWennF2+dZUcp88v+MTPS5E9t
+8nrp1e0X3jcDhdcsp+k4KuP6O/KBUZaLHwRK3NI1mvSg0dykNP+n0E4ey2Ewpn+0eOssctKvvEGR9wg3J2fRO6eHIXsZCJgsJ+hTNBxGsnPEVSlo80HjT69V
+sWqc46NGqxRxUsXqdxP+bWJDa67bXrZbvdiKREwo6+wsy9cTxgosefq43Nw4PXlx4cLUa4lpjz7paXSZWOdg6Hi6I7z9/dacnNZsbo7GW07QczSc62coaB7o
+6f9Ci7sQyyY7WSUYWs0pwbuf4FcmDGY+/SddPjdzu3Jzu7BfUVLmWzKDU3s//k8EIuRYLtFtVAEJrJ0j0wVoNbIUZPkuWDesK6iJ0SWjWCJ186WCVRgh0krF
+Km0C0XybOx8lOV7jPannBK+AOL/Vga6BM+QjbI6/Y+eVUJT+6AJJ73ZDrSnUx1Y30SVd50GtjhDrc2fQCg1ohbuKND5OBocRrtrHxZR5NyIuxM3aLdPN2l0Z
+Z9RgEhq4NTzOcpZ+SStAB0mQwdOp17J/5OL/mUqilfqxGk2nCRjG9XOmwwezM6eTlLkCvT3yETaz/rpHb/NUk8eIZk/P5hHEI8FPkeCWnxQpS/Ilfx+n4k8v
+ErGBinYKhLtoN4hEzKlBwl3jKgtQiwa8K8stc43tcWuNc+V912zLebqa3GJl3ZWNpMsD5Jz2GTSztbF6tZVa569XK78hPj+8hSRKdKd3vrkswya/dP/mN5HJ
+Ei1A2YvI1JNb6XtzSYjBJbQ3c5lDpmNH5bJ6ojTYzptHeN813HKefsHuB4IawgFQdj8udtHQawVkWqVcPJw5qCQFLGKmLMM6v2TMUpPyTSZLNBFVm5HpQYvV
+byhJ9FMkuhVwLMFXSNgQHYp4QzBHifWK6cSQvqfvfh5QKLacoUs4XewOgZwPr9ZpXAe7LxdsZxQMRDZWzdqBtOvsW+L3T9nbTGlcsLUrMjJwWkO1uWmno63J
+SYITmGW5k0yd2fjciAwvpsjgVmvKLQ73i3CPSCTJBmOypNrPwGxlzrhgJJYDWIHR8i59lxq4+SgFP4i5f0aijt29wQdI0j9IE9mriwqIC6yOCw+Oj2F63z91
+9i18ehh9XXd/FQisYx7jCKQy38Js79xqn9xC73rmUy/DCKZtGLFuJNiQlfIAtYO6aQH15byze0SIJfIU5L0RwXzWsX4n65rox39vLc21keI3jK1Cj3Fr4pat
+fvfZmT32PR0LN5/OeSYprTiIlBj8x4mTsw3TphEv/662zsVyg7ypdF1MkO+TDwMM6qMvBX0U8+8W/P9CB/mA5VHIGI5Hd2/2Oznk2rrit4zYL/rfqCoKoIE+
+hdneJeUci44bMkaJDYWGqW0PUrhlEQDCC5ZbNIWMBGfOk1fI3ERKdYzgl71wqwcjGYsFRNc/mTxjwjd4Pdm8aUvW1KkNdYls20rLbbqG7AEnznNXSNm2Cinb
+1KoS1RtwJvMWc8yKRK1/fBlznTQUMMdff8eEYXNn5vG8ybb8QC/QXI43Xg/w5gF45MgwjXz3Eqo3Yg/zFnPcikQ9ykKn3sN07/r7MCHUW67SVeQUyAFcFQFy
+sWjIbhe8TpGqHlfjFtFd5rfHFyRm2KTk7Tz2xBrmk/PkVTej/O3V3Uvtsu1eWDv1kJbYA4U8y2n6LjnF0a160LL6NwRqEXkiMTosKboxKTEgOeLubxw2MzPA
+YTMYdx574mFkLuAXrL6pbef0zWGjm1H+3vqeFXZG+z2PzXpTR+wBEDZYLlETdR7aVwyQ2+OQGcPOtUDEQu+grYhOzHnmAgYvf8F619pDR/dHUJH9zidNiVlZ
+u+q3yY3yqZ1LWl/jx2Gh5SxdTA3/ZtsPg65ggfMWFKlJCo9i9WiqMiVCxhxlvhbwMA8dClUVeodvvUvMrLlYMkPRmuo76ifT5pr1cqP8s0WNO07bP7cvI57D
+G2u5SHfTSG4Vi+ZsRinnQgyG4zQxcmmMfMjRlanEVK3T46DjpdPSZ8ZObJge0WIQtXS1dHWOyyh8Zs7dJw95SbOk7oc/zJ1h6MjHp/IzV5kMpSkkXYrMWk2j
+riRkfGVNUcFoX1zvFpFRlWqbZcr4nvnJ46/vmFb/ppj45HKWth7Lh3QOZa/swIfbsZMFDLoaQ+M6tNS+gS+YH+HdjZN2MzuWP5WVqIuOnzKhlTRlZj465G6Y
+GwwjW8pDu6Iigip84xIBRFBguUTfoWqIAB2kggnAleWwsIsrYUPGEn53d9CcYeem2nYwYBnoqtOzU9VdHqjmA3sBvGHrrol5I8PbYdzsgEhv9yyZT9d82Ui1
+xlGR2pVVU5WbnyYZVzn286qsxtI871O1JZ7+06uDJQlRExMCY4hdoiE0RhNSSX5zmhUTKykrcSyoCtgzrdagiFW7BnYXzy2ICkmtXe7LfM98tWRaw9NxrUzK
+iqsizcYbO18cs66kIU4X6qcKBwJxlhP0DeoKoVyMRaPVSNlFTvpvPWLXOqlKyi14WhVG4GAnTB8Yx67L/kOu8I8e4W6YX9TSVFqZbz26Knltu7GiRDu/7gNy
+NSw5KCYpObqVdO5gfqrPZZ5qyA2IDlV6Ta9aMzpTE7/+oYouJgRXjB0XsX3sdGZshG9MUHAsECiwfEufIJ+CnIvc8xF7MRGkz18IUlFhjFMoKe9Z+vwiWWp+
+fnjOTMSzOz97fu2yR/cVB7moo0JCIx12LJu3U/QOM7Bcv3fu4R/Obdt5xp7scDmyZsxir8JZF7jY84d0O3UCT1BCKLebO8QIQdqVWs7LHJI0TtQ5Sd+2z9Sm
+ydFLjoyf3NWamsy8hwmmfebE6OicmBjimpa6apUhiQQyh2LaYkzhr9WVF47yQm/Dzp2rOWeTE77DAfWTUo1gBcWW94Z0UgCEg/6BtVfwdP9JnfofdJGbCQE+
+g4ad+RjG5J48aerUGAyiQ+OnTG5NimPOLuBoxL+TklatSkmgkW7F+YPWzGN79hiY49GdMVm6wyzJHujy6T16jyo5ekVg4PzzCvCHMIiFFACM1fGDI/uvLIzA
+ADlPrX9soJ/YzT1mGNFX89vzkyOOmNpiMpMkb7KEpiQz5zHSNDMg0rwhhyWYjg9NCg1NYn4VWHtRl5+vi8qxTmIOx7TFZEULLPbM2LXLKSPXdpDqeWyjUCaQ
+ZzW3x36eniD94MfZq0NhzVjW0mYpl7jfi36qlY04ceJDs7t7VjTp9c8UBNeNwdj9mzc8O3ksWoCcyUq9PGnqzA1L3v4gLOvJxDhjE25e+Pj+LWsnfFxI+Hjr
+B/SCEL+KeHBM/1fc5MXhztFPAiUrx3KUNNWRhAODlFDbYX6RJ0/V6t6tx0OznkzUm1pwZdfqFziqEAR7laWrCCQQAjEAIGQYBfzvNA2OHfkwvz0/v90cixMn
+Lu3t7lnZpNM/UxjSVIfx96jK40aMbGTvzJ89SNa2dwfJWj5lkCzuxjCOtkDLWfouucnF7cPujRG/WXfPFhN29dRKnqkB3EL1I8FZG8P06dELWscUe1XEZVYE
+JydFz0bma7yT1hKfqTPviqpLq4wiNzNE9Qn+BQmZNTGj2sLS1QZtYH5KclVkvYEwv4185lXZK9v7XvB5ai9YgbflGDc3eXpiIO5/pUmrHO530GH0XcH/TFgU
+rk+LXtBaVzyiIp6jL2Y2cwNvpgYFpPqNTYvxTRux+z5S/2O0qdH6FyQYaoeRmlIVUW8gyzzKKt1LOz3H57gnM+8MJxtEEGg5Rt+l8UIMLWVQ+gLkLCUgDKOO
+dzL/595olayWIF/zZLVksXSRz0KTwsKSmvN1oUb70S3/2qcX7Y2hOlzD0fWylCUMn+Fm47z2fE2QtCzi33uVERQLgBAIpfRtMgrcAPRqnZ6zxgcDmn5iibg+
+frKxJDkyLLX15adj9xzJCY0ISux6JXZBRXNM2Nhda5I2H8hS+JtW8jIFOfRtihwvFA/6Rf8ELSGGtITwjIjajGh9RojZ5V8QFZH9fvWjFBVLVEXjlYVMy4No
+q4fhtQEJeIM/APA8j8V/6w+wKX6xuhjqlxYRkRZhdvq3/pEvSqaUlEwhZyLZmxi7f3QX97O/lw7pNgAfCIBIAFRxsdIHFmd2cNn//kW/bdm/f3/hRE1prO3+
+lo767lQdc4HNDyLB5lRex9knJi9ZmJZItm1L/zFmYnRp0N7RxekV7ifSt2W43dMZe3xHNaexOqOC03FpEDikM9hRiED1/0hYBApm/H9RdESM+UeO5HXEFMXZ
+vjKcTnwW3afySjCXp5ckJi9dmJ5IvVh9R53ptvQfozqii0LvkU1jfTgt6PoP8gX6v6ClXC6QHtIBXAWSRPfPpf+xO+yo3+vCeY4w6s2tfs35uoBUZ68jR/6F
+6biQ5sXqmF8Fjv8wRF/m4HwKUvxzFDTZ5hKBfC7/6yztogrw4yMnbHBuiNBh9v0gpVrpPaue1LMZeT//PHFtZHe1uKWjqbupO6OAWYyzmbH4ZF7yWlNaSQpV
+TDYsMZxOmK1tiWuprCs21I58wbDEMNm83r82Oj6jgqXBaDlOXyc3IAx0AFa8TtHp2fw1bh9HqxEsUUfKBvT17IXroLWH6J6z7I2tLrbMx9jj4NjZKTJZjS7z
+y8iV+MRnJAQGR0V6ulhNn+QaqtFW96yoDvZQh8k3V4+aJ1nabDDMmyjyq9v5RoNCmxw8srK7ONo3RTYyKn2MIVA0e2uZDy6XVMQldGnWAQWT5Sx9jaq4lT7j
+3t6t1I1Nh2WDcVyCgp7XitwKqdMPG+l7O6ZuGsFtr75ieuMNlZOscWxdnU9oduT03b1zo1zsbIlKlV5akqEJIFdIYcCUHnW3ubMuJDA0oI4839trYH5tagt1
+lbs7uQUEGLc7P9tQkewSpHaNVvih65iG+np/bPNOyKqNq7o8N9GQxMXaCywX6RM0GFQQCrH/4rkIOQHD3RUr3lnRCc6KPvCc0dOhrksb5eZjdPfumL1rnXPP
+ysbRY79qymqsLXXGCf7BzaPDdDV3Z6cEx+elk9+cpkSF2KRMcC4fHbiza/3r0kyHDTMqFvgy/cwPi7pbX9QnpYiDnnpixYoxT5fWVQp7j82cfzsCQrnYTwoK
+QRdHTix5Qu7xmPWhVVpNrE7few0lJF9khcuZbmKNNczTouwZ39lnOz6xacsk+s231oSUGBzrvGh8riFwgcgw3Wt6ztO+0dK48M6pEzcnVBhECwI5/Css79JR
+pHswaq17MNDD84RL4RXe1DrW1fYlcp0+EL/JsveICCsc0zI6LlYSFRmXFh8rD3K2y5BbNYekaLO1iUHzPavLRozIspa6iVNKKWlwDam0qysqb3BIt0vUxMbb
+JEW71EiVzufed/ngmFNMqv/I8hJ3D12Gi3yEHQCCxvI2fYF6sGOIHNL/iUBXCVE7okChO0fgDyaJk4uVIaZhSm9rmskmOSlnVF5moMbKN8vap7QjsyDXaPLf
+Kh/xSpja2xQmm5MlpyI3j7gsV+cpDS3TpJlOhYbMPAe3ma6qthFBXzCXXdDmV4cUmwyj8+wK9xVZEbIKILDXcoTmcXlEai5yzQ6jViMbzK+6R08ysjlgrN+s
+c95rwND1DTnT5yyd41JqUozz7s4MSWyi87en4y66l6i/qGm3bawXd7e2dYsSTZ6JARnu1dHFUl/1r9KL23H5gFppj/4g5A+/Tp+kKk7WwVX6D/QpqI9AjgCt
+VExkKilyqaNSt+eI/siCgkeffH6LbFytKrvY1dQbElaw1Or5jzKJuHv61C5yiLkw72G7ObMlq+cvXCPKrR2Z6pkWY5eXqOlwVaWg3oX56RzJYxzErUfaxMzJ
+ZJ6eI5azNIx0g5yL2LBbH+z4yNyGeUIaGb2nX1WMZeJTYYuri8ua68aMDY42mWKCZs6MCsGbKR+HzdFNCHesqRhtSC+xwyWrmGOOpdXaFCGH6CydQT0FPErp
+v6FRDtPjHcyh5ScjXpra2Dyns2dqYnIBZjPtqfHbtqXFERXzrX5zwnKdvKu9rbS8yhl/28/Mcm3ryS7l+7TVcok2klswgrOa+JioYkgQFYMRUT57Ro1ebs4k
+43Apelz+tKldNLsQa88UHM/LHOGRf/Oz69+QlPU1GJtxdfEK+0yH008WJbus3f2Cr8EXEN6zXKQ55HcuHqhhh5LPOhoKfIolqvfySMyesd3zmdvMDfr3FxVl
+ncfscsnvzM/Tl+58/MerxoNnZ83+eRcQaLdcpB3kBoxk5SJAmCtqZHWa3Equ4+nn0wNYkeT6wK04pOvFKTNPX/Eh3kwYqRExPdhcgYHHDzFfT5nw0RjPRwym
+8a2uqqCny0pn63AUSc/4Eb0ur3xsW0LpWpUu0n90+MgwDSBEW96mn1BPiBwWYXGXDWmPe9uJOm0sG1UZFmVJ/c7UvTYqIcbezsnVMXJUUESbOp794OKU0G9q
+GB/9cNt3pHMXc7ytevTEqKbitNh4x5rY2LTKtsSKal1svNOnjAFn1LbFrBu7nl9n2yzXaBu5wsVy+b47KweHjk8x5PhCxUwPjq/BIKazYhI2jkHDlN7qLyb6
+EBmTRjLJpl+I4mPmlr3R/nhhQWayHutIOg9/neV9aiCd4C/oyX+GzAPv6+DTz2WYunQNbTPHZSTJtTHZJanGuoczUrJjJuY/hz/N/yhTu3DzvDU+KR5tjVNa
+1jHLMcpYGbakmKubSbWcoI9RVwi4n6/34xoesyq+aGxYkzW7d8O84ly5Ia2uY1R10urxRlNb7IKWi2xk6la1cffLW/b5p/ssn71uSekEJhhXlS6K2DFxGu8j
+n6ebyB3w5yKB3M6/oESHZhrnJDkS1aAhg0ORPhSsliO4zku07cSCmtwxtaaiLKtdy1PGFWqyWq7vt/LAuCRDmFYbVsm8tealpIAx5PWUlGYnyYEVjzxVlpFW
+mqTym/IIfv5j7ozGzR87uow1v5EaogoIw+9cXuxJK+X9+HTLRfoc+QXSwAglnF9qNagrQtnpOpTxNrjhPJhrp9MHDs4Af0EM1QHaQV9azyf7iWVup0kLswUr
+0hc89MlpVWIhJvmNDHZ19XZX6P0jlFWhEfHuLl4e0XPiEpbNfbyj5lU52vtPaX7jpgz/ZhrxZvykwpRsZj6q5K3xqeSXuB7iVJRZ1+afTXz9A9XeQTHh2qTg
+EJVnriHk6RqP8uCUZl93hyk+AR6ZCc8ThgZO6PXt/AVd3R38PZ1ZoxVqLJdoIQ0GLYAVPwwBQx1S3bd5M2T2uMtUsWph211PM2Ysfu0ZDHeMDEjaWJDcW/ju
+F3L3HDc3fJnRKIOwImu6umW+ZNlohwKbW266rvLOJW5uo2qfGhXhP7VD/eJLaXEZUm1sWl4xJs2XO6e7uOX1tGdmtXoFqlzcuflQbPmIPk3tuMwYsUTzX9nv
+4ooXchpIZnFSjos0yD+i0t8QMcOUniuTBimzllcZ5r1Dfn/xt/IJ7c3li5a77l3dPqO2+OA1TzTgf7i99y7LJXpGiCt5g98DHu2/52ySZ4dteJgdH8zgnGJz
+5fXXqe2w/Q7mh3+mcwKBZywX6QSyCKy43TNO++C91Dw2ye2+4RiWDUe+qNBmaiqYt2Sjw5x95SLiYO3lKq9Oqk+tiV+x2dXRYO9iCFRHuMjFkpx4I1GeOOd8
+8qT5z1T/yCklSm+1vRsR/3zJ49yXqSWjJaWFNmIvuS4q/DE2//V1y4e0gowHF64GS8UbuiQZhdCjiHfU5LRiXsVD7kUGH+YOWmfNM7+ozg4KysaHpzy3UJXq
+HtE0fkrq5s22zPPrbTZi00YbXgdakSo00AqhTml4BG9YOhW15ZKnNvnV1CgKB9tl4AhawdeOuAiev05w8l9i3ffIpFLWWadeEWmRkWmeU0pLp5T8f4UTwQI6
+cpHs4Kqa7iXKq3Vk4bG3tOc/nN8beBbFkYd7Ehd9lpHQEZyRCRQ+BT+qIt+CPbiAB59rP9SODiObVA/BmDasB78OB4cDw7uDUGDpo6NoJKdbdP+61LOVjY4o
+0SnZog/xH3PfXP74U2t2do+Y9MjE6dNb15xKz1o2LU+itHkY449/kpWFvksXd09/qMPY0VRRMubgURfcgk84yjt8E3IUqi84/gFcxAH8m69NSUaljM3LwgHG
+HgcupqV9k5YGBI5ZPqepRAc2IOW1Am/rYgpbmyCWHCO9Ht0lQatDzMz4UtPYXvw9Pb18ZVC8aQnjF/bya2fOabm6nqt0LXn/QRhWGmfCGq6zqLvvI+ODXtMy
+Y/PnbvJfNJFNKJu4Oyalpq/eAsxf6Wn6fm7M+iwfUy0p4qRE56+XC+qvbzdmjQ+vX1OwbRsGe2ybpGkpYNpwssmdj39/TBeQ7x5s0/gpbp4XPfW1khPncYbv
+qZW6eU0M4Kd17FIG71k+o36kl9uT5jN7uLoJqVYpU72H1ITO3121xmVMbDYyc+YsO3zBIcMDDdlvsW3nWT6nbVQF0nttgV2aWPaq5qGxACuY/zA/2vxx17wn
+jyzatu2Z75jfnY3OX31Q/zvb/k2Q0FASzNHL1k4pZUqtUqq6SFL+RKl5HUr/JMEZTFNGBm7leDIZ5HQGOSTcrxTuVz5MTjHvYs3dv7CGeZccMpq/MxqJOyAc
+sHxLDWQajBDo43z9e6LGWxPKA7ghe9++wOzUHKvCCB+nPSeUH/cxs/GPuXPTmK3ZAdbp1k4+KNs3JWHGES634Rf6GPUCr/8Gk8tRUY4nkPfVVxEl+XVW5bmB
+bp8xjGohklbGhfRu25bJxJeG2xpt3QKx++b65Nzz0/h5OsNyjY7i4jiAGjeJUubmSIeyo5NRKWZFiOhVWqUOl+ZRfMhOFqIJKpsors4NCZIzx1wWrY4qbzbY
+W1BEqWJ0bvzW8w9ledrl2CZ+/Jn8a+aPdl3agjMbjCYACh2WT+i7gp4Jf2BfXcBN/gtuPpEkZExcUER8DOOfJ8JlTiHxmiE63nVZvDKqbGymPYNURFVcgkQZ
+m1db7zUuO/L4N/PUdjm2CR996vgF8/cEXerc9x8xZnP9r7W8SVdRVy5HTy8PkFu5si8JtVIHsC98sdgBqyizyw3HU+YJ92J3ZhnF6TLmFMVY6iB+0d5oz5jt
+jHbMd2L0tDfaI7Uz2gGFUssrQ319MG+PwyMfwqO2Ugfc3z9TsSNW/gOpG/M+Re19nbMWH7A32v85DD97DYDQZfma5lBndp4gV5rMGzPOYKX2B/IHMz1xle51
+nzwrezzBHGeet8dodCVNexZGvcRYRWilzCvMQR+jD1oBEGiwfE6/oCngDt5CTwgHxvVezfPgyvy+PjhUhcwx5gU7jEQXe2ZW/ErtIZ88sQM+7d8cFx4cF0ad
+fQylUuZV5g0fozciOu9YELUXLFaJMYZcNqWljBuXFMt1+hR1BRcAlKlseQ98qCP0KQaPnpe9th2ZwzFjkzb55FlLyRwj8zNzIzgz4Pc/VnYG95lFRQYQ8hAu
+cWPBahVfAP1wSEoO+PDh+fngNmQORTeyUG1cyRwGj3yMLwwbHu+AH39f1MEiGJVtZH5lfgyhecPGhJ9X60FOi7jaHHBVSrk1Yz05bM4kneZHyCHzGHa14PKf
+wYF+QXPBgZdAd7mEv1k7xFI2V1VjLiQt5ifIdq8aLkOGS4cZYV5kJNbGmGouKYarUb5Kn6U24DlYizGY0SweWiG1KkNfHrYW9fV9xNx1+G4Xsymvj4zav3//
+fmPaX//xNHrs/yLtq6+AQqTl4lCuvPqBvcZ/wKYCbHItIcgr0b0oMcwjSXV3/648HFP49tvnmbuOPRsbApmn83bRMLfKLNfEKW5NqS41e+/htY9teXQyixmA
+wgLLdbqJ3OJqdF0B9P7gKidgxZsA/N8XOAVp0jPPMXtiHV/a4HgrzHHvTsfVod5ohb72zCnmUx88m+07MicSrXK8/bJ9wApmWC7R92i2ANcTRt6DPbx/OAzP
+U8PwEPGwbH+zbBhW5TCsNH1YUj/z9n0kAEKr5QadTc6A86APOBQRBP0R0m5c98hm3boNL3zvdw3TCsmSnJzdTyzdFvv4qmdmpE3F2D2czJRbLtMPaQk/H121
+Sr5A8j5YqYS93kVMzkH64NCo+8HO53pAenN8DD6GUg+P+1Ec5CgHQNBZfqObqA07ZwI4/0gqUbNRsQC5EBGzkgqO0IKDR9ZWfWn3QwHxtfNdXh1p6lYwoTZB
+yakHdtt7Vj6CosDsoGsf1+Q0HUiKj15dWMSY01KDbDyBQpjlW25P25erIuLxJOODeAYdruEboTP+O84DwkBFqePDiGflIz8HZgcN3LyH/icOO/lcGKliE9tf
+AjMsP9Bu8hXYgBMAiomIRe6it+LlodtxEfPXVccPvpvmYC7OHZ2VX06+uMN89FxATsBj6Px12MhXtvu8w/lTHZbr9D1qGJIz0Pvr/iFlPNTr6Pa3skDFfMOc
+Ry4FTlHGLZJmOw5DcPTf6GDNfMrc0FCNr6HUO2Uct0RqBWQIHZbPaRHlsMhZVglBWyWfJi2s+W/YHf7VsPN51TN7NFbM+PiVsaPbHU3UHi8xxwKMLu0to02G
+7Mqp+/YvjCr5Yq7IL9GXeYk5wPsAtZbz9DLNhZEQIKwCnA75V0yDKxZbFePNaZXDA4bnt6te2RhjxXTFr+DQWtnijsBWXhOwq5ijE7OPedXHKJ3UMtpoKCyc
+uuflBRwZ0ZFZhnv6oNhykYufeHISn4xsujJnbvogF+rniCjGp5e98h6OeMR9+VSsYJ51fOIp2dLZDlhidH7XyRgUmRnmetbZuHvfyEyvh9fwuiHJcppb57yE
+yHPMYLa07B5YJY9w+Db8M/v7l7iMzUKsYHY6PvGU+9I5DkwDPtP7NvEbtkiQjSP8sjztzlA7MojV6PyO40fDVgiWhlctX9AYkgrW4AhuAOjIme2uVsOlPUbc
+evGA0+9vW5vPGEcGZ/u9mhOkyPEjce//vsjT4H8TnUOc27Y7r9zivHKF81rO1rpO55CLYMtXXgrSTPX3AZ1jvYC5c83xydPLksXmpNzw8Pzw/vzw8PwIcvYO
+c36HMsej8un+vRqXRw9KX39VuvVN5yMAYAVjuXXUwM0TGcc50KcQvZzF8U8R568Hjn41U5rvstwCH+JLwwUdh6FV+M7tf1P8IfP3Wl+aPEzcC4ZTQARZ+BQo
+l5mqVcqsho3V22ScOZjmMC/glpfcN/SyeeCPGI0njQdORWaFPvMEOz+NltNDus3pH5mpD8C7r8bLnE5czHPug35fEVfrfZgAEMyW83Q2sYANAEpFVhJ/tVQl
+xQJ0Qy8P5sbfxcTCXMmYYJqQzGTSCKDwo+UEbad2HG2yB+zGofbEe1iZB1PEAvNkrt8pJkeGFXh0c4AnJnGAOVrKLFfpY9RpmO+kFDbxklFZhluLsZzZ/fxZ
+zwMrmCaSefq0yevWN+HZwUc+9uLXgvEgpwvIId6fZfnOZk3uIWvMYXQEmZSXZ34hj8vHnWy5StvIh+B+v46zurentIcsjFoyuzt61sznmJ99/tw6ry2idWlv
+MFlhNF56smlcYnv907WZJZsaTck549e+ysIs5eK1PkN+lVIWLcB1RCFK6++qXIUpxZg/+ol9nZFrd4qcaPGGD13wxgXzQvLQhg3G5ROmFyeM7yDWhNmHgdnR
+2VPQlYW9xnKVqsn3YC3YA6wcr0ElWnuXuz3M/HkhLBtTMMH6G+b8G7EABNIsv9BN5Bw3RoB67vwAPt19jOnhppIxzNKQw6b9oeSgnHmVmSPF2TiKWci8rMJ0
+7GDlT2e5SdcItXZSkN8/yhK9+z142cOKOlvyqnNLTMzkkDdy94eSL4dVbJ72uMl8LsVAfJGZz+wX0AzhCRdydng5Z2HLWeDDcna4eA7ZkTcqtySbGVnMIiDx
+QhYBm2yF//H4jLkixRF42GoQQzKbr8OmCuS35/N55r/RRvI9dzaI1D9ALrIaniMt/QODMMydOcecSG359iL98bMVY8n3zJdRbVntMTua05yynZpWcLnXj1m+
+pa9S07/6Tf8Cl6welqls3vxPLJQOy1O2Zj7kMK6/hxGAcDhfoOHAVw9I/QO4Gkc+A/FBhIPpGV+hFK0CIvPb85PCHe7v1+dcLhP5kjkTXuGYEcwmFkZm24S0
+x6wdQlvOcQ8AYaHlW9pG3gJbLr7gLPhVC8lN5n3moAP6oIu9eQJ5y5k5wDyvylagDKX8XLRcpZ/RUrC6t0pIlIIpKIChedwK22OeQJ6MtdcHhzInBZBUyy2r
+S0w1bj6GUskQbCDQbLlKr9BicON8PT6TT+WnHnT4tMpAd4ED5ErehLw8raPYlTnFnBkidRY5GZIUEpJEPtTm5U3IQ+Yq86GSJ9xkY8/+EsLnY8XTt0k3Zx2l
+kPvzl1Rc1pRYEqgcVWxdqg8ISh7z3IG4vS8ZNVL74BBr0ZyK94I6Wm27dFEtB15NeOlIfmRUnJ1450Q+RyqSvk2lQu7r/ZFs9X9BRGqHRRHN2uT/hpYcGxZT
+ZESR/0bEPRpsuDzg4P+hfw9Owf/eX/JjBBtuncClax3+187jeTYEG/EQn7AFLB2ZnD/oA278juvgmi91Ew+FjpQjufg6b9rU4cxibGGeCFo3yjojy8+Reejh
+nMK6rDHENau2KGc0Wb12rZFxLgh1yLKTBuPN5SP2Pa/Y9bDv3pc5u9cKEgSfkccn5Fv+C86A+xPm5GLBaij7B34yZZjyvXtsTG5OYR0J46np3rbNyCgGqfn0
+9jA1bJ6y64UR+57HqkHaRJDAxTAqODuWqy/kI2mBQxOdo1H174xxEQaKJfC6f357fmww80SQyVBjnZOqdBLYVEPcWcKqXuTTVHu3bg2MZed+WJa9ivEtCHXM
+speG4KVZI/Y9P3Lno757X/Z9H/ewt4by9Uun6Gzy/rAI7uDJNymoH09lAVe2GlMyn3giIyF/6UcqsjA7+6HfilrqQ388G14wMe3yJAAKcy3X6Djh7BwpQCqy
+9gLq7/2dK8JJON2WWcP0W6ECfeyYK8xJERZjlg3zelShg6HQgEWF9jGFGozIt4vOj+LtBpPlG7qT/MbZpkKWnYhV5uxRgFQXEEjWGqfV5EgUEqaLeZEoTXPK
+Cot6/ka7IlypvH58pLPBOdneiCI0YIsT8zxz3CXoaSFv5yPaRW5zOmN45a5gKlyMfmzJhPjClO7NM/Srercwf4f/9ehOEpzY1vHkaecbL8yfErdk/LtL0xcd
+eojzD76lm8iH4MPHabn/eKeRL+Xjd29Uz5CnmcV4jom2CUpKntxJe2YWVsa5MJ9jwEfkw5wbxsXJyWob22xbaVxl0RLjDSGWYzlDc8g4LhbFxu4GvVJXCZWo
+9ZiY74uPOUrr03W6EilzKC8/9z3HE6TK4YXyrNHbY2PCejMymbGO+ERwVjAIsa0zdDOV/Du8AJQVq9Hi6NZTGpfdKmPK6ovrGUewkDtOn000dZxK1eqfrqg2
+33YkEq1Jy8F7FiTUQILBhu07ZWMzSlflX/hBwNPmJtytZJaRYEbBRbQRykBOHyOH7r/3CEmMPnP3CFGEM77kkHmHEMs+ZbFQFxLNx76lg5l8ylMkx3wQ/2LC
+8WNGTKLNZzNOpT/D6TJwoKPIu/fu59ssJB+bw8lC8x5SZp5L3jXnGtHO+BlX+3+JTiGfgjXY89rPirXhWPGfREXmTfZo8TcXFJApeEnKJIYYVVgCFqORt0Xf
+s5ylfuQm2IADdyIfH+PSWkk1UvJh6Q2GkX73hvl2Mblpgbt/qUyqM1+8igeZHOHMvav0KHculTvbVqj4J1rkK3pDUapBLA6vqwkuzvfD8jkbnEwuX+93LiZf
+Hj92ZUpohrr+yGuMu9tb223Ofk/M/Jget1ynKzh6IIDdByFKlVSD7hfRNSt7h/kn55JwhxNHvZlkasXT3wsS2kHeBxFndXJHOymlytPF5FfzGFJq3ish75hT
+sgmXu3za8gX1IzqWS8htWTjz8Tx0M5EKJsT2o3fx5w/R/TGmytvg+MZrRWybJZbrtI28z3GWb+GvS0aldAm5YX7TDiV/ml/DaVjeZ/7RP8flt+/GM7/zdLH5
+ik9RAq4gH1wjuRPmVDKVljspjh3ZaURcuG/fJ58Ums9+sO0WejD9ZP7ChTnMspxdu3Kwh1mGPYOxzVnk3X/sbXEnHMqUq8h1n7Zq3cZocyFWFOG4uwNkjtE4
+6WljXOdL5k/wMJNp5Hm72HKdNpL3OW9PKSN8+EGpVer0ysXkP5S5wLxhd/4PvfksyZaLzN3kfWfmWeY5ldFj68as6k6+X+stt+km8hl/ziK/BxpAOJtmvf+2
+deoVpKHFvNAeA7AohjyWWVydOTrcfDQuzwu9UAJAYRpbKyz4jD4P2KX3b0JJlVpyclgAinl4+JYUGqlqWNgpZOeTg/tTlbdQs5Pr71HL59SF9HD2L7spgsM2
+RVTCYsFuiGs1bvg56r10+pQ6cVnkyJHSn53auiKa6h1PfkCz8S/dh6fSbA3W4duele//eLQm79UXMuKzKgbXtC+oiXQK8fVBoEPxddN7+PgLbmvn4B/BBfEz
+5dliB/wz49KXijSfw0cnVajXnBSlaoTzSi/Ry0JsHXDQ9uRETSVVBqqFkNBddRjzBfO1HZI/zEcKyBo/j4c4nU7T0sq2Mn8yX0pzXAa+Hc/8juXJamchMsLq
+7STLsSFffSSo/ksl0wPyeX8c+gciKdy37+LFQvPZ09t+YqX1vij0on9ILgCFZqBc3MsKHIZ2PQIHJZftJy/LnMlN8zlD++4tLCnEjrsDZFNQMCvZG6LNC8h8
+b18azpnb5gZOpqkurUjPSvmkPmNMNACBboGHVuA5hEmrFICzZ/vwEk/z+RhEkCDmq/w89P5RIuYT5g3783f0VMOHnGdnVXcak9U+CTXMTmYXNwsA8S30I8G4
+FygAajUyfGsC+q1axctCHWbQlbgGKD9L62gEZuTnc7+hNeSRa2QtSLjcUWSVP1qnTn+rOXvsYTImpuuvvtC9fwKFa6Dn9u55X/P+URps9/XwUwWDB4FsGWZU
+ywcBcnT1WN4hv5DfufNjOXHqITPLzJfJ73fPkH5AeN1ylVqT9n/scRGWXeQN5nz0JM2j8mwra2wzM9/b3voULy0fH7HqlFWY0on5lflTniF/H4DCScsX1EjK
+h/YbuHCmSNhv4A8f7Gd+C8oKY75nboU6PDLVcdZ4h6UrHJ5RuX9ww+7vHz2wM8PTI8PzPxkyb4NwzuB+y1UaReL/GedPQf1fOKCf3LowZlbnygPKl/5Oxd8z
+M5fMalgSM2/8ouqk6p+WAWKw5TyNJvVc3EjDJ39KVRicf/2y/PyFfFJ/U1+aVRTL7GBxHbZ8Tv1I0oPxlmE5vD8Sibp19PjIurHzr3i9OS+vNWxUc50f/pWR
+8UJvZpW+pHBWflrK9HhTQlJ+xwpuXj9q+Y7mkMT7YhycvUcMxikFBSbz9bUj8303EX85c5s56YQRzG1E9LdiTjMDAAj9lu+oFynibLuhIyju+dH9uf0/ut36
+OzJv12H61kudxaToWmBpelnwwrxI+0z74k6Oh6cs16kfiRj0jQm/7p8ilXeZOw7XLtmZb5MIJ2aA+d470+OTCzzf54OCtuIv3GrEc/ufrheOaY9LUPRSY5if
+Kqbg6SnakmO6YAebkf5WaFhrDAvcQRtC1WV7uvUlHyUG+Edz6/9Gy2VaQmYM1VBz8PgyrIAHPAcuS0hJTmelG/LjmIuGzPScJKJgbvqlRhVJEiJH2DGfYEge
+emLj0g0j9kzCJ+b4LFndwszJ8LdLt3EYibMMkydzfXnS8iUtI6Pvzad7Zjd7LDSZkDExKWukFfMO8xuJ825Oy00Zs+PrJNSO/Hixt8EuyiaDEJSgqyMz8Le9
+cg6vB1+3fELVJB0opwe1ShkbNeDMgz/wL7n5GulgpHa4Ei0ZGWcynJh1ygwvrh1jOUu+IwWD9tZg8j9nb20pfOO80+vrmaZ8UvDh6VMjMr227V2LTcxWILDB
+8jkdR3LAhotTaKQ6V87CosOsLnPe2x5ZTq8+5nQ+zz8/V52W6EVyzNdVYLF54Q3StnXrvjpVysi8DY8I8dQvaDIp4GRCI+VnaiiaC3Z+pM/MZH74zIkUmC/Z
+P7nJ642XObrftfxFA4iOt7NY3aDSK6XKd0mT+bv8fDzF6IjOfCwT9wMQPA5AVOTy4JmzVCVhV8rjY2xzxaPJZfMvp3t7WZjYSuzIfErunU2rZ8+mbS22TTpS
+bJtEyR9//MHhfhaAJpPL4MXtNGo1rNLWayTcS6biXio9+0KVRPPsypCFa3xzR570zR05Z1nIvJV+Jr+TfrkqzD4ZevJo2q203bt37067lXb06NGjGHELgMJP
+lhfJ3zQSfEDFnWrF527zGVMylZab+1L+vC6tRsqll1I2oVRO3CaNc6lrWdgybUrazHbXce09E6Z0pT2RWZG2MauUnLLyS/ez+t23rqS0XtLSIopJjxGhnfcY
+U0GVVYtZZT8hNlmGiTaNkYmuwPn94y236QJyA6gQZ1SxXEEVcjnj2tjBd+CCHO5ug+/k8RJmbAl+XlNTa75SW1uDb26rrNxWZb66rapqWyW5bA7E+EWLFy/C
+zsWLFi02F7a82tz8Kto2v9rS8iqno49bbtNZ5AbYQABEAQxmyer0VK7VSOUq9VChKccEoeCbzdvnE2vxYFVuZVn6WP2zR/J6G3IXPjJrblW7U1rUxIamiXmP
+VMVlpiQYAnCMrlwdl6pQo+TIFeazXlPZmNG6CQbHtCK1oTjfoK9zjE/Nj4gM8NVz+vJ9YkdGcrLBSQa+P9o2gxJWHpAUW7bTaTSSXdP9nXV6jdhZrgokxZ1t
+BZ99VtB2He2/rTxovn6Qy0/utmzHTfy94CyWqHTOao07bhLuxVvMwLeVB4nvwUpA+BRiqZRs4fSgRC5Ru6pcJWq9Wi+nGqqn0i+/9P9cnCP+3H/wAr/q7U1Z
+YmG9hBT2CsECSwBhD/jSRPIyt+JLuUPAOSeOyDCQuZzBXMZA8jLzSRq7fKVhCL/eTrKsQ29YzvaXnQWvtTTnLv+DhYU5NBGXDdoUe8gazElL49ts5p7NwbVh
+Uwk2j1m+XFjvbtI88i37PXd+e555VyUZTb69y1ACQOALy21qS4TnGIDC3VmsCHR2idE5B/qJnbELrdetY+6se+W9sWPfG0tu7GUm7d2L6xhcj8b165nXedw/
+ApA8chkkAEqpSqIO0Ejxb/KDAh2LyexDGRfNgfx9ZgDyH3KDO6WNlRwuQiRTarmCJ5WfxDxhWVfvhrM4ngm5tq2sqG08ecLUcezkTmIw9B2vyM7NAkSJ5Tb5
+Dzf/WTveSvaPtMNQ1LJp0jZ4imHUndNXLZxc4VFVUdfY2FYNFmY7uWz4noxrnNIbmx4zujw7K6Wf8LUhAORvchlE/DniKpQpBw1g1JBAwvyETUuXFjGbsLXo
+e5zJLCfpu3al408AiDaW2+SbQZr4nOJ/ZNirXJXkJNPnSNKKOmevWd5e7Ta6tqmlorQ83uiOm8jGH1RTJ8yaH5URWVddUBKBs1iavrfcpiJyA6SctSwXssWH
+0ttdOdjcrCOTHH8zkNzpU5etnzV3zbnkypbWXePHkRuGETcwZ0HnjNWPrDj1p7mlpbaksbGkZhwgIgC5RS5ztpBSRqjKxVWrRMQfj8wsn3eEaSSXDcxVZgVO
+Rn8CQOEry21qRW6AjD+HAVg6JNzZYcJ5MOxyIBdGklsQuZWDJREXfLN43e51zLqqsqyCP0qra0qKqyoLG5raDo4nNxqKejQkel19V1d9+lhP4lmbVHTN2RSt
+T03Vx5gKXUrjMwuLDDN5PXvDcpuYh/gxeB7qUGmf3E8sYAzEX+auuXDI8c8MYprSvXz9rOqx43ePG0tuPLLko9+lBq9rmLVoytSVhqbqUU2No6ubOD1VQFRk
+Ea9/2JPIKK+nxowpWLYM9y5bBgSXWq6S2KF7NBKVK3vPuZbm3MwxF28T72XLeDnfA5HkDtnO3oUyK5mVDLXkjvkm8TQfINtzb5FbuehcCgTOwUXqOZjLqtfy
+uaznSK75VZIrJLNy8H6GSJrIw3NFGSvfAT+TPPMB4mm+SbYzP5cyP7NQOR79AUCB3ABvLjrNy4ujWKaKZQPmhDtsmKX6j3mL5i53i1BlqiJkxR7awAC1OvBI
+V8+GCvK2qiLfPy58RIhnRIJ/WJbeW+7hE+83TmVe1NsLBOZb1tJW0gOhoAcIUN2rtGALPjRC3q/z8JN8OBIkKsKpkWROUZByjC7LranKL9GQwjbF7lUr9u5d
+MaHIf8y8bRc+3r7jw7jL/0mMjklIiNFMzuhqGdeZHs88nVayavde5oe9cVGZH+545vzZnVNq0G1NgSnDJcMEBPYA0ERuDbID0LCqUq2UIFXRPegxBj0qC5hD
+BThnJVnJ6sCLF1GG85gFQFFsuU2l5AY4gQcoAQLclH5qKV+CJJH5ooRdUKRsuYgmhp/IVMqcmNDaNA6VNUVj24qWbCjJjfuOebTEPK4rMfmjK87krPO+5Con
+4thkMGUzl2xxyvyUJBtuHPdZblMbfv3Df2GTnNyqal25bcdDbZVeTQt2v39i74Im0hq/k7mxMz4p7fTuve+nDc0DGsnpaBaS1F3DH/iE3JojZRmOsVGJaNjx
+VCKePvvOmLq6MW+fI5vMu2wwZf1G5pM3j7FUvsnR9BcA+ZJc5vSTTEjGlSplmIuLmDm4kfHEm2TjrTTmsoFcNgCiKwD5WVg3ZII+k+FYnMk8VIz/IRvvpHFr
+rHCe6m0aQm5wu7FKKdtPZ7abkqG+S1V4DXMnLz1w4dz+eWO9qsevf3bXY1W5ZnyBWaw/jZWnkxJ1zzHXn1Miq4bhIgB1FWiVsmEDNsSnkmIAbi0uZpqKyWXm
+HEabA7GN2Ugu8772+wBkJGd/AtsC32etTkEf7bHcJs+Ty+DIwhPO8uP9DfL8gtVJiyJGtrTV15LLTN4zq4KUGTnTluBzbDt2jvmQy1zsUKpB7pEoKpaQbIKt
+Tcw8XNnErCEsOW9gFsMGx8cyT3IEIY4AoCJyGawG7QHswI2MO7lsnpAp0AxPAlBWnzoBaFj4yPZURVVUJX2yBEUEaXkx+TLvS0IuMzl40BxIypkl2GveDQiX
+AajjII94stj21LGM6SJFZbiWsP0nNeZnyWXzs6QGgMDDltu0itx44Pk1gg1AHmOOHDuGGceYkXc2bryz0fYMlp85w+xe8RyOeO455hsgKAagvuQG2IIry2Vu
+9ij91J7IFQmr2DPtJs3EaOZcUU5u9rffZhaQi05f/CBmrmGT9c5nbDmZXmWZINidXtwzelj50DkHUj+OGmE2ylURiL/M2bhxzuyNG2dvZ8l6d2J93uygeu1C
+sv055pvnWKLMozgSd814KYTIatOKkln4f3Gyw849H5arg4arzH0kqtQRGIqeKBwgJ/mredITm0rzwsMqy0f5agvnzZvR9X1qErnoNL4+f5wr8Zhw8eMcFfMO
+zi3IX8VOA7hluU1+Ize4miI/mds/T+kQ5rckEH/Zt/fh6SvmrW2Xtc2tri9t/ntx78JO10nkme7mEFVbZUNrakZKVlpStkfillH1kRlRnDycBKAK3r5yFabc
+SVLJ3C1m7pLLmeYJZAMvN59ZblMncpnzJqWcJpRqpM4KT8SQ5rMtheZT5DKT3j1pUje+xWQxn2IwvsG1ex6ApvHw0VXjyroZCAPk91HMl9XMl+SyuZusNgey
+0omQCUB38LLphRpX7j/KySbdsJ5saN1I1hftb9lANrTsJ5fNs8li7hXIvV8e1hcHARd15Rq7Ugw/QU6MGfiz6RQ5Xf/nALnMfICxzCZmDSYz7+IUbB3Urfw6
+bzNIKzvv0L6ffFvLfFXOfEUuM09gC1PDnEYtPsu3+RWA3ObnhKtSxi1bWtQqfyUipgafNd/FZ5kactlg3ksMBlJKhOfr8M+EkXBrF7coDnu+zm93v0uiax6w
+r1nGPVlJRley1jX/3J3teImH4cpv3Aw9d+e3pLtTkqg7T9/b6EHV5DKIWf2uQZUdvo20AWn51avoge8wKbiOmcTVaUylbZY+Fpdcq5TNI9+tSkoCxBlEQ0bT
+EKD801/wq27DFhrCOswERZbb5AxxAW9QAyAr9j7oJlcFqlWDJ0okCta3q6CgVX5iHFnT0d3sZLQmxHtyVkHlo08lpxa2jFbEa/M6k7t78KvImnHHs7OCtBHx
+xcbSdqu8pAjmZmT0uKw4I4vTz3Kb/E1ugTMX1WfNgiGLWCVR8a6iD3Lo0M9EEnrGTZ/R0htP0IiPVBTk1I4x5lWRW8yJujXzF6xuGI2i5kmd9dYNkzoAwWK5
+TV4jLlzkQ6jkHK5CJYHEbcyEzqq8TLeiylkbH5lfWeRmxIuTxjZM8UtUre+d97AqWQVA4EfLbfLeEI1SlXq4KSPFYcaKmLxnRBLf2zJj+rieBMIcrMozjqnN
+Kaggt8wuoxtWL5i/pg7jGfeWjkkN1vWdk3gd/Qfnp7iAK4wACLj3SIMhgyhAyUXOMJ22jiovdqscN+3hh7q7ZiMzDsuxoqCorn9UU1B8yLrZc9cuWyC3TagY
+Uw+C/j3L2eGAmsE+c/xkY/YqqQpdt1XXxYU5BQSnaU6dytuGA/F11U5JTvrM+CRWWwCBW5YR5CRxAU8IZPvuPLhUJeNwJuit7mPCSaa6xDmvuK6CxM2pnzWt
+eXYiIcz+KlNubW1u/mhizaiYnxSJI5u6UmpXz+hd3jD6r7qJbXWi2gnjOb03EoB7TiSrIVRS3klgdZMgHORyTnJuRqPJRIzdddUY3Jg32cR8TW4xx+s7pgMQ
+PANAvMgtIT7E2uesNj9TWFhI5k+aZJ5PrM132PscLf5ET27xMTUrNb8Guok5S11Kl4wu7A0vvJKcORHvMParmmntpEm8neAPQH4gtwSdHqCxQlWAij2SoIOg
+exNzDNObmK+JidxiUj//g/np9985exuH0cXbF2cKWbEQnosDQFOICyjZtUGu4bjK9VY6jAODF7uMSOJKxgcQbVNJfk6EPtKUE6mPyDUR65Kahob6NuZV1NVk
+1RuYD1BXbWzNZa5w+L0AyKfEBdw4/HKhMP8eXPQyEjJybFxdDcdgYl0Zru2YzpwfZDEAgd85/eUixACEmkQyGFHK3tnYuLMxrX/lyv6V+Enne52d7zEPr7uz
+bh3Hbx+LP7lNXAbnunz4XJc+MNd92C7OHj+tp7kngZjYuZ5XVZ2bV0WsRzesXLRgdTVzAa+Na+9otG5q7wACv1r8yekh2KxwDxfP++foaXYdae6ZNn52HEHm
+QFVebnVVXkEFsTZ7Y1j16gWLVjaMZu62dLQ3WTd2tPNj3gjArY3cHjBLpornIDmzYFteDpKoDcQldU7TALGempQBiArLbY4eH17Ps4XDQ3qeP4xrUM0PrEgu
+mdzU2Tp9jLSmdVVS+aSm9taJ1c4V+EmxX5i9tGBFYUxidEVguIM0f35+UKKap8fecpv0kZe5uc0XpA1LkmOfsoMXrlwJ0sVr7JOzKnLmzCEvH0lifsqKkia6
+mKpxZNIRQHS33CYniTXnGWg4ANwMUA2u9+iek2BIG5vLS0XVKGLNvFmc1lWA7uY7NZG6+jbeviW/EOshe4CqKObuInsL3m99n1gz/njFfIdY8zK+CoDWEut/
+swe+6Cad4ztJd9GjzZ2ks/lRYs0k4Hvsy3yHfQIQsQZEMQC5Tqw5e0Cu0bP2AKskXCmOfYSsG/XmO5UbyMZR77xJrM+ftwBz57PP0BqFp97+YfEnvxNrsB6y
+W/QUTTvJ/mLmcGHrBWLNFOArZu+rxIrnrx8AeY9Y80/ku2cHoB/uYA5jJlOLmcxhYp3ETCFJSbiGsG12W7bT2TSS25/QxCQjqy9lvExLZKwudec+ByZjbCji
+7tyy9Jxdy3WhZWWhuuW7ctLLcnFZ74ZiY1JI5pLJgSRw8pLMkCRj8YZeFvY5iz/x5PU2G/PHc7lXROK//+L61m7ZTpIEvDKVnw+y5wVoeYHXa1l0gdxnlgQN
+SfoHXlyW9t/w/r945hx+bNlOZ3C2jROAVaAzF3kFNvjqrNfQiLNnTefOMc+8ZOzrM9YfIP4H2CAsOWMeePswcT/M7XFvJ7LB9nI+FOvM/5WoXjedO2c6a+Rb
+sxHZA+Yr9sT+7cPm7w4/+OzVweMOA4UTEjFWxz5r9W3uEaz+7BNY9b7sZbWwLwsGPMg/k5K3peuwhvkpl+pTzVyedZJlM27kn0lpxRpsGtzIPIvS3PGp5MD/
+7dmwCMfRDyP5fWFW7xznt4URMi3X8VXyPjiy3/N70UIRXjVa21LmIk7BNTtHjJ06AqdllU8zMo8zr8QalVvWAoF6yxf4LInlnzMoV/OlzHq1cDTXk1ExISlR
+kzVZjswfaHT2U8ceOXTyw09U5euSk18C/tzpC/gUyWdlXy/UlrAZ3k22pZvDovxzU4zfpPpub/VqGMVUYlsy3496y2V8jK8pRo3MmU9qZ2OiGq0G5XO/ljXU
+vZk0MxWZpcwT8Qkj9jxpepuZw7YDG3yMqHkflCtrYx+GKEPP2cyp2V3oi98yY1JS8OmUFB5PieV73E6mspaSnj+X4N8Kcqf5N5a+cCQlN9cjPCBUFORhL8kr
+1E0/mjxnTjKzMS3QOsnaUYFOLwCC0XIOnydjWboD1Dq9hM8pU+vl7ENeR2ninJf6J6uqbGqdkh2P2jo9q2xYq2cqrXGjf2rgfutnClNf53K6bHA7UXO5R1wX
+XFVaDVaamJdPuLz8azZHPgACG0tdSSKH8rM03OPQVOMwgPn85oULN0mk+aOUc8nPAVC2VgB3kKTBmhQ5mzsrt0U6+DA+vbt8t5dptJYmiSZnj7DWy+13Ocr0
+bk7KFMWELw6ImB+YARRd1Tnsa/GZvMl+1RwAhEjMwOP38gwiccNgngE7T67iafL+cJlxFlNuN1ut0y+ISo4yJq7CNZ6NutEyZXT69d8Y89+kLjPz0uTgiQdY
+2JaP8QT5lhvHwSJ0dQri6oTw+pyqMQ6t75BvvWdMNPfjh4X+xtAP+PnzOR6kKnDiZcZKrZNqWTuKFZkRW5nr7rd+zdyYTRY9w2RkG1Nxfv3vGL8NEOJBjm+Q
+Q/+QGd8nmblPrsFm0s2XgxuF/KVgyy94inpx1jUrJpE4rBZ8SIJwoo8uTE9Cg13t6rtD5rdc/sVYX99VEmGbaesahGMurU7ZMpC5bVsmDzPSch37qAIUAFY8
+QE7gxWR4dpCE3WUlWBGbQCJVLq7286wTUhPj9NatE0gmvmvt4eNgZ7RNvvSR5/fM362hDb9cMTaldEbHn/1xpoLFEW55E0/wtdF6tX5ILiWu7EtCI8kehxVk
+uXWO9VNkq/0O8rRDjsMpox1SW6PtC+JdbFGy2dZoy3wrRg+O5mjLN/giX5PsytcNa2TsEQU6F71K3J5YYZeJ4s9X7/ZYNRu3jEBtWB9YRAGpUuZN5lxylgYl
+5pf4vo+wfIXn+dpg16EqXv6sA61KPDop0yGT2GJEe1O7X03RUndMDTjwl0hfY2RuM9/FGsJ/+W2pkNcKcnyRr9ENELIV8UVmK45gvsEmOTuA/H1Ky1X8mHLR
+Hn0K4ZcVYdiGPTNoXl5x8KOZubmZjxpzc6ObKwPkxbs3pn31lZGvcn32xIQRQEFnuY7nyC2hdoqVVcIKKuETjfl8DVxulFat63ZrnlPAHPYwVXlUTc9ukleV
+kja7Uwyzxs80cu5NcYZnQEbARz4Kg48SACHM8g0e/9e6Uhd9LaZ5Z2ZkJ6THl3UEdGxSsGWlWzeueD7uiZmPdxmnY8xzfD/dLb/hh9QGfAD0bkNuYAQO1mNy
+jzthF6mTDl6B5eVWTQ06jXujXbuRsbWLD0zO8nr720qji6fR069xHKPI9r9xpjy78UCy/6Rd/DP09JYf8E1ynes7N8dd2JoxMeVIXehZNzFVYhRnz58eGJDl
+H+CWHboS3b6mA8yFZ8jrT74mP/AkAEKE5Qr2UVfwYO0BXoTcyLBUF61KLIkYbWcgohtpS6Z67EzILVBnpsmf8zRg815EUVS49Evm5vaQrNSX8zOSEtMmP963
+mIUbZLmIH5A/wIOnjJ1BfJEhX2OoYXPklo5MSpa8ddRofGmJW1zK1qesS0IzFQ8vNzq/7WAcbQiQfexs3PEy91yR6/g+ufjgGKOLelhGES7OEmUtmDiitj3l
+WLrCPt12X7qrR7oH+Uj0I3NhZ7gxeDm6f+L0wyTnms+dF55yPgVEoPFTrmoNXNklR8WnYbDrZP9R3LG1aLS/MfkhjMFFRuOrJ6IzwnbgLqORfyboeXxPqEPj
+comkGqk7vufd3ZudvRijMitSmSIawXySBgghlqt4ijoJuYFDx9/wIo+nNo9uUcUlK996K4dkmry++yo227e6b52X6fRpIBALcjxKDt3bJ2JrxGJxFfMY5srz
+8kgFWyIGCFrLVXxXqBHj60gfzFyaEpOalOzGfIVjPUsytJr04OIFihlF8fh6XOnolQeMxs+fa66KnZS0pC6jbDVfIxpoOYEXqI+Q1SsAE07wYlNZtRr0fTzU
+lJ+pCa7OMZR6fTeG+fixHPKQcUlLU1Ns8mzmJXRPDM+ajxLjBi5+aLmKG8n3bE/kap2Lq9wWG30WzLbPdVynzC3CZNRJmJeZbZxs+1h+xXPkLJ8vZUWo3sWV
+f1Y+Lg/IysgJZNZmjHLOdHLEGVgsY15kltmMxAycZMUs4udeheU3PMTXT+mHu1IaKbfAbVVF1zeLOrpMSdkLlktXPfx8VrBTtlNWBallvo+sNzRpWBjplm/x
+4GAtET8rXFyV6TjDsHyS59J5lLlA3vqcubw7ODsGZWDh8DpDIt4g3Rze4ccIU+6J/RJng5e/cmRAcERGeYLt+kiJSBGceOyl0ZPj47u2HdJ54Jiu+tls6AC8
+LFfxM+pz/zMm+NOhXbW8urvfVsLClDBlqh/zU7p3TGpIimqkk/Vrrxn37PEMD8Y9ri8fdD3ypsPm0657mc+M1tJg7DdyxSf5YYAQazmNbwu1EnyBhPJeycS4
+5CDX+AoH5hCudp6Q7+efWowrR07QGs81ZWcv/K2seZLPbyy9qZYvcT+5OlgrwT87jLdueNpx94GD5PBrUWtWk0fWMqfo889Inh6Z4ZDnYEDbaPs8+xiUm+yi
+TVGA4G/px0vk12F5WpwE8+UR+kDUpetC00XOh54WKZwSA40B+gntCnzY8/oJudSkszcSNpjYZMPsZo5KFQ8LtsxRvi4C5f/M5sNyo29qvj42QZfbE9pjLDVo
+tPkR5LZz/775bZpJNc/PS126b2mdNrfnYW5d+xY/IB9y8X6VbPgzn/gJza0iMg1+8JlI7O7uqdGIkpIjI5TWS5a0YPOhz3DMYl18sKPINtvWXls1eonxRk7O
+DQAEL8tZ/JCKeV3O2wWCcnCV0EC7qW5Gl99srePUidmyvnBjGG7Lifra5qPmnAnvp4fMesVk/tGGcPIXAnI8RQ7dZ7sqcUID03BH9h8MruPsKFa2wQH3/rO2
+IB07mPUYwPyKjsznfG2BQ+ZlTtdcwrfIp3xszVUpPDaMKy7Q47LHnnLvnS1mjntjIV66+luEMXD/4/dqC+osZ3ETuQm24Mhbvnxcjo3i7fAylkVmT3vPGGP0
+rDv6BBIL/PkHxgmlBUBghOUafk2uCfudcjcxu9CmILtL78yto7gjfX5HVlgic9IYlhLhp3DGnTbXLhOzuVXxxp/HjjE3v8xIDW54mz+b5jp+wNcUIHviGwcJ
+l2X6vPNI9qhePCy7+g21MlfJTgCBBJDg6/fXE2ikqvlGnM+8NzDAVRMYiY5fa1It1/EgeR8ceF2XzO28sQmWmz0WdN3KwpnLfXO8b/zEZWP3Mb8xvwOBdMtt
+/Jhc4p+bj3LWF9S5qPn5stro8ep2txVbt+cUBuUVkDmOzE/MqZi8CHRvrSnMyG38f3YG2eCh5oMliDiCO37MnTu+nIq548d2sdeRXNtvLfnkDHkZ3MEHwEqm
+0g7mwgn5tOzKqpZyuR/EP6l71UM9SWX5tpklhdluJY+OqmuyeXGXeN30WQ9bbdsu9k72EjMNYu8kbx1ul7TXVXXxvPwWgFgG99O4swhk91KF+Ck2GOxP2IA+
+zFfBSnzW/F1YamVl98TK0Q4msm3V0qS2uEo3YjDQalNb0pr6thEGTy7/GTbTWjzA+f/sIVXaHnLDiNPSmBtcPBXmkRu0jSoG/al55DtyIymJ4xues7xAPKma
+5zdKZSqt1JX7Szxzc3PRh/kkNxdDiC1z0WTC0Pv3q+SDsTTWtOUzi/ilQkP53SvLoU8//bT8008/PWQR9rAe0tZrN23S1mtxJp+3rwCgJzlYXnzevqtWo9aw
+UTO5kP8oRN4CVBKNXMUGxFw1ivrEUt0RfWvcEV1RYn3HxJaW5paWidhKVDl/Pl1CmNefLiK4L+lH7l8SU4T72NfHG5OYZ7GGeRYAAQCdahkH+fl6p6TfSSjt
+BwA4/3LpucF3y2rmBatekQaAnSkwGJ8DkYY5CtVWj1pW32216hW+H/qHT2I7KFlotB8SaD8k0354nPaDL+2HLNoPk2k/GGg/8af90Ej7cQ3/TgKF71F4b+K/
+h0m0H3poP3Gm/TCL9hON0K6f/4w/8O+QRPsJi6taeAHtxwj+BbvuvZClbSLth2m0H/YKOI4In0/RflhM++EV2g/jaD/spP1QQfthlPAaT/uhToDVK9DGtl/I
+0zj0+znaD6/RfjDRfoin/ZBD+yGO9kM27Ydw2g/FtB+Mw+5/gfZDpfB7Pe2HPNoPGwS4sQLsAgFGgYCzmOcTNAo0sp9ZHnoL78Nfg/dUCLCMAm0srGbaDyto
+P2j+hR9baT+8R/uhnfZDNO2HNtoP62g/pAr40mk/1AjXXbQfnqH98DrtB6sHXhbaD5+y+HCAk4tjwpj1CfhYHPNoP7wpyMcBgTczaD900H6opf1QKuBooP2Q
+IvBlvTAGLB8iaT8sENq00n4op/2go/0QNgzOIKxiXl7gVeG7scPGxEz74UfaD2UCDZMF3KycpgkwdcKYPSa8Fgr3Nj/A90xhDiQIv88V+N4s4Fsv9OVZAd8p
+AdYkgSdsv44Lsnaa9sMSof0kQU7XC+N0VLieLPSLhd9N+/EtXr7QmvbDNWHs2fE5SfthP+3HYNoPh2k/PEr7oV/AP5/2w0baD08K9zKCLLJ8eZf243Haj60C
+zT8J/WK/e5/2k2IeLzfWewQ62ffNArwvBN6aaT9KeDlDG9oP39N+ZOf9V7Qfbgg4lgpt2bn0M+2HPwTa9tB+FNN+2MffC3/RfnQV5uRFng6u3R+0H0cIeC/T
+fnhYaLeKbwO3BD58RvvheWGsTgptfxXWmycFOX2bl0+cQftRRPvRT5DpHwU8rjw8HEn78QztR0faj/78NbfmeNF++J32ow8PG9n1S0H70Z72o7vQt1UCfX/w
+8HE37cdzwtxLov34MTu+OADJOAB1OABJOAAJOADHcQAycQDqcQAqhXf2VYIDYMQBKMMBGCe8RwrtI4X28TgAwcLncByAaByAETgAJhwAJQ6ADgcgDAfAHQdA
+jwMQgQMQJNzLvk/DAQjBAYjFAdDiAAQKtPjgAFTgAKTjADjjAHgJ96TiAPgL+IKE70OE+/RCv1j8tULfUoXfrNgX7YdvhVePMB7nhPFSCMqnXHj1AEAfAPwA
+gB8AEHYfeDEAOcnmuQFQD3Z/C4BuAaCHAegVAJEbgCgHQNQFINoEIPoAQHQHwMofwCofwGoxgNVhADEAiDMBxPMBxEcBJCIASRiApBxAMhvAOgjAugfA+mcA
+G38Am8UANgcBbMMAbFsBbC8A2DUD2N0BsC8GsH8ewH4AwCETwOEwgGMcgOOHAE7tAM7OAM5XAKTzAVzyAVy2ALiyedY9AG4iALd8ALejALJMANnPAO6xAO7b
+AOTeAPLDAB4NAJ7+AJ5rAUYEAYzYDeBVC+B1AcB7KoB3H4D3zwA+lQA+zwP4+gP49gD4/gwwchXAyAEAxYcAyi4A5TUAv1YAlQeA6gKAfxqA/yWAgKkAgSKA
+wMMA6tkA6pMAQWkAQYcBgvMBQuwBQj4ACC0GCB0ACOsDCK8ECL8EENEDEOkNELkFICoMIOpRgGgAiG4FiN4PEBMLEHMUQKMA0KwC0NwFiN0GoF0LoIsC0G1i
+YwAA+ucB4kQAcUkAcT0AcX0AcT8AxCsA4mcDxP8MkOAGkFAJkLANIOECQKI1QGImQOJigMT9AIk3AJLiAJIeBUgGgGT2/QeAlHaAlIMAqWEAqT0AqT8ApLkB
+pDUApO0GSA8CSJ8PkCECyMgByOgDMLgBGLYBGPoBMssBMvcDZCUBZD0KYPQAMLYDGE8CZOcAZC8DyB4AyPEHyJkPkHMUIKcfwJQEYNoEYDoJkGsNkNsFkHsF
+IM8fIG8TQL4bALslkL8DoMAeoKAZoKAfoLALoPAaQNFsgGJvgOIGgOKDACXOACW1ACUHAUqtAUorAUoXA5T2AZReAyhzBijLByhbBlB2BaDcHqA8DqC8HaB8
+B0D5zwCj4gBGzQYYdRhgdBDA6FUAoy8AVMQCVOwGqPQAqJwNULkJoHI/QOUVgKoogKpigKpNAFU/AFSHAVRXAlTvBqj+AaAmCqCmGaDmMEBtFEDtYoBa9noA
+oC4ToG4qQN02gLqTAHU/A4wpBhhzCaC+GKD+BkADADSEATRUAjRsA2i4ANDwA0CjNUCjAqAxDaBxMUDjboDGnwGacgCaFgM0XQEYGwcwthVg7A8AzTkAze0A
+zY8CNB8GaL4B0OIM0NIFMM4aYFwawLjdAOMGAManAYzvAhi/BWD8DwCtCoDWJIDWqQCtfQCtFwDanAHaMgHaFgO0nQSYEAQwYTHAhBsAE+0BJmYCTOwCmNgH
+MPFngHZ/gPZKgPb5AO1bANqPAbT/ANAhAuhQAHQ8CtDxM8CkOIBJmwAm/QzQGQvQ+TxAZz9AlzNAVw5A13yAri0AkwFgcibA5PkAk68ATEkCmNIOMOUDgG5n
+gO5ygO75AN1HAbrvAkwNA5jaADD1MMDUSwDTYgGm9QBMOwgwbQBgehTA9GKA6V0A0x8FmL4bYHo/wIwggBm1ADMeBZhxDKDHDaCnEqBnPkDPbgDWy5npDTCz
+FmDmWoCZJwFm3gGYlQ8wqw+gFwB6cwB61wL0HgSYLQKYXQswexvAHACYkwkwZwvAnH6AuWkAc2cDzH0eYO4NgHn2APMaAOb1Acy7AjDfG2B+A8D8RwHmXwFY
+kAOwYBXAgksAC4MAFk4FWPgBwCI3gEVJAIvaARY9D7DoBsDiKIDFtQCLlwEsPgyw+C7AkliAJV0ASy4ALI0CWFoMsLQdYOk2gKU/ADzUDMh6PHAe3GE+2AMB
+BMJFVQEiANk3cEfHIR/JD4zCNYIDLBGuCUjgGeGaQjBYhGsROGCWcG0FDtgpXIvBGZ8Sru04Pc1fO4MV8ROupSAhcUABRTYAkAkgXCOMgDbhmoAjrBeuKZTC
+i8K1CEZgsHBtBSOwTLgWgwLnCNd2kIF9wrUz2BJ34VoKjiSIu5Zy36cJ1+z3+WCATuiCmTAF2mA8tMJUCIKxEAwKiIEoiAYtKKAAQkEBRiiCQiiHMlBAMZRC
+EeRCFhhY7W7o7Jo5pW1869SgscGKmKhoraIgVGEsKiwvUxSXFuVmGcpBABINXaCAKdAC42EatEMjTAEoCFVEdymmtIyf1t44BaD0/l9LB783QidMgqnc+xQY
+Dy0ckREQBeyj7RX/Awb+9xjQQTj3GuwaGDsnTTV2ThnfooiJiFIkKO6jRJGgiNGF68LZDv3/Q/9oaIEp0A1tHL1sG5ZGHUQBjG6Z0t3WOUkRHRHFassO6IJ2
+mAbdEM5BDn8QckdX+7Tu8Oiu8CHg7AhNhS5IgEiIvA/AOIE93RAB3dAJ02AKjIUWGDfErgiYAF3smji1KyEykoc9rnPS1O6I7s5pU8a2jGM5ETGha3hn+c7B
+g4QN0TPszgfv4SSc+2dZBDHwL/8OomVJH66GvD7r4sp9iGuq9hnFoZXKPueqvD630kpl3/wqnz5xaG1lVZ9bKMD/bwBBoll8yJwAAA==
+`
@@ -0,0 +1,6792 @@
+// Code generated by genFonts.go; DO NOT EDIT.
+
+// GNU Unifont 13.0.03, for Korean and for the Han characters used in Japanese and Chinese (http://unifoundry.com/unifont/).
+// Licensed under the GNU General Public License, version 2 or later, with the GNU Font Embedding Exception.
+// Subset to 3130-318F,ksx1001-hangul,jis-level1,gb2312-level1,big5-frequent
+
+package fontdata
+
+// Unifont is a gzipped TrueType font, base64 encoded. Newlines are skipped when it's decoded
+const Unifont = `
+H4sIAAAAAAAC/yzWdXRXZ/Yv4Cfu7pWpT929nbpbIJDgBEgImuDBCcEhCRCs7m5Td5m6u07dfSpTmbrcde7+/fGs78qRV/b+nBekIE+nNHv16b/nPoM2bfoY
+92BAc9uoKZv/46VRpJRL2eyzsaOmT/nrL2lSNv8d2WNb54zpuXDWcClbpNs6vXNcy6jRVd//sNE2Wy/FAePGtYxKe2qHSttsnYy3/bi2GbP7pbUfaZut3+P0
++tbJzaMWft3/cdvcvh+nD2obNXvK3zNS0mx7Wg+2njSqreXbvbZdYtvTriVj1JTJ02f81W0n2/YvTu5LSZXygwxSz0p9GmvjN+U/9k3JIVVmOvDXX3/99Z+/
+/vrrs6q//rLQVn3HHDfakcn1tM6/Oknr1DnyiJS//voLkCJNLvLlJ39t9hZQtY6992LvtUihFrUpGEVtWtBMbRa1eRhNbQG1hRhPbTG1JZhIbRm1lWijtpra
+rTCV2m2o3RZzqN2e2h0xj9pdqN0VC6ndPVhE7V7BYmr3CZZQu1+wlNqDqD0Uy6g9jNrDsYraY4Juao8Leqg9kdqTsJraU6jti7XU9qO2HpuoHUDtYJxJ7XBq
+R+FcascEF1E7PriY2inUTsUl1E4PLqO2I7ic2s7gCmoXB1dSuzy4itqV1K7C1dQm6+7BtdSuoXYtrqN2HbXrcQO1G6ndhJuoTdZ6Lm6h9nxqL8Cd1CZrvRh3
+U3sptVfhXmqvofY6PERt8v7NeIzau4InqL2P2gfwJLXJc4/hGWqTv5/Ci9Qmfz+Hl6l9Ifg3tS9R+zpeo/Ydat/Fu9R+SO3HeJ/aL4KPqP0y+Jjar4NPqP1v
+8Cm131H7PT6j9kdqf0Iyxm9BMsYfwVfU/hV8TZ+U4Bv65NAnD/+lTwF9SvA9fSqCn+lTRZ/N8At9tqTP1viDPtuHlGS8v4eUVPrsElLS6LMnffYiJZ0++4WU
+TPocEFKy6HNQSMmmzyEh+bL7/IM+h5GSS59jQko+fY6nz4mkJOs9jT51pBTTpz99GkhJ1j4wpFTTZzB9Gkmpoc/IkLIVfZpDytb0GR9StqFPa0jZlj6T6TOF
+lO3oMz2k7ECf9pCS7HsufeaTsiN9OuizkJSkBkvos5SU3eizPKTsQZ+VISWpTXdI2Ys+q0PK3vRZG1L2oc+6kLIvfU6nzxmkJLU8J6QktTwvpBxInwvpcxEp
+SV0vCSlJXS+jz1WkHEqff9LnelKOpM+t9LmdlKTG94WUpMYP0udhUk6gz5Mh5WT6PEufF0g5hT7/ps9rpPShzxshpY4+b9PnfVL60efDkJL05POQMog+X9Hn
+G1KS/nwbUobR5/uQMpw+/wspjfT5OaSMoM+vISXp558hZRR9hZQm+maGlGb65oSU0fTNo28BKS30LQop4+hbQt9SUsbTtzykTKRvZUhppW81fTcjpY2+W4WU
+KfTdJqRMpe929E2+hWn03Ym+u5Iyk767h5S59D0gpMyj78H0TTI/n77/CCkd9D08pCyk7zEhpZO+J4SURfQ9KaQspu+pIWUJfWvp24eUpfRtCCnL6TskpKyg
+77CQspK+LSFlFX3bQkoXfSeHlG76TgspPfSdQd9ZpKym79yQso6+C0LKevom6+4kZQN9l4WUTfRdGVJOp+/qkHIGfdeFlDPpe3pIOYu+yd9nk3I2fS+mb5Ln
+8+h7ZUi5gL7X0vefpFxI3+tDSvLsTfS9mZRL6HtrSLmMvreHlMvpe3dIuYK+/wopydiPhJSr6PtYSLmavs/Q9zlSrqHvCyHlOvq+Qt9XSUnmfyOk3Ejfj0NK
+spZPQ8rN9P08pNxC36/o+zUpyRq/DSnJGr8PKXfQ938h5U76/hJS7qLvbyHlbuqElHuoSw0p91KXTl0GKf+iLpu65Fu4n7rikPIgdaUh5SHqyqmrIOVh6qpD
+yqPUbRVSHqNum5DyOHW7hJQnqNuHun1JeZK6A6lLzvanqTuUuiTbz1J3BHVHkfI8dcdQl2T4JepOoa6WlDeo60tdP1Leoa6euiTD71M3MKR8SN2QkPIRdcNC
+ysfUNYWUT6hrCSmfUjeWuuSc/4y6idRN+v//k1Q3hbqppHxD3XTq5pDyLXXzqEvy/DN1nSHlN+qWUZec579Tt5K6LlL+pK6HutWkplC3jrr1pKZRtymkZlB3
+RkjNpO6skJpF3TnUnUtq0p8LQ2oudReH1DzqLqXuMlLzqbsypBZSd3VILaLu2pCa9PZ66m4gtYS6m6i7ndQy6u4OqTXU3RtSN6Pu/pC6OXUPh9QtqHs0pG5J
+3RMh9W/UPUXdM6QmuXgupG5L3QshdTvq3qTubVK3p+496t4ndUfqPgqpO1OX9OlTUpMcfRFSd6Puq5C6O3VJb74ldQ/qvg+pe1P3Y0hNcpf06TdS96Xuj5Ca
+ZPAv+qWQehD90kLqofTLpF82qf+gX25IPYJ+hSH1SPqV0K+U1KPoV0m/zUk9hn5bhtST6Ld1SD2ZftvTbwdST6HfTvTbldTT6LdHSK2j314htR/9DqDfoaT2
+p99hIXUw/ZL5jiF1CP1OCKnD6ZfMUUtqI/360q+O1Gb61YfUFvoNCKlj6DeUfsNJHUu/5LkWUifQb2xIbaPfePpNIHUS/SbTbwqpU+g3LaQmvzPo107qdPrN
+Cqnt9FsQUmfSb2FITe4vpt8SUmfTbwX9ukidS7/VIbWDfutCavLehpDaSb9zQuoi+p1Hv4tITca7JKQm411JvyTnK+l3I/1uITUZ+86Qmox9d0hdT79/0e8+
+UpN5Hgipm+j3UEg9nX6PhNQz6PdYSD2Tfk+E1LPo91RIPZt+L4bUZL2vhNRz6fdv+r1OarL2N0PqhfR7m37vknoR/T6gX5L/S+n3Cf2S/F9Bv/+G1Kvo9x39
+kpxfTb//hdRr6fdzSP0n/X6lX5L56+j3J/1TSb2B/hn0zyT1Vvpnh9Tb6V8QUu+gfxH9i0m9k/4V9K8i9W7614TUf9F/y5B6H/23pv82pN5P/+1C6oP03yWk
+PkT/PUPqw/TfO6Q+Qv+D6Z/k/FH6H07/o0h9gv7HhNRn6H9cSH2W/ifQ/2RSn6N/Lf37kPoS/evoP4jUV+g/JKS+Tf9hIfUd+jeG1HfpP5L+TaS+R//RIfVD
++o+hf5L/j+g/MaR+Qv9J9J9K6qf0n07/JPP/of8s+s8h9Wv6zwup39J/YUj9jv6LQ+r39F9K/+Wk/kD/VSH1J/p307+H1J/pvymk/kr/M0Pqb/Q/O6T+Tv/z
+Quof9L8gpCZ9vjik/kX/y+h/OWnofyX9ryItlf7/DGnp9L+J/jeTluTi7pCWRf/7QlqSkQfo/xBpOfR/nP7PkpZP/xdCWhn9Xw5p5fR/lf6vk5Zk582QlmQn
+qfsnpG1G/8/o/z1p29L/f/T/hbQ96J/s83fS9qU+JaTtT30a9emkHUB9Zkg7iPps6stIO5j6ipB2HPVVIe146jcPaSdQvyX1W5N2IvV/p35n0k6lflfq9ySt
+L/V7h7QG6pN1HEDaAOqT+Q4mbQj1h1L/D9KGUX94SGuk/siQNoL6o6k/hrSR1B8f0pqoT+Y+mbRm6mtD2hjqk7nrSBtLfUNIG0/9oJA2gfqh1A8jbSL1TSGt
+jfpxIW0S9RNC2mTqp4a0KdRPD2nJtXbqZ5M2jfp51C8gbSb1nSFtDvVLQ9pc6peHtOTZbup7SJtP/Rrqe0nroH59SFtE/SbqTydtMfVnUX82acl4l1N/LWnJ
+eNeHtNXU30h9ksNkvDtC2jrq7w5pydj3hrQN1N9H/YOkbaT+4ZB2JvWPUv8Eacmcz1CfZPU86l8IaRdQ/++QdiH174a0i6h/P6RdTP3H1CdZvYT6z6j/grTL
+qP+K+q9Ju5L670La1dT/GtKuof536v8g7VoaUkLadTSkh7TracikIYu0G2jICWk30VBEQzFpN9NQHtJupaEypN1Gw/Yh7XYadgxpd9CwW0i7k4Y9aEjyfBcN
+e4e0e2jYN6TdS8OBNBxE2r9oODyk3U/DUSHtARqOoeFY0h6k4fiQ9jANJ4e0R2joE9IepaF/SHuMhgYaBpL2OA3DQtpTNDTSMIK0p2kYFdKepaGZhjGkPUfD
+hJD2Eg2TQtrLNMwOaa/QMI+G+aS9SkNHSHuNhs6Q9joNi2lIMvwGDStC2ts0rKKhm7R3aNgY0t6n4Uwakqx+QMO5NJxH2sc0XBDSPqXhipD2GQ1X05Dk+XMa
+rgtpX9KQ9DXJ81c0JD27nbRvabg7pH1PQ1K7x0j7gYYnQtpPNDwb0n6m4YWQ9gsNL4W0X2l4I6T9RsNbIe13Gt4NaX/Q8H5I+5OGD2lI8vwXDZ+F9DQavgnp
+STZ/pOEn0jNoSN7/k/QsBgjpOQzIDOm5DMhmQA7peQwoYEAh6clvcUgvYkB5SE+uVTKgmvQSBmwe0pP7W4X0CgbsHNKTZ/dgwD6kVzFgv5C+BQMOCOlbMuDg
+kP43Bhwa0pPxjgjpWzPg6JC+DQNODenbMqA2pG/HgP4hfXsGDAzpOzBgcEj/OwOGhvQdGdAU0ndiwGgGtJCerH0cA1pJ35UBk0P6XgyYGtL3ZsCMkL4PA2aG
+9H0ZMJsBc0lP9toZ0g9kwLKQfhADVoT0ZN9rGNBL+iEM2BTSD2PAxQy4hPTDGXBZSD+SAVeG9KMYcF1IT2pzS0g/hgG3MeB20o9lwD0h/XgG3M+AB0k/gQGP
+M+BJ0k9mwNMh/TQGvBDSk7q+wYA3Se/DgLcZ8A7pdQx4L6Qn9f6AAR+RXs+ALxjwH9KT2n8V0pPa/5cBP5A+hAG/hvQRDPiDAX+RPpKBaQxMJ72ZgZkhvYWB
+2QxM8jmGgXkhfRwDC0L6eAYWhfQJDCxlYBnpExlYGdLbGFgd0icxcKuQPpmB24X0KQzckYFJbqcycDcG7k76DAbuycC9SJ/JwH1D+mwGHsLAJKtzGHh4SJ/H
+wCND+nwGHh3SFzDwhJDewcCTQ/pCBp7GwDrSOxk4MKQvY+AQBiZZXc7A4QwcRfpKBo4O6asZOCakr2FgUosJpK9l4OSQvo6Bs0L6egbOD+kbGLiYgUtJ38jA
+7pB+BgOT5zaQfiYDzwnpZzPwwpCeXLuYgUk+z2Xg5SH9fAZeE9IvYOB1IT1570EGPkT6RQx8KqRfwsBnQ/qlDHw5pF/GwLdCejL2uwxM8nYFAz8K6Vcx8IeQ
+fjUDfwrpyfy/MDDJ2LUM/D2kX8cgIf16BqWG9BsYlMGgJG83MiiHQbmk38ygkpB+K4MqQvptDNoypN/OoK0ZtA3pdzBo+5B+F4N2Cel3M2hPBu1N+j0M2o9B
++5N+H4MOCekPMOhIBh1F+oMMOplByfn2MIPqGNSP9McYVB/Sn2DQQAYNIf1JBjWG9GcZ1BTSn2PQ+JD+PIMmhvQXGNQW0l9kUHtIf4lBc0L6ywxawKAO0l9h
+UGdI/zeDloT01xi0LKS/zqCukP4Gg9YzKMnPmww6I6S/zaCzQvo7DDo3pL/LoPMZdAHp7zHo8pD+AYOuZNBVpH/IoOtC+scMujmkf8Kgf4X0Txn0QEj/jEGP
+hPTPGfRYSP+CQU+G9P8w6OmQ/iWDng3pXzHohZD+NYNeCunfMOiVkP5fBiX7f530bxmU7Odd0r9n0Ach/X8M+iik/8igz0P6TwxK5v6S9J8ZlMzxPem/Mih5
+7icyMOgXBv1KRpLTP0JGOoP/T0YGg7NCRiaDc0JGcq0gZGQzuChkJPfLGVxJRi6Daxi8GRnJs1uEjOTZrRi8NRnFDN42ZJQyeHsG70BGGYN3DBkVDN6ZwbuS
+Ucng3Rm8JxnJ2HuHjGTs/ULGlgw+kMGHkPE3Bh8eMpJ5jg0Z2zH4BAafREYy5ykhI5mzlsF1ZOzE4HoGDyJjNwYPCRnJnCNDxj4MbgoZ+zK4JWQkaxnL4Alk
+7M/gNgZPIuNgBk9n8AwyDmXwzJBxGINnM3gOGcl6F4SMIxm8iMFLyTiKwcsZvJKM4xjcFTJOZPDqkHESg9czeAMZJzP49JBxKoPPChmnMfg8Bp9PRrLXSxh8
+GRl9GXxlyOjP4GtDRlKD6xh8PRkNDL45ZAxk8J0hYxCD7w4Zgxn8r5CR1OwRBj9KxlAGP8Hgp8gYzuBnQkZSy+cZ/DIZoxj8WsgYw+A3QkZSy7dCxjgGfxgy
+xjP4YwZ/QsYEBn8WMloZ/AWDvyQjqf2PIWMKg38LGVMZ/FfImMaQlJAxnSFpDEnyP4MhWSFjJkNyQsYshuSHjNkMKQkZcxhSFjLmMqQ6ZMxjyOYMSfI/nyFb
+MSTJfwdDtgsZnQzZIWQsYsjOIWMxQ/YMGUsYsnfIWMqQA0LGMob8I2QsZ8iRDDmKjBUMOT5krGLISQw5mYwuhgwJGT0MaQoZqxkyJmSsYciEkLGWITNCRi9D
+klrMImMdQxaGjA0MWR8yNjLkbIacQ8YmhlwRMs5gyE0MSfJzJkPuYUiSk+TZBxjyIBnnMeSJkHEBQ54OGRcy5FWGJNm4iCHvh4xLGfJhyLiMIZ+EjMsZ8nnI
+SOb/X8i4kiG/hYyrGJrN0KSnVzM0j6FJT69l6JYh4zqGbhsyrmfo9iHjBobuEjJuZOhuIeMmhu4VMm5m6H4h4xaGHhQybmXokSHjNoYeEzJuZ2hfhibnzx0M
+7c/QBjLuYuhAhg4n416GjggZDzO0maGjyXiEoWNCxmMMHc/QiWQ8ztApDJ1KxlMMnR4ynmFoe8h4lqGzQ8ZzDJ0bMp5n6IKQ8QJDF4aMFxm6KGS8xNAlIeNl
+hi4LGa8wdAVDV5HxKkO7GZqcUa8zdG3IeIuhm0LG2ww9g6GXk/EOQ5N+XEPG1wy9LmT8l6E3hIxvGZrU9zYyvmNoUq/kLPqRoUmN7iPjZ4Y+GDJ+Y2hSr8fI
++J2hT4dMDH0+ZKYwNNnbS2SmMvTNkJnO0GR975KZwdD3GfoBmUl2PmLo52TmMvQ/DP2SzGKGfh0ySxn635BZxtD/MfRHMssZmqzvdzIrGfonQ/8ic3OGpTAs
+lcwtGZYeMrdiWCbD8sjcmmEFDCsi8+8MKwmZOzOsPGTuwrDKkLkrw6pD5m4M2yxk7s6wLUPmHgzbNmTuybDtQ+ZeDNsxZO7NsOT93cnch2F7h8z9GHYgw44m
+c3+GHcew48k8imEnMuwUMo9h2GkMqyPzBIY1hMxTGTYoZCb3hzGskcxaho1kWBOZdQwbzbAWMusZNi5kDmBYa8gcyLDJITMZb2rIHMywOSFzCMMWhsyhDFvE
+sMVkJnP2hMxGhvUybB2ZIxi2MWSOYtgZIbOJYeeEzGaGXRQykzVeybCryGxh2DUM+yeZYxl2Y8icwLCbQ+ZEht3OsDvITPZxX8icxLAXQ2ayp9dD5hSGvcew
+98lM9vdJyJzOsM9C5gyGfREy2xn2VcicybBvGJbkcBbDfgiZSW1+DJlzGfZbyJzHsD9C5nyGp4TMBQzPZHgWmR0MLwyZnQwvCZmLGF4eMhczvJLhVWQuYXhN
+yFzG8M1D5nKGbxsyVzB8+5C5kuE7hsxVDN85ZHYxfPeQ2c3w/UJmD8NPCJmrGX5qyFzD8L4hcy3Dx4fMXoZPDpnrGL40ZK5n+IqQuYHh3SFzI8P/T+Ymhp8X
+Mk9n+B0h8wyG3xUyz2T4PQy/l8yzGP5EyDyH4U8z/Bkyz2X4iyHzfIa/HDIvYPirIfNChr/N8HfIvIjh7zP8QzIvYfjHDE/On8sZ/p+QeQ3Dvw6Z1zL8W4Yn
+vf4nw38KmTcy/LeQeRPD/2D4n2TeTGMKjWlk3kpjBo2ZZN5BY3bIvIvG/JB5N43FNCZ9v4fGChqTM+dfNFaHzPtp3IzG5Jx5gMatQuYjNO5AY9LfR2ncNWQ+
+QePuNO5F5pM07hsyn6XxABoPIvM5Gg8LmS/SeGTIfInGo2k8hsyXaTwuZL5K46kh89801tOYnD+v0TgwZL5B49CQ+SaNI0LmWzSOorGZzLdpnBgy36NxGo0z
+yHyfxpkh8yMaZ9OYnDkf0ziPxuTM+ZTGRSHzSxqX0Jjk7Ssal9OY5O0bGrtC5rc0rqWxl8zvaNxAY3L+/EDjWTSeTeYvNJ4bMn+j8XwaLyTzdxovp/EKMv+i
+8ZqQlfT2nyErlcakx3eQlUbjv0JWFo1Jnx4mK+n54zQ+SVbS85dCVhGNr4aspP9vhKwSGt8OWaU0vkvje2SV0fgBjR+SlWTky5BVReP3ISvJy480/kRWDY2/
+0vg7WZvT+Ccj0sn6GyOyQ9YOjMhlRB5Zf2dEESOKydqJEaWMqCBrF0ZUMaKarD0YsXnI2osRWzFia7L2ZkQy1o5k7cuInUPWAYzYPWQdyIg9Q9ZBjNg7ZB3M
+iP1D1iGMODBkHcqI5N4hZP2DEf8n63BG/J+sIxhxfMg6khEnhqyjGHFyyDqaEaeGrGMYURuyjmVEP0b0J+s4RjSErBMYMTBkJeMNDlknMWJ4yErGbgpZpzBi
+NCPGkJXMM54RbWT1YcQURkwlq54Rs0LWAEbMCVnJPPMYMZ+sQYxYyIhFZA1hxFJGLCMrmXMFI3rIGsGIXkZsIKuFEWcw4iyykjnPDVmtjDg/ZLUx4kJGXETW
+JEZcyYiryUrWdW3Ims6I60LWDEbczIhbyWpnxO0hazYj7gxZydrvDVlzGfFQyEr28QgjHiVrPiOeZsQzZHUw4lVGvE5WJyPeYkSS7WR/74Ws5Yz4NGQle/2C
+Ef8hayUjvg1ZXYz4X8jqZsTPIauHEb+FrNWM+CNkrWHEX4xMJWstIwsZWUTWBkaWhqxNjCxnZCVZpzNyc0ZuSdZZjNyakduQdS4jdwhZ5zNyR0buStYFjNwz
+ZF3KyP1D1mWMPDRkXc7IY0LWFYw8PmRdychTQtZVjDwtZF3NyL4h6xpG9gtZ1zKyIWT9k5GDQ9Z1jGxiZAtZ1zNyHCOT7N3MyMmMnELWnYycHrLuZmQ7I2eS
+dQ8j54esfzFyYci6j5FLGZlk735GrgpZDzKyh5GryXqIkRtD1iOMPD1kPcrIs0PWY4xManQhWY8z8pKQ9SQjLw9ZTzEyqUWSyacZeW3Ieo6R14es5xl5U8h6
+gZG3MPI2sl5k5B0h6xVGJntLMvkqI5M1PUbW64xM5nuKrLcY+ULIeoeRr4Ssdxn5Wsh6j5FvhKz3GflWyPqAkclz75P1ISP/T9YnjEx8TtanjPxPyEp+v2Jk
+ktsvGfk9I38g61tG/hiykmu/MDLJ7Q+MwqgUsn5iVGbI+oVR2YzKIetXRhUwKsnw74wqDVl/MaoiZGPUZozaguwURm0VstMZtR2jtic7g1E7huwsRu0XspN5
+DgzZOYw6nFFHkJ3LqGNDdj6jTmDUiWQnazmFUbVkFzGqLmSXMaohZJczalDITtY4JGRXMmp4yK5i1IiQXc2oJkY1k13DqLGMGkf25oyaGLK3ZNSkkP03Rk0J
+2cle2xk1i+ytGTU/ZCf77gjZ2zNqccjegVFdjOom+++MWhOyd2JUL6PWkb0zozaG7F0ZdXbI3o1Rl4bs3Rl1BaOuInsPRl3DqGvJ3ptR14XsfRl1U8hO6n1b
+yN6fUf8K2Qcw6sGQnfThMUY9RfZBjHomZB/GqOdDdtKfF0P2EYz6MGQfyahPQvZRjPouZB/NqP8x6meyj2HUryE76efvIftERv1JE7JPoik7ZJ9KU27IPo2m
+fJpKya6lqYKmSrIbaKoO2QNp2oymzckeRNNWIXsITdvQtC3ZQ2n6e8geTtMuIbuRpj1o2ovsETTtQ1OSzyaaDqDpILJbaDo0ZI+j6XCajiJ7PE0n0HQS2W00
+nRKyp9CUrDvJ6lSa6mhK8jmdpkEhezZNQ0P2HJqaQ/ZcmsbQNJbseTSND9kLaJpI01SyO2iaHrKX0TQzZC+naU7IXkHT/JC9kqbknU6yV9G0NGT30LQ8ZK+m
+KbnXRfYamtbRtIHsXpo20XQm2RtoOpumc8k+g6bzQ3Zy7UKaLib7HJoupekyspP7V4bs5P41NCVZvYimG0P2JTTdGrKT9+4M2ZfRdA9N95J9OU33hexkvAdD
+9lU0PUzTE2RfTdNTND1L9g00PR+yb6HpRZpeJjuZ51Wa3ib7DprepSnJ8P00fUzT52Q/QtOXNH1D9pM0fRuyn6Xpe5p+IPs5mn6k6SeyX6Dpd5r+IvslmlNo
+TiX73zRn0ZxD9us0F4bst2guCdlv01wZst+huYbmJMPv0rwlzduQ/QHN29G8A9mf0rxTyP6C5l1o3pXs/9C8Z8j+iuZDQvbXNB9Gc3KufkPz0SH7O5qPo/l4
+sr+n+aSQ/T+aT6W5L9k/0twQsn+jeWDI/p3mYSH7D5obaU7O0j9pHkVzMzloHkvzeHLSaJ4YcjJpnhRyktpMpXkaOdk0z6R5Fjm5NM+leR45+TR3hpykfotp
+XkpOEc0raF5FTinNPSGngua1NPeSk9R1fcippvn0kJPU+EyazyJnM5rPCTlb0HwhzZeQk9T+spCzDc1X0Hw1OdvSfG3I+TvNN9F8Czk70nw7zXeQk/TkLprv
+Jmc3mu8POXvQ/FDISXr1aMjZi+YnQs7eND8Tcvah+TmanydnX5pfDDn70/xqyDmA5jdofpOcA2l+P+QcTPOHISfJwuc0f0HOoTQn+fianCQXP4ScI2j+heZf
+yTmS5j9CztGMxuhUco5hdAajM8k5ntEFIedERhcxupickxhdxuhyck5hdCWjq8g5jdFbhJw+jP5byOnL6F1CTh2jd2P07uT0Y/R+Iaee0QeEnAZGHxJyBjD6
+MEYfTs5ARh8dcgYz+tiQM4TRyVpPJGcoo08OOY2MTubrR84IRteHnFGMHhRymhg9IuQ0M3psyBnN6PEhp4XRbSFnDKMnh5zk2dmMTjI8jtGLGb2EnFZGLws5
+kxi9gtEryUne62X0BnKmMnpTyGln9FmMPpucmYw+P+QkY18ccuYw+lJGJ1mdy+irQs58Rl/D6OvIWcDoG0LOIkbfxOibyUnWdWvIWcroO0NOssa7GX0POcsZ
+/S9G30fOSkY/wOgHyeli9MOMfoScHkY/FnLWMPpJRj9FzlpGP8PoZ8lZx+gXQ84GRr8ccjYy+rWQk+z7jZBzOqPfCTlnMPq9kHMmoz8IOUltPgk5ZzP6S0Z/
+Rc45jP4vo78j5zxG/xByLmT0TyHnIkb/yujfyElq+WfIuZQWIecyWjJDzuW0FIWcK2gpDTlX0lIecq6ipZqWGnKupmULWrYk51patqNle3Kuo2UXWnYj5wZa
+9gw5N9NyQMi5hZaDaDmYnFtpOTTk3E7L4bQcQc4dtBwXcu6i5URaTiLnblpODTn30tIn5PyLlrqQcx8t9bQMJud+WoaGnEdpGU5LIzmP0dJES3KeP0HLmJDz
+FC2tIedpWqaEnGdomRZynqVlBi3t5DxHyyxaZpPzAi2LaUm+hZdoWRFyXqFlVch5lZZuWpLz/N+0rAk5r9PSS8s6ct6gZVPIeYuWM0LO27ScFXLeoeUcWs4l
+511aLgg579NyUcj5gJak38m38yEtV4Scj2m5KuR8Qss1tPyTnE9puZ6W5Dv6gpbbQ86XtNwZcr6i5R5a7iXna1oeCDn/peWRkPMtLUkdnybnO1qSej1Hzg+0
+JPv/Nzk/0vJeyPmZlg9Czi+0JOv7nJxfaUnmTjL/Fy3fhtwUWpL3fyI3lZZfQ246Y4TcDMakhtxMxiSyyM1iTG7IzWFMfshNrhUypojcPMaUMaaC3ALGbMWY
+rcktZsz2jNmB3FLG7MiYncgtZ8zuIbeSMXuG3CrG7B1yqxnzj5Bbw5jDQ+5mjDmKMceQuzljjgu5f2PMSSE3mf8UxpxK7taM6RNyt2VMP8b0J3c7xjSE3B0Y
+MyTk/p0xwxnTSG6y3tEhd2fGjAm5uzBmHGPGk7srYyYxZgq5yZ5mhNy9GDOTMbPITfY0N+Tuy5iOkLsfYxYxZjG5+zNmacg9kDErGLOK3IMYszrkHsqYdSE3
+qc3pjDmT3MMYc17IPZIxF4fcpE6Xh9yjGXNlyD2GMdeE3GMZc13ITWp5Y8g9njG3hNwTGHMHY+4k90TGPBxyT2bMo4x5jNyk3k+E3NMY82rIrWXM6yE36cNb
+jHmb3L6MeS/kJj35JOT2Z8znIbeeMV+G3KRX/w25AxjzHWN+IHcgY35izC/kJj38nbHIHc7YtJDbxNhsxuaQ28zYvJDbwtiCkDuGseWMTTI8lrFVIXc8YzcP
+uRMYu2XIncjYrUJuK2P/ztgk222M3YWxSZ6nMHbvkDuTsfsxdn9yZzH2kJA7h7GHhdy5jD0y5M5j7NGMTXI+n7HHh9wOxp4UchcytpaxdeR2MrZ/yF3K2AGM
+HUjuMsYOC7krGNvI2CZyVzK2JeSuZuy4kLuGsRNC7lrGtoXcXsZOZux0ctcxtj3kns7YWSH3DMbOD7lnMjZZ60Jyz2LsopB7DmOXhtxzGbsi5J7H2FUh93zG
+dofcCxjbG3IvZOyGkHsRYzeF3IsZe0bIvYSxZ4fcSxmbzJF8F5cx9sKQewVjk3cuIfdKxl7N2ORbSH6vZ+wN5F7L2FtC7nWMvY2xd5Cb3H8g5N7E2IdD7s2M
+fTLkJu89x9iXyb2Vsa8x9g1y72bsW4x9h9x/Mfa9kJuM90HIfZCxH4fchxj7echN5vky5D7C2G9C7qOM/TbkPsbY7xn7P3IfZ+xvIfcpxv7J2L/IfZpxqYzL
+IPdZxmUxLpvcFxmXF3JfZlxRyH2FcSUh91XGlYXcfzOuknHV5L7GuG0Yty25bzJuZ8btQu7bjNsv5L7LuAMZdzC57zHuH4xLMv8h445kXJLzjxl3HOOSnH/O
+uBND7n8Yd2rI/ZJxfRmXZP4rxvVnXD253zBuGOOGk/st40aE3O8Z1xxyf2BcS8j9H+PGMi45w39k3MyQ+wvjFobcXxm3iHFLyP2NccsYt5zcPxm3gXEbycO4
+M0JeUtfzQl4a4y4IeemMu4hxF5OXwbjLGXcleUnt/xnychl3PeNuJC/pw80hr5BxtzLuNvKSntwT8pKe3B/yShn3cMhL+vNEyCtn3FMhr4Jxz4S8pG8vhrwq
+xr0S8qoZl/T1NfJqGPdGyNuccUn/3iNvC8Z9GPL+xrhPQ95WjPs65G3NuKQH35GX5OJ/IW87xiX1/Y287Rkv5O3I+IyQtxPj80LezowvYHwhebswvjTk7cb4
+mpC3O+M3Z/wW5O3B+N1D3l6M/z95ezN+v5C3D+MPC3n7Mv6IkJfcPznk7c/4/oxvIO8Axg9h/FDyDmb8cMY3knco40eGvGS8JsY3k3c448eFvCMZPzHkHcX4
+NsZPJu9oxk8Peccxvj3kHc/4WSHvBMbPCXknMn4+4xeQdxLjF4e8Uxi/POSdyviukHca49eEvFrGb2T8JvL6MP4cxp9LXh3jLwl5yV4vD3n1jL8y5DUw/pqQ
+N4Dx/2T8deQNZPwtIW8w428PeUmd7g55Qxn/QMgbxviHQl5Sv6cYn+SwkfHPh7xRjH8p5CW1fI3xr5PXzPg3Q14L4z8LeWMY/1XIG8v470NeUvvfQt54xv8R
+8iYwIYUJqeRNZEJGyGtjQi4TkrxNYkJJyJvChLKQN5UJVSFvGhNqQt50JvyNCVuRN4MJ24S8mUzYLuTNYsLOTNiVvNlM2DvkzWPC/iFvPhMOYsIh5C1gwmEh
+r5MJx4a8RUw4gQknkreYCaeEvKVMGMSEweQtY8KIkLeCCaOY0ETeSiZMDHldTJga8rqZ0MGETvJ6mLA05K1lwpqQ18uE/5O3jgkbQ956JpwR8jYw4dyQl9y/
+MORtYsLFTEgydjoTrg15ZzLhOiZcT95ZTLg55J3DhDtCXjLe3SHvPCY8yIQkP+cz4aWQl8zzBhOSbFzEhLdD3iVM+DjkXcqEz0LeZUz4MuRdzoRfmZDk5Aom
+poe8q5iYG/KuZmJxyLuGiZUh71ombhny/snErZmY9Po6Ju4Y8m5i4j4h72YmHhLybmHiYUw8nLxbmXhCyLudiSeFvDuYeGrIu5OJtSHvLib2DXl3M7F/yLuH
+iYND3r1MHBby/sXEESHvPiaOYuJY8u5n4ngmTiDvMSZODnlPMHEaE2eQ9yQTZzExOX+eYeI8Js4n73kmLgx5LzJxSch7iYnLQ97LTFzFxG7yXmHi6SHvNSae
+GfJeZ+KlIe8NJia1S/LwJhNvCnlvMzGp163kvcPE20Pee0xMapH8O/g+E5O9JefMR0x8hImPkvcpE58KeZ8zMdnDc+R9wcRk3UlmvmLi+yHvJyb+h4lJNn5m
+4jch71cm/hHyfqM1I+T9Tmt2yPuD1iJaS8j7k9YyWsvJT6G1ktYq8tNo3TzkJ2NsFfIzad2W1u3Jz6L177TuRH4urbvSuhv5BbTuQete5Cfz7EvrfuSX0nog
+rQeRX07r4SE/mfNIWo8mv4rWE0L+ZrSeFPKTtZxGay35W9DaN+T/jdaGkJ+scWDI35rWwbQOJX8bWofTOor87WkdHfJ3pnVcyN+F1gkhP9nHlJC/G63TQv7u
+tM4I+cn+Zob8PWmdQ+tc8veidQGtHeTvQ+siWpeQvx+ty2hdTn5Sg5W0riL/YFp7aF1N/qG0rqW1l/zDaF1P60byj6D19JB/NK1n0noW+cfQek7IP47WC0L+
+8bReROvF5Ce1vJTWK8hPank1rdeSn9TyupCf1PLGkF9H680hvx+td9B6J/n9ab2b1vvIT+r9QMgfQuvDtD5C/lBan6D1SfKTej8d8kfQ+lLIH0nrK7T+m/xR
+tL5J61vkJz15J+SPofU9Wj8kfyytH4f8ibR+FvJbaf0i5LfR+g2t35I/idbvQ/5UWv8X8pMeJt/DH+RPpy0l5M+kLY+2fPJn0VYY8ufQVhry59JWFfLn0bZ5
+yJ9P299C/gLadgj5HbTtGPIX0rYzbbuS30nbHiF/CW1707YP+Utp25+2A8hfTttBtB1M/kraDqUt+Ua6aDsq5K+h7Vjakm9kLW0n03YK+Rtoqw35m2irC/mn
+09Y/5J9B22DahpB/Jm3DQv7ZtLXQNob8c2gbT1vyLZxHW2vIv4C2SSH/QtpmhPyLaEtqN4f8i2lbHPIvo21ZyL+cthW0dZF/BW09If8a2taE/GtpS/awifx/
+0nZWyL+BtmRN55F/I20XhPxbaLso5N9K2yW0XUr+bbQl811N/h20XUfb9eTfS9tNIf8+2m4N+ffTdkfIf4C2e0L+g7Qlz91P/kO0PUzbY+Q/QttTtCXZfoK2
+F2h7kfzk2qsh/xnaXqPtDfKfpe2dkJ88+37If5G2j0L+S7R9EvJfpu2zkP8KbV/Q9iX5ydhfh/zXafs25L9B2/ch/03a/hfy36Ltp5D/Nm2/0PYb+e8wKSPk
+v8+kLCblkf8BkwpC/qdMKg75nzGpjEnJvxGfM6kq5P+HSTVMSr6FL5m0Zcj/hklbM2kb8v/LpO2Y9Hfyv2PSziH/RybtxqTdyf+JSXuG/F+YtE/I/5VJ+4X8
+35h0UMj/nUmHhPw/mHR4yP+TSccw6Vjy/2LSyaEghUmnMamWglQm1YeCdCYNCAVJPQaFgkwmDQ0FSW1GhIJsJjWHghwmjQ0FuUwaHwrymDSJSZMpyGfStFBQ
+yKQOJnVSUMSkJaGglEnLQkFS41WhoJxJPaGggkm9oaCSSaeHgqQPZ4aCaiady6TzKEh6cnEo2JxJl4WCLZh0RShIenU1k66h4G9Muj4UJH27JRRsw6TbQsG2
+TLorFCT9vCcUbM+kB5j0IAU7MOlhJj1CwY5MeiwUJD1/iklPU7ALk14IBUn/X2LSKxTszqQ3QsFeTHqbSe9QsDeT3g8F+zLpIyZ9QkGSiySXn1NwIJO+DAUH
+M+mbUJBk5LtQcCiTfggF/2DST6HgMCYlufmLgsOZnMLkVAqOZHJmKDiayTmh4BgmF4WCY5lcxuQKCo5j8tah4EQmb8vk7Sg4ick7M3k3Ck5h8p6hoA+T92by
+vhT0ZfJBoaA/k5N1HEFBPZOPCwUDmHxaKBjI5LpQMIjJ9aFgMJMHhYIhTB7K5GEUJL+NTB5JwXAmjw4FI5k8JhSMYnIbk6dQ0MTk2aEguT83FIxl8vxQMI7J
+naFgPJOXhoIJTF4eCiYyeWUoaGXymlCQzHNGKJjE5HNDwWQmX8jkiyiYwuRLQ8E0Jl/O5CS305l8YyhoZ/LNTE6yOpPJt4WCZO13hoI5TL6fyQ9RkOzjxVDQ
+weRXQsFCJr8WCpI9vRkKFjH5XSYn2VvM5I9CwTImfx4Kkr3+h8lJ9lYw+Wsmf0vBKib/GgpWM/nPULCGKZmhYC1TckJBL1PyQsE6phSFgvVMKQkFG5hSEQo2
+MqU6FGxiymZM2ZyC05myJVO2ouBMpvydKTtRcA5TdgsF5zNlr1BwAVP2CQUXMuXwUHARU04NBRczpS4UXMKUQaHgUqY0hoLLmNLMlCRXlzNlYii4kiltoeAq
+pkxmSpKxq5nSzpSZFFzLlNmh4DqmzGfKQgquZ8oqpnRRcDNT1oSCW5myPhTcxpSkFpsouJ0pZ4WCO5lyaSi4iynXMCU53+5mys2h4H6m3MGU5Ex7gCn3MOVe
+Ch5mSvJ3kplHmfJ4KHiCKU+GguT3uVDwFFNeY8rrFDzNlDdDwbNMeTcUJM9+FAqeZ8onTPmUgheY8nkoeIkp/2HKVxS8zJRvQsG/mfJTKEjm+SUUvM6UP0LB
+G0zNYWouBW8ytZypSU7eZmoNUzej4F2mbhEK3mfqNqHgA6b+PRR8yNS9QsFHTD0gFHzM1H+Egk+Yenwo+JSpJ4WCz5h6aij4nKm1oeALpg4IBf9h6uBQ8CVT
+h4WCr5jaGAq+Zupopib/nn7D1NZQ8D+mTgoFPzJ1QSj4ialLQ8HPTO0KBb8wdW0o+JWp60PBb0zdGAp+Z+pZoeAPpl4QCv5k6pWh4C+mXhsKMfXGUJjC1DtD
+YSpT/xUK05j6EFMfpjCdqY8z9QkKM5n6bCjMZurzoTDp24uhMJepr4TCPKa+HgrzmfpWKCxgatLL9ygsZGrSm08pLGZqUtOvKSxj6g+hsJKpP4fCKqYm+/+d
+wmqm/hkKN2NaZijcnGkFoXALphWHwi2Ztnko/BvTdgqFWzFtl1C4NdN2Y9ruFG7DtL2YtjeF2zFtf6YdSuEOTDuMaYdTmDx7PNNOpHAPpp0aCvdmWr9QuA/T
+BoTCfZk2iGmDKdyPaUND4QFMGx4KD2TaCKY1UXgQ08aGwn8wbXwoTOafHAoPZ9q0UHgE02YwrZ3CI5k2h2lzKTyaaQtC4bFM62TaIgqPY9rSUHgC07pC4YlM
+Wx8KT2La6aHwZKadEwpPYdqFoTDZ98Wh8DSmXREKa5l2bSjsw7TrQ2Ffpt0WCuuYdmcoTGp2fyjsz7QHQ2E90x4OhQ1MezwUJnV9NhQOZNrzoTCp8b9D4WCm
+vR4KhzDtzVCY1P6dUDiMae8x7X0Kkz58HgqTPnzJtCSTI5n2X6Z9S2Ez035i2i8UtjDtd6b9QeE4pheGwglMLwuFE5leGQpbmV4TCtuYvjnTt6BwEtO3CoVT
+mL5DKJzK9J2ZviuF05i+RyhsZ/p+oXAm0w9g+oEUzmL64Uw/gsI5TD+W6cdROI/ptaFwAdP7hsIOpg8MhQuZ3hwKO5k+LhQuYnpbKFzM9CmhcAnTp4XCpUxv
+D4XLmJ6sYzaFy5k+l+nzKFzJ9M5Q2MX0paGwm+nJcyso7GH6qlC4hundoXAt008Phb1MPycUrmP6BaFwPdMvC4UbmH4l06+icCPTrwmFyRjXM/0GCs9g+k1M
+v5nCs5h+eyhMxr47FJ7L9PtD4XlMfzAUns/0J0NhMv8zofBCpj8fCi9i+juh8GKmvxcKL2H6F6HwUqZ/xfQkY8na/wyFVzAjLRReyYwMZmRSeBUzcpiRS+E1
+zCgIhf9kRnEovI4Zm4fC65mxbSi8gRk7MOPvFN7IjJ1C4c3M2CUU3sKMPUPhrczYlxkHUHgbMw4JhXcx4x+h8G5mHBEK72HGUaHwXmY0hMJ/MWMwM4ZReB8z
+GpkxksKHmNHMjNEUPsqM8cyYQOHjzGgLhU8yYzIzkrw9xYzpofAZZswMhc8yoyMUPseMzlD4PDOWhMIXmNEdCl9kxpmh8CVmnBsKX2bG+cxIsvQKMy4Jhf9m
+xuXMSLL0GjNuCIVvMePeUPg2M+4Lhe8w48lQ+C4znguF7zHj5VD4PjNeDYUfMOONUPghM94KhR8x471Q+DEzkuc+ovATZvyfws+Z8XUo/IIZPzLjJwr/Q3t6
+KPyK9nzak5x8TXtJKPwv7RWh8Fvaq0Phd7RvFgq/p32LUPgD7VuFwv/Rvi3t21H4I+070J7k6mfad6J9Zwp/pX1X2pN/T3+nfS/a96XwL9r3D0VptB9I+0EU
+Jes9hPZDKcqk/bBQlE37caEoh/ZTaD+Volzaa2nvQ1Gyv7pQVEh7fSgqon0w7UMoKqZ9GO3DKSqlfWQoKqe9KRQl9WihfQxFlbRPoH0iRUltpoSipDbTaZ9B
+0ea0z6R9NkVb0r4wFG1N+3LaV1C0De1dtHdTtB3ta2hfS1FSs3WhaEfazwhFSf3Oov1sinam/bxQlNTyglC0G+0X0X4ZRbvTfk0o2of262i/nqJ9ab+J9psp
+Sup9ayhK6n0H7XdSdBDt99D+L4qS2j8YipLaPxKKDqf9MdqfpOgI2p8JRcfQ/mIoOpb2l2l/haKkV6/R/jpFJ9D+Zig6ifZ3QtHJtL8XipJ+fkD7hxSdSvvn
+tH9BUdLbJKM/UNSX9l9o/5WiBtr/YmYKRQOZmROKhjAzLxQNZWZBKBrGzLJQNJyZWzFzW4oambl9KGpi5t+ZuSNFzczcmZm7UtTCzP1C0ThmHhSKxjPzkFA0
+gZlHh6KJzDw+FLUy89RQ1MbMfqFoEjMHhKLJzBwUiqYwc0QomsrMUcxspmgaM1tCUTszx4eimcxsDUWzmDkpFM1mZvL+NIrmMHN2KJrHzLnM7KBoPjMXh6JF
+zFwaipJry5m5kqIlzOwORcm1NaFoBTN7mZnkdiUzN4SiLmaeHoqS984ORT3MvC4UrWbmDcy8kaJkvFtCUTLeXaFoHTPvC0XrmfkgMx+iKJnnEWY+StEmZj4e
+is5g5pOh6ExmPsPMJJNnMfPlUHQBM19l5msUXcjMN5n5FkWXMPMdZr5L0WXMfD8UXcHMD5n5MUVXMvPTUHQNM78IRdcy82tmfkPRP5n5XSi6npk/hKJkrz8z
+8xeKbmTm76HoZmb+GYpuYVZKKLqVWVmh6DZm5YSi25mVx6x8iu5g1lbM2pqiu5i1E7N2pugeZu0Ziv7FrAOYlWT1PmYdEooeZNY/QtFDzDo8FD3MrCOZdRRF
+jzCrTyh6jFlDmTWMoseZ1RiKnmTWKGYl+XyKWS3MSs7MZ5k1LhQ9z6wJzEry+QKz5oSil5m1IBS9wqyFoehVZi1l1jKK/s2sFaHodWZ1M6uHojeYtSYUvcWs
+dcxaT9HbzDqfWRdS9C6zLgtFHzDrilD0IbOuYtbVFH3ErGuZ9U+KPmHWDaHoM2bdHIo+Z9bdzLqXoi+YdV8o+opZj4Wir5n1Uij6hln/DkX/ZVay1iRj3zIr
+WUdyvv3ArI9D0Y/M+jQU/cSsz0PRz8z6Tyj6hVnJfEnGfmXW96HoD2Yl/qLoT2anMDuV4uQ3n9kFFKcxuzQUZzC7nNmVFGcye7NQnMPsLUNxLrO3YvbWFOcx
+ewdm70hxAbN3D8XFzN4rFJcwe59QnMyzXyguY/aBzD6I4mTOQ0JxJbMPZ/aRFFcx+5hQnKzluFC8ObNrQ/EWzO4XipM1NoTivzF7eChO1juR2a0Ub83sScye
+TPG2zJ4Wirdn9qxQnOxpfij+O7MXhuIdmb0oFO/E7BWheGdmrwrFuzC7h9lrKN6V2etC8R7M3hCK92T2plCc1OkMZp9J8d7MviAU78vsS0JxUrMrQvH+zL4q
+FB/A7BuYfSPFSS1vZvYtFB/M7LtC8aHMvicU/4PZ/wrFhzH7gVCc1PuRUHwEs59k9lMUH8ns50Px0cx+JRQnPXkjFB/L7E+Z/RnFSX++DMUnMPubUHwis38I
+xScx+6dQfDKzfw3FpzAnJRSfypzCUHwac2pCcS1z/h6K+zBnF+bsRnFf5hwSivsz5x+huJ45h4fiBuYcyZyjKB7AnOOZcxLFg5jTNxQPY07y3ECKhzNnSCge
+yZzk75EUj2JOUyhuYc7/KR7DnHGheCxz2kJxcm0Wc2ZTPJ45c0PxROYsDMWtzFkUipP3ljJnGcWTmLOaOWspnsKcy5mTZGA6c64Oxe3MuS4Uz2TODcxJ8pDM
+eXMonsOcO5lzN8XJ/PcyJ+n7AuY8FIoXM+fRULyEOY8z5wmKk7U8FYqXM+eZULyCOS8w50WKVzLntVDcxZx3Q3E3cz5kzkcU9zDnE+Z8SvEa5nweinuZ8w1z
+/kvxOub8HIo3MOe3ULyROX+E4k3MFYpPZ25GKD6Dudmh+Ezm5oXis5hbwNwkS2cztzwUn8vcqlB8HnM3C8XnM3eLUHwBc//G3OSsu5C5e4TiS5i7N3P3pfhS
+5h4Yiq9g7qHMPYziK5l7BHOTc+wa5h4div/J3OND8XXMPYW5SfauZ+7AUHwrc0cyt5ni25g7PhTfxdyJofhu5s4IxfcwdyZzk7PrXubOYW6SsfuYuzAUP8Dc
+RaH4QeYuYe5Sih9i7spQ/AhzV4fiR5m7jrnJefUYc5O6J2fUk8xNancexc8yN6nRhRQ/z9zLQvGLzL2KuUk+X2Juss/rKX6FuXeF4n8z975Q/Bpzk3Uk58/r
+zH0sFL/F3GS+JHtvM/fVUPwuc18Pxe8x961Q/D5z32HuexR/wNwPQ/HHzP0/xZ8w94tQ/ClzvwrFnzH3m1D8OXN/DMXJs78wNzmj/sPcP0Jx8t5fofhr5mWG
+4m+Ylx2K/8u8vFD8LfNKQvF3zCsPxd8zryYU/8C8zUPx/5j3t1D8I/O2DsU/MW8H5iVn4M/M2ykU/8q83ULxb8zbIxT/zryDmZecjX8w7x+h+C/mHR9KMO9E
+5p1ESQrzTgklacw7LZSkM68P8/pRksG8+lCSw7wBoSSXeUNDSbLvkaEkn3ljQ0kB8yaFkkLmtYeSIubNCiXFzJsTSpKadYSSUuZ1hpIy5i0NJUktlzNvBSUV
+zOsOJVXMWx1Kqpm3NpQk9d7IvE2UbMa8M0LJFsw7L5RsybwLmXcRJUkfrmLe1ZQkffhnKNmWeTeGku2Yd0so2Z55d4SSpFf3M+8BSv7OvIdDSdK3R5n3GCU7
+M++JULIr854OJUk/XwoluzPv9VCS9PbNULIn895j3vuU7MW8j0LJPsz7JJTsy7zPQ8l+zPuKeV9Tsj/zvg0lBzLvp1ByEPP+DCUHMz8rlBzC/HzmF1ByKPNL
+QslhzP9bKDmc+duEkiOYv10oOZL5O4aSo5i/C/N3peRo5h/I/IMoOZb5yVhHUnI8848JJScz/1TmJzk8hfl9Q8lpzB/B/CRjtcxvZn4LJcn91lDSn/mTQkk9
+86cxfzolDcyfF0oGMn8B85OMDWL+IuYvpmQI81eFkmHM7w4lw5m/OpQ0Mn99KEnWsimUjGT+Gcw/k5JRzD8nlCRrvCCUjGb+pcy/jJIW5l8RSsYy/+pQMo75
+14WS8cy/MZRMYP7NoWQi828NJcm+7wwlbcy/l/n/oiSpwZOhZArznw0lU5n/CvNfpSSpzeuhZAbz32L+e5S0M/+DUDKX+Z+GkqR+3zD/v5TMZ/7PoaSD+b+F
+koXM/zOUdLJAKFnEgtRQspgF6SxIMraEBTksyKNkBQsKQkkXC4pDSTcLyllQQUkPC6pYsAUla1iwNQu2pWQjC3YMJWewYFcW7EbJmSzYgwX7UnI2Cw5mwSGU
+XMiCf4SSi1lwZCi5hAVHs+BYSi5lwfGh5AoWnBpKrmRB31ByFQsaQsnVLBgaSq5hQWMouZYFo1jQRMk/WTAmlFzPgnGh5AYWTAolN7JgCguS3N7EgnYWzKTk
+NhbMCSV3sGBRKLmTBWtDyV0sWBdK7mbBhlByDwvOYsHZlNzLgnNDyX0sOD+U3M+CC0PJAyy4OJQ8yIJkD8kZ+BALkrXeRMkjLLiFBUkOH2fBHaHkSRYk67ib
+kqdYkMx3HyXPsOCxUPI8C5LnnqLkBRY8F0peYkHy94uUvMyC11jwBiWvsuBtFrxDyesseC+UvMmCD0PJWyz4jAXJuZc8+2UoeZcF34SS5L3vQsn7LPghlHzA
+gl9CSTLeb6HkIzpSQsnHdKSHkk/oyKYjl5JP6Simo4SSL+jYPJR8SceWdCTn5Fd0bBdKvqHj76Hkv3TsGkq+pWMPOvak5Ds69qZjH0p+oGM/Ovan5Ec6Dgwl
+P9NxcCj5hY7D6DiCkl/pOCqU/EHHMaHkTzqOoyPJ8190nBhKk/2dTMcplKbScVooTfbaL5Rm0FFPRwOlmXQMpmMIpUkNGkNpLh2jQmkeHePpmEhpPh1tobSI
+jimhNKnZ9FBaQsecUFpKx3w6FlBaRsfiUFpBR08oraRjbSitomMDHRsprabj9FC6GR3nhtKkJxeG0i3ouDiUJv25NJT+jY7L6biC0q3ouJqOayndho7rQ+n2
+dNxIx82U7kDHrXTcRelOdNwbSpMe3hdK96TjIToep3QvOp4MpQfQ8Qwdz1Ga9PMFOl6i9BA6XgmlST9fo+MNSg+n4y063qU06e0HdHxIadLPJJefUnoCHZ/T
+8QWlST+/CqWn0vHfUJr09ns6/kdpLR0/0fEzpXV0/E7HH5T2p+MvFqK0gYXpLMykdCALc0LpEBYWhtKhLCxjYSWlw1hYHUpHsnCzUDqKhVuG0iYWbh1Km1m4
+Ewt3pnQ0C/cIpWNYuF8oHcvCA0LpOBYeFErHs/CQUDqBhYeF0oksPDaUtrLwxFDaxsJTQ+kkFvZlYR2lk1lYz8Ik21NZODCUTmfhkFA6g4UjQmk7C5tC6UwW
+JutIcj6LhZNC6VwWTgml81g4LZTOZ2F7KF3AwlmhtIOFc0LpQhYuC6WdLFwZShexsIuFSf4Xs3BNKE2e3RhKl7Pw7FC6goXnh9JkjItC6SoWXhpKk/GuZOFV
+lHaz8PpQupqFN4XSZJ7bQulaFt7Bwjsp7WXhPSxMMr+ehfeF0mQtD4TSTSx8JJSezsKnQ+kZLHw2lJ7JwhdD6VksfJmFSf6TfbwWSs9l4bssfJ/S81j4eSi9
+kIX/CaXJ/r4OpRez8L8s/JbSS1j4fSi9jIW/hNLLWfgbC3+n9Ao60ZlC6VV0ptGZZP4aOvPoLKD0ejqL6Syl9CY6K0LprXRW0VlD6W10bhFK76Tz76H0Ljp3
+CqV307lLKL2Hzn1D6b10HhBK/0XnQaH0PjoPDaX303lYKH2AziNC6YN0Hh1KH6Lz+FD6MJ0n05lk/hE6+4XSx+msD6VP0DkglD5J56BQ+hSdQ0Lp03Q2htJn
+6BwVSp+lc0IofY7OyXTOoPR5OmfSmWT7VToX0LmQ0tfoXELnCkrfpHNVKH2Pzm46k2y/T+eGUPohnaeH0o/oPDOUfkzn2XSeQ+kndJ4XSj+j80I6k7P9czov
+pfMySr+kM+nrNZR+Ted1dCY5/5bOG+lMcv49nbeE0v/ReVco/ZHOpB9Jnn+iM6nvw5T+RuejofQPOp8OpX/S+Wwo/YvOV0MZOt+g8x3KUuh8P5Rl0pns7WPK
+suj8KpTl0PkNnd9Slktnsr4fKCug88dQVkTnz6EsyeavoayEzt/p/IOyUhalhrJyFmWwKJeyChYVsaiEss1ZVBbK/saiylC2FYs2C2Vbs2g7Fm1P2TYs2jmU
+Jdd2DWXbs2hPFu1N2Q4s2jeU7cSig1h0MGXJe4eHsuS940PZbiw6mUWnUrY7i2pD2V4s6suiOsr2ZlF/FjVQlozdwqIxlB3AoomhLJlnUig7mEVTWDSVskNY
+NJ1FMyj7B4tms2geZclaOljUSdnRLFocyo5j0cpQlqyxK5SdwKK1oexEFp0Zyk5i0dksOo+yZB8XhrJkH1ey6CrK+rDo5lBWx6K7Qlk/Ft3LovspS/b3IIse
+omwgix4NZYNZ9EQoG8KiZ0LZUBY9F8qGseilUDacRa+FskYWvRHKRrDo3VA2kkUfsOhDykax6ONQ1syiT1n0GWWjWfRFKBvDoi9ZlORzLIu+Z1GSyfEs+pVF
+v1OW9OHPUDaJxULZZBanhrIpLM5gcZLDqSzOZ3EhZbNYXBzK5rK4NJTNY3F5KJvP4ioWV1O2gMWbs3gLyhay+G8s3oqyRSzeNpQtYfFOoWwpi3dh8W6ULWPx
+XixOsrqSxfuz+EDKulh8cChbzeITQtkaFp8SytayeBCLB1PWy+IxoWw9i5M9T6dsA4tnsngWZWexeG4oO4fFHaHsXBYvDmXnsbg7lJ3P4tWh7AIWrw9lF7L4
+rFB2EYuT95O8XcziC0LZpSy+JJRdxuLE5ZRdzuJrWfxPyq5k8fUsvpGyq1l8cyj7J4tvZ/GdlF3H4rtD2Y0svpfF/6LsJhY/EspuYfFjoexWFj8Tym5j8XOh
+LBnvBRa/TNkdLP53KLuHxW+y+C3KkrHfYXGSyftY/GEoe4DFH7P4U8oeZPF/Qlky/zeh7FEWfxvKkrX8EsoeZ0kKSzIpe4IlOaHsOZYUhrLnWVIVyl5gyRah
+7EWW7MiSJDMvsWRXliSZeYUle7NkH8r+zZL9WHIAZa+z5CCWJJl5iyVHhrJ3WHJMKHuXJceHsvdYchJLTqbsfZb0YUlfyj5kSb9Q9jFL6kPZJywZFMo+ZcmQ
+UPYZS0aFss9Z0syS0ZR9wZKxoexLlkwMZV+xZHIo+5ols1gym7JvWDKPJfMp+5Yly0PZ9yxZxZLk3PuBJWtC2Y8sWc+SDZT9xJLTQ9kvLDkzlP3KkvND2W8s
+uTCU/c6Si0PZHyy5IpT9yZJrQtlfLLmRJTdRjiW3h/JUltwZytNYcm8oT2fJA6E8gyWPhPJMljwWyrNY8mQoz2bJ06E8ycVzoTyXJUm/X6Y8jyWvhvIClrwV
+ypPsJL18j/IilnweyktYktTxO8pLWfJDKK9kyc+hPMnaH6G8mqVpLE2nvIalWaF8c5bmsrSQ8i1YumUo34al27N0B8q3ZemOLN2Z8uTabqE8ubZ3KN+JpfuH
+8p1ZelAo34Wl/2DpYZTvytLjQ/nuLD0plO/B0tpQvidLB4byvVg6NJQn84wK5fuwtDmU78vSMaF8P5aOD+XJWiaytI3yA1g6NZQfzNL2UH4IS2eF8kNZOjeU
+J+tdHMoPY2l3KD+cpb2h/AiWbgzlR7L0rFB+FEvPDeVHs/T8UH4MS28K5cey9I5QfhxL7wnlSW3uY+n9lJ/A0odDeVKnR0P5ySx9gqVPUX4KS18I5Un9Xgrl
+fVj6aijvy9K3Q3kdS98N5f1Y+mUo78/Sr0N5PUv/YhnKG1iWGcoHsqwklA9iWRnLyikfzLKqUD6UZZuF8mEs2yaUD2fZdqG8kWU7sOzvlI9g2a4s253yUSzb
+P5SPZtmBobyFZf9gWZKfMSw7JpSPY9mJLDuZ8vEsqw3lrSwbGMrbWDY4lE9iWWMon8yy5P0JlE9hWVson86yGaE8+e0I5e0sWxrKZ7JsRSifxbKuUD6bZetC
++RyWbQzlc1l2Riifx7KzQvl8lp3DsiQnC1h2eShfyLIrWXYt5Z0su45lN1C+jGV3suwuyley7IFQnsz/RCjvZtkzobyHZc+x7HnKV7PslVC+lmVvh/Jeln3E
+so8pT9b+Ocu+oHwDy34K5ZtY9gvLfqP8dJZnsjw5N85ieS7L8yg/h+Ulofw8lleF8vNZvlkov4Dlu4fyC1l+UCi/iOVHhPKLWV7L8jrKL2F5PcsbKL+C5UNY
+Pozyq1jeyPIRlF/L8rGh/DqWTwzl17N8aii/geVzWD6P8htZvoDlSU9vYXkny5Nv/DaWd4XyO1neE8rvYvmGUH43yzeF8ntYfm4ov5flyVovpfxfLE/WdzXl
+97P8+lD+IMtvDOUPsfxWlt9G+cMsvz+UP8ryh0P5YyxP/k7+HXmc5U+wPPnen2T5Myx/lvLk9/lQ/hzLXw7lybXXQ/kLLH8zlL/I8ndYnnz7L7H8o1D+Csu/
+Ynny7b/K8v+G8tdY/jPLf6E8Ge+3UJ6M92cof4sVQvnbrEhlRRrl77AikxXZlL/HitxQ/iErSllRRvlHrKgI5Z+woooV1ZR/yootQvnnrNiGFdtS/gUrdgjl
+X7Ji51D+FSv2C+Vfs+LAUP4NKw4N5f9lxRGh/FtWnBDKv2PFSaH8e1acwopTKf+BFbWh/EdW9A/lP7GiIZT/zIqBofwXVgwO5b+yYmQo/40VY0L576wYF8r/
+YMWMUP4nK2aG8r9YMTtUYMXCUJHCisWsWEJFUuPVoSKdFeez4gIqMlhxcajIYsU1oSKbFTeHihxW3MWKu6lIenJ/qMhnxaOhooAVj4eKQlY8FSqKWPECK16k
+opgV74WKpJ9fhooyVnwTKspZ8V2oSPr8S6ioZMVvoSLp+Z+hopqVGaGihpUFoWIzVpazsoKKzVm5VajYkpXbsHI7Kv7Gyr1DRXLtgFCxLSsPDhXbsfLQULE9
+K48MFTuw8jhWHk/F31l5KitPo2InVvZnZT0Vu7ByYKjYjZWDQ8XurBwaKvZg5fBQsScrR7GymYq9WNkSKvZl5bhQsR8rJ7KylYr9WTklVBzIylmh4iBWzgkV
+yT4WhIpDWLkwVCR7WsTKxVT8g5VLQ8XhrFwZKo5gZXeoSPa9OlQcxcr1oeJoVp4eKo5h5bmh4lhWnh8qkjpdEyqOZ+U/Q8UJrLwxVJzIyltCxUmsvD1UnMzK
+B1j5IBWnsPKRUHEaK58MFbWsfC5U9GHli6GiLytfZuUrVNSx8jVWvk5F0p+3WPk2FQ2sfDdUJL16P1QMYuVHoSLp22ehYggrvwkVSQ+/Z+UPVAxj5c+sTLLa
+yMo/WJnkcySrUkNFE6uyQkUzq3JDxWhWFYeKFlaVsqqMijGsqggV41i1WagYz6ptQsUEVu0QKiayahdW7UpFK6v2CRWTWHVAqJjMqoNCxRRWHRoqprLqsFAx
+jVXHhorprBoQKmawqpFVI6loZ9XoUDGbVRNCxRxWtYaKuayaHCrmsWpaqJjPqoWhYgGrFoWKDlYtCRXJ/eWsWkFFJ6u6Q8ViVq0NFcmzG0LFUladzqozqFjG
+qrNDxQpWnRsqVrLqclZdQcUqVl0dKpKxrw0VPay6iVXJubeaVXeFimTOe0NFL6vuZ1WSyXWsephVyRm4kVWPs+oJKs5g1Yuh4ixWvRwqknV9GCrOYdUnoSJZ
+4w+h4jxW/RwqzmfV76HiArqEigvpSqUrjYqL6MoOFZfQlRsqLqUrP1RcRlchXSVUXE5XWai4mq4KuqqpuIauzUPF9XRtGSpuoGurUHEjXdvSlZyfN9G1Q6i4
+ha5dQsWtdO0eKm6ja+9QcTtd+9K1HxV30HVAqLiLrkPo+gcVd9N1eKj4F11HhYr76Do+VNxPV1+66qh4gK56upJ8PkTX0FDxKF2NoeIxukaGisfpaqIrOWOf
+oGtMqHiKrnF0jafiabom0jWJimfpmkLXdCpepGtOqHiVrnl0zafi33QtCxWv07UiVLxB1yq6uqh4k64eupLz82261oWKd+naECreo2tTqHifrjNDxQd0nR0q
+PqTr/FDxEV1JDi6i4mO6kt5fScWndF0dKr6i61q6kvP2a7puCBX/peumUPEtXbeFiu/oujNUfE/XPaHiB7ruCxX/o+vBUPEjXQ+Hip/oeixU/EzXE6HiF7qS
++j5Dxa90PRcq/qDrxVDxJ12vhoq/6Erq9RaV6PqErk+pTPL+n1CZTleyt6+pzKDr21CZRdf3oTL5JpL1/UxlDl2/hsrkm/g9VBbQ9WeoLKQb3alUFtGdHipL
+6c4NlWV0F4bKcrqLQ2UF3VWhspLumlCZXNs8VFbTvWWoTO5vTfc2VG5G906hcgu6d6V7NyqTZw8OlVvRfVSoTN47PlRuQ/dJoXJbumvp7kPldnQPonsIlTvQ
+PSxUJvOMCJU70z0mVO5C90S6W6lM5p9M9xQqd6d7Wqjck+4ZoXIvumeFyr3pXhAq96G7M1TuS/eSULkf3V2hcn+6e0PlAXSvp3sDlQfSfXqoTPZ9dqg8hO5z
+Q+WhdF9E92VU/oPuK+m+isqkNteEymPo/ifdN1B5LN030X0zlSfSfVuoPJnuO+i+i8pT6L6H7geoTOr3MN2PUllP9+N0P0nlQLqfpvsZKofQ/UKoTOr6Sqgc
+Tve/Q2Uj3W/Q/SaVSb3fpvsdKkfR/T7dH1LZTPfHdCfZTvrwH7q/onI83d+Eyla6v6X7Oyrb6P6B7v9RmfTnJ7qTbE+l+1e6f6NyOt1/0J3kuZ2elFA5i560
+UDmbngx6MqmcQ092qJxHTy49eVTOp6eAniTnHfQUh8pOespC5SJ6qkLlYno2C5VL6NkiVC6lZ1t6tqdyGT1/D5Ur6dmJnp2pXEXPbvTsTmU3PXvRszeVq+nZ
+L1SupefAUNlLzxGhch09R4XK9fQcHyo30HMiPSdTuZGeU0PlGfTUhsoz6ekbKs+ip56eBirPpmdIqDyXnlH0NFF5Hj0T6Gmj8gJ6pofKS+hJ6jiXykvpWRwq
+L6cn2VuS+Svo6aFnNZVX0bMhVF5DTzLu+VReS8+F9FxM5XX0JO9cS+WN9FxPT5Ln2+m5iZ5bqLyTntvouYPKe+i5m557qbyPnsQjVD5Iz2P0PE7lE/Q8GSqf
+oufZUPk0PS/Q8xKVz9DzJj3J2fs8Pe+EyhfpeY+ej6h8iZ5PQuVr9HwRKl+n50t6kgy/Qc8PofIten4OlW/T8ys9f1D5DqszQuUHrM4OlR+yOpfVSQ4/YnVB
+qPyE1UWh8lNWl4XKz1hdyeokh5+zevNQ+R9Wb8nqraj8ktXbsDrJ1Tes3o/V+1OF1Qey+hCqUln9j1CVyerDWX0EVVmsPorVx1KVw+qTWX0qVcm6alndl6pi
+VteHqmRdA0JVOasHhaoKVg9h9VCqkvU2hqpqVo9k9Wiqalg9htVjqfobqyeGqq1Z3Raqkn1MCVXbsno6q2dRtR2rF4WqnVi9LFTtzOouVndTtQurN4Wq3Vh9
+VqjandUXhKo9WH1JqNqT1VeGqr1YfTOrb6Fqb1bfFar2ZfW/QlVS14dC1f6sfjRUHcDqp1j9NFVJvZ8NVQez+oVQdQir32D1m1Qdyuq3Q9VhrH43VCU9eT9U
+HcHqj0LVkaz+JFQlvfosVB3N6i9C1TGs/iZUHcvq70PVcaz+X6g6ntW/hqoTWP17qDqRNbmh6iTW5Ieqk1lTxJpiqk5hzZas2Yqq01izDWu2paova3Zmza5U
+9WPN3qGqgTX7sSbJ4QDWHByqBrHm6FA1mDXHhqohrEnWcQpVQ1nTEKpGsGZwqBrJmhGhahRrmlmT5KqJNWNC1WjWtLImyVILa6aFqrGsmRGqxrFmZqgaz5q5
+oWoCa+aHqomsWRiqkvEWsWYxVW2sWRaqJrNmRaiawppVrElyOJU1q0NVMue6UNXOmg2hKpn/olA1izVXs+Zaqmaz5vpQNY81N4WqZF13haoFrLk/VHWw5knW
+PENVst7nQtUS1rwYqpay5uVQlaz9zVC1nDXvhqpkHx+EqpWs+TlUJXv6jTV/UtXF2rRQtYa1OaxNMrOWtaWsraBqHWs3Z22Sk02s3YG1f6fqbNbuzNpdqDqX
+tXuGqvNZu2+ouoC1B4WqC1l7aKi6iLWHszY5ry5m7dGh6lLWHsva46i6jLUnhaorWHsaa2upupK19aHqataOCFXXsHZUqLqWtWNYO56qf7K2NVTdyNpJrE3O
+optYOyNU3craeaHqNtYuCFW3s3ZhqLqDtUtC1Z2sXcba5VTdxdqVrO2i6h7W9rI2ycZ9rN0Qqh5gbVK7M6h6kLVnhapHWHsOa8+l6lHWJrVLzrTHWXtJqHqS
+tZeHqqdYm+z/KqqeZu01oepZ1ib7THL1HGuT9d1H1SusTeZ+kKq3WPswax+n6h3WPhuqPmTt86xNzrSPWPtyqPqEta+x9nWqPmXtm6xNcvU5az8IVV+zNnkn
+Oce+Ye1nrP0PVd+x9qtQ9SNr/xuqfmLtD6HqZ9Ymfydn1y+s/SNU/UEvepNM/klvBr2ZVKfSm0NvLtXp9BaE6kx6i+gtpjqL3lJ6q6hOnq2hd3Oqk/tb0rs1
+1cn9bendjupKevcI1dX07k3vPlQn7+1P7wFUb07vQaE6GeNQev9B9d/oPTxUb03vUfQeTfU29B4Xqrej94RQvT29J9N7CtU70HsavXVU70hvf3obqN6N3oH0
+DqZ6T3qH0ttI9T70jqS3meoD6G0J1YfQO47e8VQn62qjdxLVh9E7LVQfQe8MetupPpLeWfTOpvpoeueG6mPpnU/vAqqTtS+kdynVydpX0LuS6mS9XfR2U92H
+3rX0rqO6jt4Nobqe3k2huoHei+m9hOoB9F4eqgfRe3WoHkzvdfReT/UQem8M1cPovZXe26geTu8d9N5J9Qh67w7Vo+h9IFQ30ftQqG6m98lQPZreZ0N1UrMX
+QvUYet8O1WPpfZ/eD6hOavkpvZ9RPYHer0J1K70/0Ps/qpMa/0Tvz1RPpjfJ659UT2VdaqiezrqcUD2DdaWhup11VayroXom6/4WquewbsdQPZd1u4fqeazb
+i3V7Uz2fdfuF6g7WHRyqF7LuRNbVUt3Jun6hegXr6kP1StYNDNWrWDckVHexbjjrkox1s66JdUnGVrOuJVSvZd141k2gupd1raF6PesmheoNrJsaqjeyLtlz
+krFNrFsYqs9m3ZJQfQ7rVoTqc1m3KlSfx7ruUH0+61aH6gtY1xuqL2Td+lB9EevOCNUXsy6Z41yqL2Hd+aH6ctYlf19K9RWsu4Z111J9FeuuC9XJtRtD9bWs
+uyVU/5N1d4Tq5Nm7QvX1rLufdUn2bmDdI6xLcnUT6/7Nutepvo91b7EuydiDrPswVD/Muk9Y9ynVyXufh+rHWPdVqH6cdf8L1U+wPitUP8n6nFD9FOsLWF9I
+9dOsL2Z9CdXPsr6c9RVUP8/6v4XqF1m/Xah+ifX7heqXWX8g65MsvcL6w1h/JNWvsf5Y1ifn2FusPylUv8P6U0L1u6w/LVS/x/q6UP0+6weG6g9YP4T1Q6n+
+kPVNofpj1o8O1Z+wfkyo/pT1U0P1Z6yfEao/Z/3sUP0F6+exfj7V/2F9R6j+ivWdofpr1i8J1d+wflmo/i/r14Xqb1m/IVR/x/rTQ/X3rD8zVP/A+rND9f9Y
+f26o/pH1V4bqn1h/Lev/SfXPrL+J9TdT/Svrbw/Vv7P+btbfQ/UfrH8gVP/F+kdCDdY/FmpSWP9iqEll/UehJo31X4SadNZ/FWoyWP/fUJPJ+mQ/31OTZOfn
+UJNk5/dQk8uG9FCTx4bMUJPPhuJQU8CGilBTyIZqNmxGTREbtmbDttSUsuHvoSZ5dqdQU8mGXdmwGzVVbNiDDXtSU8OGfdiwLzWbs+GAULMlG05mwynU/I0N
+p4WaZJ76ULMNG4awYSg127KhJdRsz4bWULMDG6aEmmRd7aFmRzZ0hJpkjYtDzc5s6Ao1u7ChJ9Qka98YanZjw+lsOJOa3dlwdqjZiw0Xh5q92XBFqEn2d22o
+2ZcN14Wa/dhwQ6jZnw03h5qkBg+FmgPZ8HioOYgNz4Sag9nwQqg5hA2vsuE1ag5lw1tseJuaw9nwbqg5kg3vs+EDao5iw0eh5hg2fBZqjmXDH6HmODamhZrj
+2VjNxhpqTmDjrqHmJDbuEWpOZuM+bEz6eAob9w81p7HxoFBTy8ZDQk0fNh4bavqysY6N/alJfkeGmno2NrNxNDUNbJwQagaycUqoGcTGaaFmMBtnhZohbJzP
+xoXUDGXjSjauomYEG9eEmlFsXM/GDdQ0sfGsUDOajeexMeljCxsvY+NV1Exk43WhJpn/xlAzlY03h5pkLbeGmulsvDvUzGDjv0JNOxvvZ2PS35lsfISNj1Iz
+l41vsTHpXbL290NNBxs/DDUL2fglG5NvvJON/2Xjd9QsZuOPoWYZG38ONcvZ+GuoWcHGP0PNSjZhUwo1q9iUzqYMarrZlB1qVrMpj0351KxhUzGbSqjpZVNV
+qFnPpho2JefABjZtE2o2sWk7NiXnwOls2inUnM2m3dm0FzXnsOngUHMBmw4NNRey6Ug2HUXNRWw6IdRcwqaTQ82lbDot1FzGpr5sqqPmcjYNCzVXsqk51FzF
+prZQczWbpoSaa9g0k01JZq5l00I2dVJzHZsWs2kJNTewaVWouYlN3aHmZjYldVlLzS1sWsem5Hy4jU1nhpq72JTs4TJq7mZTsqYkS/ez6Z+h5kE23cimm6h5
+iE13h5pH2PQom5JvP/l9kk1PU/MEm54PNU+z6WU2vUrNM2x6LdQk998KNS+w6X02Jd/+i2z6LNQk730Ral5h01dsSv69eJVN34WaN9j0Q6h5k00/sek3apKx
+/wg17/t/rP0JnKRVdf+Pv58qxgEFoRhaoKu36qpyUEEam3ZAQK+CCgqKERUjKiruGNGgosF4NbijYmJmYxj0G01QZ1+YfbgzzAqzrxg1cY0YMWKCW5yu+b/u
+Pffe5z5V1QP5vf498+mlurrq7vcsn3MO04HpJTjzJzB9kuDMn8P0EwRn/gdMf6rgzF/A9FNgul1LD8P00wRn/idM7xOc+SuYPig48xGYXhOc+WuY/kzBmf8F
+088WnPkbmP5swZmPwvTzYLq9R34L0y8SnPkHmP4CmK7gzD/C9BcJzvxfmH4ZTL8czvwzTH85TL8KeoHpVwt6yzD9NYLe42D6tYJe29c3CHqfBNPfCNPfBL2T
+YfpbYPrbodeOwbtg+nug147BjYLeCky/SdB7Kkz/IEy/BXqnwPSPCXp7YfrHBb1VmH4bTP8M9Npx+pyg147TF2D6F6F3CKZ/CabfAb3DMP1rMP0foffpMH0m
+TJ8FvWfB9Dth+hzotWP5dUGvHct/EvSeA9P/BabfA712XL8j6B2B6YsFvXaMlwl6nwPT74Xpq6B3FKavgelroXcaTF8v6L0QphuYvgF6nwfTN8H0rdB7MUzf
+DtN3Qq+C6bsFvS+G6Xth+j7ofQlMPwjTD0Hv5TD9e4Lel8H078P0H0Cvnbd/E/ReBdPtWvwp9L4Cptt19ivotfNp18qj0HsNTP9vQe9rYfrvYfofoPd1MP1P
+gt7Xw/Q/C3r/Eqa3BL1vgBkIeq+DGccJet8IM54EMyZD75tgxgkw48nQez3MOAlmnAy9b4UZFUHv22HGFEHvO2DG0wS974QZfYLed8GMAUHvu2FGQ9D7Hpgx
+VdD7XpjxTEHvjTBjRND7PpjxHEHvX8GMC2DGhdD7fphxCcx4PvR+AGa8SND71zDjMkHvzTDjckHvh2DGXwh6PwwzXivo/QjMeL2g9xaYYcflOuj9KMx4k6D3
+b2CG7f9bofdWmPEOQe8nYIbt27uhV8OM9wl6PwUzbDtuht6/gxkfFvR+GmbcKuj9DMy4DWZ8Gno/CzO+KOj9PMz4kqD3CzDjK4Je+/uZgt7bYcbXBb32uf8k
+6P0yzPhnQa/9u/mC3jtgxgZB71dhxv2C3r+HGVsEvf8AM7YLer8GMx6EGTug9x9hxn5B7wyY8QNBr23Lf8CMX0DvLJjxS0HvnTDj1zDjv6B3Dsz4H0HvXJjx
+O5jxe+i9G2b8SdD7DZjxZ0Hv/4MZRwW9/wQzM5hZht5vwsxJMPNJ0PsvMPN4Qe+3YeaTBb3fgZknCnq/CzNPgZl2Tc6DmafDzDOgdxHM7BX0LoGZfYLepTCz
+JuhdBjMbgt7lMPNZMPMc6L0XZo4IelfBzPMFvath5jSYadfkGph5Ecy8GHrXw8yXCHoNzLwSZr4SejfAzFfDTHsOb4aZ1wp6t8HMNwh6t8PMNwl6H4CZbxf0
+Pggz3yno3QEz3yvo3Qkz3w8zPwC9u2Dmh2DmR6B3L8z8KMz8G+g9ADM/DjM/Ab2HYeYnYeanoPdfYeZtMNOewz+AmV8Q9P4YZt4BM78KvT+Bmf8g6P0ZzJwO
+M+06/DnMnAMz74Leh2Hm1wW9/wkzvyno/RXMtHNmz+FHYOZCQe9/wczFgt7fwMylgt5HYea9gt7fwszVgt7/hplrBb3/AzM3CXofg5m7BL2/g5kPCXp/DzO/
+L+j9A8z8N5j579D7R5j5E0Hv/8LMXwh6/wwzfwkz/xN6j8DMXwt6WzDzN4LeozDzvwVVYOZjgqpdp/ZvWlAtwayyoDoJZnlUnwSzToBZT4bqZJh1oqBqHztF
+UH0yzDoVZk2B6lNg1hmC6kkwqxdmVaH6VJg1IKjav6sJqhWYdZagal/j2YLqFJg1JqieBrMugVkvgGoPzHqxoGrf5wpB9UyY9SqY9RdQte/5GkG1D2a9TlDt
+h1nXCaq2LW8SVAdh1tsF1SGY9T6Y9UGo2jZ+CGZ9GKpTYdbHBNVnwKxbBdVnwqxPCKrPglmfFFTPhlmfhlmfgeo5MOtzguq5MOuLguoIzPqKoHoezPp7QfU5
+MGu6oDoKs2YJqufDrG8JqnZs7hFUnwuz5sGsBVCdBrMWC6rPg1lLYdYyqF4EszYIqnYsN8GsLVB9PszaJqi+EGbtgVn7oPoimHVQULXj/T1B9SUw6/uC6kth
+1g8F1cth1r8LqnZOfiKovgxm/VpQfTnM+q2geiXMOiqoXgWzM0H1FTD7qYLqK2H2qTDbrqurYfbTBNW/gNlnCqqvhtlnCarXwOxnCaqvgdkjguprYfaYoPo6
+mD1NUL0WZj9fUH09zFaC6l/C7EsF1TfA7BcLqtfB7KsE1TfC7NcIqm+C2a8TVN8Ms+3fvwGq18PsNwuqb4XZHtW3wey3C6o3wOz3CKr2sRth9geg+g6Y/dcw
++2ao2sc+LKj+Fcz+qKD6fpj9NzDbrsObYPbfCqofhNmfElTta9wmqN4Msz8Dsz8L1Q/B7C/C7Nuh+hGY/RVB1b72VwXVj8HsGYKqfZ/ZguqtMPsbgurHYfY3
+BVX7/vcIqp+A2Qth9iKoapi9AmavhKpt11pB1bZrg6D6aZi9SVC1bdwtqH4WZu+F2Qeg+jmYfUhQvR1mPySofglm/1hQ/TLM/g9B1fbpPwXVO2D2r2H2b6Bq
++/dnQfVrcGdZUP1HuPNJgup0uPMkQXUG3NkrqM6EO4fhzjpUZ8GdTUH1TrhzKtxp1+EcuPM8uPM5UJ0Ld14sqH4d7nwh3PkiqH4D7rwM7rTr6p/gzqvgzldC
+9Vtw56sE1XvgzmsE1W/DndcKqt+BO/9SUP0u3Pl+QXUe3PkRQXU+3PllQXUB3PlVuNOeLQvhTvv334XqYrjTPs+eG0vhTiOoLoc7t8GdD0D1XrjzkKC6Cu78
+vqC6Gu78Bdz5MFTXwJ2/E1TXwZ3jgup6mHOSoHofzDlFUDUwZ4qgugHmNAXVjTDnHEH1fphzrqC6CeacJ6huhjkXCqpbYM5FgupWmPN8QXUbzLkc5th7YTvM
++QtB9UGYc42gugPmvBbm2D27E+a8WVDdDXM+AnNugeoemHOroLoP5nxSUN0Pc74kqB6AOXME1YMw55uC6iGYs1JQPQxz7hdUH4I5hwTV78GcfxNU/xXm/FxQ
+/T7M+aWg+gOY8z8wx97XP4Q5vxdU/x3uKguqP4K7ngR3TYbqj+GuEwTVn8JdTxVUfwZ3nSqo/hzu6hFU/wPuqgqqv4C76oLqw3DX0wXVX8JdZwmq/wl3PQvu
+Ohuqv4K7LhJUfw13vUBQ/S+461WC6m/gruvgrjdC9VG463q46y1Q/W+46z2C6mNw142C6u/grg8Kqr+Huz4vqP4B7vqKoPpHuGuhoPonuGuZoPq/cNdKQfXP
+cNdGQfUI3LVfUB2Huw4Lqi246+eC6lG4678FfcBd9nX/F/oymNsLc6vQV4a5AzB3EPomwdyaoG8yzK0L+o6HuU2Y+3ToOwHmniPoewrMPVfQdyLMHRX0nQRz
+xwR9T4W5lwj6Toa5LxD0nQJzLxX0VWDuiwV9p8Lclwn6psDcVwr6ToO5Nwj6emDuTYK+p8HcDwn6Toe5t8Dcj0LfGTD3Vpj7ceizff2EoK8P5n5K0NcPc78o
+6LNj8Pcw9x+gbxDmzhD02fG4S9A3DHPvFvTZsfkWzP1n6GvA3G8L+p4Oc5fB3OXQNxXmroK5a6HvGTB3Pcy9D/rs+N0PczdBnx2/HTB3N/SdB3P3wtx90GfH
+74CgbxrMfQjmfg/6LoC534e5/wZ9z4O5PxL0PR/mPizos2P8G0GfgrmPCfpeCHP/IOh7Edw9WdB3Kdz9VEHfZXD3FEHfi+HuHkHfS+DuMwV9L4W7hwR9l8Pd
+Zwv6roC7zxX0vQzuPl/Q93K4+yJB35Vw9/MFfVfB3fZ5L4e+V8DdVwv67Ne/EPS9Cu6+TtBnH3uzoO/VcPdb4O63Qd81cPc74O53Qt/r4O73CPpeD3ffKOj7
+S7j7g4K+N8DdHxH02df+hKDvjXD3bYK+N8HdXxT02ff8kqDverj7DkGfff+vCfreCnfPgrtnQ9/b4O45gr63w913C/reAfOAeRn0vRPmHSfoezfMmwzzngx9
+74F5p8C806Hvr2DemTCvH/o+DPOGBH1/A/Magr5bYd7ZMM/ux4/DvHNh3nnQ9wmYdwHMuxD6PgXzLhb03QbzlKDv0zDv5YK+z8C8qwR9n4V51wj6PgfzrhX0
+fR7mXSfo+wLMuwnmfQD6vgjzbhb0fQnmfQTm3QJ9X4Z5HxP03QHzNMz7JPR9Feb9Hcz7DPT9A8yzr3s79M2AeXcI+u6EeV8T9M2BeRZ2D94F8+6Ged+APvv1
+Hpj3Hej7fzBvnqDvWzBvIcxbBH3/DPNWwrxV0GefuwbmrYO+78C8DYK++TBvk6BvAczbJuizr7FD0LcI5h0U9C2GeYcFfUtg3o9g3o+hbynM+5mgbznM+wXM
+s/vxXpj3n4I+25ZHBH2rYN5vYN5voW81zPsfQd86mPc7mPd76FsP847AvHHoMzAfQd9GmH+coO9+mP8kQd8mmH8izD8J+jbD/JMFfVthfkXQtw3mnyHo2w7z
++wR9D8D8IUHfgzD/GYK+HTD/bJhv19hOmH8+zLdn+26Yf4Ggby/Mv1jQtw/mXwbz7Xm+H+a/VNB3EOZfIeg7BPOvFvQdhvnXCPoegvnXCvq+B/PfIuj7V5j/
+NkHf92H+jTD/fdD3A5h/k6Dv32D+X8P8D0Pfv8P8v4H59vz/Kcz/hKDvP2D+J2G+Pf9/AfM/B/M/D32/hPm3C/p+BfPvEPQ9AvPnwHy79n4N878p6PsNzF8h
+6HsU5tu5sWvptzB/k6Dvf2D+NkHfYzB/D8zfC32/g/n7BX1/gPk/EPT9Eeb/SND3J5j/M0Hf/8J8+7s/Qd+fYf4RQd84zD8KC+x50oIFx8OCE6A/gwVPEfSX
+YcFJgv7jYMFpgv5JsGAEFpwH/U+CBecL+u1rTIMFF0D/CbDgeYJ++3oKFrwQ+k+EBZcK+p8KC14MC14C/SfDgpcJ+iuw4EpY8AroPxUWXC3o74EF1wj6nwYL
+XivoPx0WXAcL3gj9Z8CCN8OCt0B/Lyx4u6C/Hxa8Cxa8G/oHYMGNgv4hWHCToL8GC26BBR+F/mFY8Dew4OPQ34AFt8GCT0P/VFhwu6D/GbBgLSxYD/3PhAVG
+0H8OLNgk6H82LNgBC3ZC/7mwYDcs2Av958GCh2DB96Dfjt8PBP3PhQUPC/rtWP5K0H8BLPi1oP9CWPCYoN+O8R8F/RfBgiOC/oth4WRYeDz0XwILTxL0vwAW
+niLoV7DwbFh4DvS/EBaOCPovhYXPEfRfBgvPF/S/GBba170E+l8CC18g6L8cFtq/t3N6BSy0P18B/VfCwqsF/a+AhdfBQjtHr4SFN8BCOy+vgoUfFfS/GhZq
+Qf81sPA2Qf9rYOHnBP2vhYV3wMKvQv/rYOE/CvpfDwvnCPr/EhauhIWroP8NsHCtoP+NsNAI+t8EC7fCwu3Q/2ZY+KCg/62wcCcs3AX9b4OFBwT9b4eFPxD0
+vwMWPgwLfwn974SFj8LC30L/u2HhY4L+98LCPwr6b4RFwKIM+t8Hi44T9L8fFp0o6L8JFp0i6P8ALDpN0P9BWNQHi/qh/69h0VmC/g/Bostg0Yuh/8Ow6HJB
+/y2w6GWC/o/CoisF/R+DRa8Q9P8NLHo1LHoN9N8Ki14n6P8ELHq9oF/DojcK+j8Ji+zz7F74FCzSgv7bYJFH/6dh0e2w6EvQ/xlY9BVB/+dg0VcF/Z+HRTNh
+0Wzo/wIsmiPo/xIsuhsW/T/o/zIsugcWfRv6/x4WfRcWLYT+r8GixYL+WbBoKSxaDv2zYdFKQf9dsGitoH8uLNoEizZDv33trbDIzvU3YNEeWGT34Ddh0QFB
+/z/DoocE/f8Ci34k6Ldt+bmg/9uw+HhYbM/J78Dip8BiO3fzYPEpgv4FsPhUWDwF+hfC4jNg8ZnQvxgWV2GxncelsLgOi5vQfy8sPkvQvwoWny3oXw2Lz4fF
+Y9C/BhZPg8UXQv86WKxgsT1LDSy+TNC/ERZfLui/HxZfDYtfBf2bYPE1sNjO9RZYfK2gfxssvk7Qvx0WXy/ofwAW3wKL7X58EBbfKujfCYu1oH8XLP6UoH83
+LP4cLP489O+BxV8U9O+DxV8S9O+HxX8Pi78G/Qdg8UxB/2FYPEfQ/xAsvkfQ/z1YvEPQ/6+weI+g//uw+ICg/wew2OKH0P9DWPzvgn779WFYbPfmj2DxI4L+
+n8DiRwX9P4XFfxL0/wwW/1nQ/3NYPC7o/w9YchwsmQT9v4Alxwv6fwlLngJL7Lz/Jyw5DZb0QP8jsOR0Qf9/wZIzBf2/gSVDgv5HYcn5gv7fwpKLBf3/DUuu
+FfT/Dyy5TtD/GCy5XtD/O1jyLlhi76/fw5KbYcmHoP+PsORWQf//wpLbYIm9p/4MSz4v6B+HJXfAErsHW7Dka4IBYMlMWDIbBjJY8nVY8g0YsP3+J8HAk2DJ
+t2DJP8PAZFjyHcHACbBknmDgybBkkWDAjs0ywcCJsGQbLNkOAyfBkh2CgZNhyR7BwCmwZJ9goAJLHoIl34OBU2HJ9wUDdox/KBjogSU/EQw8DZb8DJb8BwzY
+sX9YMNALS34FS34DA1VYYsf0dzBg5+EPsOTPMFCHJUdhaQYDZ8HS4wQDz4KlxwsGzoalp8DSCgycA0unwNIeGDgXlvYJBp4DS6+Bpa+FgVFYeq1g4Lmw9A2w
+9DoYmAZL3wRL3wwDF8LSG2Dp22HgIlj6TsHAJbD03YKB58PS9woGXgBL/0owoGDpTbD0AzDwQlh6s2DgUlh6i2DgMliqYeknYeDFsPTvYOmnYeClsPR2wcDL
+YOkdgoGXw9KvCQauhKVfh6V2DVwFS+8RDLwSls4TDFwNSzfB0s0w8CpYuk0w8GpY+iAstfNrx2OXYOC1sHSPYOB1sPQALD0IA3acDgsG/hKWfk8wYMfs+4KB
+62DpDwUDb4SlPxIM2LH8mWDgzbD0YcHA9bD0iGDgLbDsFMHAW2HZGYKBt8GyPsHADbBsSDDwdlh2Fix7Bgy8A5Y9SzDwLlh2vmDg3bDsalj2Khh4Dyy7RjBw
+Iyy7VjDwPlh2PSx7Cwz8FSx7m2DgJlj2dsHAB2DZjYKBD8IyLRj4a1j2OcHAzbDsLsHAh2DZNwUDH4Zl9wgGPgLL5gkGboFlywUDH4VlKwQDH4NlqwQDfwPL
+jGDgVli2STDwcVi2TTDwt7Bsj2DgE7DsoGDAtvEHsMzOxSdh2Y8EA38Hy34Ky+xc3AbL/kMw8BlY9ggs+zUMfBaW/UYw8HlY9lvBwBdg2R8FA1+E5afBcru/
+boflZwgGvgzLq7DcztdXYHkDljdh4KuwfCosfyYM/AMsH4Hl58HADFh+vmBgFiy/QDAwG5YrWP5CGLgTll8Ky18MA3fB8pfD8qtg4Ouw/GrBwD/B8msEA9+E
+5dfB8jfCwLdg+ZthuZ3ff4Hl9m/sfvkOLP+mYGAeLL9HMDAfltvffRcGFsDyRbB8MQzYr0thuZ2vJbB8DSxfBwPLYbkRDKyE5ZsEA6tg+Q5YvhMGVsPy3bB8
+LwysheUPCQbug+WPCAYM3HsK3GvPrg1w72mCgfvh3jMEA5vg3qZgYDPce7ZgYAvce75gYCvce7lgYBvce4NgYDvc+y7BwANw7xy4167VB+HerwsGdsK93xQM
+7IJ7vwv32rW6G+5dLBjYC/ceEAzsg3sfFQzsh3sfEwzY3/9RMHAQVgAr7Ll9CFZMEgw8BCvOghV2/34PVpwtGPg+rDgXVozAwA9gxQWw4kIY+DdYcZFg4Eew
+4jLBwI9hxeWCgZ/AiisFAz+FFdfAitfAwM9gxfWCgf+AFTcIBn4BK94lGHgYVtwEK+xZ/UtY8deCgV/Big8JBh6BFbfCio/DwK9hhRYM/AZW3CYYeBRW3A4r
+vgQDv4UVX4EVfw8D/wMrpsOKWTDwB1gxRzDwZ1jxdcHAEVhxD6z4NgyMw4rvwor5MHAUViyDFcthsAQrVgoGj4MVWwWDdly3CwafBCtsW38Ng5NhxaOCwRNg
+xWOCwSfDCvt+4zD4FFhxFFZmMHgSrJwMK0+AwVNg5YmCwSmw8hTB4Gmw8gxYeSYM9sDKqmDwdFjZLxi0v2/AyiYMngkrzxIM2ueeLRjsg5Xnw8oxGLR/Nw1W
+XgiDg7BSCQaHYeVlgsE6rHyVYNC+9qsFg01YeR2sfCMMPh1WXi8YtO95g2DwGbDyRlj5Phh8Jqx8v2DQtuUDgsFzYOUtgsFnw8pbBYPnwsovCQZHYOU9gsHz
+YOUiweBzYOUyweAorFwpGLT9M7ByAwyOwcr7BYO2r5sFgxfAyh2CwQth5R7B4PNg5QHB4EWw8oeCwYth5b8LBi+BlT8WDD4fVj4MK38Jgy+AlY8IBl8IKx8V
+DL4IVv4RVv4JBi+FlX8WDL4YVo4LBl8Cq44TDL4UVp0hGLwcVg0JBq+AVRfDqktg8GWwSgkGr4RVlwkGr4JVVwoGXwGrXikYtF9fJRi8GlZdC6teD4P2sesE
+g6+GVdcLBq+BVe+CVe+GwdfAqvcKBl8Hq94nGLSvcTOs+hAMvh5W3SIYfAOsulUwaF/7Nlj1aRh8I6z6rGDwzbDq87DqCzBo3/NLsOrLMPhWWDUPVs2HwRtg
+1SLB4Dtg1TLB4Dth1VpYtQ4GbRvvEwy+B1ZtEAza9m6DVXZv3girdggG/wpW7YJV+2Dw/bDqIVj1PRi0/fi+YPDDsOqHsOrfYPAjsOrHgsGPwqqfwqqfw+DH
+YNXDgsGPw6pHBIN/C6seg1W/g8FPwKo/wKr/hcFPwmpgtd3vn4bVxwsGPwurewSDn4PVF8Bqux8/D6svFgx+EVYrweDtsPpFgsEvwerLYfUVMPhlWP1yweAd
+sPoqWP0KGPwqrH41rH4NDP4DrL5WMDgdVl8nGJwBq2+A1W+HwZmw+p2w2s77bFh9E6y2+/QuWH2zYPBuWH2LYPDrsPrvYLWd32/A6s8KBr8Jq28XDH4LVt8D
+q78Ng/8Mq78Lq+fBoH1skWDwO7B6iWDQ/n4lrF4Fg/Ng9RrB4AJYvU4wuBBWbxIM2tfYJhhcDKsfgtV2Tu3rHREMLoM1CAaXw5rjBIP3wpqTBIMrYM3JgsGV
+sKYiGFwFa84QDK6GNX2CwTWwZkgwuBbWPEMwuA7WPEswuB7WnA9rnguD98GaCwSDG2HNRbDm+TB4P6y5DNa8GAa3wpqXCga3w5orBIMPwJqrBIMPwppXwpqr
+YXAHrLlGMLgL1lwrGNwNa94iGNwDa94mGNwLa24UDO6DNZ8TDO6HNbcLBg/AmjsEgwdhzTcFg4dgzT2CwcOwZp5g8CFYs0ww+D1Ys0Iw+K+wxggGvw9rNgkG
+fwBrtgkGfwhr9ggG/w3W7BcM/jus+SOssWfmj2DNEcHgT2DNUVgLDP4U1pYEgz+HtcfDWnuH/gesfQqstXP6MKw9WTD4K1hbEQw+AmtPg7V2Tn8Na6uwdhAG
+/xvWNmCtvUP/AGunCgb/BGufIRj8X1h7jmDwz7B2BNaeB4NHYO35gsEWrL1AMGjbq2DtC2EIWHsprH0xDNm2XyEYmgRrr4S1V8HQk2Dt1YIh26c3CoZOgLW3
+wdpPw9CTYe3nBEMnwtovwtrbYegkWPtlwZDt99dg7T/C0CmwdoZg6FRYOwvWzoahKbD267D2GzDUA2u/KRg6Hdb+C6y9B4bOgLWLYO1iGOqFtUth7XIY6oO1
+a2HtOhgahLVGMFSDtZsEQ8OwdqdgqA5rd8PavTBkx/igYMiO8UOCobNg7Q8EQ3a8H4O1v4ehZ8LaPwqG7NgfEQw9G9YdB+smwdC5sG6yYOg8WHeCYOg5sO4U
+wdAorDtNMHQ+rGsIhsZg3dmCoefCOiUYmgbrLod1do4ugHVXCoaeB+uuFgxdBOuuhXWvh6GLYd0NgqHnw7p3CYZeAOs0rPskDNnXvk0w9CJY91lY9wUYuhTW
+3QHrvgpDL4V1/yAYugLW/aNg6GWwbhass3P3clg3RzB0Faz7pmDoFbBukWDolbBupWDItvcArLNj/ipY95Bg6NWw7geCoWtg3S8FQ6+BdY8Khl4L6x4TDL0O
+1v1RMHQtrEcw9HpYXxYM/SWsnyQYegOsPxHWnwRD18H6UwRDb4L1pwmG3gzr+2B9PwxdD+sHBUNvhfWXwXq7X94G6y8XDL0d1l8pGHoHrH+FYOidsP4aWP8a
+GHoXrH+dYOg9sN627w0w9F5Yb9/jLTD0V7D+BsHQTbD+XYKhD8D69wiGPgjr7e8+AEN/DestPgRDH4L1fwPrPw5Dt8B6LRiyj90mGLoV1t8O678EQx+H9V+B
+9XZ+PwHrZ8L6WTD0KVh/j2DI/t0eWG/3yKdh/QHB0Gdh/WFYb+frc7D+R4KhL8D6n8L6n8PQF2H9I4KhL8N9mWDoK3Df8XCf3Qt3wH1nwH1nwtDfw31VuM+O
++dfgPgX32TNqBtx3mWBoFtx3uWBoNtx3Ndz3Khi6E+57jWDoLrjva4KhuXDfIsHQ3XDfZsHQ1+G+HXCfPQe+AfftEQz9E9x3QDD0TbjvB4Khb8F9/y4Y+me4
+78eCoX8Bc7Fg6B4wrxIMfRvMtYKh74C5TjD0XTDXC4bmgXkXmHfD0Hwwd4Cxc7EQzNcEQ4vBzBQMLQHzdTD2bFwK5p8EQ8vBfEswdC8Y+7rzYWgFGPtatu+r
+wNi/uReG1oBZC8aek/eBsdgAQxvAbBYMbQKzDcx2GLKP7RAMbQWzRzBkf/8QmO/B0HYw3wfzQxh6EMzPwNg1sAvMw4Ih+3ePCIb2gnkMzO9gaB+YPwiGDoD5
+k2DoIGxAMHQINhwnGDoMG44XDD0EG04RDH0PNvQIhv4VNvTBhiEY+j5sqMMGe67+CDY8HTZMhaGfwIazYcM5MPQz2HAubDgPhv4DNjxXMPRL2HABbLgQhv4T
+NlwsGHoENijB0K9hw+WwwZ7J/wUbXg4b7L35KGy4BjbYNfk/sOFawdDvYMN1gqHfw4YbYMPbYegPsOGdsMGuhz/BhpsEQ0dgw82CoXHYcItgqAUbPi0YOgob
+bocNX4YasOEOQa0MG74mqNnxmy6oTYINc2DDXVB7Emy4W1Cz4/oNQe0E2PAtQe3JsOEe2PBtqD0FNswT1E6CDYsEtafChnWC2smwYZOgZudnm6BWgQ07BLVT
+YcMBQW0KbLDz+hDUToMNPxLUngYbfiaonQ4bHhbUzoANdnx/C7UzYcP/CGpV2GDHaxxqfbARQW0ANh4nqA3CxhNh40lQG4KNJwtqw7CxIqjVYeMZsPFMqDVg
+Y5+g9nTYaP9+CGpTYeNZsPEZUHsGbHyWoGa/niOonQ0bzxXU7GPnwcbnQO3ZsPF8QW0ENl4uqNnfXw0bXwW158DGawQ1+9xrBbUx2Hi9oPZc2Ph2QW0abLwR
+Nr4PahfAxpsEtefBxpsFtYtg462w8eNQuxg2fkJQez5s/KSg9gLY+DnY+HmoKdh4h6D2Itg4U1C7FDbeKahdBhvvEtReDBu/CRvtmnkJbLxHULP9mwcb50Pt
+Cti4DDYuh9rLYeMKQe0q2LhKUHsFbPwBbPwh1F4JG38kqL0KNv4UNtr18Bew8Vew8ddQs+P0G0HtdbDxt4KaHbPfC2qvh41/hI1/gtpfwsY/w8YjULsO7kdQ
+exPcfzzcfwLU3gz3nwj323XyFrh/CtzfA7W3wf1nCGrvgPv7BLV3wv0NuL8JtXfB/WfB/XZtvAfuf7GgdiPcb3/3bqi9D+6/UVB7P9x/k6Bmv35QUPsA3H8L
+3P9RqNnHboX77dzdDPffJqh9GO7/nKD2Ebj/dkHN/p0R1D4K9z8E938Pah+D+38gqNnX+3e4347tx+H+nwhqn4D7H4b7fwk1Dff/WlD7FNz/GNz/O6j9Hdz/
+R0Ht07DpeEHtM7DpFEHts7CpIah9DjadLah9HjaNCGpfgE3nC2pfhE0Xw6ZLoHY7bLpcUPsybLoBNtl1/hXY9C5B7auw6UZB7e9h082w6UNQ+wfY9FFB7R9h
+kxbUpsOmrwlqM2DTHEFtJmxaKajNgk1rBbXZsOmXgtqdsOlR2GTXlf27xwS1ubDpj4La3bDpfwW1r8Omo7A5g9o3YPOJgto3YfMpgtq3YPNpgto/w+Y+Qe1f
+YPNZsNmun3tg89mC2ndg84ig9l3YfAFsvhBq82DzRYLaAth8iaC2EDZfBpvt2lsEmy+HzS+D2hLYfCVsfgXUlsPmV8Pm10BtJWx+HWx+PdTWwOY3wuY3QW09
+bL4eNr8FagY23yCobYTN7xLU7ofNN8HmD0BtE2z+a9hs52ILbL4VNtt1ux02a0HtQdh8m6C2AzZ/VlDbCZtvh81fgtou2PwVQW0PbP6qoLYXNv+joLYPNs+A
+zfZc2g+b5whqB2Hz1wW1Q7D524LaYdi8DDbb8+ch2Gz7bM+cf4XNts/rofYD2Gz78ADUfgSbdwhqv4DNuwS1h2GzbdNeqP0SNh8Q1H4Fmx8S1B6Bzfbvfwy1
+X8Pmnwpqv4HNPxfUHoXN9nn/BbXfwuZHBbXHYLNH7Xew+few+Q9Qs1+PwBa7rv4IWybBFrvvWrDlRMFwCbacIhguw5YzYMuZMHwcbKnClj4YfhJsacCWJgwf
+D1vOEgw/GbacDVueDcNPgS0jguGnwpZR2DIGwyfDlmmC4VNhy4WC4Smw5SLB8GmwRcGWF8JwD2y5TDB8Omy5XDBs23U1bHkVDJ8JW14NW14Dw7aN18GWN8Lw
+AGy5XjA8BFtuFAzXYMv7BcPDsOUDguE6bLkFtnwUhm3/bhUMPx22aMHwVNjyOdjyeRi2/f6iYPiZsOVLguFnwZavwZZ/hGE7HjMFw8+GLXMEw+fClm/Clm/B
+sB2nfxEMPwe2fFswbMdsEWxZDMPnw5ZlguHnwpaVsGU1DNuxNLBlAww/D7bcLxi+GLZsFgxfAlt2wJadMPx82LJHMGzH+IBg+IWw5Qew5Ycw/CLY8iPY8mMY
+tmP/MGz5JQy/BLY8Ihi28/CoYPgK2GLX059g+GWwZVwwfCVsPQ62ToLhq2Dr8YLhV8LWEwXDV8PW02BrDwy/CraeLhh+NWw9UzB8DWwdgq01GH4NbG0Ihl8H
+W88SDF8LW0dg63kw/HrYOioYfgNsHRMMXwdbpwmG3whbL4atl8Dwm2CrEgxfD1svEwy/Bba+TDD8Vthq+3MVDL8Ntr5SMPx22GrbfS0MvwO2XicY/ivYer1g
++P2w9V2C4Ztg63sFwx+ArTfD1g/B8Adh6y2CYfvYrbD1b2H4Q7D172Drp2HY/v6zsPULMPw3sPXLguFPwNY7YOtXYVjD1q8Jhj8FW6fDVrv27GvMFgx/BrZ+
+HbZ+A4bt6/0TbP1nGP48bJ0HW+fD8Jdg6yLB8Fdg6zLBsH2ftbB1HQx/FbbeB1vt2vsH2LoNtm6HYfueOwTDM2HrLsHwLNi6RzBs2/KQYPhO2PpDwfAc2Poz
+wfBdsPVhwfBc2PqIYPhu2AZsy2D467DtOMHw/4NtxwuG/wm2PVkw/E3Ydgpsq8Dwt2DbFMHwv8C2Htj2NBi+B7b1wbZ+GP4ObBsSDM+DbQ3B8HzYdjZsOweG
+F8C2cwXDi2DbeYLhxbDtAsHwEth2sWB4KWxTguFlsO1y2HYFDC+HbVfCNruuVsC2awTDq2DbTbDNnkurYdvNguG1sO0WwfA62PYxwfB62KZh2ydh+D7Y9neC
+4Q2w7dOw7bMwvBG2fRG22TNqM2z7mmB4G2y7SzC8HbbdLRh+ALZ9QzD8IGy7RzC8A7bZdtg1sBO2bRIM74Zt2wTDe2DbDsHwXth2ALYdhOF9sO2wYNg+9j3B
+8EHY9iPYZs+cQ7DtZ4Lhh2Db7wTD9rlHYJs9W/4VtiMY/gFsPw62PwmGfwjbT4TtJ8Hwj2D7yYLhn8D2imD4p7D9DMHwz2D72YLhn8P28wXD/wHbr4bt9n75
+BWy/RjD8S9h+rWD4P2H79bD9LTD8K9j+NsHwr2H7jYLh/4LtNwmGfwPbbxYMPwrbbxUM/xa2fw622zvlv2H77YLhx2D7HYLh38H2mbB9Fgz/HrbfKRj+I2y/
+SzD8J9huYLvdj/8L2zcJho/A9q2w3c7LOGx/QDB8FLbvge17oW7Hcr+gXoLtB2H7IaiXYfv3YPu/Qn0SbLdj/UOoT4btPxLUT4DtPxHUnwzbfyaoPwW2/0JQ
+t3PyCGz/NdRPgu12LH4LdTs/tg9/gvqpsP2IoH4aPICg3gMPHA8PnAD1p8EDT4EHToL6GfDAaYJ6FR44Q1DvgweeIaj3wwNXwgNXQX0AHrhaUB+CB64R1Gvw
+wHXwwBuhPgwPvBkeeAvUG/DAuwT1qfDAxwX1s+CB2wR1+z4zBfVnwgNr4YF1UH8WPGAE9XPggU2C+rPhgR3wwE6onwsP7BbUz4MH9grqz4EH9gvqo/DAQ/DA
+96B+PjzwI0H9ufDAI4L6NHjgMUH9AnjweEH9QnjwFEH9efDgaYL6RfDgGYL6xfDgEDxYg/ol8OALBfUXwIOXw4NXQF3Bg1cK6i+CB68W1C+FB6+FB18P9cvg
+wTcK6i+BB28Q1F8KD94CD34U6vb15gjqL4MHVwrqL4cHDwjq9n1+JqhfBQ/+QlB/BTz4S0H9lbDjRNhh18DVsOMUQf0vYMdpgvqrYUcf7OiH+jWwowY7hqH+
+WtgxFXbYtXEt7DhbUH8D7Hi2oH4d7BgR1N8IOy6AHRdC/U2w4yLY8XyoXw87LoMdL4b6DbDjckH9HbDjSkH9nbDDvvdroP4u2PE62GHH6j2w43pB/X2w4wZB
+/a9gxzsE9ffDjncJ6jfBDo/6B2DHhwT1D8KOmbBjFtT/GnbMEdTt778uqH8YdtwDO74N9Y/Aju/CjvlQ/yjsWAY7lkP9VtixUlD/W9ixVlD/BOzYBDs2Q13D
+jq2C+qdgx3ZB/e9gxx5B/TbYcUBQ/zTs+Jmg/hnY8Yig/lnY8aig/jnYmQnqn4edx8NOu8e/ADtPFNRvh52nCOpfgp1nwM4zof5l2FkV1O+Anf2C+ldhZ0NQ
+/3vYeZag/g+w82xB/Wuw80JB/R9h53WC+nTYeb2gPgN23iCoz4SdN8LO90F9Fux8v6B+J+z8gKA+B3beIqjfBTs/J6jPhZ32/f4R6nfDzpmC+jdg5xxB/f/B
+zm/Czm9B/Z9g578I6t+Cnd8W1P8Zdi4S1O3vNwnq98DOHYK6fe4eQf07sPNhQf27sPMRQX0e7HxUUJ8PO/8IO+3ZuwB2/llQt+8zLqgvhl3HCepLYNfxgvpS
+2HWioL4Mdp0mqC+HXWcK6vfCriHYVYP6CtjVENRXwa6zBPXVsGsEdp0H9TWwa1RQXwe7xgT19bDrWthl9859sOs6QX0D7Hoz7LJn9UbY9S7Y9W6ob4Zd7xXU
+t8Ku9wnq22DXzbDL7p3tsOsWQf1B2HWroL4Ddt0Guz4N9Z2w67OC+m7Y9XlBfQ/sugN2fRXqe2HX1wT1/bBrpqB+AHZ9HXZ9A+oHYdc/wS47v4dh1zzYZffg
+v8KuDYL6D2DXz2DXz6H+Q9j1sKD+77DrV7DLzt2PYNdjsOt3UP8J7PoD7LJz9zPYDezOoP4L2H2coP5L2H28oP6fsPsU2F2B+q9g9xRB/dewu0dQ/y/Y3Seo
+/wZ2Dwnqj8LuhqD+W9h9Nuw+B+r/DbvPhd127h6D3RcI6n+A3dfAbnvu/RF2Xyuo/y/svk5Q/zPsvgF2vx3qR2D3OwX1Fux+t6B+FHbfBLs/AA3bv5sFjRLs
+vkXQKMNuLWjYfn9a0JgEu28XNJ4Eu78haEyG3fcIGnZsFgkaJ8DuTYLGk2H3j2D3j6HxFNj9M0HjJNj9sKDxVNhtx+W30DgZdv+PoFGB3b8TNE6F3UcEjSmw
+B0HjNNhznKDRA3sqgsbTYM8ZgsbpsOccQcM+dj7sGYPGmbDnAkGjCnsuFjT6YM9lsOfF0OiHPS8VNAZhzxWCxhDsuVrQqMGeawSNYdhzraBRhz3XCxoN2HMj
+7HkfNJqw5yZBYyrsuVnQOAv23Ap7Pg6NZ8CeTwoaz4I934Q934LG2bDnHkHj2bBnnqBxLuxZBnuWQ2ME9qwQNJ4De1YJGqOwx8CeDdCwY7BJ0Hgu7NkGe7ZD
+Yxrs2QN79kLjQtizH/YchMZFsOcHsOeH0Hg+7PmRoKFgz88EjRfCnkdgz6+h8SLY8xvYY+fUjuUfBY2Xwt6TBI3LYe8I7D0PGlfA3vMFjZfD3gsEjSth7/ME
+jatgr4K9L4TGK2DvpbDXztHVsNc+7ypovBr2Xi1ovAb2XiNovBb2Xgd73wiN18HeNwsar4e9bxE0/hL2vkvQeAPsvVHQsH93q6DxRth7m6DxJtj7OUHDvt7X
+Ya/dF9fD3m8KGm+FvfcIGm+DvYtg72Jo3AB7lwoa74C9ywWNd8Le1YKGbctaQePdsNcIGu+BvQ8JGu+FvY8JGra9fxQ03gf7JgsafwX7ThA03g/7ThE0boJ9
+Z8M+uy8+APtGBI2/hn3nCxo3w76LYd8l0PgQ7HuBoPER2PdCQeMW2He5oPFR2HedoPEx2HeDoPE3sE/DPrueb4V9twkafwv7PidofAL23QH7vgoN+9x/FDQ+
+BfvmCBp/B/u+LmjY1/imoPFp2DdP0PgM7FssaHwW9q2EfXb92/dZK2h8AfbdB/vseH4R9m0UNL4E+7bBPrsXvgz7HhQ0bLsOCBpfhX0PCRp/D/t+IGj8A+z7
+Gez7OTS+BvsehX12/U+HfY8JGjNh3x8FjVmwH9ifQWM27C8LGnNg/yRB4y7YfyLsfyo05sL+UwSNb8D+0wSN/wf7nyZo/BPs74P9/dD4JuwfhP01aPwz7G8K
+Gt+G/WfB/mdA4zuw/2xBYx7sHxE05sP+C2D/hdBYAPsvgv12DSyC/ZfBfrvvlsL+W2G/Pa+Ww34taKyA/bcJGith/+2w/0vQWAX7vwL77fyugf0zYf8saKyH
+/XMEDQP7vy5obID998D+b0NjI+z/rqCxCfbPFzQ2w/5lgsYW2L9S0NgK+9cKGttgv/2bzdDYDvvtz3Z+H4T9ewSNnbD/IUFjF+z/saCxG/b/VNCwz30E9tvz
+bS/sf1TQ2A/7HxM0DsD+I7B/HBoHYf9RQeMwHMgEjYfgwPGCxvfgQL+g8a9wQAka34cDlwkaP4AD1wkaP4QD1wsa/wYHbhA0/h0OvE/Q+BEceL+g8WM4cAsc
++Cg0fgIHbhU0fgYHPgEH7Hz9HA58StD4BRz4HBz4PDQehgNfFDT+Ew58SdD4FRz4mqDxCBz4tqDxaziwQ9D4LziwR9D4DRw4IGg8Cgd+IGj8Fg78u6Dx33Dg
+YUHjf+DAI4LGY3DgUUHjd3Dgj4LG7+HAnwWNP8CBcUHjj3DwOEHjT3DweEHjf+HgiYLGn+HgaXCwBxpH4ODpgkYLDl4MB+06PwoHlaCZwcHLBM0SHLwSDl4F
+zTIcfKWgOQkOvkrQfBIcvBYOvh6ak+HgdYLmCXDwekHzyXDw7YLmU+Dgu+Dgu6Fp2/heQfOpcPB9gubJcPBmOPghaJ4CB28RNE+Fg7cKmlPg4G1w8NPQtP37
+LBz8PDSfBgfvEDTPhIPfEjR74eA2OLgdmlU4uEPQ7IeDewTNATi4T9AchIMPwcHvQXMIDn5f0ByGgz8UNOtw8Gdw8OfQbMDBhwXNp8PBRwTNqXDwMTj4O2ie
+BQf/IGg+Ew7+SdB8FhwCDmXQPBsOHSdoPhsOHS9onguHToFDFWiOwKEpcKgHms+BQ32C5hgcGhI0nwuHGoLmNDh0jqB5ARyyuBCaF8KhiwXNi+CQEjTtYy8W
+NC+BQ5fDoSug+Xw49HJB0z73KkHzhXDoVYLmi+DQNXDoNdC8FA5dK2ja17tO0HwJHHqnoPlSOPRuQdO+z01w6APQvAIO3Sxo2vf8O0HzSjj0aUHTvv/tcOhL
+0HwFHLpD0LwaDn1N0LTtukvQ/As4dLeg+Wo49A1B07b3HkHzNXBonqD5Wji0SNB8HRxaJWjaPq0RNF8PhzbBoc3Q/Es4tE3QtH3dIWi+EQ7tFTTfBIcOCJpv
+hkOHBc3r4dD3BM23wKEfCZpvhUM/EzTfBoceFjRvgEOPCppvh0O/EzTfAYeOwKFxaL4TDiNovhsOHydovgcOVwTN98LhM+DwmdC8EQ73CZp/BYeHBM33w+Gz
+4PAzoHkTHH6WoPlBOHyOoPnXcPh8ODwGzZvh8AWC5ofh8MWC5kfg8GWC5i1w+KWC5kfh8BWC5sfg8NVw2K6lv4HD1wiaH4fDr4XD9pz5Wzh8PRx+CzQ/BYff
+JmjeBoffLmh+Gg6/W9D8DBy+UdD8LBy+SdD8HBy+WdD8PBy+FQ5/HJpfgMOfFDRvh8P2efZs+RIcvl3Q/AocvkPQtF9nwuFZ0PwqHL5T0PwHOHyXoPk1IDt6
+lBI9KMhMyVBmMk/hZKZwOlUGqTOVZ3Euo0zjIl7ApbyUl/NKXg3ZWK1n0li9pz5Wj1+7f9/5vEkTPJaZls601jrTSmmlki9HybRWxn9qaf+N/6mk1f+HD4Dj
+UUdN2ZQVkziBCv00eQbTuBSmjFRqI5VsbLSnNlYfG+0Z7Wnar/778Jj8fnTE/Wp0pFJ8lirrcUyGUrqltTZGZUprNa7dY0qplnKPltURXdZHjFLKGN88+42x
+fVeu/+FRKKFRZV0yVOiBbMyOX61ntGd0rDZSGR2pNMfqPWWt1LhRKjNqXKuS/+sWJZRS9jUU2r2GnenwGvF1RiujlVplNL7SUVTLNkdlqHEVX862PzMWSime
+DKjjcOvoafQyQIOzeDYXcDEK7GulbbWDNVavTBkdq9tF0LRjOGK/G7Pf9Ex1z6j31EeavmnHoVpKSUPchGfG/ZDJELn+ltxvSoTuuua5D639pwwZYspoNwbK
+r3rbQte6sUr9zMz3vOX/0r5Ny9i3YhKgy6akKTPm94Xr3ZRC73rq7t9oj18MY+5fz6h/pD5WHxnLRir1EfdebqRdu1paZt8UFmsLpTMTtoMKXU4/9FEyLNxP
+JPMhe7q4nwvrxr17T22sNuYbbVe1nZhKvdKsjdXCFDRlGmxrVOaWw1GUNkZndvi1yox9pKXsDs6UrAtpqp0F+ck+uWW0tF8rFdeiZjJPy2chX5N2pVTcZFBy
+ay78tR+Kls4UYU0b9zrPaH+dFG6b2rVXswtstGLH/SiZb6HRdvQzpU36HmFmMnUU5d7OrR9VViXDZIZ5evEdR9NdGXsxVrYD7fpQCtOqMt1KJjPDj5NyrZIl
+R9mvOfte53CerDf7utPsMnJvUhmtjLZv4xH/jX3bEm6BaHkP6Y7bS37BySctezquKTsUKAVwHArl2nCuX/e2FSP+XQOK7+yWes3uhdGRntFLMnc+ZSP29/mK
+N0rlLTEmsyeiaRm399Lxt61RfvLdJYDWRto2KZ5nZXfqnM0ITJN3Hxu1s9zjJrs2IlvPjp00sTbmJsyNmnGddfePO3DteLWMW9XjSpXdzBjbMlXC6DA+RoaL
+J8c5KlPjGW6e7Di9mCu4Kp5/I4WlURin0+VL/fR4JI7VJ01wGJbd8Ci5GbQbPWObZAfEN992JT8Wdf5AGMj0hCmcjGE8Zb2dTDOe4O58mDomZ8S0tDe1sNZq
+8qyR8I1d+pXRscxPt7EXnlb56KoySrllbrScc7FRRsVBjgdeS4UFORnQ7nw73Y31hVzCC3lxbGcKd6JNmai9PdP8g6fLHLmzz55z9k1te5Vqb7AMfEvOOC0H
+tgkbOGl1WOBuy8EJsc2T/QqZ5lt9hayQfFy9kFGXz8U222dW7GJxq6Pmrs766Fg9LOTMaBNvwpZO2l5y7cxwDbYrQyc7P931Rv5Qay1tV5TieXcqZxT2fk3m
+2G7v2kil7A9LYzdv3MBOGNEtDcdhUGXKREmTpj0d6/av3YteUpI9abtZ8g2xp6TtjlYlfRTdQo4KN/hwvGubLsNJbZKrfW23c6S5Nf9O9bF6xY6j24TxzS7K
+xuoyfm7SSjJ3LW3Hyt1oWsnnkjKuCbYtdhjd9OdHFSfGs7JMhR6q1GjyLDfficTQ1ja3TO0Psutt60QsOt2fArbpk3xbwymArC+5rFSQLY0f80y5SbAfoc12
+Tt3itF91RioPnxjHsV0XsK1/Ds/17XYtTU6p5OvU9hb3jE4VYWrcnUIlEQZy4c3vkgll+KSZVJIztr19z3D30oVRTym2tEuLL8qCyJmOcm2sHkZ6dKx+Xj7S
+47hDUstnK+6EFYGfBZkE2y37re7WlTD8yWl7guyH2KeK75FdwTS9VGZPpJrsEde2WuiLP2Wn2VXrZFTXipaWrR8GOnMHmW9SIiXKB5RRfm+X3QrtlGe9OOvP
+0rGCOBsE2rG6SJJRtNGx0/mHG54oopvOQTJ+LZYB5eXs1/PGQpsKcvZIsV0jvln5Q17arrnnjjrpI7bazvlYIn0bOfbSduswbLGJ40H/KD6jcIb6aZbfiYyS
+761pE+oNT3Ccp43VeiYHvSFc88rEN8w/dMsf+AVZttuoa/c50S/7qbXrlz218O1YLdU1RZA9ijuqsWqiiWqiv7hN1D012svNAx3yfpAb/J0yVhYB3x7DRj5H
+6Z9UKIDJKN/uHgYTu0UqD8gOdx3Ixup+OUxLelj2Y3pJVu8p+77Z9zuKX7O+r+5kje+vg1TtBNKgK+TLxt0HuLblZ1atYF1p08dqPZXCzs/v+WTnO70savta
+ZsDpZJks5Sxenkp+Ib9K9TLCao0POZ2spI6a4yhrnsqpoW11py2NjlTKXg3U7tnu1TKljpgSmfZ7NzNHTVmVte8rIvnXw+1rX6cSxrqM0uG1nMZOWdqrxq3s
+Hc6ovE1n0BfbFOXMkY7WZXkTw1RlqCMm03YmOS62MZy4eSuD2FoZc6dF1tFSO5DY81aX3V06bt9DLFdOVsr0UVPOx8+/bEWmd4rINaJ/aSfalLW/N4xSwHHu
+73VZ8yR6OJP++Brl0ML8pfyZq8M6dd0sc8S4F215xUVlqr1tMo4jxVccHUleOrbS+Kb6Dvvh9OOYt1Ukr/a2jnR76fZW646mq5YpizCt89PW31XHUYKKG5tw
+gsjt6F5/qpfjjyM50cV8IjaLfGe61/PrakD0+HS11xKlNjnysnwH2Fc7ij/1Wm6vZcE6FZSslrNVKtkdx6H8eNl3eybPTtZHXbZIbSx2JTXqZcmw+bc+6pQn
+bBcz16O4mDInuB71ukrmFUCl8j67tV9cXaPpW9fGyv5N3TS0vMSG9JGWTJKJ8+b67frn50deuxZP4CB59+Sz1EysSkYZWQmuKyrOljGprEBJ5qsk+kPxfvI2
+S/tq44hkll97wVBZ6H+FIRpiz87tklYan9Rtz8oFp5R2zfN7wgQrlFbGD4oS5YFJ8X2exKnunc6KY505i428+iR3MMpecQJBzzQ/7MHkmWmvY5p0f/hLyJRp
+GRXtvGn/Tna22LR/7m19Gy7J8l4FeTZY1YzTHdxbBZuxH3d7phfG3b1m29hrZZwCojstxe610MeRqaLlNayK9DXCB6XYp9M4o3Cj5DJJslijJOLPrJbXgoh3
+iTsDn8SToTAaSocRlrYOwVHtzodj+UdexEt4Ga/gL3gtf8mbeCvv4D38FR/kw3yMv+XL/D1Y+8LoMTAt2REpnLfBnT5jzQmeEjDRS9uRmVLxwm+lbkVhN1ra
+bbv8n1JHtDqO/HNZuVMk6/iFfE7/dlz2sDIl08U83U3qbJkn5Lo54vTGltE6UxZKAU8CTLRR9fgZ6bBS1b1lwX7Ocm1ODFFGlVAtpUtK5ytNHEwtf5kzCTDe
+ZnOy92rEFRveJbcyO2t1btg/zl0P9r1KwcSlCm+mog27ZcK7clIXPVfO0qnOimD13E5NPO/tqLciButh/SLXolpiNwjW7XHnlhJTarQQjhvdPoe2bbmlUMkc
+HB/H5mRv5ZjKOW4/hBGK1rema1kzsW+0WQq8Li53trVmegE2NxHYZhWnSdQs+RBdOrSnzORO+1oteDFG3K06Nlq0YQVRxWkFnbYMsQ3ZsbVytjNoRKXwKMq4
+m7CctFapkskt6638BnbXd7AFaNPd5no+FzxRm2szNbV6payrrdW2Rx/DzGrCWa2lTU8pjKdYr14YvcCvi1YsN6I9Toi0rQtGrZ7Y2Hxoa+4Iqk00urmz74jd
+7mid6HluiDMnZqeDnHhNgq/OBO27faCTdVs2zgY57LyU5/M8ns+LOtftlE6D8ZQJF7FsxLLxSzgd+gzdtpbzyy0zcQLsIRc+TIfvJ9i3i3Zi+6/ubBruEbd8
+6871MYH9uJzaiKO70WTBPBParArGYZ3oiW3OoSdF3TtIOc4D03RW1vRktPJOYvJsWkfz2GhP5qdfzEK2WSWxRjhzv/GWQh1PS3JzZuaG2i/YJ8c9FHzQVsY+
+m+c4L3Tig47tGe0ZrUxJD1Hbog6j63n5oWl0HJA2c6vSYSZdAzPvWfO+42jGBG+rcPuq14+XrMB09aWeNW9dHx2pTOtuZh2rj3hlR0UfZmG5+Y1RNMY7idIv
+OKyD0OolSvZB4sVMxkpMU2I/c/YJu8FrIraK8dr/s4etiFAttJcL/JsmLuyjlMj874KEFtdavuvdXa/8PZNLXh0yaOYWV22sXvQhTM1VQRFKvcLWcjYqnfMF
+ZM5KSpxrJtgecnlDuTPD+qlFc3tOfkJnxTM4m9il4Y7mYJzI1Ucx3aaWxZb3enQ6PE6MfqRylEnsCnp+lEMLfIuajE08mO0+jBduUw7kLo7G4GawbpGyGJW8
+WUYHw7d9sIUODW+JCSdMXmaSYVWZvdMLRvCMXP7jybFPk+l11vxRLoyco67jLGvu8bo2rWunCiYiv0pDn5xMaNo7lMxLXMmhS86dUuhQ5ImYY/JE/Iayez20
+v0AWsc8dy4pWKOdHibtFp77cceOF9WSbifboGho+zFFEjlZK0ba2B9y6fi7P6xjzDp/u461xFT5NuNALNtyjdF/r2ut97f6R4Ouh9vi2Uu8lqYnyU5PrZ7Rn
+qnMLhFZnMq3ObCoafEvsvp5x5nattK6EeE+L5lN/m+vgOw0Wl1Mm7MMYl3KFl6bewJufeF+acnfVR+x0jJR7ZInLRGRhS/tVU0v4O4/XR9EBtHcI6AwtYq78
+n6i70e2kik5FJTJk2AfB8t2MWswlXZhh9rQKV7J4QkWDKQhawTNXkm3pz3bvowuEudQl7zdvPI68cTgKhjh5N5fBg9Zlb5tntPkScr0reuFFw8rylrrH7c04
+xV9Bbk+0ck9MvomFd1R0GWqrgxqltdEmrqOnJLJgkG6e2Sm9dj8lu7hAp452Dmq+daNfqYu7dqITsc0DZoJf2Y1rGcqc4OzOVup5JiNOy7kYBdNy73dPEBPd
+Nq17d0xtrNbmDvd98ZD2yOILNklH3PGih3u05IQNt37dNR8kjIKCkOs+4Y59rh/fl3BltPB05fzJf9s815luzsYwDxdl0v6po90X9nGEkdVF+8l4Yjsw3de4
+dospeiDzicm76TgTuqzK+POon7r3oRd0oGkpI+GY2ns5b27nctHJ+6cfcHxhbYQ990zGYJqd9MTrnbX9/IzMb7Ke+qRa/FZHF1iWe8PGcSOkS/6rye084bs4
+zoFPosqq7bx+5hPzyPuGZDj6aUkujZKn5JbkCDLabzCV25r8u3tuk+MllyP7Okp1bfJDp/u7uOLG3KhV6pXaaKWejFitjXJa/BjPR/GoHzIZoei516nvWxvh
+GrSCmyXy4cqqYDG1K+x5nk0mt5v7bK0IeSundDmeknGdJMvNn/otE1uaLLlxLY0uBSOAVrGBLZPrPa6xWmQg5fUfK1VEC0fWjd74f1CEpiX8ssmJQpJ6lifQ
+jtzDLYLua7oYVBM9KXw14UqBIFOX6crmb+d9F+jKoe21wDWTNf8se8M1pU/xmGrplLZsjCnpIs3M2VlLJGQD90wdTjj7U2g/kcury3TR82TsLYfXHQbiHkvY
+J94XrsWhIT4SJ6EkYQIiiOY0GIj+jbIWhm27zOud197CWheXqXMKjnhfdqpRGDd94oDQuZgbnHLBCdnSmTiK4vpTZe2sJ8/xd067Tzo2QXaN1xVGvPOlFqm1
+U9pc1vJPGafalnDKf9GF7Rqmg7kwynl+iEh8WVOi7BFGKbAsXWuCfysInM5zFqxJJtPOqZXhHZbGKPllKXiso1089WOd5hhenX46b22P5AK3OtqcdXI52h2f
+Oussq+QozpDrnlfSgaeDf89TOTNYsrJ2X9dIJTi5e4LvNr614y0Sb90sTIAfztAGIyb/TLl+jxtTljCL3I2X9P9U76ct+vGSdlySdelzpotvlbjzyDK3+EpO
+0gnzaF+5pFJbkedzhHY8hVN5WvH5aQMIk6ejtlBKnGlpvM1JnEHDje7zeVkebyORM87zLmZNJ4zIgz1T/XePH3bj7qQMo/VRr9k7h/nEgTc5SSR+Dds2jb6B
+DDJdNpmhLPNRNkeUJ77YM0S7O8T5Nd1sifxdETbRkRDSU8YyZpRtgZeRM/C8gD75y3DMWI1wtBIY7JYTpY8iF5+XzLXjVnkB3G0ukxlpD0dFtrKStrRnpFLv
+Sppz/2uV+KClIUxxMsIRlRU8QSowfJWwc3Uu3LX8lJtxlLJnmry/TuSYF8Mkd0AFxob92kl6KxDePK3nkkwgIUJl8RF5r3K0ZmudXI4lH6TSKeIU1BWtoQzg
+5VAr8yGhAQI7CGU17jzDsjUypZSfM9u/k2yvapUon/aUTQv3HmInsq50Hc8XkYtOiJJRmBkXHeHN97G3fn35YXcLR5d1KyH6+XHWZZXo1S8Mr5pPdhdm4dio
+HKNjne97SeZXbCdJMyNvTBvfcNyU0S0Kj1L27dOUAyPEjasz2/n5l8bYG8w5pkt6XJf1uHcF+PiiIIFaEc57MI12ClG+1lXgLVqxzO6/WnAA528iC9tdAUdU
+ZkQxjIsa8TzYq9ErksqRvKHs51vxVGEhTBqrV9x7CA+hKYdYpVYpW8q4Y6/IGS+yRjnykeT+sccy4Mcn3yduh2Rjjg1Zy3039kzoqYVJFFFNdm9tLBK2MuUW
+nqP9YgUMtz+0joq6Z2ELBzxVLL1BAjgu9nMyJ1Ghx/e0Ug9djPvCjBsjio4gHGlOvHImM2OYHF+vHCMf7Cplkg+/mTLme9dlkboF6tZEYWXaHantIeq9Z7qd
+p5upnLPpP1p2MbXS5SrrRvZwH8OuRSMu/FHUEfcvkhZF0rDLUmeuhyLo2U7a9xLOTLhu7a/HjTyPfO1oTuEMBvw71eKnaLTp8XEUduUwru0yNGVh8mbKk6/U
+OKos28KdMqTr3/NJa2L5ty8WRjYlSEc3YV2OfX/kZ+HINy1dPPf9yd/SGRK0M65K0c0qxjSn32rjrrTJsb8539XOux/hsTiuduNMsSSoaDsrWBtce5xcZJe0
+CeLTuNw+437KS3KTa3v3GJ3T3aES21Hk7wSuwoW8wMdovUrW48RxA649HfaQ091Inp7qqk53rdSz0UrdrVOjvIwRjGbjKt4iQtzW0r38Se2EmUhz0O3qrI9j
+DufrFM5yFrXLuJwrIdh9003VHPMW4RE5fMU+GE6a5ImVhA4t10FgDAshR3nFWxxVLZPLfEV2v5w1cu5lqbsi7SfFuXpKYjU4x/E4xBf0Kl7HG3lbmCuxUoXY
+VWfYHB2r1+JXp8CN9NQmxe8K85g8LzyWfl8O94MqS8SXUTmrWr7aISCToSA8Pq46nhcWrmljRAXdLFrr7IfYH+MZ/BTqvDCu0tfwBt7q+u8mLKh78VrwYpOd
+wPJYmNC6KEvJ7+RMbSbmSIHtt/1c66lZIcYqiG00rXFdohjScZQOZldG4n3qlB3sJdVu0FRaq+QcszpwuMfHanKe+X9Jb53VxRsypFv+yJCT2Inc7kSO7XCN
+b/m2ZHjSWj4xKnecFc5Va2svnKvu+KrEmLLKxMeoqGK6ywHqbmkj1H2Vnp+5TCCxjflIBGHgcQciXChTkgHxTLPuwyEWAj8kunNESoT5ymUW5ddlOjLeLJmb
+I/0icwpbiMJJrqDRfOCmOMlnVAbP8araYoPC16Noex/EgTQ6N0YaOSdNMp7WJJXI3taC8fSg28sV3FOTKc0bXpkWD8/RsUl5cgV3Mx8xWVj2nnnbCpw1cUG0
+xIGWxxFl2DarZG9rerzdPUQLe49mkA2i2dH+2JP5pmSJphBuKLFJhxszSEvSVDeKQuopu8l20nOJ4j0qGzNYyca1Eq39iLGieFYIcvJLh8l+PDVlxvwJ7e9R
+P3CVGLuURC/VvKYk0ThOeq7IvRT6l/cslwFMDKoR/w0t5RUse/v4gzknfLfa9NX4gxYue3QM6agx8tSkPyd4yVd4l81kjpw1qOnmYNIxIiFzJ9tY/fRozS4b
+abMW7TR6BLo5TdyZMf44+U20xJYd1WWcfB3uTLLCzV+vjFby4zFc6FnBrix2sLjPxkUr0mmcoH2fJK7H0aWc7hNotqOXZC53SK0iG0HCBtxBa5wAW8IliSCX
+lMX2IePe73TD+K+enPmiH+cHe5h6OcyMN5g7irysdrFZilw0GONvkZmza642IhZkLwjZY1W85zW3AGsjPYXvjdhvM+lTFoIuxNeQSX4FlSmd4axOLeO94faT
+9m0xLu7Dej6fy8Vypte9dO5GMExSfjpOsQ2N/K9Rv/X9UOi2IL6WUlrpcedtcvZOfYRSuFpdho5WjAA9SsktLvE3xXU/2dn4fCz96amz0mvqcbK9xpYfmPmJ
+PuIUt3AKhfaV3NXisskUNqVp5Wsww5TE92QkeEtrpc14iK5O7Sf1nG2cee10tF3TGcsFXMd6dI1vhmZ6/dWkPEwXPGPcyZ6JiO19XdoExd0+bL+6FuLPc11W
+bTqGeOWtHB7HcZL3pFyUBRm14pxeufKbNHxybGZunFEhtkQFd6KcZjrLTzgTw2yTj5LbNbErrulZ6EA5uY/seZfbssK96E7p5FbpEK5K3lLiLz/7XuUo1+dz
+VnanU9RQT3crPpcDRhJraXaM31npIOdORGOzibZVPY7rnXEWJDOu3OeOx4AT4tq3PberXnQMx8ZpjtVGvOAyEkjMeUDWlILKlJss8pwe9UucvyyGHY+MTYo3
+Ws3boJwHxLmAM2eulvPEB5XGoxnx1/ig/qjh2o1sCsHJ8cOzW/w48eRoI0zXqEQUPP9YK1Ti0Xpq2ahoBhJNUIvB1E7uVFF28P63kteIxnV6DY/rwros4TJj
+OaeQ1opT286houf4HG9Dljl6jZcyHu8GTmMgrHYXnh/1P3l8kksFYo+HstHjbuRKJudGdaRVCREUJnxnn2BvuokyGejUUauNOQqcEmMBT+VpBUbPczwvxrI5
+r+a1kAj3Xt6/JEu76b+6aevSxc4Oy+eyX1xhOloF/lRukEjccomupycK7sk7ytOOMae5RzrIjq922v1bHn9uc6lKKDMXZWPPynIZOPLG5DvxGAhnIz/74zzr
+1oQcJx0OtEBwK4UheLzQJVFBPJVfJ7Gfwc7rtLpJ3pJaa7PRx/MkN/PGf1nSh3GTmXFKujjuuWauUqG+8yMj3GHHJTYkmRdvpffWgzYzfSVcrF7ZrTjr87gL
+1TfRZJ+Z1BYgygfBqJ4583rm47vw/hRZK42CHDgShaSCy8rlBRMtoJQfNIE6YUIOL6XsPaUKcs5pjoP2dM9B8zqgu+RSi5ioz+7C6aqStEQcjDHwcr1G/SML
+h4K3fBnRp1Swm1pEW7umzDmFNRH0o8dZEzFCpac21iNrQltbtGrpUjQV6mghty7iaIfUnQsjoQ3zlLgmTo06UH4GXxHkVzc/wdEZRs0dWBJDPDXoqCJKd6T8
+E9Oit6UFG5HTpfFGiJKYTEs6nMi2mcYE2pG02Wg3uEnyDv+F4+K8n8yQ48rTxSYqhvixrtb4eBGX7FXNuLPaOG1aF7KpeMd7i5jhzZv/CzL2aZ4B6VoSvaFd
+3z1R1pohv1JHEzL8NtNBBEuacCReuSa0JfU3XcTLRFfsIs2MFM0h9cQ1Ww8J5/z6k78cHRkrG+diaUkutXyBheQkkukti1T78CF++iyJxUicOZpkDs9wN6SS
+VgfSs5izRfGw6VFGnBwmlrKKqCKZ50v3jAZRuxxdRsYuOLHNtcSEYySrmmei2zaLRuKdZlY5dvKY+CDtU4pzHG7u68RWW5f8YzXfshFvzvQSpRu+Lp6vStF0
+4lxuQZK0TJ/kezvuRjyOuiVeIyccZqlzLMRvShqVkIcxCJTeGp0acXP1QakW/irTOpHpbV/PcFaft4i3YcpYlzmZlne51s3dH6zTSWfrFRdEVCk4JVw3faCG
+XWi+q5nu7KWEhjkJWX7jVBSvWGrXz1Zq+8h0qkGN532HLHIDR4Kds3BTj/TkKQZFe0v/lRlPcqa6sywOcjk5OtyqywNI2sZY9PJznoBOHiRzFzNe1L1bRsXj
+KqNT5XYho+MGiZH0sukZDCb2L7Fa+PDOtmms5GkWXdxN4lUYqwefgh7PR72kPcFcxSBe1XLZiJMog3HXxHiRSbyKvzOfxBleYh6DWs6RCT5bfyeJYaUuSRay
+qOI40lhGnBHJdqe1t9QLh9CRaTxjz+q5Lh2R3UKBZm5iLmHtOMNBvhX78vnxnMr5evbWTvSsizIndxQpGCXjUw+aXKgbpxR4D6mvxT2iyhJp7r4HTu5ikxj0
+NhPhh4g3rbCeEowG19q06E/zCsTEv/GqRQwqTORQMYSHVBYtT9512lL6YJePQshp4OrmfBKJJrFahBtnm1ajIKrWK7WiBb3szpopQe5w4+f3vnEpVTJaVvdz
+W1EYTi3vBHRSq3KHim+1M6XFMT8u2vjsGhjwmUWCmzukHxwrV6KgKb8piaMjk6DDDHchubjmFiLwWDlfDKnGurkTu0XgiF8SI7NfG2WKwn3+ODnFLrHe6klx
+CU6L3zW9AOD2rtyS3lKWy5b+i3fTewvVEVNGHyvtmM68kS+Iayn3RDPk7XtJLGo9N8L0+Fi5Sn1kLAvnjssx4ObXp2jVPuutXYA+BkTockHB86qfbhHGVomU
+dGI8e3u8B0BOvxBheY2PkxhJQvlGxWnkLvaxEGmYC8QiDTfTmY/hWVGzKBHS4DiRRNrso6+8rClnuYqBWTF/m/2SYYoMxigC+v6Fq0in90uDq7mGa3lTx/02
+0jMaqALJnTZNnOn1nnxmnEzYI7HCErzsvoyOZTbQzkV5O4uDbkUpL4shOM6q6vLbyLHnNp/LvGmfG30UXgZLOuwPEhmYzLvonRisXTy471/OOboketKD9T2V
+d707yvd4zNOAnOA1KnwD9zXzmUOb8Wutp5SbsTNPBTIhsQe6JXNlpXb7a4wyLeU+ZYkQEDKthxxI421s1EKMSi4bXMIVvI43cYPLOUMWzURhZkajXzZk8EuV
+SbuJQpZymw8vcoxFth8bFRGzUly59iGZV2mcUdqtXJ3k2Iq5TlzuuTyVYiYeSWUC8c5zegq8AlVKlThnJfB5wvxKFuOFCrxb5f266QoORorRhPJQVmIw0fn9
+6WdgXKtEaQp3euCt9cXcBqOQ20lHhKssNDy5aS7JUoNUFH28f8GzAILXKpqvY/cjay2erKTzXYydu8KzsROaYLAaeFu0nDJTnBOrKZ9TEdK229u3a2Wjjac+
+Zp5v5rNoOdVC4ukKtkKTBbO7qHaBBIPTFKJspxIucLoPJQ4oNfSMpC6/8C9qoTVJWzTN78WwL61XVVh3xt9P/sf4vegDeT9kH3qWg+h1udX8KOGAialuKPl8
+pJILJTBYIzPYL9eULxKYvakXpeCNEj/KuIomJJ2zB7yhw35OuRbRtxuYvW3E1tERd1ZlOXE7D9HMzLj9qUToolKZV7mPmMj7lPc5K5dd25iZPhHoaMo0EdJu
+6iA6kl/7pdzEnGnRhLLErxzWiPJFBJLx9vynE3i6MCJkceS72869D3qRdV4bc1TBceN4y0TzfBYislXZRxsZpV18QPo+pwsrU5SpOGWjwsFvutuhUjZ2NRkh
+fxiJlBGiqZ82+6gyGa2UO6SdtVfWQjElVUhg1kHRHAmJ/zwZ9CjGqJZRxiT2Ju+PDuHZPrDoKCqP/MyCPDOpwMGViM9ofc68Dz94v+WYcGtbWwKIkzVCArey
+p424xem2OYlOpjxzQu7bZN5C1Ficv9x/FNk/Ff8cP43ufHSjnYdNt3Sw8BkVWB9O33CygvF5cIP+attzks+eVfdZJSK7Ria42cGmmYiD6kg/9mrX3fgzSpV9
+3roy42QhECEQUY8r8FBrPC8yGPOKEfFyjbFUwfou5hRvYBgbHXPT4pSCxMBbimn8jqID+Q6RMmLAgz/TRd41Mf+DH6seF30rGpVtn5j9av4QqIzKTuhJyQjy
+XzhbLhePGyVj/DhpSX7ldoX7LN+bjLBgHZQp7MUepk74/jIa4X9dqNJt72t8SqP8He37SQ5csYE+tcCv7Iylt1L+q4osy8imrHjJtkihPD0SDETFrHW4ptwp
+FUiV2hMpQ9bwTNkPo8ZVhtHhiNQq84eK+6V35XhRIeHP+mhmk8teRiV+/SdzCqdFv35PIdDE8tlL5ojyu0yV7CvrsltYqU1T9rU9Je3tQO6hmBLFCbdbUpuU
+5TeEq07lhU7ccWlaYmsruU0yHg0wxtlYVEuVlbKnci7ndmdkXZjovq9xfvu38c7crl5s4xPzIRb4dG3ei9RrIfes8Z2zHyXtaj0lKco6vYgUXRr2ca1UmTgM
+E3zoYqBDyEuU++3O5ZVp7ytj9Ykif4rhVWOSdzNoDbUucULNsSI1fFr02/i2eU5J8uENPDpmvD9isrQvmVGPZ/BR3SjTKWdDPJWyBnze/8RzVunuNEw4JvG8
+d3Jk9BfncyviZLEtIdy5jBrHzaRyuWuU1vka11lgnQSHlg86l3ss0b17GfbyVuSDiXU19b5Efn5OIJkSLkrjR8gZB63Xz429T77q1qPy5o/oogx520IbTvUV
+uyQfcThu8yUQ3t0b0GojlUkxdU4Z6+iVxZkhA6aVLjlL7lFvofAitcy5jJpzT7itUvTNnlBgCCTzmUsppaCGh69xquycqKKunZwwlvHmq3VgP5dU23ufzOkJ
+M4FmYkPzZg8ndRaGo6c2NilvhNZeTHIBgKIO2eMsGZBwN5sk3kOGxJgQWpjYn8R6m9fhSHLG+1bkBm0b7lbLjnFiNYvDJUtEBXdi28HUSoPjxqMBtGUfTE4i
+O5AUbRIn+XM6tFayb3YEm2RxD47lSvTIlHREZRpV5PrKUlJlnEkgH9SY1s3LZeRGcu39KlnOg8rH9WlOpw8ZXuKYxvvCm4JyJT/PpdOlyWVP6lI+OWkJ1+CW
+XxB5w40rdxgNIDK4yriHnJ2vW9ufktyFU9zdd0khLiTnXbd5tseKXPKePC5Zkk3ns5GG+2ThkEnDflzgojteWtIHrZTyFbtM5tM8tIwybSd3OBb9tZCX7UtN
+PpmJZ9PkxN/bl8yR25O5bzexTsag0p5aT2XiGZJ0Fd5EV8Id70fzWN22lRXvHbFmlEi55WFyiP6WbjynwDy+xOX6egKstSeUcadSH4lxZWUlW/Zx+OS6Oy+t
+8JHpAj/gmZKdqWATHuni8Mxtw9YBUy+os1NGx+plnQT8uEGPYQYqDHEpZi/ynlD3u6OMm7JXaDNM0raToi0k+D593coubx9Zx3Ehh7DslrbStCq+CSnfwM7n
+mZ6Z7q6cyRO8x7hbM7mtOgjTpv3Fj2vrw9Q8Nt+fjx3v4E5KeRc5D2NHJPZMtFCUKpOOWMs9YhJ/hnL8kzMSBsr5LiPPZVFiLpI+pnRdqhMty0q9bLQ+YgpG
+3AlXY1n7ZJKlwp+kizJTjieZz0W7/J9HBtq8XK4PXUbvmNK/bft5IYvbWH2kHnUAH9c96p5cNmnuLa0fjzKYedquW9uqOCddNqHTWTOU0abo4xRbjfSYKZ19
+E39mwXls+5iw2k04tLJ0KZojHEfMI2BM6g+yozzk2WgSq5u+5WhldJpwhQvsA1/fxXsLRit2vbYxkzNjlA4GKuPTRfidPm50gY0getS4KdmmcVpsWzhfe2Ie
+uWfHfDl5Hv5rvT+44N1OYhubBSEpXRi1JxTEU1iv4y4hpPb0KyUZdnF2xwkje1oqZ0R1I+LFD92FFz2RFvxiXl7sd+bmQfpdeVbp/0O40ng8s5NiQU8kdKml
+/IorqZbS2eMEMT05We89Ufq3PXsJL+u67gsZAxMuheTf87kYK6MFonot3RjBBB4zZyXFUFve02SK+1a+U5lRumWcfVJrZfCxy+33/5kx59lo4nl8ZZsM0Jbr
+so2X7h8dq4c4c5mePMa8IAlkYbLG4w8TBpkX003KfzHX6VjPsuSKRuf8RZmfpyd7ruuJlBen7GCrCxW/4sKuTU6JyukfWThKW6Zt/D2PWikl0Z5GrIa5LU93
+sOjTzI05X7BoS20+kTCCzp1BEAhbyuhMOTL5E9gYyh98Gcaox/vQad/KPDm5t89Osme+xO/5SIPITepSOegJEenDyYZtPJmnYbpxPxZL3qeSzRBra4YEY07c
+o1S2ryRRAM9rs904/aTZVRKaEkIdPPtIYtUjua2VkJmj+8vL+jrzdhrX8uLuLmmljNHR/yRttC0kcgEqBe5bzFBiFcSyE3SPkoViSfKR5UQxnwneXxkuu7cx
+qTxotRwni00JFu4ip61SH2kbjmaR2xbSoI2ICKCjsK1zDct+wQ2CP+GswTUS3RxdIZbWzqluJZTU2cvPuWf4jE4v55U5xzXI4m02xzwvQWVS1zkdSyI13Eg6
+javVTmCN7EHbniBdhTRPbbKGTqbXxFqiqXwlXK2mP6MlkjDNS+U2Uff2lnMDumutCRzSYgtDKVnXQMlnpIurLtQUNUmepJPcPi+crS7hdkhJY5to31ZHFqlR
+RnSa8OJucdkfjlj1RtuFlt+xqQYQ7iX7bmMd62vKREFhvpKDp5pWRsMhYw+UvLJEMdlxZqKC5IU/32RVpkM4LqNajg6UeVJMW66kM6K1spKywyoxRG8CNa1l
+jFLaO1JbqUE6Zryxawl7ixYVt1w+Div/Cp+rzW2+kFcn/JOM3O4mdFb2SV0blHPuyoFzXvhIW1jCq5RhSCJfWVsUmuu+o+A/Kca053fI8zvqV93gb5SCVlGk
+tERaS/vdWZSoT2+TqCeQpHWaMzCRp0smCtP4oP1MdZU224qTxB+0nvAiOhntx6biPZ/1gkfhhUn1gdwPK/zdCWJaCplrU3GnMJJFTqSXrTuv1hhtZ38q6fFg
+SC/0SYsoFN0vwJQnpC9bb9nrnb/o/89as/0aKihPKsTb6aLo/QQi7sgl9CeuQ2u/+I8v6BR2HMTi8ZyuUmuokuEt6T2jI7VCBxK9wTfJV/92vSmo1jbnpbds
+lpUKW7EQd3Md18c7U7xuldzpUxAuvCHGny3dL9ViahXLbpCEWmWjVSyuqSYwAhqlVEuH/Bq+nrDS4UgeN8pemoU/dN6+DKOUiXEK2tc7bcTqEb6yRcVTCbst
+sREJchlzUrn9wba6FBvaartLpfC2iSqO+LqSkVdHtK+/6eRYk7StEvfABLM/2pHJQrKsZu3WFCHZGEkwk078USbIcZEZU+DwVajzTC+/27M3Ha26p812tjF6
+1v3TphQMGzU/croQ2lIK1bHi/I3HeOXMkSqzVB7xgry1DunMKKVNYd0+3bW4yGSqx/DMsFQf7wY+ijG+PabTKhvZTKLlHZXaFO1G1JPbzrjBJI/Ohd7WbuOJ
+hQ17DOuBt5BU6iNTH6dEQjPGOnQ5y5IhbzvXWt59kxnVdoKJeSuNPcqPMuV5VbkeYu/qyF1qE3m6HBvu0aJSInJPp2ZSkHyyuO2dWlDGcHLX+yTonklNhMyX
+0JrgHilwZgu2wmZbzHpbEHdeWS23wKbWG2+3KcbkZ3l13pLcJl0vkBLqKLFoiQ5x28aYNj/AJUnUa6gkPlLJk7d0HNthN3TmHs1ih5xupYvkhyxE3wdnWeAW
+oFVyKDtvu++xGVdKlTFKKW/rT3Mvtmd/f2GafTJPcJk94Zh72QMtn1NEmScQSx998rqlVYljmTySc/IE70E7O+ZDj2Tlyce2CAbLdCWaot2A66PkErZfQ9pZ
+80QjiYPdbvhTnpmkMt1SktCcSW3notPcs1BCWTKCj3ahdo72dFOCJ42N9pTHRt1wW7lYpZSaJGgws0vTx0GHXG0xfC1d41poDrrDPpnrAMWaAFaTt3VrO+TB
+NlkwH+eEQRVkYKclFCoXVUKioFHhDUdrrJdQxkO/vAG9FSfHqJitPxcNW760gDJKtbATWpQOW6h22d947V8MtsY9XrC7nM4zwvnq9X8frCWfXDHzDhtAXFll
+o40L6jA6vqVy9aBR7eTgUuqdE6Zwmw2ozGW5L2tKkgUr6pYTyIA+mWuy2sbqEyidZaO1t5cdJR0rnYYXmlT31EqVUcbbGYofOpR/KF7SafyqxExdm3gZPe85
+NG2iM9Q7l3va65rUR8aabQqGz0VZ9DkVG9vSeXxFScsBpo3rW36wxmzi2mjP2UrsXs/lcn/nORm+Q//vmJ3UFjA2OqExIDf6ZxOZBXRByVU6sQ6U8tqOXa0E
+SkVbgVJFY4FROrcXpDqKrYHia8505vxyRTG6T1jQWEYqHeWP6mNyvHWdKqU65kr5Yy6ED5VUmCajlLLGasK61R25tNP1NhZyxI72tPXiWOvOB9h3dCKLnfCB
+04+/6mTh+Qx1SncsOZ0c4gb6j8EneUbMXHxpUo03MF/fy/v5az7Cx0PfEwdA++3u9ffODMedmYw7/jY58yeSEJTS4zl1P2Y+DjT18VDZPs00ROpG4RjsgfDL
+jnMoCSbP2nPNtX3ojjv8ReJNiR7tSkG38UkZRKSrPAGDnk7teY70TKc5T4zXJji1XGCnKXW351UKeRc6WRz2bLKxnE4e9/f4BFbdJ8roaFs8ckY1AyMil1Am
+9nqZfA0E2VVu7G5234T9UXKvJ1tdGU6KfQ8evq5e/8dNjCtk/rAtJHv0E1jOOicw50lzS4hkrnVmpJT8RH4yOk6HYmbo7r6ydH0+M5cxi5UdOns5EkLBysei
+EqWRuNIWH5wa/bLeMFlI/6pClb2ie6WkTIGrc5Kvm9aNpXCFWFWDFFDxQYqTuqRZmvp/0kgklM65fpJEsMdUTbwUYE/wTKUVRCbK5Fbw1+W80UK1hkKywNwd
+OzJWm3g+guvQtsTfKD6zT0t1zIiv3yBJUI1SrlJnnA4n3bT5uc7i/LhPYuzzaE+B8e+PrqSsRDMp9dPdEebCAMSz4hqVb3Qvqxv7aCvleJq2RR+G3D2zQJgL
+MoAyMc+mcXbG1Av+PJ83TnqVSFRPwBSRJ38Pnl2vsoXrP0usPq3UKN9BIfGJLIKGGW3DMdjYdDVg25PDky8ysfqcmMQGDCR+/st4mT/b85wiwmYQrkV+unWu
+suYTN24RFRjbI7eyjmm4jwYuHZQGo0046svdHDCJgevErj7JSz2n2Uo1/xe/ZKWoV8iVbWcx99ZmkUtjfzqGp9InA5Hf+tBDZdTEDstSVCe00ZKLJQvqQ4HP
+FzTv7pbonlGXsqTucyn7BGRxetLD2SjtI7/GjZ+D4hor+1h2XwBMIu3cuBd1X1/zLpXy83wlXbaRbZd3pspxpbJUXXRRAuSyjTdXFBzyrk3C7Uv9qmNerg35
+7mU+eya0cPqbI1WiTi+oTj4mNoQ3qDxNrQrEKPejsDOUySJBQ0Y22qrCTLb713L72nltnlSb++sd3DihDXoC72qentNzYdK9W/s/Gael8YlzVQeuu4pO1cc3
+VSdbWHbuBLt5chdfi53PCVmr4nEZc5yl0UtKkkwjJNeY2O3S6uZ7acmNWdLGFIN8hHOd+wLLiS8wRF11syYWrNU1t+R9q8okoYz2G+1iBSSSwIQsobr9tHfl
+DVsSOm5KBbv6UxJ73HmJNU40O/EOVeodfqH8Qkv80KkQHz3QdvtMLviqk4J9fmdkQUxv6XxJyIfxNZ6D4G5/KBrc7Dch27DtnM9elcRy5H7YkSA1nZ5yi92e
+bibJuXIVTE7yyuhIEqje5pgL5fr8zz5QyO8EoRiPhzA50bfcxetOchd85RhZJmlriGESv1IXLk4t9RGmsdApMWfc5Cu2nZ9Tzs/m/Hx2MIXaeT3xhGFSd5E+
+jYzLXKVske91QW7Mrwr7EKnfMobGyZ5JeV4hRiFWjU25Ud35eeWO8BuJ0alHv0eXKAYtX7ylNOfjiC9Hd1L1/NljVNFuJfa5GMlVMMCHSQo1biXHeKinaps6
+aULWUAzD9ntfKxNdT9GgaEzwv2ZaFCnJGODT2BfjQPI41mDXOTfm8sozJ3QxT9k5mOJ6crrb+yG7cbtBtFD6sKTHjfZxcgVuhVJOO/K3ng9JFiXE7uJIX2ir
+5yha3KvFyjDV+68TCpSznMSCH8FfWZvQux1zs8kRq/KkLL5mgfFMhsS3rWJZEFkvxn4R8VO6dkR3yETePd8R5xN8Id2z33edhXrPpMSTl6eWaRM3oh+vmCE/
+GfOyUtLbZB0/J0Tl2MGLOZC7c0RGcntlRZIH52kkmsHn3lEF0xzFep+ViQY45aTXUhSBtHJ+glJM6tTGB7ggxod5yTHav7sYhyv+a0jWMjriUuFlHfsr/8j8
+9g+katcKHZ1FIU+np/fqcROFytSfcrXoEU0XE/9/smQXspl1WIHLbsC9MaWgLyQfiUtImbh4j2HUdi9igjG4pZU2pfa1OsXLV17jTdIL1rryVdNhNqKkpsK6
+r9ynTMg3mJHumURU77gXQmxj271gyyVJJEZhfxeKmWSJpFv2ucNzN2fJX0w6mABigJsNtDMqbB2pqRzrmmitk3Oqz7G9RCLPdebOfCqjY82JNOaJL7HIX1Wt
+RLyIfLC2m8yf+zHxSsFTW7zMegpxWP0+x2V7/rpXOf6ezZb/dt7rLVCyCFL+vK8GNfVYWSz+75Z9V0NKxcxaRrdUe6ZuVSBwTmzJD67dPB9oqlYoNXEsQZst
+rszL3XikHp9IUIrJDHra81mlLqtiUkznrSpIfVOKbh+Zuwk8DCFcKqwR4ai7C0wZ1clP1bnCkGF4csGO2uNm3Fp+rhcP/ZSuWkDQqnJzo1SMD4kAR3JHfLRw
+Sb2w0TyT9NQ0w3QUZaOpS9a1EQukVsaf0n7GROJOpFxk1UcjpciXwcZv5IVDXmmTxveK5atYD6otI3nXUFkfbyzXsG61eRP9jBj7z+1NPU5GprrLZjqO/5k8
+y0dxXdpFFyjk8k1PvdpI+5kX1HSdqo85zzuTy9gfaB1D6TaU9usrP/acwlXwt7/Y5YR8Q5IHJ5YOHI2VIrsSCUZkvchhEoxolbFaGyNEhlmJShHlM50WnQlW
+h1ZeKc+7vXT3oiHavYIkA043g4/xSrna/UkFVzkTg61wQj2+Uk8YQq5f9bYsX0lixEJSx7o79NvqRPlOiStIR8VZJZnKlOmUPpVyMpdKRsrkrFrX1zxO4xQf
+0ZZGe7XbQ+1UFMMgRRcUkSukKI0pS+2OjjlUXeN1qqQWNFVv5Qz1vMt63Fdxy60r2qR6QcimEbMGuJOm4os4i6Y1qcvRZXdIz6jk3ssSJaAlYp7WLdqu1vHA
+Gy0ppSQNPE7PSreLU7BNGVmfpSLn53ku3+1bk7jAiUXXJE99IRvuNNFqRsbipRE9Pz2jY9MiSaqsu8YhOM1AjkE5lcSGZaJgE27VNgExlCKISXDdF12Mz6p6
+vv9FIdNmqGXlD4LQUGu96HqInt696Imb+nETfVQpSchIo90JprR0yvYxc9y/dm+20sX6YNrlffOZA4OP0yV6duX6bCu6UshDRfI8P4IOyZ6jfcHXPbXjWsat
+eX+WtoqSbqhbrk3KvQ3VymOSUscjqdTaH+kMDRyreLnbE0WsIJ38UFDHlNbKZJ7QZxLtEJNwIqd6C/kVvl6SMA5Hc8JaF8LhBHTsSfmd1HWu7W7SxnTlILZQ
+ef7pVsxF3ZZ9Mhlcnyy9OPWJr2tK9ESMtUdLWyG97iuruXF2Mm2nnuNyTHbzFbf7iR2FUhm7gm083kTurVbKQ1R5peDSMXxbgfARY9oDh+fpkTF9bWCO1XuS
+Kp2VutjDfdnUmJptYi9mTGjg6Gf5lJe9wF42quUVKZXT9too1OmHDg8HiTZTR0IJBNXNg6nLQY90L2agp9DnKUkOqIsKfKXXJ2ylyFNqJp6cZmRZT56YiXqs
+TH4JB3VakhfDr+zE6n00z7D4+Hk9guFMe0kmF3VS0nCrO69NFwipCZ/F1kV8RkGaCdkM8Std4i3azpdmgV0ghaqSNM+pLpfnEUioPimhPg1sismTi4obSnk+
+Oj6COfTISYAmJFhry/6shBdbtB+GLPp/2ZapMbFzicHW1WWVXk4QUOyruJb9JI8VquIYkwZjZ6FcU8529WWZjTJaqXLQRfz2bjNJGceyiN5ADW162uk+i16Q
+1rrqaUWGej3kC/WpHJyfqdaheQnP0RcdkdxUnZpWzJ+Jh858Iu+jgQg+KdGvnh34RP5mk0zveZXBkH87a0v+WFQEmn4jhksuJHMIZtBSzjPKVRj5LicT2+/K
+CW0k9Ep7kyMnFs6VdjvIG9LqQ5Ez/YQrDZVD7GKSyrXmv6asyHLgAUxQcsgT1H2P7FNy64f3rKrc2uo+CoWHWp2e1dzup50c8lZu4ua0pozvXV7VSv75nZEK
+AvWxKVFWOcZf1ZKQuDZ1j9hVYUjHDOnK5DGGMigyFEFiKLLc2i7NUHWn41dHKSqBuW/uuQnT8dqgaeRhNokZOpn5PN2pM06OtPUwVRTtlePi6XT3qjQ6Fi0u
+xUS9bqKNCneJLvT2KJnSeX+8Q9i47DhtPK165EC8VGQ7XzAsMLZG2nP4dffBTW3PT2BSsrLukqxAqzCjOqGXGaWy9ntfLHvKtPGtXdRCrci3HhkLS62Wl2ee
+0IWRJ2ltp/tnnaY308VhED9aMQhDGaNNTBWh02RTLlen9mtQtdnYRcZ+ZRLvld5NqVejXqm1+RiTnOYS+lou5FWPxaPaJLFQGsKEG1gKYeW+Dl/9130v961P
+dSk3bluutPYcUcUzM5Es5CjIa9iUxSYYHy7KXJ2E2VT+koAet4KU/dqS78eTLDKZ0xd0Rxy/FyvEsGBC/QP/o5mwkFByLpSppPlb7bnQZm5ws1OoCV4kdoR9
+7bwKgTDjaB200ToyLenxlXJlg2xrE27Hk5O60qGe74ti5EjQ2UIEbZ4+rWfqRGy3dopHbraamltMnNZoUlHTm3naMgwE+2Jb4YA2ZltC6vDmVNPuBz6Xlzkr
+yjuS7KMjlYn9apXcohKy2/d0pqOekoRYyq98tI8T3oubR6l88+RLREeHYitNR+TEqE7nYVbk+8p9pIN9u73PdgaTPofqnbHOWGevxbLVTTHvjC6ZGhUhqbQ2
+tXjyFXzLulOJM976aHLXQ4YULcuiGUllMdwkfs1fwbTlaErjR+WuLfADs/YdFv9lMZxxXBujMk/bUt7TI8HdIYGNJMcxueznMs2qgJJIsE8q8HOqnjM1ETtH
+bEPJ52YbN8e/uhbLUEGazq3rZZUn1y/mSWzwAl7B9ZIvpTah7cUt4J76yJnZWFshX/+bsbrLLR2p1iMT5VE1eQl1rUxe19ffh0ppU8xpQ67rOLthRprNXP7S
+CLOiIwdqKnOf3r2GVq67VeqBkVmpd2v+sfX2Sj0L/gSj2tmkE2QLsT96na1lpA50ZnR7+tO2j8x37qTCnZH6Wc9lLOT2qU0cB5t6FRKuncsjMCLLf6RyeiwE
+UdKq1dabGL9nwmwGcSzrYkcomyPSp5Lzfzvt24W/tXEdxLoUK/q21c0b/T8W9z1W9GjIotRdBmtL6WARLSlqonq/bV64p7bF+1xciHASK9o7nlDEjxSM6mYy
+zI6VH68t/mfcZKQOHglBNKWEIF8SiXlCI2L3KKBi12OMaheTIk9piwsMzOOxIFlM8sFNTi0PdqMJwjam5iJER7Cm28ynR0NS2Z/a4/k9UdLaaPegDnMrwl2h
+wKwY5EtBqEid3hnBMOykloQP18/ZjiFqJVXhOLhqi/4erUjcSmqSmOIZUl1011QlEpU49D75Ppw+rnpHjGDx/ryjpEqS38CEFaBCedi82r42qi11tWhN/inG
+z2cxn3W5zV/rtb56dNvGznQnBdZzI1m3Kh9ZvnFbxjE2Mt1SWimVuyFMe5rWViwppP19o92E5b6JdvnDHkgnTph/6nlc5vbuE8g41WbLHR3x+9pNm6Pj9GS+
+ZL/9J2KGyUk4PqAmVkcyIa5jXPLWy41hspDeYcL0Ulob+9cS9SbjY9rjOeq+Mv7jxXOEWA5/U06NMklnVIcLm9BaKEPtXmSfdNNTntzVp4J0kvqPc0/5tJgT
+Z4KMzs0JQqJqPolGbWSS/VSZ7OYiiwdDugDSW82n25TSU8ZucRfehiqkfE5CoDLnplW+LIg7MEyxzuWY18ilyk/YKeLDfJzECGIRth674n0+aYJy6n45OdPl
+UZIzK9r5JMGTUioruM1DBq1WF+O/uxPCPGqdsF5SWeuZPC/ecDYT37u4Mbf5FSv0BAvQaOd9F4rOjh7rPDwGKSykqSnnCTjkcDDhQDTKl5BvJ3En6qbKffT+
+w1+KxbuvmMQrvF5OAMONckEnCXn6rFcpt18HAdRZb7tnLc/ltGlx90XTdUt1KJdBX4kWa7vZWh15y8VNJlswX7NX8pexLuvN3BLseZXuaSLkmrbPqPtks+7K
+88ytvGhrN/J9iCPNKZXFLBKlTsaNN6S5FVgMt8zrRopSJg+52c7/WCudhSr7QX51s1vkYGToJL1EIR/l5K5ZzPMslG/lA3yIj/Jx/i7xET7urdHmCQxW/dGc
+8SNsjw6Zb1rR/TE1ST0V01XqYhGAjlJfXgfrzAWmc41F1nMoMWq6hAp1cxym0xd+0lEyPCXmfJrsGWvVpDZ6mvXRjqQzgfn1X3Am57FzskdcjcIuzzjdC9Te
+4tfS2iTVYU2uayWPSXUo9+EjX/zR6skxnhrof3bfqeQ+UzFf9DmFiudd7tyiX9Sqpu022GwCfkt7kkeTeEMp8FvSGzl3f9qnJi7QzEcNKotCDNpIjBW82tcS
+l+zJicImGOlppzwKiWdi30WqdPr7Shuj2oiQRceFScgcIT+J+Ah0h8/Ca50pR7Lmc9znUWuJVlbLbcyJVbwjks3lm+1+G2fBxaikjIyEAfqLaFznklO4iT3R
+NtR1lqXlT7KWaT+e/D0cKAyJDf0pXXJkSVyy8K5DZKcjKDW9M6ArP+mYfOqaF1VOn+AyLnuzue2r1nFzxZzKnXaRyEz3ymo0dBrt3QgFxpPuJIJ4kq77sSCf
+VPypfU7C57BM9LxaWowCOFYt/AkigDoK4tcK9XsmKIpfyC4QfpOH93oRprMyfrvYEcPK2mIdenwdh6K84QTKSi2UrC4X1nPtmJJlZ7SQ7iJMyqlpWkqrNHNY
+XLHKtPHf3c6r+TgXoVWmZp9j+t26+sbzYEIl4tGxfG65gJ8p5Y5CHQwFqaSUurxjfropXfLT5VbnizpyHaXeq8SbU0tIsPUR68adNDYqZeqfcLKX4MOKJbmV
+L50hhLHHT/WS+7GCD8s/apewSd3mnR/tvoaX8VqvA7RbBorCSsE60FN7nJKflQlsJXlmoNxUkJhaY8RHB+8t2HWUKqUWg9yprzt6WopGlsTLb5Rq580FS1/Q
+hKIkmPA8kkiuLuauJ5DRq5L56c9pH7JM/OJXEyQs6yx5GrITtmJCqxCcaOHIH6FQkO6a/6tAAzFhfxxfGJOTqaU1C6SOdDh/y91tELG6d54MM3hWEgacXKQh
+5M6H4ObHaNEKIetC+3SYhfiDS9Po0EL8QefkVB5vuWZJydFmtGOlVStVtziho4hrJ0yB+6yL3r/2NenND6pYp81y1dwdFyq0pgQ121pfE7qe1xvyOlvgncST
+NQilY1OiyOerZ48X7b26q6HYKmHlQMGws6HMuEirRnvCtfHGyJI2R4JcJ1WxIaklZa3H/W1Zk1/MFYl88868/vUEGSzkho46w7SeUVehaHI0LnTjCnRPWiEh
+sQkZt5QbGrT/1J05ELJWqHD2FmoGBbaSSc0NqpCE5pSEg2zvnLGuMXZWE6U51sEpu6TUXnCz7orKV+JATfP+FIu8WPbYszI5Y56VCeqnB4V1NJg4xW04ydvf
+Y1479yUjZE71CU39b43WItRqz1b2wlJIhqy7nF5aKT2uy+4BHTZGB/UpFLs6PqmXK4zml+VMLO/DjPVFfXoP25FiqoexPKWe/7VdZJWEthTyzPn7yG8Lt1C6
++P5jmzMTTlr7G+nMeAhxHzdKaXvoaZ35KrFy9XhXf3r3nOx3xkWRb1+osOonO5a/68xPE6vuyskVzun8HNN5PLDOc4r4pR8TQ6jETK3DhFmqbSlmkPTCrS9T
+qvO5Oq6Qc97u8uBJGQsZELrWm3SPJ2poYJGJKpmb2bVSRt40RNUdaYuna5mjHIf7leeMpfdEP6/j7by7kEWgsJ98voA0eiXkxRlJgm3aSCT1sdHcFtARwanT
+9ZNpCWnx3ArhyRXMf+NtuyX82mkVMWe47pBxdFsMW7kQ13tdqGVbz+NHorY0TfhzeW+7c89qyQS1G77LkoNKuytNZRKHQ+ho3n1lQnEHk3JZjcnNWgkFrdxF
+Xj0hyVf2XGe9/otouxamqvRQPIwxfqoyrZCErtj6qRPUi5jAQBCm1RjRDr0bJXYrj81zI5EbrksoJ8e7qyBrL76jU/FUSbCWNxbo1F95hos0H+PiXO5piqXX
+u356Qu6LQDob7UwnHToVV343j4S2/dLKWWaSPunAVdctbezCU8o+ybuJ3MIumWJnxFIZ8hzbD9NW9/YVeR7qZszv0SVIulb8N9FpGLeyPOrnrGhuz3QrSYjT
+KtT+z4r20SwJM83J1Gn1AnK2RVtN1fPciX5V0CRFMA2V1HINYaT4sw9VHOliuSr7lNS2o+E710EtGn6HZJpT4Y1SbquNax+aNk4mey7DfbHP0m1Wq3wN+9oi
+aV7YvPpke37UpE6Dj0vOEwP6W0kcCJKxpSeJHR8bHZkSLrBj1nVys2pnINS986EK4j433iIaaF3dKzqJFJcw4rVWOr/lgxxjuuvPxRxOeVzNxDmcRnrqPiuQ
+Xbkub8Jo0RrZntNJK6O0cRpyZ2onLF1HKVWy29F7ROwdE4w2hTOzktT1zyuxddPggjM26HHu87Q8bkGERidvTfbTkip12hkM3c6K6WtDnkMtul6bl1lpMeDb
+4y7G8ygVSJEnFOr9T01kpZdGSdDfbSHf6OPkrionhJQg9xlllC6ptHBDSGcVeY5ZoHCGRdKuqbp5MEaiaLRv/1MLPFY7+kWJ/93O95RWaUquq4m11WMrAFlx
+0zy+4K+VUkp31IVzZJxU/ndTKTN1DNE/S/hE6YeZSAPQXVWAhGNwks/cWbCQTulkXY9WpqQR6pXR5jEspNHxawIJ27SUlqoYbSkmLA+7hC1YpJRSZd0qGkgL
+5349OQ39De0/yeyO5J5WcZxPGesSkSYtbY8XKXn9S/tDW+UJl0zMEatz511gTTm+UIw48wnTfWhIWlvL5gJQsRryBBkAfH2okfi1Fj4HWd99LUcVJMkFIDtD
+m3Ej6pCMcpdUAJkyGU6UzBjHmo/MUflUCqaTJHb6XBlrT2YKm0gu3GPk9KyNFIVfn7rCbbYxMaAbpVS7BSjLedIqcwebySXzgsLkEj5rP13Rz5Gu62I9wBd0
+Zv5oFqIJat7PmEYUdIur1oUAg6Mok8YYFGKq4yLX4y5/W8HFmOeSLHgZi/rV8z0HYUJemSyLscc1wRVpM2M+JZZTwLpTy0JKRt1BCs2QCKoOctl4Zzx1njfA
+KGWcTNvhmzk3sdf4e8fzZKInakoSpV8gX0/gh7JzWI65vXSwK/j50sX+KFVSnreUPy/PNZcOQdu9VJzEou1/alKtMcSBFXP5dGYbqOS3aZZ6uwsahBvrXAWW
+CsGZ8g1pqZhf3BiVK34l1cJ7M7xrW+vIdREeXDVmMLW3QHcpplvr7H7JI5bdd+03fUt7GM+Hw4mV6VgmbSt5Vr9toa+jlu4JWx3jb/hEUmHHNXEk1cWPYbHq
+KRQstJ86af8haMX9ryS1GcfaCpqpoygJkG5f+brLFS1ZKwhiT8lE60OL1MDlKUs6c/4qFfOzZihjR8gYU5CV7bg8iTN85LLVGVzewbo/iOveiVhpJ9BFrTyY
+t6KDwSbicOZ0dRTj2pFU5c6tWuNyBapxXZZ9YhdacZd4k5ZtvEn5zyc55mXumfe5cidJbUJJhpfmV5ggJXwtz79clKM7cpaKA7QlDSxaSGUiuonUMd9jlshS
+3VJHxEymxRrbIbrKMshCLZ5UCq10+CLSLDXFQMRacqJPy8+SsUCbj3UxkuXZKhYQ8v5OnfmsR1nKjVTa2Ad8AmGUSfycmV+cPqOr0Vnh1/aHSHP3y1wbrVRx
+70o2G8f6EYtgXvtwgsKpUbfz1frGymM1fx5OTfxJ/vL1H9p1OpN4mZY2xidY104holgaNep47olue3kZKG/3s2Psgm23O8ifkGWv1nO6a3uYYJfZw2vhIRVP
+hznPHM2r5vptlk1oyGthvDYeCGmpDei5nosa759gFY5iQuAMyF1UGa0EsqLrWfeqr1NcpVKd15T1N5J2Y1hMqeuWU+ygnQ07KdrdUO48K9Z/xVCsDVqJGSpf
+2ObReWfkEKSsDV+PNhV+pI8pU1g+H5NR0F7mOhS5Q+W9a+GDkLXu8GyF7LwpoaCswh6S89slcEVsd23M/bCVCmfJc3iuY05bVmUh60bUeNLQtJgJLiYHnBYM
+02kwUVgHPcnVOPG0S7CWDzwLpEat3CNehyicNu0l6LK2RFp58GJamC4shqLBs+3+KDsrWV4X2+fJ6vTwTFREx8WN5LJL0bNj8vJluVRbdi3yVSR1no479euo
+rDMQoezlr3ATKsNpE/JoLQOvaMV4Hx/gIzlvYtITjc0bDXFi/k50G3xqxw54AqtfH7OEiFPsjTZaaRVqEyQ7IY2fCnTkYxBmM3zZYM/O7dwTJ7TtidxGek0u
+E/4fzugpExCQp8aIyLJSxzittdZt5bIy6ama4NQu9tmEZJeqoD/Yqqmhd7ZXlfrI43TI1hsRN03BXenuGt2t7aroolSqpHShuc50PZ47JZVq57L0cGHKIZzk
+c2LF6Pjg/ZPTZqQyWhC06z5/e1c2qY9wq8RiZa3Ah7U7MOHY5JnNyrnmFkph4EnMJf8bH7LmeDPtZFL3G91W0ySpujQp100ro7kumofciYpQ8wkRJQqg3EVk
+ze3wQYXQef5M7XJ9a2MJgzkPPfANw1GTFWx6/v4x6kghECpPwJPmqjot1l+T9WUjMmOtxSy3qkng0MSFZItk/cCXGXPp/EZ7anUnv/oqscpuH2PyJVhKEtKG
+o8FdITqTZAUhHCdDaaUzdUSlfum2Y8Nod5tmRhyI7br4QGJjoBbSsYhELY5o2VXOLihVWPN7dXKXUr9pmYY89sSX0kFlcoCpvJqsyiTyH5WHbCtXIk952086
+azEdWyFWXYUarAWlrt3Z7nMtSj/8WTESi4P5PhbnNFKc2/O4S/72TGufFzBDxWq/mRIrVt7oWA1PHF+uf2nSHMnimca4yXqc6mfn5ZG5VPBZdngsfXHfQNYJ
+WQu65Ew/JodXp2wa6Y0vMx4fdAS01N0clqRO/MtRX09EFR0MGKkhO7W1BK693PRdKqlMGelI7TISXEiBkiMx6h1ybW6LSTxIMcTFRZ37fagDI1sskjqpteKk
+G5/JpYRI6u4I8jQm56cwKq+NJb6wi31WuDdF5oAIoslsOQt23V8HsZjAWEy/OTZBxFtb+EcpCIpZnIxQqQFl0llMCqeU/BAEjbWlg51PeU5eMZ+omKMwBTn8
+eVzFG7xsVrRBjVZGJzYljbl4sKRsfl6br+6UxLZaXYHF42lm8apo+5C4LbfZVIaO+W/sVg4ZDsW+ESgVPr+XioXzJe5C2asvblbjU+4a0+YnrHtPoeSRoCkz
+6M0UWRcOq7fIhtNnbEpPzZ2gkRqm5DbPUh5rZmK52Ty9Ryh/ojLchKliRi5RLjJljD9hVWqLeam7wxMWcvBQhJrm0d4nUzKW52INkzrSaU+0UopIW1a3ksWb
+l46f4snrwUghAYZGib5sdIHC52KFjTKeCqii1B0S8UZLoNHenZMH4Kpo59EhkU7bHZhGkrXxYNPsPrWOjVl2j4/2SJ2kPMV82YynpamJG9D4SDqtc6prFH7H
+fVzPOFZQMabknDkh/VVhn53HpbzCc8e78MsmDoYY6chPJDMT2Iw+VMlnrfYx6WVhKXYLj4iylmQlkoIJWimTqULRiEKgxNFAX9F5GIJp4fnh2vEJlCrmRrf5
+J4d9PeCQbVbYWNc5e7ys0g6rvK8fGcOR3AHT9IF+Mmzlx7kOjUrNu+66l4LARrl/VuTWEtEeS7fp2GGpGWmULNvgU+l2B57cVhchzwR/Xoxxd/yrKU80b0tO
+OPV5gmyX5PZMV2tnQk4Vs8GKI9JZulXLzkxLHyuLi32szLh2FZmsKuEu1NxwmK7hZqw68NL0rhD9oIM1142d1M04r/1KzHl/Oolnz9MuJRHtRxk3CVE+TZzd
+ZqIv1IhJ5ZS2OTld1OPga09ltB6JcHRptazToViUoitXwKiMpGJdhgoRAqZ7ATbnpkxK81gtWrt+q7Y1d2pBXw26T6i4nWajiYzHLrmpJQO+yGFT0mWYLDgx
+Mpa7GBZPnyBMSafHp44VTLW2s2IKlPmYvjeyrNrUhnHttmTwydhXcU6kgnmxwB1J7Wk9zuuXMuedfCOZ7gqEwYpEcdvzszaWut0mFtYuymI8d6DNx7+xwypR
+giZaDWPcXKI/OTNFLnh7PcOfyirLnRktn2yxpbyZ2RuaxO9ZqAdqKOjGaR6pkKvig3yYj7m1P9YlOjTNupGzBFztpKB55XqxsAmm5eL6JZk/u6QEwUiBpVQL
+1CJTjLkKjioxrnuJMDP50Pj4dV+LXgkPwcQqBK0802umovrp1TVv2TVyJGJ/TOS/Abd7nu08If5ebo7lWmiX3E02hHRKkhEvC7mputXQTmo1uSJfI2G3WBqm
+iYR1idPyk54LQTI+Le1LepgQfdKeuNYU4g2cw9Lk6RMy93RJG+IdWJMTnvDL+QuX+9ztjeZYsYLziGcbuVzW3kg00hm7WSxJYVdGpTZWPjZzOFhLRANr28f+
+XnM2Frd9wmkpuoEsdR89Lc9OxUctgcZFJnFu4zun/XwUtaU7+2c01iCvBa9BwiN0o1PzNQR9/uJKTdwN/oFQ78RLY/liVTqW6tay7LMQRy70uHBLyDNTppD9
+C6OUKclfRxdVpiWCJIkrVkoV7ZsXxYw1Ll48j4Zrs6vX2tKzFeNxu1Bwah16ezO1TfhYhnFvdDYFIk7XJIz+Egnmc51E0dFGxdHhglRtXJwhXla8CX0NzjSH
+wVjik86fMJqcc0ncXI9kpO5m3i14qnVwueepuTKdGClKYStnMZyjpGPCEvkT75UyOTdLqTaKeZu+aJ+qjGqPWb0syWDyDm7h1pibPNINk1LzY07DqLcZ5PwS
+qIwmC6DZxsGSYhcXZYXrsZ7mQQsXo58zP3lqovVQSHvl++wNAhnKxDgXk/9trH6Wy1XhV14XSvV/5W3hRR9sgYjQhdUkjueC6SOYKUVgt0JCtIm7/neWuS+b
+biE6QVQM1KLozdC57yl3wYZ7P4yZlP7T3t+e0UIH7TmE1Bj7I3BmktuuPeNNMdrwBlcTI3CU/o7PhhOzHnLrJiXd7NYIOROmher23cKY08z2yffN0cjunyTa
+4bHj4oOALZ3Xypmfx7ukux8PniwtX8dFuSvh5avOqHn5iPHyxkdhmS6BiMmH6RaNHnOtFP0vr411V1KZM9+J6eYr5Cyp1LpVYWm7cVKSnQ4kO5NvJt0ySbxO
+MAqHzNKoYum0ls9ZUio4O0O+HOUTl5jCufNBbkVzG1/gy/w905ndwXDraYuUn0Ck8JmSkuJB/xeCWzzdpuTlx32C1G7ktyf0kRsp4wNH6UKFC6H1KhFPtNui
+XWhwocCqPxeM6bD5lXmpj0+0u9Fn1vCnVKGQwpjX7eIDSXkSZ/nKB2zMRTqk2vukzgwMeSRHj8vm5kYrJTd0rvqWVwCTVAw61syPVm7dSS40mdIpByFZcXYN
+qjBAhXE5mUHPSvugjEtbVGyU5+sixP9/iGb0f5wPZsq4L4TO5nfRMeMbk+R1uttYPH60Y1B+sja5Z5jXeKn+vXY8mtFzMdYuto9UYvLzQuW4CY+hIOTKqKYW
+qUz2qayqEnkPlPaj4v7np5CJ4SDGmFbkMWtlMi/Ol2glaRxVehzJZjNKjQenhv1tYh/O4z7f6s6dkUTYGU17Kzf0iE98PdKTJsOrTOC5iZ2emsR7yodWxkce
+x8bm0cnuGa38DFZxUPJc6CU6PDaSuqsYp5VmHs4tPSH+MVdo2s9UqcntvTRRXO9xMfJjzl/crrmMOkkmmMXkRhGfdt41vzmNdMskbkatW37fjsdim0pF1qLj
+LBrfVe+AbankWlEqRHqZQr7h0znHWZWf70bCjoPjkQUreaq6OdF+giyjXW3NdXeAjBYJEbItEp7EiJDxspDaLJTXT+WDjNT6nJR9jTkKXYCLKqtY4qCEOqIL
+OUmjLTrVksNmcWXtCnGyN7t7t+2uDSH2UcpIRsKTW3KBNu2lGzYZrVild2If4CT/pwUuiNvyOpXj5XPancAnN1FkLUVuSIiIGbeDIzT6qDC2/MikDkDjXjo5
+C9J4U8k34DT3sVAy0ZNg2qjLSTrfnk5DwGSn87XtFSOFDZBsCSoNBTrq0moFK6D3pERbsM6j4dJkKuEVvMYfazdp1RHrXvYVNjuz4QfumBgrPEls8kSsu9FQ
+APWSLCdcBSu9PaqT1AQ5tSLTXjZWqsim13lmpQ62eSva+lJGZZuf73xeGrmDROaVF4XbhJ22wsGjPblWH58wQdBAkcQ0rrsliZLN5rew/eZoUs+TwIcpqK35
+a2jJOy6P2NEpuOYDs6mYV+n1vNdpXk7rCnzJLDfG5Z0eSQS9Amk2raElcVZtbOKuo9F5+KWHv9Z+XxfjBdyHdD+nohTUjNjRPDAkS00fXeNMkkeyIoM2VdRM
+exxWsHW9ulCFvafmFY8xibB2XzybqKsDqwuXr2t4iVKtPLaqWGwr0vlKhOI2GYkry90B2tehTX1aBT5f0al1XMfZFrMOh3+jHVpl12KGo7GunvybklRojokB
+PX9CG5MYoDO0P9T84aaNo5XmLqzoufK7PvxpFmw4qsidmsKg8+tP49W8nZslKqpLjiOXjsP/HPIdheCZLKlVM1GNxi7uMMdC8pPuqrNYKUls9UrIDVr5OnM+
+k3srknXyuntJ7mk5G0zWkeWofVV3y72XLvJY5F5mQumiTnhNjMexnh2aYdX2JAHfxVOx4gvOuf9eHM5VnbAdQsjxaLdoy55RzwQZ9UGJJa217sxWYpz0asIv
+TTw8oxKdX3yJe0xbV28pL61WjP3OmWk6kRNNgUt/jvP9Xc4reW3knTMpJ7UUIxe77v2OXW8F/Z6gLTgvR+EnSUPhBQFvlUyMLiZn4mWSpkEbX4DYR6O5wUoq
+46ZrJtPpQSBxws6t6L74+Iu2HALT2vIHfIxP8jlu5w4ZiUpi35zIUDBStBSMNbu7u+RLwSN6uuQSEL9Cfv/2OMJ+4hhyg1brYk8IHwkpR8woSeIHT0zNZWkn
+LWeEjdlmVwg66LgpRYNzavKxT8gnQKIS22QsezpdkkqTmQ9CyatBJ0dOj9Byw6BO66jfM8mdM77YWnms1jPNa1wxhlkre+zGld/yx6/JY5czo7QLYvFFsIsG
+zFzILuTbcSS0o/hsUzErb0dux4tcFOObeV8hSsdLlKndV6TK0WMEbU9pLy/npYtmmzu1aHo63TvORPIMblzT7jJwfTBaOZlb4pPyQ+iYGU4jPc9fzYWtl9+/
+3nd8fJKL2dZIPi9Wknaeg7AKsjaJe9IE9cDEVTCay9jGqJZSpqQSMftoXqZWFAl7bsSS/Cb4jYtujyQfZhb18zRvss3KM+qYn2+OUTmFnIJpXFGIYA/qYiRI
+jkqK8GkFBvcxTlE714mKHTQMMcxmJgo+QRw0uZUsVzbGc05lEKYddFFUzLfBUY76IG2ldPf4dwnW1u6P/Odi/qk+Xi06SHOs1lMM+xiNtfbHQhIP0SjiqNTC
+yASnotfHw0PdssKNK6Ni6Vq76LU2haJ7IcOiXKRx4cp/pbRRPogpOVZ1YnxIlnayLk5yGbWf5X1Bl8Y7JPKfJb6lPMGu7ZJFxy3uUPTOX7dTE5dOWfavaWOr
+h/XuplaVVIskd7ZoUkdTdaulu+TYEQU0OtTyAnOKQp9Pc1yQ9srw7aw9qaSWCXe9MjqSxUT4ns8eCLIuYMTrS6f77/McMpnyQTFKKR1iw1pG+xSa7pskoYw5
+Qsm40vfHWesSSqxEIYMOMWKkYBOf6n3fb5VszaFyb+rjza/hXM+rdXue385e2CkeZpPykMMCl70tclJlOn1MxwM684zoYDAoqWjhL9jE80fygh4xLMEagJUp
+3NVWlzi3WGF2kr+5fDiTu7ESf29PTmCX8ci6RMq0Odl8kIm9eyUdd0kZpZQpYZTxjkYVjVXtBWr8KVc6VvmZlM2R2geC3mft/G+T3Lh5Hs02A3elW9bTQjLq
+6Fq7pCQreKr00j6xXAjAyO3cEqPU6TQLHi6VxmGo1FCcJED1hoB8s2rlo91jPMYJbVyG4NlIJJJib4sRop1i2eNQWSZJLpmpj8NoCWfJuDFG+ZJcHRQGo0R4
+K7Ja8qibQr7BLtQWEzlMOb8lrWd2UpKVPj+5kIiuUJ2ue5boKT4vUGE9dxg0x1XJLeJSkg/aH8Ziucw/iXnfXVCZTq2YLZ/MRRXrHbhv2/ojlRwmquvZ2Qt7
+7wb9o9bVcpnUjY69UirpjSvy6htVTO6itHIFR+QeLqd9MsaVjOmwXpKoblDko57pM94Wch82E33Uiwc2NVnQVF1fsw7X+DTvlK3kcY1u55Yl7CjZrFprFYwX
+OrhWVFyJRqRLrTNCaayg/rhJy/yJq8bzUCptjMpcvmL3x8ZkbgeYtDax1P65iDdEydLb3aMIFErN+iy6SXa+kbF6Iix5uTFYtbrpHKNCzo6nmh2vUEWx5Ntv
+7Bcv/xnnh9Mmi+YtY38f9Ao/RmVUtFLGgBSdM0yVUl2KgI+bMjqUTReN6sSCPmV3aKgaISf3e5NokILG5PtcqfdMVEPASt9Nqa/hQiLC6JTTVZ/YJWRP6zzq
+lhZZW6oR7UzTukRMhxAKl+jwZ7mJr2VMkn3EHI3FKEuRinU07ImgiNJmp7C+e8tet5lU8gqbzmKTVGnxN3RtLGHm2oO7W3Vyx+8IedpcZvfgm35W5hj8ozFt
+SaDslYzKlFFamZKnKGQkCcBMNOVl9knK5X0ySc6ELGVlmVS/SCqYa537erOkJrZuy/XdZLSDhxU8m2EsUnpiTdjJI536Vi6Htx/0edfL+dz7Mokh/2ueXzSc
+8zoqnmVUiPtV8p0uhp9HT0l+5meBglyYf5upa9Dlaxxx++OlcXd0yYgygWYxKc/24WOgbE8zeaQ+yY2PDNy0jsIq3ZWNvHpRS0uscKZUsNB5oa1bd7UYf8Ve
+4B+IinrykXLwrK/H5qwcKsSxvyTyF97hrbsf42/5FJ/h9kL2jPbciz6syC18y8BsT4PRHA2ZijzLzn9fIGlHNucTSyijlB5P+OhBxEkTx7RE1clCznxtoiR8
+zGwzeY6NmPSx007o/G/afVZdTNEx18aJbXV9gqb3F8W6Nl2TBIh8kYqauTcysY2k0kfTWzknTIhbjgX8YnIuHeO4lIqexgB/nNptamJSr0K52OhULVo/j5Ja
+B3QY2ZA79/gkZ/qAjyl6kdSCy0JN07bw7UrNuWFq/uqq5eVPXW1cSfQpPc66mjcyT4wQHdgVXTXuk6+jkqFc2FRu2ghUah/HnRU9AO47H1BQMNwV+tdDzefb
+lghaat5u5/6NBE13SpJQIUgfE1jz/JBMcX0N54oel5q+ks/dSDqFkvfiFOx6mdJGxWUQ5IxCae8O057yerIxBf9ZhR5e6KSLt/B2z9v1mn8S8eSly5zqXkuE
+shGfGj2tWdB9N9ijouLm/fSYw6zjSjEqZJ9LxP/UFRI+yc5vyfMyHedWJlQldgNvqe1mwFM685FUSutAhU/ioWo0OSfJy5DbPSUXSs767iJuZYnFq5n6V+Wx
+hFsxpUNzLHsy/CQvm4l87mQxpdKUdiUvflrxyWhtnIAugqmOXlWVoeTwzOJ1ZEpdimAnZhMVys/oPGYsC1JZMQ9V0L1SGTXWcU+ockWHchJ0nYdNtWVy8ieo
+syfU209KyY5YNp1uf6VKhPTF9uTzJIOWVu2pOI3SSpUDCbuNOKnVEZ2a/LVuFUymmZdNK4ld8HQfzTtaiBx9g99fH+iSw10u07Fa/K7ZsRo640bTzx2ZPiQL
+afw5+BCdpyMIKH5TGf9NK2T6EGFNK10I/xVWu3z2ayJYGdJspT5fqdZFG8xkXsPbUo9BWBuenePpYAlJrifaG4MtsdbjlRu5IGq5H9U+UB/pUhk7sEubBaaO
+0c4fbNoZIXbX+IPCrgyfxCqm/SyjtGonKmWBXyX0bF1cg4Gx6VRlncQehScW+Yl2nC7lqmStuLEKgSFR4x3tTrZ1tMVwIo+Nxu/rBUr75C4+aiv4ytryabi9
+qFbyDq5STLKgg0E9pmVo5VasktYtJPxK5REVuQ1WxXp5PmRFeXaTTlwLLeXND/ZeD+dxzu0qMBcyf+PUO+g4dr2MRL3Hi16exBccKWILqfVMfCpVRscq0RBU
+ixE6Ja+Yhqq/kaLk4wx1IdxDS9YAt7LssUNwt6YXVOLEd+OXnkORFegNo7rdTnQpr4p2gfcXcgOHnvYUOx5NBcfk/I6NjpyZhZGSE6rwU9mtye72soTgEaqw
+ymF7NLlolI9i9NGM+igl3fLXlm6FKF8d7zD5Rm6lnAqsZH2GIiXy1VdPLWiT2iR10vpjVs1LnUz3Juf1uIW/9dEnkf+dGsdjipt28bTjUJacRM16XIWT5AqP
+Zjtx9djNNtnJgKNRpxrrPPZDBFzcacFYbHz3WkplIf2PjiYZcfdkuDNdMmy1c81aKiGPh8vereT25L4iRBwNyY+UoePsushFmtyYxn1NipFdSWkdd5ePJETZ
+ov83psjvkB0SKaHtpO+myk+NUlO9pyxLIhStFddZWra2bVwyEwYr1yol5po8BWqMYuoMAiqHyXL/07O/uOf9+ZaOY7ulz0nkhQoe3trph7N7Uh635cM5Vyjj
+KFnWfUab4Lex+3W0pzY2eWy0Z5r/6hhJMvWluJU6i9a0cm+qUto5nd2nEG6hPZ2xGHBtjNcpxQ2fBbaWu1ON/61pj9mxFR9P5Qynb3J6XojQmmucZmGHohJK
+AFXquTAo26Gli8ZsU9ZHbPcMkPn3gaeHikqVjgCpMXHjyrvVK7ZITkITEfXTuDpV5BUbxt1blUyqd9m+PLXggbkq71Hem9H2GrmV9lrq3epyS7iahLxLz33g
+u58645P85/GL6ZiEgAv5M53RosDH1nltDMXZjHSOVsLmGYmes9ClKY67daRY7DQUPRt3iyZxBLu3l1Z58uek+N4yhg3OKoycpA33Dtt8sMbsoq9V0kEx43E8
+dCk0RGeOcS8LsOWe2mqrfRfrA4Vq4VEEqbQHGVZGQ8/r6Vy63wYZuGxaSrUiwdHzM5zC5mfNzVEpyRGpw9C4Ccsi/SxoJCeiCjmCT6fKsLOYv8JnMnB20ikp
+b6fLbipkPSnurErbTJcjRceVe4954nPdKcvt+u3xsLm11z8+TlyN/qHMb9Q8Bz95aXeOQ/m8glen/Sv8c6lYH2eGQmhyrY39G6I3LskEJZOs3HTGTLFzJlwF
+wSrXZe7yvCVtt1ByJmkukfVW8x7NZOLkEu1yUqVp9EcqdpXlZEBl3z8phO33X6aVUSaEvUcjgzY+aXmRL59n5w154d0imfjkjENt1dW8rMxY3W2kWF4m3RCF
+5rlMnEo2RckbLoyY0eORkY4s6XVbrOlj48CSLKKurPNo9/OrMpocKiMulW9ooeeuFxppWq7CQsmoENDmPyRteKYcEVxiD07uuNuKeWltVufLeYWs5qx4xtUr
+kZQip5sVxSdKZ+2+uvG1n3pc3iVhCrlzRurau9o34yZkSvHUmmKsex4Z7/viRsBIdeasG6+js/b3U6On/pXdTu6e0bAJi0GkbTPjOjwanFK+3kVPLXRLu5bl
+ufgTpoxkRPZXoI72Utuf+EO6i6VzxmSuR8WctHm+qFC3pyfWYhwb7emMQxj1gXFpb2tegwgMR9ursrZvLGYqZQruC9+3lpw2InWUQ+anjnruvltm3IcDGmNj
+7uW8DHfoqTyNXoZc9ZTndsxJvVKvNP3VUBaho2sZHJOvqJyMWUoivPJxbkk2mhYuXjcpfGPtRuEsD3fXgM8EeBnkFtW2vO89QRTO9dM8oF/ScvbElPc6XDQt
+kibHdd3yZ3IeThjMgPZY0XLgOG6lzuyyyO+hUhxXu3uTmhPt2QfGcpGoLoEjtXAMjUguKEc5KN4trZgCO0P7uhKh/GlLS+OSshJ0ypsXRu/VK13O3f/Pe29a
+x3r9P+7CZOn+n3aiiYs47MZ2efDZPK+zZ25d+DLXtUp7JKCwTH3htqLY7KjTwU3Z0jpTeRu1Eu/MeGTYW+FRIpx0Ib7Wx1tlkZPko8rCCHe9LP3mc8tjbLSt
++k1Yz2Vh6LRi6reiADmep/pqSYRRVihao+L9pI/a52ckYnlLGVPq4NOJzG3vqCudD7TbWPuxTDQVT1xvy49UyxPCeCJPMy8yZz/CPKQXlA5MGzcxWplSnjNC
+SR846plymcp9F96A4e2QnBjPGanDVHP1BkacZJDcuiGPv8vjFc4ex5vJJj4lm3WfEXikkO4gVJPzLixzND1+8mQQxuiW8ivc7qA8l5Odw3AWxWPU+3b98Znc
+Tee6bDHv8FzeSBfrmg0lZCnyZMhaYM3I89sUqcDEEnnakaeaIbG6nWWjlajw+YcuedJyeMAorc1RYs6Lkv0blaQ5aRkfgCMPe4XMi1B2RUiSYE4prM1QObg/
+VvPMWYGSU729UoiwfaLWHH7Ovy8m+fcKjgllLlHjIi/lXvSOtEDFkdC5B0VLegcTLpDjkrtPWu1iK7KQZ7TLOdGTxOnZiYu1HO2P7ojIxP41rosqd/SltlSe
+tbjEkcjOUiE0VY/7fHm2y2WtFE+NfD3RLQe7cEqvyONLY5mBHl/PrH0Ojpm1yXakrMWCHmoMtHTZNkvLLR1SNk2UlsnI9ybuo+4fOt6Xyme4Ojd68byEl/Ma
+xupykbivSTo44RB6K3D8zis37nNIHuCMIKL8ZflkBFqlVsZ+aiE3ob0djSqjlBl316YaN8Z9VqqkzZFQc8CvLRXsiiq5G+05dzr9fq6s9TvZEeHYHetY/4kC
+UamPZPZTpWxUXP/dFYUkhiRw2Iw2RtyuuqWpxDVUdkwmq/mE8zfUGitk0i/7pE4++4aj9F2S5Rs3LpZJXYoBddOCmonGqXUx1UaW5O0q7nFxJ6tMh7gSz0XQ
+OvF0dcvopeKWT/aPnFkVeqj6rKvn+nzJL5T1Fkrz+NM1ObFC4/Pv3MlUNMSYI0p7lsC46tKH6Cx3j4emp74GqXunlOqir57MmYVccBd5RiY+333MDHtRVvHC
+QVMSJ4xO7aqqdj+By1KWVIUUpXYNufhWfWxlNTM5uUl1q1coHyLftM/HmX6nEGqdOBzTbHFEldW4KnPEFGvgSrtiOR7VYZiYnNjrpiTnaKGWVzj2m1La3ieI
+9ZGRlVy9sWja6q86l4rs7WuMlCA/ipeeMlJjvnYjFkIaU1ed1OAr5tgoe83XahKRa18LnKieiUzB7YVM6mNZl/rCLoxVZWpcZSLy/P9o+xcu2ZKrPBSNWEul
+rVbTkpZKqa3WA7FIpUpCCGUrSUpy02JKQsigAzLICBAPIcA8zcNwMAYbe/p9jn3OGed63HHHuPfXzB9WueqOmK+YEWtl7d3CJ7t3VVZWVtWKWBEz5uOb3xcb
+5uU+aseA0blmNfGhhuMzrESoOtVBd0xWcLnTH3drX/UZv+l90J7pfbt78ZYdIx5f87q9dt7bKyw7c6EXblUNkjQpLCUtqDOxvYFTfUlelb/T8bzepk+4/f3S
+1ZXHhOZHKz2ZW9ksPV6VY3DJBy5/C0SPxmRQNe2uiwswyYk3SMxNdf2lx9Tnkd7runes57Cheieee6sANM27ehdq6kI+1oseiPfLQr5fokxYVLsbpCIKSwJL
+iyn/VtgvkEZfX295NU+2b4wEVx3Qh1bIzK6SW4hyjKXZc3DW+/IPpekRL7g+h6xFAbs5fWPtL/N1RvLOp683YAEiLqBcMxJoNqq5bEAg4ACPFH0JQyJtTW8f
+4iwrlrPl6f9Nx8wwz2H2PPlRsYr8v+dh5p2AuyX2sc/zS74vqweXg1c3xvQ1gabnqBaQdZ+2r0jr+VLRt+UF85kQDW8rX3VfS7qKu94yfyPGfs/St7SGzBHE
+aWcchk1HjOUr9b9GRys+1bu89+7tW0U1VvulGDL27oztkr9KC+bGfgfG08ckX8EVv7w2h/D6Rmg8+DYvEbVRGX+ojMXHs8ezpqnegC87tmIZ4hTFU8/jBj23
+4KWynlYk3WqP0nZd8hRA7gXa/ZSMknZ0ivhWlAPFNnHLYCH9TnVk5Bdagu+Vxn63vLzJUkR6rzlncRt7ISyBfvDP4sQMEi0NRFpiWZIm6vjTohRMpiCdOT1a
+LheAaGhAu10O8nOqUhm62W6cDUO6bGtu7OQdt6ddhdPf6rpVgNesPLZ3itFqxyOGR5ttxePhTi7emyoSD1a2gfINjCOVWggCAGRPo1GH8iB+wyWFNnr9UPPB
+yFaV9WumzVqdmFBz3fm/EFXKAQvr+N2DxvJ5oBK7xx4SRNC+xTERXVKTYwDXn9Hrsr/Hq32SEjrwYgAU3esMIzwAp+yBkRNaHi//0jP/vUXPrp4jqvndVmNP
+GwXWvL6K7ZJrfVzIrs+aFTJuzdFDuVyyi642pPVpq/LFG3zVPPN8QU65Uq9Ms94h7SVRykKIMBi6Kq4mgizr0Fpbs3BMgBxqViu76XIQZTen2pnrcPuTUAuc
+piqsnfWV8h83KiHK6apXw8+tgMG1FwnH9QBaIN5Q8/nf4ddjKrP1auo1hdPiZFQtUlDfSXKxu8xZLvExSWACVeTL/pVke7KrlSs2ZUGUyyVWd9m49PTOZg4N
+6VfuqSgInq1Gt3lvwz099Pc3S9LmqZssNAO4us0OmS52Gx9xBI03pc/X8mfJvL27Y7g035WW12tN3SC3kUxmC5bidQuzZL04/g6QQL1t2ka+UMTQbZGe6fVJ
+3sii4buKRV711GlfnXYWD+6F6gGi/8p+dUm+S+VKx0q6wSef7EsItXrBGPxS5HNs6Qtbf1Q5Wo1L6nAOLRbqUOeqbZwuhDUrbQKWEqAsIp1P0viKYnEGaJ1Y
+fkJISge18GYmHp8LGz9zfFms9f/4NRzbXBlS52lVNzl2yDbZEjKbY6oFSwV08VZJi9NGYIejkvztWdaeoiImrgiGfT2bTM3qYkqQMWoqEhR4mxHi1Wjb2aPJ
+M2bN2YxWnAl2RlhJf4qvpio+7815Y8zoOa/4gw6r64qYF80kaMOQ43R8slx7iqgqulKdrgaHOLtO2580Sq2rA67R4VOF+kqyWb73TGsMouRmz5sK2Vz1k0bq
+K/xdStvyIMpzpEZz3Rq2bJyVtSphvIXRx4Q0pk8FpfFfEB3ePfdFbwKrIurPGhDmnZ7w0yFW5E8mHqXtHceBW4frBQ5QDizr1ivXv9CgI7voOnvQbv0IstKy
+OACqMqrBymthFGu/fRljzT4LDrd0rfyNeDGT3cfAZhcTXDqyzq0pXnXY4m8cTbBCM6OF9c7q5rt5t9dGWp0IBX5DvTPyVdU1Cj0l/J1idJOh7Xha6p33XyBH
+ElQIbyDYAAhcthh7r8FtV0EofSGozv9q+n2JuG5W1eBZIQXHqau6F3K7Q7TK0RCelANw3tjWyYAbqFaaYEGv9ZSnwpbqBTnkbPHKNmqxuAITeeFQ7QYOwVmo
+DZXck1S9jHfk65v5J76hHiGH/q7KJbAxuJCDWsrzMQFQTqI/UkUNg2GN8WPVwRE/CSNiGyNmH9IrjQKl+JqlJhTP9uhkZJWtvRXygG7HjgkuAG34NHBbGTIj
+TNimsOr0F2tTuxC7vFQbNSbvKJikNWN2ZV2nqd8F1mc5R11Dmn/kJrAaaNs1x5CsuAUZBM32AJATERNmKtUbAQIKV70l3EgFbXVDKXUDebItpKVrtzYCpLTb
+qF1fq6dKTvo3nJOUV5sZ0IN+vtsofVndeq63zBV25oDaKfZsHhBQODKRshGxDoQLSLc1kWSdDbWNrqwgHiWGzBZUI3K95oro2lPo9uW96YPeQ/mWeCU3oZqU
+JcbIJ4WbTiIoqpk4KQfeVVXUtPB1AgJcEGhU5czHZFsp9GApNpa4vGcApscEyknc9WqU/S9x7tdrrfLQMKUyVFrjol2rOuPHA3ez7KrtlBG8mflA0fRiHc5G
+IKwtYhgSBBS7UgYd14PrwqE16bHDrSsUcwKOumrfBXKN39g2JD6R9FiIAIXlld0zjT4P3UUPqt0h/wNmJOcLYViPUV0vkmqnSgrvF9T5YF+KysCaqZ68TeJt
+FqH44k+Ttj+qdG6dcZHIksbq9ewr3YeiLI3QAzJIxW2rJrVISo8yoXYGYuQKBV5ZFvUYdswEBsSP2oSWttEif3kfNsVzL0jJuAiXlMH75eoDYXEKDNvM0mLv
+GurRkaTFVpIsLXGgU3NminX7MCNMf1LyCM85SSBTbyJwFd1sgLwqmTZJwl2+U++P1gfVw9VOdzQd1BK/YfWVF9KDn4SOXwKjrLdBV9qrvvZf0dr8j6jHI4rU
+9Yw8297lHmy+vLnt2bk3c+xFv1o2HhMsoFvXyAyxUldnyb/KLA8q2qw0rZkAlGYdG+pDnQlMqT1fXmnUpn+88eSFNaawmfyZYi34Yn1serqwr+oXL0tKnXrZ
+8Kdw/MwrAbvnkVipzgGhIHhwsJPRfNFHCbiBanNVxPYsymsi95Utl81LDGlWx4+70tjyCn2Asffi0Rnm51vKllhV/ixvFMX+K9R4qhvvpqsWNxXg7ZMKJdGg
+Z5DGqbCg0YaVY1bHe6nnawYgEuh3RvlC+ddsTzYVdKxn2Q/4Oq/+R8GhmG39dko3W7rjti58FCehlTtNew0GzSy9Icfcrj8Qsm9ITQUBWohCZcQI261CAEVq
+w24zV76Kmwk2VwAE9cB4Jeh6fkI15b+klX25o9bBZR9qL2q5u3vJaYlr1FErjMFtKj80R8MKBKbGqGcGRyGLLHPV8Sa0ApfczWx94Vg+c8ZJMauZRAVLD0Ov
+7vc18W+kb7ku5Z8EXIbzjkR+4bYIdmo57XshED/h73Uda99oWLvsESfEVu4lJ0JCoFbt46I3upx/6GemJ4Gv+4vmraCxJ2LbI/oTrGn+M6pzlQR9aJwJTSrp
+uIu0aKZqVp4cmRdxrVOmPvfI2ABqtAb0isnr1oPJWfB9QwAShxLggpxjMi1u/ckUcKyyEz8ScHpvBiaRX6t4lIqwqpjrm86XvG59bgSs241tkZULAAi0lP/d
+rwzWhz+nDBdlgJPqzCWBK9vojWosDy+CjD5kP2clFi1epnn9X/WeGjUu2aR4HN8aPeeDU7od1So9V/x5HOGYkJS5k1QhHS52+KqOJRnJywBcpb3URLkUj3Rg
+ssD5DkIGBMy6I9t+5d9L/zz9pSjoGv/afd2PeoVzS618NbUdUodOtWShRCRP920aogYlpiBBtDRN731tnpxuCSuAWW5mpPHAaxEdf3pMtlN1j7Z8QT+pvof0
+a7Li3mzK0mafplPv4jaFeVEtabxcJ1uWJaBfNZ48uG3qGOF445KR3/BSQCcBkG6+cBjVdqasbLVdIsZMU8MfV/zHn+ZRf9t5tv/Cu5q02lgT7usJ0DrxJEZs
+EnkI/v50KK9O93pKVfeijx/LNh+QhuRdOauyMsEFALG8xSKDSy15y0og5cMdeoJYpEwI8EDibRvBHv8fzF1zbhU792t8ahWGINsp2woZ54g2MmaJ9cm2rdBy
+c217AACV7U6RlFnU4qokp55lALC5Q7ZUeaomZ/cd6rZHs04Ko94ntQtUstHFSzesRGDM2GtqS+EcfCqMMSKZZo3FD5y860/si7HpoXBYcv0aADNI6o6tH4eI
+dCFoCY4wSLUtuts1I4ZKVSpZPVjt/zl9WjMnktdSrvWoixJG6fm7226ENyrOdnhqlAz3wCWI3gD5OAW+zlf85FjlBo4pjlVGCxtDDTjp1zVu/Ir6JMbPI7o6
+xtEjySHr0eHy+Wj4tSmw99y6yT+4X1qhs8/VPT9W6VZLgCpDfUFfc32Bm5QcmTrvPi1AJsW7VIq4SNmkXgAZ15HxQ7tG5wUZtEo5XeSNOfHb+PDUFjNUYCei
+eLVkIUygzyLfMobZbXv8ivbCJ2LdlM2cNfHHwulxqhmwnMSZqVnanKhULYuhu6T0ro2/IVH/y/2lFni/+XdjIqj541sYe9CY3XJvn/XIVHgo9arkimQ/nKWe
+XcptK6Ry7GCScRihwcxppWNFNF24FK/mvGL0KuedAtPKKIDKD13SQJeEjZ3TJ7TdNyB57jkd1GMvea5fE6z9HMtAq06gm0rkftL2WXEFZVCztxVM+/PebtD5
+xLXgSh7B69fSChp1PKo/bzFZ0FGIDwThTuXKOl6s0S2DoELSTXf/uKayvlfZBAUUZDgZTyyz89h9rDdlcaljYMFfTerqPShpIR5JibiAxAL7jUk58LVwJjGI
+KnFXqaznhl2Gl/c0EAJKasadgAEEDHYxacsMZS0nBMCB25PauFS6y38wHdJJ+sJM62jenezA3iQ4CGWxyRxbO9P1BJfeBBE4SpSJpDLYbLsl3LpsNZwyZxB7
+KYkN1EBm3AHW3AWvcP7sE2wVvmK5kXIdWbtEZ6G/2I3Oti/zysDSYnyFe9q7Xs/ZCM34OrPKkoipXFjjRTq6Qzk7J2WYExPCd1gQ6+qpkMcgwPvsY+mgMaQy
+17ae1bNrduSkoNEy+xrvD8R970AmUmcGgyDzrUDqSKtbIyF+czF4Gzx2xTZYx/+nmNm7MNOme8Xb3cpFnw41I9XNryKb75xPbbqXGFcXlBypzBiirF0Z3TLz
+jKecjEpXjnb06Q9JN+gnH7xuhHz/uNOt4ZF9lTnr3+Qa3rfSd5Q5+i+ExbD6PBErafLxh/Mc0v0B5aQecHxvv4WMT9klww5Sc98fd6MKjDymMWTbOIfjdpyq
+XIHuq6T7ykXFFpVCK2+uTN5PPuqmS4QWOJH1I7zfcaTvSx8I3NKRE6VmrSUTzztPhKen27ZBZ7OlNAgPWiG0+SymRQ0exsxH31vq+WptSPJE/ZguiJDZHD6Z
+0BI+rYrJfZ3HZNhKptiotRCGMwMBZSC2crhIjz+b4gXT5u9y3oP+dy2cDpFfNtKShvTAl16eXVJKWX8Xpn2Y6Wm2Z/5xmuXTaAQrWunLVjri3Bd/4hW20MLb
+peE4+uHIcWTs2vrr6190UJcAVzM9JhgwsBcBgfwJ/XveB4uK3Sg6Tz/hFfWC8kmHDr1xv/pa96BjfHgT3oaJmOx3xHr8GATvndwKhxRSBReAbFpXsYWthh/k
+GB0S77msrQEv9c3ym2PuqeyYf5S+pDul0YwJujGM8GMbUeb4qBQHcxBdVIshPyNYJpa+EK8Hier0a1+m5g8HxZ/Jf/xAwEzc3rWASgx6v2Ek+8g6FmAMamp8
+xmPkm6iIAMUxVO4pdcz5FLDSibPkDwAXs1Nt8qPcLyG8Viy2XAM4IuPzG8xiLbfP+TZcV+l73RWAZFcB5udydA44pA1cux6uxocDIzT8BtKpd2UuJO3jk2Eh
+ih0OytOj8yFzo/OR4bIqlo1i/AtaHIDS7RVfvu/d/l+0qthxV/X3bIrVs/l4eLJ3u2u45Ofeim4YD6lIg5SAhV4GYBRjbmQBq07hSCGim5VWmhBQ49LoG2Lo
+HZA47ad9lUwn9baVlfl8Ok9Prt+m4M03iQjcpzKuYb5sgfS7fC2wv1L+76pmipcbjKccN+Kwu9BLKZo6qan4hZjszVwP2FrWzV6ef0NlRMonLfNO2hmne/DS
+KOiq3VNG6OZE5cdIDxECOQiujJ+XHyewmAtVz0DuRTlLhFVdNoLQVvmICg/2AJVRwjcEBaCD8lPxRVD5s6lildaYLa7NxLu6NYNlxZ/3oUK6pobqobTT6Xhf
+ZjeUyeosZupYTftEPLSzOJDpjBtzlD5hsMEQ5zL0oTPqftpfxfkeq0J4M8uZZ3lJruSktU0fA6E010bMbhqSCl9oD7Ad+ekVv7be0nxbd5zArY4rSiXD/Ex8
+AHrWTQSWu2VROSX4rANCWFUL5KvsuDHfcINW3Vs1y+zJBr9xuqIWmXoImJNRkZktDrDNIXjdT4i5V0NQvoJoNBZVJLIrTq6KIQMb8ZKkOubXhtJtG9ZE0MbM
+59BZ26jGb+KuFGwVeNmEPGi1J5d0pRuUyr+6TcdUYT9dVlrqcg9xLGHt1N409/9yrB4ct5Fj1p/H//NOXc06/w7rWLvlxSOFA9xu3JMbIWMblCMFW5iKlln8
+jtiIMygPT7VFrzUIH9u9X65egljt53F/tswbhiI9TftS7z94Q4+fie6zBgIUkK8qwRBiY3Lsa2kHGgAvWBJoA3rPIHYKjtKR6Ilx9+cCdjJyHY1n7dTuNvHm
+jNcFtCBwQ2NOkGWuCRFCo2CY+MdEyWr00fZ/kPmHq+9jVc2OAWXaZ+fYsxBiPk4m737TpTz721G+GjxXyYk3o38k0rkHgNVdqWydfC8IOVqCLHtMTtKVjD5G
+/NvHVzhk17Dfn9sWCMnCSBJcZJ1OLPd0+/L+DkOasQdX8mDouvsj3UZ6oAnivGLIAo1EdIVQeVfMl23zCtsdjjrGvVem9q0obPnmabrtFyK/epoGA8ACtBo7
+gODlpgsgrXoeswlK8Cvy2URE6/qMPZvvWp3hbymriWR/pxVPS6ANLlZiEj2VMJSbDZ6WAUiFxXPj0JnF8+jWEYzWjVUjQj5lbI1iYGp57aqfdfBM0Gb803hd
+Hgsdz/uJV1mR3zmwkK0dj7F12IybRuMELMqFqxJ52U+ImSEime8ACtgr6Gth4PUVq/xF5Sq5Yhfm6VC/o1ClcLSe5+NJTpnTsfrSaBh1lzPzG0FOQ2/bn+Mh
+AgAY/OzkcyXylch1Bx6Qg/GAWIkyLp8VkaDSJ5yZpnJ1uis8UZdNF/lWf4oM8AmBYxsQy+4GVx1Yn+2RAcFZtF6md3q+sm1PctVLWwKqh4jJNj/ZVb25UXWz
+/sAT61ys0kuvcmlunuapVnzLar954UrPaQGUOPNC19Y6d1uzL5wZc6kc1nXNWKaWMxNZOR1cnUchPRJINAtDvNhbC8ig/OZyTQQ5OHwXLXR54pVkfqkecKJV
+pHFa7V84tKw2hoc8d+IKUR2Y+0t3c13aq5V8o94dkfWSCt4RgLQBKgJcrRGAPGFHALX7FaDxVRHA+u4xnE2GQfYGQoWt7m1JG2zzWNWNeI3PrZs96GUITQl4
+YbG13EzIiQQiQp+TdNih9EQPYDqoY8vDY96QVHVTrhdlNc6TVcBcZylem/JLn0+qIZ/96pwPaIC698pazLAAeemTVXZ1bHJajvSoMaXALhuO1JE5Uks1Rq3c
+fOwIcrTnuMEg7bYYVJtRzR1f762JPZz31mOGmhwthrCVgje7t4T0JLlRdIhE11diLfy5/hpdWCMABN+12JfYU2YY9z+o+o+VF9/8nvuXZfHobGl+AofKRBD6
+3CKldIUNSNPMbeIDxJblZGE0msd0hQqkevUPDTJVXGF+pO4cDFWCDqFYd94LYIr9rtw6FXX0tAmrxBj3QtUWUM2rFpBou7RJM7Q+yScDA8/vVA3b6en7W6zm
+bShGzpsJ8LLyT28NW2mVmlIJC1c3uJrMkI9w3dpgQWmT4wU373Xw/v3sVd6k9/pcfKzzGKKC9q/XHMcuSoi7gsuhj/GacG4tg9wiWUoMTL5PLRGdPInNauIb
+UscUgqFQIUKqsR4DAkCPku6BnX/ad5n+oq/zhqf82uI+bCTQQjirjYJhhXeXkxPWVIh3BPpYGxUGsO/CJd5Wkkp1E/eMnYXb5F5nrF1AvKx5zvZXz/5bXceW
+36mt4voEmxxPQuIvw0I1pCU/T1oEajatmLZm77aKUdLtkQ58ubvK/Kws/gYxVz2K5j5urVuJLO69vUUsdU5APFDS48pbbQZoFyuBUnOKHojcXGWfdEP1mKoB
+HtCSWoqkv5rT+njohuAY6qmcVn/GHLRhsOd73M5qyVgMxZp94YWslj4d0NJZeIm0j4Fbvs3IvaH8ol+rXGByzxi40J+cfI23cl+8U6ONwMFkoxBaP/lCYjaM
+hHYoryee8vKNi5+DF/CSCnlzBq64sT4fOmukK8PPwOOWvEVQOOmOvPLlM+2wLcMaEkEUvzeyD0GGeK7aDr3HVAsXgQseAMaEEn31tbmxyfj+YvD02jFs2Tnz
+XDdO7ai4O6RyZbWxoD6IYqyI5YmmeuuW8CXWn9qL8kW9GvSFrSrw2cBNbh1BOqLnjT1oo1vh8GatV74JsWfmRrubrHxBTaxbvG1YgC91AaeprAvLFtXAyDUG
+T2nDD0CM0QuS5x85u25R1E7eSLnyq+24abjx7pUCobxNnZZj67EIGqq7Y4eakaet4gJUyg/EWNxJ2laJsvQWFR+JMA07uBtHU29jwGaU8Xcsq9PRpBfaAON0
+9MWn+eKzZON3txv5Cg3yW+0xpTUMQyl2IeOSyN6JXjGX8L5LV0iQ/4GN+uqhyS2a1yTKlf+MfQg7fTSivlX4WRv612bg815snb1y91JJccXNKFLSM+SWTdLy
+ynZ6vFKcB9SFmEQA7seWwwm2kuSvrvI2Uov8J8wD8dtbWRsmTw5tPdbD0dWZ4vLfMDp+fEX8skToXS0MmqxPU/Lz6agJKoDw47h0jjMfba+sOCHufDWnwDW6
+k7zDnjlny9fNPW85SNWVSmBSLoSPKqKpvsWCqjzrvGucgFAAjbkTLQWp+E3vcg659wY/l8/fcLXnebc/HybDYFt+wtKD6/qSJClmvWwAzFT+NFWx2NZ6iK0E
+IIJCr4qaruUV1taaJFMBkGL++z2OoP7h9CnP5LPnNys78bRnG7LZrO2ajYKHN293dzqKz8fQ2fNJGYpkMx6lTczbscWsW8Z5vaMWIZYkj+AzXlYpxGxdNyAZ
+80Vuq0o5yZ+Ktaee+6Z4u7+r3sds1drZou/IfyFNM35iXKkQriGfTjo1AD0msBR1U/hHGOKtDdhPeVLuH9Y+ArM3AlYxAHEt/sv6SQiYUvrg1Vxw2WmVmT4q
+BP9p7SzNkX6hq/Iq8FVcmZOV7qfTXfBibkPs9kTcmsC5OCEy62ggJm10iIAW0G0Er0G2oUeomAS6ak2Lc7oK+YMtGoNeyC/V+uTT0Z7RFJz36xRW64SfBNz0
+VNCXkxcpIkAHKacnQj9qgH9myC4J0WP3RsdtjXuRs0YrHJXI+3/ilhi4q19KBPg29sIgX8Hb2gbhnv5QqKtL3CH73miHatB+0lt57ELa2UInb+O/k3gDyI+8
+nMCjDFbVU+rODJkj3QvfUak24+KrlGjQ6w5BX+wT+UX3bnl/zpY7lfKq9TceY5Pqmom8Vd+tqltOM7JOm/NHOSTxMTFCH69gzxEAqCbX+I6UL7XXpHmvp14E
+4IsomeUmuy716lXPQ7VfP5a+WDtZs/Oxz0/gAxREMJeD6ijdrkyFWkKYIlQuAUw5ujxo2cS2ppyAWYBIksQeylj9pcEI8EzAAvyLWHfpMQFYgbXRVq7ZiUPM
+TeTIkHueV+ZEZf2j5ZVT2FgMyjItuYUUGK7hkkjB/+j5iTHxxRuPriYnlGc4M6LfiAr6Xpue++ePSye6c+afzluL9RiasaMcZFyrt1eWZh1sPHIG5R+w1Wri
+w9AksykYmcBxiL5acWOxDus1Sj43MT/wycDu5KtTtPAqXr7SpVypYVqy5lllbT2d50MoEyIQXa9sGjwki+fROvJ6ei5Ub7KYIEAdfjgj2l1XxvUzfGe1yinR
+2J12VfVGUxunck07Nc1TQ7KAi61BchiC0gIOIKmn2jdlj9zlp9m7GsDsqHdNvW91Bqz7fsoZ/z1HIe32nSr+mcU+z/O0PslvFRwZyDc5wHy+YqwqP3gcDJ2j
+SelcI0yiGlBZ5AVkeGY96sQWL6D+DOQKsY93sTJ1do+mjv2lpmqTbmSLhg9BpSXqNfJKLt851h3MQgpnq2qUf9vJkkAI7EME1S00lqKIp/M9CbAk8elJgI2x
+2t1nuVNKjY5jWcFSl/liyC44F1fPtWpo13mnwdt5jDG3Nens5mOf9eYMcTBH8vHAnY7Ow1ocUOQs12CF1wyybe0AfUxGWhV51IOoPZ8eDxaxl+OyJsKrzlp5
+UFOrHb029Vb6WlA4kbzZn1YuVBEDn3s3InQZm2rNHBp65u7zGkfcqp5InlMw89RWZys/G9RKXijtkEmfgNJxU/jpnKqiSLsNmkTaUluyA1/GqesJLBX9v5TO
+ypqREBdx7xlGhyuZyK5zijjlfr4aTfObT+f9vZIw8C/mX3rwpEDd0Poka1YK+mYVmxP+vDiJOpTjEMv3kdAOhAfBrFITWBMHXWWzksuuNPi5NSpKdpRp29lE
+uEmo/GAtmn9erQgBGGl3MChUFoCULdPl5lDafhcTxUfyHo7qdsrDHO0LmYSCzqSHgtjkkwtfSo2HC4YhWZaw0QkQG8mbZRdINRQI5HAlvf27k5Es6bPycTqf
+NjKvJ6toIw8DVWkDkT1SgkhQ2aDcVNa4xa6HZWMcqRT9gM501n3wI90pWXSQ2EZIv30AtJznoytLcI+lOHen4/pUeLFzLn7sqJaTWQxzMkBOUoOQE2lvZhk2
+PuGlExLlJlqW81UzMbkLmGNvQPmuGNCGc6L6DzVmLjxDf53+Q/ov7hkdK1BJP9t08Wzswrycb/riqaOW2lTJYdKl44Dhc+5OJl6WxesYjAGF/JQlfiH4iexe
+2SaH0GLiWWDP3PbOBLXZOOIYJ2lTH9SKmgWP+lsRAaxvjJgP9bWgjvnpdNQMXc1JBW7UufMtRYZDqk7G1xa2l5683HC6m+vZ1GWihuRGUp1nwbFdeEQ6GoFM
+LJCBQ+CM2u+vM+b843L8ohCrerAUQuSVR/ahJ3N0n0n3qgxR5iOiqv52nad7HjbV81XOTjtkCqdfeH7YzNe9GGsClbTb2tzAlxVoBz88JiDDYVzDnWyk7mKS
+Nwmn7pPZu1o/KWuqzJ5ltMWvURTaXteHYawkE6IcemeNZTw4PZ8qwDcQ5JkJY6DAxO5PH/NoaBCRSYCkaT6MJxAZE4tCdoYQwi0ICFndwSGR+TU2eP6xUH+5
+JGM99bCOJ5oT5RDyKC1Hv1QxJCue5nPPk3Vz5by+dRNVvirfmc+iSlB25UAXIIir4oGkE4uTDQSknfZQ8RABEbFAPakl6yBDGrQ1QANGrcfAau/8oye6urWD
+dHYf/sX93Rm1m9Qvtcc0D6iUbpwnSSmZjcNOR/4Y1IfMu/zdLVbc2ncdVHlr7jU3FFLPa5FTWmYbUqaDEDIZN0nT8vKYTNUtrQrXoMOOg0X0oRKpQJCVuH1N
+p3eHnFa9L9Zb+WTHfVuaf+IutTOAER29BOffmB34UTPFzUNFE6m5h7zisMV9xPv41e/nrvHbPcW8O+1OL7grwPKC0IJANm6CkajG+N6u95PXevudsynoqh1r
+ObXkbKI3oH3jUncLJ9kgEyeSDhemk1C8YEopjW53fkxw0dmRJfsqjNp1DFXk8+s5sCK/mMXU6IUFF80eCK1g0FVYvT7YUGok3oigqBWKypqrh0ohhrUScwy/
+6bml3d44suaKtl+3trbIb1N2mMJJv4V+5rNDnGQyyl0Mxmoh2xtRE5r/Qa7JFn2YywYm7DA0AOc41hoN3lu322x39uR37OTxkGI38kvvmjJwWiCGLgS00IDb
+u2ZJkMkb+PjdSK7stN4/2GIi2/NRvHzRb9TzXsBQT1mxfRiFdyMKNIpprknAdEgNaZypKCkwCC9ARGXmy3X3WG4dAkOjuGpElF5/aS4N4cm1XoV/mf7ri3g1
+QkNzeW6kj70AjPNqaNXPwDb8XntP1CMr7z55H3R5uShNbgV0cv4m8bWZygzd78wJ3QpaS5L4lDlJuKhkVFBFoxuaA0K8LhssDwQNpsrFRUx/ndnflNj4FPIe
+Pdq7Rm57zRecYhD3giU0pHKh5NmyHE7tTJuLqLIY4QqTs7mWUuqwSDUiKbGZ6KP4BcuT56s1I9IpxoWuuG227bdPDTCX3ZH8ChsAu/e6cnYQu62yss1CKmTC
+dBfshrmI5Heos/6gW7EyTrNjXXXYrNK+wPW9Y5zPtEqcunHCaiRhniwo04oy9SfIIZjiQeHgOe+sWwBJ2m/SA1V32U7fNESbXNA9FUX3xz4WK9cEi8zox6kh
+hdts1XjpRVqycvSYdLcAkvVc+7irw9Mv1hTVNoC2uzM8Xdmv23c1MWBlu/hO6EGKZuzJhJR0P1bKSBdNNIzvTvu0arVY7uOySR8kaZeHJmrLaagKoW0CKqPT
+n4qjkq7ynhbb/jPOAl4s+tOcVhGnbGNYCcexk3ZwfZ+Tk/uKw1G+T1eNNNT09JiAb+mFT+slaWpAdWjaActAKa4TV6kHSKnJlay1zUouutazfp/5EP9X5spH
+50T3xX17jUnAXlPWoafWeaj1ralwjbLtXmGqgzfrd4f+xWOQzqKFcEyCMfFt+AhsKkIPK4rMztSl9hCzZwQQdXGNF/HL6RuV4c2q9ud5miuOtOsBLfMwBbZp
+CSRcMWEAYflk5Xe51sUTbmHEqbxNK35q4swPZWsOxWZzMbh8o6tJ7Dtk3x+nP5M7Pz/VaXjeW3tZrcu3HWi36+TaZ2qDdI2gTtPpRhbEqbYzcD2QOlUPsNwX
+YpMI0kpWUvuvqXrHQOZNjyQ84m02D3eRDaoVU6nj1Ni8VQUUvPX30r/y3dLKSlj8ZSjWJ3zF80knwJ3F2ZiG9Dw9H6fmV0uyTVktIKLjt60Mqi94kZxi+Wdq
+y5hRJxQt5xr0O3W29RuI6kqgvFlIJN/v68pwsjHnb3nFP1H29Xq2zs25ehcKpHmDhDW/xIrSH7/zmPnOjA5nrhftUtbDMtRMNSsdAHV1ZQm0jkCbf93Pekzh
+3G3cy9r62C6tjocwpTaO6lEWf852Jd51scRhIdirs2FLjmdTJRndyXobQdeQNAVAJNEUZBN3IoRch7l2RryUOvAXvnvL00vayv+ZI/JslQf4fdZtaTqhn+gS
+arQmXKClAyVy6uupSFMRUbDdSAS1oWhtiQEBvUFqhYQCaLuLVrEoop0rMGKzAjQ3cOJGrvO8UyvRGt25JSmRVziiYJM9KtQoasmO6YKEi9D+VZA1D80aChW8
+yM6oi8qO8oamYIy6B1Bs5wNaOhGh1xqpeHJhYNY7LNc/na7wmbiWsZK061l0u9HhcTVKOnEe4bEDdmmrG3i857m77s6KmEpt9GfpYktn+UoREU2wEgxpzPS+
+q7yPkjEpXX2iSFM90eu5Uv3qiZiQ89pTkbYzArEcBJrkhGh28sWpDcJ+dpFZXgPrpi2Np1aeU06ovZuYtaDX5IUtq/LrppNm/YzBrkkT8M6YI+c+6Tnz9u5z
+Ad0CKP9HdFFmpIBhA5R2sua9SWEedWvL7X9MZGGHBNW1hgS8UVbbOqNmxSHqpgo2MGKI2LqraB+nym7PcwQ6VnmqGINq4lkdRx1v2UackVohhoiUWYR108Sy
+ESm9UqizPqYcYUK6RhZLZVZZTHmoYouG06LNhWHT15xIzQ7GfBp7zKInZhme0y7ql4ecQEh+6/lmkJdRmaS4iJasv0Vv5GozM2xOlHXE0cu6yCP4L5PwAKoL
+NSSrrWGjmVb2cs0S/hqjPXg1H9T/0C6Z6bRa3eytzDtja3jps5k3tidFNTHq885LtFErXR/RCwDPgceMWWJIE0cMOb12XwPolKy0kmJe9N+l/5j+a/rv6X94
+B0gl0fJKhIo+nnb7BgpW2yTacKMpJW96NNO8sUCCZiqXvObdPLrgI4BsZYpHGFbhnUtVJrAEmvhEFzkJxCBm2SINwt8bFnpS6YZzV95WaTOQ9QfhCq6hINvN
+J+B+k61sxfN2EZ1UrU/lq1eOctVdn3fN2QANatAPB8BF4oQabkEk0to4HnQzLZ0LvPh2y2SHQ+zdKJiNP3GdppQVbTCf/fpr2OkgKskzTrrG+mb4aip5nTSp
+uhzQmKPaz1FRMKNoRCcC1R17dLGaCCogmyvrQ9M2Fj1dSDNYuq3AVAx9mnWF6bJoAwmGJCgNLIVepDJXv8Mxe0Falrj9v6T/lv5vmS8e5+Rt2U0W7sXVM89p
+NSxMIfyfw3RNFeYymTjQ8Ryf5VBPhO06m06a46pk81Ck3rcNOSYttT1Vflulth/IUZ05WaNYw68wBmx3cl27SXRyZArsTJ5XTUPBCz/Z/Bj/1Hnv/rfstxPr
+mV9IJa+1ZxNxkQi+nrxSNNZIvqRDefeQTkuGHpOoD+zgioLuhE4b801FixWsz38LSH4PM18mzpCGvekcWzSqfq3trqrFc9x5F/kxnuqBX+A0SeMCl/G34hLJ
+ehpGjzQN6BxwdtIBYA8DjpHKWiZFNpo3P7pMKiIJp2c888Qqf07RvFYbDLqJXdPKeT6PYWLYLr9M9SNa6TIckQ8lEnpuXQmLHTaxGHIJNIcYof9QdaTEFq/L
+IktYMdFvN99NkO//Qr3X876il3sXvv7j5eTLYk1P+VRwbsGbebOI7sJbQw8CkC6HrdD8MZHLp/Ie66XFHrficjPEKXDiGIK79AKIF28Z8/9U5uOFfQCxtP99
+9ARs4E9ueocfthoEXqpNQH9A5kxB4pudAlCt9Ha/QANxoZgKCP7zvuktkXzAX4VV1UKArdWUX78NWQPHSnf43vO+LLj5mv+jDjTfnN3A4ZpbzpxEjrSWsWuX
+KuYUvSBpnusbNs2FhtpNV1NgW+5QLp86bY/SO/ZFZZr6ZY0w/kg9o/8t/V/p/y25+pt9GH+VAlC8vTJRyVoqgNVZ21gn26f3p/P+zs1vWb18/rnQ+ilI2j5v
+Mi3nCll5Ht46Stm1EdKxcz3q5ZRVekF7necnmjAKx7u2eRGgg5LYuAFJbQOlK7Zyacg+14yOJ8b7Gkjxs2+bCEZqYOlmY19mbcX0yLw8D8aM+cuarWlup9yA
+863+QqNc7EP1TMpAd0FNRodofRATZsI/CtJxzrombvfHiCsPQYCzTA+UAKSh6gErKKLJ270aNNEtb2GdT39dENXPdW4O3rEpyYxj1xG4kazwyTz6bLK3sIWU
+8LwGzzBPGPKyMSV4dAwaeed1mDmIAAks80vV6Ne8n6AHhhTzGvV0GKteoT6Caxv98uJJWk3VPHTOc05Oo29jNkZ9C0fK3Og6ikdgP31ycOTySyaxY6dz+7me
+BkuC6iqjJmrDGkKeEIxltcckLsMlIRqTLurqyGByRGGWxHtKAtPCONv8Ofid33PvgdeP2fqNHpD9bj6/cB21p8Qp1kTKaXGez9KrePKMydjmxcjGbZ0JoS6m
+n0Ai1aHsS364CLV727S1dCJUaiFou9Gy13/Ev6qY7Nmr842WTUlzdIT/5/mYG9Fb4QyshWLPSw99kDBUZWFNakNGTM+6ayk5iIJ4Twft8MwvvKKDXgPn2DDT
+1rUkr6At/eWwiU7v7q5jdnWHf8w63C+8irGlli4K3dkbM6o2+931CYsqTjnAClbzl5pcgHQLtjnfmM/5zWsoaFMmWgF8z6dtOZQrSkU5bSC7L7RZA8cOwh5g
+YG1ds9UpijFBxZS81fA6eauW97p3rr77b02AmSs2VxOwGPMkYRzCWtLV+HU5jam6XboRI666ZNJ+SxGqGxO+i/TS/ZzvgsCOvPpMg776Xxn4PI0UcSTQTju2
+806Q9TDuOKVXEJQKYKQlakV9VLv1IdwB6VjaKQp3SxzvuKlyYPshk/RU1+1h12SaeTlxaZyo3psxVcgzc6gTX987045jpzR7Lc/SI3tWj2+vJOYc2O6kAZ0a
+f0FpQYs5xpxq0h8uRENIyy5ItN2XUNbuH0m+5/vYlVtUHLuGRLAuCalUH0e78Cc2aHO41PvvKiXbO3VzpQkNu+Hgol0/dKzD32AsizBzvtC63rjo6PMAZ17L
+unILJn8c4iqqRvaCA0ADVG/U56gG5m5vydQd2hGL0a02N/KWfTsw9592p4bRMdzA4o1at75H6dMshCq9qy5h45gASG7MBWukAgRkftRCxhqzpEr4c0kAoweH
+F0vvikJT44WXp5hSSq+4/Xqdx/Zz6o//qTLGS+K7TdFJySQk2srH+TxHTbbzXlOUh5dQQjGvowxeWgwqy5TkyaHNGCBk2hZIKRPlOvYafGy0jEjUvCmdIqIp
+tQ/91fSxTi1JeY40fnOZIGuOvdclMB9X93dXPMfSlyc2fLCVmwk4fihsq35jgV0dZ0eU8C0tCHKTLyHKQN6yKaWUE6lt/KF4au6j0TbJytN51JAW2A6Wp5bP
+ZubFVCYZ+BfXc+5HRb0k/G7NgATxB/1D/neyMSpU4OfpvB+xg57qHfYDAb1I7OhJxpaolvtjutDo4Tilwa/xo42ia3NiTU6UfRTFJLl0cSvsilQoCBYtyyP5
+3cqoMyOnE70jLa7VGDApkN7PKyYwwt4oII9z+FKh9ksMV7i325M9l1ts5ut5dr32kbjmvCQ+3+2ak1gMFwoyGROy5hyZywwXGUAq7wYAZAqOUIweEzwSj+ED
+XF8vV36smPuy04vKGUPtNDN0z3WtBZUUBR5EphmItF+UFyk8IIyJFuWqhRT/1odZH17/loFdZcXw3xR4f/betJFKRtK4CAoav/xqdWYyAsIC5c/BA5aBXxCQ
+FkyqpS1/85X0cf2L/AcOdofms5a1ivkuHbzy66VRSezqYwL55YrxQSBQbWH53c+1l/5zeue9f/60s793jTdMZvJCQAuWv5ydIwxAacGI/3aGQH3V/O2CAioV
+Xv7bpo1zlGdy+yShcjpOeZKhHndCDTWSSFAQf8zpQSCKi0rh5ATg8GAEojFdUk65vJAdcybXYaqzoqHKd1bFOu2ImE1qUrLZ5yrj08fvJ1sCtw1WQSqqD+SM
+zabrb0kcAgSgB8oAPFGoWWp+QQJpNKomN6kPbAiZwj7WuQyza+eB+APppgaFzswzhxGddcvEwstBqR0tvSLyMV6mYz31x1SsTVZcSfl3YRBQuREWRQnXg9Ei
+w4M1p5f/s25+gJT9nnzC7kSfBXEDJIuzTCrVOIJ4IcCYPCdBwp0ED8g9EXVPFS6J553OqZycX4u7re+ifFk5f+Z01T2ZrYi74NMK/rpZQ524e2AKc/Q1nyM9
+sObjlmhGFaCtna+2wc7NJLqaXvu3pXS9wKBzu1lmtkTbQ7vHP+8d7GYz+TizOl/LfiUAobgUuzccORGjZAXoiAQEJiwUk54TvyDdA6KtLJTyiKrMQ+BGSWFq
+1b7/BGda9P5rhn+lwDTbRe5r7UVMs4oliHlkk0gVHCJJIekrLAsVWT+KfE71QQFg94DEipEo+9rW7hR4H+PJsOMahuhOT6ezWGvW5KhAl/noZ4U4ENJssiBm
+FTGAjH5qZC0tl4/seSXDreSgC2AZXjlrp5EWkG6C8j8uCKqLVn2f96UPiUr2LOGwbHUNVYpxB4ZR8i8AKW7K1ZYrdH+vXsNnlIl2L3oWbTG1MR/830gE/B95
+l41eKi92Ub9k44SLMkan5u99Xq5+z/Ncleam68KW00m8AZeZ65UrlRA9e+7GuWCY1aL9+z+ks3cOAbr+OZ9Nla72rInMZoIMnmxhrzCrv2G/+5DesOiB/2n3
+10px7FT4UPQdu9O5aW2ryUOeyjK2BEShU6O8mFGg9qnRcdsFnYk3pLI81/W1kzUWFUmizRUdiUWWLBfKIFO1rwvAINFM+S9Ll29525CinHMyDTxZ3z/GNqxc
+iSHylbBYlGgn18QztDbfiRshoveJu9dDwTeHY+9kbWNND6AW8fnKAABIjR2h0CxZ+QW7+LgyJEU14FD9XePGOxqNTZ6Hhj/Dqo7QS0840hIrwpxzApfSlx5A
+poFCQ8rkSqBR18CH0kfVLz2nN3XmhTZmr40QusbL7ZaEhPumLlJwMk8Rc1oACIGUIJ2xRBkU16/o/cWq/OqJA0bO1nc0c1zipW49HALv96kVJXe6iZgdO+hK
+9cUgVoeacqXbAlsNDn/y96kNA8IuBha/Rq5SHXsDE4qLf9xVwyv+3sgOfPHopIeadPeIX4eAkBEBHtNSnpe5yhaPvSfYdumEsu7oyh/wV91qLM9vu+L6rhbu
+VUb06ep7ccHmQD4fMru6rmdZsLF3Drwpmir6A50OKRvwFSGnuog58+GC4iiZaK0h5fINBxdL4b3j5n49za6dU9fPXEEK02x9cNnq7mEdxzVttQKxdFT+s9My
+AYA0flrbymKYdeteISWfXpKvb/4xgE6f8rPGaX/ja3tfteq3nEylYQ6dS/NudvsSOqTPk96vEYAWmW8DZFuJwCy4XF02AiwpTW87yYMTIdoxs94XkkPUfRFL
+qHX24/U3sdJUhc64Yp+UcVI5urPt50DIJdePFRJC1iR2qbk1GKRPLK6XOSgtlRi1WS8RcRbWzZX1MjXN2KO6GaRsqNpzHpZMbbrmZcMBKV95jktmlU8qc9uq
+REgPn3bnNYvA6B59Pc079tyCUrIGnafjlUy0C5omdCAXCmCUj1P+Qtmt4TGkJ6xaWZ7ToM/VzS2Kao8WsF2MQxcctZvKHsn4iGMaMd14NSUpEWNTlS7/sieK
+R3xI70hLqqu2weGa4lzxfjirPTeaRLzf/auRHsB/M4K6NTAw7X7t5YA0JNTfL1cZDoBZsz15mtVnp/JrlkKXAwMCLYmBMimnC5q+e7QP1t1iqmbnWXbOVD0k
+m4VR+tHR3cGaTyWwEKHmzs0fNc4g7ZWb5mntie5sP867ESv3h3T/MnphKH/CWIGQDzEujL0zYehvPlum5LDSI12rzfr1aLh1PEuElRuGpfjI2M3wQu0xX9/J
+Lp4QKRUjkRZrt0txXl7RiudRAkCnT3W4u/YZC/vgSsmM/enjeUiaRwusubW9z1yicotI3HXrBMMB674RP/TGc0+velYlcc1F0OSqErG+lKwaQNlAnExVNoRS
+CLkEeAaCByp7KVzAg2TR+J/MkeWGP2QRsptuiTizGEIZKUOGOQMLY3JSWMhar6hrvng2M+eKZEVqYBJCTF6GXsNwlcV5En/vkqzvCobihZY1qpD38mkAQLho
+8kr5GrjCQcAnOSkvu/XLiMUo50TxtbQDQhyrk+Gu93nqha9NmOmoFpdRZijSOboC1WZdgtjSKF8PSbBgIzzAqCWPAR4IBx1ZeodeH7BtLH2evKc4Z3c+ncum
+uT2LpW+vi9sFSOTgODeKZBdC3qF5kXLCA45gf/yC4RxCjh8+Hjinf86qoGvzMd2Gonxzr3LUgBOfhX94HpFqCZ6yU9bwpyUghIXOZUjghV5pEyWM99NkxhG1
+J97m7jXnujVUVLnDDnGVK+WE2mT0IrqrjFxLeubcFxgACMqSQsiVIcnQDiKvmpPcVvFJyuqDTJcovqOqM2OnEZ/yE0xWBkiypptTHMLsjUjSgO+EVQChiQ/Y
+lks2b8CGpYo/4ZJG9aQWF2zn2Nj2imR7S1QiapzfjPtlcoN0PL/dnXNrP6p7CCJ10cY+Kg/dS8ok+PR+4tii981db+ptaMeHAmNd7FfU4vPTavHRbtUjTKKm
+ban4RQp6+m2oMe5F22xTWFNv+EkcJCZOAfluMJSAbecXc7O4RllU2lIjl0gU6g61mYqc/VqKGmKhH9AWlZqY9M4Od1RYcH48pcMurmmu2hqhezn4JO147+J9
+2aAhsrAlptGUNkAGWAAAqP7xQdeQ8NxftMAGA2LDH/tq0CuOmoOFFVpw9LzmZxUquW1my/T4Otu3YnT+TH6CWTLBIluT+NaSYI4jmLDxpxfyWiiPOVOpJvm4
+rXuwa1nCll+y6iKJXuHsMdMvxlGLUGFzNN7I18WQHsxNYo+knk06Pp2N+WSZZZ4XRdVmJ5GRUUEV0pE9sTTcmvoFIo62/pPhq3Ma4AE0ImeYEF1M0IWjSiBz
+czwfzKoduXNFJqmTgMGZAXCgmscqP/NczubVT966lQgrOmsNE0Dlu6LfEv7EJUnJje8sXlB15Eb/u6/yztEIR+Uu+iuQP3aRv5CNTybzRMnagFz/5APJ3nxX
+GNsH0od0h35KsJn9GJ+iPYtp70okfJ59/FtAtpj7zmlsL88f3MwgtacwJz/imB2vWTxBdFv89sO1KVtz2FK2S8gEA5hcjJQWUF5H6mazrpNbtXKh0rQPbnR/
+GSFElXIKSP+KURHC4AehdpzBCHX1UHfWQRo5W1ZOO8tD2QXsNlCTeq+kC88pCfdHzbje9td7Y+cctR1eEKeR6qWbwOBj8InQGyY1M2Wj0XX5Axvr8pOqLlXq
+jf/LS63P8v18WnF4T/vjwSTcBGk5tcs00pPXJq+lfLG5SMd0MZ7uEiinsrQ5AzxEXXZIz9On0o+pRmK6sWiIVYZnT/6tx3UdTqJQjot+Xsiviqr8HCfrl/IJ
+Rg+VygsBY/LMr7FUSkVhrXBycS1p7+FxuaB71RK53dxOgWFDyluYQZn10BdFLlfAZVPObAAELY2hneCxJdSo11ny5WWX6WzmyKjXxpfPyzUFp75S58W030iE
+aBcYru5SXteWMhERhYt4Q9qSJ7OYQXPmrb4kMJdiZTf7hU09rHYeOVE8BYpm9jjHwHp4iNeNtYEwTK9KuqgivDX3VudyqNYLYJGgIZW31lWtYUuwaz6GrJCI
+XrpMG5XqISCMW5WjliWbz7fFCQjqgkNSqIT9Xb8EWLSPjeN+u2aAQZXNFo3EeJ0ALkkSrnzdr4V7UDw745IR7qTfT3+X/q3cjdyktJ9Wnrrn29CtsCjle6f2
+Rujkym/bK3HhzoF/8rskvQELtTh/flLPH9xyA62Zm0zfA1YKVAi+Un1hiN210oWY4DZGMiS01y/mjdU5RUoDaVifJWZlIsvzGPBQZVtdnFTXG6ekEiZrUjDa
+KNut/EOAEcBPOICl9WTLLYeK33rF77PklSMLt+oFnRwKeq/bKItX2w9OxxsI0m3f8Q+VtK/QQhnAPifIgL7zcEGXnVNVG6oaNR7kpnDkgaI0QAMU4nc5TQoF
+m/fZ9KX0s+yh/7qy3h+Doq4Ms9mRLkM77df2+rZ68veah2Ct/rAxxxIF1vqSDsCtpJgYstWUjegQCQhNo4hfbnylYmgeyBYxbdlM41bWWORGlU437hg3iE6z
+tjdNs4vPmmvjbZO8d3Po7LsLzC3kDktCpUFYQsIEiDUk+cGngFTqfVSPSWpvmKEmzQGzeRFafsOaY7GxrnXTleV841SQDk6tr2mNSIZ+nnQ8fcXHuhlH8hEK
+zFBZ6QC1HsflH+4JLotO2P/rCaHYkGQpGoALEGHTfNDvR0xj+obzBP9b0e+6sQYoO4EVy21hpq9jpjg7uo/qJPbnvTMk3J9Xhb1uhYdWztwV9IIWCwISkt4t
+f2Cl+VOEaQar8sn9L982QCK6BoT6tlwZ0K9EmZqTEM5m5Esh4Ih+TFFSx2l2t2tqmHjazGAZZd6A76B3ISxmbvgjadoEarvQY+pihx9WP/sL2vNWbsPV1KQ0
+zAZvxH2pC7myb9LrEFPvmFeCIXQy+UWRq3Q/05pE7UP6Kd4j6UbPFuFO4+eayptrPrXvDAsJO8MKSDGdlchgIJQSk4lFCeLFqiW1O1AWPK268vpHticBo3PL
+eaaTclalw7ne1jl0P+6brpzWW/wHjTUBILlomsI50bT7M77M4BG2ukNx9cq12Yj1W5mP76S/TX//crNxqNZjq9q4brszmVCfMHmLJX55rs6HrbkhErpVgPq1
+TpkcdskTU6jceAtdm4CNZBw0vXgYv5eMI1nOwi3dus8pyqGck/9UY4rghB66z5voa8ERsFlU9Aa/W6SwdwxdvwiElVASjb243AqOXfY3XPD6OqhOKAGVfyml
+D7ytsTLn1v8j4z2EPMz3PfIG3vfi4YPmbt63OQetilxRq/+DbuzNHFjW1UbeMMA247/xI1WgDbIveCa0kel0LJOg5z1bB+IKl7F/m/SHz8NSN3pOopaK/bjD
+ZzQWlmTHbzgHx+Ld3gR0oCcWV0k+y1vtn0BXWT6rZyQbCXHpNdj8DmG9Zpe2QoAxgaQj9a0bbQf6TcKUPqhjSo2+2ccCb0OpNnzd9bL/Iv3L9Pcy9mZ9V6o8
+e4Vv33O924cQdOoKl3erzkJd8/L8Zpo9TTvNu9N0sB+dmPqy9h1fnCFASiwiCcsfawFCvVwEUMn1RYtXPLe4XgQY84D1kYHYsLL0f06QmjWhWuLGIDev6D4s
+g/mCtWCrYQuifZ7LqhAQNrQ6GfXqq2rKRYY3asO74N8Rt/Y8YKxZ/0DQCfioa+cfNZv1M+lXZKyZC61Ozzh5E+HtVYPHCoXhVu/7Wz2akrE86Kp5szuJSv42
+kgSpF+z1nWyO6v2TvGcdY+G3Eb6ksK/3vOZsZ/c349Tezg09uqM2mjUtzEEOwfCS0oJF/SkMWnlu+SUFxWY1ttCs6ohYXJ1v+oJ696j4ycjP1aqefdbrjLWP
+7vdrLkrp8aY5dNdP+9yfd65YULWRbtQqmN0/uO3X8tyOc92wIKFR2noQJyJvID7exVwbkiPOMrSLBb+CQVtMRXS1w32aSBsOy+8JucTR+GlvPBuzk2ThNZEk
+LjKGblVRQzGSQKXxmXKTvoCF1rtR4lk5oEh7W0miWS1LxR9Czp1y2K+zQgKYtETjGO7zvemZT+aFP7WAW4y749HkJxuxhp5iVduo5BAv4RZ2Xmpd0ezNy2cY
+Ez9B6znFhpZq9fDvrdz7ytFu434zfd1V3b/vGbjfiHb2jrmX/587Idp+96KRO83IolVX0vX8goFjO+THlJPF88bymNKHO47P6K2+pYiZX3St+78I3I+Fj8/X
+vbpbu5h0FOxuoK9u9vwhlOLnl9/3hychCmoVFkIikISQJZ/dLly8Grk4yKgzDkvaRC5kbaYWrzGnAO9pXMNNq4F9R2fLxSsch4VNPFrQs6CNogxqw/EcZedi
+JmrD7gjlWj1Erbh8PN/pXB90595oSj/ZmmyCvE07hPSYCBXJCBo8DahofgQFRxFPA2ailkCcSHDlS+92pMYulWyq7UruIbQSmFaGK2aIq8SdSsruFPaqUauP
+jHsV06lqn2Iq0So2RE5NjBoZlGeluJakkX/9GEDxmq2etu2iMga+XxK2lRPAWFHH81yvOjCnnpSscuvl2SmYclezgAe2/ARDAuTLJZluW9RljEr1HrNafCeF
+w21IjQ29kjNRRXvFSr2n4fScvSvAuDxLpuT/8HXOdrBhPVWcOmf7Jd2xvpGNin+t3DDX3ZVCFiv9q3U57Q6VWk8dyqWycWfvv+oe2jQBRNE8aJ5wSZJzD2vF
+f4HUiMs/2vKsQ8WywgrAuQIywAKNtt+Xu+pCYRk062zV2dBUHQkLaqJZD+q9NmKoJMfOErKB6ejAvLKehxCeWV1+Sk85ajJzJHxMUnvmplrKRtsMxJhmye9i
+mZCs/qhPoXht/CPaFpfVe8fo+WI0FAsB4Ag9VWU4AzXelzw1PtIIY+K+i6Q9028Jin+Udi/GZCoAr81tl4xO7HrehYaKc+2n4LsmLXSPKZO1kmnoQReSropn
+K86/T780C2Jz3fk6qZ8gbSI7Y0PkJ05v+fYgZ5Lp5bc5nJ94Ui9fewzLBWmPWCjfzcUorfTyB94R5VYHwXyX/tNHNvC/3X8ix/Wm5vp+hNkSn+AZPE5zJyzS
+aJhvSqwvYFLRjZY5AIxJCUGXxItEgoeAf39Nc3+C264asPZHJSNexOySLOuLAZwvRBGJrbxLFp9EnPjYdA7thRXg+SZvxaHBat43awehEfuWJQpO/VT9Gv1k
+FY9SJVaOHMNZS8fOdDpuBfs3zYSPECWGF21kfEcKwTnGqVVeXhjBR/16yCz+JO+ZuCOCm/hm7leEBNZSlOJTeEzS4XCBqzC/i6lq1i2T29RBtr5sy4tAky8r
+90h6E968kvuVU6r6v3W1nKZ9mbUDz+dI6nfW26V5zMU8WS7i0ipphUC+qhTcxfV67nsHzfGV2a29aA2EoN00I12oEpw9JimyXhKovH9uNkf4G8BzUu5fgwYd
+hQu5+QvCdQPS3gc0KvGu2OfyB5I48ZysqZj/skbafFS3Pm6vs6XGNLxknwaSzYDBtA6eNqxainVhUMeQWp1+4xzB9ClGp7U8aE4psMGH1k9+W5h6tD1bpsmJ
+0i7kRHWVCKG5J+9e6QUXTJppWW6tUwsp3rCu651BZwXZ8MzCZnFCL0SEOIDIPSOCCvCBsKWBtuJ3j/IqkrCmQFZVuXoOMy41n3ueikYl0TsES3JBjuY+TV7u
+XiqfpLOk0mfyli8lb15ZY8PB8rzhCWr9B2VYGdDaI4zYp64Nt5nCKdB7FOUw6m60ijPq7sZIrvLYnT+WlzLbKPtLJBRDO4iyJDIUSKl1ZDO5W1DukJiI7KL2
+XiEZ0WE9ruFotu796cOG8s4Bwjrv5naqCjOEbu3SD21uyuA9M2P5Rjtv79uwq3LOHry+VhAov3S9ttamC9ozKdYW9s7q5JroI+ECiFuZZu3kyE1FLTdMyc0D
+4y40VY+cgKINi2fHUXfjdYVEGci+hX29jJcThb5aVQcXbdDGzOZAfuZ+iFU5f0TwFnmrpn0+nafOp901TuyJne91kFlWoF5YKy9mSTFpTZSu2bTEM+bWPZKz
+t+s2Z816n5HqD8QzTZuWi8EKNr6xou92m/6h9HHNjUsPYqmCaO81h7izg0hlSuaqN39vnSZlJbrnwipuuTLIWN4PIh/wgsJrJc/LzpTkC4rYBOd8UeA/NgKE
+LP7++zY4ZOZmDJIF/GW5szXe4R1uqhndDmuJOcQHf2476qSxugaifESIJc60JDksUPQaEBpqDn5OoKCF0EUk7o8s0xcUrdu61fuVs0T8BMt1/iZ7C9fZ1M/7
+ObhmdsuOqnpuP3A+Kdn6TahlinUZ11TrYkKGBLAkzFWrgcTS6FcAK8ciGpJYjkwVO4xpTK/y3e3v7U/JXR03fGdGxGp29ikbOhKiuEdM0soXMgBegRxk6sPO
+KzcMG+6O6i2eHVS2m8MOHubdqIphAminQSOYjEBABCRVsQUBiMrvRj23xHqlbI1fpgmn/QxHZTTg9W5EK8q0KfmxkjnLFvRDBglafSPyMRnqiGaXuEveeHGe
+9rQjqIMqBt6OnRQ5l+rZEo2UQTTSTUK121LPk7EXH6CJVMs13DWzm5aEVZHfD+ucSrDKPjkLolKY4x/w/Mb7ufuloPJqV8aXGAW3U7Jx93s9pXHeWwVYDWNI
+R80nD+xDg7bIWPGOoSA/sWiKSXVCfVXKU4eBJGWiae1Fywn2ofRD3P3iLAArOQJrh+4dwwvwcqn5YcqJdwIOCdCK2ajQA+7/j65PuIZRNWW9Kypy1vRU/W2d
+SrtFjDZDPfS6Z7ycXD4iCA/gYmxZMEoQ2WjiWujLzBpEzl+LEkPeCFud5ueNY7ZMDa+4ydp/OFMGyJOkPhbD6BMvYN66bY4F2SIclAFQ46e4gs3HvWucDL7h
+JMXwYh48sJQ0No3pEnRvupvwDh/bTnm3lctQpTg9ZAuLoPEwFiF+lXgtJwDlLVEnw4OiXF6QK4x+xk3j20QPv/amyCgbmyGMURfUCYYl4WBpLLUXDziKfAkE
+KYP0DreRJZ4ufmifZQkfLXd60bgh+u6jtkfoPD5rcljFx/0hiehK3aLLfWpUpBCvLomUNJzTRBJmNj/qL7q2WaVjb6czxEvH0IElhUznUlkDtkJoOfM+yg1q
+GC+iQRDy/OzdMJ8LWXvI4ETFWKGpGZHj5eztBE1fy0m7uk1p2BiWnqxNW7Vrau+cQUrK/2Q+gxDfQQbP/2k6KV8FA15Sc5+7nt+R8X5VGVm5qzu6WvXOTxUR
+3qCdzIRyoxRcwNDh6s66bDs/ig9QQS8xoG9zyxddjO8L+B5Z5TN7g5/3freiVP9L6VfVUwq85+XjbHx26gwWf/3T2Qmc3PFt3Kemt0pcKAoXKlE9D/KCqLmd
+6u+unClALK+yrVQy0YS4hgIGnp7RUTt2ijBzgfED8NLfBCr2/bXlQ8+ANZo7awZn/SgmKFkgzKcMXbQfGRtYE3A1f2BWIMSAQ4c0NooeKWvaad5txp9KLXYb
+fZrbSFRFhUSYWqCc7AHiA65fQEJc91D9nRzpvQLP+DvT+zjGKN5PmgNFEJ9Wd41Fu1fGiOyN9otEoigsjKn8ldJlGC2ZK1KnJr94cL4iiUiEJFdSi0p9czIi
+jT7nJPT44gGAFO31pJJMGTNDe/cN0353qcX3NPtK8hif5AyBaVr9kqqUOOvETZMv6qs1SnljB/mten8WXlny3NByBupSTxlBSzlgZwNvHozAEIOCyqM4MkkF
+izOw4ZEUQ05ghpuQqKl3b/GKm9ZMqrok8y5G0nsF/PFR0uWs1sYjxl4DEZDYhUx8s/BCGogZ7HvTcOixo+MvjwW4OfXKAz2vIj6w519XfcpMNnvN/ZEkMACQ
+BBGe0CRrRy6M3vX8CevpXU389BG20mKjk/M21vTK6y11rWgx79RMl/2lQR0v9McU814ZiV3cMouZzRMKlYlWh5C34ZCUqFo65E07sj1LpOf/rHnJn635O7uq
+Wdsap7vTtB810RjyDmefvFuvCsmd9yx2hpyq+IKmFdhg2B3XiyYJzbNComRD2PRmbQiJXFqveHXxk8oDYbfzXDOrs9areM9KFeBWE0OjlSPkKklxVyhervLg
+ZWcNJgLMKSuOMVc+MZnPn2aL0eLpvfvuqv8TMdK6QkWDuVmXiJpipx5boIcXLW42BDlPgOvaGiDSqr4S+SwMJ+/9g0IYdIW+f0Xgf7fB6F7+pJC9qkeE9Yqq
+clRSDjWOrrYTYwMYw3uLb5VrTjdPe3BXrri/1pX/Fq92QQkcsHfe4mPAepkbZ8xOre8b2mkinXZdLTjYBD5TPP9/Ou9H8WW0v2bNc5QJw2W7u8beDGm+R2tK
+PrdyuEA9rrn0sUD9TQ0WsOy1wg/yM8xfN6l/eWoWsaz7wEq7P7I302bUD0ZsJgmsSoBsAQefklwwVKKFQbRItGWM9wWMSThqcwqVf5HLLqNOKU2b9W6JTz8X
+UOLfMHz49a4Q7/rxXIEBPVfdP8VdOk2z8zTsTJe97PjTyChQrKRakJNXa8wFSLVec0HlHCQgoUje6vqRvU3aEsu3U3jNsXyIc4FdD9Re16Sxcn3LOsK3O8CC
+SxPX6XkOwUPBj5SfeFZPFJ1L4XodDTrc1qpzXbYZVci1QtWypqYzbPVGoC9l9PGTCYY3sX0c/7e4EyTWrGzMnPzjo9nPbuuhdhXQp5Q/C4LGYBpokTbWKkn/
+wEt8eSB2YRVek8D7/S0XJ5jIoK3zMfY7JIP5lmaezvtp74mto3FFT0p24p7HXtIy+eRlHUmmPqbaecGxhgmuS5o5Sx9r5qvEhbxoDZBToUM1CR4EbHMakmX+
+Uc0xR9N9Eh+0yZ5n2TcjA7LYCjwmgsdk2IcFIUuUiSAXvKBYAgl9kGJ+qtQXxPctf30SUbH57HXo1cFxvFqXYzE2IlySuC6rKqFmwdkwWaku3vsFN8t2OVGb
+43qVcS9zyEnHZLQapDvLdfHCZ2o2RrGhJLx0o0kqmWfH8rfv9Ln5QPpI+rRaRVET2eAmVTm4auGnO7eId5Nc30hVOEIyXDx4sW7lgjglJ2t5wJ6fDWUqUKam
+ydmYbpSe+E7sdO3WGbdvk3cSXAHTPkutBHNjSpuMjcYkue90cWYlXe/E11l1xD7eMFC5a1I+3Dq2wYCoD4499RSluG2ADaSh4fC4Dd1OX2x5j0I/d/DkIvt1
+4/+EdrOBvbYSUIxJMwnqpQWvQb21CtwcU/B9am3sw4xzCrWxfWTLXJfJdD7M3qcFBmpKZU6IGetl716d8SUy+Kye7RsnWQzKg9YIp5dalJfl97ZOK5QtDlbp
+yi1Awdr1uPyIze4OOXs5j0pM/sX0VvSpojy9NxLXTSde+m4DRBE1aMWPUjfQ/KdHEYKArPUEog1EXMzt3qWfkKpO7iKc62oLBt/mmPG2vccIi5wf6mWT897z
+PrQbnmAzP2ev4UMs53HdXPaeqKjNqtVjvvbPVx1JKfrcVyRqKJj3Hsyq2TMoqYkrvSTl15OGHsXco92KmmQcWDsLUJdCdVL4GaDV/nEDQ+SqMy/qy5/Zxy7D
+ybyCml2OKpkvEDDIhJJdwqz1EYnPPdRZPeQwl6M/7PfXPK83pnenD3WV1J92zR/WcbXsni3kkmWMaWoFH6/7bzcy0wvFswVMO+waFErPwIbr2pLTNrq0yky3
+fbYlghPkiarwK5a448IM5frzep9yK4LkUE0ERb8SkWIIoKKxfFwUI595+5bPXANs9iwp37jZwg8qg9+P2fW6OtTUaEK16ag3QnDDaRP2c8pZpe0KnSyUhCtl
+GzwmpGxkhNr3uaAe8NKVBhdGQWTZsspjBKp9KGtdFOTeNBUBLgfXKCxmYbnt7sbW9+m4q3qcg/S2LbbvAFAXuKi7lnNfJE8W4mtDhfVdkpY/K+FgM6eGI/yU
+8mQ28btl8iavddYk8XRoHUd+npu8HmpTm979mrNn0dxi6iTnuwBtHCYxH/UV5mZre/n7Vv6pNmm56gG/GM7DVeqdNCjYfCzJIpsRysOo8S7JXCvemoTIaeAu
+FzWG6y9RaJorP57x8HgkoxutTz0w02hbArrRy9ZKPlFIqLYlkwuZFKMkrquCOI9jMUYpJfuMfrPh0kUf7ilsetVBFETP6ShIdbRKQCd++ARo3W7/Y+V3wRW3
+Se2XrYqyv5f+5lqmw3D+b2Z7djgFnFXFpe7NVBvbhVZI2761g660oH04Erjs6oXq/ObKR632rXzbqMAWBMO4L+p6yX/QJ0Jqn6tOFaDPljerSRDEHyEFnXpQ
+LJd51cm1QfTuPWsLYlV9nj8P6rtozGf8sBxemP8Xb6DK7JJjsEhtzGddp4yZuPK5w5HHiN7jnOl0PG9jKwYR34I29XAhdDGT0eWGJJQHyXj2sItniqfGdNug
+9dKhztPe5Avu6po3prwwZ4PRv4nXBAgl7CME5CQqDEhU50s6Xct0lkeot3xU6z0/nr5gig/anqnu5wvAa1GysUo1ihym9aQ3gFsL8gXjmYNkpQUkbSAv01r+
+sdZv5KuI9bh1NW53I/dUt1PXnVVWXeMFrhGRKySkhlWq+MOm+DHVYhzHXRk3C/nsED4mf5OWLOka9KLRsn7mHWd76S6ep/3unkdz6LyiWcV0cSmHRFps0u1G
+WGtNi5YBI5+vZ4h0M6UbryGfgiyDvSSfuq4ebYLVfiTUHcNVZJFmF+jXwraXg5j2HHvFfeF3Omr5Tu9umquwS1F0iVlHzaSerafKIMgWKh9lhk67Iam8y2IN
+TmCl44GqgNMFMCcCKrNX6WiywK4H3dOLzZ9ou6P2/vyoK5yaOCNfSkXIBTfiwJOmPNm4NEZmkbiOeKEZXA6Kk5WwnvtW0/tx0Tl2doFdEMBb09foWW8wj6a2
+MCQer4muh4dKQQwpsPBYp18mBhBCROxHDPorG6xpJbq0c9U7ZfZd9jK4iD1l2hDrC8KKfNPZb+k/rCjm4LpYTkaqrFmey5U3GdBVspzQi4Q9V5J1pRV7Wjh+
+pT8+Wb5BOIWMGqb1Fp1QkAG6TVTRHpyzmLVbH6OFEhQuMpzvDiXW9M+g7xBYDiG/iuIpYd/CkhNkiOeX1Okjj0hUAPXD4kYcl92+W/nSm9TgnjkLjEDktE1q
+NQQwb/WZBgUNFxwBwXcFF6/Aj9sM1Ob6nqX3KE6H692131ksijTY7EyWQZqnTppoPO1Q3O+sOqkc/Cq1RK7zTpj5SKAFa84xJ3sWYgyJ1D7d9psHrmRuajts
+94oRZFmV1juz2YHexwe19m8+7tFUnta9327mK+qCb5aKEXTB+HSaonAwBQumCDe8hC7AgW2dho28wuhC6qEPTBDQ1mW+yPgK4Z/7zWgltPqyYk45eSpBfBfj
+7s1dnurGM1RjI3DRp7F55xiiZUCuJ5rrZ5P/mAzSxseuRJ1qQvhGWK7KhJ9uN2uJfWdOGXdR2v0diTiMfWg0mn2tQqmUkp1+mgK6v841KZ1lu/2NVayOZwHZ
+A4gcaE66E3nMqTgU5VXCSMD2qNgDeZfluAkCY7y6qTVB1D2UhibyqYx8HhROkd9Pf7xCwofjbFOWvYtf5+oA8Fl8G3uvLQopP3XPFNSIqhmiG60XpPUOkcdE
+tv+MA2e0t1b6Nf0alMZLc+AhBC0/DR4/oeNSlRvetGMqO8pxA/1oDQBnjRXPOWzk7OFmVmzUgAtu1k+hsnQACGEBwEB+sLQc8Y8pg+YmRrpY53m6CEUxW533
+hXtqnTGxP0n89aIjLdnNWnvhk+LufMUTuC5fdVPFiAWMp6QlS72R1ju0ULeig7cuZTSI8GiZm21MaJgclJufgv36iDOkWtZT7JHVtMxJMxasFwdX2qGk/pkh
+7UJoRbX4VQ6fsOSGGFA9CzbI8hvBW1l1dljaSARAnswbSebodnMEEUEMsFGlWJJrRAqQLDaM8E/IEGJSCSQzSlvxYuTTiJymtSOwYN2aq2TraoxuQu0+CXo6
+d1yG+5BSZzYd/qFb657gDNViEoAp1PyW6nFWqiFxFRpSDq04lftLF6N5REBQvf3cNGDsmlzW7UbHp7H4/5py4f2JY9Z6Zju1ubdP9lo3DZN2unjHtZy09+ap
+ym70AyKT5gW2O68tnYecbcmuUMCDH1LddIDizK4ekV+lyrwDQHpv6NOfNnG9BZP4G1udsQdTAnDvqFTtnY3kbZ24hEpDSybqztvCRVvxyQO37Y/lPUOh8L99
+0lreFZW7zNRIk9dinPWIaZ9a2JnmGAYCQC1muCdqgikjKGHTiKziG5u4LdkQbc9PuYf3K42uy44dOJvk83x88YF/fMLuIG+SJdG17oXW7siaJADKvIoI21Md
+VwY1+jCfSd9Iv8m768/r/trvnlagPp1Pbw1K/77br0vETEA5B7n+NXeIIJ+hUzGD0IeU/FVmZiBj0VW49lXiSVqvKLQ8RXPCdPrxwmdm/vv3xKtR3sxOQWTa
+YsqW+ztp5Fx1UibpaBHkrCGVz3vRBy3gpsU1fTP257QExYqmqeVZAAJl7Cxp36aIm0GaRVC1pUlP+zWeWSysqrhqKHXeCIWtkMRwhU6Tqp4tZ4OWjXJ5TRq6
+3pQLHxF6+7KtcIuOy/cy+/BySPJe4AOTDKuAnGsU9NKXazfgoEq64UbtorDuZmVu7xjxeInFNklpjggACjMJKH27ribcKs5pft/W0w9xn4loKCn+TeWT9o2z
+bAf2lTSEpgNHJg7kyV0C8tt4QmWmmfOuzJkpBVriHRz/2yQjHlPIq2Ko1cr6+Fj6rJ7F35DMlyOP5PLjELR/xHgH9szCy3vH0Uh3iq00FBtLyKnfG1Hvvq1x
+ARYUAVByEElcdACRxxTundOGgb+LN0/5nX6elPUj2KFSObMVVGt2x1rZbXpMJaPON2BJlMFqLhQrdfInVx2nFZf4uvf2dX2zLErEV7E/Z6EQlU6s0y57GZHl
+6q2RloS90GbvAigdQ7WpVs4zoktCsBYtUrRmucwGV4WOXD8H7V5Tx2qy6ryCQ31qWnO+9Uxiwh3GfiaZ6pcbiIpYV+eKAajS9e0TyuvVMahWB0QwR8JwjjXX
+fBam9BUfTNegrsda5CcS5s9jw3qoPxWO+vjzfs5xV3CtVEozu69XqoV1EYyp0kfA2XxanwH2JZ+J9lwO+/rtpeE4I+V8tQqJYqJkPCfelf0r45qLRjTRB/Cn
+Vigh4Fs4JHv22BVIIu/Hu7kLKaKFgDta9YQtp8l5jAH0IVZJHIH7AphQKr0AyoabxSwuBLSNEEI3nhYmoxVNxKLDBj6o+k1vhajseyE7evSShhY0thuDzE2Q
+GDTkDk61lanst5juIwj3Y/V4lIJHdSA0FtXqFmHrHwBSQFYCerovPWt87o8rR8i5Z5GcNQ/i124d0l4skv4V4VeVOqqlAHLZ2SiREnd7VMKDiH6I8aJ4bAmL
+nk/1X9Z9jsZGW1T9/iD9adW7C4SzO9d9PR3P+R8cM8bnIyEguJb7U0RdpvVh+ZDyEgJYfk4p2C/pxcFixa6R6s4+b7DC0rlhGXuN+w+7k+mNxxR55AciAsAl
+IeVkze2axvfzNwfYCkEGDu0C3UvAVJX4VRCOR9v9NXL3nFK7590lulHxRacjF3N8G3pvG4o67z4UxFU1pwup6VoEIhs3fDlc+PYhgSr254SAxk8oGvfm05Vs
+92+x+qnqbbkqZvlKV5cJJtrSq2UK/lbuaCa0Alu+e2xLT/l03g8yIozMJ3p+VgIKcZS0s/0xNUERmftq4I+FagtKcRrbqlQZN3Y8vFYVLatrC6M6704SH90F
+FvAOgR4R1lnbYYSyw/6tYamWheb/G0xq7cV/jWvwH1dkYYfOuj3LKitR15mv5178z3ht9ADSycPsiSgEQwspEtZ7lU0H+IGqfzngxjVh+nb6Tvrt9Hvpj5kX
+W7WVpy2GfNNel8CRWX24KVvPklVvkgo7VrxLVyqqZ8ewciT0ogERSZ9Y6oZ3NKCRdwpyMvwcAmaBh8haVEuU1MopMqYrIr17daYIzvmL2p3DJ4sBhffTfrpt
+sXRCbcIbQu7ZOmuSyfKTWE8ZAUdkPXzE9A5w4cuFgTs9IkmVZkrQTkNLz7p+IvDV7yNKe+6Fx1d+1P3bEB2HFlHGw+FLAuowwFFyvOmLkI7pT3JW8DtsoVKd
+qecNu8G+MUanW8V2KUJ0pxVK/dKD/rNyRJjJ48g0u5FRLpdezgulJmGynlChuHARJ3mAB7lLWe24VPhIoMSQ2fexnFzNl8cKZNBEjcmnF/cIiz6/iRLb57FM
+MHtWysPcdTZzM0DZ9Fm2vrqVuA2Wkju3LranFDhR3ssZb871HxwPu48nC3+9n5g4q/o1wZGvHATHRr5xVDxDrsel7mEon7StDtljrEUPEkdmCOcLVc5AuZfl
+f6pahWwCTMQhYOo/wp6/KEqnoM6027uGxt6EFEwYpGlJUzmXsW0Z1H5owQ5ybz9WhgdJyZeLSTB4zRPVTlhysdw/TgTHklmTCzlwvsk4FFxb2A52qY/Z/qk9
+D8fzypJVWyD2zL+eXEyXTxL1I7lNIIk9Qz7Zc0IjDyuDuQgzcRk4Ru/Z2060IA4QfbH3O+OksKE4R+9Ut4mlb9ZtDbfx0CyXjUvKWHVwpWvT0O3SVZZTTdVk
+Amh9fVkudobq9De4mLPPffHuLfsuvuML2Kecce6cX9Iyl9SqyxUE/UJppVw6M226dct6z7OWJbUciNVwv6vBVryXsdx3xsno/tNZ+2S1usFn4UYqfdEYSdMk
+4l1ZwQNNCMRm3vLC1GfEW95469aoZ96Xay/EFjdBW4Wx6mIva5ythr2AGM3FVgu3hi3KJtRVBmpxplzzUCJIUzhO6fkmTsX81ZLRKlwGwvhTzsU/Tf8i/XX6
+17XmsV20D3WxuosrysEtGIeF9xvB5H1L4nrf5C/KCQOLagY3moGVRV/t80Lok0bGTsFPlVdDntCGoGCNGJ0woD2aG4XGji+k2OyvaAZbcr/urzYCanNlomya
+T5SEOyxeljsyXx4I8JIGutheY/adVFsD3a3Qp8bRo7NzAV/KbS/jP02/3eFyxJFsb0dNAypa92R6Mk9LX06hAJ01L2ulO1MT0XsDJFwPdl6ua3mD3UNs7gUK
+2Q+ub6YVUngrJIBWI7JkQgo6gmPTHLyIVerSteCcT/+sccj5XncF3+K18JGVJdhcWn6KIumBjYNsSRvTCPvkoR/OtTb43FPnkXJCXCMu1BfkueF8vt1vQbKG
+Wksl6e3F/3vFHOUh5fqKHCja7KEqQuwTLBYPaSI6yyldAlUqHip/BSq4EzVMIQj8LupZYNV3qvWi0pNfcJ8FlfsT6l200JsOZNRh6vXkQsPcFFvanQHljxvm
+PWT/qI0r9FyyeOd1j/cZFXTD5+mkgt87jURZ1aq5ntOx/18KF0X/f2G/XrJKmqcxzABllP5g6q/MagC1atHWqj7HcYBhtL/rdp1LfPO0X8nS9SX5df4paiVm
+6/07BUiEhgfUbGvnd2N3uoo5G/IwQ0cQIT/bpZ99pIaGeCVw3RtS5t77DY4xnXfS8hX3/ku2YBr1+t9wPpDY3VQW/2A7lh2ImjKWAfJgs+5W4ma/kT3mNLhs
+2EanRvRxJPP/5fQNVgv7rsYAbG3Ps6QCpbrUMVGcGF4hvvYYIcDSqyDx66FhphDPbkxoIEmvk5QrduE0kgLUwh8RhUADEMrLS0LD1EK5j1a84a1Fgbeiyfmg
+cx7eV+6RufkvcCPUcU4dD0pNnjEcyfou0mPKjRDUINIDaZHv8b9Yu0CH3LdMKXr6kuU37B7VngC2pLNFafqhCv+v8QEi16btX4egHSuBjWQsImoe5FZIoY/I
+uGi5ElPxAFLL5NBf3GeSdVjXlvDEvVfVjSw3Wa1R6zGHbBKD5iNtLkg9SDv8yzMPYHhieaKD12zQGvG90VzRBjP0c5wp/g05jWt+IiSE1UAdrcYoU73S2uwb
+XleN5ocaj5HhZIlqErjFxSyezWd9PKjZC8oJnLOFAKONiptcoEam4fqs6SuqvLWukCM+xCy9o57tc2/s1IfKZf1rs9iogFI5I4a0uDCtbIZVwk/jY/MZEpWf
+wlXQ6XlldaI+2MQQ7+GqZems/3xAcnwzKEkW1NS/UORdw3qwP053nnQzfa+IxFPR+RIIaGl8rpxML8G5iZAZGVP3klgCi/ZkVjC7HIeqVqy6/hwvQRbwaixy
+aVJZsvfW0CrAmB9F7tUAjrT+Sfq28+JEA9K1fNXMD6dPx5dKlYzSO2rC20hkEBctUGfJjMgRbH41hX4jvJInMZ8ClavgI1pPOHN3h+Xv91Y3DPXQI2eiAkvB
+oSfV1ZGWU4kMLiwuLaj8q0XBLD/inAWyRkXZwFMk0HLvIrT8FR8LVVHhJj1JYcFQXhV18rzZj6NywEoP76w4bkCHaoiRKFes1nEor6uNNKkX33NLUideBgHm
+x0hsKZ7a76Y/UUVWRaLNbTanRdq13zsHnaHjzspt8j+fXetWYDnTTrLxvHFtpHIrnhCy9od1CAMAUEDsDXT9B3hBat7bROPCvTVp4pa77jPsQZjCqrN6zLHy
+40LmGsbxOJkQXm2ulOQmOR5OovDzhnbm8LUPdjiQpoFZc8tkT0NPBb+bJPsgvuKlvNZ5HJcEoI16knOsPfevKhej9+JVJaSDsFQw2EnLDaZ4UlkI/BmbS2lH
+RROutIcIWhAMAFSeCQOZ6vXBg8pJZKMGaMhVdLkqN5XXVYD5s9+d3hMwyGVvpVtBRDQSY8wrFZIdWTVqD/oZICdXTlGF2ZzKHi6bp3wc4YFrUw9aH1mE9k5y
+HLnG1p1299cV9/DdwHQvIb8Y3KnRQ1XF+16Y+uj6/+V9baL9WGN9VPxV0xchK9yyNZp0J7TeFPEkTEg19uiUVy4VhzOAOxUV/y1e6U9yBk+t8FmhqjwSdVNr
+coPHLefx/nzb1NmFq5R/chaslrONKajdGzcALX4XZ9A5YctohIUTy//8DgAOOAIO6yObfB21s1gqoL2GsngVV3OTa49g2t+e1vnJQEtuDuJ2W9K0Pz6rP+HP
+pP/hSsuDp2yz45alaux8p+tuJWuGWKfJdXkbkYceyL09zUkDbWx9/9eb0+5rfIooetL6lxXhVl3MFbaMY/vQlLWbyzpk+84pC5QpsCaQIM8ttRpWygVAjnzl
+9TWaDJHSRzc47CoXSRnBV9I/CTHDH6c/U0VtTP81/ff0PzrchR15O2s7b5hczrWodpzuZAuUheLEwzUNsPpls/6iU4V0ll8bfkl9aq1r1lsLypRhx1+S1KAQ
+LZCJnGN9P7+Lny4QEiSPiXKSz9jwv9ijvCzeH0HPcdwVbwBIrixoaX7c0JHCuSdchS0glzFT0945JveToCOJcTTWBUPSjorCLpQykCNwOf0LWZqgaFH0bers
+N/RdTkZK68DTjXAwujqBhSqrFvbK8GuGhNObs4eKm0RP0uNejkp3+0ka+RCUgrzcT6A6w8iOOGJOF5HNVrKlMkmARJmcU93G7fjUgzYGWwrLmbWsAr6LWmD1
+Dujl83PU3UnlvwfOhrwj8RfQMqLXHoijxnRuM6xFedbqhic5AvI5ZP3bnJvURvk4HVAefHG+2sVdcal94x8BFDVlRjxq2XGgB3m35NsWhWyj8AXY9YvmZWF+
+E0ULHYdRx0YSIZnPUZI0FlWVRJkvqr4IitJogLXhBsr6Jr70BeWbxjc7aPiZ06BLH4MgYMWqVH0CwShGRpxvGruGZJdZlTK2r52lyi5W/SiYDfGyDCF8nRcH
+H1MJqB6THvLGT8CqYZkJE2GTFkdukxpzEvZjQOXnViT9Rkxc79Gzplf6VyWHXtZ11eJoWCnEJZaPisHR7KfSOZ+Mgq7ybEx72czEeX4IBFnOgcbILgIyWU4L
+WhCNGcA6in2XXwB4pCELD5bveVfDsX2vsQqzGkX2vpN7lLaT9ueqyr4/ngMIyRq/75U4vnwcq9KE+WMkmwkJeENJr2muBwQf2bAkgNGTAhcxQxnM/PLL/CEj
+pqYP7GPKNCV37R97BvfYdEB4+tMiFGbfva9QK8ffHVSZk6lEyaB3/HEAgCU4SsonmgmIl6XAw1Dx7Kz3gYsOHw0BHO3Zz1aOmv0xgvurbyyxvhK8TC0nxXSq
+uC+p7WpXl54aIyFVeFBOGwEzXQT2xxkMZN8evWNtQQ99AB7TkIh3lJg2AAwc0FhxNlVjrLIk6Cl9rI07xykCiHaVhX4nzRmns9iKUTg5CZzYOAOzkhAMlV7U
+QOuSM0sDIJg2sKaw6TGJLaicyVaYTZqirriur6Sfs93v7Jw7LWBENd6TwYj6oqzSg8iaMihYrTwzLipw6JQnVGvJSIZqlhBGg/5SbFefTVpOrfgaStGqGaeu
+Wssh9EyVlH5Gs9zFX/3n4r/kmumuJ3jTnOc9hT7O6baGIaxHU5qIpn0gHFfw6/44Ok3h6BnurocUvMaAAOoNDva6dSD6a1bEK7tORp4T4xWFD5wiUzl3VsBK
+/6Nwt291JDBTwUFYE3mpCnDqULlwQ2f2TY86cZUCSaTu5nupmHHClFGBu645G80pJQGyolHdNHVIsfhkLCjKYD0kH2XzsPgdy4cmh/dG+qJXXkvnbOwRtJpF
+VcKZz3MkNYx9g6dzqMlcwWXhY22mZ0/fK0mKLECuwwhoGa3tCsHBzRe5xfpLUFBPVr1xYNaN1/JeZ9+E828Hzzrs9hU7UsaWO/1TQwH6Mb5GAooHawIfWM9g
+/gRAmjk08F+gagEhtOUE92AKnT36TxzdGl+UvquSmS9elmFCjhG5Y6VJlzWezeTKVu6SWvuaDFJqGcVtIS8qJZOHhVP0LnRKi7QsJrgirCPt+J7scqBHipr0
+NdL/hqEBc4MkFUxCZWkODHISJrXEM25isuuWRmTgoK04LaxPh8Ap4yHBBYHcnfIGP46eZAk6AU2quUY+z2vlITXaiD+lVabawaTcQK4NevLk1+50PTrkQGUK
+XqYVbcfYrc2+EfKhBgDYnOuLN5YABcOpkYtFNh4EXusyzkQRq17GaDp8v1QrpIoyU8yGDaisve12e2uakFZ7HuB9Lb/st0i0pvN+NyANiSqsp3uQpL0XQ9oq
+v1BtvJflikubn0LKhOXd2hQCPaVW4xOM6WfYJ/hOwyUh0ojBztjCLq+vix8me6EdqC0tjxhNBVbxBZoZUerTBrUipUO0JE6WbkNiiCKFakblua9olXb9Qtid
+UgO0c/4Yx8URnYx5JUAiS3w8NqeFVO0VRPiYiNAi6zI+BnmDDU8rady/hoFGGOVWWX2bf1bPdmV5k81Y/dBSz/5J7fFsnGjx1Py/co/Knbup7AIW5pxHuoCB
+ADWSBlxYQEBUeClT3TCg7PsKhJVlJ2dVmX5JJK3y/9/jOtrfCivSZF7meUVF0lXTnNNjd4o1NOk4MKfDl578ko7Kw32TkfocHfY9zLRwCUayB2CCnka0a/U0
+XDdAU7bcO2D9xtJgxMh43FPQ7PvHnk/9o46lrUKlJCydQw3hKdmLe63b73dytw/aodcqwUuZRCJqIBfK7yelPF/4nOO3qhKoOqnoHbCZ33vBa2wnqJCMnFwy
+PqyPVxXD/VntjFP4nnSBz6700UN1DYhUdhteAJnTgBQRWa5sCHz7ZXnmBEAEoSwaALpZRhuxbFJXe3/6oHfxSN/Lkee0rdtwU5weBB2OtQYVN6piwFeddFHV
+Qk6GwFxLGq7qRmOzoalobaB2oSLdtWohbByvur17reN5tsr7ura+CXAxGs+TVgrL50wuHLvqZysznpx3nPpy+wrdIs1GsXQY+IvH9Jpf+48xzkV4xvxKDyrc
+cV5dN7Nuc8ptrlHJQWWhsgFzVpeP8q2sXSI5WS1dKm0E7fWbRB703EXnvvfroGnPdX8Xr53Qbyya2TX2yLv5/KzMvn4eiZbo1pRJhLrwvYslNoDB2pnIIM3G
+sNRSGJGzQnSaI59VPsk/XbNJPg1HH9tuKvtXe5BXgqOlfnSUWZhK+QbCrVjLijrRBqoVG8zLRRtj/4itcKi7rwGPscHL0ON4i42KGWHXIjqdnVUzeAq7uY7w
+nndSZCkQ/tF5ZxjXOyPPTMKsjVnTBvwZiGvtg+WohIU+r3DoaA7xImvCG1+BVEQM2v4xCJXfgyqDCvN2AQwo5PzAOap5Z59jZ/J9eD5SUDAVFWwQzDdI4aW8
+oseGl1aJaoLK26ftc+CYGzlSBO3Ss3OzdDD/YfqzsMdWHZSVc9GFAZRCeVVutUG+GOY2ymEYha6AZOvhxaqx5ibhNsDNga71kUFrcwmUC98Ltes38wNjL9pz
+V4URDfHo4Qo1mGLblBWkNh+MG5D9rTQHk+QtUm+Tukolx4aa3UA79JHbaBMC4EDX2802dEGFA0k4nv4o/Wn6q/Q36e/Tf0z/+5ZWZk1ry7XenJyywM/fbD+h
+2YXG5ffOSHuppvYq1YHc+meRicKDbah1Xn4OPMRKAYXNJ9nJ1OcaNJwX1gMuaUWwh/2858HKe4EnPOAcR8cxfU0Vx4QVtvGozXp70FbZGTe9gINa9hIsDIpJ
+0VCGbOFecHWYVuIranxuI1zs3YHynBqb+ywdtMND7K4jWo3RK+TdPa9+Ok+trxhslP18Tq6ewbOsSoMSUDvVw8LvGlPPe4RNNmgw5cEy0jWf/fMVW78zlt3G
+pJ1m5mo39ak2FaxwMXdHgZode4Ek3n/KfKRKQivci98tRE0ftC77A3XZg5BCaznR4759nyq6/3D6lDIptZbaNUW87HbbJLvKy7dHHVJ160JD7PikyAhT4C9e
+geIYDrF2vRDSVYmRAdjyEjsC+kr5aWH60eapYLpWeZmOCzieUy22/qSSbBKkV0Wpp6I6a5C/D90XQgFgfW1a+JIQq8HS+ylEOaJapDiObsPDg9NKpEGb/B/2
+5BcUPf9zigv7rveb2791C0aH8+Ci2Nnbb7MbGZXL3+1vnJevchetdItzchqmS2VOXcgJXhVCLu+9yMsLGuwjl1drpVyNK9aDtfrzn/aaydfV7+vPjd1p067G
+vTlPxZO/EbWmaI21tx5I/i478igmqVGM82wDteE1WppMoyyBbxKGWLfrdxrTj/jq/C1jxh+DJarUqN25yOypgnxoWVQV0ht1N+7Wym3Ep0audQQEZeM2ZQYK
+EhxeMKzjgNpy2zI8w+I10GihahxcdJqM5+0ntRPgF6rWi+X6QmJXCyPHpmxS8iobvtKdufBIRNJ96QhrT1E6x69IYCa9bvl+RhQBDpkGUuYEGwqiNiOKnSHV
+gzH0aRmNYMWku67i6t3nsy6X0Ncynuu4eqkMXlAY2PVkNy1abLPBKFxhULMbcmSLjiI6fel9XTz1RhNRfcv5fv8uYM1emFy+16ziWuGyrVOe97N29R139Zmd
+sW/oKzsOxMCcm2BOCdCT0ebbIWZAlIJ95ppSuVPkGnOpgdvrl55LbbH2MYrDCsiCBptU1vEn0qcUBwK8iv+pIkGE/G4lBcrr+NahmWVRRKK5UytBaxl6C+hM
+VV2PEzCFJ2zXdAYLR6tSKP/Yg54i8uiUydr+rMrCotoN+/O8mT/Y1KCTVsyZY7up6UdWT5b7fIEyEBCglpLUxIhEuN4VOUkYLmIjD/1GlwoUz1HPQIbJI0Pp
+n7Z9+mHdoeoNzNKv2FNtOfElDzrUV/yfURlaaJJbUYMFa5FhgIUtEQICGgRRmkmHxKOO8QdBph7C+pgCsEB61IrOY8WOvde1gCRK+2lnsBB08F8wOtg46Kzb
+n4+IxhLxeHeh8Fm+03150yX6NAiIEOkqq63OQxknuB41P2GxXnlF8Lv67KLORdYeIZ2Eii6vbseQIKx+f5uGMYAhSajLvK03tBrLH+bOeuMcM7RC2c2uvmen
+j6Lwmp6O3az6CPzxEMx57PcaXYjW2oa1x+MxkevoKuuTOsLZT2N3iMfyM5X3EsW4a90HMeYLd+nHVXX5FyynL7XQnSiMqei6u8RVs/GofsR1YiJe6YDiOQxW
+E+YrFZvtLXblm9IEwWbKQK58lq2b7Fr9nLfSl/VE+hVZwZPmaQ28euoqVW6jtp33dtu6Cw+GukOyVEoZTPWOhKAD3buVKzcUuj6I4ilVhn2BsHcNv/bOwBVZ
+9ugfCgNxVKzIUSVoJbh3Pu0qYUclMAyZlJNhlGswzrjQBnGopmYIsBIzwlJvNPeQyv85yakK2bkr5Mc1+VtLk6GnLTU6+HDRypOUKiU+t3tdIvMSrX1Te1z+
+SPl3DPfth+l+Z5vRZAW04sjGaPbO+aMdSOXEvTvKbERuA1eXOuh3TIfq3hqkl0C8YL49VutevpEUMjtgIKRZLDLISYsb2SHyokGtDo30wkEQU1Ivk6KsH2PI
+Kt+O1MOk7/hTjNr5EmMENLIfTdHbIoDaYVx7ig8xvcQp5oPCansel2MDthoTdC3FCwFpFmPQLPOS7PrBClCocutElRGuDnoQzTsB3QYvDTgtWWOHgzJM/VT6
+mlsF1viZIwdByLBqDCWDf6nG4UNXY1Mzh7BA+Rh1iqS6ozmIx2RZVxWGBv8CqTNx5QlSU2u7afSLpNvVUJKVMFr+OUTHYTsGzg1cgEoNej7Yz1bwa9VjmPbu
+xQdlBsX0o3pmWJvwAJhfv8t+XxIMJrXIbzHoHQf2WspqCl8EklcV51rPMgcA/UCw/4b9/1nV3Pjd9Efp731mXhrRdVaY7G2Tx5ON4YkfNgs3HbbrPobeiuwe
+5fp7yJe5FoYnNSrkC2DthQwEzmsUGILpkBG5Vn6qCDDjG3lM/Zr5PV4xf1NzXMYqrJ2OUwDRqg+wvYj4S4V4tJLqAfjhraPC8nSr9tKaJPU6qZ2c+iXxuJcE
+5Mxk5c3sJQMgaulFOoNUSVYmVSZpSFC7KAeIPcTlHLlnDrpvVA7GK73Qiorjc6Xphz5pP/TdRpc+W9iE5EcjIYeeenwiEABRDU8zGvoSQPwBC0BXHdEb0jON
+L/S1wCyhGoqCzDj5Gl+RmHsWaW7OSvOKWk6cQ1AxHxG1FYEbWIEApXlL1YZt6csyR+FRYD/Wktsu7kV9XrO6RLZfeKyvBU3RWfk077u8RDLPKARlJV1UGni7
+QHNFYXTqTLs9H2U9shNNMe4UDxABAOVSUZSVeVz8FvCuZACsY/OhxUJFuJc/wmf2zwRtREfbdD7tOeb+tG2/AePwMy+ynFpAjmQFCGN5VELScoWygVi3WqQB
+FkVeGESnPgggpxayswbtvDus1Tc7LsXfiQjGsFq1x6FCqlYN5/z5MPliPnQLtTmsUXpiRAiYUFPxWuEkAvZMys3MflzzB82YuNjgKhmfxUvzBFEOUJkab304
+fcXH7f68j8YY6qU7g1PvfNNFk6jWH+R0LqM99LWGBGUY6Eqdj6zoTdaBlwB4dNQSZMrDNEYIIJYcapeQFxnCGQzpNn1cdem/xX75H9pJcziveZPvagzz1uBP
+z7NLYsrza7JhHWhQCP8ZnTzvbjTXMGobKV+3TIZY4KVyj/jB7Hb4MYnbymHpBrLcX8kbna4NLCWLdLIC7syBe8X7hV9xrffAgj8LjeB0y/zyp5197s6WmCug
+JfERyHcbrU6YQUWaxwQPuMUW3aBuHb/xSvAVPqJ31KrTvjM1/67GRU6O27oZ1crMbfBQKZjkAGhrCKgwmMoyGArT5jH640LQVKG7snRzHr7f+ZaKFRWuEMlp
+SPQj+cdKo1VlPh0T5LGxgOtkj8nnvSRrymwSp2GU5M2qlHKKM80sKVBfmwbNK/C1aIzmIIZF7hO/OICFdyFHectq67/AiHfhUhRBC+9z1t6MxvNnX00bkk8K
+8y7jis5tcXa1+/jIfaCOCjXyOwTKSSjUYVFOUnNgUYI5yvzWsXwh/lkN8svomnQHYoOzlf5WY8bSbM5ezcN8XA1I+ORrD5h94fFLl4U9SM+JHwbilSKJ8E2N
+YeyxmGge1ciN7xo5OScPQv2dUnfnOnak9hMbZF3rkV/2DxQr86/73pOaOK/NFq6QtjvFoEztovvhxxreHfUUZSLao6zzMVDqWZ59kJQLhUplRUc3PRymryaL
+WPtUNEN0cZYTzmpp27n12DrW6CLke05oEWude9bi+1pVqj20Ufs5Mn2dFPGWN3AZHVdTiNXueohUH7fn5JCMC0WOpjox8gGIIhyjAUw1eKmIi6vqtAUN9yd6
+Tv575uRI1oIZiwkdX+5Ku14V32ctkO0boVbtgPDo7DCdrD/UKmkKx9C44JnyvXCM3z6WGp0jALFqwQoDjRXVGFokhySfYyhcYSt81irPA/oEy8bBhoQSLQ9i
+++fnq6XI7irWkjhvE2dgCDEqu1Ynie68L7bMuVDRHlcio13fMhd9sV6YQxGQTPSR1QSGak0eJeDnWSDxughJciLCYRuOZC8lNjiA76Z/lv4o/U36z+m/p/+X
+9Gfkcxjh7uSNIuvXnNCoXVGNgl3thCpT+bweB6f4C2+arw6BIWgAE6h264EEHfKyPi4e9uWo+YAQJK8FJ4FBqY4UgKF/iK0vZ5wWqLxKYN1wFW8pitANItgi
+qVmEcurJ75njkzbjlHSGrIGOL9mDjJm58wVrmdRHAeLIgsPjaE5QpLyHpNU8ks1Q3gya67jIAsO+6GyaBlVLSizKjzvSx+IKZRhs1TEm7zZ9vq8S8oJNO91s
+RsNliOX5IFMqH7F2RZchXfSWEC5JrjubwGkZud6qkR4oJ+y4PzX31+C3v+5o4X9ulcmq9BEYVDZKyy2ASQ09fz1xG9JB7etkoZbzYdh4J77l8vw874bIgBEw
+TWTSk7JgSb8f1DVKlDnwT2rlKFSkLbeJHjbiIui/B4cc201HgPRq8JHemw56t6XvjyPn2ZuJTM9cRbtrn/HkmeI+mSfpubwidZ4bvIwrQGWouQyikMNbBO8u
+mzJmPoWWWqEWOQUC/oLezJotaJaGQ2jC2AvW+C22+d9L/6yp/oSqmNRojJo/LA+XIHaqR56g+wYJFoPNdTls9kB7v7PSmDtGujgs7RcQt07xGLFvGIHkVJvR
+VbpIamMaUmCTB6zlMa/+1JhnpyhcyYrpvpklB+YbJXdRXNevpZZNUkQEi6RjEQYIkVtWicwypvL9bG1busa3m7cCfi8FfU9IP6ic9YKHNyyqKuPNR2/xPE6z
+tcjreHKoTJuL1IPM5aNhEWbFaN5awYO3zpaUloU3DhIi571P5f+LlbHKq5rm06wJe9WKUgYMyXm+ryr3jN4DlkL/Tj2pmszYQfLULX1Aua/G0em7ejJuzo6z
+VNDhKrZx3OmRpjSmenyXAbHRzrgE2XiTTE+eyxS2fQKAVkXdrRaOoofUE5Vi9KSBBGdh51nJjLzeMBtG5lK/zaPjyhlBvpHX7XSGcnOveYJkuerWbHK6IsYH
+i+5fv5U2GVXlaZXTzY67QIcdVS0E6aE8RJ7Bm3A3+31561Cvu1U+5X6nyUGFfyEQ4JDCDoUMsEDMqGgFrVKdRoJrYz6OGRUN+NKNX/8/0rrJL3vm4bjz5ANH
+nBtJhrnLR+zO7HZ3fPuedvAzNqQaAv8+GjqK30goKQcMTDWaeODnT+Yb3uRqyXf1LOmyXPW+VNjsdeD3bS9trbHoIazUTgCLjQ5qco5kwLRqqif+joL5hgTe
+EECA28nI+lBx/KpCtRWtDqnBDwjm+AvqTf6W80f+ZfqX6e9kH3K+lTMOswQNETZUoUO3FWsyb3UKHAzKGcrXd6enewaSppPC2aq9+1w+15JayGFc6SBgfBTo
+G8iqkSpTKZmA0EZwVU17pb/1arpLX0hf5bljz2TUA+c+8mfzrFTPJFcKCvFO7z31qH4tn1cjAPE4GjbtobKZlQdekrMvyHG1EEuRgYgSDFrfAAIARHAmyVib
+sR6YX3SO+qcwvqu2dhvzaD0GpwC+1zHPq91gaAqU/tB2F+jQMcSWzCzpImpX9gLZy/zRLDqQ0K3EnH2ZpZRS52sLp+q3NFPzr9O/C/2L7mtHjdh78w/PbR5n
+BdBq+mrn5t3t90wzi7FHCvgGy7opBGtRnI1MWdt7EWdRMSmXSJ+MgV7VCAOaRoymAxddAcF7HJauNjsG7H7b/8lzdxd7P3X0/F+E9IvhnM7z7ll7SEyz1+jH
+KxVbTtRYsJK0OQw0n7SQ4vRQdN6t/Qhqe3j12hciwyJATu1hMnjx7DE1tqHRHHxF2Zm/qXriwuHFQ7pttR9rf98sBrXfWVK8laBsVJb20+7OdIjr94SIR+Ub
+hLwrK6cyD3xB0XZaRKKavxCjFzu3K0eLVgPLlIHi1bIewRj7k58zs3nV+Pt6rGuJCNWkH8Qpe6Ztq/V5vvK6wtsx4wPJ50XoGqVp/hKeIyimQhS3XOzACl1D
+/0LXO27KNaI+8RuN5YuMEb0NbBsdBDRW3v5pHtNNCEVauWgl+DDS8LhbC3IMJbR6ZByvasQ4uNTz7uAooVThzHr2kyx2fRq45sb0uvKnmt5GqMVbftG6p+pp
+VTVlRRcghFyK6y/PLXQ+nT+TlXLjLkhCFKM2EGQggQfzQSYunrDvAxiMMJOmnJdyj1Uw4jFVgLcebtQGyZqCQo0+sRdqeZ/34VpX/XbX+p9YH8Be1vAkvqDa
+eD3bA5JKFdmnyCVvtNrzqaXXvlFU/HMDY504DAXrxS+VJNI+SCR31J1BnCTodgXMi7yKQVy3/DYjTbdqDCiYsqOCIUukmjvUadm8mj6oKHjpJfgCZ6F+Xqs1
+xUv4e65llPniEIsbWc4anx7P4yykVNdpCdv806dzmZLPDDWib9aVtAh1YozHcjN2c5UnBt08ymC1xWMoKJ6MLuol8y3RkzKHi0yTz6RMOwDgQwNfxh7fgwHJ
+atMKK32njzBuotSD/lrsjeSwAm6d/2m26okQq8l/Wo7fMDL8bDqfVkK8YmENn8iXvaRr0ZdJNHhOwAi0AGrrOzbBGGLrOC+9eolxf0UMwmc0QnY22nUX9j5i
+kX2Ep2nfdmavUgI1j3fScQdYPkGA9+fYNaX4idiIvbTBFpndbpMCDpuJPC1fb7sW8ubI9vHAmQ1eOR8DWYawTzFRe9OeW8YW6pnVapiuKImLg7lJTOoAMdwq
+peW2iEFezvyL2Lmq7H09D8ur3If7jdp/fBNIr+5kDXO4PJ2qX9dwph8nf4N2PfAk3FbspWYr72wVVwgtZW+sr9K2EuJhKboMEOp48n5BSw0aVyrHIkNnFOih
+tUtSnjfZEGHMn2u05I1FeRd6ro/NYWp8CGIa2ZIFnGLr2zrRk/P0gtomOdQWpRYUWCLfF6lGlDsLY7JgWc9LHSTGtrjIaYq13lQ5Ej7HtQfD7f2G5waCnkrD
+bDpVmePQnqyocz4mpQKhTRX8k3a0FiN4X37guG/OBeuRHDBQn9ZH1qLEYwJA0ROx7Ad5S4aPdtHXnBX8AlKWCDbrgdYNy9lgqZVX4TX2I6zCL9nLguAUr0o7
+eOYwEwfZzLv9hjz6HDvEgod1OnrQUIsTfJraDzix70ElvUkuddE2bcMBVlZOCpyCCxI0lFpo7E2PaZXK9bgoeFue/qVef736Dl8JiKyO7HYnDIh3eqDbZ5Vp
+P3KeezqpZKcmEfa1F/oCubYoIQtJEGtyIhOcLaSZ+4WW5MkB6cInshQDVNQxqDgVtjmzFPnSf1dZb5o9wLe5+yqCefYN6aod2I7d0X2hnYTeqHBUyYhDUOuV
+wE6Mc20Kaj67BNEi0ZGk+AHlY9YggkzZF9RrElYV9mA45EKTz6w1aLHzH9Yeo+I5SwSh6PMNL+ZY0vRNH4r6KIavzysP5d7z3LfMy8CUGgAECvJcas+yNEGH
+FhyUVE/lsgqeSmM21EPeUlYrP21Uaw3Hf6vkaHGCd6e34IpcQdmrM6Dm9q0T76hJv3W3Eefzag6fY6G2OS8TM65Lf9GSApS5hWfAqF/bibBYo+0DAVKuu5zf
+F/2zo2MOLFLS1R+aRSuo0hmGte+b/2Oj94LiTT6f1IyFwpGOUJUNcF274YIkiKag4Su9PpCfrt94P92rwVcTdaVWYUs76WLPSDcMU6MLlvx0FByC7orzJt7i
+5MVXs2N1eAGf95gAFHmhh71Jayxe1KpDlN/RpgRbkaNQl40+3C79dfpbjuz+c/rf0/8ZudnPLnvPWVDX3olZK9NmNuVf5Z1mS37q9BlPLpxef372LGstV0Zg
+/+jKQMBJDgw2bxFCJFgQc1pqwhP9bNMCJ0N32BNYyOFcCGu1Us8Y4vWiC8bjo+XRiCpd5iNUb9GVK+ed2bsTH3HCRXOvn6/puPXvGwHogQHeQpK5oHj3/JGR
+Ojgk9NSve//y0XDKjFdLgW6yy7IP8XutlsEH0led0d90qf48IF53qtxiXQ/cUtX3J/nn0NV4ZmDW8YXFfiMbME2YURkcR4r313W9QNWT9Cvg41JGfCnfIeQS
+KkBZU4SSG9D4Hi1IYlrBdK3k72D8nivkE2xRf5LXQVUGbhStThUDJQGiuYJj8LqjHz1FAq9s6LV9pVAUBLTUIf0BRHVSMjhHu7BWKA0k1kNUKajL2zK2O2AR
+8cbB1KjCmeruc6g71l1R1s3vclwhmnX/KaXbo4jpa4bcVowA3aS6fN7vnqt/UFbXvdBNWGVSsIv7W0Z4VsktTRKISs3JFCFPZQMJuPE5P+NftUcYrOxmVkL8
+isXtTpmGwJlkLgUCZJ4pIJfz1x0pHhao1Fm3zciQJV7VI6qsqR75pB5D8vMN6ybHHiF/IhWmFg2qgHnDRt2fXU583jng3rKU+/Oo2DE1y3fstHUGaIsGEx+U
+3hQRUDOVvFF8a1gKIkUXDbVCQaIG50EcXYi3lS26AaM5irluUxMvsWvkPvmzFZ93k+PfkDc1x7W4t9wM7PQ90+kmPD+szvTQg0ZK8mJtkKAQfGRQkQb22SJ8
+Vo9ZwD4YYW6X/q+HuOcfs3pwrSeHXZ0KWUdZ/BpB1l2p1HnsUv/xatDP9q9lylMPwfzf23Nt0csbJTsTegPi/8pT8jY9kC1TFsoF1dGjjV6F8qW4RlATWACA
+MNY0eqWGXnFBFvSc9Sb2SNQmz3E2aksVZ7c2aNtLngk5S+Li3vLh4hndOvZ0v2mem6qmGxjrm4qDfrRdILCshb9FYiB47vSdImsmbbcodinXued8NsUuXLXX
+SV4r/Qw11/UTGvP96gqx+2/07JoiJ1Sb3QzcYOW/8zNrzmEQUTcbM/8i+dG4l+7P21+PJMaRGsgyWk4gXUApgGU3XcjF0+qc8rQgkKobOcc41s71Nh8UJS7a
+R1MfLyd927PsEeTsVlbtragUBpMk0KKjwvXP89ka8adTUIjWVg95HtpDNawAZdvEClrlFYNaC2ePRxycJUmLoP0rr6FGGZI4oGp9qyluAcz+fxNb9CpXX7WM
+kGW/54pe5efSEpsD6r6MZ3XsFK8XCQAymDwj3xthRFYdmUv0dh3Fq/UfMppcd4HWfu67gmZX6YD954yPYl/lptaj+AqVLeCsNf7zIXz/1Pzzke4qzjNUNvjc
+tluv5BxtBqxyHI66bIvt00QXZpc39b4tWocyqL0ZA7hpjcpn8FTwo99FZb2p+4O3GYT4AAJaoKpWV+boKqNap8JEbJSmJvr7z/nJwUpjQZpqf66NdHM4ew7O
+ET2bvlZxDdtfK3gKqJUP8HP0QhnryiYYeMUMSRPPOQFeYjmiRqWerUL0N4BpRNSzGp2zEbt+FukH+l1FwP+t8Z00rOo6D67PZRQQT8XjkQ6iIcgXAso7Z/lY
+ReHlRIKaRmfQGW5E4EgM13TiB+KNx36NB97ULDGMQTfGZebo+Bhup9fCHJVc86/zurKZ+necxUg1TGqkr47eRnKapO3H3hZqGk1xilfN/mgKhCcm/uvX5l0o
+4Nx3TEJsjanplkA0veEgHusZP6pyn0amYap60u0fqtMARkvPCVxUhhFbfr7YkP8mON8dpintQm4vRBG57iYe09500HSBmexnZaRoe3I01XPnyfC567y5i4WP
+0oFjs5K9TxWxdoJrEJHVqXOhPm6xchVOUmNOsOCAWhERsvvy02SgZz+2sGm4erfOC6Rn3rsvKgzFeqUb758oozVV9cPudFbt/PJB2YQqL/jIkSFz7ctfWlry
+b26ZKh/lQrVOU5d7SqpBjmNKz9iWJiP6GNQnCDNvzpYQEMoft79EQEQwyktc8pSAdBF8KC6uD4uM89rzyF1VlcPy2YBrx0lyWDINKvp3toZ5caMe08DZBPWb
+qPxlJ7x5TDxm7X/RRCXPBbLuN/KYK49CmlvuZv03Cu8ULklz/yM7twNdXK4xw0I2jzK2zzHvex3ZbqV85fZ157VM3gNhBdRx9o+s9zyT4pbricnPlxRueryu
+z7CX5H3iUc0lYtDCbZhyM/PUcX0wtEjKYWXuAYbU8ZGT3oWyQH32jVNK1sHHVQ8qXtf1a7AZq1fTXwOAXccCG389A8o1DH4Nn0pHZfzRP6pzI2mKkyxN71Ow
+yyk3SORPpILAl8ClMLk/AHhJY/kGEgk2mGskfCHJuFXNTr6ePrbhy5pvL5xkhgJw9Yb5PE6z2wrhn3N4WOVB/kzW197M8ly4kTW36SSqAngSSzdw/+igsuk5
+qbCFclcnsCq4lT+NG9o8fxi1xQXGUggq+wVhU56nLt7mnjxLv9LYxI4jrFeZPJ+6TVazDdbTuZMS2Xk2w0VPu6EsDuU7idATZc7eyQ+kBTVhG+1b4W0urM3W
+I1wv/nzybLWgGLttf/EYnQBxgaFudw0buc0zAwFcoNnqYa8XlbW3Kk+0xshX7c2mmZpkideAVFZ2bszSkIAuKTug60J1znx6F6p7XmoYgb1oc1XHS4lmKgC1
+znuzpuWuTob2GEgXq6xhvVLFI1YfidXjFIxH7VrdXBOIF7MuVXMemBf5dbZgUokxJrjzbVi73WKdnAvR+Sa4opCQiHxlAgFdgBCZMiwBLeB2zFwU1Igaskhk
+5iT9B+8PnMOvpqmrGZ2Uu85QVXwXnm/6H50K9Gey2wlDGM6B+HNfXN1goZFk6S5JyZM8lFebAdmAlO5qdv5K3zSD3kCQmv32ofRDrIWkLOJqy6fIaRYHGKiH
+ZlZsHUjFDV13Pax1JWzgqwW8pFwhxna5BmmTC6v78ONs09enyzEs+P3RLq5QHu7ag8WzD2Vd5HCsINsdRDtS7N7XOXk9zfLXDzUbwHEb/3kefOt1MpeNFEIF
+PFwGPqDcRLKwDACBgMKdysn1ZGXN/ZSeXFaNPHJjxkkI6yXH2WzxXdTViCpe0fPj2ySKEkwRiEJ5rmEd/68BD984TXEToIr/5eTcEh2HhPkGzuMu4/hA+mj6
+vOb8/Q4akn13imtqUurgmOxnPNpdkIDMWG5hgoUyXyPfM2dB4OWX5P4CEGbyS4Nc6+1LrTPGa/1RVYYo2HNTLNFwsvFpphpWCrQuLL2uwpBUf8oXIgIXL2Up
+GhpERepAFiWoTFW4+NDqH673/enDrNDxo+p71ay/gBvaU7GVdCqzyXR0xVaX1WAxkLjjPI1WeizX6KdkRvs+IWYwHoxqy6udNIb2Li6znNt911qYXb5VSPpH
+wxjp4W3KxRaaiZbuonK6fC11O/XuvumuPqaIIapzWbqdfzp9I307/ebGvY9TeQqlJ+fymvUVoQnklMSoaXRxG8qNeSvzK3yPFcolSxgdBc8ZG7nbgBeFywy1
+O6yu5lSZMCxQLeMDQKp4wdT4g19MX3Yup1a5RsttQZUmZKvU6J5vgtOu4F3lGFW5E1JWOYkpHpqMJo7xLEKurfFI0qt+fc/Ue3+DY7/CJPxPGg/WhcODd1pG
+Efx3ySXGjiV75TwfPV0vd0bWVW4gq8mOWYCFLYsUg/VNaCtsqA4dCV8eVkALGT0Lv+RrDHWnFhvzU3YfZpv/vUZtqjC70+BNedOyst1lbzPgE44WsFM1Sas7
+DrjQAqOo46ZsUX5Gsg5dVLTZknCgh/SO9KAllpwAEOh/xr6QMXUws+9vX5RtC8rj+La2hoz1+s6oZ+2RrX7pN6ocJrY7pJ1vckfHutLZjHWH65042qc7vj0A
+WvZ7TLJBBi2rowL7MinpusQhlnAdU+yf4iQMPcj3gCj2Opc1dcM1jxr1pttg7IOldVbIu1CBymphYYRLcgNLgGU9K4uC/cNc8ja8XMbQbFN5RKT3i5oztSDy
+d3x9fG1RBop1e7lf4sC8eGdFomV+VXbGnVv/DDJ/ZauJ0aelOMWk8wfwjvSQVH4kAywGSc2cWwLxvMK8FU7+c4f5MkKtcqIrz8ned6AHeJOxNJspKWtjXB38
+EnPIoUMXKjMcHGF0rSNqyOghS8yPI9hcd+XMHNnos/fOTYx6nfSiZXZVXXaROVnkh/lPLwBp1J8Fjm1+ln++kSgT9M5kO7fvNm8Uma2mJt8bcUHnv1hwRGk9
+MjJYtY25AQCLJc50vZ7FZ1nluv1E7Grde0frWqdV84tqQcb0UBG7Yl0WBM5RiuSq5ACsdjFrZlnmVnKRVd4itEnXHGyx0Pfa+LZUHpB6EznVHFpEAah8bdJ7
+S5JoWvt667V8kLv9ykqdTkr1cXKKi3IBJhS4U81kESlHlLUIAPHQcESrLhFbkNqsuPjm5nioXoN4809chaIFO0EtpjuSJZUNZyO+GlXRDizXopXt9rpQTld+
+rmXNB/I1YXtgv72K+1e6fdF96QtySPYs6RyQzsHHWM/j2hzcKLFtCZ5n7pSP9yAraBqX8rwfKAJhxlw42ihrzif+3c8yp8UTf1lLAKezE6AcmWCpNiAqd9vu
+Rn5w49o6FA/fj/WFCvmU7qByQhD/lFYrUPTQjU/ogxqrPLWCnzuMVJor8mnaPy/hQLhCMtQ5aAtRc1EEYJaT+OAYIY1+DT8oWM68/su1BWI/KfRh547AseW0
+a2sQIsJQFRDlG8z8lMEWefkHOJRpSmsCEwwtQEOKw+FHrkhfaZb3Allaun6JxlZ8Lt1fnWmlsd8koBMGpMZoLMa9AFYm73YnbFDJ9TXgT7n3a2zQRYXvz10h
+RK4x3pJ6cVLrzJvSviGxJltckmnFDO6PcvcMVyoQA5a8bdYTiMJkW/XzHNti3d0EIPjSAc1e+DtJ+pe7h1IWI5YP0NqqT8mp4hu1/FfyM1v3a3SqGaj3RY/z
+hyITLhsv3pM0Nn1Bv1fneXM9yG2f20JlgKeujn2Dqdr+4GilXCnPkV3jQIGXo3sQACyoGNv24k2Cg1cUUSx5iA+RE1YMk9gXUyoF1Q28tvY7sv/zFKTRi3Se
+glvOhSvC0rC3WtYR3TQyCE7sJ1/EftpY1+YyNvLyxCCYzjSm7oyVqtUTu1cPs2qTqj3aELKcNtwAcENi9mVRkvD2TkgVMJmejbyaqSw5GKtGmlz3F5w38InZ
+nzSP2RifStzDCVR+4c7nv714XBw0oV6MytGsTgN2YHigeunieGfmLuHJjzjK0RkVPu8MiMLuXvpr6/4Jh8XmCda2hBzW0AN9XgqJ/D1nty9urO4gdQaqrtMA
+5sJ3NFqolZmFwPSqc017+Vy0ZkkfnseJfM4/6DwkadMu2+iZgkTS8E0xkRsmz7dVvIqLHLk9w5GTjV1two+/6HyQVw1LyIcqsASg2hdmTw0LUpUhvxqjGg0R
+qk7C/s1cO1Zuug4Wl8vkdZr548pNlAc5x9dFsGdkR7LC7qzmYcICGBo7G52ED7JO7Y+owu+Ldv9+N/lFC2cwc1tJw2rv+A+uHJCRlOT2yl6/6JJKNCQm+4ER
+AKJ9KudJYVF66kThfV4Zb0NpOcAkDKNdQ8njef+W6nO2B8mYoIyAaCtA3DxDCDIQmYCDPGpzCE9/es3Xj3HP9PgBWUHHGEAcNTLO02kqbQf5yTrfeR/ZIbu4
+Q8WRs+T7U1/bywCLV2YgkzCNDSoHtMaLosWuMibVDOF1ZNt0FxiDqqq1+kbneX0jPWhD11d2cyP8OHWNleUiqwobFgNsl5mCxppeGWG0+QR7iVYv+sfX45wV
+XGOtZXt3mvZ33KzEsUSMcpKWV5SuJ1CKaud5XtlObLXTywFYCGZpwJYLv+wN6cdljoFpfe0njjEqFV04rOsxGBSoRwjeKmYCQJeWdj1DdwfrVUOG7NoZ8qWJ
++D9oW0+3p381/caTezr4HSbydJ1/cK8dcpP6h5Nw+4r5jlbpMbVTKw9NRG75huKRrKuQSq6WgRTnH/d2ZYL4qtbsWdniib3tsnNOE3CemuPdFtvBKBKaw33j
+xBgDMAEsdFu04zvBUI/0uuelaYYGhwstqbNlepznnjOx7ieJuv4k/fmTd7dG38834qyD3PKqLOhqJmY99lGmZ7+bj7fSLCNWPATCfRGvcULJObuvWfVuoagc
+o3aYEwBwYZu0jlHjA/PrrueuZmuEqeLKbw0tvroxI6RF/NrU315rOd+5EU4VE8jJLWtOp9wnuUtlLRbDtXmaxu58Lt7ShcI+wtp6D+tQ0NpjKralxoaiia8z
+ctIEosWk4jVxT2dnf0ORwQ4vpSZBsOkB4wXIABfe7ZUEN1ydFO35W00s8Sm+XyWiuB5LzBXwtJuL29M5czdP5T/NcVu28khGXAiZ5LiA8s/WFLmOzFsWq90I
+kYJg0k5bZj9XZ7lJHQdnWY+uUfPlHm0yCXbNHUGM3LC72UO1DQsOUIOl5pz6IK+6wvT+VCa3i8oiHGvqsriYKagzrFxLLWdJaT4n72YTfdT2HPpy8bqUr/nl
+s1dHbWWbAhM0H7MrTzKms9r5fCqvhaCEj5RtOsNa+FRgzbdTZR8aPPRssR6PvR81comxGSE8H4ERL5mXLQMvFqXLzMiUhEChfoT8n6aJQodllUF6xa/3FY01
+fkx5Nr+iV83H4P31FcFt29PzU822nfcBUKwKXvGIX5LWYUM7Nq5slIDYeGiEqCK7wZe9Ne9k67yenezyClK8Mly46pyJWen7VHmgPa7lHKqVGg/fMjsgrqrD
+LyKMKajIBdIKQgysHs/CmCLvxJWx1c67U4/Y5TOpalBN+2clIjmf+oEMgBiFvrHqR8HiLStYHZAm+VB+XDAeFGogt+nnuVvs6lXHvrq9qPhEyft5+2VjaL/Z
+uB1g0VDVv8rxi6AqqeOhNfaxPrVvVj/B+A5/Jv2O9BjfmBLYNnjWCyoW8PaZEs2SyETox9ZhDrIncxXjHDFANeuFX8j9KEQcqCr3G5DV1+rVUip1CZa+NwID
+DUdrlwtT2HeEj/YQGBv6aRED7EGArYwojniKeWdOsZw4TtPoYE1WYLSX1YTTQuo8UjuE0FyhReYlQu5BnI0+Zvuc8sPVbvtjdXf9NgdzsWfQB5uNTUvJ4f7B
+mIXDeOT5gooNaV0lHS020XOmh82SC9v07kwv3SlvCsrregTXHZ2THUK37fF+pQKkG0wySKsiLXrSXkZzaU/6dzZ+7w+yj/fjT3q+WdD9Bhtp5jW6wKWOLBH9
+VTd4kP7yIan8G+tpyPUAx4iq1XMtJjys+dKb1TB6X3drt5AWQiVQyLKRURTVhtQEem2aNksbnXNBPWviWVNO/50nr7fTHdMjMLh0c0gfljectYnSfkCZP/sD
+hbTOQUYMIiX88k8HGo4VJcOUL/gp7+fydU6o6718Tgs16/nDfD6aAqlLbK6jALVCczge2UU8RD4RfdNJMVxttmK0eq3XbEt6msCRCVQus4pJ5QaTo7dvlW63
+p5HNPzwuqerh2574WsxNPJWJMfj7hgz33hzIyZVGN1xLogW7/ZPIHBhUY9uqbq/3U01HaG+oxvvqb8bz43uNtguboWNHGr3bTJYpe4eB8WJxfjPvNAVNiOeS
+dMJF2535TkI8RgEqHAZXh6USvPB/3S2Wb2x1Pw3VfDc2b8e5TWG1N47Co6DyDHp7zQpevYnCpSCKh/FAqVEBGYssrZ3uqJBafSDjAOCMkXnXuy3QRMdxvTeu
+zPM8bdWNq7yKiKtIDl1sUCm/TjWEaKETrnjcPogJJZ0CBIgw3qDAhZGx6vzzS1hmhQ+E8hNIpg9Vz9KfTKBeAUcehycr59X27iuLqjdecy732j2cVogoAPJu
+AdIE76NIGeIG6MVK0XiRDskyFShhtt/eTI7jqvWyt4QfdAv1t1JN8WzFsXPvViq5x8YDXKdAJZvd7LZhxQFaEViN5axOUlDHkK8DD+oH+RyvfNe/mb73gvsn
+5Ld976MtVlmpQq10uq8o89LbrKRk65soGK824FjKBwCiFl++vqMJSCbKlyvJak26rF1NHsh6MOq5+ZHacXPw/INKf2kCgj28dcRSdnC5/ePZ0oyceODCgSQe
+YKGmGIHibiEgAQFfc3lbbhEyOcmIy7N2n32Ia21FzUzwQKaZqler5/oLL/dku8pXan/YIacloNyTJRwAWeVD+dOC+h6Si70+lAvfBAQnyYp2/rlmVYoFKefd
+tO/8syeQPtKf09IEYRCTw7DIXMNS/wEM6nNd1rYeTcoTG53Kj7gnyQxsh232cL4Bt+eVkK23itx2pejyvfO0bwrArqO1t/u5y8b7LsAaHhk1vE/RMvAqrMkV
+vTmVikFY+9SrHEiLv+XXFkaXvOJUlZrjJ9OP6b366SdzofdBQM6YHXObl1f+8M6tIs1QELhY50IbdjynjjD6khSvQjT41gnndIkDXLvgpuqa6d0RbZRbxZhp
+0ku+OXXEMppjctGcuPxgETLKhUZAZJuzmP62B9nW7e1r0e8LmeeoivybjesIEOzXjzYKytMppKFP1Vl8ChnRS8iyeVZznX3XY+MMCkTCbgBYJ08GWJJL3HTn
+bqcIm90sd/mFVwyTdhP3yaR5Bv88Gwyw8bL0G7pt+LPKhczHXa45fwKkWo0n3gYknoONNwPlMq6crFHXqwbpMdGjfFC7IumF4DO8zpbiazHibNtx591aY8G7
+z7UyqNq2Ib8nbbLqHyTjOQV+Do9pqT0mXCryzHZD3LEg6g5/rfFx7pQbpuRZhQOXvQEbwXkfr5wFkpxdssx1tmrvkWMPB50KCfzuJKkI8WCfnU9Ki39Y52DH
+MtMqDCEdtyr3UdNdxRXNpn+oeVkPLhVYBOiqTJFEnA2OYoNbX+BNVj0rViLNHnGcw4Fk92kz8tJNdyxlUAkk79xn1dhDzibUuN8vq3yHwIoR2HnmWZMgxMW1
+2kga639BI/6JOlCVQVQBg9m90ApvuVWXhu3ZylMzDMKQFjdlYqHVll1xue2mJSo/i24EkqsTOd4X0qfTm82+Oc+ywGZBmx8n68OUD1mYrNSFKTfIdwtJy3YR
+ceeglmSBZdQpxypHqsobkEmJy25CDukL6WdflMGXNX60ltDjroH/eXGL51rFQ1zt57DO4GdCFRmzY7y0K5ZlCyR6FwgDhXpE5XvDWrtYhKKWYl9QtVGfV82w
+usMZ9M1nnm/4ENMcpzro28BIXCX693r+N6k9kT7Njbp4hAWi+Yqa18VVSl4NGUrraJagiJaq31P3wo9olR7S1zcRmbzsJx+OgorUw7Kub9a7k3u4Z6qPAMeU
+61rK/ZCIio8BBModInNhTCDAkESFIrF+GXo3eNnLC7V1gx9hdl/LZh0rncTJcgHapxKxg5YeUmGtqgp/3CqEVVQKG+yRyYmxFjQ0IuM7hjw2dTnrY/E0nSjE
+4wjW2+K3VIJv96ItTTYkamvBxS97SzmHrmZn70P/b6Oe1cTYB/MPGpXTg3WXdWhCMHRDjKWrQEV5d4rgIjTZgi68xgWHkGuJukpT08dRq92VFmMqtvYJhFHt
+23g9z1Ob0RcJ5mJTY3mEKmqIFgz+pfVm5E2oMw0q0yi5/nf5/SnIh59STnxVNLNG90quHcnveXU+AUgwtPCdk37eqQcg1Laa/RfIYQMgEm03+VLuiLjJDnBr
+h2ZNQGYkhoDZ/mVBHM4buVGpeJ89uJtXOZ/ZAroqHuEvMY+rGWKyXmwSJslo3hMin+diCWJCh4CQXIsO24IsRhzij2tEE7lbIxCvemfSlTVL/nTHopDTeD18
+E/Ndz0RAoyEuK4QKsDaG1lXUJHaqynezUk88rNdertod0f//eHqT+ZjdCn5fMbbrEdlUOGp6Oh29T22uLtA8nURUABa4EmhTF4lZBXlBh18+HXCrBKjlqcoS
+kJjvAQYCiPNQGSxTdlmebazUhq7rFs+e0ZLVdpeskV695ReIORNcazL5YxGStmpk8kZDzPbDO1MXABiVQ7/PtZzZG/9FZpn+XsemsG8j96bGEVi9TudRDOY+
+lEf8BFVjejrvlUGF7LCycF1vk2qPXdxjRywrngNd6yi079jkqE8rUCq1TNL7iVLES6nRWBGVsU856rJgjEqG7zvpt0s3zSF2/bVhhzVoWBdoGd4zVX7oNOkr
+rcHVCgkuyW8+GvZ0qcLUjS494CJHC1cgaGV/VfuHJSNwxSxZOVzLh8aHKyifX2Wtgz+8kmWTSHrVz7WmPbvVg2hj5sTDG+UoEuyB3UkX3w61yTgzWehb6x3n
+FXGpjo4ypeWNOhjDrhMAGTdy9YP2MY57srLu/o02iDm3gj3XUq9u7NNWpb3cNhR97AvfiUF7ndilUM8it1V3rDXcZQUY4g9tj045nb6jXMZPVBV2enBt8SxO
+eiRLiKdim8Vws42/nsaOceuQoucG4KK5kiNRDjZLaXfhK6m7rzd3IRMoUNIpbDLbojFnOZWfdRVR19vbriGJqtB+t5UHamQbAhXhbPivmmmZaoNcfLaKLX0m
+xuTYO6oN0o9Jv1ySbftQaBpleYfV0D0oNqMBoOnl4IjMXyh9g5JnisrVyVLGNrIrNi9XGF8x9d2XNycTUKonWyYbxoJhG5tWItVnS9I2l+g+Bw6MtX2rNACZ
+TJktnmnvDnu8cqhE/b3rGQUBwal8TXecc0B4zw23531FNkpb2/l059pjq9yCJGAop6oURoA1FyKIpfK49IhNCErd/BRtkUhOqDLHp3et+nz+sVaIn8IrH9yp
+61Qop9M5MnVIsHdy6y1fD6GCAwCjJMwAkajeY3G5FtxCMSssWAQmQWTNlTYtPSbV0BUg9mtNbeTznHP46UaF/fee7Ku+7UMndV0nh5aVcWXliCp7ojvPPTZs
+U3RoAmFkIoOO7WvPb4LNPmAPrKyswj+CmDdcupRS+qDPw3uZX6ns66gSUvV5/gWrqP279B9Vb70mlTfPtsj5ZRmb8o4bj/Oto6j0AZjclTRC3wTWYW958+88
+15+bOQGyLrp7lr+ckeq5ZMALAOCQIJMlmJEgJ5Ox59elBOIK90GlBtoT1F3hnFBJJntF8qaTH+Ok1zPWZlrsiXJa+8Q+jUNx/rTKDGrUM643IcfQznTXRtKd
+0KFOFE2RAYjtJouXIpftCZDcNFKPOVGjLKtu4Xy/HMdIPmexBv6JjhljO8cXegPZehxcpnXv0ivZKBPPbb91JoEPa8NdJsvyLUqdSKtEXzZO5pzIU9tAVCVY
+cn/fRtd2+I+mT3mr8Iqr2OgQ6NUEX9sk7LW/2Hy2NzW5mpE+SpnPO7sPwnymzbejjCuFW1wB/GvslwO+EsjP8e4BPjwkL30NR43Q7QOsyhkq76p/DMPcFV+5
+2pTNE1SruW2Cprbb2ZzZVO5eVOOtgnF3IlDdcnahaofwyBZZ0EAqHNJXfiWJhX3Zty3xXnvId6k5Xz+qfoWjIY2F+7zRN8sw7G6BRZlzSYXfOvd91ATJtTMb
++Ryx3tqOriCjZ0ssNZBT7K6FBieiXdwqcqtQLDYg2GB5n6UfTCfuS/2adJFJ9HMdDX08h7wAT8hkZNj+v40JltQcoyZ4iFpmWLQQuazhIoNaKz4pLlB1p4r1
+6GLb0ev133a9aa1thaacab/tN5za9LclvnuxrDKKgIMJ4wrkiRENs4iby5YNUHy6p1I5URur3h/h1zU+ELk/1zr8ypK7X9+Mqc9yN+1opnm/qDI2Tzjkvq0P
+obkHI8TUdoNbbPXMep/E2G2yVFacvbZ8WQ9ZvfTySpSBMXZDbZaK9gIAMvujQmNLDDJYVEslERJQMX8E1DbiiIdQb+NFE4oEDYK60Uic0ifTV9o1t67mW6PD
+Tgtk4mi1wnfW2T3tz5KOWkl8dzRxpKUjPs5dppWI8w0VK613bCCRqy+/BDOSF5pqTnlpZCAbvqxn6adVG/PP0t+l/5b+r/Q/0v8n/f9qV5ITGV1Dxht8pG+H
+U+CmvBxaXI7egjWfK9ZpDg5s6FZyDNesh660bKk3bmLfwZtynaO26qZ8Nv56VaaxIDQbDNt5kvtdjOtPFT3RtjYtaUhLo1NVa1vvVwbwEtWJSnLcPWbJshXt
+KgP4uW04va0akjsnCphirn/XAOFcL0+Xm/RH83lEj0lPHZS9M9oayhUZh0IvTnX8Q2rIKvizapqJR5JVPJiLOPyV8rbWetIPp99jTOBfMnLk2Gm/nV2tTNDN
+JVzRqu20dRaHfqO5A9qeZfllX1F3hjwl8LQjlOd0WXnosc6JOQGaV67rLwMAKVV1OJ3Ld4e4OB69VE8AYU18Qv3yrzqWOGn3mywLm5O+hp0DFKL8c5IYNcEG
+++cl1GNTBjaB4IMdkJQtgb/OkJPKF2OEqGBsl1ugA6qYjjFJvpYiTuUHGl66Eo18rVHZ/70riCk1n6oRUV5kshtP25yafN/udKwSvHsmXDPRsZlRGvMqqUO1
+u4FrT+aYEyAjJ/iWC5aIkb02KvD5yCHXb6mg7oHQ1++F+YcrVde7cvc1nWP4hKqbX74jEbsbgjtTCBXoQ6zwbQDGBs3zsAlAYywA9dhCGh+t9bV28W480Pa+
++hF+TDWYK8nrMl7jhZgrPkUnc0JDIFZbPRSREgQQJ6+SF2N5Om7wwSocy7JU2OZgjcb8MV0FZV1AlFLb0hXWbaBBjTZFvDP4FTtf945qMKs9q6R7KTObn7Q3
+bbKpR3HMKzf21CNpC6s8amdruVyOtwANJmT6qAuROxQEQLEa3aJpK7w7/YCPqeTxfljzC8bqVpG125ULO/mniG4uozAq7FY5UfhR2tuYbft5Q+YCAyyJgBYo
+HzbYx1DH8YBg/NjlxqlnrNKIDoMOsXMZ25fcIzTMsB5XTRByDTe8302tdB3LIJ+CQbauRUc9m2XWIfLxq0DBWlZYURxcUPtPeVI0j0JdHmVKu/RlxgsUn+8K
+VvW2Im0bDRjvSXc7VFrKg9xDU5DZ23vCx3vTlzmdrQNDv21crOv8IrRdOfYVWPYDA4cnwaWB6Xh2xFUI2gd1ImMrTaMSVuTwdUrv9trMR7ku8xPKOCs64hVV
+vtXWpqWrk6j6ji/YD+rP3RnLZlksoVF/kOun1W5A8qoj24GO6jpQkvUbIifx4SwBFzGdP868fN8T9EyNCeS2j1dCVjugp2w9QurgHF3+WFUnlAbVWmpCHrfh
+OVYgPQm4KSdJfi78FiADbw59fIvi2BQvL2o4lLRrQmz6Nj7Bd/I3uJr+Z+mvn7RmlSPo3okmjL5/30HvokLI/RM9e1kPr6bTQ+4ugKS+H1O/GyS/zB+2KHIk
+mwQwJFrzddpKh6ajD1OIi0E5ML+efiH9cvqNWIdrLcds55jr2s2uaNC2TVQl3fpSE/WcVpyGg9y7Rdf5RT97FQ04JsaQYvVPQeSITW33yA0UodVgPqXfZl3c
+v+JqjKGsNEWs1fbzZunAdsA5mxN/FHa7RipRiUyn/bnNJNwqNzCLfng14VGSzBnsmZm73LFXalsnAvH5obs6gSMTusoCZaHQ4uMQjFCgnI8Vfa651x5PP6W3
+1vXZ3pf3NsHdaYvvREjkpxzOjSCFxEoeVp89bbAgGGA6ZoDsMHDqKHb5o88z9LSAZPHvIlERvxEv0f3p6rRf0TzRd9Jv+dp4ope3yRH1ecnTtPfur9Oukj64
+k9eTTWHsYCP7RN5coB1DWw32nipZ4jYg7xdpBf3f1fgPh/Td9Gessf1/vsCDCCB39ShCB9WGY7E/74OLzy/dW0hQvnEsIBZdIdXLmAof4DY/Rt0TRnYqJ0rv
+UqjUZH07Legcc8GG5IBH3Ab6AcVAULjx9Ccc4tDEg19S5NpvXY8H54hvOAcqT5cPslxJmxeQw1Sd6f3GtslyHriECDdwyQrSDniiDC1NrI9/QR2sUY+Ux6II
+XQJo+iHfq3vkd9LvW+Zno6LtkW9F7FRujX1/pJ73/lGxXOU4vtOMUW6KUJvAjpGMv2wBWHSX80sa8fixoTUWXidNffoFjwWDWfENRe2eGlmL9kc91jC+P26o
+vuJHytTcRsxOI3VUsKmdvzgmHjhWpM6CqndkFgL5/y5sWiDAc4RDrcXefoPRWn9efKUbBQx3xMunGrxqyXYbmdsk73JEfQnvAacDcltkOx13Q80Co+Z5OajT
+gaNtQKF/YJvIu/ExIdSuXrhO1qcPGuWPqAf9qIW3tk5dcCz/JmH6T+l/C5xPDY+IFwdqnsdPxpNq7VoRu8v9NXZTDet92CjxPLEdIK4DRmhFpCnDJp+OXnzF
+RdwNeR835HVnTf/I2GYI7b1D6n5kqTRi6QeausSr6TYwK/whe108i3NXOjgEL5M/nWcvBh63iF5Uw8l1e3aj/sZZnfHomEr2YZBtkbRE7zU0yfcuJFbTsSbZ
+M70LlTQr2k/bjLUpJ2g6s2Xaak/AYzK8oQmi5ZCEb/IwBUdUdIN/rcEO4VXM0LnG9qpBx6fsxN+Zj9VvjX0RJxMH5E8jY6z2u7uu7eaNqUp571bcTqPsJ3Kf
+27iedP8K1vIxIQ0pdOZgN3W5pV23Sh9iV3DtNFPEhuXyu2KOB3j+fr5i0LZmTXonDEjas4OKfvd+d1p79ues+MLdXveiN/BQhETYkB7cWaDATCI9/YqDCLOQ
+1TkRYrRBmuVAtdDVzFcReARIKb278V8/n77Btdxf13VjNfY281P+HUtmoqOtVjOzZaYaFNGJq3j3TlZ/a1giuTrV01tcPaKJbuTgKkODhYeZBC6l3SQEEZMZ
+Hrm1RVokfSD33apa6/uatbDN1l+5eNvVMc3Tp/M051DLjoVe+yfw2ieLwNxui2JadGCX+iSmOMMiyH46dyfYkOo3rAgca8CvOrpWqvnfrXXJamVz54uty2+W
+iZ9FG/gs1WMdp4JOK8+1/lxABskVsm3DFU4uJ8qkPANYvFEEXPg75GEcVAIFg8upLid/QyQbk0NtDFOTvFWBAFKK9vT9rPjzu8xR9VctBrOznDItfbDTFM93
+p5jX2JnTupUwOEfXLgerOsZ1dN7vvHnZonNP2OtR7lXLOpeDeH/ZZoLQYOnBynZIsxxjJOocIq38UotRy11Pw3vTMcFVxIjp58lrqrPYSLuOTSlMUOrade3S
+3VsZgQpnaVReH5MiyNgLhpxAj/Rwepi3IucxQjMvUjrBQL3Xahjeck74PzacKZPZx/E6uplx3DzEsxN8NcxXO8mPG9b71ElRRo1cf2+Q9oogbxCumw4vg1bG
+D+cxBzw6iWvgM0HLp2pL7aKiSIDyWhvr/Fyzs17SQz7Z3JzrvPTzEw+i1vmzg+hWLU92+xudYsl9AvF/5amUzDD6eApyJa4aAwFuucNEbfsMJ5pa/45s76At
+rnAul7Pm1znn+BfpX2osoUhlDXNl3NcRb6ddo8lYVogiPBVYoYrV6s1N1g1W7LgfZIGTw5JvQ0S+oHXyKNoDITNLDHvF6vmWbyH7LxJYL6HvA+EaZo6MFNIT
+Dw/l1w9WqNCKDwKkF+vUCt5YdW2o1amtmnkfSB+tmq+m98ofnEddfxF7Vfy7BjL8fzZrMUJ5BHswpTvWBxF0X7gyFiI7HbWGEvRtK+F5xCSuaIvrKDIt6R3J
+I66cZM/l0PecxLran+e/Wj6cotBb1Gqcj1O8AEAgU0VByIl40Gi4wkWPaNGNLFXVVe37I9qvmdaKqVVq6k0W2J2fm6B6QTTigvX2VckJjcyqVJ6wprEhx07z
+7kOcW/mS9UuVv23ET5xvO51vNxJvz3p6oeOYgNir4GMgsU/K94D7NdW2lniNNKOYEznJJ9b0Yqw3ibf5w65NxAj99cVMHcSH76O0cJSn88a1NhcnSyanqory
+mOr1DsmuUI9zQv5ZP/ewqSHbNZd81Ve2r3fsrncKl2aiVk9cLSLAAOVyl9A59sTVguA2nBSxydO/N+05PhZPXtnw7VA2XefVjG/IfdytLxwVYzFQOwJxSfUC
+9cJ9y/CBoGNYqrfryyPM9Z3jt3+xMrEdp/0xXkWtmEn2bUuX+HZVaIylx1GZf0DTDVwo6e4KGUfoglR1EfnDY1JGfN2KWkfkTy2e4tX03jSrYtJXeTyOdcnn
+zes+zzrpRcx/3m0sHiNsH1nArN2UWe5CltY8vxFmQ6GS80qoRdAt+6ATcUj3es3GSbJ5C3bX7oBhJ3b7l5zv8jAuSJlo0qKVXrGk6ltuqx/Wnvgvs9XXgrRV
+UPZSJ+BLaxxwc8hH7/Ypw9qNiPhAg7avIi1QOvvZxS7HoV3sIvlUGgAeYoKrEXXTmETafAAQrM+15v4s+p5VY7FE3KLMsZtdWbFv6CtTnJ3Yxo7P2pshHTv2
+8zcnbhaoyssldKiHZ+WIzE0WHwMPA21WfnAoM+XNK+kiyQWAzGcntjXT15jvTljjUgMleL1SDdYC2ZqGW9feE/TNjHVQ30qrhvyQkQlUCoQ4UsEBed2rBJpe
+qsQDz7rejG9rhMye/HSez2s1xrcl8H+/bmwMKhY9bUcRjHFlo1a3UNR8+dLr7aRqkxdXuZNvaCzQtCA+1VTkdTFyt9+yCj1Hy8nwYzZHs+JaX+YO39bGHBt7
+LXcyg+6FcOsWk8dzIHg/lGnIm1SZAEPyUslCm1wzIQvm41BTsp9OPcfxE2NqSWgVjMjK9LEG2y1YGUQcCPUV7CuYxVcbO/MZzpOXGPRfpX+T/kP6LzXreRdb
+EjZGoOxzGopryisCNk+GUK8/ejyLbKTQMSiuUU9xhjXce4w1ltHSQu341WcjyAmzHXa1vXAwlThhaTQkN12ZFCw/Huv/dTkg+ZoWUWM/aph/BR5xhJWtrplS
+YSj4eWMiFVtt/1bKpUEIUJUiKg3L7HCZ83yUkiO2u88QTU6rtiRbw1Wrp3+g1ucfE1rdCXU8Moril9wrr+Uvp/S8IYtZHS9T9jGW63/Dz5pn7ivKuKqzWV5r
+U5Z+5a1hyVBJJkZ8SAPawcIOQ30jojABosXCksf+lcqDdHQ5UB4Ba+51yaNtI63jtCaLk3o9gyTADBN9ubb5gFQubkja4Dtqaqi/N3y4pJTS4Gvsi4XHJ587
+baRd5Kzxy5v7JqT5fNueoXE7SkU6njiWTlUDis3pz43MRoHL6zBX73ETNaoxcjtMfd7Z0z/QTJgpVxiIZlPHL1ITHp8+Uo+S85m77vXaTjOadoUyU8GqsIsB
+EWfvkBgfEGAM74ElDYS0oVeB4rtiTrjUDq2U0vMNv29OB43S3mLG9v+lQ4z825IFuwmC+i7Ue4rC+rYn6268MWjtebY9uePmkLlS7QaDZYZqcBSfE0/wDxwN
+35MNC6Dy/Dmghkl7zMoXC+CAQECcfIZadQCltY8PNuYOYjRsLgIRdgsKsWQ2tvznT7sd+0qYRakKlx76/5L+j2rb4ozO4h+7C3c624yG2axzWCe2khY/L2Wf
+Opsnt4vB4tcZ3c3SwSbESuiTx6qjVia+6FH3qE1FyklCQIQXOxgQqPXQc0+gpVML/vsAQ7+yzHA7vwCYoob/VmUwcJdk52oUV3U+770168kTUAvrfSzJvyM3
+JcXZVRAZmcaK9VIVVpxRVgiWavlnsgCTT8klSfwO2dlpgMhrzo2twpaeEFd8DLGLq/r+re+kQxH+ZB+W9NXPFU1gteTccXCVwY9goo9AGJzXTOUbGS9J0NFE
+uKRIxCVB29hh+9sWWQwnmAPkU0q7hh/XOOYKyv+b3MX0z8Ju+neMhPz/8mml/WrzmkZ2UpIJcR5y5yBtbq85MoF7XDevV9R+Dt8/7dd2a0gkXMjUgZ6byTGE
+FwAB8UGdo8G6YLRaWSjp63JZVMt2ibqnaJBLDFZLP6eGY3RMX/NTcNpPfgpeUfY/BwW9oO/P/9dzjVqQZxzsoABobKWu/Vm9Nkhj+lm/Ns6iG5Lo+7pAvT7F
+rz99kaBi/b0itz2Dbg6/+bJzWB3Zf/hsXp9RqOH3i+75OdRGWqXVdmL10rsr9TmtWArcvFhUkHNVfdwI6aOG9Zi+kn6uzmpz69cXWBmjV2r13rUrl2pVpCtz
+mgFA6HC0tgRdqVaf6Th7nWNRftWrVpVQrXdsL9d+Rg9cBTpN5WK15Jkxrj1A5QEk1I48ogXDfDbUh1kxV6+Ea3yjUaj9bfH2b1fJnmZ2u3nNT/gwQRGnJoqF
+/RgzrIeC3h1ZvtJI0hAToKQs1/JjqTer6jsMjcbpaq3vjcTw5Gna/UveoP15RDTQg3pKyRYU4tVFT4742LhVoQa9Vy/yzPWHr/JZn7Je9xzXUbf8c6M2bv/q
+q/f+bCwLZkky55X41TKuACQvcFNjXP5LA/VTTVP+0O+Dgm365Wq7Ayf10aDzL1pjs8YVjj2ej7t7KU1Wy6jNFmm1rLhfCQ0tCjlJ7IQBfNM/CHOCxSVYgy36
+mLBXZwP7HteqlDXy3dhBpk7ZjVBjwYuT9UtRWbdKatRuqcX6Qug/unY0qBRlE/eWPa+d0PVU1eB3envb4K6jE9GTwCsT0Kwvu2SCIclAsXKlt3a2bgt/qdNO
+mNLOmd5TQKsrAPbwtu2ZkOuG/UGM+xOjctV06fwnEx3K8AJTxdunw11Xps2ga6fnR8hMTC99Y0wDtsY5z/Xr8vmgfIMjKZ9duVcbQ9NEnwHQ0BL4Q3KGn4xA
+ZB2gzY3L2HMxEVHDLVVVGK+cPkqJF9ACzKG5d93pgLa/zhINi/JhldFJvlK+1D4bkH4zqcZQI0u48BSQtGQiOLCv1mPqvtpxTFrsdRuLOkbzthcE94u/jXIe
+KpcgYeZgyPVGqt6QH9nqBVI/SivVKtPviLjjkqf4YuBg4wgyn1+0c4IFa7BiOeDQFTfiFXvOdeEDSWdCeycEVwIyseIOAgzSNDgYBZuy4Wxq7NaD35dtY69f
+Tx8r9nreUE+T6zxUf2ClmbCttmBjDnwPmQdWgl9sKrJMvmOXRpU1iT/UZvhNK5G6WP+Nvlr59uzabRTZvr5Jrtg3xnnEYuUTts0EuBE3cEATZxi/+QSn15bC
+3dXi43Uh0hJs883BEdslZ3cCA5FMw2q4Gljjz5S80/dqtsX5Xq9kgv8h6wsCmyuitwS/5ErDLbKFmEreXnYRJ1r8z29qb8Ifrn2469eu2r/H7R5bJsgpNz7i
+CU5H/c373TwSKd4MAV1DEgFhSbE4CVQrN1p1ynRFjZtUKThMiYvL8sR1Wt2fV2Xg3zY8vnJzvg1HooKFa8+tg4MlBfs8kmDzT5/Up2UPasNrQpP4IAJDkqvS
+IRpc/IX33Btw+eQO9rLkVZUDq1GUbBvmyxE8XT2tK/q5P6JtZaNqTMqVAeTUbtHa/gomriMXLNpguZMNxiYvvxHjftk7K/782ip+Msbd4Hne+8/2PLZjYHjO
+TY1nfTuzA77HVH19u6lZMoJPmNvcUdoTf5DeyMemT7rB+ZRsasv6XZDOf5v+/vta6WWQz3lPHzsg7c3LpN29DFHnanvtx9wGBO1ldDeBX+bRw8USqdvbgZ3X
+xzTUrh1aVR4A2lzZl4rC56Ha8vMcJiFy9ShtrU5e0MCvGqaVSGykS2Q0tXNI5VSzFXkXZhay43lzTDoF0KmXdnHfG+zzfef72QeHrhFkrurwk9LkCXPik0s+
+qQFbnHhfsFlXF7nUmWRP0ABC0tnY6i9wrfKfMlq/jitMPN+HFx1at/7WE3//rlEENk4ZRZ04Cmgv6tPKo0zM9cNHcTi3VJ7CQRG44FIXXRViws1SdqZNEAWE
+PE3nKxa/PrCBqAdyXBObWa736UnJRy8PqbNjOmgHb+rnKdDmL3HCaGMiUOPInGy1c01EJ8A3NWww+A4EsFTlO0WKUbe+i7VXJhClO2q7u3lszYiD+tLa2/TZ
+ODoLaFWeekxApIcTY8D4YVIc9URztKJ4bBk3SQsevZe0OdqsOIbQ+SfFDy2+WamDtdiFF/hl96ZLZeqwu0D3I7uZ3zfy98qenkR59dwiFrxPFLBVg/MHlf+H
+2mqeNt+CDQlIJcnRPq8h8dSkd3Zj/4XaKfk2R64++77FlsnA46B38qqMfZJeJYuQtsa+xIwuQWQFe3r0XZ4YdewAuGgKvMPPFb9cckXf5QqoYz9fzjufvAdd
+TrCKCLT+fu1QOkUyqNz0yvDxfo5ZZbEB6z0vMb/fVFV0XGTbkzPXMkgJluRrZbWkgrNDak2jWmf5jYUfwN6QJcf2SpPzaPuJrVfpvN+dpkjjMNdCuSSWdjMT
+Bt7rZxdiwEvphEHCEXzDo0KPFlAZRVKhymz0nRcYqPM4FJQtE2dN0e9uzrif7Dw27RKendlJ/uP7ejw3VC2cObM7fTy3Qw3D7FjZzbxnhAFFTsJJyoUkgAAA
+lBccoxtvM6CyA/Kv3PyVWgA2Fh+H1he7ROp2eUvby1gUyCxG/a7xP/H+3qwt7OUQ5O/v+zoDkz/1FNinctztDvp5LDdTjvlQSh+8IFI+ZkBrl4IFKQNdgLIU
+E6nj9czRc4PaqcRE1nV+Yu6u7v6aKU5NF+9uf2xovixAmeZpfvLm98gPZ/vCKv4PiN4KN9CFBurveosBSdKo6fYvSPBL5qy94Q8V2l+VlVNbDzYthWj5j8Z1
+vpmHqTJsIqLpYMpDmZfjKLSEC9ldlYf9eTddUKlm11SYgBydY06txtA1rG6xQm9xTk86i/7YenHLlnsesLkG5jKZsMNmJt/yrRX/Kiv5zWwI2JMFadKfezP5
+NByVKgSszJdJE6sqECQcWnKrliQvLzo9g0HGkGcgq8Loi2RBIEDAJCn/mDJ/lM3CNThKU8AIvzu9h5kBivZkq1P8Mzx/qUJ3Tk1Bumk8KUO21n7TnGyBw/Np
+OvnUlO2Q17MnjrxHmBeN4hm4o0ELqJpAeRt/DQvgonpdPVYzE+jh5d/K7FzCgmFqQk/pL/GuF/d1CkRFO7NrT0BOAq449OGUMXLDOkPaWia/+iArBGXeFCS3
+rL2v8pMCtyymLGpulP3wWvpA+sX0B+nPUjo1dYPnVuapwqerlvIoFXw2zsYwilsjvJAhSqPt7kZ+3FwJzJWblFbnEUWvoxr2RFkWqnflhvfJYJnVgIysAaH8
+RGowjMLj+bFgB0olRs7279YqpnJ8mHhcV10JfD/N0r1fr9Q5QOSZhJ0DF4lIbIhVkwMrbIxwCMUmVTZawFFnHQ7GucfLR+ddf7XB+IsdfF21Un8//fOUtjbq
+rClSx8G+zTUgoNkGBiHktgPF7ZqT61NsLQJouFvqiAcyvZaNFUCSBZBXENN7uvFPG/0av1CxwDwLMnqH9rq9LwpnqzteBjeH+80WsF6TVDph0RVJBr11mKE1
+TyECjMnKaYM2aXkvhOxyCloCto9/WNUxP8+2+JsbO1pSyXzGCLxgOrydBdxu2ZxgcYaejTWrQyHMKFRWS3dbozVt/QrvD6vN6HMlxK9h1fxEJ93pXPFOWeh1
+uFxTHCWhOORIoGpG6QNyAk/3OmEIXemhwSEBdHiOb4knaNff7Zenu/90xiXgzZO2y/gPREYEwKUeDY0X5GxJ9rI4vKuNYg9mLcDMt+gdSSs5mX9Y7AaFfSN6
+02WllVP/6ynNoYJ04yuLCSfe1urSlLFSPFt7+PXFxW+tTQfXVtZ7N+ze8/SxDsv866Lq/6QNNLYKRhEe89sZ25hqvjiaPEnWQcYLbA4z9q4hQiY2IqQsIte2
+UuAB+RXloZwFreGsKWzQV5a8WZeNi9La9UiJQQBYrgi39ggavVT2XqGtMxuxObkhdfagxNppdkbhzs+6cv3XvJLYS8T/F4AnR9asdYIbA6k+IsFjGM5C67G0
+IylfYHG8wHCfsf7zmvsgM2dMSy5EufNv7CI5ioxdhLbobiRaOCq+c59ftAhvzqpiGTKdbi/0hHpMoHngugiz02a0YQT/BOKS1osQibR9oLmPz9Lf18jK2L2+
+n/tYmX6v29Oj9rbFeimjoxfgLApsrllfsU3BxKJmQgs96Yo9lVufVwx8CoRFavnVypxAo2Rd/LG/bmbpGHeuadl0PRWOBTfRznPeWDIvtlMES+LZkW294tq5
+tLSnvbFyBKG8Bclokcr3CJd+pjvD9XSsHjtSf53ZPbVjcA5x+hxi9DnE5/OV2DxPp+n2FCNzJ6iXTWNsPPubxo3NKOzIVf9pSSYBuyR55UJNYK6LgKzukPHp
+YLxQ+wx00ZAcpLcVCL0DeIUj/Ub6nf8pfof362ZNNUeCJI5NITLMr5yPB6ppI1oIX9YBuRhv0jtS5VLKwtUo55nlHX4ofUIzD+UMN/wse7Y65kNsqhY7/3ZO
+7BtB/C/GdG0ACaos5m2EpnDmhsGrQV2ubKScCO9NlbOrnAUfT58MKsWFK6HgT/9AGZuOk8bRnPdvPRQZ995X8Nsbr+v5ent16CknMFqYraHXpClqT3YM1Aky
+Plyfhlaft+x74+5tYtJO5IzPvO/Xfzme5+O97uMQkA9gzZNAUNbeVYdGnxgIQFvY0Tg1r7k2obugzZ2/mX7eM+f/bLuC9LKhzq1x3OjwT+ebwN5kqublV0tA
+BE8EQbTgUhPjyjCRYHBDj09FRRb+BR6qyLkalbhF3f/bwro766lniKepO+pcwt1UiW9DWuZtnXa5llSg4/N+TJXPc3u7s4GWCgH/KGYTvCS6rKekWfOmrVXt
+tymGfU/Rv7FVbZNFxle3zII4xdz8eB45XWHQp81T/kJbZVD0HBTmVMVjZIdjq/OJVlKFARAvbOoIoBto4+8Wu22d7W909i25KobKyOwE3JxrInnubvGbeZo1
+Dntx6o1rhJZseUyaccMaOop41BJSxcH9hcxvWQAICWCJCfXM8jsbN5koxJ4Wdfa9RKKulFS9UP8/ndss4/Mgc/z2Qurwg6OM0TONiHAt6szlewAjOxwXByxa
+/fDqsq5Esy/hy0nVRXpGCqtB0WH7t9HiT/vGhfXXz5/J1ZeTLGxtGS4fdge3cebTOQoybn1VjK3q7sfJzoB271facCnHSARw4S8NDafbxKXNFxcKHSKrSeWU
+QW/qeEwYDg7il+mx7w/9c7GMpkyvepgvcwqeT1fC+JNV647alz1pSOXduaJ1AJvxvduDjloeFSa2EeY30TGI+5zZjW6x3cKRHBBTcvu2kdv23/5JliVnSAag
+JXHc2uC2rXctcWKTkAgDe5TJ3/CXCHi1watiIVKn3V7OOYnxmsxmRYHVs74bh9VMK0BkCqXj5ya5rzAVw32hElzrSCr0hRicTwp3Q8A1lg1jVbwK4gE09ZPt
+6knJXjAHxEHRq5YOZ+KD6eb7r58cNmgoR/IFmpNrL18ro2R1bAQcp7SxipJ7sohim7XB5Ruyz/gPVLH4rLSy/5AE22mKmOY7vcWi6lp+eXnlPM38eSQjurji
+r5ohkmg0AwEvZeXBwBel46hBRHH4w99SinmCWAv9be+32n3/w1fpf+H6qfIvJ3kLU3nDCxKOqF/RlVRNHGIWnLP6RNycgBhwXpYnFuXTsoMZ8ZZ161a2t8o3
+3uQXtXXufFpxXViS5wVpu5OoQBI1cp4Aj6lhnBrQMw7hJB/4J5daW4IFhyTgbGp+fiuLV/UgRCdBqoYfUz/uc8zm92uK6ufziSsAwYO5EWf0bYWiLV74tDu9
+lX3Dl9+QUbtM5GAdUk5XPJkxhTxnlSquddMn/Rm3jbL/kVqfvSL2U/6fsumDtyaUA7XsPOorp0ngIatC6dqIVxE3Jah+0UZnyX47DG2ShlRB8QtpDCf5CkGB
+fCh9VLMxpaIiaCjJxfBs7P4BC0FZcBnrVUlHSWKTTfMu5zTACA/k2vVPeqxEORE1OVnxUg8rT917/kXdxvDKlXtBvfW3W1Z1BHN2nkoussKVRT3ooEhlTC96
+djVclv1I0wca/OVtN8p770EtnpewjEnGadpzrslYtw+7Vd6pybRyM5V44c3YS/0sXznX1bUPEpTZpJ4EyxZyqySHvCrqX7qInLhg7nueYhE0EO4MMs1NsEYN
+B/5bkkk1+EtNCp/Dxye2OTM0X0k+1TYlRfmoe/bEjkYimwverY8Jn0imcq6J5+Cx7Tkpdzr2IkSE/ZMMpfpuUQ7bRjWvuhXE04Ytf5pV2Dl9lhbIfWsK0tUm
+FGvQCIaOgupKSvG86jWLimX67fT7qiXxd2q1bRdXfshdZC4qdy6HVPLd24+/86bfyoUzrFmnSyBs27JtAbzpmhLwaL8CsZLbP3GiS3Q+pOrONrWoFkHylfSz
+oksSVEwnFvLRPHMVQa8msCG2K6ZBTzwFA558bnb6+1q0nPy6Se7AaN6te6/gaWlvM+NnyP/VLlLA9oCTd5bktOWk+XGpPyBH/Jisz6+C6UQpI7U6j2M6ar1u
+jX/d2koxRXtoIcL6g/afqmnrBroo2YBvILQdpNLPRrrvQSy62Ahk3IrscNXGlK3J7v1dzqbnnfxf098lTP8l/TdTzLcapfdlfp9V+cBtvd/dXttuAUarbbG8
+yu5bIs+lUiZeqe+q/CVZ62e74xztjB70oOUArlX6+e3Y879GurcMAIE//4fSv07/PmQ9QxeUwbSMAVx18m3qrAv4H1T/4N4irwKgLwOWCrVeDsTrNRAnvgMT
+GHOwCn+bAF9QCwEgTJ0G3zO2PdWv/g9V7/J21b7dbDHjT7h7Wwdbb9jfCDLbQWeLYJAhY9QG3dqdkEE5STTHilR/coMZSN6wfdZd0hp+0mEHfk4Vt39Du6qD
+0up6Ek49l25kq3HAhAteSpoxRqyNJZ+1liSiK8GGl99bmE/vtVHLVXtWWAKTUvVcK0+v+HUEDr1DpXs1rqhQUqraS+SxLMqrEguAhs2aj6uxy8fct4/Kf7/P
+2HU1zacQhh511c3W0+Jz+H37BrNmrxRDJY34pXUKFv4aLJt1zSMYdGnJS+XJuLF2rlZdDYtEqsEgykQtIlbQSDwjJ+1aPU5dbaJ0plwd96xB38boT5q7y2mx
+wpk0rbTGmLSF3OoSQBlJgwPEqC1mHn1Xg3ltNcYa2QmWRM5wGeFpY4Rcej5OLxjnaXOcLKtNcYRUUGbNGAcgH2I/vjHRgk+PMaUQ2z0LlaaYl20ZBv7SmNPm
+89jla3zMLxWsnxtl5uOsG+Fw2hImGY1sf4FQhtoMc8FxMooLD7vA5YQ8i2MImdVipy78h1qKbvDgEhG3fSwlVvjjazmt80nFvffWqls+P7VA2LbuNlbIjSWO
+dprW8smhSMob1wsBXmJ1Ut410oOPn8v5HactAFJjAspHorYnYuQT+CtajZazRVZN4fcNJ4u3b78ocKzE9afdSTXp8+b6+EzW6XWWyshIwcctbp63ObHZcP+C
+uXkIgmxyVgsrSFsNL7exC5YsV9wDNt+0AwZ7XihBo3kPsObAG2UBUz1thUGaFiD9xl4Dz/adBoU4s44md4bztAnjMZ/HIiisB4uVHXv21ygDD/UkhgtRJoAL
+ZiJBOF5wM1xAAFKRN51vKBOuXGzakhlyKVP6LT1Dgg/HJ2n7ystkVa45tKEONDxRs6y3kQARCAYNA+haPkW91baWV86OoBHd9r2/OPyzn9hHCogn8yn3ISR0
+zE7dBaCVC0TNWpdN3mdVrP8dN5e9q0w2bpp9wT/T4ne+oMidX1DE8m+xlfBsQbna07HtfXVqnkhFtJ9OkyeN2Fbk779EqBVrvNau8eIKYeLvUuyGthmTw6t8
+2zys6wXDaBsKsqtq0dcs8vcXvG1ynfKeLxl33k3jSlv7cjWSM3KEMlIEyi70ioAZDHR6tWggXygmZlQykUZou/qYtVJQcZvfUg75ah+E5cLRDd8fsuvmKrce
+p1sXcbV70qE1CGaRwE00ksvLgE8WzALbnmgEyV6R+qF0idt5+mfpLzkL+W8brqfAivJCFIClXeaghXs+vTXI+Vrmq3RUN3hoS8rNzjBhwAFwReweMJATiMSo
+rKCcsGoqZSNMES9lTNq4nPFqZSqQriD/EXHvlob+W8/XV4Juq2EFS4Xi94pnVlmQNCPAjpQW4XancO4ebS0pjU5ThWVf5u5aJKv0xkhyXynTUtcMeyJ4wS4z
+4nFrxoCMzUmQQ7q8qJK59vEqIKa27vop9Uy/5J5Z8eeLv/E3sVedMdod+aww/Clg2FiU/gEgDOFEPfBHzc9GqmAilQEOIPCrYIzsyAt+JzhZLUn5x/2363ZW
+7ovl/n9gg1P9qzxfBaXx59ZXUQ6a+Wz6oPNWf3adwvPNtRTI1UUjzJ7OIXsSfmxYAOhxJcUCXfojrqCMTGr6aNq54tP0D9pOeyyEOIBzzkbemlfSa17H/+X0
+9+nfp/9sna9OhhiSrT05Ig91dYRVRKrFhuX33bYQDm0g2J/3bQB1Zn2T82nXge5EFbanMMC1oNSSamLOHJ8RgYByRX+Ik7MgEA3w4OZNbgobNlqsIhzjw1dU
+ifNTobv2l7kHJcxaKfa4mye94rpaeLC6cV5cMipvi/zDZjWV6GABkNVgoQz60slYdahlH12Q0HqI6/xslIWQMSG6VJqc7BcDx0nJIfyLoFM/VQUKl9py1fHW
+CsuSyGqApvP8tszwGOnWQbQPa3pUWUEWuGKILedPHgUDmDHOsCTI8gbfjP1WamPkiAb6BWUn/XOO/HgfHYxv9aRCY7Led/vI4WmYoaMbnb7Fb9Y8yksUGYuF
+EeHE0QuMA1p+VTwYcnqmen4NVQBqBf6FMvRFjrCceIrLNx7TmpkBrqwoNsxodQ9UzMh/S/+36gMdmaxQnrq7y7GBFTn+gWga+zkruccoWmXh9ufnJ216Eb4n
+ty9PAOoQsc8e5DJbCJKcUDkQQ/de9Z7VBTWqNRSS3Y063Psb7aWvcL+E1eT+Vdv/xvhphs/xopuDjS278OW96QB6KItMQ4kc2EJ3c/ayBvGk6Ol0rUkQyP1q
+A+IHOjZSnP0ifXW0vbxSBe6QsgRRk7+6TXOX4S379Lucn6meUlhGB/MGlP26bR07eAn7xiDjT578zZnvNz6TCElwJ/AFQNnVSdlll95i5WSnPtUpkNM/LqbY
+lBHMVQ6mvDn3t3yimtMTJMT/Ix7RbcOnX+4uLW/XGUKjbtvgLnvSEarT8Z6gY1Vy463GQ4nQ/yr9zTqn8+IUp/QdzpGmzzW3mbEsuNgst2idDBKbKsXbeL2k
+mBMsuABg9iVCcJEoQvuyKFt5f6HFMDRXcpwUWRKtcSk+lFusiUNe4QrKc0d/fjYw5hTMdwpMKV01gV2fzTCjukVbR9s0TzkGHAhLirUDvMTYIid5IfStG1Oe
+SufAiqXa1XZCd5b0AkgoH/ZPqRLstDZW7Mq3XevQ1RqaztkyAQLjFF9XuboPdceoHmTnSstBlvkrFgpgzKcy23pUBfXkWVIom2iJhADGJG9nMCTgmB6EC4my
+DffBneVc4Q28HGS2jLMg9ip+qakOGM+f/Od9Gqqupdaklgrm3SxHh2yhsn30XOlTVkAZFraCOWmuRjpKZCLYdpTPkKN+O3/QxAUZhMNVyT316zPIL7Sxdrm/
+dsYKwqdE2SVG+q/pv38/tiEmZzj/cB/6joJw4cmIfY9SK9LGpln7N1UYu1GNespe0BJpazKYMOai0H/R+HkULkjignul6r4CvCtP+MdawIJxG+jGKk8gTWFO
+n6X3BIzwF7jq+rPpd9Mfp/+V0UTppvNejhVcJry3hxfiys77o1jVHW+78/oMkqD2OW8rPq1OQWmUl+xBqnAA3vIMCI/aCrnGmi0YiKN13OU5arPso4WW5t8k
+kBSAHXM6a+ZEQYjdASkbr6djP97RYJQ/k/6rsrvsPZgwHPl5rpg9x9Xdx06qRhTuuHuJnPSGm137jCN4t64NtF3r+3gbkqWTlHVlPabGxsmM8yrDjYR0m45O
+TZ+xeM0/yfv5m5pt/l1Hfyqwiv+bHQp6UkbmswAemDlHQtdq3k/dwdZonj95phULZ9yqS8UslgFIgVIh4AQMfUDblRn8aMPyriGFgG5IGtsuAPwrH+KG3Dzl
+2j1qufkaZwDzYf4W9+H/ZfpX0t3EfSy15UVgD+P55Wo2NxKEHa6n7Cv/dZu0J3QFZMDKS/DYHv6AGs1aGAuawEdJKS4AwOtwID1HW6GsdW6Rv6P5/Hc3+bOC
+mLdT4rslsqh5oMN0OgZYmhzjas/5ENDmppuYHXphlP/pzIxa/J+c8TUsgGK9yRk5mpwQM08R82lRlpEu8i7KCbXWkzV5tpoCyGC1XBu7M1zvpzYqaAVWIt2t
+JqB3pxe872ULH2OoSmNlISC/laT96uoeq0qL2RmMdFRxFTwmfUf32rqIwTYGOD8IaeyYDP4JV0O5L2iLyeGFw7sPIejzQK5Wnh90YcyTUNLEr7SQs1wb9UUh
+mvwlECxJaC4ggz6RmcHNVlDcqoxzAyD5s57f6hWOEYRjzTrn/lRirLBfjpoiDvtEHEjZK8UgX9krTZnvxZXAYqhs76A7QN3WyYCA0dQS6moTMhoYkvmVSwoY
+xLWFZcORqbGz0gkvmfeWfdhQ1t4/eRu5T/hketmyjYmJN0e6/r+7Ncp0g4k8/v9Z+/M3WZqrPBSNyFLr08CnT6mmtDUipYqikT6BSy6V2ho+tEAYgSTEIJDA
+zAYkC1vIxpjZ9gIbMMZ4vs+xfY/P43Pv+eX+F+sP25V9n1hTrIjMqu4tufbe3dXV1b0zIiNWrOFd75sUKj0rKeVKzSZzuClFZAQlUScYqIINXQOpWzH1xOZP
+SqmeF373O9L3hNNHogzxwLlqrqwgWiPm4QlD7UvLtPsaWdpp2u4Xk7S/RA9wo12G7L+UkXvzN2CX3uKTGTu0IgSep2yH0kMCmKWUQ/O6j+2ujBICAIWeg5fS
+15klQTN+x20JJyZx94LwTWhRk7LMJSLu8sGFNxpLXEs2J81m3ykJ+YbIUnRAXShdH01aZ+bAg2xlAcce1pNL2NV1aK6WV39NrklSz40aD/V3sucifSvS6SPV
+isKqUWrsHRN1l57QoMo5qLWCnHu35WJ+Qgt91cQYGzUBC5aKsaHzCsFIcfQgdK8ZT5ZRf7XcWtbjal/3uXZRwug5B/4Za0n9efqvLeeAliCWBbzIlXeZNyKg
+HtfBTjWoGgPOoJHvkS3MqUAuwWBcCOaL1S5Pc2Wp6X4S6nqZQsja3p6aal+crjV5FGzwCPop2KX1PknxjH+Du8DLxEwObOIVlK/yRO/5PS/5ofUUIAvHbGN2
+JRPMgUfUbXXGnAgXPeCEwPKkZxIpgyExsWpqFhiYOuk6rKV44LEG9gqjeD7RZUqEu/CvxT4pOsODJ62AiWUxObqN0hrsDL5xyt8ONmF0RmrVSToeRucza2gQ
+JIX6kM6PohTaBPPsYepcvm28MHpXcBFX9FNZTeNDSqNjo7buE3yfY5AlOy1Vnt/xXqGxlr2mMF03i5r9ZMJC+1YMtmRLTrv9pVT9TdAdcd6nnMJMycbMCUhQ
+X0mKqWWez30Rw1rHNwAAs5LYyiTZviYkr06vNW6I2Q9zVdnL39dVekot9qvqd/OmjJWcpr3+W+JS4q19tGaj7XF7p/Gp6G7Ubo0lZjuCXkJIEVu2qzzLlYb1
+rC36DnQItqqi6yTH9pn0WUdL2Qo6HTWrwdPjB/50WLCoySK753m4kXWz3zoy9XSx90cslvsLSNLikQOvWhTV4qNdbXC3etheDwnKY046v9I9YaunzBYisENB
+1C4ea2Rr8td/kP5Eu6T+XfqP6b9KVoM7WzqV0LbZ7NtkG9w6I3yFAZdldtdlvbN7WSAep3lZgNfplc01JcMsit/qjpi3vj/Sk8fRkPx3sNB/afG/n2Fc6y+l
+X9fO0D9If53+e/qfPKOWeTwF7rqjFeFDbXEbWoi3yj5VvnptWGuyqnm3pyZN9k0weR/bicPB18zrrOAiWWW0llEBixsDnHGFRIlyzSpZafNCmsULFd0cv7TQ
+Bi2Y0b9qOm9PR2unLh+YrNUO3u3jzK/HgCX9FhmDueFWlo3VbKmfWM2Gz3AZb1sF54R1XwG3M6w/Bq+FXURgt7Vvq/PKev3L9B/Sf6loQMvDbHejCXfVJnUj
+SnMW6eOCKKAyLz9aG286pux3ZL01oy5RQgKATNTs1QZzc4ZFpRyC8QSo0hKoRSFeaQC6wbHmPZvCeWrrEAA0AEAzlyUi/0Ouif1V+pv0n9L/K/13Y6czENj2
+2ySmi5pp9a8XhYIWcHEiqxndWXRhptQ2Pa6B4H03Upv0MmAKAsBs3yRQL9I8omvkdhbCmiIpVE9ylq+pLX+klNI2zPEzrrJX9orPKjfLVxirUDvxG0xLq3hu
+2mn3J+9bVYB07FqdopLVU0FnMa129BN/Q8GfgSw0o6wdeSYORDRm4e17voZBq8u9bcAyGj6yBME5XcqkBFug+TkCiDGMoWBrT8JHOaL5KZ5jYX8RV2n9+Cm6
+AWXp3ern/eMEdhEdVLBAZ5rDOZM5CiYUfzocOYPAgQA2ySs7YNBgJsqWZ2tHi2Ff3tbweMXO5x/nEf9C+m3tR/iz9BdxVWn0FoTshHy/JWTefXvd0DeMGx2P
+d4vWFS1YI5DUxJLtHPIQ+DJnCuTEB7p3csjDt6Jlw70F5vo6ChxLrkv9cuCbsfzT5xUVWjK6xU4mP6e9HqJw/TH2LrD2n6uBHrc2n4bp7PHET0kglHu3kSkj
+XWicLbLMkTPPSO+GZCfJEpq+AJX8j0x27yyfBZBXzyTPulyEGudWQ1aYX38v/UnhejAcicYoZZ2ZOu4jqarLIFBpl2r4oiv3a2fCSkYKuVzGQYtmfXFRA/Ec
+X3nMyrei3wxLCxG6XFTEflqbVAaYg9hh6GEQdk1ZTdKH+099NW1r9+Du4LCRsF7WqpAnzX9+K/VHWW4qpARgMyCVklCMLBvtQilySPSQYGNNhvw9RiaFiqTM
+2JWKJCGfDm09tnbf/rv0n9N/S/9n9ewklgs1x4i+3gYCmrHGH8pBtLLIDgJQDqxQog7xCJbrWVhkBYayId2JsEhJYvP8eSPzU09FMk0nTcHrWhLkscQdKuaO
+vN05N3VGgAXWS4pUEDG0X+HOBqvUlX7mf8XYp+Ix/x+17+roDdq77be4P7lLxMLs0H+y2JaKl9w948xV9fByeL4ht+G0umVrTKI71zKBs38t3ySjBrq4e3MC
+5LcZVNxp2oikf6Q8Qi30k4p5+gnnx60zW/ZzWxFXre9TU47ZWn94yd1YD821E+IJFCTdYQtaz6Em6pAC4RnbU6JvPUHCGZDQyWwyZngOpIcEIMAGZynvzNjM
+ab/Hm/P1TSG/tcw4sGrXsY3GtsLcsuW26YOt1a773IV0NFdssL3Ddgnzeep8Sp+tGkLA8hy9F90jLJ6My+kFrGQNWMtjiH01fr6cTgg5wTendysTyEdCJFE8
+vspc6jrsWc5dxzbmC9wgl9G8F52Qo2r1C9Z9SAEUJvj3RwhBnAKvDBGAKJ6057aBFPFKW0qTT7Zaxq3qrnzIGUB+wtcaY+SZy7rMhVK03Txlhfj2Wuf0Ld/K
+oRZ0H56b+GtgtY5rhSEIHCQ4QiGInHteWVN5rXBDPEtBqdD4R+1zw2ux4XVi50AyzWpbMd8WB5k4JlU/VENXbmD2qtpYChsvaXPgRnDrD+kKM5l8JAmGHhJk
+AJxJZsXDBHgkDxqyWMTJZ8ZPlZlWXfje9994RPVZ9df+Xvpm7Sa4O1WkoRFCyJ+Ap6sZj61gh6dapt4deKdpn9xUo81FAv6TJh6/IU8xSMMcZBRSZ9kiOSA3
+0Bw6OrcdzOJO1LkFahrlOkGQZQmHX2vWU8GOFw/tf6X/J3QStpMTjHKENhzDP+uc8+zlvYdOu8cChoschDV9kpv0EdICCFWtjQMTZWUuAwaMOVCiTmlm5YH8
+vSHVt7SeSIgiPGvtfR1t3lOQEd/kyKF4G/8j/V/p/6PMviGir8hzD+XvNAGwtyxxWJ2HcHuM6OZU0SO+sf2tL+Ar3zN3nIDbARrUHppCYdi1GPU/MeTXOqcZ
+EegCrI0WQMBz0i529VNWPWfgn+xw7qVDpnTFRG6UwvvxuyGrUj22ipywNn35QstE5d94bKP+BsdkX13gzt5LBHwnt1ZuFL9/g0FVHrzMOpu/p9koAzP14ONi
+FspPZHKIe+OlGHMVqpPt/dur4BX5DvmnhWZQ0Zs1nMFPaw7/a4LgZj/FMsO8d8cdr9xJDYukPRVLGBtzT1PjOh+3+xd29XJSI0BCOMUlCyEWJax9A9Lot+Iy
+JxCwpjZtRJ0NwxrWEJnokrPXYS4NU1j8vC+5RsXXrPvxRXpXploSljU6jceb7ut9F0PY5yu9KQ8JMvkBMlMl2CGYy/vOZB+u9aPYI5D50xy+yx9i7bew1X/W
+mYr+Uc03SefedmND222N3u3Y5dR3AY6jMl2n6ZBjDUh8IvZ4NUNXTpFpu4EzbhJ7ugiBnN8fZbdlwIdkGkQ0J+nyQxjA+ivQ6j45haOXZ9hEQDLWDp2cAFK6
+9Tl42RntJfNr1cWSh/vnWhf/H8oO6A0RSkJ7mkzwvesLKQoNw0p3hPu/arr0y8cFL3YGy1Nu+WC/BGyRwQN3rFUwNWq82fAMbMP4WZ+H4rMaMhKxU+dWTOme
+lqJGgE399nqOGLyLHDoel4JckVisWLHfUP1SqaT9V531FzJc+5ockBzf1BzrTi9wE2/DTtbwcQGIXQ1VBrhm3LIjo9F9kwx4TkLQb2EKIGwSPic/QS7ZOvQv
+1QPqzvHGyV8Ywtin+Mdt1uppnqYwMNXPj0IkdxdIuo6VdPfoWf3bDuohWavoXEqzSU1B4QKs4GIkGbQpckbUG4NrbqUqx9AVWKT+5k2FKcj3IHI3QHobe5PJ
+UNjb0PZ68uaGk/njG5qBw1IS25YGL7JUJhNRNNdHOehMLxo3kD7MPRXJCSQPygxhDmvVUdBM4kmIRRZXYkszCOggxguShVYtsrO+5dRc3Euh5yPqV5oyW+yc
+rxcWTg+9SrmuW+Utt38bRJ0m7O56LUxtEn+SqljsmuMLzPHeN1jgUkuQ7q6TKxd5VUQvdRQ45l7qJRMv/Xx0eVT+5ZbqJK5JggfRM2b+3yXRNUssYo1atMHn
+OCAghNgEgva5VF9Lz9nnJdcigmg2g+WqbjtxgWk7RVoiYZtxseZJmxN5Ec4GwbfuV742dd/Kq+QNnmcNqYwetefQN05vjDWsV5Q3uZyr3r1hW8NWRQeyWWk8
+jdfKEnVQ55dv+6wQUxeBgKzPtTLXhOSp6Rct11iyE1ev777pCj10PQHjUUCCG+JAVxViIUNkQu/qqt1Vxo6BOQ2E56S9VHGdVnaBiuf/ycdW7RR0Izd+PpZX
+J8+glE22v7igc2CdKl/M1vMLjyxwgA2vINFnEFODnf7fmN7r+aGVFR7vwq1h5A0NV5+dNk0T6tqKIeNB0iM214KHLGq7F7nif1D6Rbmc1KyZZ3zN9XrrOuH5
+vo3rZXLi+G3l75X0CSt1ZfL1IgqEEkTa0kZnFdJOEQxrhc11UzfeaL/UN9I/CXHNYVtFKPpF4hL517lNpDFGeqe0RpXV/OiyudECnqnA4lJlo+uEOesN4DWU
+aumuW00rGU0upaKq8Q7EP9JokL2SXk2f5zhv7S7VhFgYr9AuTI2sRLiNLkFiyI8N6u3ShGrNmmZNNCOBaJBwr6mC4am7ofVB7scANsdXekkx3pDenJ4xuvtV
+jlcEz11GM0oX2E6v01ZiXmC7s4qBsGtalqC4IrJNlMpTkCVyseIMaJvSOUUwtmR7X9/UC8Qq/dRy1q9qJfG1eqo7aiXxP1mNcb9kjupEKAnl8MEM3miHFMAo
+OtO9PhIEfaTnaCmftk/omTL4/qbxefAm4vS7bZ+s5LxTpLQOJPBNsp9fDI0Ku34PbTT+l70zSKFsdQPVzSPHC2dFZxfazfoeBMBBwQgosQfj9ijTc9KtE/Oi
+o540pkIsKKgVy+wr7PLZzT09tVZ949XYSa0fNja6OdMfEpypjH9OemaeyZsItEIPLrTdBECVhSzwpG7SXfrB9GtasfGc4607zutQYFu566ZzL3f+tKJDbyI1
+UWvE3Nt6u/cReR0hD3MKbu6ANuT6HncrlKBEbCheIrLuD+jFw4+UM5hBBejWRe0oMXxcWBd27+u60O+oOCPbna6FydZD8T9y7+mdSeQZ+RWxo6pzQHAOswJR
+vSnbluB/5yT7ok6bAkojZveV9MH0Ze8mKki/P5W4JWBHT5tH1kBTM4gRTqwcHIWuvKKbXdHKNOlzYwkios6WAlj2vwLXQAp5fPM4ZMvoYmCYg48O3SKoy2Bu
+FI/99eehTDMk9d3esrAVe/ZJS3WvamIxGiYQqQjFj0b7oYLHltQlxw8L2TO2lXennbYl8eFxz6X1qVS2+IDI5JkSInhIZv2quEEYKuAsE5MQOOZOtcmABkfr
+8YN/DnGwsh0pSaaFnWRFv1bzoMyL9P6VDKLMREBVyB45yiktI9oKS1Q8Qvztmn85HupqYRFkWUcbRQ8Q+TJ4EG3BGQY6p8axkKTeLNUiAhqw3T2kozp7tU5+
+a4Iq/MD7ruW+KuPdp484V9wVq3AXqpZTeJ6D5JvfestDdV/f6Uk8rloMXa0m8KoqcFaxqp8fQg4NaeGo5sslyLIrNtjFZ7cc6/5A+klHiVkOU/hh5M42cU0c
+y240TGZ1IbQX1IGvHuxMovhc9lH07zZaIJxCHERhYZBJ8utjA8aaPCdAAj5pK/E9GeGQhUaaWDlrcDRYlZXDJMgw4HNXCOR/3u+S3tSslZ9SXkFRB//D66dI
+bT/z3VEdjWAq+J+tp1Ptq5bs5tTZ8lv9t1w/FecAm+SZRzG3Dr/PIbfen0BJLap+C1cp3zGa2ME7Hd7kOSCp75U8mnU5/cNH63rXHZWriXNNczfnz6KQNzes
+wWmZ+Sat6YWQblHIg6UnslbYI1LXNPjjhe3VNDXSVG2n4u5PAtPqYRFy8Gg5ywzMqAUuWUoGKlc0jXRsFF86sfo5lZuok4HEe0rRuGxZsqG9RYUXApIBADNc
+MCsz1BPYlDQRIOBN38ar4JS+kn5FUad/qHx7f1N6tFZmgFn06kqojQkHd0LsNJKlsvy+2KSddrLuOIbsUaf3Cj26X3ynmzYgosEWvKwAscVUXwBPdhiol3oW
+YRzSQq0TYK0GYlOr2FO5E/JFq1EundKl5tTwVG7Wohnx4SUXCWdrKV3JP2pSTAXCG3VlyTmS9yMXNpeQwWf5WEsvK/3u5YQo70iAAYQg4gyLK9F9VWKmRFWO
+ss+ByvXEHO1Bd9AUsh5+YVdytDir5oVmO/gaZpxxcWmOiQC9uNxAkdPrFxwTJeqwmrRcWLxbNf89bdtr4qTaWWlLAUxCUe9buCCSeMrggUNMXAW/rmr8l+h4
+kTHmXEXloLyWzy5WcrBJCDsgrCSisJakmTsv7uFNxz+6pru7fUQx6qCwGt3Fp3xt3VWRJSXldriyBaM6fTNKakxSDnQh4kSnfVgfU8lzc/5hWoxpEW2PR+Nd
+q2O7NhjPILUQpyYCb24K6G2Jb4qxdDeUmw5rpjU71UKOuGxVt+VzWxIrd1c22nNSznlXWhFwdbuAnOMUiYMcIazHbrLHrgb/ilee9o1GeOkRTllBVUsikiht
+uG+i+o1+vmLM6DkbMmxDeyFvjDJTM60N0kLdjnZ16cL7mGtOaK91SuHTKnFrymbtm0pPWGeyRYICqmyv16pyXwXzXTeXbV1IhzlbUtJ7MdZse4PlFr30LgV2
+cS1KT2fp5pS12A3RkC1Tud5NFaO4shLlUrTok2n9MMLg5yDgXPy7bjF+R3c2i7KIccEV9HBzUhckb3F37q7llK6d5OXyUU9JWmSWzmsHV00mzB1/RLXN/EJb
+4zJNmTKOxJqZByEaOb5mqib88fJUczKU/WtmDFleHEAGFCYsxLxqV9/QnGPPYm1WCcBkckZhmbDW6OtrIIGknHMC6QsEuYj+6gYUPajaBZTx4r58pWGXKvbH
+tYcnXQHTgpLhUDW91s9cZfhWLFmsAazMJoHxosojp9zbkbcutCjK3jKradxPbFWehau9qW6exWfTuK8Q9khwWWq2p8hfnNuCf+fwOKc1smK5ZL8eUnBXeVxk
+cQktnSEfLg1S5a+wFzNLlhXo19dLgYeoXfHGORQI6/ePrXjGOeaUDaG/uuZNv6eKXXU2j5wT+n1eLxPEXvQmt825tn41ICllNhqcXvZQt7smXTvlA/aliQaH
+VWv4BYnqPHSSAY28qJ2f0/k4LfP5NcdBmLngIWUyO+WPuVJlNONBVViacZXPkFqCCF8M0YcrYyw5MRuh/fEx2p/Hx/qYN2d8qyB9/xyIQK/3ueLZVTTt6igb
++77ip4pWxtckQ+MR3WTFH3Na12XmDauldVCxDfLtUyDtXBuzbnaCWodunOuM5k8sF2r2Dhc0UmAdvylyE3aihDryeq69qrx/n2HsBhu0SU+S7nK3O0Pv8/ki
+590xWAJ5zrVEkr7xjHa11rYVz5iZhMABhhTx47NSWlSG2Xi9b2bGcxB9hr2IABUb+s5cMRLH0/Eq/Ds/xXAZSqZ2vpJyd6xbMnI80DpeYk6lCLFyXpLusZcD
+Zkxt7rTdG61nOc/ZXZq20+ni6cGOOJl+OHOSDOsnBPN1Nqj8fn2Qxj3CW8tIwBvl2FSS2uo9Tw6wyNz8tT3uHwnp7WgDEIaP8lqZV6C1+L4SAYGhnBf2qq4R
+Y9jlqFO67by5WC5/ZWmvhKRZM6579qOOpj+dk/r5ItpDdY37PAruWgTrzrJReYhJgb8IC5Uf+3n7lFNz1rysyvfGm6P3YpQbMoqUJ1+od7BemH40G3sWGCC7
+oynjctYRMANKsYB9PkRq57ziqYoPWjh8LP80ObmF5CEPa0LQo0qXTmN+1OsDAl4r4OLPVJ4qdeMyPaW6HWD5yPIbpLy+4vO0nDnVy697L4oWXnfyxaUZ4KKT
+z/uOyOUrO5/npWDrRo6f9uxnSNQhdSaLOY4nUYU93j1+YTMAwcDlUhDGotWr013Jl6UEqdfiELFXgir4jOL/Dpqp31lByBB1Yi7upVQq/Q++RlfXKd+1cpCQ
+2tjy9UwDaWMNr9ic1Fa7qeAHAul5SmsphGAvdnr9pX/c+2Zqr7j/kZNmnPQAub1u4hZ+DHcji2Uo/huupjHjQ84TEbBsrz72nFqGh62d+2fez/1YJ5QSol5N
+GJai2plQ+50QQ7/TZddeHbLIAlofFO1fvSdvanic3hvU7nhtRV60m86uSy2wmutrfnQWKwxzci0bXWU8mmUwWZs1tKA2JKI5NRb7co5q4/xmBVlRWM7TpLVv
+/nN4jErSG7caxL23ANTIVJQLBZN0v7IsaysGP5XOu+A1M96RUIhszyl7wi6ar6ZqivWG+iTMoXcGLti2qrVVaqOVkeuwSAAfgxh8WdgtW+FBKZina0ceoTVT
+Ofdn4Xg6+7VlmNMAl0LpblsSzIAAiJbYbA/FNh9764irlpNMY429roOIU8qq02OZydPRzqAuTRtJyG6ekKRFc2gzASAX2Lw4YbUTZxvLqyFHrXEqVCmHLg6i
+S4iLfm+83OlnSZW09HDIquAaSFX9nCwGu9MEzGbUo3nMnCy8bTvp2dLdX1wPjGTXBIvePLIZICovyYdL64GQ1BlRdZCHhAsL4GN9U4NTqyzGH+P8Us9weNq7
+/zRxJmEbu2n2TzjmDTxT3LwzA9Fpk0jIk9IyFY1NlyGHjpmcx5gkBIsDipghQTGXlc2+360mxLq1POnGfq2ROzyqteJ7u1+/UeBwJSeoy0AqC5g9C1BLbrM1
+PpXXhgQg0SQpq1HUFIWU0nc29+a9jbrqxx07W5S1SlctK2vdRN07vl/GSXsYo67JuFvjCxQs7bPAaVRZzRkZ1RVpux1sxKgZSVFyQdbONq8samUHAUD+Yl74
+9rFxRQFnsDyvsZKpIu/vst4vxF1bZS2XuCuANdpupxNv3XtbBUK4fTXfsD2G3hx4SIOJp0NOBAiKzQ+5hvSQqMofiKH273B6PcAO5JNhAOO5XTxDU8Tk+tmN
+98JN43GlGqveYRXuCc+vbluWYTEBfKV3KfdXVB9XvatBR+wP9oJr6qQzum9sfKvKulrwfo1nVTWEd5F5VbJh42RrVI2yjL9wl02PH0GqL2NN4EDCFDgo+akL
+NT2k1VphWyEjxZGzVw2UTSZu/chpzpzKiVqqh3IeFx6B5CCrw7IVwJP+953Au6lcyjY2Y7DbTlcihFT2pGcqB9mYlBtKPNl8UpRfHkTldV4w3D/h5eG57tyo
+Qb3mY7+xyTnVKLNGvUd1p8vnrNpKWbOcp2llUGdoy0htHHxOnSs5Ry/S1JO6aDPmoYsPzejUqXaiBPJDpv3nCsxh25KsH0fjdakvRtLlq1npwHOGlT6ua6Rf
+DaYtEZGBkExQc7ZnXj1eJCW+o6tLRRVnQVymG4WJ6Uy0RG8LK1rxlcs0zBTcba/1e/tulUe6E+V9gMwS5mSpCzO6hMw54MgGT8eXt0kOFWsPYlqFUnaPOfpS
+HjNSp3sQlbVKvY79kFHGvtXxae9bWR7sN0qIPC1UCi4bLzls/MIlwc0LnS6baT6ZQqyEfHxm7yCrH+xTenPnG1fFMNElXvjFBybYsT6l5r5vj6KpwkdR0D6R
+fzI5d+4Ry/GOQER+UxGwrNhaw1NJo4DHdx/RbuQMlfOmvkoAzboWu/NBZhSyqEhraFnYBsbgR7qg8NHMqVP/lNp7eXIzuYrkB7NJwyys7wZp9t4AWZUesZ6J
+D6MoKnkG2PT2VwJG2pi0eCtpInpVvvwjXUZTO4zs5z9r+5kD3J4XbKy7tvU24q28MFoinCEDaliDvEHZ0BKgjZRm4m3bj5J8FAHW0iVwMI7vnY/40z+4UKX/
+prJy/8fVSCh4jqY3vW+9DKnH6+ufzCGzGFhVb6oMtYgsl31zfFb3y6WlYkQ+A7tnAJBFEYL9Ne/sEvBONrYf/qpiY89iNAjEZZfAOk50YwGcfqtON10LqOWn
+KdyFmGOpOYdf8BNDiyBCzL2L66o2NfHnySznKZqN9ZOynJIk1Q5CITj2Zg3BEKIbkGW2MHQsdDTl1B4SwTfo7OSbNbL+aPqBaElsleyV9kWpj2RgjxgVXX2a
+/M1re8tlyswxyNYlr7mhObEZQc8C91tMPYKN+LFobKrRmmi5qaq6Rx9OMid/y2u4ZWelbDSMlQk214k4dF4SR+kBybRpo5WFN0SzSPFgN/bBIjIHM7fwXGd2
+5QEn8YjEedeKA/+LjtDLAU/0Psbl3Os5+AusTPzNwhhe4xVeu68N8sVuG6HwOhoP3uW5C4gfGm2T4gCXrw5MyexrI+C56yJIkg1HS6aKsBPIIWONizNHOoK6
+sbNhxppgm5uFv/SGYgbSWlQQyHuk1/bEBxaaoz/j2YvDyaTRaw/JaRJDm1d8hOve0SZBTnS2lgkeXED9zEmkCpYOkvTNoC8mABHxCydL5yClqHHx5hC7lDj9
+U0Gv76vCnjzFLA2POUs3emXZrszc2kIRNLIqb96zi8UqbyfgcjsKtwM5C3IGp5MHp/HKYhzqMcvrx6CV1aigIqw72t8up3ibvukstv9KdfO7k/TbihFiN6y3
+wsaoIZ7L+RQEVT0cHegpIQMBAFHtzZJeRyEWrQIg8IT4wY9Re9Q8SfbV1fYQxwy9KFN9VWzLvRYzZcTBE4ui+pIJ0VV1f9o5B1blxGJqsvvp9MG1o4TzILwN
+RPYNPUuvhzzvH/mAit6Yo5ml0EoMkebJ5mhONdKGjsetrqeSERFF6y9qn5bzaO9cWaB2wZbXJ8dAlpjKlpBMQthFleze/q27Epkqt691IpROJF4FMw+9DA41
+uZHTokxB7j7VbFKODvnZFCnCf+Fz0foVb+uqNj+/iL8qt+nhf18YVomeXygQA6h8Xy8SixW0dqxPlV7F31nq5JT9vQudmJU/KT49BotQR8kFSR7qoeaFl3XK
+jWP7HErvlkFWNfckqfimKg8LgllVpRfnaU6tD/2QCEDc+Tldqkbb0zZvuNWz5uQsuOW0+eVYvzx1PNe7wHyUpRJwNOf6TtJqByUxPPanbAxaHxIAEgEK8hOk
+5YIU/I4sSMXYdzl8YOFmYizb5uBqEwHAkKit6Xa8MJ8LSn3c69v8CQveRPm8idN5CEvOmLGDW10C5U9eKJzfVcTVdoMPLVtNcjp9GhKWT3wry/cEKqFirrqF
+lENiRsKIFMf+rLDui5yihjk1vNM3C72933B/qvLeKOZpar2q8aiehdFKT2smgv/d6vA3qmW6PUqy2ChRXIoVEJD49KznqRRp2DwwoAGVLoYfHIrZ7a9oOn0i
+v9kODYSA7TLkGWO7xp3exlDRCR1zi764QWsuTPKEZ5T0IUB6S4Lw+9+ttUSrthiDK+uH9ETarRB+VVXZefPOFJRVvNZ24kB/ktAN1KHoZBshG5BarEqWXrnl
+KLASrmBknSRIKQ0+d9r3uKs13QCKOjSkAE0PIpJg3YxNrPyvFMRQJbUMRPzJnUf+WP9/4BkVTNZxjP+tfKj/d3s/6SEhIyRogDMO6Sxum+0qNkKViKESCkZe
+PuB4vKCL9f8/7R79j/PhNMXqYDn04cxQtXgBM18WDIkAaXkVQ+KSiMg9hLrX9+n5VpUGvX/K/zZSK7aoFGpymi6sxeyVXXdt7Ee32WgE42OAuv5Q+66GJHt7
+iJK20iaGyy1UVR2ofG7s0yfSDyhDu6+8FcD+cY0KkZkpgi32YZzkhqCkvEkSh5rVoNgFl+s5k6EnT0dsoG2z9R6UW1XPmncoDqScrmk6tRJXJiB3yvoN42Vh
+J2P19ow+iiGRVXIAs39RyVcceO9Mib7H+ltg8/76Zq9bFGGqKu2ka3r6wuI/tQU33oXi8QnMjvqNOFMstpG8jlj9pronAh6r2RO3rnNv/r5b3GjlTZ99t9bj
+oe8adw7xHLUb4HQ8KKuFMTI4RcSclswKyvI6J2KzK8QDYd7lB7swb2McR1ldegJAgKa367bJQ7eKo78mUd7eWuatN3FZg1AektuQKZJd7/nl8ht6Lle++QPY
+kQyRGUzvClqIKoX9OQVirFyruATkMK0ZXpfmVKFX690vCNjw59+GbJBlSopf91vX5mAUagM+rA5RWL2fkI8omIWn5lVjOtLEuwVFh+1uwHKv4mSgVEXBUmZB
+XlH8e54OdMXgxUyU05LQpizFKWlSJxjz4y0qr3IB+D5t9mpNIYrzOk4nYaA5LncuSlc6+tEdtm15NpMaPzo39fJNiqUWsUJ26j/X4mOucXyI4YqOxMeavSwa
+neKzTSw41AAn9Rbfn9btZmQ1u1diL7Oit/5MEsYDzkLy5tiVAtHWK+eGV9202d4wy9mQU05nxVJaV+7C0EK1ycahkLC5j4KA9vicLa/o3gcU+lYs2sodHZWj
+Mh+cnkpPR5P+5fqa+UWk3QjlHnYW2W+dFIc584sCGwi5GPXUgQB4mphxqa5SjdYpYh8gvVftlzLW+iUuRnPXap7x+tyEJS3qWwTxukmULYcESMXnwzMOMKch
+od5Evdq2AxxMHjXmj0rW+RN6lni8OF3SOzo4LZiy3shbis3Rt6ytTSsFjq3LJec7ouptIlKrmEZ+zItfb07XCkZVc4SccAECgOYY0ixu1gpgXmI23uV5d8uo
+lJNGswSxa/V4CrxHseRbzEsObcR30pgXk/PBx0QYOFI+Y5Ybm8W/AXaQMz2kypv8kCinXPkVaRET46YiuYOYiQoUeSkO1/Jl1vf/XlXm+1uMKFpgIktRRblE
+JUUYIMzqQco+4eOdADY2+XotG629iXPmFdCU0qg89u31VB6CGF+mWPXp/20Y9nUb1Yw02qymsGXKyMmVbOpDgMQU05lzgtjCxvXlwvBK1en3cyvUO0yDaat9
+/7Gy/hNxnsuOucTwEa93346+ScTuDo/dh2qcYQBoxhjpPLBTbkCi4H++pBiIEpUJKoB3iVF+7ZrW2zUij1qGqBrBp51w6uUVPokNuSCqUQph7IFwA66J5SHF
+wAZ6VTgwsSNhTNI8TcTakWKlt6p5fKe8zx93RoQQlJkrlcfjmK/cOe+K423sWf0MHfeKcQCS3KKsgUR7awouBR9wA1pPiqiNgAyVS7tx9UIRaxX64zxOY5Yq
+h+wEXRNCZwpucz1g5Iu0qSTJNZTXyqAkvNK3kv6e9IbG1/ketbClTuGVvoXa23QyIMltSGWPqwRhHPIc7iMrirUJ8d0vu5O/7OsNkYTV17oHNufYeK4Q35R9
+LH+7z6pd6jBnkQJFJIwb1SRoD64smRHTHqur2kjEBkgpbcL/XdCv38r/fxsSfhevBEP2b/1q/AwZwr39gjMsXWwWPU3u1TXNbapRZbLg8nrpie57RiVCYGAG
+EREgRcL2vmW0pXknrHH0Wzvb/J2MKv4Yq338cIiwfln2URBls7GY7XIfp/wd7/2NWXpcxt3h7mL3j9CEsnkGyGlQ2BLVbqfsMcWy0UfATADlvTi4q1PcPz6Q
+0FoX84LEHFOznj6RPqdaFrUDOFIxLZiKdHU1d7a+/3g4bWrWybgajQvPlS38fkqDUjbULWLD7VlvZvkGk2FEn+2ldODdAH7XhAc53Xv9a2K3VOEzVW/TNaxV
+H83IGUMriIjLqWm8Y2lcdyCU84WFCILrQMr3ipa4R16ZJMXN5G5qllnhG1WC4FRuYlKif36PN9uXHydzWiOG4FMJPBtiinpW860l3uCGN8h4vXujgaXGo+gw
+CnG6zl2rar4PKaGBL4gH68Mv5xeAkCUBAFaMpQgszWi5E7SaD8wJAZB/RlYLDPyDvLnhrAwOTUXc4ivYgO7f4lkFph+hAeOWh+mWRxhctiQpaNA0Fh9Y6aHu
+h8RdXn9HZnKSDOXkKv0rJra8Z7yJLVJEZ5QQpJof0btnkzsk0DR89V8YO0CINccHHS/c96ePtti4Fb/wODZJSh38hlSEIDiBM5j7w7OxZHCDLFIFqNOT3tBd
+15iepe/ijLV5468ObiajsdyNO+PcGsNE+vmkPfHJvc2cRK4XiJTtQImUK6WPigqW3XUWrWmO2QflGn19x+Ur3FDGEHOqhJ+nCFK2hVMudbrlfBAiuW7DOSyi
+8sWgQfhsVygJgozi/0S+duhimve5p8YZrJuAkY0CXHdt9KLvUODrZOKO2uRzKFcsKTqVWnS/nrjTEaX5DgAQ7KbLrEOrc1HNGanArY2nzqthtwrqJIXeR80N
+OHupniXZEag+xeWvyLZvQNZY+V/kvMoGC5h9COeknqf8Efi6wmNwUJXwN7hO2yZk1X7CcNqj3f9Tn1gbHQwTIxNLHnfrgyGYdsQByC7yXe4P6xXQmRSHOCu6
+TPNnAqnNoIfiXHNJGRDTG8N4zBbslfsnxI2WuZatVynuyhTfRAIM/qhdeHAGswm6nlFRr6luNAD0XZaM+GJIiLYWkDX4vo+v6AdMdU441V1bzomZKtOu8R3H
+NcFLQlbEkCxFkKtSDPnZ6BuwfANA51C+Eh/VoESYyfTja33Tqgll3co6bHOsj4Qk2ROoGzonJ/rXrSQHPXLkgQP7Y3w3cxJmf+wjDo6pgC8xXKPo/n/MeFn6
+PPCodE4nI33KlUJGL437iG1VRgILbi6mATIKbaH4itoVjzOKs8GTduP3+P3c5V16PtK+q+ypuqx0GJZrubm40ZMgbcU9mJOZHd3daF1XaU4wwAxIYW9XDcbJ
+1792NCp7az9LippX+PhkJ80GQNU8fYIApD155k2agaEpVNPcBMRGsEzg0PFZA2eohI2rZAbYr1Kt3nFJe5C1v1SvS8kIfT2VvYaoe1PuS8rA/sGchvIEiJoE
+PP8A20+hRs8Qz0DYAJ9/spo+ZX2CKwbQ6cHWm2D1BgoYPiwr76HiqRtSMG3UUYL1Z7NwVH2UEfCXOaqOlaPqFPH/a+tLwHVEeqSRRBibdMZqW+Nii9RU4EUt
+W3ERj1HqMu4/TLYnG8PBtzwwPpt+iFNhHDjAsqhJiUDqMVIshFkTjMozVP5JZouAM45aVkQzKqbI5A/EGYCQpLrqNRdQ/o7PaaRUmQ+Xo1kpGFeaZ+X4uIvE
+Lvwb7hh9IDcDXQhoScYBtXdEFjhKiyLI2k5VsVgbLnuQveVk+EZV2yBVtLQPIANbRQLlnDwB1sSC267Kdhz3cd2XJQwEZSlLI1DEHnEgN8PgardWH7etoETd
+2lCTxYDchHvy86bechOU2tq1xf/cfd6qm7LbmuTE2BAF2SGmUcru4KocBRCvwjXFop29KpkNq12+IKvbeUZFnpR9AwMAko6M+K3yC7B2P3Up5NxwN8EGQn/D
+D4d9tTRKO+WgqFjD3WnsAKp3Zps6R8xjTYfflmUmL0YzBWDoQZiT4RBIl1bT+/cDzFf905wZ+o2W/ebQ0t9IzVyOyZXlNrq42fZ42l+wuXaS40w1Dy8Mvmfv
+DufRnNHM8Mymjb+RIj9ITs8l12AKkUBumWWcNe4sPfbWuVrsxC/wftL++BW7x2PXeEqRJOYbBEySY0l4VFNVOxkk0KxNw4OyLgJWsB+fIMl6zbIR6SaXS8qJ
+PMFUUUo6R+VFbOqyZeWVfNGX9cxW1cDd6bgcnhl2eWWr221kIT5tEDgdtfOkPMt+GJWRuc8aSymgt5RUaS9XjyRhhXOCZDtpFh4/67zgQvrgNq9kLQPeytfc
+YeEyVuPBH2QUp2Dj6tKSmhgRILuwtorOmq7RF4ZiB8Qjqr5i7XzWjDD/GXsSKCMCs25F5aoof8YgRl5+xUZpNthDsysU6gQ7H9JDQs7plwmV+AEyqZnyJCjP
+oqo7eAt/Cuej2SXJAruueM9gtbV2HMn9GnH7PlKZHWoru46CHhLUExG1wzw7iYZVdiVxNHNZBYKxBXNslLfUSMx0q+fAuyB11KJF+7vpDxpdikNPgNKKz59q
+X4QlEMNWeISWLh8qo4q0TGwARO+09U+IQHX4+SsxRhi0LFDhKN6RnVP9SX0ggMml9Q/FZaBiwNt+oYoj+7Jli73CfzBX7rQmC7f1DltRrGWzf9gel3QEgk89
+xjWs0OAE5eCtqwBI77tm8xFsHZt/fZZpUFjEcsxq3rwDgKpWvo8dnSfzGfcKfVp5Vn82MIdom6TkmqaSTTxKt7GGKnKnZVlovMlzUms2fNN1uAnm5Kj3EgwD
+AQ18cTmVW1/M+exeAouWDJXwh1/GwWI1fvDOzyn2GOYFr+Iu5MNDd37g/fF+6Z1KT4n3caz9ladp5fabzZJuOd3kvK1JAWNg2XrycCMnaY7MeK5QW4VxnNHu
+a0+2mL0ADL7GS3ozUQP3b7iBBLPzj5in8A/Sn9SxH+ua3i22tPuW9SaGmr0cECZR3U/cfrHo42pHkkDMTxS/ZanpDldKLFB5qpmKXcC1FY/uYJePMwwNaJLa
+ttOcAMLaL3ngipu0uXLOcPWk5fp3p7w9nnbjTihHdq4jWhkN1xwghZvVubhryifT1svJSmQlJ7oMAs0RJ47geRZmUkwUH2k6xiGVT5rxmjET6tCDcmSYCKwg
+iJRSdlzDB6KGUhnOOEWqpIkTOqNL9EmbjrZwQUmCCJKzHMjle4JNKpeecsIHYkzCW1LSnJD8O4ybBCY1PNCZNvCcOwLSJoFe13env+tqI5XY+RBSyVHi16jD
+BXomla6Rp3zkN0iJlXMk4GVUnaAZFxUPW5fozPqy10TOk2tU/CaN38D3Xumy+d50FEQDz6tMY+Us4gu/FY6U4k/e8sTsZFJmGtJcwQcz6RFoS3pIUDbTbI0D
+KhSsmiQw6AVaXRQWGKr3KX7lC60eRiDBEthvwEA/k68l0SGDMSYxa7eYDkqvjk7IlpMBvaXfk1xbunxnXR4ZefUojwKA1KZR9+3r05imlJ7p7T8pHvLwznwY
+mwvjJjoc5B8NyjgHQEgZgB6S9KTJ/9TWv4vt/G2xl5vKIdY2lRyCJMJFOa829m4jcCZVdELM6XDalPvYg84UhYSh3c881zAqNAVmRLgonIDhSQYiJMKUUhrC
+uv1yq1i23EWTsQ1cHvZBRxzPlN1JO6MfUi1wEnRC2zYyBNgktidhnw1A3cCUGRxpqf8yKrao5U34EcvZLQp8VRu8Labqsl/nao03b7edNC7Xh2h7zKmMbyCc
+Le3Rgjaymb/skkZRM33twfeNujzER5tOCBnjqaPB3x4XxerQ8S3kISydL7vafsfdUWyCoNVwXrQBWTNHJh+MN/7DACukyoAmWvMcIUtzZznmqHmvcP1VPNvY
+sESY+vmPKddfg2Zr+wr9hnW2rdzydgsqXgEqwwlE5iczZ9WYwJAq5O28jDwwo2+0G7dhlTMq5ap7P0rKwJLBU+zQmCJDtuktM49bUg8ThqqJe7bW+ZyskVw5
+vmXxqBMuWZOcRPfWJ/1GcbeQ3s3zrPqKu0qpURaJI0V243LuN45C2CRpM0zCnFFm1/uzlBVCEjQkxhgyPoeC24Py0VDamiuEoGn0nXqGuaLRvdRNFqfpXoVk
+N4KNJ2msdu5V2GhDRvFh+gNUBbJqPln0OY/rem2x/LaxgD+cSEuRNmAKJVtOua4fiem59raBcEy95JjoVxjj8gG+P6YatrO/pWxTtahX5iR8d2P6SHhONjPl
+K4CNNGFr69mFGbJvpeba3h52acoGrNKocv0eHUOKZ0AQVhWIV8Q+sAqa8m7LazfMNM3S28K5ZgyZ79HKdIvp+43SN5Wb/udi7YrlqNd64GfmWE5uQdrTQ0+J
+9sSQxubyFsf4mSd3tg5FAYG5PpgEvVLj6Qm9iSfGiXZsDkTLb+DoSvAwvSPR1ttEf/A+fdZ5frdV8F//OAzzJGQooy6zyT+OtyUiCtvgvu59JcLS0J0/QvUB
+qi/LUX56qKc/f+M5MdAks7cuWhFV2M/9QhuLnAum+qD5OU4yVq7b0YRgNpFUO1Ngri3/SeLJlgVIRkorJNRAFPz8D6ejdugm1fM4bGveX0Xj1R/Szm3BPyw2
+gFRZ7wSw07DjcCUJYA57E7N+Lp+SlsjhuaAgug2RhdYqNdjcD6RDxehsBTnQ5TNZ1773T3XSYs41x/OQFDqgvPqCbfMD8PUJ/Cx/Y3oHe2gHiz94zbCF8IY9
+kRF8aTy+s70OUr/CmB2VeikcyogwoFi1IZX3UfR3Xwp2QWzCnaHBpu1mcZat3KwSCJcTRRTHEQAQwrGSk+Bvc+qOskWAlg1HT4z1qNclvRTWy8n3o+2RPiwj
+XMGANArIyvSaMVLfmv/Nk1d9MnIeAmzC26Z8QgDN/L05vTO9X3UWk7JTcZKoIsB24267X8zh5B4lgPCKyTECNJvlGsiDgnILSY4AWCxx81teH65LuAG8C+W2
+LGebvXGyi6hzdzquBXi2m3UPKztpXa8nuf7F1FJPS5JTxX9lhAyaLGkCHLLgDZUu0KJoaPU4P+BYIIlqQt7myH9uF0b6nfmaMKsK3ZRZriTyOpqgwlqsJFnx
+XQv5jYFOjQZrktqD3ZM3cV79H6TfFl9S0NcneaqHLmfYjl6dX1nh3qIufw7R0B74Vyll0cguh4Bh2ZmA7DxZfonLMKvcIQ2xuVhXPksvkZUd6+0y3VJrocOy
+uyHLQYbcUwBB/+kdrp5btSa9Uyqr/t1xexxz57OdjjIR7CcFntS2beDVfPkGS2BKUKr7UN0NN+AUc6UWomrMoLLn1Hp/BLZ0e0kmrF1Tb3J/0Kys+KqqUdjq
+uZUBrmfDGo3SlR1owSYBVm8qgzVG1r03wFmF27w5aWmRVWMCw95rfYyfTWm6pCx10AbQ7aimz7omTrvD9rgiaThJ2ZA/u56btNnkKN7PYBJV9yVrvsNsEJL4
+QFvALp3WPhLnVmU8hTf/l6wPeVfPF/43KUlgtwknLZDcmjLcoa2hLOR+2AtEJdR8SL73Mu8bdwb5DUuOXN6J0UjyeKUNaDGyYieh4dwuOO+PKZ+k94jeeIuH
+RiSxBn19AcrHTIYQRNSevZWVl0M+s4QrUndeLjk0zVz2ASxHYLZCPNrR/UthpbJs23ZX5TaUx2BjvFMMN50RkMg6CDPypRIoXXR2fSAMPRY58fu1rpSerfYF
+lxzy93jXqnGOFBtfWO3/MP1LO3lrtq2XOsqrXkFFLyvw7+A5/TYzVz7ft9DA+ybk2hA6UgTdyvmdGpSNAiB0/tW2KgxMWpFCrCM2HlJ/P2vABR2FR0RCtX5o
+mVfnPTD0/3QtE8vHgrrsXQNK00k7stiUTt2GKhVnSMWugPEUVAABepKxT8nKMYBNE205LKh8r+E2jbz3P1cr7FkLhxUyEOXh2/yq8AyMxjPwbGU1tJF2GS5K
+oIzZU2CUkw0ekUDdrmU3HTrEnEjHJ8kzrU2uRNcU+4RLvuG96bs901f8Uca0epJo23fkBi85WN4cshHT0Vitg3Y+80CwmmLtrkp+zA9WsRK8txXPcxLHlGpO
+1vMIBB6exPG1/BA9L/xnrKfiGvN7bd9lx/OCREOXV98G+nZ6TirIcVartpBqaAtMPSE7xg3cKOvI3W181g+yp8LdIrlamQuhl43yNlBcNQ2trMlxqgzdx4NE
+P15k1FgSyAk6dCeadFcXmElreE6xLHJOui2lKik55zKWt7o2ZrrRc0OW0ai4w4MIaYyxsOoMFU0AnglRYrUBmEcMlQaZaGYV2iHVpKa2PSIQAPtd3L+JZlBr
+elx7HcrZB4qqDBjwKaiRHgL9aPCv+m68XqhMPm60f81lPUm7nKtvBTXhqX5UFZgSP6ZCw+vRnV7ne/+V9L3pkH7AeQHcSwyapA3m4rVBv7+ScNE9qwmwFJBC
+JqseprpZ16puCEoLahO9cb98l07pM7a2dyoAran9bW1o3nLOQ3tjjQazTw41eX5drIBykBYYu9oeX9RkFE/aVTGIG0UD5nqpwd4I58IHPYL6rMdN3rYr/2r2
+I7YuyEelqZVDQ7oytrvAaLso6J3l9jvotDw/o9X0MAMg2QFqVkea0LJxXasuI/vAiHS5oBd5Sd+YjoJ7uInZsVGRxf55so+qhyCf7xb5jVtxW70ihyThak4I
+LmKESFaOaRpe0pC004UeEg5dUVbW/huamOLvJND8y6/ErtnDuJ6zaixly1i1YxLuybgCuZWcias4XWo2dAZq6spk9buspwA8pFmxdCSKaiTt8MGcJnURc1ro
+7AOn73KSrV5zMaX+VE6IL7oKSqqN76E+3sDVp9O+1vxOS/jk8bSedZyCIm458yuEkofooDhVLuDnNSnpNg9zLaMLnW6mTvmWH+sJSwCUFdTp6/2Q8nCWnoVv
+FlUrY6GbYgpNqUNbj2671GdTMvrq/U+mB/pa9ri/OHgvdTX8shYSOmzHER/BXdfXbeJiyEnlL5V366mkrexoSm1zDTvlE6Gu/7cEjK3ESOYbHbmzsOzlhnHc
+OjW3fJsnVjV71nzHXpUGgCk0A8jz1v7WhaBxiyuw8UagM1JO9hEWJ4aUDpDC89io5Qa5cgm/J90L1mAf+cAOVYuE9/J+rXVFbvPeeiLCgjB4NFf0SSIo3trI
+ak6kV0xl5Q7x/g6t/DHlhGqwQeRsCNR9R08WbALf579Mfy75/6qd0NGRNFwkB9bSaQjj48HBAnYt0n48HtrfWOtDJ+WUHkVNdACh9cEusTYkeSKDHow0MtMS
+5pNXsT/mxYhsFEnmQqiO3Oux3jFseGgPHJf+g6AgcIr6Adtds4mlrmw53f3Ju/YW0FnPw2hpcZSW4J3DT2S3yTnqj4FdSHtFrRkCguh7V7QGisec+AnYZsaH
+BNxUKRVGR59sEKDTMy77+FXt5PyMKgd8fYVzYXsca8B92NZml+NpCiGp7N493/gbfn7vsfmhMivXwGeSeN3KbGHAWVtFKAPAnMiwgg8JfYF4v09dJ3wwzPrZ
+SCawiW6jz/UurtEJ+4VpfCtC1vYIZzANK52reZPFbXQUMnzdCIVKQcv9HfiGzQ1lVKmd8tjIMSaFCBIWO4JYdMy1JRLA8Em6lJFTP8pxzL9ELVmtgT8LGqKS
+a+viCmbz4stW1pQb08/Uzxuak+QPZo68+OAELCAc7j4Fkc4coKZkZ/7OQ4KM5XLUPQfgGqDEENaTyTGEuqY7UxyQ3r8GktO7VhWYN21H+Xp725TmtfMrs55L
+TqH7lT+Vd6BoDpevmvZLbEshwG8YEilSo+J1ZI7f7rmfL6uHdIzwJi5aqbNw2i1dBIFGKY/JkcV5SzDdYsw3NNcSXNnRvO9lL4g6IAkQ34EkAJZXyFpgrFkA
+Tf1hYFxCCLrCpLqrbw1YWulBP03aTb1WNz5y3fhWP2djYmAvVniavPqZsVwkmeAYAWwSPMeVGnLSd1B9Nhgzg8RQ9T68y3u/7yNbruWXAx6QU8mqf9HGUTaw
+o/nz1vuwUbFFZdSca60JzgkN8Cip8Zp5Vigg53JUXQvRs6oDO+mz6fyMnX2uPaOfa3LQX03/vmajqr/d5RBr94LRmuYL2cS9vv5SQwp9xXj3Xr0Y83Po5FCL
+JS7l0KJ8yuDl2wbxdvtunls9s3NyJzbX5K0+qIqjhOocxcylL3l+UHrZ13c5/3bpI6yR8lPeQ/Nbio32nEHAMArZLo/Y9Nt017JzNU7jJUCVVlZaJeYSPTw7
+CdcjAQ3UN39L7yfMMAccFXjrKCBvh3gGNgps5R/CWUwADbACpTIlOwIgavyDUvn4SvplV/L9w/Sv0l+lv4k926pAyLto6hHTFuyod3lrsxiyh21ceLO0KAGb
+z2Dlo/FwvCSmXnL+vGzOxCYdWnKyPugz66PffkhNOhyiFnJNUNp3qtqZP5aApPh/IiFAcv1+i59ecZsq2Z4fSj/S7e8/qLt7qrxuNmelLnKKmWbrbZAutoOx
+ox+1X5xD69Ui1WElzSjKRiZ8LY3ibKiTOe1lT846NSBkbtk70WuNYbC0Y5iwwftXKfKyxsrR0rmX+9Hmy4y38Ke1v+mrxXvfn4T5Lvb9jpW8ZXRpG+WKX+Av
++FS4giWxbnUGltKFKw5bVTtv0b2MwdrTNWuM3PfYJL5xQElPuDkDTUinhf/+Y+lr6R+uqerlqrptPvtxkaCQ43Db0QRWORHm6K4oe887B/TYxP/PcVVmfkOq
+jYBNvqLtYgPCNhTM1BQhXaHW4Bfmw1jmA7ltG4Ldf5AWxGSU9FgL6NUvbjTKswuury+L46ELmHn6msZhC3NdWG2snBTjkRu8rU/W/IkhyGIGBGmujYIgOQJz
+0DRfLoAkCZGgWzvYBMTeP5hNpWymUPPEoE8utun70s97r+BfeIXbAtw2xpeVxYtKNsR9PTNP7LBra+nWyWL4/WUqi3vLlIxBhDGfKl1JFd8rM79ZAU4AIFhB
+Q6QsUU95drAeEllBFLTKPSQILTZogFjz4AirXhFI8lh/ED0LgfIh9t/+TvrD9M/Tn6Y/T3+V/m/lFA0plsPTsi0H/sa0wOgxqlyX2aHKlAmSQHSCTze1D7lp
+To1zGex7Zo/ImOXQ1x60WVICpaV/cBhBBakzaxNYTEh51RJiI4+BD8n+P7eH1Nh88gAyNVogL6UPpB9OX0g/o7nXP+Zs1l+n/5SSWbl9w9a/s+2pnCNmwipK
+KXzfdHdNZ/d42hjN1vG006k+jJtFmFBUue8Xrw5QDB40wtoPCUFbebPPNBh3/UMSPwQbFMWQoENXtM9xkc3mk5iIqxiZqmykPFvw3987g6qdH+mmFiZMIXLq
+duLO1LhDRaN6Jj3RwtaC2eMpuzrF8l/mY3FG4MCoxrSoq2eAudv+khpKbdYXDVk/pLpVQ3QwWI67yRmdV1XLBUdd7aJFXb+V/sg9s+WxYOTubuVMAspcEJax
+4o9dS7pkmg6nndrCEj5Pwr/bOGgbjLERABAhSUs0wUPChxSBZZzgzgnPsFFDWE+ZutqEWFoIRe3cgcjJktUDS5UD0XikRUHB8/6K7ewIoQGUdjGBaqLKK/g8
+vS49L6XG57SBM2ysn7f//ZNyQJaI5JH/y5y3m4PFJlV4Y+U6dHyinqfDXbuwWc8OmbqU3q4xZOWpfLtmE7+XGd6EoVmq/OLTlzO1ZE+cXFMccOavvdlWSd6G
+jdpjcxuC5BufhY+Taj4/80Er2zkSRCEN0JYjbblBe2K0POFZ75dnsgxTo0aroCAizTynrmb2qfSF9Is9P8OtskwEN2HarpCo1+AxSDe7CvqpU0Vf+XopUlaW
+d9ZmMM6INc25GBoicw+ggXMjYIZG2auxTASz5FRxSeuPeVUXaeOVf6mmNSoTt8rosTpVHUndvht4DqdTiyyeVCe/TMUZ1qekUX06q4gtTwgY5VvP5hGQoVBR
+11ghxwApp5QSc0+/zHhjKf+WT5ple85Bkcl3pEH3W+np+jDvo3HSnPGy6XdUSgIVrhGYyRzgI2nGDcwimAlVjA6Ufn0goPI31NAgvY13NUeYCnDbub7fZNWf
+Q8eBoOadQaJE3O4IABsGd/ny4U+kgnJQhRU9NYbcY5MG5pnADTD2TjOxKtw3CnvzGZgLgLliE0BOGQiec5Nu5KF8G1ur96e79MnVEd22NMzyrR3zWVepSba+
+5TLF7fZ8HZIFLEBxVNa5lBOJEj0/P4vNJ14L0gH9PsXBnLyPgMcqIQQvk7Hqj4iFf9aF65LUjM/3mtDcCFrF1pedlPxRsWWoGUoXqC6fz1ZttrILmvULNeM3
+a5e64aLYpc89vKvTVZ36+Fr/GExEAF1zopnKOcRtTahOY5bvteqEWTtxSFdVkEvuob0Azboq3BMpcqLwXI8t04SwkHFBaAbpu6s//50rP3847ZpfMScIvwPP
+pPwX9jteXlzFeNtcRblw/QXPKXN9Mym2XM7k16e3pu9M72h+C3vy/ItK+oyEBdrua9ZfCEOy3yi/9XXBPn9RGET3lbGyK4e2d9OOMSfy2AbsR5Xhz0ZUzUUS
+5GIVEKheshom6fJz+6qibg9WF8WOU2FGIKSYCp7JaTibMQmjgnbpT6epjeCDcImOR5sp66DKjjyKnxvyvHqylItGZ9KbrVOWe39lSDiHQ3aOuoFALQCZq9pR
+vjW1frr4YqUT4se079n4oNz35o1nkXRL3F43nwEDKxsqc2bzp7165RsAkqJiuQfSIFe1ZTXtUI4x7horH+dy6aQJGVS6nW4/8h2imfkLSAc3OD9E6UjQTvNQ
+xZI4VcAEesMlJueu43MSz3UAItt5xoeCqoFnyN+tNcoex6n/XzgramVdDLizcqEax+KcuNdf+rrL/wdgPZ/l/7I6ojEkBnifazSVikpWkcONmOj4f5XPBAMC
+SCEuKYkQkpK7b1LUlEZXDTrt/Ca3e9P+1ks4BKZi6Rt0N0EedZZl7wkxVKjWhOtCFE8J0ut9Hkq8YNFMjWUCLuwgTJuegbQYR9TSXhqPI1bjrUkwO2/5o/SB
+gaYGVWieNXI2iYWym9p1sZTvShN7nulWJkTI7/i/bpuXtYYaATD81X48jqpMlNPCC2A/7pxy4nVYLsWSTcUTSBnVE2DS2nhtsmKcoUPFMY9jg6OzK16KWshs
+FY7xnGqGimLEL8uXZO3KpJV7SPScRF9QJ+ylZk0VZgWpFurq2lkJbOUwD8vsuK3LLIAkPWZhvS/+me1GCw5lASJRuwaBNK4z1C1K9IKAmOV72MYkAhFzWdKI
+pi9foeY3w9zXjpaf0e5/SUb2rL87AXuPu0NdNc1fafwYc0PDxUCwlgEwzV52Q4QQjtsbgh8D3N6VdDUlrkclAF5A8lOYGj3CNbYkyel8Q06/m0PTrqk1brZS
+N12L19QVJW/Y+WySuV7gnJyQR2qlW+FpQTMiqLZ6thuTPb/FuTCYE1nHhPT5ItnUYL2p0vdDa3xjXjXxLyt2BXn/v0e1Dg5r+397tNa81/I48Ua/F40dHqlu
++kEzmJmrsBloZcdvQKWBYSa+UQLZNZ8EXOdb8Equ9rbzjqJyKPfXp9ckTLOQeS+rIcKsOIOyOPgYTATNRaFfS5+7eCVUZ1l/s+9luh2DhIpla/Qkvm1VKxf9
+P7vtxvOQ/aGNC9U+bpANxDnKTghZtBCgiqbHWw4QYtfSGVN0LDhTUFd4vTDNRZ6mQ13yt0GLN7vBQYVOq8QGQjnuSszJ12UytLwkNfmvK7PcBwDxCcynqQw6
+MrOj/f/TYjdOygvltTPe7qnxDzLLa8OszZ8bdXw1RNKtktXqke+F72jOwneorfiwIv2LFbx0Lj4iWlpQX0YKxLunOSJ518fOPJESKx/zcvf0KqVsAVHLnBKr
+VDzrvTP+VgWo7eImL8+ksFLv1WsylrSypgcyyIQrYdRFURzeMhRJ4JPxbfkSiMbKPN8zWp5CtD1r7vcV1b3VjtG87LsLPl1Q99+tr5+Tda5tN7E/78RuraY7
+NyrNl/grAmjWFphcZN9VqG8G4E3ZjFTFxoJRLq8Fn3irGT9f/94zUo7U0yNeoZYJ5IJNcZCiV4hEsgueizuaAR7SqnM4p2IHqeOJvHO2vRjo1nC3B85dWVBr
+FmaDlZMFUJbNupEZSJMu+mmGdjlFQ5PetDhL/rbm45nJKCtpi62TcXc43Ty2v+O+vjuOu3zpzIGWYkKC8YGdYY5ZkNlMl3vbt7XQKkJzJloXejJB0XalXPOK
++bRxhjjBFstaaXziGQDKYknNalnxiwHgHNZKxVN8lJXRBKfoypqKMWkaYMytOjankcnbLzQhJBYkFc6E8iAMxgeBRAgC9fgUjlFeHnokyInEd6mMS5s+kpxF
+ZnM+rSzJ3gFwrKxnwhcRMyESNB6jiXFxPSFRVv7oQ22osNanW9d/101LsdMpuAMwYCOphlUeLCJo2lHaAkw56KXbiRfOiHc7n+bl/T29+J42hru6tzNd2djl
+/mHc3dd2thwpHa+A6aR/vkbT+9EVC2r7SgtW5P1x08XVN8Hj6WJsgReGQLtgdiXasHIHzarnb5RlfNUWQpJ5Py0P1o9XRoT9IzFW/HO4Gm5lEyhiI9VpHWao
+NCxZBEoxUMs3IvrYYQ9mXMZctuTEZtWzQ9iQ1tdWgwCVk+Q0rTslygdwcrC/8j8oKGbjNmODaBgSdEtaXRQw1WEXGidfdmhmsD++USvswAztAKIDWHtBIzr7
+JzVOjpIkTtethOr9nRqVTqcY570rMGnbpxQvTgwOLtdIc2xy0gL/DN7jQuUvyo7HcJcQzoAbQ5+z2ygcQEY+XW3ClF5LP9j7jfzvsF3EMrmz3wclVHdLIInt
+MoBUu4zLc3hIbhJIelvZFJTlKG+g6GCda1Shis8W6wqrfI3dLL/2jhDtSwZQ9SmW0a1654O8Yy/Mbtti9URPcqNbxZITg4Tf/OpZdOlm68wChP5otzY21C0C
+AIPJ4mLTuyB8Ey2b84Jvwn2Xad1rCZHH0fWipq23WUnoSKJUx+PZJIA5AVVSn9qa527Lmsui2RY7YlKr41tr2SUO+VmpmlRdiuC5b2uKLIp/mYCSSQnsK4Q5
+HEBcMEklzieRBUTLjTnuyqLByqPxHKHToBByXtQfCqK8dS4w8mZ83NHHyQt7UjkQPs7TVC3ccucrj9Ze5M04o+LCGrLYZFNIA2hNimXtv2fiNvYcAKXGahme
+uuvla+SxqzFr1tp3ap9Mwap8gpEqvEtuLu+QRidRZJT8677R5yHVzSKyNwTSl1Sum9gerG4UtnNqonBItcmnnvnFQoXIcC/e1qRZ/G6uT8cV+qDQl9nI+fGN
+4FWqm8ZuhM5/5D5HqROzVwDa8kOqc1Ic+HonIiW4tmXW3UOGgew1e96n2YdPBX1+VfSdWr1FkzHiUmJ3926ZLLF08QVdjpHV63WIOMd6Npx1tSHgkHRzS8lH
+LN5KH/2Q4p2cPTNrNkR7tnSUkdP90y2ne7UQu7ZSvmUR3TrmF7EYwouNBWKvlC6aNTcZd+ebg/IK6fWbkktsNX6isYha/W9N71A+5k+mTwc02IU8azmHmPVK
+2S+OjiXZnmTfHU+7if2EcXcyd2FjXu32tXzc6g3c8NJE9tdseIwoGdidBsoJ1nK09FyPCa0aeLCRRfVbujmUm7epF7ySPlxjbGbcc0vPHmmtK3d7dNw7LZ9W
+xNUitptQSvS1XwCHRN5MVvnoMt8nosHVs9wRqmW6sx5dcr9qT8UnWO/wc6yS9/e4p+S3FL3Gfqw73csxTIv+dyftZCOj0Hjeffd6Lzmn4JZUvuY+PEfx7cX7
+q9V9zAtMsCxrzAngjEBYE4lGQM/ldggmqcwRehsx1iP/zKkH+e/ECgNW4taWE+PlwHVWWE//WWWrkWy9DpkPRrNZpt+55gyfvBdTQ5/7juJmceoIt+qGDESA
+AEzjEtEucb4Uus4riOdM1dSHRLFXrI1xKvMbwLk9oDIBNPHAbToEe+29zTvdCzvFA73wXug7gQVvACweP8DFTWFofagbwxuwHtkYOnFNrF2sdOmXYSxnpcgM
+8Y0WzzU/5R0OW2sq0lu8Bpk2Vox35j6pUjMLO73rKuW60jvWveRZOC+vlNtedoXGt2Q8CjOQOlMYMi4IROW2Yw1AIOQiNIcUeZk/VxX2svBTd6yj9s+wQQGl
+rE0IKstpS+ToVBxCgGxH2ygJeVSBcdKGW09ao3H6Fk8KURUXlZE7yN3740EA416SqtMqGo3eh5VUV0t8/ZE9R8flTdoaFzClpJTQfBfSkFot8ZGRLcZtH3Cg
+PNhVRuqKD+VzCpy8JqsmL/J/Gwmmh4bTiM8n/XM4xfa3SQFjGgcaQ1m2IkGWzntdBeWPtNKQhKUARCEnatiF4rPK6JwbcVrh45a526ty+9rIHxI9F/8jYP0w
+12K5A7zhwWjzuEooIesQZiTiZN6d7p0vP1Klbe1+VgzjLuAWjyeF10IGngy73fzKbAzg5YIgohAfJC0RumyD72u57h/UCpCybAkLj3qBp9jUvmsoFDyzwsHw
+jbZT3FuZ/rJkBap8Ct9NAtWCJRJgKPE3TPC5triCmlv9QmH0rm7axpDQtOMsOJXeFrIWwiH948yp6YxJDJwoa4dZlRrSqNrXq29TWMp0733SkUcqaNQIBq+j
+jDKDYE35raXYJHY1WsNrZ6SM7ZUFX9Qto3rldPxi+oX0a+lrBXtxs2CMOu2m0M11PB23CkM2hd/ROA7YVOaGJryKlfjYd+EbXp/RyEZvm9xa2zF0rt6AHSA9
+mJ/8bg5GYUgUHGaFCQcuwuJT/mLVsVEWATPy6tPUQ/RpbzrtRuU84Y8bMCr3el/6rx0kskksoq3C2ICY8Xn7QoGTE2r8XeO2DzAH8n9M/y39L0EG7ZdKXFvl
+v1ddlZPxt8QB1Rbt1hBurVVKn22ceovfMp2aVj7zlEK/X9PNp6k5Q27LoS7bFnSvN5DS8h0EGLx3ln+Ez79Y0/Yf0d/DwHsXMHSPkf9Dq8SEn6Xm9yTXthYO
+k8qy1xTPxr3Cwa0QJvBDVO7tAQigwfe+rBZF1Zxsz+SVwpwDVzZ0BoukMPxH4FD+QY4f+0/PCWCD7Cva//udgcfy0ymt/3+H7fEK+50pASgGzq9kQKjd4bnS
+qw8UL8lYK53rh6jX03lFZsY7SUQXdHtt5oXuiAJdaTs/qnoh1epL92STPmmxQWW/nrarnSxRo6Pyb3Ez1MqM7g10TaENqXlwJFgvWYU2Q9t47RnVYpAOAq1W
+Kj04Y/pQ1W/Rvm5plrc2LYFtbVVA7chSoBcueUhVMKy2Y2C3+LQPCdR6MbAiJ3K9yOZK2/n+OEeqaQqk/pp/Xk64drhsYzPn/6bZ1laeYAoksx4ar9tB1LrM
+d7tHFHRi444OZRnXib20wbnMru0EljTRGAlosT5qR4FMvP2Lu3/QXrhmf0n+cn3vT9pFXF8yPfmdCMud2k0vS8I3PHP6tTseYE7lGWkAIAmguA7epT5V2kdf
+Vti4Dp6mmi7DFhR5xCfTrZkDSSlK/V0hEvWyKTQEKrMy18MblIJB/xOA8nuYBmDBZZoSzqcCRwxnFXZbb3LrbrO7evuS1I78dIdAOGqINbf6bMw2AWWRjYxI
+VorC1vh5ChHipjkLKpzH+DV9+Tc+RMmKhvGIkpNI7R57fTGTFzNxMQmAT7uehDI71EUi4yZXzU0YJAWw5mQjKzaiYQ4qTeTZmvGUahawZEyF1VvWV13vn65s
+Y92ViXm0/GDTEGTYrkmrGIfTpoJewkoCcFGwZHovouxMdvTx5anmCMwAhJaYLosSZ0Acel5QQTmaptzKPhWrPm310sZJZ5l70MIkAsnWlM40RYvaophRRHaK
+vUsPaQOdr1D5CS/ZC61CGcj2taFVU+zNhTOnEgg0qLcW5wRDcW+1+V2orHl2Ike15YGdBWXyzr1xbboYB9OTYJ0ilON4ugkVfstkoTOgN9uvTGej5SRpv4ek
+fCQEcsNtWNSnwEjr/AQQ6i6vpA8pVuuHpILp/T8KZl47qLX58nbUTbltxSQFq3Av4zGTh3H5hvNd9pZqbYCKtOgYkA/Nudwd/lkpNOSE1ORwClIruadR7oXN
+8eoyPjYkrp3Eda2xRL11Hp/aDSD5XO06NpgsxVVWITQ/ruqKI/shw3HI14s1Z0xOkXnHBtVR7jod8e6CtNs14W6nJjqp23BnXXu2KGGxwxeCIE3cBDCX21fD
+JwAKZpOCb3mB9gvtdzU2ShHY+9BFu2u5lyYPCzuFR21NslbbMRzaMh5CQW+GYYJA6pUvoWW1kbHId3P57tDVjzZdl0np4xdeQbufl92L6dTLCH3EBOoVA7U9
+Ki/8Ah7JJCBxgWZ2gcreR5IUVrlqgDkBGloSQTtKKmCSOjKUSgpvDkrVfymsHCsVajlJuIrZD1Sw5LK/RNJYyoO5iu83PTV34X413jT37srdUiExS1pXujWr
+6FTjotk7NPUQV5MGp2CO9env9jNJRig547FRRmtuInuQe2l55qRjrNkUp8VCWVPmyLi68MhOrTNl+16wJdpUP9fsBOjQocMQvCO9R7Op5fp/inny0o1QAkrJ
+fApUeVnL7wvreRsat067w0eUvN1BfJKROqoXmQY5pPhcJWp8GAjxA6BZRCpPHdvaxxZepeTNmgDaXFRkRPUu5l04hn0d9seZQZHFF9NS/OLEzn3tLUZ9FNoG
+ando+SZRTfvOXoej2N5sB6IYFTSi96aTf/YpgwH9/sr+i9idyh3Z4y0vHIjiyXlx4dkCyj9FzhkbNxIQ0KYzNGA3MdLLEKGEu4jV8VI9l6rh4KVIGmDJH3Ob
+dspQFfipaq7xGNJ9tYXSbexq2GlJxUBiaHd6Oqjsn3D0TdtJN+7OYDKBMwGEZ73dwQ8xUSwwLd7DZdAm4LEkhbGEu00RWIJL48bZmpUqA26Kdqqi7D4fujWm
+S06D9V7b3d4ynaiMvl/q7UxsaGXEGgATIN/aZLYrjnywEYJHvLN4AaqYH82YDjOlVi+mjNF7+rPar/Gqrz02HKDFXglj6uVczix4h8bnZicaiEnIutxIVg3t
+5sEvCsqWh9Nldd4S4p53pO9qYh/BfPI5mi8bYonnmfTESybjjpeuIIMmaQ1iVkW+zeMimKw+KhqdhMQb5bEIlTyfDYOIijLKS9rcLcttQNx6zz6rtbtfCjZp
+uS1NH280/7yqpByEQfmodLfl/oXgqYZQQ4JolFr+Z1I4UHkIGiYYJHClzVIhxYek2gscLFMNnppztdyv0l/3y8qS+Y/FXzcVa5eMWC5MmYK9UhZKd4iTD65m
+NNhP4jnZOP7rI7UUWP63WzXMc6AhcKUqwGCMvDaVuc+CbK74HMO2TElASI1sbsiBiPeLc5KpBDuXW+7y96QPNVjmckb9HM/ab6ffqdqZt2vjfqYpZVkgG6nu
+NH3Xd0cpE3p/tRi04tyE5fFJf/nOwpoYlXZSwQQqZg3WZ+3KrgZgHKwkamENnBPkREDnpW0XVIZ4z1Z/eH2Yow+53bZagGIdq47utJ2MSUiJSNYXym2jnUR4
+Ric2fVDAl3iYnQlnHzQLNafs+0jkZfJmdbizdd/3WM5fdNbYgper0c6uQRRU4d1rYVvPl2o1fT24TzfdQbWvfhmC4TLIKWDjiZWwoSSd0VwzkaQxy26P3EZ+
+NjUMhqVmZxhZYnuPP+6c8l9bzQyKC+oL3TWGnX+Z3dLTM2uJ2W39LfLtk+qFmcHvTX0btOPyBQaRxQyiEq7J29CYl02+Tk0CWuOPj7qO+WOK5zV2DLuXh5Z2
+xbsUxeiP2qPFrU2n29OFpAfXLxTQXMY8CGq3wvdQ17wAmKvRk+NtJnIRMTAYTealkhOSsZ77XEgCGMm0piL/eOFwLwwB9+lH+A7/k4KK673Tuwtf28rPTbF6
+ZxXvvB6ylGI/x2HCzMrfUThR+cENCE0jqny2ZmmyasaYQE57GKA4OwqH7HZ//2i0BXLyiULrbSFUZHHL1f6KngA/o3jRgqtNU1VZEkYFxfNM2wbf4eSOK0m9
+7M3cplF676S8O90W5az1bBQhUfaSf8zoiUCF0BlT+V5UVeOpK9+UYleIaLQkbublIWHYNgvf4aX03QHXVBTlft30lnZWfjoyhX1wHsZGTmyxMMoRWL7Jk3Hn
+ENnq/sVsURYvrqGQ5YR9sJEIGUVP35i0fXzkijIAmbF8FI4HD2i1uQFRfgUp4KmuB8mPAfu6grTWvtvVvIqAJZs+2pHV88z71XjmZqxqOnfO277nFeHOYmsX
+LImiIhhSXTzLFQMRzql8cDIDz8DocEFhgUW0BuVNs0JK0Vyttu5lSgW/k34v5HgVNHtQZ6+ud2UXsWRLoKrSk6PaBzsydmphJUNfnr3E347aSFDJqGcNYWRr
+5yWEFgFJNZ00ZWhpQW2l2sBDQpTmD4AsBgi9u1marrWTJ/NPpdTwnk5pn34y/VxQMPj36T9XD3F3WG93OZzugyutKQ/XXNQzUln22IA+0dUuEzdecrTdC0BX
+9EcgUOe7oafrXO6HyvqqHreLGugD47FL8Ys19/vstC+4OJs2ISv7M6qP4avtYpmEEwOrBN7TIjmQA7KbTU2ZHQDpK21TBLUWjqyx6tlNdtdIM9HQJDlz64j6
+l1gxV/xF54f+pI9WfNEy5kOrzGCe9JUMyXrxSF68D51U+1MdvyRINJkl4M/UFZoNsU7VAa0pI/T6HUaPc0ASXEM2soMObdnGXW9Jb0vflX7QzxfxPYU1v6gU
+/Gtl8vIG6tOkDW82qlvvW3b7o/66mKdRUqJ26++1k/Ho3bNBMCN83BsOl0s4240MG3MCFR0GyRQ7jIoJBNFtsmX5dfOJQvGMQzcXekSJXYKNVD0SKBV2df3R
+9MP5YT+Mix480+ixWs5P1/y5BahVKVKIWW5CttyRxtvjnWXNZforWJV1s4rxlAoE+21zp1ep5trFoWT2XPsXmBQWqFkb/K0yuHZM7/eMU8kblmzTl2xM03JM
+Mq5p+60OSlJK6paKRPGLjIuMEmZ1WOEMqb3Gn2Ot4qDiZVVDjR1dtOSwtfKTnxkCyt9OLzDYEofspW3gRjX6ODaUdgxB6svYc6Irw+d0Gk9BdmJQDJVSbFhA
+4jzo+VDx5JGT/pY56T8YFD6lRvfEMd64lr90JIyTORP7/lZfGtugWlLChTGncKeX46HiSEnPaHKXARBDfFnGVs61Ytl+U8aifKJeA1+m3ySoKju0VscN17I7
+KNGygnT8r0jSaEtzWcvcBZQgyi2jmC6ELAQRSj2IZqo34ZyKcRMWfwlgAP5U1fiRDw/E2l+TtC9GWv7D6RQ1YqznhntQoO0OyQjW2q8XVa6A23AqnmLL3LKn
+XfxfFJInd31DBLNVvoXWd0j1F3NWWBLCoa8EmN+Nr17m+trV78dpvNzfUhuI2jGAcT9XvMBeqrW5lrCu9NrkgFyfLGoUDKU13CynVM9xQSLrLCdwGQTruBl6
+fEPEAZe5+TAz1wiyoUo+LPj99UodnjJJoHvgg3T1AucUqbKxwWrwW7Mqis1IiIGPZq8+4/KaVkj2HSm0na7MYBVzby4ygQAO+ZUBaskLgRoy67LpatEtTGZu
+uH7LfL725LmMjOyBif3CDkrSzaQ9Z2XFw9qcJjfbjU9a9sApeGNPvMbbpzLeuRJbw2q3OhK6SHVXqe3WV8sFtrucGr2ZJg9Yxv0nCdNfpr9+6pj3oaf04E2l
+9u8w2vkdxHKjDtEiSOS3HpUCLTSPrt/lSy2luOwpBZxpbaZIu0rLy6Jih2BHdCsLKe68t5AG+/Vhzo0I05vY4kAIFRtrnBvqeLoNui+zslC7w1vjr8HagA2V
+m0iuhkff9Sm8jVHHIJUtOyyr4oX0J3EpuV6aXRn3Jfk1DYlUJAKZ0KwcpotWKmmt1Qq7svxoRr78YK6XGNZY8XG8v3JvfebSbLB9ykXeccGoXuiDRhkzbtau
+dKbIWaxgjrWLNRqq9OYmNpOemMJb8k80IrPZ3cnsniJXwLFTnR+tKd50HXV0rpNjgGnRm1/pyuLF8lJV+doOhECyHDH6NZL2jluEgsouv8NBWH7jgFyDXvoK
+kP/qBCmnG+VO+78+pOYPzA1rPuw2vb/vgBz1INyr1Mu0nQaPqM9apB6glCxqrlJTfK4Tgaw1UTjppT+q5wvVX1xugLLaFVvCy8p0BVEanB8S65wmMDIoZQ+T
+ZmB5DsgYY+ddBu+LHNO70/e0IzRdq5Vx5lppYgMOQpAPJksNQbyqHfMbGv75yln25V5PKzL9HZ3uwpyosck5nm46hoa78NzEa8TSERlDqTdyzAlBgVIAYeJQ
+QiYVDARUPuGaSH5Dw//35thp9qxZKaqn1faZnfzmjtPhWdM4O+4oZBJUMFOh2eKie+9vfJi9z2whVJM0Q1MfLddZWPY/kj6bvthfJ5O5aPH3kF2y5KhbnIPw
+21oC3UmNoHzjRlwAX/6iQkGUU6bYhbLo7c2gl/tgyie6S0spgPinOHlVvtPyhd+zKs9nmYEp7Rc0zbpkQqO4vKE97ys/nPqxnpyO24uCxg1YkxhKC4SSnpch
+1HXD74nHeAWw8vuhyZHaujH03C8+jYdxFInhkcdx5T4u+O7XqBiNfWJxA3HZTdbR3weD7Hczyxt6/UzJBTzrEIO/2q7Dmwgn8grzVgiJZEzPAvpop7JKXcNm
+Q3a+O6AbLOu4ti555YgSnhoMwoA5dnjqQ6RLSLffTBgUb8hcqrSKi5M7+6uhp3brpGBhYSogwWKXIydBeSD58j2+4VIB39sa51AolGZHVDwkvWHNbR6AXxla
+wfG4afHc3WJZ0sbDsQn32dXiRnUgpnF3aK53rAxaO8vTGvvOrhF+FkzCGF6SghIZk5kOAC4+JNTYyHM7D2fyHaCtVLIBhgQzEFPrsNRZeluCxfpdKjhIrv+3
+lHmoXF9zrzTRrxT2L6Lg4GiVXr/hsB0SDKkqnlVdrOJumIhDMpdJEGQzVBGHCi4q3/FdjEAAlBcTin2NKOodmaZO9dSrktskGAHjxLhiqaSlUfng2GfKWsk6
+w8rBokRwg95jbfihbpWKNFpjb1/xfLohwi7zutc83Dvz5Xt62xG5k0W3ALMf1IvbBKsc7sqIm84r98Pqa6B+Y7Sp5R78UMlF2hrMqzb1tJuOekBOLqLV8xPe
+PP6WnHizLO0qJya0sEFG4bX6qiZhG9Aq5gSh0k+LVzqdrU84pu+XviX24m1HVXzFyD7KYEzooLx+ua4cnRX6kaCdC1+8DQ7drM2PcyfPL6Vf886zrZHkGK5a
+SCgm8Z3bXNgjh0jf0TMQ1LzwzMeEg85mWj1JsMwEkJlcNtMEQCB81ABWFgsDJWkSVJRrrae8M02qzfEp7hL8otZVJY9zHKt269Fap28fv5FxkPuWx017Yx6S
+gpfKsIEuWCDD7GlFXc0oIDh/fTdMwEj6Dl4rf13yvfyqsw6VsfZ+kWWipobnWO77aW9yQVOODJTHU+V54k29BUS+P8nvphIbPnDcyEYp2X18Lq5toky5AfBl
+C6jlKM2I/IQRznL0IKLnHYAVNd8b7qdzvk/Gxqcgy123TDvbtbO+/eLbtl/eaLfAwPcFgLQPy/AiIJhCbQsayJ7N+qUpgZS3pWaju9PgT7VdN9a7UHVDr6/Z
+qa7VTciAfBtrdjJKLr2NWrsE0GFcWLbWSS9NAkAxp3Rl5QL0fHySUf699M/Tn4bep0PMnR4CtkiqnV3b5+QkjZ5KXSZPb61vr8+9HoTg7gIpn5+2cvwu86iy
+Sux1TmXomeaVzdmLZ+gsWyGFWuYrkyVZkTyh+rKvfzulq+doutwLltIrPtLU419CMfQ+PG/Ww5murAIQQJRYPD2gqb/99vPMWlDeb59TSumt4Tx+WffAe7uu
+xdqXuWUGUz57y96/subvmSF4ifeJX0tJFGa5yw+L5U4ljxDA1foBa3o3o1Hyh/EaV3CsCsvTENuBcj9/I/1p+pv039P/Euyg9wNXmjgzUEGSmZd6wFvrWq5f
+OUeSbPA755k7OofpceW1LK/F7+vrIudHAGQ0UoqKWGicgmivbALoITyr+uwEMCvrSeWT8tgqA8E5YsfYtJ5xvf0Mekbn8hU22nnR1/2Yzr0g/f8gpWIbjvpv
+Cs/ds/dQaredDh1L18o3BJI0ehKhxm/bkro+brOaLB6zJBiJSRmdxIM/muMgXyEfOk0UwH4j689Bl21x5YeV6k8102KPBtm0ru3R6ny+nN7DUavnWF1Qe3SS
+y6mAL6Kwp5SW55RFbLXCBxBhA/AgHMxVyjO95Dnl72S8h6mXCdecF0H0z20gltQemooM5d8948BCrmcVw9ZtbhxoyA0kBASbhMGvbuKDslo+pDztq32bd+E1
+644SrjiLfvRDAIKr3Ic261USAsWkIrrM7ZDOlW7i4spXxSbUNr6cgH/rMrdS+DF/tFEG6hhdGTF38Xvj9tgOKju7JH8a2i/hnOwGDAmuPM6qGIjQ9M1Orqt3
+Sj+eviLzb9oLpujc0C2f9n1LzUJpQVyLyeoR/MUGkWbI6Uw5cfpG9mKFeGIV/JgBcjkzeKqzA6hn6rGv/oFflMRYrPmJN1vqyv+J1RcqyqOqEYm6gvl44WSI
+N6aZgIAQ1SMkjNh/pv+p3cn8nzonbXVZ4CKDp3gpygjI6FhVGTKeqe3Aw0y9CCoi1oJ9eLV+WUkrAqlbBp7Dtza4HFkfnwws/D/LGcRfk16lXS35rmB25G+b
+4jbr9mpuX2WZrOPyO+WVAQlF31YeaMDy5fEHZ27SiK9cQgVFBmtwsnNMKaVbn4NpZRZqjP+b6evpG+mfykxMYRYCailgL6ZHZ0JQO5OfAZNXnaelMC7irLda
+QwXTku6n5cyIQX0KrQ5o1jLBgwCI02zK62F2+GvEqHFiPJS3aZd+VDLni0ry6fjaIF/aC1KzFqXYnqbG31S5GY5b7Wo8qRqqdy4plXWAfubyHVGTnok0JwJN
+C0t9Kj8yQ+BWa7uWWLMgjvFvpR+1OPGxUb421C/2rlCx63vJfZBXBqi8U65QpVpvZalmuDS4JolluPCIj01pG86BD3uv0U+FPsRvKMPQn6Y/T3+V/ib9Fxm5
+cA1N1lSykEwZg7z/ScFeVhw4nJ5mCdZXfnnt2XIPKP63O7fJ8lqXdgSAx9/WtbXi6zq4TOPq7McQPo+NhJUOUw3IW31+X2XlOdDqoXUdmF7Ev/CYpGsmaA8a
+pbo9bYLM+lRJ9CeRsBn3x8vTyj/k3eFxAm1liYAEWeMJQFaaJtONWHi6Z8R67GwSAPc3OTuFvLtMlDaqcHNlDQLlWOP5ervb2zemlzWGMF1lQSSbks+vsMUt
+uJg/VsYAzkk1AvQasW6PGsqyHb5drLB9aHvar67MGxOP6b5fvpfJ2uMtvY4iMEFNdavnatGWjXhcD7xY4Yz1LN7gOWWU9K0jnOvRZ5MbclsfaTo5ygxZn9Rf
+BVWSwG8Wz+hOf3InXor+BL//qO+rWZ/b1Rm7a+bobjlra9FsDiPvY6nVEGswTKGgiBK7t/KXX0cWrZWfOmNXIMNwvhfbXhW8K2JBfJxiB/8hd8XULtRT04na
+sd1XAXjjJ68n+d7Er4pr/wQ7KJvT26n1GOC1MJhNO2tPNsBzzqTkLkhfD+QHVwtzHi9PGNWZxpRS+s6QO32vekEfT6+5LyhrrvpBvy++tmfLrFPOI/yFcMn6
+vqsqbkxrNj1i2CZu2VxKALnMSecunpPN1Jly2J+NG+n5NlHYb75/TouVivoh2LKX0q3G2ZZVjNm2ykhVToN0rN29Pn9ZC2ATN1lYIu7V7AtrbcK6Y7Rr4+Cv
+2VCiACbIJw3Ls6zlB4K8Ml3rL50Feu97WaZtBsiewWpny59hU0P7sLNNlg69MjsNGmO1J8BfKN2HroVlr5aZyheCipuKxcD22J+p8jgBzNiTPQgOY67BBKoD
++lDiCn3vzD+NNty4l0rO511hZQirj8VWwepYb+uNNJ42IwuQNGM6+QhPVR3hSwenBOQUvOlZHA31VmbwZgWAhMh9lzYpQhDYcidw92K8nQQzzU3QKgmXOVV2
+6Mauq4AcoKJguWKji+I73PfeOhrgqKrRisGqnOJ6To3Cu1yXQLUuAbMkzTxb5Te7uRRwgvftNjTHGmbNiHa0YGNsc1I+fAIgWwt12Cy3hjiAhZoxf/r29J7Q
+dyc+uOB//6WshLxcBbeNWxzXw14Yyl0dQag8w8FvqWcDYU2HW+1SW5uQDaFzIXmFxfRNQldXTVcrtW63e1AlHOuSwDapClrS4Y8kARHjQv1Qermr532RbYX0
+y7tXfbs0BQG/uqL6XDGs6vI45wj3hMf63rH60scV57F8t52QbGMnTVV5g6nkES0PbT+ErRVh1CuZZnSFvbrQPQV5YgzYtrKa4oltKgainuQBXCVcEXtZhq7o
+knEJT1RtJXJt23rZMFvcPTe3VD6EfPmcYAOcwEzIebAh2MnKg7BlZSQZwafl6pUxVDFrh9HIC+JI7i+uYVFfOZv0tjyw8zgz/4WzLNRM9WYgLs+sUlH7qPKx
+CW9JurEZ5ryG0I1PAUS4YNo9nI6n/ALDKJM/pyLqhopganRLqlfXjyw5pGkBHEWYcTnSisva6V2osdgvWPxV2fBrf2zlid6vHsp3HSn02jBJV5k0yKJ2q5wp
+urH9ILW9ipRokgAydNtJ0Hf1XtazxmqjkUf/Z6oOZzOGlV4D05sp6XOelRwp6JYb6RgtRa3SyYEjYKwy6jIPqb2JCwshIsyqFQEwK5hZ7yV2eOYa5Xh/bEVm
+tUIioZqvdLzH1cWq4MDmXt7oa+EMqUAxa9KfI8gsQ648OeWLcwM/w1hHgkzPO2Pp6FhEubWhr+bdqrH2SWbgN19LUR8ayG0VCKkSevsnb8mbSypsam20V9Vv
+42LNOsCsSrOLVvBiS0KUZktvCmv3nel9aZ9eDRZ/fd0uerU32kmyPjZfoNS0aHf6RnFBImw461OqyUPqdIHlxlQbX1Uw2XbW+7HShb88sCQ4qgyeAtAYa2lq
+J633vgQxdN0Hb7W231vCYEgY7KgctOH8GuQuqFBHE9DEGtuX0q+0mRjFXW/binfEbq41KLYVtgo0W7KFvcgxguq+1ZUlD1mNPBkNsABWVy9WKOEFfc0eVjnD
+2pGanvm8lexfrblIdvonQn5L4qOSbfjn6V9pjKicmlHtZq+RU4gHysljeQUhMPb9ygDh1p0u7wrPbwIMXFzsIKlW/LGZUGctV0C+eq6DbP+sqEzPG55D1Mzm
+rjyt/OSKS9DWB4J6mNkORC8BrU2+HQAND4ahgH4gfdEzEL+dfrf3XG4DuXO2Nbu6B7eVfi2SLPdfFwaZtXDT/RlyXug+AxhbwsE24UO64N10oCAIC659YEh2
+vTn4zdYt+nc9y/VLVaVXmesjO4ikVlcDNBumBel3j3in5SOoLYIZGmEX+UjdNlOBUQTp5Soeawy5+b1EK7WJt3Fts0TWH/f6rmXZlXFO+stO6nI/bYzFMV+O
+Uvab7a2676ZjHH05LR8ftmH2FZudDQVGIBtnbQYsBVg/YqvJ8Extzn1X7eZMg+mKTo97tlXoxCT0GxaVSe0GN4gQstTummcrZTtuH/Vhp2w+hL513cEVuG8F
+ydWz6aUQO+9CPVt1U+WfEJysJNPqK5UUfLvbbla2tEFUqAaHIDByanJEvHafJ26J9UGU9urXJQI+pk2WMdivt6QPBOSicdr/qu/QAy/Q4zYbo4/GihwuSrtk
+Z8Pu9fKftklNdocAV2zuhT2KM+mdNa2KOenYwHKJzT59S3Ov7rSSKOjzz1bFkKWPtw/8f/yEWSKywjXzhZ0ZvFffmxQ8VM3pMGSFU+2p2Y+WTpc3hxVJ1mE9
+cEhzFgtFMoE57ElxbWNt+i2MvrDqzJof8A31Atw2717AEnP3u6fHnnmDl/gFshhsUbg7ydYt88eb42JZXDBcDtFVLgDxALB8COd/CvuFJHO2bsuDZGWbPQNr
+P8aOO/ElR+38ZqyfmjdqiIu1lJASHpWG1u06wKfTQRSkS+c77VdshCcJl4X3+MDAHZARqjmMBQcgqmc9e++ufDKjrbIKJLOyRBuLfCJ9MaKbTP/koJbRVezr
+FPHMSDVQDQwXU8cc2YsZFlFeOwXMXIvjcQbV1jOpNMRnHkg7LQoTw2pA+PYLMvO5cuakAqZ7SOgp5xAzPku7BbaLz/y1iFg9QE5uXIoynoXTTk66NvAK0TDw
+8faQItCI7KxrQn8PiDdoUpRtBIGX46+/3VfZTE11kkaPJ8Rcp9vFjW/jT32PUBuuFVNU39wvNwRZfRwNSpZ1bldCjgC1ZTGXOjd3pujZvilgBKzGbfkP4d4W
+z2ZUPemwtk9O+blzpq/daeONoE/L+jDoo20kTCR71qjB5MbbvU/q5AwABHAGrb+WVzEbjRhXUow5OCZ9oh//Ocfb/DZz6RamIVeya0kqKqPFqb6hvqWG4Fon
+OLES6KoLOJ523aR06XOkhvBUdMYsN5aJX+YVcg51SLutwfBpA3Glv6gIpebTTK2HmKnmkwBS23/7Ekc733DumX9laiqTVO95Qkbpc2PgUr9g6rZoqWmcD2A0
+8mGTHR1NCpitZeMvy5lrtAjFspH6iBeS3GIsIXdvG7SrQrkEyHqLnLV5rkRVqsqOqoUAIiGcDLwEAISxdve+dGJv9Ee0rv+L6e+7xsEfxRq2kaPoVGgPaDbK
+qi5TNxkZiXijFRC3XxKnXvFXwXtcyxDmuIAcQ+jUanWWoOb9lC2DjRaWtYS+zmYEQkGOaZNoLUR1evC79NO8F/91+jeNPk9UF5uqaoHS2x9UDHXnA1aHzZaR
+fWNsUz3Foz9Ozco69ZDFsrg2jKZkYQdPmA9dZgGohXx0gK5MsYXEt15GXWN6QvFkcyuXbca6ruyHgFoe1in9mCONu9248wamtZnb1f74qBVxYDfFd2k/ZXER
+7Q9jM303x8Vu1GnTvhjMRJVSrMfJoAWwtivDhsxt8hAlWBRAMgr7L2as01Y+CiqOJ09WXuA3fjlNysb7A+mHvYrzq5rn+p3SjyRsoLYPww7dKgK7Me3tbvRA
+qe7I/AI7surmWyPbUCxb0rXke9J2JaxhDYzpRrXCpbwQEQckgTavvaxeR811vanhA6kxwR+mf2kr7NYRgIFer3N9lTRN2KCECeQRix/xXxtle75UY250hcC4
+sbHvtXpI8hIiztBVAmGgwD6I7iOxGwoD8N5vjH0IInxlIvS86Z9IP5Z+L/1R+hfpz9JfpP+R/q9YQ9Pm1+BfOrBSAbvdkZltco8VM34wbLhzJaxbvfKzcQKP
+h4AMPojRUzt3JmgaS7DNp6H0j/KCq2GYUgn4zGKujqfuayEGhNBK4eep1ar0SFHVg2oKJS/AP1oWLAICpaBfCOn16U3pLayC9h7vWPtoSppzLcmNve9HUTJn
+F3R3kIS+71D+SrVLZD1spLFU6L/NLS1NfyafSXKUOXYwFb6ZyJ38bq64fTBgFCRD8bPG4Vnx6nq5hxoo3oV8y2lnlz6Zle1ok8QFkKr5s6ZmgXpbjMgL7RZo
+xWHWmoJqC8i2ICAfNY+bOeLE4AIQyT+3GP4MoNXb+37NwylDS0A5mB3QgVc9zZPBtIS4zJKHmaXzUg4CirPDC13YvDb2IK86MnHQ54l3+pwAKFX9WblPb9GO
+x4KtOiZI6ei4sEnFwovbGfyrLetH7ba+FZX53AhXLB3Lk24xF7ebZIQzp/eshRRkKZWXtF3dtGQE0AP4IOtq/XrbPm2tRLzA9YfUVUPUIKms0A5X83+XB8e7
+1iSCFOKY4GyS/xayrw+4sdsZwRZZ6yc+a3J+jlyXy6y1vewLqJUYt8EyEzUZ4QuBI15mO1n1WJBgMoMuoOcRZ0DMIUiaKyl4zQ87htf6NpQawXoLp+1ttUx3
+R+VZOJY7Ira43qXyIxvTFxZSPZyJxEShut6yf0VtR3cBkRR6ciJlaUUMcpRB+7Vwib49vTft2EY5+2HWKh9f8nHcvMhiYuWw0hhNcxU/Wl8rNUAVPO2FVTGA
+91rJNb+DcZDMt6l4te3iprvViIy2qM2Rs+t8DS70z3d605gJY4qtWtWb9J5urj4jli2v6g2ddmNlYdwd+Hbf1AtS+1xy7wNGXWpzhEPoRRZHCJ8WT1D51lkO
+pTQnJNW4159vNaMgbbQ2YPU78emM7yNSWC9YFl0Q1VNflR8tRqv8xwkL1+rnmxJJgRCezsbQEftMm5ZU5fGVHPigg8srEX04Bsp7+BfNTe07NZrHvQ3VHfsi
+Vn+rbD0H47hnIpzTtL1i+aUr3lsPc7qw5oktzCwihKR8r7b+P6TWntXsjZtY75Zl4C1jVXaDLMDdo3ujndWcgGaHdwzUmMSZ9GyCM5+xA7h5jBvnLY0Gykc1
+2qrofYm3kkFK5eIWrUanXFkiXXTmtdw5PiZA4WeZuUelGOPe3oaaxKkrbTU1Y8gWlgqNgSZXy7ufp0zqICEAPa89r/IJnY4D0e4qUXt+bXgerMPDIimTytAT
+d0VooAdriwLsZnFXb1TEAatySLdPNHqUOwt6XmcgzTCakpkyIQBkjDeXQ+oQ67yVfe/3hTP5J6TmqHdTChzWXKdba6f20G7dFKpnx5b5k583ie3Mq9NbdNXv
+8/IjFuZi2XlyXAOQcE0gyfXLnULQPi9S/N/72LYfdKX+UKOHLhWCfxQ0iNVOmu0zMpLcd2BObjl2pxqJGVTomdELLLo1uPBDBJW2K4MyXTbW5cz3ahDEHZnE
+VQ4gfIQIbJApQ0nLSfxyARYEgGHdFl/hPd6RUtbtWPv6t7fNWsxBF/60uxmPIxNtIKFTeed01jtHABsJIREGCMvtORqml9D0OaodLF7Ad2vXlNUoOim28WiC
+htJattgrG2ltAi3CmvReCgcuudy4krjLdsIunng5nC1v5D3xrtCrUSz279n5sro2WifqbqUt5XDqrEIldAwn9aFPqZ+OC3d9DkJo/BQAG+hp8wybxbJw1TMh
+YvuSmo701jAncmZ9RuvzfaVZfagFz/xSLcS1DE+xeecJfum9KVuqIp96I3Ow3vIpJ9kiD+my51pbdoe2JG2zU344CwvTlWAn/GBKbV3ypXTnON2Ca0h750KK
+OjRxckSyc9/EPa/lcCTyQc+gEjxjk6Lmmz842HAgIFcetetlNRg0B7nW2Mk5H6tvJdf8Be1n//v1nJdR9OodncTSir+5e7GbXW4smRppbhxBv8/zlSg2+9bA
+S7bxfDmMTSmldzTz8fKKSv+nnD9O1MwFYflHuheeaimaG+znSgDphDTRs5AceiavOxxPACsb4hV7wVpUhEUWPyqAUhWsEt6iWbMV29E8vCKEVQdF/OP0Rp/D
+Ykk/1KB9U6O9c+hIocoOePIUbkT2oKg0tyRp69OQoTYlljfKe1eG2fh8Hw8d0SF7fjKHfduga6Ixb7Az1U++Hdm7H2s+Qxy+NYiMSSor5Bg1XUCEAYZm5d7B
+jrazF99Bzrh3NWu6aE5IzvV7V7kCo4Lm76W/DLzGT70x+8CuPXV+041yjpnfH9vpbw4BDr/dy0lS4bjcn7v0pPwGS8aftNMwJ3RYtk2dFKYEqlU+wmxMZqR2
+9cKSuEJzDlj+WUUm5FALDlvQfRf6225Xw4Culw2qy983sDU+l/kzb3AMUOGENObX0juScvP/cb+IUPi+xgjCGoaUzhGeeJmy8h/p2amTTxkE8hh8PoCNnpu8
++tAijiwY9ZsmjpK1ZrnlRuxGxY0bzX8DWulSKOsjr+aZsWobuRdECrKviWY0mRq+0FkJ+8tzRMCcuintdDxGZ12XOyuZszivPIPH14YWMlUYM206Z2gmU16s
+k3k2wkai8gGI0tvCNbwzKJOeunjnVz3a2TpUrRaQ2RLFwkklHTA9R1fuiO5HG+nUc+ju6C3EDWzPKTuskJfVhA1gXQAx0Clf0vO0MftHz8Opb/VNwv7wMQ7g
+S/n1jzkT8Avm1f0WikSJ8P7vw/PrLshZO7/OdC1ZxDabF1mQtglnp/AHfNJrT1/qeMeFPzIATlq+8evhrAxmFL2V8ihIXkn2p6Z3rw1ZKVPtCXLgCIDu/Bic
+koqfd5q6L7PWZFm/lYs3Vanyo+SNYidbDalqdn23vW9W4ydza67C/tpABVh5poh3G/CKo+dKk2YYywo41Y9dj43VLSVOtIxD4dhdQxLcLM7HaXQ9JfvQ1Hlv
+nEowJ6mVmZDGLNqbmJGRoCCipLUKDpUw0EACUA8xre2Y5Ao/j3pvdxq1eG9IECASdMm2ag6WpbcJrGD9+WVzKD3+8QyVLcGbga9bgMKawAc3u7ePxOWfDMxR
+Bfnx9Bi9AaG5OaggwXGvkWYkZDm0EKOJM5hw3ROpQCyp9hg/FmpDi/40wLVAnUwVy+v0hFFPtMaeb0/v4vn5fo8+k/d2lWyyzImlu+qBdesTV7IV41TrAOXQ
+ckrdh4S2Fp0QZjbFHuPaIcGnQJN9fC5V7Ayz4LNDfnnXcDR9Ovja0m0fSZi27ENVj2BntAZ9GNUQp3T3cXLeFKc6UH0vr1qRuZiwYEdBjY6y55oQgdSqFL9B
+Dz+gdbbZhgPhc84UVGLIdKu76TAeV5PIT+K20JztCoNFZLWItRr3kozAAoAgtMqKntxAWiAWbdsFZQXwaadhoFQsG5/vYx5fpKx3NVQ/onqzKzGFw8xYFGp6
+JNc8oNxDAggZc5dugxm0ko1eKeD3xz4ei5+8Umw5lD7/X/aUdQ0m0yoNNeyQ/u/IE62ZwksA3KGycGH3io560DJrWmlBqpiJTQItAciIqumY05CcbKUpjRJA
+9ZlEk+c2vVPjdIkFzZ9caPM8Pf6LLnB2iI5BS894MURPcNb0Dwz1Bj2nCxaSiwPgYmnt/bprmF1XGC1kOD2rRa5+SMNmUZccdYuu4a9QH8ScJ+OsgMBaMaA7
+HkpZARXbItdujOuvxkzijewIz4pqTk4FYWVznxi1N3XZxDaTWKY9i1vOYYZdqrZsZK/NGOLDPHUxfiQdiEghWgGytTX62hLG+O9K362erGUZPuc5BtPo3k6a
+E8nfCvpltd3blHBVBepMlx32RbG6TAuJ11h7Ni648NT0aafI3VDm4Huaqq/gVVPVpT32xGd2BJSwfN84xW4iLrC3F0sY2FIRCHAO+ngBsqHxlgIXyElJRLSU
+sLrGM1QWAQD3gy3eEtSeMXmWk/un099Xb76RCw5NONFZfJopKd8WJlTnjxgHBBJFYUeNXcn7kSFHhtTI1YLetXO6kPQhNBULXOb5izatoQSPNRNQduTdSjZA
+QslwHLN6+5k48qKraYEEEDMDFjMKLF+5HB/LWVs/bdl333jBXHX0mJ8ptqg4Y3uvWu8FUlKY6U4cm3WZO7qSl3XXixgaSMaIcHbfqyboZnqsqDV7t2R9vbw1
+pYgHMmTnpwIrYZuZjrK39sGD7dtYEF63VdEKSULMWmxVQV6bgVYsk05N7TfQUt6Q1upSarRS0/9f1sHeWXaFFehLgfnUOg1q9m3bIFC0mhOcrg6e4R23lgYq
+RQhZS3VV+YkptauQmzO4nrKoITyE5ndHZz+3LwcH7hI/AyLP0j0HCI09jLRzbLJyj9jeMLW+71GN9T7rbd2Ev68MxKGyeWQUPDMAvMgZdbfC39d2oN9U+hcB
+J1gZLLU9wOtnF485BCmeTlNw8Fmm0qKS7K0bTwByxt3Tx5oxByosE2VFHUZ3rIy/ra15xDM+9hHKYoiAcjOCocdLyOXMm31u+Q/+JymP2gNLG0jvDRbQNG9T
+bs6lnZTij421Zhj/uAlV/H1g+4pi8XPC0BeUE8lzvuqzLEYA2Hj3tzaMwpDOySDp5ae0n0OarLr608mZHL70QmxeUWJxBWpUE5oXubv0mCTLY8yUPcPb0XUp
+x/WmKUZIUjOFnCboWVm7d76sfrnmxJTzvBXb8CE+8bwSkZNB8EK8DaJM7XwpaSPYxkE7d1WixB/n1YOn3ED1ea0/qd0XMatR8vO/qtU077nNTQ6trM4mFdhl
+N7qshnCtbHdGVyAihMV4xBZTEaWvt+6MtrU8r/GQ3OY+JP4Bk2TWoiJnN5AoRqQd/y9iwPlgo0NzlH2XQ4YjsFhob2iOTBb8lk0y3lnZUaSVKCaYONckbnHd
+C3aMAEozup4JQ6pUFFyVjhikTfqAngGf0DtiLBOVtYuVw8RcxIOwSUpzzYm9bhSRigwKv8zJPLmhkm2huNS5XFPFSnGRDIAazpqo3NDwlVveoRIVyUcjSXsc
+A5yDzvemS1SLh2PcUNJoc2L5MsS5DFDo1XJNN1AsvGOArslSUu1/dWgQcR0BrLd5Jn9bNTUBozJy9eADfoJb9t0ynb6vpqdnK+6sw/80baUNhd3V+WKWgjLN
+IP824LQUl7IU3rEfOYleStv03hU78WO6GrfTqbMMqzWRKRbWFy5GMQSQSc2AlBKMgCBrtaAtxA3qjtU939RGcFkIX8SEO2Z2Fd7rH46+tZ5cp7gWp4Z98slB
+Yda7tcESBGq+UX0bvBwIPqSB1YFy6mv6jIe+EFtku3sRK3hpHX6u6QnfTsKp8eQ4S5Ho9tkyGDSXrgy6eHSBotK13ih93XJen+HSoqyyiukVHRdo1uld2qVx
+CLmLch/L2BTDMG1vX8gPZtLNNSrNuXjylI1F4oKbW3bjYDxEVfXpSvMJp26oUmq2fGAf0PHZnftRGx/fBL1/WmMYhVuzrFGvlnA3ZhlF+XeIGJcaBXOQKOeD
+5ZjkWCUP6sTsDd5eXt6AUlSVY618kxdA0rZgGTCgLsy4Jr+LET0t55vmqkXr98gtvbq2dgL/CFINT9x4zQJNZxTEXgLpd0P3Lx5dphuoTdDq6V8woVEF9Dsa
+fMv7tKvnIx7nfr7HudyOR0vQF+deObM2K9mYvLJAs6XQMvAnBIoZGhm6LMYGDjPTGXJqubESCo4dMWPD9Rq54oW/8f0dLkvZimvHhcPzI+D4UDyWYkpdOWET
+5TYMfdU3ofLz2mEh9wMdNgNIhvnWU0N9Q6tRhIFX5D4QZk58EICki2uyTktNDfb0Y2pBv5C+FDwe73IaJz82jsHNCS5Oc7Dcq+TGvTvUT8ETFh9Hx1xO91Tp
+y+p5d80MKwfY4nwxT5l0ei8s84AzqnxoMhOxOrj08MZjrF/XsqClFHjI7mOvlAU3DS8ctfQYD4n4fklOjTvrSQ0XX/lDqiXCAMPUsjXqNPIGeE7edE2AmFN5
+FvFVt9olavk5Yw2uDLBH6wtdwL+Mk5EPzqAYoz2XFscy8WI5G/maGJuUpIu67YQykkXsc/rvZWTRJ/gemZ6QsSyaLGDtMjm1RFPbKmVRS0w8QEsa3unzpmaD
+oIqAGUP2DEAV4B1qVZnmtPlQbhp5FgVJm6prAQpa4rTyqYmVXkkf97Mkmbjd5KRIgQFnioxKExM2O/zfBm2xfFNR0yK8JW4fWBLf8Cz8euDRsBjeBZQGgA6k
+jCgjBBnj0GAjK9bd2LJ+IaWp5kM7Rp8FQDmKZZ2kDr9fy7B4ud3ynzqyBTaAKDauYCUyUnaeuqX0WU7YFnW13l7HVyoAH/Ds/w9IT7P2ilZOHm6lWAcu5q5O
+Ua4cWDlmDWM1yB4idP3RCP6IVdpyqU0/p0Q9pgpUmJV+r1r9WKY91kyskY8EVreD5/IYHPpIL1dDALMfj+POAiacqUt/enfraguX91x6ohWpFw9US9O54jCk
+WenPuCLQcNa2GLPvDR0HT0WZcRVGusEPo/Y4C2Qw4hgju80ltJlR11WoTDZPblYVriXYrJLf809phLZYp/eBqZY91dB/uF3FwRiSqXg6UqBp0GcMtK1l0nqu
+IfRdsigODDlOCyxjMRNYKyI56IXUo4EKUiOiXGnQI895f/+s8v3F9NOhzlBwa3+S/uwF7ulGNutpXbCCizBXquL76AEeLV8oXuDFu08tsNczPJXJfMbgFSoC
+0RLey4UxpLniMA0/hsrOho3uGlKr2WsxzocX3rHN6j/gOXVvaVRxsVHIg5UqbMwLMtgmqRpxz0L3vMILte8IW8jiO19gHruaNTFMVcX1WA2MohfFJWHj2OHi
+dDMrSt+ixsXX3ssew0v++ftDN6z1c0m3ZZkGEdXnfdawZT1e09yfjmssdSfB05FG85wjpPVmLZiRi+oMUKDs8Z0uAZjXSpzkDUcE0OJxv9trUJ/2nGnxyoRh
+RFgc1oFl0yX6r9WtF9KEm+IBazJ8SF6xnbGl+JA0/EOKGUPDXTRnzJxWt0rGmjusNaHaofPVDjfImTQJg5pezMrx4HfMs+/byYIF4QNr8XVTQ8FC6+0v2kgh
+ZwEM3ozIH31VY9QddKuayhs0kHSWFkMbYfC9yeOD9yrKVSzAT7pKRJXsfJEmsjuxENtIiGuUtg/uTa9Fe0rOppy3WP6c8TJCYUB+CzR1esm3vbvhmjJ1gp8S
+hvqKkNrXNCEfAHcLcJBV3J8FQqBez+JO0252hsMMMPAirdW+Cm2y3sCcyheOiqKKn6QAFaK+Q1A2a8uhIGj5n2FfJtQzvZxZhWh3AhWtf2JR81SFP5YoqQ31
+7NMg1Oz8D2YkK3jNTYMVVARfw2eCJkhgSZAIkEovJ+CcFCib2U5zixFfkG71GkPPxm6MZ/c0TnlJsiCmaHc87fLpWKzWdq+fIeInQGSF0lxeIg0hEGBO5W6d
+oXzcwPPy3QyAWEYgRz1yqUOsrSUfoWaH3xDiQDlPhP/il5ex4P2pze4rs/bEQhrVfTdN9NxAYGvD0o369RusYaGddUAPQtbKu6wsXoTBKm5mhkNoSLBw5+U7
+/LYaonB0ptKyEbf8bmenl0zEFARvp5iRmDq94Knq4K52+On/Jd402MpU/5oamG9YjpqrCKxti/6/13f4V7EncvWiC+pWUoEaDoIdV4mYbvzMKxfGu0dmFSW7
+AiG3oozskqYotw94TZU3blIHQ1bbHmPyzzW9p5XR22qdcYoFrzQpVODYQwY6pu+1YclOkpXGXPIJsUY55VIJCQP1bU4tZV35IeVuCiitdYojGGg5ft9fr0sb
+TO9J39MgBpQbox81q2UdT27zRg6Z9PBihv67jrL8dWluRKZJbyCpKTCUa4TcOK9+uWyEDHOaE3HDmtc0SXjU7P69UWv9H1ecvCKuS37EctbGAbFa+zcV7SCp
+dzNasmIDWMXy1uEA5RvIGqyAMxnxW3mblDADNiCb8npOkqjGjl/8FUaK7bozOe2Nfdct3uTmzKzh3Wqjwn47nTg2NQS2eI80q9nS7BDFBJclKynaM30CgRqx
++kxkx1qLBXyzqh/GSol4ylodWr36cDQdVWz/GkNYh2wNxS8flgIglLgl6Gbp3aOsojCL4fFkpIfUholWTaGGs+o7uFvs3b4Wf1h7Ywtvd/WW14onNT3JpByl
+E6w2Fxpg73DaPdNW5O3xpoLZplZyd6/+MoZyitrwh0idXF7H2RyL7NIZ2eNBjCWVBNJzAGexPNKGE0or/LGJj15KH0s/qcoqv+n6an9smAlZvfXPMr+2PZpE
+t2p91eKCCsHr+6/EUuVHMu+BIx/8mYhoiLJNMTZAUM6eeUGaeEbe7xmDl9ok23IyC63EFOc2uIIhbpOmLvyy64ROaR+yNH3n/DXazUMUoLDM1Qo7RP3m6RgF
+8YvhVg+0pvQl1rAYs1XnhqZNxsqSedGdXx8ZsUpOdCQSZtsD1vNd3sMA6bO8l9jzq4yd7pZbccZIpaft7kLf1s3BcX0lC1mmly15hQLJVWXCcxNUZqX6EWji
+jGrWz6w4MniGpEEHS0+vSboDBNtY7vgzzSp9f4PkEAZotxex2sq458rFHKPKZ5pdu8ADdXlJbP03e4hucxU2NuUEBL4lHhrpPOzE9eyhbdARyHeF3UXAxBBC
+oLKH5u6sf4fi54wNx7qr+m7Ew2p9IQf4aVeI5Csiwxx7maH6J0OiTICgfYeYwzFhnREkhXbARjNmUkR81dlKkwNn5YMS1yl33Xg8nPog5bjdrNRPRznVy0y5
+LLqpwnKLpGEuSQ0+n1twRtyE3o7n7KXV6igARJ/kQw0XdchyNXijJp8Xsl0FuLN5xD736A5UGqbQsKCdleWOLE0zraKQDJ3vBI2NNa487BC4nbfMcRExOj95
+KXt5++RMz8HEZw9aWugzlhcL+7zaulTlJaYYCRYCLieyE9YqwJfTvw2chKcausgJzOvtiYg4/hX33661sQEAEVwB0T1mbeS2a9cOi+6uIdDssL5gbpo4dvQ9
++3dttY+OzFUtEfHIVefo6N3hkuq8bSG7quCsbfgaFtWTFKUfDGAAyGSAj2y+F8yAgwC0ypNzRUbm1O7X0lvb607+eMh5GRigogbGePGH0T3r1lBadMevbvw+
+DKlKTg0qPWRnZhVjwjDVikzLMppsNA2KUrKb0fR93HJt4Zh+IX2d0ch/mJKNJawv1y22V/aqnlnHPAV27V1IELX6+Y3Ymoa8lTl/wxdIXqITMhLZlmy0kuxU
+ekhYq7gWOignMy76XVZaYNpHRgMXPIj2NWLPU7xNrypzi+kGlZyGRfTG2cxoNFeN7uTHXsvyir72dHTsgkBqZUgX+NEIUD3MmKwv69v3+iXs0svNupcOD1Oy
+NGVnR1mUc+p4mt7pOkCPa7U1CX2uWx5a0nVL5thn3r6kh5F6zALSJyJt2MYVhhviEBVIa5rls+Z3Fg+dC6/ZRFRtzSG+J51Ukd91B6sqiHYiFQ9DhH92q1So
+hm7fVXdUDHdVwh40LFbTTeT37CF5FRcZqI7g7CxyeyGnji+VghdtuNOy1kNcWeKkPTNQGXbdmREmzvu4KIrLt9eeuigUkR+hq8jO+imSQIAO7BqcT4DXuqWw
+HDeDmQxkKnLuuqqdKa+hrMgJyiONDU7oVuNBq9BUvAKr7NVOSl2sjt1vYsUepc84zWL410a8X0EPqbzbGVzx2BGYqAdTaB5GzoFJvFubJ7umSXuoWl/yiVBA
+0RvDGv6ejifQmHs9O+k9pEdt4FH4bc1OMh51mZ68OR0VsEdZe0QttiHPGjUpSqSYpUQhD9PkKmcpNeNHNcnSxfrlfhqr/q+lf6Iq/n+Z/rrl2NpZinK7Cxyn
+2c8tc0Om072+lqswujfmPSaefpn5dQM14UlWicqamIUZqZNUX2qpy3LBWoh36h7sfkjzqe2Prz2eV9YHkNmNPGzW9/WqdthW3oOC0FBklylFRXiPZdeeXLm9
+n8ZJmG7uTkftzsyka90eDxfZIkEKGeWhtKeelSdSVa7lOQcDzMnUKVNKaROwKR9XDQ6pToxqvuWP4O2P7tK0Anbb6t+ojBiBrO2yI7jBCMGz9mrTNDDjf5FD
+z9TTyByUts5SMttNbeiSLuxY1zP3seyv1IZaDVip/7AIbOhKlmsNsq/SwOfQxlggafitSHEe0otieetfCvwhLft0o8J42jeOpqI+nsKOt6E5Kf7UTvecZhik
+JE7E4eyKTErKOETrqjVValMyHVdey13zvenDIRfV3iGWbR0tvyIDibdsCY2eutsk4GgEACKKogLtPdIsYbxZ2N4juUUh924d9tIFZswgopwVUFm18nO7TJTt
+ciA93ilA+rC9DbqGN6Eh2/CJFvok4Ge+igjhnCCXoWoObGbd5OYU3NBz8+GwVF3SkFBde703L3fcH59iuyY4BtEE/Z3qC5g/YHbebF3j2WmM78nFgAqvq1O1
+hj6ZTV5Ma+StXCPolusRdIYwq2REvv6cWKQyBFBFWqm4tiayAl+Rpo6JuIYm5USeoLc38/ORhic7KjULVzamv0j/Nv1HiR732iyla/jYA64PkfftND4VsFUO
+0vuF6/UYaUVxt2xjUBM1XQJ3wWorJQYYDVVhLYdyy8/Rc7yAno2PltgicixtvYe0xVGbKvIfVX8m2kVhIq4oMGOUEkPx5LiTk4Z8tsnbefnLamUJ/iGBd8qn
+GRUPSNCrg6+jqtXlBQD189WS6qme0yIo62JTOSApIj4AkvLl2Fot6hhRBeUHAiPMzzEOoWDSPXIVGszxCdHobntiM80pveKh3Bu6xdDZsjIZiSiR0n1Aow7i
+xa8qh4gYEQESAZdTTUgEM6hWh3V8qMtIZ2xDVW23tjK+wWzWVl8bD1ksVOM+6zKUOL/pUVNwyc41l8bYe54bux6jv/1xywmim9DjHFkdnXCYAz10m48MHw3l
+VPaMcKGs0jAHUJlVL7IO/FuyKIYirnJ/95pUf0uZl0uOw7S1lx3PtVAqSMtTtWrTaJ00ulGXeZDj4yR8ksM/coC1kcIZhhiPAGbAzZoC8vMVql829aGVrcbH
+ACRduBjgVfyU+h6H7E7CGxrt/h8Mq+bbU+5fNFT1pNuPivR7G1WKnZ1P0eYP6EedJ+xq72/WLvGKB/RO48owZdgLd06fyiM8jUePpjeC6plpI+YBIQPMcIFA
+mFt5BJA8hHVgVnSNPdglXiFwzJc+h9cCV4VgAuVAqfTjx9rFU/0fv7kj3/lt3xXmsUWSZOQcF6wFw1mzIlY1dpqaYhQFdLWpEsFobLWQgUJrGOgifaOPq+S2
+XlUbJ+xZ2kO90y43Ox0nyWZvex4XG/4HGdb0jK3bfXk6vsTPN3gmDMqVZSDVlyjbSwCBZdOpNoHTbdSEBzToxfJOSY2t+qwvh36UYK/LMrt5ynHGQ1RyHl6o
+Lly83bHAj97JceLjrsvFrqu2lFubKOQlRNNe4khPxMLi7BJgVCgaPaRc12isJ6L3F38ifZU9Ikx/mf4m/adqd3rK1u1127OpYbFlOGvf4/GkyETR8ROq1ynA
+QaMFf9ZMdjnXMOgxi9GmYK009SNzkiutl9aotAUHQJXGs3M3XzjYrUtl0e8WygZzmPwWyy0oP8ls/5qwNWvGrIl5nFGuwQXvajgrDlWjJSiZkLAqjeISOjYn
+z18mVntV35FqxMOPTcKaC5QHAcJDUuYwZ/6dZfdYcqfdP+Jb/1r6Gmtf/T4r9v+l7J9b8ZsrLKpN4k+HcU3yq0dWHWT+HvUtBSl5H8ToS6jDrh4Ja65Lzq/B
+QZYO90Oaq1zd4HxOs0Y9bEr9kctXWFkzfVu6xSLr0XlD6NFplfk8c3PsW/y8N8WF+m3prB8Svq+2HQMqycBQN86cmnMiBmgOzONXBrAzozskpHLU29V3d3pf
+n7eO8Jua6ayeiv67D1r5+9VaQWDyGYs6+Kj1AudU5DnXE1mxn4Y3QWycXYJsHh4qUsMf+sUqTKz8Ju2VRwCguPffwTv/5xRRKDXgwDKvrYU7tpU1/HRD2xbp
+HRV3mvym31Qyu74eovdGMJIY8LAQdOpndlFBUGIyO2CSylISKS/y262ab2l1TWuYdr3meWtd//tDVvunVRHva1U1urnfh66ap9WC6TC2pW1BRPXO617p3YwZ
+QK1gy0ghhyaawApkkbJpSEp8rZv0Az9mxKp4l1Mtaz9XOiUTjPf/DOMaKL1LH+LucstR+tjLQDaXE935dHTk4HF7krFHMgAAk+sfElgWiiASWFVHfCXt/ZyM
+SkCjFkpv7GL+Kb1a2Rsmy0DxqjuJOb8UYRhzpJglGdxkkofmOIyBwWGwXtgZMzXEDZUZbgYEaSaI8o5S4IGetoFIk0pAgyf15I1AnXa05DOE5cDr8IG73ldn
+sa5jC8JoRFKtXDVOFz2Yxl3cOEkX4HMCM6g9rOJBmZ/UZ5FuSpIKVUapSZ0TAuBAPjeOMTLTpucQb270uej7Sz6tmehfkB174wraIqZwqnfxYD08vVLjIbA/
+LPyVZVusgRq18eLMd45XaUPuerZ2DDQNyvIuQD1rw1td42Cgxmex5lg2Vm/rctU/2qnhluxgyVH8afrz9B9k9VsO1nNcu0bOuaLIDtuj33PzjgVdeAzSUr0K
+XCvFcTQJjj1nK47bezeR5ZwfVJx0xgo7AXJ5G52FkvcBsJg2AuCR3zYz2MNPdqwq7GedVCtzBIan5y50uwID0o9I7XnwvemzvLMk5/oHjBv+C827Vm5lCQFs
+gg4nLyqfpuUb47fvQ6bgaLwNx8NrgwDqr+I5jb+q2n+orYH2IgJY7sCSZT2yc24PjiWJIzbeBPI+7FLbsEmmPWEcJe9nvykyXAkDyO/67B2CS8GFd5+rvTnb
+o61HxZndPil7chiVC63MutMGGSJVtYn4outLs7Mo9vmU2YVh2HewLg6yyE0e+ODGejXtP6TZcf8IEDmH3qIYfEFViRKv85WrI21g0grHqA7nfkXtR460WyMO
+27HukTKv4gxDekgwIw6ygVC2yzmk7IFQK0xl/Poy5Ya/Mzbx8AFoRBozofKI9LoXhVlx0vrhjyhiklvGWr3E24OJBNrfbB8nRmoHQmQ+qu8ENLXRIADN9S+n
+U1bRkVCUyIYv6qla9d/AF5+TjikmI3qtgFJXsOjAdUTvhZCiae4z7qgFDrIZuS1Xa5et52zsX0Qv59mKzclRNNk5sLTjCrVk6o8cFZzOakDBwuMlb93RO2Ut
+r/v3tXbyu0E3VVG7ht7ukyx25JRbtyZSZcR1WhK1I6UYxnYy9pL6RYQ5AUYqZ9QzA8M6Dv21VLY01fuMJlkIM4WGCyATJOxabLwzTebKT5qO4+wrQUc77WN8
+qDMx8QqZmBi7USNgJ61Dy47uc/DRoqm500sNsl+m5bjNEauNWDIgqGo2fMU4qzgmtlCgWfPFc5d+oXC2BCzSTM71S6ZVL39bvrcd747Sj/bn6a/rXFxDE0ck
+cZmZ8dQk4nY9O2BDKcCn6iS9w+GcvWv1mNQKKmIUvXAgn7NX2LK8UKFoRv+HQCY6ilewVN5uu3Kcon2CNj0Vbcuu4cApHp4w0Y27ymZtqNHxqP3ebEHWVfdv
+tYR0dBLum5DKHWQWwCH8gzTj+zWzYZ11Ch6SaxsZlFT2UE6iyRPgpjlQxei+0bVSaykf1u7oH1TeNs9g77VPOpQZhC/CEo1T4Fx4Qg9MPh2b41Os67n2uZeq
+MiHSOeWksjLBKeCtkuaEduhrrz84OBfgDCD0U4temBwkfNp8q1gM69+s97lTEaqq0wKdGD32PkihWXGajiU+6MLYulrPaXe6u7wIlIQnPhDKQOxl/T6hLgmF
+eFkWYq6rHUthepBDy8yNM0lGquJ+VcR1sWG80fc5A5Cz3t6wbZhW0/nHBXXIbeg/jzGcehUNu6R0Wki8RWQ3Hxkb2HgUfsrmtNz3TiJi3ejlkc2tCPSTrzTx
+nDBkxpyjdYNFLPLh5EC+wxKPfNQ+/ONruR6YtQ21rd5sUDrUWwSyFhysOy+mnBbgY3Zxe/CxavVgEz04J3WM24Wl8RfVo+B4dVOrCKddRwIx+R+2cf6+5Xun
+Ft2ylsoYZCWL9gySyDWx7CpABntRPs2wrKO3kVN95P6Fc9dM0Oz970s/qvWHwvqU8qlKE4gj1YLLzAqwX3/KLUOUlPEq8UKZCCH676F1woeI3cFkUs9s+HMV
+LOKDUXY4g6fktBRvHwa5+cKik0ngd8Y4pL6SY+22jq0oaEKpw35KFVwid97vaZfuX6R/W6Mhbg8LqatOx+cpmKa99GHEHxnvu0yGgPjltUqzJJAVV6XkR0a+
+t5fATo2KBjXPG/alnBQNbCCoalJ63FNYXnb7qK+YUafLXLK5nwnafNyvVHvVxNNilEblOdDYc5FGFOkMKdmcNs2+WmnUsYAso6gpGrbZU6GMMRT/BzxLOCt/
+XHSdhuqJ0JmX32BOaeaZlp3VWJqqnfYyczdJn+YPhnNEvIyvp3+s6814Ew5bL+9q3HIvZUDX4zB43WNo415oPQjy8oqOebWJLZKl7xEf0kCKeF8jKEXQUnHy
+lZZBeUeLm2LsSgTg/K2o/N49mCeuoWLtDGgW/NGq+FByOWWfao9rWCsX+jTrwvHDV3o6T9yG3a6ZHLB0kztrG9J+zjnst7J6+M7zNaOdTc+LJceZvw1oafTy
+s6G/UykMDcqEgXhYKGTQXbqlPuCvuGoL+2saA1viqiaXLfCb1GsVgWKroO3aDbdb3XRC1VFOxei12qSQbDDL0JgLXk7ssgbcXJwTiybNCHNVQCTgZgiCUERa
+RCtoT0h+j6WsM4QfSA0e4xW15CVD8AfpTxLKuX4fYrvbZuXojipHWlM7DEunz9bbU2n7zhIqXlo7SgBvBrJdR7AGPOBwZoa4jqjROUd+lyTRZEUBxnP/QVme
+5VS0NUW+pjL0vm7Jj/2a5lV+j2ftL9K/KzjqHKz0vfk7Y6eiFcAGu3G6jFeoDlLNKoyPBE5joMphb8IGgP3hk1OAKJCHk33OuXWTsM0uZBesqjAFUuh6TvMi
+rkIkgDQ1OplLLknTORE/6zcU1R+9jH+f/lv6P9P/Sv9P1QeM2Ygn9+ieONhgQXK2dib2FfHpjay5/FLXGBFY7DMVNY8agtsP5uNpd/SWyUrhe7HxVwr8M1EA
+yVrneKWUF/Mx83dz0tlnP+6Mrg6cHxVttrJsrBSs2RTdApA6P6XEeqKK9csVnV3SfvdR1bk1pwrZshTrNbTW4Up5kwW6K9pSg0DpXlJziiUfSFlTgxWhpeL/
+EZllhLCL/QE5qStTgTeIgHqEW4wAuJqHtR6pn+Da9tcC39OfOoq9JWoJtpNzidtj4IVQnZjHMUmjYR12HFl3x3V5zj8uXdnK8UIUj5A1aY1Kse4Ht+RoXRMy
+PMQ3lqP63GEGB5irXEXdDOVZi+WWrOw3FSn4r9Nflwppy0ffAj3GYyg1bJVxN9JojZfAzMeDLT3Jdj8K7d5ZN3Q5qDoY94UsIyl76UqbGgCaZgkZwIBAHGt+
+igAc0lH9Zbpf+/41afdLbb6ucCu9M31fYL+ovMzbnaqUyN7cNz3hXGFmGOFClmB/itIEyg64vdOTfSNDkCqm+L6D9bV2NELGaDAkg8DUaCoNBJRFham2yqop
+JLKsvpzOLwWerO9ia/Qv0r9O/6b2+ld1Pm35Z8dme9+uIlsgHfq9JsF1wcgvbDVvVO9m30CQN67LvHH7i7GKvVgqbVG38mSgVykBVcSh17SpD9S2Qv2fKumU
+Qyub+F7wCYaQX8vufp3Rlv9MVs1R/5kNY+CBm3MpGT1Zy0qwO9sbDUmDprGiEvhdCLygErFrbKXd2U312nEKgJvEFWM0Jwfn6svoUtPmG1pspyp7RdGB8jvY
+nYmfCl12v5q+qj6LKefsauNolQQ6rOLTqrfX6F0uZSH3ITEa80Wjs49QzOm2FlrQBw9CPY10RsjoERqgkwPNCStywCuwKN8EFtHStCnhwva80nE2OIqfawXc
+OKGMFB1uX8+tDl66ny6g+BudoeSuaSSimCEA+b1XlWgDvjesl0n3DfVA/jc1teRPNvxfF5GmPZjL4Fu7S+jTBS3DpLT+AI40bfZ8xJy2CeAsolHRkZ/b5HcH
+PNUCc1W3S29qaoTHZaZbaz4md7adtrmbg30YZz/W9fusi3eW9s/BWk0GlTlZZxqp7TGNU+JD9zG2d7mrYXyoi+vKGfLvvD9We4U1q6361dNh2+zZBrHYHyAB
+fd96Ho+CiMQY3slSmCW5X+w51rLG0DeV1Sk4r9DEyUMDCazascJF3ETR0fFYo45zJ7jyT7xr5TT5WcXpOk9hdXzFPRt3RoNaoCYvINl50HKjV1GYeQeRiDcN
++xcZ6Xwl+qKcKuBg8GO49ixyrHBRX7aNBaI+4nvV9/qRJq+r6M9K09hykzYqzcdtZGluyOqPtcmpLxgJB5wXCSXxyDYfBntKDwnmBBmQcAZxGwLxPAAO1qA5
+IGdGvHLElPVk6RL/kT6fXVQVWp2YJp+d5SRQaPoUKoFZadGn7fFGc66LpjR1qYdy+2bvNwNevTOQ9DF1/Gv24KjaBHe9tZ6z3B5Ie09a7b19f/qQqrqAo88F
+0+lx8L6WvA9NW5O15IRyZw1y23qnnvNc0+CIt6YU22KwGEysYnTmVlI1f94zKNZRoEd2ePC2trzhYOBmNAVo+Z5xdsnN1i862ykn/A91OFeNdoN8hPswB8kP
+tJXC0/R0krHjSVCDg5QxVVVCEqrlr1S0BC6jjWlrsa3EwVxLtHmJbUpU/s6wQjImgUsf+78tfUD19WWFRMyvx/4edBxcSMQaT/dCKM/L4yK8+1gQvG3vqbdt
+l3opQQZSxpCHpOv5HGR5y6u5vAPYoyaVDQhi6fwhoCUA6HmCgbtx4lSEnlQ04cLYmyNotC9z5firikXzCk7LSX+osqnbFvjuMNNDYL1esgHX7953OnM32+Pp
+JU+AlNhvQIoo//ag491kfiC/oKLHZGDS0L0+kwjd++4jAY0jUiX65S8lTGeDqYAm8Zlrnf3Y88Lf6L45KgbtuJo5qpi0Sa1o52M1xsOEoqRTgz9qjQFZJhZY
+TifN5J5WeVoWR5bL7mfM08KvSw0xFTawkXZd3KavpK9qXPnHjILXnG5lIqnsXN7q6W2NpxilLwSW5KOn240yvbaAHh7DIO2tvWRnrSac/xg999g41WigrFY6
+zZ4oNLWHKAUk44w6TxSzIM1Z3P5OWZn+TeyB3u7dZzijyadXe/1JVTcybVvhS/jLenrZbMsXdjtsn2rrkJ7RlnWLhHM9didieriMv1NlOH7tRrGkz7TQsT1u
+aDUnwhMtIQ4FYBMPWLJxnjPBGrRT9YEqNxbV+K/EBRX34+dedoE3wBAL/bRbs8Dwsj+1GJfjWlk+AOQOkmLTJWizKN9ohOCC4p16Anfh3z6QXiOGqqHe/owQ
+cNGzBQAoyEBUcVNqck4IgObzIgEhyVkwo6o18o9B/Ut9XfFOeVx+lnsTGV/rfd7rwtGNaPRGUxyhcdGXmfZvq4pOjxatMSTibAIfzaYkyHbZgLqkuHdAvqUl
+1rQaRwUtgNDkyrPTgEPBelSjtvZrjmCQ8+8/pv+a/t9ewarsjbZcNAm72142cIxQbn8yktc+xuQnZu6gShzmhJSKlcK5GRRyiQ8JNqn51hpFThXu5mjD2H5R
+tRTy0qjp9/i7S95qoFrQBYIGnzalDy7R/SowqFhtmRopqTB9SVBb4fUkgDzNed8WtKGEVqqZsSEGXMzIlOXk9Wc9KEksDGV6ThxvSP4FW+u9STUf8ZDKj6Hw
+tau8q4YgXX+zjO3Hg4II52NvrPbZYquM03qrue7dgTuYrNlTT0GeiXXdmTUFZfkoJXqgrlQGIEUhUMauuZGdoYCypmo0INKDSiZDC0OYewGaOTmtOx9noYep
+agmUHMOvOys2n2e5Sf67lnTf+lwG7jtMlGVuO52dphfcGl5E/nb1hPPzjEVqBsAlMRxUkveqUopAIHR5qXFJcQhi1FnQ3CSFT5k78d7RNMlQAFxZ4d5kkGHt
+nXtrg5P5nGYyxV7/4/T7WkFhXIOWSoxdwZFXPPDYT+ctufySf6XcU09JdG3Zq5i8zlLpFe6sRHDabRFQ+SRwUYPTpYhz6t2ts9hqNGJRGBKdybkWwE8DqPB6
+TxZgx8gDuTpg0UHpalFSVfmCsi2IhqS6T9ujV3bv9MCzz7fy1gYgfprUFpWnp5tAU/pgjTRl2JgJiT+cKScH7wEnQ84avRRLI+m/HAtSdr7xlpMwBZVxus3r
+vJR26Xc5P/pv039K/0f6n+n/rh2sytGz9Vps4A209GgVXAgpEjv3DiKgoedY15Oz44KlwwX2l4AfF2DE5XSi2faCwBMXedNcO3xjEjW+o0UXr2HLATQDafRC
+Q2p9fpG/ptqM2CKPq/1/u/IFmeJyw+ARaVV5JsveCZ2GATLfFBash/V0f+qaLMhbDQdT2hMif8uOykRs9JuSWMpO8ST2y7m959rUhyafhoEKHVpbdFDW+jI+
+y6v9FvtMBZ/2F70933Yp9hUz3lN7tLSmG840C2jvrmbXoin3smRjzlHi+zY2k3RkshWDCuwswVnmF81YRXtucxEMedOw6dAJk/dGi6mNOB3Nqtcz8W3p7el7
+0t9OnwiMn3+gNQ32GnaNrP9pWgm6497KnbMUPIhj+BHG8pi6tyG1lxnLzPkXx9QgtlkEyaZUcSwM4ZtOLZy7Tn2HE9eNWI23+xHBppPqei93fHZMcrV3702Q
+flL1nr+R/ql37v8Vs1j9v9J/T/+zWkCdOPM01XLF09PVRg6Nx357qmmdY5uyeEJaNLLc8782VBTrF2xd1qPLaSzRJIUlkX6+BATKvMjPGGpI0Y+vGvOJf6X0
+0nQlud7exXC//m3qrtJ79U3OF/1Lnvv/0MXdh7GnoBaopUGAKtPvSfhCYiNK7b3aVSljL7bbKzm0bd87WqGyZA2EtTer0XWDOTnxISBIY3p5VWtyz80S5BTm
+aU4VbUmYK0Q6rHGeabM7ksE/k9Su5T/HJldf0UCxgv1roZ6rgF9tUVGt0CbEHI+NqFxdZctetfg5AhN4kYUeM9776pcUR8WLUvyhrlrpDp6tfXwm2Mjm1lxr
+6JKuU8QfQp6+VHFKTeozGjd8aaGRYuul7Zj2wq/u7EPfwCYLTKamykT7514/SFOJtUl69pKD3GrNY3F+UD96gd9PZMiqpCSTOGtQNQSR7OdVsq9OCIG2G+he
+i3WM19KPcrXytzRDW7QZzNpZ1sLJWqQMrvWdNlshSXvtppatprQ+nDKs/WCuwKjWUd3jZ9ujgvC7EtC+UT4VRlqYkxy6jjSLrC1AhBvJd51Tw8jBJlAAMFlC
+hSA0xp8UgWXzpgd1NVpkGRD5fq4Co5q6wCC4RQv81QfSD6cvqD7Db3oE+6fMkvhf2MP+/6b/n8z7vZ0wka7k4FQllfLc1W2a71vrtklgPFmm/8i3bL8oVD5j
+WuX+1QGIaKjbUVOOGt3qC/xvk9ayR16adzJG8wOG1KXEu+fx64sQaCLLJjgEuuHVsnq11Ow5dtuNu9F0/Va4KuW02VqGThGCt+o5yr8yx5vqe/JmnyU7b5HC
+KvUN1IpTmRFsGyJ0/Um2LVvfYtU+MqWcrzjjX98sdtxepkYUhF7IOu67RgPJCNXUc3ZUebz6SMIyIJD3hlEzHKvJ86vlwMwAsBhLObPKWIRAZtl1Eq9bPPZx
+tWNnzGEsp10ZSbb2t25x8czPfv3+KlidLA6AL9uGyIsayoHcjaViYtbvS5v0ffJI7xdj2tDcUprC2ghp5fZkWZC0PkQMwwpage9WvbW/p1ozjnFpSSarauCT
+h8bVDh/aWKIIKV3ahc9kosXdTgo1NQtB+S3y1ROGl15J4NyDVVWnZHZ+TqtB35Aes8ycDlobWx+UdQCVMRmqRWGvbh2mQLp640edvOslKVBOReI6mBM+4fr7
+6r2tpDnYWRFAZ83ECnMhfwuGBBlmhCYjkpPIkIBHow4SdVwYQGr0In+0XQNrq9tr/i+2tDlhfXF5q5ba6vKOBFrNGhcc05VFLvF1v86NdbLUsJUrK5LMXrGi
+MujdOFWCEJHdmJyS2whYtW/muDw+slWkOkPL43lICKB1fHsDBcMFlZ+7s7yZl4CuI10JLnvNn+oZU+fivemLuhN+tWHhbPmH+bb/b9n1thJVTu+p+57k9ESS
+dqGn7v3XB95R05z79Rfe6TfcnsM/IWp8kYC9yMnUM3V8bFdLHsiX7FC9hCs7mGZ4XVLcqL6Bq91+P98QeEU/1SDcGJXhqYhv/Q7uOR8pGTW13w8JAB813SY5
+T41D9Nj9EwIPUmLl9FKw4RCs94veyb0mol0At5SfJ5NFfeItfEgk1pcwB4t17QaWO55mDf7OHADG2xdi/E93sb2pYihL8HTYHg+PjXLNZTplT6xmqde4kogr
+5fpWvTmYGH1eI8KW7t6kRgup3Fz+EhtS9DOSItUQuwkhN+KQJb7ISTz+yHNKQhlHMkc3CRSbW5lblMdnMlFrF8vsGX0O7t8fXUX3uN3HaB6IqYm4AZG4q5PN
+DpKnuUpxipTHCzKZMFHk5IGe8AM9V15jlIpCYX436TXZetXk8bvLS167uyP/nbA3aVkzrukbJ72rJZWHqG/b9pcGIjwtJ2VFoGpwbm1NQGurvbwJtTI8Iwh/
+a5WbTIg97iTWxv+QMxbJVY8PApZ4scmpvWMNn8QoSLyjuyLSQCwum+Vd9/GkRoDYxNfME7kTJcSMiiRgW8dJ1txgoS5OFUnQVTk7oNYH+EnDEVH1IH+D6wNi
+A0c9lVwJcjL0Dc+F1Fcu9LOXiRPIKm8jf5aN1GmK6Wq2imdV3uNjCJblcZazz8jmP81ah8roZlKjXkkuADVKMea2kqYgqBI8AcRc16c1C/il9PO+fn7f8/u6
+rw516FfWzZFl0cu0tXq7e8sjW8olrJpjXDvhldy0e0262daEcNC6nwbUEm+2g/MsBRaAM8iTAk1J4ghiG7qGpcQ5VyAyYRZHhiHqfcKaWWs4Nz7o3c5WIWky
+9B0vu5G/nmp1eGTnOLRsBtkO867Xpv6gwYHsPVt8x+3kjgZD5Gw4WhQqMypN9yY119RxScas8fuwUOcyfjmBYzxErNmMGGYVMTIeq0p9Srehv6r01P9UwxAu
+PeP/Iv1N5Tu4xsxoBSOORMoq3B4fM3Wj/ESU35NU1Y0TGvDzV7PkYD8yerMKZ7e3R3shLvABYj5LaIMI19pdMSwrDUrLbRJzYOSe4iLBQ4LM70FY+TU5WYqm
+RSYhKWWC3SkvKmb/TfVZayMLfuOL3An2Z+nfpH+f/jPXBWxtjs5icOCq+76f+icYC5MrGd29YqiEJWv1i+mUwxm8D8Zhp7GQPCjLEUtrUz2TFavlnCB4SPqj
+55rRUj4dbYFBn9OllSAA0bNzck0sewQh+qNvCb2gFaMltRaJnJVFsesH/VZXsFWbQjehdIrm0C+qrPBmT6vtvLJEQbzNsjwB8YGDKMbZIIRu0uHFFmEo4NRj
+qfFnft75Ju1E4kz0rUPTd1vJpehaU7Sj8HBfP6DcHnueYR/s5/7qgtO1hDwMaSzsnHtkk8oWFmkuX2c9Q6QqFf17UHo7otWTCFTRTv6Hc62khIVWsTIf0LhH
+Vtg/VkVxVpq6cA5pda9M3WkZBq6n7JpZuvFn+4vmcbc9vjaohQxuoRxJNW3+kBg0SdQm8BD57DkjipQkWQ2YdPnBsNju6OdSE0yaU5RCrWTdFprPWHFfdx0b
+gJzwFjuNTTE9djs79svmfQoskJpFeGSm63l+1DP9JkJJEmh2Ems+kqcM6+HeMJXMSRXHF/6R2bzuUX5ZXaEWqWkUeqZYGqwQkjTw3MEGWSHWc+HTYewFlyZB
+SPIS3G6M18r+awn1B8VYnTV5rUIBSFTjWmC78cb0TvYn0rPAYseAh1EDvYMwrZbZc7iG5A29lQn5MEGDfhFAen3QNCmcESPrTLNNGps0tgLIx+lQoIwbqT7X
+4SDIWJ6TjeMhlVG8Ltg+rby5LmWZnmUBRwtyi9qVWPptQztt+j67KkhZ6Q2hURr2+zzgjBa8mT8tPFdtu6WV3sp7Yu1t43nRhiHgf8No7l0i3UYkO/uRIXWD
+gseHpXrcCUDvD27QFXo9ty9ETmvDOvXyYsalV9aiNAH6KLxpI1JPRpd9LheiI8jB95MBkAlGqW4WlFNd70nNm7xH+cYKBuazMgJFgzd70ri4ecvmuJGY8IR/
+psA9NmrMSg0UqSmmGUsrJ+rINANJ4gpdM8a8bQwkGRBm0KA/RT3M70zvSN+VXk0fUUX3cu2HBZw44hw/KX6P3JPbFVZlm/icjPrjubW2ckWXZFmdUSYc52TA
+4SHNsmRy0iNKONkxp5iLRM4lix69ZNsqM2Xk3I+8YaegQy/CQCuLajqE3GPJVRlxZxiUn4FQ6Q1m0pHJ95IuIs6Kp/Leuj3qQ/sXMKw0VzGVz3Xg9bx8PbMv
+PVMMuFQNP5HSTdVnO03jXbhZnnG9tcONG08mlQ7YbTMMxcse0pnqbSI+AkCRthnmxMl03rXETzAT2WLMskm0vyJFm+sqYpOz1uizVVt1qEWrLW8Ws7B7vVoB
+e5W1T56o0f47Xm+zSHjwxfIyIzdECQUgey7XzruKL3YTYrMyq8k7y0Uqiv+oQ2uHrVYIpf1bSQBmWRQZAKShl4AAjedXDns+nPwMJ8YmfzQlz1FwqnS7u2Ct
+T17erPCItMiAA4qwRZM5AESmy+ImhRRsbvEhvl+uQePDg3uregH2n2rWiW/aVi44C8az1r75qK9UeJrBeKjaXy4GQBt8jjX3bNfyfaoF8OmnX5Gpw5gL/FqW
+f2sXxzkVt/wXLq9A/16X5lRnL3UxlDBXf1yzfD9uvWGPXbGi+AQB6uX3k5MQ3mjz3O6Q+TdspHaBWAehQDAXgIeMJpqPpmfZDsqHZVAgRi0NaS7mttmrO+We
+f+LMa2X5aJiXSc1jc7mFVD0njjjK1V2Y9awn1UbB03W+bV286rz4Jd7/xaddI89zAKKZbS8e5ShItoNUwcdj5c2ZtsfFMEiP0xxgjmqulUn46nJ3cWPAmU9h
+RITF2jc2ky88fXR7I447eMPG8bUcpEbLQFdGs6mrw7loru4JJRowIjik3OLBtumkZzHn/BXSaiqRExPWXRmLI9nlkFWqdcb1ApuThxQHIOoDqkci68c1pzOu
+DIHIrG+mxua/mXuKC0rZNDy3t0+Y+2xH0SxlpoWhARgI4Mz/36U57e1wsSlPv/NblXmQmGv1Fs8A+bod3sBzPRbS0OAU+S5GIMk4PXpZk5dmeWqUS7y9rqyc
+F+WJJRxWLi4NSWYo4gd22gNerACz2L+YDZAGJXE42NoexuYHqwkwes8xr5k0swVKtQ6Q0b+np8y1KQcH+Q7obxFH92zYkGiTRV/5qaehRuINKipXycDeOHvv
+hgBkL5nnVPwHxVaeAWCjudhNs34/9oKrt1xn8a2m7YXlm7IIyz22hqXgAu28SX/X08+y6nOtKlcv6LSkU9ffNmp69HT5KEwcaK6ijNFuArvUA128E9jU8rGi
+rTCnuS5LeG5UdMFGv0UxhqDKe5pKVAG+px03xdhsxiO7Jjw8WNy5geM5gA1oLfWKCdRu4gwz9ZxHO2d8uFfE0M85I1YyLZxrJ0q38yvDmVoBvYeTl6cCKrJx
+yaQFIIzREP1ggR6/TLPX7TrP7MLNpHMrccGdtslIk8VRi2fVKN6ZnFU69nH3ArdPgicn42zuGl93xuccIF0/teKa2jkm80k7bc94APtezOdU1WxuqRfH3rPa
+S8s1+/PykPZrYd1cn2wExTCq+yJc8iXqBdhYzn9c4RsRtkFjT5Jq/jfTnz3N41+cQI0KyE1oaJzU7y/jl3yn2vLjQV3VGzmEg5t6WAsQygYsT3MVXgMgAAhx
+AlbRmZy0i2w9eojHVszgKUwMpXtMpEGhybXeVTaJJ55dksdeg6uvM14KELA2bh9GE5Pdrk3MAE2rdPNQXhqBL1+Yh+xlO05+YMPBQZzORA3wb5qeJ6m3edY5
+zMO2jfhDpM+jPppzL5WOR62d+M2g6ETqG855KL0dcwVlcuBQ7V/h5zldMF2ZWi2rUVV/BQUkmbiDVAcPzImxebp3wN9xM3frRYABvSKzWPbli6a9AiFzT2iG
+66kIs3wJMOJyd9wZ/2mu/a1bty6uz73yYkyYBHC6NOVm2U61qWWgM7kzxhdIpFX21qh5WJ9R1+OgOdacaNDhnZscNW7QeUKFMc9GpOxQT8CXa533MQPvIVyP
+Wc14gZ58sVEf0pxiRwyP/gqwfGWBSm4yA3ACuemR2aRfTL9h+EZG8hmf9na5745BTcV6E9c2rFJxBi65k0m33Kl7eazGqep5VQReAOSpgkLG8sWcMgbL5SoR
+ukv57foUtd16iN2+z7GLKYptZi/q6ba5cmzZDF0WYj2ICqvvApM5dl2NeIw7lrNr71LL9YhFTk4x2B5RPCl0hhaT977gU36cqx2fD+iolF/An+za70pRR6rJ
+OhHPAl455iUz9vaXMA6bL30ODH0Z9ei64j3igOdiPKyEa7+vPYfuImvaE++6AniPq5j8hmLNoCDmWHvQuXoSy21fEyxQUpQBrt92xA6jX9tUEHMTo8Y5+ARz
+pv4Dr5uO1kc2Pv0glmOtOcXX22uUTads5vXDWHd0c4bx00yQy8s1he+HsTpvclBLmCETLK3bqT2/7rQjHV4kMtdKksRJ03grEsNrKabM6HoUTxMfyYFS+faQ
+nlMmExYN+S9hc/5i9BoO2+v7UWztqIJi20j9emIUV/ligN7mBN7CwV4gXmu43GNS1Bs0oN7Ac1VVozkNEDGqZltMJ+8rT7QnIS49msncmSraaTrcq+EwYzNt
+p4UNGUyXOqpKyjDLzblqPAZ/K/jv688K19z9lv34KOdfG1DHF/HUz1Ybfcw1H/CcNmlGQhTWPEjpbQ3vSozsKmOF6Y/+bqPo9/QMg0mH6uePaCTH58SrWYmR
+2H+4aw4QpZ08bERMZOV8qOJd9TZRTjDbS/KKWMTB+XEv5xpqP5RlLoly2wfysnJvf1p6WbI48Uo1tn+iLakB/K0/23B7lTb+LexJPf2LEm95I162KYMxJnTa
+8zvXBvkh3ovpRU47WeVN1YjfLW3hJfO1mo+AhwQkcGLQjLB01V9LqcK5Qo1pSMytBxsASF0O+FNsV56aYbmoAF8LLCv5FO+XCczUq5e+JvGule1NuOYSbaR8
+erweuhc0xuTH8Fr+VWlty6+Rom83AAYtMOt4e9DmBGxg8nphlG8Ev2QJ1RnlpJpTwKYVrLFZ9q+Z1oQVEw7jZhtywgcvep00YIohU/AVmla3Q3VFamoyNzmX
+SLtrAx8oRAT8FoQZOWcOfIPK0BMR5BAnueegxOzsaSDm1u5/SNSG3O5fc3kkOtyfpvj9Lrt3MWJoun9O0yFGC2GoqFk/wmU2qqoSzPJGWLhJmgPklyQF2B4w
+WpMhRUi0mq92XpSYocQL30K136v7Ib0cDsNjKYgIfvKRqr+0apryipKaPFb7984tzECIJPoF6cExVdXmm9a+8HgXRL1xOPEuFfDJkzzJwHNaxS9FCPMjMc/p
+aAghLynO1VpVoWU09TSm2q/iml1OWksbmz4AWu2lu8B++GVVoxfLVfuILo51X4lxRufePhprztbCoP1xqQMqzytbpg93qALjSmCFZ+CKGDW+QC3cL200AM0A
+hIHya4BmJWC89+ITfazReTZF+ifPQ27ih6NDMsf7b2kWCLkS0SbCnj4JHkkXe15eSTNQlBmwWYh+z51ieyTfn150jxtS2aKnAx9at5YUwwvb2u62VMMvlZDr
+bkYuKiEjnPNKnFtGwZxjL1KjyKIREvI7a7mdqjDFMI8GkLZWjMiLjB+Bq1MjXC87bPA5qAvc3DTKfCfLj3QYgdFt2GfsDk7ajaHu6/jUTLrwT1UWvpsG88EL
+cSWZbje0HLagYHqYOTy/nEavlJut/s2dI82sq7zwbX4LlSfebiuRyv449hmOcIN952tMKtDk9dsMFdNqZHMRt8xvJOsvuXLL+4RQthSn5kSRmyop3vNS1Qa3
+XF+ume3JeW/4hUenygVJc2GUaFBUbKOsA0T9sDW0TTVOnrf2vo610QrNYvk5b5drfA/J4dgKMJVVp5y8lILqMjnjNO5b9NtU9SLF68qn49ZMow8MPcWT5iAN
+4s0AM5sfBGh9rUye8kkZzs4novqP0lwS7ZV171XueZGysA4FDsCU8K/CkqZHM0ScHDIQtxAqblIrXF4unITdu93MCokha/VGFcon9ENkJWF0rvV8zce1uZSS
+azbFpscBVUEkf3JkSkytOznx9ljrhBdyKoE5AZeplUvBnqr46C6cNWGtSIAWv1j1gJ+cbcyNGN3xtaHKSvPnZY5gUJ/XBISu5Byz8OAJ6FkYQklENPrrfo1P
+i594+nUrhkT/MgrPM1zN81VgEwE95fphw2vonIyb+SFlfxbWlXitvyrrqp7gi252Rd6P05MSqwffPFmUYEUVrd86C2SM2ICsisJivqWprYzWV2GbZE2g3RDI
+N+hNHS98OQeFs7t0TqdnMWt6IcNgSgtZZbpVTEBmxOLvRipR5s3xFXehqSU+x75kSVDFq8poUAWFINJk2HnQ32rIaUY513JCWaBgBx5/5P3W2MvqD1Qs0HR6
+9K6eJuUZYTnfHP5ZU8W5c2iqrtGcYADjJlxLL2pZOsuXki3v+bzEn5GrL1fO1/EkKxGVwrmZkc50EfCqioxXosGc3CiUIfa+s7DEp/0TrfR6xs32kJUjTjH/
+tl4Uiym4upvOvr4eycWhedUUMnLRl6j4ctMPTK5AK9Thjw2VbYenau40TW6Ixzv+LeXV0zjxZ8lpI7Yj9QAVbGFh5sGhVvRxxtVbVwn+s3JfaMM7ls8SJTZ5
+09hJ8kjJrtxF+bYFCwrfKK7gKvyMJGKbywVnzLRWmSMm10riDpzNaQr27Y2eq/60qhpKL4lWXm+fduXb2gfzjLMu/NPH027T5t2x8+/KNiBpi6HQtFMeiEqK
+2w9JZ15FurnK4twMdR99QXVpv9ngTPryap8tvYAxWZqDx7KmESmiC4cgqOVoB0STOQUygpWYN+0AJq0V6dKnIY+wVYSBMMsKG0WL+xqPL1hHWYlEvbA801ox
+xSNRWayzdKNluGwZNylEoma7zU+yDOiLeEmj+iQSUkl+8+jB1MKAPyQFamagq/0osLF+jvJmIWOTXM64yOXYafl5xT8VDPFvs2Lai2e3GjQHV/Y8eylBolKe
+merOt5DxGvr0JgIP7lvJfcUGQMRYLvTk15v9Hr+vwVx/MjBvfgs469OOc96SBJtGI2QIDtaxzM19MV54DVxtd110d5EIAGhOAPRIaVsfGZWwS1O/LSeA1TlF
+E1XoEl4gx60rmkwWay1znSmXzxnpeo5afPvm/Pos5+FfoB9v0TtqXU3KjWwQ/st9evKQTPtZwPxXmvb0p70ce06K6e/9PufOe1I9qS8mHRbO1aMYtJinNEqA
+ehZaxNEf6ZdkUa22pPW2tQJTJn/lIWnDcYdLY2++LM+NEvPU87JYqK+IunWV+7/uz2+1wY4tznhUnGaWTO4hxDzqeN7wjzPonHuHIbSseQfAjC02RheDhGgD
+wYXmOp47sUYcriqJLH+PMgCYriJqTCf+gdjhZTx3MDVeuYkxHS02VvRXqhxEnadjpA+Vbxy2puIUDTVUav+YEELzChAHhMpFhXNjU2SpRD4L1xp0gO6gvqkk
+TVLDu1At7Q8qZ/uT83pTl8lTTrvTdNjEPMrGTpk+pafDqnVRMm2ua7k813idZYMLNxWmpuZ/lz7/NG+7yaGMxyabIi/JpzUvAUxghF9f97tnsUMPKUs9ha1z
+Klu4fAx+2gfdI/hH6XeCEljUd1xAAgMG5kVzzMus8oYQmoLQDJfTzLlJLycIAtEl0FK0zLWM80A1zZze2Jz9r3ok8pn0RZmLJ+DXYhdq1S5q+63K6mxO+XLZ
+Hq9D00uVEHK6fLDLehR/r/ruRKF/dS/dB8Y3oR+UBSd8kFpQS5dedZsWt70MWaWGaBb3iV1/kk5XMvVWUNEXdFsCxgzucEObBaCwf/ZSsequXIko6pW/WOxQ
+1ZHOmv+8FDCsXPO1LrCXQw91xEf8GNdgZFcJmnPcWffHVasQ/ciIG7hRp5ntIsd8h5caN3yoJ1hj55Iuq7lKBAfpJulkLa9fMHiOFhi89z2lVm/+fQ03Qdk3
+P5P+fvqmZLdyPHyP9YCyeunUsFtVzSEOFPh1z43zJB42JxOrPx0PW6Uw4KP+UO7yHNtJsNa5sw9ZPzkPf8WAosKNmd4oqXMUTrcBJCAe4Kz5ycyboKm93nGn
+sqkJf/3p1XPvW17rnjlaVLVrCA6qbu64Xllvm5s3AFEe+ELBI3Q7kxT7zrYUAvdBTsZHFHEDvxTZJx89A7fH0671ctc7IvWnVMRBf6M02AS1nKbFBqCbh7mT
+28acQHSlh5DMX56j0adt+ibLd8rXmZ3ZnNB6bN4UuKAjjqScr9+0aJvdukodol5qpQs/Hq6eoyafd7dmKowunudvm6uKKhFm0HFgZyUMcyMwehDuSQACwNkr
+f4h1KtwUOlG0bTv5qc7X/XRQhAu+rq1+R7JbvCJ4ex1ul9Y/ZmXUjGUy6DDcJG0U5G7sLFxOZDvAse0ocgZlNBunVtfBhYrZG5p6TO2f+axZukkVw0Wv7Wn5
+rGddPutMQvrAJAO08HuogsI1w0eXfBzp5wXstFUM36b9fJG/Zv9U37tc6dHZ5mzNHvY6gpx878BilWWSEQhrfeXvuex0D6H5I3W1lA9VtsxvBaMpYnnfDkxz
+31SQLsE1Ea7iNTOAQNseQ22W5XgNtonKINT5Js8Czj8yBX81+lm76lltVY3iQgLax6xT+ox5rE+7j5xiNvC0Ezhz+bDdYJOoDXcUNkl67bXM16T9HpLpeKrt
+KZsRFoloTb2kRUtl8CtFUyqgYlxjRBQ5G7mgJ3JAZDkICbKWYEihOcvcl6IIBNpJ7gRf4INID825+rb0t12z+R/UepUpZqpbeTHO1INFexes+6QWxg+ytK1j
+NRASowX+BC3M3DawPc6KzK2yjhr9p0v5ITlLc0IsXjnKW1dqKDu2Vj/4VDzFXkcrcBHvZLjOE9TAQuZHWYMsHtUA22OxlLozAjSm/sfp9+yMMIXJ40p4ddo9
+jaDF72zfpFslEYKsFZ358ItEeNQOfq5ust5NvRv2yElWAFR+3PXmozmpp42olYnyXY2zK3/jOx3J9xOu+fR1jbX99saZKO7MizBMmDtUOk8FZW4n7F1tSAQy
+aMI6r5F6QlZtEOyiQ0+kSnOFKqL2KsrqCudWYUn9Se1E96pYIOqcjHHRj6W9kfVWim1+96QiDtPWU2LeB8iBL8wc7AIginKvAwPKhWZUctLyOkEG4+aZaY2d
+B6n2JLeMi6YOZH07bw76C8tzp1FENrFoR0JsH9sIfv54P0HfeSYLn2P+GWwC2jRS4ftMkLG5xyE6IvmQ6YKLhWz0YFh0lKW3r+Q3I3/Kz3Ta+6KB8MI8XtY8
+9xEXL7zpsK0nJldR/GNeCMlGAZS9Btz142qna2jTRg+PPG06Kyc6akNGsmKd/H2MEAwaJ4mE9rn+h/JFczbslTH9940tOYqU86ijs2bC++rQTcZpEIhUNMH0
++D0o29G6NbqKnZJ9s5UE4y8XhRRqBAMbGL5+p+Fl1uT6JS8BNglTl3uQLHrMPTiU4wl53CciFdcr8YhzWmRwu0Enxlk4NDypk7dM3q6OWCrcQ6zO17FPvLuq
+ztBS0aXFAtbV8IRRl1dkfYwdEC7qCm2sZmKTsDYFQpKIhnykhRt/DbHFnL2zsBvIh6Z3SWxNtTSiUvcrAX2y1SPkCdZ1mdW+bGslfsvSeNbbWE9xr9raCra5
+ZGKDh7VQY2hzb0VXpjBCfyLwLfOfcKQqf4dAhI/yHQYPCfjGGERP92GgGxR+Y5TKiUJqmf+oXCIT9DFLNN/UcncxL9R0uJI2E9BCDAlgBSPouZK99mBLvFlT
+qVujZlZvcH+6vpY9ZcSOvXfOSEzGOw8AZAv3dTJXyyf5pLdwZZ06G2yD+S79KJYn6RSoNo9c9X7xE7nF/bQ7bKjfeEhy2Qi0hjnlSox8d07d/O8YJfi5po/i
+USZULXwdnYTceRBrR4UqtZWPK0EIkO4QoW2JUM5rTIjSrat4U01cs3Xge2CajGVPfCF9RfxNzTO6aouvqlD62S690KKOfDtFLqDpaKWto20SIkIzcpxw9A0D
+gAbLtIoELntd0D4qCUh5IsWtxR4pccPvpz/23M+j2avJ+9u1ynUx03OS2nogLMqXMl6O3I7LUHEUDdkTGrFLhjYNhmAZ2EWwjLAkeCEAmpeJsagTXvjwK0vk
+xUzOdDIpzlVX6bamo+s8bU2iMTLfON9nqpfEVF61E3OWNjgeM5X3NHIwZ6FehbXkThWAwVjDig9jzH5zWB+iDmCsmf+I/W3ezbeyW73mvt05PplvdkNHcjzt
+lhPYgOtru+Pu0OgUHXYF26V0dG5F6/UiwAJCHx/koRVPQ4bnRtQmv6PCiHvTALhJEp8CUKs//P703c6B9ttS7a+3l3Em0X6Z9F1wph5DR7vdyy5bq3nA0862
+jMtgO9jGO+W6ApaVrYRB1fPGGDsNoMn6r7UBn7Fp/OWPoU7znvR+75sz5ddqJ6cVS5g1HrempGBDLKCN8kwcqu5D7FV15ydRZ2E7KQrSbi0zlSMahMOOx5kt
+8J5VokiSoZJ/681nVAKDRa/TJuk+9aJu5Zp/P2corB+8rJVvxnPDMw/tnJRtpWsluin2QRmKnAigpaEe4wEk/oo5XYDNrJBLbCvYADVpk8nuOdT0u36wKdHP
+9gqqBJrnLfk7Dhd6SG189R5maKpVvUfXyGGsqcjRapn7sJ8ib9NrWT77amjPzpzK1yDZOr79Z4JKCyBTEm+/3X3NV/k3426wGm7kefiY7wWpWnLmcmMRxBji
+KTYEF1HqRhzUKIfwyIOCd31GsyXkzA5YJDUQWrb5nLRcS7VgS55CGFJ/eKg5kLT7WdUXNV+xVk/asWIvc2brqK+if2KhwNoSx+BzhJmqEfe27VXcuVbT7sBr
+oKQUqKN10bABL+xmPRIGXGtK4pc4JAlluTrBXL4eCHk6Yv763vMIRS/VmFu98fQ4ThcyCm0r9beH7w88ru35uM6c5LkFNHSu4DJbArnH2JSg/At5pu9OP5x+
+JZyWF/Ioztv8WCiWT4vEhCmgFyi4YzyMBeZMGFxInLuYJ/nIxGgBoND9BOa8B1HDXxt4OCPUZSUCwHMCCD2iBeXzd7x7KuWnRJsa+IgN0D+32+MpJio7DiPU
+BogHFj/IxEg4u+H5Ysg5ID8hINgAkWz0Rpvs/XrlhZX3N+X6d3qNbrXrDTlEgoTVipqnAUNv5KbCdPgnpg0BwYPQMRFJo1pbSuO9yzGTcblmuXZjMQXRnu3j
+AmWCSxzolU/YcV0WxIUoHUsEa2i565hUP5QNfqTq0TJT+ic6N+VPbIertXBa2aPoqZDZcEkG3cx96WlWbePF2A1Yfsm70Rp40HiS3FDJhv16X/E+7Q66Akwe
+1wy0g3F2h9N1GPpmER06xzwJy5vFOzqQrtyUSTscEaxLMFvuAhEvNSlZ/uv1jbbjV5mx6o/cXmveqEXoxiBl28g+rLKa7q+EkDl4MvXmI84kY69xvn0dw7cG
+CWB6ckmhENlQmAGl3j1wES8OqRL+blKbI35Tekt6G1uBqovBB/J2d9o0kKQqhXQKIKTjdi23YM0GoycflE56DJgR/WX5dNxujGTCjxr7Nwf0Zu6WNypDPWce
+g8+TXY1ug9BxmxXmmJysk0V2DJZ3J+v5rfHyewO/imWOf4m9IGG8ahbAVmVLxhdBiajj/8yyyKpvZM6pVicbZAiL6kOlCCKtA3hDRhZFQWna7JcC9oxPEjP0
+PEE/z8z0Xw+8GwEhoZUhIfq50l5+Ccc2hvzg8TSeLH/ZkFbaQdcEvrX/XPpubCPkqsVpsJMOu6mgtqyUf+VF9fobXp13pL/TdEP/XPpN4cOetrUj2vKBI9/M
+K7Tvu1o40OJkLQ840dnoiVzOtR8P0jRmxYyHhJH/WrmvYjc4ysDJkh7lL58keYUYnkhzolY+LKu/vBr9goLTsE7wf3ipC+dw2eczRsbjqNZ1sUD2BlQ8ir6K
+pSAnbJpv9FEGJDfQDEFCxQ2plEoEL2Ln3+W2kCKEHzL4hX7fS6z7JbzfX2NfwTF0U8WpPI0TfVUaqNlBY7QF5gBuSPkSLrFjL054ZeQ0I4DhyUVu9Bkw1liL
+rrT+AiIA6vW1PqEavF9k3O6T+UYn75zgg6Csh1tvmF0vGK40ID4kba7gW1dGS4+QpBBoRxKRBkDl6AsVwmrrSvai4uBO0yFc9mH7AqQvTkPBpYwNlTsFZ7Im
+njVCigfpofSmpStSSMBDCbh63KAiSISP8deFf9Qcl2n12sN1H4OYr2e+G5mqSzdIkpXlwo2HvNonAs3hg/ESGr74IeEmXfBPQJV+NX1vihV+u3q9CtmnH1Hs
+DK/GvG6jXLuvx0qLha4gVzmTdtpeINOySXxAuCuSyaHTLp0jRxUBVXxfZa7ZkB9FD4nkdNbqxpwGUM6hNwRM4ydc67rYX/E0jiZ5zwfPt0Y8WJsi7pklxWBA
+fgtXqQervHxOwqMxX+BPqYtV/RAZWnpTc88ql+bfk47WVcy724wWgKxjXOEpOuam+XHcvTQeuTh/vwKB1ydE1vmdq7a33DWMWHjQ7UkSaEWeolnftyk2icVV
+hL/s9QHnFZivNMby4NokEZ/GwDiNIss89UyDK7yK2dOk2rVV3pqvkSmW95ZXEaDXV/24c2B9Sbzfp1+zNCnIa7txx9o2m1juXclurUGs9AaRlEq1LaEiZq5k
+rvAMObFZKT9jPSlkbSl9Tmvro61V+aMzFGxfgJcojHKBuVsCoWeLbTPNYh4vA16GZHXsNzR5qMjP+6QOTTaUlpnnG5Vd5N/uTznUbuT5ChWDoSM4/yBwCf5H
+V9o0i1NPmmufVTYccUjCPtdhtz7vWNjfFF8shzp7YDV45I6sHXWHR8+5UXOtZDo07QwA6lE3c/r9gT3S8hYQKgBJWqzcw3rUoVRo40FHTTzS9yf8PJ/zX7cY
+vVb1ZdCPzsMpr2Al7WtRPeVXguDhhqxntRs/C3pXtSI9BCIvB2TJ9ggZ4tpcOEigYp2wXwObdPJxf9N04275SHT4mefh2urCLnC4vAiubRXHxrGHJN0Rans1
+rUPaqoeKeoAySG35uIJsi4C2N4e+lXen94VsRInT/2SlP119gXyBQSFk5q6U8ZesLaODRWoyqHaqE5yhdZggdGzRKnlL+SYADVTdSN0X6LQbuHA39GEksOCY
+v4gxPrmOx5cazN8LY+a7PtxxdyiYeRF+cCu5FrxQ1emYA1Ccv2bwOeVHAPNVV1FLoBkzuLV8a8htG6I6osp/Je4biUvHuiseRT46uxHPwOSFyfvt8VQ6kV8y
+NveLZEbl9NPak2BblqxGGkcAWcAKS+y5LodoKZakRs7jgxQAw4hqU2I992/x/PySRvn/rNV9mkbH/lyM3Z/aF9hwfgZB2bKNttOGlMWpwhnkMS/ZOcSk2LKH
+hwQ5ycrKXt4LAT1dah7MgTOU3976rMWX8D6Ma9qGhos15NBRigKT6uE1CsjiB46TH7qbpcxhtrQ3nxuGG08ZAB+S5b+XcocZNS0OMAMDTckCklzeKqG/LLNY
++9imd4pPsURTTlsZ2b02l9QOZOXafRrkZwVEZzhNHragdni15xQ5eGldmIoc3yTfIKLAyXcF6WMPxXS+JWDihKfgs9158nXxtdp4pTYHaodnf7Jc6FERXzgr
+7OumsRZ9b0o5MnkONI1bMWGhUyVDlu2uPsSC+QyVBLh8geREmdg1rTwkLRzER8dPJTWRf5H+rPodQfNRWAabDvxrPf1KRrhVCo+FnHiXXs+n6JIrkb4CRomW
+InEtRC4kBzkPk9EAALznrclgpbF/FisacagKbyYAsmxMH9t/OB3SR/X8+fG2n73LyBzGlQg+vHkaj5eSg9G5IMuMK20RR8VI0goQe9nTkDJ1UftGAAPuX/E9
+f13aoOf9K+MWX63COyKBWry1u7rdx12kspNav9g9aRoItfHCnPw64UqmvnusfpWpRE5DyjBL670A7YHIAYLyyXrhZx5UueXolDiCfqaW4+6WczG/qryiXcdz
+bihyXrjGIxVA46fZkDnPQ5Ik7mplh8N5gQUYsYKC7K+WdIRVvbyx5d7YOMb1D9RHZpu+a63445a8IthrQmNSVOORASptB+JxW1tG9tZ1KLV/wlVEp3LpK6Qj
+EDQilS/FTUKIaccVa5/0v1ARDHtXIHbh3Cpjm3p9jANnwk3dRWpeEhz4IugawkOSnJsudjUDd7zE6HNzsU1I0qIItWEcKgk/NMQJOVlwZcVwG578aQlKqDLs
+YcdL8+NB5VVVUSYjQ7f9sDj9p8cS6xJajdpIWFU29MQrpa87lsuQOq8Q5c6aZqA+pV5ek2oBuI5G4m+iC9r2y6Ee/TPV3aVApvSmjp/JTv1/KLrsXEN4Yv/M
+twaIv6sZvwB1rBwZbfMKODzeQogAj0++fSAGDqudLVdh8jafjSZkrQR/KfhG30j/xBk2/rLpI1O0uATFZhOe1Fi2N0BAVbM7uDLHnbRlCVrgsX6zgIlbdvby
+c6WLwBBywcUONETAq41oAERAnOMhosqrs+xJe3ODKZWa2087orYqyl3VUs/CG+aczgYxlCPoVEl3KzlYrX3sjqqrGbRriuMpZZ6w52qoCYQzwADN7HhbKnUa
++aHSngngDCiM0TAkILG95cHFTJ2lXvPkY7X6w3PRYc8UO31N4IQLDbsxuOuV3lXjLl0Ucsldq5gcM6QUr+KJzwm1WW8pbcK2WBNWc9KlstSqLJHG57Va9yhK
+suMpNW0GTsYc+G3cdTrmiY9k/rMATVYRX0YYknRiZ6HkNd69NeRkTkpSSqZOUSy1lSHSW5ox3bnHG1ED/8w5rU67x7BAKgUQLeX/n7w//ZItu+oEwXPM5HoK
+BUFwcZmehCSCi2HpCgJJJplMjoaQtgANCAlBkkJCDGIQiCERSVMJyVCwGZKkyCQhk6ocO6sqa+hc1bWqe3X3h/66/7Bn13udPZ19zr3mbh6i+0v7e88Hc3N/
+ds89wx5+g8zsq5abfthvspZszmdRVkdwuBDKuq9Va76XgGZKtJxCxUyJv2t51CqdSEJPCcFXSQjwmJeWfHrHgiZe61tVqlTCLvm6xmmY/jz9ZfqX6V+nf5/+
+U/pfKpNt+bxd0Jl2h92Iq5LxqvVPMST4sICXd1aaaX581B89VOmM8ovqj13VT9dl9odz28WqE38BQurJ2h3PCfkzksTlLjXP5WfIzYJQ3rlLlJN8lBCuB/af
+sGXOy1t5MtUKm38Xq9kCEMRa4pqrIz367ecZQ/mH6c+qD/K4CUaV81rzUjWg02NwfaR9qEhzxnCo+EwrbXup0VoTaHLCJ+rNV6SrUiUONbpWzS2SbpvjZwEB
+1nbgacVoyZTF3vi/kt/pERh2Gj2BrTzaJmbRpNhJ9J3gS8zjQxfY0iICPK/1XxvBGaWUhtYRhvOW8QDGnzSu7NvTd13O8eHHFQVbJRpnrQcJCR0AeI7jA5MA
+vj28Xyl0Vgn0PcVHxX8BI63pGfox3vizCyPSkAnM7QndNX2l2u+o5c2Lpnojxyyej/ydMR+O27VZcJKGrCrpxQ/ILVxBp8d8olrfArRq5z2TdZ2Q+Is213tr
+g2n9iqhnHQ9HDxkMgjEeb16D/gxvpIEVvBlXiCiMc7V96ZVnuDEKWb/Q/mJOBMLoWsmzz+nOmI2p7W7U8eyPgr8IvG5bcw/qzu6q2Kb+WyuXG6Z5hR6E4Y1A
+5c8zgV0sysjSXXpd4mdB7wVXqo1/7lh142Nc3Onmu6jyr5ttgGrOfDs1wa1b+Pbodiwdbh3uEsJiWbpLqCz/Jo0gy5drL3kstcMhA+SE1GqR2q5KmSMY84y6
+ajAPL3Nelh7KGdY1KeBvbO2e8sfR3tu04I+CU2mo27Jbyi6CBEgs8KbufzSjaVs0iQi5XAbX+HM6pRJ2sxZDCZ8U81Bzcjlzf1DZyL/EurEpG6PkAabKImWn
+IleLhMfR5LGcS1UZO6qO6h3vWWNbGTsYlGoA7hIfPIoE4Z+qOqlzlo4zeGYaR/isEnhAkAB1XbyFd6ufS2nsapXW7GYfrebx/YWIiLw3s0TZGoT4h+jyreX1
+zNEP5DPeWtukXXA8h3VY07PEdLqMsQ+zdUWsiui8WCs5Yo5bIFyd3vk+SVxvLyxCb6HMVCm0IJA7kQVV3LuU4SwMK4cai6kV3SUb2PJGCntp+rPvTV8IGcGf
+8F2vu+FmCVktTVYnWR4vYHYdsxO3D5V+2lqC1ALeui1F9YSdOG4AgPI8O9VMoCC3M17FnRAWWV25wvSfCWLIAs1YSyk+YRKnS4WK+W1jU5mbkZkk53SUzK2x
+PPQZD4Q3h1uuYwougBPyUfWuoCLUmxTEZF2hhummoZ5Ux+BEMlDNT06mjVLNC6rGQ51uqL+UqPETKdpHn3S1i5+6V/tIefhW4zbCon5Xd0WtcV9FrKmk6sfD
+5owiEpnsv5Lwyx+5VBTuYzOdMNdJwj9DSs21HaaMrpyFESP1Ma49CLfxdwLPLWgjSpH+ECReOqSU58ZK07+f4+2dFAsUeAClVqJQTavd65uBpVoaN68UUK85
+hGo2Mo8tKoV/BvcHtG1mhcbgf2On71xj3rJKDFut/t/DRUo5rUhBFPTQcoGIejTaz4LWteyxltowitAnMAcVCktIm7R5EctR1wNFnd+qCf2GkOOYbsU/EHfH
+0Y5KfTdaemb3mLm8D/K1pb912KxDT1Ngk4JdmyqivCNnM4mD36qMFpZrQVwsy2WlLSGRIXg7rbvvczVgcYM1pX07Ko6HzeHeC3L1WNd6GWqzsIbNNw/wV9aE
+5JKFjY1SBq7cSBOQrLyTXfZupaW7ZfVyQjkpqVkOHa0lYBdKjU1w21+IGW3jbHupF89cqMsuVQXDWoeNznL1LpHEfYYkXSo5RI2uDIiNx2DRtXI+1u5in7Dt
+pg0SG3kByQV9aXtuPmwDB4YbtwuFleydD3uj6JokBgpkwKT5FbfHo9HC16DZOpzIwogmN6gV1IrWudSNp3WGYt+9jc5tnfEyQY7j/kk53dWCb7dkw7eiWpiI
+Nj13iUsvejlaerrfio+jnIlktVTTBICY78m1/yqrTfyTh1Fpqns6NASSmbB0c+/3IoC+t9+sG3kHEu8QaxweU3NSKfsJKSeQHs9EJzWwxEXwGj94Ml00UCgL
+QJasw6pXOiEQI77hJT7XCmOBETcPjct4jAbXhkermpayvo3toBlwd8nGjjkhrFG28Qm4hU2Llwcqf6jenPRM4UeIYHy2ej3WO1FHwHwcz1yJZMOzexoKGYcL
+yefjIKOQD8ftdec+8YRVIafYGGyd+/qKMB/1YJVKc6XvRoTqREfOqBbZ6MaWgMhUiXtB0Qv/hO7vP2lsbak0VwfzoZdxuECx0XLi8+w/EeSRLgTgGSLSSkP1
+ioRXbQvZ/iQMXG7V6w75TBWJAgmwq91eq/vsb7Hi4R+nf7qgcKt21PGRXZft7FxEfLzIXvHQVdLMeeWmavSgZCNBYicnxXUKQUaJd9H4DNBAngiZP8kJcFYl
+AVh4aCkkhhAeSpgw96kq4yesoYoX/5rtsbtL5F7KCGy0T2207824uW27lrwOny75Ysz6Pozga8+btaJdSaGzqsjaeGNYeyfsQjNtGJLldZc0aMowb+m0+DFx
+b/rl9Nvpvw5uKg9XY2reXZ1TarVC96aaeu1tF9rrpJK4XAI1Llzvz1jDG2i4r0koT9quTIUPz/DKNOf2cD2QzDOKb2cd6PIvcJRe4vqDIGUsw2i6KOemUORy
+KSB8UJ+Zw3Adnbz3zOcMOcZ1pQt2SRVJoMlXBOihBCMi3ch8Fo9ZveIUOIMrjVFWYtM5kfEGASA1mhHv5Wjsq6ox84fpn6b/Jv1NqzWzea2SMgtoK1WkLD90
+eHWlI1hrmVGAc3O4kSUnDWqAirCel7B61FXYb04Q1CURRL8JQy/qHgUaqE0aORj1ntypO8LK2l5A0GqQ/HD6Oo+njaS5EDl0Z+ylalXhfnO4d73tHg4fK33K
+Bde0GBOrf/x5R9powsMJENDYTKbN1AQQ8xVHZ2JEUGJ8O0YlG/hd25uuG++IZRHfR/MaLuM08LWwzncYkcwrb4nbEDoqgdmwDB2rJrz3AR07jkPUrvgYx8eW
+MV2qXd27vXoRsJWvXrWGawsl9EiNk7T4AfGK7F0HZYiu+PQKrmsxDhwaNM3npCN25Xpeo6I5149kvhla9a15HOZsZvEMBTpjFKO1yshCF5TRKj1kd0Fl1WS2
+UaVGp6OcLVLh8W51Z+PA79SonYud90CGxDdxb2oktZB5OG5XSYHnfGiYBm2rw5OB+CmMPLGAX4F+UsoJpk41JpsSunQxr+ZMEGo2Hwl6fOUqlaB9CUBdkAma
+Fh2OVwEqEmnca8IymXoqc1ZT8pOchuqJo4FqXnY3gsmEEb3MAZkCj7vGUZ/SzE7idNeLrLYk7Sa+3yxIQVa1UK/G3lwQn3Je16gnnOhcNO1n4YzrngCU2K6S
+LYCLYTh/Idn7uSB0lbwsxj/Y9r1eTu9KP+m1rj8IUUTTx1mKH27dE8Hafr360lI92/M98w6KYkx68sk0aDLfyVQagygTVN+5FQV+L+RzGuUEAGDDCkslbgBT
+HJGXgM3592Htkv5a3PEOm4q9NisbQSsNLskUCwbj8t44M7fjGDzsgQje4eYdy04u0vam7xZS6nZbqSCScrKvsNsZw77I6gjqW1B5e0Un5ofv17HfmRaQMK7s
+j/AwvfGt5DX+BccG7rBOJ5nGmhhNXNfkeh4BLajSo9wuXieu0kHa+BZaX/mBzrNgm47pZ7Vqf6mb3cYRAINz+1WGUNJ3a+u4JLP8hHrB3ec4kS3OqAGvkG1l
+IE7JIXVnpadAebpU/k0muJcAgiOFaXh8U6M9tnXUR6l1auf7qrppnj3OdpWYY/PbpJc3sT3eNLly092+GrbDjeLj5X9aB6SlNKUiLUWuYhb+TwbDCrUL9NA/
+m5FyXxGruwFqV1xbXs/00QwSXWbkcmNq9E9eryqWxnpnfOJWIdZzmmqQX983mtRSGF+o/4h1nJ4gaRLGhFlwanxKUAviNqNWCaq8Sxk/86mtRntOu8w9yW2F
+ckA86bysrFLDV3kwTGkLjlfJtctrf+vgHmzifBbr3SUF0N/jEV/LfLaIyvck6jtf6DN4s86i9iz8hFY1fzNh+rP0F01XO5DP+h39PhmQ8qPr+9fRQ2gpxm4S
+Sq8/Yc3gOk0QorhaYA6X8sBR+7voOML2DSEvSLgvYqdy52txwxGlKW79cuAqPWirKXWFQQ5T2T3zTNRpHZZFrjLJG4MSdZkQIED5UjwGs3GZPRoict7RoquP
+uQY/Mx5CIusb2kgmsApNjD9fTp8LLDdjtgfO5t5FucoXw2gwwcdhiEaPpEZHHK5NpB1FsateySJkKCcBDOlsqZsoGk+Atwm6Dy30jGwPjnW6Uuk19TXRvXCX
+wMt6qbdHEX227dEFUJzo18gLXdnMKc+8CZYwx6065i2gprCipGSETmZKoJiSWi5Z8qX3hUSQ0+R6KQ0EzX4GkCRbaXgPTxp3yo+lT6Yvh/OYd6Dbc1XgBT/E
+V7M8/8YHZbTl5NoBvLt0BnpRKIQj/3XSSdPElSsBGyPPfOk48ikNhGABaZAKmXEj+/TbT3OlNsgwodROy4PpBT9zi3bEJ5UPIFHLV80l4r6oNGiiDJ7bDZKy
+cKFi68n9terH12KENw0sMuGVe+MuEU386qpxiiOTvRqDaAZ/khOQYfD7kZGm/DNO/Yx9upbxQgnfsdOjVqBTc47teYxKNFdOsX/RIbulmns/HGv3QCld4Vj8
+7XHTFOlkSmmSuAtHm+O7JbS/S+RALTvCUldbB4sC69udwhtPtvgyzjJijI6dYanacDeyNWEPMw5h3cOMf/KTupP/hvJww7mmtr++OQWeZyzo3btw62KtXNxu
+B2usinTjXSXnaWui1+SA/FnW+Vb7dmC2sNiEkCQRMEat+365EvpOJv9LnHeViyhVlz9Sj3QG77nsQSsZdrm4zaiO25sqIbg/XrmbYhtHZme8Q1mGEjsC4txp
+GnIi0DEUW5vQPSYKqgZRcnah1XCXgAgbB1nLSqBqfqP3QGXPN6W90lFfYh0uY2sCo20nK/igekeHGw2OZmU4hFmT0yWcBc86JXnQVRW1ANUy/xYQNZ6eTeRb
+elOXey5g6j+iO/cvmxfczjsrzusT2ZYLy+ZRnlEycYueh9ooj5NjUODwhEpIn5EOVq5hqkjBRgE6OMbBWZVTtxZwstQpORALnBUW6+pvSz/QnG3mG3gekVKN
+JBQ5XPGl7jMh3nG29Zs3fbdcDsctj1TYPwwuWqpB4J1dzsj5UsXbBk1sWYliC+CTBrSemy5MDJHA96RYb/uQVydUH3V0eOV5GtwQypVHMSsdjzpgM4heHZUc
+DI/uUnWU7qSK79IKJu6M8FxgdzjSEqTJymlmsETmtJGMgLwJANZQvmqxEgV9N9fX+69mWIllfvJFOAk1CZhjJKJ4qeEkaIKmkzZHSYjtaG3d898OJNHTh2cY
+idRmmtjxXP9e+qTGx65wfHQOkm0D1jIr13182AnKXf9r6V8/K+9vnJnG80K1oGpq1bWysZoolu+oT0iVy1O6jrD2ZrhjXOhGPuu9Rd8dNa3Lyx+H0eBXW9NG
+sZev1jlWoby5EJiVG/LvBBlFLdXTPsCcYJodqcqxs6KlGV9QANkjyU0+g8Qy5iw2Wsnr9FlzjAmKrLUsM2wHwxwPRjq411n2EUKtgZsox7weA9T2ieGMPGsZ
+J2MaKOtAhZenMy0hvHdwGm2Tlzia6H1jHkTp7gKDi/loY6MiuRmPV3o03PLnozK2Ok8842uZgxKfm4pLBBUGp2VIrjMoMk0pUxSMlBZfApJPZvUVm/26D2QX
+TlqQKnlAN0yrS6GIvzVvUZ75fE6OjslXZS2pnE6x5Gb7FbfMIQMQPSORCWOCDhEgyd2PqiYzdTH0s1JiMiAqj2HVxk8ppRe7On7rGPS19PX0j9MfVVbv/edF
+Ni/e8LDsqOo/0xRa2uSYG39HVqbYKzNjc9jcuu7e2sFkACeEpkTZpmwTNraSEpfK5goiISATb9Xo89psUoce9ei3OcfLpb7xzETVWTGhursEgKnRqSxnb6nH
+FD7Dj3ZKTOmC3siyMvIM+1xxiveprBy3vdTHiuYYj6zpM96nsrJU2cVOBpl/HHDhJALovToL380j+ch1O7Rct50Bqo4Gdq9a0C7kcDyEqGzJz7dCiiS9K//W
+oFgJhZlJJyeL95lqR8IkKwqYRx+ay6b5asSg+oYtNAjva9/rd2OeY/y/n+3VeayK0vTJxDHEnDeCrt8DokXaUbSUT8qfQTX6uN3nztXILeBbOQRWe0y1jYo+
+NtmYJliBBnVgFm0g0Rx+a6sCQ4+gVzQzbY86OZow/UF09PEQDL8jQTeaHkspyqqkOUygWxMCEdcEkqQP+mTZh5GAAEA458KyqAWsdvb05x1MIQQC1F81efps
+D0Jqzvd1ejHt0id4rArS86/Sv0r/nfBtdw2mvI7ZIfYKHogC5rizbuVx7biNjSuNf6ezLSuDZ+0KoZm0zAm9q5rvXj2rQW0nEfo2HSjwSze0nBZGF6ORdojJ
+8JTi2QwIjZd0cdkWDFTRhJKMYtR3x9jqGw6BhXs++bxlGhNv25HEd+i818kwKBMGHGErKOSTiqzyaURnn48nCUZ7MRbsy6E9l2OdrtNNf65dNRbzlbnQJUbH
+awfCDQ9HztwgzmGmXIcKVZ0tDaoSOYPAGQNOar4SOsm/uO4Q4kan2owLQTTSlKKtv1O/Ghz+P3JtsKo+ddlVa6DZ7UhBJ4DRQYbTrxX4ulftfcDawcLFgWm3
+nruUqYlHBUrdjtkKzo1PIEPLCeGe5kBY/unY5ciZexK8zQxP8g/T/0lXE1+QSBGPrvXrx/vmEF1RDTFzCVF015GG5H2Ei9pZ7zixejU9dVSZRaAjhdDpBMtE
+WeKRorDkTPO/jsW7Hu4B15LFQh/4Ei7Dwz3gWamio4Tf3wMGaLrAS0wGnPeA27PrO4JX/5c5Vmx2Gt1l1CH3wE0lIR1cAMvKoe15YyWtLrjk/da575kIJ6QF
+daFMKl0ORAok8z036nqVP5MpIy+c8uCFcRUasZ8N8eEHvMZbOpjMOVhgyMuxvDGBb72qKuc+yEHc6imd9Qde4s6vXSh4raqK5TIIW6JGQ/SHFXGnJHbuql9P
+yWDVEeOkkjwxGDrLrU+GoXg+8A0Lzvzj6XOuTcNzpyIkjmNlOu2FdXdvF1hzr+NtQLLeCJdlkDzr6mgsVBnnzav5sFkJcTCBWzDVhm9hpKLnqyTJhFLDgK8Q
+ZFfFBRPCCZ2gWttr5ccJXXK5809/1fcTzil2Syx72WmHmdR/89VZL7iFBabc+yMvMYF0W6hSUag2BlPqKCk6WUy51SqHWMGo8n5uGddqrZUByVIjbvfZF9KQ
+3pq+arVBq2tVlcNbGRotfDpb8wJUUgWLjxXjGzsqY+xrk+0WObUMvzaZwEg78Z8pDH75ATfPwx65fMZqY9ZSWWPM03GN6Qf1JPqanETbepE+dy6179Ra2ShV
+odpY8grH0bZi0TJwaxzqnTtFLTO7JrDWMojksDrVE2XJv3OFOlShkHRK+otFbT7Guz/jmq/GmnNUxCgpjUZnNhQPJp2bQ2hhV5rYRrsHQlMK/ccZ2JEdw4VJ
+SZ16Nn+BhIBEMnZTi3l0p69Ud9+lw6g24DC2/6t7t32n9eOuuibBqeEQdhttEO2PQW9zX0PeCxgkIg+gMPeb8H4t1RhS1RKCagwSBkh8C+SZ4Dh3rPb9i6cz
+TyoFQ1OHcX977UirP8+S1ui1mdW7oqE2oMZZVccItRs7tI9BR8BgszZDSkZIlhUmVaHye0cT1oPYP2b9FA0j04iSIoR1wY9h79+vbpwJIJy7ggn/+fTLYa38
+yf1aD1Zb76oJx7EfmY2VEw6M9RR/Ep4FTRCvsZu2Jrc3WnfvpCGkksDkJ7vNNjCJDx2w81irUUtuTm5QgygVap1r6nlmLl/2XyLRqjuTTenoS6aDVqtZwe/D
+/2297VwLnb0ymLZjmb7uKNcbK6mHWs12w8bmLgQmpD2e29yVJRVLiPJgGDmOBLpi6vQ6/+b0dfEBU5eXun9EH6Mf9SpfZdMO42WSoj4+55hH1/dU2GvLEr0N
+1ewc1OvphWI6QnYKoeRCy9Ti2VitoK2iZ0uOXx+8lt/seNjU1sm9Rs5E2cEWzlZjDDOcrMMxhtLSzmsEh81aD1NJWnLh3lFG1JytPMp1cTeJFMMzEr55U0kh
+f0KvRC3ftOt7vvFALJgmUaf/B6rtcB4BHm75bUWyVLarnDOdDtRacmRFhV9pryTXrb+i31QzJgEBKQOu3PpsgHiTwoFzmBXO41bJrP44TWFhrIqW63XPrtNL
+jOz6sfQv0t+k//Z+dZCD0un1W2PbVpprrVYjwBCz2cbaUhctENF/MmFuKgCzaoWg0QaydBtDnIq8hZIFa/JRj5CcJCZZouLHIlzPrqu/e/4DjnR4LsT2rUvc
+r8uIOs7prYp92NxHvPWhXIC9VkjUuDED75aRIr4FfMdPsVfmH9TnSqmsZugtsCiT1hehHaDliRbeJi+G1gM9xLGCEo9j8htN30231nDQ6KgsiU+ausO4t4DE
+CtVXevUVV7ktHV3emo9jlZ1EgnLlHKu1CpTcwhe8iMpc6EKzxq7PEattY3vGhAQIqZkm4h8ZcVK36cse31vdtjg68MmzNq7eRmYHV1Raptrx4PgoazCO92EI
+yiGcOyCmtNtKxFKmzs5Zbkc+ogUz0EPey1/IPDo+rUTptyW0ldzQOJHlPax92FDqLUjoBYq5JPRa1bxkdWtXpvwMQKPpKfHeH7Tsp62lNGFKXZYq6qc6SPKQ
+Bb46NjJX5cm13FC+sphvlKZSVWPFLgZcYDCVi09iuuCdtRVVY7LFfDKj2WJkd1wWf7OMqIe6/JTgMSilodHJi+ib3w7dun+b/lP6z49wNg3wxLhXzcguVa7G
+wF2KjG64hqqwvQ7Yjd2BQ0tFYz9R7+3jtlde63enZ4ZdNEdlkrqF8k4r9vkuVQS0qQ8seqh2JO9n4X/kZ2DFEHbSSdTyFIv3RUWfW11M8rZjy3d4JLy4N5Tw
+dkWOKCmVIJ3UQUAuysTYO4kyU+5HPTBk2rm3nEPMzyGLg9GEmkxElFRdz+9gVffabbe8Nii2dCYaDzfz1Gjj+oFxES3oQwVNkmAstH+5JN2mSPNmdJiifpd6
+qMUZamfvwoHmw+uoqdjfM45CWaNxpysH5FEEKud8hf1me0l97KLtLgccwtpJWLrVTdhM+KZsBjhflshTQM9M3erIfw4XdrtEizvdKVCnE8pRm9ahRlLGrcyk
+zWFOg5Wg81aHzuOR6nN8uEADNkA2vFyy31iJ9WR2lqB1cqZpcK4LbW/LhoyvZqotHCQQtEATxS3R+rxGQk0Mtk7vTB8NXufcOx+rwt+ifvkQGuoNzEe+rwS2
+roI0HIbrYOppFq4yZ1ZNSITBi093b0KKc2RllYyA62lDBRRqfFmsGuCfZL3hiVaQdYOWSor79bS68BWB+A8jVncnFee2VbHZDsZ1fmAXWgDijk4ENpQzlw1u
+NHQvt2KlUpCq0IYZJ77PALMmhUBPCEibMuWbHFzZIUZtfD7fhTqArjfIawNAgT3f0mhp/T7rjv6z9Ffpb9O/T/9D+p/Tf0n/e/q/p/9X+n8H3eEHsmdHdu4N
+6q0xQNfzcW+7o36zCYH3m7BPHY1zGtxEz5boDHFWGyEcFTQJ+UrirsVEkd9I9jHwmtTkIcJkti45nUnXywNYDV0QeZWbKx2iBXD2ikLQoQIR0PKUrtkZIypU
+Kv+kzONxsNNzuKxN7XN+d9xGee9FepKclzQBuTVrZCaRtN0ICIBauVOA4Fd6RniROsTIMzqrhzA0EW1Z01K14uvnyKGu180Y4oqHVR+Un3a0cZCrN+X6qviw
+YlVJomWLMWFi4F0PWiUH9yyJP6RG9m2mh/TF9IsBm/hPg0doueZ6xG/3fsJXhE895bfHMyDO2JI/bjch+fGZESODrOmlWoZmyZRpaSm5ik5lakmTxEg1UgnP
+BATgWgbWOQi8m65ZnwDIsiAvb+ooIgFAelMYP2EhfKVBeEoe9N+mf5f+z+l/TP8l/R9xVA+BBmrSYiIcfR7OMGpX97pq0OxNMqPKVLrksoNjFgHsQrMdN4fc
+PVQ+4+BFdKfBTCjFz7axvMQ5ECtoTtTM3xSb9QvCcEtAfrtP0E7V2uesfCH/sMO3B14nQqf383x6Mb2SPq8Ry+/42ZN6h4ndA5xDVy322Vs/E3Py6M/cKzeO
+3u7Zat1N/m03esquOp7AirwATfqvMcURV2YFeNnOELd3OstJFGcgt/rDrpFs8BZ5H/P9d7EO+oe11i3746+2/bLq/jbOkcxd2Ktw7gvO2tIS2nkr9hCbH22X
+WYuXC2ZB7aT09tjU5Fk+4BQRPB4fp7sEs+NU9ca0fSvKkUTNuVr6AzJK4jn3l3WcZLf0Xvp+UxuoIbuolUvdkfdNk+3hA6gioWTz4YjReozExVxFQnlCjsIQ
+NsELUjG2cConKvUmGQsGhLdcglbjorEWWlSe0SASIwqz5Qq/kF52VNSv8Wr+Q6kwVQbwA6vY+wLjfAfuRBxuHzAgycbwsVJ5T1UBuvMJE7vSnVY7ZpcuW1yy
+M4IGgMV1VbOdFrg8720USyPD2rITFvG8v6Y5SKFcNAe6g2TkCOggxj6WgRw260YGUqQrTl35MkutsvKeJjQllEmvqKqa8la2pKeiyXB9Iz+jynA26o9tvPMu
+x9apVuaVKe/M3FvOafFum/V5OI6bh3UNh/j8oUJiQFrX6qwV+ZTlQYqKcCUrIHnQ6uC05OOUbcEh0bLcodQarQPajk9RfCwjI2flPw/d7LCO3Lfrgb51fkA/
+1KUz/Vn883MZlcNxe+1C0SSxHJQPtCQR3XAvyDreklZJoJI6WNryW15QGAUDljfjrjrb5dellK7DeBbv23en96UPKB7YlA7KmfAX7MH970LNIMKOKtNX3x0t
+BKyKe8OheqDX6orZEc5d+tVSbtjubwIFrSq4XDk+9sZ0Cadah5POS3SZ82pMDr7mpDZbMYSZgrN/QAmiaQSRu855bFimLQY/fwz9M61yqCf06wOG9oPps+lX
+RQUoolK2hjqxLO5BuZ8qXLY5HEfn1LdKLtasGO/9bigLq/l9tjAliv+UPygpT+yQZf/MTpH6pmt53nd2UENzAjVfnbDVXzCVlg84ft26i+lSikIvTSbJsx6u
+68Dt2M2tx+W9sPqq8A/O2AtRrYznB1Wqx0TZGvag3uP5nI6Zx38IxOR0pMqO0b+IaOdMe47s0se9UvJrHAM7btD/hcKf/FMl3HujlAXb1U2A/lfvAY/0pBWj
+rsgA0pwXfQqbN+tE/KWtzYkaA8Qlj+EVLkUkkfktKYNsqUrSHoK2izhpvSu9P30ogSLGjGHEcfB9GmYetNYMwXXdyhzhGSXvn9anWaHKDmt59hBAmyOvxM3h
++ISj4PIY76dxwWWiyXJKElETFObQlFoWt8zAzGOuO9OCxFlOstSCQAbW3KvEKvbLMh/2oiwj2x3KgfLNnR9BySo+5MpwP6P4+KSaBQ9StazNrNt8+dZ1kEPY
+Mpg+jtRakRBbvjeey65pAoIlKW9F2elgyXRDKo+AehZaFWDOxvJcltW+TCaBReKjVAKi4btkbW7CGEV9QVGc+iJ3Ln4jVAH+efpXjd/Mxf7rHgsHxzfpMovm
+YJlwV+HzXevTcw/v3Q7cNDNvF1ZBrBZLPOPxMW9t03kqPGpzcSn9cpRweBNSGL+X431WdWk5CWVl/2H6s/SXggK7F0PtfIVNBarcm4lsovByTdlcr151G5bR
+1bLKd4Hx4+4ofAxHs9xYcokUAzL2z4QO6WmwxX42nXXNwMiNUTQ24oz7/P70ve5R8Fnm1P+MOv1I9iqG01IgGRWvfY8Z3cbadKNhTYZxOGs8JvedMzQlFEqN
+Gc00PBNSecf2WSuYksG+slrWOIJnYSCUv8tLXP0ndBRCD79U3SNfXk6JBmkeimxb25MOM1vB8fiQ0xjnZoOoON90+K9c/oG3TL35aoaDfHnU6Ak42Yd4ZWFW
+Hnjg0VvDfxmrz7FM5jkSFeyd1/G6tMb0XHpn2nezhFebbN/D0YXCtkqL92vuTcytKVFmRPm3GXmvf11C0T7wq0NYEetXsku5Blq4SipEz7FFlm+qhg1fEQin
+sDpxyC6kN58z/ESELU7haduD2nURfNXbtnx03NyfYSo7suPsROSj96AkeuJNoVyOkGrbw00STqXuOJoH2zqEfTzXYIdJDjcCCyy/qdEXeX/6XIN4/JUWZT/z
+1c41eazxJvvr7gLY0VsFEe9YhfGqSN64gHts8PSZUMaAACGT+ClLtMzEC21QSfYGvCV0mJaMzh6kKnGPc+hjhT82Hq2lrvX5dqbk4/1d41xRkRW/w8eQnSWL
+rkNVYFNWTSeOuaqGrRKsANAJYM0bnW2OMoh6Gi8wQ8FaxUTmQ1qjIN8F8sxzes3z5IvppzW26VVvKzZD4SjcR1pGaRyUXjs0tZtNLj+zv5IJwe97q5upLHaH
+x0cTnxBSlI4FxxJct7INIKbzGUDCumZ8QHWFDeTI0TQfGatkcpt17/j29J3p76UfMYf2sGJq9r8ECK7tinuV7ZvGtj5vo7YnB+YGHlg8y1cNV3g7kLDI/gFG
+qy2ACQ38B2bU0lDyO6QwzH1e4huXTlTfu88fJCv7Ya4HR8UHrpdczo8dDoMbwak+uSHjNNfaHDbXlUBaWE/cbDRbaBm9m8O5UPi4zZb2W3mERe01wQgmcVST
+e6iB2ZIaFC53xqTNPCmDzHXaUIx3+Fas0jnkbbsen6RXeM92VKYcsTeX+qFVAZYWGDUGdsOxU9eq+1XZo9ZqDUNzk7SwB2dlUAIz28V+W6iZ81C2dmkUsylO
+yhmxrUjZHVjRXDPrA+mT2oF44BxbrpuIBEsLJJFpN4p6gua2r666FdidW14hId11pR4ihGT3C2i7Lrn5suMI5aRwTaqxWuSEvU2xAH+dkmGGtjWJ0X/aP17y
+xn4oiD0n8beJVeG2F31wZ/kqGw6AU4p+2rPwts58BRkBcBcHlIjpg0R1CZ4JdKP036z/KvbbDW71b9O/Tf8x/V/T/y39P8MJ54j8tmC2vHFZALRgeNbqwLu5
+1cEcrH0NupqNoVodbLGr7kHVvXEfgSxwp85aHvAsbt843140mVABc8eiC1RsRfWmwD2pp/1y6Tra/eOumxB54JlvhryyqeUkDWmjsWjZ1UwNPpKCi5WAkvmU
+Hb8RvOy9Wb2zJuIhGyosXKoX272nrO7NxrRlSO2aql6+RKA2tCETM2PVbBuZDJ4nd3eN8gDOU3jJdyJKwmwl+TjPBJBebLRJpfsaI/jQaxy7EnFAlgyHI+dv
+G41YpFNxvwTXISq7malF0ajcHPYi0e+cQcXZETXhuNEGmzpIlrb/hLqyOxZh9zaFOFhcoFt5rrtYVeffkwMH/81hrRfEznV6mt7D7mvGMy78UWM7/ZP0l4yq
+Coo6sgKvHhon/oShAldeMW6jjx3/uPwee1wNtYN40VPHaBjWLR9svw7qOxOnB+ZFFoaWAGkC3me9fj7JmTyhWivrQ06QQA1JtIU5UbCPrtTmdtTleAKEBdKd
+w+pRN++4Chqu2VvTrSsZ/Qx3LqV2+q/5DhwVFriJBYeD230e6vBXPmfV7XPro2bZM0Wnlv2ly2n2clV0QVC91w2gbVwLx1P53S3LLIeSPUhAR2EcTKwDsCYd
+pJbykrBT6ElmbSoLjCPWN/hfBj8ls9z/9kz7bIi9FX9wRhHSSxQ9NsEGtmUyPsqIzBVFWSGql4OsFhJgGKe5zjNBJlzSg2w+DUz7B1zKlGQ9BNzP06aOX5wj
+RKuh4O0LoqpyM6qRC5eWbx4QQTdtzCGCyerE2xz6fpMV+0d7drfmJ83vtTveqY3qXulNX3d+nMpHCmqHzvhbpOJPGMNQrUFVDRCI+odvCB4cT9JPBMeS9LTf
+L/cypQ7xTA4W+Qc7wEf/Lo/JfsZFzoqRagsocXkgEYaIpMwgcKQLl5O4SqC7nsWLKdsAqUobrGlSK9CMVE8bxFUdhoavITPoF0MP6K9t/UmVuVlni5vYfo4d
+0zDo2kvsyocSe1ijz+4bCjb/0J5HZxdGiWkcBECEbq0vnwG1ImXJObFyflvaoog0q6lBPLHj/hV/GawUgLfGZy4doJWIKUUeMkH9tR0n+fnOqarnwxiWp1GQ
+uY/GHnRdeZKVrmSQYZ0b4Fh2eKMDaRp4mbzfM/NFz4ky0QlhrSUXfs7E3+VQKTQjQ2eslZPWeq6gOurJoqW7aIXV+ZE+n26Ys1jqeIJK8zreTTtiZ/xnt3tG
+WOicraeDGez7cG20G1lS5NtKq+LP1nKVUwTVZwDMxqgrRXshta9VQxNMsciDyLlVLZOowmYHle8qu1j5hpy72GAr3pRedb+c3xaH1p3FWgdzjJKaAH8iFRAu
+R1k4wVmff3UWGTp493BUE5FdQ1BsdDYNL8azSfxfku7QQNy1x5DjTXLVZKoTZpmkdhLWWZVHcUHgfbIW+anGznrWNnvaS51O8r9P/ymwDe7jYgTxr7Ct2afB
+ScM3e+UDSWrsg1Oe5CdCZHoetCIaO2vlAFVuR2RwoOkpBoA1QUPpk9p4xtCjpTmOoGIuNHqmRi9OcIDyX2SAwAHt9cGow40Wz4HSk3QWwZXLnd3nMzBaWSLE
+KFY9iKUg7kTwHy39BHSpnrNqKM1fHo7bKpPL1ZSGQHCiql+ibyKyp1QjgbXwrlhOEonSqAoB0aJwPo+OVhD6Jg3/AVQDguxw8q5nUfpbf8j4n3+T/kP67x/U
+0L8NfsodNsoUxVof8vPP24z2hf5n5ck3bkNrsonyjLUiLbmUuKi278S7FjXgj7Ddv6x2vLdcj1RxvNhM1f4RfYswSuuLpE7v+m0cL/9Mw0iS6kMrltI7GF+E
+grnt1TEcpBbqtgfbM5YMuKwWAa3h8Qz0Ao1KiH81wdwNuRYRM50HuuBirY13lnBot/3G7+LIpsQ1AZewO86UzsTNNzr6rnVELjR/5edag8Uj8rUdSTYlyd+A
+lK2CHRVcBgTvtGMiMIt6wQRmNo+Axp5a9HkNhjWEzJ6rLuNpFs9UNtCfMxKorPD/KbgXHYN0ouWp8YHzwWCrZbSPKuIVERJA+XluGxhUa55qzHAccwDi98sU
+71u35lSPaI6CCu8g9SbhQZfQ2qvsC8oEtNRXKsFT918LFouyz8/qryhoty91PoPqElvXeVVmOGjXzjO5YTuvM9Z8cBj3Q3trrhbgMs3yllzOXro06zhE8oVX
+8z2smBnN+jLV1YwI8Lo0cRTk4bVlhCuSnFBGNKBm6qdNf+6XWsaax9kNZ+EQxBj0XxlB+/ggVO3Qnjst6sZprpWxRrH8zHOO9E9EpXHyVR7IYA5UhQ1CvGa7
+o742RonuQaUhrD0qb1jCYcxe6nit6SHk/M67IMFbw9Lk2sfc12EOhDbJUo56UA+9eeNYmdQtKjyDpabV5UaresQb32RK1RmRARklEOKj/Qx8nmB2VpCnjCqt
+P0XVHw92asz4qc4d9k8d81z/7VuiQWCZbocK2NkcInNYLO3uvQtr7fXdBm/YUO7Ttyo1q29EavVCHYFyVd5NzMKgmWEhamVL2uXktl0LI+tqEg3SPtQRpOL/
+hc6pg0/cUXudeuHuTHH/2aolad/1jlc+pbbmkL1TNlv92BIV1hJcn7OiIMXmOv882fskrrD2rzyG4Mz0GSGBmrha0ZdxmP1vw/f7NkW+CM/oc84u+r2yxz2A
+Gzvov1E/ZiebBptVz6qDO0r3DSFKi7r+TlHjWavYZ9rxWvdcAadktAodIlRMgX+FKyofGKRXVm6nJNjAk2M/rz9W5W1KiK4g1Wq6CJej7HR/pZ3n/5z+17lX
+QU02XBxuUT1JtOPuExFulSzLbblxjycr3xwP+82tzs8y9gLatX3x1r8ygDP1Bl7YhDMYwhuadHGzsw5mgAkkiNEdD/nphEFbCec6OiGTmdD5S6RUrkafqMU7
+v41r0mWPrBXZv33QBVhNLyXz2A89k7dSLa3HZHvDwUFbbbp303ZiXSHT/q0V+udjWHlongAGKOjkGQk40+YUdDyos4iMseBEVfkaNT7HjP0k9geeYZCnzfMa
+Y+mffDFwlRQdp12njZUerIF0W5G1lcAVChXrBxidsbl0Ow7jXO2FcNJyBBGJnBWPA7jxPULHKCHzaK+dgbbh5KORncifFzIaDSL5P3CaHUC3n77L+W9fnHu3
+hQp2HxXPq9di170PYqyjQyt7qtxeh/ogxdmqGDOGEVQdLB2ruKJFKIevK2YmiQDLgAHovJtcEaoMKXn3t69p2zgBGEDEZqQMMVIvK6NrexXm3mdaTKpx7x8y
+qt2qDFr4/v44HqVPCWLT7h2JECQ7Vosn0jqBbkBn35CKHj746/0051AdykjuXY3OlpnOVk+VaaEYIlH23A6W2pYfyip6d4/8OA/qKfqEBbQP4fwqQLg6E034
+ulTYKolnOlHjcbBO36f9mXCFQ7jC2fU1qCcnYsi1XYkye/iR3XAYbvTq7pZ51RT5qznpxrVOqlFO8dw2GmNOeMK1lcO4qUCNj8E6fQc7fVQ2V2kWyaHLtiij
+WZtkvan7zSPu6P442lUPu8DR0khOEjLAFZ69o1o3rQtGyydcfAUVqlu6r8j+uND5NqzTm3k/t+t1G9/NYROv8huauuZtCKLMVS6QHpqy9RIzear+8LQVfH/E
+TdYqRrsWxaVw0/KBhmr++Jov1nj1AbM3oWyME+AS8HdxuWZssXkI0j8te6aMxT3DQLxvq2BB1KBcp7ennwzKHZ7+jLy3MEIuf2OLudxpJqVNSa0OVnTPEjZh
+DqG2Slq3gvsXcer2ok8rnujvcLcdtm0qyDPiRlpSF+66U9X3Jgryog9M4TIIphUxWXtvxRP7WxtvuOvgY18rdVYx/ZfNHiab6lEaQ9/AzWV0ihPHj1uRQBhn
+umCMvArGler8ffKebVqbQN/ivl4P25yqJDK0Qp2rEL/ev+lXUao5QFb55nHP+HXFpv7xEkrY47RNu3FUG7lvYPPgT55W2YIw1aibNnTPBpIkFFO3b591pecE
+iADMm8GH9lItNVNzXryTGUKxK3z0Cp0QcQePwQZ2XFiecBdMNgl5dD8BYMJLuZkEfEYubio8e/iqVtVbDs0nGLGbKM00OXHy0PVqvyt9hdXJynxwveOd19uU
+LHnoeoK+h76WJSa1kI2gEm6Ud2hGjeHztbkiCznO9LTOjsskYQWBKwwk24BJMxybVboDn1lPAKgnDNkgG9ehyRefpOfTteN4hBn0Nz6CtUDEDb/R7Whe87nb
+NB7k8yGs2CH8yv2NntFPZGeaQCSm+Nrvj76sKuFyInrpUtsEMhmaVIce711ntjtZPMd3oqr2Zf7h/swzlE8Xfx+3FtcYfmc8Lgra3rfuKhNoVPSKMw8oJ27f
+4Wpxhmm5kqiG4mTQY/I+d1xzFcFTlmd7jU9UCSDMGLvOvV6oiiWOe/MEbqbNUKHCh+NrOe9y444rcF+++YYRLNc6G4alPSnJzku+thSWWVer+pbB2WOsvJ/F
+7x+Sina/H5vKrsW34c7XKouUZnavfa/Ktng00glcOgQtjNy3UZMf40Q12walNmrv5Z6N6DTX0RaM/08xxr/Urv9JdCtU4ebBE7nXlrplq7eoHpbrXJ1RjPBo
+sd7ic+d2EL5ClYK0vBYQcwmRE9yT353kSyu1ujpEo539UnB8iV32oCBmjMBNBax6x2T3jcWQ5VffVPEh1hUzTHTe1thyWdCE2V5KusMzh11XHNCZVN3SWy0T
+l8w256B7Y8lTtSbFGYMF2zhyk34wnnm1gmfFzoB+8S5Arfhrd6WtbX2j6dmuCo1Pwc4GHDJzl3IKB9o9AzyZwETm51KDUAVsScf5gcKMTv/0YrP//2zjS4Tp
+z/pZyrVNH4+NKhe7r2rl18jUDSN2wd5vs/LWP7vp5mdVGOPgzGcpTLQOQ3enIdO5s6HrEjsIxDMdfoKm0XH1o5UBmrGc9JBtHP71p5DxSBDOj9IdFb/KwCLx
+nEfZ2cuxA4M145iNw6HDfOytb3qU8iWz+9QIVEb4quoLdjzHhbF6FsfJHNWUnyQjpocKKcepxhzCF5M1LIVpR4utuBKt2E2kbEBbsj58HS/Lub+qY9bFX4xf
+HcSes7bm7Kg5xwJdOlaHA8O11v0YxfGh2hNaDMbKBdjYyFhoUCbXCysB0kg1CRdiM/Y2zFBO4JXTCGZ+lTs9bX/LIzWVjWlqidsqubywhz0iPHW0+XBQqhZM
+KLdbbnT5cE8edALx6TBVWAJwsD0Ct49tq1qIWBUemxPONDTekb7slZjf8z1/2Qtns/tGxuBqECJDpR+ZIuK6xqp2u3BNZ8fiGbkIVTYdDIAVhhbtfYNBroU/
+pcb8psmhN+kd6af6SqyehtVGqfQLXZHnGzvheOoxwJFNpsbNup54RvyZMKcHzjd+tiDoKadqu6VdRQ0lHjjVvBRbfaZijvNzXFn40/k+op6esnVwD6LqE1Ta
+82Mmze6M8ysPTpPmGbcsqjsuBgDu/oqAIedTiVz+2QCzW5hAvfVrQmz5jU/SF1kHrrgfpKsqP8ZJ/TgzJzMU8ZL2SmuPFIP8LgV61JB6CYNfGMHKJg7WbM4g
+jNhqwi3uUZFpbufXiqoqSRsqWI/bf6emS4tDbW8tJnmdPpJ+INSSS8RVXb18VM52WSsJQYUKj7YCh/0QtCDXuh4Zz9OuS1horpLBPa0ug0A5MXS+Lsd1Apr6
+6goKsAw1q2aTXoeMqejjKoX1WM+yTXprekf1XjbEk0jjyarJtRaxV0OHUIdYCNgPeuIf75cEunJUwyS0OXxGGQloXXI7nSveXs/pXDc6VhsAqnU3aHCj86Ka
+Wgafe1BCFlhNvMY9P8XZdBcfasRjIC+V8hEssKaNj419epsf3ZiEiHsUWTrfpjQRa7z6+x1cZxdlwNghRgCAE2Q9ECY8FwX5LzyJ0W+FxjRn/7fPmaDZ9vC6
+n4y6U+RQsKsMbZtCurmbJ8trCZAiTkaHbKLJsgWRPVp7KQsAdU4t2HPXGSUcCWy4TQBlbsquY3vOZPyopW0oQGXywl70oc755l99Q/nI7iw1zRQAJTc8WizF
+y738jmM+A0gfO1hCqP9pUqyLiINvlZXVnnZORBM6NZTU1eKhfCUS0MIbKjlwRjb7loYXakh+qaX+hbprF+WL/ynWesZY19m29kwbr010gccFk7Gxe6hStsco
+BHdR1Sd77fVs39UzRLB3VXN+qeyjx2U4rEGlbJZyIfv+so5tU/spOgMFfci8NFWpGI8ergSlhTD1tsfRSKmjWS+FqmUjcX7Ue9QS6/dyaw7nDpu9K4CvJR3j
+TBPKtTzTISObrZSTzsAyg7tAZb5JyOwFZasCLn3XfnfGIP40Vb4mtYe4FU7KjzQYhlKxsF1Bd0214AtosCZfODpWrEGGaRthvrseTeR3Aph8wgUWj3/iQDHP
+rxHv0dRDZ/vpGVJr+dv0MfZYUa7NQWeBCwcEvmHRSKr6SIdNM5GO4vmvvrqCcTQkPYHA3uSUl7IoZPIQK3oerVIf+p8IcTV73TvVGE25qkVq3Ue3THceK0df
++8rn8ZBdxXA02ACdhJumG+AEkONlyJuLsmSL+9REqfbVFt5OCWCNACnUHd/caOL+MPcvpb7BuWtV1lfEjCGlylcMpLGJuB8s2eI8YQz17eh6Xt6/1+lS5Tc8
+cXKu6S6vQoSbiWIjYUoqMx9dz4EXVk4Ia1BdPj4sHNS6SvLphJqwZ1l9JzvTsHpGZp2zTbwzpE16idkVBQ2cRsMA26bE2fzOtqlh4a43wKvt4hyoGiolmV8h
+qI4iTrRCgmYW2Gnsc2FyvgNpY0lnRmpm9kTYO2SfAMAm+usaLfhX0+d7HOr9s1j0+LRZafi6wTXLxceBiHifyUkvwK+BKqR5Iosg+FrymQPQNdhXGhtrrY7W
+tIb05nRIP63o7XQldTp/TaIx4uYxAQsYAYGWB406vftQbK9hwtrNYq5dMF3o8nCXwhcAmYwQ3hwvqN3w2mkgLWYaF5f3M/l1trdql5SfFnDYhdc4ZzVKR+Fx
+3MbjdvPUmIzj8iAoGaMG4jdBGlnMXuvXBslewxLPcdK6/9Tk73pAuwY6Ep4A8gN8x/JDeAIhOtZBI+KOQXeqNxG+YA/ANfyeprelMe3KyClyXSJp1qaZN03W
+NJGcM7ooSe6sFA35GxwkS9zV4G9eYMePH0ifcd2wxBstj715fx5qNWh2LxpgVrBO7IBWu/BirS+gihq0BABReFWSh1Zw4gAVKYKsIszKrs6j+3DevMAj+j3p
+fel700f5WqWHLSdOd73BjEkXrKaLMmNvQlQdP683Y1dj7YObrm62+3E+At6OjnL09m/ypaoJcTMQUiQX+8lk5FPC1j9Yv0jBA/Vp2qWXg0PkJ9IPdercvy6c
+k3x0QYumRS0ZjOxZB/d+OdtuXhqWJgXZKcDRJsoqcaedSIRwVaA+iiDWaZK6xEMZUdkkI73pvEon7keQ+buEBvNCLGnDFrBW35Xemb4nfaiZP8o/GI/VHm3e
+iOBtf6fk5V0zeFeOaNBBEKtiEZmqQ0iVCkyAUmxCWCcZG4EMgWjCTkiB7JRlVmhL30sJOhRk8AWvqcs113UzzpxZ6kz5RVVVGDpHM9vhzTfYOTeH4UZ5XKwA
+KT+lLE6zOltKTjOReiHxJwQrl1KAjHBKPEE4l8IFIxUnH/vxrrKid8mZx/XZMxfwhiPz9vRdvCOrknG3D45iiM/DsKYTuEqxv6RMQHDSAc9gvvVd7vXu9AHJ
+aK/P/k+V7q9D7/8vQP8/V2kZBb3Hl6CWSACU/Rx6ruOqRm3UxNjJsW2UqNFJVly+0U4rj6otate8nNPDp7onRHjzGszPRJY67/uT+5Tam6yG6sTI39TnoUjo
+eIi99BbS4SYO/fZ0mz6RPsd3Yd/qXrhcimx+nHC18t/lQNxVea/D3qVWsvb7SPIDMRPXFz+hJbcaYmqyXmZpTuWegQh5l0lDqrUuL/x1YX6+avntaGf27Kwe
+8tGP8X11SVY+/zG+3tIgp6n6xTLswr5CIFghAEwJlya2KcBM3CrWdXXitnjsP70jfSCox9Y9ZXE/0euaD7jN/z4ezLpn+NbhV4OAGt1Ztyd3+0SGbtjVHwug
+EhErH+3TqqiXbFIYSzM6UUcEe3ONKs3YXFP2rVICvBKkNrW5IOvpBaBMwl3ll07yZK2Q2j5IpwT1mvxcDffk+XTL9+NH+IxLh4bhcO6QGzdWGTMZMhuJeo9U
+Fjri98j6JnYjPO1aAV+z3CfUSgOYQCcqx9/upN6teqdOjs1r8IvPp5d5tv1w049Lt901tlaUQd1w2N2z6F0XKIKvjZ1Rrt+ulgjNEhXYZofILREmXvq0LlfN
+AxN6BuETOeqom6PYupyiV9BM797m640i7v9YPdZ1c9vripNWrlfzq/CvrL8gBbJvtDHan9DfOQjDz9bA4P8dO+GX41+cTDOafjLxRx4a6mFdqN9c8Vcqebky
+EjwgIF8whjyIbDDQS6f8dSnKAhMwV0qRRT/2w5ksjrAp1yZky6XU7mM8FIeRuQVSXpsakrlXFia9Qigz2W+sFZqITmCls/StWl8wzL+8ol53+6dib3moXdQz
+HDy1ntq7U+2os7X8u9XjeEGPycsO5bG1qei21Ds54uRuVsGMEJpNPhIIws2M3DuU+3dKTecB2wgdqcN6lDqi6HKm2js+f/GsOll2rsEXO8fg2lgdDgExyqep
+mFENbNkbc2+E7uqzNUWo1gtdex3hhDJjkfrLLjt4ogxAKqFh5TjISdAb2aqFYX5KhJZyVPTfez9UbZ+bSMCBTaP140J7Tnumhlsp/6Q+ZBKt5VW5OChPZM5R
+snfnNLJeUdPLcKsW91az73iRONY/R85TS7bxc8bCuPFqp3YjVM9yHEwHolzEIJXSh+6/3uzyU0O4m3WiVYT87BZTTjEl5b4ZF8fg5OtbgUzdPV4ZG2Vt+VY9
+dzecZYlP1E/WzHIQgy/Xa3/gyjYa+fnVjVoGH7Zx1grmfwKzWegXsFyhxgfOlckJia9jnWaXFi4Os9Zlmri6aOeW/NHU6PXfpXdKT5WKOt4IUyvePXIvhP52
+2ewjLzippNLSDSrfsPd2o+rcfItGRz9hu83OhAO3jeZt4Ze91pu1FwrFU+k01St0RRMtzt9321RA4mQnIWqHkOOn+26eoBT1DkYc6j/gGlFR2QhO86NptvB9
+GStBavlujvegC3Kl5vXrkqkvk5FYqOV6d7XbSQqLtQRASgMi6lneKeIBdFya5Rny4fenH2gqIL/ilW07PQ73XbeX0G47BM5tvWrTuXcYVxwBnq00BWUgi5sl
+nJwqWKTcwCnEGjN2O0plLOCS7Morgkvu/3ONF4Ltx78irlmqx74fWs+DYTvcPxrHZVH2Zhx2Vfq6HQmswV03HlTDhcmalbwjnCoTHi36yv2gYNjQMTAwTT5F
+pkRWLUdI79P6/Cj0fkEWLUBpjyJuJriDYc1NwpO4zbU9GfFb8RZMgLyWyyHMFLDykI7eQbXoT16Pj/tRwZpLBDVZcxu+UfnAh2j50Vstxxxcuvi9He+Kj46p
+ElNt+kirpJaezA2FyK8IK26SLy8DTgAZMj1zDw7P2daJ7nCd1iUzLRX8dCWGBq/K3piHcVhptiudjzU905kt2k169khfbs2/5ZpP1c01G+vyb+H35SdpBVOy
+XwVr7uOcBLIN5X16XULV8LxS55t0LVUJZ3OaPSvCCmFK8lpwjc/S69IzLIr9Yl9HWesg8juBz5TvTO9M71ZfP9dP5/hUz5L63/ChsOP360RE4jlNRFjHQ0pJ
+QhZFzgalG2TD6/EjcHfkHVbb43nAOc5haP6j8lvFZ1gxffzfEJTgDwQ+lJP+9tc3XhbPp/em2/Qq30E35pAjzjXZQipl87e8mvgKlEcGrQM2V7353gXvp3Vy
+iZYp8dE22UtrsM/PpW9J2/QKd0PS09r5sYGPolHl77Crc1DVfuVFwKr8Tyr6yckOmf9CTiR+mtwXP3EZ6uTmkUQt1uOa78W2Vlo3cjdkpvG87e4JnCBL728K
+cxjNeCOndTILZxLsN4T/a2Tf/3J/UsV816NqbX4EOvEG11CQJh+PAqHBJVAbyaRtPDLdtBNC4cLwa1prKiMUXcF7Nf3PN6dv136L59zb9hjJs9uQNagFixBA
+QGQZJcPm6iTPk274MwCkdQLdJ97BnpvpSvd12duNIiAToVBA7L9dkxjwlZVR/kNgAKL23aUPBSep9orle/LbLhyxskZkN3kbr5Hv1yjZ9gFfF0OzdgYXzBir
+PxvHYjIkCm7hz8cSqQnQL3Ui5ZOnbMATqXaSmqCKnyYpq5QPVwlsxYFBTjNgeuLX9M3pW9N36F3cG0JPwRCiuzAGCpdMNJ7eJqbmBX6VruEDpmzJK9BCV3mt
+ADLEtErVcWcNQqkCQIB0pft2QSvc8Fq/lbhlq7Dy4XBsN13HBx6O/YrLpJdLK9D2ITf6helVdtiVlvAnHUBBzwczbduHrnz/tVF6v6x6XoNSpPeQopaYjI0U
+xorn/VSOZd8Bs7B3w3bA681FSb07QnGowmtaa4/4gwEHWHeje+jcuXlhFHcoVDMhmYRT8/I0Eu9bsav29b3ez8vn0ovMJXgp7fhcWTgaZ7tlghOATJ9Tc9yH
+k3FF8S7ZGgU9x15ML6WXw8hUWUCXxXAS+/44XkXHV5GnPOjs9uIJmvP2lHTnzOkkH3wUSjSC6FuoSvETqGYc6r75Tsc4yO6xPwb44n6juCkzQZYWRRazxjpo
+8j4U4RBYrE5GjvlcE8nATSm7ZJgGqzRJeMA//UxGcZV4vn+T7w02lteKc/mB9Nn099OXlsbzuHXpRSWwDmO7MTYg6fJ+x92X4XBsli0ENZbJt7dcYwni75FE
+bRa69bCA3HDQEMsgWEh1FXz4tunl9J501PNrgZ04bvLynNUQe+LbC+UsiUFukd5NlTl4aoK6jLqvgNcpviN9d9pr7Xq5a+Gds+6Q1yOOJqxNSgSACTS4ybTY
+HjtBON81ck56vuIabJ+7sj6yvpOocxt0xY4a9zDPULcT9ffgCDopMdKO8jQh2XpGk75Ea+qjGFQAxPhnnY7pVUc2GCNrb5ifbcznDsemXOFKAserJhTZDdJk
+FYVfIxD3HDTRwsGcEHhlS6iAUrjC2N3jV+7xCpokLRGl63Adz6UX0tvYL7zsTocEoSvwZeb4/0PZE9Y1dtuICKx6K9hq84hhDOBZ7XpIuHerlf/xbGdAOQUU
+AgyetXASnEaSK7tLGU/VSX5mAU4AE7hYqBTAZRx08TnXpW0LYIsplZgqIioaOpn9CbdZt8YxnmpN1I04CUeuSpxmRRCi8eByLTOUe1b1ilon5Qpvh5qihDW8
+Trfp41wVTqNeQe0bjyG999TeAHlWHT+eW9oAd4kPkQ6CJybbYCFseQaQ0TUmNbaCiRYW+tpfd8llJZ7xPxrDV2WYwTciecxfGmpQjRNUzy2ZDea4UBaD/PcE
+eDK7JH0Z4dx+kl5QLOUremr/QF9JaFaCjtH1wdaCItriCc+zYlwrUP6kNApE4BIHWPtjSkrGEFqkBsmQ0UdNXJvQXXip5WoNypYcFbN3kGhs9I1TErVdA3mv
+SJq35qVssRzez6wh1yy4GI5RZwXKb9SHsKEmXnTI5JWG1ykFwdEJvLe+7q4cqMtn9eamfZ0E5fV5CUOWVk5lsAntNfqxrUL/FvjqmWyv8ZuaGlCMO8qoFvRr
+ea3SrrfY1Ud0DAIkZUzHw97tqA61QL/GZ7RGPJUTCHVK8Ovl6gMZiwAAgE5qMJqlIu/RbbPr84/LBbzRX3+pX9l8eIXjiw9rpqpOn+NmFzJ0i6bqbs7V2m6k
+8US8k5E77ZaoggBOAH6gZh50KRlPSBh1IWs+A547fL+iR5KJtbpmduftvl+QE9P8S8Fthbe71epjfeH6/58oDBqagrscCqL6qxegsV+mqSNYZZDKqqMm/Xoi
+N1fQsoU9lJSQLtlY3Yv3myB5ZRW0Ssve+bkq+8sKuZzsG7C0TbGWUQzO6ZQDrrTpjmfoj/KebGPRrc/7NRJrGc9GMkuJswblRnRxTRj6nnV/6wldKAJ2Yy6n
+vPljS22QlwDdVdKMlH0rGRAo+M5BWKeD16YOtj7HzbqvRM1i6EHrhmVuS54ndvMr26y5uwUAsO6D1ZAGyq6c2nz4qCjYlPXVGLRxtJBqezQG8sLD66j5J2P5
+rFSEiYIJTo6OOFDj/hx5cNizBNlp0ck4cV8GrpzXfOSDfcci3uj1mYxkMFlarnU9I21cqDhszE0mhNq9wMr1iUmKHHnc67d8UJhOO63NvJpSbkpDo4Gpuruv
+tXv7vLxXGJFrO0iJSGLtnHivywCwome0TicAWCftecZgBi2kcxtGCDElpLXNhFoZUR/IYZS1ZdlLK1YwZ1/ZsgIoybQcdLy6OOkWpiwRBsoV1IKJYp3KdS6R
+ys3l6PW+L79Vo7IPSYVAqspaO9w4S6nEumaJXLZjO1LCe05uTmKnTjzaypJGPAGuEi8zQBvcdSKpgdIpyQsu/0ioRJmd0hI3h8Ke+13pY+mH05dYByoFyOq5
+Ntb+rV3BNFY9blXTVc2FzgXEJ2+4oTnNmzUzqcCYSJMieqxfe1mR8maW2QSAJ5wHyleNZ7/g6939x2GHG5lL+yNjzh2gs49SB2XdxoqbpsiA0gfPUr3JpOYj
+0g1qe119dQMxIDBr8e2bwnlYouo3pXemD+jpUnLNn6z1G4uZFQoh3tpc8DXVAA25j+Eu3Bpnq2aZfLVKhsvVaiknEBtVi1HvVIjLc2mH7pQH7N5MjCEAWJWb
+ovdDTLL4M77dnff/GNgydgoFvuG2ySDHzdNYwD7GbZ67zTgJr3TSsuuEUAva5juF6jEQV3S4DdW7rNYxygz6YvppZd4lV8XYqNCA9i/2EYN5CNg2bRbf1ljt
+ON+3Dkc7CQ5mYZT1UADCkKahrSCKeH0AOXsFLw6Yq0TPyaoBaVJsp+CV+7KJdGNRe8oQazmQ3sRchVj/PI69UG8ju7tb3AQsdoA7TTaknad5ni59yEl9VxCQ
+v2kW2bOVzjpd4BzJD3C+UapNXxSOvbPrRjW03x9rdtxJXl+3utU7vZWL6tU13mS0xCTJqRwYDoBQSD9Gcb9yd+Qb2jA4JUsSe1yE3I2oTl0emWm9V/ZQdZfn
+fbuRCxj9MnkTHPqaBp+VbdMDtVqGRkctL3ZFBhrFTFyvrkktwGxS8fWDLM2M/Zb3/zd73nPB46tUESWHf5X5gY5xGkNGb+epKl1slsEJWSm46oQr/z2YQhyt
+YSmCpGrqGMgZic+vJpAky+tafZvnObt/SSsmt5Ij1yg31zgtyJkfJXsb+Zn62u1EhRMCwBpC6uOYXOKtwKZYPFCzZ2gqfKibV3npnvRcBU2EguUuWJ7kLJfG
+/H5wY/y6RQhawSzeB55fLKzR1fDJSS/l9aCb2MvrlOZSJl4M/IxVkhYrv2Re65iT1P6AyHZijWXe4GvkrdxfPeqKrzykmXzXuHlarsAimUWUwWiObnoRztM3
+in6ts3mEz4v35IeLBTJTIqvKNoF++QrMkiA95/nIc1xneampsmzchdg/k3lyCPP9sNh35LheGQplDhHro/IHrReVl21Zkk1xZfO2/cjXB779x5y7y1XWuJn2
+PnDxLC8Nn1LfjspokkldtfPewJZWZXEXQqpGBndp1Ux+V5nLVQzat11/ZCLVwVyR92QqXuidgaedsuIL+VzwM2EzzkWbl9FRBvIqySEBEm+GEFTUc9h/MDOy
+VBtIbTv61Gw+q5AilrcXwjnxou4/N+m9YS1o/X7X+BZKBHntu5DU8cJca07yuhpMXto0Z3hVWAPTXB253Y0r4RCe1JYQLbKHoIbO2ZiuC1sZdkRkbHlD35U+
+kj5blQIezsuG2zOimNdRiVuysg7TM8vIqhomkJKfz2Zj1Pg/ImOAZtCrup7em17VPssX64nHnXxTuhZV+8Ogdb/RdLxUi6VCemUDrpNw5wU2gWVJLslHsnVX
+UdyPYUVoy6k8Tquwj5VjJxteoHFU1D67bO3pjVpHEw2Zp5rDvCd9gLGinMXcLIQiY1+f0lx/ZOfY8WA1ju1m3Es16ORhv1XXdPtdMSOEHyLQHRvcmth331Cz
+0sjeV1wKHo+Q1ulN6S3p7Yoneh9HYKJX8VO9BrAWwp8utER2dVkdaqPTFUt9nygx2Vgit3Fz4EVG5twd2iWSDKwQ4MQABx6IEyFhlhPFIS2Y4Rn0YpIRAgdA
+ni1ImSf2VW7UMd6rBFdHhaVpcmPJw17U9gZ1bjzuOlW9slZvmjKuNtJ5g0cQc3bFdhLvI0AnyrUJYNGl3CvdfSj0K6qz5Bs8L7vRuqPEkj+oZ9WgMcFBj0zd
+1EfmHurrb/vq3dVICcpiAr4fZIFBLPqZCODJSKLKrZaXTwRhRwjCOERVwx3S69MbGc/2Zsfn/ahch+pOXffQvNHO367XWVOaGKB5cT2IAaP3jsERegQAdOLw
+XW8ciFKRvvRMeuxmS9pQefA+v/T2hH3PsEzl/ljlQGI2vYZD2x2vIdBxV/0BNg0UjV/gSYg4WVrykqCUO4NaXg2V7VnAwMGd3CXVEtSE7A3hnBUmxlfS11lp
+qEWpHfah7qELvAbK++M5abRDZcENBnGovUs/CcppFU4pFM/shLXTEUyUTQt2chc+7ZZIixiVH6lAGhuOhDhX+MvxC/lgobj1jiHo7I5eUdf8O7BVwswU4vI4
+gwHVjkrfCbB8Ts4y4c2U+57RGEdcb+cPSsfla7LulXY3Ug12VfNRyu1oaV2oXX8+qlBY3LHZ2j0O8tSNiPlCSViFqqtJz3i88pt53VQYvLV/r6XkhBOH66Fn
+IGwiOLVPbH6FGfBQBSI3pQevPUCIKcs5fqu6PZ+Pea37D3JEad31GXLbuu31eD9y/Kmb8CymFJyv7KViexF7aKcoRIlQk/ZwvnmDQr70aCzmjXJnf8Hdm1X1
+SgSP3WNKNb82r/0umxBYkG3q7zeecaOf3XCXK4g3PSdmGlkXF++98dzVyUobbW996OGu0w/5+KRsvedxHztL+00t0m4tNo2kq2Bd70/NfbyX3deAGi00JHrW
+tx5zqvSWAupC91Qvz+f3cFJmFbj7FOsZeMynsFDO/cixTiXKUT6z1turCpXG48a1ZJ8pVRaI/Z7sXvD1so8sJ3etXDKuyTuNSV6QGRUQeAg+JUfJE5EB4CWC
+4JJhNoMYmqqEsBxmKacuFsJG9bKohRg6dBzs0F2UPuHLLN87qNBjPHZ7w3ZRkjCJQ/2wAlK5E7mwk4sjr0CJW5UpqJC9zJfBy15aR9aeBn7X9kmiRlSy82Qc
+ZjXD4dCm7oMQLHRGdrHETg/XE2UD3auWyWTymRaZTtZVRK8kmrVfx2EAW53ySO2oODZUMAjv5iyjqCsUbHJV+dKQaD94r27vi6quvhsJXvn22iFajpfbxu1O
+Jw1H3sr3Q0BEBS2sEhd3kR+WO0hRNRDhGWpGq477GYgUAdl6bJazw/Tdfi39Rnty9Pum3b9DqCTVKLBuNVFs2Xfcch8Pw/bG76H8W3dospWBX06kjlgmVVmf
+gyjixM2dRVjUoZ8VnNr9Otd6jtb1K27txfSqs1p/jVmtRRcltZUaOU8lItxqJcBZN3x7g8qPdJxNN7kb33UjhlhP4Zpy34rNhddpphCF6LQGr/hAleQ9NTVQ
+imojZJ+ekvYK+BDSTQpDmctkm0K+YJ8GLFqpl0RVODmh3M/BuP38ea1FMh81iKRsDmVUdQhvdW+u/8YwTqOezyvtX5SXRNPMrat8izgUO/ELb+3jdI5gmIom
+Q9n8RaxaaODa0YbD/THl/UsmWysPTavRstgcadGbealSo2vfFBzBFLaJcqMRYQJA1buHnLwSpm3JzHevYSj4eUS0CmujAT3xbkH2WdO3+2nlef96iDuETzyM
+XYRxbCKMIGwbw5JqC2BFXP1uk0eqkz0hIa1TF3jo3XQWkeqRIHpWSTICpD0jiUFIRiEw3jFqCRGPibrAxdqL5Pzf5id2ueNqRMzT0gSY3TBn3AfhYaEwBLEV
+caVogAEqocbtgBVQecWcxJsyb/k4Ode2tpdqqUwxxPIVIth57nSHoFH2dt3l5Dp6Sd2NajhpuZ13+EpdMbQ5/1yTdelS129trhbKt1a8hQp41EBYN5+cANCS
+YS3BlJ9CT6S9pVt3M7nHnvw+7XyR36z6hX+PGSffy921z/vqLfoFxf/iryXOHFy8ULP8/XGt3hXvNWpErR8GShJ3JV7Whcz9+VdW1pgTPsTOmfRjQF6MdWdj
+CvStg3TVS6xcGW/xcjgCWSam3DHgz9NJMjMg8pMAAU5SSkygcCWjaa2iICKz0UO6Vk3KZB5p8aIxFEECwJSe99z0u9O7uVsjkdKPVB2CrWZfoyrbnSkjlQNx
+XGz+rb0vLnqodUu0jRE5otWaXxVGYQydborl8RNLmahbW7YKZl9smqiORNbjsSatqjgpvR7LzV7WU/ATrmzzM7ZjXoVT8FDvcx85DKJWZDDbraK9Dt71kbNQ
+vzpIeXFz2Avke2M0HKmEEplCU0BfZtVYLsjHxEIYaCUo4jnkM6q+ZZJBUMEqUGQf+chZ21RmYfaUoIk5nwQPx982t75b+dfFlk2ddKlruQ+Bp6txdnqiV9oa
+a2ZNDT8RkOwUlaoVLZVmSM9IzEB8nJKXyQM1SWaL11mh/hpqEn+t7sSJ5Dn+iw1mIa6jz7Angmmpd+iFre/N280YNHU4R7xebKR3pXPvclhIIuEWQ4Vk69Gt
+Wq8yUVZlCVlTXlNXkQWed44ZtIVVju5VkpGbUt941xGXWeSWtgQNFuX7OWMRPeri/fRPtfLn7kLVbs5OMJ86B1MnlAxtH4xddo5KCBKPukWVAbzpBrD8wlv+
+Z/H6qoVQALZCKJRROhNYvjRDARk+EsnGVMml6HBR0y/E3tpXam2zIQTDXIVcvMQqVdsyXfnQWIomgYmJNh4CGsbNm2KqpyUTrbzFZlJZV6uEQupGYMoqYQXJ
+IBGjHBU0AAATPxQFKuob2qrCBjNQhyKnWe720fRZVfCMjgN/EvTFalSjXzoFWtQ9fNsJoSwjiWWHtiZ8+KqcViNXyyJ+a76+SoLLN7t85Ik+kalS+CXL3ssf
+i+EGTcQtRADASfiSiDap/IbDhA5yKz+6qiQQ/ZgTaFB5l+L+xrt3WiVIaY3rxDgX5QOUi/O85LgillqAFQm3wO6pggRS0HnYpLc5T+ODFSdjUKVmHYW/80fW
+AFrhAV4jIl9LAGsFxt5VaGWKTS37lxq99nL+WPz+7Yx5B45Q0pWGdVeainbC4+9tSoa8x3bwnvi5/N+kwqvVzs7KhpM2BXQA9c5A0w82uU+aQCA//K6tAe+1
+GvXpyoUpBINhoY9l6rvli/Lajzm83vjamc5RetMC9EHVSAaQNmL7shFgjTwt29cNmTRuJ4uP3uD6WLVvqpXBsfZMn86lgLg30etnje3VHUffelTuGCtZJlMV
+BsrVG8CqntotladkVIJ4+5bnNXgMGPAPuv77PSjwHgm+9qqzlin5QGiR4Px5gOUT1zgAoKd2hEbBqr7W54O/w9i8XsdwjgGEM248YJh1ARZ2tNpwV2JmBlox
+DtCRm3LUnQSgU3R/bOMrT4ZTA/awW1MPz/RcmOsvpW2DelA129YHaNxofYnrKbW3U/51CiQnPaKylexPuIZTkk1mpkHSvKkQHgg4r458es51SF5UpGAzQwxD
+349xi40dNwd9lfJxu+GtfD62cSFOAOs6E2aY2NiJ47Vor/P59DZlWHmvX17luJGw8bJXbDjza321sjWcedFewSSqQqL5DJDXe75XDXZc+oJWgVqoR8x1aAJM
+04/7+HPbWU22Y/dPGrI0vPi7pGgmWAlvPjTobQpl3V761r3gOmFO/1eR8iYXqGpA38t7vujKf8ncRngchvHYOr/vPZxXS4WeDK7+Z8ft/onBbjY725g6WD3B
+Kj0Dd3rHjicOKFQoifgazTEgyNEYjPqhQDCH3H5JhTF4Thna35leTnvvLZnyprjgCfdvqYfp7OzNIZzl7Lq1Me2KncQAm60+ZqO0Jtb9wziRwyZ84iJAAelh
+NmlrV7pmETBsNzqsdxyX2xMllsJOF0d0PNLTmRqOAb1EXUguuDzmr8GaeXM4OqzplAy+q3qGNt7v4djtY43C+X5BynLfeOKX0KJDOnJLa2qs3kwgOoERK/lo
+qPOi7BRcr0SzJ6dYZxHtpeeYx2r7bO02Npp9NkqbKmtyuD7M1Bv4cfGdEe/Z0VX8EgCgNnzRv574oKCc9H1YxGs8JZZ78y9ATu7yPmg5mP6kMF3b1bxwBfXV
+H1XuOua1x22LZSqBk9+U4VAuaa22OmtSt9ZwKdSuR96T1XSLE7TcFl31EiEHh9Jyl9BETZ5B1kuOXsNlPleNetOh+apjkIziMsjFLAntlOsdypF0fb/M1uFG
+r3gXTKREAijFKo8pjEKVKtSO0ST7+0pr6NV3QtcxUIbTTI1L7zPOfHPWvldZTzGqJZcTauj7J1ENepxrupj3qlsAZ46J5LJF66+uhCdu4C0WMxHZ2Ic4k6Kv
+oOaZmdSsSJsnqhNiqAd5r1oQMgRqL6ID8eJM5+ul9G49zarT3JceVE87ZBkNAwLt3Hfgw1nOM60L+/YzKicx0+wuiyw7yvmkZ1pGOoGRvEPq4HeYU+nyy54F
+cAtJ1EKk4UroF72kEesnDCMaVa6sBuyCnhvRHLE61CgYUT+8Zbcvt9Q6osrBVwFZcQcQ/BHnERxJwV1Cldi35c5FXzl46xm8spPLSy6ZKhoboLkuySOq/1c8
+J9wczzRKx6avtDWU78CIo3CfbeHuAtpjBW69z7hVn63lqmgV9jA6aVW2NM7IPNEFY4p2kWwP5vcTTJC10dlBjo3lPJEavrh3Nq43+azhTXCzcnMfrqR0V3q1
+N9LsLkgplwlbDXBa+xtspnA9gHjJaU5CwQYHbM9epXjRiGHKNnvUe9LnGW8nHp1/YfpSngk0/CHHdwT8x21AgNgwHDb1fdv7FoC6g9bVeVYEwYdxf217+FGF
+0xaiZYATUfSI1iVtQnxZ6aqA0luUFTHL6kDPQFxSTAjVMj46yyyDEtfIVqf59gvBF9BUVj7l57rysHeNy4F7GqvBjlak18ExyEgk3dDkvYPHpbF6Y+MkA21w
+XHtTdzxeAQlU918HJVsjBCcQgVUfE3mebBU5bAUgoBEdByHTrbzlbCe/7IarML8+xSNQMndTFY4hZWMdvg/+WL2NeJkralCPGe57W8VWODg+ehVxmKl9jR+r
+qmpjU8lZfJkCRG1f3lE8zDNUbj8uTFyARkutvjUx+v8XXpsCp0BYhBn+bl7b5+Xe3hy3Z+/ZeNl9d23tdRea5sVbTlQXfosCaG/7JFDBqnOOzHUodeCoc70k
+jt8Yte1LdalqgWdzbo1vOUkzfuUvRL1pk7RHSi6ZqIi/NzzlqO6mCuSjIl7djpZLpTk6pw7bYecWIdu9yV7npPeYUS/ejCuP8RvfSBfj5hTXIlqeHihYsNb3
+am1Ml7KKt1WH+xtay7f8igGB7l/QqEsa8cFFTdCv68/0a0fK4l48fM1L3CpVDy9zJAC4bz1dhXl5CFW5x8xNfuDaHhBFveUp6nWKxSmaoVgUmNpm9QIE1T6T
+V/dDgWWz3TeDuDCAOniMqGlmsNTnVDfe1ng41csnJdu07heskulp5nbEg0l/5fOyDHZTRxQlgA+lH6jju50rZ3Zjex3z8v2wdfrnfsiI7V6lAo7E+w41g1x3
+AdStikr1uXTwOu/7G2EJZ1ehPf8qy+vbRRvPznqOn8lV3YxBikVixhJD6ezVQl1ucGT2ooH9/1HLWZkAsQREIfYpVTrjNwuyyjCDTSXJQAq6j2y286XXIIir
+n3TrJV3Te7NU5I92urmgdvOmXuLlwqHa9kut3NNYhBkGBkwwyQGyPhn5Q9trvO56Fh9LnxQGwwxl7RydRh0wGO81u72ridhoHhRRuA5R/yqMAJoGH1p7Licw
+frib2tu+SUFH0N9sSYnmAyBSi1VpO0s/yOhfwZZ/vbJxH7PGepPB6P783vJOC9ecJbOepRT3N5wpcOspvv4zhoOgUuaKWJoSIE+KM0u1e+MOJ0lTjuJZU1ZA
+me2qnjZqUW40rtyD69h4KIo4m6i5HLWQmAiQXx7NV2lKKQ2LfM6asVcs7289+owJ87H+M3/846F/9JW89OjyqTQ7Pjs5Z2q+OhtfYd9PLO9DHPMij0PyladX
+K75Vs9N+vO9408T/mM35cUqZLC5VLufsUBOgnfflqFUEj2Nwp4VY6M6GA3P+9Gy49+Ud9t35e91oHd935mrXI+PsnA137pSCnnEY43dZZegxc2u899mNNcjS
+/Fnh7EE8O0u6R8HMFJo4wVbNI+KE8YxrTlVan4ULKzIJJJ2syxFD75yj/mPPhbhR1rp4RpZdOF33L08nwvLF5EVPGXGRiTGldFHCNkqBnlKNZHLw3KtX0V5b
+rVyVH7JTKuJd5IyxStwjT5RwN4azMQbfnyOvInlfmp5w9ibFZQJw/tiQx6YOSjvZ8YFdbsWqvJG17mm+VFEvC6/Xao7INiukoYCLVM1WuMbQZgy+HEuvoK3l
+DKozPYScfhg31xffFclQtbY7UT/UwHkra+kDrHBpbJuxK13hjz5mjWYXNpYzunwyj+K51s+tStFkOhPHExBmFDDABO0+LRFRnyXtTWj14dupQMOjoUPk1ipO
+CGh2R6kitdSUFQGn5dsqRBnuTK4C/02ULT1+GR51VyVymd1P1Q8/ey/f0MTOt5Hbfy5mDqNUvxPiaEc4NSzFMTD4YpTdxM9lwGr1VH1f1EVUj3R3sWjjks4D
+UULO3KEeoos1yDwWDl3pB3+8eo3J/HwtZ3z0gbvvhAeNf1Wc7twZ385pURnzespWCWDayhq2j3qlt57DiaMrE+j51cpZvrhnVSNTIbqYZYK8BZ8etF5s3CuY
+e3D5XiEMIL+WPig5mqHpTDy9ZuB7IaUfhdwxP/5lk+nrB+EzWcDL+w95msPPJ9/EMacpZKhdrv7d7hlfe7CCnb5kbI6Hc8kAM5aKjxDfWDONOW6v2qJUNwYo
+EIJYxKt+E/nc1UvpT56/UoNOAZ7qtA0xxIF1TwQLn8LecOEsOByHWf1HenmDhA9r0Rm7rw6kzoNLN9EIh6V7yUf1BGvovJZ3Vd/PVPDOvnYVfjyP2xlKPai7
+JQuVoZzmV2NasPUSIpYCAKa0EswcfxWwqGuvEvyQepp/xVQsrkMutlREDjtHSM1s+u02h+NVqA5pVcQ67LK7xP7BrEwrm/86gTKdUF2zM38lZiwAi5uMFc6J
+zIgLoL93H48YgL+rWGD3+Jjg9KjQwG96s/8fJfeskao7xF5wDR2yMgjcLxxPUyM1pr6ySy/XUWILPk8XnJ+tjucDr7c9SSsKtHnFizmzoD5r5qw+cauAF/n+
+6thsTrj+Gsu9Lh2gWio6nNsCJBIjb32JPAu/Hqq2OEnRzHcJ1S+W60dxcfd1873it15rrF22zGt1EjgbbwNMAKJFck+8vSrOEwaVpyaGFOy1OZ79xONebUVq
+OAlI5Ur2t7pV2kk3bsb5RdQwkAxJ5+Jt5zsBeko3HLIGV1By4O9j1nn0cCtMSmfjX3yFbuFmMgSv5mBqVD7bMpv5uH0ij/Ozdxzp8AGvhfZ5rXWFQVnFi63K
+MK412MVTxO6u7QHooGGp3KsoAVGXN78r/c5jqwI7zRrGVhZJqunLVQIZs+PBQJa8Ex+OZwfhLi12MZX2drZmQLoz8HZHcf9AJuTkhJBS+pbF+ntV3yhIw6+l
+30x/9tiRybECXyOeAIkewzTQOjx/zo+XTw5Xh73XvcUea780TNn8cif5MoFVIar5K3/UHUwM8S6o0duouZ4F/5aMjbY5+j5xTD+ose9XX9tO4bWyMJvG/Vop
+p4XLL+x/X13zTYOauhmPjY4GnY/89ZJXQictj9fDv9HuKPWa71M2fnRe5g+H4/pRe8cgKmzyI9cudL+qfv3dakgUtzVtYTzDiM/BGTqCC0DP0PoZ667HqBWK
+rdpxMy6KYU/Xl3ZYzDBUyW6zTTwbYQ8yLndD29f1466ArJHG5ZlFzSBr9tg1cK+1VbqW1l33cjHIsMkkQrgn15iSsT+tlUsnAFgrli3GUG9PkD5tJ8wZx9jd
+Rc1pRbJhqa5AtZCd2qWwqqqsrZksLnajuxzvBVVC5PpElgk+iq/44/oQNVmtOZK72k+4DKjwT1Zw4qdiPtd6QJSYdaaRIDyfD2vXQXOjG/NJf6h8fz1rE2/3
+w42n4E1MY/ISx8M+R8UnvRnyPkNOUEFgBMrJxaw5U6blgj5JGQc1/iHCNod4kl5RtxPzg3hwClmtOT5nVnMxmJGf13zwzBLbuxS/tje0YGU21cIj9Uby1916
+eZdEZI/tUYzB53gTJC2XHdW3cmnbY2D/DDsVSpoFIzSdF67MSe7oPT0MwE5SS95Qqm45IfX6lLeaeX0+5IiVL39Y9Ivv8sTcKGA1Ei84icYVVzi7hHFCflwm
+bBYh2qny62PKmFuOcsSKfd1f93H7qH18NOn6nodY7xzPysbCf694ssV9XZHdDcI5ebVvnYCWb1pPs9M72d6rUusXdMr3lRyp4lAOw0Nz10Lip77hiNTmEEiV
+ezeY0kNrDmaoMl6VGogi5E+Z44T22uTbsr3IowjC8681pncHt4gfVaTD16peIwcMD12cOO8oMX7mZK0SGrsZEmXPYk2TOPy291JCCHPJgIkAQ4hrRx/111sm
+tAOXQL4AwLZfUjg1n0s/WjX3RFVn19U9tMixUF4LCtgB9cArlgMPVg7Wj3JAYyw45bTQWOCbDShyHwCuNlW+aGi0WE+enOAUqUkz/YaC2fu0819LvP6rnv2q
+zlgUnruvz109ZZ0od2u2kmc9zDl6kbAbIUaNGVkwivjW0pJ3uf6IHifQnZnIB2b5kTS1KqVy79OTjg9c9B/KzO7RgYb/M5lfGY9rIVVxRKmgOVZs2+oGZj8R
+zk3xglgfjttcEW/uFoLOYW4qcpABW/QgykxhIgbyEwgAC99eZpEktWzY3eX1L7vX1OWnaHhmkBNbau1qCEB8jyjfC7gguVI6aaGp7p9vUoWmMie9breJxbp8
+SWATzJlrM0xaqVq76zBgxoOayEQBJ5QS3lLwErl4r/Pc13aMy7o9y/HWsetxbYUBbPTxbtT7zxhcBCqIsnADariFlXJYZtFaXbI7/lKtgwjv5gw2+VzMcXNs
+ndM/nBdPs1u+uEMouvebn5aDJQAhQ4Jl3fZyimdzDUioq4toNVkuM8SYpY/AyKrrfVjBju1pC1qmda53uHpT+y7R+egOhwGxB8+RbwCZb5l2aRNYPqnlKkYm
+yRmmtKwJwDX0iqtler3PP8MklmrVIkrJnLMfg1biizqO6qYR8El36R6Q0sTZimfHcp2LICUiXmYiLlTvydvTZ7xzah6bW7bPLq/aer/jo6BYu0PVCdUCm9js
+1CrKZAjmDoWle4L56mVt9E8JhJoFy1CsEGqGffiWu3TiDsxr6KAb13BoNotNvLDq1Jwv6FZkdQ9qZMxixtn1LlYE6wTlq4n3Qnn5oMUjWCFN87yNb2L61i5u
+iprFP+Ydya+xRuEfNAqFZq8ZY/l7b2DuIqebsyIcXFMtM+dKq2lly9qM6wQIpOEGzoB2GkD2kGZxyzDwaE5o37YIGpYz9sanxN/k/6bATXmZV+7HHnc2Z1nI
+my3vMaM5dS8XQ1cgy1VaO3RP9XOtsIYs1RZ2J8MOf1WwkJVtL1LFspqcjnC44HTYtX1AnDRYV17ZYjbqrjzWQjb+wdL2L73A1zXxz6fS5x4zyoZ19OfrFhq9
+heajTWiF5nLU0D2RkHLFDBngho8dfutdzOu3tfTIU9h2ULUnvjHwl3WqNHA/iqvaE12b5w/jqiC4ThJFZV4jEwSbqsUDOYdlgVB+kkBJXGhySumbZtdtXg5f
+VJ3Tb/z62Ya5IYHcl2zvHjEkGFRhSdJLtXe8eGSo6vg3STnxtxqP/HdVjZPLx+Nadb7Gxvn4sDlEFe0qcJfvvXI8CZyPaz+Wet8lnfz3XfKq6eFR3SgVm78O
+2jkF25IerqDUHNVRZrUubejL8vyjmlb2K7fD6GS15VkIpesI8L+p/CAgnhhjG2Kyl6Mv2yN291o3Ee0l0wTVj/0LzxpmBc+RhS1nYrMwhbpHEZ329X5M0USP
+O43CPtmqUTafL5xMBPTA6y7n0lyd0j+Lc+UFrpWKS4IaiDBX4PJxH9nOl++j2tP0iAoAoIwEz3AZfFs9S+O5+d3ipbirKjVd34e/2MtiNZOQRzbf1wQQW0G2
+oJa66UajtZaQI7kIz3bR2/5cUX7/pFQ57U/TsD1EM7MLWoiTnoB+nN/1bQYEAtOYLAWOROf7iK9veOAlwvqczumNAoGrntN+sRPU5XTruEGG9CGHPeNEljgg
+KrcaspuJlJfqW1zGSb4Poa6DdnMQtZXVaPWVLPwrhuC+CS7vSgRnCKUxrAZzORr3D5ZnRRbHbT43t/yNdWpNUM3PfFZVJ5jUS0DdfspnWc724GjZ1GH1VxIg
+eczz+uBT9UQV0z5leqxnMWfaBuGLXdQb3OkJJ55U9Sjj17OCKvprmm7+2hrE2WS2/VVVsHxOsVZe9bg+yQjgUi3/WqNVf2m9fLdI2HRioNwn/UIT2s1hrWJ6
+s2r5alY/5QvStqk1JInv3exW4TyFQVLaajM/X0yfM18xYQ+b+mX+htGZW+ciazFcjDsfRmj6rdRI9QL2Bthe97pwrghvTbJ23ztyyGAPwk19OC+XtUUnzOrY
+ZkLVMSEHIGSKRwJYp4n4ThBAJpon4ZY11L1ZcnDDdFwy55q2217xH9lH3fAdC/00uks5ICAXkx3J69byQ6H2Lmzu0tn8iRLJZoVoPhTocZg3HI5rjfhyODf5
+OUPWHr3+or061a3iIC/BDbCyy+4S4Dot7F18GzhNhnV6htlW3ApOiZ2ZAQGpmT8lVgfrJV0eq691Nh23A//ZjMdYEBssedvrz99TQpWvJJi1u3WX/NqX05IE
+CCqHZjt5TpMSwCMe+ybUe1I16vFeev3Lf/iKL4K5SPX74H/W1NH/YL7TkYOikLe4ctOqjJusoVnMwKXWlL3GMGmNmLRmJ1F8uX8B0uxb1Xj+lrK+np9Erpns
+nidCgyo5VEVAU278iHJkDYQ7dZcmfsA8/mvPfZM+NkN06Vg/jlNoqC6e0zNOIf+Fk4ACzlLRwr70gxaFPW47Kk/m+39sFvl2/+rKNqjFrQmqOVJ5eUuvD9iL
+8kRauMZMletSeyHF40iwz6Y/lnj2HvqpPsSk+uYC0IoJnA8CSyrOhsft5jiM/HFNGsC7fAb1oIZVAsAMBDmhtrJ02lPtlON8wsvRw6c9f5Rua6NZ+xPuBbzv
+E5bhMIv0rTHyGhKXa9VdpEZIs7ynGcBh5SIh6LBe7ZckDqwJz7O6yqadGo0oc0MVfG9UEp3FmpfwZ3amfxRKKeO+BHK7wKO58rbkxUyatVZWTlQGYEp8w6Xl
+5yUAPMunoaYijW5+EuO3D0Ztmbbuabc29Cai5beuxs2FehylJ7au+zlB59TAn3Tbek4Wn6oNHRoq0wAv/KiAY2dzfUpa0F7iNhT91O9338Gf1hhPp/O1G5EY
+kt37Rw9GesrwN6i/N6I1WaQpQtoDkYRM0pnv6iqZqE75qcnmM3SKCxIIJr+35vtr8/zd6bPu36JZsIQRc2CVpZIuF3TL23W82L0Bs6RsfAladXNbaXE9v03e
+VP7YXA06GCthWyeVt9xKz5jf3eJ+53P+uXBOVv0WcW6NDu4x0r8IuyZTolaPB9dZasFPrrCE5OAUs6+JVw1VRbMkYsmTUiKfIPLVwuWicyBrLDjHOR3dX819
+T8q/J/Nj+NxM77SRbY6v0gSrdCLqpzdWUoJDWxZFRDAHpEWcy0/VrftX09dlLodDtt6xWNcxTE7rx1ixOWerPoPdtWHcHK6t3GNu22mpvyelHzIHWWrgO6I1
+cpe0+IOmXC+SSrEQxHLD0lto8Xii3N/2WH8u1hhGN5kalpBc97fI71GKUg+8CYjD15XMr75RviQMBSSfk6nw1jXZt8vDW8eVKyoGn3RX782hqetvL+uL7yVx
+lD+aigv25ISEhoihdsL6qXyX0Nvk5RYvd8XRGq2TGQGofWaD6TVE7xci77O86uNojYuWA3oJsjfie02yhHKh9aXyehY6V2B2Mpru5aQ2S2cRvsoLfWPQJZLO
+5C+lX9UO3Z8G3EaFYMeL2FbhiP2jSt1BEavcZW/3B79QF75fhx4NVmQoTbjIm7JEwTcmAsipBlHYrHK6TzlA/2OE1OEjngbt8i/oLlZ8Uv8xO2L/sc2FuT7H
+zWV6hXrpUfWtQUcsccyOW6dhmtRNlavDBZy/sczmum/8SfVNioXj5cOpHS6fmhk6fa6X7Yx6pMpE+fbWWn+h8zfH0nkVH7UyonWAsxIRqI176UT4In/jDD8n
+eePXWAnc2Se2U/nrfQCPpVzfMCX2934zamMZ5IzCESwlRwrBdfjahdEd7jgDt6wddjFh+7WycNaNTvWvVXWTQ3WnGKrGjOFlZ74MZbfbGxRK1O4vYWuDHL3o
+cWV7uHRbfJWU1d41mheDyP4j6lC0TO4aS4kj2Oe4NlD2wcQOQjWe8OtptTrHCwsEsrIbs/y11wpYuhux3klhEU0I7dL1+2OuHVlEDwEQhSHa2V3X9eo02mTF
+ZhRfHbv+tzHT3jx60y54t+kqFJaK6i0Pl2SJ50C8zjNUAgTAOSgvVYv2spTPsA5bb42rRi/2U+lP0z+ru/JDuqryHMmsxnnNQIy/H8ll1GOyVLrWFPbKMJuN
+32uP0gItJFfduLPF7z6MXWQvAQA1mn7rdIhKcsaG3XtbUocn0ghkbVzElWVWTIsVi2LVMjEne13hoitYDEzZr0KURMVtCQkHHuIErYAX0se7c/vn2IHgt90r
+S3Hqu2UwygO4RnHlfxyWkXDCRoo8Ymk4MUwchiPeB2aUmgI/LeJaejYFUYZlHOOTBuPwq1xj+L30h7bf23yfqTwF1lMZADaKjR5ybneyRP2q9YnydFVGKkM1
++LFXroVaYgzrkPFjYpC/mi+EJmipgn5orcScBN0M2tXqKxFl0FE4Op1OT2TZ/DyfEb/j+0rwrjD02ZE/HM9uJj2YMihGBR+6UH5oI2NCJPEUpiBS3CcHKY5L
+Dc3IjdtBbTAIu85AXXgdCLb8B6H+8gIz6T+h6tZftOpbzeK7+tsDtYhRC9FCO1q78EYQ0iLJdbR3Mk/0crxYgEzGy+GVQktliimtKgas1cUd0lbQsmPnq5hn
+j7yaH9eX0Snsn866M3JnpCHGn53tz8Sa0He60inXwnM9+QYxVRG1gigmPtMwO59/e/ZW/RVqOXEthFt/9fLJqZ5HRIunFTocGCDDM/VT9xaZ6ZrNglhcJ4FF
+p64u9pb0kfT9utP/UnXGVIuK2gO5uqTkcJYYp4vScrZRuHCWellcY4AAccAwPtydXC9/gGwqKEm6IYtFiZ4PR7oW10FnW/0H1EzrYN2314CikzsqXlpEiPxx
+CU1H/PI0UjuLqENO1pqYoyjB/3DgJDVaZ+e2SR738FxGwWpsLQzN463rnJUJfU9DnTKcVI+IxOAMRPJeejnzidrsiTl1Zw6Vjx2nXx0r7ApHBTSKZ9t4Cca+
+Wo6iuZpNorWwSPZeJQ0CeD1J6jTR0iVYyzlgR9eOfhI3ZKs6Cr9ldLzd5j5wzDAO4lHFQdM62f8vHZG6yQm3l2MgXAEAKapszQ4Ka6RusZsTKhEBUoczF1eB
+WE/9pfSbddy3F420VE9uj61pWfn+elakOm5Hc4g9bMKntROYunsjRgFTEnonn7iIU/mUO9YTpziwSgg6XvdMvVb2PZzyDs1PvVb0e1VBg3sEw6NqMHnR6Xih
+Yc/1F4JJ2Sd6l5eLLyeK/sYtxuDDrlK3d73nx4ENNrlVdNfZtKgmkFFecVbN5YXXCygpuWu6xxrRRxhNYHEzpj8L9WA+NzbeUFoWQJBTOK6ih/EGnlSPdula
+CNz612tUiydACJU51KMGZhgEI/9ptoH8KeJCm48HbrkoaO/iefwWd7UsdbRflNpKOG/5g+jfnaNtB4GF6y4E8Xh50PzMvDHXhFYF4o0HEZYrwfKVEIeFwFQy
+r6i3IMRNHiQlnxFARppmmuXfxfMg7cwjcB+9+TcSfu03WzMHbKwvzYDpPg3TGrZpkqW8mGu547UVBZ723KUMCDhXYhUtZ4TG6S9rrIWg19ne5jBmuEq1hoRa
+b6l8n4tdegL3YHQDt+vNwVsFV4smPUrmJIENYMP/XiwTnRBWyMcnAcEaiHQttNp5nzQFhVDrtHz2EEHLzcVsQ267VbHZ7fGqmbmHxdnNRzsR348u222rnWBF
+TQVp1poAmFC9ayarRCjZpK3TONRECiaqaB63noz/SGoircLzwSwpK3dXhIpGb08O1dXyPkjFAw3Kqq+bXTeRz8WJMs3blSvNfGcdy5xmNYEITVnN+xpku2DX
+wPzmMDcsPvpsw4wtmIzgXnyx7RfHS/KE7a0tAv6JKxnFp4fjK9kcfUIZwDOOXeV24aKbgRPRdEgx9Ho8LpMk5FRT6DOKst5aI/G2jM+zRnjs+byYPsgVkzI2
+TmY4DBxnXY4v01O0yduEzUeIEj4suVL4ZWfQ+sjqTD0U8cSPrIDquYVrTDuta3xBXarDqqisbzndN0HSPC/llB0q4ZyaE08JmJJ6FwOQKYyBkZ4NnTCRKTtp
+V5ufPQFzwkyQBBSm0AITqtCTGhrGHr2z3bQHMIZS3vAI3ypVvJDsxVwQlgSPiPdwLfCrNEFOeTESy5oFaJD7LQ2XMjLXZTf7uuKl8DVzTvcsfRToptWJe4hl
+roOAATtLNpPa3t3PPnWdyNDLm5x4mi1Ce4awRpkH5xHfpmOIUHvApsds2ln6o3OtvYI6Fges35Fu9q5Wj1xE7vCIbn9QoNn3ZjN16I5i8ru5diHd6q0TjI4X
+hNzmqnVYR6HuZme6/aBZgY9i+Ul0IyH90OmWv4VPy59PvyK5VEURq8dz+SSflx/S3kITF/Komk2EJaMiJ1t0ilgsdoLsq/YuhVtIzcWvJKMGQgC+6PKXVJO7
+7gI8n6i6IqD8ohMhZqQOe/gdXFEsEUL0bBbY9HEr9f2Imr72ZucDavzWcbvRNpnJQoTP1wiyaaHf1AkXWIUW/8l7u91oo7PCM6HsKQEY3R9If2mIBz+efsW8
+Mayetm2vbZaoLEHVyn4YQsHhoI6+ilyrelPiCL4PAf5KcRVU8zBqQcE+73lrIBX3rcmsZS+66SCu4Jk3AHhPliOBpgT8gx3/8imfCFJV5rs/RoSlnITDozQZ
+3Mb7qVlzo1q2lw8L3c8VABIQX5Vd9jlJvKrRaHW6MJdfTh9Nn0yfY3Xjn32kltac8+u8XdNgYNek63sJ0wElLzM6g3k+nbc5MwHsVTIUPCFlPBk+TfmXT5qa
+j+nB/aPHa4EbgO1gKL2jMzfcxp+pAuuzspRzlJbgcyjQ7ssTyLjj9wmAYyxyAUqN3PfDph7zImM5flxzmVKTSWPAY2nqYn2bymTy6MAGRi5d0Ibt5euv4eyu
+wV9HC6WsGY3rV6P2AnJCP3N0IFD3cW8FK4RcrhpDR1/SHLNSkO+UYW2OwBOs9VsnWwmw6r0gPtK4q3Ftmg18Pc2d8SQ8vxuPoub26srZUMVvOk0aoRESYc0K
+7lIur6QaV5DSjqjiH2q+htTXpuUMEu+Cn5JVOy65Dg0HkwHZtbWSc2DZkZPZ7ajZrVI9jsZGCqfLqQdBr0joGZMFV84Olx9EZrViZgjWLNmMmXtyzJnVUD7o
+2FLnM1xWSZE6vdcnhu3Ae2uo2i5hcmalFQ05qTxqQNgLyisSXq44lD+hO5BEDHird/zT6b+uWpW3sXsebmznPrA5tN8/OoEv/Ey5k3JSj91vDdKeZltew4lO
+IJZ0U4o+0KtWR1ablOExPKcsjBAMkuVDzLtKjVQY52VEtDC4qUW+7aYAiC6rpYniSGm7S6GeF+CJL2deRJvEkzKnbPx/hAUjCsCT0jGBKBPiTGfQKiK/WLCC
+j2bJyv3nbkp/g0WRX3t/ATK53T9RJva5xEqQ0NSJq+YYON/DnK0dbNmiiH/E/MOwjFl52zRzurpbV3z/V/0sKli0f57+VcWjXaoqfBYP3HamDpsAEtn2ttG3
+tYRXhve2EXUto8g7cp0eyjrGjJ2cmm3SDVfAynUOLUbd6dOSZvFpcZ1UXltdMtWtuO3x36SvBOz15dVm/qwKTxwlCt8E3NJWntDO2Suv2tzy+/X5/VPGq8wY
+BAQgikrVnLvxd8/upBNOpK1+zPItvEstWkK21oQ9zrrg940h9qDaUn2MD0+D9jlRLvYr9t6x4fWskdJRvyntU34yT+sG0olLEC1VtLas1fejfjQI9TCtMP+c
+kCzs5wnKD09lD9Pz9PWNf8Bngq5r1SwMbuRnqtWarftD9ZzdD6azooJOa4tmSpGaGkAS2al6IkAgIGdLwQQAa6IWqAc5ySrLwntEbdRQwyFap3fy/lKcjwyz
+/PULVoHpHyscxvfarryfD1FUbRDyY3GpkntszfJ26qPQ/EzV1QqXk6teTMohcqDWbPp3xqDoOToQx48cX0LmSqLsLSF+sj34RnfeH09fraPyQIxxUbTgTkBq
+JhEwEfvhJtpizIKKLnA4abKXE9Dk4MNz8UQTNZxM7Z6FUyCnCcm0hmrdosz3r3itRsCI5R+/1FHrcXu1rTke7p0yBy1HqFjFlms2igIo/zchImUKKVqYETyf
+CctxWdk1jG0FuEuYZ6g27VXyMqHGs6dwroTh/dvuk8GVo3LjOgWHwyZeSw0M3Qn3YiyUO+LyGJQMFZD7quR2zQE9Kfh9OGdmZCGF3V80dKrCwM5YGhH/xeBx
+9YRZzx9z/8vajSrV298VxGJZ1tdnteA3Mm7ntr8OXnC7ObC3wRMNKMazkvCl2s9LPQrDC1Uh6g0bUUOnyJxyCACyvzQa8e0YEfJHIqS61apAfHcO/Ij3OVXD
+dlSOgxz+ugEedNgOqrt19N5vJ7fYpMU70/XTfuiaCCfkUpZu91LQz0BAYu2YQo6sJh1Z60IEgI0UZeMVZbkyhQOm5Vn+4OVK5trE2s+CItOmZUc8WwcSsUrO
+3xe2rFFVrgHLO8gkHfozLCyBWaAHOi4HwKoI8vOA8/39RfYo+1Hd4Tzb6Os7w8FbhxbYDSoceqFqDZeiowqZ7nhWvMnkpnYI88RKlDnkXwUkIyhBAaZ0zlpH
+Y2PZWan1/X8xvVPP/J+Xupbu74OVn4yZZbWQo37jMW27cLnuYZYDynHWiAktPE+ScgLDrRKc2dvkMJ+cr/G6wOt/a/pU+g1RJ2xx1uPmoHIE92z8M6DV7sJM
+q+xeiMAHVtKNqdvrbSQWNnrf04lqytSnOosmLgHP+C3sovCR4EL9JeGkZa27edfxtoI0HxSzuE/GIC9VwJxtq3M86lv0igYTdmUwVFIKLRlG4dTLGuha72tE
+H+QTv8Nxyiud07gaTJ0M1HHX/qRi8UJB28ua5eIretP8FW2TVp0nRQvX55BeAaI0LTyCw1koRLCofwGALRfrA6ZiY9zCmpcO7qHuDhNx9UpFfrzkRzaH+gP5
+KBDWErYCxnQVtF2EtPDopHvCisBEU7XSzTseXywufUszuQklqXkScJ9S41XXnmtNV6xIv6ndM93d7kneVKRsGPemubtrM7Z4Peb4W6MTzrjvEpIoP6PYIVi1
+A0ztY4ZT5jBnTVMq1waeubV9ma+kX+jv8cV9mbrTzWsUVlWzNsWwPa794uXjsh2abW3U7VahOXOuM8V/fVA6SeypIgtbXuZ7rbLt3QfJSy5Sv95utPcY7aCD
+XpMcX1X2Wt20l8uDgEBVnKmqh1YdxPmVrWxd+5k+SZUMei5OUfr4Qvqd9Pvpj9M/k7t9XdXMOdzcGTel5c3Zc8JYeOupgk74N1Tw3X3b/zVXrW74fYOpgBWC
+EJBy6oVryscT9Js/ITYECCx/CRGw3diM1gpk7fZe7Wge33x7OqSfTn+Q/jr9raNo2+r/MeBntzXgkaF7TKgzRoUGOwbq7/fffnTx+VsD2co8wpDBLfes9YnB
+MwaQ2mEiD+4RASnDWdJufT76BFzQGH6FeYp/MDs/NguHQD0uFyofzXZye39Bv/5Cb6x4cBR2lK4KAmfZEm1hPrQH9TFTLNUQupFUtOd2+p1P0tfSP6+V99vj
+nK562JgBqOEvtrEVfA6832iK2feuLlI5UO83IwBiXFJ1QIA8wTIUIHk5PhMA6Unb54oLgeliPNbnyqWm8Fu8b4UdWkdrFm+3cfcSXmCsedlF2dfV8bC5kdNK
+Ii4I8Td5FE7uA2iqCV0dnp8AkB2xfmZgHCmyZKiLnXbVK+nHaubpmhiFbTJvXTVL6NJez9VZSAXPFYaPdGTdmR5Oqud2TuCnWnuQzfcaL56oC2hdP9uK/zHv
+Up8Japr9WlCiVwEhasrVBLgMFM2pokSz9PYmvBcuqrevwf/cqis810euL1dJE++Jca+8Iumml/e3ixCCOWAAOEVWbSmesC12YFFBrTwHMKcTAEgvnQJy4PUd
+B+7HpRf2GN5bnmsVdfgP/bQkU4ZnlSR83GzrLxIE5/lebLmh9UBEoyHhCqtlEP8UBBcagLOt2eYNu2YtaOmhxZAU3NdX0q+k309/5HfemH4LiD2+qO3mwWmh
+vtKeU5WDc9RGyXG7OdzM1UTr/Oj2Z5ssSOCy4ggISJ0NgS1Xiz8wCCj6zCGUDF76/xm46Ahh/sR97a3pg15HjozpTkdxdw9Fq9WwOrRRG2spZqWHZoAVoXE3
+oOVnCay3KXgS5jpaQufvqVmITYNFdj13C4xaIC+rK+InVO/mJ9QR99ceqU4l9fWASFDsxT7fU2rRKmIZC1xOxAgAECpMMJRZyo2ks5mYXra0V+7SXWNCS4jl
+N/S4TsMoMEdDAdsu9L99lNp0bhSyZVePBzfCmf3c/gGsqmfdMluDbRsAkP/GuNdqor/EZ7JDdUOId6xaqQ80vOpVaWRb7HvWIX8apMQvb7Wj08s3lDeNzQCR
+CGFRwQFO/LPW0weAhnP0JG3UGfJz7t5cYo9/7KjOza6qjwzbqEOxuELbGeu3b5s9jLVuznFbkWLjcBC447AmFi22xrsvunowQehQaDpNAFYeU8FCmC1gYzYg
+aoWwvJEJRBe6cGr46u9O72c2VqmSXtTpMAfnMggRFNfVvPuFmbFcMJlucTZW5ZKtlFDVdaIirqgpcL++8Sz+ZPp5Y9vZPstGAd55VtKEAKDuLWAPVuJuOx68
+/fD77MIq5aduq3dzna4Llf0VIfAt4uMbPVKWzwSVUb4nMfocX4+gswRxKme9nlidpuIxeGz9nOrsmZ7iP40529Wj7EJzwEwFuuHB2JvGbSrjexM5WMbwP0NR
+dEmTmXBqR0QEJJwICUEtSzLO2pxqGITL/qNTn6aYuicoLiu+YdwPd6xS9MuqAOMet5ttLR55z28/VFyUiPTHcGbjjOpxkfTAyZokK3JL1kQxkUU60bzUigjl
+yhG5wLriYVQmoojDlW9OvOwlmsym66cVVzOA53etj81L6WM6i5RpbkQtnhnbozt17e8lnAe02MOsDTkdjofNylmXVCFxHtmknrEhKeoK+Idkk4zpTN0cczrD
+2QBErqPHHul3pFdc5exrvJqcAXCMIsLli0GKW2UoBm0XXM7vsjVW18d7NTkUp3QGn9qdIkRpCp8BSFRih+XjBkY9AVBOWYUl0rRscXaqknI8R1bU6wF+Kv1c
++uOyo5hhg4mQdYjTVvnWx0ra5RsTnjY9wNGqITFvunqIBXqwH1xTV6CZ1SmQTc9hUgbBBD0TFHLt0OK8xUQZEORvqDFRzw3NiIDNeB1V3zbly/qtRdXmNfRc
+c1ebXu64lnu/3HXNwF6ICw1XZRyca7qe9GCu1/xD6Tes8pU9bau3dVb3XKpPa+B4dEuN8SL135Yx65vm+ZrVhJ2bfI1MMs5+tP2Cd9pFnHdHsQ1j853snvIz
+woTeGp7MoKKyp2ycypjb7h3XTXjfGJbPkTGWvfQkSVKIsmbxlDBsFyeqFuwAE/AxoscLAFC5FD5C7FRep2cEuIZwgNTjY6aX86PpH6c/lX3zjG9dlDM095C2
+wrl4poy9VqD8a5CGJe5mUWA+pkUiQrf9ZneYqhE9uIeIm0sAZr3Vzhi6r/BZD5sec0ggeJ22nvIpP2OEG69QvLHrODwq3XpHCVRFw6esnPVR6KCMOOLYolRA
+SXvvy3lX5oeduoImJWdXtJSCAUxJ8pPJOzUKLq3n6pvSW9Lb00carQxTWxsVQSbGI3/XgNMPZztiy5GqQmAAzygjriIj9gzaFNumnkoakcUjl+BNAYDS65ra
+QbnzKce14SqqjzQ/jWjyTRTDz1obloMA8qKfUHkAEI0QKp8r/ATPNa313Qmqf66t/eJL8U/SH8naf43d+QUKwazpxuO03h/dEE+hmXtJ6PcXdOu7ekOgaOBZ
+9S1slEFixyyb7ABgbQxFj7iKM025auwtUPHqybdrnCs2h0I0vFdAzmE5rD5Idi02fbnmDyAeDQ1mg8ARNmibA+g5VxGkGWbKchVfX+f3W9P3S0VwF0jfNY0S
+Y+3oidkKggR5g3F/mbNFufa68lybgD/UtXuXptT3FbDGfEQr/iYSTJrcnwSJqw6UMxhWwCt8b/oon/ClusQ5Qz5GZsyl9ZVQCgwS+QfRko6ZolbdhU9zHTTC
+CbCTgoYsOCKcamAzJQCNDcXMBBYkr6UggxLpeeOe3/EXlUNTdawO2iGS2vD9laUY4ii0vuXhhaqo9YxqpaGFyoPaUAovD6tTdpqQ5t7aFtasxNJR9K1OaH6C
+iBkMB/1cg5/9YKOUOEPPrh+54w3MgxAJMq5wtxXgiRbro1Lkz0Ra1rYicIXKngUcTVxUw5OvC5zHqsK++9H0xVoDeEQcsmenzu3eWlyuwyl6glemY16Gu7sw
+HvacEMFi8UnjcmtEzy9Kzm/ZwBGM3oJtLvYx23m9I69/ZGMaw58HO37HgKBSFYeDiKdWRzvXG70TARGz35x39sCw92QtvNOChOViK4+0Th5zlC4W/6B7rISu
+VKj9RdhU6yrt9d7DRWiMWAK+rgVS9DpfPSilCANTj4uVGx6IX7opVQdqtVZZdB3ignHqeY5b3Yt+1NS1L8kwxt7ssYnNAiu825cyNPlElW8FjqSAiLDZliKJ
+K+QPZMaO6pdVngPPKO5MPW7pY8rl9Pl9qBj+epeHZYLw/hE4i4A+6OoOXKoCAkR8GGIhy9WEqwXSvwBDWtYnUeDbGwOf523pB/0uF3zo1yuHx3XHBvNNdtFu
+VajaXuBbXWrg1hIoPztqvU4YxCIyVBb6SvXHauqgmUWwN0ygmqgx2oI1WMAhmHAtKGDP8dKk1ZxLW32lon+gqiWXcnrHo2eZOjpVf1N6Hs1mYCC/vaqfx87P
+nMrb0B/cXEjbJUp6OMfkBdBWFpV/E1rvunyFCHBK/Tx4IfRCfjT9RPp11RbcCyGnXM66LZ49ILlYo+nMiaQ8++nBPqqGk8DeeBtxLMvmUI5vjB4ATbsjAn9R
+3iGQQi8U71E+ivqU1dw6iV0NvnPscci3CBC6vXCf3p++xHpuf5H+RYtz4QtfFKsJ2rq3Shp8SGPWZMt6KRTNza24qWGTcMZcb5e8tIR2CHipqm6rrfcED5G2
+C7Av5ULIyGguSBtxytjV/Rrcw5q1Ccpp8mOhj1zqnXXPFS78cLhQMTvGjbdzFpZ2zXcV+xC+khhDGCKLeKGTFTRWVVGfhJETvJBkr1kMLyJEG2JROPxHLsLy
+JPjhfUH9YYp+YNmF3KPQCeGNxZXDhocq+TqYue6ZcqdVUEXwTKgJzXGEOBH27SJ9gGoLLfHXgCANQaHR0Z3q8qOK0IgOpPCFM9UCqBxzBICAVLW4XwxzJjKR
+fty9T8QnkHfooBLvrbVxfwluSmQUtxJ6CCBLjuS1klOX27DH7Zq02aPKCBE5pRsTn8eEapK10HmNovMkgR7Oe68r/k3sK478Sf8W9ifJqH7G1cAv0mAIUGve
+guVTWYbWaTSnHKeyiCjg05hKVru41k4rvCHE8rkx82BaTraAn5NJvbdgBbLq+PuGfZG+kePQ4zn+oVgjbfwPRCJ534GrlyHDj4eSStopL9qFb2yxR7jwfXAk
+dCdUfebsLeN5wGmnf/5e9WH/h6bN4djDfd0lDJ90Zrlc2oeWHgzzXG/MOjfsKg48hCakW/WNoqmyPVUHU1YKygYCgOHiMy23pk8JyLCZpIIlMk+uOw/juaum
++JiXHv4flS6MnTU7uS6fMJcZuLgm0mGTo4XDws5ysKNqgRHJZBkA8lSu3XcmAqpeF91uQ/xIgPv6TLEtfbE854pL6R7g+tz3ruh6/1Tx8FLUIY/OIkBf5tTt
+ve3dbcuavKy9OxyEBUKAiB7+IC61dwHuo9Q2yF7+Fp6l1s7rKx337DscXaTVzbEXwqlizvUJen0P1o9kEQZf3GpLs1bEgapdevgnNe0MJC1X7LFnpCOQU0RF
+EwKg8ccpBE2zBMQnmFHqM/Xe8t+dfjh9WVfcb2hH5xCLRMZGU8FX7kvy8XSZ9aWVmMqIHmwbGt2yYm18Br0+Bff39pcCfOIrJBO29VExYBHdJciGFVkgc4Ce
+d+am2PjIjOxP8ePpyx7lFHTeH5iKiiNmbCkZekpR0UE/qjPKszQ9NImN4nI7A6j1chqZCAGQCIiwfGA6Lva4MDglIoVYzjFmZGtnIgVUV+gm1/Xkc+wiZQhq
+GnUNvZ3jwTJGwgZRC4uxcbsYDhe1PXYBlLdpyNh139VCatXJnqA3pI8NEu+T+7WcqBzrs/2VlrbREMO8EHwRkuG9R73f5tW+DeYdBi++1KrmoIfSjU0GdUgF
+1R5gtyPim9ULTDhFzK6xpW+6GX0bnoC3T9B1Q2JvYExHn/nsapC1ib2YMA969XPnfvObm1fqthLj2igMQgsYXVzNhmJWiLRbH4FiAE1RRvo5syQZDFMppUgN
+/kLiDZmQf8Uz5OeiYcwbTN170wfTxzlbFlzqf3MBNmTR8sEKBvWjWUWeZzXLzlOClqfCMyy7sQIDriQhUiYlLHtAZPKFUpVy1gkAUNJK8rPF9U16qIknP6t0
+NhAB20aQAAgh1h8+kr4vfTr9MGsvyw5rON8aEQeQtnRgOCsSOZfN+cL9spkC759PZqL9/FNXqp5YNmCq0N07NUuumwtUm4XYYJ5IK5n8pAb8rUdx22jG4LmA
+FalCJLcj7juf5S6s9jyunHkZ5cf3tmPug2jxg/3ZVyPQbud4g2bo+DQmPkrKX4OlUoMzkCqVDEn1CtKpt9SB5UsnfQphe+4+SS+6a1BUskq7brXk4wPeSJsA
+PLDlNjb3fn9l201A262SLY6uzE+VxF4t1yc1TYm2FTVam0WfExperWbfCOplG4B2NV98H7Mavk/VMP7+ZdyGYQyXXulJZQTyZmSUtnwcF8lH9goNXsIdPxCw
+FV8nLUo4MbkBqmofB5lAhACrRKTLpalB7li/6YfS5zXTKzvAn6a/qqpc2wektmomOC5VfHk6Vy5LXfoahERnj+OBDcQEemgmageWjhEJL+iUunLiJJBMjxMB
+EI11lQLRH4EQYCLTRuXnE8z6Qxga2A0404B35WnBzp7Cef1hz5GNLZEkJTOI4XHWP9N/o/IANhVqtz8+ThPvplMWyZWzTTnJuPDrBpTAJMOihUcC1xjhjcdP
+oXCMccivjmPnFfICbbQ/u9/lipA/xayIf5iSLoRHXLFGNIoDFy5whOiVb+5FZkPL3kERckKaXzm/kYRudpSUZ3l5+wyCKyfnoGT+6hmZyYtyhHHu7fLjGstK
+xf93m266/bsMrXmc25+plmoZEpNf0WqcWp+bnlokHiGcQXDKXTSmVaUI2unbW2DOBkk2ckc55zSVgdHl5isNQw78pvRR9nSpGd9gcpdCBt5sj/nR02VBgfLA
+FYJhFOCfPdwS/ZP22mVdzIgSUYA8lVsuSZygvlye5fzMmaCyhjNZedM4/883tblXvB9plTlnHQ4XdWajh9R1y8g6KGXg8GpuEUOpLWGv3D4qcKwIqtGoVWzP
+6OlB+bSx2Vc9BMcNxR7j5xj9/0eq1d0wRUbN+mb+J0vRfyVaBVbA46aQaS6+muXjmuyeM11UIO6OS2mLYbM62qq6ToAJ/DdvFDOi5MvwzCx6Vhdj9IGUMXxP
+qOHqub4NghEGkV1W6nvMGI092qyRCrmvrNiTj1FR7qol3W7UC2MwxXJJW097kt6bfsTPmnL9rLh7/UhEtJcSdHDs++7XXv/KI6q5swCPhibdtep1Pu+Kp3ZJ
+U9Lt5hTAiHdpzXtzc74MrB9Q9gmvr+6MWeUSErdHEY7ID2oIyNq65jj9ss7HlQa5JbhdySUAsAhrS8HLqooyb61mV2znd0SwiG3yOHeNy20PqnHvk1BLKmNS
+dpW/TP+yImvFQ8vEuM+MyjHahjk7oDGAVeEhJ6y5aru1DsvsEdvEg9nmXvHjvMnwaZwBMxJMrZBCTOqhfoDqrQUnFHAqgSu4tNZTABmBEAHIGH26BpFQ7XwA
+TlS+ErpGtycXPs6nVTPvTx7pLvHqqglzNP3n5183NcsoAcZfWWi303K3VF34Ced1TiCjXqERWsNbTRSwHmdnHCioHWv5ofCmJNOVdnE77bnPcJ3lJ1UN+o9k
+xj3gXSZYZi9Oblwk3LapDc+d4bpNroRBrdIX/Kv2Q1RLkPcrS5giRKXTYIScvMaHAgvC+WTKpLPvLiGUB3OaNIzEnFYM9b5LGap8bohpPspVhh/pPG8fzrDH
+YTGb2vdw71XlKh26DMk+bxv0K0Aik92UFqvBhhXIwB/uEi7k37XadxcdWpy+1NblYg3/rekdaUw/VniO7ufXEqU3o6OBH2J+dmy4RzA/FXUBQCh4l4wL/cHI
+/1iU3J2w7QJc3B1MANDFvIf0Od5pflPjwKBA8Dh8VLbm2cHNM1WOeTz7nYqZ0iKdt6p5ublTy3nc1IRBbHCdwhfSUBN+AYFUw+kCFJWut7rf++f8I7zGEACw
+xw59c3qfOlmJxo6pnspJmK5s3kmjIF/glb0TxgXHBnqOlWfdGCN7/1TaZ450dYgRj2N57pVbx4uB8NxcO5xQGEs0KpABkGscJRUaOeHmXtsKGIBJayCI+p9k
+t2PFZu69V7UwvpB+M/1V+t/S/5H+Hxd0GYyD6l0GQ+KNjbZXLJM1HnjB8e5oeNqz37uJv09/l3180iD7YNGRWsg61J6Md6np9YQvZuauZ4msEF0f44PaIiNq
+P1/6DdD5fRcvEanBmpbCr1ddRCmkPoTFD07qtXqkAYi6xnclpKd2VszgXVduJTwBdf2xKXAOEJB8XgtHSuoDU5Jg1bqm8+hWT43ysxzHrkDsJmvZGivkq7yF
+Ov46vdwheEoG/deukqgz+EKNxLPgXCXWbq6DqHlZ8U+1GV8FXltW7XDQTLTMTzCprRh7tZGv6080AnPVLVi+2YRvM2nFBqfbIqZd6kj+AzMJaOJe8w1rPayl
+vOt6nKZr9Yi2c6PWWfVLN1o/P8aibiMfjcjdeCIAApq3pEljeH5YNHGxvsPqq3ZGC6s9ZqDxxJYH0hsaj4B3sNvny1wHLz16KTQ1VoA3vBOOG4tmbtTF4qAe
+pw+ZKoYWhH53PA5mEpntxhOs+DDMZQSylKtmLsWEPPGoxr8Yjxm+TJzXryqi4RkCNL2zdXrKI/B5x3P/ZtVtDWjsgNo2DMPtoyrgO1lOmrFaVSq2Xg/ewK+s
+v/KW2fYVaAJYMIQtg6UBrN/uNi1QA43lUhRZL+Xkphz81ueQ353end6XPpA+xJp3n5O+ailYP9g23Q/se3U8bOyjen9dGxeQu6blBZ3aaq0sBWbDoQgRAqy7
+trCgKqtSYU71M/0poQKGWsKL6Zpx/Mrf3AX9Wa2uXFibrUnx7hiSY/4di879fV2WH1glAjI75kl2wMxPJlKM1sKUbg73qXy381l/T/rUnJ1gkqPjcuZRNeK7
+RE2v6gJAUlWktWbvXG+3Cg7wGRuQSFCL0al8uYDDXoWQaImUbbna842O/N9LnwwnwT+LqmgxEpPo7GCRhnWCzxetH2PmbyXpG49XuCQytC79zgZbrlPP5RsQ
+Qr+zU361csBSvIb3labBfQVbTNdL6T3pa2FeOYrrENROFaR0zIY80Plz69omD3jbVxUg/rn1sWK8TDey1oayWAWDilYsHKoiJTlLeiNumxYgX/7NU+x1PN9w
+wdtqiPMvI48nNzKaNgCuhbDMaxjDIbHroohdZWWViah+mmhnxiqR2t23Yt5EmihIQAHAt1vKA+ilenQMLdb9hrKHw/GAURSL5K3Ph1r2Jr2afkAj/zIy7L5z
+5TBHk3zIGkncE7O6E3GOaPyxkUwbHcxTfs1OhS+8ADIRp0tT4JBlYkc9wKCGPCFR1WTkdxziU9A7klCUi7wgTWLQfCgLF7PrGUd8QemHNbkoTwUnPw0VenPP
+eOwXeXoeemoYcRsjewPHytQaxqHUrteo1lJeFgqhPAEhuXAU8R9+eALZkyedMsbbtYWTu6FqHdgAW28UBaUSxblTuFJfdfzkH6Y/k3r/rtWjPUTI6L4y1sNK
+Cv2esqnfXERgr5GYcRt2s0LsdRCvxdkB5ZccvXCENwbK+pEnoeG9FNUB1cV+mdxuG3ykgjxp+N+/wPW2f9bU27qDa+uy4/dHAPmy+Eee4888aPLTETsJNCAj
+M5wgmuWOi6GRaBLxooNJptxZIscZBJmNpDzakTtf1+i//5Z23YJm0bbzuCgnXMDpVmAVD5NiI49Rylxn4bi5pywnMXAmWPFFC1Q9A3nHg8w0Wl69Arll5fHT
+rMfKDyoidYV3iTcsChedFe5Q02tAbPT9P+d1mt8N7nH6bjQVVF0pNQx6BJWhjZRj7hM+58gZEPW+IyyGP0G7qkGIWRtSveRW6SybQVFV1bOh5jzWhTc8g1T6
+98O21TPaqKdz3XIeT77Lagi83kccnvYiPfa50doLyuog8UM465q6gi4a0sJB7d06CQuAlh0gHKpQ1yqSw9+fa3zXBBlW5g2mPwvqXxoa3lOY2g+1g1jldoeo
+HLl3a/bBdcWHinnfRQrwIOz7KxNQVQBhuAREyDhLLngeyCqCu2QtQwOvtlFA+a3EowZORkLZlhl4BSi/HwlQilMUYwPxuS+n3B/waP1F9BoOfsMbxW8fY3Na
+zj5zps084w4PxdQ8pUYWt1bTy1tJdPmX3igtVkJsXg1aCfCGfrlwWKWyLrs8PSeRNpGgAZlJQEunInqBNFf8VRdwo747Wc0KgQCiN/Pb04+n3/NqVcSG+eiI
+sOxBDWlb/0sdVWkgVRaKPrq5ZCPj0pVnFxJBuw1RLdWLdW0L//YiO5WfxdbPOAZMSwysFiez5lpx7MFyp/Hw8GzoC5p9d0PigNL55yOOXZ+H0qx+UqaHOwsB
+O/x16ZbZ05MicTOPARmJgpZufK3j2i8rvz9NIEaSJAjijO2Z/TnN5S/Trj8EvL51XTaHjXYSlC9RK3WHqEdcfyK4VekevRbiUa9HBjU3qKjku8Sw9WQyF/4k
+kJQNARdR4hR+EfIwdvQr/a5ja8rPNjnZh1ib7meD9gDjna/qQeaEakGiPuhqnkP5ZNyMNkhl0myjrve4V+UU+ad6OSM3ajiS1vwiG2zMR9BlK3LsVOfYZuV9
+2p6fXa4Q50pvTeSox95J42gA4EXa9A6+wF3Wv0r/Mf3n9F/cDc7r/HuFL+yH7rHNwmrax4cMFbK/MM4WGk4+xP/mqvlqF7rbKwxaO9j28amtfvSGywaatp+v
+qIBsG9cqTQm8ByijhjWypKVa5QSYA9bPzMW/udNVaVnrf57+Kv236d81no9VZ3xBWDyq4O50Pt/v6ijeHD4jX8nSBysfOVYJ4KYNGxwEtLGBJWEBKDG5ALDz
+KwNdUsdTCRCu92Pydr3weNLfPTeAbPtgocOmtftas/uEsnJ/Th16Wp3h4/a++sthMJVG0ViQYReVYRMrMeqCxmA7w8uVxY1CgwEkXGwZk9IWTiShAVax4a7s
+MkFOWh6WgZ0SlQl5p9ud/Rc9J7M4r/2C1yx/x3Pj4AHbYm8O36j67hiURkIjcM09ZWeZ8ofzarw5NP/uEqEs0bb9eaaG6/K8p8Dq6Hzc3pzeqTyxXxGX8G1A
+KkS6ZdU9cKL2PZPFGFRS6Rs8t9kxQbU864mg2YziCICuEXbqLAbLEKz40cx/K7zUnwAkJvlzO6uJrPhS/bKlDqD1yjc0fffCqSynY9EY6XWx7tHKU3XeDZvv
+3q/Yc1Wes79qQAlRDBOWWurlDmboJHpA3KmQUPhjGXqxSFfPk/6IQLnDTAEeBcRVGJAXmn7Jbfpw+piqgH6++ppdMTZPu5hy819dXZL4jxuR558vi/h1iatX
+xk9v8/wT8bU2/XB+h6H6gbSY509gTMHGGq/vh6/T8+mgV/6D2uPcqE7zZt2Jxo3nZPgDLzKLaqqKPK/pBCuatHwKALgKGvxQ6ZClnylEFIA1P3UCA0FQg4yo
+/MecSElL8XreqUzrv0r/Kv13npF72WKm2iGVaF33in1uqLFnQSVq66Hk9G2opLKm4PbofjFSQA3yDeJx0uKjuSnaYXSCbol8oQhel32VLy1jlMTd6hUzNIl0
+xiTFzZKzW61jpS+DOizTKxxBf9W7iH/GKnp/I5lXUPK6fxuQBpdVC0VR0c7ho8Okr039hIORzGHKQdNVuX0396jwCEHsJMPjYUhSGZTajpWRC6VRrw91Ehgq
+0LiksoOThnfZVcN9ipeNOd69+Nbnsk/SFzn+485iZaUEfl3FAR9jdPagunT5vuKqG6BxdhWJEg6szZ6MwH1z0Bjqvco0Cs54SmAlWJzj5hb0poVgOFmUzL8J
+1Y25iVtAu2c/rufSr5pnSKjlX5zfj/L5UC++24YDEiObR/cCs5BEzYwvTjfgrKIxa+ecLWb2CM/IwdW2CZtMcx+brNMu/Yiexv/oUl5z7lv06onPz9bzWQu0
+18elEnSVWjxHXbYOhqHLIZfXz+uLAAhOiCbEucBUDgd0ThOCUhKCJN6kwECa6U5+d3o/6wh+v84J0xCUneeeECQKJs4w9vuGrcEXL0XAw4Y3JR7J2042EKCo
+pAeA+IoCkdB2aDnl+LSSPikpVAHUkyyEJLwrd9D7WBnpto03dJ69X3K24aN8e3feia1P2y8ifQ9942JrhUSF9HNmlCXz2eyOh4DfXVDlxMaPACpystYMZT+4
+S+js0nNevtqEpSUyCNh/I1oEljoQQHrTWf/Az6WfUBTc77pOy1+lv/1GPQSfVhUB7UirzdFw2F+Fz1v5kftELB/2EnSls3LhJgRwl8oDeFbV8lF+gqLAjMEA
+rP0+9FrknwxYka/NGeR66RXpu+S3OkdJ15XNohxaps2GDVC34AoHhLsUAftqkSuJhuFqgl8wIbb+yDXHSlIQktWfvEgL2HnCvCf9WPotiUGbes75co6vvlvr
+pm22wRN5W2trLVvmPj5kjXtlZumxeCUrdrmYI0juuIo7R0JN1xEyuypodDVvUxPBshnZzKmwk4SGBte3SWN6rzKv1KlXXQu8/XHtPQDJXsawI+Y+i1GtsKXz
+wnMyxBMjcuW8mkgAjSszVykfvO2xEjteY4I4hN4l45r2I1Vsd3sYaHxYa/1v0/pgutIr0t6Oca024+xqz+JoBlEo0eREpHaPhc1YFs3o4CFiF1o77lcoaBVN
+JaPdNgBOgICM0Otv8bpyxsNtBnTsB3+n66VWDsAvp98uva6res5XzVsXuToYr2qv4bGXLe4JBzzCPvBg7LSyOdftRNti9Sr0dhnJ2++2mfsmk928S1qIKSMI
+6g7G9ztg9xqWZtBVbugoVeIypSFwgp+mtzVVip/wqPG/Sn+e/jL0CO0Yl/stWhoXugoNUQO+ejx7H3YblUpYDucQ1KXCOE4mEjbVFkT1BKgN+fIgyqGEUJ4s
+Na6oeeNbMiy7EkWvf4stsAFjdXXSJ+mjzrnX2NJ499mFcI6HC/DetrWUH9sOD/DuG2AnTIAZUdKuvq24cvf6qh/Lz8gqDAuAMMeGA6CA5wnEKjzy8HP0vqmc
+gG9PH+1OalXrvgqqhQHvqrrT0fjnnHFhlePyPdbBHcJgKvN1FNnzKzeTcUmxuxQUkuotlj1Vn0WywCiX96aLX98QpqqL01gWAmjNWMaEpOaaq61MiGWq2phq
+jV2F8Wh8vLilePRY+d7y8XVN+/e2Lylm/caPoxjJKrDsFKe3ouiVNTBZaUy/v0qTF8RVy3PWZbB+AgBmb6ukZo4U7oxpjNXewu9rlh56qsIJbjtS+3u0+OoI
+lNSr7jwHa0mp+tb1DBTsLSnSXipaBmVtqJqk2Vst/Eg+j9lDl8lQP2ZFHpdWL3SaMZC7bNeO/eeb9B6vrX7SaxtRt7CT+p/nEYWi0UqxVdhPNxgEJvJPrtSI
+d4krrFyv4A9SyCAmA6NLEK5wZnFXIoJJZSmsIoAhoOs0f55P35Y+H3pOf1ij3tFNLb3gmnsxczZCe6CZsNu3sh7DQYqEvplynqTQYDHVhux3zM5z6hsLdYMo
+jyOBiSOs9DtIgXYxqepFVw88SaSnxl1OOk0yarP+3HWjWfhrnmm2cetC1lkjoLDPPLisxkZPYI2N4E0DETWP3H75lH9u81nDu7vknKraSKe5b4/+hVbf5M0c
+s3glo7KOPTPiCp4dPi5dooeLkuJH05Hds9fRgxgO/ahSMbdSK7xVjA8zPVPAbQdQlyeRfXUUHa1KzQaBnpRj6eolUAsFKXL0eA0UCTJ+gOpmHdbZczOde3YG
+uPIIL1cl5NG2zYsLxWVIRI+j/OAxempaWlT1+3wHWkslDJHsWKmzB6L/EWi26ZDVCTxEW/mufaZ6zBlIwspPDs0s3ZZC3+IVjovL6volziQw/Tn3LP5N+g+C
+m6odhX1kPu03DdDpMmax7m6uPTRuKiu26pvo2bY/bve7Vk81GNwodrWhDASeU0gkEWCV0GQfJ/2OSp2gWinpMyfSscezHOPq2YtAIGoo9lpcqIE63nbZ8wur
+8NPp7yvbpUSMVt9x1uzoyIP7OB5asxdNGJnBWbkt7hDafBY3NL4oI9EiM0ebegXmJFSEuwSkDubl3VS+JM8bYpu0DxQ1m/OCfnwvAWhTu/6etE9f4npF5GL/
+Wa/LsIScqrBXxqrmC0VRnqosngJ8+PM5h8oPAHedAjU1Ns8pCCkIIkj4nc0WdyILHfjdgq5CLWcvqaaQqej5R/+Bzjf7laCoL5nI77kCVtXRj/ZabBYvKl+X
+oFnEJn0YTY2n2+JmXfpmYWo7sfcbkOAiV36RB1rVjAoQAV6XJlKMx5L77Koct8lKr9is+w7n1HH9FxTmK7aeF2OP4784t21MCloN+rXnKa36PJDIm1kxcQk0
+CypyTW0pv5mIS1MpSru7xEcblx7SF9Mvao5i2ox/HRicFTmtXwrMXiKwqk1fhmJ9ITf6YGXp7qt12QHPaDzp6edjFOKMfhRkFWr/FFAKkndyEky+k+vgN1lN
+KV4u0YQs7NeP8iOn2LLsvb1eTO/RbOYLDcbs99Mf+e5vlcrh5pIq9T54Oo2Nh/2VF5yErH4Q6kPVyxrDWKoFQK5hRpA6IUuTpeWoQjoyaB7gyuVPCRGyT8Np
+1gLwyhyR6pwAIHCo7GW7oKSFMoZtvvQ76a/T36Z/m/5j+h/S/5z+S/rfK4d2Z7Wn2hxounCmO92v74VnMQh8G3qezto5zpwX9hZyW8Zxfdz6uDbHbTmWCLLJ
+HsW2ivsE+CMW+KHDdpooGiYgYcYR0IRV25o6ZMFMo0dSWZ/mvPOmlVsJiO5FA1LIBDD3kSoxTKmpvpS26Sa9Kx20Myj11Z/Q+9Kq0C5+7GxYim5UJL03X3HS
+NnJvGUVTiy3XtTQ+c2OZKNKbpEOzgkU1orqn5mQJGyDdpfL2ptm1vzl9W/r29F3pu9M+fYCZqKIC+KWqed1efRbSbvaL50sL3jMyAPp4eVYQ2mqHwoYhal5r
+hF9aKgCU5940U/DKsjtrLpgZTvVBQ1NmZ81jeKNqslwGBxEBUnrr4tx4B8+MV9J70627vJduhWTvv87n75/cN0/qEO2aGfGUL/xpHDafR1f60+K+asNXHZCe
+cBOsG8k4fu1MOlU8vPeHdSCroIycLSCeIKtkg4oLs8vSg/oFBnwEyn/D0+75zmPs3en9zMwQrvzPtgi97TAH9Wgt1YWg4/zy4RJRty0PbbVAkdKsOdJSqPtR
+JE+kmW6QblVryYzKp1YsI93xUUVzrcWHRAW/Ao0u24cdYVDmS8lIf8WudxxG7+uKW8dgMZbJtWoaak7McaFVfMqoKlYqrtlPh6ncS+xR1ADrtLTHOO6Np0KS
+EfATTpAdRAiAz8hDN30DqDvNW5pal6ygl8Pe+gn3rf5J1Qr4/eiPU653HLwKNFz3t13yxO7m1/11CTdx1bnjyNQY9WdGJcDbpBJFOphQZcPDbJmQTNasrqoO
+Nlgn0WTfnrRDrm7uMQtyF1GZTr2VVihy+GqDXnOu7FWlPv+S49tlTNtdybYSbtnVET14JuUKyQuDNfdV36o3yo3NTW6m7gf73F3Sn/luo+idU/mEAmVQV5wE
+wSttAkq4ph5lYTwUmYFaBNHRMfVEV+0TaWXElDadH/T3+v5j3m3mO/p7inH9l3UmzpjgByP+Hys0Q5rp8gPNhi9kdxOl4O8eX1m16/W4bdbty7mZh477kWvD
+uwSy3Mj285Uv5pMRDk9ml+DGVetky/pUcwNNm2Q82y0iK6UelCeuoOMa/frRqZ+nlL61yROu+dR8D6/6j/Ga/7y6SJdz83d9J9yMyqG49TaYzrWR5dakrtZu
+/zqL454nI7bzE1J1PhfXPa/tSRXSJu7AqqeGUckyqq/2lOp+WUetOSYqpkp5wzxBTxSCYxlIGcCcfPgo9vbX6Y3pm9O3hpjsU8HzxlWtdjJLyoUOEWJWh0EX
+9sDuuUObEMjJel33xmG7Z8TA8v5o7gpaBap49AyyO2btLdrUIAlP1b+lboNCB5cGVcgAUGBE0tGGxinYh8kqvC0+VJwnS+YZMXv/tsYTnszM9rFj1CJqNA0r
+LDL07DeHvjB82LM3Z+5OY975ukJSNdFVhTuiOiwqaYBEM54Y2lHh53PNr1C/ZacDdgV3w+Aqx06/gbmkq35k52CgqST+9C3N+hWlxJv0Lo9lDHPyi/O1e+0a
+gWtfrfOIRVDXT8JJsduMDN0b712nso+Vj9ZUsRP3BGXRZhKcA2HuYzg7bTNlFQa0AE5HwhlSmJujhldnO+dK1GK6xKZy5Nozu6gMNle/ahQ0x7mLhabxTx8I
+cW1+3S6GulfakkHFTiiOjzTHrm84j35PzsyvyxYJXR7bKHZzgTpswuGo1U4EAO0Yvol3t+/UXOB96XvZ4e/LPqO4AcBzZyPOQGUohu1g+ldZFRVlEHgKGaFE
+RWVuF6dRoVklAJjSGkzpopyThEBA5XEBIBP2KcAzLJ+t0zMSW3dZPmCii0SobdQqj0XU1MxuVEHyR9MXQ//ut9Lvpf9mPneibpF6G1aFwkOAbzuY/zpu+4xn
+jxll+XrYZp8zKh+mmmJBoHTLM6e8VckCPbZqtm3pJGb+osiZ8iGp6d+UoDxAMoDYQdkn/a3kbXWZd55DykcrFVXdiC6n2Hi94vsCsuLL3HGxWqRUe/1E2Gnc
+VpWzOG7Y7tsHn56rZ5g09mHY7hSIcfBNTunUcSxzUwmQW3h1UMa1cws4EHYBQ/PoxbC9YfmUYzGdbrridIRPwYeSDPAdnc3MBxayq2/pW+CTYtNNvVvQm2pr
+wE9Cbvt5zWx/k0/iP9XRtvxVauraSGDt1INSKXUDlE/GzdgMvIbL0aTTT9fdbHlfzRRlNVrWQs8kiYSTWpR4bgNssS5m7BM328dATPyCMayXmHV75U8sygkZ
+CWLnXR93vpiFiPPP19Mfpr+RMTQdIdXk2lZtLpazZ0rhQmIXI0A7QMZuQCWykR1A0zVTed5uzu+gMcIgX8o2X0/WX/ZhlS8mqmNYQ2b+Xk6AEwIClYImBhlO
+flMkF4+u0Q6g0kas5lLH9h/oHmAa2L+nceH8fLakbSk8XLQ/dL29uiE/nW27luVJ+GdjOyh4JVB+fZ/tB4YAETBsvvP9FmXHVSifFfS6UrnOPvvOhHVYK4FU
+eUdyx6zZk1O6Ix7Pa+4oDhHCuB9ESZ7D9fKHY3d8hgU0AP6zkJ5WteTybzPOfz7jM8oovwQhA8ApMbUipZW/hrel71WHyrJ/6BoI2ntmm6ePit+8FI+Oh+Mq
+2ckCgKs6rGajU/7bU2LEQ62ul8uBnCgTtP4nb1M038edl7jkq3C8rUoTfs3jcBhyU/tdgx6tEvuHMc1kt2vOqShP4Kiem/FZ12R4jW/W2uqnjSkhVORhGwZs
+LNHURqEp9mfYmu3Tdj9wi82r8iuhwwACUDGgBSas1zecdGxJ3EUmKUGLcoXphGUgt/VEBAjaVuv0beknZY3m4zbc6MHsD3KAxQmeppMoqPoNwm9oOrTH7XFN
+oicJ5Y1whW731npf24CTQhhAJ4ics6sERDNdYatGNVpntfLxqmL1U16eF90M2vo8iR4u7k2i2i3rdr7YCSTzxjKelQVRkNt5E9sjTf8uJZnvtoZtxruOLb/b
+jP76c9UV6W/IkLV+NVvv67LRU/D5yPy/80tVDdtVqnj+8uZSBq3edxnjj7MacdpVHFbVeIlZVrBBE68Tu6Kt+vvmsggGvrZytq5ogVqIwOhqHfl1gjIx3D4I
+W0KVXhPXM6Rjx9oVSfqQV0Gb/anWa1sMpDLBVF+04bqoqLBZE4972WCDEmyJtpxM1+eZG2sYS6VRZtnKl8JEqJOHJ41e7apaHOaZBOwEUVkagkyS/pPqtlis
+AuTY33VVGGz5T++oPmTjAkrPGiSPHxvZK4ZKk9k0BngLIzNRXPL3jk03Oj4yFgp5sH12cMLwSDbdjA253vRXo8J0mMcaO6pN88bYGi2k51gxdz0stFYKDWlr
+Nu+6OpGn/kTo9tWgQcWz1ohDtV10CagiGWL1aNDnnpKS5ag+bv4F9Xo/UVGG91y1OsgOjdSHw+rCLrBVz6IWhtc8wbI+KatM6FcPMCHSOvXXCysgCscJe7Al
+5tTVYXAHGLlsAgxiiGH/8DQurImSLZR+RRqrQq287K3QCeqr3zuDwjz/R0OFDYd6BIma325kdRf+w4Zz6JLo+sJ4Dyv3Rs+KMuvTJCRJFkU4uUmLODCjEpH4
++aJIg6qZTRUcDRDWPHhnkNf86JCzeEKO8cF5+GWH/ngU2QM+g+QcehZ5QbZKsZb8pkpfbBVY+uPeSvsAEi/jGtdQsCIhLhSnEN1UohJeffnx5YYbdhxngezh
+uNYNAZ7NNVwBgYAkXpFjclV7OHep5cDxBVBOdEoc5UKI+01jsoz+4M6PgmLzFliF6M/vzcF71pJhakVYpa+5CEkT4mxUSRVns68ajPKOtmPeJW7CNzGAoB//
+JP2LWpN+1Es8bDQMPhNQyjEziyi3rBcxisGWMCA1VBuldFlCTli+VuijTrtulIBZkHkTWRcqly/XcGZEZhMU6oaiOBdCanS/BbVnrBM9KXVLeORNHhency1o
+l+feDAK2XZOmMGcmQYbuzQygEDIS779npkWY2/KY8Vaixsr70ocSaP3+5zSq2A/6yh+eKsP2mDfqPc4VrOuNzwStx76aQ83+uM24eJlAK3S0YaUFJ1BujYKL
+2yvMUA5XAQwoj7MeFqg10tfrXoRaF5V6iF9pjHbu3YTEQdjX/YbR+GaMqPM7hxZ/eVm4sCeBkXhXPru1fi7XibN9iSRxQdRQYg1IUzJP1pgz/lrph45B89+q
+ZONr2KdmqF7nzLue13EnP+na/iLEeuYON19Pbh1B0sDHs0tZNacz1Ww1xVzhu73bmRy3uRDi13ze04g5K/417eim13Grvnh8ssYtx99M7hOJzo+S+17Hb+Zq
+pVmxt2eGzGxfoPUVNESnoI5KDivMZmZ4b/fRlnbPLotjRA9x7LyrcEUlpxazxeOh2TZkYJ5WM/loQnvn+RLmWhEVmm7YA8k9sFaATXyBAbwq5b6AwPB5gczf
+PqR/nf5D+u9jDhmCjqOnNkZWin3Jo4kaB38WE2N77Pxo9bTlf7X/k+l19fDDsGVrFKx1yeX5ok91Mnj4vqeaGt7U4SM6t9j8B3EeZ/+qagGlrMK4clWPGgx/
+ZnjKvr8tHvaNUuVSae5hPF77pDrxqCyOCc0eUvym1IL4gzS4syL6lkcD5uEuUk6a6vLsnHzadTjiVxuU48+pj0ZUYfyPVUtp98gJNXBA9f8DTaUpLU86z1O/
+EW2lM3MwJ8BTW+K9SF/p+a4GJtoppfPxazxvlyLhxRN/2PrYHhYkVbwBUmKeRqZgrPIpN1E9ZaPyKZMlgPHKdBRVSiVAtOGE5cKz0qur8keMe+13YUZ058Za
+RrH2FNr+GD60uotlZv6L9K/Tv0n/Y/q/VA+Kg7Yrl3TLpc07NLSMrQS/x8Ml49wYp0qcsfXyyrXVLg7aBs1VmdQNqquaVRMylbdJ1UMW9dB0iCbtgEz12/IL
+Q1UvIaEMunfvu4H3n+VGDShlGc3TFLFUM0Fz+XV6WbFDEfUrrL5/5Fi2B7DPpYHEtZ3QT4/4Z1cy34c+Xvll6wqMHmSD5SZAC4MuM27Ng4fNsgUXb5r8QfcA
+a5HQ8IxWqTKT2xuAgBmIgDPttoceMTAf4K5NidkLIvoXA/a0en+JiXltepV/148dlGE2ICDAToGurMoI4b2DUQfC2oYEJwCS3S9D7eEujISNw9BpzFadDGFX
+/Jh3cX+7nhw8HkMbpO+r+jZjgBrURWh886CEBnlFBh723q89+LIvScSaJA6Juiu8N+ek4zMlI+mD7TQsoenN1buk2392FgEqQ3RxoJSZEvhlOaW3NxqhSyuq
+ZLRf6ZjG/5L3txIP/i8+mxrgts2CjYMab6u3TkXbdhOrx/UtjObSQh2bkb2KM9ThukxWoMh3JFgBniQdbCakdmsxDHqKmEDNJ+7ShDWL4E+y2GthU4iGpdUL
+ThTCrglLVc8eO25kwUseGh3xgvgqiKQ/Dh6ZYi5TgaaDLWln/jny4OyutzT6el4HFCVvgh/ONvBKhKmKH6vEM1y81xaXffmJCaodRp3XJHInAPYxh6SlMiPa
+ma1f5SRz+/Fjdxxr6nVQQY+/07FzlcS/07FTg99m9BRnRVYRf+TYfavXzAtWV5Ryo3K9Odph+sv0N2EEdfB2Cw6tAZ1Vx5jPmjJGik19YGeNWKxd3BdqRcnV
+/0/UghxyRaqsk+aACjR3y1IwFZCc5I7IOjcrfDBJbx4zyOnkUA+YEi4scjOOIDmq3Sshzk1RIgZVAPsRrasV/G91Td+bkJEFeGyKYcf3EKDm8QR/1CStJ3ic
+le5CxlGMnj64IjwhnJ+YYS7yeGUy3sJkEN9VWjynfFu0Eyo1uPHCXogRjfBJv8w153/cjNbYjcfOxmpsx/D672ycJqhjw9esw3b5ONWRAR4qHbZoIX1+mDrO
+VlW2+MP0J8w5+o/pf03/W8yVXbS7xzJs50WFByyCFFxcBcMFOvzocfUxNZ9TnFQM3As0bXZib6h1PQI8M9hqQii8m1UPHm7MEuStegXp2ymduQflDgDEuQp8
+DzbKcqiR1aed/xwiT0Oll09yN1S5s/qXMTc3MC4WRrH52zAxpfSAd+mkOcQ6BDlEnFkBaXY7QQAAk0WWSeZdaEWrDBk/N36GItK00uIkhnn5eh+Tolxzq7jU
+irsTM7weqjQ2RoRPlN7hjwxR+3KNSKWO5c3LDJ5L5FQ+4eAPzfAooJS8kaWWi+X1u71tfO1vT9/Br7/s1P1rX36t5Q6Uea7SqM3X/opX9ZWuEgFvGwDxlTav
+UMnk5J81tYe3N9G71R7kv95IO81H+Frrgc1Lz8et69e6XHH3UrHyUhHK6sxp0nI7aHlavr1ymSQm9BoviIjmr7m84pJtvObXzEr9MtTh8wdeexjxC169wVD4
+Y6OT8+b07nTkGOkzFTvhttDbjb32sZNTLn+uK2holKCmfhVePqJB7IJmck6aQpZboLxBBKJ4jtjbZFNdPjTnxU16n1f5CgpGtOoV3VzdiQ7qj98cDV5THYw8
+ctBToFo9jZvQ1dbwZDiUFufRvXCYRrKPJ4wq/8jnO/XzVdGBBr8n92yNpC2MTPoYy6nLXQyoe73dPDzuCRoF9yhjawaJvv2LJvmEQFIEz95nNEah1GMAUlol
+dHzR+8wRjIsJ25pZ6J8IL947LCMrNB3AJrBO3Pp5doMdvnAFF7u2zjpoO78jvb+8Cm9N8YnCWU+5YfEVla82vNTkhq1JzBcIoL6gJDMOxIyOM1/KGkqT2GzJ
+zguEBBDxq7v0fuWe/qTylSK2RckImi/5iziYD6Eh8M6+3BzqCApO4u9JbrE2aiOnVirY41Vxaq/rBOVtLVkCBpau5Vd688tfmlJ3zSfzzEZrbrwxgc+Jt6Tv
+TN/jTI0SGdgcUZByuZan2g4crL26q94E8QQMn9fW4sr6poq0mmzuULM9+DQCRaYzSB3WiWe8STv7ZwCYniRUXNI3N+ol6cru0P64jXdo77dIIHNsrCBOXnvd
++Pxzn/a0ktef5QadYEUn0ds7iTGmwcL1U16XHLvIKq0m5LX39uH0WZ5zNuMsIa9W/vOO0f44+sv3Haz2eQedmPvjtrnKrA1JzPjMlMp1NlRAFfIMtMtFg+WQ
+X1QvTYQ2u5RbXy6a42S+9hOEmADXqAoE0d/m2Hi57DddC1GvoOnVbI9+8S6/tYk0wsFF78dNoX+bNGxGchy4mg5DRrO/zomjR0TbmoGjiZRRnB3LZhs8jj1G
+J9PTpuhn43EdBgnPJHwPGYt3pJ0j5Y/1CI6IuKxH11rJBhPaQVtWCangSXkESP/cKdRNFpTsuaT7HOPbc8u/bFjhddPPvAsHJUrWdcvGqkHuCbCYkzAMEMpr
+UKtwHQR5QeXVpIxAk2pOc2oaYq7CkS3dAak7pHE+IjbZczDsG3oS9f6auYrjZi0uworgFZ1ikf4uZwJNUhSFdeJ8H1cgMHV7k8Bcx2+qrUqeLhGnu07vCqpw
+aVe5+IvjKiTfgMfV9W4s2M7TKFauPcs1f70STWbl2afZDXEGUFZr/JldiZuTeQfXbxf6Qpd/djOVP+0gH3d4BwiaI329vmUB/ybzVUtP8p/rPOwE3cKY+Spw
+1BnLQORZAj9sr9tqPb+/bdTKomrBk5mC0HafAePMRpTZMRHQiuxyQ5+7SkrNuuBUAbe8FOLoytrkb/Fv6cxQe6Sk/A6N/LHhC3wv11H+PHR0vcLZcvmaRqxu
+L7yV5uHQC7K66bliqQ1H4xCZ+T3K7u5AGleIwkN5TxlPsE5iswItErR8IB/tFVYEOHZwZLHUyj3+N2Kh1CPWt9j0xLWpSrWjciTTTnaW47ZJesZNPSdz4xsW
+7QlF4H/U0IEfO8zgn0pg4eRAhLa3ZT8iiVQkXcoQtm/CBpFirNI6YCZWRTO8qJhKW3IgsBnjDplXND/MfTr53RB0Z9dpTC+HFVtiYHOA1RU5Oxm2+8H0y3QQ
+NnkYfRrI56MW2cu0KqfmumENg0ggrqX8r7oCsjFnCzAEPhgPkBofVrdX6c1TtmMbUpI8R66vXFWSnWPcbyIBMEAGA4vCLkhTHj7v+txOYEZilamBuRZU+Qty
+GzitJa8SUUpX4TVZx+cbeGWj0xXlHHj4dWbdwh96ufYdKq86pbXH1e/j09nwdkIn8pfsZCKBjzP9YjA4/1oC6vIisX+VvBrSSQ7YUgHRR03sUrGWGZ7J47yr
+NLWO7+FI/+NxRA9xRO2l+ViKuurxcLzhjEBLlobtyYEIGpdcWb4kmlTE4Cfe3GwYVdlLegr6YiGrzobshKk8J2Bb15xvvTO9RxDLlgfHWpKpgm+2myN/88iK
+psM6TclYvGDObydcqa+t7Z8nxcAJrmqa6bu8hWuI35Xemb6H1SUKFuFL6Sv3vJ6Gga/AtCt/jVUFU6eqEfOVkrM5XC2+/ir5jPrxZCxOqYKeSGBnq+WLrG96
+uXaskNZOJmp6Nk/S24MqgXS6fsjVutw9/oE7EsUvR7tQL5Prle5mjf8VAjVXz3fvJBqmCBlg8hACy+WKfKMIj4B6F85ucd+CCEUZbPBz5aqltvl5z8TSLnRL
+qzhxaDsb7ORgNhvHMChZSmvDtk5TIVwbNqdsUatOb83cIQrXeEUnu30g6/8EK7RQisIPVMnxHOzDnpEzrlErKPIjjcb6WrXGYv6ZFi5HLva43e0Du0xaQqo1
+XqnkqlUxjDHIOuyPNzMLicNmncKVQhSl03Ncr9y1IKwOtMLuUg3Oi7IslGCaSWOCuqawr7mVLqXwnaxuyk3HjWyLzCywXbO/QJGOX7r/K5aWQ0KaqLif0xRD
+2HIrAXO8+ACsq5/pJViOgUGPO/KfpCP9i+lXqosM51B+S2R1CjWi3JFAjD5n8X+r8NHFBT8cefJWyXVerS5I6G9iFh3Wtd9iUvwYmlSQvgVGqF6yDdvU91G2
+6SZ9gD3LiiNX2ileOIal++qdqW7J84UqfJ7j4mJdJdQo0yIAjLeM4ESwTnVxopls2fVmaqZpWdjdquzvZeHbl97j1/xeLrnzvrrazuntJjyxtBRN83tpqrYp
+iF4uimJ8vb1y2EOmMALYy1P0it3dQuxmcb3uwtMQbtbnJXdrPK3d65JROufuYr17N4dh3FQnthWYhXVOHFKbKnS4knXyraZFoFmzoL2R4RYCdPPSzpNPpB8y
+bWNzZ37A7ePBCzvKnXQfT77ZK1j28shpdn1yE8VTJzert4k6zl8rPzxhc71v5Uzp0+yj+NPOy24yxPKSR0ejR9nUQ228XXDp+6HspbovSJPbXLndP2jholfY
+2m12OWNO5653wvSk4RN9d/oQa9V9Xq/T/CLKdel+s3ATRbZtL6iozZnAYMVCqnRCYlp5XGX8tvKLQcMj3iUpyNQXLyWG7hI63S7Rui/9AMHnGDrntypCzNmM
+ARMaH1u6xhoF87XtehHDQZVcG1h8uW4hQpIynernzfw1jXxoVfJPcvPQSkcRMUBn7m/N8VR2uQLrJkxXnX7td5ouYUTOnbl+w/ibgfpsEx62+yFWVw7HQ40B
+m6hPhqAmW3zfp8TVAJyMJggh+q3zN/Xxw1SFVMP1Fb3ob5d+flbfuXFY3F0HIXJr+XHxbLE9utDgVoJ8mO2xkGuAcNJGVdmPydwXlT8jfwFOEtPl1J4h/BZy
+NnEe/I7099L3sK58yXqLnpr4NvDLXrymXjBucVVW2E4IIw77zUrP/vYwBMx+t7gz4hZyQNQtVVnV7VLNJkDs0OImNy1XGvWUDVnHuUpNP6OqUyiZbIdtDgxH
+aT7W9+NmvGq+xdSA/kyfmq9A1Hry7Aipqtwl8de1toBp6K8JtMdaIqBv8KrGzcu5eXAMHhNLV9YI8LaXNaVz1yaZtlXIO0/MXcCM/rBqOEcnePdciddUe+hV
+WZdf97pk0QuXWu7c/tqVlvobJKZdMa9qrzIYXYbMA2rFAemUKgRLByW9Ufs9kF6fXkgvMXruPemolbx0y2z5zZU3ziKgeBiHdVXr5s9u/Obc+mcIlEk7VPw2
+qdrcRKERwzEqQU60No9bekauQYuo0Xvw3yy7R+nsi8L2uxnbq2oj1l0SbWe+httDXShLL3PNdnNKurTQ0V7eioDnSGjTV7EtmmHDXnCvkYJENObpIDgQgRIw
+ifZw/sWY8fiUqlC74m0YDHMicYwy8a1mfCCsy62yOVUrwXghoSYSJ66nH1tvpsx69DabVVYna0Ogdu1wSq2EFJ/J7l8Rpm42ETOvjc41MIiizxDXewkIJQhY
+Jf/B9Aafy8+lb0lvTm9nXlbBEbqC4X64eDZMaZVOdJf6qQCwTvAMz8yBTYNJrb488koq/uSzrBT6c9UztnHm0VW3SHEdZ6SdsVEHrfujqtaUCOWm3TRRBvHE
+S7P1DnF92cm8/5TGkEmIUi1PWYeBZmwbNDlEyglq/6KNYV/Uu/TO9P3qKfAT6imQrrpA1MdkpooaBJCs6nNgdMiwCzdZRFPnNzqDT7+ZT5FnkKgnh3wjXG0G
+8iHQ616peaocLBOEtcnvKucX0pp3sKfp2xllD6ohm56anDFHavWQuDJ80jjMOF+jWigvXCLVYxsyeXkDOPNKdWtxmSHpmtAaTuUBfuWnlDWYlYu4dpxHuY+D
+oq+jSkM5Pxbu5853vxqm+NHuE1m+fhKOyqdSZR7UJJnT5qul+0moHWxsMJlKckUwTgSZlwvo8W93lWRT9VHDpl19l5oVL3gJZE9fOYneybX3dFuvk9UI9c9e
+j8zlgxJwbdKg9bb461nRidaFpMqNxXbPf4PPqbqmvofZG6wAxVuBnj9XF++AVOYH2THU7YNKfRQ4O+HCbljP6ev0VE/pvXpGqS6VvSo5UR51WpeWFZBJzC2c
+2RnrC4Sot9O/zoo7b1Zh5prxKLBi05QxYbb9UZgBbTxkQmX5zHjKKwaDUlk8RCDz06CCpInjXZLbfGecSC6SrOKNf+Kv3fCNoqSeWH91sZovUO/Dfri6IBBZ
+Vdts3sqEqBXjESzrx+qDK94hy9FJi6GJz1NM6/RZ1cr+JcYvuKOuREpmUlr1vEII0kQsW8GHNLpex/NB1i7wAngrvDMMSu9/q8H2KVjlmO8TmZgNzN8Iicdo
+wmYIALHzjwSuE0vWVDFF/6CvGDc8INMk5lw9SDlVkMa+zaPUpXAzdikFTuW+iQhqZc8upE+hqSFvSj84VY8sdIn1EIO9kN6k+bys+qS9fg4rx0LSuHwXOqkM
+YbfCYSXORrQYiqXn9LUgx4PfmfZxdcf92QX6ytHCnQcOc695seuDzFtTo6wze7dmOc6CgioPCf1ertjfNEmfS/QX5WsVzV3aR0UJ6V1BjVgVwZa3oixol81l
+GccJ446U08TRObTLXV5Z3ZCYLke6sZ5d77gG7m19WTkPjFbKrdpwxzSRRGNRgCNSrsdjbWVddJlpwnO9DgylkT7tssaCib6Syw5hGalKAblnMN6osUI5cb5F
+V0fIXD3Xdsz5MLYhbPl6OcFeq4bByfgReEphypXP1owzZ9E2FJWb/hyRdbvhKsArWgXotN+NJrS+MIflkeDlS9IqjGPaHNG4PGrPNZ49P3LfiSFl6Z6xFKJk
+lbQyAI1Vnsq7i6MOAEvMl9/mcVI8LijabfJ9AETCCRc3sDcv5pKGaonud7+Yfk29F//ofDYZIKSt6eRV1RjZ7s2+Q98Po5s9bwyIyXCX9T33vaqwcIZpGyFQ
+QNq6lsOJPM9U9x6kZvRmmaadUOajqMV/VGJMM4pvDJr/38mxuYza93EOrnrU1jEP6dTlc2LXbaH2NsEsICXNNE++wcPivefPWnzHx7WeWh0OffbzTduIxuFD
+oZLkxebdfekVyk0VKSfs0Dh2gSdcuFwEWCe+KfN6JsoWlGGChREAEF0Eq03GepqwX34w6CMwZqLun0X8vWok5ugI6u2sm6DJX96rvWPUPwj6CHLk2yMo+M5J
+tzD1/9dZrWKvLBPPD5wQVmBTPSe4S74nl4wOedtmUi+aKSZWq8HYROrmxEe5N20YdK8kXbM9z9b1YZaUl4O0ayjDH7Ps79dhQkiSsFte56gWlrS0EyJVwUMP
+gKS8kq2oN4N3cZUQLPMpa56aeOL5BvP09rQNfteljxLwfbEDraGEbYVSis0P7fbAotjoEBA4AdCaI+ZTVx9O5SNSwK3ZfpXFW7Hb29+06E89ppv0/uA1LHpA
+wi5IjchEACnGiopv2pLhjTy5vSzUqn701qZPG+fNdRvhyz6NWcqDk+ISABBBGrnlqtHANOSqSryh141Cw18UOJQeAw18nhDNXtPqhbGm28ZMH3Udj4V4nhf/
+jcbyXDw7XtVL7PbvXbPAR43oVymbfppdJHNQUvkS+QKt1R0j+4KUklNNuYAUlrW+NbnwW9J36DyWDMVqD62gfyneXBss/bIALJkFmA71QjeB3BW7tBS08bmY
+vcfc/ZMai0k8b2eRK41rkjeGeKzVJw4w5toDPF5ddFUAE5GqLp4JxfrrRHWxca4PYs/YgHuvGXjeVcfzT7uXRRttloxROX1spHnRfQoUEiWaTXDXXQGvJHka
+n5ncSM8JYFpMNmpv9jnvku04ppfdRTDB5Ro0fjBo6E1TZ9eCsyQkpXd1SaiAzuwnLagzpk5Iu12EsMKaH1sZnZdQYpYbtBtnj9F6VTXmvug64CJdWUFJ83yA
+s4B6k7YVtnV5Xw46woqzAk/dXVshqHkn2TwjvXcEABL79DfvhSbul/6ARa4fSZ9h1aJ0Fe5Xs/sv37JQ/5oxC7ddM6Ru9OG2mbmeHoREsy29uW0t1Ys/T28I
+XE3rhdU43DrlpdpyCAzmQYGvw/bi3p15QiGQ8X556+ubulgeEYuLO6F3QuZwfCkkrfUkSG9JL/k1aL31SjRHTXOMb0G5oM06vugrEZ7Z3TPB+GDUAwQmJBM5
+kF5NYNyV2wN3iZBiWbtvUDhOHNfAEbRgNH4gfSbiM4IrW3WKKH9FP96B41dBPH5TE4nlmtgqWGpV9Wsr12P5eApkTFFhQRXqFTxVbfyuxV50BTir8dmZ9Cpf
+1Y+4f2jaaYZkeK0zh1Gsk4fD6O+kJjCfdWqolppTqFTM7RxarAa8ZRYvvjm470oELLlAcfD8BVaMEz/t+7rLCyqjfQXgWPVESh85TFy3y2hdot02Y9dUBIwE
+ckKgyj5qqwCQlRFIAAQr/vbJ6ab8HVVfv6cBbW6T8hRswkuSEgG0ecSbvTNlKJ/PS7Q9xBq81I/WIvAtK+bVHOQUsgwHz6AeylTL77Ir8iWqvAkBWuFdvimg
+JQqoXmTQedKwBpVTQUrSAwx5IXAf1iLkJlYZpf/YlYmv+57bldsOnysTc3ZTadM6xTPRKRRu1uU7vKDNx4a8NGqwjoV6vfSUr1XJ6xX1K/9YSjuOrKQ0qoeb
+etIwTFV9Sc6/8JU7WACvvIwEdIpt1okygHTvp6TnsruPdO3W9IKeBaIt8lKDmrKYZEFhZNfgqer3Ylx1xV1yXmEHXV2hQhE2VuDdckVKxJ58c4GTI5bWsoRq
+tfWZmZBoEwJZyCan0ACT29J63VqPX5wYDLn4Ca/CcPx1X49fru+4lW1FYq2qHeNyJCY/7olaadTtmu4+onT34zZ7IndB59XFEB3gRUTy3a61z4uIirrDVN1U
+HeaJXX72Unp51nVRhNRmVLeu4WCgs3EfYsklbNx1G08q4ZdUmpgX+VqRXyD/JFRBqTystMaEFj0Gf52kr/2bG3zG82mTbtKHtEYSTshzdQSFWZgI7X5dXSRs
+O9yFUyKgk5aWnmZgJwU4WEUFbek5M4q/mKAJr+2erUhz2Smaq+l9a9bmm2Z182tXBiwz91Wft33drBGnzM0s7o9I8exnCsd+PMi2/7RZuVeySZXqWR/iMVy6
+zEDNyFfNWciXmMEJEXeJH84ci5fzUx5eBUSr9U7IclmMdbUyvWTwZjis2FsoqJH36yz5rM6S89FDxGEFLNKhIDrmaeIVb9n9QOg1rnrslUz7SdczkNiunMOe
+SWhRPgqvSQKn8t16xUPTl36Lo1HEef7Twbn7l7jnpbp4ATF45di6MbrJN92SGTJvH42l+m281GcAMk2mhaXVYSCQb0Q43glloHJUqF9hQCJWq23+45UNmN/z
+N2kn+Ub7fJ9pqznZKk8hax60NjwuVCA9YByczKaamYerC1C3DnA28wHNEeDO3N01WcAmkF4nqVwB4KoY0sZsARBXiYjnBPX50Lf42f1tTWYNjsz8ErNofzko
+pfWyZwLtXi4ZXC1I11/5Dvk0lFy3VTM9teTmnLDiTnIyniK/d7zmpA+DHAIuhSaj2aJP4huiPorp2sdCqkSG1mhVko1XHEZE5c/KIOi4DKOTqfUb9RrjiPQf
+fcN82pSmlRFUDkIVE0kA8mFKVpeuFCI5E6XHArqEqvdwGQu1WsesROspkcsbzAfIP03pm8JZKnhN60NZpf4sYvPDWbBM6r62la7p2Jhyt9Uzav57iWmkAhB6
++p4Rle9K2QJFwxy7WlnMjz+oO/vf17Vu+XGQydyGFmID+W5IxVKovSg3RlWFAWEJXZYf5/IZtd5rk3uWLKJ9Ivf2hxjvXvLgNDZXuHW9tYjlW/Q9HA59SeC4
+O5v8C+DXkX0LhYBTg3kgNx8vl4KVtwZZs8QZXljOrifpmxk3YowH1S25UnHcdW1vWUrBhmdd+NlOPwOEU6aszfiyNmyiyWMAsBZSmja1ktbXPImj2m8XPOzb
+FfFeeho/bJ5tyx7/Os3OV//HWc1SaoM3beE5eYNxQutYEYALs5hWqXSxmUnhKGidnbSwa4bGTcizy07wtrRtMr8z7BO5qLFaTh7uLwGekF8pggWHd0lA23ie
+joINxhXm9WTLBT6SPuln3FdZp7B4jXjulvfDYcF3OBDyBW4WlLH3szv3NJp2WWPdH9ktAPAzBGKTyXg62JQE+sp7+KkmBzlNSOpvhqJ2rE6CGOvqiNpFQO/F
+ped9TF7krHbnupqqtnPV1RpCNhDkP7Nebnhk6Y7CYuWByscUaCXlzp1SDearHCiif55mdQhU/Pm1oiRMs9+iGb+W5iqGsWkNXLUWqLvFE3qM8cqkMHvbI5Cn
+pZVtc+0BJ34wS6ifpniBYqfRw+xbmqHnsqDdEKsNlVzO1FzlKoexvcawEZbg4vYQmBTORxQG7yKZQGB1dpUcgDaq58iNELWmkO9kERONd7AR089oe+VCDfsp
+V3QjSilyxzZRr9KykuECHIORyQgyAE2p7vWxGyIvm78Pq0RahpZ7lBDhzGu+9vMoYvsVe6MvN2RRl75ekthHX2wf+4vSkLzSnCY4+1K/KeS5pQryNq8DhFrO
+djPcO3cOXteShbCXks+YZ62bfk2QoWnLpxNJd8NQEYtTBUy0rHx1l5au4wWuxb29UTP++8sxaMnTtNGpW4CRZ/gKmg1gIUNvmEPi6j8lNMQ3SlDak4dAe9MM
+bUOGx+oab1Py0A98u7PjvJvG3ctR6qPyx0hegUC5e/AondTli/+giGkjnhoUqqjseamXX+WJ6ql67jyV/ehtAS9be+tLu5Fr7VfR6Kc1gKk59P7MVnQiO0AA
+HJdkld/y4BQOE3rWVIVWeEpo3RDEHK8nap48Ta848qjznqz5wK7tixyDmvkwRmVXYaYNI5Oe16FZIpbqZGDOVS1WTVTl3d2XSlolsBhaO31vMsoi/wAXujOZ
+33Q9759P36q1rnd6BP19er9EYe/c3vpUbt7CzdEzEaR2NVG7Z80St7UpPLTbVgd3w3ktcwgYyA9rpCLc3q+4Bu0iMsAgUUddTxUWlX0ujhUgdWgZk1dNIDCH
+SkXYALZ1GL1hOQl2KgFQ+WwlgVyHoYKOIYFSzrMl2HSMaI6o6vUINum7ulX5BZ7V52re26MIDpW9kqk1TJlSwpCo614pBXfYLsV5RqQx9iERq3/a9YAuUYEA
+Lxa5c/kLJHgEQJJdE7H0CZvzt+a7zbWp3rZHDFUrgy90jMm9qQ9VrwgVuTlLX3ND7EkWbo76WO6w5Lludp8Pkmpexhnr4i6ZK2A850TrvqzTd/IKtQptzSGl
+nbYdqm4jL1rdc+R8zh00pph+77mKeU+bMDUFZn2tp8RnOlS+XuSFnCTnfCZfP0PNNae0ciAd4UqSGezwJHKtJXZ/13L+0cYkRzObDYf4YXPYrO/tfXZnh3e5
+YvsThCDiRwaWY5Fb9hMAN6Sn7uWHXH+d3pM+rGhk2Y1+oWrtRu3vBQ20OPMMO3gPFmtsIfc4YQZq51Z4Y4xg5DCtwLUaV8tnibfdT30twK47YgcO7rEUu2ql
+2KuzdC44KCI9FSqp3hPL3Nen5+HXqVFH4jsnqEitfrqJzimQKVR9uHyoxgbueBZwRNX+qMuXpe4xau/g+1UH/d7Kx2ss52AV5Hi4kuPaBRPOCFjzMk56Gu7h
+c6qlJb3tD2gM+hntCX1Z6ySFs/Z7svus9RyMPd5Xs/YHPRyQG8hXVjRslvsD42N6BITSMauymWa9wJCx2CtI5e/ZdgEJm5dC09DBsAv9gqZj0PMq3+fYe8Yl
+NjhsRfCNVka1MvhmWztE7EakkKF8PCwePoFdaZJp9eRht5gJ6zT3yyKl+mWlVcCU2PZjQtMs9vO09TF+QdEOwrX6Se0SLzCs+Gadx1btDkNPN2toytIw1a8E
+u7qOi79Acc4wrNzI3mhWq6pPEc4u8oWytBsE4kWDo/IBq5LDRFIlprbX/s1hLrygtZm2O/JDrrK3NfXAdZfAPa1cHO+u7x6gWt0lmLDIc0m6DZJS88THEF7p
+oY0IbJlulGzpRqBxxhraVcDfFoyd1Jp65ZZ0zg5po+7esy7QMK4X6x5dNV73aOCt7eQvzUKKWrPxDKO1QZrspwG5ae7dT1rgRZc5XvE8JfYod2qITG223Pv/
+sPbnf7JtV30guPcJUtfS09MjSIUuQhLSIRSkxJNQSEGQkp8eLKEBCwRCCIyYhCxmzFxMAgoWBcZgG7tsbDx229W2u6q6evyhf+jf1h9242R/9pr22vuciIz7
+RL6XmZGRkXnz7LOHNXyHq1nEu0XepzJGeji7uQGUW0mwOsM7gHDm4oUeSMi3n78HYnU+z9uybqjNSMe0+lITJEU83vscK/W9rnDzfFruu6WwQFx5ly/njLp7
+kMigU3dhizLv3mlTXk64wK9p9suyO/I13Yd+21jdz/y/ZRVu0zY4mNpDa28eIsUtq1byDSMWUsSJC03cHmQkN+b+HKt7nYUABuC3+B7h3JuwHn0wp9RjR48e
+LXTx3+3RwNSceS8Cj9v+YtNTvXkU0Mj0OwKa10QMkJkQMGLGJfbLZSsP8tToKCO+1wHxGCu+8XwsmGNDCHyf9lr/QfpZdQr6XZkJK1dOF7pFyzTkAHEX65WH
++1ayQojHZZgMQ+jVV0UG3jc5gajmAflJJ296s9tbXJ6iqu6h3bTq0zYo189TAVcIlO3KjFusWIsQt+mgJyP1tC8wrui5qmm3rTaCrIGusLaXXVq0D9aPlNXw
+GWR7HNpnTuo4U1erT2trEYLmQ8nbez2TdzaMzO+WqsROjK32Kt009gIOt9fo/ohrecgZnoicAwymCWes6x5UgHYlJ7lXGblLWF2OTrSgDOQTaJbz/SArJP90
++kWe71+pKHvjGnDZyNhHnaRFd/fDqlgfwqvLaO2ul0MadUOQZdtG7vaITNJFeqgzgcMqVIBEVeai5TnVRHhKS7TtGEsDZ1XWqbtvfLPKnsFYM+bqBrv/vSqE
+l12+bysbjOjpHCHdeFHYd5+qvHYhLigviIircjkxKxnN7FdPuBNZb2YICRb3oOSTizcAUdYTEs/4ZGNlIcR+2Tq9dQE1sK8gnL0o+1zoRy0iibF1q6WF/qy8
+ZzL0/dkeVAMlDnP/bQ0m31C04R5K0Oj3UR2lHo0aQ1psuzvLZZHFkMtSmFAFh3Xelw86AH0YKR+g0XY1b/vPzPCPSuGyrXeRQe+CmbxG98cgx7StNy6vDxdu
+24DTYvgh5i7lsxQfB2ru4SDUr1hJlQd8/05JYtWTjkMRshxcGMNvbK3prNkv55sCYkdZyIrVsTauR9dlQ1+MrfnrTkEMQP1cuZI4C7AzcIOEAGBltagVsmx0
+BlB7IUNxEkFXPxXOy1b7uZ827oX4dCmroF7Cdr9UT7Uo5WwtdefAlTKqANxLV+vd5ZqqABNXSZ5M5SHWarCAuTnW4uJqZZgAdj25Wlf9cfUv4v+0OVHn6Lip
+gkkttc0wb+ub51Dt0SuqOkdKTY6gKqpwtvIUAGlwxKJI4tt8Rgdu02jlvk2Vh9+fPui74ne7b9GPp19Iv5p+U+bj6LeyXo1Wmq14NpNN9dMiMtZMLtWqN16H
+3G7GDh0HWVXgMrvbabiXCb3Dn2RXVdEPRbw4ftPA/Pw86rMZdXLPwlNcgMK0ezFyrLVmrSbpCNr5yvN+xb2e5Vqs+dntLklkkbbjUFUfeEelqBU68OU8K00D
+AtOW0mMkB9e6eNerdskHHHf/Ga4alhprOEXmbo+KTW0ihN250OhGLcx3TflMTn/inImk/kNzbcshIfclTeCjUWKRc7+lZ8ab1HCGrEP7fcrKDFz7KsnStueE
+k6d9uiY6jr3z3XmID9kfUyvlE69PSepOKUR76KeJ3DU+aFVSL8kpId3zxRzwCePmSrXvPa5C1SpCLOj3VjaNl7o323uxd+Hm+7i20+S5EIR8W02gRdg0uYoz
+Ss8SJnDEXU7yeUHHF1v/4/KrpwswwqBn/FatfX4wdJw/5/pE4uxvkkT6vwQZZrkx9l2F3nqjokTOIw3LSACBqXYOSXuXUdZYCqUS/ppVdk7VcvwhmVtr4Mt5
+ljsHIb4YOARvVuc9iyQ+qoqLJT9y4mxFKmuV4zFpiQ57SNpLBVIzmwYd1uIN5btWsmNzDn3vpSUC1tDmuDjplfltc/tn3Nk7qqivt6pSsNmuo1hnXiBEWkF4
+uw6ntwIL2gbYXbitMHk67z4nk7dCGk8N709n3QZSLWRwXU+0JwF0vVQJh5JNt7Iovq+9IXCHWowi84t3xxBibQ6K3vKrHj1KHLstrN/SVsRnDlI8cg2VfoII
+SSehusoG5gcqtlbHADHOlV7fO9LRTx+u5QenZPmT1zH3vFGtbREDVY2E/Xqb+T7vn3asrg4OonoZ2N0k0aSARksEM0zpa9JU00pGxmQSXQSJlzvS9IsznufL
+rpBmZ89llqdMwtKf4KqDuIXaPlO33yXA0zKxk4NZ7shJyah2nGqPvpI6AUEIPjDJiaPbbMBAvcGv0WLEV1RVnPFOObhwCBFCY4ZjrB3fXma67mNLchMqbDfn
+CK0Oe6GYtdWxgPCWqUOAATLwOZZsZ1xXjy/meoa4Qt1bP+U6Hux2WdHCbWCxPy4rFemetVtMxWsZJRKyV6gSOLGUiEDKz8vOYU3q0SVFlTIhLNjARsiItyPj
+8baVk8h9WSnacmzj3Ztj31Mcq4CqbC6LG0/DtwBrJAqsvdtxyBVIvEElOHaNDZc1soxZGDUYohO8hLFb8yx8tNTTKYkDoIaxBupuKz1VSlxuzyTRzEQdMr37
+20nrAZ3KsDFiazyeZ89cpQlbS3AD+EPqdWEJEGV/10eLTifr4B1VWRwfCP4DtdYoueNmPK6uqKz1xM3HqIqcJPIWNiu5CaN3Fn7lpICM7B4mJzrDHViib/rD
+XhNxy9e/oKxuWhQzqRttMuZaTCiulPeGTuok6Mq4bTdBJXJ5gjbijuWxbv08byeq7Qdf6hSaDl5EkCaM/h5LtkWurlCfKduGEqdxiMlNH6eciB/r8Gk/X1Uc
+rCbfwSEteisVPD4+9htTFA3nx8v5IkJt12ZnfK+rslFOQOjxgYbaALAykivBQB1o8pTkkBUMOKFYodukgQatBgDRe6xq2IEjnL4sarJZypk8Bhsr4oYC6LqF
+AIyzMGE3Y/u+krX+LptE0BmzRp2TQjmiF9gskuAp6ZRCB86Z8fwUWTJeHnKpqYF8AZrScgwDQxz4LVp5iHrkv1frnfMWTWNdrzL8m23VmK21CY4g7rUVfjVk
+AqBVwjTwoqwZovIRoa+9DVWdMyayQUziIUn0oGnSWXhFekvYQ0wbwKrjn9Jd9Kc59/nN9Dvp99MfpX+a/sVlpwKeMDOHAh6yMxYFpjNxb4pvfBNuLM6u7Z3l
+ot1x5pt2QtcS0KCrdzd4qDgqyBhhdX0BT18Sf33WWglUZzOPhgCABvn1ON+7Qy3s3ek72VUuosyCqn8nXajd3/Ktt+Zj1yDW77Db51ilWp5D3rwKWCMQ2HIC
+lNgUAGnJCcaVzEQPGBsrQZKfl51/0TTrDQFD9i3N/jRDFOofKsBaLRlJoYyvfuclkkjJ3J3z2uHLMlUo4tNZ2iwNM9OLIGqIJSUhu7OEXjiZ18pwQd/35fTB
+vjJYruNmrrC37QHsKrOzLndPqib79dPAQ102cLGjxpI1qEeNTdWWD+VvtYNEaplYFJNK+QRZsW7CBTy7xGPf3ehS/5rureUg9TP34N4u1riwKGNbXZX5vzFs
+x9ej0AjA1TprzIQmieP1j95dDyQhjUtdsvUeJLGkvBr7i5C+QWOPmo0zMr7teR+3ez1zNwz8qJ3w61bu0xma+okZJBFH/pRl9RLOROk7QU+tdgORmPeWTG2+
+Ys/hIt6RPuxVlXJO/F76wyb2bA/WGHXuF0wI+2bVjRNTbg77cLWN4uv+ZikQ9bfWX9BufiZlIeVk5WKlOMagFH2Hz60CYT1UFVXM3yY7iXofiw9zRTRq+Vtm
+aOGlId/WblXLlePjIYLieP27GvRxeyH96uTGWow3ho+SoWOLFSDApiqs/AHB3FTJ6Nb3pGk0v21hLzQ9Lun4fUr7QF/w/sFX0h+nP03/OP3T9C+vUefqhCwt
+QODDz3LU7d0Mrt0mdhb+Bhr8Od30FVthgWlamtucx156VlJIW4JEDVqreyLdgTUrRNOvcIPetinRtgRnzwDWGQewWNO+Te9m75C/q9q7oXZ007qCKjZNhRZF
+t3arhB69HTMCxN1jfFys5bIhmYMbDgAApKWzBMAHbNTuJog9aAT3F42zTl5EM7pueqPGF6Ih/3SBsbvAWjiyntGtNKdm3VL3k25qSpLElc4/e4dCUJjM7CRg
+letKUKAMUzLpCkRLZBLVtCXkb2XFVDTx56L7hd4YQ9yaLuHGvfnLX3y8XUjiDiGVi2233cIGIierEA0a0ULrFM+0LTSblVIxAp6Ar1Z6rKmj6lg5zTePDjv1
+DendivhulKPP4CHyEoXOkSvXgR/O4KgWkA+Tko98OhJ19bXoAf6etG98TLwm4atwwSf7YIDiChvVVssMHd7My1nThS8VY42GCExHLIAD+5ZLruoX+oNYnnqG
+ruPY4sX1E1TsQusx9cGmS55uDDU+lnaEIcf7g+2wOEd3i8+2XAShruoR5zPU5ywAH3Y0AYZ2MGLXUupqj2vt/JaTzDrhxhz7kmMcq58yL8X7o2IYjEEduI+P
+umA6fMXbVj0xhsyWL5t6ZE7KeoHOKTOTfTuqVHHOG/Bl8v2w3/YACHkc6whRbeP9jhms6rgRMy7VWS2F31zUb7mmHruLcfH2nKrsHNpzWjyrZasuyB4PkVyM
+D2TNZIsMCReEX5bqtlA1YXyF1HP6HXxG33PtpUTTFikyCmqnRRSVWuBZU0/s29b7JkCrGe0mZYFy+dFZ7iY+v3xus75cpXy7+oRg+kDF+TRmka9SqOeX8bCv
+YMBqlVOnk30X1f4FotT71870St+qMeSHPOuIccw1Oq6HvVLg7fCzcmVoeb43MyiF/4urjESveybgyoVMvlQuJZRHwJ6DNDHmTzfSTsS0KrcS2M6pB2D2gei8
+s78zfVrXkZwcHT/xlt+rq8Jz2LA0DvVGXHzqHsy62sroNnbjTdoFj4ji6wQwZQQgz6waqyh5Z5eGuWNLrvYZTe2011d6GvSV3qZazh90PPr3Bnf8n1VM/++m
+P0h/It3Uo06RuHub632YWe1e06pXL7OfZfQe6ywJUUF3bsjcPJFoRIPoKhUbTTr445l2U5bxUiQiBfoDP92CPxDPN55ev3Cee0R99Vn+CBzk/Pmd7fjOj5/e
+TR/gTUELWHi+700f4V5hPJXc+VIcL2/Dweu9MsUWjI98fd8ZAS3btAfDbTuTezZveMDVp5mfZnLtNTjP6oG2GRBt3XWPgRWqxtQ3NV3s7wlKjg1OsSCgwlEz
+hsb7ckh6b7HaDPjPA4yQ9YZCL1fmTXmyqIUgAwxwAgUwZpikhyQni2UVtik0vIx10DETtkqMVmYYPqnbBRSfo/fmmve+zvmVjU6ye92ftTYnQGAajug+DujI
+LlvA7jOITfkSgaQdqOdvUz7w3Ljplz4NPotvSG9KG+0ARV6woLGF3/gLphl5H51Fj9unDjTf7tc7rWNbi7DVuXrqDaBlNb7DTbddtlGusSOAcgKV8TxhAOQ0
+66bMIQ/2Pbx1E0YgUQp4kLy0imQtaPbZd4MG2Bh8tdrYNjgcLyTjC7bF1+bju5YLD1OSRrhn4wDn0vFBu4orYz96Nr7kXYxuyRexgF8b4hDxozbU/iddO1vO
+0aR0EEW+RvSLODrdLGrWVTuBD5qY+tqRJj1Sshyhm0Mrqw657voalkY2sC5/T4AzgWTDFquC7pxMMLbE2F7gCDVdRZ1uAOq5EjXmq6Z+ylJPk2mwXjXh+Rg6
+6v74saJTRitIny7w18VFHZgLhIS8Xza5HCqvbaEE9eZOG+PNrv7Ssvo+H/uX280qLNzgGWDd2mYfeGq4iUUGh0dHZm1mGMzD2FOijY4hyEKqSsZAGgtBUEQR
+aFp5JYouppE0SHqZlUu+yM7QQhVHJtRrNSJzAD4282jl8dmtlQpqhTkT2LXhUeeznnU2NiXXHPaICJLfLWA1b8wDR4T2AaWsTx5OYHUdIZqSMiGM6yEN0qxs
+Se34YmiuePgoZEoHV8uYdLy7FWMKrOplMXfjaLwt18p/vvcGzYNpZhV/iUg/K54s1sLkAjOFflFbcS9ra0F7Q+TCcrlUygnckID0c0eulyGJVbEXGv+yj6mS
+W8BuCZGFr5vLnI2sgkJ05i6H47rdX3ovmpvjoU6aWcNYJAJ0YoPsBdQfqcq00CQDa+2oItRbWmxlvPkh49OjIRS8KdTWNurbLSfsJ1U/Q9Fb/WCsayDZw7Vk
++3EFv1dyyGtD53S5+BHGwTA2stsOKeB/0TqpU5DU1Ys/KV+hgrX4omviGgERL3X5qvh0lBP2B9IXdF78jmpRydU7CrNcTAWuncN9l9xzXX/IcM/jfleNbMKg
+jGeV/QERzI8BnK+KDeGEZFM96WUqEf+hoSJhQLa1APGgRdRuu50nI7IzpsXqP28Z3FYJZX2vXc6RDiHdMH432SwD+JpXot2UFxOY0Rn4/AYICmoNtof04J73
+ulRQS7PymPcGmRECkdYfHHgEmVeKNEDMYSBFDcESg3UqM1lVEo+HTcNllks4Hqpfx5FBsfuurXd/UTQnt7ukniwTELXK+948QA7D65YgLWTxgZ9gptpGNNsP
+Vqzb/J1d1UbPVPM+10NDUAEXFFfGToXqsNi8DGBGh5t1BFGRm8ecmmmqzEkEVxt2UJRV5SczLOD/zfiobphBgDh0NcErhTMu7VoVnSJC/pPBKb+ctr/F6L3A
+RrSz4lxVayl/awxdlpQYtq7xFyB6oezhHq1dVStQZlyYIfPszyUXTiAxjLw4VfqM8P3khFFr/lxLWq2mx0JRq/NJuHVuY+w1MldIq+9yaXoAS1UrN1nsmK8W
+PuDxSGJsVz56QM9BO8xED6z8wR8GeoZCwhXWPJ2RPnBcdLm+G1Zri0yuvxuVxPPl62EK8QX879L1ZZhdVGAVN2REUBnVhavKKKjnCPJtLjH0WC1DeVV1Y7/E
+J2dBrwoXUUvfUZtPoqy16rfJWXpZKVg8MDaHfe78lWu9ogoWBSS3xAhKs6wcPhEMBjX2XFlTbrCyeHa/O2CuwJQW5YNVNDgQmMFqe1LnuWWfiDut2H7Ee5be
+QXFuh9e5+srtzWup4NwtFblMg+VsyQYhEkO0ulUPC3i8TiPc1E3wcfxOR+T9cIud2PrBfw184KYpW97NEIgEOGhqMXOS8Ptabnzwo0E0F/50DlHQ4nyW5/1H
+Gg6g+KOvHV+p3iabIMClZ+TmsJ4Lmdw6QbfVpOzr9QMfUlMtnUHQxDY9w0me1/Mvw8SFqhIXoRawgXExCKK4x+H3gKZhQk4Ww14H5FbZukEt+mZBXyhHvZoG
+e7pedfSWEhzJj+3Ol2orncniXPOso5yC4qavxswzH04CK4UJnMiS3fymxw2u0he98vpH6X9Kf+75srE23NWKdbG3EtRuKpi0h4pbdLxtosKtm/5cD7VF4QSi
+V9b6boMNylw+hAAVDMcHeIIMkBNSV3QBH088B7sNsfB3pO9JPxSq1b9pquGe8qgH1Xp0qgETRsPAHDYVhFwF2c5DkA24E2ZH6oTUpm5YJshgySGZSg66EJ1t
+aidpIVsBwj7bjnmae1a1ms3iU7ELmlPnPSrWau7kk6DEhXP82xm0TdQZJwQCpImodiYr0Kb1pxhAZJsRT0QuGxLgNsGLWLr/bwvO5393psD9C5XbdRtOJt3P
+9zdt3+J80/ZS72qB3IVAE4jK0VJ3tuleBW6XUkj4XNOiY+zCtt2rrm4kOjBlhv9+wvRn6S/SP0//Ov07qRu1Xo251xTczLXoZipXBjbe2w4h/x9NuUSWyp4b
+GbfyRaNbbQHCrnF9RbV2PF1oUXZv6ElV7V3ELmeZSZnO/7ywO7DyWYDDy1weasxgGKZaf/k6VgCuOrPChpIoqfrEHqtUrqeLd4vUDWsB3nXRYg9n33YzTI8W
+qrwkiiSOh9j6z43iM288oo6MTahoHEHE2SYLkNI3NL5irUNa5dUaauIfBtTEP05/dd69oO4ZfafrLjiAHxZqK2MUS29Ylk9VEfBpIxLyNHxs9yVepIbMzeUD
+++s7EG5Ce2AK+xRsRWHBxkzXZ/N2gbNb3lKjFfQWR3qpM6cyAioLQPg+u4ZuqP8fuwBuzToVoh55kQmAFpNM1MbRwEvl1PMAFAgranIYfZEekpiTE5BIyzf4
+/zf6dR7Svdci4rwJ1YhZVa7uSE3nbOQLXB+XPSXK83fce4w1O3FyKdt7VYxVuFYQM8hgMpkV/OledSfbcbTAiVXUQNsopN/o4RfPnN2brbdsg91wllfpJc5S
+vi19uDvXRFcpoPQVBizd1aYQXKZI1KPn5KTHZNw8AhNeoXVDeOMEud1DghMCrRJYS1EQfydZF/pcEBiGQaeJNNiq0Dw1yAz91HnWfjB9rmVG6rk2WwYWwB90
+NGpAO3avNm9XO8zyVap4YZH4W45fn7CNbFGxtTJqEu3JQ3920hPJZelbXMabgj5jr/QWs9dG7W0MSBytbl5UfDsLwaknCZ1RgZsDcAY8zRE4CMJBU/m1JRRO
+Sl8X7rlhsiRT/7i6XDWz4EZmQa1SdHGeXuP+uM3Vp6F86HXI/Luj0Cm3l+3kQExRYpIjY0BwAmWvTPI0mSpHnQ+DFjCsYZLKr5p0m7GxQkIQHBsfMu2UCLzh
+4nMsmpDi/BU7qDXnnbULm7bh5tDtnMa2bHvNRsCz0M4E9lqA8MRdvNArE3p/uR4/HGW0KvGOxfa80YzG0MOLouyh8ENtxhPHZxuwF17ZFOalaJmOrkjNFGJB
+eDJdeE6o8MbHXN9U1FhUfJcUZB9I0ixuGjROUfK88sIJxbolVeLEwiUF3YJvckWymO1IH/BgMflBiYISGggk66z8kTiT+ywYL3BjklFdyG7TgvgT5MZVyrCm
+gEBIhNRFoaskv7NjEsf94MXZ7veK49ECu7hnV9y+ZnzuVSG5exNNiI8BciHofnhUXg2LLiNwYxOxLosQU62071nxJb/boMBr3eNvFVHR0SbPoCoA8EpYxQDO
+NeqgFXAtuMLmT8RXtJj5V10PqO6awjRNpuZ3o+HzwWOK7RVBt9oFyrObw6vZUfI8Vj7zLHrb8VHzVM6b6NshdWIvo4WovPJL9UBW9btVMkNFSawh67yTeDwI
+gYRQvfZhSQIxMm1lwzOhrMR3dp6Kc+5urSdFFP2vqDL+n3I+eBWHt9XsXW9vXeijR82OM6GyqNhUVRJuAg+o9iyqwjiKDZaweVDJbLlmeRhUQGTIUVg//DPS
+mrG7EohBEgyi3KEJzhJ5s/x2bLTPwrdThTCWz6HPu1Ilnw+pC8enVMH8x7gyYVXiP0xo85olxmKUbFvl9qg6NTqfW/Giuj3eLPT0uOXRhVedYaiFzhP5DgaG
+HLGWIEwGlRFitKmn2PCy8bvEU+ULK5mX4S1fmApUHUIymFWUADLP0BBvCm7E8LsfUUX7Wtn4aT1dJN405uXWKSEKY7WuUTufbZbdnWN/XKUrRgBEpS3MjwbC
+GmUSUEP4OCsvBtoeB0AOX4XHRm0UhXi2XBH20LfzvHsfY+OlXvGFyExjRNYl/ajRk1chRWirUAE2gT1wmGWqZzswyBxy1ZHis9jxuw9iVCrPUhV1LnVmiCal
+QUsq9yVHqNrWcy4BQIq16aKBcecdRplNtiv+vFY7viJr8kbdSiPFV+u0AZfSlMIO+zNcog0jA6/iExFO1eHUjE1lm6lUdUQT8YSLmnUnykBEGtAVQr9Mqgge
+Pz+tgm7wm9nN/KPu89rUO9QbQ50x+EOuuhdHFW7zDta5/sw4g6mMPXdWLTSYhsMJ62BlHJyIInNNSoQg7Vu0o7jqJqGuvBwAPU2fppWjifFuOWOfNgjrVwLi
+6cddQUaZfYLbsS7gjF/l1Iwa/kdEdT1Zb841NnQ1WUXZNe9dmsF4sDIGBN7Q5oKZW4xOqfVKqTMCm1QuNVopH228f7+U0mjYBO/fKrBvU40l2wKiLKtXh8Nm
+9QhSuhbbKcoA2J9M3MrN3MQkj8gENE+EUCuBJ9HQSTMcdKPHB2nFMdSrgTdS4tKyS6QxYKFnXlDuVWE5+705zl5sUUavLJkB97YyjjcV1Mn3vL1RZO5Bocjl
+tQ7hQgPk5me0ggpW9eTnYNaqLI85AIWu5lVWQesm9QnfHRiFZb7CJsen8knS9bvEod9txoU8XtI6FrZIAEATX49O+ynAv3NSKY/YwebHFNZ8Q21WUaUOtvH6
+oItTstzGv+TpjBmzWx+U/bBmCCtL+q7ZgdItaMqxe1mCRSNOibikYQnoaVosWIHTXmiiFfGmiMh1vGdc22F5KIHodT7BxP7dd51PfZnfnAdY3W7tAlDHbfAp
+OS5Vn24FdHaRLTqosZGkjpATEODJtuwBpjTnjAJ3LkDvOcpl2CyNeLOWQ/pi0CQpNabqfyB6mowzC5gDUfNQQ9zjoZQoNFmcm4HdtaHk7Pi/mXkjbOc0GCKB
+mRJOHvhSRe47MDWn8gCqYnC5q4BicEJz1iiGI56CLYLe/7/jc3qffi3kgP/U1qxlyeN+Wblwuz8eqnbB7AeajvbheOnHV2ec7GWhG31QnYWgga7w8+CQfP8G
+wilg+60a5vU6/ekh1W8QQKA66Asmb475XvC13ZlwTB8PyK2fjez+bCfDaMF27ygYY8pVPfkDxufmIsZ3qSrWTC8MTOX4FqQNJnA4r+sbNyBWS0nat5P1pcWk
+JcJ3oW+QvD5g3o6Bp6qYPu/Nt/XvvVGz1Xd9xseb9w3btinFEnc5GLC2DbHpE7YGxOUjAeVEAFOSNuEEbVPQvbneFHx+St3l6xoOtkdDPcNjrZL2hXR/sAut
+/sRc3reA73DcnnfFfarnY5D4Jy7yZqtoyO5CkOkUNMuoIv7lYwWoqlAIKD1Ghk3hGK1kceMV9LZmJUQ1yc3oJ6SyngSr2ChMnu9v5AV7igsQNy9h5gTIIjsY
++sO1r2Et0CDCnn3VQ/nRISaZ4c0i5CWB3pcCT/1uoe4mPLpfkf5oj+K5XeCtr2a1ceGp3y3i/W/O7KUrcK3DBtArl5EBKBhgSPWs2SX0VZW07ilkpatXJnbn
+2f6GJn66dcSf6DnPIihjojb9QWv6bauU8zF8eytgSgN674+zZgF/Pc7DK8fpQ0R/UrBnGSosTAOt0h7h7BtP9DWqaj0hn0AFoOLZUrWJc2070fa7Y47cp5Vb
++ZOdolij6zdXbL6/OEssb7h7rEcO0OokY5wXWi5tuIJttUWeOzMh2m7oS03dZVR1PMmXPx3WhXdFF88DV3A4ryp5Z8jB9c35qwfozwYtomfoBCL5VsYuGTZW
+vRCmRSttiCqG0o3DG0J97sj98rhbpp13B5X/JvddcgZtorf6uwo1CvS33UVeWLSz1NmpMlqGMeLZDYRuSBWwReRtnfKqLGG7iPxnBn0rA64pocTTMs6DQ/qk
+1yUlEv/19FuKjaz84g4Pfil+urUyZVNeOksvbtsStco0dszJ4HzRKMnWOlOkGxsjYAqlJtQQCnEWRPGhDWjheu0jAkYKVErt/vGi+3YJ0rEi6MM+kBli4gKp
+5UJvzwH7wi7ZOgGMjfkK32ko9/khWZQkEL2kF5/4Ow/dqshijlTeTI+r2j/VKkPgWN9yF/mg9erSIWEc/dKZebewY941uCuhY9tAHGweLVj6eWa3O2ulJM46
+AOp0alud5yGi4CBjIN5A/LQeL6cwU2RWwMK2uvwUhgwFas4D8z22dPyEY/r5kJf8mvSZbhbrVUsrqtaq5iLP977TlqF5Oms3HVUPZFn6X2iEHQ9Tm6EVY08u
+eW4wBhf5l9p48A70N2p5yYh1LzYoG7ieH/Yx7LHTEuJ9OZtqaRBfC8ZAHe5xo5oxTWGgekIelm3zaq9TMcJo9tfyloEto93btJE41ebbYP3osNNM1t10OHFY
+epYy99rDpZobu0a/5H1K674rc7eaw88lMGQiCWnnuF233ctdeX59H2olUXLpHIJ/QBoSOYClXy4EJwDE8hIrcvMO+8wCu1XS7iTRSfZfnLpGLuXYqkTtx3dS
+TACQvn6GgyuRzRx5HTtKv1vrAjeKZarZ7LjsJ8BDdMtf38xURJ7qChX4fu0u7RbUsGuUJL0lAgC0ZNjMBtS7hERjheTbtcck9fSqgS0QaykHVK0aedJmnOj/
+YY4lKFhUafGeb8TOiRbp97gO+5fDDibQMEWIrQ+L+5nV33VPy2r4stqXJ1jireGO2LeJQZ/yXjB+CSbI0BK7zWi+EeGl2sUVS/qlQru6YSqyux6EXY/ym1hd
+9NsUO/CJgIf5Na0x/amNhuqOdD5QWn8OqIEQQt+1IpsVRlWFNseWJHJW40q49S48D5jhhIB607PX5TAncPOtjGRcWaGbKZJowPLRAks2+4+qTlBrfEjotPBe
+q4WCDoGgcJs8Q3yotw2RLLj+emAg+/lSBUIaE4eOSNO51VlYTc7zmAvZsqs4tjqsEUTkgHWAjA+x9GAwjZhsv7HRGKgKx1+sM2bnAMS9VVgUf1if3i+050Zv
+4KqtvoABxgVDFMNhrVWyhLlchum/rx0AngmSOFDUXCC+Ltt+UqM6e0o2m0AoaRY2N/j+8sQpxVBbtPaA/cBlyOLZD+kV19n/MT/1Aiv1YDd2W4MB24B6y0aJ
+De7Wetb1KIm7CzKa7MZtxcacuK2RakxDhBlIT61G4Qj1v6oEbTA8QBtVrCJZ8rBqYIH73ggqIvj6tLh10TNuUTQ7Ezw7BBfHw15Qi+Oir4/B1oVT2+PWc8KO
+gneiIQmWqOzIUxSkVw004j1gapeDYxeEW/mU+fTvDZ6J5Zwueyhf4CoqWh/a//PV3a0VlevBFrBB3osMSit2tDZOgvYOZ7tat4tYv23gzFcUQon901WeRfXv
+lzscIo8c9Nx7JfdOZ8ajULezJjkby/SrwQVv/1Nz9jcmRogAq3TCQlRAPoDNxajr773UaPmJY8R7GzyGRGKdDmqrt9Xu6rV+ah78dY9/al8F8VOEKXX7udxm
+GFQdAUEZtWUei/hpilPYFeowuPJD6xCSov9W0WX7geAd6V3M20ZFaR/3+RoZrWuDc1SByxgiRZnLhZRlnEn3y99J4HrgkEk6EAZHUNCmKAkISFZnQfLqSM1a
+yo9hcHGaekBOpSCpRrDVlp+qkn1B5JRur+MQ2tqIsx5fHZrjvE7tgl/qtHHOoMvPutGKmrgjL1qmZ7j1WSWkYn6BPc0zzHPRfi2M13cEvUqPjnfCo2Kw0eNN
+pwCfPrjk2mO9plWaLDVYbDlReQ9W712ficsiOsHnDSfARssxtJqa+tBH0me1cm6cmj/QCuo2ui/xSRWem1krS5CiFSJ52eYg9/twbOG2+dHOE/+t0a0DPCEF
+qZpNqTyhXdcG2ailjSRcxSkF42UJlozG4Rqnuc9JW/1PyUpNTSA62f1k0M4+r4q8ERt1dntgXDxzb0KyrupD/LxUhdgpQ6aKvHomtSDCaFNSx38dLtPCnuTE
+OJXvOuSbGZmEmeBUC2ZBLjmDuj5yrlGM73BKiIBEwfqij48h3akz/kfTd3msU9YQr6DDWjsQBwdeVSPojXqCxPryTWNA9LQ5NBZcobmGw3ECVQqWVZNzQsAp
+yUEYna1z0AmaBHLsZeOK2mwwr38neBJ9f4sC3vlZ0YOl/cOaaxOtG7bChWNDt0yUY77K10xiUF38iK4KFZg0E837DmgBP+tSyZKxTl116J0W2w5pHXr0Ze8U
+xd+Xw7qoyq/SjVw7mKsS5FvF3/LceFvjhsWjcrnIruclT3Ff9WkCDvVVmSajNKdl+zTPdkuPePWc7ETM+jMQlIGjJEdTTylo1R91LdeC+nH+UVRKm4OdhKh+
+f0ncdPYzVzF2tWGGFHssWW50q2xaT5QlDm9aLKDTYpVp2af8dT5OsjOYytpxznYvV80AvoP/t2Wj00PoHWS1frF+9ZOmOAC2vZGVMyayavcAYIKcFOCqYN4k
+8i7fDELQ5ZPKDdZ9buPKpK3DZVU9P1e3LpdV7mVpP85yuzM1D8xJKtKThcUyJyeSpA2rAK3KpiV5ZC1DKkVE4o+KyY23KPgAvOiaq+8P7lSfjx3VVi/saaPl
+3NZcyx2KwnntFT8967MWmkJEJvY3K6wOBJOkO5r8OKyA5BjkFqDZIthSH5xvribiVJ3XbHNDaLTTZDw+6JnPZ308bCw2B7nfh00gzpXJ2agI8ituQn50qHrE
+IT9q64OD/HHgNcDyER6SQjs14RtEzwVWSY62AYEI+Fsor+KnYYWnRJYQUjXtrHKjAZdrmLLKGkwz7QFj/dV2Hz/Y6CSXtXrcFu+9w2a1wMMfm2sf0kyLA0hP
+ZXI7wUEvZ3BPByVGJ4CJlwlf6sRQknKFpBqzYJebYj3zw01/yrFErgN4iEjNIB22LDxxWDct8vkZZuDrXZMcrXBqfVWr5kT5RASQVTSV5CALehN9XzOjak4g
+1EZdPMi6PvgLnu89XcSch4qdUlFcS45PtWJycx4ZcO6Cq64Pmc9upocUXXqzRKGhtuMxywqt+t+3+duLq3yDd6Vv6bBjn1d2kmGVR8tTTNtpVCz2sZVDXIsW
+4s4jlXl6d+ihhIGbSlyQQ2n5TOpbULo5TXonUlqoHmzB4HRKrd0i/wAaPqrP72otstzNHwwus+5SnPXCdQ/bzsOVYwQsH0djtW1toDaH42oBJNNG7DehzHPf
+GHggEDjHwKOOiZ/U8TDukXp5ZBXIrEdeW/fCiAmAuUNvM0ozr9SiNfAty3mMOjuVdF8dntTcyY3SKj+rlnIfyWOqNE+1fOrZWPLsRAOC2q7N/U1iZ1vB1gQA
+sFK6eQN1qBfua6V6VI6ujvpqcC10Phpr7NfsZl3JaaszU2B2wWecKolOiiOLblfoPYrBScUI0gQrURBgvLkiRE5tUbPqZFb8xi8GBaYeWx8m/AzOMQfM8H8h
+eyv74XE7q3rOij4esjbKkXPYygQ5mZC4e+nKrpgD0B7Pq4YY4IHPz7YkGreLxqfVOJw/o8oK/yMrnP3TtltcU9u+X7MIQD5UZYn1ZVLsFe7vjsjHDFOyZAaC
+p4uBTme02BZkVjWLAiIbZ5nPAj+2O32aGqrhc19I36jqJd/mnrc/4hGVX//O/Nyr/ubqnImBkkLLf/vdAsttp+8rhMmwA8A7KnqJ0austSd/MnqbMc+H1HSH
+OQxLTbVI4nNnUVPg+Zk/nvCCP5iOcz7UqKEUK/Cro2cO5r6xc7RrULiPu+c9JN4qOf/BilC3Qkk5XEFWFJ7q2ZNpkuVSpRX5tVOkYSzvLZh26mPyuc4HSxxt
+1q0sTbQg2PXtBZ0A6yuxp6LJ8pCMcmp4Uj1YVWYlVoAGGZ1MVZsmE3aGFMum9hEXF7UwXvVsYY499uua5/23HYBijjwOd3l2lhj2mGGQAcIXpCwEc5yAr1CY
+XwY7FvKl+0409Y4KOaaGsDnDdn2/axdK38iwlf9EmKymUMROga49rSMgOP2DKbMdq1hxyDrkJxiQu93kiq9xsY+sGK+zKbUJrBCJAooHQb6RgetOyAMeLYCH
+ZK1wjSOynLYcmWCtmpfaAtZSU0KiLJNIaVATdGl2xeDKufx+9vw1VFLgMmRmIrCKB49Vp3q3DyS49fZ401TddWu51zS9krsea7wKorJHUrq9C8lSAz1AVdWb
+R9YQyxNjnLW1BoS5Aih5m3lWo9v5lhI48QWv9J3sz/mTwev2K3wa/6P0z9K/Sn/jdefRi8ntYXz0qVai/HuVo9nuzaXz1UFLOuuWHBRxFgdvayxYXrzMcBf5
+AyLAmYLjNgACAbYMGNmUtTSvISuPHvppnBNDGBwuL/9rHYQwGzZRnwTe4E8VKgddRQHMPSRy0V9Srb2KaPr59CvpN2QFR83JfBRoSpCOZ96AV/1qm+xg9b+V
+/o4gKXNRVE3jdqWXWTkSUDfvh6QyaicpC4hYPn8KDn/KOcIssxQH5FEVzFP5n5Oiys6ci6+9MdQFP8S935oNKQcvuLvzCT2qP8jWu3/Hxp9LWc+MB9wcZJke
+D5u7phhmiEGxFZpUzSmjiRNQheLKGhJMGyq1dNJqqZeFqK0K2BTD8ohyjG/8YePZj+nr054ZiJ1S7Y3UwyqEyZySsydKEdbcminUxChfohUBAHG6DzBomsRH
+XU5GM20ETMj2Xw/8h9rfqpBlecstl+bNHrO+6Kge6+d8r0atX04/F7HLN9bI3T6P3nGkhdy6P+aqRdNoKiCinIqrbFgV25VOgAVx4ywdUcKqQqdSdackQe1D
+tdcYkqBvlyWNyzkX/YgzOLIO5+cYpu8N8b2ogf1G7aSvpdVzqPKdjTD/YUHDY9yoRH+l6u6PT2IspJ9zo0+5a6MkAg1kFFXi3p38JGTbWZh8hlJkQWIVKgPL
+5RRyq8Ce0AolBiFh/n5eqsfd+poqKCtxHX65cSTvOJ2GI8sz7d/xCmzZzQVj/92C+itLKpWtsu7AJDg6MB73oLqP3ihPXmlxUHfdSazd0OPQwqhQsNyF1vPr
+rU1U/ePhXPrtqgNS+VljKx7g3cRRuxOm/nn7aLHOqpiHpj5vmIwp1VVl7WN14H1Is2KdmR8oOwu9TR2Qplq/y9inHWGZ1cARYty4Yt6ndZtlfFpHTY64PTra
+S7mqK+vstXGxMaL4zKu9V6O6FDpCW8uiuTnaubIW5ASYk4QxWOs3uvga0FYfNMbazV7r9z+QfrrRI+RRuXcURj25AhmwbTjf1pdsjBx2v1S24Vd76eZxVWlC
+ByWT9VgjcRiBmqKNowLbJjW5xUrTA1myQ/AX4GlJdfqlZm/6usbF+Du0X2B5re9JWS41n9mA3MRZjKIY+nPfSGxqZWOcmUSpQ++J6iEPnrlO+pCf9OhXdiCO
+9NixFsKek3jfQffPkgko1RBPO0Le8Z70Po36PqHO919MXw4OnMwqzdLk3moS2yupcqebg4PbqpV4oSnaiy1vZwfZrpO1fMJN0ok6OQ2sXHtvllLbKE0u0QtA
+6vPLtGUwHyrFgCD2vVPunMq2bZ/D9t3o09b9u+suBn3ag4r6C/zhMU2Thb17nk10zTYyJGgZjBn1VmYTVqBl33dDJwwYpGyBdFuXVNtcqTWTb2iwdAVf/W52
+4PuQ+tiI02DFXUqk6bUU8zxosrGlxlxu7LUbaUBX/7Bi066Rpl00cFx0JXGwpZhmTAhk/VivnWiqJahkfkexcSQ4AaySmTX2UNaYz5ZxbZt91sSesb1wxm0N
+Hmlv7jRVXlJuwre5asyPBz9qwTCF4vPNWTdATX7lZD3s88JTCxq0Y+N5vnQ+ZGwnHq/EMjiiUZfBHk2BuSjhVwSCxjd3CiQUHZMJ/aH5YOBcasF9diHdpvel
+T6Yfd02ir8Se2DZKNLrcgOADNlttnZ75xr3goarzy/6RczR0zUCaZbqXZXfl9i/43MDyxRzjhahefBEbYA/JQo3eWVPmVOVMFG5AOR+rXtPvKjOqKCD/dfr3
+6T+n/1q12nlqiD6JHYGhZFLN2O7lfNUE0bAXPlDHZZ1zVTuJmIu4FVSXnEa0d4Y5av19T9g0QgRtm8L2aSFHTtBoUpuKL5gILajfV9KdEiDDM08kCBCr6CoG
+Wl6jA+I04yd+DyqPMfQ0rbO/pAwptP3GAtAZi+tR3X63Kmt7HOsXnbrYbYff5xnp+SWYOZ5eIIclqs8Gph1EtSkxBIqeU8/4NyBQTQFsc0x8qONiboDq6yoV
+m8oa/m1Hbtn7OenMVhmEa9DMThO6UjYQSKuIs4kqtL0CbQ1WowpQYjavdfur1w6hU4Nb9VloE/AJLZTjeTQlxOywf3lgD8uDJkYpTEartUtU90/SP0//ysao
+9YjcH6s+fFOFr8THpmOxVySN/czm0LHWc8NU9/O4ModntqLTUpu8JgAYWOvUENY5uEHDjDBG7oTmTQ/8CNDbF6gBckNeL5MMKwQ+aFS9sfPpjnpsoRt2L8DA
+Buprfc8+8do1zqu743hN7RihsSFBavG9UeigDfY8mzIQXtMMQ8eO+Bgv+S3KPvSzHV7gX6Z/0+NGnQ8bfITlMORJZp/H6142i4cfET5oEaZo4oSKo/Loy792
+zxaQ95Mb+KOUWLHyZRF150Psvpz67F3HrtO3f4FVDz7S+BxFRfb/ofoK9F5YOrbaVuYkXXRTZ7tbbg2q+WkLmJ8eji/nJjTmkLjSkg/uH7WRRfsk4FUBrOAj
+uf2JYk6e9bj0CxcjARvlAQCAQKNrijX56HAOJ1oBNW1b/oVDmtLg5f42SEZv+4S+T+Q3mi+y5LrPwW00gR/r7W7bCpoU5nYxsD1HcRSLd5wASXd//eYjJMfy
+f3nKjkZF81aNuLell7WO/0lHP0nPcIzNi71PkdtjKPlsLmkq72KW1OO8ygpT1Uf+ZGAEaiLRQVp85X/toNaajpoEVeDaNHN8xXDQBf1IqZ++I31AEZ+fDu57
+jTaYNZetd6r1UcXXPCatrvTNEkleEL4akuqic58KsNxHVnmDRli9GmG73JV16bn0LDfXLPiCzFXFaHQqV29svPXemX7QsZ+lhlO8lGcdTn+sfoKtXO92bV5D
+ys+oxoP16LrvwqsD1wnZVtzJ0jcGCe9anhB6e8b9q9OBYj5nRCU+rVDprdhFB/YTA7sQZqg8S/82edv0mSXvghunmT/hOxjr8pn0CzqCyLWJ/1l8Z29M3Umm
+SUPmcrnnBkZ+G5S1xMlaR87hYI+QgMYz3qozIiU1ojz+ELVcIY+zLMOOFDQ4lhRbYdf6mx5S81vbf2GOpatqn02uDa2+AKZvTN+ajsGZpPbUDLN5tNPN9i4B
+0YTRMySd6097yeiw58bzvIe2XVTGN7UrABhsnAgwS3oygRz/U0MwzOTxOvfJMmC9EyfQ3tuEgKFvlroBeV3Q+v3JikzIbQOxRlZds2K1d5IO03IdgblfFGxT
+FfWlkVhhk7sQlL9bMjiLgMAidNJuT3XDQJw4zSE9xKt9KADnPhnmWuqYDtwT/FFHV/2Oeft4henYeFZtDkc3wdzUvKRc+n3P2Njw1t+h7G74pxu98XPELKoh
+iofDZvHjelm40hJMJGop/M5Mqqz2dB6Cp1Kznf1KelOjs1bjl59QLyTH+HsaP+pZ3SW3m2pzfVSg0ILI0dONZLhi3qW7eVNqWbCWWCXk/rmmJXVXJiBeNlme
+IoPYqj1xoin5YAEKA14RWOqcUHWM5KfTg+zaVOnu5Nw96+28HLjbrVasdXXUG3HjDome48olNUiH5cbPnaMbRNnlvuvsDK3A/Al7LAdVqQc+tFaJW4JZ0P+a
+oi03lxW1kNHh3Q1e8YPpk+mzupvGiu+f9EqGS57orfbX9pgbBFDv4nO/6NlzzlTLSuvuFdi+5eQahw2cNVfzMXPPmzlyBKUweWXlVLQYVxvbsP+umxjqXdy1
++EBQhZHOoORmix7FIoTFlSNpGt629sS7mT1xiK83BhR5Jc98GQPKW50EE+HEAyVGEOpWLNdZ2UXJvIl1rCvYDsB1jwSCByec+xZDcxAEK+fQg66Moy+mL7d7
+92jwl4OLrMkBpk5t+8gr9MUnrz1Y+rpi5NRR96N+nx5dVSV3af/TypkX+mgPD8wJhFhbd+yc+GE1vrGuM2eliAKU5R2aToDMvZVAnXcqVzUNBNMmrnxd+ibF
+UUccjPPTbqq2DDOP5nW5Opsi1Ujm0siWL21eNnarbkgiL1MmT3WsZOewhM6UzynDQ6KZVyVPC8rAswUB0FmHctkqkt7BOUJcY3uQuVHO45oou7NQrN1vauQi
+e5RGO647onNIogKZM8eb8wI8XcAqf7IQSQGJ3AtDVZm8Je8hj0Q3VqJA8OquhD9o5zm08jtvbPR6yy5TOin/SKto/zH95+sUe6Na7yh9U5dZ01RjWZBgaz0p
+7kc1CcndRS5CU4Ms18b3HKq8EMrxRr43x7658XP42Js1qS68ZVqiTNk+Dq1xS2e0W72F75jt/smGufGLEjUFt0gdQI+mN1rxOeYu2VgvmNg84rGQUCcUSI0g
+g0nAo7M3htSYameK3pwA7EGuB1tIcZsgUoGNbb71QqNvWLjuH2s7wTsV67OAQDXROU5Yj9YY2bfwVptWG+1Xhv+ayafL+jwINiabpUmiyjYPSetnMY+NBYQI
+iXXYGcnkm3gTG8o+P7MmjW8ImFMYsfTirBbwU0E79B+lv0x/9VrrAPd9wl8BaYcgKbagAXI+SXks+Y/5PgCQhpxWncuqOXV1zk8d4zC1+T62CQz0Ht/iXvA5
+7piYJutXZicCj9y4Vo0c7TfFr7yJeezX4ng0wrJ8flSITevG+JBmlEoM1gak6lxRh02PAiBQv2yH8UkXj5qVqfO0V2OLuoSvTy+lDSNmJVaIvls/Z7HVTTCl
+7SsBsaOkIdLxb0nVfttJFspJ+UwHSLb3KT2uc0+13l/eqFmdQ1oWvafWIini2ITbUToq5Sy12Mp0HB1Le7OALq6e6jvWttVmXXm6Q2EZyjgvzqbDzLLsJpQ9
+9crgBFlWzIkGZN2SAUjKorjCaiFVoYAKLYoVzwfV+ZTFytMtMt8rOQC9D1XBROlNXa146z2nr+je9i/SXz9eL741hOm2g5Tua9hSK8YNuM0F4VexUHxdzOEZ
+EIY1CgFYOlPJ1uDCwG8YvGriJ9vZEM/Qs1FPWYBMdAZXaryKb3Z+aNXZ+4oiZdKNu5Xsqz3gnk14DsEe8HauSq2tp1CxuQ0qO8u45fPa04NeduQI1tKN7G9o
+DXKFMKuWkh0UKBSuBCCO64jmm6vdqpzwlLLFx7bcZ8LTkaMluLXvCo7f6pNr0L07/XyrGsJew2n86oVpqf8rA1eZlZIfjhdtFiEDEH840eCFT29c+sFAqs6S
+cUhVNRnBFTpP0rAQRJBo2QoyiEBlnSBIcc90xwob6zOdokey2MJMJTVW7SL50YS1eGi6fsPqon1qN1GqFLfdbLUIC4Urt85FzZOsMO4/O0F2YIxIcClpC9vg
+AVt9k5SqDp3sWaWX+17nmn+ne4pqPH8z5/JtDqxfs+2xZMdetuPmTIfgpul+3s0qMhknIMpE2vNcScA1858cbIlRW4vRIo0aKYWdnEIuHVoG2NZjau3qKat6
+fEBVmmuNoTKVUvbj3ClJMjYNynYJkzLX+YgEtyU+kvRTqJxyLl/fwFN8b8nJHnVJto1LCgBZh20QQsCnAHRw5NgXfZndEH7AVcpVy9HZ6yzt+phT0jJ/vxWD
+CsM1nnN0Z64rzO2RJA6VzjlVvEAj6a3QFVN9jW9D4G1NLgmlH8JZ9cZG/6PfXwrrzxgk/N9BMThzdeSDsv1Hd5CYo5s6eY8FZZzmqxWiQl6JkEgCcz5xmY7u
+w3WqhsW2viwmR+88uDxyN1Su59FsNR5XVo7IqE4SH1vAFvxaVQo6BLiXrCgj+mXOWDrDtfXW4a2PMbIUKexEWSlok1FE+U2IETmAaXhIkgZKZXCQ8+lkUFQF
+f1H5kn9TrqxSWmRkyeOmF/V16RvTtzCy/8PpBz1DET+yMn+OThUS5EUQ4DlmG5TD+uCQCk1z19vWv0xOcgkTx7PiGDur+Jbr0ia3SUZh+bpmsw5LUvg+6qhl
+eY4AgYm4MsHU/X3SrXiQwHoS84kM2AFT3xTw0sJ0+DHt0pV19UfGc5hjU12WKyCla3bie48b3GkQnWuCV0W619v14dW8WJK6bQ1wdD/q1kdN79wVXQMWHFD3
+ch02AhHeodjxM/+k8hNDklUbwLyNirccCojdQEKnQfLOmR5z5fDzbrU2hbNOjuigisONC1fwXTgsmk23Wk4m583MUQ9eKSCcBuVLottvq4ATQIa8qLPq1RL1
+YaiJWjayZSAfvxDGoqy575irljUekNU9fjMuYSdu6yjx2fdq1qO+EfJ/xP7RDnBdS/aXT915NWB7NitGwqwcaKoIr8YB9c0hL/gW5xB9QtfV39dz3LhqZW3x
+KKyCZ52VwZ3po1vs8XDM1u6eryDlDt1W/9ODiL/Pt+m5vcGKZLstV5EDJB5lM5LY72Tu0dm82rjweSLODsB03+xTRIRTrXRWF64m+7cgHaaa0IYIKX1tmEv3
+GkN/nzIfBdfrdYCbo/fKDdG7bmIknlA6KAYd37Z6P71jhqjG3sxyVxcdOUSBVX5t31vn6Bp7EzyLk108VgMFUxccfK9XiDgCrPjHvPRXpq5F2LJVEfnYojsu
+eMhQvjfDR0P6Vme7fSYwNkRLQiAGm2qKp3KLJqkhm34tDhyCxGxPzCprd+M+2PslvsZAkRsl4hoTmYGvsTIetMwufCsAmmxh82SsBqZ6HEypsrEa2hUIa6ua
+cjR9mDLnXlKk6kdC7vYjph4/ukp8xX7xptT0XqLs4hkjikZukSYoQSVYWPCQBvS+i2PmWxOSVJNbILLlVxsPFNBtncpivdaXw271Y+7Yyqz+w7rqwwcMhnPU
+7bH4vI9zA1sBsJ7bhW4b6wA0oyd5RxgSE2FraYsUsy2f9OZn8f83pTFrPGXoDGyz70Vhm/Fb3+w3t+mb+dz6fs05jMkvSkfGmW0KiOqlubbsYivIvyBAc/Rt
+XiePjM1Z4tjm8Lgbur6h299r3C14QHMeEHMKcQS3bOIhNR4VTTtGpWlCpbG+sVwPtns5wkIvL+ZvTznf/5QiJoWP9ze2b8/z2eCT7eXZO/emDHhwmW23CtsI
+AWcQAWi0r+t+ZjiynfLCj2YeezhyADW5Yn0whSathcnYQNeKmUJNdrJdHhDoIXUD3LxFwjgC5dZhxJHAjiimAbSPFsb4nekDQRsnYDNvwrZ9OLbNFRFBanet
+e88Qt7N9zASS7hb6VfedzRlRMOsv7RIpgOueDWScA347x5OVQR38C4JYOIjEmHOe5PeKRbRI/GfdrbOs5TP4nx7kcdgcan2BY1Nd7a6n1Y5RZh//0GVxKOJO
+o41FAFDgxzm7ElYSKMCghTpiIWb56LJKEQcU0hiU/a/asTh7VqD7GsrzP4cU8EBf33mQfdy5P//Ax++PnJ/3N+k/pP+S/tf0f6T/T90b75s9UisyvMg6Pwlh
+oSmXp2rAWfFBcscQBbcRsHpc8o45znfMMbwk8Gs3h9HJEiusRQQCQtdUoma9TjXhFCi6A0IKDgkD/k8CFcFukYZzavSQU4vh8U0B7R15c10Il7FWnauWHGKr
+7/otrAD5E0GzsGTy/yz9zzXn2LpP9hhkhSWQ5W/zpG4O/UOACMjHbQ8s0fuzivjbcr8Eshv0s1pwDpAEZSk0vHwFYGDaaB6iC16QbxIOu9ScDL6+xm9OXthw
+T93Y1tJR1ruv20iok7yoiIDPNv1aqZVwr2N0n4372t0w1nNLnZCJXQkqm2vVyiLXaTuT+plcbpuqisAM2EvODCBnOls6ByC1EOq3YkdXTsvKZIE+EfStj4vo
+76p/ZFWibS95fTT0vGkgLf2U8XuPvF3nYN14HG3j3Xb2VBAgJnF01BDaviM6f+JxF4oEQzUmdaHr2KJFfcyZmvwGHqTcAOWjX8/nfC75iZTdryf0PtqvbDb5
+jLP5ZkvUB2eGED/vVX47s/zTVYS6T0EvRjCj8cYSpYQrDaBV5lZ/vGNrXQ5n8rFXtLZtp5Aq2Y0CSXKPjzoQ3SDIf6LtvGYHqOOuO7PbrWvU8snoQ88zct80
+YLENAoNEAIgG8ECKoWetNoCMmE0tqXyUcChjV9ykibh2SzVnrRqJ/IXnSe6rgOokXZE4vxC8I5VfGGy+ZMcfW6340cmHDPKdZyjjHMpzNhAUqiGE7iEvLBSF
+TJEyTK2kHcalViturhovfEzMqU1CGhmyLgaEtGJFX/GD/YH0I+mLQZ3iDyob3s6847Z11TzLhZ/tzDe+HXElrvmqElGaMpwKagR7zUp2M5BlTgSCP5LPJ2k4
+8dTCmXJybR41MMvyD01knzrz0eo/uUlvZ15BZela7YNV4o2pWu3EyjitBOx8G/W01ocL3IvzZclkRgqACGS6WXSCSLLQS5dPYQdepWcwYNYQaoUTzTm6zYW/
+KXDdX0qQfmxWrf2z9Bctuz0rwsrzqDltbR1UZPZrOeQjCClOp1WHZOh1PBhM0gNQBw+KqjwOvwcjcklW+XVRxwPjLKkNtcp/k9Ahp5ygNek5WaXfutq1Lekx
+KKQ1a+y/HHAPVXsijbF6xhdl2f/YUUmXHXvHxd6+ab1IszonRq5xO9+Np9uimXObsuGEZDBxlQCAIfULzr3d7Alz52VF9C2pY6a2Lr0+7NvahVzZXliB+VFB
+uqeLV79bcupCIldb6d26SM4QliKhKbkKHcMZZP0RoVmb8Ld62bkqOOcnUq+j/g5GwHwb15g/wdU0UVOXyiLf+SXNUPludWDIvRNnf+K8N1fTm178EmshRlGd
+mN1zY0hohfcVTNwKzDS52LWmaHI4U7UvhtzCFjBokLa6DphW7LYRVeXMsb/phW3/dnpgzVZa7Qiu6YFpSqCNMBmVa5tg8tZcd9Gz+IjqmH+e77sjXG9juP9W
+bQ33/mWmY350xKay+Vx6eL06bpuBOG6PTw+qbrl3hReT8JYrgJUUQxFrNW5yoOBEevvVKgDNrMM78xmzF5tq3oo5lYCN0IZIs/1KLrZwOkWPjrenl93FXTl7
+XOE0NnRAC5YHVkfeeNnTckxxgFof9i6IMlar461pii2FaGWnHbyYgCQJIn/BLKw00ZB4WiispeV9JB04k3aXp/nVqPvCSTG+AG2A5vk1KcfonezqLW5v38N1
+jC8oWqPWMNXa+lDNrY7SX5lrhLxm716agMqSGYAAgIZUdUIu2vdWtNOAS22YS0a+bwy6fsWbx9xpyqyoTkeOyNX/N2vnB69Nwo5vfjg0jge/8744jJO+VwrM
+IRR7+Qd3WnLba99OvjE2W2t9054xkm2VzrXwl00IK/D+5clr3ZO0JjOXIPihR64IQUjE0yMvpdchNIw9eRy3ZY/MjzN/o57G/1BrEMhj6XMnhzrlqBHGvAbZ
+gA3LNLzXJFpuydO5G0UtPFYnipsKAQIC0qZn4AODttwpQ/WcDxVFs4WVoFi+mBoGWqxZ6hB60VdmaI2E7bPi+Qg6T+m3p88HbPjvcvdzc5iZa6mJUEwNGlmx
+kCgcaivCnrq5ylj6JH8jIOQExEiUVnPS6CqNR59XVeVTeY6QOt07bDhIc4+heJZ/Ov1w+qkwItIP/MtGRbf1YKiutFsFeofhc/Uq7rIezFvK97K84NH6dAn5
+a6KyVr0B64w+JMCaIAbCEHpVFctnNY6hViRyoFDtMdWn8tMaVSZgpi621Komaubk1puCbwlj+Tb1LT7ynv+p4LbT4tD+meRfuVIymu7MrQSSDtBrve5Vy09V
+r8vPr8d1o6cR5GCbFsH+xqdgK9aZqVwaARAQoWsdQqVGemwxAcFEQ3mU1B6XX6cyxQmQnzkhrJKtYA8ZXK6MQqsL7ZgJbR594HqUKXqiv4490TeKRj84q7vi
+RFN+JHt3k/RXh/NGwcvpfIY+hx/KiEgUYZS+Lp9fwbkkXr1G52lZE4R+beiHjOlONRDN7e5HY290rKtWXUfrOSiNKZlNmUkKm7Fdj7EyL5Pp/kxgUZ9baVZt
+fy/KHs6UbBmfpPuXy4+EDj0MfpwCEEFGoGe4KnncqstabTdsbR2iTgswF636hn/adeWFobAxLQTGgMgMMF8hdQ1f7FrMEFP5Ecf8QdsubFIA2oRAmxW8Yxuv
+R56R68qKCp4S4KroYlZmsbcmdIpEu/xmP4/XHvvDnptVFuy24e4Eg2nzzzdg8Lhfv5fz95vFq5UtxbGdKi4qJl8KOojKTDoZSlDEl/uQbDgQSPGbCL3lqsKK
+rYPJIxEAnLp4UJ9qsZuQnqaDjkqt/P20Yp8aBEbFQCkozOEtFok/xph72nEzOARHAK5S5opnMXNm7AT7CDAU0+3NvJSqsM0CGc7eeo7mC+o8b57TXwgK8L/F
+2JXKqt44c0f5KTO0yf64PS7rROTyS/Ym/bXrgQJaZmwb1+PmvdnAm6JCgwBZwRSgS0O7p0MCJKJnBAOUj9qCLhuOTI2p1gLNl3dwuEn5aYBe2LLKrtQ3lF8T
++D0lBn8rc4M/0Gim/Wj17r8JuLkygk96OyoeVS51hHlzv2a3X6d3rBaYPXOP3xU5nE4sdIZEIbxGEOaPzaQUCoQ2pjJ4fg6D7FMK3NDGzIyXDbLlPG08Kr9N
+uzSfcn/BL2q8Iwp+pVf/N+k/pf8SUK2asK2X7N6O+Wj9rPLc2vDCN1Gsh3ETuyBF66ZNop41277P0jQ7Y9PIXzAQNdmmZTUxAElSZPByMtzmhI7kU095D1sB
+gZ3l3TKu/FjW2ttkNlAdB0QwsEHljRr+euQU32nN9nOBJ2NeA2HnHy/wrO4DhnulS9wrNQdXp1U/+vkeeMaE3M6B3Pfvq3C7DfaEnnY3NuS4jDTz5JA/TtYm
+oCF1qiRERCG5Xoe98S3qHfxh3R3Fi0WwJqVfUnmNtWOy3ywRsxvLLFGO9HV/dxxnNd58hgyxMBsRDSwiIZS6C/M1TQkhY4XrDGmqpg51A4XIxpI5WKsSfrDY
+SRpP1vlceyFtWP11VO/hygZNN8Lm78iuO2N1HOe7X2Y1nKPp286y5GLMB0q1wgBhsH1uSpk4AD853GyFDTLMSR2mkSFPeK3fe/NvbDBin0yf1djacuM/kHqB
+vAceUdijD14vaKwZO0HAVdX+q5nIOJM4uG0NzVk/aoIh1XuJgNTa85Nos8tZ4CQrGTBqkZltk39KMsST9sxAi1dzrlWna7NK706/HrJbUWy1vf5Y0XJ6+Y7O
+YnEfq/UxlfzIQWkfV3Cd+LBuNfG90iChvAoB3jbmCaMBO9sV1XSX9aQkH4d2p9GWvTrQETk+38p1bp0AGttJDYGCw8LkzPss1QXr9Q8VheSx/Lrj5BeH709p
+9bj3QU43Zy3MuX5gepMCEjHa/sHgoDJh92V3OjSGh7vF9Ga2O5l5jHqEetWAcuJVaZU+gc0i4IRyzuVZhEsNLJC4cyWjKRWu2pmqgP/lvekLGtn+ulZG/5wR
+na4gfGvMD1++OsFczWsu3rlt0dziJl15blEYIs8q87WE37c4OydSRKXpC8ffCR49w1rcF0wX1jYxcd48YUAOdhCKUzK2qrxxr0wGfEJZ8m7XSn1rmB8E/zLj
+hVWVxtY/0eMOzimaKEOYu+O6EW7aKZHwZk5cvmu4gXbbVrO4eJ53oVvwZmmDJv6CVyFV62TTkYiSZqY+h/EA5c88ZhQUmLwPInuklb4fUhg5e+v2zZ8PyG33
+HdBoze1KZy2SmYepiTp2DLFgHaLPlN1SSL2qJMPune7fU37/7oxR7oRBBr7BpnYhnZc7wAUu22MZa5PE9kiWIvINV15lJ90zO+iAKLcSc+nWa4Jfr+gM4ahW
+N7jfVmbGX8rYPr93VL5a8YmdvFzoRJok0t5ruay75lAfzhpLyRgSAKAAVSR/zUmZdeVVJ5V2L4FRmav8MZ8xnJrOSj/lUIRtmPmQgnfGi+ooG7U0v9szvrLr
+WgSQROHJNlHxQthveuBdk9vOHKXUzjC0vRtKvthm6C/Qp/ebu84uScXBMuAEmI0xFMF5OUGQRy6lBZnBqAL40hvI5Oc0ICqf/SE4J0TNxSji6j+mXzfr/wPp
+s+lXmtX/b7yCG0DE2w74WWNNdRBwJqK3owKwPTf1LZ7ivAHLQD2mOVnVtBAWUMY8jEFUWycTPwOCPJBzvla3sqpFzBM6IlhSgMp1IvOH05nKV4zf12nDEdOX
+gvuS4dr3jSLnulUHFNuuBe32TVU1qoBk4zJzL3TNE/MpQzcuSgba5ZArBNYsr5prCoDd4DnmLu/ilDZQDymyq4LquyRxFegmGST/rl4mMMZP35A+xMiev8dM
+wS96NPVbjipt52R535fk5bAwAy0TzCqi2+K+VOMuVtNZTbdz1wQgQFWYCIVvVas0xC3VvF8FdCcTiQrn94z/NyTjowXgbVATruK6DQ7sG9I3pm/iykHViSoj
+ldTZRAvpOkAiCHU3F9JS0KDCG2Q8LizJNjHWVqiC8xmTT/zhxExj0wWkvnVsTnHCaPOZhIGSx8MaV1qLYa/rbJNGPnk/oh2Hv294uLEiedQXghXbhVOraJex
+0UtYu/7KYm2pfBgXSksnjhSktiSE44c0qP6423TVHWilU4QC1KNGxaHG0tCVdMpFhMYbGy7/Z5su3G9bTX1stXxcISLUktaquLkZfd3cBk5jrCy0J+eus/Nd
+SisYLDgko5/X6zYTbQJ3VOCtFYTAOPG1E3fNSQoHsKTjWoEaMFB7KCyxtOvopcYP+i3pPaxmY5jKH/L19EuNc4ByRhh8Xr7crp/PNmAu+FNTh50b1GdxoZto
+sJ6V0kLkMYHKKENWiqPsOAHIAjwhScMpsyabu9TjLNrqa1CiY/PFlnFkXr23Lo22D7y3sDuzfPD22HNpJODV3WbF59Wy8NjdmWSg6hViwOl4+g5TGhJMRHmZ
+UBPVtPrWVYMcAvl9ze7s4dQZ/tE9R//mj+daGrcNX7gnAi7LdQfOktWoNjGhNwfVqKofYfN9APBUoVU3lZGkjUFnStQ9ybMeqG6BiBWTqDYL50rjEG8Md0Xt
+dxLARCdaKbItop+9GtXUod6Z7rzGa27bZUb+Iu91yje5zMheH7cG91QA2iUG9hhgK3woio3DunxxM5NKXOBm206uYHl+m/R0mxCy6sDBSrkBamu8xNP2JHfC
+GT3bBK6U0hSdQKPXu0RTNUqoWKA/q+5Wy6zto8+rQ3Wx8tw0+DXU4Xy6OTRJ6lMngkmn4a7vgB32PZzSsUKqUsVvUzgDgiV+qn6NAMih/pAQ8VTJGNqlYUx+
+5HT7bNcDV5wd7OAQ+BuIIbehH4wLHjQZvrbZLz/YKFPKzmm8F+nkuCQRg0CqRXyTRVnv8RqX0IP3tx/zNdcIXkevdQE1hUauxYkrSObGLYBrvvCmWp3MMa5x
+bMBw1G2WXjWFGuyFMXxLo4P9ImOrtprnF7WrEs/8iWeo/zH9L+m/p/9dq8+1ISszc0ahNvNZNw3RhJ6HuvzgzgIfT3P5s1Zk4g8wDvh+1tTdNdz4cVFzVxkP
+Dkf1gSvL3xQLy1fY1bM4uIaIKFwBwCTPwCr5vtwKiHotQA/GkIDI0wIPc/VZsM+t1sNK99ufVJXVqvPACGpDex0a96mFnrq6bYpAIpcAKo+v3K/yRah1qXfH
+gW/OFTqtqndESBZ5T1Qxbbw3Q07eKg9wDOFto3aZwl4SbKnIe+ZmqiOJck4u3Y0YNt9OzazXwXma3suowVc6ndYywt4rr5nJuIl0FScoX7c3NBXpkL7JmQzS
+Fzd6rZ5YM7FW8xJ2oTurp6Y2W4GMRBOeW/z6JmMT4swyFtWvS+qmnL9YxL2+hmGr7p7PS611OGpndDrKqvV9refa0hmmLUIG4AI/MCjTjJhwRrsF/7mechu7
+nfJz0PpUSb773e4j9FPu+/l7ojKpUCdeVTO9BJ9CY63kbQ7H7atDHLqbzjyxKZCqVIL7n1u89LQZxyeOq5rqCVHGxo/fRf2EXL6UdmaZT6k8RcbDpMrMdNxm
+jnjzqW3lAZj/d6cFg7P6slVUSl9JWN/f78yyn+F9rzj1WERfj+5tINfxu5jplMkVhO7ryJaQPR/s7HrKrQ8xZX6qfp+7OsqOzl4bg2RttrWHMRo2bE09pTKf
+s4vdnRIAcukBau6jQ8kRqZ/cMKCSSqbYi+6CJeVNAEApBMmAF1LTIBMdu9a+onIbb6C+F/UkcLYaVbB7ee/YSl2BdAxF6tZ8pgW4Hxspg+P2uFtgfs7UPwGU
+Uz4BoJJyui59MzwiX5ORP1KQA61KKs5s8npp/V00K3LHDbQOX4O7faE5o7/iXadlZOVX3dfbb0bv4nkHz6GXdxeMNSPOsuHNtA29HDLPsg8I7rI1jVrBNQ09
+NAgmSVRPAJ2uQd1bX++6mJUv9yVXN7YovnaT2shn27Tu1wfxU9boJnaMlU03LgY3uwVqf0w3YRLgtoU61qDMNXM/AdDgxwvqQKrv+OT9ObG2D9abApPTqMYL
+kO0kx9ijaz1snng0U04j6cL/afrHumv+W47cuQda9b2anlKt4K+jk895pcSbo2akusl2GhM7/RUMmOs53aYbdqcb786T0RXBKWkXFBuleYwztnq9EzowTgBR
+Qk2BwURegIyhMuiGTLU5AqDPz0UXyTkKDY4O+l44v2Gj0f2W9C5GCEddDmVWxcq3TNbNahMCrfUrWb487OeiL+rHt90LOF02iFtWdZFv6W/aZx5tiRMOPMr3
+rTB+mcwwpYFjpTrUc1Fqhqoj+Q5BQi+WujBl0uizqg2rIW+wzNHnkZCMW4lSEG3iAdkUGk4xcMT+y+zAFJS/RtMq9+1T57WoHm5r56UJvVyQTf0ujzO0D3+j
+Wj1LXbmxfTkvEAgIOEg93WFLUDfVU9PIhCnERco5FtaMWLABdSV3e7EDefSemU1vxmUvtRql/rSjWkXnLmVf39vQtZvpMjUH07qe7EdTS90o/K6cPfcRLMZn
++rjscbXXm7VrD6dQ6JEHcXxgAjCICWkV/ZQAYMVjfkJf8W38owM0ASEAaW/M0NqWgTeGigQ9tqwoLn7BPdLjiv4r08RYKC8r5HpdCZJHAyzK6PFzPMWOTV10
+bFw1K6Ds/kwT46bFPoF6OIiACHnL2fdKaD2Ry3dOsozxRIaobupMteWjhx+QINHQgn6z0W62yFZPoeadP6NqToYa+av01+LBmT26b3mREQ/Ru6oHYWzXqdHP
+SgqQHzaZDjVZNITjXe9ma7nuxuwRtLQ3WgvETa4WPfityKmi2DlBplIVTbX+N/AkFlAkWbmjCl16Zd7Lg+VmTb0i+Snpvuo6uP4bYh3p3eym8dH06c5J47fd
+g7Kp3+vWKvUiK8CtWnmCjYoCbtZ73xIKnL2v6+8WjI7uevsIe/NZW96yXg0BKn9O8bdYAY45kgFw4lMpgOX1xaBaFkOqfcmqsNqI/L45+EiIC3WpJf+wV0aq
+n/c/Sv8i/Zv073s9nMs67WvXaK+YshZHtlKU46rr8dZNtNsBmAxcCPvzDdV0c+LEtIrkMzW/w5zsyB5koIFldPxA5wE9QdXS6TXbs0jtlH+qJv8DTJDN6AMx
+AqTQ/5DysKvPvZBe5ZqnzdHfmKMjg1JtP+77xg90NvK7jtl2e9ZLdjbmj2VUSyM9VDabORkTlc8q5ASaU2HMqZKNMeomYGPcEdpa21lwLw/qvfGqnlPBqnyB
+EVG/nf7Q99x/K6gVXuzHavN5cMtAO5/2M+1pdfAtPxkXvlfzN7tqR+MDawHWXPFzPB7aJ1Y09Sk+unY+aiXQE1Hr/51IpiqIOpRlAicv2D+jmsliGXBXpEbK
+UqDWsY3xF2EwcSfE+jmllN7a+YR8u3q0f4adQv6BehFi+rP0z7TnVxhx/y39b+n/GbQW9mLN8wjTYWe1rZAxaANGkZHGjjOpWm7qCTXusI/zOpffUhgA5ecY
+GLjlZk2XnI2NyPOKeiyIQFRjz5o/TVSdbMuYlqccB1i+m7U4aVlaVuMfdLEGnNMnNOazBMPfUCISqUTIy2dJGhACYIqc+pe13viJ0KUV3OCvutakBXRGp696
+t6wsoKDAtYBQWiK5hmV3Z6oLu7NGVKvU0Oql74dc1XZavUuDNGTynBgJ5pEDIAJ8TZqofDcDZHqWBspwiqWvqHhrD+VBiNk+4TXZL4Wa9x+2CAArxgQrID72
+59FxpEitr5Vl3y3SYR3hpMu2jAEBQVV0m2iuzi5j1oduQA3RWkk8nQdeh7Km2Vn27ekTOlZz/cWiMR69ujTIikmYlQiaBPUo8mKmDbsWBa36VUHzjILo6Rgp
+l/SibmPAgKjmbwJZmqguMnEzU8hTAUjRRJOCJT2PqBs02L2I4lFCJICHNBB4BUA+Z8FiGhklNdOQAgKxnmtf4JzW0BR/kf5Z+le6s/5f0/9tjqqoc1In3kV/
+ve1c59oUyO6OW9PTuJVTLC9I7954EnzwxHh3tq6oyPzOPkG1H+JXJmzHGyQjLjQtI1fEnKoRnxnTnTPlQ3WdESeaFNT0GxnscBfCHH9Pel/6BEdrFh3XqqMx
+LvYOjApjeoitGyUJtIhYNbDbH8ejqAsoYXK+tc5VS3Yz4t99q3FsKtAanGaYoWal8X9ypRLyCBnc3i7I2/QI4xzyuWfItd6J4m5rIrZtkzbmH+bJe5vek151
+zYEvKRpbsuYy48sYl7HcjPp5JTGy01l8bxmXnrzT081gyZo/S43SFChbSO5uczg+OTO8WY22CGR4VfvYHpV3hAHKd4WTJUQfyWPV88j3j9y4RMcNF/uj/Zkx
+MYNLdAVv84cG5yLolo90Sinixv1XXIf47+z+8P+tnpwHN1SJvZiombIK/bIWC3M/4xBxLadhE9k9Gf2Rd3X163LfbpqvxoAubMFbyrsXxloYLOXCB9lL3SNO
+QACZKBoQDJVA4G5oclw2R6Tt1jl45Dbg8WD/4TW9aqiLTuyUF1ZuV7sOnrKOyU4VfkxRUKqbEd0lymGzz7o/bPebO70b5fEuoJS4bl5eH02L9quy9lHi0RP5
+diu0cw9dJzdneIiq8LiEyoza3pwmIhEAYNCqg7RyrWrR/HNmoFlvR6hvbQzKdNp0zu/8v0tpMFetv0Z8Zm6S86h56nAYzuebQs6LTXPGFJ3kstKN38+dom++
+U/l4661fjl9ChXUzOVSumb/NGql2Hbn/QwdjfBmiU1pAJ3Pf4L3eisYr3nXKFVH8+56kW1V14RE/qCeODfR9VNLYzxqzR2f7t6qC1pypFfb1ymfeKkSbJ+pB
+0NjdEurYKyCbrZd2kfy22Ccmy/CNeGnm+W7raqsaIkfGAj26niw1ZCJeWEMsEz/uN/bh5hDWEOJAuLB4tLKvPPoT38cBTphh5h3VvSFSljiHeJ59TeCA6Sy7
+OTIO3hRF1+NmfdW8cwsYae4QPcytRB2ByRNeEcNLE45SiyF6wiiiV5s1XtWEfqO6Dq2Ce5UhTLdnEOvVXPO57tjW75EDRRQqQmTb95Suu3te3k76Kxbu2Sld
+uJuEje/861l3ecNKIwf2vorj9VMpPa1XEgogr+R1dFA7jvscTuAtj4Y4RNeif8uGPm7lxKi9TYTqu1hucViJshFNGAehrtqB7Eisytq5fIUNKjR949kzr65N
+Y9zX+fKzisQ3lO5rX7k6D/IMtWek29H69PZYR+qm2kiHj8+x5vl9SkBAqtWZ+Wt9EF/UTjO4OJMkh1mcg/z8FXGGrdISaZTRvmJ0a2xxJxGFvbo5cXd1MWu8
+XZJ97d5dGjffd/wsPoFVNiepkF2IOuQkoIhxkk++dyKf0aLqdeUJndn6Wv+rYcb8eE4ACGbIu3g2nwgGpqc8kwOAZOtMr/O/bR8qq/L37Zf/wAaLJlirw/G2
+iu7w/lA3zFHo6fOhw/J/Bil6oOz3WbF6wJpeEaiCnH+nnCCrhBqXpnrdtCe6r701vdN15T6SXvWItnbpfjvOuSjt2ke1ZQY+rQq8m1HXuUg697GtmZnJ73wS
+fjPPxf16XJGXxaGZhiesEp4UxR7IlrPL9SpbzNoXsbioFpuMLUNiE2ZyCJVslEgPc+++uhseHC1mjhxVGVv2Qz9F19F/s2KevqrzcheiaXlnz+QQ1dlFo6qJ
+PNdm2OxcTrcbUkyLwdUNz+6BqL+WYt1b4nDJgcXVJOrnyply5dq/nY9CufYivytsrvm8Le/NqTtbdNRcvCA/4hHrYKTlPUTHfcBT2Uz4e/xLyX6p7Sc2pyLb
+dKPQgLVoOZ7bXWQ7KZJKweSij/cNj8X/vyrlgJ2HlcjxIlLbxmiY+9Bgqqw4nRNkgbH4lQurLRpt6tNaYU0pXvN9+g7vTaUcI8yz9zt4uxylU5itLygtkKrU
+aPY1sDgzM5K0MeQ2DYJ57sLm6oN8QsiJhjRBRmkpEzU14JU68le0+JdDb4Lv6Po4HjsljcY2jP/jC2uliqp9y+bKjeLl7NuD3F8IXqQBGWMsfGEcnd8UyCOd
+1UKTon/q2ZmIiCQ3eiGc7+8Pu6fE0z/xPKc9u7Zw08EGZvTV3cbiZpY5LkUDYttSG1WQ0TU2p4SQ07kFnpXR6TVV2+oyAlFKT3x+3Lm+fTkTLkYMdpHaWDGD
+q72TL8Mhchy16GrzZ5SVz6dNK8G+FFYMaFQ+W+rZB+FBT1DJkwbsA40yCPoIFsoCODnskggg1jZeTAeNZ7ljN7IqXPm0um6v5zteNzBek+jKWuGNsv715SES
+PMPFxFjgjjTbn1bq+exVmNuyR3FGZza35Q8bN4d+33VEZ4+Xb2svuTuVrAZ2wiEpvR2r7xgvwdoNsePYVYEGXuBESAZGLpFLg6eXtjSg1cO+tuFcfm16mt4R
+4pqPheyuyXBlCMqOa6L/NaMdQ/47Vmz3fr3NBwt39zUfLgV8W7uH4xY0sDWTnIlcbKaiLmQ6eg48ETVS3QhAyHQ1ihX6jCDzd5AdECYtzUO7TksEbDXdq9fp
+/Vp0hGt1cF33p3Dc5jlEL3gb6/G6uNDoGbAgffmICuazzYcAKNSc/J49kpcI0rgDeoTrqjBGb8Os9XrzpfonNY9KGHGyHuuQYPEagcoFQSVXc4lbuoJ8605g
+RdI3NFyt96Rv5SzRTl9WU7f/tj2uer+uC+2V3E7cOxmO/Xq7ChNypWDojA4CmlK4upq7yglJ5T9edXhKEu9auQVVipkgnIEx/6rZBKPzt7LAxr3nB6XldRO4
+sUt1kZElWlmVe1JP+FUSqErS3nmSFHdIA5NmGEF30uMLIoKMiKwXgCvUNfHDnhPKX2meLHuLP5utsGFpGrxqtDFnRy1RZqiZ5WFW+WFt9sQLPpiHDy7YDNrr
+U2mFCm7nF1GAVxtuMIekGk4Rd43DjNP/bp5lRRumZig/HviWgs3ZB3MeY6DsQ8sgbPY1ZRbgUxWVeyXXF2hlsDxWSFpIXVZImJUPaZ1eD2Un1LvtapyZ7Lu8
+cWJNkrNxIxGksCBNE1NarDG81gvdhQ1Ixqny994fGM2frt6ml+O5jdtraVwnY9FWFjRDu3ed4qLCeXZP5euUdVpjWZ0nFs7ALNIHrfxgUyQgdi90ph7F692k
+j87wWr/gq0ITM91D17uLo+AJjdkUrTSiVwhCMww5tAT4jKdaEg4b0uCPchWKP4GHpznBpAE/zUbD+AvPCOKWkFM3Blav/KBWhYMCN1eyRYmbH1/OYVuroKfN
+YllRKcgBt+hpofeRKVYkm4SlS05Jsnp5FYRY70Xu1EjeVv76yo82Ts+1PcJW/Sh2Rvah1FPunemEwSxyLdeafRpi06/IiS80E0B327BlnYBtjxTuWdnHy1n5
+mSZP/WXZxUKWERPUba/f2uSq474u4eygmfnRdIyQ3b0YUwxVLz/yQP06gcpu1pxbdoNXbekdO3gu4cnUW+pZPSArm0Q+Tayx9zW8EksoMmt0LdG9KOuF7fy6
+QOK+UxW9cchgdWHJqdKz5sGF8mFgoqhf47ry8zAjTHTmvID7z71Lz7TiIVsxp8IJLNGe1FNagQXzLG+gCsft/tz9zkyQZfH8NAknDdQ3INuUH5SBZrc3wcT6
+M1Z9jP7kVleoJkzlW4TYaG+91dWhftL5JYz/qs1X+V/KRuvXejZprdvu5F1fVENsSMoXjyZS8mLWQXDdwPLNS8eUPiX+HACUnjb7WekgjZzNLfd4f1YZzX8k
+Z3Td82KoMm52h4X57Vndbai6NkzaEKx8sOaDZbwd283xYfmXV0xINh0nyGVNdLxDe5ARhUpLEARa+PqzSUGQzCcLBxV3YNFtzAXbt6j9itjUb3acX/yA7pnX
+16gszi0jptYCagZ6MP5XUAg8G9LIWS7pBcrpVi4Zl6MZnSJgeSLRpIcjP+MzDICiL2WpAezSd+sM+emuq73m/c8O8ZpEvjo8lkTujmpvVl10ZHc5bjdQ3lY1
+se8TyBOFqlzWG4SwIvSjXb4ZcsfJ218gpYCyy4aY5QPBsa5qTVjcVo+8etRJJB/O9PHoodqie2J5aingfyVLVL+adfhXNDXHWXa8eHasNyL5xMcaNfgJMaTA
+RcMqc2ItW5eGlvGW6ZKt+smviNzIXVOZfe6ejEdDLJq3Vq0Zr9Q2cb72q+XR2vo157FV1gp9MMTfhE1vWgcJzgVIhvjUxlWI+VFD3KYO+J70XeknJUrio00j
+pXmzRTbG8q21DlI4RL1dc9y2Vg76M+x+NDo1gX3LyrBl4AYS+e0mwGnW0UbvMHE4oAlfDt2Yh6SFMR6VpAEkxoaXu7OLNmtfIxRlnB9IPxTynV92JHAy5ZRZ
+iTowq1QsfGdB9cWkIJaZbhZ6H3eul8OQEbTpUQt+k/JVMvVRtpei+sYyRaeF/gAi+6JLLExjY6HZhD238G1aYRVmz+87hjo61lSlymDvlOvX6ztF+dbxPiwV
+nGfPNDFdXY52hNfRNG7yvZBjp6QLii95kk8AnJdhdeVpgnAAeEgwJfKWp5UdThLDdqqhKuCaobMPjk37UMAJjSasuN9eE6fkp7FHbxkPj/SqdlqvqvNmTcHv
+DjHiUZGMmuaV461udBwH62Kcp3rJZFczuB83TlAxHrWynZcKw6fGblkxDqjCAoihR2leWYcuHiz7+h+aKn0jtRJskladLt9FZFY1y9MKrEbK62i0byMre5wK
+bdYDi6+6gWKZrYTME1mt5Ht3JgnxCDT/n1K1mmiXMw8SMg0L5FGasJ83pZ5REfm2Tv/cXJPHXpGm4vD36yA4pWaArNNbHRUOm+ZUaDw+JJ9kn0vpm7TVv7E2
+8wbuYHcrxS1l9NpnUMcMESQjEya30p1lPAgAkGRl527WPkPTvkkWSJCtvTc0nlxWn/eexM7Oz1nP7OCH5zy59rRasGqjsnRdvyZm2z44J8J4ZJ5ieIkgPSas
+YUQtmvH1WguNhzgHcZqWrKjJNgE08+frWJ3/3elb0j59O6vveoci1x1bL9wtCm6fY5Gt1KZglQpAzVe966H3q4dzcA58TFXI5bZqkS+UYAYgwqZGVvot36Xx
+srvWxg3joLSpjWyUF4pmFiA2/cDZqlgwueDCmVtRGlUKpz5dekgB4mVZwhzyi+pp21bS1ClzAbJZfi3VOS74dbm/lWedvARijv2j/LcZTQJEuiVj3DlEtnI3
+l6u1X2ZmnaWzAyzMic+4rU05gVdXPNDR2kpOfnr4nBWZILQ9YOpgcTHvfWtzVlhFbJQ6mOSBoxvfXbrZXt8NLoy1CCp/x2JtN9gtWtGLpcTz0j0DomC0GHKZ
+cu5VlJ5hzH72+g7vU7lKV3FR3/ZVaGAzRetwXKhvLLd9CQBM0c5ONr1uyW5yWmwFF3wAloo4mv2auCjEowCpj91NSaTcyR9RnwjLfv/EMYrSo1ufiSZDjPNI
+/bN2/RfKGy512RWBjN1LYTJay6KNxqtQb2jl5YryzI5kafEPXaWUBxetHlIDf8RYLyhr/P3p2OFieR+/NyWQR7NhjReOzsprPDfDDLrVhgfBEnAZeL03vaxM
+QFBVemYJry97tmCeZMJQFVGfaXB+RDO6P2SlCGZ32h+WG12usHt1boV1/x67nzBZpBkKVrR+7Ik8A3RWZdSVqHBKoNNt0kNrrhOnUYvCD6gZyKm+8KF1KESs
+z+ozJtqb6r9KKdbRXkrvaRQK+DzQk1Iwi+prfNzWgsHRv3E1JYi3UvWOLh8H79RWzZx5wjHfVgGoUhqgzBBCbZoukojYUWsSGmXUsDDt3BLxfLRRHpFqQVB4
+GvfHWAGRybF+DphDkNEMj1eM4z5V+MJZ+MMEAe8H5IVVefyQltEQRLrXElGLZfx27qR8Sq/XlCnkvBzjB3eSa/Fh+8ZF5sliHOhXH+65fFyLM281d5EmEfQ1
+1SEBmVN9dYFJytCPwkhTsqgJo0CV/J8TQNNzeUfXW/h5vtecTUpPoapySiNpd+WpezNjrdhjOzWGMg/5NC3x4AzGHCZ8SH9kHhCgUWm1Hy6JY4+8is04OVej
+P86vz3w02GVepQS036QCBPul3mqP/PVZ4PqR1+C6xQjmyvxBzhgeBlqqWPmiaN/ITpwutTCWhfjE0xlvZ5rhwk2Uukk9VgmgxWG+O300fVlrZemq2ut9r6ah
+O8S1bfyowrEPqUeXURJ/LB+meZOPgAx0s1CEDXaFy1xNfqjpRlOX+JDuNWV3/UHn3X3FK4cmMdL2a5bhf0+raDMTxWSBjd6vUaNmmUWxN1hClcNmLKHiuDmI
+oTXNuKmWYDgay1yXJx8ZxCz9SzLMEmbAHhoYjbGF14SqfWy84wyQ2l7W+xmhJXHMn4a66tnbvwmd3p1DucaZmF4jtmCybseVDusxireGzIAFdml+HAMMaCZx
+3Y6tX6NjsGUkewyzdot4ylguF6RryoBh9FshAsA4VqVP/GrYv4OybYt7eDXL1nG5aL+To71AF4+R2OYZX0A8dEORRYBaJU/A/CdcyRJrxNbDeuSFUvT0OnDl
+VQqnssVZthzekg9pD5DfN7vn2FDzPijSMv43KlLn42FzK/DTEqQo8kOIFy7Iv1Dt9EKVTAIiggkI3eZOyRbt/jngJIvJZesyuVWstcmDtnFZK5/mPPCPVaf7
+X7Zo96qWtQ9yWYcFfmNftBFrt0ubUOA5aCfj0FR7xiD3poYbQEZkgSj3IFOA5hMqw0Pib5ysdqy5UqZWzXwhR+9mGv8+jwYmjY+a/bmyKD/esSjTTQ0D11uv
+omzX414luPnBeLb0qbXgKqQVCsKiOLQii+ioJJAKus5pqiuNYpsAn0UYKNjmK0YcUfJBQEUa+NpRvtBnuU1vC9UixX/eaJ5shUvJgmWNrR/dTW41XNnFnoLl
+zbKlnBTngJrhz04h23ZXxvXpWkyAC90VOWm89k2oybNtLjEGFCxB1Vj/4/SX6V94DKi0Niv8uTDF+uBhnOSD3hHpFBUsxBv9KO73pbHZkbIXolyNVdeQZDwE
+urFOJtDXveXklM4ysLwrDdWPVH6BA1N0UKQ2hb1+H6cNJ4iJxGDfI8BQa1yl73T3j6/4HuSC6I062rpqIC2rIG2vTab3DbRa15GZcHGYVik4deF3w0XKanAu
+VdarDa/1IzkvRXxUvuJ/L6X0pq72ZDuJYNqamp323A143ovxhBrLytzLtIMslb6424a9hrX7DZz0VH/FU8vAYs2uTA1SY57OcFxX2UOKRvISwZz0C7SczCp3
+uCCrVSNoo6cZXkU2+Cn1fFXN074uaHiU/WnNDKcxsI5LPdR06dPTqwsQr2Z5L6MhDMQyQCserVF5/bKnC9TpiSvCwdmShBzy2nsawOxdJ8yJcEpGMISZxi8A
+ixQgkPBshoSkfpvEzHbXMgHGeL2UNkHP44NBW+Kz6cca1NflmIfLT+WKb10tWtHS/Kz1x4sKihzr+8XGlJhIizqByN9NRKSjM5kVTpsjgnCq5abLds6/IoGT
+jSEDUIdVv0sfCAo3v1L3Ga1HzCWrRjVaO1pc+xqLdMF8oD5ekXUqdGBE6Q/hkbKdWWVpHJNq086EySHTY8U7Ff9r+rUFKWH+qhIV19ExBaorqjaOSeEcQP2B
+pFxV8oC61wowonZfF4BeHLwiSE2rlryz/8CwVLNRpRs3/UEkvXzeX+uZ8xlFg0gVp8PA781H3l2ND8cFh+P6X4uuseGKzx6COV3bCovGHNhiXv1tCs8JpSdD
+reNaD+CcIk1FUycesiTOKcKtE4eG2DxrYpy70D+yqLasIueAHZypLP9ta7WfIeW+eq5tcft84VyJiBSXkAXWP5gp0Wm52y07Jw59H4Cp2rTc9ZZp0uRGH0jf
+x+qugtUqHZFHWJX7RqKAMUNbPmy19zuu3QG7vJKZvzPPov0uGBa1y2VZHQYgAyBlCvXPnGqeNBEg2VBx07gnYMr/wrQc+IfhFKUyPctC/kigZeCwv74vfZd6
+3v5I0EB5XB0nKuNY8aDuKGULWW3MitjkPhnnEfYSXUYLjVZjqevBwBNFdoh2Pylf9YMSO7UTP5VZjoZp1o7WqgWHlzzeeHN6ryqafG/6nI/Hr9YqprQB1ge5
+snGv2+TsSR0Q/koglKurz+fyRBlf32W4T2CBlOIo9KFW58DEq+endHnKewwyqoyNweaxr7aFyoTsX5l0h8mdBkrpnRRX78JLqDxeUwcwHdiNqp2MzrNsT6Cl
+R+syNWrJQZ4+VItAFhGQjIgyTcA7atlZLU/y/VizX5jInPuwDVIh8tCwLi+EVfINHOVjoyn0Slcx+Cnv0P+2OvmJmrE6qHk9c28K/JVWH9bUpr5XrNnY7MWv
+Uacv5AtRv6BRMgjZzyq5QnHw1n0uaTZ08HZt6KgCKIY4arY7PqJNZFmZ5S/Y+ig8YS22d2isXHCkcx6N6XqUbjln/Wt3CInoJWVSWP3isEytkUJPIAj0PgiR
+WnMwycBouroR8RtvXCkgiIeXGmJNVcyNI3/yLJ0AJkRzXtUX5DrWJ5naBiuASEI7zW5EbDZpoFJzXXDVO0GpCqP0k8rUSFmZaeog8TyceG1ilI/jIYpXZHey
+GdIEQyJ18jjDmNfWZ1aEJJU0hcpLETAnLab1TeMh4UNCBd5z3E2U3tTEVUfnTFc0bkFeqCOKRaSNAP+xupeK+rsoPT8GGtw9ByKX8YWHTcaJqJZASNcNUIZZ
+wXoFC8Bcze+yl4nkQ13Dp9RBD88gdMtXcs6uOzz9R7gbFhkJv+OukxcR9XfH7aKG8YbD2fujHzHKWaj1p4bVr74kDX7vFWFT5mAZMc6B9DIo5HGatv0GUhiv
+Fq0NNA/y1aQm5CgF/dRFPGHhSd5jyvtQCf7hFSTmJtTH/Kv0bYEfJU6yxXtfMbzbaI222YaZ2iRKYsaxLUnhMczivlTVFDuP9WC/fp2vCKZyNSCdSLL5ipQT
+DfiwpPdKfjwsLnnjx2CXkMnvJsLutJHZvailEbB4b0nfwLqW35X+Xvr+hktTtML+2j3Pm5WpoeD9wrDX7svVydVrBegjksOj7Su12FnIwWgG1tc3bCylaaYu
+fcJl6P454P4buj7EUz6trRORbhrogyqQbMZN7jz213Uk9b0c1U9Vw4DzC9KZUTb1U/m4YiDuVJaW8EwBAdXqFTiqVOMB3e6o4VydQvCIfrKGNVjqlD/rDmR/
+Ut3Hs9GZpa2wjhT9zcHua8km7oIXwOXMdVRWZiUw3M5dLRrVyV3voQes7tpjSbCt5T6kOA9KgFGXoZ4gJmZdvqjUFm1P6ZcheeWcQk8Tx3wjuNoDQFNTl1j6
+r9O/T/+n9L+k/57+9/T/YJeF/5/1bLYtbueqfL/C+fw78dXGBjkeqkxOh+bRXyJQnsUFbDVVlgEJCznEe7jQyDz/la5dFBq+ebpPdi8aXM+sXmB3Wp9DAjMu
+5Te7F2FhZ/CMufdXGDX++dEOm1y6aXKn/muHcTG6eu1Mj3vLl4/bdQ8RqggP20xH5+TErfMYs6KoU6sqts0N35hjw5OQ96iikCJfyh4xeztJPtgIt2QI6jRk
+AABqmmyQy8cpKRpExpZ0jWAsAGYP/fGsELMJslaO2JvS16X3JUjfn/6+65FK1eJ/1I5TzSn3PAXzvMqpkH8LtkZ1E9seD68O3uk8qhP9elzfc2krB4GuCvpV
+7YByo5/qFkOA5oIBEKrArWXBhBkcJt0Z9T8ErA1maiuiemQ1iQryLc1QIiXebHIMiIm6nt070vd5dvgP+VT/4y4adVn4CoHeS2/h6L6yXXfT94f11rudJhd0
+poMn7kU6sHs25XrKFlwyu7n/gDVvzktgQH7FQ2zfcZWIJpFYmCQpUqh9SA25HAlAEDQI5ZXq+MuTvTbxToicKORkQFzq/GPfkN6UPuSIYuFxVheiJD2cjeGo
+yxYpSzzAzqsvbxmiEtWHxmmMQ5vSSIu+rcNrQYE2SY/bTAZQqshiebcGqS1+R6KckNuh8gx4k4MwqM8GixsBdOTUdUl5KKnCd3VE5TdoZ7Tpe6wYrV38twti
+NY3mW966HZdHZTxuawhxDBbIi+x2b5UEsOv8KDy66lWIuDYHxbHe2OTeMPedqt/SYm9k0fc4jk32ny/fQu012hybs+ExnGpxNvK/rzCqluv4TY4B+RU9s/6U
+a5i217VnUfXau9dBV02JGtiPbk7oTSjVAt5vIi5tCSXUCBjSieqgCWi1XEP1EkF0oYBTR3zHUNZEMxuVfLLhjGEd+Yla0BAAEKRmf3zzgvrQl6oKlRfBDc1b
+5p8JDXKgfrwelucJjt+Bey6/KdBDPhIgAHF+LF5VuUyJZQ4ySWaHJE1rEutxAMLKtFNL3TaDyQkH3WxJW/MZEZt8+4X0E5wHWp9A+DdNDzYfx4acvd0cZjz/
+Or2Mb3EIDEurGvEKHI833H8SwJD4W9a+pNt66VcrpAkAYJVONdCw8gX2Sw9hkulQ3iEn6VSeFH90Ii/oZlxYyzgLWAjAwKCI3qpsTEJn/jYvsDvgXqu28eQo
+cU1qBCa03atB/Hq0La4MWyMlLQasNw6TLkP31Id8U1V9GCRdDlz97FFLTgBA1ikwqT4+IU46lqSrsmxfgDnhlMrHrGVxUtoDZCCoOsdQaCBKCJsST1UQNEXF
+HyG0fqpP0kvs7We9B6top9umaharZ81RsNR3OB6OK1fA5ehO6crM+7Mw233DS3ztOr/V9Jhi6wBAkuoTCY15SpXDJiesvBKW0x+UHDPrDqXFxKzVTCCEvo5g
+OGF3qA94K0H4XkBb3au6xKifdZzOnJnrsESPVnk43vgkKk+VfkqCQYyhe+YbQe5Lf3V9wlR+MgNZqxIXK2E5+dTE9kx0YC0NSXYvJlqlN3Y4tagO+2vV++LC
+mC1h9i5LwnCqMUrOFXE1uopz3N4JyohhE3wtYvgyKIeWQkdYfqYbroriozlcVJujpjtYv+y4uEURwbpGf8BVnb/kqk7p7f1fghP3prUqNr/FxaHTCWepWTgZ
+rNjdILO3HerP9PEEw3aj6/Lg3XZrQpVnN4f9qoYA1GNp/bHmo6Fp53HFEF7s2axteaFRhHHIpRoB1KNcUEA+FlSnh/Tg2stgAcibwn5X/P1/itd0GXvzQv8/
+69i7E2muZL0QvrVfeGbGQ/y4pJEC6ntOrP7sqrozlVRlDOUdgcE/sRwYjfLfv/UyRxgqcLUGbsjUjDqkM7REPd35y/lsb0u2sm6GVPEQ3X76Fu9p/4rWMm1v
+UCy817nZ9qT8FxgHbp68sIdWGsFBdUHWh/WqzYiP2/0HTYtFUYs7jo7hISFKAwQyQbWLxRm+Rp+zrfAUrEYxTD8pQ2iuQWEj5deTAPL9LiEpZlOmbKu3/X2K
+sxJseVostajihjlKCxuJhYcFPGHsJcFMq4NC2DePBw1WxjaOPuw3OV4/1hRe4BFghHrJb/mIQZ1zoZqC9XSZ0uDl3weVwAT1m1Z6Cr+l13d5asn5f5/Vd/6J
+8Alu5ZIZbqamuOKVIiDFOx2oGief0Sb0bOG6V1u55Va9h1c0IVv7cMqQDGZJS/AsGs7UVjSbyi0K0kgqiYJzNgQfpfgkPz0RLdVVy25neN/3M4LhO9xl6aeD
+QmqNg93I/a4J/usZkaXooefsxmve1YL8RqtMh43fnM29r8ay7ky6kmACIMDsG1fWponFdxy1EZfeeGGRiz4OKmhN2iyolWY+Bk48xeQE9n4DfznTaWu4g29h
+7Z5vqnGwKvboeStXvN4ezaz6ziWNxs010OB6djtL6raswkAzlTssF5sJCbPppDRvKJir8vypBm+TxjI5VI2TDm2vDaFnazlBJZel1NUyX/AcveROZbZ4fSPm
+pa4HxNuP4LUeGxAR9vfMqpIMdjpOTr4UoRVrrewCB3WlpsdlAyXlPD2kbqiEl+GjMSVZRLxggsdNgk7ayR6EYYNV6pTnsp+socpMBNDqQb0lfaPW1E0zURTF
+JOKoZTWrqzcDu3cZ4s22Duti9W3UV9zOtvNVaCDVZXonqQVoq4HXI/cuqSy4meGs8DaDwEROZYGqqYo5/vtINGiPvswmeBg5U+QV6PNxpiVW9rFvZR0twV79
+RN/XlRraWHGCSvveWuPn9my7l+2hblRYIovpv7d6AWkSnZiyLSl4UTqTfeNXGpcT0gDwzC97rjrlppmOXiaonMT+ur/g2nOlXvuvzAvewQDHg5Ukel2WxnzS
+80vXqj1u/UVSFLoi/Tq2y29n+HW0GuFUPnWNhA44MZ2nTxnOv4rWasYO3S+kNvzMc1LwQ4ro3AZrVsbVVNl+hFfkr6Y/ZP2Gv0n/KbBfXbJ5XzHt5zOwWRNG
+W4480NwwsK/8mNSWuprplw+Hm+arXWzAByKsjCPJ3GqBEw2zSksipwRDFdiy6SccZPtS2BaVZNCkXg+1VyNjz+c11SMVZaWTftRAruGIvt5RkxEzaZUVZaZl
+Be76Wr1gCOhfldkvYgU8vqvIkpWjZH2vX9917Fk7Q/jKhGOw4BkouzxhzbCsPKULWCEHbtHc0m9I2ozlfgUTKwpCNHY09T0v8Vv+/gW9XeMiWHXAwwnX5lZL
+RF7m620ompeu62E9zuW8X81W0jycGa7RvtbPq0YoT6mS6Ki15vCYDRnhBBzb1EY4IsDXpEk4vTLUSANlqOgDZ+bWfKxO+F7L6ncVL/MfOLv/v6f/d41a7qN+
+98Gm3Va02f1dlcq9grKWlthmu+lUPLvK+qbqQOimu7MGzqYism81fFzLDm214vv1KOATGeOE2Xv7VLs5kQ1keEs01qqNKTpTiogUZCCxof+imu06dMcxgtNc
+zQU7MeSYrmjLkec7SFadUkpDuCd/15V3Fbk0W9hBRXXdS3OsCATwMPtjDApezRGGdEqrBJMcAZQpeBCu0s68tlhEUzh9tTm8Dvs3T4rbBjl86zXZCXoJHRUW
+nSxMKH8UG7elZ7hSI9ihLBHBBEvYGv0R35M+prwRWd9NHTAQyL3sXiiYR+uOGXJNOeHlSL8NUlHl+AApQzjFwYMUcQwjFYXU8mUwldGfJBD5RX0SGfqckwiC
+rQLn9ofSr8jd7rUkwqUscenOyEoEm/J87npXyBRHv8g0UYePJfX11VxpSM11N8IQF93dEZoxSCmlld/DVfof0u/btYc/dWPt3f1ruWrNq/xrH832jh/kRhPa
+lfUg4W4MRAtOYQoIJCNx4ntqiwrONQ7it7AOB/+zcv5D2I8LnvHVmYJ86BJUDepunsjqfKtIkocbfzwcs2PuJBjerw8uRa+fo3BG1mYu6rWikB9lT8XJaQ+y
+oU4oji25vGRSv0UCch4cOaZNUK6ZwjhZpphr1BDvRkqdH/vbLjiL/0jDNomajLO5tLqC2XNXnVlezurVvmdDH3lcySX2zmliCN7pPHnHJ4XZVWG9+IHa6fjs
+0iJDxKaell7f5NStq4342Eh0o1iftcvNBe21M8jzMvnKb1gdWsHJMkorllSxv3uqgJL6Vr03yM9E3mMfEs+LBMte1HhKrokBXd1crvG7G76RXKWzBBlE95xX
+uYplgXNXy8AkEvz24vUC2dlXL3gCIKTLl8s/XTdPaHS7njjqsyDm+FofubjwrJ+K/AqVCVfQZ8M1rZvmNuwdOjpPl0dkNgzqwptT+R55549ca8vyIOhH6ezg
+zHu39SM0peU2txK+1E/OMqs/URbRmteF3HWtFbRU5YPIEq13qtazm5uULJxNkmuZJGdJFm4Xxm5Ik8SWER3ykJjDjc2GQkSKJmGR20TyhQ+dpgk6ezSz17SK
+zgzpM6onVMZm/G4X+/1zneif0lH1PXcd9tylRRZVVR7de7m512hdHW2/NcP28KZCtuxlhxj1KWf7bXk1DL28/zW7bk4+Rm+acSvkfLpPH/cO6l9WltUY5s7h
+GDAjAXN97vsVjjmul1hZVYM6cIXWOagejbxSGSfhJ9UAbgbbP2flu5lmEU+zwX7IpGwWUIfdMypMHH9R+ZzV3EmGteoLQFo5vlpqfH8pONZ7GRVPOxtFZenW
+Le+GvqrXWoGvM3JsFJh5RG/d4+WwuQufdxJC5gUZnlk1s8bXz7p8EGTBT7PeFr+dcOiHc2iTzVPSQME7QfVj1I36rqAy/NPCFI2e+VLiWGsVb6ZVzbFCJUlL
+EtEYzWbt9uWjZ/UrCjpjWNWhB58Uk0CuyORNBgIUjayJRW7QlaaNIESIXKYzu9mcBKGEAICUcgLNq76Rr3HNFa/W4tr6vit8qNW+spHCkPR3nvhf5Xifmnzl
+nelnfRXriFW4tCKeHZ8+WtHIhBrOOZ7q0auvlibzUXUwDUJAq9RgE8KbxB6EPLJyAc+EwgiQjbp9EjhXBCMwsRtzwhhbfD3v7t+RPqmOvapRLH6X2iV3M4OF
+IdivLT6Uas1doyHQCvsQuj99FeVBINVwl8xCjZel6KrV1txf55AwGyXOPElwId8sqCvXTOPWvzZfbW4b5CRKp/Eljcs3fLnIvQoKaWXuct5vhSTBpZE3zho0
+qIN7clKSJlYXf5zd3gZrBXK9rw9rXtxUqoutsiaVAL98QXq9e6l+epXTKL1eOLbqJodO3uVwzeSHen3qXJ8IFFlu2kwTKNeBqlkv9ZeYEaeUyQIdL9GHw8KJ
+ubEnVHjybYTilYba4HKUuM9drg+sI2H0wJAkGywjNMh7lFVXLJsEuxPKXNabozRldBUVftdqZMRknsBntFOQM5LtSx7P1fhspbUDUP913ve4w7eW3OecENO6
+CrFms1fkf4RP4gwdeEk26YydaQR4t0rEVAfAlHLQpTZVgtGomLUjO7JKCS8eUxdxEusE2lxMUk4o/7h0YzBjrZei+o4m41o7J3FdXWM2dhvXoQ6w5rZ5+as2
+496XcuaAMBhh1KQzB3Uo5fs5SocncdbX0TOEbGT+sP+8i6PmoHe/3RsO0YRNZSzqH7npjAGFkRSGSgcqnKwTmDePKx+XGTlQnzOlWE/dpfelj6Yf1PrA0ihu
+6yhyGzQMXbALvg3GXkc5ENfbzY38fMbGuRmRS6QTdoVyseWHpSEGOpGObfmuluWIfzxLPRYh1tKLY3mpXse/cj1qJ/yx2SJKzNLe76cFOP12Uszawp+bUkpf
+E+7/u7mj6ro92sD05eAzIHdgcd6u9rv+5vvtn7yLOfE/rFU7Mu45T4BIJ4f4d63Sj3N87by7Q42TD2dKsKESYA8eW3nWGvA4R8GF0xnyZTgcbSmqAzLY6aKm
+9rTIg1haoYl/F0c95FwvVA65qKm8Kmim7bH681xaC9mhOaYBdNzecLykr1/wcNu6XjxHh/uMUVu5KgJg2HGmRcCc7gGzCw3Q7YGnb7Bq48JS3Jd2rAJwaQfd
+NvfR1tEm0IuPq5IEhASRQBXzQGFWRPn8zimbPJDAnAu4CyaK6/gufR+vY91uzv+dZdKtr7oSWd3mIYQkpmnxz4924/4XS+FgeblHP9z3tvqQ142udOKtpL+u
+XJtNECxQnVrGCMqBkYR02w49kS6c3uXEFs7CFVgSL+EXla8TN4vKW2YcQ9ur/HD6wXiitTtWQ3wJZ51CRZioVS0EGqjyijVdH1JGx2AQAGQQLjdqCgBN5woB
+Y81B/f+JhoYH2px7K55Z5U6l0FsZ91FbZGy6K+vdletEutYlJxN9ZDlIOLcFAEH1zpY8f4FuxG79AqBzq5zavfyYPlEdFA9WH3nsPDcDmmNWPxr5b7PSTO/A
+2pvUL+9yaaBJnJR5cZVAFvzCXyuz8pTQdeRIjdaBKMypsmpSNQQ6mFKdTy3GsHllbKy+irXYPc5Dq/JrVsfRj1DS1FkUPcukgglJ++aoeDM9YMkiquUKV3Xd
+yxR7E5Dent6V3pP2zOP8mPLHfL1UHkqzJiKusRdeYG7dcbx1yrUoJsli0cVBWa0CUfCa7uo50Clp43qo+bYbPNa3KQHgkHQoEAEi9rfEMZZZFqZguFfb5WFf
+S7nO8K5zftPRWM05cAgLeWmWG3jAi5wUhK0NMddksAa/ck+afIV/zAoKwOpjObV+OsXXXVBYP3/tybjTOF33bs0rb1shorjFHbebY+X/HfabhfNTZYFQnqLm
+IgDPnadYb+OQonObCQoYw6/ZC1+uGr+7VjlnKQR0Rg/vh5u683cE+fHRgStD9qS6aAMC5laSY2Kkitw52UbbndN2+yCbUwsqZNkGoL1mduoBADY9tj2rT1V/
+tmuyothRHY9G5mPagOnEmR9teW62l5bPJxOKzxy1lwtmuU3MQGdyIhGrBsjaBzolgAwkq58/ZWi8yD6WfsRZRr/BfcQ/WTzDW2nM2Vm+2YZl7srXNe5tdTRv
+rtDCNMvWTgUzVxfhRnVkSBF0OTUWZtjy37Cxfh48Mmp70UHdMq6L702/kf5Q+g7Rpq4dmmNlELZ2v1stnAW+jGFQovDG5tBij/XLY66YxTsjI5VPbmWHFZyG
+KjiGIUHI+NBL38EJpPM1YVN/7LKoSeGquGoyR/l0gqwmM0gyYHXv/IIisYsSXjKV5k1HRttsXdVhU+eKCeE5rCXqPGyCBDRPtyvDsDWLcPE/vZKILF4pkZ0J
+lBMKML/sMIQqkjWLrDFOu/AtPNeuthLo4l49CDEFEWhosRulhtkq3S5XMK0kc1iuXF4ao5vrqpla//BKJoWrzni5ojmhFG4olDP7caCFumbtXX08/RT3T1VZ
+MR/DfAjqH63YmM8yA4rbZw5Rdhfm2srH89zImcPBq/nA7cB7nlUaf80Vdgm4LjWbYdgdVWrU1pinu3yixzQz7bKHJAM8m1wUeoFtvUfjUO2ElC6Zum2f6yhX
+L8XecGd/vHV9O4bdC5ynrx6HBVQbVSEDCr1l5rqUL+ocAK7MgPcyUj4uIAv26+6JdTTfPcoJtdO6ZTiqxZjb9JDy+Z4/QSZBruriTTBBnOuhQzf5XmEeQTTo
+MX1KuZ5UTc1AcrfSpY33Zh2Jl0fpM44ztdtN6z1d/j8G6cFwk0Bc1Zx8Pm9XJ6iKEoOna6liBIjQWc+AukXyh7Buv9nRi78qqjVeOVp3fzwnmyNf5Xr3mm/t
+rek8h2udmtQ/pwCLnr+RIe8s0oJJL5SlWMotjFv8mXvtxyUCpHbdCVc53FsNEPbO37jQgvf6YQv3btZftCtF17xrFiK2S7BcoDR+2n67SdYw91ovxWMiSLeO
+KLTqejWxXY8d9ue13EStzk1ACx6I3S2LXuYTwQquvUc9vqn6+H9WNbWjyqLgeIJW8mu5vrFBPHEEfNxujm4u+lQfVYWqqvZXvrXz8VnyyQw7lYXIk+IjGgpI
+rgiTQAp4ZAurU0kcyxAHmCyC8pP7Tc2eXV2TqtZfGc301Y+g+rnNxrKMThm/O84thcJ5zdYuRg8Ek5s8gHdvxdzhup0esF10meiELMmjz1iPHnWMPj1Hrr62
+kcmGQ6jq+dH9e3fNMAwAwk/UHv0Jr7rsLG0n5mxAtQBfOOM+6Nys2UpqrnTxKseGEVN3RyshNdbQkR3DT61zs8G06pj1bcAuPYxvJ5vqq35I6oCA5ViFw5Js
+LgxCs0HKFLeg6o9ed5+qL/WaVop1X1uP1bKDrMdanVvFeWLRj4ArL8wQniMe56r/GsFDAoO4X5wuqQHBDeZgYeYIFjgGrYESUdRdxM7PEhWpLu1rigL3EvaZ
+arVNCZwQ4XL8547wMntW5WquOHHACt0KWQNodEzEx+HT7hr11eyPG4drvar+cxriXnWDCVaJb6cJbSo+9pq9bwW+G5B0i/SgabyUX9R7GZQ1xlAx/Srnu3AT
+YjmS285bCw7L91k9ApabqnGeJyvLmTrWoHEGis3cVfN8MFUYe22c26v0AV7pjX7aXXXa2zfw1FAXKivgoNf8Wk+LyngLK4DaLrp7+D66JupumjujCnp23fwZ
+hB5XawCbzmPmHQu+P6V6+Y/TP3VtMMef7xpUtTUaRfL2q43cGow6/2aJzraBI3QfZlydeQHPR3Mf3n5k1XtqDl9XijSf1jzbok3z1cFcTb2xp6sGjvBLei7J
+/uvcKqcXah79fCe5r9WavSyCXm6a0SPw7umlswnAG6o8xg9JEh4E0viO6g74kBYHKAcNr7Y2Gveysm8XbOXPKEb9q6pM6EBIx99kFSqOpmpmHgyB+3g8twKN
+14EkqMkqqXRlYGdUvMzJmLyKn/GDUH6qwV7eh86CVO485/Xi+Hp0zeMgQF8LGVrE+Gqi4TKgodNw3IZ9LvSSHnEubleks4Adg4SQgeeV2fkgGrf68R1PfqQ6
+pse65/sVt6v8z+eNlLXw245vOVS2aysoGFh7VyrlQpmwObU8AgPVhae8m5N4hqdOXHQhKD4l/oQD4omGdAIaxF69NLhggMkwaqEG/h0+Br9kikrG9x7FzaBW
+xxZmw3rsOq9nwouwId3ua69lVS0M+LXvzXX+EMwklBS2DdDl5jxvqpISZOUv8FcYmpYTWb9rxiCH4DaO4rLVhBSNz3zr8vSHrovDq3Ad8JqOcD9uz84mninN
+NNq69UuoQymNPlgTCHmrureNS3ZEumio75e0b9EnDSVbL6ORUWI0GDSFaFRtLBSbgnSI1i2nVDO1xoHIsBuWm1dWcXTqfM3Z+dFAKutV9W81ZwfRigvR2OV6
+hfe9GVSE+k4SiF0TbbFbG39W+191jE1NDFZ5WJv0vZ16ban9aVi6Vnudw+arqf6dc4HePW9VMFMsYAAQE8wA4apkrQSiGPFOnedX4fjdNwzSGb/v1jp0621v
+UnHsKvVfRck7dzXCWB0sg6DxzwIh7dLYmYBMrQ/6Uxw/YSgFQoWbXCoP8dqTd5jrlhWEVFEb/3Xn+FXdvAXDcY2NFizH/9YSoZ1Zf4QOL7ks2+XKK7WJM0DF
+MPBwCbxSxufxuZgJYwu64ZvGGqvUEH9cXdvLzPxbrLHG2qrWE+KgPceupRmhVVnB0C1TGLVry6w6KKtUeJInGafFvQvTgfeuH2WWcq1ANzoaKqJ7MZy4vRQ8
+VEJks6+3+1erPXS+AjNpFQUeEp14GgCCwJWxjxc69B6Pj3AkJVLwfy6uO0ir9LI7cnj9wfYu3tDnWD3u1DVK5M+V8T2+v4tvDlwbls93+pyMiuSDpMMzpIsp
+38Km/4amPvfxri/01dXoluYKV+tsLY3+6LFq3ZRgFgFkZHfZa5ZSmCqDosl4wiC2dQDRAvwe3V0st+N4/KuIxceAdxXRmqUF5gpGeSFMz6F+C+diclGeNHL2
+Q5q6kLMMIkG5/SfTaLkcml+IV/EZxkjdN6QXfO29kN6aPquqCf+w0fjT2i3L5UoE4RpVzz/XxmOF1mfz+W3+j9AHOfAQ1Q0gp0txgjg3grieG4bKh8nEAZTm
+dXEShl2rVtcF2ZaXPEvemt6RRtUmW/aYTtnxPQ3BYDNuIl9nISI/LM1hd85f9l1zdQqJTitsiqTyZBUUnWfN1MzBYV/fvMbXomg05sg4JWFwTDJ+PqA017BK
+KaWvD/t9iVtfSpv0zvTBme/+z3O36SvuCfwvUnoaxupp685thqgdNuWGX7Q5+N62vNtfSBLlRU+bqo2li1D1H2Vu1bQwY9e2bNNFTbzF5ioOEZYvMYwjEeGg
+loB6GlCXJ6KP7hvD2BaXapmF0Ym0xrH7hUB2FPbUuTD2yjNVTo3HztUYykqYdTGW5ROknAThcJX/c6o1UaftD5fLqSu0c6VPqwLG6z3OUy+5ALO9JLnkVWwZ
+ZiBMKDtn/fxDpk2/UT+v2Kc8Jwk9UKbBQ7oQ4J+wjA+cwCQ8ykJ24+4VXBwNJ1+0uk5HX4W/a6pEDbY4gurDMWk4T/HDeS0zqKlDuMLOjVbcjSIS0sic6l6/
+2DfTjpDEHyKs6y6RGCK6IcUda3GwNDDR2ZvS1/l4mQ7cBzsdoojZSdmGrQ6e7zLxLKiK/stnQD62YIrHzwKJ84FskpQrmloNj5x8eS2eACyIgrLx+CsEYzHE
+LKACLtrt/+90egivaq/xfwgem6qMO66FgLM7mq1ID9ILNg/ru3quWj25qWkoVzHU4RWfJrsNZhkP6gya7OCsWH0eg1CCUNwoaHta1GNEq5H4B5HN9LXSTIPo
+QOcZh2mVvpMjhcZz9NbZf6PHtK6IJuN01i/32lW31tfXmqCcpXc6b2TVAZQNOyvJiS8lLrbpkQRbPmk91CRJscKsDfk5LO9TmUAXX6kRruQnzTej5gOvKFah
+9d2uu9bWpIUudO73kUTszsSL+bVVGXgA6zLeHIwY0Zx47R42g+aTchexP/L0KQQ3bOEZ+JAU9YLzCFbXYzOVfeuq7SDlSugvb+pfJbPs8nCdj7wWa52ibmI2
+bMYQro7BIT9/ZMTkY+5qPNUnm2TWURmspjkMLPRPccvXfnQSmZOK8NY9XyI15iMhb1c0pdnoiLdNk3vTClg1o8b5z9kN08l1NJHqGjWpQBj/aHlmZVG+MU4r
+MCifyYBUX5q0CQiA4Pou/LCtZvWLzBNMXmpJLU1U3SpBNQs2wFDs8RSfh6Lo9TPplxovzIogaY2gZiMjB5kvOSPh3M5MViVxPOr56FykEiwsBvLiv9V7ngFQ
+BJ5iObVAU6SBdCAznNpT0H9yaEevUTJUcTpNCEhHVWYU1BXXaxkWvQdTMYz54+/2FX2eCFX1gAXPfe60R+PxTl8tw5cb9F2fLd0eD2eG8Ka1U9azkx7SgJZq
+h5Oe+KhQzGIz4jjF4RwIniGszMh+UMbchECM/zbVY62XqKN3zDIfkjjgWD4U8syXglaDqUR+On1fE5UpbmTcuBeZbPsHOwhMVNzOgsfHb+cU9d2FyTiBMxX4
+0+Se/l1LbYqVRgT1slE9BMzAWlQlwBgS6vP9+EgdrrXnS23sUbRGPqMx6p83XoahWnFOdEWxTcH1sOnP3h7H4G24aRh2m6q4uVMWxtEIdofjqtx7IHGFR4rc
+gYxY1xz2qKXqq4RAD3XjmhOAove50+I6KSn+igbQHaSt47+U3pY+19Z6dqFaPVZ/+PL+GtFe4zGMrPzGjRuZx04kVxtV2+wSSG4AksE7QdMesngW6qqiK0pl
+BrIMqpsQekIFfxMRciWeMISc+QpsatNjLZOgdxgwY2mddbvjGPqYNfH0WaaeAHmGlBPZguP23ms65Z/btWgcard8fdBVxmxUBiv0+4sBATW3CifuQ0K1OTAZ
+RKz2hDLPVumUVg6JI/P+RkJY3NvKuSHat98bKo+Wb/p5YeKIAlRhRUfl+TAEatxENk/opsRiuHoCd3J7gdJVQRQTnWhFBCT9NDJtzRCveQVyslIFf8hq7SWD
+ma1SOYkuPhKhEqY0agunzjMygWoZU1yqi0l/8mfSL6mbFxoS5avqnVxj41hemDsTx9XM7+uqpuUqLdk6UjayJfogX9e6XHZ6BAl/yR6EIln1xf1M48/9y2FE
+/136T+m/hHpHY8narVdVedmbdRw/yWevq327kOecblNp/HfWR+CG/E0Y13uTuPYFb8iyAWYM1jB3+HDGusIDz5ra4jmBlnlNNTasa4RBoyHealGqlrqX6L+V
+ESdpm5Gq9HvGBgDRc+BFVwYzbph41P2cR95/4f64/4nPI9ei4SiG1/F2fz4QN+jZOhow+G5prunlsS7/uMGWUZbCQXX5OLIAzt2CePYKFfqeO28cxbvHI5gA
+IQcUu3rX5CTgN3hIFJoVvm8jIOrSsKmvtdQcowB0PjP0pa/F+lV0d/sb83cTHT0TXRgbtyKzGnKtiufdbCyoUrK8flPCKNnoRc79LnCrWNWWBU21JlcVfM/H
+BzbBy5uECLz8p9DuONdpXNxlsGmXo4cK/stazfKiG1p0CX8h/Xr6Pc4hy2wuu8l/U9exg5voHbovqyqZqJ/chnbN7rkK0btFI30Lvg6tPoYXY3VPaVY26ngk
+1H7vmRZHTs2HcgpSncRwqvGCIQgn2ZwzByarM3diHq0BaKqQYxWPanPzaeMl+i1+R6pmd+9q9me85zTuotnuiqx/o9OMWtxtit3HxebdTNnX/aab5OpG5/t8
+b9FzjJRAxyOREzED0lPQwBOBqgSMahet3+GfqJtLPTKGFEJgFgPOTQ+rNvKgigSfWXmdbn/rJFO7yn1Ux/q6Fs+JVPK5iG6cxW9SbdupXPq9fu7juAmWo7gu
+dHuG5QGhVmyzMaE5vSUigwp5yc09qg2e4CRvtH3hLT4mwksVR52+MiJaHP85/df0v6b/I/2/QtyxiWHFpVPP8URng47aFebuAU9jVhaXLTeGG9Gt0Jz4D334
+ESfsgBH3O3vj3XewaA1Q01mcEgAQPvMe9JTks4QjqevdaBQypGZvWApL5CQcVDzD+qpdx7nGJ+3ZWLqmJRb5Hd4b2trxZhuM+7yOrJwd9kSMQfGRNXt5Ii+X
+lMMObJbDO33cNHqA1NkFSBKTVhHF3mTlkhQVEJxaavXjWig4RTN0gOy1qvI2tRUGcv5OSm/2XERQI68oWvLzHEOX+O330l+kf57+Nfsf/rf0v/VnnsUQKgx/
+HKNE3SzAvm3zi4JlMMyDWSio8bczYdjRkCfqE3bVPVipdX9czFeaKms49+DUzhV9iLqLIGS0iYXZkuWcAE9apz/NTMpqyL2yGwUPyZ19qA9I1KVd9ILq3G31
+tERVrWi5pFYAtBaiD81XJfUIItgHlYoyUNPKxRFWWuyDyYlbpjiTPK7VU6lGvTivUzXwTC3HY/kZ0bkijZtK70t2w5Rd+2/h79+4mlr9+5/r5ba7jsGRaFx4
+zmoCWQVeGn9l27POfGfiNUggHiPySExiZsnxmV0T6xqs6ov8maDjzb2Y1umj6TPucJd2jnYrCeXOqlRz37ZWFn17bAxytIdxb79MI3XO8MPcIMhNQE7q6onx
+uBXfGzDZe7SyOy3YVePSV+QDbD4UpDyKglwQNeOxivWXSx+bTdT08J6RqMZOeiIFFf4JQeVZcvj9Z3730m/sflnrZfRN6Zs1Gi1qiN/Fq7bEo192/EAtEJp5
+zsHk1xTEFcydJIP11WpSJ6pryu7NfGyP0q1juIDU7yZrnhq7emr9q0MELncsJ6xWPFT+nyi+Ujvg8gbE6s91jwLWOH1Pel96VfL5retOj5oP+lUejjetxEL5
+29V8oVyEnlj1CrJvsFyAKH8s1T+24mrkIzNVJ/nzGi+TL9pd2Lkg9mEj0dQVmu0COxD9cduD59eWDbRRy1GIfeYqYFG/PglFDWq75I0rrrfZNIP1ele6Tl7n
+/Ksy/ntFHZtzSTfFel7adr+OCrJjOUPHnco8ZEQSBY66wavZjKwjw55milNGbpWS9gBWeBLF3ERkWthl3aV0m751WQ1JLaOXLqAlNDUTpVM14hENf1acDym9
+XTTcw1xYupv8JzVDpoB+jm7WtzJI8Y9YpSr2J4CmCeZzVYgcaHcXgFRsv9O/+kbuS4S9Y3FMsnWwnseBYNFYQEJDveMDnaX2Np4CqRvoTKEFH96QIFgJpJtw
+Lz6q3juP349xSXNP3GiCsJdUqLsbM7W0rnqeN7dmwLBmpzSwxMkcY/F23vFe5kpniZBVp/rCX++7+8ZaRVsZednK5eNqraHsOh/W2+4SdOpQ01DE4LPSXYr8
+1aQYHfaupiFNaYApZUgpYgPL9XyzV1I+2mksCcrysbsTxQlezTF9rBeowevOSyNa+mq6X/Ggm91GL1SguxkxoTPezt41dnkBQqem2OtLlrcYh7+9xuGPjYRZ
+93BGuLasvRb/9gwK5DPeRixzbSFcKLXq6eSx1uxixMuFmCYu+0ouC638PsnpVuEairb6o+tMdTwX7CriX/iQwK0qFlZS4jOgs6hovPTLGqrVu++Q/nTj/lD/
+vH23kniiLEiM7A7rXhNhbFu1jTZ+STNvWbAf5dieKBYRCKBOJa9GZMO+u8WFDLocOvqU/HR7/qvnvgT5SOajUb3ake9ROclLDHn5Pt0sChetlpbNgFGiiJZ3
+iwU1IsRmny73quwO1+zT6wAVW7tsO4NPFnZmcPsWXN6TT0maz/JdmpT8Uuc2KGpG/7bNYd+S8iuVatvaz5mjgyLe5OOZS7uV165zajL4+kW9+Q8GzqNyoWp/
+L/ghS0r8CfNGymbYU9e2ez+03rzfkL41fVdwqrPe2u/Ve7MQKYzz9PDV4cJTIfcOG/bceHZpxhHUOmldBogQOgxzg2/sgomlGtgll2P0vLGso8dPZSM+bYNg
+9n5eWRh167g9LgQWD+Y9CN60c8+rfqsGKC8Fir0XqTqnpm/99embm52xehOWE/nnz1+VlDT82dzRsM4YBm+9gLzhOvArWZTANmOGuinSKukEZf1TL1/wQDgi
+xeJCyzBz6seBTBFM6F9nKiMocWLM4X4o/YQwbm2Nuwazw3DWvv2bUWi9w0ZWXlgZtxopa/bWzjDeoWGWWFdxKfmW9iHlFuNDGxQDAFCsa2Bap29NEDQhHEBq
++c+lGRteHmzXlmfmQwLjVuBSKKTfi7ixjc88cXT4QR73hT9q9/hJbaQPiQJNqLVstSuqkyt+PFMhyhD+dFw4bDlrYToqhtrE1zO6slSxz68azvUMU7lfH6y3
+opLXmvOtx70eYUqKVdvs8lcbThLUmILy/JQdEgAyJCZRFhw8g6lolU56nAWtO8sxrP7+SDQwyy8MgOxdif16NVMh6HYzubCsmStq39XEBi7kGIlpNsjBOJiy
+QItFLPHeh7iCKVicX+cr2uQzFyQFITmxPJoTQ2+tGfWdMqVIHJVIc1seqQRDQ6lprpfc6XFB+yMoe3TTz8NABDB7s9jRzij3N2v7hT+2Ov1Fx+OTfGfLnn69
+gsfdpWngVkjhHq/OiXNQn1sZ3C0DhZ4zv7kQx5lJQGgA8lrzB95HPsy7yPecU7+5vNudIWpeqAPVLnkjY7O08zm9sslL3q59bdOWl/gqXdzpTNzvsOxDmrvF
+ud6upYNVAspV+T17K+HHA1ccwLS3YPJ89tcTqd8ZeW442xOHfgtVTBfxsIhyeaZQpa/1J+CY41dVF0Wuen7dFeWzDZF1NWyMWUCWb1rQvVHkun7pP8Jjediv
+ByyX2h4IgV4MCLk/Ik4EZJJzrVgkeZdtcsrICp85nEXWvXT4Jx7y1t9q5fWQn5Jd69aibOukbDdnpkYt+MeBkZEqi6EWYNdbMfAayHXDqT0S69XHvQeqh353
+/y0HRTE51Yos0ZSGHqdrFfxSHxAvpzr7+7r5hdNnMT9Yb++8sFXm/t2C1KDXzfuzaJYjSM3B6z9TU0Dvj6Yu+zYIY9nkqOm3vi7gwct1K29Ko0117Dqv7RK0
+E2x2Sx92adWY2aH9spsgyJEQZ1wAPVwQIROc6poYMDTNWkOdqYrcIEqHkcLEyNgNUKOx+y7OJUVZ6i/c6fHS7tcJ37RV6LmBnZ/VoUqjhWm3NeDfc6fzScuj
+5eqb7bHU2y+kg80bNY3Zfqc8V+uWdqbZdTiTwAxiw3n+Lcqp/iI7eKR8brRmTRi1hDvs1WVfyVNapBgVzbqaBXjlVzNEqvzKDPPoGQepumcp1UlyTR7hPaTy
+jhnbocAwlwSZJqVz30A43iOkISGGPfLt6UOOwLvqtJxZ/41nrP/WKtuwKlcL3QRQ00/Sm0VBWaH8lbMzMQeYwpLbXyaVkaKwJ7yU3qdY5h+WPUGBLtcGZJJ7
+WudIYoKqXsQfvfOwEJUBqPJ7Ff8Kjoz9zlc+MEsNySBSE1pAHuPxclWf0jjnx9KXIj5SbsDdpbs42mXzrme3rnzfbSVm6caq9qBnt9H7GKRmWvJed6rZrRS7
+TkSYEhDUKLRc6d8JtbL3pG/VU61c66UKGddU94wGcLGp7Aj90SSXLbjb7+YFoQGlPCIhB1XVfzUWn9W4CDJgTicAQMwYWc8ATT/wXel7itLHQtYUdhfZT4Jk
+aQ3GLOSoEMKxe2UAGPL/uuPWjQUztmcT5PhVU7198DK5Q5wmOYqwBmRWNone78DqC9+Tviz7yNbxTnKBu6o9wB8M0bPvQeuyP9oRfTd/dbn8o4TYrpfAOFBr
+gyrgU7tB+mzujxy+qdgUg7GuWvlWe31vSnfpO83byGKHtYuRyGahDvB2zYvXO555PnMy9pCABq26Sr1CpqlcxypeKf+xkwGcePeQBKpegn0I++LT9IH07aqN
+lPKZv+Xs3+hXKGyke9e83W8yLf+dE60SnIqoh860jEt/4gTAAzB5fvfE627flL5fqxAc4TV4kLow2urQ9nKVOJSIbySr2cguKFneunb5HETrJVWKyFpdObJl
+DPq57vFY7b3ntWQCQCSfyYRedIh9A8EoWW3PXIl/4uKuaNJqtwErYpjLfEH6spYfZqe2OUQAArkI3ZAEPEh8eHvEUrfKISSx0qJ6SFHaksxROsbzd6F6ruzX
+bbDKHvfVTnrrrNgLo7HrIOpVxow4AzUtM275NJcdSxOQg/2AcFqhu9ra/12oC1pv4BNXdOMY0VPta6qHZXbPp9khZsdvC/UpX+ByDxqEAzIo7gf5/8EKSpga
+Pb9ylglLTBgEpYv1c1f0fsNWIn+3t4DdEYz57E1BQTshh83I5NLNYZ5HZIZFS82+o8qyag5mCUmW+7yAgBmeGXNCfiPXEso8JiLIQStbYuXvUHfGX0q/ryyt
+yzFzI/ViTUoJJRXFqTRl+en1yPJEY7CMis4oPQK0/9q9ErpqlHt0tQ4bBBMStAKIUf2qplKzGM5+Y9CW4R9Qu472Nyy8KS889M/LWp9lIdwrWlfkLwM0VNTf
+EORqTzwf+xuXgizEiZqFoA+C7MuyunWoNBuxYAdIDIIz0oloIoAVIlHbwJA55mC51zWx3w8oo+nxVaLxn+GM5qWLDhN2UfTLd7l57g0Ks2vf1LQVeR9Da/n2
+S6a8bMk1zEJ6/qegrMqoZVn2vvf7ziEeK62K2aOZ56ac0CaHt74N1TcJ9G/5Rgs5V3LuDZtDOE+ma6HOE9JJN3ZlFiKWcSATL8gI9fyuZN02RyWe2cQOcXry
+lV8ktnH8YP7W4nxeSd/76FhoWL12P/bDWjGY5duyyRgn6Ti7zhNqHU5lJGGiAYCAzqTcXHvzbhJfY+ZjRmZ9in2DTzru7DfOx5VGM3U3BrlXXIOzVMjBPsfd
+/BeMR6+rVBUZaRM1kTEiABHyNuf2C1PMBwgAYAWmrBlyAQ1tqDM41hdNFgR0ugkFa7NP364ocsN5BA2PC/dVpPm0X3RWVDTzJWcPHFZWWpLFIBInh/2NggTu
+5zN9UJwtcQQnF8p7IHg7YiIArLSkWcd2SNBqh+aEEmTUoyQDyPhS0FND5X6WWLbEQeC7fsPxvLsU09aXWX1Jpro2SUuI5BKPVrfouqUaDamKRHT2rlQfWoxh
+jZFJBbNX5n/V98rdWhbnN8c1efukOnrzOt6bTrbIZogaqGIfuoQmBPU1LDjIeG2yKoypOk1OcpEocp/8zYDymdRoG4EadjD/NMcEgfXUILrsDXt/g7end6f3
+6Yz/hXDm1UxZoCmbUa58VGPDngtdLs357Meqzuj1G5M2MxtZ0fYxpAyDoknb3wgnyCARMNoGnR0iSMb5QqOAUR0I67uSDWpjH+tvHu2fksGQCYAQoqfIu1xV
+wZSiziP9VZJyvXO6P5e1AipuFETTylUUuG9c5MqUM5o7/DEOyNHJlKqEtV68bAvYcQFqZwqm5G1XUQwXKqJqpwM0vfG3a79NGcWPnel7VzPonU5lFtQfPHJI
+MzZETA5vlpJUoJzsUjiUkwL6RNkqKZK/zY87BPc0FdVglJNhAqLsDC/IvdZVwRh/3nXOH41mru2oym8wi7TCoi6zoCQIUdlwqf3coyGmZN76+hziUt+Zllur
+z8yk6RmZvGbEDcT7X3qtXwrI88sxHV9XcOQPBmlWSOO6p2wFR+Gk3x6O2121iOwPyPlIxLpfbaWkh8QhnZbdNfTtxgMAJreAwUmU80F2khPaN6hizknrNR9V
+RjnvgIcqVlYJfszWaQU9ahdFJ/hueZMoQ5qj8mflfNBDGpIUNhAQpKweSogAQDjoFqD3Uo87Aui5T2FPgJwyvzjmN9+Q/p4qN3z5YiVqJ8da2NZttQsXsjyx
+FibRltU1pMe0GY9PTGlD4p55PcpLLvHQIwAJUa3igbMaDUJNhEV+lmsFjUBZc0+PHs8ZIqaqsuwrti/kYoqUMU3+u8uroapRhMqUG6kgeYEK5sUpHQYj6KNu
+b/pMv8/5x4a39Hn1jXFeeP0Q5GaqRg/fxOqtdq6pwR33V3PYr+TjSs4RQkk8iP9yoNizIJDDRm4X2t1CT07rNQHX8AKCLfI/fkTzzKv2I6/iOyamYeGOlmiV
+H7QnjdVrnqz9HuRLU+ahTke/a26lcDan5J+dPEkBTcRyagqOObU11KIjWGrGn7L+2VxB8MzmEoUXdzOZQClCNtKAE7UbjET+Q/KwwrqfrgSYsdtdemvWKVl8
+EfebQ7o3LnrtrdiKkykplzbDitqFbe7lguxdYQ6Hc7AH1ezYuKK1R13lKS/XrbyGiKH3RoirBLBEKp3zEGQsUEWjiDrkonPYiRYB9aiS/rH8nF7o+sbf37AP
+fnmxd3yRI7W41XGtioVLbt1ZrBeEkUVv97tH42OVINHE/MFV41X9HOAskcr3PJllp0Atdg2YFsP5rvQFzlBcSeSR+nVzUuvpZJqiF0VMjZO19d+maP+oBLVU
+pZMzgAA4nCXeLOLRzN+Rap3qPtgmMEeTnX3L5F2CjuGfWv/uF9KHQg6zkK1vxrq9LPfotxtzcJCfHjd1K9GOGeLgxlgxYSfgoo1QGPzd3nRTzcZSCB36RIRg
+hnYI0MyBwrv5c1ZE+S8RMXaInWh5Zh+fCmxAO/23tvXojW6fOzurJKY5xFffNF9JH/KGPw4SxuECm4bazaQv/U9erJfPk/sMIBCQDpqVunKy1A6lK4R+uNTq
+f9YXgMkL9D7mgks8NGpTP8wqGGXXKWqCRX2NFe7GTX7sXL7VCcPlrf1xNXKpsKPBlORf9EI24x3XGl4eqr5SlRXcSkW4YwptN+JlLjelHG5dF5DXmPQupdGZ
+EIGgJo8PCbgoqlswyXENky5I4fKh9dzm6zQneafgreU3Fl0nqZV/A4xaLe9Ix/RJY9TdNIGbgNSk8rLf+Jg3p2B54W1/3EtZkqsQfkK+Okg/hSstvE1HsY2V
+CVCL9rkuSQlxLCunFuyJbcoa9Ul4lMjSFYtyoq9AOdHENVkUu/5dozVt75vtsebci8HqDO8Y+YZ80K+iSpImME25usymWy1FHXy22TzdHPYrqquU2ni33d9b
+UAr1IYNS9SUmoFC5mm37zo1AawBGqKHUetHrtG3v6sOMJv0z3+8vdnhb7OjRwiNu2Kso1EErmjIZVaKrFn68oLkNct7rGD10p+RA4MRcwElXn54TyfrC4KVR
+k7Glc2ekBmChj0wn7EC3kzqaN75Yb0kf1NPxh91/8zfCOXncxhlzBdkzGBVwKfvuYOt4c7g7jtVJuZPOWrkmEkdOfWlM304afwNNnDNPZauPwqSxwbVA7MzB
+q5IIxLiAcZuIHV6xYMLMp0h8aDR/DhuS7Edc2bJpEhXdt3LpityeRembw0yeZyuv5/E7bG4Vknyvv7McJQdNYbq964JCct2wzJoVyDtGrDfrScwiVhtUlKm+
+xS8mfc9h/PlDuyafNB1TNgW7NSVca5MHoa2z00z5cWoyv43BjtwaWaXmFqJR2gxu3HL/MoWpI0b3E4WATcOIjI0WcjfDJsqzQX9mAUmnsbZyB/hObaWMxWHd
+5nSHqhA4Hsd17S8cD76T771St1TD8qC1cDEaXUWpU6hBhezt0kALdRodB8xRfwWnVvi2CgzrQVErVvxjrafOu9N3cmT1+eCEb1WO+M4oRa61WgHnImzME3/L
+lTfr2Hw3aUpF/Hspx497nLoaFQXZD9R6aV0c2m08EX+3mw91QGRyTIlQlSZ1PHLqPGNEBaWw86p/QnAbMi2+C6fZ4XiIJYFjcPySJ9hDbLvxdXQTHvepr31e
+Sbu925NRs1cwaW6QffghQbYHEyGVMdUPct3zcvVSVwqqTbB9Dt9sc+Kf9n36D6K/TkxoTW46vHOGo5/9/QxfN0pIbgJA0fpWFbRCMThEqYKa1maIAUjq9phl
+FBwNhksFkmDNPisiuJSGVA90p5pzP5ec8H3PsSvbrg+1Z8kA7mpF0R5utj3tZyKc9eEskQ57cq5za0pxQ6rMsvgWX1FXZwq2yuolYdsTKNgBTPB4PsugMk/C
+UFYvu3a/Fl76D7hDh5RS1jNBACXa6SQ6VD50MKPbrlfBCGdFAAAU/4Z4AJM/u0K5y2jySklNC0niRMqq0Jw6P5t3pF36uLuNKJ5/FDmDcd9Ib6igw4bhfKGx
+E0ypNgr5d/+q2P/0S5fIZi3mVJZPrAgmmHxPlcxTDk6KkJVhPgNOqEEzNOY1laQ3L1JKP3RgNeBV4lZo6Qjyj4Z996V0y9qzHwi+Db/JOt7p/tgCGUdN5w8K
+zht9HAUgOdZR2RwuDMtbc03oO9v1gzfE7XrKEoFJjtzMJ1SWTthM9ExKJg8LCwg18Xdar94AmS/dGJ2SHn7KQuiwpLLT/ol71vzb9B+tpr04GWrTWM7hdZCp
+l55M+GpT+4lj8P2f+d+YkH2dpWsf0hyAqPw5Lco7mAaqPMrQ6MyaawUCTCaLPkiIQHVWDTAhAKFMYWxKWeA1cTD8HH91QrSAM+tqrWP7MWbo/2arIv9UDvAW
+Q3nNYXaQgZM1bdFRO0cfnZ+77oijfmOi1jphBTxXaYWzCYoAJ6ywZzx/yOk4VqmE05lZis2J9zWO53sxfTNzi9POhViiVJSYIRz48WZbWvMOxRsS37EBnrle
+e4qb84M7TnJQnFjSARSolKq3DqY3pDel9zAPDLTXLLGucHAY/XOsW3HTnBG6pW6yowe1Wrhe0FByeNmrub7zuYc5QckTyWxGxVFlIoGOYuydZ6joWvSaFclN
+RjtXG404e+sDuXD+vMAI2u9hLSFTzCqzb63eKl0k2+0g65UaQVtNy7W2yoZ7V80V9u42V35i8E7KFCqPVGaPfSGzLQPgifRqoVXPfEjoogWyYifUwh6Z09+U
+ALUXQQCGqUS//2UWxlryZ6MX1bWzoCzed2RO9DY3iyJ0UkOuQnP70BkTVOXd8fyMGMoYAFC2GL18YVl9deOSpA/PTg/5WXOG63fcKHMUezRljXyfMkJ/Tjnw
+1sIX+8EqlrMPBd/DMU6kfG6K3NrUmJ2x/DErKmwCEatX5RG+uDBVwD3ry0NA4Yk+JAzKJZOcG+0kmQxmo4zYiq17Mb3V1UrE6V/qS2V/4qr1VZenbFGe+E9M
+g15o2uvRnhJCLlfLFq7tROVPlJXATGt+AftX4NnrKscYaems/Hy2OloTK8gaEHWHgEK5cuZn12EXMEo5urLlJz7T74MtE6cWPsnTCTFMcvU8KX8nDoQnryw+
+U7DKBPns3PYyP5HbXGHNI8K+/4KzpV7lef1l8/S+7n5W3pRbF9XbWfFWK6OaHjy930ule7+5PZgDwdLtVr1iooyApxSzI5VEtLr9mVv/jCD4MPPN5xxMkGb2
+vLBPEs48Il+X3pA+6564RfNBOj57Ddq4wN7FN2NQu2k/al4l7U0ZnZGjw63jeEbziRqr4GA0h1LkqcCrS4xtCIhJYhmFDYIaVeOEKM+qYZ/Tr7TJhZcYRDo8
+rZrMZLnMRKE/mVYJdMyepnekrWo8uHCk+12oFKd8XJUJyn9sRlD9EVQru/IOYsp2Eso7P50aPJXxAGQ36qQRRvHTmZHweTbfzvn3IPrUpC1I0rn3kAhjeZVm
+fBxpu1VgVM3F31UVniJRNiQKYl27tmph/X9z0G8JoVTNafdGSJRDivgmD4SWWT4kOiGVuZ8qAWuywsLiuOqzOrjZ+5zvq9r/+mFrizqm4fu5JcAUTprk3gBJ
+/i8DixaHPSRodAw/xp0d/ncVzq4ftI7cQJiPqjvXQB23AvrP1bahQfCvaBL5Vv5DHxJQDQczIAAQMZFaLBtS+VKZ3gjyZ08eyTuZZQUzzH7VMvwYo7X/Fq/J
+iNW7574eckXsy5eT2oupvZEfDd7yyZEcHep2L9PCn2HVCJU1s/amkfBCxbIjFa7OkXMwBmfZZOVykrJtTRKkYwYUJc9qNc0QBS6CVkYR+dlgeDJA1EGLNcot
+c2rEvTFJtbAz+Ql9ovK+rpFhU6Uy5JuUJI6rs6pup7RKhM0VTqlmoYBAFC5Wk12evvLFgOAyNfILsik7yxXKuQfca309+6q8M23VgztVaembaIvAGVHJUa3f
+l/UzOJkFwQQJThQ/eVSywlMiqawpwZMonsPy93xt8BD59vbvETxK9eDXv8ojtv7P4hSJlzfI35YTTDD/2wgzkIgrk4qHx7+wzoddem/6gOrdf1L2+uDAvReg
+q/wB/ieKYm7QddiFx6JLSEAiUeMYa5g4pmv/1EELhXiSP7CMO56MWSA317j/TxLqPR7TjlX678XvwSmPS38sz9T1hb+XiWDphEMqE9D/2ockDdnw506EKyvo
++V9r6lHh703y974xrLm3cmTxIef+SZxeRptXV+N0vmdiu8+RUbyfTLNUWMvLl3JbraRLy5pPWfOuUj0oOMHQXxciDQ0fG3N65oZ4g+yzVK9PXpRuEj4Q3w9Z
+cZv01pRGD6wL9a78wfZ5lfrFVLT6EFwLO3usktKN3utSNy5OQWO6S+lGf/fW/gX5HKIyebxK6hbS/GOw4kBBJpcqH/ijlOq/CVyrfir+RKqJEWUW4poUXcLu
+ukoekJnki/2+UK/L/oVtSrvwb6jO8/K/M78qpjHAgOIt2f5br0uo8+916etVX+I9ZRT5hBuPzT8Y/y39PCTbauzffUjx35Y9xf5xajaYNyTQ+kzBJLyQXnL1
++xJTpsY2w/ZjY//VhXAcq5zWfLbzZBzAnGt4zMtbLnOcTtj+rRBAjqjleNPQqufkG/Qcsb974/vMx9OnU6p/7/FlP0I2Iyv2t6HEuL9M0dXjRcR5eA6Rd2VO
+VKHNNWCfEtTeDExxf8T0RHOYxCtR1sv700H3xka2f1dX6N6cqws9NjymydgGjfrYQFGxH/Xz4mJ6Es6/r01vSd+QvpFdIfjvyVKB5KW8vuXPucGBW9zwDFZy
+zBHfJx2UVeItl/dsLLd6SkOadPL7fQTfe1+fXuL918bkIylVkUpNSzZ+9B7W26drS13mY5Op0ruU9s+PawdxBc/S1yT5AxOsyt+6gtOZPeeJr5NPOe5edQYb
+N8BmVSzHrY2XsDsDSq/BOILVR7jzgV9ZTHHG1W4i7XwDAvIJkvSSPeLGSjLgB5POW+mmcow0ANGJ1KCsuV9Pwv36Qe4Q/gx7HxgmQ5eX3qSDZZivyRjp7K2V
+1ilMqg9zToHSYq8KqtQmCpb9JgFkumSUNC1MhZwo+hCVvefF9PXpLh1cEYPrWmHe1n5fbi2l77VKV47du7MhuQpQZPOar95mdCJCY/9r6sF/NcaWpiNGYgxe
+7+EH/O/+nPCZ9T6urWfbMTqPh03Tug8FurO3qzFafUgwkwydMG5eqfVU1VTerX8nOLufqYlq3NPe35wK1jQyAn+dfod6IGwUsbCSI+3MfaFJhtzLg/SsfMIB
+pmQ3pckf49XCCuZHmvYAkGP9DzSY+5S1VjrDV1TNtPUh3gtzSrHz277up/uQYAI1A9YSDg56NptZDoJwcfooEKCCAiWXsnn1zibu/25ZFWNNy1lX1bcITu5u
+zsye20WxYrdWMKu5E7kWgOsS87e4UiTgENnblhZ1lSJ+yff5F7XK+N7uXkjdnu/I6OiouArOZC9NFHVR7COTdFIyzbNakycGyG4FCDQlAFjJ3MoJninjA/pU
+QN9c06PFxdy3O0HWHX0MbdeOKKX11mp3q3xE/u7ZMgPfQS7Gyn0qN0bR69pWhFpEqmvJeBXN2xTht1lRvX7Zg16j3dP3uLq24JcaH1ylKnv/tZGQ3wWP1pXG
+3VaOWCu4QhRZgLDYYBhdlDtNtdQX7yY8JH1RoPM0rJBT/SKXdwRofGpW6UVea+8N+9x36x38clV0M6SkChHIMctXuePvGLKxGoVEDzg32uevtvKcCB2U9bsz
+M3iutQ6p3sCMcKowElK0FmCWr3KSPiIBAqxSed2Jf0idoZEjD7Nmtsc5kRz55b5ajvaC556iTpVMlGAsbbPH16bezPLHs4dbhn7lTSmzzf3Koc3LiwwBdB/E
+FTS7oDLulDGx760Y9jL7BP99PBcP7Lqpt0LGIRPhiT+KQlUtSM4rTmCu/CZwbx98pgVfwpKjzHfA73Wl7S+nX5axbi2PdgqE1LnWxQb3Z8yQRp1no7HBlgyR
+VhQ9kKJNveaU1Q2Jv5u1i9daIuUUPZBQZQVOAC2LMqLCa9c+vS6Bewi93yO/BSehmVlQCIvP53R+tg3gD3nKV+6UCbwNSR9ZPDvRQvoS9/i/y2qcn9HY3fb4
+vZxB2sZoylyzLd66ywoGPDLs73z4ipO18yZ3a/Jd3uFhZfewnT44Vvn1tvu8PovNXt9qa4++KxatuleNn6i6TNu9b+f1v6cl3uvWV2W2PgNdayK1lrVoWhbc
+rIjxULWzh9SRwyeGBkB0t0GARpvzJa2HH8OKm/G7d8dxYQ8ZN634687FtJpdA6xnP/Bsk83jIa0SVGJuW74KbY2whTSq3xD56jVHsppjrfMf+GpU324XUND3
+Lgh7u3AvViUnpawbcXkneAYwG32M7XjUtWKy8vGPjNzDskN/Wt2AFJemELO9UpyCxYRyxrebBc2bUUo4UsEpd0YwC5tdWOM7lbvRtTQQyLxSLT6FjlBnNjLx
+wZexbmwnXw2tMIF8xomMTC0Bif4ymZ2STBFRXDdjetn3eo3bc4C8NyJe7uF529dcffEYtc2L9wA2a1ZWup9FuOyPK1keAFBsqAWkhT6bDXOjoVF6p14LeD1z
+ozWlsh/c82oSvf0vqgJ1UQ8o+NL/Kf05K8H+m/TvC4/8pjJD1POh3PpXspxXgjAzRUonbBWUkmyWfOf1JCvnYkxvbme/cgwBWPm1B1Vz1n8k/Jr6sMRcKUwP
+EPJ91nHNKuk/gcuMIHPy2/TBS2LZOSsalfOrle8cMTH1IZSnif8x4IRRf5PvfxDCOP9Jkr+1yxc/ojn651hv6aetv6XcUj81o13F9oxdxapbbaPbn3JVtzlr
++UpBkkgE6HD3ttAekjY1HQqnp+0ZDws/hG35YU65FhOrlsc3pm9j7M/vpD/y+fevFBnVGbqYUI2QlrQcIOGiTKVVUCoQCyxH/Yztt5zVq9/edfp9XMvXGXmn
+n1d+8/kdtG84JBkzm1Eo/XAI2++JjMFwwlxjmMZfx54oJXX+sUyNBXB9fUs3P2G/q78Y1v+9R93VS7swyTgWMBWn46GHEQjahENaj8y1gOenVlmCt2GYdkH2
+KX69ohPVqxXifAgvmcsgVx0L5/UDVjsRqc6I3hB6TiizDAGmWWTRnHUvBG/JUaMicTYULHYysQ92aza0RGEt87MHVRoYjxV+uNocjk/KtTb2wvJ/LtvX5r2B
+CAYnEenUXUgEzsJxB4SNQGUxS39GDWA2p9AaAkknVRdkaIL3nFCli9M6aCr0MWE/O8qer9TKCCvj4egilV0AWR66e344xxuUTINnODZxTABMeB0rVhEzX7xo
+VmDDI0VopYJ6WqDXQpP3dqS/V9bAejxWQEO59evtZnTP0OgkcKd+oYq+kipLxpklt54EBsgIx0XudHvewV4+ReHi53ju8ewqo70OgJ2DymOPQaFov27kHToX
+MP4728qTpY8ld4QpGd+ZgyIuRnflaJ5SWnWfJBkBII5J9CLLlWNo+lWmc07GZ4SGU7XRutPHecV9TjWzf07dZI6H2bVfb2golI0FQ0O3g+Nrd6ccufwLvoZo
+RTkTRRkAJhmKrJgfH4wBOxLRKdmOvuBwCJoK9foUH04/lX6L+T2mIrOvUouGTusbEV0bYtfW8JUptdZRVSeaPfPvlEXtqpQqXyA/+0TLyw+JUAuQ7fQYXIDC
+191kVEuSVjUEiRNsVqpTrII8GjJeBUE0C1Wb8gw27q3sRa3My9B5rJZuR2E1K1oghhDd+PFQ1NZM+WqFJwo5Nie62HfUzBplSKFoaXsWQuXh4aBSujo40GBW
+f9S0/Jr+6X6j90Vtd/RiDMp4+YqsGG/P7tqLg8ncVKXnASjkn/lV6sVo3JL9XpBxLHF2rVU0wq+25cv+XHQPuL3masw5oaZc+9pOPt9HPciMvmkvnhDPXacF
+CpF07InDAIAAK5hdMEJWcaCFBiot3fN3ez0j5avmZ12/8qmbrAs3btBg9CHpHF2clIzRKA+YPjrUO1Yx3x9Wz3et1ppskggoXXHn9v0fK8IsvG3isPCX40TN
+35/nUwwf0gAL4/r30q8Y1z+upcOSGG/z1KaqIQQbjtjtuHyDnrSXmCZsdFMQ2scT73HzS9cLH5r8ErGmYUgTBTxQfz/rXHtz4+0cvVQ/oUjaHwuM5sK2uHo1
+rm8XHFar02rTMllv97eHKGZm0VS3IUniOVuTYCfugvcqQrWN9+P27BqVDbh7E5lwSSgkHvcNq8ZnZfQ+584eqWrVL+ABROJZwpB7piA/19wZ1Xi5oMxbZAAf
+qEDLM2aVACHLT+jlEkwaeeDycWSSz6nhYxaPd8O7aU/wmvmg55SIut8uwh3ObVM0iAisNt7h8mZFSLkczKlFOSycMV80DZ185axmOvroEKVIElhvw9myNbvk
+I28X15wtyx4pUMsB1s/sLpn0kBZaeTULHarRWHf/Sp2vzNNfVCZ+MvXe60bAcCC9Rgz/N8YXj8ft+tabUZvDcewHgpgIsbikkTSdt3hiSiGkst1fGwYZFsZl
+4U0RMuKUuKQbvOKKhzJsn29W1DCjWiGuO7R2H3us5yHlmcDDEybHyDcvmXRfy3hmMGwQy1MZqQMGnMoT5EMSdZbKzlaw3YLeK6fAVev9rW3Gtd9IYrXd37BS
+avUvNE0cN1TmXvvqsLT/L0+VAd0dd+oHLZTTBjq/30u/BgcjRfFX8mtzXETdXPnt9AdREeeqc1EKZbZxbKyiJsnZPNY48gbSLKutGwEqeXd3xQzKVRyvrUxn
+Nz3lHG565IBEKwg0oUuUxOCEvs6lOl4WV7CvYW4pgFflK2unK2qjcbZ0eHEvhouQwaz9wA6QTG7Q3yUn6ubGQhGJKB4grSa3Kb4kNxeeqR6bvjrLRjhRVxT6
+njtoHIK3/hCcluFsmKjNIjSfw0z83ElOfr2sCzFirEF9s7o8FS9O7w/rFVQgbwO4Wz/tbKN3frdOSRlw8T4NySinJ0uZrfNlgiA4IAaPaHRbknDGvZTeyZ25
+3zKGcO0QOIV34UsrhVy3kh9JLLvDLsAiVJYTvTuOi3v9LARo7m2bM+SlxboQyvpG9oYmHnjBdfRCnH+vHfTbawsK1Txh3RiexRjB6kYr10vvj0DeqLOI5y8t
+ZSMNKs5JeWGYE4YMnF9hzjbtuJT/u93Lxlh+iOJ+X+fUk/S+Nu694a7ldnNd/DvjNs+27Wl57wo6krZzaQtklRYjYOM807lz6+8rBv0367k1Xl00Oo5LIP39
+JqTH2+NOXYXlVyyFODlxhr88+eMpJfx4DAdTxsWSUvD4sTup3NGc6kjUcSi15c9aJ+PyTlaP6VnYb5epFgBtbyfX0AXnR1L9TACZeI87JRiSxCphy+NNjzzG
+52AH6JR8JsieSboV1mv8IOfwsraVMSPQs4OWf6vzuJuM2QWN++vKGmfqoimgr2zPEk70rAqVpKmuRVLkTk69lZOsyeeql34grtJRdbyvmuKb4/54qK2shexU
+0srT4lrNKiwNgyXk84kKwHqDWXRzcKbj+W72/y+Myk8EPjP3Pp4j8l4kBXNAsjsHlbwu2k7SrKo+ivzhlB6JsmVRDjgloKBmeWaPKjiUv6+KV6aabD3Vzaqp
+gF9bYjy2cfXhOM6ut1zKROUSyu0pf+RiLGm6YXU3pvJ/jmna0vZUf8EsNi6Ymy+lnzNm0Njbh+qV315f1R/bLfpSZFKunPXYia/+qupEDUkIzFGNFsOQlqV4
+MQ4xV9zfcLXReD5dE5GNj3oHj94d4P9uVaLuniWeyrA8Fd0A7loL5RjVBnYppwqMeaiNXdkkeO+StYEq9ZYh6NJ14wSwJHtFQOBeFBT+Jeo1oXfqjP8l5o5U
+1GY1bnHM46in0zU5l55/3ZzBmUtLVvDOLA0hXygY7ceQR284F7fGOfKkObO/L/1I8FnkVbK/Pn7xky42MDVBWYtD53wrJNv25xdIEW/I6AS+rhquyH/9RQIi
+uIk+ImWVh/ZrjvW6vWrry+qoyFarGvjsbioFx61u+sfrAtTjqNetyhkelpKrXAZzTFiM3iRvy4k/DfFUjDFPloN76ahQPkhdS63X6gvMYP9wqzVwU2VbLF/Z
+XB25rCLmsrvtD5J5DgmK7e1ye5cT0RiZ1zTiTI8X3UdaEkCAua7CC8rUf2e6Sx+IdYab4B5gGqZjZ6oUP99fOxZPFuKcEsVNZMoRU+uYi8tDQu2ZiPPelu5l
+F2oO9X5vtXdvXosWBfGkH2sP4VDrrFs1mro2cp35dXVN8ETwkIA0fjUxtIn6qKgMVIyFjKqkQlmJ+LkzPblTavBnOS2MxUrRDOJqJyNiNepIQNgLH/OqETDY
+uLuXdbs8//m1j7C04Hn6A3gIn1MNg0BVHefLPAn4TtxlQde8rYMnTW3izxTl+TfP16moEVB4OnpnLDQwxqh/eQw6VRFLdLPQikNcKsHpuWBvqJ1+K1o8hMho
+QtlUYaURVUbZQs4XdZaaJs1yi+27Zh7VNeUdsOp5UAZpfV21p+HWiiyzCOhUqub2iSqltVVMACSk5UptDTl1+DAEEEBA2uZqB4ZvAQdqcsU8HWEgriWGXfbF
+4M8i54ixGMTBnx1GsocUJhZ0bZXHc6+n1u+0RaXR5eH48lLCrPDn8okJdYs9MVKzLSovQDWXskXHF4nLc0YTMJL5B2jg2LDmIhfoR9TR2f37r+9usOLTeLZD
+OFa3KGYa2uarcYd+9Wgng8fKiYTa3SLzKKNTgqyfL7Qy+j289gnId6Q2Don70s+7Hr77a6gUXxmitghqHu5XpTHjhfTlzCHFmwo8JKwApYe0UDvNba5SfgDD
+0nLhYvL6KM4Hrp+TLARaZ9Kbwhi9LX1AuSKiRV528X+X/pOxRW5rTT6k5aJp1T97fyWOqM6veQ7sKFCh7cp7XqDL66AutuPPbVk1QX5I2LVmnWA/BHuIpWr/
+QtVAytXWztaan5U0AWFe1yz72meMdb9rPY2583T7HGiyKIs5m3Zl7dHikOQEwN82ZJlmQqdEw2L8g7RK/AoYlmKf1ymb9pPOpf1x7rmt1TX7cGX7MGg8rLdj
+OJqezvYe0c5YzGcnu0ZWPgY6IV8ic4kJlvuJA79PqC9Dqjyfrna5cjcaddrQbbPFp621aRzsDw6PdeOO1XS+Wo1Y0X53lNffGj0d84zU21Wxo+4qg5GMoUlm
+pzHJ3oDQFbQRJKosI2E1cOpq12UGRyzGtf2JBSCfwgtMW0eUkAv0OztodGFfHbgduZjhxCIGiuSMT2spYy8tZf/MTnrItA4uGSTl9DSdingmf7jpnuvKHq8M
+0+qXvAgiW+ncwcviU2eunHiaT6JtCBnk8+BW+u1Vg7nT5IZSOjtdXx9wApUzL1GZ1LNGFlLjKVwi1M3h8fnAvMo7CVXv1Ph011x6m/CK1r8I3M9PT74WYlBm
+LHnr3j+RftWNABA5HgnbylZc87/BiNQ/dgUKTTeC9ZbLVIcKtlQC+DIP14UWzFaXX2wVsD4WlYEoxxiFxIImGSLWt54PTgKpaZCISKUYVfilO1zRIpXF2GIW
+na7DOH1L4INYhPq76X90P5mkThbHgNBrdgcD7m73xyvgC8F/SFPke//qbgHOunMn3ZvjNuylLdtIToJmBHnO2feDicJJBdTNuVNpNvJSYSxrptRCJE6hMB0W
+m6Sfi3HDId37mMqKUwzbFRNLWdqeJXTO0dI9jb3UpWbTUj6o4p5mphG2oVBUFihMO5XMrihKv2GtvcKSluknAu+x9iGuxgGKeIc8JWss+EvmaCR4GxLkshWL
+/cLNmVb5YjNGTlCdDYDyEAEmQCbYTBXktATx6bsN7i9olnkPCYC0vxGrlLUu/fb06fR554j+ijHfDx4yWuHkcHx0rfkUES5hDT6P+7VI364rY9RgItxBFJio
+ALRnOfOg4n6cC/GjIZlZp5kJ92uH1wivH9GjKHXOJKRUb/E1uWC7F72Gc7np080RgAeGzoq62pka/fJxXe2OMTw3hZJ1Fhb62bN76Q0pHORtqT7mx4U3Gv0Z
+gv/kpQz3ft7vW96a1x3MqD8mS4R3o9Y/q7CUKKeegzPRkOazpx7YgBziZ1Q59pa/7q+unnS+DWeQSWTnHulrdBLV/OZrF3i2VfPDiH3La4i5/ZVFm22e6MXL
+DIET/8mrZpkgAgKKyKg0blAhM1xgwQ57N+BJ/F658C8TAOvV9Jiyl/z+/wV73qsmdO1V7J6H1RWqt+pbYXMhSu00JJ2ANLsz28g5o0RC9uWKG7UpuheMiN87
+UFYjt4aLBDB5HiZ+Lvfcn1OquLUF/KXx8QM63awyLZQzi41rQAK9VZapJo8Or935bhNTfkDBwyIutXvRR2wCtZbuULdD6rak7mDCCcUaSWIimOSBt79zmmm6
+PWlUtv5B+gUeod83/7uFrsG5cC+A1uysVk61PO3aWx80WA1n0jcy3/L6oNjeEimWCfuKCj0dx5umWLd0WAEAOmnf0IispCGG5r5iH9KUNMV08kOz66Tyuwmw
+fJsaThM3HYVMzz8jvxOpgmhfP5tvv5h+XVfydUyfkfV4tz6Ie7PF1Ql22LerlJ9c9W04Hu0rGADlijS5MP6XnnPkDbnyI+i5OZ2pBRNw6CQrU75BIoHeKESE
+Ofi6xo97xpEb1y56tL4O377ZXlM9P4ptjYAO+xFC96NfxKwQLtbOJaGgQKupaKZ8bfG81jlDPbhyRNfaxbYedvlPS4tS4/IK+eLh3+xX66j9PW5E6o5BL5KC
+rYSpLJwxyEAV/j9be9naurwkuCjj5z4OoYWna4yXIg4iTJ/d9rzhA77gnMlS++Y63qjkhVtn619DKzIxlXraNy7KUY+3JPItimGM8qGT3ElcRLRZHEddUmDb
+diI0e6l8DlWdz1TMp4QKdDQHidcFf7fPV9akKA1zfeL20Rmg9f11dfPbGj3NHe/X0cdfQ0ExW53twGVdKCzfoOWlAmpMhGx1ShqonwnioRydbHgjqkCHrnck
+LoW/o12RdNNW6N3V9tDWdJZPrI1FwQev6ppOlswJl3G607XBgj286myt5OBkj4AAdOZ0sgMIsSyTjAB4QgOEYkg34mEEwCoa3HkEmmy2lS8b8sbrwj7xCe9Q
+M5+cEdWu9Jm10n3dJsHT4dZNwzlMDFjPDJCBkJFd/bZQzlyqMd6QqjocuptftydYlFhNZf9Oo2kgjDGtVO2O13WVtdhftT1Chrg5rBUCtHZ9PW/OSxaUDZR/
+EJ09FtV8ugT5TYsQjyYRRK7EAMZShB2Vil93YuZCk36qtHRwMpLNK+6oJFA+UyY5xhZxgKv0ofQxxS78emWr8LGoQGeNZuW/eFSsD3uX+OIntwxbeDQ2fBIS
+qqEODCoaHE7oh0nWb85WEkIW3Jz+sB495aEIMqHyHeQ7A87qehQSrli7KoixXwquiCH23VWTW65gVkSo4Kc3wT/zSqhtu9Uejir25cDZnYFo+3wrqOCQHb4E
+UmqCc4obZIJ8lkuYS/nQdRz9i/hb+jTMjKIn10tF+X+BK1005v4q/cv0b9J/TP85/VfNuvZXtNpaQ7GKOt5HDESrE7l3tT475ffHQxDs02dZrk9WOG9otXWt
+Lr2rxT28Up6hjLU+R1GLikKVSDbrDDWuG/gb/e5uP8Bf2xM4uGQbBu05GexcnT8h6qy9O1Q/Qpc3P1e32qxhs6ipjTcNYmK3GbvK2XlNlKoJLC2+BDl54ewC
+0yEnJEKxC+Kns0w69KFML/k1l9xV8HySbf2y5lqCl7icubZn3bz2PNbasyWvquFkaFlVuxJS05g1pd3uc2kD15K0tcNnkTNiOdshe34q3yXd/oYEUwL+ZnlJ
++bbXplF2gVh8Vbfszp5EJe08tkQpXxq5y3WD51jhomK6S9+nqqWam+1CjHXcRrXCW0fAbSNi9mwnnZX7xSXUhDYZja3x1i3vfm2MhRWXh6Ys3nfUhwQPSbX9
+zFtlqiYf8iLplfsu2qzIQf3zROwAkfyUdjWFvu8xV3zkSvatdtKWkpX9el5R4mh8tFhl1ODksN4e1+aSO1P6k4DMvkZYoaAMqAdd8KBIl5EAeFhU8lFiM070
+B6tI2qBa2WRQq6+cwDX3TrIoQ4eofoh1kG9w5P1vpj8Str/mc50lOC+jx5dqn7ywgvtY0aahGmLGUbUXUB5ZcWmniZ0V1BYXqAxAlZe11uFUOwNlBPmItWhL
+ztVmWcpqppg1gyskN9Vw7PUzixfcJ0JM8tfp36b/2OQ9FiO436iiUXWltqp2LaLripKeOztb3nxc+5FZEGD3rVqwF+lkock4WEHILnTeMECgRhJMdTtPqU2Q
+Hvxm1fTQj51ZqKff5qCYyEOmttawckVmyyc35gJ7UH2zihhQXe5ACjKhfXOwvWq1e319bR7sWy8ZK3J8Iie6Qzk2E+XEg5ST2LVkmYXtUpdRUiMXNKol4FIu
+0gd73Zqv4k+DuhdYtlnnZum6fDn9cjOCf9Gh5mq0fBTgSSzM3bYCTmcnId+I2BS9dbHcXaccrDLqZjdqyl58XFiOjot8YgR+7eTyghZqR0V9HW/yzbIpHSN/
+BmTcP8b2IS33+UqHvGiglCi5zj1Jr/aNubx6xPS4AEHNSlxy2AtE7xowG7+0vIjvwUr+FU3xpfjs6AtVi7P7H98IAWgeLEOWBc2DmTFiVsgWr84zAMwJCNl0
+Is8P5JwmG7iJ3K9Lo6S2Z2a66tIv+5v0H2bI63O90JnMa1XVUUq3FIPit9c6s7l/1MzNlc7GyA6+15l579GNds+agaP2KIhf8coH9T8mke2lZ+ULdIh2M27V
+NZu88TPFwgGgTlU0ghrazF/yqi1r/de97iZj/K/Tvzu32ivstRbWrlvtCmIoZSsb4nbtr0LUcHdx9WPo4KPtabPJCtnOF6xr1TYAAowbAL8Ql1c/wygoB3jE
+SUU2FjaBjqP4Qvp4+iVVQ/qrWd3BR1Z43HKQm48Ij54d0nZacVf7igrf4XjDEafS9R1HcsyH47YW/LTPm8hLdNWKTZtl5WM/tgPPLqwHTTnPERbrDGF8QTiQ
+i/XBZ9x8exAVfHKVm1bnvmCA/8iZT4KZ/29e53JPczmKKxFnLaprC/Xk45licjOc0RnguFvrvn1w746xqSTLfL3Xr2SgyyweKszM6AhhFs3jphqVuvHaBKDY
+NysXViFZRDHFimdaM8oR/IO6G4TUyybzrIf8Uvo0Z0A/07hchEx8ZCCBDNyqbbRfM6CiDqWRvnhtbY+yp+xq4/he06H+s4TEh43qVoFUoRf2AhmZUmREgAwE
+ACcklE03fJjAixI2WmdHMoHclUwAJ2sApsX+gI1t5IEUXMvfC2qiUqf8SvrjgAxSrYPHCfy7wBzR58tPy42x6ndkenzQbI3Lzari5rxJb1Y8JbkZgBl9gqCc
+67GmxW8nChQ7tIYnZuXsdTG9vVFkROq969J+xGbMihbrx4Oi6M8r6uP3pZvYjdeMl1fVutfXDZcs4qsHDR8SnrhtYmAqgpyAMvQDxo2Ws2NGrda35viLw3Ui
+ROpG7E3uVVCq3x9J393UMSRj/52WH54b3hFDobv0xxAfh6VRGttRqiNVxuVJVY9/qqQ+IHhIK0Rvp6rWYtchhUynGDrZQE0qseCHTThwHqqXjbx1g+RVVur2
+uRfSe9KPO8ekYM7/NP1jPWe84njfjFMT2sjhszt2S3WvzJKxwd9bh6Xappg3yOFYq9c9rsbnm8CvbaFqJkSVmKZamIhEAX/XoKumpFhyAsfhTyLxJxtpjv06
+6qLWemT0mpnwbCYxKD+c0qrxwn+He0JttooBKMMiBvClbqieMGVPBXhIwhRaefVzxZ8FuyCGOCu9n8C/fWx0rMO/cDjK78/qx5ap/jMwKSNplWRjc7SsSuYS
+GXYDGev7rvTN6X0zJob66SjVlbOCoHC2wsksiXl3zRKGiUwGIWAmgAkIEJgNXL7gs9p9zqvP/jvcObD3q9+OBtNS8yP2Oh+PW3PGHvc6DvpVeVxeqn71Bpz2
+8sygmUdOJ8iJaV78KezYQwKSYJDoIaHqXWXOO8rPZr6Gr0mo1/Am8zzMVojaH821fLsRM4seJnVspf2jiGKWNhYO0EGf3HtJhnhIACRO/AmkKaTSHZarnZIO
++Nf4XvpUfedYOcuFVTamjSJ7qHmFrtzvT251xuJzYnZ/wvVHgeIQ1BxlhbYfarc83QSP7R9izbKf891I1Ws4spKUysWsrBCwn8sdadt7fah8t1G28WohKTqz
+K499gGKvwd/kr6UTSk8HQS4ATsgxvXAtMk7xJzEDSf31FHpJPDFuOh89RvvuHDUWFlejV3Co3pizuGj1/+ft39+kW6oyUTRipuUCFrBMyvQDEXCSJAUskFwk
+aSGwYICiotwVBBXxglcUFRXv7Wiv23tr27139z67tXt37326+znP7vM8+7fzyzj/15ezzhPjFiNizsyqb+Fzcq2qylvVlxEzYsS4vON9lRdrZxgX4NoCcs+O
+0pwSAamFAwK/KM7YUDNx3r8Hnj7Ty5Qehev0VLpm+1PVTqM+0Bccqxp7pOeajGWJszbSdh8v7ntySxovlILj/Kx4Nsvf4EtZO1Gez7bDrzRZYQPMpCAGRD0R
+6u45oantRn+lXGelEpYzoxYaAPngBYBVgo4/vtFFRbMN8oqmyRvOOlcvl/6l0XKNel5y1YnXRoWEiW7ZjcAD2WpcdyshCttPntHLZMpzzVIInklcEUQUOtx8
+LTzluq4vS9+oasJFlS6Nm0O4lmOlwzapsPUubMJrfoHfXP4/XvFV01RaRqn7o/DCTpwB5YsgST2bdUK20eXJsNtESafcJUD+vKaf92Y9Tz4gavFjBV7xxzhG
+M7POUbExfvRb3YhZ7chdIqqJwiwM+1xpIgAQMCICAUkKHFQWD3hsABQ/vCYJMer+fasykBYWGNFkVDacBcgQ76jNloVzj9s4oJswgp22A47OUVBM7TZsnFOo
+vcph4tk2tu4ipqYElmWiy90cQT8EIrQ8OMwd6zDDVpO6GwSfvujJix504ehIVzKK8sXlhlVcZ8q5qdKQcr02Rz01z6+zFREAsDekwUYGArtwSGGtBXNYF55e
+Kv7BqNv5untx43+LuqtobH426mbylAfZDNN63imSbU72FV3tyF7D17DIv0LNvwiFpq7Iapd0w5Py1jn8qHWCB5dM1RdkPRPNeIQNuydshumRfLxnB70mFc3i
+TdmSnObnnDl/rVROfG0NWDSq+r8cgYyq32dPRjmmqnL7onWa3yWuHwOtpAlMinN61fBUNdfILbX2ixFmJTM4kc+FwhgQiNLLuvE/wyzZ3xYi91/ttFH1TFtL
+QCpuFf93uK0X3DrzpWJS464GO7S/ZpX5KJ1SZb9N+MRKX8X30H4MBC2h1xIwmixlefOdVcnvUseUrDKT1GKneYHZihCBcJlHanEG709fUGxBqRr9p/R/pP+W
+/od1PKviZu0IvEBguY0NpSbveezIntwLvPZK06P5znr4RGfXpVnxLlpUmLBnCfqaZejpXZz4SkA9pEWZKHmOZv/sCdt3n7kmOQGmtpf6KeeZKzV68d2sZ+t/
+T/+nrtsQaUXrtPPTRrL2Rn/fFFDKFT1cPelsZ9/sfC7Ff2IdpQ3lr0t+/4RBppc9uQSgDftox8sAZ6ZfIiRZ+mIl9QIOaelCCKiptrbj2Zmv/Sgo8WG5B0Ql
+3kqJdSbLiXCdXiV8JuN6q93A8f+VKI6jNPWwWR/whPZl3Lmk+uFVOTzohuc2kPNWkoA0kZLTCVCiuRNYFAcToRkHOekGnVMCkIwyCZVbepF/jrK63hxU2QNf
++nazbtJHIderkkD9DBhBnX0+kdOs2Rn9gIgG0h5Sn2YrBvBURYESEzuCcY7MNU917jQet254dmf3a0nG15kzxXXkeIuxRgym1oumYt0AORFkaQCTFmu+Dal8
+T0/75yga8JXr71OaO2+u5naT56rHM2L20dm8GTlaP/JK/4AFUnFycYouQJUJmSx0pBgn6HJoUpH6w7DQj8MKCW9LQW+26t6/qlOetajyC/PxhytglinkE8dA
+f22WyHCyGjuO6vRKdL2XCRgEhF0uW237Ak0wluel80celVUFJ3570BYO4403DcXtfCDloyPVF3ia7fA2Pas+r+ntNjunU7I4OtTaqq1n987zWf6CXmasJZyh
+ZAf14JkSmrMn252XMraiE+jozLifciNHIbGtjO2Vri0n13BbCRTFCQoGqpLusVPUrlpXWy09+WhZJ9CEE4DayOq23CUjiQD+1SRXJr2o2Wu3aqM+nD7er7Lr
+49Y/0/Zi73ruUHtW5fdthZJ4IOmIQbNfutiU9l4Wkcm5zXSsbADshWLsm3lx6IJt7b4BvvbHWnRvzIbjgrs2w9Zc2LqZgPOPoN4kiRETFIwcf3BScwyo9kGG
+0YyGAjJcxrTCyntke+Jb0xtUdUYYkOJe2NeEWBnDprZMzTfBauEYYbMLVK4KF+dPiuIznJ0xttkzAFNzfqykd57MmMXP/WrNh1jOOnzuUXMMFU08/7jyzFUn
+kGdXwIWUPSS/M7pZ+dgrVT0Hzz42H5mDAOGpSymlZ/xz9za45SS4ZH8VWZ8roJWFHMfO4y4/fT+c1MrSUCaWS2CciuJ2wQlqzNoeNOZvDCkAeNtbxO+WMY0B
+3fylfhxn+AZ1zx+W5E6OC1Xq4/w8kmBBR1tPx0nzJPVUsMPWLig/wHqgtCSBiBELbs/TyU0DALY9hy9u+CUWvMK9ohliUGq5/ks+Ysn4a5nT0v4DhXVpY6AW
+gTQkgMfNrn9FswaXtOfNtqkmxLInxCg3rtteL3kHzUosV+fZHP2GvSunj7tj9IdScIfkAsWUigNdLEvKPaPBH8hYIX8Adp3ygoKaNQcEX7DsyJphLzb+Y+nT
+do4aAMVYr8ZjVbONxF9SauodwDJPN/sAFS5DRpgSDolB9ehy3pIir2YdqdZEdZRaXtCsHzWOXzT52Nr4pxdrBcojpTr3G1OL64D0en8tXa0KVmraI+U3tBZk
+inkRuZ81HyOhvR4O7FLgChUTBkG/prvZ4a1X397KJlh6Zqw/XBuMQwEAy/LiW5Z9QQAppfSNPjdlL7w6xHNLdRbOsy3vBx4ki8yuH7QbrvR4HLcP2RUncgjm
+0paYHCAX9wU2+yKBawkat8zirmjwpI3P815HFPEcHJWrWWqsRj5ReYdGa4vS7Hjtndcy4+Kbw7saX5QPY5QLN1EWGQwClRfli9y9gtXII0hXBZDcbd2ijPMs
+rPZCOa5V4qaXp1ekb0rfnF6bXp/eqvp87impQsAsYNXLzxQmjBoUOIL2iHvnkjXMC80lKJI6a4dgTqfEDZw55XQC/lH5SCBu/SFNgJJOmNz1hZAvQPY7vid2
+/l3FfJKv7Vs5rkzdxB1a+a9jgZpdtFwrYyQY7hUKHsTooeQzUobJ2whIAzw0P8pWrnrq2ADsG+MQTuTan2b5kTekt4Tr1WvK7Y8mHm3sroIK2C+5iw2h5U5p
+Lm2nOuGHFWvU7ULZmEnFasThJUBC0BII4AmbCI/iQWg4v3r9XhVOqWKffp4xRv+yu5bbcGLx17hfUAoUFO+iT2VL1+taZuuOh73AU7cBt8Z/aOQrTkB6ng+E
+sThrV/jkcTp4qZwc+oOa4AvXnvpcL1k0kytZDIFveE2Lh/Pvxa68/n3pR7hbsbPmKhe6ncvslaeOjWmPdLccZGTtqXI0eznrSqtTcE6VPT74Z1QrXhnBKIsM
+vMf2e6VYEidVDFke5BKKsRQhEIHUMavdtlVi+72z3jO+9Ab0rZw6ZemXl9t+ua7HkA8qHAIFjOMAkpSS4S4RCjbZegr5/4GfBAAqi0O1FyaS04qC785varjP
+X6F4n+/nsVlXZdXbLpcktuFnAwU3RHZ1P8gSXvJeNofypzaldHTDT+4C/9tto2i1EgvOW+DkSE3bzBPcJU96IYnr7g3PBMSQOVsCQLCgL0owKdY76/oHRAy0
+v3WVJcdHAdtCiTvTVdVPVORa+Volabo2Gm2AgmfTtkYV6eUcuNTJE/+14jftCo4pHyNb0EpqGlbPP2xWAdVeqt2e2AOp6FaMzyqV76g/yz8r4Knqj7w8vZKx
+ckXDL+W6gMu/14IIYrNcGRNNABPQkJSvUr8kdiIxOLyhplRzcAO4pr1htyRyerZmrwPtIk+CyfCOdtAXoB77J4LNL/Ec5VQ/QvkC3gyIVm0iY0xQ/AquML2t
+ZvqzCiEbOnmBfC5wl+fFa5477IrV3icMCY1BjZFZoZLcYGJqXFohV44t2LEvXfao8QtsxrVINEfwtMYP6+06n18xKtuBioFGsISMrB0Eks7jyXuYM9eCTjRb
+SNyTALP63Bv5dHifal79oOLoE3v6VWa9Sj3KpzaO96zwyO7j32q9eKtHwRU/K4vFMFdG2GGEJtrfP1nXvrQLK4viVKysHZDiZ4TLdpf4SKDySoY6bG9JUn2S
+qgOJjNPZag3pO0yVpaUtHJ1Xa+RMeJ7J7LL9wImmUKNjrEo5Cu7COj9R5rLWydS1FRNLxfE2Qd3QP1o+X/G53+Tnt2uOxsqMk1RWWO5YJefMDNhl5OW2a4Kx
+gcDSxWX5S29bIaXl5GMa0slo940ypnYoiVIsKFYRKfRAKmDFjHG/7iQDeOO1CMmNy0kdEPBjXFzH8XDcjseDYvHK6yvNYMqKE4SeitcrPWIdaLYG9Ik0cZ8A
+poTZeeeTTsJjUtQeuI9iawopU6mK8ntrnj1wG4LWmkrNtWaX05Xt8GNA48hFYnaH8drNpVw7HgUfTeRg/MxaEHgyjLPX/QatJenhyE3N5UHmZxocYM142Qmi
+NO+bVt8ycPpXzDCv9mM9X1bESrOkbJxpaoQ8s9EBEwnVWfka0rzNeoLKNfmYQptPeqnitGsc+q2KgHmHKcVn3rRrJWHiA/hGl5BOY7PeK4GtDoG5V7AmOzlT
+L6ucNaShfvYy2zSlyarADYIfBtnrGD9/5HCs+RXrtFs8QcdWYIAt7y58YP7QAPMztQKu4tlavhBAStaI886q5vMahhHSWzVy/G7GW6XRFSEdJ2a5r72KZ+yF
+Sq0UDefHWSMIfBxFpKup8tIk23KSig9OSZy8XLMdiIAZHkO5xcNNzCdxz6idwStGvH6I7UrJY6Ur8Yc0pt9utptZfduIL/k/R4ZvxmNVOL452ojkgB5Vfztr
+knqxa+0u6fmsFkPeNiy2uNVs/CnJGNFPcOuLtTHepg/4CAseLOVaV9g7gDckrzlV62TG3kFzbPwPPT8OBnreeSJTvZHI6lRxvHcJlDkLB8E0C7Q5c6iDbQqj
+VnuDn2JJTG2vfkk4M96eviOcEqypPgqifc1cOZttoxanejz7TY1Wj2d9rN2CRtOKwPGm4TZVrz0H4SU5IpR+sHzxphMcX++F1Q7ApDEFaa/NyH5j8XKtN2Lr
+Qs2ywdY3cQy3e+Pv3DUeY/nHy34oP6eEuqEQubCl6dQST2MNNaTkKj5Iw7/wgZALdqWCseUW88xYIPQIZ8YYKl/XgcjD4xPpJ1DsEoomqO55BcrqzfnZKIuf
+7suwga15C4qH0LyLHPwW7RyyRbbqz6ertnGUZdRttD/qAPeb0cyBq1OMnV3YNeodK8ctKoQLAEgsAmTp+5QlpbkhTZLI2JCqyp6JoMhSAq93PB32yuv5hNyz
+R2/q7il0JpYwRNI223UURqtdrdxu2Pv1owYrsRs5tmzf6rYB76W2ixEKHICxXYB9Rh61XO3HADmROV4ncMxdJlmnKyWO955rs4dyZrV24tOBJ2Afj67j1neW
+rtjt+YPr1px9v7+S3B7WtIS6MB7TUy6Gj6pcO7baM/MTTGwKIIY+/TIe8XPey1itj7ACt+C0FOF2Xn9a2E54aNcLQjKlMJNNm7hL+jCdwbRkrg1hCgAnXMEp
+ERA46UjUnp6s88C9UlLUOj/A1jdqdAlyQ9O87XLw2zN2Ji9aFvFO2xqyOaliYdh/AmVutq/g+ze3SSxIxuimDtCcVcXnt2smGtKio6ueR82UhlqCCAHdbER2
+4mgLcBd8pjHcv+2w2IhwJ0VfpSavmw3xhPodK+Y6W5erQrXLnJSHAwDqgaBnFTU55brPCvbyoJFbsZ1iXzTTMpjqWdVQcOqM9dnkmBe+hB+orjqgE/qxSwQk
+ZDeagiGH1pafU4L+wC3zkmqPy6s46qxV/4/W/pyx0RA5bMbNo32Qgpjn9saa5moyNkRVbpUCY+tkJtBofzlaaxoipkRoWyecz5oPI8Ro6yUS+nY9o39AK93J
+MtzaE3VQBsGlNRfFvW+Pgat1b0SgZ1chAznpTixyGagtPveM7pLgnJHl/sJSzADEYu7JqJD4MuY5XOExAlCzHC0mtFzCd3iev3bmq29Y9aLG9XYJZ+OpQkXw
+H7fr1tPfaZ1Hxn3wPgh7ZmWe7yyVKOZCVm02zWCl10Uol95J8MkEd0GqBNkaS07oAQA5Pp+nINR39hwFF+2+T+hp796jc7R4+1mlyjIrxDCcxq3czeK1cfaM
+KAqDGB7tAbA4DnWNS67Lfmoezlsf7T9f4Xp59UdKsS8tZsC+z5i7tw0NzejlzLDm1fY4OKVfzvG7a44Wz9nIk1EGxf05mFP4TkbVRSSVaqGVQ+TGLSD1uf1c
+V1KjZzpOmBZ5Y9WqL7rOXFCzMZYjSRP40afV65iVuY09KLqCQzerRuB20htJtBwjWQaCkse0BAJ520K2lAH/aobgB4G2P5j/rVmoJdYSRBK/tZ6b4rWWbOD7
+uLJV2EmUO6tVZuwc75VCyJSzeNfwZfbPVReHQTgojRbCMQuTlCpPpO0VJ0CS92QtVwcnfCC9zADuimN1bmrv6jpt0vPpe2xEV8exg5Uq6qBBm+4342Kv0cU6
+gYJNBwDwBxVcJGlE/nxgMNQsd/vgfIKFGpSd/SUr9F1aKUiGkhVlm2hcay8OO9+hRjzuTcV9eSiDM3E47yVxgZhgqmMYkhYVLcqbEMohk2U8bG/nw/A8aIm3
+beeVmK8jrSz/Cf2n/hcxIIp3i1CdCTIQiGYTZK2w9XwFSUI9fl/0rTVOqDFs1p9NXPc23hvv8bO+YFh+IrIoGHHmkh/DP9fbjeAROQyIvsxRcLR2HcaGoG9F
+Ug4j1UB3tybzIrNdD4pYmARmi+hWXzVMbHE5v4pcHdL0Fmhc18ayz6XvTp9wxoaCw/p1z/yIP/2gc73m8g41JbSU1LsN5YNDQ39+JiMEIHJSgkFzL1WokXOr
+8DU/4msKeZ7sQ0Mz1lzF+0O27yc0qqicdtF6HEfnsGikypvMjJKpyJX2INfyF969rFS1dxZ710zzRMD1e/Zo2ZsdIJBJIwJK4O60TGBVF0Tz6sjiwZc31/2Y
+votjJ7vuv8FcQn/6NXF66Yo4Hp4frBe/vHvBFN3GXMjmMHqGQ6l8ZTXgZCxVzS23JQ63vFU8qtwnBYZcovWyNIOssZlJ83gbgFre1Nek73Dtm7RTLG4FhHgH
+O2siRlxbAIVsqpa7vhxawJsSCqEdfjBo9IaV3dU1RlcJgG12dCyqLVSKtPlc2mmAbXGo+g6vVjR302eTbUPUbeEuwDpecYbzxCeqhnM8YGvzxsSj0stqDi7j
+ONTZK9tZa3ISldtF42DUPNy2t/uDWmn5wfRZ55T/rdq9fF0dePnaV1EH2+jV9B2DUVhf18z2eru+UVO/U9OwtSb3WrDXAQdQTeCabCI0Cb1Psu+BLYEu28ek
+CQcrEIuwTZkYM4FqTPiacmYXy/wgQZbyKmlC7GWhpvNU8D4KTqGwKRa2P6vulHlab9dO5c27d71Q4lkfPPd5vrhTxZhvF2sEPApZFgs7eIJa8SEIVrCKvSav
+I1amajIqN4Bp4VDAyky7WCOp+hafC3XGzinYr5d72cfz2Y320YqgcQdg5miGJEe4e6ES2ec63CGonB0v1mhXOldLvNv3miz60GNN5R/C1Y5fu2PvT8OURETf
+HelBOSzkIJTPeQLXEOpaAQEwXkIVwIpgnBeFPM5bPYr/rOQLR2NgjdQD6sop2mNrB3ifN2yWcEO8stMiHhEB8meSq5fFDT1RRd4gOueKJ3TqEnc2MCwT42lD
+HZy/Nb1MxwhplZ5RJoA3ByzXh7WbsOdtKp92YyX7zjiPLNi1tCp3ipo4HFfU0TGJ26FJ0ZwmwKlZmIOcWq1KPHVLE2W7hsVZHrS6lgfefcL0+gvMCC0cOnsH
+2jq1xH7TAW/1+fV2f7zVheyEBBp3rOc5SBEHXcjPnTSQbRKMRJpMlZyUbNYBAR9bjo6355TINHh0tJkwaBC0UEXAWocPeZtyjUu08un0+fRTjFCdn8obE/Uw
+UYF988idlePC2HfeOSE/44E9Nsd2RGPLrWxpWxAafdEpcbaO7CQnULym5/cIYEhA3ejlBSugIpY8T133Utk9dNV6YRDt1757rbujiomtbxWxeNDTXqqoIXbT
+PO1qv5zr0K2hPNWhL1DgQzRxszNxzKbrYSCh5s7Jj/Veslsz7rwubH7kbJdZqdAMNKdI9N3Nlr8qHTwDrwhts986Mrfiufqu6sUf1aQveO5jM2gzd8VT5+RI
+so+fyV04vuYnHS4KexQCwgqgHki97y2xi43JzuDKQVxYaKxibr0WvSjX+tBqGDip89bJ3YMQWmVmOkhCsIFiZTEspi/SrXfb/EPX8gQTAVV3XMUMMgBkBDHl
+RNKGZkH8BDl67drCVz3zDACBH9/mRuqXn1Gv7RecK7/lx99HojTrT/GU33rOgO3lzkdh1Vtb8KXyhdxfB3VKgibtazeSWYKywKFNAieCShXOBuMkuc8poXfS
+eiTflDEc42L5qJLfqXngT7o6mR38a9EZ9KRmr4PEWY4yXHNvduGrj2ZuBbUKJHvC2g1cAokQTpwZHWTQHO8kqeJKrRcyyyvYtdba8ImI3AAYsZ9W6xFAONEr
+/1phX3t/GO8XVB3q1zXyCWxslS9YWZU9jA8EyhUqyn4ClyP79MatQUv5UUiNF5PySJYDEJ8BANUVt0jefVuYuKqVzK3l96Kj+0M2r6P2Ncbp5LBSa6u3BVKZ
+g4Ao8siXLjzrWrZqQTlHfptZlv887qWWxWhjun6awtxHCvOSFDTe5R5pK1MToC+7xqt0fMy+iiOP1vqjHUnG0mDUNRFRYIQmqE1CAOyXoXT23PFiLC+pIIf1
+BtNgfwEf17Zpw+gaZKb6oXIvpRhzl/X3vGZRZdWV7pnfL91k0Rd1VcvNaLy0rTU6eOvHwfMptbd/E6OrVSNZudNFGhxZTzvKeFXjNEq+VCBNTURKHj+k4fRH
+k08mu8uVKpQgBqSlMvaH8usydUQWiPGabLjWvlWt+Y+q3vBXKgtrlrSaWvEm1tp6H2yn1RN7yjqaJjZfYrrciN3GM14a0K+Y+QHKoIT7iDdSxiU+M+42xbLQ
+qHwTBj+ZCGsukIAG+HA0PTjj7pNMlcDjxedz5WKU6ZIctti56/S65ch8NBXp9aFWgtzi7WyidOry+QTV2DzS8M5suyyZIWkFr0ta2c9qg6Di+33qTjECql1W
+ra8vOayPeLb+i1xXManW6vbUoFtbbNsz3o72LeM3+66mLraVU0xgO8b/DCS9ts1Bnq2h2LBIVZe13vjX1R4XvF2p3CTnSsUIu+t4iiPP3bPptvHyKyPh76c/
+qjzFVTSz8t89MsVMqe/ur+J9ZyF39Gfv4t9o08MlehQ2zVhmyC8xamVX81MEk3lBBBoHYKVp0sCf3w4utHlqt5l40Py9xku1xk8z0jvnbEDtM3u19yxLRjTl
+oEuz9cqWWOVr3yvb0Om7r+uNQ4UO95853zeyqUUiS2OVc4vU6pEuJ2OQcS85IYkAD4WdkkPWR79N0HYE5FRz17Wv7bkG/1awtmwdmv8k8DNj0ULg2oa/XgJT
+fq4Y+aZ9bdovRpFR3F9MEzVaLiFenFLF9IE3sMOCymVjF96ePp5+Kf2ma5M7OifoRHfRbzwyDl6h2Eb1/U39nluGTpEMGC/EiDcxJLZbtyhP1CouZtNg1EUQ
+okeOH5eYgohgKTGcG8gELhSqESB57qxoFxcEzLvShwL7uai2pF3YF/qlrPTrqnm1cuHZKkK7Fv9FzuLsyD1XC9v5mXvQy+VxEinZO9aebVMN1IOXnbiqMAlA
+wIElp9hqMgHFv5kSoeiWrziODHg3a45vucyfZUTFJwOLgw197bpJMc19cE6A4+gPy3tZ10+8Nb9XjITcWyl6kJwEjh9xeTuD+LcEkC1lH1vYLckUvFxLk0z8
+N8oXU0yhcGTZtV6nDWPaKjPjj6tm1Fckl2gM03KpeH3rntm6P7qN3DUq+n2zj67rRmzDGOxE601Uj+JW23Vc+E5b/VAjIsHQEOCUUNk5/C7AKXHQKINHAOVS
+BRLGbpIaENbMEZth3zm+Ay3bUnXhgM/eajs/EnU7lAza+aq7lp9DaPQ5FP9+QY1I2YizGrvJLTzGzim51CfSzNJSe5ufE+j8O9F3sF7hWv/7Ub3mBe1QrCZf
+9RzGdjB/2UoCHQYiBwla+z5uxpVnRx65QLdlSpSCN2DExbN+s5HPOx4fsG2dDmDpMkekShfZnYUTmXkk3khKep1dJX+wVlGs1QW2tAv0uJJNBExpCPP4kYoL
+WI8BDFjxSM00hQVQs+81v7Y9lq3PEB4bpdh8iEcCGiewdGYT+pbH/kzhC/914fN+zBlX+TNbG2bTcuIB0ngG5HA8tB+cA0x7x5W11nC1gz/75BTm5RLMxoIW
+LBooIRayZtchBAral7Zyn0ZqtEkjQb8a3ZXo2vaPEbBTD+28PqyH5HQquNi0T0AZ6lUILftsaMsTYlyKW99+Vo3HPMnhH3O71FXW9FdoE438grhn9jsMrpYA
+1mNQoplrAEKeTRJ1MjkOmMgUalAJNvbMgyQUtOmKHgvLc9gHpX4ovqN1lrpo5ZMNTcUMVqTdr3xELw4DcvmeGfGU0/Lnn0C0GIK2VNkD70q/6zhXT/tGIMrF
+z7hbQDXHjvj4i+uoaLNVCeAtFwkTLo5roApsXu4FEV+ePEpoLlhVeAGXO8zOqpmp45BcafX3e/3ML1dxsz6+oKtXHJhrfvSowSu8Ko8q1nXuag4k9Lzms6ii
+vRi4xUvLWPZa8c1KxkKUWh2i0hFTNOCqxdt6P0LLqqfDlSCAR3FrtFzhKr+gVX2suSYn7xc1cZys2ula7EBS42JxB4Rzy9/voZRMZhAZCSXlr52dRj8qsvcn
+1rkrPQ2mGJqMoywq3b7Q+dgfA9ar/Cz59MzdjOWX18Wbeoq5HCVQcqI2wHOzoZ0KZfWwx0+yKuJMnZsDtD9W/iVpuycuOuvUQrR1wB2Oyr0TTpMZb8F61JGv
+1KI9bmV1M8GUADDTKgVvU+HegHjiLvvU6Ge+IbByvLdBbv1cqPY3/U+WMdaJz53M47g+rEfvvWxFHy17M4pqievOZcZE+0qjxl2aLMyCyqRvLhlkUa9FSb3H
+hKdmAWNqLPMGwLomQTNdu/RWYQIMI5ZB8mG4P143DaVX1nM6Wmu2wNNKjnd9WGdV6QWikLarTGGl4CafGiat3ibZNjnRCk6Q5f4pMZas8be+yZDeFX3QUH7d
+XLhkPJJV+YBeGyo3CnNPAN7lHri8fH4H7ioon6oMIMzjyldSiV84X7q676PkkDB8T1YaJCagX4/74iytRGY6fLoak6AQFWSUmtUw+5S6BigjTAL5lclcXvvv
+8x5HiVkurH21T+fXPgcZ68P6mu9VipcrL5gwe8fSmrddq2Qu6HqLlmppVztINdNXe0ElwkAnDE3VbZz5hsA3UvzFZD3Fx63kETT35NH1+qBkKbLO5zRKawZw
+8YtlM7CTq6aQPBddama6Ow1TPKHuVSSDD3r+lg2mgutO7iOv5IDmfLbsi9iDZFfT+M8l41o8kC9aNeee9XjdCA8pfrREj2rRlJZbJZpcg0pS/2tWEkrNaiWA
+RjKPVNjfMafmniTk0S2uYKPCca0E+WWkO7Fj1pvzDu8f/GR/VQ8saXH/btRGeuUkY1PmNk+vaW8uhkQqF4CBqAAhw2M0LrQ4JmuLz/hYxpQR0O0bpG/Xa5fy
+sYIDt8fFj31oLJ/xe9pKZUNXjAghTJVRHx3fOYlpwfKB0Nk/6knCRrx8xjQkiTFPythQvoee7zeqDXl/QXEq4k/RH0sf/DiuD93nvLYdxbqd1aPcb0ynWvlK
+Qv6F02+Tpa8yBviuufI2FnZlhxSHAACS+dMcSOxhf1N6p3egKuvb1vGnkUN06+IpIlrsZb7dvYtNtNzXyjlDOoYIysyJpJ+0WW9c/qp19C5/Qe7FtavO5TlC
+rALpTcqXZujNH7pk9TfbqkxvZ5eevuvKin21Pigp2WqtYf86H9bbztiD75y7FPEWqsHWmXnLK3GLUflL8sYyawXwwtxmMKUMQZv224yDLCg2bhSbZemKTQN9
+EN8yP8R/GB2MBl5XMVvWX7DyGU3K0Rao5d7ysmsRczBlLO9gi669bfKfeD3jcfl49u6BdpeVhaZVI9dus+txl2wrUfmPUXNmCdhBy2kINqDRkXoqvclPVecl
+Xt+7Bdxf3HlgUmxOu9xB0VlEICUF9+19zqRVWc+H8tY25nrWPfofc1SDgRpEp0k5Dnoe+PyQTVw8+jKGax7NKmn7vOR5yOtr7aBInpR4qCwPAJUJz6jcScYK
+vODRiQnmJEzV3f7m9Bzj6KPCd88neBsgRWv9fzNGaeklIelVaXyU4hhbH/tYgLokDD7OKeNcQbn8PIgopqldm7i0S0pH3/5ao7AyBvuA3hp9v0ktCDFe5XcJ
+CHL5hzrbmbI2uOQ0aW8zzU9odiQzL/Kq91Vqn5/QbP4T84/du5J2kaDMA5Rync9QkXWO1kBGTZbuYyTrt49jaXhdUZuzKGfEO9SjLH0acmUOVels0QAtnO+L
+PTdMFFezcs2RHjpsMhiyrJokTr1lDCc6RTKuyqkw8iln/cH3nHFSbiuHm+sQ66Ia21Bt3MeBH8d9Ph66gEZIkSVnX8xpRu8jId/H1JxzvJtOqlDM3rK9V5RE
+y7uIkmMOZF3W7AUE3ujNfSf5noVFY0TTy11uQhZfqNKXZRglVr1WVHqaHfQZIBAONbBBHpS04YgxbIKAct+zA37LFYtr2om6BIDI1y4yf4/Ni3rUDzqRDq0k
+9fqwvu0IwzP2Lln5QdL2przgA/V2hftzYCgfFw0kKueVXNOv8/P0lek5jsfLday9f+zhM7u/UERYTWl9aIfAXUV+tq6s/FpmyfIyagyTcPuDVm4nzv0/Rj5h
+SbXTAE+wSlI88HRMiFfebjpixkDaxiTxUQVYrrf7BXtes03mHa8UoxOWk9KtTSFrKSDtIQHBSdLzNAH/TJM1u+i1IM82iRdT99Em3QY1gh9yDkZfB8p8cHTT
+thTZbFtzvtItpeSl68O65bFdCbrRWs7rqmKbjpVlDDRLIEECMfxWNMJbN4GHJ84EgbAnlGvuiZAXBfzxwTFZqg6u6In1jIT75uxqc6znJsQ+TbaAD3FNt93V
+Cmxdh4PTQ+nZRVaQRFmc4kF3S9LTuzxACVRTTgCxHvitxjF+f+S/Ziq9dfWL9vLY8wDtlh/k8/DGZxDqfLsnqaBwDSWjHANchkZ1oGNN5aO84n6q8iE0GvOb
+5TB6u7aiwFmezPYQ1sqZNLxX+XlbcPWm/qoVxV2HvkbUkFUnxNgx2wQBADQ2DdM6bSWKWWJN6Z85a95W6t85P4rfjaYNKi3KkOxe7qwamS17UcAiVU+hYHY8
+B9N/mgbZatqZNSG4zZp6yV6a4TcOxFNLTQMWGnLzxDZsSiS2S6bUPrKtdMnGTFBuKzpxSleUDvjqyDvI1xakd2om8FMWGVzcCYejNTcqNfwxCyeM/Lc5sFku
+XytXQWg2BhJ/7tqzVHqxqIpchR0idniV8JRIgxrT/5dvWcgkXhJyY+925nTDUz1sVKMW1taqF18ZMsemy+imEqpIImO93c/HmJMnKmScgkmuLk7CDLA0ZFDw
+KavaUa+cK1mf1GBKv1XjamNvLqO+36I9Wh8qaopt27WO6TYS3i945Z2hU++NAIGhsnaZ3DmfGT6Oxwlg4Gt4Qj1qpR0mY+x3T86z+H7tApcoXVlOl3edZ6BG
+o2F0duTQGn44Xns6URNS17opWeRX2qfLeKHZj2VAZLzIZPgT6xvjS0sgczJQ3J0xlrI/JvkqmsQV4fZlnsDkSMgXNfWjjzvv35cemLHf1XbkvvRegS3ib2W5
++D1/t25j6lIUBT7gTHX8BcRg6ap7M0/Yq2JlvZW9jyf1QTDwwzwTrv0blAP+uwPvnfDE/NYDVnpgxGhFnDW1I0MsrtfuzOB3oX5VqThn+8AqUKjdTLXpguej
+jtuYOZdcAt3vTEif1DsLdHlgBJ01Ryuafm/hGEbmZ2FO5nXaq8rXFTpw1Z/r7YB8DyO2um2WlW+KKRg0nIcFn1rbDgetnN4l0iuvbNS1/w3S06pnVrXLBS0p
+fCm/IuiIUTb++mF1eI95rC/wvIjnkpoAZx9rWSTUJTkHiU0UGypY2lBa7sJkFdd65XMStbCapIS+gbD65+tG43uXntUMvaBiK2JcLYTAYXOwFNVaMlqvTMvB
+8vKBAmVzOD46HLcCSVhqMon0wsZ6XVwNxClVg6nKGydC0kOCv53rMDE7GX2xTAC8XnQrlBfszzVA0c4XEOWkb+9Zze85M2rFfcNPCl3B9UFiO626z8L9/ogw
+4kQlX8Cy1MscSA+aYhvak0G3B0gyVQ/RFUmykZpEQKvn+ppmlyjSNOTgGqX3MtSbS75zDjhhrtcbpYgSVOxCXq44lhayUeNdD7bQAfleIBepMq9V644WHO/c
+w4BbzqtG015aHUbN2Ws1bt3KoNk9S3aPTAZkqj67luFKqGAmCRwIHAKfRYETJEwTRTMjqSe/8DWDZ6NTWLnWc+z/tg/qFYwMt1FxduGqchWr6tjm3gV83Tio
+5RKuZnxeLTtMTidlWe5WsfgzDv/K1NDf8usTAoRskC9ms32T6/WCmzAdM7J2jsRT36nMEJ83lsnlfL43scTkZOwC0MOMIyu+/5R4L3a8bVaJGwbrOSbfFDQz
+AXD+PydCICW74aPcTzJsMo4rQYzJCkhKeZ4hJ+x9t+v0Xa7d90tNV9Smdj4JvmcfVLM7mFttPz5uayVynnWx8MQPrrCXfM1ih60OgWeoIkPsdVLyVLSNaxJd
+sq7DFrb8GdhFf3HDc/+uBoGSdo4L5pb1c2iGsU2vhYEeAuK1LJsBIBPhBCowAbG5Y0IbHins1Vc0gEEE5GCW44ktVRzT4DKE2ruVAkf3O5uxxf7Yh6AedvWo
+PriQ+iYrAmKpgdRE126qZkuLgchJc+4F58yFW+yaQQ3ebO5GwESUV/U5gLll1vagaMes//l708fVV19i9l2+xErzWyeAp0NOXOvlW6b6VZLfpv8m91cbVZtT
+ZTmN6TfXMeOdl7ER4GRt0kiB8zeM9dv9Sn9K49Ilrmrdxscz3umh3F81Q2b+ao9IlKXMDXvDXE3BurJdGwCIM/HJ6H+BZMfGiytcH4O3dgFJikWl6ZLpbdQz
+t2hESfz946ox4mftdt+NTferm6u4orcRDt4TJpQxu4SjXFfQwLoaIFAPfEACCogY7e6XCxsgLqBSl0OS2uaMAUGusnxHDPtZ9DefVeWDj6Yf5Gv86zHurMY3
+HsLzIMsa9yTHJBu3giG3kt58JCm17eZwc/bQbpxNjztNK1+5HgwtY5zP1J/fwQMVwH8Iz8oPVZWUyFwYv4i6gzxyWj6T3uVxmjH/cJZmzvfWqTefMwXrxsoz
+NVxwvaP7fWjorrTAgRAYWEkb1/XY13dGAriBnespRVugcJZUk6sQPfFZ34PkG3/B+x6Owsy78WR95Toqa4ZdumMAUu+Ph80isbaunSt2a4wH46DctCg6JyC4
+EhmQlcxdXEluXDDGE4Ge9OqOK0gJTdChzFg44av6l2RcARsuo69PL/GsuPUzymm3j6fZPTDhrnquvH/bTUxLSBFZnXT+rB2WChr8Mzs6/BwAnQD98KoMj2dw
+wmS2QDATwD5/eRT1RAueJiiiXMLUmOi6w8sqpqbgBwxGY0Obw2lE4at8zykTAMu+8tUQ52UBUwM+KRmM2ugxKeHfvLf2afVaJM8Sdq1gUx6WP1+LrLxlkg9V
+l1QGaPIDi/nyzKVO3qQ1qMIKbJ6nEkW+mZvIJY0kGaeA7VhzfR9C/kh6yA8Gnd0HHFoD6vbGGSvX+nSsKirNQY3WLiA5sD4ZoJ6niodXPoWc+IUTxhAqJMC1
+TDuIispEZaLavHjpqzNV8J9VViPvsttuIofYnE3i2MHZ1rtZa0eEH22yY57HwBouE7A+bjcD0pDIwfhzQJIpFAAPuOQQBLqTanxJQHTS8u4UfztzZzlYS4gv
+Ac6agVx+ELHFJk+wST+j3VT/ovKxVHbwvTBOsOO5daaArceLuuTXAfO/j12s3nwYq+FtUbVllbfbhGJyKvtXxC8AKOUYyCWv+xh9x3DcIqZMK2ganqITGOUG
+la388hGvZjHKj1ZO5W3DzBHZrVpO+Xwpi1TTKVZQ5r1Kdjg5oRVj/pvUkdG06jKCIcUatO2djIvV2nqoU4PX/n7VzLS6ySIHoYshOXZLi53q4R7b3ClPRXXb
+9WDunfZIN0im1hWvN4++AbE6x6A3wJfH3iMxJUW4iqKUV86ilty3OGo7qiU5r470DDfGoVaKFrMJkk25Evfc3a5KG1K+Vsiu0ZQyOWA4wjhAiDUs7aAVH4Uh
+itAumz+apRKy0I/yLpfpdAHq/hwTFQFjnOQdf6Nlro0rJ9TyfENCesYVbYVGO8jErVOXHhqVUT7vyM9h39SpCdgCrFrbEVjBDiEo7IHEqumUck1N8LOTYZ1l
+8QtzY6ilVDv4I+mr6XcSpj9Nf5X+VdCScOJA/t/BIpuDGAIes/0cH/a2C4fIyqH/krFYBaQnARoFMXUMSv74ROEEcUUtUIFvdGK28oPsQfuwPUBk3oi3kQa8
+T4W64/PKu1Mwkxbvbg6xxzYwryxm47z0VEvRB+O0DfjIrbCxewhsDJgrdDI6ZQK8S03wN5Cx4GWrr4j/rmhS2WqkhwZoU4h7GtAyYGMNiTWjKYdyq9/42nR0
+jRbnLN02ob8amYP0cdeDw9hLr4+L4uMMpxWpEuds1RQ9TVhrBXy5BcGncjTEHrPdGP7CUdqUOrw5QXyUexg0mZpBsUuIOm5STR5h+i5rQnCXntvZLxsOG9Wm
+ag42+86/eqOS48aYpXmYxVUS9whC3EvodmSQ6opum4zRZFSRmhoVRg9h4Gf9HBEOUqkvWlT/08Lr3UQ7Md5R+ZW9CymGi+h2tLyyrmwOj1x7p/CzyhHsQsdb
+noXHYOkMaeIeyrB5mAwwOxkXICCPUsqBfN5gQ9alV93gaar8JZ2kqB5oZPzxulPVJPhk7fy6pEewXdIjeEDXVyBkYigbTACKYuPrHNI4XeuCoanO6xPM8Aam
+VCBItxhXWF7zRyr72GhZeuFsODT0W2dGpQU3fvL4lCGMlCbSAsf1YXMTqKnKT07gIwK5umYYbG2Q5RVeXmHfCQ1qEdmpm8BR06FUqxMoJUoKFjDg/VYcFVem
+Js/sHI4PGHyMjYsZuK7gG01xjmE+SuAvfMzzEesA5Swg8EJVjZIHBOhH64Cjk2XGptr1r95T7SH7ZPpS+lWrv0WaMRlmh9PeBE2RutgXDAIz+R/H9v1aBllr
+WsBgLZNBSUDRFA2t7cQrW45x2ce5UV4q93HA1r3Sd8Z4FEXFJubx35jeFfhYfkyYHSxl55rgS8NjEIpLTWn+vmknvBbjNx7HR7q4mePkYNqMK16xk3feuIlD
+VRSVPjAgQiqWTq8ekKZ7uyqeDTh3p7rYBswY/UpqeGm+yOo6v5X+NP1D+vfpn2TH57rj1fdbW5Y7PNdHR32j0yYSANekwxyCLOnwfIh//6p5FD3IAaESXyDU
+JWOPoEUta0g9gHMG+O9P3l7rNXquGrhAu4RfRGpC7NdT2YXYeOHVAPExlF7u54fwoH8wfSJ9NqDYKp/yfRzoZ7zrQ8xTBY50Y0WXoLSswoBkexATeiGG9wWE
+YK9iVsUjjtbrBF8mQ6/Od45s3SdHtgLHsvorPMvpqcYX+d30J+nP01+nv7vIfn5dmS8bRR39vm7W6t5VFyLdmkEjNUFdOUrLU3YVQn0yux6+k6s1/IEL3KAY
+HgtkaiAh9G7Yz63hgRyxkK1s2/w92dfU+HdiLIaez/uZ9L1NjUaVFvtKTW5mOHo25+rzKmWxu1i5sSPe63tetzE/F0P1mjJpM49VcOyQF0E9DNhaxKpcoecf
+r2OalXGgapohpqe7PFHliBeu/ftVKzY9J8h56YqYMVoQsejyRzfnhCvmC4oTSTwPjYCF/koUrrD102pXaLXL0YYhtUQuaRzOi29Mr0zvTj/MTKW/k/5F+sNG
+1YUHxQnorFoEy6glAyG2dWFTTKqeSPv6wfsMc2Ud2V5574dq+WSM2UU7HXNbFMv1/uSNRuQa1hPB0EDUzLJhA83XH+XbCaySOusfeVX6SeZG/sP0p+kv0t+J
+zT9ovtmdhnNAL+2rtHnZhxQCz5YeqQeDl4QzYa8N7Oq6HwOmInvZja+YaDno6KZYa27A64jkYgQC/hfPxTtYs0qBopFRNjAxi9XK/86xgeBwKzVd/DIap+bL
+gw17Or2lUdH+Ec/VdLWrhknc8Ahmqe5FUI290ToenKdf8p5bF0+0ueARquWCto/N5FUEEA5isameBJ6MeEzg5qvPdEdslRoxt2gEEPPAzzHu9XMBeWZKRdIl
+2poo9eqdl9Om4ywWr6b1bpWE2uK4+HPnJXp0EHwl3sMIpybNhGJ0cC26kdZNS/QgkNbPOpi5zE2mNqizo6Lvjf5hrpkV3t0/SX+Z/i79z1FzVhOcKmOg/Nrb
+Sl6ppLzqdvS0lAsV/ItFk+tG/OI21NiugijxAkElzp+f2m43kp8SG6A6ro1AP39vueA8tBjSGXwuokOvMbUcl7/Ms/pn7Kv9m/TvDKPbxpZzix9gEpffp/HY
+eWzvjVoAIZSsF2XlmN+VU517YwWgrzxqnhE0lTMJMfgXsckcKt2aA67i0TDzB3EOD6a4TCULmUEbB5Lqg9u+LrjvTXpVek3appv0rKoKKENiBF2xHVtsZNt1
+rQ2PQYAFXFIVgwYrMUSrBCfdhEbjLHxPj6mCyuqPdOUYiddw705BhqRr1+neB/lq9zXlc48cXObaM7wZy3WMaztT6KuTFDXLs/ilEvEPWuFjFFIEAogcIuv0
+du8zXRttbnFVxs3q4me87T+WiaXzTgnyCnp91VJlRpkKuJjaT0r+8UJ+/TXMQPdOYQl86Kzxut8rk+/u4vyhcE5YEokuT2NGyFRKT6Ly0X1eVP3pcgorJ+B2
+cyOfWbdvTAtlbRHVV/gctuqZvmVbK0mVWS0MJbd6+tnT2DHOPJnpy4D8Cg8t/FZZ7TFwIABCqajVITbYrg+kz3GkVJnHvb9CGTr3Z3muI0nG1jCuh4esLwBj
+QIG54kRopPPlpmxBqSq71v4JRJmG2eKLWgsftitpvAYz0qPDnBwpX1ylO351tiiH2lwO5IxIEzZXlyYIO6lSHzWESO26hWal1rG9Mn1reh+vVGX86lOafATL
+uPKTGgK9RmCmv21y9C1GQJqnzCw0kw14f84snLNbxkAo+tcvwG5JUHjJZD3GgU4PtFmr9CauGGiPldRDxK4e4qaou6ZM+tWZjcDqPf2HZs7VKIUmAnNTczKk
+YM7kLWyflRlHdjvFTSDNEOGcqDGGnKzvaLi6f0p9aLPL1WxtFqyzqz6NKtPhcuXKe1ocFkd137gmu446qse1NrwavDJUd/5zwP5PejxmHXp2E0gNkMYAoFkz
+0EiEVeC/Nh7WPNxT2nUoeEFnc7u8BqU5hz2SOEQDHZXOSsR266tAkY0j6cVlird+UQp7WDa1Vqn+ztbowVUnDRfiFk7hIWv38Mfj2Fu51bFDTFggFIZtseNK
++xi2m1UKLBpqnczYEQK2vg0QWc4ZMpY3QAbyjnKjDLWRT8CzAHgCgbcLFVhjN16b3mtdcj07YMO78+Q2L1AFevpk6UhyvkBhCSwXddGivCh85tenN6a3Mesk
+8xlcK2bzzKe7tn5eZ2i1ZjDjPpGOsEXnqHHjweH0OCVNSBRbHb0kKU3a0rM9lDkEECm0SS9GtSsvCj5x1QArVuU+H29OOfX8UJMR5edFf29IegUs7XDe42MG
+aCegCuRTwe97UZNHfg2rXReV0u9fVHYP0cjuWDtRmdzZiQRHZwfaSzFt3B9XaEXLVWojE24nxpWmmAhI27QCkkW8JYzOxKB63URIIVYV/1XGIBxppfeS46in
+7vG8o/bQWE6sVbkis11Sq7hgff0gPIgTtxlOvk+Gcqcza/x9hdyaKVILOVyLbwgxg10HUReSvtgf4M6zUl9K1w8czVq7RYXauZI31Whx568/1Vjyhuh5Nwva
+PD2lDwzMWPXUcxr8rDothCN6HSdNTqIJQIIfU8qTFfIQhRvh1lHtXMHfeY56VD0BUZqw5rubh3jnuwVelLFrqCVSAbA2cSqHmSlJIVt5a89Cx7zPHfWAChet
+7Yp5lH1oPRgfUIayB+1HVU4rTx+b3TlXlmeE5+KuXKUCv6KhjJj3pwiLKFvEwr6sKvKPQ7FQ8kjq977yjJ0R2/mBoBYpqrTCBvIH6U/uH/e2tUMNpbkxHXBi
+TwWRhc28NGfJl1Cea3O8u2ylTM24JUEP16kaCjhNqvo4gdEhIHA+mASUIWWb6ik4fIV4jYBYOu6i1zjjorXL/UFcH2jdnzdN6uz584r2+QXO3VlXrjQxjgob
+Fjj4WOFrfcxrTbq7yxZHZ//5LDWIWXBIkEE6m8hTLOQtaLJ9TnFoAzi5DOUYigVhLpT3EJQ/PjcvxP9Lqm+eA7AcxxfFmq6t0Kdkl45tCK6gTsXIza9lLkbv
+/W1mYtEnph6/IJsmhJq8uCB4yGJPpjQATIkibF5pDq13sI55wNZffrrZd292/PiPsELdV87085m5dKmf/f0Rga6Am0gz08yDJmq33jdkNWJ+0EQLA/EULAQM
+bY+fnBtTgt4HVdeVrNyJJn/Y1wuu0weU+/Gn0q+k301/cLaX/6j+zQMa+Zk68rC0MtaWnw0z8+YskKzy+7tj291PjMmYMJ9NFdlRzAeuBoxsqWKXP1XgYO3x
+n4zMWedLfyGrk0vQ9yY9o9xEH60o6kXbHO67zdlGUNmhQWDI44WicCs9vhlt7hZOrXSiVULucu+pk02vwUJkbZirBtcQhFDJ2htxJER/7Ly0jZ0tXBCm8fR7
+/2xcEA8PTm1R5ePDqSH6SNUWxoPIIdJywKq/OrSY41elkT3Y72eW1S8a25P4bbVzeC1em8J9thuPlM7Ym6uz5F7Rr9Pl6b6c6YhomuXUpij6kEocWilYK4U7
+IBCtktxvbU6l9QIM2y2eyW93f/4j0sHjead15cOQRJuxgC6cLvzqlcHpDdY0nqkT2AePxvUuZTBttHnNgK/qJBkI0V4IRgKdFaUMdoqx5NOdj/ee0LHEOeg5
+Imlk3pcy8v0919uhR5HdZyHD7uijuwRcso8rf5XYT/V21DryAD3idmqmOZ1o+VSJcEAde7WTJct4dL/9B7xT60Hbmb34Kp95Zo37CtADNUO3nQdiTdsTAQFN
+8k3YjU7L+Sbk4p/QKvgyHioDTjfGgtcoOmIf15OzKOQ3OI2Fc6BRup4x6O8d/ujQtNWxvfo7JoUJGKLyG+urEsfoidhEMUPyAGTJeQ6CKigo++ZAwAXVVMyp
+vFIxqhrtrGCyRo7c97AVtOg+QVSHzLV5vAz0+sLSqM1GQRh7kyujBi+Aw2bneVeCSVdnGPgAwemWFnTd/+JiJkVq5HZlhCk4VTqN7BzBEOJWTM8ENfzI68h5
+Sv7Aat/vseu9CH7c47e88IUEKt7fNeoCObX1mrlhR1nWifjcsrGRku3WDV8L/RK0637wn3KtK29GwQML+9UPuFdZUUwBDcxohQce8KMyhxw2pcEgM9BJ/tIq
+dpc0970Fb5AwtD3sU05Qns/IBsCHOWlfHnSI3/lpT84ZOaBhi1t5GcDOZnw774CfSb+T/jT9ffpP6b+m/1fsNzt47HUIcEB9ZOei4UhyO00+fVnbQ7f1l90J
+65/L8lx8ne8LF0e9OKOSE/Gcnhb1ZAmrZwiwSl2QJ7fJfEwgmWKhYQHUBmDeZRTiWv5LAtqzedciz+KHaF08u2S97jqmTXpD+m5FC3PtZKxMXZtR1VX3x2vX
+KR0faqXGxkqtdTFeC3R0pcicE/PjSmvERBb9tuYKAw6DkNhAk091VeO9bLDKXzKasObs+r70KfVCf59xYn8e6kfHKMptrY/hi622/my+7BQ7Zp2D1WIQeKUg
+ui5FYAWArTYZ1+MILRojSXlLKJchlk4BIAvdjecjCU5V9625EbXKrwirWrX27SufQatvUMVtVwk0z/4urqRaX42uHgbesPsizbFVbmurqSSB6IzWYK9sXwDA
+7glH/Mink3UVZDA1oTJe9b2hvGEyrC+Wt5BIHatLKrke+azAiKpbZndJ1/3H2wRkS/mMh73S1RjNySFkNOQ+2Ad0x0AqzyeQEYBkjLWbIgCS2bVkCM6kl9Y+
+bK3vfbd6Cimbl6SxozF8b/tPrhPMEAj59Bo8bLTFhNejkWUMaOofCB0foDKr8WMq5S/gjw8Ig2cjpkaC18DBaKBVux66XJo+hVIhq92Whl9NxkfQDi4MqoxG
+xuWVJa8ubW6syTQ0ad1YDrhhuK3A8hWxWkMdPA8cbeTyLPFGKf+xLwyIk9Bbk7yF52SimptBw6NbEgIjtmnmTbZ66sVDNC0IvtobU9rvOlm8vms1blu90nsl
+U8AKkBL+Y25yps1xQSYP3lgcdoH0vi7xFgtqXp6zC2VH2B4tvdF/6s2hsazXx5Cpd+2lKizILPwoYric/0qVNVkONxKAgcEHp6rJl1MDe3Vjx73g8rSJCubU
+48KkAvHFe8ZkrAEVGXZU3Fh9UvvsZsMuX9l5VBbHyN8cCSKrCOWInGhxlL7gENXOdIPOBACNBsk7HWvvPCJBjn9cQL810s/c7tKniTeH51n7sZxjh/VWxNZA
+K2fQZ/fNYZShDAH5Ypl9rcxPhl9xpHyG3Obgag3+e0X54mtZke/Jviq361HFgO5djxG3BMTW9YHLEQg1HktDwId9kP1ja03dCABi11+ruVJ8e53auIhkFok6
+1KWKwcreb66DXwX9bOXs36se95N9ElPd4f/264xLn0VcEJwth7oYEqc3Cm5ogBN2+9fqiMYNpX7p3vzb5r+ZbTU8q9nU8ovr48iUYkYlzSe4G1fkVJIo/yTo
+FoVIbBkdbW7h+PUWKFjRANkIEPAAK9c1M9WMhV6/J74eC+rPx+3mqCTwx63RwRuI4Li9akThWfxt6RqaHqG1htJMAlofDefW2onaAqcdwpNtIGy0MPeqlfAC
+1qQnYF0D6nl9jv9fXqQuAIeAZxdq6fhnX6+sgEmhYuWr5WEt2P/3MtfmR3QE6+rvaZfF/WOSMGy0gW2u/R7nhEj77eeDccOcE5T9VN4bmEjDqIRgUYICaPu3
+9ovrU3L9L+CadBrzutpcX/7KV+CSxvzi9QpC82Ai8x3ofEiX12JVmlc45Qy7gcrc/yHVkxMf19mGHnINWwDq8frJIac3Ph3LU1EzWuegprg8GY42teTaMs6U
+3x5t8lMcKZb17djS6xewSw1uKlbYpEHnF7oKlii21Hnq5gdJBZkSpdYfv00/J/74uku+9h90SYXtBZzRMUOLfa1u8TJWhWkZVlVmO3uAN/jZR+mDrqvVqrxa
+B4QgqB4ykjKG3UXFV8V3L9qfue6rjoYWBmIw9/LVxM0r1hTSjo61HPmHeQff7JDfVXLfcKFbF8F+d3T3X353JSBLY22MV6cMQbQEULxGnEg9R3lnDr+QU+MX
+NJzs+YyuL7j9uWr4Q5Xdx/wfQ5kaS9m+HdK+o9LbHvPDL/mVysStCAAICOcfdvmSo/XdZSShAmshPZDT4qV3Jtlmr35P+i0b73j0TgpVUjwYq9vD2nmO+f6h
+131b5imKKwLgzNhOtOAgIWr3SNn1Lh3edvdYfarfxkBNPvd9vI8/5j5hyk8ayR3i1o/6WouA0VXp5qDlsI54l+scZKyYAcpJE3fz6O5OZLnJBEzLc2LKtd8+
+9om/w9Vx6piLn/EVi/ketHC9f/dJgLJyr8HF8hC7q+scr+fwsfIYlfN0SEsmDoCnYwkgaxCGtpfog+7/7qsV22+kHPLEUZpUF8uZu8JwMMGZgG1SVQ3UmtB5
+R4oTnyplH3Lyb9NoRvrZC3vOX4T8pxnd/YzSJbAuPvGZW2OZ8n3/nAkkee4wJOhXbpx0c7qu/aITUsuHZFmODOV+Dsruba/WuTO7Q3siNFqRcTdI3PsbT+Rr
+Wpjbe9zPOSS24GXLhRgPZ1mVy++O+3PepkQLzZhJFPNycnRRPu9wOtsZah22YUruuCxLBfrH0++lP0p/5lrOHZ+l/H8M3LGi0XDrLNv3zZrXEhXKctzOlBgP
+rKJ62GvVa6q6Fr4wtNVtdjBoR4HFK9IKket7pELCz1J26ZeFeZudQ0nZJBudB4nbvjPUq0vE8hvW9feEZ2GvkyH6qzY1LGKeY14+mli+vzL868J52akTkol9
+O9eXnjWmN7d4dIpVBcIMKs1HrILuNBTIkx98quuoazSaTsPG8nEP3W02UcfrddU1ekBujqoqmXYVlDdnXLjs5Y6806WMfByQNq4pVxWZD8ZfwXSGx219eHEE
+JSmho1B+nql87ImE77C9cjoABE1/EQx2v/ngSR3f8hdiP89ecyOfco61J88o3C6q467uVcZdvCBVIHdJHfdcFgFmKrll93YKuRFDt/e9Wfflr72AsV83SW3f
+l8Kfc2jUxMv+XB2OWw3l7pmIyjgrXfZikIEMS5rT5Rk5EYS9aATiuhttKwKIRqbZK1F+/GzIfla+qsKG/TC7FVfzQaK8vVLHVQbnkHxR0Z9Sn1KPkdGGWlIc
+g8Ziqz56xpxZeIfGOyi5GM27oa6xTktz0awNqXJ18G+fEgARH5N3Rj+/FEJiE0O8kuf1B9OPsBrrl5cUvl6oucuCU3qw2lc+l/HI6Kx4M8Wv+2yiIH1b1a8Y
+N5vG6s/P9b7UB1SKoCfbegfzOUqH5+oSARydydTJG7SjDE0odb6XgCGMRGZToM3BrVhd9h3pb71SsHH2325ALRHg2uQFWmrGMXo8+7Yu2guSqIu5bVj6qyrF
+iqSio01UsYKySi7233GV+VuAaoFUIyuoWSPplBkCAeNyKT7mHpre+JJTMvXdX/X6vKEZ9y1dUcwt2dJ/cCblrE639QhN1Hl0E57JrPDmKPeVIRhnaaUz2RUI
+gJQ4B2Po1FOUL6fKPEWmy0ChuQaAelRchPK1GRn2xKHkpNiYyeStEMoDmKDQU3CFh12AtlKGKksKAAZk5lay8rYYD+05GhJ/7bOcGfjyCzgxdy5Zmhe1xk2W
+9GpRkvRMWVOESqshcxCuKpOe9RsA74SvCVG6h08pI/TapFdBG+ILNQcozNaHdd3ENg3lwj2hNVNub/MWW9ypOoJkfAikwpQzr0Fa0PlFW6oQ6I3PZg80FgLX
+z+k0616tEXDp6DO9EEx/XDIJu5gHFYCRD/KJDzQtyW0E4n4QvgJuND4D1nc2fPUIyn86jOXyaQYgXhvmUdlMWTMKeJ6cSAVs6Zxj4PPJYAPyLZ7dDQh5mD13
+mn8ysKb+8gup3zkk8FCrIsyqq6klPw0l9HuumaEz+0c4NsghViLeN6F3uJzdQY4WLRvwJIjoAU4Efl7qTLy08b3f7R0cP6R5KXx4bqXGSj6uRwyT0e4G8bDl
+O7uLR0F1xxaxA2cPbi0XJVN6wRV3J3LiNZYTWwnk0oMF0JqFWsy2lDsn8hAYa/pJewwxZishpU3jX7Ta/tLrIF2Cv8n5mL9Mf5v+ddXsemC+ztI0EasQoXxd
+2u6wCWm77Y2FPeKgB6L+3nYhntmLslfQvXDr0G6wfdmUoCZwWg50AbT5SQs1+GlvHWdOQ23c8s6+Jn0sfcF5jZ3RWMFCPqHj8QnmOu7gsd29T9lrYaMOKIrL
+PEeTSNss7lxB7gK4aCkwpJsUn225lzO71yamPCr3Wr/2OxOmf5X+gWfAui57zHXwUdvyHd/3cR8kh7w3/OkoXvJDV2jQxuF/c912Y7ZN63bjRWdq+gtzh1Og
+9cqN+9qyVcqkNX1L/ZKry6rhUet4ZFs9mycPeMTKqUTq0T3DQLiaYwJM2sHlk9NytgVh8ueIc2WeEZNzIUjuzZZPkH9B3psp5MdWPafUB2Z9WpvxYetAmzhd
+I7mEzmWk5XnxDMQNliFnBjMtX/QsUpjAmkDIdEUTG/MhtXBiN9zqE8vmOsnw+B8gQr3cDZassH29P7AtlwindEOULMr/FLFkY9vdMMNMOsdII5CRl8y0FhTK
+j+2+brLNbiOhhILs/d8p/vWKYALuMSVs9tHAWGzR0OC0CDT2GYmBmtrnCZNySUiCqsmFEIVJ73pH/N9DkRrXB+I1hDzdRzR3/hPeFc9sG9lSS2s3o8fR/PDt
+/kErqiZRNg59v3H7OwY+uY7VGqW5TYms0hnrkiUQNPQTwOSepqy1iQAx6MXP3IUpgZJ4IGIE+QWfsnSKFmTG8+nnvEs+mQaLCupWVp553umgijU7Vww53jwc
+EZePlbG+fK2Qt1TyCguVzWIe8YSeN+gCa6lsN3r5bodRspcUFs0Z+By/MVl3TYsdu2ZuhdIh/sPpJ9O/SH+S/qqebD1S9aZl7NiM4fETZHasXXec/xO+yZ9a
+iz6odGhUZiSQfBQCQPmprDe88hZyOblGvv5k8eezIGjKS/O0THhmMamzcNPK4l3KNUaMPSjvU6SoZAd+gdfjH5v3MO7XMUKOqyrkh4VbtKJGyipruZUOcsht
+pcbQaUkevGP1tvVXKyE4LmQCT17+gyggGIRHssNvXB0RmddaezvlNqRJa2YeCpIZuo4/6J0cUQtHzs+9QIzx2Ihr+awua2f5HoUJzrmSkmEAspdMPWtqtLPO
+pRDqrdXQQtuQL25imjemt6T3K0toYDu5PRpTfJvw5O/b4625ovvikFv1IMonrg8LW43p95XPPIhsE0yJdxhReygB5ASEA01AjVNIxp1zx4YOMKrgOdlJ1q45
+wkZUzEH4J3QB7mZd7FVVKlZcXlh+YKvNvbV/cGMehx538eBUx/SY+wPwcNwKQuFC9qBm/xWxb9PnADveSmzCnPTj/oxCsE5lK+lR+VimDXL52eSo3qUsq1JP
+jjUsQSt4mCJ5qgWjzZ0gMj/rUaUcpKG8dwJ21kHOv3Yze/WwKfl1D3IDHJOtEE7SyTIlp5BI4qfXbBStkuv3UTXME0EQc3jcCChFTRD/1nFLSAbvV9mP+gPD
+cigOXj2qEJwKCEjvH6KU4YP9rPN5mvXMswJA1e6raweCruECHMxSMzZx2c6ziWKHA5zztJq0TKaY/kxPd/zGPxq8rJL/FEWaVi1ZW2LWLn6np99mJTP4BLXl
+B5ARDKRuu3CQGBpKhHoX8Q7YYictruWf4j65J5/PblE4Q0vQ2fhd+khQzvqlynatOul7rX26+FjVCbsPYyj4QqNy4UJI0cw0ntftXtaTsmCrlQLOjCDiIsau
+7Et5L/Log+yO2fGupENO6xJKJlpKTQ3m/S/S36S/DzmAg57cNSHAWUkTXfMx3rtgjrUd0t4UUXxzLUE7OgsLsKBkpPdY6kXZKsWwYOox6le0twlzqokQTQ4Q
+ZFjA3sk7AG1z2x1ZVwQ9p8JKo2mZw3/7UD6BKGbXRNoN582t1tOW8zELXn3rVmSTcxcChqbVN5ZbNOwR6e0yx2WTUefGk9MW+qQss1H4S7n+Vv235k78VCu4
+zf4sKoEf0I6iny5soBrMuYKkT2GlIz5zdJo011gLN+ypzyx/1auph8x2XXsCDvtNFpYKXU5FwZmNNMX2v1WaaGL9/4nEskVUsFIG44RVoLKeweACyDZN7nM8
+DuJaABagWy0n1m2Lj2FnaLrSlWQOeaNf7dY6Lsq9CX1vN5Gm77CRkHNtx+1lM5hdWesgMA/MXikk14wkR/6XVxQb2VpB7eHq6E9aLoIJm6WXgGYW8c7JvtIz
+HT7imXSdHrEGT613V+bdplmyaZqcAcWP2/AbjlHWM8U4HgWJuJLITrhj1c5rPQGy01rJBinLZRYla1hHhIRuCRER5/wLMr5Xp9c7M5txkzWsbKuFLuj92jug
+olr++lrb7mZjPW5tpDKkcKmGlFPZMlWQirTy1I40V1N1qn3SqJimsqvaJIA35L18YcyvY//IqlM/bh3+S+OupX5nBF4Hotww/vH2SYdvB9CkDbLUgDIeOAvy
+dgWi++nVzsLT3dresHconNKypsMo35ON3HRXT/1Rqdsjl0aNc0czn3Wog5mk8kEH5BS2ATaSRWGnxG8SzVMdoh+uOpSWsq1e1xc1urt2TUvXv2k/sepTf12v
+7r9IxSoJLAVXyS7YkJauhzmvLd3Q0scNtvhN6a2K5+TKQsDPyLLqKT56cutVJScwQzxu/H4zFG5EP3GNRKhcsaqDDDzrdpn4GtSBETRq+YHdO8nSG+5bZ8LA
+IAjNgqhz9cbrqsq1mecyvaYQsGK3DrJor+MDNpzTNHSuC1pX82QbCGjpAmtjWrpbzjDiuRpce+1f3tRqW7Zzwalw1K/jq3lH5aA+WN02sKQsWKVNo4ixOxi3
+ed2hs/nJbafdkCKsRkE2GY3R8KR+CfarxaJ+XU85Obe535iNGDEnXJihF3Vxa7BMjLIXzgYpJfbF3HWw1bPy/5JlWpm31egLGYnPWauEEDoTT0SznLO8lNad
+rS1nzlv1jP24YksLIutfmv86v6bar7jZtlc0V4dUkUZWUfP1cqvaDdYS/5zXiC6tglWyvqQE4QgpWpCp8dUJgKrLtbgUhuZo4sKQXPcJ5wRkOJfN7uzIKwKP
+5AcYj1RmLykvuaIbjD5WQ6YxSvmPunsWd87FdZJJqo0AsEpTQtejmygoVsCFJaOY91qsJ5vkyQP8peGHmKfknL/NO7sKH5HvCDeGymgj+0OXzHh88gGvaIIM
+mpPhwoCEf3CXJrpvtCHpTCjSwPnsAGuN/fU6PmVpsb1+cICJajQZDEqxKBxz7B56Blpzj5gzrvlPsMLHpiJ95gy0/yyVMFy08q9wf6REqW9gK3ZsWI+tq4r5
+cWM/41hHW6XFBGc6ataywYhZ5SHqfz7JuvYsOEzgKzRltt6DYlG1j+X+ZT60E6HJSeJqYJTtAzi/3mPs9Xpf85If/z5VAil9HS2uZ31QhcygJnt4fmiZm8QO
+PpvVXgqf9nZzqMwYx4cto6am2JaNIjQAGvPYrSiretKJBPFsbzzVDHjGuwTUR7at34u+zt6ovuTHhOfK9o/AAyw40WzIumMKftDRQJN4P/yxJ1iJG+xQ8pn9
+lz5OzUNqr4WRBQ/n/KOYOyvKt2/lPcMdGLujcz8HfcHyAXNtOD00WKu2veA4rh/mLMunIwm7M8LUqi6XB5ThdP7SDiZbIoLWTe5abghTZ/aX5uCdATtpsWm0
+hoeg0dLrUZtG8v5oV1mqqLeH+62/RqIlZojCCVmIwCZBWqGOdskULJbtlRqTUad9bkvvNTm+1wUfsCpObsKlr+E3J9OHkV8UauTxoZFRKh8sm0akCiOUJzKc
+UEg7z1zpodxPRFJwRYrMdXPmXb++sab1Fo3/pPrHPA1jx3vp3cOV8FKrByaq4qE4v2376N4rrKY9UDwp526ZAg9yL/ozUXtEcBomhEfnjHvc2y92ZiiOfB/5
+QVdrvPJjrMtd58TE2I+rB61mKDcaAIgAzeOCLE12A8C5IWptXVONU8pykuWlVdte0/acZy5TYyFxJMloUd0SnUyQk3cymraA9qBNjJN2z7ob1we+E1oR98yV
+ruW0pmYGUTYeGoLXbmJe3uELSzRnSqui6VXwAmk09ESNdz13HPhJXPMluLR747FZ31QGur10mO/X+XDcPswOZOkggya5rLGqNrN6mUJP/hOdOwHI58N+QvbD
+HXSvgPKwMheQd5qfs4ff7GqZJQoqq0s5i5k92QpAdi48UlRpNszyuLl+wFYZyFizFQQjH5EhbufWiHAZM8+yd+5KOTEBIMRxVRv4dOAoKXkQ0bR7fz3tr2Jf
+EjsuqwoOWd/wUPt33ARv+DY6NZcPAPR2Qst/rCA8NfB1o3OeHNolzlCrOfYcglF9nPHiXt7lCCwf9F1B+e4r9eTzrIAu+hjhHg997navnMFMolsGW7FUN/f6
+fCvNAElAl1O9psZoXFksT1iBRbTgDQ6uHjDEaoxzLiSpvwDO5wkQIKV03Wg9lkrMuZjqp5gnplXB3Wwb+q5qQSqtTwTpKbKbv4Jz/CQBNJpUMY9L7pRDc/C9
+lQy2kNX7UMDthXO3c6myNebEdC4sIyBt571s0R5XjcWC9f7arbF2wkp3d1h1/3yWeCrvCXC28874faaYdQcA0MpHy27bSxs7HHuZfox7JP42dFXyl1WEvALt
+jKJW8q8E3SFE2W6utSi6C01Klcx8G6K5ABZd3MAo9VTBRZRaNOIpzYZG3EzACyg3ldQJZvu4RQhkYh855QQABFqAA1+V0FRdccZt1PpN39VpfTK2NNuc6nR1
+Q9w068lo/RwJV44IFjyVeGDnPzvwFjchTzL0Olj9vOUFyBL8YOZoLhD5BxgctuDjdvwTAVD5OzRB6y6hhQphb748vSbt0tu4ZvLp0Ln7Lw0hz1ywBuoOD1vg
+9iF2sRoky6zgcWdnyma7OQqJhdm8i55CtvNOf4o8HAr8A1uVxjJLGuxPjkSemTYAcHlxudNLnFZ0vaqkUJVYB8gNrK6JIZ9t+ED+VUAry2yse0jI0WRl2LZJ
+nt3RIudYDMqBfHAs+I1X53QnXz5ny7oS/A2hpxcGWqwzEYAaP+HVWdiguZYiwTS8CHICpM6DB4tbZUqtpEz9Hu1rxv15672bOZh1zkDY3n3Ivt23YO8IFeQN
+WiMZMFKYhb1K7LXal+oTO0/AyQ5OWtqlZH+nPplTpxEj9umnvJ/n3wS8wLEelB12zQBDaytLMK20Oyci0u9HhxU4r6XIYzNgf80ynw9y38hIRALwCrUVwjFx
+xXAhINswAMCTnZpdTi8FzHj58pBae4YMgemu3ckUSEnf3R2ussCe9ny51ck26dVcG32WY51kgUzeHI6PAsybTbzcr0DvQHD92FL7VLMUJvVpG4EdUkGtyQGA
+WamK0zf45yqfSdAibRVGMnNSr3AvM2LzLBq5lnRG4aou5KixFU47lY1Nb1u73oQgDiYaiA+QibVMFRGho9EqqnMP3qUcKKxtIZMVPMq1htLr7r2B8YYYsAmv
+13zyuzk3JUzAW9Xa2jrDQeUI3R6roKuhWiXJ/tRelaKYDAIUqooEWRcMZUmnIgBJ2pUPVrTLBkMSY3bSaJlTbiSgZ6mR2XUqKKZ4dYztT6/G6B+9bJmVKYxY
+Z+FaPdbeP9jWa7GSHKHsDPYJCE4EROYHqDZ6U+A8mT7gZIJWniZu8r1Pa3TzFmVMv/jp5zopOoZwlK1rhn9xBCcVdOSEBp4INeu7PIrk8VoWDgosy02y+Q1+
+uo7jQdehH8mxoQf0zPXZUUiLfqbZKKxyeXYklB2EmcCIDFruyJKfcJab8WgaUBxWiw7f+XG1H7xS3HDZZGEc4B+56irOPvwUP+m3LGAMbO7NToEyd1TVwdLP
+W7oI/23697PT25GMZRg7RRbspKUtCieV2ETE3686foVn82G9lU0/LqAjb9uK7vPZfq9ypVWecJSeL0SpzpKxJxTzwHdNfFjLS0hAhizO5hwqaNJ4MxGodgdL
+3G1skd3N1eUksSHdO94ZBV4PjtCZytn3oqaH8+0hx8XnRbOWpKB9YSUtmiQF7/OqklL28rIKtgnB11YFHjRrqzFOdgaCn4Hz8+9jvLbuOQPH9eitOMKBcDz4
+8rB2cAa/2XnYtIMzSnh2HIIKrmi6INfg9SQyzbXru6E/MFeoPA8IJO7vHEJLdilDnu7lXJe0ypTw5Hu8Kkego3JEmszgenY4Nlk6FZULUoFuvMWiP/LNVF1j
+ZgoAApHZ5GtMqBp4CKC1LL+0pdkDy91Be99PWBe3Ns0Qn69e57MVokY+YZYKF2AfY708vZlX9vPMi1Nign4Wsor+btfSIrs+SL+axKvuMhzWuRtwNr1r8TyO
+Yxj4ile9XlW8Sz4DpyRjzZS1tcrHKld4sl4/UlvCA+eJoTpUuW+0VSE2f116Nt3qSL+Qfib9XvpDtaaC2tt7da6rxzL6yGFbY7h/cwxQLonem8e5o8+9cNIf
+/LwfFeCBU6fLhyvC2otOMNgdTUzyjEyG4eA5ODnkxWNu0W6Y1cWb21mHwQAAsc/olWwducLNV3vf8eeNx3HhBYfEMhQ25gu9HUgGLV6lhScnGCg+5FL9KWg0
+13hEUCj79O3pAyES0cxAvQDeEdJqIT3am9UbpW7JUQmcYBVJfE5hCi3wZ+8eAUl53jX2VzWVXru1oEmNGfajFf+heCmZpPVKl8Vtk9eQJdbKnKn58gb0CUTF
+0jljhgTkA5gw5BWonsjommzyPqS6LoZ6araYaEHmyykDDSZ6M64vjuHKnQ3/6DzhzYfvPvpJw3yq5E0CAcjYfmaoZ3xfw38qfXMaA5b7w5ITODfTClUqlbtx
+fd1iFeZzn/sPzDaurFbVSDlBM/PDfK55C+eyl4H7ayDM/Dd2/uNrA+rq2zX2E+4b4QL605YpdGl8nSaYu37sO5pnpyc8B/Dl+SM7mCIxoztmz0f/zflFSdPy
+xVR/Eack7RCRMDeHLBIBs4tSM38OaG+Xa78G9Jv/F9fyixreeWC2uOKB/xSvC/eStlGA8DBTlDns19dxhm2fHgI59ZkFzwMBkZVR7id0wNnkOUSr3cUdkCFU
+OuutGPA6JVO5X87I1GyKiO8oa+eDqun65YB/aEUX74P722YfN+PxwoZfWBuIkzpBmRbw/ij1pJz6xRPXQcSydrfK9YLUQv4RQ+xYclhSofy02eSAYC3HtRoD
+wTKtpIdxX7k4jTPrgnlTrVmBLUljkFL7+RnIcfHM2OW6foVzNVxiXLJ51UZLlVo4Cz4dohlNZ7bI/Fh1Hecr+uryqaNCSWTzzuOEaBUXLp2pYstzQN2WDtfq
+JTNejxrdfHzJjkuiRSFoez89F5Fnl635JABD4byLNaXzFh3FURGXQXsle4se8zDflL45vS0gKz4d0cYN1viMMV95o/owMjv1bnERKuqxfJ+gjlCdG4IsbYx1
+7Q1NCbI7rlDqG6GRSlbgS5trdZ1eE1gzPsVZefbPdl1/0K3DBKPXFsvY6qNlbSLyJhLVuWQ/rvXgsqswETcVrVpvc9KfduxQ7S6tI+Y/YSyjkt2EGqVYKy9B
+euniGf02xz+w0vJ46UxeVT2jYnZyC/89t1gL8XKeXU7ZcMxOMClJc+P5OfCxP0AHZ+SJaS1dsy8J+bYSV5vuZam2/IzhXqMvu6sX0kfKJBRnlzKzLtmaP3t0
+Wqlx6lqW6hR421y0pXwBpUe3NmZOMAD2E5FN46yxrbFmKVf1U8o+8SuVhcp7Tdthb0Ij++wIvVm2uqY0fW4erFnOPyRBCK4oWt8pzaYDeIAxTVbO2l71PLoT
+QLqfdEbqWVM6fT6unsTPtXw47kSZAH6Mt9djDBTL0buuZylHi7z6b+ee1JGd0JVdcSGQEq6lUJFGO1UBpuiJi0+BK7de6LGRk3QR5rAglJs/+dwsnLVi5z7H
+GWjrgvML1g99f1z3V/0hTpN2ww3pFMujCx62b3aVH/DkIQ7nvaIYV39L+tZ08E6uz0ifrnBqjkHGX/ve8plAQ+gzWwLgbkh4Ei70uwQEWA4VjHEr0YmqmdYx
+QQYiPl0RFbtWBzQlxCZRiNhp7slKLSzZv+JxuA3IPb+WPHNz0MSO8cYZef61pVHbavJTyiKyW1i1WC4iguHYs4zEoQC6yqI1I2/5K8+iJtWwcX0r6UlujrEu
+OI4Yb7Hexn35x8HzYNKA6tnvN/NF7BKqtatDo2Z9/cJh91SYmYVFrlcYItGAXUrO76O29WFv9qdZjiPyb2D9M/o7RrXZ7YmmU6iNF0uNoDJl/7jWm4LQRogd
++/+0GrVk6SMKZTE7IgxAGjWabLm52lKnVWPPkLJTb+yhxyZKgs8NfIPrRGx6AV6ZviW9QfPHHxKtwjGGSdbJsu+jwX5X5AuWnEeopTWmVV4IAKeU68h4JwB4
+BjkD5hlk5JQQB4pmO5zjr9KqoeHaJC9+3M45yjgPHgy6Nzj6Eq+QPz26ljY+wYlcQYBH6+0sXbzkqV4ZpysFEQCRNW3lZrCPyZU2eKgv7WJ9qQWJIkrZ7//S
+uNl8e1a92coT4oNS+JplIseHNEFfBfLMM25MNXTxu6lspNDmrMAXNFhVE6lUR6Xyagxo57e5iN7fTDhPjry0yxeKD19xpD/RxJr90l51JSOf0w6DUVZQbrG2
+pRvIFknGuScrw6CQ2JhgcJJkMXlSb8MB2+WvmnmDzo7J7U1k2RO5df79jtdL8euNFfl/arlsG3ydXvKtAqNGEe81imOngniSQOAB5wNGwn2cCCgnSQyfCwri
+HJJLpvstJ5lr4hapNhScRQn9AfGioJkuPXGy3z7q/CbrXfQKFWC3F+2+c2vqaslQntIqPaaVAfu5AU50lpaz5mI+BoCT5UHLt36hQLUbMd/yBs6Zvz99t+Ko
+f9F6PGMz/6EJ1hlreXv+7F/zemEXg/3gg/BGL5sH8QWRIB5NzTgJFDzdnngUG/pdmborGHB+noY0IQLWZs+FXGnx780b/pTF8q3d9Eaf42EWxZShXjVWcCGS
+n2CopnDQaEQREcwf2Ds3CJBBB3MS2h7NoknQgqY0RGdyT8XOvTugun9aLNy6OxXqtt0IBVlPJb+QiLrs85O7+AJqpwkq5YsnpE7YO/7Zm9PxDHVdTE7BuRhg
+pVZdYoBfq0w3sVX1uJgB7xTot9zzqW2C5wL5M1lhRJoUUGQqlNin9IV2dJZHDX4dM3cr+bTdogi9NnnPC2Wdj/c6zkFax8RPyxofpZK9PlTZXQnct8qj2dZ6
+pa5+xpatD+ubRXtW2eTAl8Xcep98TuSgSwAo1QNdHZK0mZKT48QYqMwyBi+w4qW/RWuCn1fVpFJD+0PxA6uy/j6w6TmEv66LpUiHf4UTGoHdZBuswlpTmRcS
+0ah1ZfKmI3Rdzok69L0Ndppn2kHRqPEWi6cITUuxpjzin5/HQW8JLC8fTT9WfaNtEAAIG6f2exzWs/AnYMovnPre/Fe+TIoaa46rospP2MyBWYIwNUBImZpq
+mf1vw31FyHcKz8Feu/3nfTBfqcq8Qma2X48PqcCKl/TI742mSxpAfVe1P3YhMgTIBUCNbQARTYXyKZaLSuVNoISFyTqszJpYAVJEHduTEuPUy41qP2x5ttEp
+KRFjiz8rGZcvMsPxb1oNwCJmpQY6bvevMorQ1niUe/tO67xXkdImOGswHjeHZWvD4i2cQyYMEBSMNqZyndpd/pVqfUDDTih/5i61xgbQ3O76xzBw/WHX42e1
+rE82+M/fd9SntpI2nPXtxqqnkTUenEssahJ6H1i4LiSYEQk6OmOwBpV2qVEl5cL2iEKADNp5MAdvzgsReCp/Wo5wJOqS8NE3FetT9+AvS+fVTiUL9L96pjsV
+RxfBeIR/IZpfHceliD4XWyzoTpqKGyMJ+eKrUUgW892J2vieMpzaNTUDqdqNAMjZHjzWL3+1lmgE/ya525exxRL79GPpi84r/ieGALWrfMHbs0XTeXxRFFZm
+53DcVsqjR1V4+nLSl1N7QCsMR77k9qA+9E3IVSxt054W0sHOpZvhknPolsxKd32aOL1sVsd7A9v9b/c65g84o/25qE0gkmX8pZJ3VfosYhokNiuHlTRDbAmg
+j+26CquS2Z7GCWrjuLt0QiHwJGsgcVnh4AA93eT23tGc5Z8UhhMux7Qq0m36ct/pZ2QVh9Y9VVs5mz6oBjyAOU0AJGjvXEcPBBM/IshWeZ88Q6Qs6uAs/EOV
+/gEXjPbAj7pc7ksCLta4a75b0cEaC2wNFixGodUD0QT3jXIdnfN3awgR6GGKF7lbsiOIUgEZ3GTeJUQCmi2KSAlzSv2hpFsl6IMgdHlCnkGfFKokjxgxw9JL
+9Q4/wz+pFV6mKZDC3UExshdmQVmsjrf9MSPjHkih8UTS09UMFk4ARFMcYwbAKQ0JyyuzcRXCfCq/BJHorPVb38HnhfUG/ql6+scqFlQruKNF8V760aDAVVCW
+3uzvuj2zMM5VhVAM10SZtJkBNelZLlH3CnrOr8N31oQxBUY3sA5DRy6EW4d6WM4FCeam2Icfjntl3M8Cw5Ej4pYdn1981YX0Kb/EaGmRaFrnedLYfLg7PQp5
+7aAWzxbMZ0biswMnamYGPWAEJAICJRpcpdlygkGjTF6euSkkPN1wBJfT4YeCB/dVw9g3XPehFGStoBvRm7w15uZNZXS/WfSGzQQ3KeRmgrRJD2qdyPMmcJcm
+a/YPzu+E3g4eo2zq6QDQu7D6533GKOi5+/5r+drfqn025cT5fkcO/IQqFi87rz2pXHmWNTq2G44mKpy7PHIQya3fuzkHi2ptTmQOkqZHhxicqjc/w0kFp6OS
+yiFPFhECgjAsgnxrfNrYuy0dSJG54ued/39tOv7+6Z2V2QGpzvdxdpvNmD6apWNiHZMw68QkwNQdRVNQ7jpRZ3qcx2rqtFDa4pxbnhxszlOBR+bbgl/COHPV
+7+/HfczzsY6tG99kGViapDQiSusMOART/UnfHDpOkJbckyQVVXmMb0TDTJejjgub3vRXh6srUcuXq/5vkG51k7k7djgLhRLtOhRqMAzchnWh4Jaj8spAaOTt
+UxMMF+eTy8jQJtW1y4ENpuI3J2/+bcFkotB9Nn57E9eTvzN92HkKfsY5ZbdGLMsPTbhDraYVXm3Vbjc24AUB9zoFm52v8sNKOpS5UWZS+669RKi9axjF23V5
+E00oAlj1PwPo+tkxparTjvEo7fLu3+bI/Y+kH1qqLO79kGh0KDzXLtwEgSF0OUKNaFYMhOnlPqfMkuTMsNnDoTzUqB8/JsjCiAoSU2Rs7PxTAVvyKY4+C7fH
+r84xYQ0cbDOnBN1G35mXQyiq5JYL6nAfOMySWwoYqtTQGRV1LftgGR12lk3eU6/CyZMXa+/Fj671QKsmf167ZlyCy0RqKnu8ccScseIxxFoePWQQNXn2AgxD
+1OVt5qDmGk1kHZ4hfWfHfWhFza2//Q2hz/wZ7hV6V4MENt2uX9KVsal9qMaLcyay9vZLaTOqqNltPh4219LtrJpJN2e8R1ZIkp0BrctIfMekPk6iZ2PIKotB
+2hpwmA6cEmE8HrKikFTNsQvCm7Pf4tC4b36zomobQVOvtrSSbheKrlccteV9bUzZXQYWZ3asuyXfrB1Tt3Z6r1l1suaLyxsBK7YfbQolWcH2ssFXYpfrK6um
+aHn+MvNx/376G6lPxfNxjLiV2nt5AaygtJTLhykvrBX/nVs5V8r792frE4N0PVcMWqaZyZgonrGy9Cg3GBUvc2L5wqn8xFLJxRaogPYD/Z85Gb1r7Zfq5nHO
+W/jzlfWh6oA3bKfB5Vb91OXexN6nXCz7ivorEHMTO5avrB7A1rUspzIuF4Bnuehc0Rqhst9EITYZT3cYn1vPeZUY5GcvdwUaelvmRbfdkYXjD1nD1mqaFt3s
+s3HHKZy1OKEYbDPRwuN4Keg4JX675o54V+EAkxsjamjzevz2ddq6asOnml6hTd8ZZDxlMQXY+ddncn3aKMS1KiFCMHjGYPNAXsSTKw8VvSg3Q/KVP2CzNYMu
+ttpZUl2xK2xV3l93tcRyPSWikAKcw1jXB+tbHk2v9VLSl79zhrAyQCwkvJKAfXnJu2Arj0hKTN2iQBA+O7Qe8ZD5DQRiBcXCDzJOyXg0YqBFITqJLttV2A/P
+pR9Kv5L+QOLN9bHC94OfJml/8bqqNqL074y10rLt/Dl557qfNmVR6iO1lR24KktgCXuaqOwMlC5ZBYB5YRuzQMEnq7fNjAXWxp4ZTyd5stgD9DTjVXu7n89e
+cVqKtXkBBTk9Hp6tk/Wh911LIH8192jv9+etAUZgQ3zwyNCQFwwg24G07NYbVDLrxplgAlKTTObRRoZ07Otv5Sz5pFa/i97Dn9Qe0u2DGLPXtUvZOAJktfDb
+eDrXXS0uu5Sh6afcCMFmnwCTSlzqu0qziecAEBA4eOCkoYHwl8ZURsuGVuHoDk1V83OXgHPY/ABgCjnXMH8Rg/Ru9oIFcfJ7nuUxkd+t7ieXslVtiCX58byA
+r5TZ3h8XCppXy9lnqVqWn6ACQBhRRcvAyhnQEKgKYHbwwgFC5DAgzvGH2OfDCgL3Y+lTPk+/obrImxxWGVvvo+ktcUx06xnVmHttZs5nbzHoqLn6pSBCi7dc
+5GVbzistAxBKkoTLKtV2GcFV6Mc6v8zQvhNA09FFC1EHtRFFXV8vSa9KbwpaTDXO2m927Sj7RJl0ZomQwZWRlOvPFU1JaFm0ucgN7omPZzyhUpILRFlReEG7
+FuX6S50cMkrPVsk2Yc/j80yDpf1k+nGvZf+u4ZGrNRm7x5eyoM51sA5ezj63D6/0DyxZYdBrO9gducLRhXXGKu3y4Z+ZmoecZoOpq+K1t2Ehx25Nq3dJ72B9
+p8eWLwv+neSVP5++xHvot1Wb/e8rqjtypUpGcuMBgak/r7XRa3be+R9wDtqmFG7/gvA+MotQmVaOpxZ21xBBaqSqspKTDlNcbqS07eotrpJO6Img2V+NhUGo
+AWxySfZeFqHE8gNaeodL8CTuNMLifhNet4pGSVcyfeXL+513VV/Cs5fxrtU6ZinuaqsiP7wotBPAQMSzM0E2K0N1LTIYhbeZ0AZVnBzOCoG5Yz2OqntubuT/
+ssZa/bxntDv/A01U5Z12tqF2znzsccbaUpzr8WI5WX979L9UFYqXnaWsgJEBNU/BwRFf4L7yI5Xz8gvIa25aXkJqqSeF1eXljotmslof6kUN/uDd6cPpkzxL
+P/9w7MH91Y4COxlN2t+fdSXzs4iDCecVkBMOZTIIAbz/aBlrMEBfCkGpPkZHAiiFuOOQvo9VFhVxrMlh/rjCJzXulZVsX0HIFmzvj7Py6DawuoUj7XjYa5Wg
+II5JjUdODfY4lH8gDp8MTH4yNJvAcnhQzOuH4YCrZSBALhs39U/hThJugBZ18+nSTShs6sYseLbDLFfy9HEt5qBJCKt8xM7J+8aYAEUhHuTTY4iNg0qgfgLR
+Qggc6pC9t4aZDEMY6RA+h6aRwrha8nTq/LyKV3txehmf9IYY/d7acXV13CrDXAAereuyv70cjpcvOYRu+fu4WC4/Aa7CyYJNhlwqA+ha8G4QePtkHrZtGmpb
+D9mM0MBziUEvucekiD7GjykP+m+4r2buvrYlr5WdWhkn90a2v7bV7nk8E3q/kKxYrhkwjFovqlK6yKXDSbKzZUBzZwdblE5FJaqPrLMKOcREfYoiimxiqwVe
+1sYH/TT5onPF/44hGqMyfsWdSGyQAyQ2CtB5ZmJ/PBNkMpJAcnprhxHszsB3SHLhiESq1wAwGJEy6MxRjZrQtpIa1pxmAJ5Z8r0LRqHmKvzU4SOv+2WELqb6
+TPppzaX/LnuCf+PI2Z61oiKfeKtpa1Oo4cUGBz9ixsDX0kFpD0199mzxzooztSNIu1x40iJfYuZvZqsJlVC+q2W1ffIRcuCIUs8zOapyqhr98QR/psmBCGvP
+DzvOtigF/3n66/S/dBH9tmEPXXDtrmcZ1Us72CnWH7U9uGMjRn22CMbRvqw3pE4DQ0kBF3oJyGBXBBTVQtvQv+OmqiwjNPeU5JUsPyakvkLWct8JS5JVD4sd
++JX01fR7CdMfp79Jf5/+bfqPMuu7Ng81k4UIOi+ac7qY3F01VQ2edeMdWhdxorqUb51VzySwF+0sTHJmSINes1WhTwIHuDNJLhfNYePVm9PjDn2DjguPtCYJ
+gsDLgr+q65x/yHuQGsf1pU3u6sNLOfUrj3F8XoO54GXJ/1c4vpYZDr2lqGzKnTDM2WyMrDOxEIjseMoES6gNWkIRqVCa0tnKt1wDrHpi2FUgsKt9NyhjnOWN
+vym9MX0wfSr0l/1eXaXbaBhqZ5muzeOtQG515kKUftgfr5vpHfusTmQ2nydIJ1NT0HJ2GW5xjDX1TmZs79IKuwarE86OKQpLFUL2j5+uh1acuybn1eX9nulU
+xMtK+1E96Z2Nu4mCjLC3igx3q6iqlBx6oo0AmOjI38uNl4524gYloQYc5XgJ+Z2VrwnvkDq1rnCzXOq439po7ImCy48tYWcEVK2ejObqRC7nuGaHee340DIl
+OgPj2ZHrUHs5QCegnMpzxHhGOqUlkMwpZTK8yKQEnY+pbroaJDRjj/nwiI/5TKfHeSFXovCwdaNYVhJVAemol/1GRGq474A/JdZo1zXvswGjXKWGgDRHGaSx
+feGbLyI+CLaD7futC8Ij2kwf5SFkiw7GpLuA7TxwguQhWMc2HjSmDcE7i/KCQcI1ToeFyL8V0kLCkGXsiwK5BbhUKGRY+O183KRPqtf06+m30u+lP1O8S9S0
+kzW6lThnrdZOQYJL7ztogdJ17q4voWAXGNZqR4YeJGz1BTsFFJ6Uix0iRcWIoh+8BhjCyMCjcOzmOf6BuT2V9RHFHq86dx9On2jw5r/sytNnd4tjz9d1mkSz
++CBwPHPK19m8+fKw2o46bfxY2vjjNjLAufmJIOmVEwpDAxfIHC/EPCxDapySCitDUkI6lJoukleF7f9OR6z0a8UZSfXzild3b9q1OolN/dbyZ6O1f7hw68ET
+sSuV3R4AGKzT52DrDSWvrTrdjHQpvoq7bl0rRvhVBQNRfexmCYNOk83HbfqF9Pscj/xl+tv0r9P/nP5T1VYLqOL1QjziLR0a9jqX47rTb69vlCekcrAXONpi
+4uHGY8DduTQETmiSmf3EUdtGiua6xIQNu7FTwj6eHkARyzO+8AwEgFOotslGb+D6iurmP9XjHa8XNF5aBcqfS19Jv8VKyn+a/q7mwq9bpu5IFvgQzo0c1Dmi
+UsfOgCuHK7+3BIwOiUF2egBxCjlBcRHNr5GRa7cvkHsyE5tBNnMT8Syqa6SZQmzTF3PSGYBq9eKtGAr9XgUJfM6jLu9HdN9/yTu7vMJlXdRSIuyDknU4NbcS
+tQQAvrywPwaf+0yUcghaEPJ4d8HXRJQwmiylYS44ho3vfrdA0OXY4RlGAupbLnIzqyKwBFaAJn6IQ3cqE8yKyWF+100M8570fa5QZLX4bpYDd9pRj5PrtgYv
+CCHL7x8PJkrWTqqmNlhuSIl9ZSb3gQ65e2apjpggCxIEuDxvEyRnEoIJS2kyTnp1UFNHueL+Z8G13OpyVLlHZSPXGQaiSpfghozin2hw4qIIJVlNsRJ/kf4m
+/Ts+1fv2EFfUuF6sxmqLvy3kbdB/0wTxXjOfG1v2m4aeJAcQ2wUwm25nGVxMGAn6yOSdKEwT8XRrYXeCso679k7ZB4rrbBo8TRlpimVKFI3hLpUxq4Y/E/i5
+CtK6cHh8H2Ngf9jz7l9Ov83rmNduw0NdEUcKf5R05+1CXWYBxxWXqblPu7N1CJM4l0nNWNs8azGCxAmYRLKlO+aIYNCsHlWma4oFmoru8gXabf6KDX2toval
+SyeN6kNFbKirh4p9HM+6WeXNK3aeDpssaMtr8yURJQAX6IgCPxk8UV6pTqawJcFdogHgMbAT2SXFzWGu/pN6Spm8a6PBspVI+yeVI0F231/XSGTvpRVVDF2o
+NHTiEIq9coSwqQb07tB1ZMzinrTzzSyun8pXjxcIdSwjDbbcY3dqc+HZM1oLh3B5v7s3TTRiOXc2prp2YM4X9xTP39+lf0r/Of239D/S/+3n7+ZWl0aEZLGx
+aoH7rhekGTA3ZMHp3HeAFb9Gx7a4c7087bNgebtZB4aGWxZuU5hxrWSF0nD8Ge/5Hlqqok/sxGjl0N5qrDInqis8Klp4EhIb/MGs45D5QED6zgBa30j8Uck9
+/lr6bY0J/jz9Q8yVz88RX+uh/6TNo+ss8/2gBSnh0zGyXWkcFrv0zlNgrhAmaLtNiIJYRJhzC7Xb7uXMcHl9oObT8uJdBOlFJb0WRrtagT4njzJs09Q1ETFW
+36rKQt+fPtOfKbuovcFFszKRKhxS/lt1BuUSdI0xneUJz3CJhnV1gZqptGibCwV3CjjPXKgEBElO9JUeoZ0z6+G+YUiOgygLhHzmHAcAMMEEaE1QOAcBdD78
+Lr23qyj8kqLVOlzx2CR69tZq0KFihIxxvWUmDrYy8SiKAAHLDDd5Uv05kBdqypw5Xbznh9m1lEmTp3w+TkQZ6ATyHRtRAXP4xW3SvNAEsALAUxpShqrSL7ce
+mBX1T38y/S4rhf4/0j85n4V8rVuR69D9tF2PvW/eGub6td8oRlurv3Mgv8mqu2W1Jxi0xU68NOcXwrtB6g5NZqQlFUD1UChro9McljM7viybCd5pN+9f42TJ
+1JRx7CjkHyf5WA12sjBkLq1KNLb0Q8wwttWbgwYqWxcJN3AGb+PGU5Q2gG5F3jIXbuuAj6GPmZAkWaL+AGrfvQboJ/XDkU6y3U8ESCamCTqzKFmVUHudTdyJ
+tMkVIDeXjCi2lEjc+NKAayn5uF9P/yL9cfqftEL779N/EVTPsZ7vMR+lqdxt0BiQFh3tHeBtfFizT9Ugfg+bw/PD0nlvUATNBx820s/HuMEZJVM0nmS10HjC
+E0CZdQAkC9dnjpd2p+CUGttZ66+I3uEixO4meja0qxXDKn1MCMLHxr8fm1uwWa/ltG+zIJL/S4+ONivl+3YTUTMugFBP9cVEqSbj9cdmdP17dQRMKkCvEPv3
+jtA8thmSsjnQ1J/wLqlaEiBPsUjj34UzJfN3qgeNzTIBmYmd+7QYODvCwW+vIkwEGZ1BE6NyGJi+buVR/7Szqf2WIg9EWfQf0j+6r6tZECXOkgpWc9wE2MdB
+VNmD7XC5MTMbu8v4ttsQpR9CpWsxjFCBAAzIXp8KP9FFngwAsaWEKDfL7UG5Wqc+w0qOGaaebzQyTnV5p9oAi61jm5ex1qv0fq8LFwuTRuXvXYt7dVatwuKy
+TRs7KDLd+mLYz4gC173QTnfwuGgtb18+srNFTHU0Sltl1Ub0+20vpnDAlLOmnDSLHVWXBPl2/Uy079+0aeRdxzUgonwiRrDQOoUyD6mbBQELGatEXs7tgvyJ
+nMK6IOojqLY+uO7woJ9XtZZGZeoqNsH7oVLGL66Lx5YMBDwctw1TYbjc42Zckfp6DvCQkZLtC4IgcX4CWMlkRW0pEGB9BVGW+zhp8IEefOs5id6XbYiHH2SG
+rRI9pHx+NNb7r+il66g/rJSoLNHApb1GlCHzoxlfYxgohMqkeaKTiXGjKqqB4ENFraMZqsVOU7QAqwRTQixk62Xxy18PPuw3pFcyG9QnVYdxbXlO3rSaR95k
+HfX8Yo8+PQ5HXHVDLFeXz26q1g+xRafriIwFqmxa291L1xVAFM1Ngzz208r1/HI8FWIyabyHWlxb2DarGdniGB3NfXOacLJJsoRagW13ceXLl0uLKGgye1dO
+MNvczXEov9Rua7k156TpHINy+/xE+lnOPvwmZx/Yc+72rX/85b07W96zZd0J2O650a3b6o/4b7OCgfnAXmavq11nwXChuvL5YYbyayRvISGZA4hLo9/0Dt4V
+4RHLmBNPOpHaw9T1hZS1A+mH0ue5ls0ZBO3dK33puzJ190/aLuiRrxsaEVk1+3Ve2CEgWQIpwvdzkxXmKVIstl0IiJTjY6oHfZ0H+RvZHmiWcb5n3sT7n0/0
+3CG6HdN9VHnPjcjOr3f3mEhm8ZiPksSF51Z8JShWQEw1gWTWIMldfUoaZCnD3OwBhJHVOOjFrtNcKsI/6GhhiYP4NFtfNmUN0wt3gV2rEH/UaO5teq07rEgU
+lbpOHA5dEoJRtoFf0qGBQzcGzxY7Cl9aTkQce6KHNobrQr6mxRK8z8f+kDNNRskSmMI/+3xmFl3hsOnGyPdmpxcMWjDSRssUxrV0XiHAlGDlqdRSd5B+MQ/V
+Uqfzstd+FRlZuZaccrp+8PhkPNqus5stUpwQoR9WToFxxy4WwIq5T9PyuKQQACJFW0+rrw/7rlhqUfN8+PXZudp/rS+u+Drd41lIeYcgS9bBr82Z68LYvKQ0
+cyeu2g4U7EesPxhe9Ac8X5PG0AruGm2BFVz+q2mvpobDBvY2NAEruTOfPI8Cy4tGPlnK9hM11JvRqZKM3iDHD/n1LPcr4iRWTSt0e0KBGaZBVMOtDtBhDMvZ
+YXPAfXUv3P+4dg0QvtTsQh9nJU7J69/jdjwuq2/grkqUfiK639/g3Kh0TyUAywoihnhpwwwvkD4u8cHuqFyBDXlcbrIb+3V4JFWirCwv282Kr3q3FjYrwTgV
+XWHTlTlRpr5VVvok/YnY966eAjphBJwQYMVX0yKuAJjGlL7ObehK847JajVxd8q37d57GYQycWud13aC2HFoGD9/bc2oUuMXCzz4Q7iCQEJAnGJuEOU+8XmK
+QiEJDpQ1pkRC6aizVA5fYat1ZSChTVB3rKk9P5PelT7uqBO2sF4mWW83q4dbKj1mW16W2XEpB6V4ZgtGyxGyk5+S1otPHgTP7RcB4omfGapmNEAT85foT3qy
+fq3pG7Kk6HHremHnGF+vF+kuzsf+bNU8/9mjgwPxa06XNvZkrPRkiL2m+cf2eFurJt/hJeU+zLUd3pT2ar2+FGtAOxXa6jM4h3XtGz7W9vIHLo+rhhZvaU1A
+5KHiSVK/Py2cz3OHQ16pDREE8NgQF3l50WgtNywXx2OB2vhWg+aXubZr+d6vwebn47a2m4g4tT29C31QGoHZH5MuE4m8YqvkrWbQLiwgn0CSMElnBUEA+Rqu
+8uaSWb332JCX/QwNeM+MypvRNfb0fsRbNfaqXZKOnH2Yb3dUJ369hJY1YC3Xyc9y184cQpoWjNIkFtniLJReBeWttUVosP35Oss8w63EbuYuSmexjbHai8O5
+W3Qx3p0+wNigUvM+f/bK+cSr5cjtGRslfwqn7nFW01XMpTAazw/eMizhYeFzt1kPOCHEk5d8PYjfVY/dAJPU0l/Aigl3aeRLtK7Dkv3XqJxrHevacThLUt0f
+pD8KSbtHHtNc8fdlg3SXgAhykvC7lkfbaN2HPWnGIoZ3hnABkjcDTHgmj9GqpGA8xJoa/sua7qVPOhr/V7Wn3VsP9n3Y7vym8mof7N4ct02VzsSybFJYR61M
+zkYZr8objmJ7TqQ0Xdjn78uqQQfOKhGPJXYRVKJGSnNoXaqWwGZHzmcLZ0AR+Ut6URAa/uc3pQ+65f5Jric9POqyot0YyCTl6xHHYZEd98ZANg+KyDQZxdG8
+21B0cteLMVoztZEF19QAgg2Jugmytz6jnDmys54kAg0wMidJ499kFLFnCtkxEBxIqZatHJN5aU4iVMOm406KIISIj+nylBBwuz8/l1GrZis8JeI5pT4cD+fP
+owTpk80J9Nu1Zr4XDnz3/FdPcCa1/u91OG1umlNodvJgCWtx2S2e3PoA+Rltzr9Ie+KF4+eU2DUu77Mjp66WikH/Zq6NfE8T23/JUegFz5aqolyjsFt2yfX9
+WcLr2lLfhrdqUopx5oWymGS78t+uGUaTtjHnkVrMHhlyHE3Lh4xHLCf1W6gq9uKCp6jgXqhN2wADxkyczmPk3f+cVxcNDfgk1kdbzLjG3TIuH3wnGrCfi266
+3UKHxNKRtmSSAGvIxRuFOGwsoyZePXXK6KxhMiYe3oVuyYHbVDLWGLY902Kt+dn0g2qnf820C3MLW6nEDts2sn5wbfJKdJKez43r53RHsqYmYeY0iFTj3Ibp
+G/i8J6cElhkcEiAQmdynFjRxsVhJ2spcynWtjxzrW4uxxnob2wzWfTONoU2ap1su2a3xH8XVdRxnqn1t1GK9VBJrAIiz15Fm+w1FqU9fgahxgLbtLDK7S7XX
+qaF4CvdPDXCBqO1CaPzm6/Q67kAou7Cgof5Dq6yu4+OwSx8/P/jdowkcPsx1Uu31QMCwX6Qeqa0NeuXGjXwCXncIoPl9BXxxVAA4pZlHJbripPsVoaKZVcDP
+EFHNrTGLC/4VLU15/EeB+t7H2HlXkLgFs+fsFMYxG5OC45lY2e2aUo3q5EjbnjYzdp0yO08Zblap7CoiB8+lhh3UMy0qsMMKf04xYA0coTDbzEIE2Kzk4PW3
+2zfUpuuXBQ74D7niSu0d/jXVGnjgwspdYorfwrzgW9F3j00NPeyr3pdGrgyzdbTieeIdPCQMPAQUM6inBIP2wy6smpBq4OSAK4O3VOjhR8fb8aH0Ze5mKJ1Y
+/8r2qnUz8D7Zey/Vwnl4XG5Z4Hc/QR5hayxfm2udv0vH6MpNTzg7my2zkLLSnvalU9XTdxgPUmyaE85ksCYCIFNfWTpk+zP2lys+wFkRVO3pcEH09knmMi7a
+fQDM5SZQ2upE3qWyE7uCKSwlYRDkzKCqiJXqOst9x3HNrM78YVm0DbG9YMXi2mx7i39cOw1MgVpnbWv8gcqf98BZKratJ02ZVydHFdAqFgu4iD64qhBhly2Y
+JCEVk6JJN71NzYC2hedzEvm55LDWxdjE1K9Kr0kjx5Mlni59lIwVk1ZUq+UZgvimMpHeFxbshGzaoAdaQKp8IyU4KDMWlw6rdJJD2bOUcuPMZImMJHNDQMpc
+dEqW+9RaCzV9vb3zH3suvEz4mIhWumSChnnBmB3Su9MHNZtVcg/R+nOOMz+Ra3FtojIVgr0N7T28htYCgy33jxwRrHcBomrHgD23cBQg4ACT90VNipTWKs1k
+DaYngEwEdO40oPLs4/R16bGbfCTLxruk8BT7r/VnemkCrcPtXcNI9t6PKiO1dgKMTY695rrG0LY8d++O64XEloSauybBVY4dVvXVXi+JZ8R18OjbfmiBeTB9
+NvbNKFox002pI5aqRJh/rB4y+7Dg9chrZrSq/lWtzgQ7vTgritpcmJPbY9Pao02TMnYb/worqjBfnIuJpMJsCJZWCjjIWenf8Knw9zxuClnRj0LV9RrTjSI3
+y5ow3GbKwk27VslKLUs1pH1dbqF8HW1G+GtzqyJD8tTmIMWVp0IvzSrGH7Y/7lLfhqVxkJoLmODrFEtxMtta4X+VC9c3H6aXOk/rKr04fQNrYr+FsY0f1ups
+OtTshyO0RtGnLJ1XuzDcmycdpl5rAaUnEOr0uxmEvBmmkilkhExwlyZLkCyMLuL7r2dXVU5Zy/qn7AiturtXXhh74JUN3eD3X2PCatmNd6O9ygNUJZBmEgY1
+hKJHekIfc3utu6iKpyS9wte59Y9ueFYOfn78IK/1X2RWk9SgjutaYPxWxbGN+3x+IbSzItbv0sxIdWSgmnwXjLGt8xMGGEmweHepm6aoAY84kLvJYcXMUP8y
+jeKTkdeKNrx6CvYVVCmm+GSCjlqPYZUIOvWGh3z7xPuezDH1cwsv7oWBbIIQL2yEly7ug3LimSZdymE0Y9S68pEdvpatXkdmCNzuYy6aNASVgp+iNOv5gVbe
+6Tekg6uQFn/o59KXmQEAtVpVW/r2m5aA3U+suL7zP88syMpeGeOyNeR4YRMcvX7vxEQZZqrAsipSe26SEJdsQFkXr1FFDkif4PO/2oClDoRuxb8nL3ckyEaI
+HHgjz8xaYNuX5ys3fQonqHui9iucXK81x6xpt1GauAVDz8IpKCUEwgnZNxE38IxiQmv/d+MZHQK6ynyikFaKHtGNJzWNDL5mE0T10nhT5X3FQ1oJkEc9JAFT
+YE5YBcDhLiEQDEDSyyO8aoJazCDCLjHtFM0jhTMDYE5GXbu4YNVzs/2U4v9/22o1GuErLKuBX243Y90yAamy3xzmDVBN85MoJdjCWi/vxxy3mkf6MBGPry4k
+mMcitcIVuAdAeUVglSrPD/hETTRLeLIHg5jrFtQ0e7TBogf6HdzpVirFxnacssL41nULbaoIaFCt00zVP5NtzhHWqPgL66G4bIeGgv0EYBFLBETl210l6x98
+kK1+eXpFemXjexrCVZDXwmO3X8cBXxnqr4SdDzlsS71mt+SETZrIUNuSk/JvtUPmeko1JyJwqClHjlGmpYHKahDHq/oTu/Tm9PZ0ZN6tjzkWrioDcIAheQg5
+hMo9jTvWT3AS7S8fx9KsAQQ5ASEABkJPaPLNdX0MKIDInNhRJ8yJr35eOnPQr/UzPvY1e1KvT29N70jvYixJ7CGSegnn7y22CtVXLcCtLY3xBLHHWLuHwneZ
+i8HynlMyMGh91K+DAeWVIdpEcVQeu1HJibhL9PEZn8yWRHrUcbms1c/cpWe1/0A0lX+Ma5Rf5tP499If1hM5euTHbZyQK1sC+7GdmmJkrv1XtJFQHjXTddUB
+cXbdvYHd6MniEZj8qNb7aJjhycRc9SfvJ5k1DmBUIYICJQZiFQiwlXeCgckO6kxa7UXfHvFKb0jfod5fsSVfUBbz4v/9qWQPax4sUBN4fv/IXHV7Axcfjfph
+53XNK5+OG1udNeDx6b1nE07UVBT1GAdvNUZPGSrHDekr3a70YiavNUThhzCv0Bt47Lfi5uaF2J5NxTJ9oI8DR92Zm5nWcoBuCxrnOL6Qc0kwPHMTPWA1u9La
+dZdkD545nbIV2GxvkjRvScbVsz+LW9PoczClVy7wfpqP/Gx6TmfpezhX+DPMx/rn6W/Tv0n/q/qFAVBbVs3VxiDfFgooINB368hzmsN7up2rLtWBy/D7zeHK
+m4DN32ZPMx8uB2JyJkFWFm8IBD6isSabNdnW1Y3MT+BJrV2SQNoqQXFh1Wvlm5h/2uz6nKP8G+XvYeBu1TX5aGH+iwZZ6c16rzKofyHgeIX56m8lY/uAptL8
+0JCdG/HCnD4KJrPOrvyRuvOroSwznkIcI14DxWjGlzDZdyIXGeT5l1yPGoQpyd0q4OZNqBOeTQXIfUPyIyGR8VxZzPwmPZffp5o8fi476Jn/jzJFvCxl8V07
+6Ve/9UcDcD5ZymBKlvKxk2PCGh5n8uW5FCITmAWUKVGQLGB7rjQ+6VNdvGd58Bca6TX0YE1T2uG4itHd/ZFd9mY/bULjojlktLFeDuu6SMVP2ogfeCvnt97N
+Ga7CiiQovFI/cm6GrRn+0H20b9qR1uPxnzE08c5lanP4XFGjByRKiH0Q1No46A4pL9DpnmwSAKSUZmvCK0MvcE1c1xZFiY/tTcdxU+VlnnB1kHUmojwrJKKK
+j79naeRKXaET4o6tYHprbchyIJYBYaz8154FUZ9ioZei+5NlNlgMab1aH9YPy4ckc1LD0GGSTXOXZBbuT4jA/OactEMq4Z+AA6xuHefr465t+7XM1a2TBa+t
+ijtKQ1QvIpmfcO04njSnAcpDka6fHrR6dNMoqyC/ZJqRPkHpGZ+Tcoq/Kr2OI5znvDP5RzW+SVcW5/L/h2MM+E0p8mLgt4tve0idjZwYUnihY20hoamN9enE
+Fff2arYIrW+7MSVgz3YFGNlPyzlF7qb42tbI5taJBxqMklOdR8tyrQmG9fZhm4kAqCyNYC20Kv/AfQQUAZkhhC4Lj7eQzg+sIMzPBwMm5oXOT2NDDqI2dNOJ
+qTxgErQroPzAE0f6QUblnimYwqRV5n01tHWPFM3MWqexGfiYVqFlj4zd7lgzqOW+lIi/7XDPrpjg3KbQbhl+rt8UaJFVVrBUf7ba83FHxFyyoMT+SCOpf1dz
+yYo9vj+bLFX5CF3fb0J6+dBR6vBWOYpnK2DkPbeXxJBgFf2RBkU2ke2JkFtuNCywPXXoRBpY2aK3sF57qtsMM5UFB9irPmqr/GRchJJjVlhQqOl/S/rW9Eb2
+6D+gmTbpOimVSxOaKluf/7OEyPpBntv4UANLUyBOsWJ2tLFoq6uvbCaRdjPYHZe7qffXytNdzb/uI4vXhYML0nc1nX5pZzwQ+9pifLU4+FvDM92TwbC3LeUw
+MJw0mrLCSMRjuQt9Wm1DOUFgQJIql4wdSNIgcaXwc7wI0trHLxlFmYN3KE+N1Pc9rtPmhPacHS+fsFbHn2UNZ8H2gUVKlsyLYX0GVguKRy5ETqbyv26SCRCo
+zkwtB8vx2x69s5Anowky8hqpNseyhIJ2KfPiSJDtw62OilrnzluJs3hr7CINAMSw0K0BIT0XB61F8OUPib5FYyE51BM1M0JGdNNyXT7lrDCfVb4iq9/9JSvY
+BEYUb1TRzp+9k2w4b7406OlYOdHVWY7gndWXdm16dGxeulAItoK3w11C1lhD4ZoizahMy7FdRNFjlBMyEJMAbbrEoAKFSrE3foY6juyv16bXpzelb0vv5K7Y
+ysmyObBJPaybdbQ+iJn95wJU8BCUBHhuVxdtKrPWSOqAIQgTTaAx4/k63bdczIO2moaGGSyxzm9yTu4vTRGpct5pPtRznzVF9HyW13y5xByqcsB5E7XanMNx
++5yTIjJMa+siM1dN1UdY2TUnl3QVlYynQi+y8ueJ568djLqkrL3FiWFCA6wCLgYS7NYJa0O6GDFPhM5wiEvWKq45Akwv9/P8VjPOhRtVemq/ytWgwlKbcnX7
+926nImv1qMTAVl8fj4F+74EJu7nNP5eyIWUjc5yLIzM4dePLVVuzIdQ3esdySJrDwS6I5xuecCmnN0/k1DxnPRff6oiu72PMizN97dwHHMN5eNufhOMZnJ/K
+i3dl5omAowReI2gHnh+ANmh/Qrm/pMfITLqNrLKuIBJpPbHWv0aNsMuaqZ21gtVI2inlNbCxQecUGsKrM/iK81bpaqFX1vLfd4nKMEwrwDZWA7Sol9zKC6C6
+lQTKuEuOu8iatGjUu6P2Caa0aWrOlnN4t2uRGsJZMjNfTX+W/tr7LLz9XjR2H1Qq2Hk1dY5xNMN1Uw1SY7Ou9iH5tzns5zM3gGblYsEgo3AtZTVW1pZjW6fC
+n8tLWdkS5HkNxQNBNi74UN0NY/Mxypp7WeD0fZX6229X3LSfiVlbtyULXuY2P7nneT+qQfwdcfUyxii2RY3OgQ2ZAIHKtyldci2xqZt+Y4eftoj9vbzGPtWs
+MfGvFvDUHN5yj9nuUsDVe04x7lDr3J6DgSKYf/HqHPKaO1Tu0vnEV67EPXbwZRDJ5BNEVDa/s4dkd1qPEEinUddQ1Fh8lG7S24M+9FyfQRl7ns+dqIU2FtiB
+p9z3/Moo2DHNDORGVU5ZXY7b4yMXgo9Ohcm+y/En12wlK8dl9sh9zbYJIVvtDzHLRBDwM9k2s+G9KfyhrlSzAuWwtNugvTL1RqSrMqVXN/7aNfdO7bRHyDwI
+YXD5Ge2m+kPvg2SVEeuDjDm2ndd/rqNP1qBPHnUeHbtmD6hml5cWVjQ7g2e8i1otZgUal0k8+eTrvDf8iqgBlJ0+El6Sr2kzA67bohsdTp0FdH8jLvIZiBwA
+WoxPxZHXrF61El/07rbfSX+c/kLq2OMDIsuz8fkLOIfCQhd5DjuIcJqHpfOY/QGHkAFdVOdSxTFUWvhsLH/uJJK7BI5xb/saPhV4nb56vpdhv5bF2CiUXAj3
+OSxmqcAxNyF+pVuJgf6ZToY+7kcQwuKTef9T1+OLq9TMi97hAwtEHVf5DXMSu7oJnLax8lLWnnD2Ck+RVPNExVbW3WY8rl7oCR1wZA6PetT2vQVD27NirYRm
+H+45vcPBJDHaCXwNWsBWnlZTfKLLp3q7v9vGGZnLZwKHj1nQz+uZJFHY36R/SP8Lz19NjYSm8Vy1G/brw36eka6gqjIP6z4PvVvAmck2v+gU1ZR1UKDJQQ0h
+3lQiTAB9CQCVfwCWkKD8XiJF32UFoU3YUnNgDplq+xu17Rwh5K5fNqudvi90JZW1+pV/pvpPtHbFHOwKu7HKV9s1q2bgiepCYIsLs6hZnoi0FRgeXiCSJ0iQ
+HgpJRSvK11j2NZzblk6XcpL8pJ4ivyWxTPnvUOfBhPQ3ozY+1xWYX3gHU93XFVxcloKUPKyH3MA8J4RLnU2KdNKndNzlzqrpRTjX7sRv7+KSD6QPucfzC+k3
+1BP/S8GKjU2x9Cy+5aBk+3yvWVYPn7d9NIu781gXmTkfktVMJwd/ziYPBZk8pRDODI7puIvYFxHz9isxm0fsHZhN8Cdfkb4pfbN3L3xn+h7tE4qZqL8vNnCs
+e1RxtcdHoTpwaKfnsO+zUFc1G3ix4jKDeYeEhN5vgd8ripwDOl4CE90Wlps5rKwSxpHWJcr7FJ+X6x+UJ0P/9UTBOcRFMJZJ0SMGHohvTq9Nn9boOSCXdw13
+MB8xunyVKFhUu1xEVe5739WxhdeYpcxdyn71kPYB4p0NWvd28KZxnumyyqkiZebAZTP/gCSI8KBcPsuUnpRsxwqoGoPX9fl6xod+W3qn+zefcx4cYRouDCR/
+JgrA4zw92nat3zIugBMVzeQYoPdiVuJRk7m+3+U5uXJWtq3OkBqyrgLNk3a5C9K9fs73iec7xhmdtNTBT1BMbOC8dA1o9aOlXIehE4TLu5w9yz3j9+c4nGVp
+dsoct74Ar0J4wuqVMpFPltAw2giwKcvpVIX6c8pg/nRMuFJf2pd9633myDyp67QJHbYS4X029JnPqDyXC0IH4azuZkievJCG3bGLfhXKaJksxKg5V8yedR9S
+hhnGKaM6LkImwOeynj39iQGxL86qrNjhOt6WPhz4HP9S+nx2lfUyeMz6LbrObNq25rzwcrJobM9ym2urUUo1XwqUx6YuGWzcc4fjNmI6siRSTXrbD01wU9aS
+SgACwGNoLblW4vSHSNfrGwxb99jolNTxzgkR6cS+MWTqa7TvSh8NMcZvqdKmnK//IarHuZ7BfhniIvKNPp2mt8Woy702ueQLRdvOwO1CX1AxZE0gHVKxCgOM
+fHHV+wche6yuXmPZeIoAlPkxqDmFnmdoLwjaS4h4auu4btkwHhuNPnHJFryNvZqSc/xBz9p+WaLha4uEuW5p7IeVPGdv4gu9dbvnkGjwIjpvygYxiUG38VKv
+oqivEflLOQFMBIVOG4gIDWnEi01Zv2dTItVv54WgFbkNe1V6s6qNGF9tyU3J+fmvFUM0ijjM6Crjo7PMV6nc8Z7Ht03bwMO96GW3JKcpFe9EHBP3S+pR6Hdg
+Miz4kjvdNAVVMuDF5mi74ZnYpFl3NXZ7Y/o27rb4Xs7EFD/FUBiihxG2sFfr9hWPUVIEpodQp+9obE381NfGz7KyE4+o1mLNnZOy+NlGyQg7qCgc5f1/jAPV
+CET/+BwuGGFdwTZaD2ntVilr9Aua5fs9ycCMQQlou1nHLlIN5AoWozN7j4SXgJ/oCt8Rnf/U4aFFKBk+AYYz9i55kd+Q+icJ8JuOPkPIojPeFKeN3KtuZqlb
+Y9eBt92qn9Y7FX3jn1ae4I7B/fYoO7qdHi2QLBG03+sPP2VzHzjcd4FTWf1fmgBja18gbl/wfhFglZTL/U7eVgndNViWGYv2vwYi6D/8f0gBbxFZqS2OsDhX
+OLvHKnY97htBO00oRNILdUXY9+t9vtu58pLI+9YD4uJ6Q1T6ZCTiDkbnw8iJJgwRvUNayvTMg7EA1w4AA5tANW2k3jAu78/CISbVu49rH1H1YAquN42VaTOc
+Alutre2tRNwkSH1x8lG7mq3OgtLcbuKZOzoN0/JilJOo/I3RUgN+40x8crEcu9niBHL2e3A+OvJWyfKUJhTqH9A8js2u9VhO1qHbioOFDU3lFyNnS8GHF3T4
+60K3rmVifkVXqFTc2RPONZ2570Ca7LuMs+yLKKE9Jevwngy/sfMvJLRWSSTTalyLMAFkZ9nIDl2bgWAN/im2EJXryqZvhaeUeerQui+oqrljTT7r8vymsDY3
+6VVeE327Krl+jD2+rzD2vPg3f5f+5/S/1UpocJU94xc6e/vOe55dFm9sQR29Q/goZB2MuXR2tFw1pTorl+rpjCpsqfjFDKgdvFMt2KOhPqg2mQ4JyJcj6bl+
+EpORFYOFACuLiS0L0Tf16vxCkwDDmkqwvvxv6HI00sMLbh+kz5D5YUdrc9Jvo9JbV2f72EJymvN7DtLeLUImxjM406qJgDQF/y+EzuS1ZC+DzrrOpQItEaEc
+4rhKwTkEt55txsWyrt/QnN+GYn8X5xM+qhh2ZUJlBPeenfGuVlc1r873g8ib4po7bttFp2tUTu1tPbJB6PrwfI0uI2dVjOt60EhOfB5Lc0v7EKAQx1TfObqB
+2GWl5f+W+/qZwKojSFThzv0VY/CUgY+b7XHHARsDu5U+tzLrLJ0rNw90birgDcpNuGEtzUQAgLXdacGVUbLSAd3jfgwDTkSCZoITWYcNLYa3ttnq2vnG9EpF
+OIpes3CJfI4VxUouJu1EMNMGP7Jk2E0N4Yzsn6etN3tMh7EV7KC0yOuyi/pOh0BAvzncNIEuuRY71fxKRvQy+UkLSFmbFPlVb53HeFZOyJMDmQhCmkBpjEgd
+FuoCMv0p/ssbZjjn+UlR6r6fUozzr2olSZQSyonxv6Z/TP8l/df0P9L/nf4/6f/b8UAE/gfNAOvZ0Z8fUio/eGfwfn0jJ0rZiOP8DJGZD9wEzb9kkMRDdNtb
+Pe3618PdseMucN21QhoRYM+SPTX5YSzfJ2roDFAugP0FY4JBswbyp6xocpcoJ/l5WqjM20W05DWCMy3XUwjnaB19YOao81efcwSwXFk5i/5Er2tqydq2Xene
+S4Sqq7cX4dHlJFuX3vgak2wSTfY05gAYE2y1XnJfhi10WMgPyaWbzFqXXbsnvxZ9rlI1lF60imD/fPpZVTb8fefu+DdBP15NjDj+D6O9GhejqDbHYZvj0WHD
+eNTmqLsMStEbPu6JB9R6tWl0UIVqyAiXwSknyqBy5QNMs2YMbBGU5xAqzwT9vrcH9kure3vkmg0M0DBZXwfUs7fhe8Pwco1ibKRtXgDBBzhyDIAiu3zldpCV
+KmHEeaYPy0MVOH2cmshC58o3sJhhqnpUUuUWPjo5PQXLXHwKYeByPv7R8ya6RY/b2jogoxw9KCgL66lLzkRb5qrBQ+97BQkqmUE9JtW82nw2Ox7ajFPjf5FV
+sgHAdzc0RrUtC+ofDrxRb9b8+fdqP78xR2nFWposNSen1G4sJ6kpkWI/r+Y5kZg/ueHtOTZ4xtH7LPo0MIJK3gFqpH1q0j3ZylzWbpIhQ2i8BIQsVnQq91Gk
+qiPks+vicT7t6kN8UVlnUfMe/2v6jzWmvJ1V8sdeVKQv4y9Wdqoy3nHrr+UX5sLa9CGvI+08wOZUCZbIV04leljOoHsPFTau/OLN9THO+bjB569+mVRtrJfx
+y+7zlzN5rIQQNlklYCr4zScs7fer7cofDwSAgkSWzUV31sSzULjPkK0n0VbgSvYws2iUQ1ecqgxwgjOF+uDxp/RanxPhDqgMG7fs2XzaORZbr/Vv07/mTMf/
+nv5r+r/OMAvM1APnGcvdxTrNbq65F8RGLE83f4adW/6KAJ5H4btIBlSiAg0MUJNv7ZwDVpdT/j8Z46cViuR/zYPoI+5ET21oiqE1tPF/WgHkcNPXUirnTOT/
++GCj9/JjAYtbrtEZrOOhqqmtx/s5qCSeM+zj+ihIizFiwcsxVtNWh70CYviNM/AjInePLpJUoTLr4IToBcxyUGUABECSlAHOgJDk6MeWM6TRhGhviKSJgKdD
+bWirbPYFn+acGTufMcUFaO5zG0RWx6/NaBIieXWHp+geo0jlv8eMlPy6RPLwnNFL0tdBHVfot2gVbM+ZoZJLky6CrzJe7I+9q+M/pf+n8uJ1TIRFoHgM9TAB
+GmuKuGnFtfRnXVxbTfu2NtJBT9XRLpu4YxFdoMyrz6wY9eQRp6jLqcaJ2k0jhRRSOAi7nPeyP+FdIcY3Sno3SZoUNZ9JBGSwcCCqx3tYqDOJQMTWKvQ48VIH
+MQZX4aAvnYa/axi0M1UQAYhvxv+/FUKqM243Z7OqPPVPUACBvu6RnaH9CSsfixjezzeV8r90vH1TGTePaqwIyGODg9yL2MGeuzjyhazp7b2Qq6smTrT8JFGF
+1yNA5m9sP09a2tQ+X7LUZ4hqVspV6PI2s3aYScPOKeANEBADtU75r1uPTykLyHcrq3BkfmD7sFIJn/U2srF5Y8KBqcb2nhcsccnMiWJLcmuOwZOS4g5EOE9E
+6JhN1xMrM+YKA2t7088pAWFtVcoJCTMo+2YtpcuRtkiMW/n/jS/DdCRKLVP6qFUN99DrJi60ET0s3AlpJRXdbSScs+E8miZhGUFLJjILelztU2hcicwLTU4L
+GgzcPOIRKTNtbTDns+3dfE4rat+plfMfbjD3zM8adBb3NTlmmlLbtVm4tRw465FltHazk2sXeJh57WwO4wtx3oPlu6udmMyomGLWku8CcTx5jzvv9U40jaqJ
+OOUDyLy7WCtp/IUIZ537agOFz+kdiuFoOGt2x60ZtK0yVmv1sSGtvm1OhVFBBuM5gIGDScfakY544m0jTWYe+gUqg1j3sYZ/Dmy8eQEm9LywLd2wz3EOJtVe
+a1tnwj3ydu1ofb/yfP+4n6+at70S11chGnY6CLn8RqWss7yn7WvVzHzLz/xcgyflub4X93Kl/8osbzuRhRAoNBh4V5kyHhMMEPiYFRI+4cyXzMhLNfkxkwCt
+1lTu5WS5+raC64TMiEjGAtngAnnm2z6a96o+nnRiSTT5m64yvqlSxG3DwV72dBOCl/Nlb5HKrUICl8LxvDirXcORFiQNvZITAKkxVP+xzK4VdDO1HOoVJZhV
+zL6mGZuAHKobc6qOS3bTat/inK3Sd3L3epmtkumWLsH/kP4z71mzg7wwOzcv+n1BQ/uwqVqN4i8qbGbT9XO9wCqmtbi/OffGEZcFs5jWRPRm8Byg12nu+L36
+nqUjXm6kpwuEA+lsRqjlfWtzkR9TLO8vOC6m9NC4ZKhMjzR9aQ29zIiTGV/1BZs2I/mQcoMIx1UDWheslzxZ8bOsT1BiWt3DYjlTzGM7RYxR9GbiFkF2AJjr
+NidBE9ZgMpze5UYhI2HLtcFCly6OL2mF+A+8svkf0/8ZlH3GY8sA1gLdjFAxSCpc5vcVoVUh1BmVu6Ev+KwueeAzZAyLHiUAAkCbyloabEKOiZDbNCciiP7S
+jJNGVzcaSwD0kh6znCZ66V+TpB1hjaXvmtqN1ZxL9k78qNo/UvuW2vilrNO1sdAdzqLQxyXitaszUJgVnZCXYkaY+CjKGNrYZUd3XGuTT8kq2bIt97hmOLDV
+HGpjDdWZqwsy2M7XNoiOH9A5KP53GmUVjnIQa5ys6EFBCO0rY/nC0dJbwwtOUHQUta9VdewMYy6tIn2+R33BCPDQiK88Zdqcg8wAwNQnf/x7WCjftIBRiDjA
+72HW/h9R5MtXrWvwKpam5joxz+ayapgK9MACtbJOxnm/ObsyxXm6EvDQoQEPlT8/msU77ENiV0M7UDY+6y73FgcyfrAcUjyk7xLxMhSujpO8u+XkiKC0XP4/
+6cJSD4AKjiaG0X54Nz2XK+/6+PX0u+nPNXfW6pltHkIPUTzv/dreEDI3h32L6m/gmU3rw85wNAKDEzu7M1TNAgRgRicB5VToDJIRSWYAtKNFgptANrFKsMAy
+YRyDEiaFbsVTTYJRgAACkuW7I26/tW4f4GrhZwL/t1fFOCW9rjw9DWLmYX5NLj394ex9KqZ4QuyslVRZKxz0IdSG1gY1kWnCWbSnpn+iOs2EBDNvhSUcaIqt
+Zik16+8t6bvTr6bf9Vj5XxsH8qxX7iixMy9DFy8X+qMzjXKjOZmHdcdMYUJHIuLNOt5GGrXUNVc7MFekXAe9+KxFIrBKfZW0HLSe6g+Uf+i/CaC5jJr/QscI
+i3aNZCDA4Cko7rjUgFq90+J/264uWRvX6Pnn8r7XQdPja3e/Z7nYM053WUnIGVq43++Gh7rbTbL3Qgk2xOJfH7A9e2VCLaf1jyhj1y8rp3fSTuBtif04wyjq
+8bsnIVw+3yA8b6dryZgH3uBE5egm7/VvWZiX6P71GWc8L08ZLbXoSjb8Zni2oI36qPW1C8fJ+9J3M6YEub/z79O/Tf/e+jtfIMtJeWFsfqqZqF+LsiOyxDue
+/O0jo4LaKEsXQ6YewIgyedXOASn8VUEo6EIcxsCVk+fh7lMtweUGvHqn6nMgAKJc0ngeldPIqoVVjdMUpUrGkmsLBkR3e8vXZbVcK1+uHlxmA5kJn1VVuRUR
+lBWKRoQdAdeSUMOcptlB5a64treHcjgBnBaK2tAXtCUx3vqelT9V+k8MY/C89on+aFCM02jbGFXznFFukyPL6qH9f7cIkbzq2H+XiVQWqEgZwIdD4I07scBJ
+8gg7GM9VMs+9JVWBSkwKFdtXLar68EbR7ZStvUdvOV871qBydfWazAUt+bEGGfDbjNj4z+m/pf+xrMysXpKUaQScGgi7Pe8ux5whTdWzDGH8bccj5yxxTr3S
+/AsWbbGjenA8R++vZopo4hMbAgpHvx49kKXpB8t7yTHD4lRoctmxlDQrKzY3JELA8ue8EdVbLXAmW1f3QHl/TdGW702to+gJmPf6JT3nDAsuWK//4jVecRdG
+hxocavxg9DjN8jd/Q3pddrMu38MyHfHy6Xg1Oxd3CzrJgMQ94kSNxKY0F8kFOzXzw2Y1Yl5zCNhOQYA/47xd3xjI+aGbck2R4HlwWMixvjTkCUyD+jfZd/4j
+9Z0Z9bF1lGHo4ZKU33XovHqiwE4ntAvfDpp60NleaO6XuUZL5KvrhueiN0BAIEtJ5YSAk3kkYOKqZksIWL0JLsVuNVrLtccWscfQrFyT90vuv/2Rs7gHIOcT
+zZqWXOZAxr4QOqogj3/1nrH1Vy9OWsjd1T7D5DIi/FUJJaTzZHnKEi6svvp/xQyL1sbG2Y/fFmLbH/ROJMNdb9ZiLteBAaYHcGzG41Pi/p4F1W3G49Ui+pUX
+GZIDgWvreZmdaR7SSo2joQ5TSPHE8a5JbVjbEVE1nAhoFjZyhpmPBWmd3u0It8+qXmfcqX+mehsyHzonueH1Ve1vMZHt6rq15szgtxZreeuU5G3TuueJxbsu
+zU71SxqwSHaWnEkUGkrwLkmcNYDrefquImtkOUmjEREjjCalRg1svTyfcZF2+FmM6CIKC844OmSPCu71/elDmhv9QuN3lSrT3/OOZZiQtFHuQ20upOrXB/XQ
+DpttoEXsQ7Krw3o7XiZmqmj3R127ypU3sqwoYOPQOoE0VV87/7NL/M74mpJW4KA21knypkZhE1gjRcD+d2BMqDmLyNFrXMnFq32fKgZ9If30bNUWFh7JDe4D
+HLMu2oMhkKIabdMaUGY839cHdNsQVXaUvcHnu3LH7M05zLVuWZBte5eGxDu5tgtgRW+1ZWXjn+VOYZioiSbSqU8uyO8O1rkhqQMmBp63CbXZDHsY8v5VW1li
+ipK9+SpHyKKRv+9p7fYhu7r1E5qBOdZ01aGXd0uVkBlVxXaJqmLCSmAeUPCnBIMCkLP+bHkqyPQMhhQJpvmkcVX5FPIuBuhapqloeBmf4Whs65XP51W/2+r0
+v8aYw79cyiqwU1r2fyfb2uuzPCgl84SsH7XuPgFRJiI9sleu9bWYnjnP/1HDMUXdVF8U8UKu5t45fjnHusa28KH0CT7Xv6iajRXd9G94jpWvh40qE75t192S
+PYoEf3/yFy7q7X6Gqa1YWvle2zmPB53N9+T1NjufdfnuLbnOFoBa7nSsiNtcMbhQvQK7Z/5D2cxYQYmq9GlbQFIQmaYETjJMEyDM+WpCqiGrZjWVZ9O64eN6
+j55tUr3/imbICk99wU+EdezscNUHlakRv6El8z0uVZYX+ow6jtGiFXnY3ZtkXJHq76r7IBOEzhFwglkHUqUXLVOIPK3znGOfBkOswu3qUiz1JXGGYpZ/jD1b
+H1ddmJKrLetYEONpNBWUGU/rxlPlVmj1rtjNgZ87Opnm6LCrmgx/dNwGc1Fn3Sb9XLM412lsTVqZL2kTmyQKtBCrkDQEolpkyGlGQNfQsSIA4iq1xgBAC9cd
+bVDoGn9FWLOvU+WTVg/rx7Uj6SumlLxWueMeDKHTdl46jR1+74pdNri3zGI9+uqslWuMwvAdEUHGIU2Rol5niRS2VzF9gHP6s6ZK4D32Po0oDNb+uNnn3xW4
+z0qs+TuaBf8bzoPX2qvmp47aXW0J7H1NpFQoz6bqf+m03tNYeHtpRq8u7XdEBd/obidCAkBAKk+5XkLY8f0RllO/23WGyX4Lg2vr33HWR4eLtYZvbLC5xat9
+v6od2r7/pYCkt9Mreghe+9odO5Wo/VqZwtSHkBhuVs4eK4bS4Xw9D8vhjPbfVWNa68bNDlyGzAfNkE5EmEknNwMMFqqdIq+QdxRRVR5bSP5piduIssp9527h
+77WcVnu1o+6U6QS8Or0ucBKLztjn2NqW0+yvNIr4T46flABXk1QF27Oy6vfGTWMsN4grO2tgnDlodi2tbbTZKpebG0IJDQBJuLF0r3s7yEpzLUMKvLJxVmnW
+Hk+CHvLexwQClRZsNGD55uoAiFArP40Fbx57tSLamJYnq3SKfpbrFL9oehdmY7SfW9Qv5DwzVPBBuyIO4xnaIc1UPVQf7qmOKVVQCEYaqE23UQLDSHvpHuJe
+qJlZv1kulqYm9l0qV865Ua919n5N9RxLFOEdZbtaUbCcHsuQ6+Pnh/qSJsJD/XFfM+Ahigt5weoM8JccjzcLjNz305Wjdz5YOQARs5XDgJCMtFznEy0tYVjh
+BfJyPF9+zB1HQ/9Tz9pFPvOXdry0H0pfUX6Mv+bKwr+be787q1KO3vhkLITjPlKQeaFYCr2RLH5BI1ubqfjurgbeMdlm2e4b/bnS5rtVwsnBlYltRiwOA8EA
+d2lhAieaao1Yk7BNX1kooIH7Y/qk0cc3Bp38mcfkgaE80ZyPUnN7jXKyltyPaAT+uDPCNNq4Vc5gw8FeaTM/Hh61arnSHdXjukLbdM/G1R6DCx0ZhqLguoVE
+dtnXp8op2g6/SybnkhOSeRcIkAH5qKxzy7UDAuquyYAnkhktELqAKMbQsRJKNsjzLTssdTmKt2lv0AfShxWl+BPej77IbC0gkbFflx2KLsee8UXww+Fe4p0F
+ns3xrJa3IO4EA0pNKusuRdLh7Ppjj6nj4DFnD03xu1EoLr+To7vjcDs7U1vzDjM+nu5d2PVpmU2/TT+g/ZSlyvMv2AcUj+Sf0v/xxNY9d4iTJcaKJ0BFjSIh
+vrNGpMN63Fw1LAHuGS7Z9uSheCStWOZfK+h7Uu61u6QtwjlW/peN/Nx+zVG86Cp/5W/nQKbaYiOL/fkm7zIsubsSOf5y5MyPzJ0Mo+LM3SHLHO7LM23ELghB
+4efsE027ms57WFtIy8KQzTkjRMw1pSuzH1vgTrjYCmJ8PfXEJHbliUswmHXqkCuelsYmIlpi7+1wlD+sSPGC9/tDZiv/d+kfFctjPvB62+uoyKHpqrjVPebv
+1jpmtmcvcpLHw6JU1+2h7Td+kFDXyjSfkCe2ct33MN7Hnry3cHGAxSbYITmzNiACAgFJMxMFPKa2gbEuOnVsU9S0wzZ9TU9zVc8qxFYX+buZdyJpeE/QCVnS
+4T73RBMiRsBaI/2D5gFkpZ/zQ6wIKEwW5pXkxJNJhEBLPgnAUF40cX1EVB7+KUV8GkJlxckkz8mfmHkk9verx4gn6LwQvoOUUuw3LvMqHUt/ziuY+WhV49vF
+iR+gVThGRQ1rfbQVrX42r+ejLWtd1Vk02meFE94Dm9UCnmRFoCrPfV0+W7/DzDd7jDCQzkkWKC94YDhUreH5kSZYYHusymEoTgpUIQ/MXrnHnhf9VdpHairZ
+hV+g9k5ovckQTluFV9vKXlf8H8/U2EzTQaLBxrQK4XJuCZPvFQQz9v2MoOLNSchWAQDIsejqw01JokYsr6HhKDUlksDJsNSQoApr1zkLlX1pfCwJLgS4pAtW
+qk7W9W1dyT/ruoh/6nmOf9Ja/1b0jlnddL/EM7A/bpnz8lbnpwD5d4t1vDFwFMX5jrKyM9UNm+ueyX4nNVOwI61ZdYzqmxAUWqmlOlSuyxkLgZT6VpI30sZH
+lTGHunBz0jAUxTvJgk9uQZkL1VOz0G3vWIlc3q2RS+FgK1nVr7hyTMuTcfCERzyojLmeW6Z64ZMlQuGoOntPRbWFQIFSjOWkiB6Vh880X+XuMuA0P+MyxlJI
+ext0jasKYZ1WmnmBsqpfEezDe9L7w3qWDt5iE6Qjkjt4+fTxTrzbY01UjH7GNU0Fh8qOe9vryn+NLFtdP+QxiMjzIabzJrpYXTeBrNxMVnCKZdc5SzGv/+wV
+a+qp8BChFhPuI82ysmzLI1Ei8Tcqc85nVKFW9NusK+Yf51mQAljnBbxWfJ+Iet82xJZtOCOXIl8sei/kU8/1H3QymGJ/IUcfDICg8ed4wr3grUUrXu506rpQ
+J2quWlBYJsgp13Uc/vqs8I2LDQqIwff4FsVpSVSoNcGdAeCrMKb4DduNzrEBjQ7RRTEgTEe/o2/eVv/k6sk1CE/O50yApJy36oJA7UIyPBHW+Zxn8vgOEqAL
+08LyhKExZHZpu6B39mplRqh9XOmSgtnOi1riEsvirfnPtXlwxyrEUrp8ZWa5Jan+cSHIb7q28oKvllPbpYWkhEWxgAeAlZQViR5bIrlz0roWLfeG0Ro3EUmg
+GIQ0GJh1StKzhbU0GOxv7RI0bdvPMgb+y66F+VfcU7T32Peo/tjG2EW5E/qctt45q7q7t5v/qbnB5mcGzCrbHYRWXNk71kVoSXCvPKu/gJlUSjkBrrSejXPd
+vUZ5T28pvbLT/H7kaoTPKQf8DykjRYkxJKsv2WWNkEutr/hm4zqbKPKDxRa0rrS77HhdNSRxPQezkRKy+59JmyazMYIDYGsoaVa3rv0CAumUGM3k56kaTwKl
+OfMidTWVXblpEbzm91NKLwrn1x8qUkW6uQw5rcHt6JXsQ1Vh3VdQgGzwnud1v7Hf3OsLQtnIBmO/9rS/29zj4VgtxAyWHhL2qISlWNVC2vEhaCfQnZWoUFLK
+d66wAidCCS6gIWo+I3kLgFR9W7CAGyIqNNaoWvtaanif1/5CqV+nQ1B75G/BvvKdvRLnbXzueRpNh5Or2eWFjSJadGal6HTNuI2A6Q+bn7yKBAFwqmkagqEc
+Rgz8y0mGJbMk0z2k0AWEkm9ElFV9Smg0ChUl7LkE2ewxf/hmVzD8fs+v/6L7/38RFSPvy6lfXciXCyfUmzmYvXpg0WKBRqoyULds3fVUhYE7cIoh5J07r1mo
+XbBsY1y4tUO9S46DqgV1VQvkJ8sTDV/em9J3hZ6Sv9bOnn9kvlxjl/LEVk1OhXp7Ocgf0pUtf0doaY6HQDQ1787OmhTbNaiqYjGvm5P+tuvTbhEjNO/Tpr64
+LsGq/w4phxnVlm3t7KxpA1/lwSMwALEJ/Gnndk6VCU0vobejUF+H/mZnLqyKQRIR/JHy8EWcmiDRRBV7NMfUk44Gorqnn+r2HljEoQP/3SVSgSAlBineqAT0
+ZfYrGgiWqewzhoAg1i+zXbDQQrAslOi/1eRqb5QVttiCkgmQ+Om/pP+uHVJmM239eXH+4KX36/Z9Bds61hK9ZWT3caH7L5dC0dfUDn+l+BOZXQTinzZ0pFpF
+kBtSgD2c64hXBlkEhGxWyJSw+El8DKTLt3bOxrr9vDGeF+0rmppORGR/lO3yjy8gsiPKpE3SuCDD+jATq6yokwXISeiRPcxxVUHTSTuNpW1INqqnFKeKmAIA
+656iDn9iiOHJQVmNvlMtmFHtppmoKpDjEqgHAlAYLef44q5eX2qWf53+wXsxNrf6xZhBSdoabiHcVRL4wA+r07/ZLvlOh+M2MJ5u7Ur1ALmgfBQUkI6H4vRt
+BgICQpxSRigWQokkASTPapqgFAGDhC1z7uSZWDoZBafXgEIaDEM1Yqly2dxqvCf/eM+1WCKIN3uP/Heq0uCPse8lmt5lJRurZ4OgiugpT5O9UAzVvu9nPVOe
+x9ogmJMfaSd6EJRKfpnfcgq5quCHnbxeSROex1PFu5IhqLieDyojSWGr/HVHtv9VVRU5BK3kw9HpMji3pfNrHHX7WMTZjJtLvsbKDciVJrEuNauWA03GBvEg
+Y9xOyESheBbuSRCA0sCXH9axmuYLUCEOg0xqAEAMFP2H8s9i9PAQav084ktuvWegoFsLi93PskLe74S+gf9lxue0j5DWNmFbkTzyRLErS1kqRQTxRYr3x8to
+kmVkseqmW+u7MfOWL5mWx+42ZAvN/I41xge0j7nNAgHiJwARlyxDVZbLKSAG5Se2GEv/I7Hu/ktBOcvytP+bZxU20mod8H7tZIdrUc1u4JrW6tBRTXasfGpm
+jHsZe0/DLcVxdOrkCJAftL7O023RWHVhCQgRq/6QaQ351i4hiAYoGU960VQbG2fgPwwEJk1ZDYVPhoK0Zq5o49TgG37TMSGiU/of039P/+9W40ClDZYZvE2u
+3tILTHu5UEmuluPQdjVuNxpvlz/E0XE/6wrbiU5cDYm8kWmgFtYeMipTpGeFOvE2raeqkx3079sWjyodkZA8XxuTDRT+XeNEGVKldyUM6tGNb/dW7fH4lHaB
+VUv+x25rjD1GyvCXJ3gvoeQ+SP5waFhlFzdOt8GnwcFKnoe+7FwWunodS7QPJW03hLRLmGMYrFlJSJpR6TcQJ6gcU3Z4auHtJAASdmlMFJ7aGcZcQ0jvdkb5
+QwCuah67S9q1/yLvsy/MM29PB7X0nzSU2kEt9OiPmOVVkTwuOXU8HO8HZGcxCIqFHHyN4GlR3R6i9zrB1+kiOuECrvpFzqFTxlHQB8Y8VM5+8ZDWo1eyujE9
+nx8gqebQxDCGpY9dxnWCDlKPC58X/fMWfi/TDjg/7zwOF0IXNYtyLcYnnPgVVEHznAIM+9zke5M3Z86HdBbT/nS6VhZ1UH2Oz2qN8ReXx+WKebX+5Q6YUjiU
+S3a9N9915wnuON7akGmJmThiU8NrJHHsrJFEdpcsCF4Y3wtZU5mtwXNjsHDk09yHsj5iQcW0mI5SE/yqnjqx9mq+kT9qhGIDEUCQG+KtmYNInpKwGH39Qado
+7dwtAhDLF/RkCRyrrFUqBMh2VCvDQKUJUny/t1+BqtH2J329Z5djTs5nRE92xmQU7BhFgdmnmr3/lvSc2rB0bh6vm75WHvm1iA0Womo2FOXOOsctBGEKnIOf
+Sl+OzEXGCdvQUIdTCRKQ2SyoTAnBXZpgCPqSYoOtrlTWyrt0D32CeRK+1I7n0QI/1ejXVmjg1UtbH/Khjl52i5SGnjp/1XMA/FslcVIx5aQW41QFfaguBJwL
++NXbKsFEhFiOufoqYbyeL25so1gSY3u5YB2F2rQyZ+u3Xp7ueqFZ7l6LmVTzsHxyU6JDuP/Y0nYrBIQqPjdhWBQIC2tYWAYKPuPsGs42VD6KZZCjEb5t9IRe
+58vdv82iXukIgQRXjVC5a2fXVB9lHh7T3JSpYl8wc3XhLjUi0NfNNTVb+DZFCH6f64k5D/V9NvA92b5kOMftSnL48xFXzpQZLq2718xHhlYe1tRloRJNe19e
+lsrzQCE52pk1pEwnHDBi3zEIKEe+cvPH3uwzZByKxrh6fn40eBDiGVNed8zH/rARJmqjj2lmSpTqeC90kJt2ZlI1Cjo43gtDqkUNMs6ICRfWzQCPBV392LXX
+FULcVDjLN0xPNWvnLc6F+CT2/daCKsExhBSPuRau/8Xdtsxhtrn/AIglShdOo67UMD8I4MRVSyQEQNFVLPPHVkYWEmKTK4znwrPcR/VePx3KupDMd1c5Oxxd
+eyV6DoejHgTlyh9kIWz1ROD9UpyJ8nVYbzN/3UMhILoIdlgkcEI0Kv+zUYicFr6GTjNPwAtfLemzvWbt34Tig9EAU2tnnmnsqPiiUh34XPq8Yq9KZvXs2vHe
+nOPWLapi2spLVzoXGm7rfLxnqFPba/E9mmnxNStpqFSs2tyEp3hyErmPmiPSYmqZWuv8AdAEIA7bmfx0kNVbtsvPpvelD+qsfSHUBP/g8rzlmU+yl14jczXX
+h/Gcea4WaS5gGCe1PbIqYVgtqzq8r1rpTE4PdpIfkL1JvZ2+SnLJ9LuSssMYb0ramZAIMMRxb1ek+w+bdeLz2ZpaNvxDegC2ksz3YG6UCPpmeWafX9h+V4HY
+1hQAoh3iCikY60mdLwipePLhZwRSEgVZZUHUqJkbrH8cMaWnGvv0amdJuxzDVILDWZ5RyvOLYNJN02O/XR9sTa3HOQZ6Sr1bUxMyMBd5lxdqa9UsMMkR/dl0
+R/iTuTxrhaOUujOrsj6e9V99Xrr0q0tTtghR5fXzpPixP674JJiF/TB5n1cvKFlGpUuiCsLGCaiDlmoZcGMTqN6dHloAkF7iNvjl6ZuVv/y9Nb/BvMW8F/jE
+vT03ITzE3lMvYiyLnaMTIBoCWAecWwHILCgLG9vKlL/aRI3yp2KopeCsf7xc0w8qA80vOg7oby5GJxtT8Gq7NzeOMj0a8+KoDUWaZbNghneIlWHvj2AWFkCq
+1R+IZSb+wW8lWo5qht4adKdKrmsKA781edgYKxxtb8XTnP1qFdBKJV96tFJuzxiN8tSEeP/VZtxHd7e6OGI1AlGle/zPZ3mfV/EvRElqSIixkiBwFC0KT+i4
+PIrhgBw0ADi1Hk4FU1nrIEhAiFN0e1slpZYb6SWBL/yaucJLnGA7zHnB21zntt9YgUIjjHtOC4uTVchITIHgvOIZm6E+BobVzvZU+Z1AGFDuhj1l8Y4pD36n
+9owVPO3ZPXVl2DptetqvD88PDyFqvpCSToBAQNDqFUi5NZ1JjpbRwYCiAENEDiciwV8tjLNyYR6VjfEHLpwM1g/3fF4zOp2N4pXebxOh92bcJ4CeF+lC+mIi
+C86M4TfcbxIY65kfuVNsQ4leP8Qs9p9bymM1IzU2Tt2qjTOtW/a2dgfyLIxPNAM5tUH75NXeyTzEDPdPSm1hQH3FWP/7iXl0Joa7CQqb38P+QckCS+6jnCYz
+H8pYGCwlvrOfY53AkBzfusrmoQXwleefi6Tzm23bgs7F4lGhdwkm2fyD9jJjTixinWw+kcmhYliC3iTJd+6SGEI4GQ8AOONZ9DOmGV/8ObLyCgYm6M/lUTmi
+IxfXT7UrTjGNdrpuxs1Y9lGYx+PF9oS5BMIulhxWyXtluLhiUzXHJLrkQXgDgvYyal9Hc+YiVEwzQKi9oNZeXuV2BZQbWvZcM/4raT18vjpYC6nhZ7uCwFWV
+LKuB3eZwHK/mKZJBmrvhfHq43LeQLSG4m6Y3VICEnDSzSjaipx6xtz1bPUGMGfszyllQ/DRWy2n31NhxFQgyfdfFtQtBq5PV6JQ1W0plE4/bUeGAm0MbvbZr
+ZLJgFk8xQpMVXnv+yHvi71IGmFJcN2A8BEQt8CTeQt8VpuhHvExXzke4uzUohrkW4rnK6XGnzIuuBz8nJylz/EILrCuQ+op+eoxIsc5Gy8zM6655XqBrPNL0
+9aGv5zo9n35aolhdKY3vNPZqYbHU0CDVr4PchHop4qiEfgqZmL3LfegqLH9bjK3q2Udds94JbwR5cMFgaPmBEABJ864qmJ+NoYIm0zqgWZzzdmfpDvzn56L7
+MczKPuCHSnS73nrJeikMUhgNT8P6+mBZ/avLyccrrwy3GepIaXiXQtdCd4s7DiAm5OI8kupJoJGjTiGIygkBi83WbG5A2PZ5gPdqB/lnHlTHWkwFlCc3uTPh
+99WtQtQv9Ue1z2crVhg3SwrmeMC+J1PG9UPM7iB8lxdWx+grI6QyjrZTykuvysdtBx0+WB8Hh8b21/bHJypuwYkwnLEua4k2W9hWd5bmxjuwUWtdObwFq/yo
+wrXn2KYyV+/m3Go5nz6tvVaV6YXxvhdnr9rYzUyhTHIH5iTO9L1rKHw16xe8cW/xTJp6lazqK/ZUnOCJhoTQin5bM7vGv+BiQosJ65mzRxWZ0Z9fbXsgdvP6
+Oq0nvtexJ3b631cxE1T1cTXzi+r0vSfbJL3A6RMtatCNh012+knmKycFoQQPCe+Zp5c3safEH4KN+rDWFFkB+ez8BITBe/K6gnXWI2skj/tctvDmZpn537A5
+78ln5mbwq6/ZRJH/mpCIlmgp4EyBdSBAAJ3mpK5TTaa0NeiiKPM2R+IU9tJPK2+v9PNw7qmNGiIjRWbMq6Wka/TFJ9Y/ZwTmDiMKKlBoo82aG5wYX1gEFoKv
+8g4lS4X7bjH6enlTb3utZzXAd+ACaiOuLcMsjJzEGdfX5zijQyavwXotzJZnqkiFTO8S9PzQOjO+5xYmBbSaq4b+RFl50rDZahaGplc2sb6w49b19WHO8nxO
+vSnrsFM9rXnFpPWwO30/dRWvQ1X3JqDAnlvCfzlrT0yLWRr0DJnMBL0vTtWnEp/IzFXI/jbU0EPQoLSmJVouLGm/81Ktsn2K/2HofJCye3+x8kvdX3/yTt2F
+4kr0SKo0kgqBzt2OXo/7qvdHV962BY0XYkdp9DwGT8Ev12F0XYYSDKGfAZWXqvZ2tbnHHXPpl3X5vY66OT9bbPGsl5tT60pWxN/POWDzvuQeP5Kt/V2qLZ0b
+nkEJ8ml+/p2QUIr/AGQBsnpboM3GSz7qq7VnRWKYi3XsW/NUx7kDbs5X78XqK2N4VrssPBC+1vbirH8pn3dWy0iYOrOtWvpUqJW2RDvJhYdpyWvlP0HQ7iFC
+sH8O9S/ZpeHab1byKMSWm7DYtU+wBfst5oA0DrKLNd+dWzOPmOcMplr/8qhROa15o3qv9sLe23kEOHbaWN621taDYSKVIHbDgjGbT9Dux1yphk7922nRh62P
+MJNvxgnbBBYCYsAIP63MLG8LfOpWDfui9rR9Nf2ZsYmEqMASBwpf2ill7LwI1gXMXfNfd5iMvTppOXElnaX/4Pbm0JTH7hKQNam54psWxjJF3hbSXHL5hlI4
+B9IUV8Ksma26zgEE9Fv+dF3lWA+DnJb4ZK3RAWN/wBvTO9L70ifT5xQLJP0BYRl6bngfqLr365uHg80qd9bBO7TKlcldusuTC7ZIFgBmA6pjTBDWZfWCOfEA
+APgY8SzaDHjy9d+ZSIP4l3f7+hjwZGXVCVvjvTtb2f31VDWmFM4XdhjrM+7KzVpLOu0EAUzQ7duOy9/miDpHhCCf36vUp0LlTofY4+NHWDI6DtyntWJX/I2f
+q1oHcYYaPsBtn18O6Y2aXz0ejqvuZG2rEfulbodZRLVKhqDU3LLcsimJS+WWYgO/8QZTQ5BgutZRwXMyiKcFV/EIKm/DOE9SxTVtZWeW2jU8lXWIh+O4hPpx
+dOau84jzfFp5kY374+psn8g850yA2qZIuaXvm8BrwlnpV8vg4S5p2bBvHAHHPsCyKYK6Z31aXU688W5Thyt7P/N0CV9fYv9fSPBt362NmWtsZ1RgYh2WTLo0
+O/+1r/pPRGWbiRXHOz1As6YSAWpXpoud+oZDyEIZV8E1pvoCna6OpcjSyxpf7bVBKeBjjtu8FEVK80bLVrb2pITRP84ZyGau61UgHmvTFImjRCJUhgXrwG59
+iIw0xxVOBDlRZR8D1CbI2OIut2YuXp++TfOF0kv1Y94Rmcaqn8/fxuN2EzkYChXIWajZlmmGV4tcbONMLCiGhPK5a/2kgRcIj3joJ8tAU8UQufgSkTSaduWK
+8oYTEDsHdd9U0NCLOxz4JwIi/kmw4JphLstjjNLgtUfEAXULil/LOPBM5am7BNYdQndJdpBgLXI0rvMTWlqk5NSpjSJZe0CpEi2/POgiVzSBZT0NR7BYu7q+
+oK+xPlwXMAkn9fY5oGW60lQUpQwlKoReJ0Nk3u8SUo3tcny00PRVsCZl/IIuWdGUynICI+slIqS5rSg+80G5DL8nfea+3gA+N5jQPyvaf+3XvSyMbKHrYb9Z
+Qt6ureB3tjtiICSabFHAibIshLvUAJM5UJ3ONIwV03JyfWjF4BiVVg7c08impK2Bv4ojiTen50Ik8VE+O36FI+AOUzcG8FwpIFw/IYBu2YyekSa1YKFMSzIt
+y4sYumBbe03SkKuUBCY1PQUdig7DQSz/Q/SBX5Fey3pi0ltRdlPJjfwiZw2cP2T02TrrEl9vg8u2Nx/XrexhNlNKJX/0CdsGIVdJyZ6UBaixPGHN2CbC2nWu
++mrOFWSkjNh3Jk+0QBSEQVMFUqedVGKFZ0NcqnWr8UKcYNIlxr7CavdlKjf127ZnznMXeSs+3fq+Rbfcr7qqw5q8w75Lc2OrMtAXp7rHCLmlD7LW16nm5BvE
+ZkqvaziYHwVVYatBFHaVzwTuH1F9MGWqf0r/Of339H+13MxLy+7KiCA4cH8UahES0FuCpTiHNxLelwhkwdY/WvyLo/61Q8Xolb9d/9JVuHs4bleEJ/KDolYt
+pFahhQmZP7kIBFi+Oxq2+RV+I9/lqQblwb1LlJP8PIUr2B0xMY1MaG4LebW+LfFCPN+p3QNy9n5EezSL/yHXa/Hc1fx95Bbi42Z9WALMVCDE+laOo+1+fRt8
+slpHW4CNsImN0BEaEhncJXerGkitQ+JHp7r+jZSphswEgvEHGLDJMuaElIUoVnDfWP8n6nhsxG+r9VnxaJ/Ed9sZ1KgNnZmR/MDExG0x8r7+vYFO0lsu/Vdk
+BTOpqMEFl41szlap/ByY2mTgc409ez6W0zd0OWip/X9U9aFK9d9Yly/Wp2+122xjqan1Qfy20VbOnM6kvOvmEDtkxwv5+sN+vVj2B6xDl42k7CVAUOn+e+hE
+bsBp9S8AAhIUH1dNMcalZ+EzUpvRBwxrqCDV3rW4hmxFCKHEcaz779KKWrmrc9zujOXlLLsq4kkEDstVtk740A3K3zFDBcm3tYwMfgYTLRIEBFXb1BY01g1/
+6jvdT7GsXekv+h1jlb+yHEmVo5EH67rK2NjcdEutfJ0vLEbomlTCFzLyB6OUuup6dJyNRuPGWu+w1RKyeFoba1Lx/FuTn7C8c2XKDBPoaQbDmD5uE8OCd5Nl
+5b0IPMeNjyO9jz+RfkW5h/6W+Wkrw9nopP1h186xTYuC1NvjoTp8605h79Y0Lo6OcbLVubrP70HZVUlmFyKKK0qeGIsqAMBdErDSlIA6Xc2AoIzy/4CLCqcY
+jxd9EVUwPLc9ui8JNvHjHLP+bGA46zOpmypsGiZ73Ot8W/R+jPqQa8u+7kWmgn9tGYJ6tVS6RfLUe+hXJgoUswRUvqaGPMfE8WLpdmgY+jhrVOyf2NgM1Y+s
+jQPOhT6vvZW9XvI/dU3+4+UMfYW1b/fLazHU3KS7zKCa5yVWKiThcB8Kz9hPl0pwlXlfN7EELHFp3WmpeI7TzOfqcNjel78e8rLt+p2Co97wwWHI9f8A4xu9
+vzXGz1eGFThUoXTOIRVMS3nXuslyR5RBp869UNmsJ09YnJGSCECAZQLHoMqF101ZyM964wEvuZyIEAmGhLEjH7Q8iljJ9jwRF/dvWZM/wmiWUlVnldGFrpW+
+D9II2Zr1FZxhr8apU2fC3SKHMlqlKS7Qszjhq4P+xptzWykx1oJVj5vr4SbFXsKkvPqdo6MCVC3hQ5uCmK9KyqDHYKe7VjOePa/WLj3nLAY/oZx4qj7VodSO
+TcnccguNF+T1KZ3HrdlPrSoYRU5tm+uqBXNO04B4WSUD2Cvxk53xoO4MoApJTAkgg5JfWF0YNM7ouae1n9QFvuy40n8KPVXazbfDYAKfRukSMlyW8Cv9lJ5B
+gsv6l4xq+LdsWfeV+vEYXEcrPN1c8NorVw0nMxaWqPJTh7YR9tMD4vLKuU51/T6SPLxk4gWHx3yy0bxSLZHfNe5TebtSMVJN/WSttZ86SWj9RZwhUE+0JLTY
+AQQD9qjM+SaNzMYhVR2p6ZQZ5z6Tlox3L3S7HdfRuI4tBWub3F0nk9BFxVFFIatLK2k1J5BHqN3xBq4SdELoyPFHgwn88CSjY3Ql2QsIgIOJfWHVmuO/mAgA
+Oq3PR5yfrVqfooZwNs8T8B4LJ7ouLT+wy4E+HirTrnCLauJ708yTVQcsY8TvLJfEIscVa5Eg2nwx8Awq2zgCAcEp1WSiTCcOunwkZcNhgMscNLnMWCVF37tq
+SiT1Q3GdkdboJyJqOBWfUkWDYi2/FCqHspL8qImuu6L+PLegfcVclm/yCUtZlzbZbTmYVeDFBtStAwQESJZNsXSLLL6c6lqqw4QpDS4K5+V3shwLuS7qrHen
+dBmUCLnYt68oYuu+3p0XoGL4fGZPJUelwosO4Qztdp9oYYa7tMRGlnGeor1PrzCwpuICTrLuy/fqruz0hpZ35QPUSvILVyRZ6BIbVWvI0rsl7Y2xaaxTJRku
+CJHoM5jFLErog7O3L8iR3CdBkino8IDs0yHom749pSyucksCytUB5jgxBiHnY1zRBNk81ZzU/R14BYmRqAHnXVJyOqmelY+TUnYs2Lu4auGpRCG1XSux8P4Y
+25Ck7sOfhy/UsZzB3BzNfQpk6MGcEMoXf6CSW6RcEx2MRy+fBiGltArrjfvilel/bT10Yo3082zbz7O1D6ySbFrL5fUXO+/Ka/Yy//886+SuV1oS4n1GThdF
+ptjRF0hQKm7lDXcJzgzKAMp6IKDY7ZRkzmWsP6O5uv5zyVXXnmwdxfHBw9+vfcBrY31c1X7I8pHlwcJQ+Qcqlat9aL63PMychFW8XOy2LvD69MYEAUEn9bGv
+io7O2HLdjnu5L/0LAhqMitweoh9EHbr1P69LplKP7MN4HB+pT37bynOXrZII1JaWbcIfu+zVu+Tx8YQm3m2MPmopzR2ssbM1GjtvwszoGk8GYXUFKWA31unb
+0web/uxU5kacPy4ECkSjXvPZ9ZYEDzfhGJ13Dvzch6C9ULuKOTcjbrJfaFglydqTuBEZFEzJA/bTgnQR+BKADJQh6ypSMoNAkx5jt9fyqniLInliV/pP2ro4
+uyZWmhy80r6+Wx1aFESwx7tecUJ/yhqY2hUgHWeBm2CiSLzHvVcnsm/tpZdxUj2DPdrwe62+3Nc32uvm51ZNjuO29T0sQzrWNV/jLkux7ue2YWGdKKnY84Oc
+leVr5fPCQQBJQAoAJLlhKQg6fKBdL/wSElIrSEJRRIpPvGo56qJBc4iDEl9KKa0TcL4J0jpt0quCQmfEb0j0n67X6sePPeFRywRcoaHC+FkfjxZZhp/lOaic
+7FO6t+RaXkdQFf8VPAaCASubJ4G1TUt8wA/UJsiasDG/nHfInusZ0U5Km12rblusG9PPN4PPx+1moFJM4tgiE1/DnIC3pg3JmTqL/YvX7jGswgcfqOFvA2Zt
+MVbE7/AduxmVNyR33VmOjJGHAZcqG/FE4rJUAm3VRQAtuz5G0gZQNTFL/mzgioUVsGbTy9Mr0jelb3Z7c/S+s/QocOayRMTx2ewMFfozkDCOMt+7w7FIlY43
+waQS3zC0emmCdgJEHolwRLM5OUFOci0WBsBfph8ufqHlXSGM5Q3pTenb0js5npPIReity4ddqTyGLPq1hqzl068CiCsmWbOEGTbpurzLR17BKRGQFS8nPd8m
+ix6EEs4hWOWWrnyNCJajdFWxN8vua/lf0S21Vs1MCJs8+2C8Ngr6zbK5jBkrK/VE/LEyCnwbjS2M+2MHB43Wz/JOR84KUnhc+EBVnWveRc7x2fIH5IItf8hy
+UqoFk5lU2zDJJBLUj9t6CAgwaGLEzwVQiydoGPYGRI3Jd5ooiu03CgDoD/r64SZsPmDZREITK90PelVBPXbLbueqNVs/0zX3k4uKRBrrTB4lYuCIV4rNAcZ0
+fWbhFUC1lITvVM5N7vPBUNceihgI4qAh/QrigvPPhytIb/Dr/AmOHCotySFqXleE/DE7YZpe8eP2mM984CHJtqzq1eW7TRScMG6YeJGlNw81EKDcDeBFQcu7
+6iEU9GYZw94uuyzXKsFrV92+lhYoTTSksI2QKKdME9Qv3Uwoa1YuBniPObo4EKL0vYHWgVas+Cwn8w2z1H1H1RiwTyWnlrO1HcdAlrTdl31/XXimdHCHzc1s
+DANjfyfMAr7nQ8u64zmUhAkLZTah7jL9yDwGHQ3AgNznLaeMPO1DCufa25WBTCpcSfnkxRvT6a+y6fN+lZ3Bo89cjbsERKSHAE3a64WQFdKhI8UpyWnt5fmA
+Gyl3M6qbAehUOKyZ0F6bNyp289sdLy+9remqq6/no1uWtR3O6/Fox/job68eYz5/muhRIYq6ieHeqB1bPEYttLM/XWah2TU2Yv4xabtbKz2iS5KaTfRiH/s3
+pg94x1ZkcdgH8ur9UWEve195Vb9OTP7YvPmKjRs3FNoFXhf8x/wiWwO45svhMSHIBbb80F3CE0+C9VMlsC1YngLTqUcPucW/LM/TZGN3f7nakZf4Oq78+J9y
+NF6oKfTBhbWaiSX0Zf28wsYaTfYr5RuVBB2vhKWFHqIIekyB279CfnIq47VL72+R9X4Sc6TqsxRKKOgqBBH/A8pyZ5h3UaUWJpk/CDoys8rVsdnIN/XJ11TB
+3kdhfF6DqnMT2qnGhlBz3Bzi/O5vliy0BE/zxn8FAyk6RyndMVvPbFYwCUI3gaC2j8KG8Z6EjgG9lhGqDbH5FJv+3V10bhV/O11Njbduo8O+FgMV5XxQBn5F
+i69Hx9ser9WuHIJSvKmYsr2ZW5gyZSKXoH0QRJAlVOVFN6XciJGUGZ54GicAc3lsamUj0czwoAn7d9cFQ1NSzVUKatkzF6N2HlktWf9jV+M6ANnNQ7tLIIeb
+u2ioESUZAonkHWnQxPzX+b9tLD/u2R6D+yX58tFwgOyZWa5T5Hnk0Cn/pliVyaJBVxrmfzYBlwYzCVIxY5OrlV4s9fPrx/AZuABpVyVFtbXt9MiMNNNT/u0I
+WDGJACkUYW3PjF42G88MQ5K5uwo5ZrMUDYrNxrCt2vx+Ife9jm/HxZ61ulibw4rvYldapYrrlebhoJGLCS2LciLVFm6M8WF7G0I/QsWDik9WtaBFp/Se9Vkl
+Ya7aHWeFPP1a3b+CbS+RpBuyIZPma9rNE+rIVnKRKi/R0ZWAv5+Vwax79ivKhq3se7lnIaydsOFbz7LGxGBu10uO4j3ZEgG1PKfzZP2zY+ilvTmjZ3HFkDm7
+sCgaSXIbKPBXm8osz51c8RpqU+wHYHe2W+HVqE8NWh+xbc+omDJIsv6l1mP82J9oI426RGTx+3/bOT6J4yjunmSn4MbUJTn4wAHu28REeJcGrW9OCAvclPG8
+WiEUl6pM6IAATX2y9B+0dYaySgz1W6N7Oec3uwtDFThlGG3spb6Osh4NGrhc+Wx6C26lVnB2m2SsJduJ0LxGcjYrleZFw0gtFnPDlS/vbNG9Mk+Vc/H73Cf8
+GfWN5+6QAXmbfaMu0sGwfFZzckWx60rxsGkQArq12Nkh1NSjGz092aDqiJ3AhYGxBgbka15iID3K+Xjitzwux7+sDbTcxTNhfazTpvNmqip2s0qkeHZ7zxp5
+gevDSbu1trUSqmgtvZxZKUO6Z51M4Iw2alJywvNcLIsLxX8Mye2EzF3BSkidgtfL2IspOxtlELC2BXXZoowhI6OLp5IB3eo1yeSS9RAqaID53M4qT0hekgDV
+R0Br+sGmcr/YzWZvo5XloQ3d1ZyzX59e4t0GP6947d+Vc3a7X+9iu8FDTyQ+wmb0gqO3RkVocuk91kzLwQTKGEecQSQ6+qMHcUiVWNBOah7eFFTqScrDwQFp
+zxtHnWTyoijWFhXzR74u7L3XV5yNq0ktLSCFs/IjJ6Q+v3qO7NZG2Ou+drFsW3n7zYGV6IfmhAk9nXhu7yE3oSNPCM+n181h6okt+BXsAq0FMI6vO4JuTa3S
+wTnwv1/Vpn6Ko/fN6n4fp8HaKEZwc11wIpaX0vsSw1fKLV47dGoLfXrP5IKyZywmlLSiQJA8nMLWP1F+5QQAMPHq0rzFkOQBKUsNdj1xW85aFc+1+Oalf98Q
+a/Pip+G23P8ePQgqAbaiBtbbfWFRHNWjVfgRt/Xv9JAq9kaX3KYEouNmCBl6HhIwZ0Gx0hIienN2uZXJGwBOAERA5Qwb4AzsqtzwpOVhVFhFunOXAbVQON9H
+h3SbftYwV/e5ai2dWDYWt7M+T62eW+ZihVKaSyZfqV37/KQd4XKYXAgFELSnY8nMxj64M7emP+ZDDR+TnN81G2EbPq6M+FqA8Cm2JqDrb6Sbw3Kch/C1Y/Mr
+6d+VCvxL5tV3itZ0hZEJ6ZTAGQ3RCq0nIgaUAU0NqfbsRqdADYPx5M41U6Hr4+WhbvyaRkflva758EMcIW2cJ7ppPy8P45HTVs25Jz3LD4kMxyYmtEs3yCdy
+tcugkFsOFyEehZPE7Km8PXeORyOLuQI4pVWgpEtXCTQ3JSP8rvQjmvXQ1O2+98rEI9OKNH/Wg2o0tHzr42bx+RUZtUJkjZpUBo8/rl7dTOGRfPdhcc06VtnN
+ma/3o5/6DNeA3q1+qrHMI/c1/JUhVesAx+5xbk7YWMd8fpipERzXtVQ8ehLjsM/LTxvZgH3lrp/LlXz1mYnaHhK9wEbCq48WQ5qA9u1b6x6jvaHSIxNA38wd
++4xLPUR0pAULIOwC0qluuJvNGBAB5rY3+h7reuLwW/fX51+6aulmGXoWoDxRJSHryTsF0QbUoB/c14D/H23/wiXbUp2HghErVWwOcDgsimSDjngsJcnWAQEJ
+SVICdGAKvUAIoSNAMkJIwmo9kK3n0MOyZHvKlvz2tVv2tfuOcXuMHj169O+YP2znqh4xXzEj1sqsOnA7995VWVlZtVfEipgxH9/8Pmq+OqN6XKiN6Arq6B+W
+bID71HxJCtqxgwZzcrwv8R4r2im1Hvkx7aj9Ds+Vnx2hQ1M8DdtnulLK59Nhdc8dtiZXLOFWeE+pDAPBTJsEAOWLGQgGz4uEjWcNWXOnnBNVuisuFqpVAsun
+85P0Tu9vk7UieJHPB5xRyzn5z0WRKDdwCxEZ7pbCbfh2xWvJ9w65zz25fvO0nVhvNsSR00L4STMOFZh0ebU07X1+2pbICpVj0vedqjYlGKzTU5bmxnAr94GM
+qk80BSxOxTO9SbORnyvIG4N8G0TcU8INfcHxlAP3X/blMebRF0uWkKZ8BF0gjAUZ0pyKizlrf3T5PERLYm0D3LxB3Juu9jlp/33yunxZE+ZtiN7rv7FsY+xk
+Dswscpttn7+OqE+c1surZeML4ImmF6HHoXcBH5Fi0sNisP1Teadnqrf7SrRXF0BO8v9B8Nlv2U58RvfLd/TkSgEH5jnyaeW1LIj+eN70X26nU7HTmcM+2Q5s
+e0KaJdc1OHjtFHKiuR4+lgags6QnSSouymCH+bIfOgDA2aJHXSgAGYhwAQ5vuLgFfya2ozDY/CeulfSdEVGu/mk8Wqa6hrq8i7xrJfdSM3NXDilLYhtfqhYU
+pAcUge5TRXkuDuPWwmiW2hYGmvI96qmDoR4DNfFCKycTAIDvPUgfDPoVX0u/LufPMqnUeDZlG96umJmjY6LLv2d+6ESjssEKS8pBC9UzKPxxRn+POoK+rvrH
+jF6lMc2qKEZQRQRbjOWLaUyv6pi/KwiwXUB8SSy3azt+6w7rmtrz6mQ8WZ8AmlUyIgNIhZCaOA1qYV4mg/oJ8Pb1lUclPrDjN87AGxzHUexJ4WP/lTL6x1qR
+28pJpoi0B2xKxaspsmfdgijn2H1ycGRjSeYElYB37aEOKswJz7QwIAnNgQ59kMXnkp4AUxv/S2NUYr3How+8NoZzUkcKvUp3sy0pr6Vnct0rWYH1aLaoendN
+6FZ2g8r0hT6HzgcxSeWcJEGZtOhj2x4RsvgqjFIz76PzPbSSlTUoVLfVMXSgCpufTj9nvI9ZJuSw3dmsaH6DC8+mllpevxXclnsdm8PYzMt2r8vFXzN4FO8Z
+HgSozSPuh9HUSdJaR04yHfcJsjYuArrLVerECXKiIeUEcJaexmxiNjzqao1qPwVwbCNnjFR7DatR6qQW9xebUPbH9t3ZV89uexxvvw/3lYvAfCwft006IAPS
+3IkzXnBNQToZ1VHJCQCIZG7orAtkeNgnLd8lst/a8AKZ+mM5Qb6lfe1RXyopx2UVpxPO3EbKZscJoDYY9KrF09WXeY4E3FIBdHerRaENU1uqegOPsDzRZP3q
+bgISRm+iUCtWH2X2jEX2JPMc3oDQPm9lEfzRavSaakv1hAsnwN+LP68GZwxLx3JvlszvcBKb7zNy2mt9yOY1SxMbegK+leJ+YA36ZObazwFQVe5CnuM5PrAS
+wwyW89xioCecf/i19Hvpz0rGwbxX+6xdrYIy9GTlOHnHcMDmydl18q9PlmGYtLsx72pC6yY8z+4kbjNgOb65zKpqQEjiClPL04H67Vlx9pRJn5wbmE1WZCrJ
+nFLbMamRBQHiWW8Iyacs0xZ1Ad/rnI+c83rAV37mid+a9N31yWDr8K+x2YK6Qhu4p8sO87M1Z9nuOS0KQdLna60VizBs3VluH/cpuMbxxd5n9lo3snbpmLaM
+l/kaoyCET6/M5X9P/0vs3eS1/OUBwH+sytuyKXgt7ZHpjepCRCTfra1fpcI5HtguZlXI8BOjFe/RV9ZOEPM6kOBM5XuUnR1ghrphGwIwAFjbv3PlIc91PyNB
+auoQm/QpVy2UXO13al3GqDuMGMCUb4zNo7LzsysSkRPPtLpwPE3++a6mpzcJcpoFOT0rGlGpO1SVRbasvIQ5aX8VlG/OGIKvXJ15YTwLOUNwMooa8CG/vevt
+rWgK0UL9t8wM0NZjGqBthNqKL7aLytOHltCk/D0dmRAuB/T5RoRdbKFN7ZeifhNB7hVmi10NlhrNUn7Ia1m/dwbICFnmYxnZWZ2Dm4TZMDB+X3MFGBobs097
+BTLLIymWkrT//yvpG7JH9SBYsgAfldz/ZNrdC2WNgFSsnHHH0yYwtqlZYlhnmi2TLNXdTHZq5oU9ysmUUxstjJxgUff/CI/lm95JsQR/Cuq+qWc8NKIwGsE7
+NA1VFlwhDCuDiudOn7VcDkqG9JwAAhamVBAkI5yafiz7w9cqLW1qCHYdfs5cIe02mMSglvLMk7K2BZnhzVElTOTbVF4s3TB1PLpetbTmtwXqEaRn8jpejPhZ
+vF+vMlL0Nb9fF9fU2pqsuVu7P00sRcu70ah/zHpbBJacK1KldeVccUVVPyVICv3UP5g+kT6jmBzZRU2eQO/PZIusu0NHFyraWNPSq4MY4k0SK4IwAMzc+SUN
+PX47bKpVijTTkFZyXvEoe448Wr0pDebqZT9XQsZrp+iEesGvf5GVpclSF57EAnpOpJHqjOX1q2tsprxsYN2ky6vsXDFlg/vDJVvMnh1v/yg6IX9dgkCFz7as
+hGC6lXJwl0+bJFSnA3LSPw1JJT25gTnaI0ib9BH2iSraO+gzLWkgq/XUeR1r3uVUtTF0xW8kEVRmSRigiMhc3PRcUiN+5QatygtqvYoRESZCCfD08A7r46PM
+dVv8j5T1/JwkJ746lWMVNBB5A6WU2I5egtqQSOzVQzInALhX/wH1yhSo0s14IY0u004AyDOfnTuy9gmUuv2nU/L5tKaj8Fd5uHROpc1spzwz02lDqxM5p8GR
+9Vbhsuys9eqLaER5hQ0rRc6d0geQJlsTtbG1715YaV7QJRG00phz1ntvpb9CQYlBfMHue/YsSWPmZnSzHa6zVFVSbvTYnB3mEiFfx34j06y3/HRcelzcj3nk
+xQBAWismzUjQ4tRkS0HZ+ETLP/kpZ39A6WuT9QD3CUWiE3L5ne36eE/6WsF0VJsWAcbi8+zG/fc0UuMn1eY05MHkxJnd9fENWJsYCEhdn+wEySujyxj9ORmT
+1I15zX9Pd8gYlA/O8uvaA5OyGXFaL2vPzIXRUIBI3CcFfHpeev1e6UcyEis4U9s/c0yfMT6FfPWu2Jbp7ol1/TCr1S2vveOYtea0GEJOcgihZBZzdyMsp64E
+mMSYo3PKBmylehYgX7t3+X4vV340Ptuqji4fL139oJBbYXjwvvH1y9fK/HNtzxt4X6XGP7C+zj98aATdytraUDTTsd0pP5SKPI2m8XraX9AJuzREQWmLNprw
+CwHkZJpg+cK+kUHzj83Ew5YmV6OVkT7P2LNVKszMij0FFQmNFL0sH4nFHUbc2Lp6igtU++71zKLSKw0EOXJB2YNbu+zFMgMOIu0mbSAjW85dqgOA4Jz0m6SJ
+8QtTyN8NPvAH09cZoVLicWVmnoxQb42NT7u9H7MF9F2BVJd/cW2A0GD/NGmANlqfA1IYoaR27tPKlCAO/tZMXlUBrFFmWRcAqO3hVjNo9xF0pMOhDJVJ/gU7
+Del9zC8kWoyNaIK0qzLYXKQ0QkxDpQOXsiofSpuWuP5SwjgjaEGofFdVMFqf9D3Kph3+5zEAV/lmjcfxVjdvmd/RbuIhZoB3ehhEsY8BkKVmAAipyZ/Z48wu
+ZuXbAeIdWYiLZGIHAJTADbnuLUN4W9CeaDGnvbZ7r0IxVRWK28iXEJV1AgvTXuskk36OTXY2/wNqxgJZWUxYLWLeP8vgZBdR6LxqpCcGpSFZA3OAF7ZjvDwy
+v81nOZ4Y3Scrf7bj1bsZ26OYuBgQAHBOxoEtCD3psMDnONBZUpj9bbIlVfOSP5Ter2irwgEg6vq2tnYnvxHWzXXanZ7ytE+q7M4KKcKJddxOytR8jGpcu0Oo
+Ej/TI6FiAvSwNteJ6adS3fNs6KEsI3kH7xEhXJQzhAsNDKIGbY1V5ZjYy1U4kH406JLVvq7frDofF+e/fO/W1VBV4nJ3CEW5cae5a1uW9llvGczt7TIY6txW
+42bTapK9JrcU+ttYGT7kzCPm3yuTxG8AgkYDE9Im3YUekRLRWsXXI1sduwW3K+HtoepeufJfrU1+/BR7YTlifBawMyXIuU8lb3AvPemNmobKW0XZryyso2DO
+WAbUaDLUJuu5Rp0MoHMcaEx5G3jL94yVMOaWX+g6QKX2Yb0jV/Zkfl29wAcrWvQdwfKdKfQFK0qT+h0+wIVGYF0nsRX4LJuIbCry0hCsNAFjT9uPlS/F1pLg
+ewtrRslqFgUmyZqpgG5jLpSft9i6ja6XbC/zuRQFqy2jf9O1gw7WFdBmPnyxzNWJ4ovdJCgBfjJLYUXD5wQAm/Qc9YU5DXBOOPCvJqqH7RuCva7Mgdw5UHfJ
+wTnVgwZA1+p88nSaeJURTLYxDnXdElylEca65KrrWV1F2wLln42d06MDdoIzLlRui1/zaG9qeDpa3pNfMXsfkrKXvIqu538XG9nLiMZp3Ec7r77Y3CzkCrUW
+lx8opzNbMij5IVgeW+V9WNsksqgq6w2rvv4n048rb5GOyMEVto01K3p5S0vQrwFaOxAUdldpvaJmZyYAwDAIQKAZADIRUO8pyR2SdJMeVU2d8F1BFe93GAPa
+nVBhg0nxcLIK4RpUeEES3G8wqX9VOgdp7YM5+a4bMDDAto+zR/sGY6onlm4/1OMNg0YMKmrLadjl59NN4CQ66ZklTESW0j4etENvqhZn3HWMG9y7p8rdWgKs
+/HZ8QXO5G1J9c667ckF0RiJCL/ZlHfRsZeZBY+x6tlRM8zuUP+vHtcorJ0sXI9Q7t10vZ91q9VLuUWnGlG1mrKt6YuhXGcNtOlOsaCGURYqUVQH4LF3eSN3t
+CRWt8ptykjujx67qiunZ0GiqbNJTrptEjo0SR/59+g/OxL1tVUu5PLLQRVqIOHrscnCWNL7LqqDE3xmzepwmrTYprKz2RLySPTWpo5zLOnYJWweASUVJFgR/
+RxBm6pUlyjVSTGckbAuduHjGPwyW49MoQWw8dD+Y3hK4a9+l/nj1UHgHNLGenD1C4RohUdM4xaJ3DrRI5TNrysgAzwSaUGKTlGYhEJ2BMtCZP4ZDZpOew4BZ
+kUwbhil6oboKmKR3NP72hz26+8n0y8G/+lNV3WGFvWz3tgsx/BYfa9pPPIy9wJbKS9tnpmlUu9Zkqe1dDspDwipUdpBeourFR4+e7V7na+SUExBkhOeEg1kz
+zYoC5uSkc+p7AVLkcLPqYw1cJJQ5C4rn3oo2GLQ7sBNTxfoIcc07eL1UDdzPuipmwSyu252nVcK8alyuzuoY+lHGanYmn97aWeImCA0EBxl0utC63MuRmVMu
+8zMkAILWDpH9YDsjmUc/Kw0yVVqaYHc/zfb2F9O3nG+srLC0V1/TO252OmJJhOipqGBDzUHJTCm6k4cezktNgJaX7/RnDq3a/83iY26zdBaV8HwNAATsvmMD
++efz1KtQWVURZH0RoSpTmCmRpNGccpIaYk6Ift6aW4+9Hta70sG5K7+sGoJR32Sxck7WFG7gQl0nrDyx0T3qCNXJI6O92qCWkb/2n+jL0W2c2nMNIPuTxGUV
+IPNt4QyNEDUqI4zRnQxx4w3azFXOylTsWZkw3WtV1KC23KL6JtHH/HzlgNlX2i/bKqdpNStsQpcWFXj8ILvr0HFROIgikHfU4EF41+V0QtHdJKGAVl5mCyju
+0wK7drZIolIA4uJEAg0tGoNUnmCNKxps23vSx5rswq+k7yxmRxmx69jlIDN84AV5hvLvijRDmQY0WvWkw0bhJ6ySDDbmtFRkwDo5gp/uuoe1+4ZaJYaoI/v+
+9KPpEFRkI/fh73PvJzMfj0dpMamLYKfpFqnH+dD3HRn6baTtdSnijxskXN2fKlQe0533SRKb7G3z7NC9UNyo4oJw4nh0GVeBdvtpr5984Cf6Yvkd/EVNxjyv
+rUrdqmLzU3GA70vgTHrfDP0qKXsV/XhYWpmD2OUKYD6Yk+CG6qbaIQlr7FTbL9Uim5yqyPy7jZmb5kfM1LoAOlVsZrRbjRcd5IRnuk+yd8z2iJ2RbWr8qxq8
+Rg6TNhcuc2PaOjtBGLRZOnOIW++4/MCm8/3sc25TchrKelZOlIdmeQJAmDk15x/nkI4DkfxOrl0E8lP8xD3dup1yCqYj9C1ZrPubenb/heNCHafieHazJl2S
+iVu0TjsuvJym8Rg4OqSCdzjtWKh/E09qsTr+tWbofKnswwHGiFDxRpL0uIFm3Fxc1a2kaIACklTAE9emlNCYJD8/Y60YmPcjeiJIs3X+aGepxNdQVg8gRP6Q
+8gh1lRfTlD6vftDXO/bVwgehqHjbNjmimQQ1wzK0IcwwjhcRVTnu+yP+CZ/a8ZjfSMar32gegkunaDaODMpK4TdjjalzModbmrRRJsmzw3K0a3TFaRmewATn
+BJsUJYBBj/cAPslJZ09PttBDMKaPhvNLTi/PrBykONme6mKLrCAsG1W8SueFbkV1nunRbRsOyHmPSJVliCCLjNtcT2p0i0xDQNZarVARbkBwrkwqCyyVxJON
+noF3AHa8s/5hezyNbi1s87Mz7nykfpXZAD3WToPmXiAQKT9HsHcvawU1xrk/K3t+eUkjow0qw7L45/k47m67OuCmWrfuMiFrT+EgExUKLXLhQw27zjSos5NB
+uAFkhxIYum7BtWQ9TrZydrVRf1fVJkPHg5LqHAJJ21L6vMP1uCxqpCffCXdXWF9EAJqwRCbMBIAe3kOknG+Zw1DSLdHdufoXam8e0UCVe/zeZqP6yD+RvhhY
+hA7Wiqx/jmxExlYY+8SWW+jko/54Od0ibi5fuL+e4wFpXiC+7JLNE4dYb3FE9JvQkvwaIN5dyGjW8l4ykqn2Pnvl7JH32d+kZ3joPFDiLDYVB0ltVhLsyzfU
+2NLl9tVjKMk9FfWFlXuJXO+ugArZmc6dE/gHSj7hi86b9VvCIOZEx8flLa1/js4bFqiyvKEl9qxU+hwjl38a87TSHK3UYXxDqp0JaoGalSMia7jIITjuuvH5
+NOl1QesC93mougg1h6VVoDK67CqnS1pnySNUMLL0iNXBVn74nbrwg3Eex0dW11PZayIMI2P59nPqEdRnANgkonOySpDEtQ3n7Qvc6/U+x19InjoFLmdPKKoa
+Ikf+t933eCSjaw3KcMdjVh/Az1ptkALC8LU0DwJwqpHI7yLABs+JbFnDwMkxvrnZlE2pqWXF8fyo6laEsTy9PhaBh5qSQXPtpJeqV5oFpCblWFy5YICcaAPn
+NNA5ZfBLDbXEt3JmV3AIznzOm+j4Hl9QQY/L3IbddlOOIyFZINXsLhuETLXT1YK5/Rm0hpZTrP+9MSHrXJU18FLQRvu0rOhGfau5mGncjYKsDuw6J+fzGtyL
+HcBp1WcQwSAu5QWFAtYx4fsKMynUSeh0+NHm9Ao7syCFPhZ4ySN23UOAvHLH7W7XKr1FglYS0GLqOTUZAaWYACzjIDT8uoDVz54Y1hsvsHXxCYiac8LUVIrl
+HEPHmoBXG1DduNPLHnUNis2HYgHvU9tZ6xHEDBvF+6ktFLEeIr8GqOtNrmF6Hf/7DGv/+UAA54v/cRg/eE465fp/H9b/c/6OKvVevQheW0pskBW3Fy6Fa/pp
+jpdU64mVjav2E2yr3vCFKzuc/MO4W1AFarOEvmJEVJrcsqVmPVqp7cbF9dLRrClK7hlSNAM/qIC66lglo2O/JKfZG84lDxPm4M3BXhauMlFIND/mj2ulLl+0
+nOqJrnLHiOPTVTF3uv2eKYXBxLQiRga+IUSMG2/R0+LCGqBiI+UJkhZuRRiYqh0eUvMrUL4gdvkJSNOjucUFG+pHxz9FgUwf6+7Sml2bg1Y2eHdqFzRa/1fU
+g+0bvAGAZquSS/ulxLhscsKu04FCeQnp3O3DOM5vsIb7d+o4gzXaXrAHj9dC7gYJsGq6eoSkDzGTdoIAZerMSquDPGMY4psXPsDL6Yc5E/tJxvitegHuATgV
+gKBd+Oy/03aCfesD3CdAUi5SmHkRIpdsoDkKpCMfYUjPKQORp1VR7sebVGfSeu3fnd6n5wNf7dIBaD3GQqwp3uR0WwXD9CjOzlJ4DkBx0e0mUwgbuHBCjYtQ
+HpgdG6YHcvky5PbLnIpnVSMCz9PWDuwAOZG2LH1hnE4PHc9mJbNCouqOMCrKMy3OaAZ7ZWncQ5gTmh3kJ5no3N6gmerqyT1fffGGdd1Y5/FRFXAjccTRhXGz
+OrzaB3Fry192OIJmcbhkohZO7NiAQKR66rJmksqqn00tmZtybCO/2HGSytopeN1POptAyiptnF+X53sYJwXgxfnHGXKCOOEXvODsvt1zuuAQC+f3DBkuzPvo
+fKFBC8i0259edIotRgQCWnjE7A5jCRpg1SGO+omfYCz9a1z71F65oJ9utu/gvZByww+BhpGJkB3nqSfI8dXscIOIjS7PpHw385WbM9C0xPMr4NrACTMPxD1R
+IMSCYtTVgoBIkiDFEBFr6ifM9Y+4dUzZo3ctyTUzHZRMcx+JKNVbUoVEpykDkRCoMQnVApreAvRuihiY1HtRru2nOhtzciatto08NpHr4aRyirenFm40nqrw
+ZXZdkTkhqUn3B4+lXL4DLXxAJSJteG74MQsMiFr8/3PPMAzyWtsv791vk6iTX/SHtS03dHHoV7tDd+JylqRxkskru+pKsNWJ7sNAZVviWSlAmDQAex/iLQn1
+ujfO7Bt7NrRf/qa7DeZd5Dj1mq9dw4tzGWbaHrdCaiX3QZEfc23PyOBcm2jiHPLepPeh2Jo0ex5n0FbatrjtZzGGfMjLGmMy1uqa5zBZHtI6acfjaa94Nfub
+lw5ENmGamdbdh5kjGq7GzUrDailLuRd1n8Qqe7FawgxlLvq29Ukl4ThedGKtDe4mrKjIay3eR8sUzWcshgUnQA2jv6nvwxDj8G3sYzWIYQq/MeQgN67jbjWt
+9DQYYWugmQ656QLqtHlr2K3tbOORRRxkYObroQK6ckxbgbEMan+CHuhidgXjaoI4WoYiyVcBci1HLXDBzqNqjL/YqNOXCDndXk79qPJGJPZZnHYlM3RuWHs6
+Hy+3Chp2zyBcV/VJd448TLfNrt7EvTwKzdhh+6irm1WuqV4g2o4NezU1UFNo9D0kbpVrfF/oGwss7Ou79aal5DgG/MycZmz36KBas2emUEzn5jjlTWoZII0m
+y8f0xG3JO5QD/KPpU+mnrCei05C9nOfbH7l7/XinDrWcd1Eddk7L2QUCUB1s3KRakplX14D8dHb83IuLvPDLiiWsHVJXrSF3Ndd+5jLBPRJTzOMU3KHTDi5O
+/FDzIzMasHJxH2bStcMGEijSNJVNVz61NtNWzOc1j5/2j4yC2Udu8lJ3QhYV9W0vpOs8WaXNuOqwSvaOhphDy747zRpihcQHjt93qTK9+v03gghZOKgno0k5
+dCTHo5TTQtW++EOyIwwkBwjCc50ixn+wJ4podf8TNn5zWFkb58CBKPbPOCFjrN7hmx915uoLUf41KtivnbkzhCOXENaOXdvUJBLE/Ah+c7GIWlmo8+1r53Da
+HUafYA6HzSYto0Ot3JkSECmNmgeH1sNV1jWqeg7w50VgGGs6H04fU5/MPIOS77GekdEwC5N1vI/e8S5r/rR7MEQv25aTJN5TsTFiL7lQCkWoRTZdkHjSMOMy
+Ls81nQOQU/nuYo+LKysIaV5IUfPqHellt7dfaKtYGqS5e1CTPaU5qVNKa/CXp+mQHShlnoHASzQFlJQ0D6FyfmaBoJIyWJaXGYb4nDi8z7JrAGCQe6xK5nIf
+n3itUfzru77OIdXisamjjo3k24EbRLYakd7UO3Q6bodEmo8oD/QDROjsZblbYImzR0J4tjsB0pfJC9d80TeFXm6pJP2YKvmXtWccWpZAVUbScuHPjOerwXGY
+LmghljyMjkg/mt5WSSEP6J1FgUAeFAlM6ChoEmwCNNJ/vNYYycHY6ZnfuMHniJt0rjX+NwVc/Q8v1PCjWrFELNO42z656CaF+rY3qFQQDTwX6o7+SG8yX6qq
+jHzDlk5T1S+xvdHa2B3rOf2scM897hxX+3Bw8gdJ94qQnPAR8w8Z4GvlKI9FDba6uQxgYXkJCjcTAAqPouC7kD0rWW7Ai63is8p9aGs4J8H8rZ/btvgWVIHS
+al+BGuUj95WtCTPHGg6dqSneEFRpB8gABNUA6ENkqyxVyaYiHPoIDe4gp2UZ5Jy6AtuTlfpFmZGWoezU+QM79TrX1UTqoA9NlzdP8Kn0vZvY3Z0uAmH+srQD
+mpY6LeDmfCPZb9AzGAE2AIgQHIgEAIQBpsdvI5VLJNvORI1P90nnM5NOO8/dTeNy9MexIWRxDvfxZsWtO+0mb+LeSPzi4yRvMkBt9nTfTllCOOEehldCGzU/
+AIx5bFw8AMCUQu9U4WaXGPHZCkPGa1rN+V1l5ZWD4XoF3LMSR0bP93ziTd/chweF02lSc3sUoBUt6+R84hmILhAY5GqccgIC8j4WmrErmpgH3656/1qw9i8E
+2/Ykvd2r2iVjmLJzgbi/JenMMURZpS15Y2u3/NM8i7hetX5S7otwERgdqzpez2HDJHXl0GjkKG1jbps8/S1H+LUaJfHUL4Y+uH8a664RfeH6dP2o1hWCT7uP
+t8phu4NHW0dXUt57dQUdu54lcVg90EyIzb2sRANn6U86m6LAkGJfCMbaxfM1BgGo2s1aBHihqb3YHDn3mad6SwQ96ebdupHm1G4zP3IULHRw2ZeI9k08PFkD
+Me9LFY1tPp/7EZVhgCuUKG6UKvVhgyQT76981nkYyKr0OJsL9cawVkr8I/nusCKmRgPKCtHLorNS+Oi38pr3a7FHQdkFyWl2iBnXjpo0kLuNXRtVqtPhwoXg
+EodohfclYyyBNsC7O2yxi60KgNRwQxdP8iPMTFDm5Evayfgt9YRri8cK/+DG99DxaiTDsEP3j3VDSO7SB72KCUJrgXDNCZ6CykyYKaT/g4UD5UklW/dvDP13
+H0s/w3f+19ki2KrniNJ5Z48nY/venXbty+uqjafNwiN9FqBbo9xz8LQSQPziTL4l7q29AxCM91W+nBHIdWxpNq6ohk9LeqQH/oWqmUatZm/pXBUvu1QyPd+U
+bbCHJnN2/cZmT/RFmJpbe9ROfo5D+3srPqt45WfjDwHiQTbFTfNbJafGY6p+2Vs9jnt7eqeqRpaxydktO/x3Jdt70t4d7YszwDqjZKw3V22YJ9GOh6frL1uP
+82e9WsaUj6LWRp55J7NtQCoxK1vUvziTJuzFGOQyIfLTUEn127I8rj5t8opvTW/XjvaPplOoXPyms8yZp7Z+d4/S+HUUMTcrwntm7i60ndyp02K2c9qQHnXz
+AtGZZbnzN4kEZ4W1Y1v7Sn39lu8BDAhUgR6StIPyXTdr5ZeklBreqTH9UPpY+kzju30n/S735HT1DKllePHmqYlO17t9G7/bfKdRN6lLYjdZO+DoZ0p5+02o
+exgFRJTx8JUC1sJkc5P0MJAZLO+kM9SwR/yjKudrOG1VRiDoGGFz4v8LF7HNB9h7+hXW3+FT0ej/D6MikLToXozdGCnrb5WasXwlnsMiPaonx6mGduEE02Hq
+NOC9GHuZK6BzdduHdJ8kO6L+j5XwSduUZDJkYrhpMOCCY+5dfFnx8L8aukeVYdjsoZHWnI5j1xjCiZXcIRRkPamTaCgingeLefZeD7eRh5uDrS9USZ6JOf3U
+BxrsvSCd6aiL6Gy+EIJgFfgMsb0i/016qfOZdzwHXw77RDUhs7ux0U9uC/05nHoHzXlWxbfcHYN5xZF+4tPhrSFD8O2yLglhIsgEoWs2OM0kK5+WAM8Zz0QY
+xI8lvJXD0V8DQz9FnMxLHkcYc6BhlJgVSC3jxbXeInxIZWYyEbSru97YDAJTEinGM3ZrGcyXzanpoXvCFt5WsJzlhTXKlQeDBGrnx+0aJvVGkfB0AW1lDhxv
+0oakxiGluTYm1zS7gYchU6xYmz+3zMB02fecet/1B7Waazj1n625UFcbb+/LYczl9nFsM1oPm27qJnyTnfvkFIM4RvZ5rSYpsb18KZAhNJIJKXCUUfv945zn
+oJSz2kiJNc2CPsAngTv+E3qC8X6cjDek6vAuFt6oFLAqOa5mLMRiJ8EdKOeIuUXGGTkpZ2TJ/VI0zRUs1eyuc9KFW6smOEuAYmUVn4DsTkEOSqVaAtIpQJB8
+U9yDrq+wD/gp5fsP3O9riDGZB+U/qErzB5E1a6E8CsnR5/zJhq1FCFutszcCE4A0r0G54z7SampA8NaLHgFkzpjXVEEm9rcuLYn8O0SZYj2NT7dLVj+rQFhs
+VUeBMcQsn5+T7EoCIqBw/bomxU8orwfqvnqa3gQemICwEjZej5cDrXMXQcoKtsPj3VmhYrVPXuTFearPMGgE4d7xmYTMl9ygVD/5rKqlZNn7rCaFup5JSJ+v
+VuNG7Oa2S82KwNvpqqVXv0Zs56h9xzHdARKzZdUg9fsgy2qmWcbJp7lG8pm3V3MCZD8R+WNtznprh+1su3vL+FRjU+uRHqjuPUlr53Uej04ffRtgEnpufziH
+iGg6alFGl+UNF1tw1gj27N1/Guy7Vg6qYgkBEJD2UfqxKKJgcufwjBtAvt9QFgHl2D9IfdYLqPNvSkb3AyEfKFwhJfrn2cjXsU1cAzQ1MLasUqmUG8zkx0ee
+ht0qp5UegAHlhMTnAyi6SSEvuqZn7PmrzmT3nsuFZSkIYz5HUEAzZa9q1s4ExLany3DWn+PxOz9eXiifj1Fy9CThS58Plaa0hmXgrvtajksAaQGx8VdENquA
+SAhD8mko91Yv/6wttTCo8awZTtX+IhI9teoTvLTaa7NT76DuBMYu1dz1BfxSR8x4o17ArSLDDMpUMhI9nCknsNB9JhSKQwU1bWCR1pDndvyDpbdpgUGX8bw/
+rGK5i6uxrBQ/w/o16v1trVcLbEgHU7a3qrH5gGOcyo+stliTV2eKpGL3qVKSdDGpLFfT6ytDNCBXpS5r8vhvTe/TOxerMIawKHbsu6wK24xdstAqPyMB/Mip
+HR7WBbbCwBq899bojkVL1kI3LXifNubN8LJUlgJo3EBL4dfCuayO++SzxMt6wDkBw4Kriil5xYuqwodOVlO3esKVqx9M7+8q51XvUPQnyulWRrvtrR4vmXbQ
+d9ujsI2bm8hvF3mqG5tCPxJ6BuUNhzBDdP8HGzUBCnUY1uxF1pdSWwWxvrq5mgCuAoifSBBl1Nz4ofKtvSHYg19qWbf3D+B245/DwxDeHIRhQztWywGWIQjR
+w4zYNiNK8ptqGNVgeEHXzwqO14ugsVbd8gl8klEiP6N1MGaYY7i4yQCvOM2C/OICnrBEmI336jW/ErlmSnQ7OyGT6b4YhlfWcLH28hY+6wCKeIEHB8IToTzz
+iAGuZdoi8VyXXupDd65rB8PNorZn+TxXW1+eaVavM4fb6fPC1xuqVTzAiulxg3DvXDmBNSd43gMb9zkB9YU7kofpkfGnJhb8QPpo+iTv7DJW4feIkp1Ha6YN
+I7ucn9/7Wc09oUDABBce2tbUPGAGOnN+AvkqIZbZB8FaxMw8lV9HXS+kqXiHns9pEZofRdhWQX41ygkyGoeVRlhT1WgA6IqyO7UpGMQIK0DAVbYKf4E08ZgB
+EGBT8XNg9YgMkBNKP33bCqvnPOWEc9v/9kLIXxgT+Lc4O/1PKn5ZnLFtqMWMd6FV9kKoW6el0m5VtyxuWO3Gp8p8ZFTL9zXa9yZhg3GW04xv9QwIXFwycowa
+5g+BFv1sflr5ZDnYtyXQ8ZeT65IqSclmBcWkiU3WzWV0fOigqCf9VE96Iy/cmo8q7ghu0LtfIsQbM5pKAvdS5dD+MosFUyO2ghDrk1mAKHMb4v/ipxaOMN4R
+d8ozrlmbqYXNGDbM9oAASascY2UyCtIRB4u9xcZJY/TxoMq7ZRIHAgDmlcIF2YnWIeNDhJ/4k9LUuRnUcJv3AojtQxXSi+eYgHVQi19hH7ykXC+VmzDlkGst
+g7j1utWyK/Bgqke8a1qxRHFvLbPngQk5oWKT3AILLnmEcp+ztI6CpRHgjAP5akekGQbErBlL2fRE6JCLRtdgm35Uz6zU6OOx8btZWEPBZZ6mQ5a7XGurRmt4
+VntFyrZEov2DCFT+PC8vhSP2B1IJqDMRVAzNm4JO9AcD8vJnZA/eOP+hxkdruPeOyiRUOThNYEiIHgWhZBMzRQy8PAWYxXVWMwKZIObptDwSDI8RKoHdAX0W
+cQ8xppLMwKcYzfYlRT0UNftFTKWH0qnNC3iDoMAhpnEf5Ed2sgOfyiEUij93xz7hug/BFnSJAg0rXXUGoLwqujwdKKR2SCXIAGSpgqQ8XOJfAlJOSBgzBvyx
+8Zl/NGb0bgL3VJWe253WkmGvRmCx58COtX/YYKYzUOh7gOIUAVPmKz1MeZF7+dkdqbcYcpLv2AAW93XSc+Qr6dt8NwsH49+xpn7EN9mlG0Zaq1kbeYfxppzu
+HIzqVS93OqauEtZ/nS/7mLyDQ/9rhH0RzIJuDB2UOfVPQmgFVkDS75lQsb//TLWidkkwq/FsNo6HXokpSjal7YUsK0SV9HfOVOaTMZ0uEt/cjZMGIccqsqBw
+1+pyG+3afSLt3ojBk7aOtD6Km3IbRsvgMYCvpRA8Uhno20Lc+A7nSqyeyc9W9Z2nq4XfdT5uzSJoPd1zSZ1/FrlyIRaBA/G2NjPNgMVVieoSEEu/VCm23Ssl
+IQYgOR/0243G0CZ92vXgJM8S/fVFpWlNjrlGibcnYxOe3BO/WyUplXErJK5659Jkz/9UrIpo4FiEJLC0TEuWm9oUmuxhasiMtEzcJYUYJqDDP3xNa99/kv7c
+coV6Si+5Bk+O6l6UBIwouRKuH3XIe/4Vx0rB7oHm3lf8LDV95RKkzilHT8wpqXFpE0uWiKvSEGig7kzBfvKLKEdBXRjEH7v+REib9N4Qc0aO7Tb3dlQ4tJGw
+s7/S9c9PI+v7KCT6zhNqr2bbKjf8OisklJ+rW0Ay4sDUrXYkAvEpWBmLCGs5QFaBKgEBAc1l4QMAFA3sAbk9zTHQtXZN/GZqeFg2jaaM6K1YjxcP7nTcrjFU
+dSUkQ1FapM7NRJfInyJwchPyDghrcSp/nKGHTgpxGJTB+0/qkhD8KOBgemXn1MMnu74HYHtQIlSJ5IXV+PAQUdPpuBDibskkYlX6cAq64jGdVvPu7EOknswL
+Lx5pswlYSB6+mICMANx8WI8E6syFf6GAq8hsVltGMfaIlnVSkTN/0iGKr87TqOwfNimrEvdB2HEKs7NvxAk4u9FPjhuDKOhaRlFmJivZQegQ4ZbgOjeDo1Wb
+B0p1UuYlNIq82GgUlXPTLEdFFkWFooAps1TqrsrLqx7K1PhS2n60gFiddttjbDaX1dLgy/RO0yyL6ALISssaWRXsjPkbBq3USpwHOXhMABv+Qn301p6ucp/J
+ejb/82LzEP8Zq3rsaGZEp8aTAZow2zHZh7chqn8IgDPWniEMqgNNAbW8hb2DIeyt2DFEXuJRTeCsHOKYSwSMbjleaM5UwRMW+8F7w5hqWwmPg0S6HZjF1Uom
+jTm8jX8nzWNqQUY+SqYm5rgLneJceUprmIicSG+yIh8Y5CMbgazJzFYORUSPJ4EFlmq9DGTlMg1RSIs2kVdBKjXFhzYlhu+Kv3Hr1YltA7iUjeCWdb8oXR9G
+78HbKVhr33lYtf18QzNGq2c3tRasUcJGwIzE9Rqa0YtdzucOkIl/BFRty3uvoHzk1k8KzmZnM9+fvhXOFVfAvqp9HdSsr+B9ViWus6YlvEQtGgHRLqpfhS34
+J2hbS3lvLjNSXgCARuJaJ3UFDBR1rVENhGSIYn/1m9MxYDbK2vintl/kULjTEoZ8CsnQxvfenfY1Hj3dLtpj2d1QV0O1GQ2jbKptYsQ0L4oQVNT6tlkCFbSQ
+2k4QVLpPNTvantVl0Xg3bXDjCCq+z/rOStfZx9Id56S+aN0Kyz6tQwdeEJ6PCERqqle3vHhuascCnSk0ZQEYDKpK1zAku8RPfD5skgzDUIrASlBC7D2D5kI9
+/UsZNT0HbU/9uzgbGOPpsiNSEG43Y6/d2gG0oSISFSM27tZAm/sQT9vn2oWDzreDog4BCAhkMOT7pPEl52IEquFwdYIsBwyit4Zjj90M991zom8M+hnvUoZm
+RdoaS9qRyx65dm50EuTy/eoYHQzasZGENnGJNBOgab3LkKRm8RxFzDTDJskAZuu8UOfOlQR9fXY4k6dcuSj5kNoN+6vaZ9jXIN3P3TNFSFmqd3q5x6oDsp4x
+yl1qIATOTU1SO+UoJyg56XQm1hc4N5CFcxT0yJ3qEqimB3ovkh4JVqvUoy2RRdFA1Nn0tvvS7mbj+Y5KfWd3VhlSptMmuLPH8FnuqCZv+DZmDXpQeTZ5Jc6p
+fNwk4CQB8FKdOUkCGbPC5YN5Agjr8N1+J6WOHPKeVhLna1UEhfotJrOurWBrmy/cLaOsQeYnZc/TvGkCPKew44DsTmkbGALCxhPaAt9st5kokgOkJl9XeErK
+eRJid+ejZWDbtD2uEerrer09uW9W+1i2q9jwqQlGAIxIumtP19Yngma8ycvN5mJAp9kJAAPUQGZhZLDlvTGcQMESFQ/DNFKLxhEjqa91NUgzi2ti1dkJ4au+
+tZ+wnQml5gU6yajSdjddXA/uOIBl9GlJSmB4Yt/Pg0wgKDt+jxhRpbr+ZYD6Qgev4+sIvXVm627T+5jt5o5PqIKkKX686hvtTwuCSGUc4a9fHeq3aiq9psvX
+OmzXtpFwBu+1lXSctiboviFQhQ6DkGs2fMYmfKs8ObbmRF0ZBy2x5JWG2nY2+8cZmzVI0pzFgoDGmJJS0IraaHVN8Gxf85hYsip/JXOZ6xLSTXdaQ4Sb4Jae
+hgzLPQkCV0VWJSU33lUD1HAbTwr8k6+4lKZw3BAe3SfSU0JFuMtjlsopzQm9U+ccnntgFINHNt1OlVzz1d4Eofduyb1bsAHlPFlgfKpEn4sdcrDccCyN6/38
+yryimiiB1mrQM9AiZ9N+bxZPxppnmwEtFzkE1R6As5EsDc0ZimAeoposEzF4U9P38an0E9yX9rXQ1SOO3zGwhIQQWiJji45WYX6Vb2Y6qE7xzsAgtavHceVW
+XrlPINKF1ZNQhFDxIQBQ6uUNg2NntmNx6TnKM0Cu45Xf7eCY6kd8rundDEiQ3RpIQlrPjmaZ7eD2V54s3chN0GzeaEVgDQmB/DwjMHVr8TbI2lbrwpB2A/4Z
+o8WjFX/SBcJeaNZ48f1DfiwQ5x5M0Gd3ibNWYOujtpqaV+LAtay+tPIxHj1Bcid+lVRc0WnPos1TsAYAsdCINCkL/QqvDiOGQmgp8gc6G/Fw3Tcmi2p5EZmH
+iGN9Kd0qR2ftXrC4qOYJZCVsd3xmTyUz/EAL81Mlmo/a3LutPL9zqWr50ZvQ38AaQVI/gdnzHLGf2dDaghZi31qbhZSAoKJZM5YnBNru3jU3Q8gpawwJXp+P
+j6B/apyhkYHuW02WnhG/bSHi6LBWo87bqx19TBmCJfG9fb6FRtrzgSw0nJ1rNnvedFGhyFWFwU4JU2ZAIyJSxT1Qm9FXKSQjH/Letn5T5wt+tDlBSh6WazkK
+XnGcGRuQai6mbkPtTUA3iGsdGrnBoy62o6Knq3Dujbc1mcCpxKcE4r0M1iqJyO1NyjYKQJUg9z7NJiJfHjQDRMoAgKYJ9uzbtM6L1hRD7PM0PVPPxDnAdy24
+lP+xX1wzRaqG30fnbGxslamJKoZHKCHQOv14ixDbFmiidCWunb0qn6mFUttjJWDPSb0Xy2e2uYbvaAb6L9O/SH+b/p53SJSJO/ZH7BKlejqGPNvhtOu/ckGW
+8k/OWle7DZnYDpEWpDGx6TKFWuED9LEj9wZnRu7BfcJsb8KQYQs13/AryNWFDa8LGsfzbL0t8GEV9EPpnvlMAs1vRIVl80dMPLldFytdKWJb5JCSXilPRysh
+OYejzzSCtB4rmSACABqSrZjUNKe4SLCKD85A6CWcM4g+SHuo18iH+1S0awpU2A9manwzWUjvXtWEiIyqX2ZkiFhgwTv/q/Rv0r9L/+VhrZ+x0YuoRfAoMC3t
+LcfDTXi+71JCQjoUWjUWHffSouFyRbWuzKbHX5BFYqfYfWInABGxNm10Lfhr+kDA1URq2eA5ncEfQYEYK4dd1GguMy29LMLhJbF8yrHPlIvxt6aBqG6wq0qc
+jl5NOmwDb3yorZ4YsyBPGbUzcnz5VNfhXdvdZldJzkkkiQ5seFnOyJ+yNWnICzJ9uc57rhV67fETZnMTdpUFa7tZfAlemBCb4Nq4qfDh1N6I4kMV71LykusR
+kZURggE8REtWszuS6amtIYrslJNR6a1qdkcLKGrM5grR8D068+tIAeKJAAtyM8N3Kpa4xk/WMaKvNJqat02MXfYkK6NNfn7LSZfX81y2gg418B4nLc957Zbr
+1O4kZBUzEibVm0YFvPhHZXgOUZOmCkCYAcgCjejFnBNkXUCI0nAwA3AJSh5UXqpZMbS2O5EBJABlHrcVZFIVMfKM/cPWQfaF9HO848rclTOznJh+Xsq/8dh9
+WeNuFg7m7Tjpv32T96mfV3I9d5V0V7d29TOHFIAsTkqbUE//iuvjDzHFSKEtg9OMxjGH3kOVMJc9mjZraJDnbbInVs6lYbP6mi94ReCX2jPzxiXbsnmOk5Xf
+vNesU7wZj6qWKYtss0LDbQssvrYhWShIms6AiHVHyJXyhF8+K1cqw+sQkAM+jX7PrfKN9N0n9Fq3Yc7I2aNbrsFf01xhUZf/39I/pP8p3sMU9KrWN5RX/QUz
+5bvwUCF2h9Mx5hevbeZHbU9q+GDN1Lh1idnAsH2vbFSYg+8hexEBUfCK4PDGxVa+umEjPk3Qin+lPu1/CN5G9eHZKW2+PLUHY91yB8Pkigy7wnC84KFZ77pB
+Dzql7fGQA453g3FUrdMR57NFo5xBRCtK36M8PavTCrBpIz3JM8KgvyAyarc+xRl0RqN3Z3naH0h4T8x3Wnicn6aXVX3Zw5k7UdRhzQUpSmWVwgTJF3LtDSgj
+lQsqS8lAhtKPD/dVY6XqsJtCgqIKJ5bHM+Tk7nRbe9APozOKPSsa7MLYfZp0MWfb9rNU4XlhZQQp2pJJ1XIonXgadeJyucbnPLMgzhlktRpE3OlGDX/mUz5P
+JY4q/m3Sq/YjVf3T074pVu+8kanW4tsknpfZTl0Wt/wGxUs7xYI/n/h5Pu22A1SHSd2FWb00ezRiOcZKg9rvU747GGUfOE0aCpsoWTICS8e2vCcn7lBQ1xhd
+j6e53y+mH0ofdD0/RzHc2C0untYobDZuy4SVvTJwlme6ANzSTxXFsOhqc5ebh0iaqqViVJIkrs4SNGcoq3VjJ2RZzChEFPwxKf7exZG48Ug0d+keNyloBtcO
+C++gOHJRV9tPT9NWa1bc5gCm8wWZNg4LEZqE8r/pf/SGYOuCdoVVMSdtZp6CWJlXMsfjGItzm0D8qGr2OKuyUhaMcLBU0hdbr1HtlHUv10Vkcfmg/q2neZtr
+b3pqxD5XX9sGcVgbxdUREBEQAs4AItSOUknREYjmif4LcuAyij5dP1A7gk1K98Q1wFF4n29MjV7hJ4JhqCJxDSeqSVy5cCCnm5nTOfuE8f8nl6WF4U1ffaOu
+j67ow/++1R8Ni105kkLKSs/+AIziToTJ/YKFtm7lQrN8jqs8jHuFa/CXp6dK+rghNaegpKtmcIoK01o9hMc64wwUG1VypX6MzqWYcmhPMgGuYU5ky1S1d+oc
+mdZLyQzbXVMHiQexner9c0n08ue2iihUJJ14rYdjUIE0+VLJv86wUViYMJwRcceZHtVMWd6fxvItAF0C6Lmthttuk56xJ/037DsarsYcQHUa1UMMN9zeE1ZC
+CHP5Jt8FGGpc0ouf8BxDXtz/TTlS0TFU/GSQnJo3hPOKKPNFzi5GSHanAWZhqgcvjKKC4mgRhZAnZ0IQ1OVG5QdTSgP7M7ABrqoYn7ObZu1IGCcO0zISkqC9
+uaIhKYpkFpmTkrm8BmkT7g2rOt3U7mX57UtAgG+uhmpQrkLKluNunBh3saGyM4zhvzXHSrXCFfbyWXNOjQLvAjEiBpDXa3qj8wWXOflY6OtK+ziCeGV8VWLl
+porGO2VJYi6kC6RzM8xk8RZQ+0/gPmkuck7lyoH04uVC+SIVlYXW1MJfpRTmvVTEf6V2EO8q/ea0rcKVvpClj3EfaE2q9Z4Cn54vc9V/VhdKHjMIUQ2jxwVh
+x09T7Qb3x9yWR+nCguU4BlQL75Y97oLWqf3HEiWWE36cfCyWha/ag/qWo9yg03ToAY8Zy3XSQHpLGOKeAIBHURZHGQiwQh7QDASiZuMn+zlB8KYCItRih8gt
+f5t+qVcbqBgZh2/HbiDFOh7biO0Yv1E/BPJRMXbPZPsxVrD826CHnDCQ9IihsC/JhtLn5X6Wj0Ae6lM1Q4PaIluARi0GZ3LZdwSougONzb6NtftHzsButFHu
+LDe73a0O/spwUYZLqEOTkWIGGODiGDOgdEgQ1TG2g+vw+7cN64xwLD1+nNPYjIsj8azNoXFoewk2eTaeWJNkvLdyfeh+3ABE4Ev3PhFeGDF3fvGok6VVn/vb
+Sp9oynpQYeCsKHuUmUhVx09tjGw7lQE2EWf9JwyzyiuvqzYAOfjtvb87dXJAm+Q6fzmJUvCFu2xtbLnaoeboLD+OwQkrM4TL93r4GNb0bdP1nDTV1Fim3JBH
+Ga4+aj77KEfZuNWPt4JxJkIaeCnSnAZvW+M0QvkEWh+1YUF9g97uarYudHvPod+8/G3O9Hem96YPCG5XeFW3DKrScM5oOSQNwqh35KCD7pMwNGTlG6AhAakU
+MmQ52Tille6bWoXkQV5Z0dwRFl8505Tj1a7jIA0wgmOy8yBUu/YrPNPFPxWyiQx+sYSlzq4nAiDKxfaVLSV/kT6ZDOzPJQyAt+bR5tPLnnlohIGCURTlNy1S
+6/WMkKCcZhtgCAE8cmyoTueZHJp1eXDVHnxYuTSDFnIncHhcCiFugtcSjaKkIneHk3QJ3/LHASPJm8kekqdllLmKqBE1DFKHvAoB5O7xBHDAf58yFyDbe9Vq
+W9Xc/Xe1O+lwOrp0QuXd0XB3WhvLrUkNeYoqSgVMlUZ191QdyGn7itTKiyXQqLj8Kw2bNmAqX6MKvp/lfsoaLV/w+Sx+jb48J54Ir07aOhaCQli/3QgYc/hv
+TgedjT9Of5b+eULL9lhodO2uauC9Dxt615B59R3Tm+rcBVavjSdBP5u9jG3JTF8PADgosQxdSDqj7BTNOhDkfpUE8xgF0rThieFNnixttSdu053z6f8K1x7F
+D/wzRp87b1uUGsptllTxR7WXSXUnK4av4VuNz2VNca7NJBAZ0WYZ5KwHJW3Im9x4iAJ9JJ4x7fRJkInVo0MQOmCwFwqtBgBjbB0IPX2oLSLyAZpe7WbPvZxe
+4TischWbVsc/8dla3VpZDgIWGGVKq6PyvW9znYxgMY2ASMpKNw0egufG+jJ4iw0Sss8EM2gen84QYQ88AaRSXsSzkxYnxYyyz84aymabjTayx7YXxTqiPsQ5
+t9c40huPjaj6yKDPMS9E4+Xjx725bzfuFsxg3OYnRwUKuLN5YNMRT5BloeuEBEFzkZL17AVC+gHPef+aIjUsSj0sSMytd+YY2BO2x8Mic7BTGKPXTsWRVEDa
+5NzuN0E90EgNgYBgZXiY7UbPNBs+Q2wgaYW0bv74C5A3PxLRppb1MNy7byvKrOD5/1XBmE0LjNkhsgWd2tiAX5qW+ZNDHX0tiR7FD7pdNoctXpEpKTf9XHk1
+IXbyAmWSOZkTYHQUxUJU29n1SRh6CqW+6Z45Igb/uaJVjSdeU95VkyKcBdbqPFq0t3Gw2DMjlNkdVDeEZrTacxCWUxCJ9PuZ9oQ3/HK6H1RCTGrbTlDOQ7G+
+fXStvM8p7+ZWr9pJCux8sld20nFb3mF/DpXsR5bz2PrmJP8GXXQy8bTRmzCDXV95tpELFjb0DTwv6S3kqAjgvsuTF4RrYRn8103NoaqW7E4PDKbKvR3Wm3VE
+a99T5HzAayhYCSh1zBtyvAG1g1/tTir+us8SSb1SEAqbtDIv3AwYT3zMQIjahyvOoE3Smxqs1Mf5/PkCe7O/JipZk3aKP3i3t5yVON268blxOeVJJRn6O19m
+hr3QLuh0RoacFFxwnwTCC0DZVgesLgpfFgIhZ6an8n/YstCaZOzTFP/WUdBV/XC5G48BqTFOp/2jN8EU2eLj+EXQqxn4fZpxbU8kTXnoFCDCXPcwypYnur5T
+UjsNTU/Wk04p4RvpW+n30h9yfenfBuRmHHTsw7i6Ih4UDg2spN083fmZXbYVe2xzarOoxF8SQIalhKhwlWKoG7DtGwwPXqaLUI+0dhG11r38D2gLCaWLu9EX
+kNjW9AWEc/x3HmUr+7lybsXdYXymfIqn3WZU53fMx3F386AlbTeRT0k2RoIrxjVOAXF3MSAxa1Ri9ZOUwWai15z7GGOlv+hWxLCq/0z9/u0j5kP5Ne0EuSC+
+1++ou0VSYMnJ3nl5dZqYz4KqKJ8Z25VUAb99bda4exCc7z/I9GXbef2Sir7BD/mslTipogh4GWjIbfXkR29DncT+DLo7NGVN/hX8vsoAyxe7ttVqJkJLHmR4
+zZzmZC07mVY316Y7g7RFEvl3Ja8hIOdVPsYWqUSR31At1tc16MmELksOiZkGy/bpDYzmGWB1oIORngg/lBlg2MC10bnIhSCjDOMNIncY6yR3jsv9E8bJJ1dU
+qzHA1HRbMyrK14F0QKoH/EiP7LTbjgE91B9JpBhuZ9J0F5jNBqwdTuQyN2SSX9AnHfiB104oUiDRhbNa6mHC4P+b6juzjHpw366uCsVaiTttC0QSjFJh6P00
+VB+tWxcOrhsAZmYK04VBQeJrsTayoQSspGIEKSJ+bcY04tdLBPw5ZWMofdKl4/zPhD+ePTOzk1ok0/rJYilIStLJs0RVv4b9Uw1LDxIumw+059dLQYbTCkfm
+etzQLLJFQmdurhlAOHJd/cX5e+UExqz+DlmfUnnT0LlzCRQy8dzTUWRqnWj/RWrwn5/puEd+P/2ZqBVqdFVusOGMJTt0fbMYnOcY/Pi9tUv43+w5zbhqXLxY
+gQ6dk8/id2AUWRksGHANHVk+g4Np1rZLhGNDLX3IrrEd1NQif177RTD9x4CMXbIxqoqa0fxeU//W1x8XDE4ewi52mWYotct2USQngA7ZQGpo7DwlrbRLq27Z
+V1pxWXsMl8LJPjqKWHapd0lWo+CMpafpPzT9Xyc7rR+MJnejgT6ta1t4Q6eDmyZLlU+LBcZtJyvEoRsPIC9EleRtt5Climgmm1eOW/yq25dXA0yRsjZayJyy
+rUPE7FiVWqAAAOy4qQ9q00rV5De4J0Cw27Jjx+UcVguWg9TFaSrTddo1irRSEut3qsBaePFtDRWh+TTtfS/DbKQrMjv8OAtgUDesISFUyA1mki5OOeo3qTNi
+ShwGHObSTPpT8JziniXhI2+qkekmodfZD1yP+WJTWxdZkO0SULQ7CA6kkWnn50H9hOFF0CJSdG3o7VdfP2HbPqNzQ8/rT6uKbEp+zZg2rGTguKh6zeuX3Aiu
+itG2Olm46Gy4qMWFVyMqVOnlwsW3xB7sHPJjnPBCl8CNXC8Sx3w6fT7UMAoT9B+nv+gUoRudxKYvltdy+Tx1ilrTIjCJ0fCNjbh4gUemv3xq96zNSGu9fjbC
+45pREkhzOqOkV7horuPGhgpHb6TBmwJ+W1KiLabnQ6GG83d+Zw9rYpHuiMkkPIvEFaH0UwtAFS0xXUAdyr6tHYgCeJegCyAMSjclkQMSibFykQYVy1vOZd+R
+TIRiEfmTrG+n9ZNJAVqBGWriASX3TTxPb9CeWNF0+URKFrsdlDmgYA0ZorWNhYtGP542Cc4woLQvJTBbLftzlrOOsZSZ92oGIkMXlOuFwLdqeZ2vBLWNrR5N
+Hlvrlcl2jMBhNZ8y+Tno3G+n7AqwIg2LKK6PqnIIXx5aMJmT1CHK5QIZsayyTQvMpjzkCKH0tkbH/53pPapU/smuKv2nyvBw8gQBdwScGJDTDG43RjWrPE4t
+mTl/vETo8NlsHrK8N5NqcWc+NjJw+iCz34F8Hwq6u0npVmIHSzvcJ6i1NJ+SM6EKYWUuKvEkd6QOfI9TemeoSxfekB90hMVXG75Z6fq0ziibLyXI8cni7KbN
+lcC8bdNl0xM8NQ1U8m876rvWJu5odOY6edvjFL4zAMIMAsQqtz4nIFk2ib8oNnoVgjGHDU9CveJfUpjUskUgS7qcv5JpxaYcVvuyscnP6MspDb63P6DcjCEo
+3zX6VP4niG+bODNvnPKRBVzVDg9k4IMMAdeUA5UFuieVkxoj1PO22ubXOEfynZ5zW/9zo9SuzG0PI5M1jpZuixP/JvmoHNviT7pZ7DHKAWywqd+lCzTlYunk
+fZganO/L3I2brk1olk7bkJ2PYuU+o1UV4ZxEa9Vn874kN12TsrwT4jXccjYqKbZN4WBWm+uuS5M02Vs+uN9ObmdeuaIEpK4k5PKuhtyv6TcTZPCnTcU1r9/l
+0xhYE4S/poNjTtnriFI77O4mQgy5Bg1Nh+7SgIMl8hMVHfe18f3yVuZ4FvUkb68Yr91K7pRB0yeAnIZuytxqlm9vwoYgAP2/bU98Pn0r7IepEUrfPhagf+1i
+tXOqNvVkWkPrdyMw5k7EC6T9mZptngYf0yfSz+l9D8DE3WGtUh7P94uLlCtwcMZKH+SXniUJ19mkBjBpICJRtw39rYqPI70PH7FIgPtsQovJrtdBqGSSp9uH
+Fgmdi6dPMOPg/7NA9nFtycisLuca6lwjQMcf8T5nmKoMt1+P1bALe9+wilbtMhSOckRsj9uNv2Xi2peAE1pwawZgRkUa2CFQvS9+VW7UkGaAbI1sGRcb1B7O
+LU0I2NTG9xWHObGewuqd0cyp6Ltfvi+3EZFO8FxZi/yq+uUkd6SlNir3MzUn3TkC0Mdw7XJ3PpKODZ7UEPffkYrBtXVk90kSBtmky8bd4Um4g/vjJRYZ1nnH
+/o7JSNVqCix7kLB1hS9GeynrYMW8sa52lbPqH22PW0HWfpz9/OunpMehggKTOPtpiK3bkZwrf035BgIfkEN3sUjKosAX22g7/rhzJG1zS3S6Pa7arPYM1wzG
+2hbbLVSLhdze0gOtbdVZZABod7fsQc7o3zva7hOE5jb7NsoZfeO27ml6r/rg6aJtCNFgjQQXrkrSCPCs8V9z+oLFfhb5Sa6SfF98UGOlz4T+DL0PV9aHB0zF
+/W9oUm8ah8FUDYc1h4YjoVrq3CQrHWVsre3ZasvGABEmPp55m/QbYnFHP8W5ELYyvw6R9yTT5fR1m3TacEaUL6YxwUKsTY6sdRObq2E1gkj9Dl6R4om48Q+m
+z3HFolZ6uUsm9uHKStFc3t2lBTUe9cfkB0XwY9OecqhB5pqtcjEkACBXRCkrrF303K1r4RhiHMtHNSp33vrJ8TNRD6rb4IfrzlXZz+pEW82kSb2Ug3/hWelp
+4nI96E49NmcK2wKAENvdFg/fPNWD4NW0KenKRVpTNwH1e9gyN9IpuGljtx9o9Ea1z29aFnvbPvL9abp6OSPP13NPefJNlaw9nmHTTVfTGqM/QbA4e8Ne/KF0
+V/3PHiF1mkaRqF3bmsXBwXPZTOEuEnb+jgD1TfR8hkyx/xW9JgPOP1fyHp/1boU/LqpcN8veyHJ2G+neJHQTAstqLMRhe9Ra56bCpRc/akk6B67aD0mF0vR+
+uTIMKCOqAYFXq5q3zDW/01ZTRYUjvACuqm7frSdSu65K5PUzGi+Um+MJsbWwR4PZ/RWndmPM/eekficExkaYATap344W3HbZlgzhLPBQN8RuO45xU25CNpl8
+RUHtto8wIWMwHzRb0ZlPqX6vSnQgbDZMir40GQAQ/U+JxiXfVnwv63f4hubdvnvdG7sNDLM7o5/b6TvK8tP6gB3BUn6WRVm+fqKHsIG9l34NWhigCY1AKAez
+Cz8BLFw6KH6H6STyP675B0bVeD6b7Xr02awf+EY6pOvgTY6XTuHyrHyW4c1oa6w7ek2/BVhzvtErMd/ok4ETMDLhPhwv2IkcrnusKlSPaE5bhgs4JDvdZnnh
+gVa1ZcDARzWPeEa82PAY69kvN5FtnYNHxA8Lu7q7kfun769l7ckXtf7Ahi6ESmYwdbiai7C2m01aXaNgRxzXHRCFLdfEP15c5M0+xHfd8K2mP7eaQWvqMu6v
+V2HG0SUDtsd90Nsvshba0CEQJE0ChvSau1wN5NB6kOQz8R8AwFwHHqN6xjaLiTpTlGcAIJTfkhrs1MtqoX6WdW2Z5cGqrOppeMHu9gF7urcvpkPzvIReJGQN
+gwVO4V5LDZy9oQRG8DAr9gMHbO9u7ZpKzZlWzvyCJzW2jeVu3Va5zcbzbyiFaqKp6sqtZcA1jTsg102jQao2R6g9sYVe8Jcos7HIzaxUG7rvwDn0Xkg/8ker
+MtQqy9D+wqk91pa5aVvuXzbOdYB5JR7mdtnq5UMrnBka24Ze1//l0N/70MlXL+loAlArQbBGunXVdEZgYPdUKp/EUXCcszezhfuYXo3n6++uuDfPascx0ww8
+pwGXUZMlPYdksuLdFq39w/F6nrqO9sXcwG3T8dwCNlamplJadUZioLMynJe/IcaB9DNrVi/sFs8ZCwGBkBZM6vlqkjbrJVW7Jo+BCdtrEm8G4MJwk7wAfhnt
+LJsxk7yI2NqsWu/4icdXO8bp1MWdveLHg9UOyXXltGJ4h9p6guEeb4KOW7nDzpgoYdLFTNBBoyK5kSsBmgBwKCeEc6yiwZza//dH0k95ZWB3obpxCHR0V495
+YzXgm9MlEWyCwjVixi61PpvRa7xn6TTfhPt71GhSMwaC+VGdjuuXqAxcLedC711ApoxApCgExDb7JPbijZ1PJB5R8eWv+0JVyIorMcUhmJwu1Gig9+tFSADU
+smA2H120ZGZmaV94Ork7Mtr66C1fsV3tGCbxkXPI5n4ZEs1pEEkCnT5qbydRcy5/lvVJ0j6eyctVaJzjcrg+VGnzSqr8rCg1+qJE1OaDuj67Afjrzerkb4ef
+SnFbAQA1dfU74zq5MWYXu8hxtx5Kd+O6MiqlKJZ61X0i5SuNxcPlmEgSCNJUcr/m6g9xRJoeDuf0yL3mUjNJe2aOnDikmRTUfMlaceXNawhOaViQLtxkt0wG
+10IthNbTnKKE3aw8Frquh7AfP/ugH6HB2HHUbk42/+MknzbLuqsQ9vFsqy5tF0kBZeCP6T7RfZqJ7qP/+YX064wuTw1bQUffWvkUm8xzIATZHS4vDBFyPtYu
+9o0gTbGmPltPMq+XNLQUPasqUwRe5FReo7jygRn8tJ8t1jKKjRZuOMltXI6ZeHzbGi7VJM34LOix7YLkxV3UYo5BEgBxUzJH+QCm05yV+Il6OIIQVrdxEYmF
+cBq3tIKleJaA84bpwUpuk62JPaJy04+VmqLk49dLadkUO1B7ssWNpLWybQxrQT3dTDO08dCnFAsXcsfT1QTfVCX0j1eiBoOhb1IpsovoV7Pusp/zaVlrx2Fh
+ljSJAYBtSeGcZKWGe/PW9EHOXf54+kKtWzFkXjpe9g/cqsyuqgHHY1G3jF0JQJwgpT3x0Ewr2D0T6ashyXGSU3/xUGiPBxkfS8Al/l5Tv3qtRaFcTpEtsSmX
+OHx3JzkNpdmhS5aByDOvYbKyLED/VpeadfMR8Bslw/4sfb7ypxrbWW7wAhW/sb9gIfi0SHMiXtObUlzIhMR/OXkTvHLSIlRXpKAGY1J9oE+7bTo1VeZoh4Nf
+tKz/XSyuFS9JjK/Ol2hbS4FJTrdFVQ2Z96t53KcWhx5XERDFOLFgzSSXLLv6sI0E2BdnNyu42k+irfRuHbVRlstCQDAIQ3Jrbeu2nJGvHYUtCZvrBCDt70Jh
+pm5s0cuVr/KK1znpk50doJM5VMxdfdwex4sl8Vw+gV1/ufoZu/K4nuNqYLl/Q1mxiNLbw558GhSLfjJ9qUHM/gFrpHiOycpc2kxyegzqZ70J+bR7EjOLLtp9
+2m2nfTgLa4v7wkQRrnYlU/V1Fed4DnVdbajEvuRSH9rg7l+RHpnpDW4H5LyU7GmL6LMJ4ikScWtT67+4FnTs9j7WEdCxAiGACMItSkpVTciqXpTr+dSEnPIr
+jIN8E/zfj6WvuDWzs1w5oFfOz0u4p+3RpTfYtrHPCKxojWdSV1MfTZrNLBwiEDSGooE+yQJofeJNj2hszpLWJOy8Tsnp3UefKoZohGgltPYAC0fg0rmC2GY4
+49lS+zY/r17lN7le9kfcWXfJwzzwOjtUGPNkYinFvp3q56yvl/cIGOKpU84dTkIbJK/fhI9GprY8h3T4VElb0PjlEpC8hdxfCO4coimHqqzaOYEB4uST5FYB
+8XqesLf1t13jcpU/aK8fJUtYbuh9otUkIbOcorGctrn2opn+ZYvppfzNf7e7i8iaB674NBDv2HMvdpaX162xuTahxffichwNl8lLmhO5C1jA32bO1tJnuKp7
+fvvYXM+xpe7i4U3WiZmvlf48ITEsi4CaE0qAQlWsbZXzqq2PIud5PVnUlCOW9cDu3JZzUPnVJhN9m5QjQogor/hHqsCAz5m0VRO6K8m48k/TAKaw3KUC5OVm
+Db6Wvmn4r6UFVtOtnxZrsjYyVGomo9KLe6iECdQmNslU1hp2OlKAClmHehWsbOaXKuWSpulFSaSZd+PaWezyY3f6mNfag6esj2aUudH7oPuIaqDmoK0zganv
+ln9mD6gmbTW+Bu155egsNWcoefz8LfECtOofCWpfT2XuYuGNPYIkDJZS+t9crMGFkptLe6EADlfqbvGR3tDwjchO+Jp43ko93vNAmUdwUArCaB92uSgEH9eV
+twaYU2VoQ2BTKE/hTJKplg9DAssSQHHJM5YQE0nr4cTdUnVkbU5g1Coc81MLa3yf21vGDr56tNim+bwmTEDbwuofXNq+Idf44cAX/cg55UV+Mci/7eZ6bU6H
+tI6FIVyZVw4Neq94INZ6GgK+8eeDz9tQvE6npbdY3rddTrbjAnh536cs+xQpSINmuGfCa5t3lz2m0PbLwRDep2CCcGUdvJtPP14HhsmUQPjuJDN69zBgZ6W7
+hInwVKXClCqBMToJcAZq0xGtI+yyLhwQJ37aQXjcls44iM1KjVa89BR9Iv1YZ0EnlzxdddU7aIeafaXaGnejccVuSi1OakvURB/gJKEZ+dJdcMNETKm1NgLl
+T4IgBkLZNRTvUemk/teFXy03LOk6+cdAI9xvmdPxEMnDDgpomBY/0r3d33qwe298zJu+WCXE8HU88pk0lR8B286bxaYLg6ZNYDVosLj+XbSX+UV0Cn6FF3R5
+kVf5jotmmByIh1EX9uW6xSh22qevsmhuMKjR9GZj7vqhnNCl7jo+U++TKxxlfntTPyqZnG+HvkyOTBqhndPFnG13Lt5d6zhSBe37BOjZEVQape6UpEXSdq76
+6BQ0d7pHd5yWMUcOnp9Kv6bdxn/CCrOsLR4xzcd+hOt9a4/tAaklB/W4jU1wpbqQ5iqLsBiUC592OXvJzc+1lZUadpRHNYrAWeos+poGxl3v7se5b+lbbe/u
+4+L1B3JRNd1/aKqnD1QZ2cxtkhMMtTMXQvnYruo96iHXckpfKhn8yf3EGlacQh7opD0bPBCPvnqb6LXggzoqfIJKuOFESAjuvKyscfTDpqq54Cbc0jkBYEqp
+yRW+3PUBihX6imN9/6C7c4/IC67lB/e1tnqs2cHdYb92L/s71rSZtSHjXHGjCaC9u213dt826JlBUGlpXzRh3z8Neg5fZZ6y37icubgZVTPAArYqP10quivV
+tqmkXKeb067DQ0XX9ExLk2VALSZoIxAavhbVU5A3CDW7EzoNSwrPqAkRG66xN6db7jT8qvMr/IFqP6jq2p3Jg6zOQd3R0xi8Ro6hbp3jeTpWaRnNJHw4u40T
+lQcgAlzAmbw7Rr6adXgZgfrmAkU06XSQvR1gSNLZltv4Fxt8VsHXmZbHT65j7BZxhpPoKpfTlnkZmVlEsm9n2c64Apkd0PR8Zk1rL8B3M6vF2DiysrQTZcSl
+b1x2b/CNbXeZb3z7CN+42q9aVtWWJUTtBqfiqgPNtCjTaf1CtuIcfSLbsKt+Ma74xBtVdRdWsv+S/u8XeCAOK7CwxQvh/NV9eTRuxfi2TqxiN4pOkVs7t1+T
+8wwA9JDB5WOxkUHVmWxln7EhlcD+JPZu79pKZOcXZpJ1rW048s2G4+gltvOmk9+oWbkrEpIdFlHt/y/IeBh5I8+VBxclGJS/Wcb1iCTI7HUFG37ws9Mj0iL1
+HGj1NGOXdcXAPLRLjLg49nu4nuBRcw9S8iOg1XJ27N2hDkvuoi51q5xNphdgSCrQ6dPR3O+CNZDuscIryrnuO5FlXKTizP06GZHqxVR3gIo84XGXSyRGFDXL
+G4cFkYp1gDWSDXbv0wI7ATBIIqOtE5XOry5KkryKcJZNUXva+qkuHdk1SAK1Y2BQm/biNULKKQpFt2kKAGh4x0Xv8Ohah8IJWHidfkcY2fen5f7ZX6ottmHB
+pEH9UzdET461l3mqguJWHCqG27nQxKdYIkSABnYqHDs86GGDcBYYZ2q0SpuqZMUqZZrrzmxj7BYzPj0CM17R9F4Kug4Zn1Puq0EO64daC3pD6D+A9LPpF9I3
+So+QaXnIqec8qX5grpUkG+GE24NXjMShC19tEFUvFBDFI6NaiSSfVXbz0Y5JIhefQfda9am3k8qnLo/9ecF2ilZdZLUyVeeWjG79+cWeApVV4atjeWB6jk46
+7h8XAD6qy2e4cEjG/VTXS2GdE0aPtK85sZ0DrNWzEcnfHeusXUmVeV3e9jm3AQIBcYKhQas7RtFPHOVy6y53ya1U+p//EV9v9U9iNkAbEnaStryQ21t1xayQ
+UOg2Hb+TU7nS1KX2ZhIPU3qSAm1quTeEi0MToX5q6lUlHvgjx0Er256EuH3PRafVvlD9USZJG5gk7oLfIHWfBhtNRH6zRBmiRRPI0MC/5mFTKBF2XkGTp6Oa
+fLY8R9+b9VuFw9zYcWom43jo5MV3q/pGu4NRvbYS5ONxId50e52VSe7jHMZSB6YVeHu2rFVqKoxnbo3LIaCus9MMdNRsC6zpB13R9xFYU+3A4QKljzs3gver
+yEXKAPdJQ1fiDat11xWQKYuRz/JUi3zY51w3XsHx/KVXlK7o8+dHVIvL0jybcnLU6l9C3mvdKYErD8fHahWqXZvfjFnYXc3CPlT9OHUU/BczcXeWRieR1oL1
+iA+BVhJwlma07GF9R5eMDaETAgCkF/ws27t3/ovKVR3qs1M4DUy9vVZrr69HQTAwVfheIsZ9g9sWHXyu0RKU0ZenpYq1yKdaHhVzAkO0VyUt3m1Nbr3J5SiX
+fhf3FtTeH6V/l/5T+q/pv6f/VeNe6wa4Ft5OCinqa5HKZ2vsoKocvk6c+BCE2l1LYU3sOzYW4Axq9ZBWZhGl0Q6MBfY+9bGvx7tWG+wRCh1TmDsZ0ed7t2d3
+pOJS90wUxb1KF5H7rqjDBgkkEwdnyGCF/RXiFO+Lel6BygjUpisru3OLrSidqF9L/0Q6nevVLWsQk9GDm1Xocu8XrFuXl5b3lbHNoSNJenRAj2Nb1yRGrqdi
+gS4iL99Qi7csW7SLJtyzHVdG5aRJ+YoKs2neP+XsahnAaMa5NiZZjr1haUG9a0DSHZb0kEEEXGPaFv+bs/GVZiius6fOovGTl8/GUQjyT0EPuFzu0uXbTksu
+DL5GibmyEFtoyq2UmLswCIDO5Fz2NSh7sakHROTwV5gf/NfTH4ntyY2saRTBfZhr4OnJiAb2yhroXB/lF+6Pofmk/BSjlkzAEjueAbR6bZYhVcKP8hOA1inm
+ksBNlJp8qRk3gRzABjSIPsKX0muiiD5JZHGo7Rd9O65voiMXbhfAgoMmWO5OliO+kGSRtnwSPZVW1olmaop9KIx6Db6wfOS6AAyNj1fNc4DqrlDVpJsuh8S6
+iJqZOJwqgbaNm92mUx3cWnHLQ3mBSZKGrHCfjJoW81pQ3/ZxcEs4VS0Rezwn2KRzanhApEf+o2wxgPMvv8jM2o/K5qu+ozRCCrxzL8Q1xUU4XizGrib5Nbdi
+zXmQE5qkrJVd13vtKTqFhvwWT+HJosYqeNCHfVlbiY/yaNsMpuCnlfO3d/TyosE7JjJXPdwqc997ueYCNnyeprz6J5dqkrvgAAVUCJMqjkdR0wjtPLWl5OTY
+35NqXauYyzP+OFUEgp0UHm5hLCSZOn/irYoACmYLlUjZznxkDKR22cUmQBrigbxEZX7hky5vtmCjCKd7MVFt7myvTBQSYx1jiVGSUU36TLLSNa4qb6t5NMv6
+1aDqDU1M9bF0l34u/eOICllZhV2MUbU4a41xMnDh4aIjWgv/qVuNViZAuxGwNC+dr1HjD+w+AzZJqWgfC7PQP06/J+dDjimpbcOkNq4lBmoGSHtKlfBE2+yD
+KTKhnJJFTBA7yupCRIeQlxXIvnbm6SFtM80JKnwJ2yOBnzNPfyiunI3jtdVT/VXXdXMszBVm9q6J2EprLTOyJkhqOsglCSzlfecV4mfSre0a4MH8YusohOHF
+DAmqBjJR40UsGd21w5g6NhuShgeUfY5imWEN//Jm9qcKO1GKa2PvcD5ZxvtrnuGS3WNjEmx5iewRkpvcFxx7T9DoPcLevVNdm68KixJ71UHNetmIE1X+9evT
+/qJwvTrdiEQKD9X7dJ/KS9pmf58IaJY07BqAsnzshOdb+/PuNKVfZk3k4LHWXcifhJl2K9UWY/PU5q6jHhSmfyMtNVvtvyml4LurMDWYE3qIWsYwiK2HToKB
+1hIIGQCtFSdX6yS/MtNZaIqttSb3oW6vsfekwShbB+Af1izmnXe96v0L9sna9W9fLw+4va4xy247bYjYE6+tjQbnWOVt7VjBFeITkJR5tZQO+RrYZ8nBMJa+
+332bQLrAAd6y+0nM4xyprncFrQskLxr7fPbFfOYymxVxygcaiG1sXMfG2/d/C8x9D+Xz6jKXixayAs4DhAKQt9Q2giZ8kq7jm6Vnx84VTWoRnyiyVRV+1WxU
+fghkjSLVd3TuWo3uN6dj6GT6K+NF3plOt3gH4zKl5RKYgVlntb9uG6Adnv8yiHBBJtyZkB8oxSJWUQ89LwdayoNo5WdIiIQzBf+I5FsGyidZpMFZcRZKrEu2
+vAsBWpzeS+k9AYv2JUfoVYXV2tNbe79U83Adsu/cFrXroNvTT53R4mktKUd1a9OuHjdkGU1pOrhPC9aLisON6D0CuLfKBOeYAskjAcp3sd/YXaLcC6KI3L3d
+8Jq8mMb0E+nbTa9ARbXwK30NR6XmFpWZaxVF52ziwbBTSi1gz1MOsa6IfUIs6iAtnc+uv+0FVuL6Zc2HGbfUIvhmHjE5504V1zOd4l6yZxZJ8S8o6O8hlX8L
+31mTE3VzKI/qkDLfU+zic8gJJeppcE2keqdR24TZth2dtEAUHesZdZraPOCiKYUJdKSCX2wcGbpBs5XA8bpUYYbUcAfcJwJHUl0AjUt0xMFGGRYs+Bx9X94+
+yoY/XEtclGZWK4jZC45WL1ySzq6VD9vSoc1VXgHs9cnQiJtOobxcPjTnWuBj1b7Hk4mVXc/m1j/K0aHM1ScGNJ2e9RleKHczZSxmN7fiI5RRvBBGUUohuTyD
+8hkygJV8xLdXY34RUYYtGgYAGu38ysfzSdbPVzTW62HlmZSM55XAxCOJCYMN+/PNOqkCGmAYyqyUNxCrKMqnCzQ9OAMid1HLnddQMOTYxrTlc+nHPcP2ddau
+Y79KyLc4Fzoex2chwr+9jm5ahHqnXR/o8Y0zJJz2Q6741CHC08MGTGpGu0hWXEcKcZ0fL31etNzPL6ffcuUfjedsbR6dhaWiupypfDt1vby2oe8W618KABvg
+iClpeEQZiKyYRKgJkPIv1JpxERqu0if3xo1oAVRNb+0wbW3HwBdVmzqt9jSECoDk/E8fzquRgjbgb5kupAaO8nGxqkVFEBX4LX3Xzh6SmZFROIuAoGmCeE79
++HmaJM2BGOz3U8XsXUWn8WqclD7gQvNH+dZ22t5eGY+S7ZrItXamSAWRljxaCKAt2Pwaip/N1/+GUCf8iOq8rtaediugopajNQLucgfM8ELTfeqwdzPW7utG
+S8aSDkNSFF4GgIa3u1aehMtZItaU5XBojgKFdj4Yne7H43jTACWr3MszR2xgEDkrnyHDWtdQGdc51E1cnzxjaOxc46LRPYtmVWgFj/JOVk0xrnYL12JJ34Mg
+dTnvvqdGFAHxkx9cGUl2EV5pO8EqTMI/s5pZWvSczAkgtTq7T9Izxb/+afpnNWaRnK/nk2JCZjyucm/stq8DvL9ZAPc5UwOAFLJ7MbZDWj/nM4QMOKmGLoBC
+2GuksorPnw2bH32iz7nCxy9dyOabPERVTtH4NSp3nKZxt4jq+1w/J45VQcDL7eUoxRrNt5n/sqAjVO4+ZRddqC2wrY/3xONUOx2/B2ijdM/YDn0Om3Tue5Zj
+h0kZYarlC63Gx42I4S7OKJlH/jLUVp4xHvlOVVfW7gZjb46VALK8wCn6E1vLO7eWcqhtc3cHEAalEoIZgHv8ksBPw7TfJ7GkICIx2mx9n7x0Tws9ss8a2ldz
+nizRfQXoMY01fVvQ5NOhAf4vCy00l72SU4tnS/eJ9afhPtECz2MCUnOfqCsvCr2futa1tlJtRdGZ/ojjdH7CUbRTzP9MJhu08DwYo7OdTjdFpVc/l3WUU7bu
+sSEtaanZlyqjkY8OlkXo1PyKIzGQ2HT+mNJtU3v+EKuU/Zij/38x5PT+MP1ZsX6cf1bq6turPd5blU5vWyv1K0nRlGPOpoHlK9pE7KGnYUPICXClLzgJeEAc
+jHJOs1RSJsQ5wQCasoEqfl3eDWREmEPXQS57MIra9zhcMaFt7PwT6TfSb9caooY9tW3lMPbUFMv69gXWpoaqwvQdcCZhYyFYtl1BV3EjqE0rBiLx7uk5NaXt
+oaGxKOFYM84vSLx0E7LxR5VbbjH7CiqZ1kAl2+MVeqqNZD5ErpcwA9mxxd3EcT+33IkGtHCJ5jUMSVO5v+/xI59PX1WN7jT56E718NIWi+ZW8ok/Bcl865hu
+3nW5JHwSZkjLzBNiGUq9cNPK5gE4HnFBxqdj6uL+mXLVwRjSihM0r2GND+nH1AJYHfBQC589M6oms/mOn446cPb2VlrGAzUSEqjaPDuhyDEgI8UzrDJ5cnNV
+xu5s1H28zEeKL4u9/sob0puCMin3m+wOprYVrni3KIFPXRG4k8gsDk3kfyr/mI02UwukXkAHTQmbb5Zx+8w8DXSfEOQMhkYfHXqYaVYbX5EOb1rwW8W6Gnvt
+UmIUKp1ll5A4O+LP1WBQ7vik52/rwAkGp369SSaNpjvVIkUgJfET4R115RJntgCBlMVvk1wnAADmRB41pygZEe58UyOOEZpz5k7jg1hMNV01PRfHZzqDWQWy
+jI3EF2tyiSyJ0WqN2HzTZc/tHII0eUuPVXln2jd+qv2xM0JrugfP7az7GTwC9XI3NgpNTfEy4q6V+0QzrbC+JtKI03IvGBq9MrRbL+u/tgejcGIddCQlbjYV
+ncOoogS7y6zFEXzCcl/H7aYqGfDfbLJNm6YlUsKZjHNZbuwG4QCKVhywq0wzrgTPCKJmYwXTts+75BFlbQnq9Nsa+TveVAdktYzLB57E+TF/yj9aPMB9QHUN
+yeCli25VRyfzNmOkBxG5jp6spw5UKk8G5J1ERq6wCZqovyuobc13i01oCVG89M7GYHfwVVlXnVfpm8jew6fi2fMRa600BJ2lFH9MNlT9VnkjyIXL0Em16bS9
+0MZZ3pIJLmbDkW0SAaQU8oab9E5ltvqU98P+HNcvf9sqPV6Hl9u1XsjarBQonx1javFoIuSSIH8lawb6UFzeUJNfOP6SJw9MlFqQ5EJeJjG5OaE6rDmd09XE
+oqcJZEJTUxMwra8/TX+V/lX6e+M1mLru3906W84KQ2n9qvbcB77sWvKLwGzZJtNBUpi72PtieLwBOgLTiw9zI+Yk2SMACbVm1N5D0nXVinhc/m1oboj6HRiQ
+VvyGplem4CFfTd9Iv53+Kv2L9G8CIq0yGlzgddUOiUY+bX9a563aL9+3ciJYlXxTRoE0S21UPe0y9FyTTrOceBYbZVyrFGdjikArxeFyoQE8MKPxbETE9GT1
+XP9ySs2pLkuiO9gVFKP8iwLRXJ7sz+x0T7p9tLRWs8jWcNFAv86cqmqPb30il946Kl3+KVoaUQOUAHu31eFIAap1UyR+anCyDe0rtv7zUFv3qmtZwUqcyvEz
+/UwRMtvUun9CfOZaP4oZMRWN4oCovth2hFj5s1+Hjkoowb7BBe9T7ywapTotoIQKTY+bm32YbDK/gB3E0ACj8V68l/fkd5lxxPuVPImt1kZ85vH2FAEJvjvt
+lLsqEd4mAyILHpmCOZBz9Pl107qoUgjqQDYmu3IZum0Xo74001CpLtnqdzFgEyksxrLdjdM6Z5xDhEfpbTRmcI4ZdqGjvUfrh8Fp0whiRQhXCgGQ5OmaGKGD
+l8B6GtDZ0PMKoqvN9X8kIJW+6dzULZq0rHhBqBmUSI+q2yu9d2JjrIJz3A4JhkSRpv7+UlEYYAbkg+VMXvvE8uxsii9l/F27EJL1a1CHdz9yDNjg1Sxjs1uu
+5dOx+/ruZIiGeILL95eZzbWa3Q3fazQiCIq4DkDmBxC8rBY754b4I9TxxIithFJEixOkP3MayoCgSfyiVovr2SKevXApCmtuwCPFr/gEPk3baz2oU6Q4CdW9
+p+G5zV/ms4WLPEBaAuB4bZkCLdZdbTzUpnn5Rq500vqZlvhqf6C7LQCQXurwjkXBsyo1l96k3+TamFdN7i5m5GUZZD7Wdts8qv4WG4jT8VSCHyl5nnZPVmSp
+KpyPSTC4+rNs2+SDy/JDprBNZjGopaOKUyDUkJI2hcGUAsixVyjuNkKPWZ44iv2iKj9Jrvz3BM1da6LOu3RUEipdPZdWip2De0OXiGyCuiuKMNGvOpSJJdTi
+kbmYJkeaEAGJZDV/xpAUBlEFEOeXeiZdCk19IdlKiB2nkKybW95Vx477/68Sig2aws7YVd9gG/3cKBS8PFnLu3e1YVFLWXXPTVq1fRbQHQWwM22nLGxWWjFn
+9tj7tIml0Ga/2fFJ2SKEzPuqkvNq0NX6sdjaKYL4bn1TFZT2n2zO4/fWnKWPK1LplvUgjvDt2nEdZB877hI7jYMjqY1//GCoRIrHksUFIIPmhKXWMVVAFt0S
+Z2hbnYJVbhjtq2//JL2cfiT9qFaj3NdcjVxqafMw3mzlcMlbO2RYNXVF/m0gYL2rcoLMoBRMvXM4JymsaQsI8Ikt6zviwN+cTuoVf4e9xn8eeeo0GX6qjLum
+4lDTH+obCWeFs1Y+hH1miLx0ihxvj76aN1KzB2OYlPqnDQ2R3ailBjoCoh2bkSI8160Nvk59lpYoZ/7PysdYo/7QAjHgYYzNySTMotZOdtTtu7+Qvoxu46jS
+lzBDTkCIA53LDRaAFQ9yGZlG17FhdYoYO6A+WeKp28an+jGtlP669j5I+1HfqCP9OZZJ134ddY5OK9mjKCix8USgorGEpV53GWHMpjeDRUAA0qYH1HSHgbGY
+Aq88AerZAnhnGv1a36NTGFd/o7Hj/yL9rdSGS09CZGj8Hgiitkfr/phiH5RjvLpk2hT4e6UlhB/RZVwCL6zhUipODT9vto5n2zJLvGX0Hfn0jJUYxGVeo+mF
+LRjEkvX5+/Q/IqNem+GZLryeA/fJpDnV8p3jqGwE5XuvDovC45IiI7j0WXRS7Vc1/nTDsM2om/JZD36ZMZmYhXY9OBwH4BIevTUxtgFbtSrsf6Ao3PITOTNI
+z4yii/hZqyjcaIY5h4W0X/SOHddZrVfAnHgG3ADADADMX14175YoEgVxgmNe2/G3RRJFcbZxaEHWfSH9nHIv1vMkbIjtMrV35z0g2jHkt/Rg+aPt/mpNlo8H
+2fTZWviZAFDDNYXubi6TQoEX5Rq8HQRmrv6UXZz/b04vpafpZ9NXJQoP2MLt/ipbXte/fBgdNXFc6PlnzbUQ0CAuXkeS1B4Cs7bw2DewI9NDGcuwbFnNi/rz
+W3ml/iRXY5VnXtOVIm3HYzhw879Wu05K6bi4dTciryw1sJYogJ9nQjkQeJCoCBhcEpaz30so4yUj5RDCbs+kIDRnpRyu0NCoUnqj78kX0ovpfQEfqxyoQm/K
+97HcubEW1FdOi1HYEGr7X/knzNW6KxN4FqvLB9ateDZCelUmNNI490xlJHAWlLbybrbcHFPTy+ZYX6m/Hrd+h5o1OF2pZfbqOeXVWx7ZTchMl0vhc8UBIZmw
+JXs1ml2pNZQho5NzKhhA4kBY09aR3wnSCyW/KYy75EIs0y7+2z8SdIzVn8X0VA9XKUj5Dl/D1z8tRkeSJ0octAuR7eEUDn9l7JJkGSIRkFBbwNBm5wOxWU68
+nrORMObkzfX3aXmmDJ0i7PMg4eltj+HHdG2YFqdVI8zHlUxAnaNxF04e3tc6Rw/NDwc2Nz1GtusqudcKNRjd7ypRWZwVWSIIwr/UnEqylzadL9i8BWOo32jZ
+77ki/hNaISjrISimrY52PDaZ8BxUBfLx0JNJOXiunMGIYs0WFIX1eqWEM6uLP6sfKGebaOo2p/Fc1dlgJq6Ha9MC/43cXkWX7FuazbATSivc0+Eqml1ZM0Lc
+fSnSs9NKWSPKjmIEALA1b3t5y1cErV2417YpAe7WJrkW8n5fsw5rjAuu/tP2Pn8j/UH6c+XSKGx/TYf+MWDlQuIhlkoWZBqnGBG753Kl72qsiqLPVomNSOyg
+YOnC3uZg3tsp18oqnEakqqa02sF/Sb2mbc1CJZiEKDfT+Ho/nL4S2EkaPczO/z9sLQW/O5muWZSt8MmcvFK1jjNug0n5mJHvc3RnEOHsFDrVAvT7DdEd3vpO
+r7iZffb5QWyCiln/T3aCY338fV3vuFYrpoaLZeIRW66vrTxw/bR8anyHfUPcSnxoEqk6dZ8rwA0gnMVLPFMtna5Ur4fK+kiUk5fmXJGyx1P8cPpgqPtWte7m
+VKh7hgVKykFiIh1N6doyX/x16YI+B0Jo6wRCkpYnB6PpaKTJckgQvVuAM8AG4EzWeKAvZ94/elo2ufD3ps+EftFf5ejl9xg5fjUrsp1OD2vEiTBPs2JDqWRy
+xqErWZIMi9SI3CSy55IOmi1fAk2+hFYE5VCXrme/V7iH3sz395e0WlKqA01+cK8EnqPL4kvE2lAQSc7wGs3+yMXFaADLUhrASujaLKy14dnry9ST6oOxL9UF
+jkqDmhGozA2CZPmwlZxyJmN00F/PBzGFjoKCJvpj7ir4m4Tp79L/tqrvsVT1CDUVLaZYB+vyKDE0sqemLjR3lq+fqqjPjctSB9kProao4EdHlEe5NudL1lzo
+8TDSdfMHIIBsmbr6XU83DX3QCf6e57T6Hf7VDT7jvekn0l9878gFeRZb9ZoSwkFa3joefs49ObvZOnhBHq1YSqQZyo9AL9T4hVBRkEAzbRIAtGfHM9W+KtXJ
+ssr4VBWOQc8ljMdu/BciUF5N3HzECKNHwtEG0dsQC2E1+45ysIwzS+wFaGqWEYGmRVvqs0bRw1CJsRXMWZNzKD7bS+lTloO/Wam4SSZhN9azMqyMQxn+KV/J
+HmUEa0/YwJlJOHztA/AAhws61PEGGyWYP7CVXfBuhRe6sVnk5d0pU1OFWTBvdKjvBre7DdI4OZTslTKvGWvi5ImY2AVrAwGRFCPOMFg6Rfrn4v1MCwQD30Ox
+FwHxP1DvR7wrHRXX/8vK6/dPHbmkTGg7bvkbLSG/88Z//lwrUPsr0eiNSgFzM6EAF+44zHlyPO2eHaWQ6jIfpQYj8DiUzrllBYY0k6ERJxm9jjFXzJBxbhPQ
+uFqSAZ7nmataoh+MMU6z2vNvKMIyZBKdUU2/dnadJlHeo2KOoWLXsbycbgJn7i7Y1d2hHkkbQ5SeSbISNKSl5ogXH2IvC66AVyE2QdAim77ywLOh5VxWPcQj
+t+mLejb/afqL9DfskZpQlI5yZ3O25KKbvKYlMyPflZ7mS2kw4/YqLVCTSpTZs8E3CkrzkkmiN0aj6XUjfQ+vJI17c+3oJJ01Y6gztFhOCJh7SIi7740t/cHg
+tf901x91jMDI9QRP8d/LP23xlCYcQb8hScmEgPmolknaMw4w8ylSvkm5zUplbHMZ6t4LoEq+jU0e+u16TrI+wd6694V/aL+awJs8seFN3dMhUhyNgosaFZ6q
+KT3BcmSNC/MCuxPkWp31pFw7Nw6dEbJ8gngvB2zwwZFStJhiMzPuiHXnYcnclOzFf07/LbKBChguNjIqw+LJmo8l0K7JdivorpTrOPo8ev/m/opQmSoUHGRF
+bDcEvft0cWeLv75M/kjaXpL2CvoiwfUoIWjkKYR6FpF4LJ1rnxNIMqPTfCjdzoZL+U6JbmqNZneyjTCFsozkA49W6ndqCNV6W7HByx4s3TIisTyjEpJpZxjg
+XGk4u5MHA18nAgVSa/cucqOy2VTFsQEBRLv5sVVEuiycCw6HN8gqGr2SHR/DePeOSIeyj1v/IhE4Fl2zE5KKmZG9T1d1t4cQ/2tuKwYosVfQlN9inJb2jQcV
+Ld4aP1hTBAknxOmBGD4mZ7ASVUrz4MKR8IfyOyao+A79wYTd/nEnBWbEGsFjOHPBe+Mlv/+29A7lr/5S+qqv9N9mlHg5I4tm/H80+7kVfNV2V0e2eDGvBfJB
+MymH59H15Cl6TCOMHvBYpgP96byklD5LK6XRZeZUXpEwBCyUBSmYeGSnT3LIGZcPZ6rTqDPZNsB0vYyfcn9d+v94wLuI5zICquOy2+wq+ZSdrNJHjiSktpKn
+nNlNTAr8ULK0dk4EMUkGcXQQGK3ZXX/MesYmgNhH9kPpR5hp7Oc6hOg/TX8h9VD10k2TUzxsA449RLWwcY6FksEwWMzkFPDrrAriqEtekDZpnVhBakVSwC6P
+GTCvMCmAtJYxGDtZJmOFVsF6YKCRgmi52TbKyvaV0HnAqGvdM2u9uuMUbnzNcngSbboUqo+LM0U+D0kaJZcaK5jD2SDmZbbNIm2XfrAgYOOf1LZt+ZZuPdsh
+OZwrSbdK04vYZsKrIuefes5s1FT3tEyCS86n0pTbPD1tFKVCQuyg4f5WabCf8b+YNkcmj+24d0nsKaH8W2lKJMImh6584AE+5a0ZbbMdole5/W+vKfbR9Jfp
+PzmfTERdqgJIw+h+0nrKvk20Hmr24yB2u2fvDVRGoWR1WCU72Jjcez1tERCG5Ok8Wma4gOtVHSuNRcEkE8HLhhu1yhfyTTRNTTRvD6PJCuf7J9IvhGz0H3Kk
+l/ZRXXNdWXMlG7RilSYGPdQC3Wn3rAkCFM+vX23oHGLZeFjP0HG4FhcHa2kuQ4azMpusSc7X7FGKYGuhSzTwKnhmDmzfvS1gH+4cUWYWyep03LG5b/jmAzZL
+icHyVZWh2vFxvaRxvMBQX4scpYwlgyXarKi1S7Y1+EeNTfKDXVdnRwW3qHE4sSZi2w//ebbdrwUPSfvhL5EsOPbnYGwapkqtAyyFroNtR7HVU1PDcyrw3mKr
+TrVRT+VEnIEUBl3SyLJypg4dJmROsnbMsHGzPZJ3LdnfRovqhTSmDyTQ7hirdGh/jCGfXs1il7KFmgrVO12CAog4Sj6yETveMdQ1QKFOk57/vJzYOsOcLBWk
+ylJxTWBGkjcoVlXgT8nZsJ0jaEFI0W8kkyvhR3br0/eNFzxfyz/i/QvPam/G2iIJYjdBr7yYHuVai0BwScwKET8DgxZrQk9evtKZ5cbOGJHgs2sY4CIti5VB
+CMsPt0VR5VJu+QM+mb7o2ZXfVRX7gpW2MzsoavCSv20ahow9IlBiLHNJake21k32dAERmhavDAkyKbVukuOCR0YSRvXtQsKVlRMAA4Ysb4tBxUweOMQo2loQ
+7HEG6xlpLC6VJw1P7bc5L/Nf25xMLWYdOhVJUb+YlMM2yNb0TfMruZhQZ1y30/JJs1yrKZncRaL1FNf9tGkYqqQmIr43Z2W4tcR5mDIB9P+JJmSqha7GmQgA
+U0ppbOrNLVLxG90K7LpTvmfEoj+XJ4XabX8ZxYi6+/AhFOOcQsvUTCuQxkeAGs9d1N/BG0PM8UK6DczAIX/TYFXrs4WVul2xQv3XilSVj12NqDFAZ+rNETps
+1T5D3VGwwn5UnoYzuY7qy+rDRDz1KWKGjyujs830dJG0buvGGz+JIx9JN1K2ETGFPcdqV0a43CiwmsvmB1nEdBNycYZV1f6Ko1lB93GFalJZ2WXk41JNVI9e
+8VCcMimBgE9Roz/21wfB/XQgZNqADDLXih8BEEFg9IJz/Oo5bYBLW5iTQe9C3rqghn5J2Rj/pWoK6807mGq+7t2tZPKfaWL1eKq204ATXXBzjZWPv6cScyUe
+dFZoPvsTVQ/e2C4o1i3jTgf3KHXPG7koAJDBAKhagY5+RXMJiNho7b+ifdppH6ai/Ns6D+1tR0e8osfnIqGTIjVD77IMWT4ij5mXHxGQERK3+AZ+gtaaTJWb
+s3yT6l6ujtW5fAWOvTlr+qXF2b6cPpS+wGP97spoNfwYmZFgd7IO6+1aA0Ftypbh9rHwq6tjRpJmQexGbOWbe+ErCmBLfQ5KPQ38SntsnpMMfFbSXss8BZ/q
+yeJMqz1oaWoq/atJ9143a63Qv3Kq5S4jHz9ncf7mlKnPztt+EJIxylaSsaNNpu8sEt1YjXf0mXiP5DUkQKj2ITT64zJXH0+f5ljMcmh/xCvlNHlPiVoND/oP
+scarz6eqfrAkn+n79w53HcpySGQJVQCU7gRvqe+ZLmgjfBawSUInL+m0lMUNAIoJpTXYJVGFXNaJafqnC9/Sa+nP07+5YDe1/hnzRBbHL2jMOxTa42r/t1pF
+lKAvGk+/7UiZLrCb1weqobRsovmPFpYOa2ixlbr/LIXA2J94y1WOsruYIeTutOQE2deOj1PsaD6MyhQncX20I+OSCj50clVv41SZMiisc141ampLLOt4bqWO
+BJW/7rt/Wke6ERbOCR1MILSyRJdS+2dY4o3e3OY5IouBseJy9e8yyaTT407bYwDDR0hEz07lVAVo/JM0w1IRfObkPZUn6MWxMy04KNfC3EX40WcAIHX978XD
+/Mn0NY0u/rLqB0nfy077XR6vp1oVSNrmxvX26Kz9QZN+Fs1fJJ2kXvI3OaSTVjGJvKK4IGKdUUlmINreRncVu9XVVoXqG5Z4vG+kf5/+c/p/pf9PXT85enC1
+pyp0T3RK1sfOxOzUkTNc/MGomF9HWLxfM2/l4kgxbNHKkhX5ALCiMIZ0nx6HU5iTZlC60tAC6yQvIV0Mj1sYYajNx7iOtUJN2fRqcb5dsyWtbZCYRrbp6DX6
++yTiyd12TGU9KbuxKIk4k7EV64N05orlViD6DE0lANdsUqybrduk04PMt9vHWKVjZ5XEh52t4YryBcMk3LHRNpFCX1HG2Jqm6yXXCIrNHafexwLXceDKbWs2
+UxXu4Ncr5++0ymNa/tz2sl3OBQyWXDbeKh4lMzZRT11a5oGyEKfUCjm02mZdCVGNUOy9iPWvr8pdz42GdYfULs/HppA+VhROi//eVRz4OoMw21nBaXZ0W5lA
+by8YAQWsdIznlbNHfxWVX0GGzunv/Hmh3/HJ9IWI/L1T1nYlr7a63WUZq1xlFafDRdaRrByLMxICPidQLEGgo0/GvroAvEryAlxKhyJtElWka1O0mwN/ch3v
+l9J3PJtsnTVd/Ldb6cvdtSysB5cMNhqIK6pRjS77xkgRzDaTg0BaJ6or4AEK0kTzXsujs3PQsNGnWQq6S1z85hAffiD9mHYg/YLXXwIXhOaSw/woov/wSCKI
+3CTSqza9pPfurX+u2HZY0JNZLzpZ/6m0M4OiU/QImwGgU6KUsyOHAxKb822bvuOsvP81/Xdha4yg3UOP2vWa+PYYm4xi7D8dLmN52dNfuAhHnzJltjsdw7Mh
+JsozAaAMlWAVvrrE9BqTHU/E7BTw8jvQq+Ouf84dCBHci51Tjy6Y0MWJP5l+L/3b9J/TPygySZ2xQ6iNV0GLy7SJ3OdbPdlG9fIQezqPfXWneWPA+fDHXLtJ
+aqQbZo7Xnr0OGjiFY9iQT7IPh+RvW27Gii9Wc44EVKwbdKmHs7S+Bf/259NvKkLlLwojVm4SkFfsUZmVUQXajyooMXLWwrpf15talX1cSsZt4LRqnAgF8iQS
+KtKy0BsrRfJwESJjN83yPrHokJVFCPtl/FyOvlwx+o3W/q32v7GNsmRM5TvbnZpTWf9Nji9oQciHMezT0eRJu2T+MXRg35raEwA9B1LOpBodAmH5ojXPrU/n
+rU2ZZi+hL1Q4wgozkFj4X5rDf0hLv/afp3+Z/iH9z/R/pv93+v/2WvCd5gwr6DZZTBfR33q+5+id+SG2aoOi2m0dW/hvA7R514G3x0DUf6cI7oZMzRk8lkEO
+Lj1c7MuGioGqKUJZzqVahKAliQZQhEC9cejyiyxyDLx1cYHr/kj6GY0k/lX6N+nfBc9qEdWvi/s4ukF9Cm2amS59Y19BwBdlgZ65NJCVhaIakO7AnABdtVm+
+OGP5uj1TyCe8je/tnFLjF+GK8Y6t6ee81OHif1G7oAsG5I/ZEv5t+ncFpaYNqQEjH8L6QxTTCW7qq4NUchaY14My4pSfX5CFdAwJk+QgI4eI05R7UDWHgLvz
+ZOdUXqNIIGIlmhkrrcSjEPU4q34o1sPEZhmNLKHFkvxa+oP0t64/8L+n/yP2+plWk5y+VzMeuypHaIDI7bEj7KgNoduaILld3f4XqgOaMLlPtOLj2hIkSYyT
+4N3UanRC9PfO2GJxMa4BBQC7ZQpK4o9Nv+GcurpBnONPcF/TL4eV+4fCSzB1Z7g3EToXdqMDFRvrLtJZCmNpA+gq789wnzaIINwd2uah1u8+lcmgDXXTlDBr
+bFFW1YBwdvY1mxA5ekwtCv021AgjWGNZyP36eyk9S6f0xYDjTvXiDdl1kdHlcDKcG8feDFAevQDTHGZhKVU5KZkZHiXwHHSHhGD2CTnabHxqAjonzCpqcEYI
+LSAd84u1pIP4NHHHknW+LPJPEZ/sulrVZ9FIVPrEuay+PS7RyoE3Z7xz4PFRWaUN+yefB+EIl6YQ606KpXcgS6eweaMMdIbyse2Xm2mOdEfPaUh2GrRK+pZz
+iRivfXo1/WwYczkttR55E5jqjhdPyWVJVvZZted3RwP2vZJP080CzdXDaVGkgSlYAboMo2VRHd429wkJzwkqorZNurh6XlgNBGdUA47SeRvJsvkR10irPsbI
+/hsxw8eDdoPtXId05Zw7LtpNzePjF++u1Gm5FUQhQ0Saj2mPNktjKcAEpULmAUAZkaGwmhjJOknVSkNflFUIR1PHfjN3hIh1lQjpX1oX0VFrrsJYYBrDKkJg
+eA12Dba7QxuyH0M65/aa1b0C3YqfEQA2kqoyLY6uTIa4tEANdks/kHsW5N+/T2Ts2hJkdMqq565yZx8Mo20cg4VV5Jnrrn6ecz/fTt9RnHaD41o5uQMS++RA
+ibjHnu3MR1XS8NNRvywzvx+ncSMNPNvj9hSyHxuCGWCAZRE32746q+2Ki4ZyyvJtfXO6Tws2Nglmy6N2syGYave9gjqxz48VbOD70mkND/hMV92znnnK6KY0
+72MVX2dUWFlgu62Blm/bfrWmuc9JUPSG68ecuMLb6HoiY5adIYOWzX1t6YfjelKO+rqgYIZM/EsIAC/0kRgfDauejY1bvg2VNY3EPahefOu4KB+00IKW3ct8
+pm0sLOAZqNWdbQHJXVGhKZhkY1CQ2vjFslrzEEtWo6IEZHXSrna5S7/QdiDdBU7vtQT7XittMSM9CR8LT0j5gl9eo+Q15gpriD1qFh4W2XfKiSRH5RiknHIi
+WjT5gTiMcKa+dNgffEAzWZ6yYUOuCpSdfS/VRusVKTvsX4SIpWXmcEOyMO+qjGG5550CuKpRX1XX6KiydtoCeDdaT8p+VW7d8MVYrby7xkuE19lXmpYxzq2l
+77KJ92qwgGhoLXztL+H/03+wWvo3N77WFztN6t+rSINdX7O0Nv3TkraG3cyp80SXhc3e3VSOYEnao0EHciNNI1PEvqhLM51b1zPCXBHLkyEF3zNDdUnPXWac
+PwTs8k8rBlA8zpKjkZg47Vc877C4dgcxRiY0t7NGA54xdyGWG1F91qZb6bidju5C+EmoW5W/2te8jXvs/CiTRAi6fxe8gjYppBmCcyUh54/YVE0BZsQhlbdq
+ZwL2PZjKtg09TAzdsXhL46O95Dauemjp6cksXPm420YOXMffGlHMKpz64HGzp77k4xRQuxte2DUE6uEGBgEbsEHg0gJyjSYIRDUxS9LRmxxjt3xgW3qNPCNC
+nZ9r0ggbatiqIYYbZAWPH08/mV6rXd7m4E8r/DEH43MzjGIgICov7WoT0PEwDoS1tgrBf1fw10zmsopzbwaoPBVs5SBfoLlbQhnMO7s8Bh+Hq648dgS1FBtC
+vyggLZLLOsxi2S+PBkg9Fr2D4hfdJ2+FWIwI6h5zkIyMMQ2+xnsdYTYLy1twfcTKpA6zkOTRLJAXvQOLAVH2bTckm/tmADj0HCOfVPxOZV/43taSiQe7DhCT
+DQnLgraoqoboA+sK9ZPGw9I/r2wJl5cXIZmYVOw33YQz7sPp2+2ZdtxeJcvl/MGDkyBbRnrfTxskOcgw6Gpr25F4je2Yc3L5PVgzFPrqYtAdj/hGLUFVDyu4
+SeER3z4wipMty2lrg+hVdY8Nj2OtrZbfc2OkjuVnjxsSbpRuqChj5ZEqg6Vns7F6O9o0R0B2uISBw6AO9LmDKVF5f068ianGG8WuvF2V1Mo8jCayPO7Kn+3D
+i7y6h2HnlmXhy3uDKqiMQENaWdbByOgXQKgoLhig35yIpv4xl6877smfls6pydvrJbGjegZCHZkftWRPo3L8l8tHkuKq9tmsL1Op+5OxQw5rq3JmufZ4PtWc
+Jdeo2wtruyH0AB+Pwc/UrSUNqrI0Ow978HRTa0oQeQtCZrc5J9FlUD96SH7xtcN/DgcyD3emmJesa2qTXq6MyXdBIz4i+sbT7vptsCjtoDfx9OQ0KaWudKix
+jLpkC9fGl4mHlBcsSkPq74z1gRJA5MZ+t/KmmNaQc8o3pdtRMRanYxzNbsEyfNxaKyk3HweREHctBNiUOadz3GbO/pHO+IAYlPXMZJQbBBVDcZ8AzxyESvGQ
+Ql0mDrl819n35Vdy9QIVJXafiFrNkFiN+bNlbl2bgI8GVjlaLtX4LA8V9jdqmWxqZ0vnY8yag5g06c7ep8jPG1eSy9soKa7rKtDskqagIkwzIu9X1hzpROAc
+PeCcMmZskgCANhiUt1CK1WI/4Z54rVv2LzmVJUOfjuNk9DkFZS/IXePYmQ7jxlPasmqZ3gWrNBiInc9SajbYpdNKJbZ7wPcGtPYhGBdV+ToK/cVBW5Oj8IM3
+Ih48WiixmNmNoYbPZ8iE0vF4nyz9I3l2HFTwg831YMJtlWwkAzLhCqWN50o/nD6e7uyk4WPGyT8PVtM/BbaYo9WVj9UWz6BOjJ2KJPcOyjWc+cWMPGnyOtZ0
+XadD+0J6G2dsX03p6YKih5sDWn7S0avlckHjrc6YnlqigWptddLtICl7Z44pbYhyKymXd8AZBzzzXa77TebqTentOl/l7vKcqdrrXpkY9tvj+vRtwpzlWmYv
+dxLnBDOGUMxvtlw1DIFlrplLq21y9QYBZA8Uew/po+mT6TMJUno6KWnYrglED9bdV/7K/uBlOBrHGAMIykfKkB3VX+18NrKAnOaUqYbMyrHAPgYIxr18TG9s
+ODE+lA7pU+r7mYJumZuHJtJo0Op0TsrjvaEzOc1FmMlS9bWoaJMAnuNGalqL2US0+QSo8QYpLutH/YrLCfR17SMed6NK1drWHXmf1yt2Stlm44QUuD1jwFYO
+IG8pdgNqzMT1ftdUNKhApjM5+tWMk3UVVutkjbXEe2674OJ4yoy4svcsqrKa3B9wD49wBAQn2xe9fb09rtywydmkymA/Xj5shZikv4nlnfK5nNO7CEaZQWkc
+z05oa5Q1cpNzbAXkCRGTDdofT5e4MqmzTXyc2aQJh4mqoL+wMmc/oshirvffeAnttJt8bgp1G0tyiDuet8cHzMMGVMO6jASAADYJuHpPrPfdmIgmT51JQpV7
+gTStGYouZ18Ux4whOnBraMLpsEhWbL25qPwdj6PcpePD+7YxgFqUvk/h4vWhLBlr1hAAZS7KIBt0K3tI5eMZr1tJgBibfIb3ctpfUqy6dARWC7Q4CkVahmim
+cBzWFjPZ0ERAKwuvXGTdsLre0NfbmN5d11tca7m1nMX4HEcR4z8ebh5cb4PbCjhLdUfE52UU8Q7IY4PP0w+k56hxYQagma6sN8O2Qtqoft+3+o4UX2Oj1H22
+Vv5S501JU7cdyzbTPUoeOrfKjHKmZgMezBaYq1CxA9LOYlcJ+saTudak2wqblmG1kFpxu9JdwN0muewMIT077Q4+PI13r6yqk/9R13vcdKdGYXqS4WWjWgRo
+XK/oTc/J+b9zGBNgv/QqKI9msSN2apDUsMhrxe/1nM3PNHrvfEcXVmDy9t71w2Ef8jU3cZyhQLwTSbjDTfkwbkhXqer9g32s54B46jZ4JzNW3tO5h2s0G1ES
+q9EP4P50beN3Xi9IO44wjos+wn8cdLivrGY2gZHD7SS0eDeBd7Geh+Wz/5KJ28RVjLtd2jIAmpPoFZaP8RCctdkV+at+kZc3zgIjWM/9NzXxrfmXyq0gKVRx
+1LfTNrfDnTQGs9mIXqY0y6lMinTHyXCqnKqe5kMCoHI7iM9425uaM0m6J+v5Zj6w9P7wvtxpGfWaaWdGhMO4j+vRnZHbKCZmcmjo+FaTjKeKvxz4ld7Yi6at
+7zPNLqLqAhvH8LvS+9nn/EL6oqJWjooUNOohBhfatlvZYcft2kmFQgYtaZazBx8ylLLazy41IsfCxkuz3YaRptU2Bq7cf5z7iyzRO+sYujj3MoRT1cfuLl0J
+oA1XVKfe9VbrOVsMWDvrwlfWHrRNDFpYn+7UB+a45IKp1pmuSkTFVI+uz9cvoA0n7YIjitZGc58A56TBcrxYnuZzAtiks5ovsc5JBb0X/tzT9L60d7Ut7gIc
+61+h8N8GX02u8nKktRyECeyYi0Y0Y3TCZd0M4Oe/8hiFpFbnnMm74r2o63+b3p0mvRs/W7PhFnSXEU3b5txZ3QBL11Pk2NhMlqUUgsbQtJ5BPqgBnQk2l7ZA
+42C+obkfO8af/bT238l9CAqYlcSxoMb2DwcH2lEOmfPAcrpnXfTuqOkNACqvD8mm31RV1v20Nwbd7JfSnnfCiU+3YnkYFLF/yNDwyVYUHkKgq/ST5u43dkb+
++zMoJgdg46pW7RSX94FCdjQya9fKD6UPKCurcYyqyzXu9Nq0qZm1zZfByuno4P/lrN8FThvFtR0YWRuQVdRYUogyR4DGuC4wBpTxzkmLLNGWESxvjoOWAVJK
+72jyZWPauu7IT6XXmO+qciApJ614G3zehju47wxuY5WnoIQmuiufzT747bTvnNKbTnX/mSKOytufGfaRdIIGqDSZYjjkMPeZu08ZzyGpkRo1/tpN3oAkVYgu
+xvHVDnXM0ag4yCeNLrXMZekWDgjRiCC+bZ0aXQni1ky58WnwDEho0NbGm9kkZRg1i0iWZ+A9IUUgd2dC3sy8GcM8sU0RwKV6hhObl2uO59SESjlo3xnlt5MX
+ADynjLJvOzeTQ6Zzg7Tke4ggO6ELn9jBCXEU9XqnT9Le46fgQRvfS+V8bUT8rmQ2dxdzmvqrFZWFBqq1cOLcpTtbD1TaRzapx0siwHOMGdCY/RygPILv/BXd
+p8nwsuNDkWFgKOtKWBYp7phJqsvNi/hHGx+Gyy4fScc/pLI901poyPzsciclRuQkVJPDf7PnKrbpPWmXXkkfY9/vNePfE62w9u/xtG88kfh3/STMy/fF8VpJ
+TbPpWPuaEAMgyUhY3QM8p9qHav+6gzI137MnK2fmx9VfSZ/NOjbxCU/P1rOj/FeqUJvFiFADXadmllFkKQChb0A7NuMlDyh6e/Lm1mVMP+DXPeqpyZiftpMr
+96+crvrCp5tFMiywYPLT1gN2PxdREI/yjDonGOt0N5zAom33+1XHMaAax93h4sU6wk57JRQzYZ0SWz3jmxrDnSadhO6XnG/fbkvLWeCqbKqPCkTIoUgdF5SX
+hRcCsiD268oTA1nthcR/vyD2Qkep3WGHq/eE0axa1jttbKm1NwkAl7cmJ/EwKctuGby+jd3dIc0Ykerr1GV229j3j3d5km8G3vy/5m5i7+FWq38Yrw6t4Wje
+3vbgH8ujhHqDOi/HvvZgQrAbTUtbo1ecEBCVHJI6ROSzNb/FIh/5QWMjzqmbL3kDxk6W6pLEJcD/Td9bVtgB3q0ZgaoeU/L34uOprdlfjvB6P691cxm5uivg
+qOmaI8dTNHchYOvvRjho77hZJNvGhNV/y2tumztt1Wd7T/pIOukpExidjiH7ePBsfj61PXePjxn3GjOaKz7zsi+GbU7atEMr8eNgNTo1DxRadq5GkQSQur77
+l9JOq3KVr7lXRVaakHrdtys5uOgZ3gZH8Fb3AK8ilzjeJGFzspUr1+d5x/KqZDAytcQ9dlZK5yiq/k9N02W7+c5PKnUOyyp/nf3Av/RxxnMofDAHLzi5NlIL
+68aGqUeSqTcGeJdwZWwpSHgFDNb1QER8a/s8q+aYxCGErOsCspoIQJsSFK+4ZqAsa08zBK9Ye3WMWkPKtOktPjcje1ZHzSZ8Ob2m9dmUowoCgwRvQ232bj3t
+rtQx0ZWSgj8jXpvke5YRCiebV07cFRFDJshAGQpDBM+QE5UZK9+ox2Fc8YhY6wwMEai8O28JWMgf1Lq7nIHS6fFbOu6jNZfx6PJDgw6SKc3Q/fWNltTKgVFC
+Oo1pDFYQR266B9IHSiD66DZ0Vfg909qwm4HrCsAFV0Sp+b09/XD6UPqpMHJRz5dqSw+DP2rj+JERXYtkTWf5j0JbFw2d2X1RjwstkBtXjUECNEWmYPbK3/Kh
+wEOU+sFYHrT2IgLojqfPgkwiI3dTNQkzhk2ux3cRtvnON7Gl+FNmPf87x1qV0cpTjw0ZDhh5sO2D19sinZWrhWXZSMfV5cRzm3WOQjXVMqGBEQRxSdnnWQqZ
+O1ktSWH5MwKZeBZPkxgQ+xmxooB0pmWqDDJik081+wq8okS7yCKz9FRbePbbmv+SgU6suqcQoRYHwF9P29zUr45NPUs+SgVZ4l9RgylE/+76KOMjg9IwsNSo
+kZyT5jHl9ZoVITtGuhpsQWUJp8qlmn7I9V3yLifXXZ0CZ36r7trA7XuP2qmlvMktwgBywhoDVKD9eQ2Z0nmPoFoCQSklrCi/5TdNLUNUO5URcNvI+ls/ag+K
+ZbDn4kCt6ZRXmwwSgYggIcuZk8bSWLSs43lpvZe8Vpvq61lcBOTBicNgOXfTlur4Nt6aPpB+1M+DX2U+v84WGqbzGIf2eg/FuwXDmhzvs1z/9dMw2wAxcyyO
+HvG25wFFv18Y04qNA+f1jx2OPWda/XBSwVUtDRpX7aRwSb6Hsah2G2hrRZKkHPWoAbgQ2Cs5lWUtq6QyQ1Vcl8RpelFWup4P5N3JKAkUlbAAWOOv3Cgy5DvG
+Unuro5EGgeu4iWnRn96qsMebesvr4kbTtACR7q3Zqe1DTnJXKczOBJK7iLh71MZ0jiAo6qOJz2s6oP8o/br0r+5V9Xmsof6lcunRxKmNq1GHWVZIDsI8TxaF
+1DuBFauzii0g3ykSLa4T8ySG1/3XJtmu+VLk1gV+cUM0p8LrXMEklFIK2mgbro89036LkhP4HdaY/yvpANrrHMSJ0CziqPv9w/nq8bxfxx5Wh3/qvJ/t8bBx
+ZmYd1xyav32iKmka8oc5dNQBhVni7hKgdawh/0ZO+YfCVh/zvyv9UHp/+kD6Aq+Ugrj5LVUZ7mZJTzlFBmzz//+niI1zthv+vUwRcYucI/pfxxQ1cyTZ11fS
+ofOU/2k9Gaar8T5L5VY/eiexcpMfbJLPpah5PO20RbAGS2hND006AO3kMIVdE1DTd+fUcMn3kyD+MjDGERkyXqci+5S3WsqF+USybCVWKld8VJ/F230qza8V
+EYrFHXnctw+6vpuuxpuxMX8EZlrJ0qGNlcmJ89jJKfcQDYImaM55bS4ytQXgmiP9TbUdpmSlfvzlYkoo/NobWuqckAs28tviKo23C1s6oNdvsePSiO3oYgPx
+uZyuQIT9SgcC4iPJyVoQSyZ/ZrTCkOYIW3lLkxfs1RS1N6qquu0djsfHifNfLtwEb409njbBQRi1TDhVSY7JtUmlGq5sLAIaAtUDit6DlAIB3XmoybDyJcHM
+QbRp3tUH3ScILgVZ2a7jVsWI2zec2vsYqWf69b9+iR07G6N1yJ6y+3cJH7P0F/cOP5VotYxkRk+U5jRDBMsY8AEwE6jfhTAnKDe6borGyehyB6nrUbgL2H3T
+IJF0mHMdPVgyOIjsZFVfWVbdcofc32g3aSn1wLKWAILwcxt3duciW74IABaVhVoxPjflt2r+Oo7md3o+3ColQuSxPQbBGXEgrs6B7nuz/+UnVYxkEeuRo/l6
+L8qfZqVQywSywAmAoyPT8evGLafdzO8n3g9rddaCVxB2q1pn/V1b22WAOeBVrjnPFUleW+EkofFkkSq7s4BKBO9a/XKqQBdl5r5PpKlkUmnLUmCXA3F5zAOU
+vpysuqNxneeEHR+l9F9A+iL7zBrzNzGgjSiyKfY83aeOfft6/nDT7nSPDIfoK88mkd93m5y7BKL8jAwxUrrUykjsiawJ5xXslkaNEU/x6+kfa1do4ShJ2Xt+
+dxWDdmELKEBTS6VHE4qRU8HSi05D67S+dsz0iPVbMQ88MSRd2G1dDQ0/ZaUVcLxyhbCbL+l4mbpyckICEOdBiTqy1/OhVvzrfJVf3eBPPuZMlcVyfrvWWY3L
+7AEU6OmpQHK3FbTv0vhNyCX904Y/XLGVTpygKYSzt047w/vCXMCcyKH7ZJgbC7mWNcSSP3m/Zk9+KX1To4nCRrWWRXF1d84uty5hl1DWeKJixZ6oy3AoBAwK
+cjnGZOltg+gxPyJjzTRr6vMciq0NuYzOXHkrKvJXWRejtADJSVIicLVHbbrZa/i8mOpZ0/gUVd/qDekHXVPiF+3EuVGMIbfumliCMPxfd6T3F6Tc982BW14Z
+hGOECGdC57uuARc4AbOuGv6A2ZjOTCMumF7yMC4MW/yKt4Se+ym9kj4fThpl6hRtt9E9KTsdlHp/ezxdN6n5wnDvuqKdtHfeq1wsEYU2sUs1GW81BlBhRdok
+UILz3pamZgYw1OWi9v8mPUsf7+bhu67g/be2fx515loBkn+AtTbH46uX5qORGwkp9qdNpY5hbQCLWGvQKBOVwtunaC5v0vmp7XaLkzkndk6x1LuVZ7IpX3my
+BKDVm3wx/aLmZ4Xr/M+8B0PaC8fsaYvD6eEU18nicVGJO3EfYg962692agBIs4a65stUV3kyR5Y779yQcBs46likvLiRIktYAgBzPJO9oaPh23xDelN6a3p7
+emd6b0Q87uVs5tTfehtp8GTa1IUDYkLsOnXHDoOwuUQL0LeWOi+0zwyR8S3UVLbIKwZvLZMCyrObUQU7RCCDjv/tYQ+9Lb2Dc1rRfgpr9h+b8kXZ8zeH0cZ0
+GOucjLv88BkkkAYRHF9spttVlEvEv+LsDISVYq45e6QF1Q6cC1gXba4hhEFZPQalh2wPH+L+VdlH3eETH219uPRGvC9ggX+V2Q+Fme6fcV1PRC9CkDseC1Jb
+LMdqv7rSzPkO3FsjmrJ+3DUpn6PmDG/UQMtXzpIkD1QiFDTsE8k0cVe+6ACfgYLPBjkZTFPLY7WxPRZO7fD3DRf4pTFghJ6mnSIcmc/HeYiE/0brJ1L+YAXw
+ApB+XXkv0/kjZUbQlhLATPcWxy8yX7Kn0BbVBrXMwofTpZSX/IpgXz/fRPt8IudDhUWIVI5KVu+M7uV6/2l2Ypfj5bN5EqDEoIMTndT7Bc7YuiI0w1MGJZwq
+Ao9IAgtKKzWUWdvum7NG8wFN3uvFtE0ve4/Ql2p38bSez6kjel0QkbZmNCeRmTKYMQKdWy/EbCcn7jZS+IpJHUS4ggnJ4JWiWCsRq/nD2gFgd1y5mnNlkOUE
+7vjUXHnFcjxUAyinzccPIeUt/Ul9yWgqefFpe+SMDzEMDozQ6Ny5HoN+zWtb2yIxS0WMrE8XMzwXVxVM471bDrwQqAFhawNUxYJAeqqdG+VM+QPtj99aLkTM
+3MHTnCvf2q0s++hwSZeF4kR0KndiQjT3zaHZfeK9jETAmm+yG4ScNBj10gAi1fDawRrbWW3xi3dlE1PeDulNngN6metnH3E0mHU+VdWj6hNw0it3uR5OeVwN
+Tey+7zvTP0Aod2rug4muFjEJePoLOektAlpc8D971od6wL0WTqlr+Yr14p8JmR9FgAvn2naK2d1KZ+GiC+uyC7vTzeMNv2Z6JXiYDZBjuWpqEv+r5Y/K3JmT
+ckYgxiKQr5ZrJwJijEtvuePwpBifUlH9VcMHWhJ0pz16DyEEZYE8OfR8T2oh+QAds7GIz5it+Y2aBLf2KHAEkVOhqhnSDAADc+7NaQ0XaFqQmFOT4Zop5jHe
+kN4ZuAjEk6zqjpKuEoIoRcWNx4dAkT0Ndmf6WgK/nLRToXxYAwfWQNyZsMtTMgkVSXh1Yw+Zw7b5b5GDKFXzr6df87qX+M1Bbp6f8MWrvlEw7Q+XhA+jcxjp
+bIngyiIofea4yaFXo39OC1sAgQp8NngPt6/YXtB1L0Z/6Q2V72Qqv9vUasJmMA8hYgTfn37P5wfNOipjYJD096xmfYO85RFdCLU11E+FZR5UpTu2Gz7KMNRI
+sxTBtXsu1g8y8ZtQTkphNXKzAYSyerR0ZjXGZb+BLyk4N9ZD/oOwpkpO/b1aR/xKRJoLxxor02zfnRvcbb5MfCWzdafJ4SadtZ1ON6fjVvxkQJp1+JksWJCZ
+oDLq8mKpDMB9Ih46Cb3iTOJvU4mrLhBgxXA0A5HGo9WHLNiKz3kcJbm8kq0wpJWcKdMYKZol6/AAMjQ64vwvTsAke8dI0JGkH11v432FyjZoilgtVelw2UlJ
+Sa0R1xGh4lDyI1BO0JKPV3jACgeYYcy/yd17F7oNbh6chvJTG81cNBOgkdOttmUeT5vKFCrcJsOKR8n+0qyp3hpInakX3b+AJhG1OqprwuYWEVJjXwuixDJX
+rv9xG3R46metptR/DsgL3cHbvnU4Wo1TS2CUSzbCuIm3k3rnJV9BlqHKPkqdM3FEUNtYrcwhbGgENHsBxVfYJq3TvAAGd1R/hzIe8U5EAiCEjg/tE+k30t+k
+f53+ffrP6b9V5IHpKwcM7SXa6r07rtMKSTq/6+CY3mv41FOfPlbWpFnV9NqH9N+rqFycB6ShTqZOr4qmRdhBTrBKmNP2B5M8e05r8Nbs+Z2at3h3OoZce8hb
+RFxryFzUtefdMI/PY0w+VZLJkPoCb0EAK6osfPvyyArNQNlS5VsbAAAiQw6ma+6rluLSmxosi+A0qs5CsD9H0xA1JuIHWNqsfNmc0LI3G1gbW6hnvUCYqldl
+9WwlrRsxomh2RpEvCdg8QfX4GiZPWPIAkUVP0CSzshnDpRbj51Tp5Pc9X4oxArImv07JslNYPrpiFY/1euSTVzzihZgay34ZaTiZQBhSeQFoYcozGplljjKW
+OWkxD8CLE7FfvVtKV1zlsKZeZO48SD/viqv/UjPu3jEnFfKgd3VqEMXbXUc8yxJYVQbl8Vvt7rTzjaZ0IKmzFfWw9/f03gBYVdy77YxQetX+YC2DX0ku5qWm
+0xfDSvM1ptrb13fe8Qog+9YA7N53Z8qfy4J5hWh3lIqtzZX+EVR5MEPh61TKZup3XvPzMEAr3hv2IsQ8XGGq+Ix6jDHz+kecc7Lu26se0ZEJoHc2SjsbTSju
+tNsfOGzdr+QhxVc6bl/NR+3TnBdRFqJsw/LlLDERzmSQ0wTlsaHnFzwkqkdd1ReRuY8VYiiPJj8pcXjBHwrvzN+q9pByPWqMMJmz1Odi1E4dXF9b1sqdbsyN
+hfTmcW6Pl3mq90HFY9cFYlkLgqo2uHZ4oyIG1Pwv/HA+Fh21mDyP00rOOU+8LNnnaGIfi1lXppo6vR2W99OOj/+2djbJDrThtxqxF7uabt3BWt9uR6tkWD/E
+AIrdikceiXVv9pmTS/n6gq5xqSYHRCs6APiqvdmlZ+mr6XfT36f/5Kpo0Uy0aC03p7c+/EWCrzE7d5fMzqON98bMDEBQrYx+Y2uL1jwmhKDPyHsJlt0mvoSC
+7uUly93Wl19kngVDJvx5+uv0t+nfK6OOs47d+TE2bRdK41EpTTlWubX2+4WwSEumSGBm3Rb3SRtv4mPVVZB0WgL+WcxK8Dkk6ptuEGuBe2WjCdiF4l7DmA94
+SVVDPxsQYcXGx323nb4nl2nS12Ju9S48r3tv5cTv/SXQFCmhbz6i5SqpyPOcTMbP8JWhH+km5EO+pvY77U9Tv+dE1XK31c1kopZLBXBvSguimIftbvt69trZ
+lXUJkLBKeFajCXz/1qqs9gwJENwVx478FeE+XdtZ1Yd8l6JtC1LwG6qWmm5iNekUwOTTpW/0koKjwe5dubHqoR6tqwKIAJHaylJ5qpq62qGXKeDq9fuE8Wm1
+MzkJ1VgMMpq82CkoKhoyo6gJphsLu+sC2LVo+q3JE+sLk3IJ7XwtjbHJgj+Ou9PGhqxT88ynSalxTW3weLiR4IzHDeR1f+1clMnALtL3bsWQNskJZhQIAqrl
+DmUt1KcbXUuzscObYc8d0Fd+rMWWllks8YacaabDeGgzIVZP1AmVF2xnncLxroKBU43jnLfilB820+xbnnq7VH5waEm+yRKRXIeXMqWo7uvplWEtOSeZy5qi
+xjoxCHQ5Q0JaI+92YvnPgFp2zZ437hdir5ftnCwAD1lwV9L3ozcHTIeFyv7xtDEIwavDsQM1T6LdISy+96lVBANUT1om1YDN6i0lqgKMCzSv+FRDQNCcDfUM
+kWh0sV/fs4LR/K5EJSZx1OBSFV3FTRGvgzbjEj6ivj4o7ToaP+OyTRoEi6fuo3wLKkw1AW5c4adZEetgVcQFb8ZLact4kMqY8S8U4RzAdU+9Jtqe5UKXdVxD
+24XU/s3JkNHlte3dcdzlxcTs+T3GNHG4UbNmlPsUysNC+GDJNNM6mOs779UpGGoBLfIA8LoiIGHj6aF5EX6Ami8OjedINNdSUYsXf9l7dF9jdLzpgfx1Sgb9
+2m1DivekirCaHmGJ5gdrBHd6WwbuV2WC32faX9YtNS4dTRrCOfT0UqJEQHV6QnBujs03abBCKrURZU1hsFTtpYZUe8R1WGtLL4R+pB9M700/nD6XvqB1WkYm
+qdqwg7TGHaecJpVcz3zMSZwvo64Q+dsHJ7N3oXhZAAAXR1k1XcM74llhg72W19XGf4PaYMxMIehaQnhOa15nE5u8GPjYham2cJf9Emfi/ib9Xfr36X/wSgre
+xHjsvqzkLCNDpW91vqaQGJ66z493NZfsHlrlTytO45wwcNJCFTtbm0aXKavsaLN+k/dnQiaNSJs2BQhgK/DSxMZMQ5ezE+Xngkj/O9fZ0NNwCpLDR4/8XHNY
+XY/2JU0qxKnZViVt+V6UYz+qOHb5vBFS2g1LiczI8jXZYGNImcBRTmCsKR7BoRZGqj8h+vXlaW4UxclMJHWCOE1oOfv+HQih9SM6BfHaL+VCiOGPWjr1ZtvZ
+mpYvHU+7xcRkB46ylofIgLF2PUoHWTYaGZ2kXGdJi7yyQ5WpN69RT+BiYqj8hD00Sgj78+Pp0+kXWNHj1x1L/BfeQabUIhU24ZWVrfFqymsCu2n49RqwXTkt
+FZHz7Epl4VlTicGAFlJjRBiUgmT1WKCnjbVqq4y67ZyUosqmJyfIVmonQN5pWJ5QFUuJsbPv7Dbn8kLTeW2qWWLTJon6LGbqVOkfXDy5k59vF5FPzhlwg12t
+EtcWzyzefN1TcyKQ2suAjjZb2VJn6itZWWeizgM0ipR/al0xunZMo/M4Pm7o3ld02tsPH2MG4tq+2ksS65l+KxM3q4pxWdlR3J6a9JbzvzpBpVFX/gEQZlBh
+RbRmxXaacpt8cMBG3HFoSedog1oLJJ6CZDTZbB+8e/V1WZ6qqntYnStfQmJ9GFwBlycKZhjY6KSwgEAITrS7CqvGUD83VLuPfDp0Eb0taCYUVOtnuCdE8g7/
+PGH6t+k/pv+W/mf6P2P+YRK9fd1PVsHbN4mIRlF/H/l0VhT3tfQXfQjLSSyAwU+5H6J/1ZISvDT4CQ6JtXggyu5LoZzqC/xvkxQZDNkoqTEW8FQ4jDcqLjEG
+/nzZeazEkoj1aK15P+MOGNNT5u52zoCpsN8ve/+VBLasGOazAFPSV7nPcoFntH+bhI1P/IH0I+lH0yeU0bGwMkhGRJvs9Z826bsMbWl+4reMd47HFEGDp9KD
+wr085dlg3AFaPkWomSC+VpjJfXnJH0lkgFrE991eAPtIVGwHqKx14zM8TV/kPStnpbMXyx/L4BxfHabt1CfXdwsYcm1o2592jivqVaJc3yxQptBsGxYAyb7F
+KrEJdOOCwk5WFw1WwLH+UjJLdm8sey80tXTRvpJ86Oc1pnEdnFveLlbY3LlnuNvKyvlwrpID0iYsYQoA0ByaDg0U6Q+lDoaBr3PGOQ36XUYUy1sxxq4vKr+U
+Kbb+dKyjZReROSnHr1+ts/6OPP82lju+/mdeVdwH8QTVF/CGqA3NTlKiPqcYQJIx1XFaJBDG5w7rgMoKaXiu2hJVBUFe6MZbfZDvPm6U+WDc56eag4zkmbJ8
+3fGL3KPxLi5HfN8GUGgj9vc0t7Ud9swZ7TJg48HUbPZMYmkAIi/gD6cD1+V/Ppykv6MarYcaT/ooFBh0Op7aKZJ29fKdyQvHNmUmNfRsMfhB7od3sWSdDMFV
+kBF1DgjzwkDnZE6BMabaMk/axTyTrfUZvK0GsKtPfDD92MroD01xxjKgj1gWseg6aT51O67e+sFiFRA3qlkGWXLGmd3r5s5bghcgjrpZBR5Mn1O96U1tvHje
+glP9DdfrOrDns+sxhNvdth+nvjydjsF+MY+6NshY6+NGLe9MgOSoAIrmCa1Uc59UrVcAk9RBU2VsEG9zGR4HgPwLMmF7bz+YDsxsU73rP9ZT8zSFcsth29Zt
+y0unKq38zGRbdSkcFnOhgM1KLCzZpnCXZ1EaJyCQ/Nl9kq9qND4QzSJuBAAZyHm8FBNoOUvt/huSvoTNzMSO0Gaf37ENf81rcL8tWe696azbbfSyZOAOlH81
+/FDqkhWL2JWgLi15ALQkrPVrUXazJ3NhxaY5dbvel7svopmqSWwsoa8dmRNMY/BDdunjGn9GboY/4hzt32rFbntXA6qxq/YrhcvjTsMIU34Wnu97/YXyXA+U
+7VFdMwQkaYKpxoEtJLiSySYRdFMm9lEWBHePWVyRm3TGRRsiB2io/XcGqOUS/BnXVZDdJtVPxwzWlE6z1A4jT2uZ4nFpY3aWXxSDIueILq/yz2T+gya4vcJf
+PfWuIx4DaWnOtmGcAcrFPPGDPGoXKFOC+AM6URlFvkOX3pwyytTrcQVnD2errJm5HwAArSbLlF5lz6MidqsbGN1d9QjdV94GOPipIJW8Hl4pGCMf36sDS3XM
+3UnaTgeJeAH2c+RTgLD8btJMEj8IoJw1oP2Wxbsq6JqyRqQv5tDCRKMjtak9L9tItrg77ViBUSnmnwnFfvsIjvrszPLIF+tHCPtG/Mrz5ESuGYzTI3IjbtJ7
+00fTrzV9HGN/4RIfN8HLXZUXizfEPmxbeJUg7N3TQ51bRDBBsZDiLDfmwqDvU8hlovxBiPXplLbOjywefmG6PnZe/q+EXFRBLzzqjrWWPlr8m8V3LPDUO3nh
+Dav3d0Hg3RWX5mR3vYOGnenCKnhOPYgYob7Sxq7vS0dV45GzNCmm/nBpZow2L8Z5e1XefmaA5oq2PBmHkz8GbeCWwA35SW12Km8mjCPSUvDZMreisEY2c0MC
+aGLxogTzlYDLWVqfK8v81vO0x5BkdOKrUMl0dMHWF7v6AX6Lkc4KQ3EU5Aw55bIHqmNgd7VZ6zk1Wuvochrg4Own3Zid4fX1jtjz0K4d0hhhEwpsZPMuj1kG
+SZX0XDFps4BuZro27CEtdkCVDMSeJ/zD6cu8t/k+T7WiEEqEckA7abQ0up82D6zx27ieIy4G6s0U6vNFqcUWNJD0pSFJbY8sH/wcYWWR+4GqFNk61ojF+3Ht
+TFQ9ShHADVTY8uH1DI2ptAT/SABIYbF2wwEXjpe7MyNYOLMyBgBIrebXM1eQNebqmuG2PgztEyuXd/nyd5qYrYyWn832sUiRdikYYfK5LkYqKRqY04wL7O1Q
+X+DcdqpLUvNiHc/PLFuhqiu6EVOjhZUGuj48HbvMK35Yz7Gyu+vOPjUYi9N+mWs8GQdCrfVmJ+fhH1M1j2cmUjqjb+dQ7MYZqlwp2BYo4DmETFiLrNRsBWPB
+aGIdbd9f4LY+p1VG6ZzQU2M6KdpGF/fW9DcC6ts8QS056vHkoO64gsrGr33Y+6M7XHzry8AI1tY/desBuc1CqzfSfqlxfqhVR7JviT0SZDD+VkJotaE3aee8
+Vz7+62fw1BDhOyC+nZ/TMc6OJJS73U99LDpgNQaLubAdokuk3f5u0hWhayloi1LMMMT+PmNsKHZNYtV4elVb7siDWjVdPdhySMXeKEdYzHLIrBzuxmnMXC2l
+qsEIyps5q6qKk5E62QDIIoHy7Vyh/i2+MThxLZo/aymNbXz5aWrP8DIXv65V9u9vFqy5Qz5ONaiSA1EKC20kNh5VBWT3qEkhAm/wwG6fUPXmr83MEMGl1JjE
+mbIqSbY15eLff8ix18XDK+fHgx4rb3slrS3t+6qt7vJeYxD30R8/TYeyPnI1E31wCBky8Yae16ah2EyeN7LKZndWSh6TMDMNqXxvgNp1ZmDb1rfd8Tn6aT0T
+WPNhfD2+TAWxTlrzLff7vO7DDErdzN09aEH1hTO/FMm4Uk6M6smRe13i5eIDVDaaX3n4vi2uvKoFv5ovMJCvxBthOJqdlP4RvOaGPSfVBPaKS4o0y8OKZs0u
+/YqhPaZH2PDJ97FnI1vNgRUZ2vyw+RYOFCAi7CahceW6cXs9WUxU0KFtXLrGXknc+FuiLrBmryyJYzDj9UhE+GFv1yya5rTtV+U++vCY42xYdA9ENAxJbT5h
+Ne6qmOoQjHgnH/RrSZg2Fvp8knmofSE/zXkG17L1nuIrrsmt+7RPVxRs974Y3NsJLD5OnWDMEreaMWy8nPZRtWzMgXXzRZWBjQU+ofV5Vlyb59Q7tbkKV8iZ
+ScInoCmpdNOdfd67/n2ceyZqcAhvqotJO0sV+aLKFkZsroHFo04/0d+bKawQpMpySCF0f/AIbCaN/DDUn+r4EMo6E83L31VsZ7e6xtXVtT/tRq3dyjv8fTZ/
+1kPpegeSmX569LDqpta6T+uOI4WxYExelYmyYxDOLr8e6EE11yxgmfuEG6sEoHJDJJ5rqRhgtUdvCNxOH4icsZPtDMX1xKO9rpYQHK0Yag2kju062grYqM2S
+mO9Zz9MgOm/4CbhfhJjRXQj5viF5YySG5iOnp4nB5aVeGt6RG+y5YuUcPrKV+qlGT67kRx+T/SvTJWUL6XF/NTuP3DNZLk/VdO0XjLqtNlJYO2yaz1h9DlQB
+BantrETZizPMi2LPCdR/1JO63341EfpCw6H7gfRJ1g8V5po0xRyaG5qpskOUKbkLHGGiDDKNu3E6tMp8/H3hGVn0uvOfcWeueVEV0sTGaTfWRGqTEQMw40wA
+MBAHKWTENRlWxxt/HKqITti1sgPRUo+N5nYGAkAGk5KY9s7/+QzXX50d4pI3EPbhwpSzM9A0bjdoqQAvF/9Qgr1xd3gyHkd1CizxQNULUNuNaBrdlnDJCQBI
+GG2ggnzX91PU7A4PXrDivM+UYRnTlfqhR/bfV0wX0zkVhfLUXOPt7nWeY0gOEValqe5AuhrZBl6DnIQiSf9HO98h8NRI3q/OQZtY9xurKHvVKWrgcodtRSUI
+Xp70UpCHAmBQwzTzwTETWUPBrEl3bKyuPs0BooLgyv09/8lPBLTBchyRQScMY2xbBey28q2rPVEt0ZAPS67PRH/O2vBRvtYFPagmeyKgGQjC+LBJvsflugmJ
+LQOwv6GrJbz2vdROlCVv7IuB0yh38bidfNSTsgFerCPMQFj9K+V8PVNVoYcHyicxJ5Hbt/a2q6zOUhtkf0pyj8fTLnCVmYhs9Ra3Ux1eteJbVpXa7sMgL9m/
+ctsZiFEjGmMliOovpoIDMwqz4Qy5tXGzcorGDmSckyMl9AMQr/4VZ+EsSw2HBT//m9OHu0rqa4on/G1Hlch8SdvUuP+e6ip3q26CYUN7NGWJe/e+cgjm9YpF
+VsHa6DYgNCBLmDUra+vuUmrD22y7AzWKeS/tPqSfT1j6rr5fu9/RLVYm4+p1OADltLbWHnse8CorXw6AQDAjRiXPZu08lPPELo6EHsHb6y49c+Xb32A/4qon
+ql9bwt+4KcIHYzdhvIKdGhtlVSpO6ivaoLuW2lOH1BDUOVkKjGmMSSsAGWklhwTWqaxwhVkK1UQA5yR4PsNHNrHd+xQBL3x3v101G6/tp9wshukQm0nFMZKx
+X2rbrvmBadUp1+5OHQvNDZ0xaQA80FoiTRcNnkFpWalSR+oHIACARR7gFwxB//3smY5q4wlLzWyNRXMb9FEetzOQnWuUmzvrk8f4SAikgHsyllWkpqf6VY3B
+DKv35+mvvEP9UX70wTtfWldaMC6jtZxbQ4wTlXIfUUFPPXW0ihnZfeinXferCesUoVb7kIShAEzXVx4Z4WwN7Ksudp08goF/oDPqWBkVsLEfMX47pVc9u8yo
+/huHfYqOrczS68qXX9o4zo4ppN/3af0MislmAGLNcECga4n0OWyRzgf9sfSPgrJLh+hWzO9kOZypboKo6j9V2sxHTcGN04GKuCcKCl+ECYhQvawMFw9iIKmv
+KsTbZAwAVO1figUXZsOFP2MdqPgkgiT4Svp61TvZ6712h3U91+q9XuZ/8N3k74sTXhOC1UY+7WhkN0yMCm3uTiINvrmAs61YNZQkrZZKbK2qUJZBVeLGAatH
+Rk5apFlU08l/MdRUXvHOm4L6FQTQo06OvWuDxpJwzUA/pAS6cfXPlYpLJkD0ajFVPECQAiW8kMYhwGCOa1q5FQTNkANNZRO/FM9LebsfPkVY+efySTL2hLry
+jiBUWKtoy1NEYraFk2VkpsrPPEedz+Y0qRV4SxibIhfa/zSTT8LyHP3O/wX59CB9OR5Peo5Ozj00PS7R4CKXoDIZJMxmjzlBNaDhX61vanLiH05fVmY2YXf5
+U8ncRXbSLuU2Hsc2dXvRUlj1eOE0vZIXx4Fxk/YUgPOyclzdp5wwNBiHhaANiZb5bWxF1X9XfDK1vV+Fp+WDzKthyPUFcmgXdJ48vDic7kLW4ljftI1cGLfX
+ExfdWg/bgBy6S0Bd4qJJh7vpwz57UTFZbf7ijU0e8ndc++xvvs9cZKC9kPUxyTzVMpz8jt3pJqjSPTFSoO0jcpJk/iHIZ85lyRMgOFsd93JWcgipKzKRcLW6
+grTtfaUNMyD/FKu+foPj+eQVpFNTGOImhcmIxkN1etSciLaKaCMgt3vWTXIUmaR9m74GGJjcMAFo9lUqjJmTy+UZaztkrtAnGQ7q+QCsF5+wfJYjNgswdCW2
+ugn9cL/C1uF3UjKvaNEFd9u1Q/XzYCxsahhiS6Scorv3CFrkWBIUsQuO7EmDjeMHbxjVRrTut0GyqhjGzMQDaNvBfmmPDflE+iWNG70ueKvbuTI3rrLuTzW3
+8EC4aQT+6jMY4rvBQitUMCbazaOb+yh7VkOXpPrnWBO1/OVrXFvzy2gzzPCMASUdddLLun+PRwi/qXbiytrnLicPqb2g6FJ6pqYfIImtXJisiBsHLkz9Vqj7
+GhWcUO1amYiwFWi5G3jlK9U6ouvonYlUTYCdbQB3oqvvKNqj0oF/4Mye4XGj/mjWpuexdhFa5aGDULXa6lF+tDTJ6jw4I8duu0lSF21Ox8HjahciBdEftT4n
+0/ngF0joXLQDvKbwZndDcz0nsLLBLnP75bz8UPqaZDQffVYKMZCAVFRGqOtecm4kx7d0Df+mnvT0NF04QAGIoWSk+uD2oBbhAoAZLp6iqM5koLBuGSvvUza7
+2WJzDWXHFSzDNe27qFpWhYEKVqynAwxODWOzS8ab7bQuU8VkF1CZKlAgXHqcxfPKRLOp0qpHqeYDYUBtN5Rtg2dBNjPJj8fiREgAkLbNOVm4FI2rDDhD9zXH
+eVkO/K+v2ZC9rqbbixq2C4VamYzPCsb9skBt+a3lte5sxbNmH3X5zKgKtSg1YlgXqVXk+sLGkPrsvXgtBqXaqPIaH81akr7s32YFrr9wVRhlhDLGi1owv4yV
+kvirKctLrte4JZQo8DTZVE5KAn/iUHVA67HvMzeh3gT3gsHw4hsfU9oFmUORbYn0DsfQkOSXnlWRTP/nBjsmfYQ/G3qWrI7+z3yWrkYo+QKX+b7BaMhsXFlN
+MlPlW2sAH8QBK5c5RaDGpeUkSXIIHN9dGJOaCspzM9fi0TUPBFj4O6UeJbuQ19Pk62myZbI7rJTfvDzb+LMnT5rLwbe+l8XT6Tw9yI1YI1oFbkiOqyXAZjIH
+nNFdP+fghC6dJH4uP80Ju21Z9ykBNPnkD7G9kr7l4uP8QdCkLypbIRZqwKVCc2oRkZ3+Y9t90yzC2iDJc3k8fTavVFjsZ7dShpqjL6zF35xq13ezEQkg1ltU
+dAkrPlMWUCajiBUxOLRcUlAXVHIB+SCv9zFRseq/zyxTnH2/FhPtJFHYMF6HPOu2yjNtd6OXyLfci2YZGMVQnRSQJ65CrFHxAmutu1gtIp86Y3vlB8lCGzTW
+5JoyA4IWYVRda2d5Vra3rddsks1lTxLmRMjBFRl0M+RiJ8VFVMtV4g/lcYrT8/0kofNY+Rqe9XwMnpomhZp6u8aMlzLStgRIvOwhogQek5Y+I//ITIHbTaY1
+cpwWrF3pRZaqxNc1BvWY49ro7zrysb1j4HX7vhpPuY0QFYu86/ZGLPkx1HA6g+qqcd75pKEXFUdKkpLAYQVoSnslTVt/13PVYEwAcsylrFEgZd+E1M6NYIKs
+K/tP+qrX96FftX8E1NpyV+oe3PRyr6fdgyJWMzyU1rPKz0NSVuuAavn97llVQPAybjml/5r++6Pjlix5bely3p32IfkVZpQ/T83Z6bSwDqRuUwlBDltTQpeS
+gvdJpD4Hg+m7+ICNN36sj3qK9jGOnwfLH1p9yF3Nia1oajhUhM2s1FO+Ffz8P2dFw8I9WOf50GVH23R6gKxtJFS0svOOE63bSKzklFOG/DcIbehzLb//kAMM
+u02+I2TLLSKxsynoMS2pDKRtW2cHylaObBB2QrWRFybffowijbERVTW2linE9RBq1mv0cR/G0GntwaiNPNKuxYcwzxFP96xlRJ2deLJ8mklqEaTEjKJ9Uva6
+zJuB6spOV46jJahOV9AKsO5MofGv5eXfBHYKU9L46/SvqsVb64DpVIt4LZlZD/wdK/nKY+gQPm5V0bigziqfjPoYG+FE1R6PZpRa2c3NxtK3lHuvmimSqoIL
++kXO4ETgoDbs8Os0JJgTgHShq6fxxm5fftu92e9hF972+hnaAm1htkvTMM9Dp4+lVc9a7rm68ZDEKeO1JdzgLu4OBEBwRvEwru21+qqIsPGPIubg0JSvEBdn
+60uct9in7+iZ+h/Sf0n/I/0/VtV7A4+HWqVtbhLkuysJ8UOT5ZLpjz/bfm9f80eM48txCdqJOqRKATko58W6Tc/xdQHzZeIdGPUf+ZPIHmDTQwFNjh0bakL/
+zc8DN4UsyhebftRXA9vzt9NvaeXpUT2p00W11jGKjUTHRKrxNxG9tV938SDUhTIBwgyORtH+4zmpr7LOu6K/0NVbn1Mw8/ypykdh6BF8S9BH/FT6omPa/nFT
+m+BQUxHDBy2tXE9zsKuv8ZOFo/tO0E/+5tr79tTJw3WJIec5ERdVRRfG9P4JTpi58MFcM2fknM9d2ocrWfJqxY3aP4I2A13nqehCfIl9jb9Mf61aibxbF23a
+sY1bjNrkHZbTdlqWIqJTvF7fmJRD2aydCNYfG7zTsQ9KN7VTAHXzDcQw+yiqTE0EtkmosJecFLmAVuNoEttYnQ2ef/mvJEPbVz/qYw7VJo9T6159R3pX+ijX
+ASUC+3Od6f+ks3ySnIjJ45z2VxO6VXt5y13lRhtdf4fmIm9HqxmNG3NMvDbaz6+sUQQgqME4eFFwTpWEoLyhPEFgqNiMJoiP1mHHc81/lqG/EnHYmxRRADOg
+bOqqMlepz4KPXLhVSzfHa+zH/ZP0J9w1VU6Z/5r+9/R/pP8n279It9iA6eX0GGsvRFQ/l7YeFSXkZNUUHEPTu9bZEhZe1UaVX7YPS/WzfNZ0Waiy0ofuzF2R
+S2BzL6GKhWJ8TzAnPGsmvbwi35H7AnU7aM+ar+XcMMAjSLe82QNnjbSKDcl3Fv7PL1Tc/ev3f3JIVWmqsxY0tegnGsiyoqvmRUNQeN3zuU+IJOXrMlnlvbza
+skvRp/s0S3/6Fd9nwODj6EkvXbeoloVCKc/57UpmXfioq7rOSTSc8kGYoCpVoyRBN35kFB3cXO4MAM2edObdRZsEQGfeiXROqPlaHhllV/1xfkBIm/QFxld8
+Pf1qyGhwXMyYs4MI4PptamNpX/C1Nr80F/xxI5VjpJyeE7fJihcvxOdUXXJVe5itUVndobYAgtUI84MQwxp8ifn4K8ceV4prk9NR+QmMTdVQAVW1gtlzIzCO
+IMukF3ZUlB6teqFBzXKToHxqoyt+2uRwv9RkIf9cMt53MvNHTSlNp5rsaAMt14evGZCj5zzyCmXK04tjvE/sZUkcmYHHuJxkYscC/EFW3A63ijzobuzUhcJV
++XmCxRzVc/DgvXi/2LAIS04yTpCkpF0faIqSSEqGJwuR52OcbpUe2US/jRR2H4xHnKQhNXODEogD327LMM58yyXrRmf+aFNjNFtiE0I6EueEyt2v7zB72pAv
+e14CuM5dsBAfSof0qZScfcE5Y/zccVTDGNOv8pE3zJDY0DEjBQAJk438s41VqohSgL5PWa6NEGOsK1xpP67W4zdTWljunukpuMS5xfBa65rjwosW2OLaM884
+Ii17fHJi3wOjdmyWdn0i7zlE5fYEk2mU460dYc9lIOiTo3eXpVxzG9ZAZ8tp8snP/e1RmKcCuKtmavaIkAWngEzEhY8qqjequU1D6xHwjTL9N2QT5eDsnp9B
+Yu4fVcv/Lcly3UZehVMf4NTBdPcqsFW19+tYibtfyY6va+6bfuT7BtgYFn6emHSID+80JPTId26XaHnZb2ADrUtvTRD2TtFYvE3vTu9Pn0ifDj0YfAbnpcri
+tbW5P7IPsql166PcYzMn8lG0sYJyYk5oqNCZ2OxyiEFZrOwQiaX7NQobDfsk1xAW7Fua9bpPr6SPpE82vAlxzVauiQ4kflwsWpeUlJMnx2Wrv1CXrxPoK1yc
+e6gAMl00NJIJ2aT+VEchnwQArufJ3aaaNef/Kp4Vgn4RXpsfSz/n2qp/WrUUDLdxZIYRBVIGdsabR93x3RgV3Q83cb2fdm6j2mIe1aJbsFE1bDKBvHOCIUjz
+IZQjB2BQeVrExXrATa3sIRmne0opvTOsh7dxTPn+9MH0kUZr1XjoBRnEDECZG2lW8T9bnrnbB6ZoARHax49bdfnL+pJXFh21p51wimAI2rHZNWeJzIt3m4uP
+66gOfq3pox2gvD/igeS47aaRDCFEFklSkz7WR8t5ad6J8a4nA51KtapB4bYi9fU44LiG3304xZ2110SQPd+4ejjOcvohkOo1SPYibipWguPjDwelOFRpEh0V
+OMLn7OcDhj2YUtC2ejPbkpPysHw5fdtj6T/nVZPu2qbfQ6OrLUZGIplxETB7SfR4eUVZJ3XjqNnX9UTJPCkAWggFBI7uLAcW1o/EQ+ymwZDoTNXsdN0qbSzM
+T5vdJ28OO08OnRdDHHLL2mBT+pH0mbDz/klKmt83z8HxyY/wikRmvbw43hnbcXfq7GWNKWhtSFj7VMpRCt1BdBZdCwA9hMquivIYZsB4uXR7h3+TNnnIcURE
+5WaEetq3gq35q16RQLZBxyinFtuHv+2QY11xnTt9OCbwHEv5Xds7/0pOZtY1q9rrADIFghcTXmF27nOiDHTmVVTceUOuuNNehq7zYPmQmsgnlwtBAujO55KJ
+/0k+owSt+odcW/vbdlYa5lzJDFjK6WCdtK5CILjdh9ZMjW2mNuap9nkQ/U6o6Bq2NbWDhdpVIzed18w5iVROn+h0SXFyEGy3gDzcsX2EgBj65H55qejpamsh
++X7Nxnra7jCGNVMLGhYy116Z2kfQeDsZ6uHQWmFUtWL9NoWU732qxvk+wRCyRb1JLs8y9pENzw1GUYu+J7/lx3I92Com/6ijSAn5XFfwuD0uTqWpiY3tdOLl
+UM4mbGeFUHoooRLblH9nstKgVB5wkywf2c9IbSE849pRhU2P3Tf9dPoLZun7u/Qf2311NMWd12lu2iyMaiydniw21Z6FZPnda4bINhis2h97NdtKKiaIZNPR
+GYzSV9OK62ZI9+7cKCqgtFVQEDWL+oaWVyhe4nvTBxiVuZJb2IkyfKUx3x2442ISgcVxJ9dMkYq4PMh40TxoYwcYcUgFnVY6huaUofKwx+v5IfZbq/airPJf
+ZeaJdLOISfUaJ/uGGEVlUvfEeng1m6HkEWRpJD7tDk+EFa5EeFlr3ATIo5K55WgdKy9TeZ41e2x62SjYvtyN3HwNNXZlPwypnMplGhxdSx2P6ecZ019yYb9m
+mZbrWZbIPaLaeDLSci9PuZ+zeF8Z7b/MswScBQ3J5sMfA3aJFtvHOjqkc50ExAyMzzTuLVGdf4XztZ92bSjNjte8kT9bya+UoTzzocTFedpVv2rDFLO2C02y
+QVYttSs2JwCATc2vIHpgKgVECRxEJ4YkRA1nveVZ4uotFup151rW9l6zcm9CtoV5UlbyY9jeMfTFihZeX86z1C0b1irUAuabXW9ok97J93Kv2cGSMfuqq5OH
+8pqcL4+9h/Kd23Inee42tSSA6olklAHFm2jCbIOFBFD9+bWbWAZabiR7ganFqtS8Sqkr/lSM/L6XzMr15fqI3Mr6iIlfeTCvsrp8KeRVgMf8gmqnvqKqGMLf
+8HU7H3oGBvEg1GGd9kGLpXkeRIcYA2wLF6D21kmjT9ISTPjk5iaJ0a1sK+Un5wREvW4aInbYSRnXK4px+zm1q1Zh+Gfr49uK22ljVOdaxHyfhkYpZTk0mMdF
+cpaVadA5sI462bBYe7LVUThXiIxvcrnvyWfD58OrtAMJUCENorTeThGlJu5/P9cZar4oYkz/prCOLlldGpHSw3jTJNccEG4T8OySKl88wPXzU11fk+G0bjTb
+rv/7YeNBHRLB2WbEnCoKWPKzrR5+DdkTxzNU0DPqM6WbRISBdCLrwuq8dNTjoe052KTPhhkUlcO/dN8q9umejhVUZQlLUz60pnb+RkUKLGcuQosEddBOUjAH
+JntCgrlFwI22tlgnuNb/zV6g68znBJlI2t8HAx34vFjlhaqjWefj55WR70884/jv0v+SM/4o+NDADX1sQXu7beU4bsRR3ekpi0yJG/07peZ7uqj/6PKFLhva
+Laq5yQt1z7UvyIkfspZvZKVJMhJgALLFpecwSY0bpEzRQOyRIMvaRCPWCExkrQI2L7a3hp7xnwp2TFC7RYP+P6d/WF1xFg5OVXeFuzaOfA6Z6I5CYvi23C4q
++0ubZk0J/dK7WfxUmFFUq4VUW/LDxj1rdOkLModggpvJ3RHIbN2MKF97ShHDDPq61Eejo1nqQx9ghbmvukpZYVn+h/Q/TcN/F0Qv43z69uRhF7SBpxdCpb4x
+/yV8Dl9P+rUhYXaHsJIP67PKyHokNJMHYvIGcD6NmIvJSY/PzGDfQGE0Y53b+GMyv5b5q0yf+r0qIgviHxPG2jV/u+U8epJeYsX+SXa9phYED992gJ522w2c
+YaAzbGAGmKFYKIDBeMotyX9OXhDbLPgtnzAORDol4v/ooX1UUd6UCkREtG4YripbfINzIqBMdg4LNjy7shSq4vMmndXfntEQIelNYZ8+SWN6mib1kIv/Ea7M
+rktZR+PV1I4KhiSHa8tIQAJBLlelxDPJLg6lYvI8/UB6jn5drue45KYa08vq6wo+yXQUyxodj0Fou7m+W23a3IjsQLm0nETzVbhMy9UJP4SVwAbxZ87hqipP
+1AvNfSx786Ouw5TaxFQDrq1E5Qch+R2DODZPZAmays8Y0ksa7nJQA+A1rd0/cSLFphQPSlTSgXDgSZ/jxMo9sbVIbltu03sUk1TwPob42m6Wd79fhRtmonEL
+KXc81wYF3qNV86KbVF6CHabhZWZl+wivP9boiWuw180sl7Bv7rWvxdEv+q7ZLa6dqeBk0HY0CmKgQS/zjHHP5IRoDtyiv+Kprk5h1+PrnsJsWrWpm9FLe3uD
+OrNSka+bKe5wZbJSBl6fYVjs82h7ClN/Y3uaq1Qv7epd765vpv7GN9eWhnTx3r/g61Dsz8vph5WX8LVV61O15GUVWKt6xVUJnZAFOntBspcvFoZJrtYSg/Ex
+Iw0J9HJXVm5OpfyPZIz/SCLpSGWTgfEMvqWxrbbPdmq/SuVyYS1uJZSbZEwSgGfHV5YY7ukoBI2djUBnRMzc9068TjoDIZqvZQC4Aba6RLQpr29A1wiFs+D9
+lnO1q4yzz5gD+SOzfLu63zJvsYFAuXwJ406bTal/KMzk8JyGMsdEg2+wN4b18SNqEb4qDPJ2VZXLYtdUDvzPnfkrZaUfT5v2bLCr3gdtjZk2UbjTYfh8rbM0
+BvJClx0pRjY432BBn5UJwrqXsfH39Wwj5+EzZbKwKyMAXP0CtiVHWf+7w9jcmcUOVebdTESk7RV2V+I+BRPWK3/L8sZ4e2y3puZ+2H49pE+mH3twt5p63Fbw
+SUVE7tR6MstNqWnqEr7Qha1I5W5kko7rep1v6XytVxrcpuKOmhP6VDV9unVitmZnb+IbIGte2sRloxohSBalsMqrz/vzXlE0pg1fXtEOo5n4n/BFM1NMGZO3
+xkSjU57rqSUJJlry93266anStSQ4bvb+DzUxX22Nb5eDO54rJxUPWglOZIkxjXEyjn39rEbUziyEGUjGGA8GjT299DBjf4hhGWV5Jb2tWXdvTtv0PuUU+pzy
+focKxKRZz0trMTQySMvOPrAmlcznk5ohEmKXsUSKo3F68cBhTs2StQwoQBlttlYT70S8937hXJuY4koOqObaG8t1y3LnJXXR3OdSg/pQ+kTneUojWETaHsf+
+Vipt0Db77X92inZjNu5/IJhtZ25Ib6r0HmSNg4XsIeOZBnCKYmn803HZrozXXirDkqVmxjTB9we92mlr61JPxKW434pybRzjre1PpynemKN3n3Shdq22s/Qw
+A7E7O6BxD5BL1M52N02ZzQ4quZVElNV91Fp4HysU697szL0GJ0+rFTrGgQZgsu9LrTksfF+G6lfXgDy9KA8FGRHM0gdSbrMZ1ZwA8Jxygoy+BYldYF57rUs5
+uEZNO74xfa5FdvYnwuQ59WNUUKuEZv4Cp8dsdU5qejZOtK8nBmBZl2VQGAYJcJ/kHpdTJBHC0Mac5d+cjPhAbyrRWQfoaxYgpZS23Ri3TQ+HqXtUrtiS4bIV
+3VqgxrfruciOfJR8nN8tpICnD6tqPNus7TM5l3Yf91NHu9IPziw+sz1CRI1rDHN4loVpWdOcYNYcIWhIKHEFW2U9iNQGd8wEUsUAQCQvW0mBMezvH0rvV2y6
+WGiJ1ZWMJaBjyoFktqlxgteCTPnPkI/TGRvv19rwMkBOQKSNebN3msrf89KfeWkRywsy7n1e3f8i19NUJbC70KAyuedteVQvsnzgW3p3HJsa8Tjdutgb8/P1
+XjzAAHAG0ppJlkZ3COzfYpSG1Dn2zkIhdolIaHpYyk2AvD3v8xPn5TP8QgryUCvniJVQxtqOXidjunim6Dg3yByMYZcCzOBjH+R4uU8Q8z/xhEFQzAI1rSiL
+c6Ydo2Cwy169q761XfgkHAaaTfDu+rgA91Wkz+Rx1b5u8EyzlOhFvjdpxzPKcY+V9sESgrBJ3hJvSxGj2B4ABN3/W7Uyn1fcwTc6lihFui66nEWVonvJ79bU
+0tucgrPU7r12fV+SItygK5WDa9brFA1WBC17NoW9atjzpS7hxa5S7L5oN/SwZM3QECu9yX3Hra6GmqX5vGW8imVWLmaOUDbdXp9OK3mQyYwT5TQn0bFoNnRd
+ErJj1UJp5im3KOfOPDnHKoVegj2zzX7Sr3qcbBV7eXcMXsVGrNKrORhSyknXbUJAr4rRfXJ9pwx8PTAkVWNAY2Es48Imp4SeP/+ARa/7LoO+d5YCv8jJaePH
+GLLn7XTi4oFl2NOMxpEph/UAQFpUpjMO5V+cQ9Lal1Hl80HIJ5nEES9cyMGXK4+WwTPLgaP71GwQ7tvcn5ZuVwm7+SlB1kZY4YfIcG6dLut/nRPAkIjqEhAd
+1+BntTbt08GPVKWW3bbGeYbgdq/L2ByDhMGoJAgGId3XcR1Pu6mMqzrOiV2DDRLxAcz5hGjFceYgj2xRc3vy3Kx6NQ3nJJB4gJzEZpwbY6h0odjWZ37YPYkv
+aM+s/rGYXTwj9yLzQ4UAOxFnDDoB9wnbigBCeSYkyeoeD+lyXeBNIUaV2OyTXBcI3rAzlhwsgTOZWsTFhOtth9nRNx32wRkWe1GBU20+1rCAQECCOTJqkrK9
+U+1gaoc2eJc6ovnCL3b1ond7PlOsafEj1jNB5RA9hVXGnzVxuL3rEC2CqOCIaN9niDaVWISrgmcZoGGSZ5DApckWuTKE7C7edd7+ZvF5TvU+yrqzbm0bX8GM
+uS/bhqmH0VxZCzyFMeGmSSW8mmOSVPxasHg0yzbjxacEpboYiQxtNTtdGGTSnZZNtwCcf2fByVZ92ylELuWOCYp93b9dyUavKqvzd1+3uvpFH1gz2Y2y+rlK
+APTa6mnVHzZoYBu7XNBUz4bXJuXdKbP17jZXb5w7m2t5Tq2eCHvaxgeJkuJbZDm1h4OEn30jysvLzegL1v4BLPZivb8l7ipYwJJjabzd2Ek61WuWhGw+RV9X
+ZZpWMvoD1cbRGTS7P0PGM2glhfiECKecN47yYDagp3EgK7YlG3BeMqaD2lBQVOOvOdZLudTrahW7amWs07HhOQo21gi6ePnW/OczDcody1p+y22QcgtLdYNy
+XlBkz5pxo4zXYGLAtaGHU0p2GmJ1ZjXGFvWB8NAQIWlgA1oHlseVe//jyrHO0VzML1269+XJyXNrh1FSL8fTlB0Wog3Di+ySKYNLOmlwMD3HrnAu18ydNF2a
+SYZmi6G8xTS/zkgkiJjyPlrGciOfqZ9T5ZVfWjtp9s0JG2pBTgnWHELSIXu0DtnFWYPG9D8T4uLcYeBKe9RIM7HeeuZ1nZMNlsNyRBOKrfizt6a3p3fqSq+9
+m4xkLO6crujVQnjnzxUKut1KJBeW/iAAG7pPtpjJXSCJt43XCGfcILi4ksZqkVoK1ZGzYkUMyOydkFJK71qcRVbnF+v6k16x+GajjvvXjW/bhK/SnTiFnOIz
+75zWmLXxadeybOWTrBxpvrgLIBZNqs2y38vWV4eoTAvnHAnkOMm2B7Im2zA7W4ZRSvF2MX+47bORVfO89l17xk0Rwzq5agAaHGc8y01hOO1dYeI0NTqeZcVs
+heas1ncCVmWR+9AXhaMdXSE/a+oQ5nQh3JUDDoy0l6hquQIuMle2ZhQwontjPWZ3povpZKrYvtHHmOJYTWlkVXbcTkbqxLcZ70UfWzzo2cx8smqp7IeBxyZ/
+hwQ5SXwAhMaMuJaYcPxuf++eaJ60xDafY86Lnw9Vbbl1TQpufHYx17Y3B5tbvpaZqpp6wz7bpvishFWPF/VkKk+1Itek2sydFh5Yy6W+dTG2D6WPp8+Hvc21
+njpCRazHEWYR+vBR2Y0191FjbluYr2ZJhTdo/X0zcgd3lLGWf9qcoLAawCFJZYcjOa3TBeQHGCo2U05xCsouJz6jAYic/kECJuPVGn0dW165xBOWcWhVwU1v
+1eh6y7p2b/Nyki4IgF9J0rViqzY5j03KGYM3uH+6stLzijZ6zMFF/FHZ0a+ko86C1eIPlXtN7HmwTVciyI3e8A3welSJy/ukVF5cf9Ztq1kY8CIsURwN+9+L
+QFEXeIvfPLCeTeNjT3r/FoiNg5HvG5XapUE4Sq1DbmgqCaWEXoZQ8RBBYFwZhDInXxYjuO3O4K2vwK81fRSGgu9iwjoeF08/BOq1wAMm7fObR53EWulSt1sW
+bD19pcheK31KTOsdo3w+2gE8i0tKcEbqyVxFoyDGiVoGbN6HjlZuD2F+vxNSv2WRH6gasn4CT9qDYlx1evyGctftqpO2kjSYmsytS3/ONXdr3tpgqBMxX4Ew
+v8xgzOO2pdyQPEg9LtpWR8jqOniiag8Z8amwjk+1b1DNdlNICumtSXTlpfcnDWhtY+pzYrHMHc2sImJSriRSfX2gMduMk1UoPnsX7/DxvcjV3F2XEbHzSuq5
+rNuVQ//YYWzu3pGVAMq3tO38zlqnOv6Yj2v09Upmk1DmjmWEuJprXVamF64tAxZiiuVKZy+NIZJvfFBQMxqyhiPRe+lNE8/dGCCzWmx5jb05WrXZAIikZ9jb
+F767zZOdXaWD6LvpDyqvZlNjMw2O/x9v//4l23KVB6IRK1VsocM5h6SUKoQAaZEkhSyQUiRJ6XWkiSQkgXhIQgKEAIEsJGQeNmBjAzKevOzmZcDY7na7b9vt
+2933dt9x77jjjv6hf4w/bOeqO2K+Ys5YK7OqDrRz712VlZVVe0WsiBnz8c3vUx5sS5lZV7CR8Zr3zgVUyUSP0ihdX7ulxbTquJEWUkmrohREWtgAUIi+HfRE
+ZwxGkaF1j2AoBJDD4jkIUrMTz1VYdZGaHkXWPsu8xVqDnvRsZy174c4MJYKo06JyeBHyovzXJjn0QNlc6q7kt6NUz3sujFkRXc5GGvN9avK5SDlHJVk9U1Fv
+5zz3TX+HcD8YQ+Jo6uGhAumzMzQkOWLqBLnzRaYr8rUuhi48AadSZDiCbbRYTqo0k7GDeJ3cKVbustsXJfScn+FV78KaVerODd5HbRf9mFR2U7ifi6K6yr/P
+PqJOgdbNqjXauX6mq3iI1C5pOJVVQiSAu6oV5FKgsXZzF3Wpz4Dtx4m+abb2nJTulGhnrJLn4u0xBy8477fa2TDmO6EYtbNFqztGxju60Spr6HVzc90I6xgk
++uQVXjMvBXV4k1zg5AZW+GNB36BUilgDiV2tDqK1Ho1Oa/7mA7bPP2MVn0Ao3JKSs0y7c5CEJmmv4Ood3+dNXvR0aXGbuwuoDbf1qwITJ5w0pFHaSej2MWNH
+StEe7FKwFADJVb2hw1++7HrpGwbqBwXZVvuVCQXVVxRWBxvo7tI4b5vmWZNIm1eI6rfaIbCbw+IlEzufDC9GooWjVMjF1uMWHjM9eiCLJcjIJypi8LdvOt7f
+V2SePhd0MOvJqRBAgyLsmeV/cyem8W4pl7vVXC4dIGYs8tGqh+SRiAfytixcdwYbQ+bJIrGWuoqKzgl7Y9lxSQcWXoSsjbbS90CRdAQ2hHzvgJKGqGdSxGyk
+1xrnhscm1XUVfM+DYpM2UTzu0OQ2aJs0I7FX/QJDFYBAXIXrvpQCuAJUQMG9yBDIEEqANhvNyimpacACglHW6/d3u2GUP5U+z+fglaZx5W4bqxbfwrXP3Msd
+fFs+tH1jRbe67i2ZuVaDSXecz879TkQDM1pfpJoL0w0oCjejDWJ7A4F/TjN5DdReve+JvvBJ/QwtZmJRU9Toaen8Y8QkK+P+Y9aaj9htBuRs1GU4zAlljgvO
+BAWfW4UOm3zsHE16fWzcoVrZcN7BfdLKvzyMeAVk2lhdV7JoCU4FORbTgoe5DMg/xS8V97ojpX6ODnyajVm0+Nxaq7tr1PleOUU/GXtiWrR+JbxREoatt3Pc
+8J3nMV6IP2cV+FwUToDAOYnC34TJla3vO8ztCXKiA6W+LbbVoYq7tjgUIl/BN0quVDsta5XEzlcJswWfOE8czes+EqpZnVPuPTKOC5lkQXLaCjdoMRjzX/Fh
+ysML1k7uY0Y5MAiQm5f2QFO9+bIwV/72Agpp3aqUDRWuu1xb4003M1SDVIZ8xRAFIosVLbTtztINowmrBwQTBDH1UJ8UDme1BjXcAmqC7rBNxFLfdgKQBKk8
+TSjUfCfJI3PEWQxs8rpO+7giH5tmtOUMG1LLU6NZW8vWU0EbY/BWAqntufLZShsH93yIuNIxxJoZtoxFF0JzKQ0HLZSA1tPEmyQ/gqwyL5j6heCcubAUa2tA
+rSBgOn0gurVFeqM1r/oOyat+SHikf9Iyyw63sloCXWsug6wCLZQ6gzf2jF2MTB932lENDGHpHC8wGIf0R0xJ0g8IAJypO6XoKbTMBAhXJxbhIC5d7aTFEB+n
+6Kl6UqoSsT8e9OAYZyDYqGZ8EXp+rVpRo0nLgAJ2ZiHQIiKdukQVsZ3PiCuWcwh11Ybq6+CtGqZed7qcSXisObQ8eswYOFt4NpPuswUXbaHEwv15xp2m76La
+Pte/KR4+c56ZON9664ckh9kiAchK/OX3ZhP96s80rH+KkhUUZTLiUx3oRpaFI60YE4U7zox1Ro1Bx7/zLH2v1Px+VHgDvxBG6+VXQx2hG/7++KjR565xZoVS
++6Dygox8wjjwaqiKR7BL3yq2YeMJlkZdulrnrfjAHBdXO16tuDJgioZgQ9Ix/UvbtdeNn02AnkfVyrjjza7kE5JvdF9HvB3dR8IEQ2vZdSDQQjkMkA08lSHJ
+U1SaAME9KMynPoZI4Iaqvqjy2y1XVABSmtVhXia7/e2GdFLWVGX+ap2qC5i8xsa3Doz4AkIbN5mnzOWaW3rV96o0/xd9NzmKkwf1vhckhhap/mdh7/aF0+Sa
+zjUusFJKwUxptgJQpoINzAaNIqZ+AFjELir+3c9PqCifwy1KPr7Nk4rcq2Owd/O1shRaTJ6dbbg3qQgEiRd4njSRkDT1cqYXv80MgMTh7FCXknVS7KxvWuus
+LPB2syN1RpwVoayTS6KOAR8TOZmrk+itCB/z29Zq1vgC9/NaK7cS6K7huZBjoqVgREcETqVAwxXrHnlu/N3YxHDd0sAMQe/vO0Sjn3fLD6UflSxzGrXTQD5Y
+w1mDiK9rafJcU8fYBOTryIPtVH10TRGxbbcYQQdcOCLkGuzgEDAKhcRhoeGDqUWcOmnH8RhRPuzxkDrKKJli3vxjX4zlZq1Zu9YSMuYcG2Dn+SzUD3q3J4h3
+i1JycWU89ZwBl12fHEgAXf1JcY5LGIoflwghmR631Fp9PkFyycfbfvSaJrvxYitHY4Ww/OLYeISkBDuvJ6CHUxSQ41N5WxkJVnQeCugb9BEmIkw2z0fXd9v8
+fvUHDTfI7mB9OmMl2mivrYpnP5sXTwpjpO0Wc3o5y2FfsNWLEhQozxPbK/17gvKaVEAol7tayMsd1pqZUW6F96fWRSSH3qOur2MPTuPLc1DVWoFlZnqxXgY7
+3zlcX0EIBXRCYzNrvES4dLTROX+flCF9IEdvXkpXbKcD8urNa/yV6HqI30icS4x//H7hYqQoJvi80nd0Ho8fMuJCr59Z6PawXz8T1WXJD2w8KIwlMUtPH+M7
+TaFOB9a/QcJacrgLQPsVnlKmPHKW2gRmyAmtR+alThuH2bx83Z3yWMrh6WiJ6PrH420sE55vWqQj344tra0PtIfqPeV8932ixqU+bmvcpXKGi1boqQAM7DqX
+h1sRpYTudTe4EqBVgO8PmM1WI2LSmmrNj30vnVqvWcHz2vLddlyJHjmUiREyIbvDliprasvFqoNpRDZi92ChYnVzEPSbrfW3nO2p9t12vd3WnlZW8P3j9Gfp
+L+fYX7NfZ7FFVw5bxMiLKg6kzK3NmFOeZ6GXhfEFzxb0l0ZfUodTWzNGn3mypGemgz5DgxbwllGiQsSAsGtVdwc+WOrv7mFJVrbumF/pO0kL98F3WhlvCmcd
+/wmpwf++y544aYGzqprsHuwupuuPnoqyS8Yv8VasUNo1PYsIQgEHwV5MzEseHhqrBRpJhz2wUT/GtHzDksna7lkufNzOFsujrT/jmVeWvG7NwFwL15Ap8c3Z
+66KmhyZiOj+bviigDSHO1xaNOnQFOz8FnFuj/Lb5YzpWkeyFec/FC1Jb0D3a9d3oaB3DhzGLLvILBY90eyQ4JBdjolB7xP+J0UIoPAkztiEAKOVUQvbCtd9L
+6kJFz4x0yFxR3rJSeZENCb0Gtme1AOvUkBh9KxZccfbbTSMHdqCxOcjey95Eio9x03DNe1O3pvUvk8C7ojg7ThCdSQbGHgx9o/7L6o9i/Uzf4TmKFr1zY5Sr
+7HULdr3l5Bc7CHOQrdmauIACmNaN00abrD06bNtou6lgxzO10Doormnpc/D3dKNF4JTuf1K5b4BZmbqOdKhvoRwWbT3WaZvoLawaU19ELpR3Nd8WmzDbxPc7
+G/FZ3jc717uyOneyN8Z2Cuq6ALUlujxM1jqvlk54AQYXATQxyjJbIQKbeVg65umIuU/eVNRP4uaklF6/sC6+gzr3dJd8yinlBlyIR7VntgxCNEw0DHm+TzxV
+c26YME5vnYW/5xniveUWTmipCk0GmnTfHAmfY6PHVE6ikEkBwtQ2kUh+5sdh4p85xT6uiLv9JEFcY23bOK62Vs9QGRJzl2uya1tzxL4AcAkq6JeDPRB4BaF4
+PIM4M/dKb0Y/BWWyqhTYKUuuJInpBJLwORGPx0r+iDtxqm1p6t9H1lb3UmTqrvjkR3fmmFL5chZkFQrBnOrIqd7JZLm3wnh8HxvMAmNAFriXrQSZu6VKaS0o
+BpCwAlKJAXPJhZdMvz76XfQPWlZYJ2ITUujCQ6/kw0dbI0ZBfPAwNPoVm1uZjmuXcNbc54rvstx265uoH5GAU7W4WQcMdEQNiscfnLCL5M2ZoWPCAvVn84y9
+/qRN7TKT0NdOOHb8YckAu9kYm6I/Hy/SI7gzuj+hht06Yo/R3Fv+yUPoz1joENUAWlc6x9CrpMMUGoYCXO5sxTKTDQabPBXK4K/ppGE6EMXl9wmGAefz8YJ1
+cfxc+pVFFHb0SffNQTuzSbbHOeOJZgk3BxVwOhgFvNs+p/kppB6bexQjRlHLKwf1BO5oVoRtoyCznaPxUvLkzLogUWHYN908NRyJVhs5c9706er59I/b7tpx
+Q9qS8ux6u1B78xqzOXhz58+pneDxBIRyKlCczixHlchmBLlrV1jbFs+piWcvo3xNhKJOanbwvK6gErNarFx6BA487lWu6M/vJC6ilrtRu20d+mI+fNvEYtrG
+4XHq2bWZd0/sj1yPmCV13ppbpU8dIWLZnKeluWebk9PhVC9drmdqoFGYQDonZnlqRvYkY3SNrRNu6txeZUR8jB7JMxy5840mSqIHbnzcBAXe7T7E57Msdhao
+yqhHm0yDYhaYYwR1fzYNnxZA0gFP+S7gBULvwOb20NtL3Hh65EEBiHUL9okZq+DXiFWp5PTa3BoCnKOoFk/mxzbuez4cLiXWwBEAi5D9Ez/FQgs/vzAAZFxw
+1VRAYamTP2M7ohwm/DuFpav186sCpiFxmLzIZIr2jqFa+YWlYHeuXYzeYBHRrWJcpQMQ6yVSeao+y0WS2L5hbABV1dVGoqxqjQzrQx8XdH1jKsIhIcPcn60V
+uveJKqJUaRXFLIUHy0rL8buRfPeejx3hcucU0o6clTW3r3Oae90QfA3kQPW3oaiqCgL3rchVwkD2lGLjXE6y6T2qQ4r9AFCAAayUERLBNlwBwglX6TlXr+t/
+MqT5efyK4yn/PGdNYtfzdt8csm3rDow9c849WTCZ3Q64thylb33mQRUPWOJsgOT9g2EEeuEEwT8JTZJT6IuaeSYJuxz/NUXDygdbV4HTzL3IWugZC5tgTegi
+HOdv9P2JbB0DGaW3EquQNa2fJpkzbF0u9rJgHKX/VEgqsyr44QJJoT2ZAHv13NgpZOyVQ2rGxNvQH3QR0VfSb1qGvGVsXctMC/08h3CfyKU/BicdQ0ykeYqd
+Klx6h5hPGdUqlW5FnBSqjMX1wPPTIt0p7UAeChDzy32auL6oXqDAA1G0memhcqRLdrl9tsmc0Y90sWXN333MIoeGHdGOZgcwl9rT2LOzBVPd8hH99pw1p6Mk
+bnVxWT9aM9RANQS/Ka1RHVV6y2SmCjihI+cJh6Z1b5O1r/Wz1q9pkfXh7FG0gCk4E09fLyMKbrvQOhxM5+AEekSxhrfF1OF8mkUEi/3NGYxQKHJUfE16XXrJ
+xQMfdz05jCFQQtixJ3FepNAiPkjbZs5QZQuoXc5BOlGNcXzVuJSYS550D3RYUE6JWeEsxQ/CS5ghiL4ocUGxfkYOty2gRvo749l62Vhy+y7ouaJR6Cg4X8NY
+j+vcJfVDh5bkbM+VMSYKVAqUCQqUdiq5vFsqoF6Z3W9NSU1Ugfa2Ypa//tGQUQl9iOvWh6gykiLNrmzBaxHLcaY3dsNfxx4kyavQrafqC9GutCxkoYulZBl9
+sr4j1LosCg906MGU0tVzlKRlqz3rm6RLKWPgjnupi+eUXbOeNT/Zaj25nS7VDNz2cFxvK0x/vWvlVtjddr0LvGPbfZ3EKyWJFs7KqWBp/osZRvXT5F5zKr+1
+mFB+MXsOWGcfu4Q2FOS9IdZy4gTuKum56/sctUPjF0Qfkjo0WkcA+3NkEtZN71oYu2IeUhT8mwFtIstZ0278kmQN+A03oQ1nRdgynR8jQkTwaER5TAkAZQIB
+irRCTsWlVwCgTMVk47LXZ6//CQrtxakeqHrYyA8yLitieG7SewXJwna05/ESJE/sZhw9X8q6NXq3dJz4H9vGsOj/7qzLkWaqdY5G7r4pAVh741DMS5EA/qQd
+0AhwAnpJqyOC45NsS+nk/gUMU2ZnsP4TREh0ZGK8VE8gn/uXDJRuuyA7Kk9ZUXTTYCPjUfrj6Kmji5Wa/aj7sjfYwSumWLk5bnWtEdZTEnkqGiD0v8i5lsh8
+PrHcgsyK2DG29KF5dOa6eRpgWaMAqecxbVW1z7reWdVtdbwKXA8xKqX2rzUQunWkAmL7ntRe49XeBz5sNKzw4UUEiWChYmJoKBckdUOPiFWbkk6gHPnKithE
+l/2DSNiQfhIQu9YS5wfncAbyV8H+fyD4hZ5b/rcFSbg9qpFb9BFlVimtvCWvcLT1Ghgo555CX/L3aQzgfG44BDAn4zI+WQurR99q9f/eSFVzx2LMkyeeQoaO
+ljIUOTGWReccZ4xp4uhWa5u1k9XnOprotqkMjC4PIudG9K/Fw7Cy+fa41sWohtA52G7aKP2xQjkWaqajyD4qfQCVhd8BOI2Bvrbp9A/b0pvc2uMME2QTbg8K
+iS4r9LoZZjvUOIUnaTzyJNTBb7SXbzNaX17MHOuB0UIQfaa+x+54QapS6+RSh7HGrUTKR8V3IqCAPScte7mwtGBhDvMzbV0Le3HGA9LQcI2Dq/ldB+9a7t30
+iMDhKJW8WWJ9NAb48Zh7rrixi94lBLs0LcIXSK+Inbdm8BNCTm1WFJ4CWfdvnJ4ZTBJx1hvFUUj1vJo9apHqMs499PXlR7ipu6XcuaIqHsa7n4naPQhL7Eru
+xSW9TYkeaqhlmRz6bG4aTpbZI6wP+qAmxkk8C3WiILFsK3mnobnsy9CkWAXGYgcUZcB5qQTICc3HgHakQbXEIsEEcBJWEYATfxaBvM5EdfYYcZj7A9eGpb1L
+HxA2jXp2/TPRcP+zxSwjoWzka804Rh2U0Q79cf7jXeKjX0bMXJ9JrXxz5XyIVRE5N9NLkaKbFavaYW/LYgLHP5rrsZbZK6tvnNKC3cEzz/s3+cXGv5SuocVQ
+jg/93ZbRbqwlxlapfaeH/bJ/sDcuy7HjtJxnjJwnsHOsd/VjyBsVQcBZ23HxrSf3SUsa2n4jsYsusXwmVaR7s3T9N43L46Ppk9J782VaaWmn9Rz54GkIeMzz
+7ouQrpX9dxbqdNtVOGhOABEmdo6W9LBWCbXhUKrHpptktR4jpS2xbWPRHDWt/4zd3GjkV2dIq3ycS6MIRh3w25hhDpxIt2f4PbXCwctBTTOP3PUdrdCRIZWl
+JuVM0yRnFZSyMFwr9JRQ4/N2uMW7XAGv3kztP/vhDpljWYJmhiURkB2cfesOJKW/k9f5dh+zsiquxzVzdOS5ApyS+tR1d2OWpoiEfjWa1QvkzldZ4dzXS88A
+62sTnVI0f9KrRw+GOza/LzN+p/QEYFZCOYnmHHUxuvcUScwtYEh/nvhwfkMx6D5eGIXhzOHJ61drjz2RuVPaiw1pNynh/+YQVCfiNqvbdiNUwmfo7cuUpN6j
++P0WGmjYzzuIHUVmhT4VHABw6mnv3WOC4n4VqodeoO8L1BeHBZu0ctxRdb39q/Tv0n9M/1P6XzQG3grJpAq+sjB2eK0T2TzoLPNk7X3NSH3xnrlMeDLInRw3
+u4P/D67CV76V7kpJxT3EkCe1RRxl5ncJzs4INdAjgcDgZScVmcX6lzLEqpDPby9FlyR9ndC58SdVOHREBkqd5Lkw2XfvK72cjeDV/MdkAWXedbuP67Pzur4N
+FUyxi+txfbvU76bN24e9n//6GywDtnOtQFRsySlH94B63GmAkKmeQJ47TjLHiFkjbcEKSydzsQUKk5/G3Lkcz4to25lBldYOucPo82FNi5H7ehszQo2sf4MY
+ojD9UesOax9aot6jKBZVVFfqeDSWcVfF8y1EHph/a03Bd21S6aH0YB7oW6xKnIvS3ic6iBL9DAlaw4CgfxRXM5lSvta7rEDsKqoK0EfNFYGbu9YP+t1dJaf6
+bml0MVQPL1LvhC0uMy0udqe0XP/Oe2pT743whl2lU6E2MY0HtMJD11+niYE1TQ4mHM+leE8kGlT+qtON0a5CZWWOXWYtWnJrJxLIxR5DSSfUchZ7JJ2nNkqD
+pRAtuVgJi+M0QGAcQeMkL5lxsDYrqaDy7WXs06QdsmBKVEaiNRHAfHXibYaCvmlU2/iRoLVh8fX20fXeM13kRMfETXTec1UundBRDo9rJxeNJBbGAvPuw6m6
+jKj2AXVWQh3EwZ4CRHxA9WVfcVjQ5FJOqmXouuS2l6EVx13w9Pmj4tjXkci0HqQrOMEKJjarq4KoXofaSSEwkSXFZQYaPPMWnjBUGaQmdj43BSxrnVWRCRSC
+kl7s/LQ6L4wxdjmZLi2nvv2+8X8fOsjOviW0YiamkfP1GWIF4q6EuuY+uXxdUTdMojqJaCj90rQtCkOvE9V4HGydPiFw9dDKjBYNKHdRkv+j8FE5w5S8kLZS
+Nf+kdi/PtC/DKpDycBf/yVLYr2WBmM9rUrpugUyw4u5iIGemFFFJk4WgdLBy3UXtZpdkyQFyjwC55CRKIS1ETiFnWTt59VzxnRoaCbuRtuh3XFC2aPf/nC0J
+54ycMSjFcmNf8LoW2iwazUfSqMc9ip3XfSP3TL1Fv+fycM0+MJbtj9OfpL8QPqN9NAgMbNSj5mAhi+ZO2nz5flErV7U2Qh8azfLbI5HWHA+uCjXEpoqiRPKM
+k5dMCudQJBUF2BCig2UOBhBRyeQ5LXWBlAsZJijZV518JhwQodTfDBA5EXVFqQdd5/Wv+XSirF3I80ofgv2Le4z/baVu3Krwh6NWZ0b67HOh8zQfo0HYFMUi
+H5bZodyKBxrLTXXvrQCQSgjC0u9SMQpwMtzG2QnVntXi6jRLb647WfYufXven+c78d/n2JRcb9EiwkFmdJmFSnvtN4f9taiz5qMQ6jBOd9sU7K/Crg6QBjrB
+RIvY6UAiYJYQkDj7yYkGmBoCO8ii0w+VBQoqmpWJG5aKvIEKYO5Gpo6nU/GrymLPCNZ0Jaun2bbtMaB7dXkG/1IrgY3Bk96XLzlVuvZa52upu5clfUpWFl9E
+65dhTuPS63NZjhTRo6IX0sUYIHb5jHU0XytD0P+v68x3jSdZR9V47ddhg1JOQjocDWzlNvW+q4+OVuPZblYdD3wNNFYJABsXHQ0RkVcVgEiAwCR9MJnRZVro
+za4VFqpddIkFezjq52y6uHO+MmaAfneIvixz3itkLORDm0vZoOCHMfYC+zXTzkqaGgz3vkuRhmzwfReVtchsJoQRcqWaSpmaYnCXV809Hlw1t2/TRyl39evG
+PvHHflaseOX86oPXdgv2qPe/l76v35ttLT+Hbt5WCMWag07iTA2hu9VwaPaYFQFbIdDM++QWEVxyzBfKNOD6bWO+RHUAf4iYtL+a/iD9N+nP01+lf5/+h/Rf
+fGzX8qhxP1HMf1z5Dg0Xs4jb7gKajcshhu9rcU0rZ4djdtmVu83h+IxYG0Mf92F/JLYaDepLwQyEKqcvyuDMDB0LqP38ucXGq6RFw1KwOfk5waz3MOP5MtkC
+1mYCYYnXLAxiqMm+0HX6qzc8iwGCn8JIwfAl5675XYe1Dw41We0/x1iAH5KsBOTVWZ9OaLFhZgpH/TjHz+tTa1iu+ORSomwlzvvaVunzS1isrIz9smC6bPO+
+bc1Niwz3za3TZaVt7fvj2Moma+7svZYc9J25FGNQBc7Ui4xFJXllchR0DIhcxwaTaFaVHCzMf58UamUesIThkvT0y6ZAI7cGRzciaWVdkwhLnI6NAeALhiSS
+jOfj/N8Dw2j0c/gn6JGlYiQv0tWSx5EjG0vweJE2Bf1pIqU4la4SKbwthua/TxKfL7m7xXNOWW0kHCpncoFBE7vOZ/Nn20z+afqr9G+Nd7b9Wy8m5A/cQd56
+tQR042eWsxtbUdy8wLKwOwjjOpXtVCO9oXuHsmSMsHM/ClgX3yC0rMroMQlAc8F906Ive7rZ+tzam2uNJHuar0BN2cCys3it+sO6SvnM+bfpv9MT57K1M8hq
+ZItqx/4oKLjGcXBs5T4xHK7vZrvoFm1D88EKe1C45g3bqmt5RHsIZzlktgT070SBdEFeyq35f34T25nf0zkuHu+OFCye8c/cKVM9p68SwuUv09+k/5ZnfHQR
+ycj2kvhdWT9p9JFwTFCY86iR8V3EKLYeiP3G+BSzMPL4X7Gfx8nC6S487jixfg7DsP1EIdEvUTqiwbMhF6gzrHMy1Sfcr4kOoSmJ0kbr1B/1GKhJ6O8pGWOA
+me/GYfca1x/xzdS1zUgiRvdwEmFU6YH1YZ2t6Fb/x3sWuqCYPyfv9AkLPt3zUxmE5z+lK/n/gP6/txBacuF/3IwGI6W+sqX/9aTy/FMJ/+3Ayw1WiT7yf/xa
+46V8Kb3eNNtq93L/vzOxQfsfX8n8b+EanDBEuIIVPE+vSVNqGzAnnMcIyi/AV/I9jm1LYgTmFdsc6Lo2ngrQPjdm60js19oDpCXAKhCMIl6Sv8WWxcpIbypx
+GxMgqfqpSSoKTSsWqL/mG0nrvOKH0i5e/Y2nY95b00icaptYjbmYJ29K5k5MLmFbVpwGTiuZ3GduLevdrXM6X19OV96tslW7kKX1ZkoYCP5Y0VWXPJr4hOyX
+Cn4Y0msMU/Oi7rOr2klnm+suztfK3T+2KVyApmtB/Y8zPkcoNjPcuuJ6e15Km/QmQySzyhSr6JCuuBMZWqsCtS4luZRKMXsT5GtNWqBNEsNsV0p9PCSYANq9
+RH+sqr4AXTQ0imhmKpWhoJlFtmMp7N+6zvr9u7TSmBo2H9bbm9qAdW6ZFVlVC8uMN/LzsgKgD4h9ntHv4b9HLOXvMWb7T1EujXruFvbzzcJ+jkQgQbzfSZmN
+3Q7fubWCE+jgEJd2eZMzk6Yyvhfl8t43A0D7XzrGQYzAa5wNeCfVTBZ2nLaCbYyXabtWytszViA3EioKTXtDn+vfEw0TCnVbF9LXzdjOmiLXtUlbqgv3V3Zj
+yuv1YsgijEvXUnJhR5/6VUu3+VkkvX51nyZQe9TO1vcIAw//7zOdYOKKtDSg/aW04VHXyzVll/2FaXt0KZIRLXypZjC5c7ZGdJCLaDpkFuPhMyAxBzmAnct1
+f92l9xq/du8JROHyg6OpsH6tPbeELphQFlgpoJhWpaebSmmuu/kOiqKBSQXoyYEcyvzsqTn+xpGfsnDxjGu+uZs5NNq1n6xkAzqLd23aCFtNQ6dsXDnuwWuy
+2TmhABeNg+AeT8ZGimrkWE4h7KE6/2+jisWiH+aY/mUMF9yy+yQiS9zRYHknnWIaTikSXNU1km1uvzacpW+VNSGd0osekyzejTaRcWV3fWidQetr07dU4Qk2
+ezkebAAZjG5I1QTEu/bXX0606bRJNqfCeK0EUxkkB6Lazv5MfGtgZ1btqJ9dslqaAVxLo6aI8nLzzaIBbwrOu+58bHatmM9OA6oL225MJLiyNzeTBwBlAii4
+VBTT9Big5OqL6crdyrpqLFY/bqwtv0p7hlIjKnpvRftba4hzDoFG842x6mrBJWVdsexm48r1CFjaqAD4PZR9USv33io3C05gWwzRAeAnT5ZRlPoEVXUN+TWH
+zwWKN19Om/Q+Wd2EbLhqTd2UM5FREXJ7q9yuW6V85jkTgb4qPSflHJ6tHIxJTiD8POI2TsFKsG9UjCh7crAPKACIK2auQe23xFMyr6lhfb7BjW/uo1RGuI9L
+5xF11iz4J7mjHw8S7+KZ6HZ+thcK3w316W1VG/7AmaGrRQdlII28endKEHkHgOKCSdoJyhrgHRRzXtoDDWlYBPyQoI8Nqs/4XtMv5g40OiQOznw1IE9Lma1H
+VmOKR8UqoFCrsw6NtFvDw9KfE5AZFokw8ZtRY/pcysmfEYMmx1L6muB3KtZv6d7tqnMltVjRDJyfgI1U1bJ+x+0rufPGfPg4IHeULsjC1QeLXfZeZGbsVcio
+Qqta3Ju7ZjESpE36dlqpFCORkDKd5nqSXIiVGPdRUwMobAEtXqK/cGKg9CxqSlbXrf4iZyaY2/f9sxhjJ2fxxg7g1YKe6O1yrsTgo05DtHShR+bEJp3JyagP
+SlvLPE/P0huE10Vmi5kHuvm5oXWsnHkxWkCbpNJ6+WVXreB5cfPE+Sxgeg25lPSim7NvCFfzPU2/dLcc+YwRiWzzVrMtd5ZTzssOuUWayZ8OpuiB3YTaqpNP
+hVcBsC5e76+/JPos/ZWP7s+2d+IXY5hBM4KWHYUpXhuU+oc99VPKyZZjt9/f3XTkt5vuurJKRccL4v1/sKZ/xeKNZ3Y3LcyQFNZjCprHAnJCn0DBhJN0XWWb
+YJ/Prf7j9xmHPFcrlP3ht2yGtzbDF8jSzvKnjWfcseZmNvpRcsUAoIgOALcoRsOEwN0B0Sk7FVDtPAUL6D20R1l82rlo9lT9bM5rNDz3cvzFmTNFcdGftZy1
+xrbaRGAW1mICir1I7HpSm8hd7ir/EqMwBBGRLzxVMgCJWl4n1w6CBfkGU7qhDCAZnKBjP+bDUcdTr/owrscY1q44VKV9nHI5tUOTdiqUSVKYvMNhhSKuOiWE
+zGLrGlLZGgTCcvosGOuU/1D6hLFguZ5O5SgUF6iLDFU/i4TK51Lz6uaqO+TaO8FCXnTj0o7DkJqpKy1l5cowmZBSxOk1d29WZ9F6a7Md35d+oPm1rJjFPWRd
+s9N277KRxD95EJoLwTosHbhtlQ1uXYt4ZMmiAAM+vpOaR05QUHqSsuROW4Ynns1yR18b+lTrnXQK2dyUqvkJS01syG8Icb5fgHz5wY1bEeROCG2kJI7zUB8B
+Cg/DZypK64OXRYnqwnkfVHvamYGEc7ESMJsp3LeWvsZ8zxnzbeuuCjDOIIhNCs8rU8HOnZ9KN0gjDasMlZljpzfkBACwSjAgJ5iytKcaEKCeo8UHMyx8XRh3
+rm6MrwHxucaIzKYa0Z9qS0K/TBXkDjuWW/GKG8dzLmyQ/I02+uSRC5MJvpngLyDWfxPTziIs5M6+WzSpF7I3OpZmYUz267hiF+1MFgdAG8EhA1kBBXlbKsTC
+J6HFLRMDTRPO+4bebH3e7xFED/efWYeM6I2LkHa7aPK+11deeMwCIgt3azgo3705rBUR+oxsqyaAxs3Bu6ET5sbxCM4I2BotnkmeXkFJGuibjagDAKa6a935
+60/pLEY3W7+Jw/e8Pr05fYfTp+V5UYaq4PCt6SwTzRZLIjfWqjUDIVoMLb3LJnPjnTATNrlPNLhM/FJFKUXNCZEBsCFS4i7upctFMiOJk9GF9qSNvyFZoKul
+vMHpl8iq3TqPQzngGbi0b5y9Etsfx33gv2xLWH2spG65BfOZQMmly0fygQ5YTBoAJ87ayD7z9b1bqjRwF37azTMXIoCgaTvmV1u3oII73Ojj0q7jS68XhijZ
+JuOjRHc6iZRBEf5kjinomsXotz7aveRdqufhMcO/TFpw1kdr6FhVc13KXEsVoR7FZ2Ip25I7dX7FS2xJG/64PbR8bMhiCB9HOBCK+Cs0MeoT84LVhGXXeEtv
+IHmMMgOMKD7Bed6dP93wVj4+r/e/xeatrtKsbXPoKHHQnLqQKt8txOwxUpdUgjhuk2YRkoO86do1oom6ToZWH4P6r/ddqu21DJQIytFltiEIXJBX7xuz3LnR
+EENqSMb97ezeMTMRDQMK+/ZFGIiBi1TyTYoIChSUYfDz5KjEWvYp1gTebnyRXzaNcx/HhYbAhXJXVj6/zpHxTsxFD0bNS6MNNtDAZBn2iL2jlbaKeYFSiiTs
+kFwYPmBbCRpywrLgxHxdyFV9p1R+W1zxaTpH9y15xVNjdX6dp7tOrvGG97HS6cimDYdlAeBlKWuwtLE3WQxLkKp7Vl8EUb4PNe52j5n+ZI7r4B6aO6qW9CdE
+lqy322ahZWsUmemFc4Hmk7YXFk3pl9J7NiBnP3rNKVYPml9r9Sc/TPNPPqVWG9z17o6jXW1IjJ5VmrKO3nHf5c6SDmSSgcTmeIXdqdyogt70kJ7jteoXA1j3
+LoZek3pWcz/er1BnzsKd2Nyx3ZPRHVrjbsxrHxXO3l4VGLKFGtfUDrbdZDFFi/cPJy6KT6W0HdjUjED9bLI4M5RUhjYJtoytoQwKP+fWJu3yslxds0Vvo31X
+LalGVYp7lfS9Dr/ZVs+ozz3O9sK1iE3U90gq8tY9d0EFAiK3pceqnkDzisXnOflXKbAE1Engx6AVixOvKetnpc+60l+cadOM6d3iAykXbe8LbbyzzsfJds1u
+OZte6U2g+HiU8V0J0ETTZ7PDMZRpEU1KIqto9JT0oJxKl4etnzBL+Y76swpxNjuJgsBnjytwGnvawcfsBryM12Ef0AHiOXFY94qYJc9pv+eIoqEAwpxxAChC
+EkvXrFTd96muf4PETm1ncxc/u4fPjXmSPafEJrf3Z77T8lIVOTTf23PRVW6gvZkJrSoB1dKOZZfG+qMk88eBf3NwAwrDn65eUrUInlrG4n1ztcDVWi2eF0+W
+BVnN3HW3KG105bwMiGBxT5zQPHWx81n9D4k/hrYoW6z/zlaBy15Xgf/sw5Ikm7t3QgtZRj628ZNHVz/mLhcgNcXirCRHgYx9qKNDDpUzjyybNUZzTx2jlXQJ
+Ip4gpAjcPawr0ekS7gK7zza6qeMSQaVevEp497dZha20EyuHYBgbeJD3iwtAMnAidZDWRU0aih5Eu69dYrSoUrL4i5NGcn3tZy0V8tA9sRXF+fVBCsKt63qp
+CllQG3GAnrfsp3SSPKfszGsSfdEXIr82+DNq9xhN6PNkzdc+SxcaRIh3Z6zfsleDy8Sg9ykID3tv2myDPMwdaqx65l822/edhi0SlekxMA2qyTPtR7UOe85K
+u112PIiz2aciWg5ezJ9Lehbt/eB0xCBHNzKFJQADDM20M+8voktnunPqa9OLhgJtWLR6uG73xywCwGsDyoV87/FwxcLZxh+TtYTnt0dC7ghnu2VgEYrqMifE
+Cq9uIHo2atyQ+7LCE7qjOKO4mhkyIODcz6jr71ulcuLPp08s5mtd4+VRExnXncjvldf17W5Qy9KK9c4tjGVhX+Xtq0PSxFLMGQwt0hNFX/OlcpnFsrjC9E3p
+26wyxFm/tDNttjY2GdcdiwUdlULo2rdnx/Gg8CnreMoEGcDUcSQukFuJLvthvWGm/FgPN62WQH2kb17gremrXDCrutYuptoj9nvpD6x3KYB8diGBNM4TSGvC
+Ro4uYr3raq9LfQgO/R+yLauCjO0NCC8x7DlxtlAOAdEEQgHUYN+7gA0t13qRp1mXTHslC3xs1kqTWioaQfLDqv/wBkLvs+6dqmqex100r4U/3h26FA6llbfd
+PjiDyoDlBA7AwKIOZoaVNK3hvdu6f+bWzRuEea7GlAuxsjSSB9Gk5q+sD+axLZJnhbEvnC+IA5sqvgtlKj3NVXBI0Z3r2ASSrA+q0WLp6OcYijcIo+0vXhzt
+I8WXlwbdpzpmwxW5ZS1A9aeryirPhruksezGnEEPpPR1IUfKJ1Gt8TCzR+0TTQHGfdjMXFGnb5Ntt+5oqNdO46aVP7RHxA0cJxhMbhJNswVlSFL8bcdXw07T
+U4CSQwfjKQEg4xZK07XhCisUiUK+xvlwHA9Th3qeKSXvZgrJ0afdBMFkr9CyXDcwgeTsZWWKw/jWATOLS/3Hx1eTRS5SRmA9NMkOBWlkX1JIX2f+xkuCt1Id
+Ts1A9lHX9UzKarWkXsW2OmhYyertwkTTrDoBFP7kg8YgWZXTTKnKyYT0nCIRq/pOUdn5DOE0jB+/y/bXlXyjuLF68hh4V4pWShU/SmIgkk3vDKp3owiaesdJ
+cXeVWtU/F3IKkY+rlBNY7rhw3VUFd1ldN8V8X0fIRu9mf0aJXIqCg+j3+BzXddpSdufvCxv3P3mUquSdV5R0B1NEFkSByTsnTXszI1ldYS8mGaM1gKYnKXk/
+tErlknqkwcA9nMDtIoNyy3/Z6Va933Feurm5EpKT62qiHK+OCVbNlKo0UmLKnWjaWK1KN8dy2YiZsrjwUMR7m7OeeHM3FV+yPql+vEgMm5hCq/uii6gmwzwN
+jWXWw39cTfta+tG8vtc/dIy9TrElvyqlllj+v4taLf4s5Cuczsu0tCwwPSYxk66vMqcHBFoMNy37SbZUyLW9z5jAXIZqVjt8nCTJtZMgeRZVR86COeYiJCo6
+4tVGvEkt54RHeNFYI32Pk3opvSN9dlal82N9UHpL39Desiy5tdKCQazS2dChF9zK0GDhXmZLKR2GFCagF9kqSzwEp6Sa8N5eNP+vV8AXhrN5ToUsAXtGx9Fx
+OY6BZWRRVis7Ka3VTBfy0FvU6CJCrSRhUdkHX3qfeK0ArxXPJ9zhiZ1OTXMUm4aWWld/KHkL2/Dlyk/9MWH+r3Y1/FlQwjjsZZ74j/OvX8njZuV1L2Ydzlmw
+0liKwq64pkY+H5cHoQYNp4CyVVJHKDH7l5uslfeb1M94u8O9qG75mYrhIvntgTifWrbGEz2xV+Wx9nUR+eyaRmrIqUJ2qBAAcAJXMcyYey8iPCyFUx8DqIsl
+y7/D539bejt5iB9JX0hfSf+oZQJmI36SlEx+hITMs2W1mHmJAhe1Y0AYaoP7AXOVGFjO//Zex2L3ykwaxnKVL9gcrtNbnOK9rp0FrvE5krFRjS+xiwtqeIlj
+vDjCC2IXv089eZ0OXpnFe4QSd9c6OnHd7GCxBPOJf59k+H7U964Ln6FTs2+EvexZq2plxI4x3oHYMb3Cy12oJjsrINqRepdwMqPPiUzh+dW0p+zv+k0ruxZ2
+JFPhTKcWP1YyAYiohGEKKu7j5dq18sHAFHqmgtUTsdLnnRyCy0Cz1fHggo0eyDIUCSTYHeAOQQWe0VHZBu3Lc6V5AGjVcgdEI6sZ7PvXBzuoXYGq8fBxz+5W
+Penb+fgb7eC4UQTaAjHqtbQceWo8H16q11x0Boqg5I30tV6/titIQihBAWl7zB2kgr3v+vfk2R2YBZXnRpC74OPPTcilvmy6ArtZp7Di6H+Wswqc/KWd/4pU
+7c7kUn1i1JKlkkRdan9aFZH7hdoEQcadOyf7FmH0XRwsRcbk5vLySXP/2emIXDCOukpughbbnYz9c0E383dFfeyvTZHlf1O22GWe4v5cyIxCqF+vd1Epig4c
+ZWYydX6hH5Oelxlhhs7eqPZJuMcaPNK/Kxdlo2odQFyFo9hs0rWE3FgrKuTSIeVzzymErILPbXKa80dO2tXcgSXl3c/FHVo+vQDQ5cZS5CO5Te9wPd7c41vX
+K6vn6B1L4qTO+UM3W7kf/D1Tq6Lty/XP7PuNZvdgK9PeSLTem5fn34o+Wo8uYv3KQMce1XuKJv07GhP6XCB3Mz4lnXh7yA8KQIPdruXp5b+hJOB6LV+QCsub
+0y69jX1hr5Ww5aLQJsvo1lLFrx+pgl837ioBQKm3V2l5fR6kQROq/UZWzbj3/rjWeb4lbdM759eQ5f9fOchaVuICoi+1KxkK5OQv5FQGqSuAT0HD5L3MAUqp
+tykFDuRvTN/cK1hSYOmigo3mlrK25yjooU81hG5kIsL0bR6sylHt7hszJy6tXIIAiBRiK3DK30itDtwnJt5ghn3LuMloS4HS6Ugo12gRLHMuos1R0gvhvrzJ
+aTTy/gv3R9tvpfZD4VANE9RKrjqxGX3e1g7W25VFH57ul1xtIaLTliTg+1ZAsnMEZc6WL3G2JOReX0eosjcSS8Sd1LaqVnKNlZn9P41Hy4bcdKr3PgSmSu/O
+JUFu6K1OEmvHNuKGyU63m8OOKdaJas8lGHjVvtMoTg0Tl7Fo5EruvxQAUSxrVl0rnFJOcJ9QxMdEvHMI7LeFfkyk84qkUfgDAIF+uSNMRAXcBILzpV5IN2kb
+7n/jw67Zhm41SKpoMx4bzoSDRr9CVk6maXQEDKPwjFPrIbtWRyJf2IUlU9iOroTad0K3gAS/D2hUQideXwiAhbvPdSsMQeNukr7l0qgE4wPl7qSUrhJIfFH3
+CDODi6LmSO6j2CzPGRxOI0FxrmfMwh25eo1DroUXd4X3qW6J+1Rb2OMVoiDAw0sI9QYzFISPmy5EzFy7KX30TeyGSqbvz+F1eqt1EX0sfSEw6teY+1+mP03/
+umk0tRpjNuTL1ulrUilupJngmbsRcmSjAlY8SUxdbljU4XDGuPRf10ryykCnci/hBFiXlPCkZSD9fy4CzR5T90TmESW1x9aUFiBTSfCWFnQiegd1KjPPp5kt
+xzm2Sp9reOi15iD2aqoW2mc22+XXNQ3ecWI02Me2x4qvFcMQWnD16VyQCGU1GiW10k3jvOHSfBfs3UP5TUGfB9KKIpPWWf87PCPXxw4Utjn045iNyyZiv3Hs
+NiIuqTimAzc07Fnr8yCEv2zhqw2qd7nAw49T4RkgSN+EyFMCJ98s7B/F5/5RE/yMs4WpwKoQWpyTR1BKxDMdTeOXGWZ/WTQ5yWtZWV14YYrmS0NA96sGsX7b
+oKEddYcLN23Mi20Ot3uDE42stbdqDASlxGVznyg8QdV34Hxmxib0IccXtSC0FAHO0PjKQZYC1MXexj9VOn2LGv1+RjSm/5JzQY+cJZmhnUCSfQI1/JDBLpwV
+1JKK/yn6HfXda8k5UhkbYTZx0kWPZUJJ+SDHd0M6lSEVtvetayEXnWdTilqcvHMx8wKAiKtRqxmX2iq9KR3EOxANLWM89yvkyCO9O45e2UGSzlsD19Vtl7ua
+zLi/Fjjaiq0MTwwiUh5BllJNqtCRBhOYpgCixSTF77YwPt6ez71hyonmN127cbIXpHoyXG/5/fTfCIvzf0z/Jf2vlllcWE1uATGeX1JmJGxrjBKHUIjaHPYy
+GY2VoTE12F7cd1vxYGCezU5iN0dyShNZluy7zIIYb4QibaroGh2zyefWN0i2FgTCllP90y+5+pA+s6kdEeicd9246PetX5ER91Qrf9Xf+PP0b7STeRl+v53n
+72c73J8MR71Dgh+wnmDlx5ufmdSxWr/l8sGS6gIExPt0YavxTixCwzjBJK/Qj8KQiJh4juV3s8PHKXoXZUg9y7HcVSyITh8J0iq9Pn1MlG2+TF27NZvyr4x/
+S0Va10R1ujL/qw3ef+EnUg/W7qjWyRpDgpWIYVUnYl2jxpFBDYdVoUBHne/Fx4TFJswra5VCFrOIICsCZngOQUYX/RSir6LIlMkXDLHOpf5KxRxqDPCR9OPp
+59Ovpa+mP0p/rhzEXExn55V3594pX7Pp3x+357+/2lvTT51Q94UI6KpIffhqJ9ZllM8Z75PR20OvHUBfts2mbZXNFeHnQyHTXys41hSstQz78gTIbSn2CZtG
+o1pf81vqvH2/01z5h05L6M8E3eZM2uX96+nsxLz6ct6Kk61iYKsRXAeW6Nrr73nRd5qkra/tczG3F3HJXiJ/c4BmBGUa7xsRWsa524fO59MDW157rt8bCjhs
+sBOpc5jXXfpy1G0xInAjFGmWSrfo7u98hsWBuZN2l45Ob3rsNCaYAIohZECVSTiJ/cQZHBpG+BtScjF7zTe+OeSUdSVyHGsrkIbnFs+V5o4P67mruD9TL2k1
+kcAcdYbAt/6VgsMmO3EWZrkX6BmohmxOJ5DaqHa+3adIUCG/ARnZCCvoFpnUUoqHHYdUsr2x194o9MzxQbyYPp4+t6gnVH3tf5P+ffrPPLeNarDb6/szZ0vn
+Xcsq9CV8mtuVnNHZcbkEkk+5j6OdQYSmvFIOl/rVSOlXMLrOtmgXgl+UKTT5ioyB+JObRltSiQ76nFTvPgflGLfHC/jlLHerlEXPnZ2D4mKc708/6bCDv/13
+YFEZMkLvbC1/e4NVHMwJarSMsoQf2vkDTAIHBxYEQPHmpb2RzMEjbCe9fHJGU2etUTxCffh+gRdodur6JA1Yv+t9hPvqZ+3Ayohdp/r6oJjzA+PRNlJS54KG
+PJPNLwXgS1MI7atCHielizMXSin/u7pcIQ0TOaG2rQsuPRdpVhc4LxhPXp3DD1NEVNca7/bfZ/9R+916Q/f4SbTEly2uNq3SRSa9cQ3zfMczaK9Jh5yzg/MZ
+1AWnQtFMQAxSicP6pO3TxXmDlT92ZBYLQsm6muW/SW7ePku1pWghU+6gZ3/b1dcwCv1ODWqUh04u7E5ygEXApuePbSiSh2cPhwHj3EopG3eWM1w8ttu7aA5Z
+bUQUUosJPte8Axg/o69d1vpOQ7q5ibkKcjdcv+xbmFYNaAUDAMCkmuyF//k8pQ1mlU44UAaC/6arhIY72lHd7Oj0G/cEppqnfmq+UXswCF5HVwSARGzifHKc
+ylTCNa0Q4D555OAAeDLUfdPCRdPHv3FMaTWf8eE4a37GtJIXROc89UIj/tqMK8a6kz4/4gRDKTC5GTzZFSbp28Y+CSMcN1On+oSIAfutCJY7x3T/2cf6vdE3
+a+Q0W6swe+IvDeZn7uxJrtgIIKR8ynqXC57rqSUzEBeWTsh5rwWto0gdXUOO5OU6jEPU1sb14dbdq5W0Z54gwwSlXXEGwBMghLXEpyYGqfUshpP+etvFfQof
+DL2YxtkddqBmOzthOIaPSraYBdVX7tKVbv2dCh1KKiigjf5gysYTmq9Wr5f/CX0ZTXVIhgQNVwAs0ocV1NsCn9wqfTOhI8F1RnKGb6/QHkfINsOZbg5vzH7k
+hKtyNykB65MyVhOjCJoVNtu4aNNjiR1HACWrQx9v2uuCPnvjt6qn9W/zWNYK9w9UgEJ4sAxkkMrJwahW1jR4EQq3LpIb21WrhV0FXf7PPQq7IRPSMzqAFRCs
+h61QNcfSAueCc6rZG2zist1m+/pFTfXbhSq3rek8W7zxNt6aWvhmbFi4JTzRcbsyulylXu7ucIGS5VQdAHGCICfAe9WyNUqBhuehWb53Rs9NfwbUMbeMnzHL
+7Y9h1N2Ic1dqpVEVhvNOaWntTqAsM7wtaSOfFiyNgyOE3l6PRpHrddTlDqH/wIYj7SDB5gu8nF86s+OGuVL0zDa+6GJgtt9vppOqMqd+wHGq+M6kB84i5ir1
+59FdqImwn3hlvu+qTKkYi7Scs0XL23Q8SbvFfaKWg8zD5Uh0mp9QmQtwIsZu3HmF+3HA7GPt1mz4cfN4DmISY+m+dtw+sKxWSVSOGQwEzdqHO8NwpwG9ITRv
+KC4oz02Egu+qZ6tWTXX1a0OttpGNAnp3Yjl7qxafXV/32myf0wBQBIZfprPrq4CAwe8TvZ8bgi0F3S219LWd/ao7Qq2Wzr5cz9bQOeN6kSB77bXq+7EM7Aqn
+CVYi8um2Ag2nODx3/dfk7+SNk2PELo8YT2+bwj7fPmJ/Z2+PeJ9f3N9qkAbscNazFQSQYr/N94gPpH13FRHzK9apKVcbsgjH7d7127S0n++4a9y68Qj1hmBn
+9SbqM2IKfJxnA1Qmy5y6Yp4q9xREHFT82Rwrgt1BigCAmL7OxWPPDDNY7+B3i+3j+OIR/uq5xqOV3pJz3usJVnBKRW4knPFfc3FtRcEv+tb0druXxnO53eR2
+UxQhQWV8Ku4HF/eyh8dSUSqni4zJE7jM5FQ8FkxDToDgkjfFYfzOeHzCCMOxX7PRz0wlwKvd+3ujis1rzxLhuwMFIuPHRqB4iVtl3922/afcssD85KKRh+3e
+8D9AIvaeyA32UFWnmAZOWwtd13lQtff9wTUzMIodOQoXU1LZ361ln7f9dmNsZ8V6XowI7xMUyCoPpD4qTKmPBKfE3Qv8RgRE7YOex4Avz3o23pRGy27wyqTu
+HQdUlWqEu3a65uWrH1vnnkQur+SGX2XLcjhuJbvBcEm2GcmNbPCOe05wQgdHVDVANO6yMM5W1BcDwjVBcHmT2J+iLB/mibPCbggmhVl2v45B5J3zv7UWUIt/
+tJUP+yuJL/nAlv6crHha8BuRNiYi70b6SK6VSlKuCsCEIbLMyYWV6E1qKaX18OrevDGryXp2zI0235103+IW3M2Oi1pdCiD1O2dY/bacENCN0i9loH2IRUHl
+sBRsoMrKJEQrCSEGrNVNiKzsNDekMGN8KyrIvn7Qe6cyAf1vkNlZmrhn8pJ/FZF2i57IJvjwN9Iv9T0O8/rDxjtVc7f/UCsrrprVYl/ejQLzrnvR5Ac4GFbl
+4dBGRd8ZfaGqFahG+easTHVK4LYpqhpAKEdNiV9HAGn6oTU9pL4iJUV+hKJGivv3TyHQ1uyWNvw67rF6z9+25JNuub9aMNL1XFnNkyW0xB9KmKh2dGlFSM1+
+TvCIIC77tAksJ06Cr6cWifnNf0w4l77cWDK3op0oGuDj3mq5XkP2UsRn+ejDPjz3J46jy8jJRTsD94IWOTNPULgwXI01b2Sk/Y0w8YQNiKcU4j3tV0JBCb0h
+9Mq1PMEPGP7/c6L2wj1Yv8/1C7nTpj642W5mxBtKzGyzcWZWosRVk+rO7lxTWor+uepxFhW1lwVbLDpG7RVQyXtlGZfHiZkHB3pahlTfWjJCy10Et/kUvopV
+kgH0BEQfQgcM77MFJaegsp2bzMrS+beVYvi1T9YvNLMdXBuKZvuabzoZO7BxGQPrcFjM7SvcxZTfJgUoaPLGn4ZFU+azHBU6oHj131osUWsVb+6yVBbbjtp2
+/og4UHNUq8RNamUoZcLFSDAhFEAuf66k9XrpoGgQPc/F8eHAwPIlQax/NaFprQjTSjMRM7W9zQPCAbZlRNJej3wTqBUP7qqLFbWEQbW57Nxn+6Qj1XZEWveg
+y5+cPlJJ4n6haktUYABnqgLPpaRnqEVR8tZ13+zqt3aq8r8kXI5pIY2+oQiMgXJ3Tgtj61Lxe/fhsOjaXyr0qNFAX7LU/igURlVwblKo+yQI1CYq2goAKF/w
+uluuABWn2QPpncaB/iPC+VYtLa3/rcvjKJ3/4TiqzplL4HPzGc/gmuLrJ2TnXVJANocBo1XAp0/NA0AuxnifAU68QhQDI1sOzyfmU3I92m90yntHmY8ftPlg
+/0ur538sJ7ES5BOIwmPdLp28Z3t5Q+Z1Qwmo5ofN0UX1Z1flVPiMVqGZ56XLxqKX+AQixpGYMCPtudgUnAGwnuncHJHREwFYV18rzmuqNpxAXW/rMxdd/fhy
+lBGAqobVp61FDk2XuZnRf7owg5pXmdyADj9Jj3alYf6BCX2sgfGgcMG/tYOE+sCz9AOGPPPVSN961tNJL9Ql+Z9QJh7H8NNGJak9CzEyIfEEJmsv0d3U43IA
+1mpr9PPRS01B2HWhYjlDYUzJ+6xfHzTAFevYW9jfSeiyCL7tVdGJ1aKsDzMEnpdaDgX23G0qjjnvfPLBObX8XPEA6jWQzpDi6Hq890lnZipQijq4uVdtzhj5
+utuvogMLEQCpHI/YGLyTzx2tzOeYZcoEkBS5u2OnSufj67Ki8W7jrtlpZztovybvA4D7NKWY8OQe3rZneNlo8qH13tVPz0MFX3izLWLPfY8055o4AxFt7Y/5
+utWdowATEsZmVRVLFDNRLvbt6lgt3SR1KyXjFFaTZg8WEmsgpSvsWkROSpE1YR/CdlnrMu9heibeW801GXdw1mjGyg/rwCnHQH9t/DdTclCtB+FO87gG/jxS
+okOKFFh9KbrqqblSgUQBJldiYV0eTn9IwzMovdMpeKguRweqDSB/+demlF5K4OzFkp5sjfRcFDKu3UgjmGMb0m/S3k39Spdbu8VyTq3OJ6GHy00V66FcbOge
+VJ19Qmcti4s6fBNrgQ7DyHWnmHm0OqavPPnSpSV9Yj7O8xwk8rfLRHewMJorc6drHF1pZfEAMYKMRd1GN4p635oPfUifEp6K36Quiopm/cv079J/OONHax2j
+bWTPdHe8jsWN4zb+lP2M5pD3r87Rbp6Lw1ajQxzyN4re6/N+N8JCgUTSlxC/R98qBYDzH+d98bY2tEbM1vDzxDWaRu3bV+XhtRBG0GsyfpXlNaGRvZCGXAcJ
+zfNlPv44lL4tczJ+aWxnXXGTUr8gDzPVbVNEgHRiK6M9yDIDy3649xffHrqLpauYNvzauwAUmsydKXGhpInpuLNmkeA71c1R3BhFSGsAep1QXjW7F72lVVK3
+0E2EZhzqDxYToFIPyeNgYBax/4ZDQ+5doOXoWzq6SBGXpqrZ3il1no8+b5egUPdpxsygHPl16Jr0c8ccDxL5dvNPIfSuExNzy1JwBTTpvOa/Lh5/j3HlMbN6
+VVcgRfsLPZYy+m1raYk6R4sFNgO5z1Odt5LF4HAKnf7RVLLpg3O3QKhrJ4ozJe/JZarQO5kll+20kE42HRpIgc4KYKjHVT+a98AnRZNNOcN+b46gvXVZUMkM
+r7uow1PLbJlg1WNu7SB1dfA7Sekp9tZ+f1s/aPKP7VHKgEyXiiYZlcg4WstKoh8Mh+TAsyK/V7POBFxLS3Dd1mVET7v8efUtfnKhM8iwlHetW94zrNHaCi6X
+9ZhajLrod11b9CeczUItos/v2O/mWN0y4aVN4ywAORU6i6snQpD0E9slMzemb5I47aEkX9bz3DhYnpfmhxX9XOa88bXmpJyNPy9sKzU7VnOKlWtFe80lBeTJ
+YzTI3W9CX9klXt98NjFkTL/01egK/d6Jp2iKiM/cqd5AKDRy2bCSNGJzhvyZS1Qp2LFmzZbZfp+X/gBztWUIvn7by99oqJ1PieX/ovDY1JlNjT7a+vMFtebI
+HMajmD0+88XjOwcnaFb/Lsxk/EpmUXIgRWK6exZwVYaH4AQxHcgJ9JO5QohQIKdBnp9Q0k80t87x6ZNFyNPV8LGMhv1Bq/go57Z2TYmK2nFsNEaROVn+jUJD
+vWm6fvvjM8s7dZUwmZbsluDYTZVfjCsy/giaZwZaXdY1YWuuvlAniqrU9QfwBJjrnNaPLq2vs8JsPq33xZV1huJXXLHTYyGujFbPxZWjTlnPDeYyLp6j2yu0
+dBZPdnTuwi9lrB8KZEASf0ajm6cFUVB5wGxsJvnZQk7xKpVuNPST9y2RPuhkfwPj3zTL2Sl/lK91dYxYY1cZWfI02TnbMtm9toBwE1RHwEFvbZIR2U3ZSogY
+ULRpZok8PjnLMOd1YOyt1pJUxznPOBuc6L1rcWkTifUpntqEfUPAJbzR0HWtIvtJYTFVFQTTB5HGOa0CtDzNGZxCw+HxvrtWxkclGOjghzs+R6FolzsvrRaQ
+1GMTMpyUa3FKBQSMMNFaHIT2vS2r52hw5FBIbXtO6HN515vrVvq19RXrf/3nAeFrCgi0pI6H7tUZsetyXOPYgXiNHUSYJR9jFXLUZdUQFBja+cFHPM77OJWQ
+AMkSrM76F12hZpYqxvrnVGY4Vh/f1bjus8Z2aWiNQ8d4K0W2beRam5WdjiYQul6dqc9msUsrBPa2G8oPmUw07plQqmU+kIhbRS+Izn0hUHcudcKCsYhrakzs
+9EvBDlV0xw9Kj8znrEfmn5KWvVy1J9s5xNotq/LwP5ucbDiIftcZLfqZ+hsz/on9DRwymT0BgFKnZIA6MIXHlpMrx+WUE9/3DPwjp0JT47bUyeH0dEX12QDN
+E3qdZq7NvdNlRti7Tx6pXSNbsbvr7b7yGB5CEorXxJXJk3MDn9bt74LrmZX/CU64SmRiNF9knBhUQqPnNBFUqwUoZWLLYQggFEHZyePypgKIUHQuwOBTpWDE
+lv6M+OL/SM6tP0p/mv6KPSGT+DyO64UiPn9/zXaCnpv2CseI4kwaEYIJGl0F33zn1E6VRPNK6yr2naEAAETGADTeFh8jTuIgZSk3YP0ZpiKfeJIbia9A+dnm
+kmgLDumElqYrLf+G5shKVukbFvKtB6tENk0sZi4IqDFFIl93kR7nX8cFL3IMqPKV7SqJqINQQX1lhSJM2JgtlJV/MludjbCdfcgMJ54drm5eyN9iQ8wNqBvM
+nQSwkHfzOpdfaF2gnbndKjnGfgYLorTbuo1UN5kdUXfCtGnejze+BZonN4UeO4nGKLTljAIjhValmd/WiaQlCvV7SgPHluAXvhDWx7vTD6TPCPMjR73GwnyQ
+2iTZWGmkYOt7OFOL2blzbHd0nOxS4zWwrGiguFSB/lsBTKUgpRVhMONY2i4SrFQRBI10ASOy/3wiv/FEy0V5HaGEFHVLFJR4C5waEESXm/Mste8cuprvm6wD
+7W6xB6Pj8VfjwnImzIm+3na9FzOq/qKvLLJz0TGtjfR4KlkxLooTfVPAeFVLYKuc5Efl4JxjJ5euvubFVrQmWaIRpjKxhkwRCQHka7dwDlbcVU/+Zi4NE9ke
+WLRGBp0ubT0B32HVoh+9NMcm624DohwfHQvnO+oZdI44KDSXGX5oFOoFTF4TIjLB+XuQdIi8Y1vUkV7nMHfroBJh6+Zi57lhYFZu3Ry3NLEoveeq5OOm36+b
+pc5zTYKT+zOV4mLq2P//A+nH/i56/6vIx20dAeGmHtPxDwB4lqiqa/dnWgbeBV1+IGpyfCBWHUfT4O86W2ezf3XQ3bLCehEycSgUzxkmyKmU7i5UQyy02UOI
+KqLWUGE3NDG+BBY1mt/sTvLqRf/UZS1mveajeH1MMDjuc1NjiIxNbxPJnXHDYQMJB82HMzHEqMZL9GKGVikTPvVhcXxQN0iaSH5uRou9kNf5VMjK/obn+VAQ
+uCwmTg3OGYqPkXdXqIj5eOeY4hzasy7WsW4ySb/DVM41fkvWtZf3EOzEUOqnDLw2VcA2PFjvErWFUHHPA3QcxLx+3yv3X+OBX3RcsIpvjSQCY3WVo8DSYvo0
+7M/sbCa/cxVmwCobp86XQSgL5O7Z2puBa/Xp3OaVQCmlcI5Fvdr3U/Ws4YWOmvqcNxBf2MZZRD11wdxxPUxhLUIWAWe39JRCim4FICovBRFOi9s7gzkjiP16
+j9wInwx2ajPu+2B4w8f22pyq0P/Qt74w+81KqjGT5jGoGUVyGfdpclxKfIRNHMsOBYrG/fWMR3U1M6I7U0qdoDAmRZPySv2HLeKok96PSSinlskw1jMmjLCO
+FVHK8vH+nAkp0/DgfV1OYSPzCm4cGJzsxsnvW8xgHBjNonMLoh1BnT6Ut+Pf3bGJf8ZyQ4+x6MKNUafBODHGZU6McUFHqn6+YN7F45TKSCTJANEZ7UR1pPXn
+VOYWXxOI5x8xJnl2aU66luC9q4WLjIEmPYz7Zt+K5KtuZ9T65LUnwEEZI6oFVtUv0+KeaFxW0VkkwEGjd2xIKyzK7S1KEUnHft3Vv286b4XtAc+HYOZG65qe
+QyCuHI+m7+sZaVcoY2NuVt5beF5Gt6asCYBOMzMnHpwSKXuRgVPxRh+lEg6trfp5mXMSctcutNDFuo0tF4Zh71S+D+1XZc8g3cxwtdLOZ/1NDJsgIsDgL1Rz
+mOXUI25wzkzfOQ+gPtNCvbH3mletHkGWs8wYoWc0dogwkDqd8wJouHX0xRMP9nvhhbQ2i8HnfqpnweNO8MNxuw6iOPs7L52aJpwf3Oq4cA3hjIPtdKY5Pu/6
+rJqdq3EnRxEfSj/YbP8otMoPH9Eem+GfU1ZbiXDOH9AdG04p4Kx9OJg9UPnrunsQz2VDKncMIBpF7JdJQGJs5G3xrUWsdT0+wAdCw/NyZlmxJQP4hmHusJJE
+YueLoMrm5gIQcNl/z+XCnB6I9MUtnM2bA/tQRhZbATSahN2ySrhATGhV6h1UvfjNpUNcIYw45+LUbDorFhWAUhD6c3yw8rQ9cmONlaQ8ZlnVBTXD2p/pvaa+
+5z041HzKUzkP+kj4PNOBj4DP0xx0sa+3IVGTkndi8yv33NfPUf0jHOZuB3bUL5f3oetdvE/nwt8sFJ7pxRl3SMNLG0dD8CUfZBDRUtkDaZXrxiUCUM5RiVzK
+tjyOUWQxB2PMIt/g+BrmOaNmjX6izYR1yTNLw8O2tTs32/M5fwNBZu4TQrkUDgnwAVuvyZzCgfq1cNEEx1lRFoc4Fz436PtWmzb72RzhrfQuG3BywV9+bz4u
+UbMwheKe+ng7ChY2ykTJKalDYaC2NmjxjAeR4HckLTkBdYxHShZ5IDbZOHqUVsEFdPA5wRLhLM7wPiQjcC/Mjd8Go3jGT+W9aNnU2cYIIL3z7BfzTcEVgTMs
+GNHWaW/Jz0kPFql7jcYyFHuD2fj+LfqD26TcinOtCXISGkIY0DUEm8riYkewV/I0bKhrBK6m/aFmYBSnmuZUfLLzeWflWfD93hxDPdErO1iJdIUArfv7kpno
+WsDrJLWJmLtlCjBs/QLvXOhWPccToTf6iVwRFBd4/mLHDiEJvjP0EPVbUrILzBB0E8+zQ7CSv/YGvBB49Lx2/IfTD2nuq/VNsRIPwdpmhB/rQPhx0SSuQAq0
+VHXueD+00jM3dIwUQWnIbsiYqeQCnbVXsxZ0D1t8p35Kr4RZ80dfESYYURpeBr2vpRs5+ljXi9rPLfM9eGntI+G9edOeWieAUJzLOGOy19AxOdV3ZtBSXmtH
+14yA89WmZWbWxvVS5+ZlY9hS9Ey992vD7q2FU1SQDptDK1yfM1VtXlhJnYW+SiaLI9UJ8HbJcrp0LFPpr4Bm/lDai7FLgTnNeJzVYTzfD0caluURAqYuo0N4
+zi6DU++zsjF4hh+5kwfP4+nKVfXAv9XYQprg6sqGUzHNPFrbBbO7zXRDsRFyOHkeAUpNpaV9stUEykxYyVDT8gi5wjZLB6cfz/mwqKIe+/HFzr26nvz1NkeN
+c9+Lf7jUhQ/loTb8U1IJfXq/teFPZ5rwyU5eaMSn72NLVfhHl1N/j2OPrDHDF19dV9nZlrJzSeez7WShm8ylmy82k4VesoVU82tsvBwXfbHrJTrsHTOsRuOh
+frtlVU4Ty4uIF8XdC8CHD0YhbVd9K4rEI6DCNFZopWSkw0A7jbhVyOyH+L+l9Q1R2jnTmZM6P6/6/D/lMGLEZjJytlPbAIVEYVYLtDt8e2x20zVZ0Q1cewbg
+s/6e6QeRp4eAHuEtuZkiTVVQ2ETcp9BdwIvechH+gQJhQeurotQbf+uUnMNnxASeizIyT2tG3auVXuiw3z6qeMZowSyGtOmUDfVDuVQ3q/8wS1Bwubk+lM5K
+661/6SIHw89rT93/pfwL1cW4tlXjnUXZovAk1gWatcJ8C/evgnOBHMjBcrTsSr7U4dq9/9/8K85gWZLu1fMQ1CnRc7yeEJKee5B4QI/ZOkzLx/lRniEegEGz
+DTOs8Uc7FjXuDvnt1kl4u18rg8BaG90CeEgyAS6oCKvl6sweubF5EFytYxOEKbnTP3NKACSrAq1g1GHAFE6Rpfgi5+u96IAiQAMf0W8HA1fMQQuZO1pzvYhO
+b+LDphBYtZr+2lUjH+wWsfOC1SYUpn2+WSTilOcNI+eyFOyKzXpHCkceBeBM64g2j5TSxTBnm0ikd6ehn2MrjvSQxP8n9sExvvQn5FT2OEtMf3Sut9Bny/8W
+XYbxxFpvc5e5qp9JCrmwV5LQ0unnWwshHF6mOUnvbj2FtoV9QXSptxDtGIOpsQzrjo49TPM+k7+b/q6tPd20+fSze/WqO72wp0+hrNbTO70sEC/uXGigXl+v
+el/o/dWd3HHv760357DgI42utXyr/G/LP+gdK9rw55MM1dBqh/CKuUcwbDUBotQRD1DiN9Eyi37j0m/JgoA1Gjx5FMcqF75VQo8n8IpM/F+lLpe6DvX4u/TR
+9KPpZ4gn7ldD7XnxRDxsdm7rtZr7jeTZ1AdjGVpZi9IJu9mxMbym31i7OEHCgKkoYbS4AxlZc9KpSwoFLXjstDs5+TFwVtrCCEGbIzrmC0k4il17oTsrauzu
+u3uXu3goJD3IH5+eONfQc2Zar2xCuTY4b94h42XIDWvheV7U51CMclGPo+veUd9JQA9dGw89PBBVXLz09Qt9O7xiXhEvRLEsj+vd2d40LzX27bBdWo9rRnb5
+Xt3VQ907Ut9kL7NwQMoY7pM2/l1q35HWHS0du6S8NfC8GHoLtuk2fYj4Veva+Geq+nq+v2C03oKdUwbgV4y3QLoJtk5d2JHo8LdXXvhatMX3az4N1ZDPOg3I
+iy6EbeNlxNupQC6l5WxkHqEA6hSy5ktoN0jumJyric6l4CXMSfcJNZh77Rle5B+RrI817FDed93DsZsCzspVfJYYGFeFkcwgCGZ36aBsWSu+zlWSfVXqBzUT
+WTbVZLR6sjvSlVsP3MX13/QY3n3zKutADmEgDfx3WCs6kEOv/VERv5fFs5awvvVbWZG9RfZF7EGGxThNgzlJc4GD98YpsWmhswmhl88StO/EwMLiNPdX0vlf
+/cOgj/Go2WEpfW51W8/7sbkBjuaiRr5WByFuFNc1WX8jh/XkaJ+ZnBmMkj0h5ffIqKfqKp2bHEGQNU89kw8qRAFTMT41KWj59fQK+dG/x+xbqqL/lKlS12YM
+P6kfNl1RUNDT+mNtulaizXlmlga61xDkObEdJ3r0nJsgt7YQpLN0SpClM1DynW39/HL6dWMK9zqnhxDF/i233XaWGDxoarCtIvp63QiGpH38/F4rVuPGcpJC
+SeG6EnIO7IFdBi13yIU0k8qMc+Tz66ShK3/mNnUzunpDS/ypxcyd5SxtJVtM2qylNyLJr+WKa6+MgN9vir6txdXukJRC3pZ1gt+b19uxOlSMYJe21uOqkDSp
+znNuqSVTaMDuwsyKl5XWrBLAfM5YN+MHLl7leususc1YlnCqpdcr6unEYZBdaQNPDhH04KcQeQYHeF5oach0ppdn81kRQ+8zpi3GtH3pyXN8tXB03rhekXfS
+c/YttgK2veLkl7L09Dcia6Hw3l4UOJrEe0t3R09WeQAUBEjJ5f55vEAj5OQma4hvN6Y39Mgxrwrjl8vE8eyZcXBSkv1udpr6iw5rSO/LkRFoT7qiLH0da3bm
++G0Xp5jl4KAJvs2vjur5mUZRJgxr6NvT91J8o2iSLxl7UfVi6Oq3mwdGkDuR5Nvu65027cgreetHfKZ51K28itGG+9TNwIBzl6UoRlteWcwvwnzh5QueEP06
+bf0Cte9crGm+a+3Xaci9H3H3XTjYxkfuwhpUHmbyQmLtMkW7ZXkdpKmonlCrtEkdpd9j2imcAUqJPCdvM7a+X6JRKHtH7z2stRfpKFyMLEkT6m2NZd/K+EIj
+v5IR149rtiIyrVC3YruBGAokxgvj77mdltIbX99jR2cBpEEyvQW5MDkB+P1a7xpX6D8l920zamVtL6ekzYE6mZd3cYewOJHmKhQhQTJOs9ld1MaUwvGYukWA
+AN0t5NRbuHdqF5uysN63UZ29p9mi9bg2cpVSNIiczp27WcmdjHqmLFmjXJgFatanX+1mxf/U+9G4Ch62oMu8czwCj4Pikum1I0TMy+dXFmXT5yWafx6M3MQy
+NyPG1/C8yD06MdpzoFNGiTNbHSfuu49YVe9J++56zbHNRpmreKDVfjJI6Nm+Jdiuzu614ndbcf3gy3uspo6GNCnUuZpnlUTkfEIK43uH6U78KvOWMX6Xj7mt
+D0KUzEWP9LobuXbwwDzUb19FivjtnkELJm4ZykRUrqZB9XOBqEgPenuWfG0RckVhEOJS92xmoAg7uKpaRh6OmuX4JN3lr6Y/tDs9i80aIkMyAGRAcwMyKCtX
+E+3hHz68kimhumIg2KPeKgtiYQokbTA5l3r2GgrkV/aBln9zAgOFUSrbfgP/6oXXPWcJmPKxKtm1GIbpEkPcEkEqxy3DdS2CoZ1ol2y5qszHZE0ZTzaYlqSK
+17OSWOpOO5RM5fqwlghlrXk6y9NIteC43RxtPTKwpN5eEiguFMXxidVqwYOgDqFedyrIGak6mMnRnwCgzjLdvAEmylDlVIr3mVfpm5ryoalYxOsWRLiuO8sO
+xIybYGElV0t/j24YNpBVYgh45I9lh0p0yeNwcoJClqTBvrVbDHg4aZXSfaHxrMnjqitibZdc/7CI6HxsK7pCuiIEBHedXFCAjM9xKCfeweG6hvZ/61zWWns3
+k1yJ6+ZKm/V5pjQNY4hGOpEXLlMmcpZQpVAFABlpI1evUwlYeM4KmykjNy7zwbzW8V9E7r+GGuv0PRkNo9cpWqGviHra8eAUbJpjlwVGy5GTpDPKgDAB5CBO
+oz65RL31gpM1XpdJxj6AFloxhVzi2hR83J48UoW5Lk7pWTr2mzRC4Q/LCVZZ5D6luCcfMbseQ75jUAaqK8tAS4A4yYP7KScwjBjnme9TyaxGWcIG9yj4FnsU
+LIW/Eff4tzZup53dx8Oxu3lhn0vF8lXvdoC43423a77jUTabu7t09+uo8dK2T1e2799o3mHaOf9o3xkyCUBpiAf2E8ejRqO0WBsETADFlF6lyXULVb2/SRAV
+4hgaoUsBBOYYsPEAd7DRtYP29siQ1CcqFjPuJf7+4TCeQxOIX49UnPbD27lWphojKuTkQI2+ByJEzOJ/UEdKvUIblAHqJunprbGRuOynAkxEBqU/ZoY6BJho
+4ZUi94TPRe1G+ZnGnSN/N/Oz0THRbWWkHp/AfzmVk9eH9UrKqTtec/WPWe4CHfIAGENaRFyRacUmjOZcFuJUrFiccaKyjpRY1b9HXnseN9R4Od7VKUNdHudS
+Q+zusO63lrMx22agyART1XQ38xakegsepB/gqajLFQpvwlyWpmPCDjeFamkKyIFTZEJeI+u3VkE5z8V+GWXi6qfb5RN4Z0fFyjiypuRP4syHQn2GBZ7j7CSW
+iizfl69xftk7TDf/AkOMQx0cxSZysmrRFxJNAaKOpKYYLH0OfxLSa3f96YStKpqbkhp9WZ63jIQzGTLN8Uz7iOPrY8ug183OvLVzOVO9lnhpfVCmp32wG7RU
+xXbspPLV2Go8fKdQr6sOq4hUBJQMDQeFU+KfHBKX1YFmwq2rzKkAnpDA//BN6VvT33N9Pqra6LFj8RTrT7AokWC4u831wk6rn0P2mp5zhvtO5fmsEogTBC/W
+LAU3L3dt6S2hjSDa5XrShXPOo37RNXD7hKKrjwMEf5NUk0aHAxNEzbo7+OJKP3LsrMe6HOdrsztynItVAT2+m2mhwxBjEeuEPBwKc5ms0R0URXkY6wxqs0mh
+DdvW9jdRlZ+RqsZ02hwV76eIUza6lU4zsDNev71p8HEjR8+FtSL7fNhkgUZx209ZpVOR+wKgXK+06kugl6TvJrml0IUrPiIWLfc+f3xKAAPGHOs70veZvuvP
+SvXy0PVnMiJt0x/9dUzZXINDq2zfyTDvDh3xXzNCuSA0P6BgRikdAEyliI+mvICQAVBGnotgaexGk5eLGbl8TTMjJwUihPrCG9K3uTOz7fbqMfycizbm+9ua
+35jE7UxbGyVrz7W2sRNkO5n73Kjhr2tqm5JsXNfZNtvCrdNNzqH4cJmTEntz3pC+yfqbBB11YdwimLVuubGGfp1xQXGX8tpxjGfRI+nGrhepVqgH2NdXSoHZ
+oJPskQzP61cctWhVH1cSrauzG3N+b0jf7/Do6WzW4W9HubHpiTau3NFmMzCUi+wa9XUi1pDenXlq4iFyDTBSDT3w3BoAyfu9W9b+T8vaNxu2nTP6MXjDGP2s
+x2273l4fwlTeNGojQc1lM+kwi8VYdKcAoPouxRbHVCbkpe4mampEbsIdyvhDmiHkyJwT/268ij1oKvfpTKZM+3BmQQ3FPoRou6ofJI2dzSXLGNxgH9kAufRM
+6l+QfDIaVJc9Q/V7c8GcTlyplhS2eGTq/+MK0htE0fK9xmf26fOrWnHVB81Vb1ZnGH52AveUW3gc2Wg3tzKJ4LN0O7A8SzYnNM/X60CDqWMYCki9ie62RqOd
+tgGvyp+k3Zp20l92FDLjRhx67H2NgyuLNS9j5O+vV+JbvZIPypdNqg8eWV5NSvLepvjUBdyuI0B9wclB8IWivXc9aNYMn0h/+WDioOHrgk3+Lqr7fST9kESv
+Px/9kZmVulZLJRHb4bgS7DhFG6/4vqgsrVf0xq3iNEfhV98c9rzHzBuJSHjmu8n8FPSQqosUJJESI4hG/0M8fQwMEgFngd5LaFwKdDb6mH4ofcX1aSydS/91
+hB/PGu6nKTsWsgTdQfaqVB1RLbmvAXxTej8xEzDWxlg3uhmTfePAv51cmxj23hDcua9jqE90EzU9CCWwiNynJtvEvosfN2e3p5ktL2hhJ6KLpd/i+rDTg3kN
+3izaxiPdxdJPTPKt2Q9sbrMBh1LKlEAoYqh2RHApck6X8jbUIZoySN80UkaqtBDa5UPfKJgWt6cXMoVHbTkyDq94DlsGdG9ZGqk+7dfX4ptKeGH7WRoUem+D
+Ep0FTxpP+OBpYAfkvsUQHBTep4HMJDGlpdzHUp8V9fraMxTzBGaWGc9psCINGbo4SZnrzZ2cmT8tsWtEdTdLH1CitHgKYbbwUFBz4lpaR5gkP9qAtQglame5
+PoRwuqHrVBiSqN013+MNlF/4tvQdpmO0uJJ9Xu5v0yy7s1TRbrbGNTX3YGts/ZYokPcL32fnLjTH+j3geWm/LX0s/ax54f/ELNYZX4yx5MfRRBMjOtiXs65d
+Gcs2yDN6wgprWp9V+HCabf77jiVaKlc0WfVdEp8vOW8+ASPcvY2Rhf0d+W/8uc+R6HtCHEpoSaoICCHAQkplL9pErdXg9kybQd0ab82MtBnZGkqn3k5ahVGs
+th5FsjqEDSABjeJE+lYsi6HtsFR0EP3/rkwkpP7a+eQEZ9xqS6+T3C2mG8KN6jxwprFm3ASVoUacPm7W8/ONdo83CrQ6amBCYz1oBwq3RxcAEY6PWyODtplM
+yBKqbBRkmFwWytAfaUPD2dQucIO45QQQsBbfLlxprHT5uFy9ub4MVpMMsvN8tCjYWJrrcqds6zNJ0nvCqFXLKnaLH6XoBVEub0LQqIW+cTZpXwA5LRtbdVVn
+iQleUGPR17o4JvaKn4/MmWjQCoTbhS7KeJxQt9/xIKfESjVx3OfOuZuUTMmTRUg8LTvEQp55mGNp+PbQ4yOjArFyaTugcA8K7wHPSu3wmtvYKyGJxe7kMGCd
+yLkRRmk+WoYcMJikq9zLzSnU2o1hmEHOLybeHCyrbewSsR7cRfWD/r5yS7A0OUgHzchnfrjlV8TNehClPgOTHbOIgMk10bZMJz3UB16F2olYP0kQThuX70BY
+v/XTgDwvEgIW57tBWqVfYJ8moiyOnZZey/5uY9d48Gtak5CpOc2XOd21K6uwlZYX4VvXVlmRsKzgfQoLGYFvH7Y8m9ucrqPZGKb7tSz3svl31RPX3eru517M
+lhZM3THFObM9WyHTKZp5cxp/XUkC9doCLzUsGlBJwldL4kgAhyJrQZMS5LiDdDChg3Ho8JL5KcXgjyFHWs/lpv/2s5di0f3RDilLAm5GkWkevdzu/sj5Ip2L
+5o/EFEtBLJCRwqI6kAz3vGOhBiNNBLGLKqVmrvA3y5YCMvmbRqsRw/Ddov7/s4rR1R7BJSN8sMU+bpSyc3Q8zF6kmcENOwf0ZFBiwQAvK/dpSNwzzoklxEL+
++ND6MuYwKADDJIfYRjr8Z5om35Q+Kuv2y9QTqTt5KbvCBjcsWOnXWkDkXLe3mD565HFxDLn8WzkfilRubjkXvsFMfFtCQ71OAG/YussBgO61tMTGBIwu+ODU
+53KmgVAtaCnWRwzp26STh+sEH/OYip0TytwueWIH9daPkdh2n+OXzyRwuyZcNyOgaV+6Znj1ue+T0NuXbM+QakTCaFSy7P/eO2uuObOh8hMmOeO26jn2G9LO
+dTD9lHHcplGgSm5nuNZFgubT7V2M7q6cF3bb5VkO3WG90jUwqYknuGgfyRmxihzXfO8z2Bc4j94Wsq8QOD+qH/YPhMX1q75TzzGbNQFVC8KUyp9yGrZxotKI
+bgGNWcfNdnO7Naa0ulWuLS8pvTYCmJWbyDXgdmxh5LNWgVAtE55KySVURS1JBZbTFXk73TM6K4IHK1FX5ZupctiiNbMja7cmemxbkHk8NKGxQy8eqwKyK5Tu
+Vm8dnIJjkdc5GjMt6xOgAgDQ7wIvJ2sNSRK50w8wtK3FKh+Sk/5zwvTme1rrxz4mFTon5vXzX3mHfC1AjzsjDODPu70SoNC3R8k2Mlttj11sN3BKWPhb3DJB
+RySlJTniDEAd7/HwhJRTMjhc4T0l+BBE489kbom3UV7+h0394ouOox+ts0W1kAwkImYiLgilCdfjsr54fWjF07vWc3Y4bg+WvuCqMvfy3NRfSGyaKhBVxyuV
+lRbNNwEYjbpVlfJ5wSGp745OpMeYwoqEOlZ5pY4lyQZOCU2dmtMaWaDhBWJ/wfelj5Lq8GfJqvy65j7366WmZ34qJC3Rfl75d/WM+H3S+pqzi2KuQtOWzo7M
+0ECfkLwrpKCY0qE4KPzXJgaQMn11hcXAF7DyyQajmhmchull87FeoPzf9ySw7Ib2xWpUYV7EK/lsxedOCfelvLw1fB4FDRV3wSk/1z6tNR4h3K0H7w2nAAS9
+GIs+rbrKpTw2L/ZSwXb+Osj/QgUIA+jvJP63wcg12Tqrg1VM4176Fj9mM3WpDvaAHPwrg5hWatO4NrX3Ww8789WvAXvJdzGwphqj8u7z0le5qPdOnR28LbEh
+q2LcwUg7hzs7M3LmKVpHFXMmMfMRhwKLyfiupOzrKFvYZAc92Nt+QlYJpWxJfoe104Tzsj5OgFm9kNI5661PUtpDoEg57TkGaU8sbnn42mCzuMRnYtheZpc/
+qIMi9raaXMmNeLXpfcuiclhuJCVaPgwcb6pcTfVWMzB5b1lUySdinao6CD6YlRcBZ+42ygTgBCLdOiTT2dRNRWYXJysAthln7TN6N9cnRLyQIkYF4Qsnactd
+NMXCmnOvLHj/Mv1p4CRV0W6GzLq15nJtVmxwOfijzpGuqXUAPNbDiY/zUaUeiSDZYd03B8NS82OAky694sOYZlOAg/16ChWAqdCqA0DA5yXqVDOSStZh06Lm
+UjSv4BO/WMSzRMmuFCjF5bC+Ob2b1l8aj1tHRzLLBRg5o1ZjGkPbXav6jZ4XRMLKw34TGPXq9wQ5DwhuL55sVgpgfY7S2CQxkiyUKUnHDXZ151awLhipklFX
+qWKaXxvi509RVPBbD0bPu5gWMErgwzw5Fkp+1x1YX6OinbPqV1LoWxWhLu784yKl6TJLkmlsTdRbCQBOCQsshs+XHpn7Q5qUvks7hH2HQTlH0dO0htQHMuXy
+/bpbEYvnnPJIZosV+QDTVicfRzY6xp1YcxCwIa8UN3HAYl2UN6OXi4XKRUGovJAYvpWTknP2J58/GGZeOwJgkZpPW1Pguoy/wB3+Z/sBd0rfdjHBessoZDLo
+z3rH0GumLPQHQk+Qg4VhSwVOULidHBZyURH/oEhcSSarU+i0U9q++o70CtnmX9Ca+VWzEuFoP25FM5V3zepsCSwspLiJ+AC7leaqbFupcIdwMwRuYxUcVKUA
+QFZFfeFUsHQHnAqbzupjOSToEChM5TQm6rYJuLZvsnjr06JDfC77eqXFX3aFCG2ojMrGlkT2ZzWDuEV0C3+M5SDNN7D3p23IvCtwsvXdZWFBIvAp6ble368s
+WxyzFiPtaHxE7PORdk6OuZatdZT7GHxjiRTKTW4XMrU9dRRbHaEYVdaBa2N5XBXjNkXRCYrWAhthlPDoGGP2KWnhU/5Jv/mCdUXdKdbLJh5gKZ5/obKo9/bz
+p33XtSBvNbmiCRYGzlDWdSG/dLtQFuPP46Zlm1Q2ZJLWOgTbv5JcoupYUZnDycS4HQkh5ShdNikQ7dKHgH3bpDG9k+p/lGcdhZx4FDwQ3ecjd1yMG86/x8TS
+invuFhNRV8qHvRnF3yK+zSK+RZk4De9STFRkwZZLgIxQORZOCfvOee/dn5CmALsdQZF2Kb5/baRO38rQ+WXhT/ttOxvZH91vlEPSeaPZ5QqOlHQhjRk7HDQF
+tXXoIwmIRwEQjBWw79FgHAPSnQXKI6Hrt8QipyD6mjgoAxO7ljUg1LgGIbdGFAQrU/JOwe6cEHmJrFSW4sgDeB++YiNazrH68syMZLkn/cf7YLtZPB64GWst
+vtR41PxBdiTLtwcGPG8OTPxKJnVz1WIdeqAkze2UcLIgQrNcZAvUQIczLDPVkO6cgKmBqVBlaRFEopEK05rMiZy4FTVSswSN4Vv4Lu8WguQYH8oqGjdGkBsK
+O0LsyBS62hG6Frn+C3H0nXF9bzdDadrFqOEhQPbqayV06PiQmp10TWDbDLYQ0f8wUDwNrPWSFQtPQq3z8Jp+MPhjteLZMlO6vjbmr5pcxUGVnveb5U6pLc+a
+nqxj/8JO1lM+zvPeCcyPVFkkwzGy15GRP/OSmqyzNiMnwEtL3OXCQw7FX55AyZkWPpN55l0yPGhhbmiNLekazDwTXlhjqwgcFYa6ZkN9WAvAWILD0dIRaz2Q
+Rvc5Cwu6TVOrGuKKD1qaM07nq2CSyv7lpB8J0YdZT+zmtKi8OJq4tA8Vs7DSNApAXT/YaT94fcwfNQ1x8Wtzn6WaG6j9RtSTR+lRyAuFsR23zN7RL5BepPlh
+fhBnRioBaqVAekmq86olMwZKFAAATf/W71oOYcII3OZMN6xACCNcesLElRy0ACIXnzIK1zkydV+G7K+bcI6Lj4/SRMssk1n497UdeBS3ha4TkJL6WgAHTV4m
+pcykf7nohUa8Z9NikHwcFr3F5pvXeEUZHquHztpYOo7lASjPD9vTsWurEa4DMgt31KtBbtuVHEbZ9ZPrQ4JNIw3IVAGt65TuzVQnYNX3lN0nJlO2DJI0vtbf
+3dbyy06zhfsDG4vlz4tFFOSeUFZQsLF6/Ph3i4yWO0mR9byWyrw+Og9dmUKLT8K2mUFHcHlihr7WClzffjLBy+e4wvPTVDzrpW8c7zgIPu0UoX6Nzt9znWQE
+5dweH5qp44WGMxW4kfXC1Y3WZ3Y17zMrsMQE7nrP6H1MyV7KUs9Zbr2WfppmrWh+6yDo2VsA9BzR/fO+gHLVuXvKLspGsXYUA0qzsj6sV0ZNr/4cg42uPM30
+JqflxZMarRwyvIIe8s6pwKV9NeCp/qMyWbWVnJaelIRP7YmGhd6/rRV235Nb471PWLQ322WzBJDOzZrnhmbhejYbO5f94RhoSoOmUz2bAvkXPAMJETPFfuUk
+nNqzbePmoI03pzhyJaPDyKf9LWkrXXxpx+jH1t5W/7A5JLoKUUK2li5+biErUhAiGEemGc1JKP/4BmbJiE4gORzT3X/mrqdyaOyFwZeuyRCock31zOVruto4
+UOa5guwAM+RCvSDMCSgD15Ar8foAciorfI45uSv1c+dUtO60GcBL6wl6ltjZ14dG7acJ5qaqRJ7tXSfT01Sf638t6XZABMuWFXbopcyOaieoVE5SSDTqonT3
+8ibHzw9OKS7ef6eDt7HYtVsRM8jQpP4+m3067LRxj4TiKQEsGQsH6tUdOZXGDxSuE10WRjoT/MwdLs3+Mc7+uldDWlGlonB7xHzGTzrRHEXxRfZzfioDnGjB
+DMXJGQUe7TDTem0mNc5rWlv7PYHzYb++6mZ64ADXr+cBiY0UkYpXSEtaLs5ftFhY0kMZXAHB6gYYeNI/PEPeuevu5/9m1jykUpqhZUbn/07fLl9nvQXuTkhC
+b+KvRJykgdwXBmhmdKU8FUOaIvY45L+/JX1XOjjFuAfGmKmZVxfZ+lqotFpFbq1Ccv0yy8apSsMbRIkGmHQCBNMw6QAXhjagCdqcEmRBIpKd16YPvZnf4Pyj
+XlO2YfwZE/OrTiPq4XtrarJXQkgt5E0yytHd0Lt25DeBrv2atPBg4V5HYuqTGgiap7YaVAUvp/M3X3o5HbbKJgfLfXf/P7jALXTp/mtPpCbAGy2g8BDVFKlw
+8HZLIK7wQZ1oGxinccFMztIGbtxE7MuQSx2tu+I5cIXuvn9U7rqdVa2rif6ul8d9tW+qc7nVC+3vzp1WWfUMdZAD9+Ly1uMnqLXLFX2e4hBRa5vZeh1zuk9x
+XHzfqsrpnVvRj7hzsWFt3Tgb3TjO7teTxTL3CXBK5+/RILkc2qdMlquMjbCEd12lnVN3brvxaORFC+PZ/S1JC8f+GORdCRAGfYnC0E1A4qfdZrzEZNjmYTl/
+EzNd/7hFvK014NLsaH8A0RyyNTrEIvW4oAWfvd+zOeyVIVV8Vbe279PQTiPdsjmoOqg+IIBi6TsHoudujw+pXXlb9ZKzU9bHvT5IZVao28jhHKUgvTFY0d5o
+Yccz26PV920LUO6Y/U6agezADFNxy0SSxiCpTUvjiQXHUl+jZEDwSHWzOJcpA0BKb3B7o3IdH7qTq9arv+x0IVntsO0c1b05awsaoOPVU6VdddM1qq6WNDji
+bDfxpDxEnNY8TnoVFWeOM1dOs6IXCdTaEuO5fW3olf8FpxrJGnXrwyL1kC2k49aj690Mj/JHfVlOLXP8trJdV2dudHtwXRcZzOmjZNCcFBFZkha9JQ5sATNX
+/ASBNSRTv5PfgYLD9/ZrEnMkOlQyRWXGQf/eYIWs7ntwPMDCknvh3LFOpdC70281boXmUSphQClzm6Meg1nfocnGaJ1hZmVWHUKiFX/9edpXuh/jCZHva8Wn
+NdN0bfeU91hKnt8tH6yeUKrI0aqflh1hOMloa8RVNSxPpr5XjPbZj8/HMRU//2tufBvh6ujPTENgmir0dnEqbt1Iw2gd99xmJO8PwZfcuDQANhcYHCWA7Nxd
+qRTRd5Dp5ujFBQ/YEvBFyddecPc5IlsY8/HgvW79dbSztx2b0/64Wugnult4rfHZeLsIKFfP/Xdl5jVys8CSowVI71F2J2RMlemOtbJwCti4F229/4Rp/FRk
+oeX7WG5I6rc+DxwUT2bHipBJbMbGBGESl/7rO6k3RheM0iTJsUAIBZ1MVOJaCsS+WXpMyYk5iJ5UZA7pz47ltDQGI5E6n7taw5/1J8ZjfO4Ns+Fon65gKsLX
+osSwP0py3TTo9NnMOwfV66BPK8oyzoS/7xOq01Wel4uRNeApMWdNyYk+QV1N9acyWKmuuKlpPspteidFIh8KkXXz6NlD+VfpL9LfBKvTRNEW5s5EcF+51GkS
+zhCHJFM80q183lHTDv+Two/7KDTf4Nea1MEJl1nMvcuM1lFtAkcr1p7JBCP2pomVQi2Z6oBI+lXvwUQMcLPgX6C5jdnhw0azlY0NcT2ja9Fq+FZaiFsyucdl
+Mcb+6ihcbVsJBviEPpXQym5Fez5xjbyAJ+pUGoMBIhUKpeEv0ynXSFdD9jODq1R4G/769D12Un+m9YbuBDuvFKgHPpseMOwGvh83Uttx1lvM1bh3Be7tZqXu
+Wh1YCRtx4PuZCx/RMkc5PddsTl1QACt8zm7wwl5Maq1ODdbVlgoUgJTe3MUH7+iyWw2f9BuCF6+InD9Pf5X+ffrv0/+Y/kv639L/O/0fj48YQm5T4gGlLa+H
+fN1VlPlq2fI69drw1G/V9+b+V43ya2T76i9tv+KqPSXsuTCS68SXQKNgIm5NMv0+FQHapRi4a/sdr8+p4cq025k+n4tCStf9Lre8aNt8bPL2LGCCfXe13JfT
+d4dcnOJupTNk2/jJ1+ezIsa7Z96bqRz4O0A9366YNWATee58E71WM4Ku5Vs7YJdOFWFqhEacwduaiKAntoLBvr0pvTl9wPPQXFlFRpsYWvUlOzbwrWM9cGHb
+cZx9v4HL8rnqTSpKQzKxDox0dUrNqT6GVLy07dTXdNSa1XeGyubSY0pL9Z6WB+KOl7ExCMpnX8ofLQDdqgzaKDCvfpSFiuK5tTMrFpK+k7hmGgtVzk+aIpFB
+Y6ejjw6/sZKMa4/Wql7TP+dae27yDhY7kPmW/tPWxCwrIKz33Qw6PvMHzCr5fIZwj53afcEJGCYsPLaSuQVrTtI4s9ONpJdQ07UMj4RBvIPoc2Kfj/CnPXo8
+xxu7HGDN9VSfyZLVIhxp/B6XrUFEZunz2IaBSOg1QEYxhag7Q5HUHn/m5aFpLVBruLj3/SOjNV/4vG87of6lz/u2Ac4pprx6g3RyLdAWuoL5pYj11uJy+kl6
+H3f+t6yvIzbBjsdQiuoxfrUuBcQ5UfSZB2KYvdIvkZRSer3Dbe+st+nDcl5wXK89q4zC9b72hZDtar+2Nob1dStebtnxvg4l2C7Clb15tbjVjtusCe8WqCBj
+I7XijZq7k4QHM90akM9tNzlhukDOdHgGQRIN9DszCAWgVYtmVjjiS2vN920Wx2j+/bPp824Wz8Z4+cmxSnY7cF4Wo0MHwLnRiqPK6ossRHDPL0QY1goVcdvv
+Tz+TvuTWzB8obvv2qOj/1v+iW9DYV2gMsRl1/aBJWnuO9vnEjDp1UWPFRmJkI6L/qwtL3A2z15nIpOXW20/Xj8QXi5pFWjBdGdrZzj9ZZzNg7l6WKLchbBWn
+7SRItusgrL/fNEK52dxsxr2fWfpZxZoqiWQEbSiJyCqJy2Zq1ei+BAQCR4CR/4JXHhnqBwTs/RZ+8VRA1hVAoM0Lc/Es3YRY/w95Lq7FP/P9Mdfs3DtG2rWJ
+km0avt23lniZNWkgEfiy4YV2ri1zp6yD9Yyv28cDn/Rc7iQIkUd9Qm5C1lyHYDcLAs2C769Bn3xqWRcCSkU+j9Y3Xq/E5ZLGwEOhXakPWGtt7JX2nObWStmB
+U9LHFXNQ9GfcjHt0wTpTWQFQmz2SdH5k5/x77tFFm9w5OGLG6371FIWBg1TX0g1xhCkzbc0bNf2moycPd2ZmMx7nzbtnIyNLQD5TAyZNfDvXuLt3/rIe6GZy
+Bt/EG8MkMiqAWp7IvipV2qizvrebuPZmBq4BFneAxfztKo0LvRC/yfHi5RhxbCvosA4bVDo1qaShu/TZQmG0T/QPVnCz+aDnfvMJ86MQH+tqcsRZC6eany01
+TJLPzHGVyQz5+flMiDl+N/2r9GfpL33GYz1qHl8VwBREdzg6fu+1ZShbJH6mQqDN0LnrhHAFEftxdZckmaHJ/+5RfI5XeyRCgYimUHMdigtKIELTlGVqQBlY
+dKAKiC4eeC7qYsvWz+s23ZJv9DOOhYkQ+btH1p3vrH/Sxeuyh9Xy30l0yJyjBFcZuXa2EMyYOF2bKOC2UQnYs9GPF+Ye5ZP/xN/jz7hUYI5eowO0nwq3OhCj
+afFRP/Q2rTKPv4/YPv51+jeSfTNGmSaPUM86Grwem0uFVN2vvifdJtHiJQ9b34obpzflcDxsumlewDysgpAiNcC13b1KOMt48Qth9TqfFbTVgw5BBCjdJs/9
+/LYHeobkUJrJMd9wnW4XcDNmFa8WMkFL2aFrzbWoTXS5lGw4h7irxxkthHxdQJX7h/aUzlXW3dJUizWzwgnsWWk5peLPD/6QW1aGcjBTtIQQ+YAdl9o3pnfS
+zHyFTtjfTf8i/aFYxr9J/yH9J+mCkMI+a6ZLBnE8941uuQoV7dga3Y3Kk3vKRiktHBRCf2hNifMMzpVVbFjHi70O6eld+S/uEy/UAnV/D8AQnbrZB16OQ0AO
+TAnovpyQPw8I4Imn9akVJ1SePInBrb8zQ2jQsQ9SuxmcHXg7VSj2rVl+v2FIuYaR5ibr/tXWArI0INnICYSNkS9RfFapHOgegqB78a2E8X13b3f4XgrXcbW2
+101xvi53w/Zu9+sstftyn+i/LVAQcslSZ+X9XTiTCLnwVZ1QWwiiDhhrMdRr+nD61PJVbd1VRUn3volAmguyNLny9WfFr4dMSrYOArk0wNm13ydQpWFpH+B+
+giHRbwv39O3pfXr1m5FLvOKDj4+dYzr5oRRu/5/NcAGU+y5LcGGWU+hv/Nb00fQZiUofmtncVB02relJy7ICBBn9DrdeYvWkVdIpTjirXvtuaTmRC2/aIu+b
+LZwUc2IAelpIgkyD3lgPHKlnqUVTX2TGTMdkcGYCTMbouDVFFFOF3m7isK8M/u3JtW4EBq5lZEfINjRqBsa9JZxoyOyjlT7BxbfXTQe/OkGroMhcYA90S6F3
+smp87F3/pNaNK2byV4Ii+iNX6QPg9/r5Wduhc7D7ku1IC5j3BuAISHc4Z19ghncvHuXue5VqnkLibKVSuFZWXaPIGsUi99pWvBv2Z7Ufmm7duCd5eZUh5Q2S
+CwwUGaonysaczfis9F+/ZAicGHvrtfUkstj/SPxauydPnuME5SxoXbUP2t7uRDg4fjAFjvPf8Dxa3wJ8whbe7xTulfO3lHHxp6RJS/q2PIv39EXqWXw/86Y7
+f2OzrgPSCsDyts9hZLvjwl1v9D2rogQtKOKOaudgUps2Qe5ujaCQcoLZtiabD7M7D9GWf6NolRAj0c7TUe2ZcWhkXTXWRnwQtiptnNsZ2ZnSZ3NRdSVcuAJN
+1YQQIzvu0wyyzYekwlRLwZYNceuTdQAm7LGpgog2a88nXULaG+k1wab9YvXks/JhLN5aGteuiSJH3njt1/WgmK2brS3/Bm52dstjJY2SuqJJqEjlO5bLuxMW
+h1x2dx8UrNB4aNDx+EzaW29n+3WYg+8gr+Mj6Ydm5x3jPP7gvH3vdoACwG+7w/1vq5zd9G0kk6g7pZgxWMGD0tkIS5a/EWEUPS0vKGdniPyl3yr11F8wlZvL
+3pEHffZz9BAE1D1fH+f+kTD48hKJwE+yjzPXaEhWJlwEfHJ/QgkYgs+SzhexyDhzN9syklxYNypWcQDNgmwOulNMPZznjLn2hceJ84qsfsf3ubnfwWgUUvRD
+QDag9bLxPsEgo9Mhc/80aO8J3LN0NkNUgE7/DMz4qnyZK8f9+MGqeGNMZbKuO7REY8QcnXiAx1gQVJ3r5Nbdix4wIfVwBYs0WIWBJTHPjufBdju9rfPhP2j1
+3F+TmDGu0j2TrJmA4SWw36gnxLaV3RgoeWPaXL7ZeaUtT+KteZQfHwD1hSlBUfafrH45yo7MwloTQX6lZNmypR0CGOLCl9PbKXph9OgS8ukhm7YIfeqO+kvA
+p7BDTV5J9ikswJ76bQoe85R1QQfA0+tCXL5P73EcnD/3RI9s96jisxWbRYyw981WMKVcPN5VeAzSOTdtsdo8tBpzW8vvkrPq09JbyuxCl05vei4wl7H5Jd4P
+VSImN65VoXxDG1m97AyYE3L/NAJk6a6chAXccLrucG6olgF4rPJCLjY6319ccxna2a6Ip7/vqg41v9Z0mtwWjifNI0EYfGvVQQuFwvFBKAb42LQs4DAmqSnI
++YtkfpfBGEUmDnVh4EUcBioIY+4sBY7jm/R96SPpp8jr/erF2t+lPdL3GlzP+J5zV/y7ZYMQckjz0l9vHeh1bc+Syh+XSnnnxMLfkqnQ3gz+T6ZW96tbSvJN
+zQf+gfRx7lbJ5+vAFydG9Olimfig3KatOqy/c99g360oHOxHrBCXJkxZ3z94LuKWU3Uz4GrDQ6sblwLFaxTy2vgpQqr81qtFqSzOSEQYMH4l2hqm7D10qJXb
+B1EqNkdo3JP0jCZPdC/PwVNoMxq/nnAkUwTN9ggVolLmGNpV+mL6A1dvUiyGSgPpXlo0RIdWDiUZgy23AUdmEV8Y1YLnrhPvlgmVHMnKcTLmVvYE5x2qYUCQ
+bDfOGlmwWStOxKO02Ur+voS0vTqQRXqn7pOCweinFBzWAH5NxShjiVjcm/Qmqnu+TXoMLGckHZ98LBsD6o2SZOGEmTKhzP+GGaFAodJPfbGxrdTvN80ObHp4
+K3cN9YRZ/v9F5mg8hsIVf4cJGshRWPFl2PWULOw09VBU9iI+HxGyhaL6KMUVRNsn9t9ekwhD9y7JLEn/5LjvpOlCwcMgl3stHrFYobUkjwIRzrTinsm0viYp
+RJKvnJMPZKM4Yy5E4pPqvivCibZe/QG6D0DODgAwkzDCib2DghY5+n31xvQt6ZYyZ+zPsJ82Rn06LkbwWtAhrwjPTLKasissc5adAMoKebECsq3lLCnfDbG0
+zITYpCSRl411QCPkgpnQBPVneDRN5cRraNWOkXe7CiD52nqqdiPqG0OPcXy7UMbem1C25aE6GjnRgg/YSNkvTAgPOgnQkgJlhaWHbYGwaAGeQKUn3Y/Q59wM
+AqIXE2rEcTkpcm5WUx4Fi9262hjrwPY1+flqQD8Phxzn7xB3bwxzqAn2G+f3WXXLVs1eE9dtyu/klZzMPIp/ol+ein41GaqjVeonDsH5zYO2UcuUNQ0VXWez
+XvuhSImMcw/6hqI1mpdcjyB3J/2Ydd233kCZyZasfHXz2aZlnM0sp6tY4KtvU80CgjMS5aEdNlOqX02AgKu4goB0LFDeUcAgqJfnTnYqAA7odXs9FvmltBcr
+81PCA6z4XNZ+FF4sFxL/XU6ZrrJsIqnaRKkwyKJYkIdmi+mkpRVqUOVq1/DyiMlC2aT0GQAcFulZ+hZBfHw2dLmlxm2xNHhNeD40dVy8OTdN7VBTQ3bUmRPZ
+HZs1RMxtkjwrhgw1zlrDutDCknXmMTbY7FecxpzCl4BN+924v14IPUbVtv2KaTrVDsHUnQM0jrXJOIx9CcwcxPnZxwpf7Wdamw//bj5MVJS14rwaV6SErTs6
+G7GglpABwXc6YxFkERSm22m4SSheRTyrY9Uis1OJsoxD6o7UmLhEUE4/qG6S3E1Qa9fOjQ8LPw1j434n/UX66/Tv0n9K/wt1XXpr513q40yj+e/kaJGs/B2t
+yesmGKVrd36oNL3gdrSEx0MnTVTmkdiFDhmRi5KTRqSI3Xn02JMHc8LiSHHb4ZfSenZ+V1xYPb+Z7c1z5rzqM3znzOLMQjgrUd9zLWXTwyvDYdMfPpdP7/tU
+EDJ1o1FIOUAZdPqLHt+nArm+69Lp3TRysHWhck8nuf6cnoUGZIza6+8jJsTW49AkZ51nxzXXWmRczERnMcrXRJiNUbYnwwkGbrM3Ll9DewRxOsgAJyGYmkzo
+Txz2xutZ/XVGPaSdxUS9JJ+VwazWoXaJvj1KQ3kbDA2HIWySGaGwQectpzIZBwAUwFOyQQFWGJFpYYr8HkJzyuQpJ805CgY4AUDke659X7H+1+7L08exqnEJ
+v3uzWoAsS9PoA8PCoYiWZf2u8L4/NLTBTrHQLQHAfmMx3MYL6WXSJGLcWGXH/QE69WvVX9RlNM9JjJvXorx55bjODUK4Hvscxc4cnVU5oaU371UeQlV1hKJd
+ua25IdI9TN5aazylAGdoUDHURfZTPyZmpH/8ePbGLCpb7JFjmBJfeTl/5QP3O7PDolyhxfqerikb8m3pO9N38fUSI+iasyB5fu3rcGHk83LMntw1yv8sUG2q
+lqR2aXzSpte76+D5u7YV8fb0va6OYvxwV1Qo2G6uwnUdSS1D5tUjuiK2qUd37YQPg7eakZ3RqOBkw9FJH6xZukudTyjEW9l2iLeD4EMMz/+OXUPo17r19Ebh
+rP0uYo5+j90ZBrXsg4LB/B7l4O3TiBychcMVvVXuAlfWvj4kAXw7TNeAC+tHz+MfUuVuukrGpBCvUodh2ZtBc1Qx++MT1tlyt31cd+JRNndC4SinMluFcTy6
+j2/Td6d3nt2/eSPaSds9f16d3a0DNjazuEGpt3yq5ZWaVEyDXk64nm9ObyH93neQykWbX4eGaQm4x0yiglzO7FhrdZcWEliYr7ZOnxHDwzbdkoJFRSfWK6QL
+ywG/dfYC+5U6IZTB1LP6iwybSa71Ps2udKIEMl3t17q5fIvV9RhDGfcUwygExc2LtXJa7V0H6MMTnCaR8WaIqCQkjTyit471gVDE+xPNnKUpT691tuE7hdn/
+vZR/kVEIUTNvrrWWzUcJSpamf9tfPghjQH0wxLNlmAq6SZ8AATIUHNLS9OtVv7CwryrLbbAWi7uLcepO0v2wvl4fvHafoSSueCDnj8uc2mHYTsmcwBpuu02Z
+aZ0hMl6TBi72hF+fjW0j1rrel+8hnn+wu3I0+o7DcX4LdAc489FtWN6GM5vtmAlA6khstk8YjXYWTNbSOmLVGWZS/njbDYo7iVY70oSPzWS/N9swuiMHTxgm
+nPfB1AqtNQiSr1YJwLCEWCSRJojg7gBq9ufGqedwl7/gRnvucnbvt1IflJT3A9vZcZiXxvowYb+R6Xt1BwzplCDz9Z5wfojqXXiduwf++rv74OCrgZRbbBNx
+cs9HcDW/D9Q66ErTNLllElOq51QrmA0wQyXnbiiijz0/r75NxuP838x4p9GWlS4pLlAq/qVbT7OTSxiYDMRju9cbVIRJyoKM3+Jxn1K4Aa8LdonzjYq7/KXm
+Y+qFheB33e7KMoWJgsYP/Qa50saK7uaAVj+D5qM9JjDXmTc+3z7JMtLTU8GZpBM9EG3oyhqp9+wFtwa3wjj2faKjQ9UwW4XOh/MCwevDotd2ZaNbGusUHbhT
+7yi1NbjyIPgJB+HMACdqAt6ueXusemnKuWV3tQH546g6ebDOPeWs0Zm7+Ur2NntnI+Uu6WWemBO2+4mt/S8mivq2GZbt8PVbS6En55NpLbD6Oe+Pfs42lgKX
+8uPLEd9o3IHuXOIsn7KoY3c+IU5NLVAKfUWyWCSn446pUuQ2vtblp75dvBzmw29nU29DvA6Kt4gSUa9nllD/Y85oTOZPqg61UGuIEYEJyB8oOQltxpBs1TW7
+p0pLHxDGzHq9a8VgrhWsOoblJ4D/9cwF2i66xTlxkVPkaBxela9cvLTBvBppS0Br1fVK7H1I58byLRT51GiDVaMaS3RDIW0aIqHtqdhadPZQpQvmEyTRUigS
+2xVo2psyJtBVQ09WklVd8pBfXszFvIXOV6+BdTYj06Q92m62dlfNud/Wo+Gsp1lAdyjzIkyY+SRa9jNb2jfrbmHdcK0d9uNpuZHL2aVRMoCa1VShADZdJGg8
+XnaXQVHRAFkP1ZyW0mMly9gbg6+K16YrGQNr2n2OehidHWbbIxGK679ysEmLxzQUOFr3/NGzhl3T7zjMjpvgHsTMWPHFD90ztMQKgtmnzKSRUDAnQCxNsph2
+ZE66f15YuF/VhoV4YGndNVKE44FEPI6G/h16sxsX20y8I44yc1KXvISTLjOuC3T5yxafcfbyjuLKM/lYoTKiHIhBG/hEccAGB2GuZ4ofhe8KLCpwXjT1zMM5
+zc5MY7YfRKqBbfOAookHA9+KFxdizppBaboqP3Ypq6MzP26u9ZJHa41tKHvLGQr5YJfyAZi07sIXLdTVANNMsZktcbs1DWUaQPWbB/Ln3+fY7j8tvuyFcdqd
+U3PtFTTVt9nJ93z/52Zs9m959G5Z8nE5JRql7KkhiYaq0HUszQY9oc/W+uOwgPUVVO6cfk58/vhdizNQ127lSh1dfSQHhuTZQi1IOSkwBocTzLdbOpW6NqdU
+SimZP/LhtJQH+XaXLbi8HqU6V9dkY1rYXlp8XFIn23wqndNoc0ySA7zmkl4pxlhOd0zTjdzkhlaU5puD0E6PPaqs86IflZyUXkwVVXoozyu+JSo1bxORAggu
+ZosTfOzX9oyymnb56wUNhbVy57uhCe/KpQGOMTCYgeZL9J9BVdtjJhs5FyRfLKUKDQ8y9x2+3ezEpay2yEeN1P877tfPpN/kQnp7YtacnMrSMXSf5PwZ0pSM
+NNRuSLTXPj/bODA1ftlca2XqAUTi2VtxWFBubbcGjAXtUoVBeVpOBbLlRgR4C3M0Yl/3wtY818etrAvE41dFCxe7jSFm63Q9ggJQF6vG+G3nYrhTg1wITBu7
++FSyD7ntN6sEcyRnBvx5ieEczO4vxxWaY/hE+rSv6rk4Yin9uD7Mcqgu8bha8N6XRsx9zSG55QdbGg0wvz6kFpVPGjpi9rlWzbOaP99G7/dhyxG/31Z3yLFo
+E8csguJwkBOXAaPFct7St+DPrbrfcip0M04ug8/XyLeOpsLnWkhlOGZZmBunAJQCSIeaoGo7O9pqlbUGVMdnO3ZrA+OW9nGxWrmnthVp4fOWJiMAjWNKudyn
+WAtKpL6OegNXKMWTDHWkz4sfCFLPUon513rtyqrxIfIMSXvdrt0kxzpr3+jlRsolX82HtJMhkcPIA9MhJQiKOQAw9WnlnOjk1vNbGeB8Sgwy6X+UkBOrN4jf
+kr7OMPz1fjRkyS82fjc+y33XgcuGMR3UGJNiHhpEUMrdQnHsvTnMxJpmjvxJrbC/hhLqFDymZiiHWT0G1XOxe1mM9j9bCb6Tw/SP3AjwnmPw1dxbY+5Mvcdj
+l8FunQ2SPGxak8sF+HGWMpu4p6GRcPbL2cY/aDsDRQW1ChIWcymtVvxyOD9bZZAzUcwLVhFy7gzdmqBIU4Ny6et8Hq6imGsBsnsjva+4uFte74Kka+ltD+U/
+wQzK0j+MNzEj/4OcTkmDxa5EOiEAu1X6w42FQ3qR/flTZ6b6tAuVifU8FWFeXz5yRGjh4ezmpnKfumRDNVl0gS11MiBnUzJ4ZFFOVF8BCwOHBX/1WbBV7LG6
+antoyllvj9a4wft35KLw5RpwC/9Whc4D7mrK9YO5OuVUQlF4cCWJ+pzPylasyWTHZs44zu8P653Es9GQmnvxbZT8o2thcdJmlwtJoNlHpudyyeB5PUmEzAq9
+Aa0yWTj3ECKLdeAlavE5xxg1guIeicr9suB/035qTcL746E1GOuS9HG7kz49btfbxvCyOewJZC0EV/vjuHlrXohno7WMpPbFgOlT4cQL9f6jx1WbwmWR4hV9
+gFywFIR6w4Vwn3/O9Y3Eavm7iPHeOsyuJFoa94ywYUvTz9W4OdQx3bqm//yAf1+4P9V69IpVoItFytGzR86nk19/wjB0+g4WlEZo79DHWF/Vxmts+feVVVyS
+sqxJ3/gq5lyfB8MnGwmr5/lcqL/Lj/XGCeE+3Se33jW13qRrXI1xkP08NcgHdM3P9Al1IUGRI7pB4wDKPOYGqrYqj2fdCf+Me8IZzWvSTceZ/79Qf9yvRWpN
+1H9ZgK6fwscgjgrcpyEU29xnekxJS47xyPYllSFok8onqgEiKgGYPAKwrkGVXD7r7aIG8QO0en4i/Yx0DVFd6eDqSof9Onb3hXY9mtI2BTZ5Oz8TdXrvuihi
+JzPjjmna13LR7QSo169JJxQTyie2VJ+U19IghujcOYo4NX7S5meA2Ku7Sj8skaLzxQgCxMCaPbdP+YNbgmLj9InQIfHadsJF0cdO1Hd6n6YSiVJ0ByAQzVsp
+Im7BN56SaZi1hAWU4WD/lr5dckIxvbwp3uDOdY2f3ion/PvNbmiErMpfAV0nDf7Sr7xbTkGRnVyKjuWtV3ZYxLy2/pLjtmHycCqclsuaodeN0Nazmo2MwCbV
+Zb1D+RFdI4N3byc0toqWHe77VWKOS33270vvp/y35U2kt1rKrDPn7jq2e9D3xu5zNSrbhTzlie5tc/cKZNWyVERQ4rAanbkt3u/LuBC18JEJ6RvP1Gbe57hl
+GmP3b4g+DHWxLtdsyDVoHsbmoJ7FVhiv6LO4+m6teOy0+Iyrri6wdei7UfwX7u6elXvKwnhNR89L/udmKbNXuGiTORVveIvSpaNJ0ubC7Uix9SvF2qri3yuK
+taHOP6zrpxlXRgSNm9Uy5GrtGXniemEnaUh1McRKK11iC/scrqeDWxWnt9TO1b7WpSP5DhmHy8TQXtho38zqUu43jmDn8eNUZmsJ7K7c5WoNQ2r37NRVuoDl
+s4aWJHzJcB9vMu1DVUL6hGF4zDdsImFUHKHiqeLl5mc+F4aPUV7MP+fvtTuGMGEBIT0BwixhiFsJZDFZLYjsIrQ+2qG+GWGFEHMXzXFUbrdkaFLmH1rC/tfz
+gE+D1gXxJVudShmmqeF13xmxjruevEXb9LoMdha213eoRRzJ9tcTsZj4THN/wlbW5lRhjoZJraFZxiL5ZezrfJCLWUxv5vvY933Bl1YkgT8LqQeyZSbMS2ri
+Y9Y0sYA69NPVwXAngEL8x673FvxHn2NtjrJI8TBwmL2h1nPUYRPnkByfk2oIe4claCxy49EDiup4nsXQgJQhZ8iVQWAbp8JAIrM/9HIwTNLQif72Md1IfS8u
+YfCemXqp8qB9wSMrDQjlPdbg3DdIZXVxl1Bpfg93I7NKTpdhwj7nhi3sL3zHnNRPqwXlFMwYoplhn0vW2vM707sXskyOsMjyGE8wxPPkxVkbbGtP7tqULljg
+lJyWAHuhNU/2LqnTfEwikJ8mP6OOZ2+alVFqQaiW88wPpXh913GPvlOsjdiebeCr2m/eliUCYbRYKaZfdJ8G7LxPKktkU42dkjST0jhnIiwIgy/LDQnmaEtZ
+62FN69x8b3pXh5M9jtom69a16pou4g+uFpWZNR7NHufHu0yhcsVXczo0Qmkq1VPpvO1mrQSy9JKzrS+km3RrjCjNp/ytZmN3cy20Tvx9uw98bKP/toTr56Lx
+axGI2M36gNohYXdHyNl09Zc+ECno0uWhS9pe8izNxBvVxx+5iCOOM3/xxbSmDFbFFHG1OqD2t/tFe7W1JHqF5lEe+XozHq/o1gvXQtzrMCQsIdWKanxOBcnw
+TqWg9d0M3KXfjYRW0CpNUIrw0iI00NprA2a6Ir4+KTor3Qmjx0xLMe+FYnURu2fElbfu426xHFtUUgpnUL36rTK1Leppnzy+o440iaSfzyCkTofsTaYm8xMN
+VRC5WPkEtexc9/phAfG/YOkW8811zQ6eXbChmnrgBzZiQcjJfdHj5gMY+bUh/1zxAzXbWBkr/alL7rLWAvxdi1LLVuVTl/puMWG5KD901Q0fcJL0Y4vdrAO2
+Kd7M0pPSOddkxACtWWje6oAQWiyLp7SEFM641mvMEfVbLZr+kqoJ8Bq4Wogj9BRjXOpW8vSchDOgYGtEryga56XUn+TukK3H0dF2jxGGciCI61ygqY+LjLN2
+Fgu6GKU40yfooYnWsukDJjrR8KPIDL3+Qi/737N8JFuIn6X87VlsnRUL7ZR/W5Z4YtT5E85tJuykyZhpP5+D4mERl7Oxi2u3GI3txLrP2NRDF9C/7aHkM6X1
+ZUNawpPX9fLtVmF9lzDHWXy9WiybCn78uj/vyfjPPfKYIEDJteo5P3X5gtVCLjnjfSNjypy01ZzjzeIeuLV+EfbXf8ROAVXbsh2xEFnfnWW5rfSQJjS8aqJw
+LuV4dTblWMNOPKUYV2nIOQkchyyI9t2fUIFYpwLZ5ZJUEUr3BUypIAxpshotSKux7ZPFjGPEm75ZuqaianPDSPbc3bW8rFpZfeHGkjNLaB46JieDSyMdl65U
+qRTI9d+ExfkD5SRk3SI4lGKVRizrIqbnWfoRufe/FfKKj67TdDV0plvtqjW+Yu1PlLP12/HQ8opnqjY9BqSAcD4DuL5TABhc+ywsVW6shqXFav0/QGFfoY79
+NWYv3kN76MeJFS/GS45bcn90AvkjkQh0vlRFytAzwg6N4iH2EKnJdP95m1AdG13hQnWiq/+RTv0Qkd9xingEAEDOyhRbHF/nerP0fGhdNVaxX6jUXnsZYBk7
+FWzGI0W6m9FkfyUPJV8tUp+I6aeqswi4cAxf+FNXpBbqVxaQyxL7Ygev73NNHxbNQe0I/RXLPbKHr/orRs0zNtLusO/Xrvm+mkc++d36nrd67aRaPVUDCBT4
+Sl5b7xiW4OkX1M7d3OoKQq+GUr1EBi2iLIb6hnKaFSAhctr4TOwHra7yU35NN63nvek4ry0TcLuUKV+Ke2/sbrd4nw++WYObJm0mvd1lQq8kr8EvdqUmecWw
+mZ1WfKuxeOZ+y7R2q1rpZq7WSvXGG3nDq3oU0L60w9zMXJt+G89WuoxT9+LEp5QNq8asaoQweDL1zdx8hWryJzAd1QwhPFrslVH/5j0+rl24k3ehb2lLGcbt
+ZrUZj8/qcb8XkdI6GbuHepjqgAAIQF4QymK/HE6J6zmZ8iDs/0pUy31ZNDK3ftXDuUnflr6LxvJJxXfaXu48GrbSikilN1EfKXH9Hlyt7EAsU+bA3UiERcUD
+yOhgApKdm+i4oIG2Jkd15kpz6bKXHy9MsIU0TR5eXpz5Cr5qY4s5BGaeUEHhf5FPfQayYdPkRYKsgKAzVINiKr9wNrbdZJCO3yKr1somFqBotD8BZiZ9LqcO
+/5fT83LGbS90z0F4DCOe903Wy/4eqaNbJVgkYEwsZrtRCrsttQN1fof6ZA9lzbnHs9wn52Dk5Npv+pLnKmmDwPPiVfgtj/5Sxy3SZx4/Ejv0Dw6NOh5vF/oA
+OmBq8zbnfesnhm8x9nvOOyLjsrHlBvsT2qjQDgC+2RUCOD7kn3bSs/9xicTP+k128yTXPNM9EzfjgW6jzm1qndRRCn6Wm+nuZP1yiL7hEr+U1zD/ptCdv4RW
+sHIeN0Mcc0ytbrtIk32sanNrEHo8zKIHGesEhatygAUKhkCzFMwg2UbFadQ3cCUasa0DcatKw6TPeklfsGxB3X8/Sj6D5NtaliQ7QTcRbuozidczyZM6B7e2
+1POCt4hSQhPxugjQiGonmYZUXAHIgoISsouTv92iBpXBe48vBf5dzz3BPgQj7gO2URmMTLJ6Rs3Aa12rmecQkL0/Zff/yuakqEgdeU7C6Iii9AEzNKQ6V3XJ
+JMj0UUrbMGdfUwMfKtwusggHlY+f32rdQB8ijZ1P6gnVUNnHVsTlDb89mkz1Yi1hR5O4v2n5BZpvddEZ7eXtePWVpNUQ69V31UEQtekEOWEGZDs26UGNrjjq
+icTNKwulYoDFHl7fr6oW4TOi81Yj8HNZtpuug1VXADMeZlVCOa4OnmVyrYqwV3Ki7zzZwXbe9DoVwAZ0MM0TS8gW8ji1z3neaGoEATYRheRQDCrFv6uUYnl9
+cD0NXJt6a/qk6B//qrCm/0H6k/QXLSPhxA9pqKujwsXVQd05qfOmv6+B2taUz6/bBlzCjyo84HoZYk1caENpHhwvgVyg+Pp5fYrFQUFluoUavCGuPSqItxPy
+L88mwSeJK9Q3LJy+ZBJTg2Kna5lfjuWrh9wYgD5o8fxPiQ7lr7UKQl8B3F3mxtvtlX2LlTGItVo+P5DwxVD7k2dT12ZOLddJmFw53Yt92a/tvylGEwOd95Pf
+ol0KOOhrsD3/hfTL6ddJBaz1ZW5EUnsrLMn7Ho3q2kgIkaoJgo2Ko4lNY/Ze3q/8wijK+3kOcoCB10IxkJuHqvKj5CTOtayY+wR4n9CQqzr0WlFs1RUAHBJO
+IA0PSt584p8qCFRrF+/m60IMfePsusfpmCLIZRGvBc+GeuD1JwK+e6ELJxxMKl87w6mWSbcLqMeDfu9k27je59GIvDS4iPJotNrSC+L5cOzc7PivtlVjJPzG
+tSetOn1DCy8i3+7KE2JQTCom07q6JffPu34eVtGSpgBFZJS6ROkUmmBBSE9oK5zU8oDv4hoKvdm69bnNJ2nT7ASIACs4AQrBQEF0CvBgcwZpla6d/Xllhn2m
+9XNttTUuTY7d1wt12Bw+Gfl9wEK1wP2EPSw3spNl93HSWlMM4HGpMHmffEUuM6mkiH8LtiP4SD4n5fGc32PsbB8LOPjj9uzeqeuDAgrmARo3eTG/at5y3Cui
+b6X6mCHDypy2AblNN70Ap2iSsgPG2glaz60iaDjP+lmqNc06vvTIdi4gb5ZxzzvjmqHdSzd/ZyDGuvrHWUHFfNTCzl8ugOWeiyipw+jSX7YF6HNuwnxOv6Hk
+NCTwfaZDApOthIi1YMUCVr3783aSKB7b9365aCCmlr1A3kHA3K3BwxuTjVejCN3zW+Lrq/9Ld7SoNybdxJ1iRgmna/M1PPkYDKXxNWLTy7NDRewtvcZvwWwN
+9cBwLl93QOMrfY+wkvScHWRTJQUtVeU6j3waO/b8RXjwEgXMTqcDCUyIwJ1/WEBqCqFgj4UgwSfQxkFYmR1h2dipaKmpVW3pYGmSBy054vsJY3eIx+V92rIl
+MgN8snDiedSa43x73KgVPI428F4jYBdshPX45VQG3wNqpoITQ2zhJ9P0m4CmogGFW+JLZ0qtoDuEQy3Oxder9AHr1NcK9a+KLmtDYDk5a1HP2z/gmVFpOttp
+eRM9UwkfibDfgOQL64TLbzWyhNg9pEyCMmUI3E3JUtN8ZqpDVjcdZ9M6l6ywp4dBD70g5pbRb3inGlOy9/FFUl5VLJ9wEJqBDcZVUuLVO996wtZD66YKld2I
+gCIlLja8vhtk9Jx/oMQ+UzUVTbbA5xU0D1xQk74ZcsEmlETPJJWM5ZTqK4M3SOqEoB2sQZO6RttVKebP0l+mvyGPzKPcTPdOdbmd6s6dP5da0bp+m+mC1guF
+b50LLYhsF1FTY8cUVJqW1H0CGABiTqGnEFrAh2V+VdkfEdGK3k2bMzcGZmlxxNIAVMY11NfKvCX+qdCdqHEiFQe7xO16tAzttvXpBkssv8HJvtYf2Nwu5LVO
+kFnqseTEbeqpZCQnxLenFcxcOdL5wxNIiQbN97fJnGy7OQPlpxdj6aXjEv6EZTu/1CJnwRNKqm9sBzod9sukA4wZEQ4O+iOL5codVYfg8o/Hg9hqLqixJn8p
+s9XCTqgyb0yl+OC5aQZ5YWho9ZcC0sdRWhikxh1hQetFs1oVVVT5R1RJ21bL3dFwdEIKKc82BydZ+QD0Up0Zaug5cA32EKuP4/GgkwQDsiQzFK6lYbHhzI51
+rAasKP0M9bSigxx60zR3+7lbIAPr7LPWsi6eF8KeqjnA9xhnr6sKMHKzy5bHJbNfX7evpH/A13hmPn+Cspwjr/8mgAWnH7UTvP61jaI4KvoJ9ZCd6//1Ttuv
+RjOvkMf/kxTZ/ZJx0fxe+oOWzdM8geXg9FByuuFb3gAmRbUVqQbG3scakJxMsc/LqntGW2K48zYfg0/AFXF3QNjgLdeErNUFVhmbsIkkubSCg2dq4FFabyL7
+hJJC145xbAYnnGE1L/rW9I8phviXMQ96reisvZsmMyxrfucD7pC8tHWMA5Ki0gI5RZWHGWxdE6DqsuhhBRzltsFbni0nIAg40DoUPLsXTW5fyfqboPOMoHuK
+0ieRfX9Lcb605qiAqlOfW2DJWVTNNZ3chcYx67DN3JW43zgY/Ir2pIBAt5vdkudop5OYlFNkSeXaAyBHVSiSm0PpSxGlZOj7LqVZNqvINuYE3LnVzrKAlf+Q
+6Rz+Xh+jSqBJtF7MyTJfQdvjwfxKS3RWg60x7oMhqixjaiOmOKTvDjlBxlLYAywYUeD1UQboO40nPfrUk7aD30THJ7c+5Ye5eVErHy1SVT0EBp+46v3Lbh69
+ssMPmObw53y2IziT9cRvspwHgchtz0Wst84V2AV3wDtJwuHDC4dpjO9T6dtZmdhYYFUImVxzF7hJJ5KEqdCFcBMKEsdVeZyP1M65dxLTy6cJj/Kl9Ovpnymy
+dKt9mi5+29hSmxE8bI5M8UCYMwdA3rmGk8Ps+Z2cGZwOPG7tFb4NkiT1IpQQFher9pd2UMK9mOvWH5mlB53m6lQaIwSvkMw5I20Bpg9I9k9WHM7ygM/Sexx7
+G3d1VPXR/3ABkXtoln8r2yvGIse74yyOOZ5tnj5s8kbvDFMHHEWSZNPwfxTFePIQYnbXkSMGTLXAuesrvqdYp8vKWUgZA5gIsQ3aAhVTBPJ7wm4P/X33KYhG
++B8PODhwcc1Cx7WKrzTkZ2QD9OWJriWAVuhOe//UK7uTFrBDh2Fo5UOjBxQWb/qaUQ4FM2Q4IZCsRmx2cOMsxTwZnRL0Ai7efPaYyIZm+EL6rfQH6V+nf5v+
+Y/pPwXbtVZHSV1YVFbo5yMwcVC54v15cYMrjfnCywvPXcptvP/fNx1XtebZ7AAVhpvEEAPjct1F2ZIxq7cD+nupHlESWJ8o6YVhyQ7sBsujmj4igyG3hxv7p
+F4zx5bfSP09/mP5EcxWjbdrcqmlxC+83scym8hgK9qsGM/CI0q+UHOlGiytno6vbWbGWD9OcXL6ge7S9Cuqx+J75IZUSKLVXCxQhAGVIwjorlKRQLHB/Hokw
+tQ+QeRWW+76O0qH+C+mX0j9K/5T0gy91umTPeqLMmdwauHNkOqOaRqsMG4c3JdmuW/LePs8IXDEAA+zYZQoQQFB0QC6iSZlA63HGOUQPtLIFAkzhyHZLHkuY
+blHVkzjXLDhA0P+v9uFD6eOShf98+hKtV1Zsp3m8c9CmgzJVtC832g6wlvUp/9bXZ6h4Fu4JTXZwMs/yuPdoHNQTtzX+zBvwZEGfMPSWtGopO4hiQk6W8QdY
+LAW5ppKQ44dgaz9tnVdBw135aA4RMNbZXwO4aA8izfJCou3aFO23Kh7MHuUte5NmSY7bLodwCM6lxnFI5w86H7zrXRaYCr1dUWKTCQy7jJI68sbn7s92ri2L
+6+VuCQZwf30kv/e/K9ROPh5O9l8PUbTGLwbKkCV19LWEzWGj3FEVdTkGJTZhWKO33SzUVm7ZONT/5L2ZPx+Ob41iPwz61qJ73dFyJOUCZSI2Eptecus5QT4l
+lflBodeakguL3Zavrw+lnUiyVst9cquV/3e5EWJQvyah+Epfk74+vT59IzHLJxVq0YZHqs0eyNNpvEhllUA7XXMSxYQp1TEhi+CwbMIg1B1o/giuwKL29xK6
+onUpfimlvDaQyFahFIe9wbi446xT8YqSF14W+J2BwoqIi1nDdOjdBH/uSI6jVYYhcx52Bc/rd5WTg90SlObGBjhqQnvpaxPKun05vTm9I30gfcQqXimHEsR4
+zB2qZnzg62wSpwzMutsLIHXcs9MKUKAADA4wNXtykluJeifrXRzM5cyLvo+ss4KEAGQKJABIaTA7WOPjJDpfm8OSYKD/+5gbTr11q/VhTV6yFj5Mccbfy6mU
+gitOBtw3FcFEwgXzG2glz6JY9rhO362shU9fnR1gUzNQezXq++NBqBWOh83ZVSkWd9IVxovtzHK0hQwrpRzA+qGUIvcHKX7/HK3AVjETfVOjxl4SfD4+fgaG
+RA3eTt2sd51L9KYRkH1BfxYMy2NMKaWVrbXPEiMvrbaRU3bjshygYkifvOqqMT8ct2TjuFU/bobFJYgaZkxlBtHK5exKfE5btqSvSSD36q3U0c3V8qeswY1y
+inICs+03/3xh0U2qc81ZpPPrbJVK4dyZ9C3bs5TSa+zMfqNq6WXV+uW/G3FWakPl40dFV72i7SIA+LIw/6dEx49dEMu41l6kZRNO74NS/Lqq0cXHUtodSflA
+hHPFh6hNc5zb3D1+S9AdZnaMgsV8U+jA1g2RknEqfLovb4OvtWt9a3qn9IlxfvKnn7hSvFxMq7Yct/towcRqDThfNInbUhspphgtQCjnlxB3ZdDst/abDN0e
+r+fnu+sK2qn273rL4r9PGmPLPvK3h+iUxvXTiuvSA/QcAFZn1g8UyZNBfcjebRqejB5NpOhLq0nOfCl5eXmKNfckkyjDE+7g4bhd8d5GyKkUKRG4MeXlQUJB
+YZ9R75ES8pATFro3Kzx771C2OvXMq61SVNOnnroC7QQSOcqeZYRK6EvmKgFhOEWOtHUpKK3K+dU3qAcxaHBc0trG8g7LLNQ99ROOwVdrnSk/7SRxbISv5Eio
+wrtN6p49x9oVtXV4RTd2cJdmQ6yhUaqAzg7aQQtSheJvk/7NwvzcK/rXZb39/2TfQ8fxXNG/nyG8eMo2nqB0xK0Ejvn6+mkr3VHFD6k4CbEkKMQMCwudzwGn
+yjtx0XZg//3MIj9xZj+94NYEs6v8oLGs0c5+8jpoFtcyqY1TZVxsmFu+2ZxC5qYdZVCZYovchfubnAEe5r4+WuyNZMu4CzuiNlvN9Z+k3+aoJnaXCJLobxXZ
+9d3LGtPtZDtUa9lStRSAwtJCuBDlZfnRUJA4d/Ly72mbAwMBcnqdxX0vhU5fiSNuWHFIfbOnmfk6LVL/kenw6gl1IqRJpGiBJewEjzITwhmF5gE8x1U5O17E
+ppaQAKKP917BAyWHZvckgvulcCLqMe2fEF1cWS/E0GjtFwTflmwBp18E2YEmgmY5QszLcwAIAMnZujsa9VfcqN2IF/StW/hhNfInnvKGax26tpiQDl046Z9r
+klkcZieLnSTfhtMlO8i24DXmb35Car/paqO62IfHm0BBy/l4eCNzU5uBVq4+IdLECw5nwcmBBjjhBJARy2lpDKXRDk71tZJ5Lbd1jMS7wDs1jS421mtV0OMT
+Fuoo+GyqjFDrdrlPTeM/2iZGX2sITN8747soY6+ryXMP4IvG/vVxPYdvgjDsEzzm3uFYc9P2epQYktOBh/2G8T/n3OjGHs1pNFmyFxIYaPdUczOYLZP4Nbb/
+3p2+X/pOflKyaZpLe8LtIbwZ/VQQDVbkCncllqWAh+VKSTtEtT1bW0BZvGmG5zF5KJkfl3fCtBamiB+TEzbtGA+g6RlRSBkZx2RIuydYTyGt41OzWOvphNqY
+unh2PC/kY6dGIV1KiywuuFGFQSUAEM6MVXpn+sGK1WLFm5YV2CgxabOcVvLca9dYeDfphlYhCDcH9IRrUvKlpd2Og7SAd5rFiPMKcqstZZDbjMhcPvy06KsT
+GVhLMWSVIM0JEUuLk+rYj+nzdGLWbkK2NcHgGGxNzY6ZyfFVGKF8BkYzKOUybUksjGolrwAAzwaMYEAZX4K7ZK5ODSMTcFWsE/vu9OH0w4K2rpiY307/Iv1h
+s1v5kfc0t5yFJGjG9eGOS5PUFC/WgVq8dyHkqnh1roZrDYGeH7cCB+gWw3OnoUbbHlrbi/GvIUCdXe4fmSwlu7w4nhdArD9dnhc0UJLW2UBzuFB8L3O1Ep7H
+85erpahLpykJrQ+Ko9Va7OFVrZpeZqGeCL3nVRYToc8dDwUAALJhmQChlBW9tHzCpSCvAADJ+14b0mCrq0U8EA8nrubiiRFZq8BuI1dwnbuVc7C53JpLAIYv
+nA0SP0jqWzxtrXzymxAGOBeZKVFh/TSxotKQxFcBOe+/V3RjPB/L+uBBYwrE5A2iNH1b4sF69LYSfPWAuspRXWWiFkEsQ63/9ZvkBC268P204iwwi99zWJXl
+HSHqQcn4kEH05j4qnIyfl/qSZvslwz8ed4r8eMoCOKhA9p17PlA/vd7SDGTyF73rglD/ZYNgiETE+TNR3Jkin3te42oXa//AJ+Qe/wPCtfxh+lfpz16dZVSb
+KGZBsklz6zheiXX0BlH2w11nHetbz1vIDAAFhGwlLxnJDMjtPAXUSGZ8yE7Sz5f6X/Dh2zAvBYQhTwpkpeP0abihVwgrpD0L/z7939qcXjmJlaf4ytrzwrIk
+sw6H/eZANKB7ElS9k8mr/8nY/aj2qW037ofQZdQXqx3QCyQgtmV4L9+e5iRm53OjtL1zuKtY3Jf0XaNU1XMJCee6oZrPR4N3Tn7qOpANPjH6FUrCPPNeedXg
+UmHxRNtLssMJBSoxlUZCeCHHfWLHtcZuzX99ncNAH6ny+IuS//rt9M8166kF1Rl3sHQ1c+5jTUNbHQ+b60cbY4We8OeVwFGkLrWhbig7m0qrFs8c2pNh+ECI
+JhWWy+Af7oPRPvlTcZPU7cmTo6lOtkjSldVAPmrsAE/Mk47CqXswfRkl2ljVsLrq4xhF5WKE1pTuCmSAwlEzAh1ZZ05e9n6VBG/iBqokCwHRcgVoan50/kZQ
+3NOSIb7R2d6l/8gJOa4cLxjnElpjaRwzZ7SQ30EHMB/UDa/9vCxmt8jFtOJB1mMs8t5V6/mO9O6Q863YtqQoSo1Obo+CbOXrr8Pg27emQ+SVYauL+ompf58e
+VirLSmNarQFgO2ToaZaq5Lky/Sm5iiWgyqDqYxBXhxPqcrKcjXDCf+PiedZD/jLhUv84/QnXAe9UbqDji+PVPheQbUKHT/PgA5qS8q23hg/k9IaS8JeCpe9G
+Xj5qSvu2gn8b+Lpj5SeLe87Dt4kverD5GLnW32vX+79J/55yIIuN3bGZq++EcGq8PJGtf3t/poH77vFpFFpyeti6ztEurRBbustyUUK7u0tRgc1SZqkILGjf
+OFubgJ7z6kXCBdQ89T9Jv5t+v6J681Gqz2RP74w3bVTmjTBpB4dAfeokXYgij+RiwwSQeTzE2zKbvgdiSjqp2FqX7n3ymxAAz2YaNcYsAOjq2Kt0IM+l7ti2
+XxfpHcdOcMV2apP9Vv4FO6cPr2apJYDCHglgKWGv2pQV17E6T91AWUDyFSP8wxav8Vsft74qv9H3pp8jJj/rZ5qzhF1tNI832hAPvij0BFdwFOr2Y/CZzE3a
+CvBw3BxuzVvakqfkqMX4nC+N0WSpXoY5ha4HoUWqi0bBhU5roti7+eMZvMikcnacQizgdBzA6ZXx/L4jvSIe1O+mfyEx4F86r8P1fvCXfKw0jDNN9BuV427N
+Gz98peDn8Yl+aKAsBZWRJ3+z3Fs9FsVHR+GtI1gwzELGRjgTKMp4eidOtg0FmAkP9HP2OaTeNXV3zuePIF2nNxK64wOCG9w7czgaykDAObGQ105RaQquGQy4
+T0B8kVOq7V3NanHVZ5UAGGiQ8JTojstOw0KbuV5v6wuH9CJVAN4mLAJJGeY4u8XIvlaZkvu52cWrtr+bW3OTCZMuyIFCFNHm5OpMMeKML/aU6hXLtQPIhTOk
+rl12av3s35K26T3Cvf8Jum5ahVa+WJzl7bE/bTYHa086HjTpdmWd6VSyiFOO3YwjwATG6YwM/2NAR8utAko5G8H5udX+K2PbLt2ZXkJlR0nZz7nch+xyBgTH
+3oySAtjbusnzu+Lbxw/MaSclzwmkwzSrJDkX6GWIJ9lWQvsB7OX6NSYZasRBDowJ3I1Tfw+gu3+8L96S3i4VxOXRikSEoM/X1N5cn4zri0vPDavePRbdL6KQ
+OEFbfv1mEWIGutWwuADbWXRNCKR3pg8b96mhTkezhvv1bmlcl3ZQvFe6l1CsDUzob9mptP1E375PUEDQqIJELd3NiltL75Dcnxfc/ak77N3CnmXqNGLDuvsk
+/VibqzCq0DJosLLIOTnvZMtcEyynQmOsN9Pc3RPHxic56KYEEG9iYXhnkUFK1x83rVq6jo89l+Ov3SGs3JJ2x7FpHUjbrtNmoxOLGVnpc7y7JBN89RjbSPcJ
+UDChKO2ifGNL9QWCmSRTo5LJ3JPPX9VhZ5bEX7aYL7r7+ffS3pizGU/FdcFzlkbdSaa0Yijs2WVLyCkjXxhdV4wleOm7o1Q1bBUPii+DcDrkBFDUYwduys0c
+YHfmZ5WoXQFyOc3ODaT8BMrsIS3x9ELA1Xy/WVxmPftnErO0XpK1VjfiNLUGE93rq4dOxjunlE9TtT/a1NXJXrnMqS4HNNBXXBVW7Tgh0JKHXBSXWKC4ozSn
+iVl30tLBqnsCWVUz+TpI9QzYArCielspm60SnIzk8I5CrlAZerldbdGWM9PXZa8BWs2TydMLBnM+JNBjSUfN+4gdwizfc2ZdpqrfG89Cvr7a8Z+lWh/FFJxV
+GiuSe/nei4Hb7gNvm8b7296xWx4sFHboit3unom6NwX8nQFMlkrusqSO6QOWMqVle9Bs3vekd5Gv/xm2edatyKt+vzDoteuoeqSRK9yI6JbzmUEVKEZ1RSMa
+6DM71wvjaNqlN3QKq+/00wu9H9z6sXwSsw9FF794Fss32g0zbjMIizKn5iulMrlTmE9iAKZI9ctSabbtKK4f21Ec1uf3EgvKL5DFTsLZtF976Ab9C2iWapka
+kCVf2JSX1ygUxmyUmP4N4++WaPa9VwxiEdbJDAvxyczNd9iNb0y36bvTwTjmFR1fq2q/PTu71iwovJVWE2X0Pxx3eqfH/TnncTycUSA1K63dAQSPaWcYgBOe
+ri5ZTiZFFBlKUXmBc2rY+HagZfrL3SqS4JwvE3cfxKMJnAUvpDdbPLc0Z/8o/Y6ccLIv8tP3xZ3oiCi96e2C6NOoak/WV8HcT3zGXdpA9bsRV67nvx4MBUrW
+UKPkh7YV+AabkK7D0vYZ0lr7Zoopf5yQLv3aOponcK0lcbEx8mFjDX/rR+wsWz9a8AyJilMXKdsa4dOdlsipvCZNSLSMOBWYCOzHv3ZpW73O4TdviB34HbZG
+Ps1rYunkptzh+CgfJ68Pay0UivmA0gxFUWfuFBy9gjABG0xw9xKe10IglwNx5rnQqEGE//rY7E3SodZWfqKzPLDRjptRVbxax3Mjh5CUn/gBZxlGjweDKa04
+C4RcD4IylGYZNQApMBH5Dt90yiAgF30QciOUZPXVvkeC0dWBWBStIOJxHfXeclxdz3fPmfhH6U/TX/EZWYeocD+9uwEmf7yTsfsVwXGAj2GdU3vG6/VUJ2JL
++wb6lVKO6cMbCADMAFPKpk7Z9gWAmVT7WmZGEqhsAOgpry2EATQUVsxl2yqaz+CH94drTfIzhAf6DdK4/QPByzr1F1OoHS2RrJLfsZy04FtZ6ey4elyGbddm
+rojdzBZWllKiz0U2tzuph5YBld/BQ5cKUTuVWr1t0bRAF1d9CyHDKzPJTxhi4V/wbB2MjV/kqiwbJ1Kn283i7HDxTVacI7PnPbh4nLuSZDZfBqbiQGKKmbR1
+Rsd3l/zSE7wIkkHEv61wi80P13wmGN92gYWju/dh35i+W9D0nyYNnZQ9W8vWUusbJg9ZjNVH5+UcD5dXzqo0vtXSzmGWaWMHpoQYC2lzaqNnxhCAM8QceXQL
+ztzrAv/P56g6WBlqarX1f3AVnKNzX/u6tN1uxRmG/TajpNP3716d09swu6tyrpZln1qsNuFZnzhbrUYYqfrfhzj3UgA612Zp6/He83P8DsrYMaP0ryiPO3Ww
+qaK2J0trp9rW+sSOq6fO28EBVyWpR+k8z2mGxeo25+aJR3dCk8zIvmcKpHUMVpeDCEq1s6WKc/MW06hSjONfSD+HGGpR5+WMM00NFa1HLzm+YL3367jsspkp
+K0gvONHx2y3VK3JpbMopOdDHz+IfFtdmV5ROgWW7pgQI9VcNqbjiNNko46LTU67L2CO2INTjcW7Sm0Xzv1UpvrR0Bo4KlqDXb5+aAa/r6Vp4Dv1a0nNuKoWD
+hJYJh/5oyyKTkEs84HQjXsyJl6I4LNRF1DgO30bd2l5LQzOHC7njUV6ZZ4l3XIQ5PwM7V+q5aV3Z9HF0s6KZ5CHVZy2HnBM8L35OGqhTtThP1p3oWhNdShlE
+Sngx9mzQ17jHthStv1u4MHl20owfeeGM70+xw7nZsW/ELWTbR7QLdCq4bWpKrDaVrHw+JWVNpkVCrp/tEZiSZEwR+4IJWRh+pBZTcZbiziJu0y/VTpulNOKW
+A6vN9iBJYfGnz3jS19Tj13bFgWUfKNtnwWROGkLQpzTfEygQ3pYkzmVSXvHeYanfymzGBZERa5esNMP8WqqvEiLng+75ppViS5vl7RwijQAF9Yeyw8jvHDvo
+XkRm+F9dqHTN0kNUBAMgI6m3NxXj082FiIqZscnvfxoYrOqtJYVtKljTvZZuu1KAv1kfMUf+nel96YNOt61XB+EuxqCcImHXkw/ZXX/rmRlPOmwRC5w5V3O7
+77Q56vest+6Bw7TYjX8pcC8cpO/z10xfs/p0f8Pnwejppywf6QtohAVTjba5nyaJllHxIOcnpk1IC0QlymyYzRX5rhgDZ4AYYw6C02ypifoGfSfPq2huisgV
+Cj+22NNiG4pVceF+PqN0n4rR9ff4plpl+U3xTlSvdGPRwFqTvJutCXm1TsV5nH5YN8XIo7ef9MOPcYSZxtGBIrRFWvHePIvBNWmI194FLkqYQlMtNqco1kuC
+c/4pAdVyu/ii58uGPqzLiqQ4kCX6+fRFl/f4KmtFzPusVabn+thBoyUSf3L8cHA9Fz4rOra4q25YROGhXa4m+GmDkAnlGp5u3fo7sgs2L+/kPuCIMfvrCUnT
+9LFqxP7HSznQ7f4otBtrKwX67W0tJhLTHSnFdqluvBPBiSX05q7PlaKn5S6ARdglfE0p88Fn4M2TKxrDUGzRUkOqbuZcqNMnbtbSgJs0Yy84zq63pO9I35W+
+hzhKfoys/1fm80Vola1bOD0j4dlyxLWI4FpD8kat/xjnhAVVaOEUzz2iZ76U3GBIPouMlWrdEub6r8wOf6zvtFIDovf13mtqTj+ffkljzdCXQkT8C+VTa1Fp
+HkID3o+Xkzq+DSXEmnRC+8o5amNr9YSzjByMlwhlYnKxOOF5GZpXNCQl0UMOWWcZDg1qLVDwfvBt+lD6jGkXEVe0dCdJZbLLRdP93lLGeaf2yGU3DuIUXzMC
+x6m0ZPOPKPSSLgj1GO441truJUldNJFnaeoCkAu61hqcuNvY2OESU2MQqxxy3QVogu6tylcXitglO2aN4RvgOS5g8IthcV5w/ba79AGnRFfnrs3ccRvmamVY
+6WpktmSYxv2c33n/wBxlzShSs4KkRFhp6qDZjRWfXpPMGSJgoTz9kDgxRjtEWMrDjKmMHwpICz0qleaNXjbafm/M+1lTMGd7E2DIUW/TB92O/P2F2OtgkDqb
+R6qAOPUfzzT+mAVGE8ZZsyPRxW+ta2LcHFQgg60yT19OHdv9wrwxFMoctnKfnHANcIqf8XruRQP4+lnTr5FlAcHF84x7ZcakX42+167z5G2SlkO5w1GRQOxi
+kcC7F4QM2bELjuzOnXw7025eSdQZ8RQ2UllCzzFDTiorgDnhBPRCsQIJts5qs3QAJzbynMF2quYRKthCXwBIKW26ntcPpp9OvxS0gP5l+gtSgPi/p/9H+n+l
+/1/6P1vG9y5g91XCxdTbTKhs23qTZCEGx+zgAgbxTAw8zhX8G4Majq5CXWOFcXMYQ3QpMK235fpjGlAID9hmhdB0NMV6+WzuqUg4CqCSVpMG5CeRHqD33lt+
+HTyKv4h0evG4ATI0jncbfKbGKjiIjTwezUSU0oAb9dqD7vjLaZvearmsnxGfmRUkfsfrjnMV8GK4aoTyS/kaKp9y9kJ/5SroEerC11dWfBrlMsEMJVBfsayG
+qGjn4gBTXSGdnWSMnjEA0Ny41Q3A/k7JrWUj3NwQp1XvuNYv/vv0P6b/Of0/bU2f6dzZ2gJt9eVDzMOL9Gkfw+2bs3Pctp9uR9vxLBRLkySjFcDET/JLznSo
+9EsGP7eCWHw8D4BjZ1v8AvUmqf8FGuVhi2n40bveAMoOqjlGz+fyQtqbojHnYDnSow7RK2MGJEOh8hFbVrKVE2yjCOVNXNqarqK5skQVP7mxSO52ZjRGd25y
+eob9rOojMUbhPqnyN3Nzaepq0P3JH04qe6s1ALhPvuTv5lWCaQ+acBoSAIjFbIC9GPSxq5dVMWVsqf80/ev0b9J/ZwjI/WyRun91Kern8G+7X59By9L94H1C
+VuVsUNhEJTZ3wUqsrKdCPxdoL3V1kszfjrBiSpfSz5xgLsIJpfg63IAORwsAsKKns6QOfbNhB7w/thJvrK7OOsdp5H5lzejsWzgU8SfCXCoKUgd920ELwt64
+kBO345u2uT52/ptiVSgSPfL/WAOBk2GNJSUl7OhTkYKAmIQJMDOE2g48oksT0k9rBw8eXSNLNb2kLCQcncQSgmQkJRbwPBofkTpLzUtwD3jobb4+SiwV19vB
+SxUdVM9M88/8Zy8rOKvAEf3cTpjMD8eDJtrnmedr4Ye4k8+rQgPizA7Me0OhOrNFJoPmSscvGcacqDeo/jQMBaogb1t3miOzuIk6wAXHwDJ88h5BkhXBAiN9
+jDjS90m/Sq1ctRkkOvvzXPYHRxut03cUMa/N1b7pMrc0IcLEjHqYlxqVG6VVRg18aDTUquPfN9nqkaNchQIR+rG9O/2Y4HP+vo1N48VXMbBxM1p2tZ6y54b5
+wBgnjvlgPlaABwbKv9IPt9P/9dr+nyd2EGX+Nl9kdP70HB8sbbJ36ow7NujdQd031fGrX4/XgqDd0gGXJFEbjGbT9GLRKHoKZQLIYnOzTI7i2RUhLI2r7gzL
+TGKPWXhV6CurN3L/NOv0NJXILxpC+ukz0Npe1hfFDOoUZzw7+iEKG5wMgHZpxCs6TJZlDjL0tpG7BlU7vGW7idFuJ8R+Pb+YKK1vsqtKPzAz7fS/4ZDobdnF
+UKMmZUjGjGV+63FzOG4Z/6+8ZFOph+kEC/NFcaWor+nhMCRpAMmSyUUjLuA+B9lds9mEheZz+llfZ7khhKivD1gPRPPb/YyNmmVynfiiGP2EmVQVzc0z6e5r
+8U4kuKPJuk+NxxWhwPLc+XZy99BJ8u8+O2HBEee+uCI8aG9KI1W2SemK3ecKmVXq/GtfqXfPV+J0ZYH6ZpigiH4TfaIroQw21JvdSBuFu7LQffo208N9j11B
+vQC5ErmIca3P1HT3Erd2OXUnlYks9kS2hWHEE6yAjiPyFtWfNFlkPpFWDntKLyeHj6l8vm9J307dUlwP0AxmGmel4LaAjleuFH5wHkp9KmXMg4Vuvi4yhiTG
+OGP9xca9DQVPBQEzAH9OOix6ZrdBH4xQaxqsoJ8CR961VP5Vu8jfH39DBO3Z3xIl+1vVZcioL749acIV1d+z3Y16qyZJUZsvPwgV7lT6uChidl9IHzX+oH/O
+0WG7xhzJXPZekPoYAvhNE5cSrtiAGt/PqBD5y+N83PTuFZ1mJ2qPWiUwKg1FrpkrsOrypAj1LurMnFQ2bCpy3xaC9onLW+Zy+E+nLqpMQfucmQHekQ6Cp/dM
+a8T4LOqi+/XIB46wJJJTzhlmSoI2jyk4GFGDYLeoRbCiSkQdYB0xV5/vE6HkRPaeXWYZf30pl16XAMz3rj8sBpNxExnOPtByDkjYwW9P3ymqgO9J75fsw6e0
+M3JsSJ9QYTYhH012thb+9bYp+LFvcRUFcYICR+bIFO+T6OuhErAjgE/iQszESMTF20RSYZxUX5UTTx8JlmHQ2fg6h5ms424j/sSTR7yTULY1xa9MIXLrvE4e
+9c3x4ogHGqNJ/swDzsUBN97opBLsYJMHgLP7/HbyKd/v+mOEleHRo74Wn7HdYS/IsnvqneYlLofQCR91mzM8T69JfJN5yF1OEwNmn6159CgtC8wn7tFkc7ZM
+59miDdPieAR+m+6/dNv5vrEVFt7rahozIJShSN7XRCndo5deQHpmtPZym3NaRnFzH8gQ9azbnLDvoRGW4vr6NVA76cgArtkQnt35Z1b88t3PQhB4n8I6mDox
+O3f7T2UABePHhTBhW+9269O6W/Ns2z4mbBbKBis98a/CvtXxra2jmI/NcZ8d+YM9e7LdAwgpUBBk54Dmoj3SCMJQJMScWrRdwkS92M3T/hFr4uL8OAm03ZPW
+RJyDwsWlUzq7ImZDLrBi2T/ip1lYEw3b+TZTpdJTn3MrrIxm49z243ReLPfsiPLQYb29ocbIB80+xzSFh2rptCHBBK0i7I1+6UbbeCVzqhbweVkB1A8n7O3+
+CwHX/X1OwZ9rYU8frUinr8cObsdA1lc5+FwK4KR9SU+YAGo1riNPGZFXSzk/By+Yd/NBy7z+NHl5gtFm/aMLq/zgbvtjVvaqCLM3xAVeyllbRxVq/rof9Hlb
+93qXN9uHvNmP2onHEQKznv11y6RdGq21itqoFxxZvutX5gTvrL/cVgdzk9YZ3l2cLJhSN0+KLOHeLXN6Ge5FmRR0PGeT85laNTnDfCp1Ok9RV18wl6CksBOT
+K3WznUKNqdrML6QvWbbl9wgrrFW6YDW3S1ZT0i9K5xByLE7o92jNTdJ+wQiMhw0OTWu/7zj/AmWGm2ROsH4HyhT3Rtc0O0rrOdecjQJnw17s/W7uB2xagL8z
+P2m2506aO5YqYVS9Ems6e6Te+PiAf+Kt09w82cXL1zTY06Mc8yIFZchIURkbKAAn93reVvH8tN3LM/T42ZHWnM2Bm/epFDBqF5hmgdevbk4G3qB896XNAh87
+I8JURhA8dgMZEzxbK99w1n/TXgTOe/wuI3kfPTOP8+CE0Z8Zn7YOp8TTsnvCdLVBTZp5OyWhA5jYkDXw80Pzd4JFtw5c4hNbuT2llN7YranvEg+vnoFee/DL
+guVkZPSfpL9M/+6Rs6pSha0FRvsUdHWNB0rjMVxMSTNuJLm+cw30V10rmHJu6fxenZnlAWFKrep0kozmBBnVnhXIkklQYPlJvIgisVcuy9PON+jUzFsMN2PH
+mJrBUMuqOabftOrun/Hczk/gh/0v7UYcuflhvznslyNROSKM3a2648fD8ZHHxbKLGoBDcbtPuOC3nTl7GwQM4ATZNWsD91kzkeRp5smldLOwljmTofkq7dv8
+B9a5g4JKeGReY9tY0eu/3rupHCDMojnKSj9ur8Kyjhqzo+yNC5FOWzbk1yDAiRvvLSeQFf22ou9BThovPBT+8f8AMJRT/Sd1iB77BbEX9iXpg/2QUxxQ3eU1
++8kUBawfWLxdjNTCgzqJu7PrDyfxw/waROA+L4GtnLqzpu71omjEmd9MkUImdLfIpkwlrKt41jT/+Sfs7OU8AfeBPc4qhoSRoDUXzh57h88d5DO5g5sHDWHW
+4F4kbOcHj2zkKcygNfDHlRR9ZQtQWluUWMI2mVzjejHsU84y+q7qRV9mPHO2HCU4W2uxRWZUMEH5ybknt/sGFJLbrGV8mrZgyy4kHawVdUKVyi4gB0taCNTe
+NLNfXP17v2Vb/En8T8W3+TNiA/+P6X966nnci2SrNZMTWeK1wxnB7P8rzma0jpQEcAJYzVS1c/pbHdKdddNFuZQejcd1F5s0reFPmQf+2NkflSZ3JrXyyHwY
+LoVrEORXHp8W4wBNiP2ZUbcs5RBedP7Kd5o2+1cFOflXhAZ+VNaAJklnQTGTjmL2TOY88KITqfVj5urKkevO0wd15qaywDwCIjZ4WsjBDBd8F5tPlhyLcCg6
+XZcSNKDYv3XnE7a642+Rp/JHPXPMU/3D3Ane3yjUKeYOAsV/KMyHU+h42Oye7CZmMYRRKqslnQmBzdDh5jM+wl8MQbcwpwizKxgpaP3vSzzbW89atbefN5Wp
+f0oaHm5Pt2zX4lyHqFoxMAqZcvoJ68Pe6JMP++NjQ2weabflixdDgUJVkMVAWz5iQw8INTSlsKbEN6Y+R9SgMbrWsxohe9VNbf0L0gP5pEj7LNrMk2S3V/NT
+shBJQWjSzajPHhFCz7FoDMHSr3I6XzvlExusXvxp5dZ+2ryQQHJeC11Kmw195WkzMcGrnAgiHxQME58Lxb46PwfvmvFvcjXtCXOg8FSeieNV4IUnKImZMv56
+d3h4JjKNUZxbNxFZYIoXp2NiyNjAKu0opLBszuozt1f6/G/N/f6WdISxBX+V+d+ODu2/XiaYx/Z3kg1WHrXHpYSd3/Giq0h3uARCNe0FGre+fXQ10luZsbcv
+fflBNdIngIUwncY5+IqkuV0lY5EIfSkWn1ynYcQnvNTFAz8fMhfV8/rz9FdP2FWjefKWF2rocEdnbihxeqaHlFZvdhdTnPVdy1nhIpL0Oi/ZIs975rBckW+/
+MhUl0B6LdG5PAi6JfoElPkFzA63XJfpZdV7fRmzgWhGLnYBffWQcemMyHaP58V7ilFHCKjq6bzG9s2QXfH1balCgUOm7ntYTmjN60hyHggTLI1AyhTLsGU4+
+xzYAL8cJxbgt1hE5Oj2k77cc0E8Kf/wvE8OhZol7rlXnmjoOPsqsVXeUpUO2R2nzoXfvXJqYk2412ryxV2/Ji7g6txztfTkuSNPWlzYzSbCL/KCDzMRcUi6e
+Y7XZQ8wS2LOHyaoDPK0FC8gOb2em+fz92anIq4Y2+7nls/MixkLkh1/JIapkK3epyHp1EXFRwCAXS5aO9+m5fOPK9IrNk2DKjbbCEPvc0PtkJpoXUXfmo3ND
+i1T4edHDzE/NDCHQbSWrxnlHKKdHh9yOGJ+hv97D7OvJ/TnAcSHrmPwiKQ7W6sHZWdF/DpMoTJfcuK+41LxXzqTjVoi8OZXNxosncZ+JF6JztsDMFA1DwaUI
+s3r7BKwY1bZc73V5A4+W4XleWOcNM2/DKQ1wSlh/0+QhUZg8F7Tm0b7X+eQB4fK0jNkZ9NLOKgJCgD4+PlLhvpAhOThTduy0ZXqwbGr1JxYLssWkPZraIjfP
+49zanmosLTWfmKRTZtly7xvNY0xmbTyJhnZ+HHtfNJjzmyMV5vNDuI/oS8BQ7lWBrllTw4ZmflsPB0bDRjbUvAEN5TfQ7yx8jyZr+CxdMmwJO8g5Wo+f+pUn
+YAeXM/8x6z9aXDhcnrGrc66Dy/jXjxkVNlj3bT9deXm53SeX5hcvi/OzpWTorbnPr2yWcNRXZIG2lAcdzVQ/oXT0cFRTqOk5p8Wi0WLXLv2FE3NgnisZLcQq
+LzstyUMCYgj8/AyL8CePxdW1rRac97VIE3qWJPpBLUa2/Dz7RuNFVz3MiaWKxF/n8Ym0h4KqOhi2+ugLODxAmSySbq5i4EYhUur/iiB7MDrorQfqW9O3pc9K
+3GP9Fkq4cVRCHuL0ESg206jvl5peQ6trAASJSu6KdaiqjgPe823Xzgvqu2D9MV8b4E/sLwN6GLY+MtAMuKYWAGe1TvXJQBUOTqzUPfOmLn/wXRSfRD2TLxom
+SvPCnIP/z+l/flJ2oVUiVU58NMbn64V0cf0sC43e1QyQlzi7W0T6x6VXS7mwVA3SSeQcJ2+z1s7C65Adg8HanmZe+pksRAkdQHID/ANtiWIAHvj4MeIM9ul7
+u4yf7yFgPpl6h/71EyrDTZ5mXh7fnWNZdHeLYk6e6ZkGzfiAN1JEek7d2pCwBz1XB2MyyFqbK1DOgg7QBGtE7Vc93uXmI7kBpX70/m87Ob5oinWaVWPtKy2o
+bR5lYbeqTt4ROUqP88UYoQ8yZ+3xzEMeJ7jlx2iS71MXOBQ9gBKY6KiJwS2gVMkC1cRktuMYuxQ1+Bb6EEs0rmbPfbSIiJnNoLqHx4P5J0IcQKuM54K74Q7c
+Mv/QeT0uFJH7FbpKIrR6n5QNTpqGFw5zabQ/leWyMErNBCRGB5MZXTre1aV5KdjmTxhG9ZckyyuIokdZYGkffECXYFyrZY4zKAQy0RE0ocEGl+nWYA5C9+oM
+Fp2+ITEKlR5ZEhmwuK3r9zuBgucuX24AkHZQzvLj1XP+SkAzVGTh3zzaQ2rY8zHqFOw3Dfe7XwfqZbdgDwHzlo+XD6y8vJl9LU6VuXnvWtfa7HQqZzDnlg5B
+lsSbRJWXf/mkZ2F3JM37NL2n3Vbo46PdW5XNH5e7FlXr6vFxLnPomNAMst3Dzo08kz6qz6IgluBnTAcr+OGvc+urZSV/Uzocnra2HDVCqL6McYEJanqzXedH
+gsmX1lKotggPGBTAaYle6MwaKkGtRpYRHee55LQYt1w7Xed3BWyLj2abila6kknxnBCPitnm2KN8AUYkX3keQ3c2MMWGa/sPoR3Diu5Ta4nmQecCJ30KKrHL
+aAUlpS78YTHwA2hxb17wWdpXGPlqFrtARkvGXTojLq/CSEK9NjTH02t/8ZhIpV+OQQETF0PnhTUZ1yP7K5CZVPrMeny9s2cH0eD1vfefJ7Zpjqm5CvawZbsy
+RUwxaOfTeEbv4tFuB3OoXarGS2K+NZ+1fhLUsMVqGEsDvNUXkiq5QU6OpRkgN+R/f/oayWZI+s2XpD97W46ixi0/Tl2NjSGC+78If77znBCP6QDbNULT9bbZ
+w+bVHI6cTzbQtOtpuvIETG2unRhps5sFYN4ENrRzBG2J0v6WKZlc2ZG9R9caBgiw5F3LwszwXCyophkREJmeojgZQpe1bzloRnD+sNNj/FK0psECeD3C47g0
+597r2Vl8qCvbId5kOUcVUk824a3pgI3qZCo6u7qhazBYXHYQmUDbEhwpcE8k+znGFUI3sZknfqpJfNRkUPNrgr/9YpcLaXjrql/11+m/Tf9x2dsez6ApnBTh
+5mKnPqeI+FtXMwt6ZRyFagLGzWE8LKQ5ECaYpWIZeIiOLkydR1f9hgyqa65QiwfTG11F3Nx8h+qMzYwSGL7ocHAfmXVIMMLz8Wg4pYeh02l0CBU6x3bHbWdc
+wi15ELd90xDKZyFyVRgfwJQ5pgK5lX2xaHKzA8wNLbE5Q81lvzyL6//jiURtvgjHGULADn9aEO4t2vmL9Dfpv/XWdu+1/DQq3NBZPhqx2Wi+gKA4Fr0tuQfH
+0eeO9pbwqD90sY4gMY8nv+TsAkMDuPjpwbRS4oVcCtbX+5rCgOYhmLEu8jUWmNlfvq/8IZZFASDmMr53hjr7mUejznY9f88s/1OPf52qHelQjQ5xNtpX83xF
+7fAGIzZjf9RSFRlJYwJOIq2Z2+LrEhWSnBhSK7pIQ4Kv78kZfx3i6zvShPmkcHKxinqLhmq83UVED5zzWbmB9/V0r+kJa7lwCbPj2CB6S2f69sr6A/xW5lfz
+zF5KeMTLZeK9fsKQr8jQkMB8uGfkRCYwQ9TZkClzVrNgBkE21nlNAvLLqctKGg1LnOvIFfSjXT+LZuofj+OQrnhxBqpPFfM/o1edXNzGuz4v2bb1YqeZrCmQ
+rPggyM/nfhdbbvJklepFwAfkVOecqhqt4YUazCLmw6FhXg5Y7NbXzNhJzqH/28rQ/GAEH2qE60Ojl6M+x/1622QvlTfXjO5VZGcRKbbjoZ/lvttquZxWLHkO
+1UWnl4pmzE+wjMgd6N3Ly5Vza1PJTMJMdhER8N6q3IKodJyS8hoEbMQbA9NFX7P4KkX5UsI/bgWMRa19rz7W9z2QnXG4OvQR6b1SgT8c3vNH1xgkoVSjYS60
+lvnAWgzsVTdwFtgXRcdhwKJ+j+G0utNG1+Z+rQtwbk9ZFoAC0n19UxeIcmCaH0Rr6eHsTmGyeUSoTMkyGIQ6tsdqibqqOipTaa0UJAOt8eSArIGUk1Y+3Tnz
+8qwn6sMhztG19Li840geTiMhJdNX+2lbnZJen3uFVwst+Ms41AEK2SX6JEfJUL8/NQBksoS4LK3evrVkR7WOzaUGmFJwsr1//fozffbguii0DsOR4eNxvToP
+wkB6mJ8BflWdPTW62sE5vgsK83zTZ2alLGY8Ta10cCZx4akKPKk6EzMNeCrWhuyqBs2HVqQu4yn+0OLA1JhqPXnluHlgFmkrBnJgixKjHrcPv7eN4/IRyTXR
+j0HyabpZ9fTAgyXV5P3QBYRn9LjD/AaO4K6MPV+nOr8vhPmNKKbfSl9tdu0MttAsW+sWE2Z+33zm0JjS1ENdZHbO3h5VeKoHGHb4QpRpqK+IEL3VSKbE+UWH
+LiwRXaiB3aACsK7tByof5JTkoMHi9SlmNu/j5Fd/jmoMHcbnsbUW80SUJ3Kz1XrVgd/vu8TGc0qBT4LjDxJQcJoX658i3rSxdkeHZNHRa2ruSP5eKTAUnJL2
+3rF8YDSE6xCbfG96b/pxi0u+YjjEpkH1tCpgRZFftdhky1CKkCDfhRhlt1iGHn1Z/8H6HwJkoBjZyerR0esbGQQ8pQ70dLEISL+A45H6Vla+4gSvA2qh5h5C
+XX+zEIMoVkIrNx0d86vA2+Wzi++qI26+fUS1xorPfuVl1j/VCTSq5gcQecmDs+z8+HqXR7wmlupPOt1iv3edgk5LW+0EW7KXZqzHTZe5LHIYxwP5MqhkVfzo
+qHO44GzaaAvm1NbcvGm2tFMiJ87JLs2eKED6/KBvSlL79+KZmhafxZzPSruH61qqZEbfpcbZ42iJVDKEy5TyUpB29WjrS+Lz59x8Dpx96cMIJOe2CNsoGUTt
+c5NCDAPOpgQD2bl5c+18E+MskQ2AU1Gu6sYPhBH+OMP613X6I9ZdUzM3vE4fjSQTbh+ZtJkqubZxd9v6qY7hgAUn4CnlcnWGIu2mbUFedAlLnQiq7NelXt+M
+HMVJ/LUEJOn54t4V+NCqH/34ngipDYqgwfa4YyS/uTF8njQshFa77gKz4YV2iIGHzcMrhlKHBkEckhNem/dC0KMUKM+pesBiWhlOhokoHkCiMdqLwe41ppqa
+i/rT9O/Sf0j/iWbJaRGaVMNRmtoY1HW2ON3qARt/Im/Jlwn1LFtyFzhZ6LfSD1x5W2gP0s1JU4mlal/0sIQdw+cCgInB7g2oaO9V4M6MXADaG7RsSAuzy/Vj
+UfBvv4c/aF3gnnnq9x7PF3I8nDGO4+aGD6XN3CaOm12Xhn1MLwqn9pspLPTZMDsZmZwuejbn+1KCEZxSwdak0l6PzTtf73oMe4Ypz8ww5/STuvzZJapHjcDD
+KfowWN1OZBn7xN/to71r8CBFcnwn9Bj4oh52KWWGndf581EcqN7LifXWpB2qvrSU4INeJ/At5F//iPSJKbOFoaDGR6ETRahNEYiqMHrcNkRdo69y8LBxnV9F
+isHUza2ez20mlumTcsnDSQbJ5tnyJKOL2nENktqqqxHn58qL1n/wdqf30KHIrqQOp9I2o+kMm8aJTCntz9vHHkSrTuvk1jQ05mHxCgu7u/eJa3CcaBAxtf4E
+4vImZxT81vVAWF13Z86gKclUUVdNcfv2W4LNe7th4n+IENuNde83ApfNX1E+v6qI/u/p//tYi9j4MUIwvBQi533Q1u94+FS/KfLxjY2leCuqT1tforpxHxds
+KLCDXST9ilAmhPrnuSbPhMaqfgGYFT1R9O8JzTko51qwqDNJY2x+kpsmwDIkTQMiTOuZj/ljggRidjFGA3Fm7dGepvWobaKKMTubuS9dzWxrJEb06KpL0Cqh
+eGaVUasgM8FHQVSCj3Z2A2SaQQdsXmKq0F0B9XZN8/rVQpeCP/e/QZSQtO5aZ5a74v+woSdZUILmMT+UXPOJjhuzAgqNzDFvwfPX4Cn1u/tnXfMNH/WnouXR
+3lqANDQJhEqabG0aFZciGQ86wwoUhaPN8m2gEavkPGMtvx1gMb9WMxmcweh5L56SX5POBHNErb11261TciGcF8X9viKsfym4XFyWHOuhNjwX8ELNRdz9iYzE
+qTzOmxJAVel2t6OLlh9BXORv4nmteMqfSv9Uqqn/Q/rP6X9N/3v6/6T/I/2f83kdFzo/Ym/dxjwBhQFq3sOVWDkhsl1S2eefvNOfObo3PZrnbammPcQOPZpq
+2bMF0B35jhGil1howJU5KmO2rq2BrMQKol6ApJKnBe7HGZ6F+b0/5eqyZxlJtue1hxiNedCc8n5WCl+PnKhqrtssKaqxxEMU1kV2O+k8F4MQuNJjyeliSahw
+spMIwNHq27y8cQkiiL29+HDX06TMS/h4eyGVH8e96ryHte/BPsR4oSOmelTrv1PreTJH15DA+a41LMOTJIiFeKRoSzZ4zZueH/krXfc/s4k+PF+BUNAp+bHA
+XABW9p24kbLqKsCsXl1pkvdpVqgpn9kwl4NjeBq99VErc/C9pZBR4lf/8F1287rlSyFf+tNBrTSeZo/rBMh9HiDONHeitCLl2jMu7Do5vt2TuwEsLxBmoFwu
+Hi3iroYku1hfmcxoZi1QRjvbzrKXZppEniXmi+dyf9tHqBJJhpRFtT0mI57/1SayjXgM75wy8BUwxoWTBANZQNIPEfFRZ92QsCb9cNL4LsuxYlje3h6+36pA
+Pxf6kfEJHDpBGVYMYheFjV38VUVQlxLJj2DVQZQ5qIObAt9Qwcfw6yTAViSbWqikU+ZhfLM8cs2RtlqQwxacyZAS9QmDRv9rJklvFjOjxiEw4atLjpb55P7/
+efsTL+m2qk4UXSvC5AgHOAZp8BWC4CZMk04ICIIEDgemiKiIii2oILagZS82hZY6y7Jsr5al916r9Fresl6Nqnr1atSo+8Z9zXjjvTH/sC92vrFmt+Zae+/I
+yIP35jnZRUbmF3vuteaazW/+fllux4utioqFv6LRWCqoKcO7tDWB31cO+SOPkFyKMlOZczaV1TwHB2eEuGdmjv6MB1RkQd8vMuKZkWAFI+TgwnShQk/Zk93a
+s/NkBtizUL6yjNaZHmr8X8VqCNvox9IvNxjJYkWbCHmg6mRa8MdBl9wwWeQVc24nz+Cy2g2Fxnatm6QvU618yibkmBI4YtmhaGsplJ+l+CwRYThGwvpsE8z7
+VH/iP0ao7kRug435zfYz36aYtS+kf9YwalzGtVzx0d0J3bFaV+V9nzNpT+m9JkbVaexlumX/OL5lvOTItg3dUABFVOS0u1mPbjTbm+EZfdjIzPg3jS+oZ7dM
+m35co/Zaf/rNR5zfT5pJZWnYVfcpbfZnHI+gVUP+m48i0kXdriW8FJ56mV9eAQDSTGd4JsMpLDij4TJx1Lydj3XSjjvThNN0HtJ4wVuekVJX/YL2RLqJqMuK
+SntdtN4dGWSGdBBv2lOGy3Ck10v3kqXzH7/WKnWDUTrME7+k2fI0oS5Y5Hg8S2HT1+hJaIlGlEZ8S9u7Sh1DeL0P5VFUORlEbMnCbYwKsy3YZjKqj6c+HHj/
+IwvPZZ3mcjYtzUQN21u9aW1vWVgbn1Ecev36bL+50Aq6bAXX7kYp2p38UOIVVz54waMr+GOcdkJXOfI2M+iqJf0Mc3yFNeb8bdcKkJr/w/s7H52p9kwa2dWh
++I/KVD4/vhmO63+gWZ4xzaWU4W3E6YgPKQAbAIitPjfik3sqnPab+zp48dD0z3XwFd/Q1KANY/dz3H35M7sLVzHMD9O+u80lwUOJXOOyvTku00ZJHbrRcbDW
+wOCYlOkcgOLfwAPR1m+gYA4hZI/OVMQ/kxDipC1FOlHQE0he7D/1G0Cr1OoVCLR/E97Yc5cbzp6dyh6ATsewzKx+h0a3f6bKDaXaehk/zE2NuB5gM1vbnwoU
+jDvrQB68OX5slYNEdLN4+6vLOcF56Hlsuf5q5dPO/PKRt8vaRlOeUodLm5lfnYEqN2VSjzvKZ4FlUW6D44jzeya9veEKqAi1v0p/W8/Ijumyfmi3RPbubrDv
+ftuQzAQgwn5zXQevNrvNbVX6Vz73dTOUfXeYS0FGG+p1HLeFr12rD3zqTZi0fYGr4WckNNC9DOZwc5RQCiQaecqPsCSW/p2MIzTIg/RcU+OSjO63J6x0/9eL
+4mjnu9g3wyB+V2w5n8Pk74/i8wfPBg9nofqt4lFgTpqpgXHwV+nra61Pq9fFPlC8cvmy6yXIgTwmOKdmElRhcFJpzP4vATSCzgid/gDp/XiLMnJX7RjNaAZf
+90uLX82qbup40CeHvllg+vKH6u/udfBY/H75pTt+0M+Q6wbWcKXfmf1HV2asy1+gNT7DDfXYlI56Fnyw3BOpQq5qzGPYhrj0g60t3a8Dc/6dZ5YLvMxvSd/Z
+nbG/5DPelS1nU0e4A07EargXA7GHCwu6M2LTRMa6jQognIVjVzB2W86N+XWOUYpGMTPpIjbDULSgb7Dm3k0bK9p6vfislDrQAqHDTT87b3fACnDbvuQW48QL
++SvXVrsI123z8trrYZPbsGKOpHUEgVp+udnYo3T0qTxegDU2xMWeeokJa7+nnof/7jFYD2ceEn9bs8nNcMzNk+rTKiJBbwAPXrXB+c2DHGwz2GMDIWj78T7p
+gyBnmFmTj8cTTnzCosRE6N5KZTnM+rXtpdqAb4p1Yv4mJn+T4kAru0ZFQv01I6H+06N4oUTH1Xm46rvejvq/JUf7Y26YXw8zHaLbzqFczSjsXFVVtckpCch6
+W9iqt4LG6TKv4H31OuK2AvDw3JiHp3xS7WYQecAsNzCnuldOWE9F/xC/mWgfvzsoL7QT0bVbJ93Rv7uMIZP9+W1tnByvY/94XtlimMmcDnFgug67yhSxHrDb
+3dmNsvYmE8FIyjhqaheGVfHl6yrmulMIM8G949Pn52KD8RkrLKTghMv5cntnyqfA/bVOz7qCryAJo9KY9fV/L87rMEj1cmyE/dLGXVABrsqE4tpbzjwmNtOU
+ntD83Keqsd3AI8xxnAAyZCKoMOpmZjacAABnqoixmV28Ti5lRT5opPgawC3e62p1b96kMyVRTTSyf/3NRRwLzgdi52nkV6t5UXnuhpWZavm1m8Kbgk6asYph
+Qgs7V+tuDSfT2WOqoygqvBG6/oa9lPkefmCec6Gp8WCtx6C4GaMylv8hwjARcUaP4oOqr/59Op33sw+pDc1oeAzbzb6ZFNUJgJvYQ6hzyoyGLb6ngikukvEo
+lUSgWiXJ9XQU/LQt4PMyHqXYzbGPjQBQ09tfpVhEhOnMXvEEH1Msu82KBp2hks+sH8MKYtgoTyE33Lfe7I6Zf2Wfl1r/XV37rhu6X9OINMsC4l0svtRiZBiV
+at+OP5IyYeVRW+rB6FIFo75BFsZlJx2hAV7KRvMBrwpr8QWtEH6nzzC3vuBPz+g32Tq9Mem1YvtWgFmScCtmi8U0I5zCsruAWxfpDLgipCmworGcM9UtcpFb
+jzjXuAY4pbYiC6c+3m5621xnVcILDVe0/of+Fpq3MMX6PKvn1kcbFSed+7kKCcilPW5O5LKsYYkgJoiLNle5sZsh8/bHZ6IihhV62tb2iSAngqCQGEctIBMg
+YK+Iu0CkciJNfSyR1ihchta4sy02e1XnG/ezMZihfx+FfeSoaMN6PPvJ1OjNdjg+oxWHvB1kYupFadpZgsG+UVEqE9qVh4TsAIQPsTjUZkiKrABB9lWbW8Qc
+2pieK6riN3TOj2MkxqvMTJLtJ5X+mXV4mMmqpdnXz5vNH+gdZKWZMpsTZtF6mmD123hpGjNZNdjqwwkW2PKhI1wMBSTdxFu35zN85hjbiE2xTLF7f66olUsR
+KzddTqCaG9u7JiNbxq3ki7Q1ZAt3W7ymACsYDTWpMT1bdD0RdlH+0wX8CnXm88B0AmIJpaOFebVyxs/xTYuVH4fBGI7DUfl+N01CPIFfTFmqFgLSBgV9Bm/B
+Bzs1kPARnWpyEvM3I2zzZ72xG0CHtsgURO3DnbDPDf/0IX2RfYHE92Xq999fghIwnH7VIhqMYWe/3R1nODxV4jqg3A7eI3ElsON1+TvS+7uR8tGcrpwu5VMH
+HdLrgxWRhlrmNZpVWO7EKXJ6hhN/BicQuIBRzDiWm+VziPxvQ6byd+3m51l/LLX3A59eMcP6Ta24/Uu2vNeEQ+NO7CSL9ihLuza3H8JzVJYOuS+8rPuCTmPl
+mletBTVOBARQvkBfbT3zjtiQTthXb9hFWM1eLIzl/5GC0vvMaJASnvC/mdMU89vFCVIXqCowv9jw1JYu679uawLrh2NYbzO5KG4YlNBwbTrmynD1g8Ddbxb1
+qIfmkTXpkuoiWVNkzckWOFWWdeVYzmnUrmrEvmSeFsCmMr+E1ySobBMRTkA+ONT9RschABxjfC7U6QVx+BecKRydISFUA+ZNv3cDW9rgSE5nk9LKzE0LhDPt
+KUXAXJ87BcWU/B7MDYSIWZhsZX2DjrZKHSwTnTpQbJZU4kQ2CkTBwjJoxG45FgpOyWsCRuYVUbHqL6pWheCKfjB0kH7V/MTgh5u74Z2NEAqoIpTiw4yPzyHf
+qUXz0eeueCKZCwEHN7h9vSahMkIULLXMU/g8u9JNIslnIgAsTwECKksxTrpVx3tKCICZGt2AGhhQ9OxinFe5L92kdzmP+ieaKOHXnMuC+Sor+VsJcG8vw2RV
+1reND3RnL1XxHLCvqyHsaAEbtjv9JjTbhLlyBpsFJdXC+5R1uj1Txazw3iZxsLJfx76GPcVpZUO7I0FlW3XzS7TtzjT60u2kb1mVAMps4N8HNdCdb+LjYZPn
+OeB6AaMQwu44E5Wjbd8Iqw7NRlakUCtjJt8dgu5hVDJbJ0k8+TCiFh4U3B8fX6skwKC6UQHWgpxIIOPE8jtjzTHkFIMMxnsdl3fZ+SN+WeKxI+InNwUGaFqh
+s/w1hYv1BwJWxTkurrxtqWm/Q68v5mCNtdmDtQx6wPEDxW1ezjgSznKxupkg+4jLjKaZ0nizY5kNdfkPEZllT4J+jxRyUsTaNDnDt3TaCpHjZqLEJTnvcWlW
+Q/CZU2jJYSu2nHbK4sjfOTShd4uBJ1KiOMgYC0v1zFe+DyMKl3qC+oQyuB06xTPKtuGtkoZjcLkz4MFaSzDd0l9QbyCTgZI5CPNFUy6w9bV5qIzgh5T82kFy
+iAA7WbdYQg4ILid8FbFXItRKVVevcXyTLNrRKjjz5K+rBD78AvzsOXADyXEoIdplVLAdZ3ipFAqm+3uaGuxvutUfxSVZ3qU+cKx7vrw3s5rF8EoBKArMNajV
+9Dc/ugvPn0GPslEHdglQgFTOiTjT8ppvJ85AYfX3CLkMhEh20AXdnPm++xNFbn+q4RW32GGSNVj45OCRS2A55ZC700aEIb0ltr2eYeNt6t+zDnhosoZY/D/1
+TIAZgEb2JicizGRnkE14BIPzjEZv2RkaOx41rDif8q39Gb23gWDRs4VNg+n5bBdV2IoOCMyLkD1DxJ9VBqcJ6MHrFOKy2w77XeeuH0zVEMcpBlyYoyWLIOIY
+2DaBY3oqaGouT1sA+ARwWpe41XplX0XjL9JL3ea77hT8NUOf1QzCRrxqejBsswpgCN1dw6C83yiMp/I1eBXtOhQY+kIDkx6PHANL3AA0Et6nNY0aQ8jPMCfT
+opGYC0GpBOeR9isCV3WAVvOTAW2MeMVYbRdnUG30xqZ+W+YRFv1r/SADMmaVwUoEnpCZJpvNFukk9k7RqpudHnQyXXzMzjrYLz/5riZFsgA5kyU+sinUFkOm
+haRdbrTl5oMdEfOo4S04dbIOx7JXBkJa8S/khGM90CLm8aUBQ/BNgUmwzBMs6gNNMoWbbrJD29Viy7tAqhWKY4FTy9PfiNxbmj7MIQv3zGCdTmQXp+rcWFO2
+2gbEyfRhFAaKUHYlwMCW/BPtdwz5G6cPv6LRXfxI+t7J7o25rdYLh81jFRc9KphEBF8KkHRRd5G/WCWNA04XQUmX8aO6BN36mhUsQUqDNvez6Tntwbb8i+3u
+FzzXv2u7slP+2mE5v7rjJxafcDzsX1hZFTe3MiLaYsx1JmBJ7Y4Jx7aR/lJEcdYS1ir7fhycN+0lbw3cJ+/gCpsKWQQ71b/TdMOzuApkmW3s+kjnyeIAcUgB
+/OUzY/KMqJtR4t2eCVPuxMXzc6H2FVVcjFz+sNEeuc636HSMJhaHjmG+xZxzaDtXrzHktGMPrfSFxI1HRJ2dVfJas+a0UAPVRCPP6/MeykhU55PTtJXT43jX
+aa/48lKnkXiqoBL/Q/rP6b89Zvqzajfuq3JCFWerTJCDET5uQm+oWPvYAUvXnTup7uawSO8/0+NRw5XjvoJobJy5RcidyPgiRzMYxY4PLBKSYQfjVRwv/6Yq
+QQGg3Rjz8lMAsPcnogbzdzv3+s+6/tPv60TMo7hJ9N5ItLFRKN3gkkXyxbBdEAmYevNp5nGe3UlzZum967rUMcaVNJCxdjA1WX5I+QO5sJlWChLx+X2XDjDH
+HkncXzXDXyT8psIO2+bNF6PZOSC+Ve6rg00LNVxZx+GonKYdtWRPdn99uSB2wkxIzGpPo2UUDF9nVlI+KzPGM3N5tpxiDlwPAoQRnOQU2bpqzYYd7hUdN9w3
+T+Z1//oC/3wTgej7iZO5qUTblay3FWsZtDEv7Qz+l6RDfDju5AA9XIZbLB5ZpkbJxIuFENJqwGgAOdnzaw7OWEn8REtYxhlCKQ/m7PlWexfkKUiKU6t0TY2t
+KG1/Kn26Ows13guCz55zPELHZirP7THfQ7qasR1U4z2x3KJ4TYwIy49H6OWhc3IDNW/z0jW1DAyzItE1IWl9wod03rmqRFdusz+42CecqZovEMBdhfa6o2wf
+L8vSFtIrJZy5gZWu0WllfcoO19bSn5IC8sHkduUtG/VEX2OPM/yl81ZqZxa51Q7Fw15h0HU4cFisXdywSsXjrl3/q05xrbeHitArLng7XLT51wngRJmIjUcS
+vrEGJ9d7K78MEJt4jVBYOZ4ubnx9IJz9ban3RLZsMyBAm9+RY8meTx/ysypySZeVuYAgW8B4eMmsfBo2ZjwR3datPQchM7WHM4UHBo7hBPJRTiooPtW8aMN6
+lJUlVmfrvWXZw8biyBUCjN6IkJYZRtAYxgnDUICo9Ye3px9t0HgoWXKNXc8WvbTspcmDWnJo2pg7/vVBf93dJx/+/IPYOB840loL+gugr3wBSOkLAGS8GlHR
+MVnmLXkp0kiY7VRiK+B8FQzWgdTU3my3l5WMfTqXUssjs2dc47cFbZHKDl/nAi/D1XfqsM2Zfs0btTx5fz3w/h6uJ7yZAfVdKfWizn53II1zzR2uPbBN9VvM
+0polGwAxnL3qlFOImbQum2FZIZYCsJmgvAQasZJ3mdpae7smGhzF0qXz8AeKwvsP6b+k/57+n5KzXXMdVtAzzMFT7b43IeggcjAzrC1DlvNQR0Z72R9x+m0r
+me/lzx6umgeGmirHuYcwi7Mm8QvuETAWLrHDfrKl1+hzaKAd0dje0Wkc8sdzAlSBJ9DGB0ynuCswEsDEtQJrCGBMKLDcmFc3mLInzIr8afXMlltLt+Lf8Hxs
+uVf/rcWW3R2rRMLNI/iWbqxqbFXPvSumKgcTY1GOa98F8kWpDUVc32FSzjhMShoGOaN5QF+QWyClTCTJs1epAVWyp+cHnio0GM3/rBxdIv9KI1zdSCVp3dmF
+2eEEGSlKHGJ7ukZ1/IaPaZ3emt7R4P6EXeX/wlzWs70ldfemU9iMat4dd12FNcCG61fHdsPtK/F1JHacwQla+eMwRROX79YoB68lK5IDwzppX6Q1RTwVdO9B
+hAqqxkgFF2e3t92VtvUUqrD1DZviqv5xCcVrTb3epdD7e2k6zqKKKo5A9TOs5/8InjJlHOP/dlst+e0EHFMeOM7tj36wOU9ioArs1guabJEkZD/pPgHpAqax
+ZjapkdfIQnJGjphbZCbjz0AnruxSTiibDWa6Djk1NafXNrnP29IL6Rt9muuz3WynnemG/f6P6b88Ihsqxe28iVzY28NmvVAHqdWQ5i4s6zy4koHsqVARDzPQ
+sxT7Y+VjRmQH5QNfTeO7FYAgGFP5muTmnZSmf5G4D0WDegRCRC2neDEFsd4YwInTar9v++Pl/rQR17/i+/O3fH/+q/iwWymTBLqnOsjo72Wr2OftQ8MR1p+r
+3bRw8ES31TWFlvvmvndikVtsQ/xfPyhh/5dDBvmwGZUPFIp1DTyWSQfRa2DR698SyfuCBB+s7CXVvhHOddLbWsIHVHfqB2fnqRTxzBMMvn02IunNe2XBjx22
+tdFpwUIQyL0+WoV9cwhp2qC3YGC3NTTH/eQUkVotjBwxnSj4MOnG8UesBCKSvUnGlhMYU12kRM8ogjUILhUSnBj/cemTBulSaTnN2bs5MFIK81Zf3egF/Qj7
+LmHQiZHy/57+PxWB5u8a0pb1HtLj5W4+m3syhPrEHdcgjEXbnaz2m0PNaeqp8YwQXzoys4+VDwtCqM36HbtOP/miFG+WtXk86o7JGsHxHTmRZdqqwtvXKNHy
+ww4DwLsB0bMlnCnKaWG+LcF3+p9vC70nOVP+W/q/z8ZejTpb09PL/T3sv21d31bULJxSRnQtQ8Xu0EZj0ottxxNl1kiwxk8MsIJOded3g5t/eIbAoqrp+1tJ
+7nlXZdQv5Y2cJaBKvfUjoP3tUyiM4oiga3qBP1teBlHAzRse6Jaj5EO64/rTD4Y47I/4tPnbyxBCZcMc9H0IXxtWsxmf2fu8jIPp+h/slHBaT5bKecJ/+IV8
+2Gbt7y4BiQAISE4IgpFoZYdKrvGTf4crqWuVv4BZHSBTxyDQHMoo9XcZYQaO6N+otlc4k+SbON9R7sWvNqf9X12KztL4qKYddt4fpeLX4e+5RCWl11bORDee
+i90PqnpoAK47dVnHYRG+xXKEWWdDmmlwQONMJwTCtXL0QnneCETg8tjtm4ResZNVnyR1Ht2S+lODd/EzkVJKK7fxXfqgMpds/WzeDtYz3el8R5R2kQbqUQVN
+9mVREkC5bD71ODYheSlkKbIGkidagVTVNSMSNofy1JS+zF/Ta3WmXV6XvSqPD/ab+m/vt/WlyRRrUAZYuwS5Dd24odZpTNSyQMqrWUUawVMqzywfU0rr8Pre
+nb71/KvLx2ETSOtE9WAj3K3Tl92+yBVGvjkYqRUj8Jea+X9Dr6RVyjW9TCk946/3Cfd935Ne4NplynMveMfgxOlLC5ScYlnNGzdeN+fvbiTfqHbuRqIJrORF
+I4aBu/J1ytXmOgKWE63hVJyN6VmYX0BdH+/mbuDHlPPgh1/EWsmhXPp83nilYwhEJgXDdR0IdGikdh2tHFsh4Zw28RDOrCs94FYk03P6aEZs11hhzH5ojdlg
+Rn9tjEzdTOlFric7YsXtrJOvMU6nM0WkaLPmSAcC9OiGDCPK87IwBae193DemL5leg27tqJUL4XzAB142m+4jbDXBvdeHh4OBm7Mtd6jyqTaokIgkStUOl1s
+NrjdCwCSysFIxQzsuFB022O/ua4zSB/VONtmaF/EegvjWvJenrPeHDaywiSB5/S+WX+STE88WIZGvBJ5MWHbaju/AHGFJ1iR0jG537hq9pnkFy/iaq2Uruxg
+O1ML0v8X/HImkAwYAZcvYOSYkTOu+zSa6n66Tyn150jJi17EOcIZpekaaRIaHN7GqRsVc7RwNRF5pfKPdObGPKUsDWZtdKUTAKyTAJVTM/9ma1N4sYz75ce+
+hHWpREOTgpPgWDe723Ldw7l7JwM8BGNTPypvpCfVuQWJDQyYm6O4KiED9mtSKgyPv9IbY5yWw6tSI8uT+d4ehxg9bPhYW7639S1Xt790lfgU2NGg0TziU1ei
+XyWqWHHsfI/c28df7/Wc1pyt5/1xkMRuWFq9lc1ELgVqt/lB3wLlwk78KMNAqcViviH41VaZ4xdDt+jFrOKwdvlJgRTyrasWSSxi1JvBqUkW7rE2P3ODG+Pv
+VRcXq47WGYNgVIE2dFBq45q3KnfOx/Wc+cfn7/rNsSW5z4+Lc+o5MxfjZIvjRnog2pks+opEovmoh3Qcqlz/S4JPK+viUw/77EF67DM73BKZNu7ZVz6r4Xg9
+67NXacQ2qnMpEOX8mdnc7faGyIGWjfLwqXZrw31+Q9jZws33M931bmVW48XEsLq/h/26Nq4nCCC5dDmPzoazdjOpucvnglpWVB8TeB6K6r8lJnxleosqPQuC
+R+rt+42cMy8ixCgBVBkVtpGomV0sdaQ1X9SILmMxcxGcnGZGMof86bXpoxzzSR3igZhcFuCUU3vrQ01SbTveSeiu0gabWm497CvSdn6JW1gYlu+Ep6s2RvU+
+EIWNPE1QcocSz3rP+QnKhuBAgubf8ZhRbHVQBcWH7LTf1P+3Covf3Jy50TNxowrCIkixyu+3EiuHm5z89IKTIlvDXS8/Ag0gYw72yfSzD3pgDSZiGHHQ2kkV
+vAhT3LLeLywFpEjN5LcPcbkqIJkBP0dum343Sit21dQIYqwsOlkPZZx2yw5aaTnO37EC/Z7kmUAydesvXXvskxs1JlXtbm5S2ZRe17AYCVzd60vIUVqAqtdY
+z6QoAjQtV/JwmlJVhkU9YOYaRM/tUy+uihE2UpdjPZxvrTzbeuBaTgnWvH1k4EMDwZh4fVmI73bpxwv35CSSmznXso4syhUc+alRKiZOPO6P0702u4tqSAcQ
+FiARuJJifKsrMoZywTMSX6gs4cpKIrssVK/SS5r7avHti7qrxsX3Au8lXqHreHjP3En20dkVfs7eSyPDLs8WGCk2nGFfl96S3p3er/llqQG0Sh2F72YmZlmo
+7rhO+CbOUJZj7Pl8fGt2NRmpiaiC042G84MONNTxvica2odSkEXmCKJuhZUkQfuwSqaKeksFiB+j+ia8sXsvT0Y+NhqO6nYuChwil768i2c/oBZ8+ETc5sW1
+IDu7qDR6BUl29maYnIhjXBMj32P93hCEOU2vsK4N41ItxtLP0FzXGxWb/Y2Mm/nJiyp8137//cmHcnYe13Kdu2yTiAwNLN9kXRIWHfVFP8W9Yd9bxHq9M7U/
+U9eSyRPiXQDr9BTlKKIxreCUcFWeHfz0G9PXaVei3MsLa5oFiqJXfdcobvJ85aYA0Q/bSS1TuX7Jcm/TSeuvJAHIxeBTWDe1BCGqIWpyqz1Xiz6SPnZ+HV5f
+sAbjxwVvJIghbuiO9EDBoC64NdgvSC0v9s6/LlREPsLMb9/LnGZB++PCFTiti2x2OaZIm92eq13MDPeEN9pKtk6//igONY0Vd0Pnl1+oktBTVPIFr3dhaeY0
+cegn0k+ev2u5Tj/5GTrYXFg8QWWTSSPnTABqRYBwatZWJAIBjrjcliIrWFvajDmN5VmUqWlSPReu8dY95Xd07FS/8rDnHDR/yMtxnkEYeqkQ0WfV6pDpyx13
+28PUsVKfZ1i2X88QXxMJCGgmxxpV4nKSfsy+Sbs2vcL9UNnH3zijUflrX0qU4cRz3SHzTmeUE3MNprbCBQbZZMNyPFIZTO8r2m+0iRf+ysrE8ICD0FJMPZaa
+k3fCXfSGtEvvSO/rVtLPMsuZTWk+WGfMLbeRi0vutrez1lwQ8XwnP1UiG/k1iXXuNAJamzJWu9rMekz/SE3bZ7LijBwOEKa1yDoUBNZ3n9RqQwhj/0zHAfOa
+9NXpfekTyiFZMBlJwcRtsWNj4+8XVUkaBdrjtfOs1xi/6mxWYpN9+durbtqkLeE22iYOSTAIEOnx6fn1uODImlIwYqCpxvI5xESvZ7zQN/m+dB2IuaNox6Nl
+lQMu1Ac2gTtyo4iUMu53OO6MAidDPITWqSqbZYXEr0g9b9acG5t+u11zeSjrxMe9T5uqyAu0tdmio/1tzAP2swFlu6/FjxkmS57QM8L2R2bmTLTKH9cUQTPy
+tRaZ57Jz8A5F+Q5bCCs9PduhuU/cCo3YiXWC9CnF4/9mYPY8VF6NFiqkjaVwT5XdiL/d3DwGfOGj3Jz4roh3v1BrAEXOaSC5oVP8xcrSHAxKYGifjEOK3E0s
+YjLYLi9tcoDvdob/gvV9XBbQcpEIQrFR5ikHkeAVIig0fM0aVDMpwTqBQqdRe64CoxKY9NmUgIeGQUV3Ci+Uqc1zgxwzAcjakFr229J3ps+kz5vSxlWD39xV
+psZhbzXuSk1+UQLhxLwCf7YE+siaoDWPXpMiU8EGz+vUUsiKBW+iY+FGcQMEOlfpQ6gAOLURACnEUyqoSOtUvu1xOR/2WtanX2SX9mojNDSyCHabXdkb23Xw
+CDpAUZFJZzvw2a6STAwDxD2M51rxsg7KQ+U3nKUAMVeZsFZv6Q0hOzE1lqpU9Q/R1exji83udqO1S7bT7YxdHt/clCKtF01Ggsv6m/UtA46hk0LVYK3/KJi0
+jzsz9eP8R6WFerILJdCtFTyz+lXZewUeK8M3tzMZdx2odEHtUdCuzbzKQh6HqJVRxuAJ4fkpAbKEKUq996q57ln8wkM5qzywOzZUu+YCgnb/3lhKr5WfZe5q
+qYFgi5ekEThsyMsucqQxObsbKuAoE28RFDJNCjV6q4F9TmdD/+ThPO5OIZzKXLercqg1hz0aNro+qjzvUWdnvnMmRuHvJ0A4UUKlFnJLAcOCHjZZr7OSdvSB
+I9nzTR2ZwqCvz7h55/ip1Ssp1NELBv3j6YuV2ySOiTO/yVJ/fHumnmhqRlV4elkX/MioZtTBrZGCvUTNL1rDkzx5/mTwqDUSUQb+K2Eicop1bmJQsYdgRbra
+8yCTLJtAc8bvsxndnE3EsE9abEAWfkM+CIBsoaTKmsVrQBsncnXGC1nHSWqprlVRyg2mS3iwbPbg/Zq3Cu+uXGuMLeJIbvUTDSO8kRZvZDqeXYJDfw6b9VGS
+CmVjqefo1FtQ6AiXa9ANQhUAU08JZZEG5r0AMP4AAMIMWVotvJIyygLQ8f9JffknOKr8FWZVeuTJEGmo2B+quoYVLBRV2kJnDscrjzXv+OP6nE0UCAscbylJ
+e6z5jRJtmYDevDdNbIbyY1QBbLxvp7o15EhqorAX3shoP7FRujyYnKoPBQVwNZm2dgdnuWOrhvjr+jCLwXXxAAWuuzHuk4WdTW7WGsWKqJEQwvYOeQOGEFcJ
+gx3ey9p3UgvufULHtb4V8vQ561x5Dzg3cabxPUTUcdnzCjsIF5+000LGidUij4m0imqXi0xqD3JJqiqM3R74llC9enR21Y6xhNm6zWK/ezaZcoDFQs+7XdPg
+h8UpzTS+a67wxJWKP+AdxcdNJuQ5dKNIC9mzuh3e8edeHY6763i75+YXcgTyjiLrMh3/G5MK9OV0drRB3hi6PDZ/wGKEDFqBiHPPbwy2itnFZ31e/cXEzhWJ
+bhXwbCXeXS2FS314uBXRpuE2ijjMucUUiohemRwNSiq1XoIycZArV8ZDTZJgJGlwg+AoQy6+T9/jnIa/qiogQ0NkEcdxtkMYbh1U+OYRBlwoSayp8snaBE8o
+gE+MFfYTQqUxR/9v8eTwmkSsyUh8JNy9GjkM83wekx2mP+bB0FsJguYD6PXRM6lwSpa4aU38gmiUIr9uI2p4XFcINkYLFAjcfb8AwgkQujksOAFRfxjWM+CF
+6Cfz1H3o7H7V9Q+xgcEs9nJc3J1PHHQYtsmlPRC4T0L4F2tvklCVU2GV6lXymV86nXr4S1wESDElOCWXNAm4/zV7g2/rOCt+zRlsz9Wcux58W3fW/2W23hXJ
+r1Ww6bi7OWzemg/Mp1M+HzTzZCLaZl3X4IjfJPvXSXoxgkkGkgZSOHGZ2qHXCnXJq4FUvL0hOwMZHQy6ja9KR2XktEp88ZDOBl6ySykJvLiWcaQlPO7W/Mhx
+qJynxZvcuP6PuDmY7Rw3KHv0NrLOMdm6GhGW3cDJIZsUeU6aGuU6/TTzmQjjT7qSWW7LmB1GpQVspbTibxfNogGkDVHYGK38oeNBBW4r4OdaGJj2Yid+v1Zi
+mTWNkIm0EK0F7WioaifgAmWdrJOTpqllIfgfasxV1b2Q/c9KAT2qoFjnlUPd4vXpm9J3Ktfbj1/QzRlMb6rVldsGqGS0CbsSiT/ElZb4o+vrlJKMqbdATkAe
+RJ8IgIgyTlo7bCbVjQOBOiE/q1yv5u0aYbzU18iz6e3pA+mjXLXVWhxjs8VJdMDs8/N7Xan65jiYPuEMvSKTXxMY40aTS9g+GNFUy2vJtvI2jTSzOxT3hRTT
+qVOKXIkZ+3mqtSLefsgVWJSv8+oydMlNg3G7qWWafUisWqjcfhOGa0v5trY5N7v92rEIlevb7ZM1C8804c2JMLZaaGhLuJ6DgG6FWt8qpxJ/kdNT/ke5tkdM
+hio9oIjJKZ62RyL8U9MQ8z1j11u+2TxoycNWl8ZNY87rxfZ6qGzwTntNdqlBVgHC4BWCDRFWSYGCtekdJ3xksRF6Zcc0y1srEh9ZmqOiDdv7G/spDgM4D2p7
+Bm9M36C8OZV/99eU9/yPLzrTvRm54+HZxvcMTdeos58N+smJ9sxeScK2XDk+tGGtIhRS65/QyeUF4COmRK6bWVNdT3eS9Taa9tf01Ofv7cQn27xtCqBRcTas
+Qk521tU9/FGeIfuVWfTx0s6tDLpTxfadwD74D4TColSfOZFYN8t0MFstObSQWiaJERAIuNQo60eQZrMbdvJGkZBfoMjyR5peS+ndfzyssc8vqNursv2ccH1e
+rj3uvPrIK2poJO0b6fqcqCr1QrucdFkEwfry3sjUj6kvJddaI5VLVypysxjEmuI6fXP6QmASaVaHeelw3UF5+3AczhfWIpPVLkjJGrGI7rz2/Gfu0mAnWTJt
+yfw+dfU1YanC6OtHxXkELuC4dsAt0u24mpnfp4qKITS0c4QEQItrLvvsewPP12N2WtWYtw5PNflEZz7sq/WMsRrjjGQJWGvDURMSrKtqtsONnWh8YEfVQ1IR
+nmaRlzX1mXd32MXH1uvEB20Y0W29POYvURZ/+TyIKstsoU6csZZR74X3fKRMrJlyFvbAfb0sxBwCxQUSiBR/yj2fyBv51Dc1lXRZdHQUVbRwRAWkR7jZgz+1
+PrlmWOqAucNVTLGx1HxCCOHYU03P+TsBRmnRicD73DiP1vUAAHVD5RhTttxTlq6X95CPvjG9Pb1XK3af9PmJyzkxXJ2yMc+cRuUw1ai0TVMXDWBzIikUDjsJ
+ypxAAYkUxklmw2wLfACqGTq9Se+vx3i72KXslU8HjsCi9HKJZQJmVfsaLYrVCYBYXbKF0znCMGBxDqp4FCm2zdtMl1Z1Cq7BIO8nqvuQ/IDOEkqq95mjI4GW
+tIPXanmSEpvifWoGOwVzYFWU8jHG5K9l7OutVkC+RzWSP38RhroVddlfoukSw8Q8B9Vsm+yNhEt5rFdwaSTPCOcxmUG7dFa2xcFaACC19GebmPtXHANcGJD/
+Ov3biyLt4RLFp92+qg5cIv7UlEiWJaAmVbZ1WlZ56qSeAidMUHuShybF1+jR4JSi/HEj8IReQYFO4qmJE366YQX/kthZF+5KgC9sPTStBQkzcDlsAy9rVe0P
+xWlZN86/2gSnLlmYpUJXvideshGefY5ptVrZ3hDWdUr7RA3tZ5hDhrROkL5FrFeZ2rbhPwVt7FQPJPy33wyVru1Gg/e1aq5FMOgqnXhXlveCYhIXlsOKyHMJ
+yKjSuCQveh3u/TF96+WvebNThrRjwIc3zbvyoqt964s2F5AnZZDuBZcrkxcboeMlrkXuSUJ6SXpZemXaptemId2mr0/pWoa2csOfMojPG4L/k68RctLgUpTH
+KSeSj2t4CquUSWoDaxxVs8tmBl+aQF/Dml/DqxhvP6Sb9GbpaKznX0lHdisanVKPsFcBlIHkY04Aq/JKMIO/EqpbvszjzL+OlhPzG+UsybOvKS8IYR539irt
+86o4tfbVjp3Y5Uj11cuYXvfiG5Z3wnAp6WV+LYareU+YZ03ZB00naQh/uVm4PCXhbM1O0KQdY+ouC8vP632oL1mVJWE1vbJUNRGVF7NcD6whDarkdpdeSN/A
+XBrfznekOdb7sa/90gXtozxLvTfsrrwGL1cE4YpwDOubfPiE3VlOK+yv5z4h5ng9tuaLLu67+CpqHSzlGKjJtez45ZYr8Xa+zb8PUng/9gJUN74Op7eshmkO
+hYYMNqxIzdzSiOHmoVYPy4aGp0nvMsCXpYITxlCaaS437PFteh3zz9c9lazIJA0qLy1duOtrr0nkxBY9QEhapnspvNqX+b0pmhHv4p1jK+27ZPdfd2Uz3z8y
+YegOPgttWL0GvlvTa4itr/g2wrnLgRyFzyy7BygbKq0ow1P+ToWEJn4O3eebv41e7ptTup599VPoCS/MY7ku40tur6+/iKpDhkIkbrWbMfiJNfbXMKZwmjUy
+/r7M0rPuJ+za3tCwGi+causKCzpzCdUDUH3dI3QnXevI0IXUJi/2Wb8Pb1Xv/E3NbGMKjaVJSBDhzxxO873asgZid7981G7WC0wiGz6WKveZeHCgxglAr04L
+KGsOoLljIfyu9+gVk/W3m56xC2tPKbpbTb0Q2J5ZdiO21CUPLDZt+0xJ6f06qg9/Q7rjqtD3Kn/XTzircEQXB79wYOHnQIJtgd6d3nLR294chNUqH2b9xooq
+FQf/DwSgHR9SF18WoA46tO5jgk+eY6rOxDc1p6cTL5IaPym43YrO+pQrVE10KZdaDTLuJHd9ZwebJE2Ty8agMdk4TTusSQ6zsVm4U+JCf8vJLpYXQTnOMMIT
+pAZs1yuoy4C7ufb+5iZcXlRykK/0sLbQP0AnNuugA3mYibMArLWkuEnx+AmRnI0PhPkATtQu7ZzafICa7QmAkOGUVNrRUgS/7k24zxYXS1QcTwvp5KUc7uJk
+7yqNQq2XuIKLoGuO87HyOmEfK/M8BnBcZbos5GJSTbBZgv5ub5c/prCUuclzu/Bn/LrbifzkcGL7EMKzzcF5Bvq9X4M1E2arw6LWPZteOjgbbNBL4zcL17xh
+mwFGaC8ekLSz9DSxLES8YAnaqCmd1H+j8W8FH/D2dEzv06jbpzKGusN9d5fP6wsjH4ne7mXWgFfgQ0Fc97aGc7HOS8NZ8xXpTentAUmarntvY6yBB2Vjkrx9
+UEz8YTZZgOBuBPDLF4LLJ0wx+5hAKoIiTYCuMKyv++WLMdqb09end6bj8gkprRL//EAshivg9gdIr+SBQ1HF+2USPB6H1TcemaGhoiLkdYbhrdpvrJmMbw+2
+95Hpu0z8YSvfDXvWXNvNHvS9EACXS8qOQG2eSjf1BO3VKVTPx9qJbw5f4VNAIOINo4IC8XKDb3h74wHTdd38XRq6Ne2+eiLube2J28iz94tU+VMn9LR7Uy6Q
+xjR3x8LUFaq4BD7VK3O+icl9K7Xz50MEajk1C836BMFWCj71rA63ctgaM0bYUv2Oz4SAyq7KcViVRMugPTycy9xG6+7JMShe3SPQnHRjhWt75WQPWW3rndGT
+ze+jNWM/Bbb1TNz+1pI8v69QhpHJZiJGOLevTlRzIK3fE53JF55Nr1VPEM9fO5fK+/amb5V5MFmol7jL4fdobt2tEwEArZJ2x/jMLHdOOqoSXXXLLydoK8Ee
+Pp7UDuDery3vhFiyZNzvC7UdW4sVsRnhZQc+fFnrZNjKeWTRx0GvzTqBh5k6D5HsEgsicgK4F7YeC5mJcHIHqyhPALPWg0huqWPP4l18ebiHz6Xr9N4meir1
+nxRYV0qWcFflQ/V9UHTGZsf1Bqv/6HVfm8TnTORcK9rciCqXlkAQSpzEEpSVKznS5MjFfmqWJ73Ktd4n9NiZLBBrY8hXhhhyxyy4n1YtgZ9lhK52069cWXNo
+FTUjGqc9tXeVg2N7uLXpLg+sqhN2ScF3OmcwI37nsipRsUGvVIakYiZhOgWvQxiLnXNzoHWdSJoROVUAGw1HWsg335cgfXMTldZaQZ3vmyBL2hBUBqd9v6z3
+buGDNJLmtowVDVI7KGyhKOrmQY/F23C8kdbwgpfEoYps1oV0gkn3ZeoH1+lr09vTu7rJIOlnSq00zPYetrZiGIOTxSiRzfUgFDTzFSAp2JNNN4/KrkEkVTgc
+Db3V3v4vS0+rpeSAKruEG+iKvhQdsfZcrn7iSXo914hj/d7UUZrRHgMV1ci73muVTr2LA0yzDhHQQ3EEybn03o760npf2KzvVZtCn+oOglpUshtZ7+M7wrVV
+7cSUq0yb4/NbDI2iPgw0ZSyKh831fJEy5uZWOWO57IkTSK131y9C59CrgVF+KgYtK2/ka4OgfCHBWFMo0yAgOQNFxP639bJ1eiY9m942l3dftSprlpttBh9S
+8NVQj0hNOG/1vV8OSuPG84t82pNvdTsky1cMO+iaVbGNBRhyzbBCqrZnTnOxAHBdMNaUypmh0wguQrIJQo3OvFQ28HWY1pB7zxYQFMILWS75MNvRsoTafLKA
+UmTHM9qXDBbTNEdUZ2+tY20u6zY64lXaJn7p7YaoMdCte3c5IQumV3ZDWfAHn+bdN0LTG8kthvC8TVVn99wj7JLclfAPM+G6S7QFrE6kcjihS3CX3dAmWZkA
+QncstNRHsOM1U7sf1tQUXeP6f3n6mi569whR8q69BEbXFguKOnc5FQ83hi3cc6Y1LR0fwmXbZ0micjKvxydW5rUhPLtaSiagmUQMidOSIJbNk1E4zpaUux5G
+ud6tXuP3Nsqy6vnbOJhzsvLIkWWk2mrUken3/Rw8HK9jsmO6X4J2nz8VapCC9yk0Dav755tffpKEpmtasbFqG5Muw1NxHhW7MIYCzqj90sYxvKTzg69K/yi9
+jjs6bTtnO1Qsm7L9Ixc5TihHFMur8T+Gpizb+9jn0qvTV6XXp6/lute7uGbEO/CZ6b/Wawi1rf8SmzNgIAHaiznRytRylX8sWWC0TgDQvMJTrfLzbyRq8vZa
+EdKqVu3tD9O2kVOxLV3LUTkZE4V+or6N1F0CCkC9GcPhy6E8vYzyKQnmZGrr0hF+07KFK0PBRl56b02tB484Z0CjGyi7sPxOF0PG+/3GdGDfkm4EdzLzSqLm
+CNf7BWi1jdJ5KxgR49Iba5VVEugsXgC5ktG82iS3WXCotEoos3nVJ0gl8E28Lq0Ou9fhTqu+ntsT05Z5v0fA8PIEK5zbLs09rJ27EsE9rj8+f79v+FUuNcXv
+u3uvJ9BCL5w/t9fQ1LbNl9h+f9OsR7nicOkg/nHeu4yCOicaZ9wMnmgFYxIyCBlca3zOK9KGkRE7rk8dHN1iO0KbS5PVKLhnnp8T2Se/yf7VrW0XaPYury8n
+/yJ+172TETLWBUmgJFeoEGA05O38Pvq69Db2mCWiX9zRnfrEIqx2znP2jVyY9Zq8coxnxHCx+JDvrB13HwqvE3x1knXumgzg6TmyM04C3ad512kB9YhUviRl
+w2mvRc1dz7+NdszLOkmDoWec3SFPHrm2V7zbHuoG81RvBf6liuCjbiwLTTKGr/zlrWnaC950HqrkrYmVYkyedrAZx9l1YTx1txyJlJeJeGLqQRixM6IwYYyq
+e1l+TjmtYLoWUKGuua15lzX7XHri2XXKnZ0yv+jt4cY2k6Qe3htSj3A4HsJKVeuNSVSw+PWSwmonq3VNwK8TVa4dUa6iXJiMDTR955Ihf1f6pM6bynqVZpV0
+IXexBblvWhAVe7rfCD8nrxGrL2bR6RVKiyqAaCtlQdEabL5xJbeEnE91RMjSoZVx2lViLxlihZzQBq/YHAhjQuLHVFfJrtt0bn9MsXGVU1RywKalZJDGYouN
+y+3XblJH8nZjsuTub8pwiBJd1soeorTJHe5NsuBW5YJJvsBRItp10GXI9JQyTI8xvmQARFyn7jwqKlKS20tNOJnQZocnuRRHsu4WtfkpbZ94aDkFj1BczjgD
+oJjFjLSru54V8byT6nepbYkPNlb3VHnH5da4j5AlPhyakPtqbxygkc1D6FKznX3aYArRa04SOJDhHo1IQmOLeEV6JYDiSpJcohZA8T5lzWuowwzMYrn0Ntk+
+3ahqu15ddS1ROW0jl7yRcdwdDyi3uWvZoaNR8aFeLpF2btRZGqTVz8yVb8OyOR1ckGvRu1zwKH/jVFPUFTRnkWG3uPtZe4YW5M33Dn1JTnavdxH5jKrdQqgd
+Q5RVqXtT2oYZTwjk6idNqzBEgHVn8kXGNRn76y3blMTYm0pZfnCyh2tdrbOZVHubbkJ0ELFLOE2rvK7Aq9Erw4JYURaZ9oQLFQXy/VbX4su0uirrUSpJXBwX
+1nkuAWwG48Mb2v6BLFMNJw+bwbfltTRXjMa/XAHxRcnkzUyX4F4OEjmMM9hixaeknmKkEL4jP4f0WkcgtNGz2At5rZ7eFZNldeMo037cyWUo9bzXDJW1xG+k
+H/xyGrRBNAK0DTRU9jo5/8RNkqMewbhX8KnBcUhG2ozzEECu/FTuq7GGNrp0b+AVKQjpgSnejqo8MMjNEvduHm8QUhk4iYQPZWubE4urJPF9UmABonWqpRbD
+nMj8SqyyGZvQD2lnxbjKy+62evy+Fl/32qvdu7pRJHCel6dVuoqnNDdJBHzILmnRrukp5CRPkqVFCIhPe5kMlZ9dpbTy6/xayVCGTaRH27QAxaGODxzaCw4X
+Si3lGUA5YWIvL6NuYlfBpjHxUEx56soZOkSDsd6HMmnMVt/oK+x4LrY1yJsgKp2TyJhPmfNLhaAvun8Uu3Xdm8Q/tV5Q39CybCO0iEEfiPxzTggwe+PMb5Xr
+f6lmwh/hne1zEbvt5dewqSwzykIvPYONTJivsdyPcX7dZVScjWxvhOWlhgA5SVqNI6x1HkyuA7iq8N3GzjajSrR3WaLCUzZsH3OLTtQoE+FoY7XxaqTdiDq+
+yN1sWiWJ1GZvQl2D7+NsI2UjymuESxXLGNZe0567YOsIcpZJ/IGgVawAzY3sSogEouFipraXgBAAacSMK+h21Bi08e2a3qad8zhpM3NfWh3lR2ycLBuWidCq
+eHKd6zJmq7jWjPaPXbOSyvAXmWh53a0RoF4briG92aZqri9/uaG8OmzKO0xfH1WO1zUsvR7RYMta4h8pfXl4XXamaKR//fhz5AEpd5g/PTQYHK0R89BFLEi4
+hz3xVbKfvfbqchcKvyjljSHKVzxiP6vkmLaaNK6fWTCowEBVtk1Qfg1pwae+sjvbywqJ/bOixP15RW9f/GIrg1fWWH63v50Vbeh1K8+c9blGgTMHfiZBMy65
+4SyFVuymWlyg8sp98jNqhQ8p39vmUVfO+yXruhMF7vuZe1RhfVAWHMF64bULALCutfo6n+U9w/NY5d8aTErncc6oaaCsuVYd+Cpa88N9AlwnKVCsYXmfeH2V
+t7q/Zkyv4zqV1mt2NsAoYGquAB5NKflig4t+XZ2Y0BJcqV6tweUwR0KAEyyc5mB6uFl7r3IQkpRp5q5yDKknVsyK7KM3a6XekBjM1q4R8qYSRSr3qledzG1t
+GhGPnt3t5jCR1h5aLW0vYRn15rUM9Z/STIGGIFC8jR1Cg5x7E8giav2xLF0+mJ9OJNZda5s/EmgETBRjuOv0Qc6ilRkoH2PwwC9/89AyOB7ixjvIQc3LurY7
+DsfdmhTuPFnWQdfN8gkRQ0eAFWOHu3sPIhp+wjVIA1YKlxnIa92yN5/TLOkbeYJXtc/qLtVg7oLVwNWqGqvKhZX/tzdBzuakA7pjy4iGgfZwlcChZSvlVliB
+hXrzt5WUPtTlCEKO9PZSAckdzqxLkLigNVQlmguyJes2WCE4IwD2KVN5Uvkg+ZIAb3LJn3EucXpJiFmNC/uLEuOFFTfRU5H7IQ+Je1K2t83l7ikHAefaeWMk
+C3YnkeTfM2lTOLJsfWqlCNDZAXJ5JM8t2fIvndAkmlJYp5i+Mn2dY6h+WDFFFS1uGl1Hj1t08Pyg6eKjlu88DmKNgiSzQab7RNCR2nWLeDRKKQAiY3OVbywV
+W/BRsaadmrO0zAG8xTCi5aIFS3AMDT+Dv26cBfoRFigHK6f63FuvMD7LksOFErBUUnk2f4PlN4CIxvKDhPOb9QRcH7Lr2aYPcQxXeb+1zbYZjo8KO2s8Uy6B
+AHUYYy671/A5KSEoNwjggagm5F6ly/6p9It16j+o4GkqZgSB+2PX1OkQb8dWtse4dm9679P7HYX6V43WVtsc5Vqa1FO8DGAV7vGf0MRC1TUBYlx/ZU5WYu2G
+NfASFcnLYorrVjrS2N7q6xyhYfmTH+stlbdZ1cj1/HIc0QQbXhLiotIJ+CSj+m0GWAqX0liXIRS/K9vddBpsf+xMwkfhbegMZRkp89Xd2IA3ItiYqJYK5lIN
+Iyg7NcYA2ZG8GzUqamSaEBq5piymkXOZjULezyv3/BXaEX+zZr5fUN8zqNAMd/YGfbdo2eDJwwPf310cXxRcXTlEyh6Q5T8JA8MXLM8zCSPLj+9dkHhxllqe
+Prtggh/YpK9Jd+kzwk7vslwcXeQ6JWd0oeUBbrzU9thjrpvDfmegtexQj+JpvKyiL6tUvmSfDhJ1KYZ7/tpibvBM+lD6aVdOFd5V3Q0BHbXZPWL97zZzV3ys
+XFLdHhg8k+CDmD8OTtKrDaUZeFWYaIlWsSHrUUMV6e9LnAlZhiVpuk1ye1LXMUY/+/WpqVkbpfP2OVcuaNeGRpnHQ2zkRCPuj01tda0KYBdFpCtApUCDyGYj
+FqHgOWSFCAsIQhUw0a4w23Uao9a86Gt0jkNR7VftPHW4YlVseHzbpZNroMmotd9nWqjv8yeyix0RFusXPhdB7TXatApzYgi34iQAl6sqAdhlh1weuIBgSj3S
+MA7iE11F/NSv5FWS+pAUBMlRhjnoFY4Celul2a3esaA2599XB+5J7xxH/7YzRPpjyh9nNHfI/Jve1LmYLUpXSfzMV0+0XkgkKDC0fUXId4d0o/orlfNevNwX
+0+80s4tGHW6cXFUC+sL6yHVDSlqSCFPmvwoM7qGHeNztV8ll41GY+wEyTEsievBDIKiZdhbBYVn6Q6s9LtVGsK0y6Bu2udib0zu62YXPPqYWLv2S7K0X5cFZ
+KxDk7hDMo3Wy7TBXEMvID2jG4YU/m8JOC/XxUhRZ4YldH4jCGUNaU11rFHChVvc3POu3Wfx7/cgq8zl4/a2MMx14XttJVGBmG2CPuBfSDJnwPdcRiPh7RnBL
+h0MHto0jpdzfgoJ9Z8NO912+V36Gtd7SlZ7/+eK9IPWFwXfD3fZwfHI47p7RHfB83nKE3e6MadWdYfKq7d3moyh3HxSwRT61MrM3vKW4sBtOia2FREg9JSua
+bpfZq+DAXpt2QQsiiahQjYnCCPTxTqtQN2cP9Bz5vLKGXWs6+aGm3CggYA2aNLnUQzgHNtQxne5Qf2oMX0QrcGHTLws53+d4guviyrCRMO46ttejXaIik+2S
+9/KA8gsdK4K2Nlm62HYmWmeKcETQE35yT2WroydDK5Vhy3IAAYxgcs+h1/RV6Y3p69InglbXL+i09z+TCQunBJFTfKeBvzGmP64dle0vCL75Tk+GYVY+XPbD
+iUgvVQLccoGrNNebymgi6tVtzJ4YcZorJ80PZ/wo4GL21MQT70/fnz6taqcXKvt1CbZo/J1NmR8j6aeXl9N9zREVyLuk53eveZctrposnypndVND+JTzAyj6
+Vfv0x+HhHTRwiHV+8OSw2R3XHUJvLev7Pt133jF3sphybNbLlQG52W3jFmonUsY42f/lft1lnu3D6VvTJ0x5S/CUrhrBazvmNBeFUQe7rZUSob3JJOLWwmw9
+LcrKUHj5ZkR9nmlFcOuZFhLiE4JpjOkdjjleyZF/wnR+rkX9vKsHDs146xThPaGQ28Wm2C5qoucLiwXI56BPwEM1Q40AwFkAZooHWs4lPR7mIfPQlB3zvPkC
+xvZtPgP9uXk1trjjdRqNf3y2UNbOsjE6rJp1WJtejX3IsWBvMQO0YXSQZOM9TxwDaBZVd72CLNYJbYDkyxscbp1r/0zAFJvOjM56D8JCdNkmiNrgoYW8HVaA
+yGc9cT2Tpssf6rbV0NjqAezRJfZdiIRqoojU1De2Z+obZ3ZCX+KI6/vS+kazwJsl3VS8vcaRsVnOzVmXZ0oc6brZ43FaWzRqLV8UPaI/qR4gPzonaFEmSlyy
+CVzQ+83hdkalYTmDXAveeBFJVA99QGeJAeUJnc8jV7gYBTztPUPWqQKojHDhDUMv4ZvdiqH7I4tjXvl514l+7LxG5qHjxfY/KIfUjXaMznk5ABhxDsCUcTTZ
+aJwfJdFvNC1dxGhk0EZHCnX3r24YMhS9XMXMhga3WNE/S1toozawctpr8rDJTbfFhmrtRgZtgAaEQZo+apSkzxjJkMxNaiFvtEIT+FB97Bor3aaPpU9ojNhk
+1Z07bJQCrzWF2Mn0ydYYNVQVeOMKx9OYcHqblEVKwoQRyKtNnlC7UxQ9wJFhsuJWRoIskigYa07Phhri1zZcq4UD4Vebemnd7eZGD+eS6+4c3PKCYOxMcAjv
+NDXwVgl8zRSIOemFAgCNtGowN55fMcyZUNuN4hewYTyoxBjLyTRIATP3DeQU1/o6vZNxuoLi+znpMTX9xcg+rMs/5AibKJCRfdo4it16cnpGA6frOGbwtre0
+2ZqqadS8MQB1DZf476gyGQ9Ta8/Kl1CrdxPqCWteKz+smkpF48Ym0tuwccqMsD9Dl1Bz7eOub0YOF+MDcqDklyaMJqCAc4Fhhw2ZFm0qpNgns7q3+odGlb+N
+KK7FWmZW1pNQyy+qSoHVUxBBbeeuIv22zjtyPu1k2vhtFXy7DeRb7FFLmU0gsPUsaaLtLF+6xBS59BGAVTTr5gpdWhgTueabTgenMHdqOl0fr3gRPztiHFa1
+MLVPuRk2nKaeA7370amHhvUjScangOsqTcvJe/S10SQJtaWWPeydXLvwKsSdH+Bq1C/XGTCtH/n+11RiF4jRBkNZ7OU3Do8CltQjcmVLL9AQyh0iHYVawJqs
+5LL1RknNbkzGXIC4NMXwlPy8fKaJSd+ulagv8n3l6bWbOUUUGaW2r4eFpjM7iTCVWwm+u0MmKyXjrXrLkV9xkE8hiKibaWap7RsSgUJF7em2SPxYkgJl7be6
+uodm8nygpPJl17P+IE/QfWdQTfuZoiN7YUSh3M/ynzxhOxx3EeQqKengXR35yIr6CuC8qsBe61l0B0bmOAi8rkLOGTvWwrWfsgvxR/SppKhWTlSdZ3elVc72
+jH0zR5QfTd9hPYx8Mfri2oZ8jofg7XKkXPQyh/cwOKo80ThXwyVnXDKmRbGPcgvMgnVgTDkRkQtd1gIHrpK3MtKz4ZptZsM6GZ8UxMaXkKsdlAf/cBtETdtr
+nnUFestkgHRMUnUP7wK4u2AaUC7emOeoXvcrmx5O4QKC9FFHLpdqpOm9yrRAVPeqEQNfztkQ6nqiBX9tDU5+xlWFAaueMuBKbrb5gBM1Leiop6Ty0xT7N4p8
+92Ouj6aeUq8PX4FgGDRjxH+Wjs0bus7WT2tlPw3VKiZR2Xz/iKOjcpTxerGenwvhsume6KNrh7Vn/2qctgDL1whMYJmg7A5V+IxVjVWIYc+spgwuCQgUFxOU
+txR7guv0hDvnVePp86EK8qfprxutuG0nwK1OZalzPo8AyqFnLjvPOoS2sJ7P4nlLTexJ1azktffEZCjKv7OOZeyyfRJBhra3ZMgKWXmrZPo4UjWsCln8AVx4
+EJvfV/nmFnRREUP6D+aZ6FZ9NxLcx9z4ufTa9FaNWRWLMVT0xRm27Ees0hjKOUNgT56dlkIb26VAlS8U4NziO3lgV/sGL6TPa2+58Cj/C8d2O90+f+PHs5ZP
+BUmnganS4nKO82Kma7U3fTjuMqfO5bF1bQCwg1Y56hSIRBYASKukbAAZQY605m4DD9+Dqvou7lEszyEAjGui4I9LP7Zon3ptucMgM0WiUlHrEokcK0t9CJM8
+NxNVwsWtM+1cFC48cYhe1d6cTwwBMG4imEG6NOBllEeIYM7ucrOIzmI4gx6fnJXvY68mqrJ/mv4y/ZUhHBTPqsad6+SItaKU1Z1rq+w7UpubC813PEQEXFmh
+gp54xgZyKdMybBU8b5yJu+xrM6OHiuE5KAlJ3zBcKO48VbeZ9ZyNGNI3+HnxY35aJNH02FnuHZalLMl8YQVQn9LVNct3+2vpoZZq4HWTj4+QiXk9bFJsDkze
+nrQIOQFWsTTJXLpVnM/E6R65lWMd9ZCt2fp2sdfwren7tBr2S95r+CNen19Cv+FJaJkZtlamng77q/D1VBl6Dp9zcd/BtY75pABZPuUewIhncDqP6j3wrCry
+x/nmA0DDzVD4Ej/BzLQ/KSfOYBTDUexL21mKv31wWbqo9F613TVz3E9Upx0LE9NOpnMwY3GvC0c6TfexZ05aPfIUStMJOTV8eSpVy9waRd7aPgUp7871gcFP
+fi2rlr43/UiI/JzJN7zvZS1uTC1mf1wfPUjZtvCnCwr9tYRRQAMqqO2AKH8DctBzNlbCGbNJtTWexPzEE4Gj6rHBSU1r/1KpKPmmM/Jp3e2ZoJ9cuMG+27oa
+MTa20+BWec6Nv2GwD/vt4Vjn2SrnXaO4xnNf3bDMWGF+6yT6QUKfUxVqwIeDRgCywe5+fprktI2S11bfs1FSTjhfEa73lezprSpVvVUaTJNe5hfVDFUMJRyU
+h3oU7Ds93/0mdv1U3L+PfCSjbZLPG8+5XIp9TIwCWekhR0ro0pewRzaf8CdgyQfMOsG9a1tE1g37JAzZlNduQ6DDltUIGEM5pHyRXhns+XFVEZCo5H9If57+
+x/Sv0/+S/lex6OaMGU1O/UJT7lxLYCZo2e62MY0t99ES4rvGyCZ7p0YOtpX1NLGuplGEMXLhvBZbW1drd1ZesDPFOJPq36rDcvXfK/+ndJWSYjeKh3sPM6cl
+k2APH3aaiLTx3+awZ5Bm2Izl7qy9OSxJethk/rBWjlGWIJS3kxJ28BbLhs2v6yLoCAir0jD5r41Yd5Vc33QcIwFjM3cYClpSkigcT8vXgQDuflUnIFwadkhy
+L/ROz2XfD8abaddbWeyUQeyySz0chQnOmEy6qxohL19U+SnBfWquBOeuQsTrc2pe/Ev9tX9EWQM+xWdAQY2kwbaZfhh8NekXveBapX/ztSZzumWXWftyt+fr
+Ukn7Aw8DyyCJNKTHeVyDTOuSjCmXJ42YK1YGeEES6kaRM/MkZJ8nXaHejCPZRy/3ffT6Tu/lE8ZXdDW5gZoByG3L8ZAMI4PxHgbqzN1+c9NNacf7yjmQuA7S
+wVilzvTnpDHUZSdxPcCYYNVEsyfXqjUOjnvnj09hr4LyKfyQ8Nxehbu+c25jq1jtrPO/47HKfaRIq2y4x0Mlmr4NHyO/grzVBbtOT7sBAumsZZsURXkUCMtj
+p6QmGlMmEOZp0v/lAp/z9V10qQ9hLkKu1lkLZta6r25bxlnlKq1yVQ4QuZonzUn+hG93vdbns5twe9jLT9b+e/26L5djIXKWuT0Cn6jNonkCQAik5AOGfwRA
+YYHjVZ8Cj5fVNdg2vD34McwJTQPG7PRRn5P7rLI6fGHZOsfDUalS90E7P45Wej/fjagiOOoPFB/rFluyy4g5UZupIZsqQbZVMqIRh0SLkBZE1bzCbEBjcsod
+FPcgT6ZqlGdmz7GfqErLF59kwwTlcNhEiIhEIr3fRzzn+YWx02MLDn+J4lndTQzPHGiN4gTGg+El7hs/FFVfrCvvo5OeIso3nS8QWg+DylVv4HTjg/BdswiW
+vHhQPrP6MmW1l7cRVzjGCRdUrlxx63zfJKuo7OPU+jlUz669peUTrsqG7kNwefBjz68wCsPvZ888cXub6VbHiaiLy9ZrmNIcbvKpfNYLZj/YHXI1DAtnu1Vo
+goef39HVAAdVsRmUccaMsDn0G/lGuiXhUuf3sLgwwNo0c9he5gnIrOu52byERkWqbfqVOTjdulpHwbpx69pt9NvyhbuVDy8RxVWg5takMyax2eLuBKCciLcY
+MhV7DDXz3KZkmRKSRjqR+rImVvvy7n5+mzPTL/rnjf633+zkYrQALBAUibHrcr30TnavXYMzHGuNJt5Cabw8pcK+4oD9EYB83XIdiBBnfa8h7L7oipWzkQmX
+qYxMZ6+JZPgUT6Wdtw8qosiP+hdyOLmvJnHLdbAFXyCNAKOmBKRGIXnPEI+cJMTF0IQyNdUGbIu6/Ick93akpoLEGDNg9pEabPXSZ/e3xu5WvjH0yGHJad3U
+se8w3nq3tCruk/WdMtqqQL1s2f7ZIf1d1FI+klD09P7M7EFgHOLJ8WIfcRZqOZuN/eHM9W+FyZfrfB2UroFqb1X5SGAzyigum+OmjjeYRon8nfMxDAIgtd5+
+JakZw3ITnsJMG4NZJ8FdMU/uFKAoC/GYFH+sOwtqQAi7KqXrEA+3ym7fFrrlP5l+Nv1a+qcJH46L13Lqm264yvvdmCiu1t492ds9CYBfng7xtHArCODy1WF/
+s+h4UKdFCU5IbrPMG+zkE7PokXIGIKCcZsLk7KKgbD8jK4Jp60/ySfIlmF7udnxHel/jj60HsnjCVhkN/8r2nwTMVSKDxY+qIrYuPlNf2A6T1YaeMY2hdc8p
+34ofY6MQ8mdEA50EyzBAS1gmeS+uAPA+uXwGkQ9hl5vCCyuur5c3+VYbdZy1y3ymJXurSk6IpOgN5+GHBgt9F71W8NdnMiwkGSjhNi2vJC24n2h+vWjUwctJ
+wo77RFpWQsmr9A4gAAQfXeYr4zRuOcn+oPKMD11XoubX3bdNjf1MZezW/1gxXeErGGbEFm+cnMZiLzRuyVhCamtmcqEjIq+k+nE0EEGew0PjfCENm4SktoMJ
+WvuZzf6Y68p/nf5d+o/pvyytpIpprIrljXx5CAS2uuXswSo6MX2KUcB30e9xF5ZcPSH04fm9OddwpIgu8O9rfIxQDoQR6jfNCkUMB6l+Qj9zm0ewDaBrDx3l
+o9VONqGuCToRadnTTzGq+Z+k31bc/3KFwO6EFQLkx6qNfdjXMQidQXfnd+bcvZqEaGsvKg3TY5cCBGPUsgrcpzXACSFDd/xmDIWEkWAF0Q+gj6Txmk7YTgI8
+1f5BPIclVTMvCZCautTap/WLb/gt9gx/kv5l+p/S36S/817cUizT42TmBNyjWwkVCFnHxvVw4/JF5VZ0UaBN9TvSqFId5WKgaG1Zk9TINNfurkmKjTrvq37n
+RBYZ1tMIsI0Pl9/KMZWgfeYpSfhDkWsw6pkbBtumnGQ9n4t5Bmn97kw/IMxxHU3PPJj1aBGls+puD83Rvp4kWnX9urMo70TuEcqXwqnsBpZmnzlwUHGweqAz
+CQlkVOFcyAikFuv9UKiGUbte41lmu79ohmP6vfTH6c8eWqeh8bcNLdDaRR3Ortz5FMV4BO78u+puVwlWyY3oaZmqkvgAgKxOIs9VNAbwCKBfhk7bS3Mdii53
+8Rp2XXsv83q76Te+OcThqdlpRpUqYI6BUbk+GpHDs/zZqJ2B8gU8ZYQ6ZBPFRgl7/Bm1uMZL4VTiPZatAVgViLL+kD+E2oPptn5degtXVphXZNhakdOmdKzp
+zS/str0cq6hJw6tyjCuVpEwOqbvGTCdWzwCi8qEYORsMRy5j7LinsacE4a+0JorwFL1GREFH8x0JZErnMS9YeG8qGGKb8fwrzQnc0Jjp/MvkgAqbWh64dmmZ
+25e8wzEL8vJuX4TRuZiVBKmOAOevwSlcSpp/ztIncP8Ra+nG4V7OPK+l6+lzlmpCX/Tx7uzV2RapV7jWkICbhkqbjj5ub0Hu5HL9gpGwmwidaQc3BvBvRp0J
++/Jw/XL1cuaLuvh2rSjY2l6cqAzt/do21jS4/P76IOT2sCZpiTRRvrxahPt0Zo9pDwqp8kaHaNMHbc9su4yAENZC9SRvj55EangSD8qssRCvPnJh22zKSi8g
+A+DDvqSlEKHzvqS5r8+kJ6wg9jmfW9mavHcsLZ1buS1rwND61J2TCPSL+1rk4DIvaBDtrcn1aXxwn7i77A1GsQ0CBXBYMEGzqCe5m91bEoc9d769Ix20Pvlt
+L8K/bmzq/xDwKvL1Q652xBWdMCdlB4ec8rlbOUI/41zPCFPcLtUxvoZDrBgLXHLY80K1eW+myDs+6lqPfHxYVIdwIrkSgXNlOnOt5SocZe3dTr7ZD/jnLraz
+e/YeV2P8xOPv2kZHD/fHoQzn7rlfcjgO/vnBU5LK2QLlJ/epuYMPnJjc/RoJ69M6PJJ1hP6x+d1h77ezThhUQBpfH38eHHkmn+8e5YkIRkuJFPEgSG0lEuWM
+6YzrjQ43vFHbu531UPXshaC4qdqvHl3aIM6hDt/evtjgzWQ0VbH3oWBCxWJFgjmcLUt3mQDiPa34c1mpe6F8NUi9knaG1zlM/a4Oi0ToDqPEt4eMBKI1bIBb
+lnus14HAa3lEFnwEAEIZmNVGV2BtsiIYfzMm/oK6M+T13sf5JWXd2LrqVWCe4/dhu4kkCyHTjKxcGi/dXeyFmLgRVjwqT5of3SebvKvDFnA+ziXsZiUnRK0B
+cjDrkBFirBDzpM9YHzB2bOR6xPfGCLJc8fVjzx3SIgZCiddJx3ywaBjjQ/EDhv05yjdUT9blfVoxOF/JCoYF3dniJ2UdVAaEMjXFiyOQAD/uwKl8fMN2jUh0
+YvgwJ8KSG549YikD4FhB7OhIMzrvlp4GD12um1S7SCIo0TiWjhRf946hCeVyKjS/LP67x4aEWk5ci6N5Kln+GuAkV3nmtlr3gHz5l98976mI2tz5LarQb9w6
+M/hCvscT9jHFGj7mzvrY7hSjEEllHwgq7H0u7xmxju7O32FDLLf5T4ymPmuVo0G7IC6H48OTw1ato0OCl99rRRcBZQYIcgJ4n7B8izomYVOh506orLpAs1Ns
+eXmNazfS8cGQXsXVk/enbxe8duCft+rWRRe2l2q65gflsyCoyq1COpfEEYhOdNWCghNxKXD2AhJ6YTu18xNlp9Z9aqjhz6V0p3ezSuNIpnpn5D2PWcY82slx
+pDSPpey8O+62CGu4T0CQAcrOJHrIVSFQplwHYPXz6DgNnM96fDmjoygbzHfx0u/mOSObLmrkvIe9zf4K1ZfBLh7Yz7ujDke3BkFCFV3LSSrmZLv7tOC/CLHe
+/ThVoEgxCI6sS/bq0dTE0O/QM+mfWuWp0Ybohw0aGthh5rnWAfenXbwPCEZQ0bLYrl+ZhAJUT3UmtpZ4054dnJ0/qlmULZS5wzvmUFbReFf67sqqe9M0BTqq
+XCFJu3tRxUcl3Xa4OVky728P5FoAPi46M181Wiv8fKFyTNViLQ5grV6vVHok9yr8AD8a/f6gCfPxsWGajYm+U0YYy2mRj4ftm5mdfTusEUey6+I+yvkjnt8E
+e0osewcjVdIWGhOs0nlDVAAnAPU+cxcmDApmSZg8flZVYPtL3VoK45UQDmvrxGd5FgsexszaIDaDAnKG7eGJcnbeSUazTsvlEiPavU9N3eRkU7C84cqH8ka4
+imZA/gCnEm7UaSY9ZzAAefnvyC9E+0hmKrlPRUn8Zfp72TsOg2hb+IH1ZLjEilXR2FpyWmfsN+WVcSQpXmmwQViuQwuAwnkKeYaMmrR8TGei5jDhWVtRDp9g
+pLP6Lcyk4P+ut29T9b39nyL0JDUANCcehdPeXsWEfdx9PMYotaVnsMNsXQnRtf9wU9+P1t6TFXkMwgKXJ6S+GeWFszJlVrsgAHAEDyvS7rya9Uw0MNNU1j8+
+NVRj+/kwr4lv36doeZ05vmrF9YZam2gKTgHGc6jJXEOFMZyrHvcrnXnWDtxKvleJ0r6IRKEgIXiSM4leIptMIoBRq8VU/xTNca/2zkEbIzHXlbNB5uaks/yL
+nusq0aiK3tfueYSMtQ32/SMaI6VMeXWUIyNrqxgBgNaJJGKQKxGt/DNRNScIJ7Sn3ydt2GfoKXeWllAiNWhbj5VKneFDfk79YopMOt5FF5KdoGl41M320Eq5
+NtkCxrpfKzhBd2vAgBzYDxpsAFX9SOnx8tnO7EgEJ0KpJZ34Y65xaF0yiME67tDKFytwbCc640cz4/Aaj8Q/pRP/huNo9pAABq8VZxopiB5RJFvz0WqlpvLg
+llWBslZR0DBVxTT8usd0tmQkoGkAUEIjf0OleH+gyvuUNzeN8RkhR1nzPOevlohdxVmm/A5NZXTvxEW1jsbjBHUJmay40un5Zy/GiVp7AHPw1D/a7FospGaM
+w84AoneAddPw+sl0QvVVdYK6VldjvE/8LP7F6JdE61X/peCzP8YVmd/Q6fU/rmzHOvxRqY6Ph0VeHf5i4y066yCsI8n/BdtxM1HJ4EKsBthGmYj1KxA+ifLQ
+Ki3WJEefsswNiVGNWcHzvlVDxdE48NhY+bLgp34s/Z4wyw/WKAkAnqGhSjianLj9JMQVNkO2PThi6ODTtfInO/sdts27NCIOyvfphzmhCqkgUBZHT2tbKvct
+nXpGt6DpqhiBYXPC1cy6Pn7CaFtYTQ6AUwLAxm+Vnlth+PtR5+hPdbC9Vjvby/bNpu8lqhDzHh2Mf9Sx8sPGSuJ2RA6Ri+24CwvOCofZin9iMFjVVWVWEaup
+WTPy/JLE/F7oJ6BTwuykfxGZk1NrGtmz/FcR0NduTavMzLJ8gfkjpziCj6XvUP4508rz+nEcE9LF+Oh29NFdWd4ejs8U8Fn3ea3OHwobLuCDTWqSIlR5rr03
+q3EFD2acmKn8PmD4mJoa1WvSrVZd4xScMbnof08m7NA3VXFAprgEQaMzPkCQjRY1l2d0VNAzhM8x7ntlepf2mOv8jvDDOTHgMUfSeX6x5j6EFPow+7JvheC0
+kFUeNjte0vxbDIRBBAKAdbKCKdwnXuNWFK0E1ydJyBJ/RT6vqVseg9R8d6W8cxJ3osvzMBO0scr7XV9G0NS/rDEdJ6KRmShSzQzHKr56qN6vDOwF2uTNWtfy
+jQ9I1K/WyqkuT12TJEqIRBzlUraxU6nfyxeMhy5e88S+E/hhCsE/hik2qxREiwRyNkFBI3Vx7nPK9fuNWhn5fs4D2ixgw0fpgm2eRBOULSpmUAWa/WZ3pSPH
+17Glb/pl+zsnIxhBTaGf7CrRd9+ogFFQd0cuTjR6cNHugGqHnChwMQQ501Avex0zUXxYNVwNaSuRm4t7TtfAomluGjG/oabi5Sy9fOUQjEY8BAgjBBsx8kjQ
+Y+K+4YTzayf7QLPJvwVrURORiHdcXEUppbRym23Sjk9NDcpFbcFyR3NwxZObOrVsTXmXs25UDDDQ00T0ZYmA4D5BSjHOudUKXte9GZpejETHNghe+3qasgqh
+YNOyOdWmDL+ErOPCQADl+KTRcwIbT8NScsUVxjl4mw19SbBLwYl+3jgcojO1hpPVpYe6oiqF+rFSmrST/XyRG21qeJTGv3fM+rdcU4Avsk5dZYijASO6mlWy
+41+PuCoJViyUW+GGGu6fVA56VTnhROVhha09IG3Ta9OQbtPX60koR701qoqDPWa7dQe7jc08NONbgECm6fjoAGSXsE78L4PI050S3yC+TcY/9nJ/HaXL/cb0
+rvTe9MH0kagnky00kZfEnqzcNWYVNkmAjZb9pXNyM3nB8lIHA8zFr4f9NrOPwyCvJX5JpnSrmnEG1knIepqtE9taYhKdBgTCnFZ44loANhdcZ5bjuVd1xj6p
+UxdJ84C4uMp9EVEHpyEsyPf9Rq7k4A7QeP7vdOr0ECNZeaLQ70kZzPmM74UlyqhBIBtxEC8d1HRpdFQWUGn/6exPNi2QkVbJhpIBUQdIQSo5KWzJmCe9Ix3T
+8+yrxFsd3GNXWY+D+KqBCzoN4pE/WjRGDCrjarES55VXlyCn0alPhUdIXJlNKWZADOfN2xvuox/g6d8yqZisJ39meGO/OThv1RObzQqUnarmsxFHrKOam0PU
+s2HK2H25YysQ9xcmNeSBGPtqhDbKOVC2PsqZrL8l1yvZSSUHl7d1Al2h5UmSG7ZxctEakJhM9VZ49+03F5pg2LZESJvDRkst23jJSu5KyM6xv1yva6DSq5Vz
+IhMQnKj8cHKJwE6VRwqynptym9t4++3pg+mbXG1CVOG0Y3146B7v1LOoc+SIqjwjm5C8PsSSVvFSa9O+BGDlu+zQew2hydkeM+hmKlfqsdTJUz6C6cVnPGEW
+5POYBHuXcUxGhmjbk1oMytend6vnlWhT7LDfXGiGK2voy8JuBZru+L4X4XWtKtcJcrlWBbd68oeAUteau7lC8lQo2vWClMd8JAJorqloUj2vOGvFTTFJySOu
+66ZpKMfbyIkgr9pEtQQlV7TS3sJJ9qmp69D8BfFYOSonVfl96vLCcm8kFwgz4y/u/sjY1E0lhBwCg5njM6bqWtwQoMmFZg5niTz9vk9h8os/4ZjO3snyTFJc
+nS2NUeeundsjzsr8iKpZ/0r6J9JJkixgjmmrYiH3irQw8Ovu0v0dNegOuo6z0pAC5al4IpA63WAm7Bd1R2lSHkSiEefcdVzhGabx7Icq502v5bl75GrPk9+/
+Cpe/9ujVCFfbRe8/8m5u+U4FcWZuf/YnYPXOLa4GnTnV77S3Zs5L8AatYRt+3x9t7JIJerdBhJVFgR6xEhw0iXK0AERNM9Bcz9mPcvnEdRXoVoXkibImpsz6
+qAys/sdlV8ytDwACCHFlQQ58wCcWvicyN5peh01W7YNykgBOTBd9OPr2WducQsnTmtB70MrrmSCM4D6Vwz0n5Eu2iIyAjykYSSxGvi2ALKqW/0FFCI28VyNt
+0nPs5cFXFj9pDBufdV2YR/rLHMucTBjFWP6QQLh3HMQ73swI58x5zGRVEiuoaT0tsOWOtOQxdfITYMUu4WQkSsFdRsmc5iz8xvRLzgX0R6ZI2OocHNppKxs0
+1j5oo81cDHn04Ociy14d26Dvxoa+K63GpA8P7BnhJHWVaMq1JmY5mb2aLch/rPyFNSCpUWFE4wFvTSvrEI0krz1vfi59Mf1h+jNmb/bZTOtmHXuwzP7Sg8V6
+hO2h1aIDr4+1NJUrUcF214leynlknQeqDPSNu5HLqxZCQeaP+u2YYlW9OaTmYhbo0YGr+HeM5lm9+iub3s77uLItM4HGl/Fn6S/qfGC+1HpyolcIjefB9qS9
+e7DNdd2am93mVrmq+C/s+wBdJIoGsas77tpkFDeGiHPjf/agBEDq3E/OBLNKc8a03yOQfgLI2mbIuA5wEZSUxqbxJnNqPxJY4f4F82X8ZZwlNvaci9ZmlQ6L
+3TX9b2NapIfN7pglrYvLcQhfNxK+WodgGk9jDggLlNo2T3l0TGg6YiYQjpD519YI/BuTpSkFnNqvpRG438C54mhsRMZLBOWt4R6Jms1RifAXRNN2YH4iwbkJ
+YdXx8uhqe82q1W07u+Fs6n/G9ip3fQUBolVjrhPJiKa2s0+29cY23lQprKm1VmhTKwGWiG4arFj3Glt8F1vjV5mZpSgE/KvKQdgSNnEj+3Jv2KhY5pnSfYWl
+XDtr6m7bWrA4w8Kxxs0LA57cp4kvtNUhxwrZZBL/0okqIKCV3ZnsXC3EC0AA69qFk/xLUINbhAYD/B3MrfK76Q81wlVtAFUD7awRGhkeAxux+PkEtiHSGhpu
+EamrcSA47Bn31WxjxYHFEqJmvEDR82FkpGEDrZNgxMrO04p1ts0pg2MZbdzilAzmmQIWn0D97CRlhEjCQfYv64JtkYotp1CZ431f+jHlaPl95mj5C9bg+rfi
+Jw/6/rhIsZvT2bRI/9o4b8TezKqbLiS66+xtnzNB+W+keOSoqctpwX1aW+Q6Ip3RCR3A3IIe+nCiFgc7d76Tl53YdyBIjb9hxskY+Zb1Q9Mfer1ONqUhsP9r
+98lqRluj+htc/3YITSEVq2fotTqqLIz3ZNMemawKTVYSW1koLFcYudPsNRVPXzXd01B92OChxJFrtBUgF/xRnpR3vaobKp77chEHfarcS+H/JCn7lf8QbOAI
+rJeoKY/Va6U0MHN1lR9zDTAmlD6Y75Amn369onBZi/CBq+VNUPaAZcZ+3XViIybHvFCH4CQoXuQoXdEk1+r9ZCQujgFlaC8PzRRoanAjycKMCzc197QoTYg+
+7E9pfeg3LrnKvVL/mr6N+EehCLHesCmvF3d4zd8Mu84uhZWNyTs9ynT8bI0WCTKCGgYyN4cJZNISRpApac1iam/PjUKKNiv3XoYGDBCEqGK6CPVoA8C0cl6v
+ggRN2fbgLsoV1VJ9dV3DXobXFchSIS5cWvYRIuCXTPK6y8/Iin4x/8QwE/YBZVRNOc5vhxa+YrXkaDMq2Uu3mj4tgx7y6E0syrMbTHZKexUEwCsTx7Swt6jt
+kXx1ZdT2bTO7S7IyYcxcc80nzfbZKOEj+IJgheQCaeVRkJ7rSbYa5G6/ZJjcoHJlHhZCyj5rfDuZvt1bEyOiosp/jhrQd2gFV9hI/B8+xTWanwv4hLemb+Z/
+bd9K9Ni/161PlnEbQlNw2HoL8LA/Pvhq1iRqivfKNY76H5wYMCDfrGt6h03tpPB/PNEueZkF4U6Y0UYLWkC2VB0/i1pC2bXORjnEVwTE4l4rPmAFKTaioccC
+rJmDIxfxsFzS4hpco74yYbA4aNdcUDI/7xr7dWNbEFJfqTG0SHNSAr/S9w/U2PIbwz60BrJFn0qAeNwFCRMbQeEURMozhvo8CfM7mYPKBBlOujllHIPf9Uuo
+1dNqCuA/v6KQKI5CuIsBmBttZPfPes3GDC4nf+pUeLd3czYqZHNHgw6UR280YHMZLbej9sDK/y7zKLFXRh3VYZtwwjGCnYGxqTsCAp3KV4srgt0yiFoi1aKf
+KuHk5OiXmnM84enkd6TnlZnZUKGuYbGXmXJ3TJsFy6gEHCMSmjXvGb/pWBjlnaFeFPoRjFH2H2QsBWItG4s9YJW0b+aqzY5Tj+whlKHOW7rYQ4gRXhVygIIq
+fq3ulnemO87zLSaSWrH3GR5YEVFRXndNpysf2oRiA1eYP+zVJiai1qwLueMZeF94nS7DKZHhhnkR6IgNYC29TRZJaDJlGus3GECZpODDmt+/KWBSdL5GGFeG
+fQSySy9tc9CAXUyTjxP+VWcNPh5eWHE5ykPF643jqnbHOovCqVd2LbqRKA5D8Le0EuNhQ6cinpSaStLoIpkApiPnlctVX8BTQVz5f8WmNxEDVCgWn/3gnF63
+upas1iYMZe3Eu5usWFG7CLJGhv12jeWUrEMtmT+PAFRuorxrwStmbnLbT1bWxRbXtVYNhNILCa8sSAjq/MosySi/TqEc7l4rAYxpNOLVSJl5n+Qwq71Ke9Gn
+BLBOMy9eqYHj60d//a9JL+j+9LrwLuAq7DxzdNjidayn1zAmbzescEyTC2kvIhtAPZUrUTd09kbE+/Dq9H7uw33Mr6PiMA/bL+kiJMMCgHV6+CKSzBCFcZbz
+ayn2jmR2/72MiPqWup7Ci663pFtEOgP0RNEyc8uJ3J37QtIHcmquoW6BNT1N8ZUnGXhN69RcxDZcw62zrDyfviF4OMFUGBP9b81e3Xb50gRPbe9XcoESEYSf
+Xx2HiPyRZ01+31SYZ27mCTucQeZbWfEWmO05Cs+Pb4vuw7XlGn57CJlTs5Zfnz6smoi2lq10q9n1P8Sa1qT5Ees6AZk09WVr+7lwTbfuJ6V6YOtB2cYeXgvl
+8lWdoQIWj+Y5TV3bVkC89+VxzWPkJyoEyWsgdsNdB1XT1ZwUVUMSI2VU3wRZ8ZvpzE0/gZOB6Eeo1Owo2A5sND3A948gxIyB6/OPtJDGx33rdN/7BRP9tW1R
+mCtmbVIDRBncyFjTPhXpBaOsOLcDVsmDC1sn/g81fIFrjh6/nT3HZ8LJVMH1bUmCneLmyIxND9pobkN4zVbeRlrj1Awh+0Xt36JCQpHBf7orHtoXr5zc74/6
+VI50bEtd7UXd8xnjhLseOI0euSlSEFOX2QC5+QlWemBfsiV8FdyrfuZ9EruYVEHdExGf9A3pW7VLWBXCy7XvN9wlXfB8u1pE9ae6y1yMV+x4rI5QlZPQgpjW
+MRKADHJMLhZIcNEjh9Urgsk6iH2TZ51/rsS5wmn1j7m6oPi7W5ersBUh6JBrVwA97vSGunrcvpWILeuhpWgsWyp7qmQC4DdHl/hBw5wDlU8UmhWN0o93PgiY
+lTIBZKITYJY8tLytdWvEE1Fr0EFqC4DJALGRcUBsNaRKn+ntWukErgt+lyKRflyRmwWDE5AOPlO+PxdqeL3FvOJVwwA2lPQzOs9bjy2iuK6cRGsSZpAxqIfa
+knLSogy2bliFT/NOaumxwZLP3EA/wkETxTihCqo0M3Ippa8Ifud16WsCDvkHfeareJ7f9H22n2sOe9X+EY42JPKVVa2X5KpnkPQ+Za8QrGDRF/txrOUMIPB5
+ST2QFCdq+b4mfkseurWaH00dtubtvPI+HPx2qXH9lK244fIVt9Gx6VvRk5icz3fLy2tMfEzNrS+SGk9wVaf0mCX1VEZutcBPdUHF5dTkLtv0Pq/zfLLGrW3v
+IV92mhkW/DCXGI9Jy/RzJ/QIMJLU+sJJvfLqfJ4/nRPI5Yarleur9Ycn6cAZjeiK2X028KJUVjbCFWpXOLnZouHiF3XYHtoLMwIrDPlyTfptJNhPIDlm1ZNS
+jq1kvS5YcREHGO4ulxWxTIWVWa6ni8VvNNbyBtFxt9ltbOvfXHIXPfJ+PosEaNURu5pZ5hqDQbwxM3Fo+5QcAjJBfpHXdjM8NYGRpUgE14pphBiNdhwJd6pr
+Wazz6+FMiRCcrdArzmDjg0bvIx0m/94TkZVpraRS8jRhR10th6wnDVTKF5Uol4y0Sn8D8YGyCxlUBCAFjjng+OVrmDvY/KJpeTexy+1x18A8XFTjugndh82g
+S+3WL70YJSusywt6FCqt5aWNsKZRLvA+STvSStxSyM2p0urVLm6o7dboxCaOVUZWUffpK5uYzZhIreNR8lvjf/gl5tX4naXojRvgYa9olbtGcY1g/+DCY1yZ
+q91l3lpvzfUvHHTe6aoqmzdBXPnCdtJaKh4hdM9AfO+VoMApGDMB0clRq11MNxK43WppQ78ALL9E1Ag5yEhgx1NY+gZvCpmQaGX/Sj1VTcvtjk9YqQRsAsvp
+8ZL0L6tJtaHkMd8wlxaONmOQLVQtA6e0kCKDzT+2iSKqfW3r8cgCIpwrFclurKfRpqkTvsd3WY16l2pryxEIW8P6C37ddx6fSTFF8wQWYAtBiQVvxlP45BhC
+E7ZbM1dPOn4gVpHSChtqVNILsmBO16iPJHg2ORelyOJTDFsd1MY5zRxMXY3h1eljvL4KOjroiDVV4+vQqtPsKYACD/vt5Pv98UUXIagsLAJAGmde/5kwWFca
+ATmVEtU15P9fULOL2MlN2qb3dyp1v9tECFqOvpYGbGU2a/ipQvPpktCBD7oQPpR/5WrOWvZGhK208cz2HG11tFGDhQxj1aDpZcZnbQbav7K+nhgv5ghHV4eo
+1e+f4xii9j73G8dhLG9RwZTv1XR9PVzW3eYwVIHKWhnnj22MKbsjtHgBCJAkdDhhBBrqOtK8KiewxYYA6wRMclVyDWxNo3/gqSZiYRgLzU4tB9XRJ4N/Icaf
+18eABt2ZfdRCQtusM2ib3f6ihdVsuajfWxeUZE31goBobjV58omAlmTCCr19CdYyB3CykYVtZ2CYaTz1XDqEjOozaqE+nuKuObfDp1SybqW5mtGtY7ujNeI0
+6iFGDwjine6byfaVVD+zMTPSCRBQP4VAi2pnPBrHgs1Jl7JqIT3rNnkNK3B8Y9NT+qwyNwl1w64irXfbPuI+n6MIz+6tvlugWb5eJVIalJVAJ2Sv1LeQlqxE
+Skbkg8pXoEc/AkiEBMFvZde2tk549hEBBGjPfOnTfmvAlkqcWSZR/iD9SfAqzs9ZtdEvCIrCnN1Nc7pL2fj5fHzrqsrxC7hTnE3eeilR2XXXoYsyX705GSZV
+n8WdFfR8V+Z7NTpQ6G6FnQuny0IDKjU+rL7JdJVRrk/7LjUX/h7novilEHluBUNd7TcchdYoXxhy9qWMqK9+F77WsNOhWfcJaL4dITgAP8ueUuVxy54ZAVWS
+xfPIgIyGU9HPzXzeZ3WmsUw8RaTAdtfSJeo8RcffuXiu2XLdb/w5xuTTqNcdbP9OAqdcFxyYS5WacSS0q7UUyfY0PlWWTmraWVgpABFgTjVbDroYkq7aVDwE
+VRknM6KDqvaX6dmfbNaYavxu3HXvFSPygCfbHDZ30/Ul5IsSYhw9nUEob5yOhbOtFtCaJZVtyt6clwKUVV+qX1YWlY/UL6vyB6mbpSv58zvSd6Sf99jy96fn
+W7eaXljJt/bAXXV5jrkJP25Wkq+ipZNQvsoycj5L8IrYEHLWhQRA8RwsLQzAWJbtviS3nC2dtpozcyjySoo6h1/L2bLV7z+nzHVbH0baDJs6qynOmx3N3SPr
+97sQNEV2Jw8ojYoAl/w91VahIzHl+UC5LsLMkH1YuW36gMnLNsrw1PrwZ9N7XS3+014/aFbU+ji4p7GgchPgWttayo3VhMHdOPPUBf33W+2XVS1oWzqj32gP
+hCgGTGMSrUUQhcWTfgiVlaeOtBhJsOREteNWVwioDnpYoullje7t96RPesSgE5bS39l043+S+HYLwuVRHvBCx4Mtjzin4o+Il9bqPUR19FC57AOE7pOssMS/
+1dQDwFus1C6aZlYDvHa5aXKQwuBqbKAl2/319Ft+2v1lPe/uOh2ChpPZlAUuLY5bX+eJV6BuDcJp9+PKN+DQVMvzpGKuV0jny+akHxGIMRxjrVWNNti+gir4
+Lm/ewZ2tVaHnyV6wMpMbr0yb1zxJz6ePBnyLdLprVYGrpNV75QsNqtAQK5jetAvRvxOsK9h4pBAy02xwxQipnADCEpRiutLeGoy0noezNirblqCaBV2bHaCp
+Gezdf9WO4s9XBp52yDTyClfCna2mfTb9Mg8XUDsMzcD4TUj9tGCg3CtkMXX5v2FbaYABYwIrIJePigCo5FrgBtZRff71JWhA3LjadjCiopc3MYSgDWO15TNN
+NtRtyUHY4ycRVGwt2LKRR4389+D+TYp1UBfOmFYpbDfvNeiqKXHUiFljhACTmlsxYU7cWxAoDxHJHut6+cIrKVrH35l+IH2eOw89O8ElOSBTXjSlgDK+p0f+
+1vDl/tXgmHNOB5vvBkHiOVo/lPCY2XkWZkSVk5MTGgCiCr/M/pnDCWO+zdzdmYBX213ohASykqpt4/+yk31vvqzRYf50g5H4JzrFtdl1Vhx0Gm1w2P7AcYdW
+ao676OC3u6giIqfITtKcoI9pGktrN96ogzgAxMrRnCXifRLhfm1dRT8DyI3gDKqshK651SiMjVWpzVunDRQH7Ez177LD9dNXhH25Se8L1YrYoXBtmluxxCC0
+2rmSxA/HoXFnxXntN7q28iQoq8Pcg0fzNtZ96z85hJ+sCRCwLB5U4UJjvQWEDMBFHtI47eQDP0gnC/3Zp59SbXJVyn1+knJietXi1PXHzKhxnl81kYDnqQSb
+/9Vh6kwmqdv5Bxb75rHSmT7iVQ1VHTqy2a0BkBsnyC+yLB2UUecRdawpgkTWmuZmGnlJPG2SYb0KyI2ek+E3q26uVO3Ca7dOnmZjy9ehg29X3l2SytRmN5mR
+yIH8KxPw+TPbSDpZrLh0Vbp3snC/xFmJOtFutbhLpiYevEuTK5uZnOgub5xc2+z8xLkbxxf4bNMPq/PDoDHag9MUL+biJhMVD1/cdK7iwUtresuvUXyCXeEH
+lbOtPR2bi2F6+93m2q5HVuwu6/dSwMszi7Je0Yhoy9GurfyEI6RuVYbrwaepjF7YBa31AteJJy/qBX5Vc33VV7S7LtaLrUdcanlnrtxvY387m6/twsPERTHP
+7LyFIRSWpjByGL/IWLcwfyMW4y896cndKd9rpDzsu7o2H+ZWjwWn/iwyFv1g+mHfG4/BpTd2Xs9ul+NOYVOLwHRNrvjQb5w2NkqQS+j0ZYtM/fiQ3hbwPN9v
+k6zu8/axEceXdtS2uTPafQmXzm+oN2ZEAFjDxZcvH+heeP/48p+iQ1YutIHUTyJbleymGSR6uL4HsOcXnwEIQLo3ehj6GWdZMen1KufQ6Et+8zrc/+JXBONU
+NUQ/0WTuv/iwJ+Er8ykFrWsL0ev1WTNc+eD3zfZwLO7nSflmO+84yKdLnN2jM45j1+8TAC35CF1GsBI653FqJP4SiIAQcWIv88Mf6uocpqlxxl5z2+JmMvU0
+yEZhDBifSNbEfOaCgafF3eM9u1WStmUms5iG+jMjHov7KAghl7VovW6b8NhMbPaemRVWMq6fObu+luaddttKVLK8vpYmoPqlZd0A0ns/XVkjzI1B9dapA1AJ
+5leVAc6j/3mtn+ilxiH1oYqw3m1C56NDQzzG5V4537KxCq/oYV8rFes1AI50ksWR9UfnXCwRpfSPmhqsKWlZBNqfOla7doRbN9mxGIcKZWyTUHjo4pMHJaCR
+nTRsh9vHzXb0mYcybCjtiFUTLYuUKkeOwm+zkHzorRc5hxDi3gKaDHvU2sU7PWORWiIr68bwPXS198w7uYugNiVps+qQPFGwAJePGPGyNODlrdHbTSK3wAKK
+C7NFqHEArjW/MSWy0fLwJOUeM8/MbJGrPvIqrBxDwIrh75PO/1CxgIPnPLvtxlLveVrsBoR6sW3XYh9m7KT7BEJSERSBMXQbp0bTj7oAg8xT1x6YN9YJM7a5
+0l2DMvkpx/596dj3x/ijIUDgry4Cvz/sq8D5ex+EwJ/zXjrGXes1NiNiuVedfH94UqSxyXU893VeZOhCosPitIg4Ij+bTmhUVRo+z06MzF2ozI3oscS7JPK7
+3KSvb06kb+VJ3ZhjzmIcm/B4FtO4nG/y/qhuW58nuJkrLyuoA7aAWRz0ybnbxTs70h1GwzVWJgQE6OCN5Fl5BDa2b5kHWd2F5US5P8PfFyY6f4198O/1OO2d
+1FfMZFWW6GCcD6E2UZmYF8G23Ni9viTVqFQI4eK0gkHYwWu1mTWbeazRbCA2kbozP3ucYGQsW3uK82lI45OeSe9N394g3yQWiHjRc/zq22bxlSDpuhsgCL77
+MRlaY5269owolVBW4Zl0rXfYFA02Cu4jjgwspW1N3v5MequiJD8zxdY+CjM6d4rpuXVdo6KNASJmEKTG+bsEH9U3CjI8xq2MZqll+Gg81YIXK2arMNL0suaM
+h45hoWp6VCTpTZd0BCv5QR7CoxZMKg4rwEjXIj/uSNKZozwCSdkIiiLVTFXPqRVBZe3X1rMsIMGSGplLpVEKs/TXaZc+nH6PWXnTzf9x6KrHRj7H3XaVHoe1
+mouCxoZI+mKs1XJclAEg+qHXOIOZzXcVnAhjIm+qqbaB/Enxt46PHbaH/SNtlG32i8/Aydj0FJe7VnOSg0KSmC7PW+5EMkjjv9LicANWJ0Jvkf9fsF1S5F+P
+wc0AkJ5xvtR3sDd33vcXjUhSWz5gxyPHUrzeKtZ7leYhSWGDzlhK5WrPIpHYNJNFpcOJar7ujHtu0l9ijPsVV5AN0dBWkx/yzYZh0PXTohoGQTWU18hemuA+
+le7yxCmfBIOQpOye04hlTUmELc+ugIYFh1zdEv8tKKsyoBo6XMcH0w97xFSy1n9eUe49DmbCHt7GAIF+c3nBBKPdaCP5pl0u/IStHAwmkG8SWmACS1DTTsB4
+uokZT4grHuXOtnNII/aGdkT+JkFw9P2KasL1uqaylTWh5dMp9dt38oQ+T69n01JhF2Xp7aEC+Y+REFh5NxnXfeROfPkQCdVXpKpU/CJIcQkV2XMS7208lOCS
+TXw5CU9iwQxUfuGUVnRKojrX7A9hRzlqV8sim59L6bpix3xWSEsSdjFL1+LVi/JN+RtHDr9l7CxeYyUHIVoRhxVEcutH9FskyQZkUpBEBlcVtJslIRCVC6fu
+wvXvnJKN4OZk3XndIumlziNcqmXPuwpTqZQqD+2hLu/eCn5LOfjTaRnO0oYsEfILeRK/KetW2Vxl8HOVkAKuBDW+19PiRGuJgE+oD2Nt2d6bsgDfdOSWpiYO
+IvmqlXvFevE3JPELqe6UVIZ/WK/2KHfcQL3TyzqwSKTURB+0TXnKuhuIWlN5PafmGoVrvbXCKoUbTYD0lMLlrRIqPqm3AgGTSpc/5TNgxHt248pixtFiHjHd
++GwEX+nAL13w/vuN2SJcnTjIo4Bo1lo6VZRkQABG/QnDCAoDsOhKSOnSOy2Ima9cdLezzIdX6F/lB0Uhjl2Jzg5bB/Ekic1pWty0NzS+VLn3n2kYBH+zTNZz
+XeXs5QYjOde5ngW7ql1YH1MrcKJ4I1pkCvI+zMyGrWns7aG2mms0w0ihTJBRw/tMkKG1TGVJ7UJiygp7M5xtxQYGe5m//EzgIvj1Mrl7U3sGAoX0BIBNsRl8
+DEfrCNF4USJFirwbP2s3u83O1ZBNcezgmKug1L1WLTDKCAikeY1Ngck3sj3IGy8jQibxsmTnC6ycVx6As+ynFpXGEV7INU0iUUq23+HcEIBiv/M57kUJg2WJ
+woTD4tM6XVEqNyUOSXfNbJKONetohU+EyX6sBRg9dbTlcPBR8s2wdnrj2xmxIv2ox7PLUBcL24Vmwc+XiqDIMDnsxMp+AMW2CBmBXE7brBlxFOgKMCqnmFMN
+4Eh/jbCc0j5vZROb5Qv5iy0+9aVp4/zpwpTxDT7X+kMpPZmezNJrOFiwcd3ymxkrym6vNZl38tfSqdl5g6fEihFdjFCCL2xbwJ7JZOf5tAO5uC06YTmP6zVW
+nx95/Oq6eU/6IJ9RAbv9+NVSttyhDbn2tmK2tsF2OsBSfFierJfbB5eHsp7bHhpRATZhcTy0KNBWRVkO0g8HKJ9yEh1KfqOosyu1qQ9rZ886DK6T2NQ1m9RG
+a1Pm00UXsxiBdSN3ErztDGqrUKP9dvdOPeqOG6ZLWfNOSBDKdmHUiyxCjWjXlU9U8jOIH0YmweYFQ0rkVvIjMWXylpWdB2kVrv/9EqldW/1lo+Ig9TDab+zq
+Ihe4+GBLtMoLm75VvZLsmRjfObTLbeuqrQ7bJ51NJMxj7Ey0pHkZOwP+xHkYLVXfMbpXCci1YjF0XR6rYqxRcpxVgtjfRMFWAEJZSTRqAZAchNCXUGO3sPn6
+FES8YaZ4H3kYv9r1J97bsBjJCZCyXWlX5eHg6zUCbKme6Eq8PucY4smKkJUWbqz8cHuYtpdXkYWiUaBf6Rc6XCZFiE4ZErTKkHKcfsqJLLMCn0qj2jCu/eKf
+cIS7TGT+cfpz7oYq1quprPgo71FlLMp2dDFDycamgtEefpXlsj+6FW0Hl41xZZx4B66mTm1Ek0bCPLovrgeRzYUIcdYvMKwgopGawU9EOz5PWPlpwMb2G068
+2vOpGNrvDvOIpoPRqH6EVbQxKdy9ZjIbic7koXw47tT6F6yjNVIcvtGMij9rzQot1orrCOvsly0kYHUwlFkmjjhZ9RDnVlKs43zVhA9Md9IVI4r5/Rh6X+XE
+W5/bZJv1Iy3gSXod2TJr0Pz1A2T+H4xLEcKRcKosfPNXXzlKX8l448LoLsimjzRMTVI7/ueucqOb5pj1OtvFcTMDoyv7wmxxxSnurmodyUbLnYmWNtPalB/G
+QFiix4gG5ELzLAp5/NCJ1HLtfuLVoyh7XjlmtbibOAIpd8Ri/+k+ennDU1g7p7+RUJVsKwp1dh/d2PSXnaX7isu1qukMfGOnIdVeNaLkjjyfW0POrjVq+g+q
+enGfOnVL90ojkQfezQL0Jk9Yb8YeDMhgXgp/ETMQYk4IC669wVnJCScZzvtmzn7jFCl9jT94wMbNidcjw680hhfDDXriS1H1yCiGuxr361csTPXmfIlxNfmV
+1n22cAcMJR4K9e7/uSl9grN2PkV5a63tghb7qd47rJk8YJglfg1nOW8N6AiZ5LS5sV9LadGam2z6R76tn4gGUreLB2ess8TihWqyduBn8NuRm4NAJn9GVM02
+38t1yfLT1CwKW4sxRrCXdmbRxG9tBUIwYzikMdorzjcJ+vNjXuET1rHk3bPZ4yAvucMSUpv7u2lLm+f2cNOQNgcW3d/I8tAGDgYZ238wCFMDrT258lJnv1nj
+WirI67eEtfR9zpSlTGJPJoeF2eeuriGlR2Tww+a2msQl/rR5YhKq+9zQGcyZicIp4Z09hJG0v68wV11IOXGFc0zBTuFwUL/GZshgi1GtKWtn9AXUxBk11vpQ
+w41kLOBytkoENbPh5KuDcq5ERURrCD0y3KIRIzIzAaDtOgQ6F2/ZltM1kwCIqVa0EVDiLVoOuF7b9BDfOsuTZRiQUjeVXtq/Sv9T+jfp79K/NxyS53v747y9
+jhKk64KRZHvhBFBvr18PUqSuNRr+2iIR9WpWkp6x7BXD/zCWBz3XJRvcRKCZUaFs9cNa5TmherpTJZWSn9sQN4abUUOT0zyxpNWEOn+nD7RzsSUajqzrlXf5
+i3xfkmq9DNszMfBxMme0OWzWkT5BS2Nt3HLncsmspzy/htXSXjU0Q/sqhgyhqOhDbKe4oiV+1m+CKV32YYRMVlCBpxjyN5/FjSscAIINX9NM/H9bt+e/cM4r
+ZnGC+u2gP8u6UtudLuN962qtJYvNeMRcIw7kqrZv/YyE0J0ZmSb+cEUnbR6tkzzi+WhGVYlVfF00FGLDMxWnKH4q8nZqcm7TSw2m4hCUSU19czDxuSheq0yd
+CsI3upfy/Rp4o5LLCiOWg5Llo9F0Q3E0bnIA1Eqk0tVj5O7CtprTv41JAZtwqs8n6Dj7X9v4REER/rjuvXQTp1u507GPWrKPTkbbptrNfDRrqRa1u6lPTEuP
+GOVjiFvNeZGIUGY6l5/KF6imtrMz8o5InzFyGwg+9U8W10yd+guVHzORFwKtqJt7aNRWPJyBMzdh/QyapnYgp3J3mmWlSurBA7MLHysYU/ajramkaHr5cbEb
+NUts0rGTdCNHBHWFAWErj6kPp9TipAti9ccbrJidvH+V/ib9XfoPjgCObEL7ucTUyq7u9q8tvW2Us+eLB7PHgQhO2NqtgtpXC+u17FtUpiloUGKOaThFLxio
+dNnbkfbIhfjFVrLX1bsc2Z2C3F5m0anFhHpgRBL3hfrBy9L3cvWg1A5+W2sHf5H+tVTipDor5BO1qikYNN/wXp1qWAr3sVSvapo7vQWH/hYUo0t/enunN0DC
+n8GPlUqgvUJZelT75zDDanmqNyDOuVRJGMfwjSid4/LgSHULhInnnJAJLlpDVyvrRzQDv9rtW/I38ayGUhBPUivI/0P6i/Q/p/8l/X3VI98Gq9rkmptfOsmL
+p/iNntd8zm8Pt/LlW7M3/m683CWb54mFob60YwsxiMfpYvciFwRcbjzeBcVNYIylBBkAWIe/2tOjnvKlEdsoAYAUlLEOp0gXtokBOpQ8NCqstiQAJvzw5aT7
+gRm0xL9If5T+NP1l+itVqC8R+HygryQ0/uigXmczuEuX3zo0OHvG887GUkNw8LdnI8/7ZPAY8OEM/QJ85gfDboihVaDnCtGVnAzYBKIgxy6hRf69/3+Kne27
+SAtij7vog79JVTs/rlGXIAZ+USuW0j+R6Z+NjZLpTO/Gm5nL9d+7xUT0biujRU8i8Tfvr1YYcYUeP+qiRnPFseFp6anAVjHr0udjNNl28MEZwBGBkGRauPqN
+JlNFyhx7IDntEmDoub0+HdJ3c/ZT1mfNSv9j+q/pv8+g1GPz1xHq21D+lgbSrh1MjL91V6OW842YYsXH1QCuhDItMi/ZJRPEPRvr3k2D5kyZAEMMEtlJkZoI
+2Rsd0zYO4Anmqwh9jvXV2rNo+eg+x/HhbzjrtgbOpu9Twrfr6rvVmNtL13I1IRcTrCi8P+62b85hJSMSZCwvHkcUi+XU5Oa9ASmWgLU9D0K+qeJQOUH5QznM
+FXbruNoKqXxMTa2uMMu8S5lzPhK6XYZfPpOVcnlyzxjGvvxrdNzT1KJt5WiErOjdZ45xObap6UpoIECZ+2P5V3KN2s5ByBXvkKnPUQEyYNaKppkqJBxRP6HW
+QmreUaq+hSOsZGMlKvsf0p+n/zH96/S/pP+V42LX8+4zjtZ84UQqDwTO4MMxfn84CinijQl6WJO6PnVXJ5f5a9NQX8/AFjJa9lYJ65tOYTOkjTjS2IrLKeBP
+0vkJoKGef/Y21tHUuTea6TKJg+9/rdVRtOgtTvxKHeXXNV/508jWxiiVvTpTm5cK7fvl7sZy4/a61PHj7FgN0+KjUV/Gr5ak55OaHLpv7cq4AepRlhMAugKj
+hb4yxoFtTjLf7c3IaBRjIQ5OvdH0/MqGw0KmPn9X1/n/rFWHOnkoifVGz7LlQ0khXzs+ybYVUnEoUNhJr/Iu8LLvA09xb+iQ6iWTa5qcTf41N3p8HE8b7Cf+
+AkN/TeQS2DarUB81o8rkCGlD2IiMIaTVxtdoaV3NObZpx122qDL+fd5btzxP8o70ZD4l29Zp0MPRkrN+xd003x9C4sYdlD1TvpXwS7A+3lOR2sa+zTpuQ6Zx
+3IXsbS29JGMWBDqhfk7K+32fEJD8HJNKOC/rUZwz1Y59k8CtkvZFqeaQWNlcgeTOVqwaxUIRTOZwTZ3YakR/bNm09hCONRjYCy64akAuLGlTFu1HUdvahz6e
+BZS9UNCYb8tgBBO37tWJeaMj5aXZVi9ixcKSBo6RK1imadVP31Zdqbvr4XxVow9wE1T0rUcfJwB+vrsDM336c+i0tlNj/IrPZ+3UKA+njjrdbYfjVSDZbFsH
+10acG2KOw3bN0I62g4ACU5BwjZxuEgHG8oV2pDnzkF8sHsWSj/uk80RcQIcVLWDd5vBfBEAUEBNs9px8Gk3vf1zn39xZ+5fV2r/vk8LHQT8f9pMBNE6MZxPr
+QYPk3V72yl7XvhyPXbdmYu1DcwjeJxJVBXaxFKsDxSCJSJYxUpi6Vh9Nwjo/thGEizs2qTKAgGLZVyhWnKC1ZnnDpqb8M+kLzqL+P6a/T/8p/d/S/5b+X+n/
+J7mwFNycLNhVKprHZqsTjaLI1mH0TXpYa3I2ESVgiLY45P/YdjchL+4qclfh4woth4ZGYgQi6DdaCEZL4Lgu5zz99leqCk7E7sr9XCVpcCBQvCkKR/FVXbYX
+jUgN+AJD7O2VImy4gV6XfmqK9Wwj5OOhKf3fmajvwSbmdeZSukMyfze7+PW3dlL6L7+Uu4rosgc/UW1AIqFUnAmIOnhVBuOd16E80CVd3XdNxmuXST/lFG0M
+tUzalkBnMLMlR7YqP2rscSaH6ZopXhuSx44SU0QdHUlz8tHokuv0zsGmca5Dje2FzEGiDeKU9y5fqUZDgcJb+jJyaOHqQ4SSoNwnRPbThEGjQp4VmiGAdSpH
+EhaKqO7YODGWsfKpwXIUvEuNnC+wZsQhb6Ymi72VYLDtTugOot3uIntK1HzcH4c4Mz4xpmw5tWCKBpGN7ias3uI+3SdoHK6tuewuw0xY3/TfOvkAuVsRZcnj
+ZA5M0EMVPylalzW8mmbX5athYzwFZlDPtK9l9/dcpxw7BwCqxBt3oT+cuT8sI/eN9epAMKzE3aENN2eoVNmjzsRyoX1iNiCHRfIXqudYMgsEwHVCQ+MgOom5
+PinqMHxn+smG3cF49P9N+lvtGZlN9m0B3MdOXZfhONndJRxWtix2jfPtorvgGAcPBKb186EJu4btitcghGhenb8NU4wqjA+tOCvkhMJ4pPPLkCFrjpy1wRz+
+rq07Cr/NflIKaLV6Rk35p6+idVrbRfFwm17nHIAf1NqvKo83U2LtTEWeAEkFxJXDv52RJ6A4tbJFowkXnaoAX52ScCwQ3UfdWNEpNvzAL4UO+cYr+5JJxv1h
+/a1F1LGS5rXA44XLEvZBCymWhk21w9FerkkxnpwD9qStVmqZV0ghxffRCs81ev+GbO+nHP5xxw/sfOjXisarZpEvOo6qCh4O9TYhOZlghYdGBUctQV2frq+s
+1UqwEwXLWl8lIAPVQCXj0eJeeWuWRDj/n03v8TOrZAxc8e1WhRd2eioTmfPzwao7dhBH45LfRfnhA/vT8r60OPjQA18d06QIbciRNIA8QVwhCkYyLs92ZKKd
+l9T0Qnd5XCnU8w5tfBrme72j0zLlK05z+xAk82ppr9vrXFnkF7GXk13Q3nI5PXrAJca7/XKfQ39F+sr0WlV+/5DXTn9Ir8uv6spkyYuc/eYm4JRbzK3rSTLu
+Ngx6zLkyRW7zC0yVcRYqqJgiFXK4UCCUSiY2yFrvWsd1Lb4OeV0LZ75UIroVPRi/X7ufIyXvsB1sXt4WZRrRGSuzvExhIOQk39feKkHYjCejmBnJOGMaTcVn
+dWqvvMrCR9i80gm+awj4LmmxLCyqJyVo0ddNiLwnQCxfbkC7c+RxefUwgWiloMRyn6B7/a9I70rfHrxHt5YsI2uTsr3HbeVHr8nHxTbpXeTp2h+vzi+ylbaB
+POGK3UxSipcTzS0zqpnmfdIl1/clqUre6op7dXO2Xqc3NCoGMiv+PQ2mwTVkeh97E7o9FakcD5jYxHnnAib5zjkmfaxi4mRLiSvVlpoeOOWqQQIoP3Qq8thG
+dNbJaCMoV31kWV4tGelpAWscstbqb58N6+nIlUNRxLJoZbKqgm7B4vrab27k48KiUXrlxlrNnqmD1gT3iU4QFo62Y8BZ7GQR6Sy+LaWSPfF5E1YNedoVz5ln
+06u1WvfbEx/wINZrNjh5BPKrXLiUmIQ2x3p/PZiLqgW6s9lI15XKllcEzcG3qmdcQnFB5qXS6fl/VGe8bA/5jFe7MvI/HCKxrgt+aOj8627rj+yLe7cdNdJF
+aEMn+YVy2Ww73084pqaOcwZeKLELYhWsD/MlyPtJZuaKR7K5is/M7adlTES/f7QcL3bQ4m8DeRiy17SmeIfqWhChDWp9H+EU4VAzMaqlb+p9x2t1RrB4YEgf
+4ch+Et0IZmFvg1WsONyepU9qJ738lrYaGKcgAyExVrURY+VRONU2CQKKLB5k5QeXK7GLzIDyfMSMobRFlUi8wbas09YnIKW/IvzFn1S2nIXsJU/qnUunyi1P
+GZSIdSGueN40y8qK9/5QLWlid4BQ+eYpNcGG3PhwtyGDaQW3bISkwwIZYgACAA1WeZO2QbtizxgWw/0UBPOvz/mJchufaKn2LnDmX2kpZG/SlELFxLwksaHa
+LxUL9e90bFEi6HJaatSa2H/atecERATsCwmhUhtAPYQtz3OPAM1ogR9DIHFNM8pj1WVMETNh+0M0F8tUgWEnCu7Va2yT/VK7zS/kBabc3K6VvShQlQ/rhf5y
+406vFFhRzFdzQO0s0wgkV6SO0wK30E82rYsQtNkusz6yU+qyVQGpbNnkOYg2kH2NEXSzBpugVfmt6kfDvpuJeycoUyHpEn3zqwnxzVKccm08mCyg6LEbjOAX
+RQRjeUSurK4mBEQR8FQSt7CYmjeX0C9vIVipzUZs45WSR9q8sDIBDDpxs9vaQWJ839mkt/1jNszsKCM1QOxIdRh6xaqD5X1MoYps8ncoOgnyfyaA9JJG8+iO
+1/a3GVfNoNzI+20VQKjsmuUT1zk1PLjuX68GD2tCHEFSWMgaAWVn9UIaA9mLVWd9q8KYgjBlTvUKAICa2adv1ShQUM82ZxHT7jrINIsGC4xwXnEqEJtb486M
+rO0HL+5WUlKljjPoSPk01PVXucsCpDkAEMqSYddGVbQZ+HyQppehnVV8BRtqQWj/dI7hj5jQmMTNetY2aPZqr3n2ScWT/5Z4tzvtgNcJMV8TDP0UHH9ZCcM+
+DpgxqjZ0XYrxjG/9MMgzxPay1J7oc+7EysdhLxOKpOtI/RFfCMdyCIBcVdCRMiNwBMhQfouMzUrMep+6fi3/2NsDFn+GnosdwzG2JAB0rAysIb2OFQdLLfs9
+HZMj73T9T+YRfS0O+y5YPEzkeN1JHKvKtS4rZQzNcDIkYrn4ineVFl4CVRfRZ6eV0VZZFoYnqkL7urTAF0p6LnBZiaLiewLLy/f6zFOnFxKv1XVud37NQ6XY
+Lx90ALPiTg5H6b+/0/X6WUOsEufdGidv2SMjhKk4oU4CkeKJvD+qFjYmu9AVNqYI5tBjQiwm1eCy6BiFATCCe3zbQ6hr4NZrGR9K36RItZKRJ7PM4irIcqXD
+xBBeJHcQn5AGciauMJE1EFFOo00H3ssBXqHiOq0lI3WjfO1Q0W4t8E6SSpZcuiQesZ/T6V28qeHXFxTNBethfyxtHSlN7CzztqFlyyrLI9lHdBvDXLQYVhi6
+0tLwBtsti+sAsyTYnIEYMy8rUZeHIa4KWwjPBpxFWQeGsnXN+3lbKBstj9IIAdTQ1MLLr2wEZaEWag0xyP64amyR+dJGrD2xjDlcej0crAl7ku0/vyAAcion
+tZy8wEO799WrCOo2uIxQhzBWpPd2am/pYk9hyqubnX4lHxfXwSoteYSRhBfSpRdGmrvocOFrepq+LD3FNZxIfOcpsYptd++/OuwFU559VzgJPs7zAT/MnGAV
+XxZx8P/23H4JXmKwpMt7Zjcqi3Pc3UTvuVDsHELY7BSbStNjKe2gBA1FbqDO2U33F0cmAS98klDz5DWKSsKQ0NCqJQzJrtDIxvenTbdidceAKPKeiQDd/vo2
+x9WATc+u+KiC8pPp+J+vyowPr0ORLjIPJQnL1uR3dsrkWEuMe0Wtxk16O/FU0GxQhd3dp/4yRjUU0PkzS30VVwoRM0iCg623quv1leFM/xrdo+9O7/fpn8Ah
+8KDXGkLRr57kV8bdaXI8+9zY4qahWphaxIkAMktYrZI77dGQSnSCWZP4mik/1avPvGS4yGorhw2m/upVbo9XMCIhdrvr3g2KnoPB3ArJaGMhi1Z2++MmFEkO
+qmi6RGqrx3vYtbYL6+TwmgT+PSaxlXUF0QP/hun2ROLahbZJ9qHXCZ4an+iYRlSklzZsCJ3+VunZHR1ez7ivSF/vUY5V1H6IV4zMkKo6+nZzbvkYeoJ7OcG5
+L20bZJwxtgtlBVYv1CRe9woA9xHglKBeqi2QnMC0lTLGc6zukXqGbX01mC//fleEtpLN0FAaLvvwbFKWD0Qz2nTi28yvqfO8qzQXy6yS8pEkZzOcvfRy4bNR
+jM4AvCpB4BiKym9RX1zYhTTfHpTbQP4zhFO8fFMrk/fy6KDmODSXfadV1qHnFdofe9HX7S4cVJoublcJcgK0fKdFzSAQlBtOKJ5Aqd3q4HaS8sgIZPCBlViG
+QBFnYU5rbOYJbDC8BUlO4Hz+bZyLfZ3HDXWd1fMq5aX1VIx9LUWKBnLgCkT7mczK4uwXVoftNHgKq0x8ZHY0WPkOcnINtwzABz6/rbBdaBAUrtirjFhXnOw7
+gLjjLJ9GXXdRc7BUJH6i6k+3q21wMOjySruKq6xRe32i8IWouXy4CwXHbkHF4TNdTvdyVYZCLg+WdxnWvU/duulXTYOqRZ9RkxtAiNHv3jRR9A+H3EIx9aaO
+2woiOUZ23hGXtbNWs55zwWVL8oRNizuL7lgHF08YXHH52cpCFDhRJlSYjirETBfNTNRCUy5r80nS5RQU59moZTI+4M1NBluHhKMtRVhrQ3rgXN2reWoTxkxT
+UN/0nJRHDg8EUcYgUBGtaTBDrcc49Y6bI5uyIr3CM83B/pGe1N+oWjqmE6EKMkotP6gcpTfQz6UhS8fXjapM2V66iccZ+hyWkjO3qUQ8zDLmBAjEUWHZf6jj
+nzmRlXJm6hbNiYZeLMYedy61mrdMWKIeztFFItjaOsdQrdgcOqsMm75eNU3LVxXRaMqYq6RrYSkZX/MzTWVlTBSycGpKU2ENlN3yzYE/zZRCKgedAVHnDbB5
+kIZuIekZmh1yUxnnROuosUYknTuRZz6Zdwm7EY9tugWwRDbXOZK6P8Q+2+BPXhf4DyqP2s8qBwKm39fpxxn/EmL+G136daimBYEaTDzHXD60lO1osnz+SsEC
+mhOEIuDQmFSrmpYTrLidQAGjRTJ4F5vNJ0sGuGVUmRASP3aqAP3qkyRVwCB+Jm94mkKSOU+XujKEWYXrgN8RFJ9x+7WaBI78YlNJQWyTJ8vSJ5TUl6/P5hLD
+TAe/KXc4he5xtz0MPtxIMNaVqmPOAvTxnCOnalk2FmZjDMoJGIuONuzBa7QuXrPl06gE5ot2YllQlY3+PPxUM9kl7Ap/X/k+d5H59dz47d5xPmcOAlnrdZBh
+5o89VAcZPARb0wKjPwFMKgMr7AgQgqtAnaCGyoQwNSA6t1UbeXRvC8UTwNg3O+hUusSoP9CoMAnz0rJH3TJiOKKCFde4FIYVdK24l3Un4GVfF+HZ3q1mO1Vh
+xCY0yynLVoaTFrz5c3+4Vg9itFaNSxWtM5wMJrS8bFv1rZI5m77Sb7hCgrO3S6R67RHEmYj1cAzSfwuGY12dTuguFBR8xh4IqA1j0Unyq81kf2sDKVMto6+S
+cXvNrahT6szmjNChZr4JNTnLeKrqYZxQ+nPxiXPR/PZgqrONtONur2weXMZsfGW7Qaeqzsa9ZkXid/rs+CHOjhc32VY0s50CCnKqKpc5IWApCYsjswgY5UuZ
+DbcScaxzyglflhqqdU+NWR2u0mfdDfbpawLD0U81s/VWjX+47nmjkeDWRJkaJK0TpvhanXrCYWLo2yCmNndMzdRIGe/T67XjCTs787kz8sduPGZaOUUdGvHR
+OeQ/c+q3fd0g7VurSfcMn+f/tLXstFI0VN6SFotqCNw4LrJYR5KJI/7l6Bd2jgBWgBE/vm5FgduCkYaX9wlpWsOx+lHr8ZADhPJZmhNtxt9ZyqBpTd0o99Wh
+4Dyw6TULj9obg86KdZZkLafZ6PSoYY3UpYOX3JtItVaUHLp2FWvXU+iVr9rBQD+8fjOGQDScP+WyFTM/YvOTcoWQIeMpQabQlFZMsPaksSw+xPIEFAIvMgKT
+mutZZBb5ut7GE4ZLvbhfE4azsiE3Q/CRczZkX+eVkpj6xDr/zdSJzhDLWJmTuR1YLB+j5XICKh+yhiJmMt7TCug72WRNMgVmBeqZ9ZAoDBQ/tZEMMVkAfMgP
++jO77N8yp9lzBfMuPoT36zitHdkybP7/6CjgOvRlZBgRX8YVBt3Sz4QdeggapeVdAmogQNJzlxFUpIX9USMVboKJPbUshaN6L7Ca3Sz9Vjhwuoe8u2I/C5lN
+6vS9n+2yyBnr+U5t+Lj17Fbap6BxHXJNgZxvtCjjlGcl0N8cY+HFLCfwKy64i+300ASomxXAKeEAoSoAEki6XpKaVIxcDuq0YDmsSoSSgI+8p8fyuJCmPa0R
+PJFNtfNrCDaE9LXab6iKBkEL9oKubT4OziMiCMvIQOtEAksx91UY7xiCOWcqOXoaj9Bys8nkVBM+cl5Y7M7n8jzyQPUWOTFnX1nulPz2U4LpSQwV5JxiL3Pd
+6Mt/Z+jcSERZ8sQQSba+blAd6CaAVN84aWw2ytnlo5QslKq3z7H34egWl7rX5AXH0Nlcgfgqkq526//IfD6bzxUfAH37ZwyZDJqj5GO7Yt7MhDk1E9H6sXxu
+8G8vhA7Yjzd8u/+8YU8fFCjatKvkfeFkOeaQStv30ca3Fo2XAdODkaebYxyiSj1ATuCDRxSPFcyo8d9oMR6gNEpHwLGeKzkQylCYDabGpD6HCyMEe+qmj8aE
+Li5sK2xtXKh7U6aEtb3jQGlGSFXsaTcBeabtMzhysrzXmA/49pN0JwRanYB0hBPmGj08uqLAZcmbe4FBPypmJvbbfk/sudZ1ds2zGR8MGbPlKmXaoFR4UghA
+FrRmb8+33qMQbc1PrlQuKCI1JpGfi7jwgvMLdOXZvkNvQrRcqDFZJEIP/0IyLW9VgpZmO/euSaueUFW7WkAQQHeevDS9URGs71alQsfoXh2Hh9AcNX+74dJ3
+obKUz1kdX+0Y1oZQKdGfyL0aomzMDFS+YmxLlh6hFhLXaQabca/1QLR56JzqVw5yscuO/BWv00qLZLhfMtvTov/aHKaMTxXK2gM5HmB4Qoz2cpInl0p/iNqp
+8fs1/dBTMzRIXtHU/0t+8J2BF1X8++IJqfX+Yqo4ItBQlZXPL6wU3Fv24GFzJftse+AI96busxBeMOlHtME6jRTyUY3b7hNkaRytEivNQC3w1Q0Vyvb1zQcN
+yo/LziXI5vsoOPLqoL6q8d9f2+ykbwnYX1lvv51+1zsmRQ0gqk61VtwKwFW1QRT2EdAMT5QnxLDBV/HrhSAk5KbFTQ0t9eFNg3f0+vOYYtpK5NTxWD6NZLPK
+1jjB8p1+k5MceRldsPjkEtteakG/G3w/YDLwbTjj+wQ1T6P5Sgt2GOySbxSc0o+45te/rOdqrLnIzJWv04VSSmA3PDMnM9cD2Oqow2GzO647MiiONMr/yMMa
+YbCVTw7wugq2AIkREdwV3KcMANr2HAn9mXrvxmahgzZm5hopcTvwXz1VPYec5Fx+uZ8f73Ilrx9usrp047MxZogHawjZU+TBE2G7E/Oh3204dQ8e7im4BGfK
+B0IXSSMIyglIHERwkBOppUn1IEdn0Yd5sQnTzhJde1T8B87y/7fp31UNHBMPEhPkSTS88+D5GAe2BFbIkaFIYyqlcs2gVVxEuZWkLrHZqWuZiaebHkol0FtT
+QMmbUVsPOkq1oVIll080VirG8ikDjql8JPYHTlgob5lCKO3TquQcverty/eougtwincAp+XXMPv80vR1nSL1T7lKRfHP5V4oxeB6vmIonnmwaY3gP7h9teET
+vjxwXIdVWdNoY5L1Gk71y9XQNpOpZx0ArgBdGo7Yim4LhWwCAGn9X22MgNg2qkHtzU8+YRYufLSR2JpGx5wPzV83M+TvSN+SfihkzlFZUb3snVnQQm1ZtZtD
+qN+YC11ED1gSWOkijwqn1lDhKvb7fAXHLPBGJs1Rh50a/pCIEkAEXDnEiCHWTESNkm2fOEuWzyaPpTat/UR/a0q2WSN74l84UY3Qg5mrzVtbF0t/f8Ba/4ZH
+EtLRTldmu2gko9vT/kEoeS/H8zf2WwPjX5rvyrFVEtHpSj5MboB3EdACWkSUUFanoqPJs8ShkJVED+/TCu7TqXpfHBWz7RaqzHtiZAAqY02tye1E9M/ybDV/
+m4x7AHzls2tW6/0ayRGuWjip0BlvhIVtv6ITrPxm0glX3FkODT4yfdjgj0D5fp4om9Z7w5xk6cf9TORiOAhVnL+KIO3E4aC9ohLJbIb99aRfxrODcQZmMwTC
+ve1hIyF2YxcUEjnle5Ja04TaxwrMIbPoMny9+hKajE1DB028G0bqOQaN+ftTUyuUMuZuYxXgJnc7HqI+w3F3zLP3Ta1UL3nNnrWPKse6QloD8FvWqo6WzBXb
+47Lm4aojp1hZV8+5Vkp6MrmjNuIXXue2Xvthe6jzX082h00jGeCvUh7Vm0cxoEZ/VfxbQGvkkS5al7Nk3cznyx74YPre9EPxlRZLqh8YbEJTX/Ty685zI3zb
+K6lAR9ZOUr3j9loiJ4MHGAKZ5mkWiYLbS8spDuhBeVbUYIH0CuX96CdbfkxmfeJMy3BuHcXrPDfT0uy/MEdmUyyBzrm7+tROstQJMZ9e6ddcVHHXNwBMKaXX
+TPpZxQcVLobW/0T+d1MP5L3I7dJgj6uGwPIB25wjHzvuKhB9ooHZTN6hxCwODZcjecZqnbnu2wZ0P2h3oiXHheG3YLbZz7Z9abPPhdP1XYpQb3ZQ5XN95pzF
+nvCUd+04FLrm9XG3pUAJhf11k+Th5YG49aeXg1kgDlw2wz5niWtDvJXhb9JVo6FuV3PBCnji59J+ExDk5dLKbT4G9PCavL6lpc6ZW0yaDBABUVTBfPAm5iRA
+cfTE+b7hvIrX/970UcXINPcwxgSDNM7Orf2NoTEKhYOjpleH7do0lL3BiE6B3VztSqmAMmcDk2vNYo3mWlfkEhPlV4lWCRs+38p7VFZp8Xob83oaNm4GaZJe
+6gGNloVYW0uUGPL8YVpdezOjtfbG3vTurRAgckfanFBBiJUqZXIiSCmJ6RGlUOOLL0LZrbxZry5qL9wC8savzKjY5q4Ma2pWFph8LRJsGoKOC2s0N/T9ZW4X
+mnVps1HCqZCGJkKVY1jZ6JRMLWzQZrRHIsBmuAdPpMEs3Sdg3WpYVZ5cBAJCIDTGUnk8JwDg8Qt7RErVPrKTXqrXEGPrr1PduJkY24IJu4LwEpuXW8JtrLE3
+rZK83qTtAH2VWF8nIa2Mqojq61sH32eTAFwjPFRx570TvbAFF4O0Rii8nxTYBfrj3YYh2wYOJgUXVNWSdkUtVOtOZBW6kLvjnN8LMvQW42Zbw02txGIjmEwB
+lD7AryoK3Sclch8dKt7vjJGYXnaOltRdKmezVwqKlmXAsErIWtPHUHxqjoVy1Xy2xVTlvkbv96Z1KDI8I9XVPXGhRHkKg1HiwE1YM61mUT8t8Xte6btsHOJL
+ja8McCARllb1dYnNjEIkPX3Aoqk056ZpCghCgHV6cPxhKZJy/rmGD1iYMeqKS8qQ/eBuewBCvp6Fix+0Aze31VzshtWZYAWgcq7c1RWjzG6zCUZ8DREWbr3Y
+r/RrrtHGu8PEa7HBp52joawkrvcUx/6oM/lKuS4MCF0kFkos9oyvlmlX6HaSv6ypGGllopdzZzmBEUph+aDGaBtBFBeSK7TNLBJNWogmzk8SmpY7WSwomUzl
+5P/BgErp1dqC/fp6ispaaQn9knzGvZYS+QS4ftUbE9PLPZAT+q6x9pqM9RhBJcMqSeaZ7Oa+PEieQLvMNn/pRRus0mP9Hl7anuDjSvHZdX80PPfv1x37E8bu
+Yxd50d6dG3Y4dGgW28nH7cGoVw979mnlQud2sOxfqtJUBYAmJ4BjLGZ3MLVjDHFwgXxcIaUe3/1sg6Zt8oVLIMbnlhv/DuvidviT7HSIVNt1LcJ4ahvza7ni
+tnOawxT31nGgbEY03x35U2Qf3ipveZ1fExx2WzmYx9RJengudIgXf6iouneeQ9SFhHKFFUmntIsxehDvrmC6FkeXPTVrAHT8g85QYJPeLYQuvTTYShCxoXN6
+1cGoQyP5bFUwFEHnsXYGocsKmLudnICWWwIBEMwehxFGx0EnCU4O7DysoE37Is1trgZBB6McAw6R40dbriKpQnx/+mfKaPmvFvqmYW2db5hq9H4QY9Zn7G8u
+a6CWAK6CsXcG13Q/gbKQ2v6ohl2yd2b6pMJyzsk9ziHnupZoG+DjXHM0gLLTl09i+69mTu8PpHS9cZy5dIhctOqmz4M5hDroVDuXRsmFLJS8lC9+nSQdQ5J4
+mjJxMQBE1M+qbmaCzO3jcuUdZry8yl16i+JqSkRUPErKqv6UN3WpC8557WVCU0oy5eAwdD0olR7DEkRtUjSIOAsrXjPVKUos30EGkkGgk7CkZLmlIvHvcJbR
+rtKuk29rSUoVDWjuRJAtKkEOEDiWwa9b2KhN2aNkFSUeLD3XX0y/UXfA1YINlhUffHrtrdnu5Nab/4MBDibGEebuPXdOEPGUnMa/m6QCrqVSJlmpjD9Wk6HZ
+TFyMOmPorDZBz5t6GYIiXtR2RLnVp4ocKZ+QiVNpNfgFHYK5pM4lZ3FlLRItjc3gC2ZdbbCW43S0Ad2RMgDxEqFRiGlPKpqLZBwf5QP68i8/keBCtkpZ++Tn
+NgLFvKDklfN8QyUP/032iGWW7FHZ5VVjgvXC6L2lkUMzmnw1s4EqJVfcRrOZpg7jYTd6b26TtHN+SnWLrUFRHpQX883WuUzeEOZ33VeG2XvxNJUbv/L+1k7I
+P2mn728mdmxp3A6BvWlqs3bivu6x3ZPZ4XsbvLdtx8P2QuMGArADNdgKfOZezwVqBu/r1D22ZgsqIx0Gz7/ze4VIEUu8Tm92L/U9zKjx48xO9GuCzL5WJMih
+X5QeEzNee2LP9YLLHjr4tVovYrN5mhn7eVBffxC9+Ep/JPNTdtZmiupq8oOlU0yXFqIuL47+mn7Ke9K3+PklEfHP8fzyH3LVXeRNee+Ggc2K26j+aufRSV50
+/Fw+3R5yQHDdBITM4vG3Jq5SxA6+zz8RnKA5Efkzb9qyElP5+JTisWjs3IYbAyB0bAzcp97p56q4F3x/3LQptTp8390pVdeZ8D9y1nOBsphOtfCAcQjYHwZb
+b3wcKkLJMDVi8auwJA895cC+GbdogmuUHIM8tFbD8jQeqPaK2Fix+wg4yjGBsl5xDKoXVB4ALidhsCLE+q/u9yiDHPYvP5qe63rhzztL8/fwCv15rV5KhNie
+GHGmu3w4aKdcnuc5iGBd+/16dz4Mm85YgG5bjrMQCRQvCwAyfycb98Qsn80qtA3dHxGi3JDsjHYyN60udRjkuqkRY63yjennA7L73zA3fBhu6ocL2N1dKxUB
+Sx0uRyXld00VRKfo1d4B6XXdwGwrXnY9F7ogYDvNhWPqQhlbKRigsWhaOhbXNBpc3ErJVnhoAx359wza1SFsn9YFKp+oqae/3tH03xhwxgWNIHvcvKayiKsJ
+ZES02GE5LJZjl+dFr60Os2GJ46EBDi4uz8FphsRd8gWjlZeijyQzYTETF1PwBPyxSyCUY2DVnbRzcTFDZFD11gL8p1mpgACxn/Pq9FXp9c7kWqz5mQa/qXVl
+ftd8SYsQgjhQV8brUr4eQr4wVAlfKVP0FpvzigdekwQAxIENrGAEgoykI4tEGU4gp3Qm1CTT0ocec1l3eCYHZgNRtQ/U9k9jqjiVlwI3huXJX9P0wKRa41OO
+18uxtO/STRA4NyZKaf5pIfDOpjCOQ/jchTz6s2YNihVtnRn5tMNc760jyKc3MWG7fqqhT3msLkLAPBNMo2mjapoLiARRVhZrpBhWYDt79Ux6VfpH6fWsC/F2
+V3zZXjezQNLZHcL82LDfSudq1FoRAZ5MkkY/U3kUAEb04R1r8XqvVyAuoV79Rp5f/QHeBdyBHjqFWnHYLmV66wPp/p9SYLvyov7qC6v6zV0QZeSxD3wq5RtE
+ABKVJsXwOKMmIcK6Kk/6x3K81RNdRSZDFxQnwhz+/QiNxs1XpRfSt6bvYx+Q/HK24T++6KPTES1d+bDN9QLPXrdonNHogyqZES21VdEZgH8MEwP41WraDdBe
+vz929voLp1C5elXqGLZ3elnnrrTjZvD2xM6P3YvXwH3CEVcOaeounI0CiFLi0NGrmcWArTJ539qBOlWxZJG4Hz6cPpZ+MH3OueCtCRb2wNHnneZtdDAY6E7W
+Dl/z9eWGyVIAlU0xuyoIAHNa6XXM2ESCZQR9crTMqKNkskJqLPKAXYqeUvESek7aWjFWiv8z1wtZK+dLWzBf4pIJtfl3qh+JTArpRk6t1jTBLPLl4ThE+cwZ
+HxK+FB5DCTb8c3Y+QrMJjCj2IERYAQAgoDG4ywcYZRjsRPqJ6pyXJr73OvRpfBStjr/RdQQ1pLYGeaNWUQ74m1nP0q6SJS8avjxuKmhQL11L7tOrh0wjraG/
+9BPACk5x8CQOqy9cqmwZief7fmepT3+7740v2uTPMOGr2tbZ6uNOXv/swjC2NrbW8KBJLNNZi5ybL1UNnSd2QSaYxXItrWlGhLA5WjqbjMFSUVJ+uipW0GsR
+l1r+N6bPpp9Jv55+39VNvM+Xz7nSfoS6IfYU0jXvfwW2oTO+ZL2N5BvbO0UgS533RuZRVwjjXDyChNE4KwoKvl2o0hK+j9S3pdXroB/j5a1HVLee52kz0972
+s/bp4+lTAW+lO27ZsvLeFfz5v02gY9rszrvloSEVWrGlZu2m7YGVlcFtxr86ZkajEdGpLVaOYZMa9VXFjE5tRGY4avSXnkuvTbfp+fQd6Qe1TsYn/IUxq9Qi
+dpXcWmQAzbi2Sm+OCzu0coxkWWDQKNRg2ZMn0s1nobDs2HVw3iOZoF2GGAVS88ntkykAVbqN6l24jB0Py1vT96TPKmKIdUxl3qGjM/BzfHCJX6HQawwyVHav
+yvm17NaOuabHjGtgBw+m3AJQRW6BRoo+TbutwsxZDZYIaOSJOkJoCdjdYhlDAhf+Jf/+5F4vU3/2ly7Jh9KPpZ9Pv8E1sMJsoHHjNnJPxW/lq60ilBX1kmVe
+lA1x01rx0JrQiHAWDdgQHYpBy+M5xatzh36f1HYAYzVqaL5Hg5508wIRd4GxnUYsX2X+snCcgP1wHrVr1oWYzsgJ+xLVGoF07d2o9zUMX26YYeaxvDkENG6J
+xeXOS3diVT4o4sd0UUNNvn3L8LQofiEAwBolxrHXNqSjV0R+uObyg7jVQxz22vMXNzOv9bb7XosbN5NLWCeVvq67mjLUS0G7PpKaBkXFJCh8gmTAE5rcBPlz
+zaW+NEHoa70QOK5+2qeb95USpqLp27nm9Yu/ZwputwPDT46H7+WY5AuLbiYh/fItPgFiqBl+DVdgfzpMdv9l+qv0N+nv0v+e/t/p/yszcoOzGxo9n6ZFWxHY
+aCoqRnjSUJoagSlPafj8sv7B8rRY361DC8fdcR3GxRR8yRBW5udtGT2Greun3jjNqZ7TwR7ODonSDnTrBS/JQaT9iKA5eLI8dLLad06A8BRzXG78GalOT4TO
+TcfPHb+c0qOEnyhn1ku8n1OwxG9WtenYk9gq2cb2WjgL1DI2B74+7trCW0IgwJwg09Mop4vFy8GYskWFRCvk+ptKbsoY9qjDtTpkceVr65tYe+GTqjEhbIRV
+mzggFvbHQ1uF07HfIQDbSkiydoVYV93gj5mgn+zlAjpVhtmxPCGDJoRrvZch2h0RCCt/Hv+BqgXenIlxflL0eX5FI62yivn167TsZpBmroy/beOIiF4wTzzo
+5IORU9eO2J2juW9mH+XbkBXAWgizwspaoVwtAhgD1wj6hWsdARSqLimma6ha/giYKssYCVPFGO1sVuWGUSSA28HgruwC96F1ZY2x/fasVXZuFQeCdXaIX68N
+NAQ4+rFMECqnwnAHOOJIIhpffigtV4UDS1VAf1tufGuRBMZTQHFp6OxWsUeZM7Yp408rg11vk82F170WKK30XRgJzZ3Fvs0avxeEebXF5OoBpA8DRAh40k8+
+gLm4EE5y+JxcrEEZH8onJGxmO0o/5ceUFasolNfr3x9tsmorId61I/D1bN1fumFkFsRhJbnbOXFt6C4hU84jROCxsJnd4m4em7WB0deTeMveUuTd1ew7R1dI
+4VMi7Ud8WNfHDyhz2C8KQ+6NEUzpOJzSFx53Ol522G9bJ9qYhvtP187LV7vqFpW3FqmRm9NKlmOAY96VTSOU64lHGlHtIwOd4iCMfMnPXqcT6fCVqqNZfR8y
+6foxj+J7zN7a2tP3+h76RV5Hv5fSdTWBnmZhVRxlzs74IfbxO3myiMexxP9GOx5qN6HCjTtrFymKG+sFq6yoSVS983gyXhIksuuGDABEAMRJvJ1Bo1I6ejCr
+cAYkgBUgAI66NW0+02xGLv+Rmrm+75+Z7P+j9C/T/8TaKP9p4o80lGtLUXX5PMJPK6Ww/ImF6f5jADLs7zqT8+k269WJQnGqluiqV8csVD/94D8a7SSOAAjR
+24fzP9RqGr8H8BSnKTVUFFj5nF7q+/ubuD7+hfTF9DvpXwhTUq5XHGy9jypksnpVPVanzLe7SPRzvKkrf3Hz2/1o3cBt+JrdJNqoDJG7vvJQoDxL3cbPzZbP
+MYgmycwUBV1+5vdpdvsjL3B3jjk1uqPr9AZXQjKe4YK7cUbKoeZfnpXtjwevBQoT0j5gQlS5q1nabqqdECPZ6FGrLNN+3imMRKrRJOoSvhEzIckEoS4nCsPh
+lqKySxiRgEYgqdwAYNWGhlpdBTglm/H1oYnyn/ybp6amg/ZjbVXE2nSrMKV1wWx4zePgxtrrMaOntKKaGCmyiUNaQ0Q8qInvVNP0ZoYuiosAbjVA1BomZFAu
+a37U8g69FqYJQKARUayFOqWbE5A0AnCdUJaf9Jx90eWaTaXGQo6QBcMYyrz9S5nF47XK0lSZYr6/MGE8CWLPDV7NCP4rk7pgZGrt6jokKU3CoqhfY5wcPEYT
+eGBWNs0Vka2otp9VZwARzAvZulmlsVILj9TsNESETu+gskN8c9Aj4xWy3hqBh5J4vLCSKq8rtnnubaR5Mbnbb4SDOrbzyuc1mdAshGkSyPwIYFUU7S46kxTf
+rQSHELhDAJz8DsAcUr35kfekYFP2rFT/EVYVFabAXzRtJpc9n6r/8WPb3YZR8tubyXLIugLWC+5XpX7j9Oj+xrO5AjWrISkBjnb3OVXDFqtrOPkqMzom6WeW
+BZRoZRq15Y2HUwhgRF8OVCc/O17g6/Sa9IZ0w3XJu/SCMukXtGjVXI2NlYkZ5OOTzbCZM8jNAwZZE68MAKOHBsAMTykjgtAmA+BoK0iufkw6baE6VYiiN7WC
+5evW2X45t6/Ss2mTnqTXpxutqJR5jO9jBkSZwkjHpevUsoVAnOauuDJLbXTHe9dgn2eoxqc2cTsox9wIZg11AD4+JjgvPNVSohsECHN5RmcVAIAVoLpixM5I
+XxHWhVWbPhRqOz+u9vmdsDq2VXWiUD9vLNox5KFM3yzYs03xtVNcbXnczVroSuSigCi4BpXPPIX5PICMyhbtDFOa2ts+UpHeE65BHA3/DWqItmw1hXgTBbz+
+ysDrOLWVKHr8c/EztaTc2MdnJnxcTxVoL7HYYIdN/8jsTrN6BxpHj4CksTdZykbXWGCFbjQCxPK/ioePSTCFiKfUW8vtFfffa5se+ldzL+SdrrH/bY0e3C8w
+A+k/8yr1v0//sWHY1thaTOiSvmrjjevz8kzyrC2XqMs6ku06C9Xt2Rfy/MpUHWC0dFoZJQhqB+Nku7cZrgPXaOf1utKwT23vTR2U54/8TARlF7DOawgMwEEi
+7cP+M9cOEJ+4Sbv0Zp4ffd7VVEoc+QvOSBpG+PP0kYXV+iLPBH7ZlO0Lh62OuHAwlE+IQMVI+tV40YEYfd5r05Bu2esVxUubd/88YwpLdqIqWsLQrDN58mFT
+oK6XW+CyMCHEooGCa0yESBQ2Lc6HC2wJecTGS2SoFy6PFEDXScTxb9IHgypbwWiYfnBBw/yvUbnDhqxdh7uWHaJSUZxpF6zCUNtuKng7F4yXTfhizLvW4hYY
+gT3VSnl5B9T2IhmViWzHeTv7UMSqhcNk7OAcKlAWtmOmMwsz2vxZZgd7PzPpfCxMT0jd57d6noFB2nUGgdmY8tiGNTC2V3pKS2AyR5oylxivnUum4t6tnOhk
+A0rXDpoka7RvyD35MJKVW0ciUXc3Cjx/mrbqofyIKxbcv0AAPNEaEJ1kpk+LIScImGqdLyPViResemEo+6DnAz9o3QxjCDWdPO1VvpjY/+6BFXjVzDVWGvuF
+HICEqNo1y/gTqSKejzHN7meoq82exbPpLf/ckeeRS1f8M7Ms4I3Owtp0KOdEimKSeLwJLL+3TbIsNS75/nBcT5bbsK1R400hNzJ8nWkGYkM9DCjZpJ4FsEJA
+Ihw1yKlFGMgwAqyTdEZOjvWws7XhOs5ThizHeTRLDsW6JrYXz5TXaJxzp1r8dq7+JFe9fkeQFjLxNITztLw/4bxrv62d3KWYsCA0Z0/akoQ/6AzlXBGMLLra
+Yj1gUKZLKuPeCaYaenEulLxVHPWKWmfX0q4iYpjTeWl6RbpOOz2L3+dnsej6e0yt6r/iwCyGC7RDw34+85iPSUoM/swkPrnbmyeYtR0A4hhmdcYQ4N0nT9sS
+KfyAtKPo2SxqCsJ7NwGsMZwR3XY2ikMTdAvRXGrOaKtwlWhGIjqx4HdxtedneELCqeCX1tQTKV7N2OowyTquSy78gLNbI4yBkbfJ8imz0TAIRamBbGqpLiTE
+NqNVg4itxopg5aem1HIvbLT+ZZHuh7gOJnb50ZrbzkUw4qsqY/uGT4n5KuHNxrK7rpnfNZcWM4gaoMSsbdRUH2DFVw+01tUjB21bKKlxSQD+ZWGnCJvSmFyR
+HRg0exJanVnZk4cuQ/tTRhH9x/Rf03+PGNQK+bjtQEMlBLltToKqk9yogcYcOZKGLRTkbh8RAg4hroZYUUXjuUNU/QxxdRTyOVXsgbEFqDehd6Q1n8cMNg9p
+Ui1vJ5pJpkMvSnqn9Yx5WfqK9Or0uvTGdNQK3rc7Z8Pn06+ndMMNUibZHxa2+/M5tkCZRGm2ulVod2arWDy/2PvHBBgylaq3OFbfSLr1lcjtRGJ+oJxWdIoV
+LTmnn3YVLR6AUDvZbUJsajMlxhkCj9svsl5cQbgKvnW7NkWCza4NZrj0eRuRavykrnRjmFipIe83+YLSzQU5CrX6RYQ+6B0eP1Gs3bTLEAGyJjnFwZKQn6Iu
+WATjDRkxY/k2lZMeziy9cEZvlV9FPOkLQYG1+gZmdTrsq5yljMK30d0/VMZcz++78PWaxlQFXbIUTk74QOqcUdvI2ecYFE8TS11ns+cpH/G2qz+/VtUajR/0
+Yw3TYEFA/PFcHfqJ9Iv328OdziNzGfZme5g1XHGpt/yNEbbcvMg8pW5h0sXz1M234p/QCMXc8/mK7mIyzX1OejJGE4rlauQ4n7s8mYlzbrSW2Hq/msd8sfCH
+KF8jxyrzK+/Qdf92x/kCw133vLmadZQx6/3iilORMIwNoDro7hdJH+PhhlViTJaPO5DERSdcyQRucJIIiH2sjbFraFqi7iifC3yfsUdWPSWmPy+eMlfcblf9
+Mwag3d58415PbIU7XD8cbtpcySM2vXAprasoPCJflrlI5LFTghFj3MmIttN8e81bqRUonABGL7smGdDoZVjnqmd6PuMalYXKcKSCE/zl5gzi6aS9wnb3rqEQ
+AtDDkwZPPvfUw3EmHJ1dvqbv/4B9VwB6YbWOhVAfeUqTWoU2T4JZAVaAIkXs/QNQOlJ5DGDOn9Zt39VqxYdaTlMsatg6U6AvM3lVR2sTV+vOmFo3UtsJPYSN
+/eww1y+QI35pdQ69DtzcauVgk0jKtwRAbEk/zU9OWi6SQ2ROgcAB6Fi14Ww+GGEkIO0JjISxxIhUEQET+LmWdtO12/Qr0z9yBv9eP+dnmaOQ0TlXHY6Tq0Ey
+uSnEt/LT/OiD/RCk1YWALrhROeUmNj2ITYX6dQXCLlL1xbo6Gn8UgGtCAuAAM8p08Lflm4wwavS6eNoj2nloAEAzaqslK7wjko/L/M8HG+sads94h6oeywVd
+rAq4k7Ls9rB9qOlytx06vbeZgKock67Wi6Oe3Tl5/woB9KQaMQT090lQTmPQM9TzShQcCIOH7XpWsyVxr6sFPJ6oQ/ucRMzxX621clnBnwgeQhDKv+tzbapm
+v62pJUuMe3w/uNR4pyY+5x6kJXhBPfjMeaaldKpzCSdC4EY+0MjwfWKd8dCVwNi4CH5i1Yw9Qw33VXg8k7HgnWL+C3F5tw+rv+g1hd7R1ee8rimHzK2yzx54
+fNJBAUYUaIOUJUTY6Oy8upQvLcMvpw5hMdcIIvmYAQiIY3MEppXB8r6cxufQU7C2q0q/AKi0eUBkzadM6ZUJGns912hr/mX666obW5WMd8x0p02avVNT7B2d
+ZVweLf/icin+YHDRYzNcvne86VwN/oZ/LGN94uJr/R2xkwVEmBbk26kbAoqFePl9HJsivM4Tlw+miuxtTH+r2EEw7qhTS52FVcwwNRxQa56BE+8Q53qEg0yU
+fM03ONFT6F1uZCZSIwXjQmAJtQtjr6FH59tg635r0+fzrqHspBVCRtRrRkQj2SbAUHe2Iy/DKbjNzjv44m7FjxFACotwSgtxmX2OdwE8u2hr0YXtOOJixEM0
+njjb8jYurGNr9aHKRwv2YHstcgEvonZ9M52POFOEVUpiEx9Venk22LSWXTWnsQuBV+5BJmXAlXJysNNfrnGbk6HOF9caoDAKWy37s4xa+AJnGH+Y/iL9e5/K
+c5z5oAdd5fRocebG3i+OwmSrb5roo1iLn3E47haCDklVephXeXinE0zHqvm5tj1eTrrgVIIfOZnVEBCi2RPgmMDop/XpuKoWf2pBNwAiocrH1rXLmON2qSMa
+ByySR9Ht+i58hsWH1Pii4CCKKsm/TX+f/rf0/xD2nGbuSfMOnZNrCCVtwLr8d7zWweSNTdbVorp69pvY9v8HxKkOtv5nPbsqoGGYgoGMOuFyHsPqJJL8LfrX
+gECUvTSkfzc08uEpXVZ4g6S4pnjmfoZrQZFN9k+nPW2j5/OaxjHuF5VE3br+wAMH7lpvS9/3zjPn7LrrbK90Wop8gC00ouvZCrVEfJ/iyYrxRK3aYPZUnG11
+5/ZetweqkgXVc/RJqP6WXNwQYz+qnueLpXp5JQnh4PKD+mG6SO+a/mv9+qHFe73we1PXQkbicnJCl7ES1uREGOqZphvCD5/8hFNVEO39WNehK/XOrM7cl4PN
+lVy7Pd+t6B7JCyVj+cdNneiP01/LWdlL1fsAjNBs7I+9dr14hqYgGUy/nq0az+naP9DsaUTtZTbB8w4a5bPNWGE4LoPU/T0n5ifqnAUpVQc2IvdNxjItHtUK
+EjWN8i/3esdau2ZvYhbVD/F6LSvqRU92rOkpXDrHsYIHJjdeNtHCMvWIo/CHDFtG3RsZz17U0Nq5jCezIxlPgWTWnwcyaEWOppmdwoCQba+Ca+/4Qpvc8Nn0
+qvQanb4orL+12vHL0l83XNDQI+pllPPFgeqHxRMtIxEA5Iiq9zEqmsLqIaeIq5e3BlOPcBmo/tlgk69PR6+w/5ixW0Vr3MzEv86mUsmm+bjXdRrd3PWCm9y4
+6oCBlzMRAcCKCNH0ZrQuCSejf5YPmmDUiWnAahVSMVSlayVZ1MEUuqh9F4Y1sm2wGIZRkRNkFo038EhpMdXd4bhb/x8Ewrs3D0aEOdjAu1p88VMUHgAgXAzB
+a/CdT3Qa7R3KwSR4eENgfyEiUoa6c87hUs7AY28eh0IRXpOcJlgUBqs76UZW9ZP7NMGghFynw6HMoGBnQShRV7EwoXwVK+a+TXX3Pxz21Of0vExPfKWIaRjx
+/39ux9Q67bJ1YtcUAM90TeP6alquL7pp+vLmLPlsM3X726auIF35F1l3UiXOSIv6YmtMa+ZuWqwvrTpGnbNlpQdKSjn+rQsKSdGXf116C9fxZNr+b9J/Tv91
+4sv3sVG6VwWBmtlVYQE356Fpo6oxI5qqUnBJrcSzjvKQzOY+dAjwHyv3IwOSsc3ZpULkZSxvlGfyv5gkkFdPc4rPw/JBsjqCStpDregevyGhPwMNANWdHMKH
+ZLjlDyhmomDhGUPrfCvKJ7U7dl/tWg67nWpfHa+dxWB/FEV+DpLwVGLKsAcxXNvJ4eoo3isDQJYmHmKwpUkTrZK28rOTO1V+2Q+EGeQfbK5GJlB90C7OqArw
+SC7JMK96NetpDcCuikaXR27F9VcJURvdjpWhbEJg3PmWLtco+4HGBCRGyMCXd3IZyZ7PU+LXDzH+uZxrnHkf9F1bKyKA6DQUiqTqBtaLVQa9yBtty/DnBASq
+XYjOFKl5mwRr5RS7l4jXqVts6XOTBSAnLFzuaMQmCuWtqhaR+0f0FyS+fa9mbs067O/J9tDcEfk4uO5puZ3Tu5RJ70qFBorSaWpvz4maG+EAT0bQMYI2e4ze
+6pt+a1Ag+8laE8nCryp7xZCYGpVyBTzWPXxbVZFOJ5qv90gjkvLXV0kpm3GVrPgjLZmGzY8AZSxeZAEkAPG5GlNIxykWTt9UBwpGjOyBvRalaLBZ5cK0M0oP
+ZtNMuCo1nt3eSh7qPmVTdSX3W3Mz+6NO1x+vJgU6Uxez2667UviefQn4PsXK2EEmrlR+ftI1jVAezVJBu3dO9BNRZYaykGEFcaEgUtUKa+pnts5Ljiw1im/R
+nvW51V796TC/7g+b3bVW1DaHtcVQKuakaszLu2Fs+d5PMRO11QFndgh7Yrv2LPRgI2YkftQLznGdvCq9WdXQDOf4GeVxCjAo1qScNcraqu8aQmk8NGsc3zGI
+CAREsOpNYDc0V89gHrv8AorcK2B37ZmkFGP+0U0wvV7xaBEN9lPtHd8xKQCHz/s61uzCSHrlh22/MhTaMHPZPFvW33ME1jBuaD7rOgiCEacEfA43p5YLR8kl
+qwmbpYAAVO1AvgWCn3y1xhqf8Km7pbW/Lg/ysSaDoo3WrMUdTva9vtAGdr0jmup6n1Sp81s8FjJJ+wPLN4aoQIiHtl951WB/Xfoa1Xaxibmp4p2gK3+njVpM
+CNvtcqt3Xnqkd6q8Nj/VaeSfBQM0bAepn1caElOt3Gk9q0qt19DGd0nmNYLeoAA4BTeKWR3FqSpmQ1lC7l7Ke/kTakZxsNwYtWA1lBtnGD+xyRm+irP7WOlg
+pZo8d6wYVHoXqaNE9tSJ8mfPlLUR7m9r6nGjllqB2UhPlVFjf75wBd/bwUJZWQSLYSFXg+h5ojuPAdapPLP80eZMydpqMyb+GPOW2OOb1K98oYs7GFBfggzP
+jaa9ltmY47pOJtaT2eQmZFfd2pP3mxiFiHZtZIgNIQjVhlSN+JFkiNUikRCI9JKlZGrCTaMdgAiA7lsO7lentwWEguCXlr2NByjbnUqYKjtiAChziDGfC6Bk
+yxkRYUS0o8ND6BEpg+tr5TT1K6KFCyTyo5TtcJWHm7Pl2Uk9+43p64IOV3oS+nGyFnb7sC00qBSqZn7OXsthoSuq1F6Vn748ivI65X8cCWiFGlryWXCfKJvw
+Kis4kgRgFO6p/DqM3nQjaO+p3dSMYNwKMVd4i8ZP0GiPnY2hTEpVkdNXs6emlVLuTE1Mv56LmqQoG47MBiSylE0QHzsrPGGNmRxBrp/STMz4pvT2sI6nue1c
+hvTMGZy4oPHk/3X4+uZc1kR8wdQWKx7MngDWqbKauP4D2FeeS72sy6WKV6/KOZ1XG4JugC1fpcJ/0LNt9C+FGX/PqvqMqixg3rRGKzJNqnjvIoA3gWRKJniz
+CLuYyasQorOLyz7s81eoyrVVsqXqmFwbiG9fSKq2g/JCtBq5x10JpOJyUX7A7Sa6NQViyklnJ568cpEqu0/Sk6gMcer4RqN/PEmVg2ct+JPLC3r6OLp+QrGa
+1T/UyUk0lSkWFTv+DNkbb2y8/MfSdyj/6Y+cyatuAzNyrvuEY6k5yHr2oMkCpmEhYLJwaaSMkAFG2TXi/5NnF1q5T7x/ThRjJN9B6HjJgo4UGR4CorY21ARI
+rd+I0/I2yfOZB/2kLpb95no+3Qy1UOuVMiP6QWQYZ1wHh0IBDMHgIMF5leMiLXiPkU5ICCscMeTWiMr7ys3/hgOzXHOLnf0Co1QuORm0omnjIKEXWAdD1K8o
+VmsyjHjnNuAdI/pBQk/XBQcwAiAg6JmoibKtfDglvu+qk5PslJxxr2U3Ef9Ie92AiiWq1sKwTtpc7GVuo98xHE/WidXtTkaJNxozHq38uxMErOOv1Mu4GLfx
+hIrfqRoQR+sBzaSwwZ7y9Z3CJXICBFinUTQ3nc1zysKkpy4ijMIPIFHiKBkpMeYbfQyECLFqL3mBx1wZAtLYJ7aA1txteEJfzX3GFzgPqYjBZb9T4WvePdhv
+e0c0BFfTuqMzAacaBGlF5nUuCzwREIhQQk2s3gb48SbwjLH1t/hEgHEX6VXvZq66WT8C+y0LqfxnR1SsVd/qobSeMYSrN5MuC7vt6N4VGkgFGefuiJBJ1kNv
+AwJdEkAoZW0v8q20lPeU3CAeh78y2OPtms8L65pl87+V/tm51WBeRjsQ0qO/awYC+4j8RnP/Er4vhrC3sSs4u17AWaliaK4ZK1IIaqE6oxm7AaLsFcxAfcoH
+p4TUTKbYh6WzvLCUvF/rIy0309Lquo7M2dzYYWNeKZempPsz66hG+Idt/HqyrpzWXcWXidgpiLAMG4mASD/N10gRytuairotIcLa4OO8nKYZwCu6HPb9oWr2
+aZ1yusTHVDfdOJgXtK3pVpkeZuvmMJsuH++Ku7fxjiOcgKx0vuhupAmPbBuha/FpO0DMQbjluWCL13Oe+xbGKZT1EbU1z2T25SGJ9xzaoWNwHj5PY74b7ikN
+WQe8/FyfPdEJ2O2mCsYl80MsI8+20GhHkJM4U1m0zfHUR+Ea7AFk0EjBakCvDFqj4o8/7fNZv8Yqbb+X/rCipjstprbKXPXHjnb4T2xpYmQ3yit3UOrwpS7p
+Luy8J1qGRx8t9rdVb88RgCAnnjWqcnVsN6s+8h5FwAb5HKghm8KKHwYAk+YqAGLltY41lY+kj6fv1braz6o9f3extjKEFGvbvMfk1KovBmkems+tLmAuHs3A
+ZgzX4vHFw3HXV2EUdVdn13LSugx/Q5VP/USepKpnV9OGAoz84Ybm34oyTbqqf9AtjkgAhE3vA9InGySznocxDLKhVI8WO1z4hq2zryGEInYXjoLAfxsd2q3j
+tUTfymEFoR+ibmcFkxMgoXAK8LoBiSDixHpN7vWbmT5ZnUgpX3pv5LnJGSjd/Z9MP6Ncmcobdy6LuWSgvU69H3dnnjU9EGrlZSmWOPXj7GQT1dSInoLUARbP
+hnpYNkPtuqYpPBRCipxmbPkmtmLkwa39lX8eV+C+BuFyICgURELRc45w4gRnj1NzhNyvb52hBN06moGzfnDqBdEcoe9pEiErtbGqJeSENDlfGv5Mi8tyJ6TW
+1x/f59nNdwYmpJ85F+1H5T8XA69dzK6sfhOmPa7Cjq0KsruZoEy5ZUD7JzlUjvkBOBGKbizNlVYMFimtTkFWWYRPJGEZP8OO2sYmXzupyErF6UwvU6pzzAbF
+FzRZKMOEcEdKK89U+sHZzTeW+AkQVxg2GZLR5iyG7qWPKXUGiiNBdfRCv7DC21cG3YdyPl6nJ+mtWnErcbpwRvw2T8n9WZ0LHybVVpc5rINcqv3YP6Zly5vw
+JwYVzIglXWttyHy5AiF12P+mG/EvL8maLTAmAFgHRQTEtVdrRcgKVvy08rE8AMT5jiswCDJyxFrrjcdj1Cdp3jDK7j2t6MAE/M8A+nAdyg9nZsWv03tDflR8
+G5+tCzaPBa1GdV/nWnbHh60asyyTfhm2h1tOnEKQ3ZoXIzyOQEqCE0Oe9NhAJbUlJKB1w/sG61S1SIj4b5zSnO0sbgYxM81ilSTGixytDZbu4Ark9s5W7aQt
+tCuq8qwHQ+3aArZ4j21pbVMrYJR3KT0AmbhXlcIJr5u/Z3hXOfyy806HxRb4kk8mb2A4e3BNMio3hdxENQZ52WRtfXdUw17ax5VqeqekDjq8s7VTtFtPCrPd
+DoVm1cWdrEmTg4HKauwXEiHZ8gDIQGEdybCPwkg5IJFlpWLE9a2Gr1oEDJtPioUhtK2MBeW948aQWG3vVb9yKlbtJeMkUP2lByoXW6MU2G0Lhf7BK++7hsm2
+iWp1C5dyx1X4eqmsYV+vEqzSNL7VyWx0mnPlujwZzjjJ5/sUqx2n+qOFQM5GU63aZOduuT0QPk/egr+7SR9wNeWfCNpsnI3dNHtUxesDNTrv2+P52kAFmutp
+U1bfMD2hrfIYlHPZL5IN7KMV8mmF/Tnt4O4MnE+kgBoimQlSOozyRqTNxni2dOgzgEkwF92fbu7ryd5+4opNhUHnY+z/hC3nL9Jfz+/2TfCKQ+sh5TkBdm86
+V0cXX5v+QFQsZ48cgzLeRWV8jQ5ajyCOk/jTSLSCStSILvmg33GdKgWHQXbyiHo2IlB/dIfvUp8M9980h3gZiQv3IgMABLz9Wi1/FP3hwQQqFeZlm3nY9xnH
+JozT7LYrOokCmvo0+Y5zV39dIn6fDMCaDX311DQw5mofb9XZKWHZ/nbvanyuvtrrC15xeU6LNHvir13apsK3ZH0yXm8MhNxzkzlwI4F9zxIrM+cjwkq4kzA7
++8mZS8eK2Ue3FYFruBc7lDrjuxWPfsld2tdc3KqsRbrcL3kNI2Qwzgvlt0bhzxEg+pl7llGDdQGPyNLlC3BNfOSJ1u+qeNrhQeCk+MTH3M9ruUe7tSSn4S0X
+2y/fND9pO9jkU3rwZpWfA3UzEFGbz+/ObUDRbiOt/tECNtM+3Z65TkHkGjxg6K5aWokdjBb87vkll4fKt3qMkodpJChaW3UoYuA4uXTrZFk9BABoGqu9VZHU
+lp8/aof2sKgcfLIgP+TrM9txBOAFzIKuyGbgzw9swoiEkjFu5Ybhz81cReHGLRWIb9Huh0/X6dXpte10iP9Oz5ZhAdB2rWSN24ppjENeKzwJdwY7VRppBXPA
+NtL5Hj54VMfSVjo5TVtcISesAp+rpIOrr5jkI8XrlKl04Lya50gGvd5Y5Bs0/PDS8WbIMkFZVn+Z17ySWXAN0fcC07yJSrI7H/u+EjxrEsgKCGQDpNgGxKzQ
+VnEpz1HNZoG/eaPUdbAUN5z4E7UdWSA2A92n1n93szQFLW3YPkbLB/8bALxVrmp5ufum31UH5uV4K0GxpfY88AcjrfWSZBuPNbwFAw1U/eYsF0n1kQDipYcd
+W+BdAJjpQZRzWJBf9Rz+SbHH2losjzmP/XBaHTx4u2ug4C9kO57XfKKSrYo5t85PAMpUT2UPYR3PctYIoGADqrORPqPT+Dqpur1Nq5Alb08CQt65pGKQR6mg
+OOP1uXETVVELSeANwaL9Azuw7/jQvk+aYPqCZd1fWFl5VTJrwMBuYgGkRamKe+PnAZZfSeL0lBFfT/eV0369rOG5ewtPZtdKI1/3dXPXFy8pT4RPlK3W+Tci
+gGutE+rdlZ3cL6xSCTN9QEaencmeP7ksxXDBSk9AASR5bTE1+vcvYyZ0Y579fb5OmzW9qcCkrCfzWQBShRyds84ctike/Nn2gQTWSkA2ThFI1NjLVgqu2O+v
+5SdWkGIkkhUVWvwRQnGNvREbvJLtGUyT+cMP6KkhLJ2+Sm57LMCwPWsRbiHdtICkbs2sw2oxRvIV9Dbg+88hUHEPWW+6LhgHAdDkcu8TUgAG6OBDVg2Stg4q
+EzXSoYh4kUftkSl6pHLqK4LkhVzzfguRFnZLhIuUcCiBK6BZu2J5wzRQkVH7iQIXIeJ+hn4M+0bYjn7S1QKcVbh+uLjT+gizXTvt9W34uEqVZq/trq5w1lxo
+Gh1eLJp2VfFhmxnYMb0krI/KB2xR45dYz20CTplMM5tqWysUd1elN0MX1HWNA056NhA07R6q6pbfskMFYazqWwjAvhWbeOKFqlN9EzhlI89RiCOaCm8lOlWI
+wv4o7LM8d70/rjV0KO9Z25U1FFh1dAcZRl4BK/4YiQ/sF1I/+ZMBcJQZbMJxrk64Tof0zenHG7XTv0p/k/6ucDaa4ulgLe8e/1LHjHmN710HNYo2l+s8RHWX
+rf23MxoKe7wupI7o5LC91qDrSlVTTT11LcdMC4+hcrWm7ExT+gj9xIwn5JGpnUGmdY0u7K3EgAhiT4k7TgizBCExOm0lVAAgnt136dsY4yE44r+0DvvW9smm
+brKdjLTuJrBPLlY81uY3zkU6Z/nbowBnpLm6JlRTKFjF30act7AcYXHxCjKdpNsqUtuYky/InBApn7UtdNBlafY1vDxvYH4yqRB+X/pRtuufKKPu3zLH8X9O
+/015obdDv063WkI9SvjyJGq6KcvocMaig92mzWF/Fb4evqTVLWMSEjnacjY2HyvDPcXe+OV5qYqHYj1UZta60XSr6cX4hhahrhHL8YgMJM2u9e7RmKu/ND1J
+b25mz37ENGb45B32puurfmSQVa0m3vJZnI12MHih7bCRglwti5evh8qMsD3Em7ANfMcc2+VwEK8JT4BZKd5XlQ6WpwrkG7pPK7hPY5JZpklfwboLpFC7nKRP
+dvKBDgxUl9JxO/lOoOkfQxAWGsQmfvmwK3oUtB37alWaYOKM4ej+oTygADBxK/X45vhFE4PjjLBW+HrNY17IIRVfFEjKHk5p6beekHRuFcg61EJXKYZgSBhI
+0KctssBcnlMzK/Cu9J70/vQNrs8t9UvvYAmvOx/HB5WHGWaDlP3mmr8rRR5t3w9S4Gk6KhULXi6W5FoBRKKyvVgVupGLY13kcNVOis2fQixyb3sHy9NwFc8O
+0vijns1fr8oXkt+lKzsvIynWHDEvL/K12ucgVghEsrNLQcua/PPbGZOsydw/Rcw6m8gxQNFCWpmMa4FaT14rQBRsoMM8mHXumYKJGs7pdXqn805HvOTvqOev
+POq8GzaHDmXVYqw2VsH1maVGVOHO9D5Vai1ukJvJZolkbc0mIg841QdTUybKCWBMhtEVHBadzFqnuFRWHvQ+xfmeMKK4DhiT87JFkm/JDetMfNSw+cOqYNPt
+MfOnQ+NdD/38xdaeeZibzIgjp2zW9cyKeyHvjtJ+bzYiKZBZUv+wbGRL1eYUlB8WMIMnSO6CQ6VZ1yOFr0GNifeOkato6ZQin927tc4UT7bPK4fPeeupl2qs
+cF3poLdDmCDvXNNRi7jlzz2f9QtON2dNNVJ1WrDiXgz3ojDaZ3SONFK0SGMsQwnifVpVvbBKdC5PqzVqyq2dSn26WOeHOvY/Y5ZUDyU9KO1tcx3SjHjWd1XK
+P1lJN5yqbGyqZ86MK5JmIMkquVfDTZwY7xTJGVlzovoz74OiYJHMUsGF6dYeid0Z3cdZZf1xsFGxTWT0+3dB1XSGwV1x3Yc5P9ZsSxe7D8X7/z97fwOv63Xd
+iUFrP+/5uB+6kl4dHd179f3q6OjeK+laRzo6vpIl2dvxd2wnTuIkTuKZZCaeGTc4M57Ww4Qhpbs0tGaa0gDur4YONJRMm3YMdSGlAVrYUFNCGSCFQANNIdCB
+BgiQX8mAf0XnPfye/bnW2mvt53nPlR07udc60pUsPWvttfdeX3v91+JN3nG36v0CsovxPOrqd0MZmsT7eeLm7bFUo66YdLKzUYXVHsm2DJ9KZ9Kg65fghETh
++TTzJ36q9Pur3ZkLNLoML05/yT396PvB2J/9F6PtyPUDe+l1DPfKr0PncA4V/6PVfjOLNinEWBdzREehnByUHYrBxyJSPyqH1cZpMZ653bbmh+qoorPSUzGJ
+O+U/TO1c1oSs/G8tCeIy8PY0FVZ7ABiQ7H4gymwZffQj9KeDozRVJ/0Jy6S491U+B+HCx3+y8H5thwQvTNNyjCuTNNMvn36Vs2Iy5xGzZ+VfANtk7/8S/E34
+W3UyU5qMfoSGsu7n17Ta4wVt70Ht95JSYE1bqnRNUHe2kJsnICPedBP1Mj2umI7t7E+Ed5VRfaUSTzpCkOeI0uHwzT9L/0fRWdbnSQheOBkOD9AKvzdJ2K0P
+kvwMes/+Ofhb8C/Cv1ylvddU224gZyqsknENz3n5weIcUo76bxXA23tpTm/UdAZ1vpoQeSlIs4rQbeo76Mu/llrGinfSId95/PcX1mXgSCt7G1Mxp6UbsuPv
+H3keRbQ4JeNnTlZpNxStV7Rdd3NK4oNJNeepUhL1KG3AIluv5fFygRFI4c/GWWcHu2bDP2wjfCxlkw91Fr4vlSXGpVcV15uUjaK905IYHL8B8Y1//HMKz+NB
+32E1NzGnWjpol3x6PGxsiikCFUmdd4+iUomaJD67SXNQ9ZtAz3I8xeuoO623pRk7+uVtrZbzzanOJ5c2uHROyNm50gWPBoD5EOODCiwO/rFScT7Gd5Be70+O
+71J+K3IOE3Rpv3cSTRryqkoq+94BQr8GfOrKHVSFc+rjAXPZh2yOGABsIbn8VK70iOdqhSSQ1h2bFBUQIHrFYQ50qHk7qA1EczogdQuMKcqQjGTzXKz34ktN
+MDMuG5OcMhiteIxtSx7Ipuq20bKnTIJJZ6Da54hiHrFo5RWmlMim1e2vyrj/VLtwlN/Ci6I6wkHpsZwFkf+L0Q9cxFdnH9uXBi0UwS5MJvjpCjfj8aKWYQ1W
+a4JssOifG2stkscT8Fn4QoygQqX8fv1fyv1F7+ukZlGzbZOe8Zaxf2y+RWky3P7x/iIfq2iQT65GP3eR6jwSSnv8q42J/+CogSAIS1omQF4rnW/h6JNXTpth
+eAr2+8b/zze9nmtPkZ/OWIJU40bWh86CeBTKYP3ysrnIZR+ro/2IcY8h+iKabmcQ63Tp4a9+LJQbH+nCkAY3gu08tzF53TEDlp9217n4w8SqMuNbHflY6AMR
+Z919LMcv4UTkMZcxjF7m3sirMkD36MQosdz4hQVYA2/bnI4zpTJn7a33Jne+96HG07qEF1nXmp68Yc6d1lHVxls6Y+aBNFnmrdK1q85IrSDvoxOWxQsL2F+V
+Z4CUs2PRflzfwrk0Y3Dw65iajP32Yko/wqHKYkqTUhuTbeV5PRz2U6TYXX7F4jmPT4bK4j/He5ts1+hi9tSvMoXwiG7n0XL+pK8MxTiIe7qOKQ8+6svAKBSS
+mpXmfMXi05rOEMZ8xb+WYkdv9QFf6TtCPJ5mEqc61fCzF0LLpOzUGFzxTevLfFZqtSIKx+g5Kqdh+p00Lm2R1NmYRjPh1FhHyzAc7+uC0kn571MHTx/+MGDd
+RDCetGgwQuzfi9U/lp6/EbfyBYQyHWX5K/ReZdkkHyL2TSC52qwqqVhPUInDPkalEeGl5NEewdrfia8wZaJSAZTjnTxaIKDZMHoHActGA0s2GYH1y7G4XAz9
+azRA8Pi/t71fvlaSpS9g7CqNcWOFeXq3OUSNZJmg8ntNvOLjKYyd78drv8ziSz/i3pRv1g2Jvs34H43zZYywKGMjdCpLwnnfuLPpTpuUVsAuu7N2AB8evRI0
+0hO7TcIpH8dkrj3SjRauBixVtlixFn983wv1iyl3NGr0kjXKlcyr/Vx8FtvLSi2cV6j7wkGyCIsyeiG3q1+nelRwp7mhcHokN6nevkxNHa+4d7GW01rvE8wR
+VzQOWUi43s6G6swnYJXWeocgD8pa41LzSvNDZWit2KySrw9PV5PWGH4tIIL2Id4FA2V9Zdiat86G/6jcF5tesF21gegZDtVeXAu153ly/3vLW+2fTrrnr4EL
+tUWQ8vxjrLFKZqyi1Q1qZcCHvKaRdzXeWx0dpte6FcYmVIetjq2PZRShJ0wZv3t8chAHkK1j7nABoXQyWfXU5dykp/cIPDTZYlXAnLfxuLiEWXej/Fy+D8HB
+yIYgej3shjg8JHD8i/VA+xR/tMyt+3Pw9wU5/kLJTCf/IJbYkSRjmjxTYUvjAQulLlTqu6xZZZSRkYSZBVeRWT7LjquW1I/Z1/8z1wNZFyPjwZF8gccdHZ33
+8ehCkmTqY2mdG4BMRcoJfDxb+0n0DkdnWP61ZP/gsMzWXmVZxLe2PH8f9bSu0XBO26aU7R3UP23ONeWOWJSfKwPfDBgI8YEn2RH7ttdvb+y14MLpSw5Eur3p
+Pw47Yet7bz7A+rW21uL84CHY0J1vPH1fyKj2NIYknKUacdL5s3sneXJ2rj/I7+0nxO3Yj8mVbL2So1FM2yLf2dwg1SXFNj4B2NwFwDrrwzttOBXxqDlHXy9q
+DMJrlxaA/KoqPGNPPUpWudj7iPUCHfVe7qb/afizYz+1gqyW7tp2KJU+WB7nwS7xj+VxvJyHZB7t/nGMyrSraML9KvdsYa11Cd1f4s61w9cuJT3RvQrNOEzA
+JATpwjoaEFd6yjly5ZJuyv1MHkpVF38xVAz8I2Mv1JNxmXT62tWy3DTTmwoGT7PPcP5UtFSuXkxiGdJtWZBMult1/m4qoc2VAEXdF7Bv+j0a/5qXa9cZn1ie
+1NwQfSZXdJN1zvrSATXE9ZA6MJfvxD8cxiBeC/0J353wKZ+En4i198rJMSflqBQX0TDNvb1cLQ91wUhHZgG5evM0j2Awdg3dM2NiodMagv+3hqSaTRy1yQ8L
+wV2OmIN3ox6EPz12YzysVuwotrM5KmOFT+T7w8fubwdxzV27d8Z5ZqEWYc3rJAJYi9fG5ylR7PbkOCQWRir3pcaybwRE+M/Cl1K/C9I34E7VrSlziTVsO+V7
+kR9PynjEfQbSW1WRCOo14wONTx3/8jOrjT7wOviPLndtt3X2d9SaZ0AKTGMrd5yytAaaKYp2YS2bT7cGrGm9tcZai+u8rgUU29iv/L2oo+dPh4zKqHm6d6eE
+Xoe1eC76mmKv/23UCi+Wb2mnqqiakwN+s1wCN4dcEoQTlqpxjfXrUMlVNI0Buw4GxrK7VhtSRERHOHKjhsGVXLHvJ/73ogiZXxRxkU/CxxMK8POo2+Uvwi/l
+vlO4W0UBj0U3qWqh/WPc+i7LLRZTJ10dh35kM7+NCpdCId1xUs/baKzOdj3FqQQ6Fl6b0rFivGDBqR6Yz+ls0WD5pK6Dssq1lgOO+rELnirVh+gWJMRiUOA2
+OBl+nYqdMPrEl8PrXWoBTWT9eOqtj3ucfS7Vcf5VUluin1nc0MsUkxrlWMzAceswXEVImkMyb13XjItQU1KP7+DTA9dpnl9nrV3XsTLhX0q54OSgZlVZfPp6
+imsHUZ8NqXHhwcjhXJirG8ONCNah70WyzJWKYJA0D0qWFCe3jkJTqVKHF2fFprN2cljaBR3s04KcrCtNmKvWJlPzXxejWYjFR2dl2EUcupneQkap4hyUjyIN
+OdRRMH4Nzrs1eINuPkoSht8aW70O/OsU4gRoJLuKZcL+2hNpFvrod8DVHL4cLdP43Jhsyf5VHnAyvhuPFmlp0LD38Sev8iymkp01Ls3ijp2kEOQ1Fw6CiR3h
+QlAdHo5sqOaJQ6vX5RzADsthfh6+mHtqHZbYogKXM24mAV+PeFa47mmcu1reOHHX51qnFBLMwde4E5GbPrCdG76aWNbMfvkYwsahpAZ8UOwJNOR4vpE1zolB
+7vivppRBVGDRreJvCqMuH/NHwptChmwvM1blhMzMTKna4yYzHoPfGATfSW/rpcdgFWV6NTelkqH2mhwPRGw/OmrN5CElQeQu1947E3DhJiE9Y7W/C3+3Doas
+tACJcrM1h1vnvTe/6hNE+nuX1ZKxLD9b/dERAUt185fSzPeM4dOkF8AV4/HLTWBQ3aqkPo5Sn8DD0v2qao446fcsnRGPRRbuix0FVsYH5lO2dj7cN4gPMeBT
+mbKxTFDpwa7qYcetZlUYZfDg/eAItnzMXh6GV7mPo1mt4bxl+FT97Q3WdnKRa+hqtiC8NxaodFC8J3EgOvtvjYsogPHPeSycCcF+qK1IL8+hNGLIRqr8W7FN
+GuS/VCtu4quNcRnP9na6pKPNqv8ajvMfhFV4l8w5pS+EftZHJ6i6fbli/+B6ski5m0R5ab1TV47+WB7v5ym88Q/8e4cqPBMeKERe4yFYgPWlc/DalyI8lxbt
+1rCAdsWLoH5PUyLFtiOm+PrfHZDinw0ZtS/OWP/S5IKTZIyPC8CrI5STIIsVbrixSKPsiQgWYJ1fW19TYFke8SLEt2nXymKAFMoloC0TS5RHaa7hHYnb3whZ
+7Z+BLybPOeQTTc0lhunQObWVfI04EvyAzqSvfY51cewFvOdJbt4y/qe47PpGwbrlHrejU+V8OvR2gGSuyiSbUxvW5/LbLMlFx7R33HwD0fakqZ2o/qS8sgmX
+KGqYNaB+hbXLdD1LS6RTbocXf4jVK8kSRbkkVwO/bZjlapmhEXHBb4fvx5eM0NcNvWac+mFcQZqVmPkO6a5cGh2lNP6DYtkJn4dwK3EYKxICn8fkUdTkPirj
+q91yFarvt3nlwTJ2sVxmgHvKLHo7gB+PbODQo3xunqJb2c69rezah3/bqQsAWtN8s86LTRW2ERm4RNJe5on+aYD/6iQnbmnpZ4obxsK4JfGOUxphMfKeUeam
+REyxZBvyE0DNyIYD5GM+O68v/ETIPmr9ZHNmoHqTthRjIvzS7dTpJ2JLCpo6t0quWJuT1TJc2WV+hl2F4edp4av9Yxzc5p44QVLRhB83L5AH2QdKQ2B8cV3K
+sICgWsj4+/yv1Flmdu2G+iIdRemjFz3kz5AKbNT/MppzV3pvL9A5GP3FdAZWuQM7dpJJKdhBLvSNyy4P+fmOHqbNzmAE9AjqfAIO2zQ+KfyVeGexD3keZod6
+8DprUW3jB+GHYo7QVBhBNpbRR092Zz8xX7ou7OdCYwSlMCXsxaVw5e8D0OyoPJ9ab+Mxi/3McEH3GsEhUlIYIno6poaDrRlYQVe2P+SXo3f1w/B5+HtDlUbY
+p+wWHJS/xMA2OqRLUk8d/0B9AcLOnRwiY1yQQ1U6YSpU+u3+Kk1pjVIgu1Xq9ko51DrXNJIJSOvUvC680rw9lrqV7MGpr2+cYcoG5IjQ+3iOg4Rc+I+tcxiT
++HzIopT5PduhgcbB0Qx5LCfXb3Kvq/gP9k+Ocn5qkYr6PJdBUsuuTAxZgy1DiBbjuoGveXBsrac+v8zZOMjIgPe0n2icWfmTqT/Nz4d337FD/Ffgq6WisV2c
+Ionl8UmVFhNAbWzDxbAqfUbfMPnfOdhfHabmBG+YkxdSq4Tw54PYimPtqoA8iivWGOa6diVbF34leXhb+7LkfkdVckWgA3ZEYhucLMv4L+Sb6ZwHMi9r9DtO
+4I0y13h8V884xL8/zgsJ4VxqFZgPWdQstT6qqqHszZnc6jgBMfdXSaSxB9I5ROlRiaxb2JyHH8DGxnH55XbtpgWZ3LJcupL+i6SxrLWhKhOVT1kryrHmknLH
+qHG+T87AzTqJR0vlICZMyHF2R+IL10GZxr5M7xvHJ8dHRrivAzSHziKPI/wTbxAK2Np8c+3ghTNWFXjKxbhSsWgH70MDJlu6ZIR8lU8zVD6bKl7+4aTJ4/8i
+tH6ZgLvHBV4U/Q+DrzOCKUVtp1xejKipv0tAhT3S1aI4Y75qdnRTUw9kSwo2S7K39Cr33vjSoDyD7EJafhTHQK4luZLGJvDCxdLP+ePhFP3p8Gr+l+CvjnUt
+GW+XiwWOWSL8uPZiO4iiSSeKzI6KUjLI3J8c0N/nlh+H8e8Prh4vc9rz+GgRYNHW+grzSBIYkAnPrUyLF7DO5a/xRcEZCeIRhdx6Q/WXM8VMjv858g/GzPfP
+lCwofjnInsLRTB9hsYFfcJLO0khlEd5PC8PEKtrc5RU3CEqYotGox9qC1jba1jpGT8A6F8NR7Au8Aj8WsON/OehrQBmGueondRSo10u5WjgBwQ2jyXfKpaOC
+1U6uia4GL6YiorIxkEObVuNEDUZqWU9dSUXkq5Qa+l1CfvKIpf8r6T35r8e3PLOBd3Ck65eU+DzKYPrSEjN36dnLMBsknmPcvOGI9AkOWRqbuzc1enpwcWBR
+xvGOv8ntQF0ulo7mv6uw40U8A7uGhJUw4N4uI1VKW6PU54fW7d6Evww/l+bx4J6AOchrUlrhT4sNBJ5q+mWh78UGtKa5gfEdIlton7oceidKtMo0jKXN5ZzG
+uvS/+IeLLeAFcaLhKEGbp3HkUXxDBDpgHOnV1Gf2KHV/h4yfTvjv4jLlzGd4UbpT335Z06uAd6/B2AKs1Lsq45SDejlLM4dicRjtM056xlxFPS/xW2LqRlVm
+LVWmQ25zb/SkV6XUMrdLza8i6fUotzxMOjjAryIghazvMK06te9v6lRr66+0aB/f1/KvZGXSmNn04B3/TSjjHjwRlgm/8UlU1p06ZzwupvTWWmd5Gxlmw8Iv
+/B61B+8mnZmijfp5Mi2u7v60SjFIJRFBBhHl3FoS5goXex1STRMv+cI3OmVd6kysgSHem9PoCY5iGf+xi1WVrogrip6rFF+0dpIafhbM1eEIo/UsvAhvltlf
+P56Qe/R/R3HW8zInkI/wLFYyc8WEd9v9gwoCqsOS0vA3S39SVjc2i3XeeGd9Oku50j3mkxwG2K1jjbNNGV5fAT5jIOtz28mU6x2A9lX4JJ44QKbOoEYucQll
+6N0KrTI5OvG41GkoUpPmG3n5aThw6uMSD79zkdnclCq2elmDtPK0xDJHoT7IRyWTwtd8Ftb19WwX6Zn3odfF9Dq7Kq/NuW8qGdy2PE745IP9432UE08VtfmM
+BAmtijhi7zuDKj8KuCuw5bz1Lmx1vAvJufT5pSeWxIZTcQY+hAUO1WNUrbMmQHnjyhtjBNivc9+C8Ae2qd8DH05VWz8e83nLvITaIIOMnAjiWdIuIauoiY9P
+DpZ7aaprjKYXqCb91NPO+WUVtSkl+pXqfmJlUc61Q1GABlItenIVWF3Ca6nj2F/OfdkyNnFV2yZQiGJd5aoOVoiXHDdaIL3c8pgv5HqaBD9L8L21x1ubG+qH
+5Z6lLlk2voWtvT+D+uRunXd59Gr5Ff1x+s9Cs+HxChkgOdo34cPwQ+OEm+3c1bdU2BwtV6WUMyj8k1U506uQZC7/5GocBLwapxcRVymjF7JWX6YJAbGsZvyB
+tbU+lKDECYzj/5t/HyGdVgBcDOB8LevNEU3wMcBVYJlbY929UzA840vw9wKkWRGrXJJLsJfhVcgcpees0gI8/t6MBj/8NiWCBjA+TUkpkLd4O/GDVo4l4s1z
+vrpoUQqkg+K4hODX+pCXgFQBuAfX4elgm0PZuSmThvJlCnMy9leL4HoT4792ZqQ85nYzEnikNNJwgcb4evYgPByq4g8CjVSzvAhVRGl4aSYWdnzhU+VopTTu
+6gBRu659eO7I9R+Aae1gWtupQukkPqUfLbcFWsE9DMbeE2pBlfvBZllnYpXWbnm1fLYgIJZ1fTKx4IYOVqLnbaK3Bm+FtV0ub6Q3C71wd6ZojsYoFIMNTqJr
+vbUt4V1Cd0Ryjb78SxrltJumZcD41AkAzmCR0x1n0GOC7/AFQQbjO+yRxk18KT6exU4avrDucnQG6V/z2d2JPRLrGb+CTwPnRBRMOOZrm2riQDzr03TrDLrn
+Od3Y7WE5QT1qzR75WK2NGLiv0F/AFTYD7xjuwHuj7dvj3ByM3Byn2c21V/ehxJ0Nd8QZa33QYjkr0GzTYFtebU01ZZldIjwv23NE+GUhqMShs7H4z5Xcwtpq
+vK2h4eiBDj8VE/phlTMkQ/RicLAbgx5VomvnXKzAL5NMCzBxkvv8K7d89aGGA9+BPSpVdB73S8+3wOEtc7Ja6KcyNM/1sZhk7RzhrNiBZNCqIdhFMr1eTuS7
+swxTFScC9B+dTOjOVHOYG1mL6hPNCHSGq1HC02OleuQ10rW36XU3wVVw3jMm0FhNqddfxnG2JuzKbFvWsWfxd9ywVHmMmoPeQJREq7STdyvLYSQarPfa+bVj
+DLjxj3qInZN16C5cLffu1dhRYlXG6ubantXEnkTn08WwwVqjHRbrunuS/bLolV1N1mSZymtGZ0bzzQYf1tt6ZwsYXbO1XUQEuKsuWjoDNvhNj6QpvLcADpMt
+R+P7xz+MvGzI47yo9osmfHBr8D41SnfUh2roZn+t1JjrdO0ayggtK/hti/ScPlhKGdO+XOzWu5gfMWPhyKuS158ciIUkgN3CwyKcvmq/k7ewmObAOJMb67TE
+TXEbFrZLf9TZWF8Tr2EmF/Hk62xEkywywuxyjkaY5j5k1ngGXwtPLLNyUsovP4jcPSDwlnfrVulJ9AnGZdcy783iPXIqWWk7sRJkouU1XURn7yKX9kqw0jM4
+NimPmLzp1CWo5TLWvcjnoOq+7A09Bc/VfHEERG93IlMlNgXrrBlVoK2abwfZoIdTn4ob5X3sJFS49eNTJ0aoJlUQBIgZDVFJjIpo1hgVkV7pcapTI1VItBNJ
+bN+zT/ZiEy9NEsWxYyd6ZMTZvebe7avFq0X05/rcM7zuzM1aCAQuor1fwlXKFZXIfJ/VTXutxiOmqNsKu8LZD7tVLL9w82KVaXroUDwAkwttoxdg134RDOJp
+aZxtDWB7sAMPyRY5dGU42D84wUxsx0cX1SKbNNUSW2XvB5tfW6jfTm3yC/BKa5VTFnCCEdUyY36KdQ6P7JQhLT8WfbB9PT/mrMVuaMmPRe/Ltvkxy3NWcapo
+hYLsb2tel7fWMZfX+Yi0Gko3C+7z8PzYeNL7tGzCe3JaaeJNh5acH+sTCzfHGifSC9EEJ4j9OS0/NkGznhcv0Y0ajhO+gOiOJ0TLSaVM1LLHA85JGZdmgSqM
+lGmhfgAuC+pXIulnv7LPRfIoW9IG5tC8CFe4L1t9yUnKYVka6ehCNsSjjXHEP2M5He477nXPgiOWRTz15Zc3jSz6/iLL6cz0F7v89jxF3+Ue+YntOlofEUlV
+8hHNxO5Swyhf7/HvTatP8JmemdPpy4zmdOQ9pimdjo7Tcycz9FxH18XfcaVTZXGlOekodxIbVExIIeZOvBfJZ3RYe90RDzPzJntTpozmTZRLh9ImXCbcVqOs
+SbDW3RctiPb4VDfaxr3tFzRvskvsqeAvlSVHLEEvgxGoy09PcaHGhg5Cbew+nj0tgzGLAeIniXwQ08cYAWwDo72RMhnTnKBMhsBEa3t6fFzkWUWW0ZjHTc5o
+qOxQe9QwdFmwA09MZjVm7FmT1ZB3rTFRjMMHJ+zU+TIbs/jvZjb6qxFMFlvXJcFuTWU3ZpwIIbshcootWHMmtPxGiu6i5t7uRhZeii0gxnRlhG3Nb1iea8ix
+BamlW/XiCydHGDZVuLXx2w5cwTopUVzNJGzcaPRcFadEXeSAxjg5hj3EMU53xSXKcXKcA36wGr1dwQdYTgsZxzpOiXaALhP7HVpOZwZdGu84LeKBRsY0vkCr
+LvHF9A6XGMPpUYbxlDSNMdD5YjHGHOo5znC9SIMzAHKswfJZXKPfmdyLNt5wvYiDc9WPN3JX4e/dRItP8zwVc7h5UQdfSxtzYN9Sijlm7LYQdzgl8uCnvX/X
+Nrrj3Xsef9detjYn+ET2rLDFmPZuneLfVsMxAPNwJ/OBSJu2DDD/1ukebtaqJmAJqrHcJbJX84FY+vP9XNfxdPMuGEes9wWmf3DGmL2YzeKJ+Jhuwss0XmZK
+8TNXpQ7nPbJWujFTbq2v6Sa8TZXRASzAwg0u1ALCqtT6pmL1hVvbaPlPY+8CO4wfdxYAFmCD3oXw315NyP9R5Pvb9Z06fsTZtRsC6HDk01jrXBrWOsQHGfK9
+nfi97CGUz+bvQXhxqR+11p+BiUlzlz+HvjdGQNdwJaDwwWFsQUK/GVdKPlq/GWvv2KuN9t3xr+Tb1lvLP75Nvk2zs+z72yl3mWv7cOVcnIUamwR7y6nVHKXP
+a7qA6D6g1/Ct8hyPuEJWKbaUOLGx5cRgS5FYZcemAYSlyNubxFGV8UG24CMXx3Vqwj4qxddkHzlxCePhvPVEPnkf2C++J1vojF+Jp6hSulp8K3zMw+uu8eWY
+NxLH3xy9gif5N7HfxL5s15Z/OkW8ZTtht3x/QSOaNMVG3MHxHybJDakt/joV0WeYUm61jzfN1esLO0RWe7XislAUfQW8QgMsoDOuLDYh6KrC2ELrvJp9/FDX
+31RtHfLTMXhr164WavG7mX95UafEnNYD8EhEBB3EF9vwDn4jy/AM7BksXNQj4Kz31kCqxq57tZW+F+tFH4UVkVd+71yme76XmQ8vVov81O3DVgVOwfp1eL5n
+urXSGXVroFPet1tyqcwoegqIWJpLHS64w/RcS2fUYenUiRpSIFa9AdfQxAqTEd1hNKWsm0C3VCK3mmuwmL6JEAWNjbVvVSpsF55yHfAzADoX6FwiyqkA+Qza
+/Z2mmeM/hWbVMQrltHCFNFc6FwptWnf8rngnFxIXQu1Q0AQW7T52dNJBoNI/bcwH7BZeLqacc+JElIVSV9NKhJUQNII55foJ64rxblddgaoGd4m+GJx11rqi
+MQaHKwSJ/cg5blargtRc/mM0krv4RttxWaXHMr3RQ2r0OoD3jtMb75dUqzJJtN5saGijKyUQL+falv1Me3mAfCFaGSfwMIQ1G0tq4qretLQaruXhCjnfbU14
+ieWRNGZXnUkyG/kMLLt+vRm5FGsv15q169lFMs22OtfZTFjrnnRZVA9MzMWEO4kn7K9fhofofdkuHrWzwbVck0uysNym2oRvegSeUCKTo+U2ueiDj47bujLs
+SqnswG1cfWN6QrKl+PvYjg6+taIDKN+P70jP9KIMTITbz0qL3bFKbJucgyX3RreRh8vFZZJ5smt2j3JxK1pSc4eZZ7jdeL0StfB1u1avLSKYYxp6X0OWhtkj
+XoVAZJpt0UBygWv50vmB8iDdsYQFWk3esWb1TcJsLd+rykCN7YOnWqbUxT9QhO+ttyjEZ2E+icvzacdx+YEcnXvrokXD8Tn2pnEsrZ7yg25ETWjUQ0599hon
+PMBjkoOJqCt+n8ddSuSF466c7U3W8qBdTz/6ioS1+EuOwIaYCxt8zOUcRozu8dFyL+20PbXG2lFY3o5fB+PzJg+F95zJ2U+T/GJD+b39dFje9iY2uj6DIA9n
+M6fGegM8j3M/7OPzEjqw5W/FczJ+0Hp0SKz3xqVPIb5wBucoHpOj/VTNkc/F+N8XBk+zrXRrv4D0wfo9nL2p36tv9NI31/m71od12/JhfJZLBR86y+PHj7k0
+abJkJGINBLtfj7Nv5buFaMlZokAtDGM82RuzRDo5Gy4/oxfO9tqFwR1pH86bk9mPIGqyYCkng04B/xV5qstHvNSMTDwMYcWlmg4dVxvKvsuBbRco52QS99tt
+vVz+doRRrW39tnNYNWSep3IyidKi1QqR2pCA3OH2c5XgI/i9KgSf6li1fEymptWLldV5MR3jMr0Y7uQ1bpCPaU4Gz8fg84DOhAF0FBbEp3gUa7ztmEnHKZPY
+bjqGlFUDOrdwpyhRMqRvOtiBp8or+V6ZqHsch7zm3vgLsGuw1gU241zh/A7vrB3s2loD1ldex+9eg6fi/Tk4Wu7t5+GPceBaVT7j2n388qmL5dFBQXrvrRv8
+GqxNcqg8X+a6QGcceYuU//rgQlcwahubeiZKb5rfs8lb5g2Vr/4bJmNUfLzkbBff05X3yxfld8ulxlXzXskYSc+UjDLN7YU69faElnee5pwmX5cf1QW87RYZ
+xlL0Ct6TF7UK20N1fcvjJXvxLv2p4hoHopg9k7Lx4yth1c3xTub86P6qrLBkMus60QoHh5eG7syDIWKImOUylKK0/UsSHHzaD2OtsTGUMz4yOCplb8BGk45i
+LBd8lIfhscZLSW44uo0DnOZvo+torfcDnMICPPF/4n18vPVr+RqiWEj0RhZSLmReivFxEVtIPmMt0ZOChHZJAIdFlKO3KqKSU1yEMIJ8f3xnflb5Pg/cGJUY
+tTEyKWhzaTvIOc51Uc/WaI1R3RODtoWzSHIOR2zW2iq/ij0aCv3kFw6en9rwx/WhGkWI/lI6stiLxn7vQ3C9OVHHku8bPke9X+z/LpC/imKkg+KxHiQ9X+qK
+s9/qArQO3ABrSO1vq9tqwjGqPjG4ECcu4FJce4kN345xYWyb1bz7jv/+MnoU/MU3/ofluZc/9mJZhW8gWdE4Mn2oyEd6i035IvS+277s1u9EP0aOQ8Puc29a
+jD3L93LYWT64xb73RPvFbfKewx3zwbMgwDXyw75WWr05iXEseZEcW96h50jp+Re2mG96rX5LiInDF1E4LD9C7jL+ClIr+7v9+DeAQoXQV3x43CG0rmD/vfvi
+OF4P5bmxeWzMOj3SuL/ckpqnOXWDt2dg1+AsTUnG+lKLZBFiz6n6INRPBfULWOh9Au5LduApVE+qlZBAPhRtFefgm/rYSuMS3A8P1TM93lityijWbTIK4Rov
+aFERrtfMsdHTuF5Trx/K9Vtix6YFK3bBdWNLpcJiVq2SV4qUFlptUj7/cl1oxqHpVc4tBk1mwLD8q1S3taBSNsRB6GAA9Op/ndZFuFJihOfamtCpGqxe7dVC
+qi+nNaBE3gLeTN1rXvvZKawa2NrbWknExUrN9pp5NZJiXfvAjjnDZZEaPRWXpZ97hsnqN9np8HKl2ZEWF6VzUTFRrQziH44fQ7iE3l9rtLoK74cnTU+9F4Z0
+PI7zcIft8OeDoEmPt8OL13Hqs2dttU3W57+cgTdgvWNd92ypbRz/7ziXgfffe7q+aoo9+HI4varcrEZucgWIczYlHc4g8DH+xot9+SLXkRW4SHjB7/YntEcU
+QQtMM5WrBtcJGNHhDUEHGvaQrHa4rNQeggpbtZ2glTmS+gpibiovuNfdi3A81fNPZYi1/1P5avsAYr4uiXzFKtg35vbhk3nUWvKdulms8h55dG/x2cNv6qGX
+od6nr3vqoq06nThz8/na69wJsY/fBHep09Mc9nBvP8LgfYU/qRYh7fmcnnkKr237vFOvbrfSRw8zvOzye4zQED9wdz31NNlPtdez81fH++zhdV4m53mv3ZV+
+z715J4j6BGcwoVxJIz7E7LQuU/rMqbqMtZzTZdr2nkN8VZuN88uHwX95Xey3Ns9ulw4sfcstdWTj5jvJjvZIey7s8VR/NkV4qFWbdtO0nm2tfRL5mujf1rGY
+xtm+BtB6ukl2vO0j8/q8/m7TFnRCfmrPN8TmxcIj7v2W9f5E/zfFfpJWcBpzvZ5wIn+4N1zWLbP6w+lcorK4Pptazzi639hG8d5xI/7l/Zv1j1M1e9tKrnMC
+Oj3lNHul95b7GHwKfvSd6C+nrm2q1dyMlXZ7zrW2S+o9l3Zrk/5zmi8ptqLTVyH3pKPnrNoL7FncwBa36VE3O9KbFeuxLnbMWlQ7ewW9a4RovNvTTnUCXD/i
+k/vcaTHVZYL2uTOnB92sWGZONIP70ml7KsfttNfXzN2M/b+m4nbSDUyO260Ui6q9wXoW31vr1ooD1/YLk2KUjOtntoph+2cyxSD+Xc663HXkpPY1045VaXEG
+2mnivc50v6MXs89miLckg/4p1zijvgaPfdWeYH0vo8OP0idMyAXxfmHI/+n1DJvwLCZZE/qIYeaiPyH3E0Mx73RPMTXmbdqLwbQfYVRZ9n0IIeY9b8+xc3gP
+ft7qtD5kZJ2t38B2ZV5PMvX0SO3JoOcwGF0XXBbtCosuWV+qmdal9KqaaWBw56omvtxB+vPxEMM9Dy/X2DILj5TV8WcEFliegU9QpWiWUUyZBFaG9ZUkeqoh
+FHnh3bRm8FKCSZEX0lhL4WWX6Mwn6rtG09Fqmh0cmIkMNQpTZuqScM9v0VkbajepGUJrAxxFeo1OUmR4sbmviNtuF6YZMpUcfIVfdFUVTqd9e6k/0wbeoJ/2
+B1kHJ9G3b3txHHV7G015Om7C18H9jnRfp+fXi71RZvo7btLjwe1SpJyA3KdnnLvxvk169cy2426WJfcK1307nq34R+D74IfvvpfPOW2529Caa2udPj+d3jrT
+eWHjZvC67hygy6I+GK13Oj1q/50NrLibZcflDj1MPywK/mo34mBWGc8YLfmKtj4oL6bWexsyqdYKNVrxnfZJGdM43u/DXMFfexrEJ7wQ4GFco0lDNZv6/Z1M
+o+nAckhpoEYsxhUaFe5lPaKwQ3BBT8LN3HEx+BKHcdzOCR68M27aHYW28Wcjbeu989Y7a71r+RgXbkmTUs+5oniafRG9oy6eNXchtBHmktLbbnqKPCV1FRG3
+M1XznfENRa2nVVqxLu5Q7zaiUQxGQCaZwzZM9IKCdbk93YFkSTgQG5FgCfNmJPRA74r4lxem8Jd7khSaSkHHpLEuTo0i/4yJeWlOlxJ60sRmJc1ZU0845uGx
+mv3QZ1z1+HDWz2VEumxCv5ND3JUhvjYuD4mGxM1U1uPvhvFP1gZxj7GUyy1VEN4YGJ2b3c4qC0qQNVhxmWavy4pAO/dAuT27C8qq3wzl1NXVqx1RMiP3k3f3
+dhbgW/BB+PiM/hxdT4bZMm+R6DpP7ngdSkMPnH8gu3uB1Wc8gasz5nX40Le7eWL38t6zbh9t/5uLcD31dqW9h46X9b1EOuWpBQg75yPqJzxMB2fqjBDdRnm7
+B3ElmdDxoLZu2KbEbe2AYMOvNdCF11YItHND+BPyHWk/iTc27SghsaU1luAs6u0lxN4QqTb/kPVTMNLZGEI5Pt+RBdsJvBe5N8SzU90hVmKTCHzT5U4RAs3c
+L+LWrI4Rq17jCEXTSAzsADQ9JA4nu0is5GYSp0zXih0lcl09ND0lXpjZVWKlNJcQybcdJorspXN/EHNkG3R5WE02ezh1+lHnTC1I34cneOeH1b6JNEv8cQbp
+miHnqg6bt3i9CQeR+kVexUipBTtVp9akXsku5oLGDxomvwXpO/Ck0HlgQR6zSAOC+mDFuxCMPySeub/i/REii30bNSQon266EnC8P40YWCeBvYYAbyjgKyHW
+VICt4YDWc85F3tMlTgDw0aq7KHwfZLDFcPhPSUh8I29fAuSvPdlChsuPZNQYRkTnS/QqSJ/Ra7H6NZc2FcOoiH0qcQm4b5GYZfC+j71+9filg+DfkyTAU7OW
+yoGC+Ru5z4hdtBMnAvvxOVPQ/SResCxeaFD+htFEYP+z4iRwyD/e7wGgYHZfmIGhD708ohQEKL07Az9S9gqafvyxpV5UxtN/7K7x9JjHGbB6wvQkst6EJRTf
+o2LrjzfA1iMOFYg9ZkpA2TOMP8ba3+ii7bXzEnwAK5+Y7PHhc7Mo698JUUiuvWDLZWsd4fZg12BteiKJSxy8Zd0jjLWeIOx3EL0Rlc3qKtQFb1OlYE+9NcFL
+tgbSqpHDl4Dtae0wPk24gnBPuc16X25yRNv04smV4TJo7kwjhVEv1HO3C8/iSQdminp++5Nok0kHCvlKe9TLz7fTDmZxEBWxykLpMK7L4L6u/vjwZtMFDif3
+rKdCzkDcxp4WaRckx2ake4R2b2tsxlpJ4Lu6DcB6PjzT7/qwEps/CBeFdIBo7sdBG49x7ae3gqgKUOoGwTpC4HuRaxtAo8ZbQxBKrDuE0iGC0otvMV16basI
+TpV0i9A7RiT/e3DtSTkWovjSuWHNAxIhKFmQfgjL+G3he8WHctYar73XxG9cI10SWhdtiD2J0gdxwwTJ397J30Sn1yixjG0iGRzH8DeZ+n498SbD6AmvMZS2
+9BJDOIE5fROMEE/VhgyYHurKgGnsEBolmuB0cn99I0RPKGKKt2HtBLKo0YK8xpKznBvPmfmRnO3FcY1MtpteEtdpN4k2pkrPUGtyYqUlbze9JZ6SukuIFHjE
+xntNaPEa7jdxo9dxQo7UrBynseYTiPiu2H/iUO9AIURooMZnpBmFkBfgd6V2QBHuSm2Gws/Iuj0Yl0jPizrBMee3zvG+voZp/NViDu7qPjQt+Xml30W/9lqr
+T296YJAaBMxD7oXxaJ0vxvph9Gt9NA6aHhkNbo/2sCiRudbHYlZtz0S9Pu5toWKmrqC+Gi9ovSUmapg1PuR+E3JNNe47UU6r3ntiqna5z1LbjwIxJdU8NbU1
+U70pZtY69TGXbb+KPv6HcTndu2J+Da/OKO9nQe7eUOp/dlIHnoT/D+CekBMIT8TOGreuwzZKH36b5wqZ4t3QZgvB2pRHXxOdFk9aKEAzS6PU4pAvcmOdP7zG
+ih1/vskt+4LtyN7YHiaSIgWwiOf0TpQ/e+oN4JklNtQhoTlIe40c4h5ZW7/pLfnmGrA8R1+BzUCSvlnUjKvfzY4S/jCbH/KQXIsj71z1zAziHrtk4mZuM3pP
+w7MTFEvjBJHkOo9dEqjWrgP8DD2c5woJlOjpiUrS2HnffVQ+m9T1Il8PC5A+H7Ucmq0xxjUV0VYqeyglXvrs7LqcAaq8LNuh/I/9mOeQad4MnS5fhfdMUe8m
+PkJeo4igN21B4dDW5AaeRRP39dEoHUE/COoU7wRTmpaTHhkl92UR/N3ne11+Dtu7Sbr7nEFmgLX1odcU0xxzKC/2q4lutFSd87WMyFOlUH95phw0vdPVZVyf
+FV+XfnsL6ciroTZRnZreSrGZlE4EiSNeQhTbs4eqPUMtDoJNSwUh0arRgTC43+pOwITnk7atNCIYhY2rPNLBck17ARC+Te0QbyZQrNFQ33OKpAfAHQLwt8e9
+fEa0IZx5bkkGdFJsJUTWgGd1XAoZjiIhDdA/LiGXIzgsJCuh9Jt5IA/Ds4RGB5SfKSHsPSbVIu2B6d8cfzxPNKCZANVnjTdYjp2vSo4Otaj07xPpP5mQQUdg
+ZU42AsZn/qZG3wjcKnj3rJfzOXgwSy3zOQfcHnbLiRh2YwsbHLTO/NaHAZD+qND07L364r+6giunPtxO7FZcLUoLIs+GhHmyjkLDmZ/5mOBn4k8jX7PxNvOX
+uY5+WvMN6Ye5f9h6iIXAFpHD6AnIkthmvpPgPeVv+uo9Ufsdeyo/CzcVGj0b3rPimfDaFjOuxTYJkBhPx/if5dgmWgDLdHSNbbY59htpfm9tZsVjIDewOW6X
+0Nyc2+Rep69vt/XbhV69xBHTUS+uQ67USN7V6u3KR7MuhEQYN6LSCU+ZEBdnXU5yx6/Hz1Fbo8Qr6IPMysTPEn++8rlNvj1qFTl+yAnlJdoQFj+Uia2MmuXI
+7Zbu49nzE+nGqTPLaerWK9QpOFuyq9cau7qkRy9b1Hr2xHVxW/qkYkuFr+d3k9aEIgK7aC5UtV/Pdu1nBV3n+GVgGDPJbppCdMpmvuc8NlO4aD2MWN9WVl4l
+O/ls106aZicEzDYzkPWm1/2e7XfXlct+d76wjd+daS6Irn2o0bYm2+PwpaChiNKN4G16Vq/kCQXE+66iiZky4n2PXybud5ZKRMKK32cWmn2feODOADXSnn6d
+zpl7qJ1UoBNpvPAyoNNqxLYbfSF44pK4kCeOBNbojkxou9EfqjeuUSvj3xk5qksSwQvi3X52yiPnMpU98vQ7VbUkHq5M6hd7VxqG8tj1yhWOBVWTeN8V9c2E
+Zy7unOCZox3kmieR13xzAiSt3pdP/pcr8M/WV3ms8cHoVH/qhznqidmEfWXfvC74P+WLzANyxAdyCS0OTcyt+OaEWdEXcsxDcJln7hM83voErSiQX+Aaz8B4
+6dvjvX5G8wtkCtk3cIJ3UInMiG/fe46bRMHe8+y1m7DYmGfJZt/s22xRSoLddo3lhkoYn9ElPFUphuFzQq+BcvZtS+AMxt+Nay4EFuRuXlNuJ7XWrtprxzDa
+zJ5eF+1p5XV5zOypayxqvlwmvNXmeZuhv8z9CO19Mh7QZQKSLuDUWeuDpjJ27cTZi0dpyMtyhQBc4wcMrGEknWDd1jo7Ss6WmSgDquehURP5ZAFulw8WzHb8
+IJ29eJlHTeIHMRgafbegh/KXt8h3xRcXun7hxWUBiIbhjy6JlK2RPcaZlNcWQiUXuxAxpzoXY4VPkm+Wl5bmm8jjYF8OypV9Oj2zkJkm+cWjTgukVNrClgSc
+rgLCNS22CqgWtNA6IPZyQalJcEq8snaoisX0YsYDz3B8MsVfaB5ASzUMCFhAnANATphJAY4jC3OOzAe6OhVZEGqD55GFAXqmSUOBSBPlvi6GE0GQzUEHlDeD
+ZQZ7Qn60zuBSE2dDB0wzy/vtp7mBCUt4chx/e0zxzBlG6Ncu1pHgZF38jS0oVpKvk3IXKhECGaS0ag0cI8bs60PsHe81eDNPEVxolGe/6TEJTD3scV4lFDKf
+b/dgmhRG9zgPo0tzo5NhQrs7BBgBs5/7KP/KxS7u6+ATz4j5oHypn/co9/O0j0v7WWvxWyIkfox+UvT38Ne3WYlx+XLx+owtH2ZIT/r9azE+Fb7flhQTKuGr
+DRlcs0NyQtXfuymfRHXyXJUasMlzSHh8VjjyGYQZ3ch3SBDN6D1UbGbxISiOMk+8P4467Rh5EXHSyvhBtwbr0vBs3/gS5Lxnf4LN/safP4zfjX5F+XjQzcSx
+sPy74oxE9ePVx6A0uOV3Lp+fap+fFaTCG6Aw4dQqVNlQG+cc7Iq2Gs/9xhRba30jUA02Gy2qWmyv2Oxxi3YEm/28sE7JajdHobHcVrDd8WCkWeOpHxGqvj9M
+JzbCf0MP/VRp73V/lyJ+DyPfBOobbW+F+QamZswaz99C0N7wKTxuMX1qxqzx+jEC47Xxg2nW+GDTB+fNGle+Cev83QILzh/GvtPD7fzrtOS9Ewq3jcs2CGgb
+ZLiOviztL/UonX8dv3h9oM5sFWZ2ZRNGL+A5mpqhXAPeerOEynJP9mYrNU98WV9oloIcB3T2NfVmGTXRm63EXDsgENMbf8t8AjKH+k6NiI+WBxWy5JNbUPBK
+PkXZEWlY/SSn5CG+p0jv5GCuV8RBonHasl+7VIrZwYaOVscOYdmnvgcN5T1QIkZRl8hBGd5L5OKNtaJkFsl5kvwwTqH9Lvmk8fFTbBbwvjAL+E762+QsVTiY
+W7tRLAgIFrSJsaewADpnOPYWe0ae1X/ESWB/jFIq3dPTMaz5/Drn+iKeCMwpMJ+sfN1AxXyVM84RX5RGtaotjdYvI5QKzouS4nOBqw6V5vIu7yRrk+Fdvpob
+YnJE/yiNz40VTJn1vWQU0+xc69warPfc9JwB92PI7vL5vIZ8vJnPGzS9942uj1S2mL6vu3qwXGHWkQcTPx25T/5Lq/dNXMQWkTHTk8doXwULANZb15gAwQgQ
+nfxA0GZPB12m0NI1cyapqGamnOt844TFC2fI1HMTXOqg/VpfGs03Pqz3M0P2/LjuPN/YxzPcziZu5xtznyR8qDok42+mZxIfYr8BzST2a/wUZ7NzMwNbR1fY
+ziROu+z518uitxmNR+FJnYqY00tkjGX5vEiorXPOe3R/nn9cPRVzgncpZ/GaD9HvFG9K83gGj846/Vw95xQPlm03mn085ekEKp6l7JKwkZOzw2TwcPXbJryb
+wUv3xyIa+e5skR4gCTGtdi/MdNumH+hc4qLNkVzTR+ZJIRtYVsTygFlYKAWYF+FojmUR3kYgcYkzNacup2nCf0xyNPRNa4nQGImp6mqkDE34WrLVOT/j0u94
+XiPek8fFW0I+TLyB+v1yPwiBIX0/6h5c3ZDfSWot8aKpId5r3y9Z7YK3+M1yKNhz+p0lf7OMLyr4rRK9UwYdZvkb5TW1LlioQ/BCJfAg12g93a/RSgvW6rPk
+6qyFUFexk+5l+w7a1DeIRcbt92Iso719tnUMSkHxAGot1NP9Wqic75LqoJTq4UF5o3x64o0Sr6Z5mxRKbundugIPl9UgXVLOUB04XKYLj6Scd7bqkkgizxYm
+9QK78EC4W8vVyWpM7JcyXuPXsVVpuCfr8BeGQ9stHQFO0n/dAtGMXbuKRXMhLnMdLNouQlezr7ZgtPLxNW4EhUkoeLSMH0uvpogQLkBCvMdIq3xawqQ9kOsb
+Eo6Dy6SC0tB3vSXf5bi0Pe7hiN/FCgR9O95v+nGGTdsTZsfru0k1Cd5ZL1KT8Wl5tsoEVQ2gVvecPpcSytjH4rRvwAtzaNdy0y4H1vc4yN5U4GELEg8H8ELt
+mBB50FpUtLwNULlZAOpUsbbt7lPgjgHMYXvv9lPHBHEnmhuXqtCdJnf67ceUO92A+AiFsCTjVMFiHAmejFWySoyaDOQrJDmWj55mBcvHp1W/DCfwxiQHc8B8
+VRTdZz+ZSwXPF/f5sSglScfKYAC8K40RY+RFTN8q3roOpq/Raw2oD59ujOujKo7i+krNv4brWwiUObCPXStrtRtF8XdPwS39tV7Qs2IhMKatXmVsz/eqPecg
+vNBgKeLwklUfLAHiLVAdc0aZjf/rAfFCoIGxePFA+Cks3v0prsY2WAPjBRoEj+eT6dTxeHscdV2I9AF5hBbW7MaTteCa7zECQvqtB8obP89weekGTeLy4t09
+pHT6wLxMDWPzELkpbF7txvYC1WgzwXlRlhI+L4p07bCHLePzaEe4G2FOyvsVbs4D0AsimoHRYxzPwOjthu4mSXqF15kgvciWjNNzhZUuTm8X9olvz4B6ycP3
+1ce3GKynxQgIzBW+YGMd4Pjf+xbPtVtQNWn1AqCrahBvbTYoZ8BAXVvkm/v4vhllUmi9AWQmKKFAqFD9RDqTRWXdArYS8xmzlWxR8z0tPkAfJPFB/iSKDwQc
+mB4faEAw9G3itcqYrHjvFF99GgwWZN/gwTxVdxoeLMYJL+i05wDCMgfWKxz0MGFRr19v9fpSOGEVF+b09XFd/pSmy2UKBRvmOgvA+ZCqOw/7+rsBh4VzIuDD
+uN6WMFdV7x329Z50U0XcFVV4Eu5qM1+vrlb09dAFaX29lvYV2C8SpjmauPKYpCE0U6ImKvn0Whb+KAIOBeR5TxdEF++12tggnZ7xXkwpt3ivXYRgIl6egEwh
+Xl5qV1rdvB7m6364Jnh6Gugre1+oSp1oKBn3tRfQvROaUEy4UnqCShSwX1kvSB6fIjqO/1J0RIv/ynpC9/o6FAkGTNUZAgYM640pz08GWAmeX/qdqkIEHJjk
++71xTu9P53MWFkx3/1QsGNaDUx6gtosaHkzWiC0ejPqADSCsenDZh7MYFEb9oUclf6gFcBUN6ohPZBUM1x6slJvbA3ERIvjaFuzZVAzxvvOcIhEE1T9DhcnO
+EZJxUPX83Jqwow0+SbelrrGmDRYqn5vr2slprI1D9sZywFJjDx6V7YEIWir62TUWweq4pSXBLR2NSyDQpcGdFvQSpNqaWqOzl9+mSqGjDGGK3YAwislZAmMi
+OKZHGxwT/jSBMuUP16Lj+GH0vd1c35X6pIofjF1Sm2/GZ5LyUYaRekrGMYnfRjCp+v1axFwIbJHvH8CNaRo9uFSmJSCmIkmMb9ol9Rx1chzhYBUzkw0XtMz5
+5IByYy07CASgFO8+4qq+J9Kaq6iZxBPH4VyZEkZ0tUsm3x6jKPXbLawLUajILkSiFIjssJqKp3KH+IN9MylJBu8qG9oivJjoHGwTucWpeeL6ehCvQlBEeVWa
+KZe9ILMnX5oxd5LxIsycbC9N/eXR5ZHv5sS91+5+6RtXv4/xXuM7DcUCjf9briTAV3kgzhMsfYoyIuSLvLc+yufHHRemj49U4Jdx6xb7lRkXsV9PiTPjesQ4
+YIjS5HoFY8Co3FaS3PikRCa9lIrH8hureeuAROLDSJMvP5nv20G7ytk5UTy3MJRSIznMa17W3FKWHM1TDEm98zLNXRNkRoBn2clgUmqwZ7keRj1j2vnCGLS8
+EgGD9pSQB9OJKOeKlPcwYsRPijZhBSBREQFpecdQJU5jG1pMWrQPN3Q6CjANUSsVOoKdELBp9RzfRlNbtdMrA9SyNIXCndbYChi16yJGrfiJCKYWPEWCVKv+
+YsUO7THsUP5ShQ+N30F1+43P+WDyORsMUZJKAyMKOh2Xc4+/abFE1yQsUf4mgxMNARWBEEXhkxj/81QH/4M+KkGA4sdxZXhiGOOAsh9EcUDpyxIUKHyVooHG
+zyY4EMEDVU+oYmfil1VIULChFBU0fp3WdlBcUOsJcWqT0KCRqogOiivDWPcdNlOwekMt1T5EaCSqoYQyXYx5t8RfqCc/6XAGFCrCpGAhbyskhmCFrsCSVVUc
+w4eYb3m3cCHj1vMRQ6MIpiFDBDO0jPpblw4DDWEZEeCQtw1waBfhV/JMxRDTIOlI+93IIkXyURjAZ9fFIxjM72CLDNgAuzz5SrLtzcrl9ZKlZuNO8Uw5h8zw
+THmxEqQpFgdTVJOzBdZEMU2Sda9oIEZGgjUVagTZFOk1mCNsdVsqXdhR2imGPApXtIUe5Xkyjs1Or5a3pa5aXsKBZ5YXL/i0mt7IBjmvVU/dVtavltE2Umjr
+aT1lY/x9Ku3GGKzrIgar2P8Kw0JmG5tubGMfEibyHpPTWe1sAv1wQ5tNLcFhoRMp4LCyVu9AsUYqXrC5ZxwvdQX5mhSPhSyvAMlKxpehsrL9TbAsgpeKXVhv
+wW0RLzVlqZD0dNQUMVYYM3WlYKbQPmcXLyGnoMHZRaxvRqMgPYDgUzEVVhBU8S4Ks6F3AjaHYaj4GRk8Px/N2dgNGgtn/9iH8nQk9K0ko/Qxisu6LiM32PcQ
+NCv7ctbijwLHTkX73fl2Dz410pAQVNUBO+OY7+w30N3iMKqwGISk4h/cavy59nstnAq7iuyzAn58F/UkxniqKQ8xUxJQVY1zuMPkcrX6v9Ne4eCVe2YxLYYb
+X4T3inzLmMVHobwrj+nZ1lesz3i2Sf0iemdANYsLXmP0cFtjJLxde0tqfwroh75zPdS8cxHQT/ocfttKLhCr99lv632KB8FrfXCljxGwSJeDl6fWM8rv2l6o
+YBykGpd9ocZFfL8WixV57iLXGGu1LfI7tVKY2GB86lveaqKmBYN8hHoWpQpxmFF/eOe89Ycz3539jIrDQXkrXE29FTLptz5UW15Ic0axnvvGnHrufDbFWu7B
+y5Xc/PznupcQUxwsj2NGOvw1I5aM9f4MhvznWtFIsUtbDK8TsFuhGf5ylf7a4l7AOW+8c35gOCYZ67JFcEaP1KzkdZlUyUoyMuSlk+GOKIanqdBb6GtCWoIT
+JK/oFCiyzeSWLIlGpSBGqOgYZkQBjnBaKaPRo1V1i0ARY0h0hA6vf9lDuYecY9c4aFElp56wIeNKFHDJFZWPEVnyOrwFH5jN0RTK5NRRcc3AmUyCTXabs1I8
+fpVfSU81OyniTmTwyTbDYdwYZyfqSAz1tjSIjHVzaRpMBrs6TIc91uowjNLAesxYjNQQ8RpbxN94ZOxGxFejITeKeE2L3VABHLSO4xF4TtVtIpKjkBSgHCKe
+g9b4PQYv9PVcD9nREm/0HQV4bBPZjh6AoIeuakiPIl4J6tHFe2yzmvEn4LZMt4P8wNRb6Ecf/8H1YPZ8jmWtMwMJUrWM6WBBeoCQKwpP+S30dfiYzN3eeZEh
+lefZ0JCZ+JBddq6uZ+k2vM9BipSd7kJFeniRaR8r1xhjHwsq6oNhP7hOEnysFgXCtBHCgYhgEK6Hni4R2x5X5hSxYREdy8oTUXE31ztT/pWItuC0Wv8qLwd2
+GL2nci07jvYkzaNhH8rpVdAXCkRhp1n3LTie5mMKiYG5sb7HDUMztDp4pevgpXCcGljGHOxJ1rs3pvSuQpHBNNTV1fw812u35+lagtqoPqdRcRsaeGNav37g
+rvRr5XOWZvUdpsV5P7JOvT1PpxpxF3UYioJF2SYzgDb1OTvjgNaNIlF8To4zqjr9sY5WZ9FzXClYUblnDMl2o+M7nicvbW89T1TRroNKthudr3ufIroEO4BC
+MbGEMdlu9OELcy1Bm5UTGNBMghHyO1n/9bxQVdQi/KSPQdkR9OG0J9rjQICjTGBSLip66fZsb7RFfajeKEV69EEqAHD/DJ35sXdGawq8z4eszMWtXFB06FzP
+VN35PoSli2OhvukTiharuITGQ6U+KsG2TNu8j9zN7jVIkZl2z82xfBgvsiC4h8ck5MOKYyCMi87ZkJw0jIWIf25rp3Lf8YOj2K8m1uwtb8RvHxydmIqOCMnd
+cRdiXYfxCSDh0uORD7uwBpozjW+kD9YJDhkroZIsqAlGsAAnKEGCQ9hXqoW7xDDqoaFZ6joZVVwjVms/xnzehET3lAp2TlmoYacsnFreCzHWKMV+SKQKNyS+
+EgPxd824DwMs2V7mQtTzU4d/4P5LUebHE1KPI0ZSjXagfhzkz6pqbSwA9YMdVw7eO2ELbCzYdnj/69pvT61dqeDGEjAWVXETGYztF1ExN8lnXcyY1Wn6pRqa
+UHVWobhwfH5gmeRXa6OZuG8UIR/SKuksY+t9bIqS66Tp+XLlHRXXxD6Bq2xuSNoo1sdaqoxomWz8czOn6FmhWvZGuUKr8jtSN8vdXFw6W2oYtlj97DNNdY9I
+B9XSIjJNOS2vv6BaqKmqlQnx+lp8MNp6n7Syuq7Rqt+B92eqISYJyJX054hraergpGXHKD+tzzpvfa3i4JLgvzwu1gp/YjVJuUcVqgYWeWB1wYjoGbS1wbhA
+uKeXO6te6LW0iLjvlNOymtrtRiffpndH0gxiFSk74qwDfblPrJ5U1ktd6ipNiVyu89hmNZ1pPo10uw6KwMWW9R7HxLzCE5d5IprZ/rykVXr2SDc1n5SDpuoT
+l37CDql/jC96LwD0Ke9JLe49z2w1l6hpdi/Qj1UMM+grdai+yXYpbLBG+BeEGtjbsZ5igpepmlDfydw0vLHK0OhDO1TzxSoFV7Q2lDnPqHaw9Z3j90o/61aX
+7NVaQuOQ8iDlhLymcIvVFD7Jqgq79kmyTqIO3k03hlUaajbp1AvfJ3WHgo29nN74hSrBDp1Ui0hpsSLBTGxbwGeou2GWK2RP6tfXTclgUzfI6RTrpdHhkBNK
+jZUStvWEF1g9IavtVmWoo08qA06uL2yKDHFNUJrtfZjo9iwGsRfh6xM+7KU8A5t0Le8o69jCHKUacR9z3yjobdLL/HrG74gnco51oHSlg2ks180WnU96cjDF
+XM2K1HElVofRiWd0odJE9kihKRoCksn1GmlWy7dAtZ6pl1FbD2G4T8FqINgc3PtDJ27tDbBUb1rBaSC1B4Ntaw5W0zUHWp7Xa1UGg1hf8ETnbUvO5+oFBTx/
+XWtxpt60lLxtr3hggM5b1q2Zb1m5WlN9x+pVCgzaG9CtmW9Azaq1skixNjJiQX2IW2K/naD88ry10lV9/Fb49CK+cXkDITaBXKBYYuicL3m8VHPmPtG0OnGA
+XMi59gtb6xPDsWtqE+u3K24l6VNGourRlkD4R76SiFWJmO+rSk8fvgZ0a3xDJt8cvJTgO2D5XI+3Rvp6tuWNiFAdIv42mafiSM7qoEej3h2RUq4/bEnhWSuu
+5KIfQLhHXGXMKQu9rLkM27pDcizyP/dwn0I/1xy+bxYnkz2tHRfPRL2hyC2uNdxhZ+GpKDWFT+HeSzvW3HzORozZt5DMrsMhvNSrh76hnH9SFy1cAVocTW5C
+pX+leixs3owkhYjjSj0Im9u9dr52IqQLdw7RHN+4wwu79sK9kFfcvG4LiybP22TNWM9eb/Qs7XZddO2Qs7NJ244DY0jPa4fmqjxDT49SPYmPzeBI/WTgV6qd
+rHR2Ih1B94pVk3iPBqCVk0lH0qpJIGu6Cjd0XdyrmPQCWaKTab3kFqL5QOy+TSSpVEpiUebZfY4IUymUxPTGu3+rpadXSHKqtEiykhUKJKmuzn7OS62GnNMj
+22EZy7WRgRmlLvI+kZf8hnkCH+pwtXGvbMSrjpu3KudaQeQOOTf7WZqE5xmVkGRHrVYMWVhqCyGxbnmM65amezb25Dz25RzuoY19ooQ2IVox5trHK6/4c455
+dDaYCXBngPCl2Kd7WvPpMCnBr3ONZ5dJtf5Jg4zQCE37KE73UjL9dTk4QOw9xRJrHEzbfNez+piJ9IDa9/Vrd/R6QiBYsOLr50JZpqcf4r5+UyXbWp1UJ5vG
+k9MaWWpvHueYxigs1s/cEgK1QDYQKF/m9qXn64uVsb4hQ3191POd2pQnZJuyFEWUrQmSkVT4ye3IQc+O6JRQ0Sc3HwOqU3QlRq76+ta07aD9uZH8WCMGJ9qM
+Uig5ZS/ed257Ufmbaym8wq1U4CnZiFvTNsJouyUXdxLjUAo76/nYzOftVHQK51/weUu/f6xvrmsaxzC7FD5swLaKJxVx1jv8MEKNFoPaOsDJxvrYgAPpoCC6
+kVlHXN+B6qCcSSu2iZPCyduGELJNmZLxjMblUhNKdZFKiCgkRq5qJEZvC8ntWpyWLEuOPt/RFRXdRGTn2j5tldaT8bVUpdVkaFuKJZHHSLJebVVHxBn3z8JN
+eBfSDI08mbJaFqCzo3Jl+Twi3Saft0Pk/GTmQuZAfx5kUhBqpYxjbODM3pRfyDtqU6vvq913tK82tvtPy3afFBDKtt9x629xL2ds/5/U7D/r3809AEd9ANTE
+m/oAhx0fQO3j7QVixBNAy2l8gZXiC6hiQ/6Aaz0C4xVao8690fUJuhSzX+AkzwATlX2D29O+Aas07fsHTvYQEB9TPsIHzusjtL3TZ/sJbspTQPxLvsLtGb5C
+bxe1PurUY6iN1BdEZzzV1xqi5XbEdjuxp7pDvU+fxBmleKtLa+tVavRmTo73o3l1VSGGPTBgUx3DOrjGNlWzpt7qa28tbBd6Y8+5HHmxLEuSISUd31ZOjveN
+QyCAlr4B4WZELtYw7oiNmKl6PneTLriVO3of7B+y23FjgqeFJwGoJhlr+VXhjOH+81dpFXbd+lKBXftGxbnfJnlrZ4D7gtryAnSUmz7HRSjd6Mev0Y70KX2a
+WtLX7+7QiFD4fNORPn+8dqVPH8f8XhY6PikflzrIVxq1SW6hUusmLXr5Eb4udU3PX8ad09OXa+t0/P386qN8X+6cjqjU7umYTH3z2UHvpddrTRjucUmpTnZQ
+z8SFLuqJBdRGHbbRWvHLiUR5opN6ISx2U0e0cw36UOodr5ca6GN2UY5LTXSpPfdVSYYe3T7e2HRx4jTtOf3HT47RCo+7/ccLLdyDvDb3R/3A64xVXknaISfm
+p2TqbY7KtoycViHP7x3e8nf3vcMT5/P6h5dlTDYQh9orzIZT2zs7vF914mnw9NSkGthOL/FWQL2zgvuJl5XVHt9TPcV1YlJPcUwzq8yWqNxXvKGk9hXPu4l6
+ixcivLm43FtcpNXpLY4o1tIiQpKVn0z2GNdPud5jPEtX6DPentkcuJL6Kt7ttOWiU9kqSKMtS7GUFRK8Vp8k3JXYX725K8sxRotrPwNXVXpwGrw1kO9KcMzg
+DNvhnZgBwZ4EWWHAmZQmpXbwEgXuUFjnMI3LudtY23tfI1R9C5Fe41wEirUuNdv/WwAyJeoB5N6onFb2AtZecwSMc46d3eoLvIy8gYaD1hu4EbgIPkG76OoR
+eNUn8M4Rv6T6BS9pcpBO7x1FGo13YEX/YOQC99a/znvrE286ddhHW57wB072px9M/nTTZ7+sS+y0P36YQIYC0+QesPloudd+/a7QbX/8LIEIxc8S/3/V6blP
+Pq513Y9EMCooUcF37Erxp2nvfZzREbvvh69TlE34/DrWUeXvX0Qede1NX75+3fRa8I9/pW34k9KQ+vDHmtbWoxaozunEH46U1I0/rTL9Uwc7ZK3Yo5YoT3fj
+D7dGgRAV2mQ+UYzNr8NB78ZIvfmLYzE41J/fVbWfWvSTHv1Sbugj580NaV36kcszs1N/+EdTrfoxDjTVTk1LrO3XT+SGoFaN5JKLyfbpqT5VnRYnk0vmUU/7
+i7Bf3iZqh/U2x0zaqlcauVsuOevWG7AL7lO6pPuelvrzk0Ij3qEfkyMYrkAu47don37JE5DWp3fqp1QJbivRpfXZLt3nJ6j2ItQkpBaiZDhYK8qzBWrRfv21
+DvMloV+//lbMO/bXFYPStZ9emtK2H3aZDLA3IJ6qyc79WCYqUKuwg0FauH//U3L/fppnox3jkWtA3YMF8Q3qjTkIn18e0CXukTb4YB3+vG9dhNhsH/sJDwmT
+LI7ZbREnBnBnoboLJG+FbogwN4AvRJ0cEBck+A1xRdhXvsJuR50fQLwHZYJAciDYFIHiQ5hYd5ppPZC6kknzA3reBJMjdSdEhwL7E3uo9zPzJ1rqulfBj6Pg
+VgiOBfHJH0j4oBfoLRS4mDFFoeNitE4GnqWwl3Fe7Z3Die3wjfaeLULPqNyJnujsZqrC+NcChQx/ZDQDvlOX4JF45utchfYu4Y/WeyTcoctBytLcAuZvt1MR
+sq9dAF/hD9giax/9pmb145ltxhSk+2jw+qVZBbVuX/yuMq4A3QP+eeEO7BZ/75DMLJg+94PXznwVkXbeFwjL06xtanqBeL4toZlr+Wnu6Eae8LmSpnsSHgYf
+i+bzYE/pLFgyEXbivOGZHuy8tZM9hgLHXHt6nIl+XpZJExQfQKWZLEJEBnAqvuAC8KaRWP1Kmv7AqpPY2yapw1/w+vtrSi1EqeWwQu1RqX/IvgKtfXhErH2o
+Mx1YzQOudyizrXCtwxP9enq9it5JFfSDUNtwXatt4GKgdURSCVFTy/BUv5ZBooDqZMSSIaV24XBGXWOZ0aDXLGhV8IP81n84561fWKUE+GvKxQfak2ycvQYZ
+32eOljFuGy2h99ae2gHW1jiTzL+PoX28CSQGfRAeiXkpPnuAfDLVgp9BeJqGAQi8L10zb+CMzQjYyd8vLxoZ4Ue+b4OddNaeJgKpHsAmiF944asEgo7egpT7
+ejJUZ71aaBzG0cgneEjyuPN7CIhUaQ/2bKRtvXfe+oSUZnwwZFCCBFGm8HyHJbmpSyMSzpNYBkrP27TuhgKdvbDf4oNVOiiAJ7RiBCoTo72zHq/9Ulpqwokh
+Y5Wi6Nbp9aghLB+iHUI/V9xr683TnHosJPV0KnOBsOpEELg/Tp5lBKadN8GvS0Z6nlp6ZebQyS83jA7O+gvUwgJlckllYoK41/oVWFIZj1hCDe1JaDvLYRSn
+roi3bHBBew70jO0S2e5VDkx/PoOweqpC155KIbtb7IxtIxnEXFwXSandL4KkNECvGIZRmo3v9bT+kHSIDddNvth0PkWsFej1CpYoN1XlfNETyrLasTg5CDB+
+chsr56CMXbZmC8DwSYcsGu3L/kjMphzKCMpttpSMoMwnt0VQ4lMj0Mm2jSEot7mFM47QibZl4TCCkp5P3A/iMSH/2FsYzj9aRjcovoWyQNK7YRFOSdRFCooS
+UzUOoygzQQMSjFKlOeqCrAe6SEpOGSEpKekWSkmJY124WzCkRRscTqApidybmj4qeSuBKTE3VwReaj+LEQ//IcbVRmhKxut6Ak0pcK6AKfEadtH5uYiluZqB
+piS76kU0ZeWKYykl/Z7nht0K70Qd/d7dV6rlG+lgNa8yVPXelRxpYWwn8+KJHw8F22kkvRex+A/HtxAjoTvpx51jHn1sS1PhnUOr8zL2UagcwvQ6vqfkfUKi
+a5g+2EXryhMkcRfwDspSWKrqIZR1Z5glj2XamCujnqgfZPOgyfG/TminNuayOCY6bGaRNJbJj9YvseowyJLYU+HbOesgfzu8hVn0bWtjz6Pet+WYQyGArI8n
+dOKuS5ToLJIHYK/6o/y9i0z7UHx+4/L8EUbc2KbvPPTsX/b521kfguVD5AzMp3MRrmA7K875kKxdQ471sgfBzjliW5DPf6giP9lZZ7BpLGDbdK0H6V5j25A4
+WPXnY9DVC6iIygRp547JU3sw6e8r628sAV4/dfe7PMzwv/dU1UD9b74JyP2WdgHP6EAeeMZyMgfZOgP27Ua5hRQqtUXbjR5CXjhr0i964SOlbCCkSRyqz4jo
+VSSKTq8gURC9nHKaRS/rwhsdbXjc98gpbaoWBeI7gk7CXnmHMvLKMVFBP3XpVh3VeuYT1PH4jVPo6KuWgYuCP/xE1zvv7UGLuKG7YOWhG+wc3N/10Y/P4aX3
+ee566coK1GkbbC0XFH2seeu9nfbyuI3KXTtoY8I/pmg17nH56nNBgakZPS+Q/ONDCeUq+16OeV8ZFUq9VUzjoYAYRv6XTKF4YI75YBkCZ8SculV9cH0x3Bdz
+jTcG4qpkf2FVK9sPu2jQCZ/BiV6DFznpx8R36lzaw3OgQc3cu+Y1rgUwKOW/9Xmy3Z9Eg077PY57PvJuYt/nMRwL42cS/Nq81zlRcSxDenh2wpmqv5Qrg3yQ
+mlVvsKmNJ+KILwIMm4pvPp5NE+K2jIrcI+dy7eza2bFGbLDjkhZtntKl2PpaxqiFCuWKgtwrQLxTXxCQCYBn7LpAII3znt6pZely8HzucrBHKURNtd2UN8Ry
+DWsrVWPtGZha4xAvuzUuM+G9geIYusAMrmMJ6yOozMxEWF9FZEZy8R35DOr3TQRJ4dqC6/y9Wv4wRWOi7xesBKKwzb6/gsMeje38MnSyInMhF1BpmegGrUGg
+WWNF64Xahv30Xo4oZgSoYccioT/X5GTQz+Nv127h7NvoFailEHV9SyK5WWkNu+QM5pefGxgBmim2WI/FyWq/oD/z2SvHzrNTt/bkxOH6mprXOeTrFFGfbLUt
+pgOvOkM60Dn3Ra4JubdccaTneFoi2tNmlOdp6q3k1xahPMfle/LO/VC4yQS5N36fQC63xyVU5J7N+MpQoFpwe2EtBVZpPZ3LLvdI/QDCmjVk59XBm1j5TjnT
+26QmEGjLsFQAb/DME94zNaPTWr6VUzAwHnluzxtrrMRWcj89xUJeiVVx7ExEDsbzUKgNPu1UOg2xtH4N6CxUH/Bx+SzEz7bnYPDiKRhiCtFj3VNrgVYCglMi
+xNCbhF7WdxJBGu/WSrhCRargMWSHKopyJFe3pcFR5jVeaOoVH0vnpD3hYkVPMo11pcTxPOXHlmMoMx+7JBe5X7kgUtb7eXMpNJ4blQfFTyYmqg8TOYgoyoP8
+B/dlzsD6WM0SvJlYbX4G1KfZIjb/UVoRji5ABDQeVtt/Bu7URxTjurX+1rnI8xaxz08J8zVUIgQ5SWlxqxypbTN7WS1JoSChJQM9l2kgpOTaYxPCsJLC+cRV
+E7cxYjJT15CSh9mG1oUilORaN6K+8IHnneXqx/1V0VrjT0Iorl08EAmCMJTSlDX11x4sPi5FKNLBJAmdWKaSVNiB8bU6GPd4GGvMqjeTqsYX0bNdxb9QEtal
+KktPqLhYaJmKLWu/dIyDZBWX4/cr/wgFmT+MQA0DCL044g63KMjrQ/0mAT/mr8ZuLn7hsEyaHiIyuvK6UT5earIRNgJTwHcvxhUiXqnZWeoYJyggEj8qHZ3Y
+a90nD5QjtnPv5Hi/R9quvfWnAu1wIdcu1BG0+4/9cYQkDVT4EbZxQFuWp3HK1+k53mvPMbWAlI4tw3QIHUerWCspolvyap4innm+PW1AmChXFGkdpFOPZUoA
+lWJWN5DzuYvWmuux8CS8cG8Fn4yummcBHV47qmithLV70btz8r0rfZSUe7GbskbkxaX2REhVT1mWPr+zZDLRa7CVmiuteludZNGZQdp5u6Bgs57OSEtn7Rms
+U3IjAy0jinPwWGlj/OO1Mp0oDiuLFQz7MZNykl2E7aOT1VHss1dBq/GDqejcexs6Q1kbr6R3juAfhbfYvQY5S4lVv9M2NHPisQBjjctUKf7xSsIcAa+A7NEu
+bw5rxyizRl8ieYKFzPlG1BWB5z0P+3Kguc91K//yy/uePHJtaLyVwokqOfn2XHljR3iIcLJiEEN9Anx+HyjRUaKm0Ti15PODa79LZzA+LCJ38bMCOrUJuWus
+PaXnNpru06BDssDo3MXHeshdiVyD3CVUEWw3KHZEd4Fwokxqx9Qzy2i4NRDfTPDPtgh+8xGh08Ox6OOEr9vGyyF+zhbSu49hX52jNyM+BxOhyE3v167xTIwN
+OS/RT4i5H2xLE3LTFC+d2NKA2ly71PFIMtoGKLULBENZo8jbMorSTFrUGFzpRlUxq3i+43ib4LDohxy3ve1TyOZC2akcq10K3i3e+z2+547tNtpp6hum08lv
+xB7Z34owdNQTo1/eFt6Iwp6WPCjax/QlDC3EPZzS78R3p+s53xH3bnq/rLhVDkX8eJvQbLi0T8L7wynUOuRFp/74vogmO8QIsm3hffEU0NtiBJCp74qXQoVD
+2X/OXHpPRDiSM1iEDprqO+JufmUtOBWpZiHh5xhCJbYNsEqd2LJFqk3UQ3heCtESEOrDpHfQXB8mvE63tWGMqGENxaxSO7Rb36cxOk6quRCqLXrfzn73U00d
+mF5XIVZULPS3fn6vClruUMynbffecaXSiaEh3X+/HV8i39i0pnmDGgk/UR7R8tu+1yYprdR83/bEOy2tgRja003fZ9Eru/4+294o9i5Lj3f9Jaiazv3t64ZW
+P9ig1KULXHsT78IS9jl+8WB/FauJQuWzq/PrMIQxZFQ9MAzjo3HOMUek0SIljGKMPiqDMaL7Q3zuB+tM3YpL4vkrRmhtSxLLcnKu5LFoY9aLBJP1cHodfg6O
+4NX8Oszob4tmELNi42i11AM/HYTTzBJGaTnyQDywOYEU05nzxhTTeUhpE1RnIkdgnWhON8NPPkZnP+dVNwQo+inRwBBKTILhrITctEqnRVBm8WEMoRfPT8RP
+PgfPT+EnCVEVQSkRlg6wiKG8AS9oXBQMZZ8NhKKUOKnwxoGIHnYa2d+Gl3u8HBztH4w8zOLI+gmO0gM45YljO1M80mA7pdtd0Z3kbqvr5/hOrk+aPKJAsSA8
+BZLSAmWM5w14odEmHONJ6csoz0aBFJin8XzGaIvzvBGnPndxnqIUWhwHVa7ZxKr6i9/9OTpG1DMUZsnWXG3d5ZSVbjCO2d5llGO2eALMMVs9jj8MOX4N50gX
+wpCOYQ0t1NG1+pLQ0rCOreY3jtKS4I5O2qOMdzxssB/9BcqIR6wSFspCOd4x3k0N70hvaoN4DARlyKNMM+Idb7Q5zmnKDPGISLeQRyZrGfN4W8tx9nlRUY/o
+prSwR8LRFO7xA/ARxtlmuEfO7xzkI+degT66thcoxT4Gqc7CPjJLp6IfE2cc/jj7PhWfvnufqn8PkkzW6oUCOodk3EcR64g8furzc7gj138Z7/gkPMMRgZrv
+77j330AeQfYVdzKd6u8ickb0eZ3g9RakI6GzzWrTDtHc+ERiu+8bONE7KKvzqvsjxGME21h2J6AbfYnHCLyRxmMWxwcNvvFQsEgZoBftAYY4UmaF7zcYR/Z9
+gnLMsUGFOWrfb+MPlUCJfikNu/YiEYqlbOMPnU6LpqSubkusxVK2vn+DpTxUPW2KpsTEWzilb893xS09Bk9V26pSpmhKZlIn6EScUrHhEpbyULakLTkKT8IE
+ZSxl8a11LOUh960lNGVjN420ZglLGTjoYymb1StoSmRkjHhnOJ6yeA9TeEp+vkVEZZaBBKnkd9chPGPxtimesWizjGhsFVqFNFZ7wzGGxOPmYCjZ484YKORy
+UxCU7BciehqmUfa6CT0J1ijTi6hCXLszTbB4B4ymt/NoxvfZ1tOfoisiKSWV2BIXsZTU4+9SF9CUin6aoJ3xlJLnP8kBR1SqOktiQsNU6t5/fz9UVKWqxlqu
+pjGVm0YAUzzPQlV2QgB9LTKmUo8C+rvdQVUKGlra79YXF3CVxNvzyN/j0Eo5F5F8ZBlbqfh9jnt+BSsGqu9XcRYNvlLx/hz3/wrEEpr8c8ZXHrS9ljoLkhGW
+RB2BtDLqs2TfvzPh06h+i5M9lzLL0EBzPjmu8wUNUX2nt3YN2dlcfS/v7RS28/2bIam7vM5Dd3auvLIGCd/5goagNv0dVRGe+K7D1D3hZzjF+90zjF/zBFms
+lUNM/K+aRVewnMQLc9QPE+GcWdtsEx3Q+EVoWceqX+Qkz6hog1AtQRQnxpA+APsIQ7pcxZWkCr+AIs1AggQjtSZMpKM9+PfyS2VAu0UeDxQ0qV1b2+JJncOI
+Upznyd/HeDpKgb5ZNoRMebI8A0rO5wdL45NcSl1SgyBhRBl6NNOiCJJIxQDprX5ZQY8oBCiKlNBB6BFEicqN4i0JhRbRmb/eYDrj52tEbBs6T+j7o6E7ETWG
+70TkMsKTzyOquvQ2wXcSyhMoz3pARJxn5AIjPUv+wZE5dC/I6+6jPQtxFe9Z6UfEp7VkBucydq1K+L7x2h40uM9wjwPyc/xOg/30DP3pHMkPXoNn4LmqjQJA
+LK+whwAttAQM6LgohAJtbOU+m6r5Rug58jGMkpNZ2BgNirmciQd1jkMvW+e53PW4R6FGV9ijo/hslnPUptmpwdmUsMB7NZY1hze0aNPAuUZ+HEf7Bnwcvh9N
+JqXyO9dcUpOnSRUpTk0lXUMjxqnJpGukk22Q5XjfFFkWCaKdxbhWT5CtDvetW8K1nG/np2u7vkAi6GP9fKx2LIDHuC5XHh/zHGvs519jOffmQAtIWr4efpsG
+kGhdruh3jqVVCVJELaeLMLUN4W0kT4apJRIllYVcpGWqE5cpK2CMSyU0GY6V01TRvIgyx/Mi0hTRS32odC7jJNByLssEUJN8qTNwdp1OR4RCGojnMs8ANZbM
+5Ykzc5/lONayMg3Nyqhwb8Q6560lOMslQ0JQLzChLTPmi8AtsydI8ZbZF2zxlnwgIUJd1ulBGIrHYoCMh7xGZ60XPCT6PkNF2rWtwiiwSPxmlL9PX0koTot+
+nyK1MgUC1EK5CjYHXcdIdojU+TINVJLE+xkrKVRnCVhJpQiJISYLWQkyyXLnFTMpVGZJmMk+CwU52fDAwI38jThjMjAig+EnhSOJUJT1VCqUOI6S4Qq7b5wC
+mrLSa+GUZXEynlLAgCwmyig1VGUWswyrZPkBJ9U/TeEqGykI6EokCwqvRHGseKem761ydznMkuSzMh6/voaxyT9pxQXlg4nWCUCjsAPWJ9Ec6RVBOyef44y5
+fExGyK32qc5myMtk2iToZVHgFBPZ3FoBE6nmCjEqMhCWYJFYa1xBM1DbXNkb1f8/POfk071N8mUt87Y7+tR4ue4hYhqz/9/BNIr7FpGN4s4haGO1vxTbmD3l
+Btso0Tq1lMzghO9j7Gp5v6unnkyVWlEl4zPYMLrLmVo6876OQJXevTOm8kl4prX4AjUBVZm2kcEqhXy1U9/tGK5ypefJnUC5gVYKbyqOzAoJbyp9LdoRtdSV
+hUnd4kmgkk6F9hQdS96hQ5hM7B9KPiLFfD4uoGSPO34coSJAPyXbz3ZTx39yA9GiQLnXVWGggu9zBb2+dnCgzApyNKjgfBhgVGU86Jg72QAP2noEAiqUOgUd
+twD5BXXSIDvRiZt53kHY+b6DoLkIBKd6OfeT2WvOcP5ahKqiU4vnGF6qnWRSZkAYBelscVSNRdmApl4q419Zh8L9Pfn8O37yB5C/eTnPrZRwsPycEzQs8a4b
+CtBgYktXkqsyooAiY4tIGDRW/j7x2q9OeNGu8aAtD9w1/7niBUk/krn3xMpXRIDjJuo17o5rBPENqajrBQi1OxSTG7DzFJMrvkufAn6Tjprfqe/R7FwKr08V
+mVvfoBfNYxPF3+HIGuNne+/OvAQmIvBcpyZQeuem+NnJekBOlENonV4LWGoRCIZWrgMUqmy6348+QkCMizha5R1drKRZKPUd6r1QcLT9d3OxXGZo1yhhUwPl
+DjZ18o2Z1ZIMzcnhtX4oilZq/cTzyur88Jr5AJ0e/cdwtcIEPpZxICBk0fHlEFmnvXPvFpR2RrCOdPYDJyfLbLaPjxCW1Y1EyUDOYD2j/cSxQ8acPhlflZQ5
+i4VYWO4xQbdmYuKYzkAyrBXR26n0lEmdDT2M7swExbGdiGAzV/Kgi0jUCDdozEpfGGlJ6e82mMgXshc4PVmS89JBRyos8fGPhLkdhkdMvb+0aZPiEUDIRH4M
+ZtMe/ePSmbU3gVLlIGMRZBbwOErMxEURr/hC9dKnplI2/IjIRbQ12ohKembuF/nKb4fH8P6aeRE47L+zKnJMsG31tRW6q8AvhcJ6Lgh4zCTlOZM3tV1vkJmN
+HiJjOClPO2wuZXzVnj2ZsuFJnVGJ5KaNqcScbSEcZ5m5gpCcit7HmM5Ij6M6mfbfJljLZ+EmvDgxwVJaMkJ4ZrrKOEu8RpF2d6qlaA2M47TVEZeSTcg4tVsC
+MnyeAEQEKFPEi44gdhgONOvA7uTLxiZwRGgx/foYTJGHiAvN93LGJEyJE4oQpawoYzHp3lwUcaKx7/gG0zEFDSciRvHtVEdlYg7vn8CNfqRWddzNxEyB/xkI
+UmE1vfGZeF0XBBxpkvr8KZqtv6IhSiun4khNeiZwvef1PPeeoDt1b5j7wwTpKerEjPV8Jr7SdqZbCobJtV6xPOoSr3AH0V1WL7VFZZqTCdKCN0aBmaI3JsUZ
+Bc+kSLZgNBMVhNJU4gxL/X5lGqVsZRIQMFMTRlNq9gXHGdKEyoYeRnHWU9qMq5Tp7VYvWsQ0yVaEoDoRTQxqAjGWsp24Zppug/JsgghtxS2mKa1am1qpWS1K
+VxthiUhfFHGQyGefmiop+OwCIlKyC0bjaQpL9P5NX0oneZ5CFsgrkMBEfC0SlihJd87ETGGfZbQnNQCmc7cw1hJ5xAVtqWkojLvkSiohL5mmatf+QtcG5rWf
+ZPoHR6GCJVWy6TbQhf8Zb8CeWi6EzFJEVsXXHNkGMsxDR1N7z25ZRVh14oJiAzsT7HR97VqNTWdzCfmArE+qDSzZ2mnSSKc4WasYrzKww7BRKSrooqP6XrDM
+BsdJ2Rn6rUSnc6fgzdBxTtNyuogm9NydoOU+ebeT8TbWdW5a23XWdEHAUiVpz52WN0/nOUHrgc7XFsICJZ+Mo4HqS+kxxgXZwduz2CXaGsDoIOyT1XfJ66Fm
+5CbHSghYofEKHGeghI1EAu/hcTWQywgJDhtqakUTTRHJw+E2xwTTgwm7uEZcS1sRN4zm5dqPWUP4aIQJ1keiTxA/hINtJOecw+tIGmN/RFGXh1tB3AQHxGhn
+7MYEbYoH0jjIrqPCQsUG8bqDOr1mtKsdXjSMjshRA9VxAmcYr0PeP0vH9sPETTbmiZNcLY5ybetY++BtSDN5exr+JuIZOKLBOXSXCy1clS5Xph+X+vR0ncMh
+txFtFv7I1HCdunNFp1F5R8wO0GJ1XLIuSfxOKWM/Plk1vHCpF95kwXvnSI4z1rjv89pJo2m3Ol0IqTdW9V413Baqe6/6Tat8r8f+uKmBpwSFSvh43Ml9G2vj
+WNzZVMMzmrgunpIUquMLSVJTRfUbq2BfdAjzWnZmQtqS9kqf1lZV/aZWdCuiTrXdp76VdlPhXakD8ZdqzTXyl+ZVXVOupPrrtedC0aqwC3sXhDrsqvlmVWJL
+0mpqsgWZNWVXWGZbqJbwMV4Je9y/hXiSRHsP5bt4Qag7q/pog8ozQRpiDZokkI5ItlAtWpp8h6vRJBnQG4Kq09q8V64ne7Spld2b0gVW1gOtFqD4jgdYDkqo
+NJN0QK05a+8/qzyrlHdYvPJYQZgJ1Wft/iV3hl97qRpNpsm0jlaRJlIOB1UjTSvU0FmpdWLprExkIU4teZNb9N/i7svnRJjmoMXap0DjbNRvncQU26SO7Bqt
+9F0ea1RqRRnJh6JxD0pecimew96Cmgoz4TVtmHhHu1LOoDAtQc0ZnAIL1NnoBD1XsFfOnzpBQc8RNGSbcQoTuYHr2a+fmqgwmROg0pbGK/TfiwonU1ML5sTL
+hBk2woCc6YtlrvuCZCTCDK/DUSapYmqbyaZ6AW+Y8c+DjUXyp945a42tk9bSb2MdFZaNdQ7V9+Ae+rGKZqRNa6kWjImj/YMB7ADp1SjVX8R0RwQgoXoqU4pG
+HMB9JBdDJ7u/Au+GtwqyHHGhd/Fv+Vr4kEsAqZ2/R3xmR0js6k9YRrLKdaxJVrUGLDEbDovAk13DSDdwdeoIF+H6JEZO0fANj/dot1RhreBmfaPZI1KSCY8n
+0zqRtLeV9Bq8ldd8Gc3SfpGhuGbQL4rRizwg+GfDxAXGw9Op5qKtQmtPrHAyaF1uOb1+7bscSacYv/dfZrPGX+1xV+rk5rGX6+T6HJ6hRDFm8oJSj3UTQOJK
+FFq+5uWF1st3fR4POet6A94l8YA8oD4nsW6uy0pOWCNmLjPds9/uW6N5+AxD4YyHVEFgjQwwBF3ZlKNERAUPdvl7OXUv+QB8b5fTbub6cEJh6m1LpleDE9dk
+XZfYObxKpc51vRBJdU8DNcLKoViXnmCSfpV13EY6tqNnk2suKjnsB/B5T8EP2ENVdGbaF0j1dLIzMFhcW7cG0SewCZv0aMoExepW3LsEF9DIF9XYWmO3BrYj
+baXd2pHzskv8fcQHsrd7rN7O6FbXW+E+ZrM7BlK16I4yQmfb5z5Cr7Q2cG9aKNQSiiwVtW68LJoLJF64zHXprlJ/J2+RxeV3zR45qQZP46XGsuU+73br8FSO
+UBmezFJbi8fOzmXxrS/3f3pP1Zx7E/V4qmvpeTmepOnXDschCq8Pdt4lx7lmb6UKjE9Vrpfnqs1T1zLVZKmzMKVADy/xUjMLkOxEWM+MGj1NxQgVes2hWQuV
+emQXsP7FFjdMMU5xGKrYW82KxjrxmC11fEz5Ep97gSKjF4qFbOv5VMPolMjM0pq+XpyD6NM4B7Gx6sY6To92Mh+C3s/2OPsIR2LMMckEjTtcJ/IQeblA9mIf
+ewetGLan/GfX86AtL3TETivl4zFshWQ+ZvjQrutF23YghvEdHcu91NeId4q428SXdjO86czo2gru9CW2f49RDgXuNvE73RzPE/PHXU+41NE7d5jnJ2rBUfOs
+4jvntAdoxioO4gXaWEtlgHiBzlmHbfuoBx5JHtit1gM7OY4dZU+Eo7Zd4A6iB2Y8c8K8H6z3SDfzOhfqg71b9sJSCnuCqb4nFlgjzphzAS7Nebuo+mOvtB7Z
+DM76XplvHTOFr8tdu/vevuWdx+cM6+sbA6ywOyMXmuom5+RCXbx0Yi40Rj7WdeMelgs1vN57uxPxeGtdrvqKfwl6DJd9W9mHvgRL7s8vaB/iCQZKU2IDOhMK
+I8DzsvenGfpPS3nZpZaNXfZYjA4nTchyRpNij7zWZKwVGN5tegvUnGx8pzzYP+zyY0+9NdYagYvU2sd16fZysn3COVcgks6Zgi7tqZzsBH2k/yQean6xZeIi
+641wtZf3vNpMTtqezHvaPEBJZYrX2hMZXWxkRGK/lr86P3VDLq2f5JKOGLFtXtSS3icoN7vb4BG2e1G8dOP7cuLxu5BLkCZCbXcjd5UNXRCXhbfBq1JOdk/F
+R2xrfqSjpcOSqqnGyLTMTcXnQj52uSFOYnvTyNxPLUQAS9jG76S+AcvdLLp4Ce0ECKXDzVlYM9iEaAtrr6t6AkhnxIP9WKnQl6KNLRINWO9amZUuiWsXvBJF
+Tq1PgjKzhwjNMSMzW8c/dZ2TiPFocrMXmI+iZGfZiJyJ7Gxgx4npWTonhyauLjDbK2do70zzguKDhhX0MqpxcoHp+k6Gdh4zNC4QWOKWseXpYqPXtSztrK2y
+dIiWm9Lx0nYRnq6kPv+9bO1czshwLTet9yXm7hN17GpWxnbGngoZW2lbZVMg8buctAnnz9rOXc/UPC63mXkQz8xl0U7Myd7OVEHirC43YTC0+9bL3xK02Wpm
+BOm1GNIWDJqWv806muVvjYRFW03Ekk6NJjMkTY+Jat6GxUQ96iQqckpcZNMoID026eVupwQgxCdOdbMlMTS+Ncvd7orYvNWEf+06HnaBxenvYyxvu9vB562m
+fGzX9bIFZkDzs4Wc7V4Xp7ea6Wu7CW9b5HHK347e9kfg+zbVrNNrmPS53Wyvu12a5HOzXPRiErO3mu93u47nDYLgtVz06OmeSFp0E4/XdXxeyyaZNV4v1WmP
+SJ4m0mcHAadyrHuaSQhW9jXLiLM85Azbmd1mDspoCd/dm354UFpKT3ib6VJLfNGpiDaNlmS2+lJT03pY5h29V+uDMptTEQguc8tOmMTsRYQPbXK9GSlqhJpg
+fr4SajSkPNvDZb23Ta915/iMAZLrTTLaP0xvCeOUOUndZbrWnnry5mPHv3hrc8Wga2oZK10SFWDKhmR9Fz0GziDnfJ3IhsPj6Jp3Vpf6jEi5U8yQ9EyXQa2Z
+j8ZLcIkHwUdwqKegkL+cRRwDW0UeCrCVc3FR5EHMX2I+FvPqNheQ+Yl9X9euw1UtmRwk/8WV/oYkN0jOiv7ujK6HTdBb8axqTFAernbO69SbM+Ukqr0OKxiE
+K+QIqzyIDj5g+yVM6hPsZxzchw9QiqKooc8bVsyTI1cbLhX+Lsr1jfR+C9q4KzWuf6142QHDhLHN0u/bSejzM+++pTun3rdY4ihee/QWT89SeesmHNRpg1Me
+RuxaOIAOgMjScXkiYeNfXGC8PZl6h7zM9+2wTAbs1N74tc8cpQIccbNsHPi3dpqd8EU3I37qjDeRLbUWhzLFasnT7DfCl1y/Hc+Q5vlsxBQZC6fzxpU4Z+5y
+c65WaNLje/UdnFvrQSXXFHy4zt6e+vY+0ntAK7vf4vdgj090nH0b9BLgzONg6dRHNdccz2BEXRzBHXQGDxirZJyjdhJtEmcqb7D6STRocOMZNPfDlvOYezK8
+2XYm3pTBeiqhxyc9lRqjFwnWbgn7LOJrfItUJdLncigSJHUitm/ZFwqP94l2dZV6R70FHyxxNpalUDc7JVtnz7JILckTCNJtra0fFP6X3X7D462KXS1/CH5c
+WsnifLNK5eyBr+vrltROrpbV1UrrvsRwnDhHcsz1iBzXNdqjqroS1amqY9GqjEZnSG9C02wJNgu6FismhImGaHWE8i40nyHJXkHfWkmMtTpB9aoXdK5pzzYV
+JLCf1gKUI8rPVf4uxPlpZp5OcJWQwpO+/kLaQ003sXehBbWZIo5Y1khuLWkkvqeKNmrkmHIaD7S3MXTMOch/xNzGanyR7OY2ziBqeiW7ETtWnIGc49hBPWRy
+puH5uqMHJyGNRxMdXD4jAy5yMErF0y30Vkh0hI3bQbmFq5U2yywQFg7F5AJi4AyE7EJiAZ+ZHTKrNKJFX5Lv/BQDNcGg8VGve8PJLpE/eQcSqJcgvrMFNYYX
+96EJ4nmeh0USKh8okJ/gJsXxHXZIIM/i5AWSS6wwfJ1G8ZSzNpgXAnmyUQ4ZXXab89nlwTx+K6979yT2fmXupIC+J70mnpfvFg3oY+1d7T9FdeJt3Annzpzs
+qT21JrooYmb+DAw4QbUw3YKzp7RH1R0lmYBbVHmaRUAtqhxJIeyS/lQPpXeAw6ZH1Z1utnIkbL2crLTes6xFXedSqPKsPaqWppc1we2pGsppNOxgKWVMW8ra
+sP5Ys+mvBR5Kg6yGiV3SH4tmIFGPrDtsvpm01WGn4xhC+dXSRvzYBvnH1Hvpzrz8Y9p5Jf2YH4XU9OPs/CPmanb+kRxLMf2Y31u09OOM/CPmbG7+MUutm350
+iDee7iDzvx6DQxqr6xOpKL+HkpdpravjqZgMiYWuv9Jj5GZ3bZO7PnXfbRkGzS/bBTIP+rFe7SPZydipSeKHjIf2wTOQyx/T5jk3WC2+lPK1yNKYOjN6MsYM
+E4K9NRBvW8/ynEEcTizhJB/szHcebWGsEvg4/GCtE1icc9LzHSXat9MDn6nE7RDym1K5QJn87Du5TSlbKOzC/Hgf7UUI02DObqT2oMKe1LnDY+3T47hXC6p+
+WjYCVt7rB2sN2DVEZtahWSkW6DD+U5sqodDo7PHZnkSSF9Hc5+gF02xm8R+KKPcmRFmqpNwaTMq2NoFuqZUaBRgiJbeAU28HdwoLLV/hwllmSEqKWNidK0Ca
+z7ReE2QuMciKXBPkRbS/u6EGgleNlzkf87c4pS7WVuNuSA16SplVb6vhMuExZsayzXkdrF5ps9iIZ/oI0OW9vAJAj/PpfB656ZvebymdV6/2IF9pOkec1ZtT
+fwL1+5NENnJirYv32TAhVRtk69x2LJsLZG77QwyVzr3//o0doLIi31bvvbXGTtzRfk6RWOhZOUWVKzTo3Sm5zotkn9qcIuJmRk4xcSKnFMtW1Yk8ruGI8tPm
+FBk/s3KKlSsppUjZSinFhrGcT3ST+UTE4Ub5xMRlN51Y9rJ4An5oZXiZ7elVzimTo9q3sC/NtnmhKNeswwbPGb2I+vF2tNbx/KxE7oxre3kJNTdB812txjqI
+2YmDKfc5zc4HG8utvZqjOAPnjFVqq0QtcVCiiYNi03VGgjZ0BhI/KYL3Xo/hG44usDi+1Q4Hy1URycmqw00Si3U2RfO+G8+bRjg4jsZ4KhIV8s7KG0fTYH0S
+RDee7kTUl0jf56so1me37/hcUXU+4NNxtRpZBz1bej/jGKh0xT6ced+UJwbHwuf2jmGc+CpKZapyMlATyiaVosld0oca0UKW/3Ai56eVJ/IqpV3Shzqfzuda
+6z6rKlGimx8MrIJXqLTp22lDfU+tP6KliIEJXh1K+RCEDiDObR/l8Uzd5W7lYTxUStlhp+iQ0m2yjodzs329UsNeoaGMkSBYloOyC5vopKnyQutn6KLI07LN
+hR5upn9mlBRa39M7pY+4oHc2wQYoccnYnkaEAjB8VX5/OGD4qincq29hZhFTFR2cbi9CSQcd6CF4wVKdOinaiJGg1nMw7na8A8/S/hK9uKL2llC6/ZpmmRS7
+1b52FOzWTOys0XttGa/iU2ON4V7yIEvMjnk4jPniE5w5HlkxPd6oUrTeO2+9s9avVU6zBYy/DCh8c5wZ2i2KM5vE93aQvYsWncmxZVfxG3+LLZvG8HbRuy0D
+M/XkZvETx5D1IbqDncDB5PwP4spsHCuJ+CoVpDq47hmhLzNHnb4G+i3DPQ2YgEhHg3bPLgGI/QzihCW54zzNlYfHmVADd7SddPlJMHDO192q+fB8czpt6K01
+4J3z0PSJHjV7zNK+u9+RPnrgB0E8R1lUycigBvW1cKb8Rm5Tn/Lx1joAuNLpVf9e+B74MHziPN3qOzz3mte7Zg3TLezJckht+SUq414n+x6/pLG9FTiU2ttj
+ri40PZij7n9jRqf5PmNN43mJPan9PBHaRaHGNL/C9LuozziapSWk651P1lqd7ulFob96rH9/a1aH9ZlcxlaRM9hEDSMJo8sZvc1jre6P3XV387kXTG12bqeu
+mtLyHK34stLzPFZWf2BO1/ONdoiaqTNoVqD1Qkc8X0TzJq6mF/vXQz+LjwBEZObq5E7CaEbfa3Wy2PRmQjhLEa3grDX9+0ndMPmWYtuGM5HxVOld1OfZNxfh
+FBMGrtdavbVzuMd67K/35pwu6/2jgJuun8oWT2m9jmR5oem9Xvib7r4+w1J4jb1uS3bJjuGeoHEq2/tnd2afbTk67Ort2jG3Uo9Qop8nurb3t5y0C1X2XG/l
+rvGZ+7kTXTWro/skt6jBe49dpc07Yng5o3f6D8APw4/BT75D3dMnrUof+SGfodkt1fFeaf1dY3+Mj23cWX1KrQitXpXNU9utkwt8WenNXGzNvO7Mc+2j28BC
+WqlnM2Z+ThzFu9XOjKOstWXSjxpHSS1sdfvSxFFaN9s5lsVbi4A/RGxti9vWx7dK3KF2a+0qady8FYSb1bZw7dmQftyxEWNtc1Xoxh0Kg5fEPqtFI8/utNrh
+VW282mdYaisqxSS0tymJmdTuprOsnX4MN+Qt9z1t7HGn8+k8CzfBYtsOtRMryX1Hm1jpnJ1Hz23V9JMypx2pYA/anqTE/5jVlXRqd6QmpdC1ZMbKTF9W7ACJ
+Ou5IHULnWYPUEe/tmfZA6hpazMJFoV+n5NUrTTLnefWhzVM3A9S08JP9z9rLU/Xqz8Wo0NzTztDMCr8Xmr5UjUT1zlSTEsWNqjSZyu2qmEwBFm7w6OXqSXgx
+8JlwifvHHEIycTQXbu3SpPeCZW5Ppi21xdJ5vK/UDeEeTM8Wr+BNjj5d0pqCrNKyits+PnnBBA4jn7l3ii9+alJaCa9m01+Mtc5ZZ22ehtMUPDjE6w48KPPa
+7Zo0yStupeTtusNuxT5y96/hVc6wvVVfEc/PLG4DM8lv6QkjM4xwvtD0Y4re2PvndIeazTdqGTXFO4Jza8zf3+U92q6P9fjfZV2lptYhtZryNvo8a5i/Gl/9
+JGldV8j9VE4SXQmfgDT/iq4h1YnMuah9xinfGLeSPbwPSHy39SSbcR9Ny2z2s/PXLuABJver2oma7ii12HwlroWlTi6ohMni/cixsg32p2rOUWvaUKVGUNht
+46dJN+kMAqjW0kdIySDZBKsVO0Elu3SFzVvGmv5VeA3eO3ZEMSn/3e0JNSX23CfBWuPwE6Uqbu87faPaO+ALVlZcQ+3IcXKgtmyaPDq4UUdayGlXpabOHWQt
+0rl5BMU/fLpyvAcZ4TXGQD8KPwF/Bv5Cqd8WljTv9Wj27feWrnrqJUmTh+XCkPBh8tVidytj+98Fb4Z3aX631GZS828YfQbpXbFed6mSqNpne7zHcIZjB/VP
+pgzunwr7+7Ml1g07bObHu7V5T1jg4fx9jm19jOundmfu89qLMXHT4Ydsddxnm/a5+p+vwGvwprjPG+yuX/u0u96e2nk7u5B39AqKhzBOJ2ue94AV9Q7leEOd
+4zdROAw+g2R8BcWee4LVfR98qPHizr+AppnQ9Dqy9za1kOKT5vrB8V6JPjVegYwLmm/CfK5hszNMmNqPCC3j/mbGFvXj3sivBHQNWs3bRitJ9XDzlyL2MKJ7
+UuPwB9sbXPoDxSGzQjR+fHKwUqPxM7De+TMg8Xjw4XhAHvtt8KA8K+RLSm+Rl8INQGgeUv8+I5eVOo0YKPxpuSwJ18NyWG4Bkl6JNqH80E4kh7PcYGe99dYV
+6P4ZqPtfeGyblWB9EnMGNe+SLfQrwQvj6EUSzu6Hh685eYMA8A+yTULsZg6s962AI89VvrruOxpZFgS9H/tHzYvCgznFwl5DV/MhadM2Jdk+Zr4VPdcyHDuX
+5JZLmx2NmCryazvjdATtsHY8WHWu5rxHvvc17dZw3k743Yx/u/YoWp3k3zktXI1LQOc86mgaqY6r+KS2DhGFveFqhD5K02uqeX1pT0DpexEjDqYF99rOF3Ny
++xGZbSCZEDdLKcrtMJA/vdfpiXELPgzfC98HPwg/Aj8Ofxo+Bz8TfenDc3XFQPt5tH8H/es9R3qqX4bNxTaqJrBCzV1F1cddbPf0vhIntX003heiDGVXG0TB
+pnvrjZ18vpnRXgPtMT2fOGI6CbUfzUruhv/TedZ66LMM9yv4/TYjwbH8zLGeYxMRyN8jp7pnFH0yiiY05mhCNGbP86SKrKljHuKT8EOanosvPeautR2InZhm
+KfGuyhvt53j4Bs/0xq2wxhZJOsfjG0Fmvu/uOWtVVw/JG2NMqwf1akbhSm9Dc85JZLA+DlnN0e88DjU55x3yykZ4VfCqG/l56MFiwsnTXDzEa5txGXd7tHJd
+vOtchgkQdprvHN7qnAM8MMF78Zsk/pt3lhnraLC0/KFlzmrU9wrBh6JryRnOH4AfzWsKFdeB8/TnpHHOv1dxjbn9oQt5TbTeyX1jv6b28H5yr6V9HO1YudnN
+m9L8q80flboXfHKTKN9L7Z0S8y28KW3GPXlUmmZfflRKC6h+Q6yvEaP783sMJH3Y0fZS9rAo/fsZzvbhqQyilHybI+KYQSxc9094SiDqWTch7yZn3eQs4vkX
+QdoHzVoLxv33F/MAq997YOrEKxnEGSvJ54elEPUDPyOFyN5IWqx68W0iLmTjyqxTO40FWczGgNQJG6/oSPZ59blCrZAIb1fxHqP/Ej2XIw3hPq8qV+BEgr33
+anJJ1WgXib5JPW6/5suIMroo4LzjTTjuIL3n1bIqWybBvwVcIsWAZy9uCgU+s4a1x1oDDcfM3afiw7MmnIcQ7+2rABi3k8WofhAZvqzgxgu3s5DjG9eeKvIV
+4OQCRg8ED2rkMyK499MkxeOTg/LXJOXD1clqEedllQkCa1/kZFzuseUIZLs0yA49c13JqcROW0+nngcMo72fHzdW4eU5hL0+1Tu4Qv0sDTlp0NiBDbCI3g6m
+h2YRJbyyRM+G+MlZe8oppjJKW5HJmWDQRQ5hpa+XvmZBF4WH5DDE4WRV2xcfnexVHKTAivE+cLL24eQqPPnwJxt+OVMBj5y3LUhvrU8m2/Eaqj3VGmX0GRzs
+2Sgr1CLD6nKLDKKD2zJa9+0yyhA3c5p0hrD2HpzMS+yRaCU57RL6ozf5XPAlFQ7SqTXi1qFgMK56nWIlnRnhJONzdZn0739Z48rk2HUOW8lqnE5wRhHlhbl6
+rmIfllzr9/55XVgmDhcobVhsZ1+nD9ku0kVXEJoMTIvL19RRgeF7RScp8sK099D8OUYbxYI9DiLEvs9CRddnJi4VHhbNTIiXo53da1H0yTodSvy40tow29Y1
+tLtTDnkpEfHk7j2g8JX9pliN+RGVwz7OX5Sjtf1aLDdjFfhxgaznIjlne1TKpovjX3R2nToF2uZjwD7iqa9fU533hH5NzsjaDto9tNauZQVbz/8VuIrl0XS2
+ydIJCXHxFtjcsj7soV/bhhE3/lE3zDlyF6tf1OaVwGD0/4RvNOTxiV3vaMyQNXD/1keq+JCnQ1QFhwq+XxIJVDh/cwNlIH9zNhr62Wfi+H3NczLOKhrAwNov
+HEfqFw4q/cvo1YRNS58hBDYmXebFemv9QhXGLjjk32M9rWHvJVtLRy5yJgxoMHuJjzz/M+uPPrZe4QZh6RV2ZBh9YQjbjhxNUD+36XQ5h7eFJ9ZDPUHW4rBM
+OccPCDzWd+1cEf/JTd6z9+atYT01yrGzImRGOmu7CI7N7UfSX83B74s+oQjYF7iVgPqCPm2td7B3sVztIKizyWhzTrwJ1tnQ6thyZbqD7EydyTVygToE9GNO
+p0SdxifAf37lg0aHxRgQ0a1xJyK/6sSerhN9QqKvxU1x0tCtthfsDOI4dupGTwITFwgPdfrLGz0+0svHwajHZkUsLp1S6/WYJTPnZQeY+SFL7Am13HXiANeL
+BIzHXDSRAPeFkMUTJdSPB1w3IjB+SiBYr+O7+0LIaGWPG3E1Oy6YFRkA6qHRhAYXyV5dpZyZyZ4fi67E+t608Ygx7k7371z2pafvHPanXdejbi9dPUOjl3IY
+3inC+5zWe2sGR023LQM6X7S/G1dLcEm0CfnVunrZ1TYxq7Ad/P/DOd62AXcK3OG2a78If18d7hiojb+wf7UT6jAeT28ZyOs9OY6o7BN+9bZPjqOxkr3eMJuD
+Or7eD9b7ajS9td7Cruj3Hreeb6oNmmKm4/0inooD7NwALVMl79T6nieS9zmHN90DLXxxJ1Rjrp/Tzt10prwMl0BU3Zw2aZ+jxWsox3zY9MtRIzVvrWvCVsf6
+4gjxEc9phze3Pr1Q52Yletb6MxhsSy/uv5Pz2Ssln73ssmH8GdgFlIS2yg/OZ7ecYVns4gxewapPCCPqW6PRH3VtKxAcn2q56Sm69W56mXZKukprxnriITU3
+nTPSU9uQXarY2x063Jjk50pMXUA8jbdQy023vYYm2DKu9BbS2LJC6x5qFy3qHZpzvG0foU7kLpE3MItunWVU8gZij6BepK6Tb9oBoXNyn5JHzdVS+S2VcLQi
+rYdPDvocLrw9haQjDZ6cLO6WsZXl9MublvVLRd/UuJ3lpXluYa/PpSOep8Zej6d+LoHlpA837ae0aRbBT6yAokT5WtrcAZLuqt8rST6lUm8kKyYNJOWB7yh/
+Ld3fyyOP0JjXo5MJ2Vlr19Fbdb3jCMVtdRJfVc9fwjH84dH+8TKps0nbbjsCcePvrfF9HX+lOf0oP34ndn2fkEbMj3uv7Uv8w0la5FLHv3qheljjX6ey4wtI
+rZNmOlqkLxX2t7aR/9Nkx0uGKyIiVyfHJ6nUIvzzvRXKjkeScZ5IXLPHFs5lpRVqKermiPRr56Zp+qVPU0M/1xXMpH9ZyY7PY4LEBxIv2AdRmNkh9rXNjs/g
+BGXHWyYaIzvJx2hvtez4TG5ybKKxQ4yuzNBFwV70s+Nz9qzJjou7xu2Ydo4uNHagn0GeIz0hgyzxiEyBJr9eDjllCqIOXM2J7vxkfAcxQZAtQpNDtjyXm+O7
+HO7uH2dm1BjPaVGeHZWvVW3ADlzBecFEeTWXgQjfLP69xoXGyY6YG0HxZn/1JeJ0WsyJutFJb4BaHnta8DzOcnqkBe26eUxD8qBCTDO9D2Jc42ZENsa37BH+
+xhui5dlJZn1zLq2fw2VOJgt80hgM59hL7ev0CS5xmOtFYhP0L9J7xGKxWVzkeMz1IzKJES0ey/ipD8yJx06mT70ak7nZUZkkRjEuY28D3Krdmea2jc1cPzoT
+eevHZxnh9fFN4rM7cyTdj9Hc3ChNXFMbpyFpi3HanBMsxGpOjdZA4quvm09C3m2Obi7vHToT+b2jYYPGR/QUtvHRDG5wjOR6UZKoYhA/M99f9ma5DfT9pXM5
+0PNLw96lji/1MvemZkdybk4sV52qAcRoDvsWtVsP64Ob/c3AW5DSIX9yiRSNtafOmiIj3Oz2DGqz22K9yFke/ZqbUiZ1//iIsIGc3kPluUXhB/sakaHq9tr8
+3kLtZfY3X8Q1RzM4asIqxkxjNGfwM9rPJ9MUs1fa15+ZXNHwSmSLGFGNMakG6Vl4PvUGEO3RjVl7qYRZ7W4ym6Qw2rdNIzYx9tg4j22aWEe3EGlqVY19UtaX
+elQtbLO6GwBJk+xP6pTYTdRADSCdHcDatS2worW3PmoZokO20ZzyjP2MfU6THVjl2Uh7iXB0PNbOrb1buyGMr0dBq0mbnntljqQAEJ2dSgd3cC7kDKaTuzRH
+YgYQodKW2VpnDTR0Lhdd3XYCVonhbsUizdLQF1HdITQzdrZDNUl0gYjzFsNJuuPeeZ0BKuRdYe1CBRPhJKMcJlkxCYVvdW7qW1I4YGiG8niyOS6XcLWK2Wq8
+M8x31zm0tojLFo997Vo+rc2crsvcYxNZRefmoHRSmov/V86TjvLXzpaA65fPGfZF2G29mqutxOvqEj5furF8+wg9gnbj9KodU6mmEi+NbMoKRsIXEY4xrzN3
+KsizwNWTElCKiIvBWVMOiKkHhJ2P5mS4orrgApH7VVqRSTgR4oqlKBGsqnnaz9jKSpwEnhjZQXJ5rNykIBGMCUKPPSeH6ukcvLXOem+ds9ZiGbUKr/7yTN2i
+2YVt529oqOdG0z0cgDuDkZEzWLjYK0a1Z4kXi1pL46cO2Cm84a7S455FpiJPrJM0EdHaZ1ZyHSvdp/DLAG0PbbNsdlBP6NoRGndjbdnYxscld11FTJzSc5sb
+JWA+BPqX02QXyRpMM0E6p+q8ZFsgMrNL9qJaJ2U3jKDF2HbUmtHenqAmI4ETykc9rR0+ZO0mcJPqRifZKU8gaZ8uoZ7Mbdft0l9b4I556FT7WrpbtH600X6E
+z1OPnkF89MN7PNbO4HO47XcDx2sINhNLuYMym7ki+rzvvaVz8HlHRe1cSDWy3dPBamQ7h+TUlzce7y3TsVzuXMfyhuOzNO2QmoxP6tqxUR5rMU7eeiyqrcqd
+uFJ1cZQgmrOK+4OTi2WT5EbntwKxBIG5AsbKvbzXnuq+/O5SeCEYgrSpHV6iBi4MCbxQFTyAxMsu61GDdA6SDMJETYrGWAqKEoRjMS6q5ekS8iVq1Jm7YJWO
+70VIAh5JFZob6SaR0fR0R/VUl3yQGL7IepQQbgmnar+IaZmy9C7o0s2+2eC6vF5pOm0J93XilhZNMvhicfs3dNHcTH43c48not3I3ZRNXbySnlr+9joOUGRB
+72GhK/lADV3m/wy+5/0MoNC9jDx23feRiAt+T8sD8XooE7tKbyoqddrDRhS7KV1r3NRNr9QpfdzNvaHf9KtRuYhkdTZIf5rMiKxrSg698Rjk3jSKb0M1zKmb
+o10GEO5q1SuFM3JCVL2iSqvtPOMmVEllrOYF2259tXd9+GNOfvAMrE8Zy16GMCaRz6DNE9bYcxd1iQsSWlXdEZ+MDslWncHaBxdjhG6cMWXqfVIb4f2I2G6X
++qwI2cJA8rClFbOFkaCx/kxKF8YHeYHWZXxDuZ7QCdaMoUYXTZGolHeRPHGntyLRksdRRZpzOaJUa/WDE2kuYb+cKUITRTs9ylE76qTTI0QhjnVA7UzFszon
+K3T/az7nRsNJyOpkgddn+kb0bAKMK8fsIpH/MlWZ16xO5ES48/oRb3M6knTydUcH/qIyA2LkBvWWvjF1y+2pNdb67hVPHaT59Ub5zwfJ7aYzHTALcenjIIQ0
+uqHJY7kyqsFb2+Sw8IwG3od6wemgqQucDBqyYKDQqevZb2710cmqLGzsaycQi/MS8uLWIAxp8y7NRnC2yZdeRj00m96Tq6P9Y1WivP1tGjPhvcBAHpjKZTz3
+HSBwEqcy7GXc8AQr4VSvbYeZOn3BWiGPTPPWfC6Edrzy+AcnHTGBIpbBRd6rbSXPdBBpo9ENIm0yqyGTx7quIqO5rktcLNrsNeNkSB3h3EguZ6/bHQjSd6Xn
+njPlXF5EsrjCeqQieUid9payVJqrLomm9D93RC622ZOaLU6abrtOeJjsGnOaE9ZngPnQVZ8jEx0oFrH2zK9dcHPF6B4bx7Dav9MoDrsGa50PjijfolysWkYn
+eHo/GrpJJ+7tx94T+7HS5ERQWPbUW6/RjfpxpOQGvwZri5asdC9rWmLGolGcZHUecvFGu/oap8QZGjg3XHygKS5ywcbaaSyQko2Wi8rDeEdwXpj5RHM4iSQn
+WCllGg0zD3RnirxBc63nmyUi7OOMYSHixvbng+Q1XUR7HOvxUI5VqsdbzpE0jbB0JlM9nnD4Lpfc6kWxe7SglUoX3Pm6KQTNsIF2IhNJsI7aFXXFi0VLtWLj
+RWlIU3lr7TrmNKaUVWwefFYcrAuIjwfh0cLHEfXlJgVX9JcJmbSonIxtOUpqrEgJxsr4MDykMLUr6jOGop0pIKLVdDkJio1LCsuK6rfbPQ0ncVW0XJcnWdMx
+ti4o+u54WuNpnEXyM1hrNV8rs0sdffG6rDEWczllWqO/v1x1MFaxT/OAmO1Nl2BTnXHq5qiLwU3qibFXcJ2Ngvw92u+cO50jI7Z2OG92Mnqb3huwOWBqbekO
+mSjAo7yONkjThLqKwPsB1Pu/j/ZBjHuOVPK0x7/KRbr4sayZCIDeraUcfbDe5bL4a95XFH953F44ugNAeNhralYQD03uV+Uk5351VlL2lzOTZ+45gt1AGidl
+gBNXSm/yJhKxcXdoM3Jhn+Ie1a5IA+fvEtmvPc5dP0IyfakJOWD5JtmUBaZHCc2H7GqX+MfhZB74bd+MtNKVi5Qo2kF5olarHAR2lgdHJ9u15SgPH8ENYF3C
+C2sZo3FPLYlTXMHQRapcmxzvH5809EreSBnkVVJHOHm0g3JHjRY5KNmjgxQhiQsNKtEZSOtdg2+Xi5JIxqL17rJ6M649DsK1PanZYU3MdjxyMUvbTZwYQNRx
+7uQBLVdwPDeDEuRuJ3IoYhaFvhvxV6MTnkeJHJnZ2ZT4IDKdUJFTKiinEl/2npI1hj6HbqlKa1ZyRUivwC6pD3yBvuZrFYKc18NGk7E6Qf0y0VLBBJdFecmL
+ceadWMsfdJmZpb+6qssJmR1cN1lnxYVdyrXtwmbEyvZmL2wpuhZz2uj7SD919JKmkgwI38cya7waWQfViXAGlLQtpnYB0WLWhtVrp5fReAfNZP44o5ve9qNa
+UjLI8U/xH2v5XHp2wi5exR0m2TaWimdhJ1HFs3SHmmz11Ul951RNR1/IxVxxOzm+6Lj5es12VZqzpMK5yvci4eNKmzOfr8Mm1Bd68kYMYLlfpVaXtUerlc37
+RtBVa0dVVVdLeXLPqP5kGftOhfW+maMzJ9QlZYXoLPROlnMFS+XpKust6c3KWGvPxn+afOIGu9LqlpPYf1PTLUm/KLolHniqynTfhveuV30byB3rx8MuOVWu
+Fvc7J+uQXXrGNJypsrdNZ09l/U7Z20to7pHy4js/W8CyFrJJHDwaAUjqwnbJHLIHSbVFnvinBsppop+YN8m1WbkkisXols23a63ZrOi8k5MTiLMZcHmmGNLw
+2zOicjUml2qxMuXL6myu8uoSY2CzQfTLa58mFL4fKFM5Jy/NBYWZWGbUz1ab17CYmtNwX4glw6k7bHJSSj+Ypo+uo7P22n6Teb4lqTfIi1T6rshzohYuD9Lj
+vcPjuW57adbus9PTFrzaL3zh+Op4P8trBYct9rNUGZB6WWpcGMB1nq7T80XrPVN6vsxhJ/d6Udmx7cg+cZ5YnK0Up+AgdF9/ttJiFo90rtLMqUrtWaXY9Yo1
+Q71eVG5c1Uq9ARmNfCg+vVZlNf1depRLZrA/DIMTl3qikDMj9KrU7xDvhSLtgm0HEs7ug8I6zcyfeXGOSRe+yz2bcjFMz7dAUl31c5gzep4IrNG5idJ5vo6k
+2OtNqcuL96WUJIS7Uvb4udKcNKHvic5J7XciySL+4doTv0jnC0IN5M04CfcwzY68UeXBZHJ8dDJYa7y3Nvcjd866rFTitEhvq2ZxDmAL4bWuwMHYZdFEamkm
+1KEo/2QpBrADOGvX445nkRtn12nQvYE1pNTl+Mutx9VbC5juTuj8+TzG3CTyIVUtko8+pUQ8OZQ+Uj/1BhD5HC+h2YzPwIvwPZNzGeeIpJ3OuHYqg1TFG5CE
+hWS0G16q6gvVcm8GQ7nztsyFt1VMKt3LIbP7UjvfdwZtNNdXoo/KEhsGGCY18nDM5wzP2ZS93LeAeAoCQ8l76LG1LkN/S91ovTd5ruAkR9dNtNHy1QnG2bhJ
+8g39POduFv1qrxUugqHusRGNdYmTfOn9faPkk8sM4ck94pgxZ9fSmfHYalt5j07Tb6wHgCsqb7k26pPn4LJrx0drLd63TouYiZUgK07w0fncpW6ic9awFGy6
+osLbBmYih+O/Ts7iaC1vwFs9231jlv4gVlxRIdiMK5oE29KnQ/0T5au1oXfQMMIhskFMqYvEjCOW1ON5g8W+ZZ/maTiKO7TsWVM+cG8A25jVOofaQGNX8bQw
+wsNoY5+Go2LndFYO2eTBbGkxI+UKJktLtgFPHCQ8jHr8EE5aW6IwwoRBLYrETp0Sj/lBEtkmexIniHd2JWvqRX9bEMC22RYnDR1kfIx3+M4UH/r0Qc4Nmvki
+s9MOHYRGj9d801tVQypnd2ICYdSIZL/47EGbB2RJenygjF5R+My9eD4APzTF8d65phA265gYP9hblDJ+kOZZFyjyeKt3PvZmTCKkp8SKIwiNa9nlMwiJPl3C
+u6I+PSTzBhfTEYoco9gyYdBYFqaQOGW05O8qFg9N2JoRqzg1WskjtbwUrrB45RrtETCLDRSzOD1qEbgQYpYj+PDcmGWSLy1ucXMjF4llFkM8Acet3p8pNBpH
+uE4kITGyzc5N7qcwizr11Z3urUtnp7rruw0PL5d+BLP40P02N8NzE5grrhtsEf/oXfDqJh7SqFqux+c3zVNSXKU6KS5eb2QTo6/y4lxvpUyMW54c7/e8Fc1d
+SXPivLWUj+yvvL6Jx4InsyWGprwWzW1J09gSX9SPvTljl7bT2JB2Z+Jzersv0X21je/6fNd35RPYJK/VpyoC4o0Anr0m+avPo9oYjfx2oRvRAZYRt67UAWPi
+hSr3T1+au9uYsLbHkby4wZmBHUZ/tPZCnmPZzW7U+VEszyEkODSGqr7KI2oEf/mFOf7yUjsKubu8cZOkBR/55fk+ss5BLs3qucaFiQvkLbb6mndme5jN5KyY
+21CTGq7rChuod0X2E7txxLI7dYpf2zYBoPmHeaxTc16Op/MA03eL5QGE68WyAOI1Z3buJry4gQYdX38FLZpGLemKNA5X4mf56dA9eUY2oF7qEJEr2YAMHRPS
+AXlj4tyX1sY+P8vGbjM+FBsbD7FkYx3lorWxL83PCgjM6PY1stRTv5WnHUHXzcsO9LeptAeXtqnRexo/Uf9tkiWY4Kq0B1fZorqwMHZRib/vnCNTwPdyIlOQ
+fjetHzOzFxQduXk03ZWmEE1LYuXqsu71gvng05qJjhPQPDzBx1v7Miyg9bdenOdvlYEKmsflZJ/LpwlEjc91PPv2k3kOfb/LaY5OHi0h5QNfmuXfEC4UH8d1
+vBxTWRD8nJMN/JwuJ3WKTu+GI2amc2s/8M7k1ujUl/mZNZ/3dU5mra5L9pXe2NhX6klbnPiiqgAovPH4+5WNb3/HO3Gyf7L2bGJI0EGxj1icQXkTzRSY4iYN
+FRgcZ8F6H/6eUc96x5U5gjdTf+yD3Hv6LTPqAULqZC/1XS7UcGxjT70JdA2QFOypp/UGrszzvkmnCnRJl+kCDeEyW6ChDIRm7KsnddifJIwnDQj0S2N9gYOd
+hn47V22aB+EFfQESL+QFXeJoDfUFe5udgdzjeYKbMp1FOgbrhOEwtk+c0859nWfQRh6WxkGwSh0WytM5iS/HKr6sbe+g7vX9vWnQHqmLfcOYRyPKrLg5pyUG
+hV0mnzL3aYaMJLy9JKkWaWgVpsZ/H5+XRepR/noX8zHBJ0eAqHcr//LKHd8mWJBxB9+a0Wl/kjfWcX+COYW9bYJjSDLrYBim+WoQDTJr1nX52kK9VMZpAK8C
+VOqHuDpg4i0psjKAWPQWfuOtdantPzLE6U1pm/HxQpi3xDlJnbxDZp6f9vSy5Nc+8xHOM3YMbIzTIy82du52WCdEC7XNeosftFnA+XzxnrQac0RZi+zBTiOj
+kBHfiBuiN3vCKpVHHXlZqkMvknqYJTyGKnwyVmI2p0IlUkd6jtYj6UI8zb/x+J09y3PMfgRuN5Kp9CLUlSxzUG2X2/w2hHl9Ht7D72n/lYi1mK+3VXwoygw1
+j0WokTwA3M/2m0Yo74cPwcfhJ0qUgniVNNpyg9rs2hV8EYcthaWQ4zBVDpBPiBeOBisJyL3CUQ6D2uIPhehlYn2LGT3OB9suRelwbqy8AN7gnL7lxLNztNHZ
+OYxplnKgheBGPS6L5j0x+vv4xX41IbhDlOksTFCtXpBrjUiclOeM/vdxT6d3GRH0OeeH6nKBoZ3mLN2inu9hNwbI6I5BkkjOfEhnZM2gNxIvUQ/O5IXjTTSO
+At0OSxhywrDNVLe8IdkSMUoVcSjYjqgGZEJBJCgKxSZSnfDGLPmpOkGRYpPZmFIDA5B8wnj7bqNe5CFvsRQSCzSpcAbWOxtgZvn228hQ9JgSmgLdf1QnlPtt
+pDqhuN6DuRmG1ARdyTHoWQacYxhx2O9qcgwH8zINIgM11yBFIrts3ajGZB5pOXITOZFjt170lnPcuXf187gn0Z3JDFNqWN06DjZNzmtSTLAgfaqfF3pU31GT
+f7RbdfvkmHuJ49QesBzTiMW/2fQe6tDEnaulbbcxnY9JLkjv6pe0vtWmS5R3sGbXH/WvtnzFW6yP9bsneljrq1e7WVsNM6LtAZ/rKaBGcD9rnaVlk/Mqna0J
+V21Ta570Be+xrK6kGjDW17ojnJBtqh2uCfm1N6hJk0ia5bsKViT38+lQZhmv9nTWhFd+c6ElPgJWpNrXjMd4nfdo6lwTOePVMkYTXl5gDiW8WB9whquYISdR
+d7ZMye21JMbGf9j40K9upDVNaY6dmkV6yY0+A1WLnqYes+VdyKnvQj889S60PGf34RBrzYmySudha+3ABPq277YeZnmgUc7vOZeky/NwR97eWDsp8djVV4mh
+5nJ1OMWLyoZLQdQ26Xd4kOtW5twH1J0hcGCts01WzCsX09ZmoYiHbFPftYFVzeFT7RTK2UBdQpl5taewwPQvJyt7ovUJnWaj6Rjq5CBuAEEs0f3fYXtyI9ep
+zNCdLIprOShBnLgtrItoy8vtXKMyj5c2ihM5SkGczhLuJlpiOMdsTKlPWczgTo/iWg55ECfu3dueNhWt/mns13nU9uic9opzI0Cr+MWqb7zF+mS+KPbInOOr
+RvKKt9r4q1vMXz3RemV2PNZx6ftt10zqtXrf8VvX4ByQefZLdotS38y+RxbL3msHTcu9srXvO2Ym8LFIvQ5jb51n4zk4nLP37YO7s9b393uk8djYE9Wc6JFg
+iYMDjTYEVh/Zt1h/Q9p7dSLm5cRog0Py6LbFepHdaDXyvBf1liZtdCi+pUUZxt5HJ3NeqjkV9EzdeyGHhmZ+I5/9Qi1Rzs/TE4/TF1gfqqeqrSm9Bjd7lebM
+8CfpzoN009cxdnjN7/Yb5jQ4I1I6Q01l1DzGIvYqn85la/U5IjJmYVvs8HNz8BdqjbC3MvIi9hWScBfPzqgDHFel1P+JtX9jJyxKazxVK7hBe1pM+E+pn0VD
+0K4lBM0g4I9f2AjNs+zWGKrQnQFEXMfxxriOwMM0pmMK0ZEy3G2944159Y5aXfME4HkQ6xtvb1LfqNYuT4KbB1DxG8cb4zey/9fDbkyBmIcOvvd481pE6bo3
+mfoOolc4DyUTsyleI9+VPlaji9TIyki/r5voCEVPpMaLCmkkj+gT5pNC+o2qZwZ1g8qdnyhKwvrxz278oz0puQ8UhH5ngPo/1e5iR/BueCtanNwNalvOq0Se
+lpHpo+1wbMY0y/5xsMXOW3vajMiPyaB4nr1DPaNYca0B65zzFrYTnzGfdwNeB9v2IUlVqkVW+xHserLK/0+D07Y2kDz1yP6abIxj5kDqR5JEy3DblcfYZ+pd
+YNVOU/NZjS/DXUZ7jagQq1EnQNOT6sPwmY27Us3nX8B8++nFzGhaRVZWZR97OB3Dh8ptFjs4bbQBNZcCfdb1lk7SPjB+vx9+6J3ieS9658LbgukL3+SIdsY6
+WHsm2Gnu6Fglcxe3NGeR+te018mq4TbHOy2vY4+mu+QVVZ5Ncax3vdIFfBGA9Jh6IVUhfUzrLbXBeWmr0c66R91NN8hCCyGtsuD+zjp+AH4Yfgz+FPz03a5o
+ObuHlu+vc7NeWtKibX0KgAvCHflA3MG7OHtShd7ECZzRdYutxTlnib+Ae5GO7xjvTxEqamplzuMzuEB3htMwWNwJaw1d3yH6nk/AS/DusaOJSadrQ4+h6Ztl
+c1gkOg8046B6D7SZVuV39COejfwiP2ITtu+IPbY0pr3evgIxzftuVX5HWzZOA/14a8c2YrrTj8vABO9SfIqZx5LOdqCejTF7/5HNT0e2VIu5x6PXy0s0WqyJ
+Fud71COfPB/fnX5fHe713l+KAaMLoLZshTolop5a5m4smNBTy2tHZ7pLmGjMWEOt+9U1jZNxR+/ux+DvOd/qlufuI6atedOOYhOGbWh6vPF79Xre3Y3XPqfn
+mK6Gp7uPCXaOKGRs8/BcjHfDm/ABqSvZ6pyRsp8XK9feZYrBa2LRa/AKfLDbRexc8aibEZHapiMUiYSwP5Q9wY8EPxDukk3VJ3IbeUVd/otbNCuPklp8nC+P
+Yq31Y55nKo8SvSHrZvtC9hy+UNOHqe8FCS2ZsJHgzZla/8eSV4JNWOU9m5zOqNy+CTHqSu8Z7u986O78Hbm/k4E+rxOOTpWn5OO8//w+znJ629X+S6JrM6Be
+Q5Jf85G79Wvmcaz0a1LcGcR09GWcYPc/+c74MqSn0xmoFn2ivZPow6BGT3P8l5/+lvovdZ3n8Fz87EUjvwUtX/VZzuNX9/tf6adwuheWYH5KVyyAy4odIhH6
+HdRs6lwRemo78/Z8exT7U6khOlxUZf9OeYy8r858j1HosNPZBCO+rWh+I2mvsTq3Z+Dn+Aa2tOLQ/cY5OuAvfCt1QNuv5Tx6wG2uCWoPl4sRTzR4NDn1yVBD
+9mbGFu0f5/R8XtSs7Vq4tQso9jpoR96t8Tf575T7gmvID0MP01fhtXiyTIN+POblIifHufZqSUpoTg7eMEfjf4BarZxhyK499Sjbcga+QLQot6lSyyDhU9xb
+xEoSvpsuKT32txW+aQMVwvzaUtYrzKnl32t8x9rcigL+aA9neQ7m2yYsE2ugWMzOKi6xM4Pr6j8499TkWjOz0bFZ2zTyFmYdnlqbNli6D3wNeMbG926yBjQz
+d+OVJCd9g6XUCbtoMVcYRqUiQLO+/UHenaW7qqYWTlkZadxCFoeGj59Bc86UM1aL59hlv685by8h7PzH4FOz90uqrdts1/jIXzdPiUEdCizdo9yHpb5efWZu
+R5ZzaAe1WcsMBVF/+UlFcan4AKP9i7NXn4WbwSP7cKgajradN1DZ3sgWDm7k+gwGS4tEFINovSUtVhS7eInNsbpV7IuNcWTsREO7XtzAfX2odVxoW7H2mfeY
+NigBlV1DayG9Rw0w/ISVvIT6s+yFbjr11nyisTYz16NZHAHu3y5KNzrawvB52kN9NGK1A+2i8bHg5f8o/AT8JPw0/EzBTsxc2WKWX6lpeW/VrXSahymIoyOK
+UxDCTqvet7Em5zl4Ht4F74v7ze7bHut1Ys576+gz68S1GyxujrIG+fYBPMT2eo9MtX4Zvg9+EH4Efhz+LPx5+NkSR4SdNvNzBLWlCDkOxRsf/5NVOBzH+4dk
+s2M3FeP6zx/JPpSNNvVv8HavvZhQyL1JLP/l8/3OsW6c/ps17Gj5v0fc74132a992mVvT+3sHV6oO3sJ1W2PMdFzjeeeup2cHDTGrV7VO2QtrSqqLVAsNmrj
+GviNSx1R4qUjtizizMn9qnWee6EPUs97v6sFCLp0Yh1UkfYXAnlmd65pfypVOVMvvrsCiswzU+YNc5/H+VrFvBngbm+tzp5ax62Egcae/OQ6mlnAm60mjQie
+vxxc8X0Giq876rzaaSvnTX6k7Y7xjnm8tXHG5j7vfK83z0bOfTVqRusVeA3eh1GFh/os4l5yJLbeMCAMIW5yWWe0kVijry6Qnhw8O9J259g0UKdtO7oOeNvI
+Q4nPL7KeHs+HHr3vjnlbAYd8vsxIahUwKzFSkcoKy0jOWlZE6BByrqxI0zpkMuphoMwZuR0chVd9yjpnqHe2l0vALTUo405IJXAsJ76b58mJIBTyXeZE5NOj
+pURwy4lZKZGZOYM569lEg4qrmlKf8uIU5Xm5+HyxF2P1id+E74GPce25VxpqnON9JrZhyF0X1m6uMj2D2BKiV1fyEOnPkddQT9yHShz3F+Av1ncCbZcW5+zV
+cUeN5s6YYnBzungwzTE0uzrR1sMLvoANMqroUZIj6spEOLnLuRLgToBnL4rKogcrrTj1j1eWyc40jvReg/fCh9ozTQKZc53n09mewRD6mqy1o3yJ9ETocF47
+JJzDo8ltE9xcl2bKrRn5Hm/p4BNWOnP/IrwCr1dE//l4Hfwkn7g6XOEP1UJQ/fDeqLvv6nXhbc9PeOdpYYOHhSsMg575Zpm3g/3JFWyUhX/bb5yCn5+Av6/Z
+i1uSb3D4zlnRc4QgM8OPnDMB7dxH3ME5qxlO7SyswWITjMGb56+rmypQEKH2TUVdAd239XTvOXc9XcXi9yrp+nV0GaDf1tG9/+7q6ITeR70auhlQgUHDCLx1
+F/Vz/e1V8O4qKGAArW7uA3ddNzfJqYiP7wAAhl6d1cfeoTqrKa6nsOtKPXhzFsbsxx34GHz/FJL9PGeY4Nu7d60DdW8Pc+V/N+jPk1BtrU5qWJyPe+DTG/oL
+oEDfLv94ftlleJEi1G+kLuUHqTsL24rjNBHcWu+tZ12sKgC9TJbIvsEW6QM/1tq0WD3xtTdjxrxNTZvw662n4HJiVtaOzhKqmPIXS659jyPKRRaCyZLot5hx
+wkHpm82w4h/cHCku8iWgwq3KZXtGJKGxOeDX4WVqTfZmMYathxM54tjoRm7b7Lzcyn1IpqnvZf9X3LIG6EwPjUcAZ87DS3C8CQ8oE6RwwgHMAisZuHyBzHrD
+GTYZ4yvfpRahvJb2Bw9ypCBda5sqNmut57Pols2Uh6LhZ8pvNvJYOvFuHuJYXoyt2YnS/wWfw5fyRJVZ65Bsq3waVDBxw2bu0Y3P52hHb8D7elb0cObdJVbz
+DCRmG3spXmNsZ56NPRNH3ibsC4Y+D9baxsy4SNJYZmUCnKsgmYH25Rq76L0Sd+3OhKVhsDjDKoY8xSR7weZQKDLr1xX4QPZHZUdCHTNerKVmyNO4imGMaa+e
+G7zT9t6EbDQ0sZN5KiqeMUVEQ3oaxfzRcX+X9hSUMNumBhvsZTW/sD1+xvv++jQ/Ovq35Yphfr2m8hljVP/XnND7qla9M1frCwhXy/ZQAfN6Wf0z4OoVhdea
+3/7haa73zo3WpWtxs0C6fsoUsCXCLju3h3k3umuagcJlJ8ap4FsvmQWiebD+XeZJpRRlu5jn7Sv+ftG6MYfDXH7md19D08v3JFjqhO/tdO/bJnypo15k63sf
+wUcLDxO+9wzeNP/bzfXAFb6pD/6ENG19tvCoH+46nrjMDPYxslcH80VEfXHX8cZF8sQdh92Gl+PgX27Cj+qPuTkemchkccmov/PiTH9nOwHJBF8nXHPJ1XHR
+y7Gtj/PihI/DUceid5OxxtQ4WQYxbvwaOo9cY4GjiYEzgDDEnqrhRJn7MS/P92NklLCTWJD9F1vwy9xvuT3Pb1lqoqd4X8VdGUCkP/opx5v4KT0uMIZXdU8G
+gvmU36te39w3ISjdNdsXEMG5ik+CQKnT/sj3v2P+CEHfzvRE/NRikB+ClqX4IK9v7oMY/TQoWFrR+UAQWthiuvBoQ22Yp6RzjQjW5iHpmlKMMNnGl38mvHPN
+igAZzFWLADOmVQoBOZJ1u9GV86LAbcaLEgXGayGGgZSR7UZ33jmP9jTNK5FTeOqqUSPMF6z6dG4k2N+uGgnK+9Xo1sLUjqBjN4sGJznL0WCHNapvM3MXFZ32
++rkiQr6vUxFh+t0M9Zv5vaDoqvNETH2pOh183tVa9SxSP+6VmZrLEBi66s2J/twZFFi54Fe9a65fVSHfmmflFN/K+SH8gxm28ofeKVvZotQ3sJdutsWsmPQt
+tq/vPtfO9iyT02wT2uABknlqbMK75tuEIraRG80muI5VyBtuXCwkkO9nef/eLKOB93X2HXX9WwppG018Rc7zSiP24UWAVXp1NXN2FCFc2TZa762xwg6SOSYX
+6xsmxqKqUsoTWiJVesx9HlRq+R7lWfNbDd5fRvlr5AmcnxKn6H0v5SEwXr+D0u8R53B8zoM6CRm/BVLM/UzJ16kuEuW1L5NdJPHb5i2Q1odvwAOe8qJxkie9
+qKzQt0AJ8y4g3dV9kae+SMw5NvlFYLCMPXbNbEbyZjaLM3EKjHh1pLm2Im9oPmOd5f8an3FeYdjTVRBlOr5YDJEZyUhrz7XJNsqNjnf6SXh30f2GM5WAu90c
+aZ17bnyqIOSZ0rxzCV7r9Df3eN8P4I3ejZ/FmDAHXeKPagCFwQts/2rVE2zMmdTZsCdBHgjbDqvlwDVz9d+VZoIS9NqU98Fn6wuOR2JGehJV5uvj+HPetRRn
+7Dcvf9qQ/TzVns/Yf1mY97sJM8L5anjqmBcnz7c/rFNeZmksZd6+lN6bsjULy+YRVXvz+ib86DP3xXTftN2pdZIXFftTYs/FPK9ocvY+M0KTw/eF8fsXmn19
+KXN6fmtkuhKdNYBfGMGPfNrxhr6szuDnCoM4tngOf9YU4iB+rCuwrhrp35/6JyQE8UHG0hv6VsZcrEI6yoN5WN57iwvSqYOV57RlPclxL5MefQG+tgUjZWyu
+4NMvGMZVRraqhwNDWFkGjCBWG592q8GpSujUCY/+7aDVRIfexjdbVo9DcaYddGnfm6cwUp7VoqhRNabImkNAh2rU+WR1uvQzYKPV2XXr+vEIDTnXjxekX914
+x9xmwY2f4cfP4Ko3Yd113HgvMUjc+K4fP4czfcq667vxTuNt/KetT/VaO2d70qdqZ60LbhUZts6Vx6lP49bZvHUZz/kO5czkietUnLNnrlPxgpQ6q0PXSXyQ
+5f7ec0u+nb0uyJ8NX9d2II1fR/Ots48bEERYrxZG9Scgyl/C6azBAH/iSAyWhOrIZpvCcQsYp5m7MJ1ciwc2keDhpNw6IvM8HnDF/hxtZIHaSe22MX9kVDt1
+u8VZ7VIsMEPPqNPaBY7ouHYhFKAz0nEcMIMTNoHTS1ywie3stPCR7Q0/NQ6YyY8ytd02T5p+gi0ytr2Z2y7FADM4nJjcTrn02uh2WZGV2e0Xmj3FMcAMLjsT
+PUVpthGA1zgd/+ng6Yz52P/mVW3G/GRuuwCmFVe44w43s+bfJc2af2uY45qC9Vb1Tb2Q6N1i/umd3rx5lb4ycZ6/QPien7q27cx5qgnizPmeIOSh88xf5UPn
+G5c1Tp3HvhjtjYRO8PH45+7WaA5Zs1OKR9bxyfJc/PhueBPPxZ9xXoWHmPBPps5o9LeeqxjsyReYt738/KI+vkA7J/85cicO58Rpg3YTGNVmVv4LrUWc/9oi
+0M25MCvcv22hjmtSsmRuvvq80n1c4XRLVHi4UTTWeVLpP6hcUPsWkG4FG0dgE88o3UeUXaXvwKRctEs+/XSiP5wAkDn6z8b7PfPlXH4r1wA8C6Gm9ea8mla9
+VstbpZpVm6n/DLnjXYzOUqu1EOssDCTcPp2rP+4umavfq1Crk/WtQNSuZdDN0NbN3t4M/9Ot9/K2h/jh87oj/RD98F4FM9BZbMS+5MrqlWcIojWI+KNbM+t4
+O7VmU4CjQcIZvbRR/W6vnmwaWDRAr2735Bx1u2XifrdmdxJFNOh1rSfnqGuVpST2LlDhNPl9yKfaodw55VFYBY4CjibL4w2Te7ms7gSttFp4b1NHoLQVBgJp
+a1Kezp+BtdY6aw00eZYHQ2/b4OFk5OYi3oQblWSkFPK3C+9S3iH8ipRGmgOEm+jXPpx/H3fHOgvNW/S1pP3SnLuEjt+W6dng4bjYe5xQjI+Bg63j1AvF9q35
+idbTyetVCRddNDiZfoz2bZnJi+jvkFxW7MN5A0uZd1ESxLxO1/3UK7I+K/G8wdQx7dw36zan3fYRVDgYKU6xEG8+ZuIiwTXjyTrHGUNcuGF3/VDmx1kXX83L
+hV8DY8pam3ejNAFi+0Lf+PcwV0g+wl2/0ZUSvfKasNYpIEeXo9773C/pSTgEMMJ85vbuD946a514+xcErXzqkAqgdYEjcm3EEtFb36CUj8myIQvfpHc4tNqq
+AAxCC65dWjGtFYhze2rHDI5KNrpWiMdNOgUG1n7hMDp5bRt9ZEsMdMArzxbzhIC9FUUWyEuQpYFrwKPmj3eVaYc+J8ZhRSGwYaiiUPaF1gQsYT/dC1FbTHOE
+FIfKElIcMlO0Dr32FHsho38OFe0xsXeeaBKVS/JeoTF5v8Jj9PbGvOX7MwLocNYrzOw1rNW3mLWbXlFdmVPXhut2L1LprwQtOY9zw6PDM1C5zfpSPbNZf2Yr
+83S2sxWFvN3znnT/CQr82BEHqvoWV9JkgFDJgPGhUz6UU7yoGGOtwZ1B40Yh2zWu9WqyXLfgDqe+kf1ycyxY4WttGxOm+q4ZaqVL340fdaLvGi2XlX3XaruC
+L3kYI/Sstg/zudvfVqzWYK11a1/X50bKKapLfePj4kR6MXK/bqbo2VNvRXIleOfkEL3d1CHpRo3cJxcYd896jahde5FqaxMbf3kGbWoNBfrVFjYMtHYwrJzb
+QY06tYANaWb/GPXW7hUb3Nq9HgfI4iksYEe52YOLLE+YtW3xmTVbp+6JE/xlaV+IjWsYm7Zvb4x9ATe3byrf05attwpm15r1yDYtSHnV0ZydnReUp8Bg0ZuM
+od1Gf6IogM+AFbRowqyedhVpRK2SOGCn0W1SJHDI4XCdSMCZ8W+lUICA4ESfk/CQooHFPB5sCqxEHnAbD5mJnUb3qRHBJCNtRNDy06hBxtCuoAvFqKDPTRsV
+cFZktdjs0a6gHzuRwTRXLDKQ2WpVJWfskqKXDqejg4l91KIDYS8l1ckYvajom0kvekKSuhfd8sl0j3APZT+aQBO3+56cV3y5ik2U/GhbagEehZvx1o0BA8Ig
+agm6sTYouqNr2+QAfJjvNdS8K/fn4nlZwUuRJr/pK4IyXM3wdZzq7SRoYatv8rkN3ha/3T0OBH/H9TwexoEU6wedJ9/oKU643+P6ng9nRvN7bsGd/i3u7pHq
++7iu98OYm/Z93jf25t3c9+nyPtP/cTM9ILYm2f8J0u75PxOnQPOBnOIFUaZ2G/3zBDwLL3ANNMMTcrovxEHSp1QfDWmmuIOL8HBFR4SGHXmlC7cOT//eno5F
+l86aoE6dN96mOpU0s2J8W3kMo0C28wtj/Np+xWo6O1ZUhD+twVjrnE1dPpwz1lry3Z343Qahi79L52vH7yIoroH8WfTd8Z3kaQ0rxT7ezr/ONBAWqlDZQjIZ
+d/dxLJWr5b2Ti8XF6g4iFltHQ5QlkO+PZ/kZ/n38rilQCdq8JZOHHxVCu4VOfdN4Cp7Pr5lFVu10iUq1gmBRQZaBKr8ouzK10tUjADuFfn27uInXasQ3Sr5i
+A231FV78OkPiM+Uh6UCAh+HxOPsy1ILlO5Hm7IdU24hbjTURa+vPYOG8tc56Ny7LemsgvUnS/n0XQ87okKylYCxTLm0PCTEioxY5i+ZTAivybyBiJtf8Dm2R
+d8hAr0EoVrIrJDgESlzE45EoYkBiplv2a4u8QT4Fz6u3SyHKwYecNrlslPh28wb4nCzd4nXI4s1vkGcgy9gLF3KX0X4ivPy/otBXOjzIvFAj40SeTnvn92nt
+/OKuwPQMD866QKCc4sGRTsA0Por38ja8lrLQnZu5JGNyF2jVdly04etNNp4e9zWqJchTbc/KORiKzhgz1fraF7B2+N6CZetdWFLfsx9PlGYtws52z/LglZM8
+JAhY1e/VLj8Aj1a7nP5g1tlbb5l5ZiYahlhrNni4CI/gKmiDJVMRf/FTqbjZQJQKAJgi22DnD9JiE7oPQfwWziV7Caeu4PmMs8Zaa13G8QFQO38/XG+qo/ca
+K5/m4lEjHyt7kwQrn08TTJ7AcLHv41capqOBR2xbn/Ynff9atO3NhyP0LpZx8q/a8NThkTSC8V0774k8Hg4dVoWvHx+dbLNiTUKDoOYyDecIVA7Z1kWZ9Vws
+u0BRKcqkhDkirhBfO4yD2yZ39MlY/yhTFYsvKVGhtprQLfXURWftJa2YbsF2Aa/t4fuQR7w5a8/AZlURL4VLQwlduRsLhP+5BgflHO/FCv/xFI+XlxqeENIX
+FJmp6iG8lnhv3eDXYLH/OiCMz01Wb8iwbESeWCPZSNFFaqnwMQJ5CkDNkL4acW5gjJ2O5Khp2aPehEsCB2ywn7FC3+OLwdo8J+3eAdLC0h56Y4MfKmxjxLut
+y2buNGt+ScuVL+iqj8iix9itWbi13lpbFz74Zu3GWWvH/yjVtuKz+wA8jlffXfMZ0OUOjq6zntmH4bFcfdfcQToAzKM9M2juF77s3huwC1ojG3FxD8NTjYYX
+jGcFwRni/xX82wAj4A3bzAW6F0+3llpalwBzaxZXLkZdnmlqnF16Fde09S5rZ0GpIAhboeEb3Bqm9WSp4ZZptRC1hmKBp1GSFJO2izBp2UbchHdlPJpAXYef
+UQ5cizyrx8dyvNkOkfOTmYvZFsMoUpBgZYQNBCZLNn/w/AbGP4jlQIgx4koxd4r4Pg/BExIyTPZ/wAaLwT0g7AMNyAd6juC+uNAOkvEgMC9sYiPey/vGFzLW
+MR9OvQUR3kUwXIyEdWHqtBcdo4jaMmnOqIP7xx04WGIvLst6cKc27KSznuSRFnAJ9iPeQ8pMBdAUTUvxpBTer/AttF9G2KcmFYUMOc5DBS+fo5LUDBT5bo4j
+0JdxfijmcB+JqxYzT2/7Ju0kJp22WC7o8fpNJdsUvsxSTXKiaYfwewXltKYzPONt6KR32uROefvBczsfGW/fZK51DUKSddFmVnkNzcPjaljNTv9teRQcSNU6
+sVujUlt6KSDPn67nki5IeEsSarxDEmt0PEgJafOOhOt0mhrSyXditj5eNTr06kX34UCuF+2/BzOSQoXo0K8NfSwgWdTa0Il3X5E8qwYdmjrQ9o0ovd/160Ab
++StvQ72nXcaQ/IYSuOm9oSiSkd9O5BfcZmtSPiO+neyn/m+57c4yz10cillf24VN70nOrstQxayDc74yarT8v+Uhx4cYn9SLXbuSic012d4RNAj+9mjbH0d9
+aDmJ2oW8+X4t5EgUTr0ByvdVWHHUmbgGfAMbOvX2oaXQfO5I52ZjpXrSwoiyVnJeoZoFuNXgWZTdKZqAi29Nh6EUChVEAkLO+qBHB918iVoZP9iSK4kXPHPl
+gYLGuFXeVCTK0pRBRv6snTBIpJr/j7Dm+xQeWMeJCW6mJwlyEU2NERQ5tvVpl+KMLqf3qMOIMBR5FafTtDsnDqYhrIysE71znesdklBHumew1lnrsvYZLB2q
+t0B29aHk9eT/Lbe1CXp1CQMbnxcY9+3UvAWpPXlG1EfyhDxEC0FQ68Ud0xRkLB5Z01XuJxCCvSl4Al2qMYxH69tqMC5UkuqUu0rGNDPu4ikQR9ttsb6bt1p6
+vSl2lCqbYVfJiqPrmA7J9Rkvtbd21qQ6LGdlTF1gqORw+Xg6uE/kp6JfP9ThbPNpdEh082bRUe6RPuHroHWR+1mqhO9ZE+cwi+q8ucJWM2cO65nHBP8GIT2Y
+l+ORn1OHyXG9eT28UOauEFhvTmAqmO7sa0/LEBRTflsZ24VWBNb6vB5fZnRRnfk436FmIhfXlnkcV2DzDMgYLqonH8cxfhUUn7hFCKBxW/Eipy9zvdjz2+Sp
+Wg0Z5kFlQtuEzmOhA4jquUXNeEwmWMmum4l6UKLcjNNq9PETsj5eittk6OyuOTRif+2ODtYp4flcXPUWYrh/SNVxt6Z1LpvAhfZQHr/FdC2eTzWlZ993bj1L
+p2zN0bBe4xjpV8y7pFtvTetWo+2aMkeLKFU8P2tBdM91TfsYplMHl+djUSVUZmJtMV2keHDNiB3Bg8svVciFa2fpbDH9JHtyh+KUK+pR0WEmWINkaltMX92Y
+o7GEziYSXVF1iTO1Ij5B9uY6YjXtnCpZj1TZbjf6pO/VTVDns6g03VIYuCDe71uzPDtp4pTq2bk6bkpRN6btHYLv7RyvqCedzkwp8QbXPer7RmxkFPckii9h
+8XgoZvOf1Gw+nwbFzLFjdt/mEVCTevsD59XbwsSnubrbTWrvOudpQWT+VF/qogZ1WIdaPrppi8lf1WnClCamW5yg1SwbzZTrqwLmdB9POsKrSsWjCwhPSuOP
+tT6c2bgKVAdkS/bwqPJ7MpYA1Sro8XOxc1N8yzGQyoiNtTXf4EqPvAH1yHucVj83JEr5MydQSqALAfTdy9wD1T+Oy59bGqWWrVIB1hv9CU06V9E8If5lV4cJ
+lS/jEUKERs7cKTTovCCBUhkWREmVvN0O8v9yxuwFVAndUDXaPCBOnDaqJHKsVdGwTeRZs14SZWXeT0MYhHE/hHYM25Ccr+TOaiEGXaUbL3ORHpTHd17npJNj
+khPp+KKdwznuizFWOkS1tDwejjOG8gih6HqMP7Cw3tpcLJvD4jhBCGiO/lF4FldHj6ur66Hl2bk42lUlZ6BUZ6f6aJvWY0uZLl/TDX1NoTfb8bJkFISVpbQi
+XdsA48rWNuy2I3VDY1Vyl16dv4GpONtSWDhmMx+Fp/uy68pt8JLUBqA1KBYWyG7eLj2RW2KdKSicMrQzUCoH66aRWo1hapV3yqE0XHSnnDA+jDzjhLKC2qdV
++zUi6Vh9cGvHzsCO+0osGSoTxvYs96QczwuZGEK+mAqFbf1e6ZAu2MYHc60AKRE+RAIjUz+IliAzP4IwqN4fa8oebepmyLdR0TD+NJ3rET+N7flKn+ZBP86n
+d8TDkomQlsh5AVifZtuIyoYx+3tsQgdZAhvPET6/Ju9acdZEtY6pSApRuD4IQzgwFT6AIyXNsHHcJXM3WuvYUl3udaZsYOpenLGRVoprinfIerGFlKjrkzQw
+cafN0Sj029ribKvEm9OMyTBAde0ZsJt0Bmkoxn1oJoYUQ3zkvDGEPAHDV756cQSdfxHEIo+MJXMvtphtvzEtMTbegsuNDLagkkvDLCRbqFDU6TAS2RhuoX74
++yUHVPrgt7npSsJahyj4UkyLz5lF5bSsnvZRXO1TpkzQBzY6VYKSoyMlbDzoYZbEFpkl0eR+lPVpkyM4VTo2ItEluR+X7vETVHMRakthOgSiZJrhEFGeTXEt
+pVl61mo0lQkQjDIbAIFIkyLbXbbep2rvWvkETUxOoFzogxPKXW1rXBcAD9AbEv+4w/yKVIjqGzeAuQLEXrP8SqlzvSPabLA+zhFpjXY12yQORCe1nXKALIAy
+1QBbby/a7zNwDujcLXpKU6nrHWbF+egCRww5n1tQbHmeV7CD5hXUHr58WgGl2htP4CbNXGvoBjSTYBnPh2nOBHYzna2RAzkHi1BzmXqvU/2Rpwp45IJAnSmQ
+7lHJkyzILIFH2CQB4UwNXjpPwlnaDVnv0otM+mBgrv1mkmH56ILMIHhMmkAgfDcX+3rqT1pLP07mDFxOp+OgT8OkNz/80rcASsvY9NrXkCQFVFvNuxjf1dHD
+LAMN8K7iWQbNh+l3i5fc9Vzp1/GsgtZppfMAsj91iCcUzPBWMUUvjCNoHdUFqqNc5ihL80BYKO7q436Jw2mf/UdQpaF0uUoUfhprYzEGKYfgxSC79s0pnq/V
+xOmSvQBOr5yrhiDu5f5AirUbXbHdWn9Eodp+Wwn0esY/gLX4of6KrFJL9p6T4z3ia4x9JelQVkPGcue4bmzR1D5ck2sflDdGb1nVQ7L7C9aXSHr/QJMZyGfp
+m0eBrLFahyf6NWD6e6EXq76Gtr7gulJfoL4LKgVe/N0z+mS9ugL97U8t5hpAqSc4nFFPUCcDqLUEatXWoNY5Hc54i5dWKvb+b0qbBlQXGHt/70Xdd7AMaeRl
+mDEee9eGTG7MGi/c+GXw3iYoR67dDi4Lq90d8WmpL+wqPBI1NcmLk+P96Hm5WsFtR6ZTUIsrkA2g+aaVRprhI1FJt28V/2I4sdhHx0UXktN03oAdf4zldcnx
+nR9nWDnxAIM9OU5tOzDNU+/KY1ihdxpoCfXdhw1qUlhlIlR1O3B6+b7iBQZ6Ow29W3B7Ds3irzCfxXgs33iL16CwUL0L472XZyasAHociLsa1cm62dMpek91
+zxKtNpeopphZJpuQJZHwBbXeO+P9JQ44vmTc94gjKWwQUEmuByLnutSdj5xcmaw7/8Asjvr9xkY+fXhFquJSU1hr3+Map7C8h12yfzlWv63rAyH+EnaSqlDX
+6AbI+PD+nc2zjvQ7W/wK31zZZFrbO4t19lV4jOpsMrUB6e3UQQZrblr3vgaTYpctVv/+HJWmVgGPxIgr4NdVm7c18GvnPas1eIZOZilkm6kMXLsOhR7Vr7QY
+PlDkdVQ3hMkslbCwUEnbYvpE2eGi+LXDegfXxjO9o1bHIzHT6ngkaLE+PpDebmrkb7d0O1XyjDqqkufk2zr5vPYLSq38catppqrlDdUsbbU8VYFyvXzm68pk
+zfzHOhxuVDXP+J6qmldWodTN5/XsirXzx3S/Z1XP412Xq+fR7kv184ElrLeuwxNUb9Ea+hX1OD3zOWsdPdVZuZZ+7IrALUA7GYEp/tb7tHQQgiE1LprPnKpW
+ic8cvmMr/0npWuwz13rVp/kOser57UbTemtTD5HAN62fR36lZb5rmv1XxURopLnghVCgkYPL9NZUKbS1qT3flREStGmhRx1HRLGtSV3JOnQpig5VtK8d1V24
+FtWimAPXod7o6U2dYq5sXztJXRKiF5T69tvTenKPyrf6iLzCfe1k/WgQI1cma9w/cG69SPmcoxG9zrVQ526Lj8jrZW9P60Gj7aJQ6Y72k1fKWl/j4qwzHpO1
+RmgPgL22VIV7KiiPWO+e3LbtpuZd8dp4CbDktQWKnrlthrXt5n7MTqUrRcUC3cZ7S3RZeNyQ3W5q4F+Yo2kIYdF7K/QllUPXLfXr1zy4jshZRfypp0qhrYmX
+6Oe6+L4nN8EFrow/9T3dRBi5qNbHz/HmpL1Rvbm6O7q6IrxdUOrk53hAPWkplfJIanKt/Nq1/kPrB5HK7RX3IjzxI2rZdhu74bptwc7XJtqipXeNrc8l25Kt
+P2zRbIgY7dnds/dOsPi5SbccN61illSw+Q1Vwe470fIbX0lyux90Tcfud6lm2+8U648Jz4hHPlArjDa1u3faPZlre90M60vXIdnf26HT/5T97UlTsMFOtMKA
+mdlid28VX5fG67A6iRkT1uk93MBgi60ztbm79UO6gya8fhP4RM2l4PMTbQSb9VDWmOSwOtkrGicEYfsUcWpyt5hxUUEdlhszpJX62qDFe4/7dufeOTFL1sxa
+WMzgpfTQcS0fpIOOV5mR5inG2gll1sKNOTKi81KcKKX8y6vMLVIdLwQcymMYiRLSdqvIyZ2j5eqo4lGcO7XOeZPhKAasDUKAM4aJSNFd7H1bDj9GpewFh33t
+TCrTL6gU7421rrTNSX3QvCf1FjSyk8gEI1vRKZWIsb6CU0rS0dNaCSGq6xGhKBVGq4JUMDVcZ4BeACQqV9ELABdZQqqsqdRQGzVek4uy/xotmv0XKMZ7KpBM
+HlQkuiviVl4iyBUuz6baISQvMnoFC7aWPPiMXUmc4Ib+9e2H4ldua+sXvSRBCg2CxRJp1N5v1BeyqOr0KB7fctnSicqYku343u/OoNJNXf7X4K0t189Zk5El
+6SbiPh1Xk9zHd4430qqPCYjmeLpL/CIUeQ3jnlu7tqlVm/iGEMXg4p+EPvG4HjYiGXRZoD6zI20sh2HUd1QKqZcuZBlgH/HJ3GO6ziCQhJAIjostmJG64kwz
+zyHIqyxt1B3Sqw/EtbUICUm/FpxE1bAYJkH17BbRg8/UKZllXeVIh1UdhvXcqPowGJBRkEG5et/qQxtfnTCty3IEOk2w6kaBbqMbE+Vtpq/qjW2oMY1VaTtM
+D2ktVW8ZF4kDzUlV3TXeoaq9Gk5a7XWjcBN0GBUA0mBe12G+8LQgGBlWRy6cqoyVKWcq1ch61W4/WOw2R8zUld4JoiWombgmTzEzKZjy9LzeH2aQt6gZ9n2C
+nMmfJ7iZ+nnE/zJa0QY9szQCAYKesYhIfED0g0VEaJ3voY7QmaADa0IL1/gWYrim8rpcqRBIHmfMTqUpVSqE6fQxci4K1Pm1w23wUnAW/j6+sDR+wxUcR2DE
+UKW+VzwGjBqKJNtC44bWdoMbukFRNJXSddN6KeS0IB/FlX6O3uIKhUD2goIfeqlFEKF16l4KPbLMR/GIk1qZGVyEXRFDdFtb/57qpRAGXOujOMYDclK2GJbo
+hq5hKpqIYVZqOvcMzsAX1ErSOBkZY7KjUjEIdc728Tir0VCc74yu/6v9O9FLsdaurbUGxMb/p248cdaNfxS3pPb+T83+thhm56AnC+ylcDmc+kYIsYB2nUWA
+MCCjfnw8dlhvkDVEHMRPQQibuPIYRhGATVhuxNcEP2WbYGwOcqcPGWWjk27RNpQDArZJPBRfaQfhT64WZAGolGldZfJIMVUDKeN16r3xiCjH3QTypc+tC3e/
+5pxezvH5QuNErItMMnGWSMJ4/BAVhUIEcuppz/nI24LgYQ4kRIzkR2ZcDDf5ktkndpnnUDE6RrPNYL11knGm5pnEnsSHbFEySMNFaZ4ci0iZTFW0oaMETWNT
+MlbmRouWYRasUq6IGWzEFDNmMllsU/bQqTrhNiXjZuZaFixw0bQoxoXE4hjDcwzQ5aZnZRAzqpmRDc0iYXkA4xCMcp5RSOSsNdCJhS6FubYUhaOc3cG351Y5
+sxnXIuAOJF8Po2cqDQRkqVS2hXdv4F8ePR2DfJz8VZz2sR57NyXts93gWFby91tPqlJBqR7rFSeKxUsY03ILo1pmnvPBy2e8iq893zmnACnP2tQKstfmYJZR
+feBCrQvMc7aaOhXlXdnjBzKMdRBqAOl5JTWASyO8V4UML3qripiH/N62xXAPq36tX+eN2AvPw8bL7+EZA/GE9j6lvQWLr8AL9IK43WAhDvpvUup7r/LSi4hd
+UDARt2bUoFRZavUn2nPuQN5Ld0VsxK0570fCypt3I+HddnCYfu1vtpterUKN2o00WTG+To1/Ht2Cg8DTcfhnx7Hm7GThvbXe5/nsPt9ea5IB87684pR4F2Mp
+Iy5/lSYMpQriUW/kt7FIOf45IzZjv6RI1XsfqI3LipXERX+M/9caMnhzQeaT3sS1HAnVoJFd2GBDONGYFRxsAjkQssD7/bfIg4m14ls6uJZ2ercWlgxt3fbr
+lDpunzRD6iizMPjsW6698QpX4Tdrjbktsu/5tE/wkG2isPVBs8h7b+Xe+yPdhGeeQxe9IgrUA40OebEX/xWERAjaZm+Sk722L39zKkjgYUX5n1rcm3+H7UXy
+WaflspReDIRrSTTSAApDrN/9rczDPB10SHrhS7oIrLPWDVZSRZ52yAfyVvFkmvzV00a8iH4ArphM2g9JMZFW3AQb+SS8C+sokYVDqYe+IQwA6qK/AMyBo530
+MYbyINY4YQylwkCnp76X2AjEGR9YAtinuVp7ok9ohe4WICDBoOiHhdV5GO/FSZ8HHVXAOEGggkHVFYwZrDdqxuKN6qWIZ3NGL35H9kdoxr8AflGljvz3ifyN
+keYdeAPeDz/Q53Tv3L35Mf92sje/shi9Qf8OOYerLHV1HTPQBuQ0WBFtMIjKcWB3BPelvVMypwlhsNjEe1P9N0BABNGFq7bjSuoic5KyuLiv/2b2w01YkPF3
+1q+hzurWbcjLm9iQ7TIPQJJFcrUk61HGBDC7cbNjNzjqQbAY3lrHjoInkwO4rbhJokqJ7DabI2Ap0TRIgF4T38wpGG3D7Xm2YVucK+BbsoJN8GWZJCaMuuWk
+jXLFG9mfNYDVh2OzBhbQKmc6DaC1U7em7dRS2fJctDn4CbLcLr001y6plHPhpm6O8JpxfU/V9SczNbw4o0D3X13HBKFm/5Ke7tjrZRcNMUBH+bj2Utpe7/9b
+1ZveTAuFzJWoiay1zoDtK6MyLoCclyfLHNuuN8vKw2VvNvAgubOshfoW01HTHu22NEug8Whr321RbSTqND826q3jTTVX2y/Ei6zoKqzwst3oizmebXc7MMBi
+SneIfEQdMt+77XODgRbT+iQzdEH0H0829nCFmQQdDzf9bkq/GEv65lEds6k32JOelZEXfXVT9hT7PXc20TiGzTDQvB+v+D+oPryNnV+c9oFIGbziBznZE7Jl
+YgD3hZ6f9IVMM1+h8Yac5A+dQRmzwHyil+dpFrrgrl/kZGfkDNC6uQ9ye4YP0hF5BY9o2sTUOQ3cFzme7Yv0OKhAEl2DVCZkf+T1zfwRNtei75O4nldS+JqK
+ib//nYiJ23kcsyJiP7EKaSiH5GO9vqGP1bvqEuBFUX3l+C+Iznvj/Fqv72053d9qwDHM33l+hr9jpDkjrb/jNI/nDPi8kS2ml+6cRzPN8Huc6vkUFSX02Mu6
+4vX5GbWWL83vcH3Pw3jO1w6azRKnbo/cEWRMPFF7ypQLu3aD89bbph7C28FZ5+xZlFWd5+9CvuTFUtu8QtX4cZXjik9ihcbByV5683JrF2cKRHrWmvTOaPJY
+C581lLOnqWVppbkDD8OL8STUqvEJ0gVLIxEus14oZUA0x1rWl8Xq8RmEMb5Gol/LyBsOai2HSxjOt3rvX3M4Ut/AXIe70mlSZHKLnAdc7T7FC5pi09JeWzzI
+hlJdS71UI/1cGTSTPp1wI3OBhtxIbPB+/g4WsCyvYm+QqvspjrTpNy1jZ9DOv2F3BzVQ3WF7VHKcs7hSdIYgLS/Oxmn5yudbu2MbnOgFnrqg3bHe+cX531g7
+PO4cHHLqCdtUawr2mEwGdwYj9YR08oGDKossigx48lGrBi622Bv7q3k+yrhejHA6ZFI4znsUtWsYRhPgOC7NRfDxlBhb3+8KtMnho1x6Q/Meiq+yKTVzWSoo
+JJEhOrxG4Gjq7X9jZlC9r8pTVsYqU0JfwNiF7y14P56uM5cv6TVc4c7RWfU6k+hpfJedq9GLCpxuKD1BA6hHrXGDbZfT8a/sDt4IGa470h2MN49PejLqTQwe
+VqqZP/XtbRzAuvA+hCZArfGdzP7n8/Ce8iZUpabfRIMKng/2D06CY24TQ+2dNHRb8Z0cEi5wAC/0jwh8kW4uffbuML7iLW2Z83hovWuZo0zRGrQaI5LuB33G
+9oSXXsKoi4eMskkvBbsa4p0YLOJ8l73J3M5cz+F4odazdbe9uR+gngD8bFp4rjHslYTvCD5X764INyTM06o3xNlT17sdi3wroLkXR4I2mboXRXk018FPXYVB
+ugNHuo0SWDmkk9UERrrnfuD1qi+1L3kbMNHaJc4LtUoNM83bYtTzs3ZlIfZpXwuHFrVqb3bHSj3bNZ3wlmInZ+gEPJVuShNMa4EBlPv/1kzZdafVTV57N3nl
+B2hj+zxBD8LMvCWJ7cWbPmKJx+bZwSN2PqKyi6du1zm+P02T65HtcwnX9RyNuE9WvWChjIgJaO1CuYQtBbbgPQ2mnHMk3t0LVXztBP5ZxDFyW+Qh3ymJi4pv
+cLCbOtEdA0xSF9DcC9fSX1uCTeAcAEV1wwUUV15GvYXeQF3vN4opI7I7obpb4TTQBe+b+K3guwFgJ2G7LVxEEx4R6muvn32KWG8vJJ8SAsx4kn8aijwehBcL
+CqgivveIICLFEixi9LfPq46pegQBzxeymeUa78PzDdqsSxMhwhlJhDwznpFE69xH90BAbfcINwhu4BwUDNrCUg5o7mk1kXtKSKyeIPTckywbknsKGr6zL1cI
+5jghvLvcsBmRjPxYo1WxcpTwKXhP8z1XhHzPHIl05jkyfli+RxCHnO+5yPI9c7jS5zxyIXlxCFbLl4vbheztxdQNtmZbGm2BkNpi/Bnwyj56rwnZpauPU5/h
+2/R8Z+zyk3CEbnUQTQAUlsT+yfHJIuJKx/YFY9x5sspvruOLWXVE8nRHn6b01kLUEa4ylt6swVoyK3APDrgPuacx0U6exK8bMisRZMGYYfMlK656N73CxspD
+mYvc16nhZgDMQhrzUADsceBl5QFNe8Dc7DJe4kvdWx1+ZqHsGW8yxp6xmI8vBtqjHNDF0lm05izUk1yisenzHKBfMOdEp95B5VzTfRzP9au63FL9Qiuq8JiI
+xRXCs6iBwjhKLiaXihmKkPJUox10zx6Ea3ATXoHXqAXN4hJZ3GPCS8jCNYTS3sqgj0yW3gFBUsCOm1vA2HPAncKCnvl8B18/7y1Mj57iTXRIbtOXMQnugnIH
+3r/ZLVhMb21zEzrbLNyGxC/V7ddJZqK5EYcz78Gp610BT08+nUm6hFWuFES9EHq3gIwEtfzslxGzXjj40ddi/TAe4ZFT9hVFJg6bqbOMBdQVg53rfJxZb4wn
+2krBaVkcatNoG25Qhwwqkhi67rC9ECa09m3LUtsOOqHWKxYlz6nlfLyQKwXn8KFMrWXc4Km1Ejtkdi3t3bEPh9x3XExypudFGu54WkTar1OgfTwALjCZ0S58
+U3KbmrZrO7qnTNv1ot6JeHQy11XUNMdzIs/cC8LqsacQfy5I/PlKkchBjEAPJpxrMtA2WDDm53s1EF2T/iou5D7fJXY+mRePlrWLEakUky5ITHqn1w1lQgBt
+ZxRoBKEGp0EQWyz2O+bdUaaiPz5b2LYRIOn71QaBoxUkceAeqo0TO6acLxoUNkqKB3sRIY4JHygZpPcBbMTh5C2ajA316HAHzUmuHbVKh5X+PRaLl5xNLxjC
+3V3AU3H+Qv+07qHZyo5flThemd6SU09x+vGeXqKdLvfNnPvZEkx3s72X2yQ/NfZDem95ETlU1PSdCT7GdM1YhIZsicTTmMGxRFf7lsMFyqdeRlPxZwmjzItu
+5ZGOFSdH6koeEDp/zqOZu+E4MTMlEd5idF/ZjHI6bc2AacZA6rEpM1EO4BY566V7VHfLl2joNCOK6rEYxepyMZolIzdFkw2kFiiXsdQS6eheYVyXC718aq68
+6GJzHv07uK7ubbdA0buL1A+2dK8y59C1jXSEmiuBn7amEd+LDe5i7z6WOivGAIoNR93OXgmat2olKqwv1a2eNx6/VCeqfM53jsbynG+22NhVWXojTrRLpS5+
+IKbmzLWzkB8Q37HmEScxWMtDVQECE3hO91MpQwVTlNvYi6F76nxw5ptaPg+a0kce2hR9KebiXNTHaIkNYWZ0lUO8f6/P4KUbxzCOxMdd5urQp9060zzPBdqg
+T4hW56/2BlkIuKZnZ+CaFOyktxKWKUVnHMf0zCSO6bDMSef4pRa7lPEBFLd0a36fjw4ewKmdPQaxp8fhHJySgnfsNvEYWlzSC/NxSRq2YKJZxwA6NvrlDbHR
+Zd66jkHqN+YYtN4VL2+K1xGOML+sereKAQAADABcATj+yX/73/jJB978u8M/ugUAAP/O4Wc+nv/6Npy5LVg4ALiQ/ovw54U7c/DRLXgb3oat/M/Lr8N/YgCw
+W/HHpb9C+j2kH/z/478H9Nf833j0nfzve/Zdh/4duwWD3QLj61+Noz/535v7M2Ce+I8V/p6vT/pxbP3A1mQFmYHCY/rnYX2aLO/2Z5NvOWVv53zfKjLdRP69
+/85uyNe3Skbfrh/3HcjTvZ97P/d+zqdf7Iz/307oprk6clO+bMcW2O9ieUs21Z7ThthvMb/frn9nE3v+Tn/DfgfZYT9xZub8vEO8GCfYfKfceTtjn+x3uL7r
++TodP1mV/9S/p8Uokq/P/zvXiRVggk9NZ2u8uc5+2g3lAzP4m3O27MTeat+CCR56utp1dMlcnW4nYge7oczm0Jtrd+b8d5vID2buydx7AhPnYq58p76xiQ6x
+M2LHTc42TPx7MGP/p3TGt/Pnnp997+fb+WO/w/Mn936+M+OYP+4yuPfzrZW57cQO3w0/TvF1rZJ7mePjzokJQHm/4bJ1d6njv1XvGNp+O/bTk7WdGee4Gf7t
+XP/Yzngbsed4b/mTri8kPxs2zKHOofknzb++ZxvvyfLezzu/53djE++do3t64d5a7/3c+/mjO3v2W/R+eTfv0Oel51nspK1Vq4lzwnvVnPjzPHETzHxbg3Pm
+/afegWBmrZq9i7Ojxa739Om9n3s/937u/fzx9jnfCX553tZtQNd1fIF78cC9n3s/937u/dz7ufdzz459p8rZ3tunez/3fjY+806IAZyQ24OZddwg4GHhOwz7
+ce9nfvw4t479j5LvOXlgmFjHO1XbDxv8u3Pz0KDw0rt7937u/cDEGboni3s/937u/Xw79IH9E6J/7unVez/fDffYfovvot0Qm/5O5G/sd/l9/1ZiWzTcxLd6
+jfae7r2rmNYKvSqkfbXfYXvl7vWwuvdz7+fezx+h72PvxQ9/os/Dt0ue9o95zGq/S/bhXu7g3s+9nz8eZ9zO/HfcXdKe6m31nZyT0Ho122+BP2Xv6bB7P/d+
+7v3c+9lIN7sN7ZD2nXfCxt7Tzfd+7v3c+/njqm/tn6Af+BO23u/kfrj37Oq3Rlb2LnygP6q9+XbwfO+83bvTm+RuvpN5/HbGKvY76Dt3k6/7o9Jpd9MDyf0x
+iun/KM6NVzBam/74u/h3/IbfOS8P/h343neDj/rdwuem+/Gt0Gfv5FvEHGzVeeMM+y2gM1dW9h1ak93Adt/7uacr7v3cOwv39uCPjo8/7j9/Uu/Ud4Jc7t3n
+P758u3P+e+7bQOOPYo/cd+m+vtP/rjvnv+/+CPfgnj68p5O/W37gHfz34B2k1/tvYOJbbsa3Ns0fwDnWcbd+5jvx72j/rn2HfOF3ir9N//t7+uO7a+3fjljr
+bv029y3wFd13+Jlw34X0v5Pfj7+dNqz337/TdWp3c7fcO3yP3L07Ud7C7ub/5/+O/zbR9X8Mbav/I8g9fCtslPtj7vPci83/ZOzhO32P3LeJp3v7+y1aCwAA
+ALwNYL4OYP4QYPgowPBNgMURwOK3AbYcwNZvAGy/BrD9KwA7NwF2vgGw+1GACw8DXPh1gItfALh0E+DSFwEufQPg8qcB7rsP4L6vAVxxAPdvAdz/VYAHPg/w
+4IMAD34R4MHfAFh+DuChhwEe+jTAQ78K8NA3AfY+DbD3FYC9PwB42AI8/HWAfQDY/yLA/h8CPPIpgEe+DvDIHwJc/SzA1d8CuHYT4NqXAK79KsC1PwS4/jTA
+o78O8NjTAI99BeDxNwEe/9sAT3wZ4MnHAZ78Q4Cnfhng6Q8CPP23AVZfAnjmAsAzvwJwcBPg4NcAnv0ywOFnAJ7bAnjulwFufA7g5k8B3LoP4NbXAJ7/HMAL
+1wBe+CbAiw7g9sMAt78C8K7PALx0AeCl3wE4+grAyxcAXv4ywCs3AV75GwDHDwIcj3/9JsCrzwK8+gWAkyOAk08AnHwZ4OR3AN79WYA7FwDuvAlw54sAd74B
+8NrnAF77DYDXXwR4/RcA3vMwwHs+B/AGALzxeYA3fgPgzc8AvPm7AG99A+C9vwjwvq8C2F8CeP/TAO//KsD3vAbwPb8P8IEvAXzwTYAP3QfwoW8AfPhFgA//
+AsCHfwfgI58H+OgWwEe/DvCxDwJ87xbA934O4OMA8PHfA/jELwJ88ucBvu+nAL7/CwCfugDwqV8E+NTvA/yAA/jBxwF+8O8A/NDnAX7oDwE+/asAP/wJgB95
+DeBHnwX40b8B8JlrAJ/5NYAf+yjAj/02wI//GsBPfALgJ34d4LO/BPCnrgH8qbcB/vTvAPzkrwP81DcA/szjAH/mVwH+7OcBfvomwE9/CuCnfwXgc/cBfO6r
+AH8OAP7cZwH+3O8B/PmPAvz53wb4C58H+PwWwOd/EeDz3wT4e34H4GeeBviZXwb4j3iAL/wawM/+HMDPvg3wF78M8Je2AP7SVwG++AmAL/4BwF/+GsDf+1WA
+v++jAF96GOBLXwf4K9cA/sqXAP7K7wH8R38F4K9+FeDn7gP4uV8F+I/9CsBf+zLAf/yjAD9/E+DnvwHw9/8ywH/iSwD/wDWAf+AXANwRgPsmwD/4iwD/4O8D
+/Ce/DvAPvQjwD/0uwC+8DfCf+i2Af/h3AP6RLwB8+YMAX/5dgP/0FwD++rMAf/3XAf7RXwb4xWcB/rGnAf6x3wL4z3wF4B9/E+Af/z2AX3ob4D/7ZYD/3GcB
+/vM3Ab7yIMBXfhPgn/gqwD8JAP/k1wG++jWA/8IvA/wXPwXwT70K8E/9LsDf+BrAf+krAP9lB/BPfx7gn/4mwC//AsB/5VWAf+YTAP/M7wH8V38e4Fc+B/A3
+HwT4m18F+GevAfyzvwHwz/08wK++CPDPA8A//0sA/8KrAP/CbwP8ra8BfO0I4Gu/BfBf+wrAf90D/ItfBfj6LwH8S9cA/qW/DfDf+FWA/+ZXAH7tgwC/9gcA
+//JvAvy3fgHgX3kc4F/5VYBfdwD/7c8C/Hc+BfDf/XmAf/ULAP+aBfjX/gDgv/erAP/9XwHwXwP4H3wZ4H9oAf71BwH+9d8E+MY3AP5HnwH4N14F+B/fBPiN
+awC/8XWA/8mrAP/m4wD/5jcB/qffAPjbfwPgf/Z1gP/5LwL8LxzAb34a4N96DeDf+i2A/+UvA/yvvgzwW18D+F9/GuB/80WAf/s3AH77VwD+t18A+N+9CfDv
+PAzwOzcB/t3HAf5dD/C//zLA/+ErAL/7FYD/468C/J8+AfDvPQ7w7/0BwP95C+D/8irAv/8gwL//uwC/9yWA/+sXAP5vHwT4v78J8PsPAvz+7wP8P34D4P/5
+WwD/r58D+IM3Af7gbYD/4DWA/+DvAPy/fxngDz8D8HcfBPi7vwXw//l1gP/vLwF80wJ88/cA/kMA+A+/DvD/+wrA278CcPpZgPXDAOvfAjj7XTDwB2DMr4MZ
+/hDM4jfBbH0JzPZnwOzcBLP7OJjd3wVz4dfBXPwamEu/AOby58Hc9zkwV54Gc//TYO7/TTAPfAPMg18Hs/wKmIc+DWbvWTAPWzD7HwTzyOfAXP0cmGufAXP9
+02Ae/SCYxz4K5vHPgXni82Ce/G0wT3kwq4fBPPMimIM3wTxrwRw+Dea5p8HceA3MzU+AuXUE5vkLYF4AMC9aMLdvgrn9h2Beugnm6E0wL1swr3wFzPEvgHn1
+l8Gc/BSYd/8SmDs/B+a1L4B53YJ5jwXzxtNg3nwYzFvPgnnrD8G89zfBvO8bYOxvgXn/74H5nrfBfOD3wHzw98F8+KfAfOTnwHz0M2A+9iqY7/00mI//HJhP
+/AKYT34VzPd5MN//d8B86ptgfvBFMD/082A+/Q0wP/y7YH7ko2B+5GtgfvRLYH70D8B85vNgfuxhMD++BebHfwfMT3wRzGdfBPPZfxXM/59iOI5rZ34cOP7a
+7W67bbfttt222+59HyGEvoQhhDCEEIYQQghDH8IQQgghhDCEMIQQhhBCGEIIIYQQQgjh9/j98Tx0EMdh7TgOT+E4fBJHcw+OIwSOI8FxZD+Oo1pwtFTjOFrH
+cQw4jinhOLYdR2s9juMEjuOKOI4fwXFCFkc2jSM7juPELI6TqnCcXIPj5AUcbXkcq2txrF7BcaqG49QVHO0rOE4bwHF6HscZVTjO6MaRq8WRm8RxZjOOswwc
+Z43gODuD4+xpHB0FHOdU4zhnAse5rTjOa8bRmcZxfguOC1pxXFiF48JpHF2jOC4ax3FxG45LGnFcsoCju4Tj0jyOyzI4Lhc4empxXFGD40qB4ypw9Mo4eidw
+XD2K45peHNcWcPQN4LiuiOP6CRw3FHD09+G4cQ7HTXU4bprEcfMgjnwvjlvKcdzSj+PWZhy3GThuG8MxkMdx+ySOO8Zx3KnjuLMJx51jOAbLcdyl4bhrGsfd
+TTjunsVxTxuOQgeOeytx3CfjuK8Nx/0NOO5fwDE0geOBFhwPVuB4cAXHQ6M4hrM4Hs7geKQGx6MGjkencYz04XisHsfjMo7Hx3A80YmjaOIoTuN4sgvHUykc
+T1fgGM3geKYCx7MpHM+lcIxpOMbmcDw/ieOFPhwvNuEYN3GMz+F4qYDj5QKOV1pwTORwvJrF8ZrA8do0jtcncZRKON5YxvHmHI63Sjgmu3G83YvjnTYc71bi
+mKrG8Z6K471JHO/P4vhgAsf0FI4Pl3F8bOL4eBnHJ+D41MTxWQuO2TYcnxdxfDGN48shHHN1OL5K4/hax/H1GI5vGnDM1+D4tgLHt8s4vhvFsVCB4wcZxw+L
+OH4cxfHTKI7FPI6f8zh+WcTx6xCOpVkcv8s4/qjFsZzG8Wcdjr/KcfxdjmOlAsc/5Tj+NXH8u4jjvyUkRx2SlEZytiHJo0jKEJJrHsk9i6TOIXmrkHz1SNow
+kn8GKagi6eVIoVqkUAkpnEGKmEiRJSSjgBTtQIoJpFgBKT6IZPYgJVqRkp1IVjWS1YskUkiiiGR3INnzSKuakFYVkdZIIa0xilTWh7RmA9Kaw0hrtSOtbSKt
+PYFUnkUqX0FapwppnU6kdRaR1m1DWreEtF490nozSBXdSOs3IK2/gLRBH9KGGlJlOVLlFNL/hpA2yiBtrCJtvIxUlUaqGkSqWkLapAlpkzGkTduRNtORNutH
+2mweKZVD2txE2rwXaYsM0hYrSFsOIlW3IW3VgLTVEtLW3UjbdCPVdCLVjCBtW4m0bS/SdrVI280gbd+EVFuBVLuEtEM/0o61SDvOI+00gpTOIu3ch7RLBmnX
+FFKdQNpNRdptDmkPA2mPfqT6SqT6JaS9ZKS9K5AaDKR9TKR9BdK+i0iZKqT9VaT9F5AOWEBqBKlxCumgEtLBJaSmOqRDs0iHVSAdXobUnEM6YhTpiEWkI+uQ
+jpxBOqoDqcVEaulAOlpDOrod6ZgypGOmkI6tRjq2H6m1Bqm1hHRcDum4RaTja5GO70c6QUM6IYeUbUI6UUY6MY90ko50Ugnp5DGktgLS6lak1eNIp6SQThlF
+OrUC6dRFpPZ2pNME0mkdSKctI52eQzp9HumMWqQzJpByWaQzy5DOLCGdVUQ6uwOpoxypI490TjnSuTrSuUtI57UjdZpInTNI57ciXVCJdMEs0oXdSF0ZpIt0
+pIsF0iUC6ZJFpO480qUm0qUlpMtSSJdNIV3egXT5LFJPF1LPEtIVQ0hX1iFduYx01ShSbzvS1Rmka0C6Zgjp2gzStctIfUWk67JI1y0h3ZBCuqGI1N+E1D+H
+dGMe6aZupJtWkG7uRMpXI+WHkW5pQLo1hXSbQLqthDTQhDQwjXR7N9IdrUh35pAG25Huqke6uw7p7gWke0pIhTGke+eR7ptDur8VaagG6YE6pAerkR5cQnqo
+H2m4EenhFNLDw0iP1CM9KpBGBNLIANJj7UiPNyI9voxU1JGKBaQns0hPtSA9rSI9nUcaHUJ6Jov0rIb07ArScyWksQLS8y1IL6SQXphDenEcabwR6SUd6eUm
+pFcMpAkd6VUZ6dUJpNeakF6XkV5fRCoVkN7oRXqzHuktGemtPNJkL9LbGaR3epDebUSaMpCmikjvTSO9X0D6oAVpWkaankP6cBDpY5A+LiJ9UoP0yTjSp6NI
+n9UgfbaCNFtE+nwA6YtOpC8bkOY0pLlppK8Gkb7OIX3TjTTfgfRtE9J35UjfLSJ9P4W0UET6oYj0Yx/ST81IizLSzwLpl3KkX0aRfu1CWqpFWlpG+m0C6fcS
+0h+DSMv1SH+2If2VQvq7HWmlFukfGemfBaR/l5H+m8LJBE5HP06pB6ezGacscCoZnK4qnG4Vp3sGp6cMp2cZp7eI09ePUxvE6S/hDEzgDA7h1Is4Q7M4wws4
+I+M4jWGc0V6csTac8VacZg3ORBZnUsOZnMJpDeEUXTjtdpyrunCu0YKzbATnmgM415rFuXYRZ3kPznUKONcdxLneBM6KTpzrd+PcoBvnhq04KytwVi7h3EjD
+uXEaZ5WGcxMD56b1ODdrxJlK49xcxbn5Es4tVnBWl+HcqgPn1r04t2nDWZPBuW0dzu0qcG6fxlnbgnOHGpw71uDcKYUzXYVzZ4FzFxPnri046zI4d+vEuXsX
+zj1KOOtnce4Fzr11nA0pnPtkce7bjnO/GpyZdpz7D+M8YApno47zoAqcB43hPLgS58EjOA9RcR4ygLMphbOpiPPQapyH1eM8XMN5eA/O5gqczUs4j+jDeWQH
+zqMMnEf14mxRcbZM4jy6A+cxlTiPGcN5bA5naxPO1hWcx83gPL4X5wntOLNVOLOTOE9sxXlSNc6T2nGeXIHz5F6cbQJn2wDO1TU4Vw/iPEXgPGUa56lNONvr
+cLZP4zxtHufpGZxnVOPMyThzozjP7MB5VhfOs6txnr2Cs2MQ5zk5nOfW4zx3Ged5Yzg7czjPz+C8oA7nhY04L1zE2dWG8yIV50VDOC9uxXkJOC8p4uxuxXmp
+ifPSBZyXFXBe3omzpx/nFe04r6zBeeU8zqtyOHvLcV6t4bx6Bec10zivncHZ14Xzum6c17fivCGNsz+Ns38R543jOG9qw3lzFme+EuctMs5bpnDemsd5Wz3O
+gQact6s4b5/GeUcrzjtrcQ4aOAcncd41jvPuAZz3tOAsVOO8F5z3TuG8bwbn/TM4hyZwPjCN88ESzocGcA4P4Hx4BOcjwzgfLeAc6cb5WC/OJ2ScTyzhLI7i
+fHIZ59MqzqcXcY6O4HymF+eztTifK8c5puJ8vgPnC904X+zFOd6J86UunC934HylA+dEA85XUzhfk3G+3oCzVIPzjXKcb9bgfCuFc7IR59sNON9J43y3D+dU
+B873+nG+34jzgxac0wM4P5zG+bGJc0bH+Uk5zk81nJ9V4Jxtx/l5C84vunB+WcA5N4vzK4Hzq3acXy3j/DqH8+tlnN/04Zyvwzm/jPPbEZzfdeH8vhbn93M4
+F/I4f+jB+WMDzp9yOBdrcf4s4/ylDuevJs5fF3AuDeH8rRfn7304/5jB+Wctzr/qcf6dwrmSx/lPNc5/ZnH+24vzvxTO/+aR6UF2dCBLlcjSIrJzDFnuRlYa
+kF0msltHds8iq93InjZkbzWyD2TfGLI2hOwvIAcGkINtyHoXcmgUOdyJHMkgGy3I0WbkWB1yPI1sppETHcjJamSrAVmkke00sr2EvEYNclk98poy8loy8lor
+yGuvIJeXkNfJI6/biLxeCnm9JeSKeeT1h5A36ELesB25shv5fwPIG00ib1xErupD3qQfedMe5M06kVO9yJu3I2/RhrxlFrl6GHmrPPLWbcjbpJFr0sjbNiJv
+V4m8fQq5No28Qxp5R4G84wpyugw5vYS88xzyrgZyXS3ybmXIu3ci79GHXD+OvBfIe+vIey8hN0wj76sh72ciZxqQ988iH1CDfGAtcmMW+aB25IOzyIcMIzcN
+IR86i3zYCnJzJfIRFchH1iAflUNuKSAfPY18zBJyK8jHpZCPTyGfUI2cNZFPzCKfNIZ88jzyah35lBrkU6uR2+uRT2tBPj2HfEYvcm4E+cxx5LPmkDsqkM+p
+RT63F/m8UeTOKeTzl5EvrEXuGkS+aBH5khrk7gHky2Tky1aQezLIV3QjXzmIfFUJ+WqBfE0b8rUjyH3zyNdryDdUIPc3IN+YQ75pHjmfQb6lgHzrNPJtS8gD
+y8h3tCPfOYt8Vwr5rkHku2uR715EvqcLuVCPXFhEvrcf+b425PvLke8fQx6aQH5gAPnBGuSHNORhkIenkR8xkR9ZRB6pRH4sjfx4CvmJauRiM/KT3chP5ZGf
+7kEenUd+pgX5mSXkZ3uQnxPIzxWRx3qQn88gP7+M/MIC8ouzyONdyC9lkF+aQ365C/mVFPJEGnliCvnVVuTXGpBfTyGXKpFLM8hvjCC/WY/8FshvTSFPtiO/
+XY389gTyO1nkd6uQ311AnhpHfq8X+f1B5A+6kaeHkD/sR/6oFfnjFPJMDvmTOuRPy5A/K0OerUCenUb+vIT8xSLylxPIc4PIXw0if92FPK8izy8if7uA/N0U
+8oKMvDCP/MMC8k8p5MUa5J/rkH+pQv61EXkpg/xbM/LvDci/DyP/0YO8rCEvdyP/aSL/BfJfY8h/55BXKpH/0ZH/NZH/q0D+bwmFRRTHFIrUgeLsRJFzKIqK
+okyjuIZQ3H0oag2KOoviGULxVqB4R1F87ShaDsWfQglUoQRTKMFuFF1H0QsooQxKaAYlPIQSqUcxBIpRRIm2osQ0lFgeJV6BEi+imI0o5iJKIo+SbEGx0ijW
+MopYQLEHUFZ1o6yRRSmrRimbQFmzC2WtBpS1W1DKm1HWSaOsW4OyXhNKRRalYhJlfRll/SLKBvUoGxooG+ZQKttQ/pdC2agCZWMNZeNplKpOlE1MlE36UDYV
+KJsWUTarQ0k1o2xeibL5AMoWjShbTKNs2Yyy5TJK9QDKVtUoWxsoW5dQtplAqZlA2bYXZbtmlO1rULZfQantRdlhHmXHCZSdRlHSeZSdO1F2qUfZZRll1yJK
+XTPKbhrKbssou4+g7DGAUl9A2XMEZa88yt6tKA3dKPt0oOzbibJfC8p+KyiZCZT9B1AOaEA5UKA0dqIclEc5eAmlqQbl0BqUwzpQDu9FaR5HObIc5agcSksB
+5ehRlGMEyjHjKMfOoBynoRwvoxyfQjl+COWEapQTcihZHSU7iXJiL8qJkygnGSgnNaKcNIRycidKWwPK6kqU1Z0oqxdQTmlGOWUJ5dQulPYylPYRlNPyKKe3
+opzRhZIzUHL9KGe2opwFylm9KGc3onSYKOeAcs4AyrnNKOdVoJxXQunsQTm/A+WCWpQLQblwBqVrDOWiXpSLMyiXaCiXTKN0F1EuzaFclkK53ES5fAWlZxbl
+ihLKlb0oV42i9E6iXD2Ecs0kyrXjKH0ySl87St8synXdKNcbKDeoKDfMofQvoNw4gnKzgXJzM8rN0yj5DpT8EsotRZRbW1Buq0C5bQZlIItyu0C5fRnljhLK
+nZ0ogzLKYB7lrnaUu6tR7tFRCuUohUmUe0dR7suj3N+EMlSL8kANyoMC5aE0ynAFyvAKysMzKI+MoDw6jTKygvLYBMrjsyhPTKEUR1GenEd5ahHl6WWU0UWU
+Z2WU5xpRxhpQnm9AeSGP8mIbyngdykuNKC+nUF4pR3mlG+WVWZSJDMrECsqrvSiv1aO8Nofy+gzKGwbKG3mUN2tR3lxAmRQok50okysob7ejvFOL8s48yrsj
+KFMdKO+1oLzfhPJBPcp0OcqHtSgfLqB8VET5uIgyM4jyST/Kp10on7WgzNahfF6D8kULypd1KHPVKF8Nonw9g/JNA8o30yjzBZRvMyjfpVC+z6EsdKD8kEf5
+sRPlpyaUxVGUnxdQfi1HWWpC+a0L5fciyh/zKMuTKH+uoPw1h7JiovxTjfLPMsq/eZT/unDRistRj0tK43KW43LO4JKLuJRFXO4GXGobLk8jLm8LLl8Gl6bj
+8oPLv4wrWItLb8UVasQVrsYVqcNlVOOKZnHFBnDFx3GZy7iSVbisJlyiF5e9gmtVH641+nGV1eAqW8C15gKutbpwrV2Na+0FXOWtuNYpx7VuFa51F3CtN4Cr
+YgjX+h24Nkjj2rAF14ZLuCp7cP1vANdGk7g27sFVlce1ySyuTQdwbdaGKzWDa/MmXJsv4dpiGNeWKVzV9bi2Eri2WsS19QiubXpw1ZTj2rYe13bg2m4F1/bz
+uGqHcO3QiWvHJlw7mbh2msGVnsW18yiuXYq4dm3AVZfDtVsjrt2zuPYo4qpvwrVnGa49F3HtNYlr7xlcDTlc+5i49q3Cte8yrv0mcWWKuPbvxXVAO64D63E1
+1uA6SMd1sIrrEBNXk46raQTXoYO4DsvhOrwRVzO4jjBxHQmuI6dxtVThOjqD65g0rmNTuFqrcB0HruMWcR0/ieuEIVzZAVwnNuE6ScN1sobr5BKutn5cq1tw
+nZLBdWoLrvYeXKcN4jq9E9cZRVy5LK4zK3GdZeI6aw5XRzmujhlc5wzjOnca13lTuDrncJ0/iuuCAVwXFnB15XBdNIXr4hKuS9pxdVfiutTEdekkrstGcF0+
+heuKWlxXduK6ahhX7ziuq5dwXTOL69oxXH39uK5rxXV9K64bRnH1F3DdOI/rpjFcNxdx5Udw3TKK69Z2XLc14xrI4rq9Gdcd47juXMF1VwWuu3Vcd8/humcC
+V2EJ133gur8W11AlrgcqcT2YxvVQFa7hclwPV+B6pAzXo924RnpwPTaJ6/FpXE8s43qyCtdTOVyjVbieacD1bC+u52ZwjZVwvQCuF9O4xhtxvVSF6+VyXK+o
+uCYyuF5twvVaI67XK3GV0rjeqMf1ZhOut3pwTbbjensJ17tVuKYacL3Xguv9cVwfDOCaHsP1Ebg+bsQ1U4XrkwFcn/bg+mwK1+dluL4o4PpyEdfcEq6vDVzf
+dOL61sD17Syu74ZwfZ/DtdCA64cqXD9mcP1UjWsxjevnSly/GLh+WcL16xSupWVcvxVx/T6B648lXH+C6696XH934frHxPVvC67/+nFTwi1V4Ha24HZO4pZT
+uOU+3EoTblcKt2sRt7uAW23G7WnE7VVxe0dw+ypw+wq4tRRubQa3fwR3oB53sAp3cBG3XsAdqsIdGsUdzuCO1OGOLOI2OnFH+3DHanHHM7jj87jNMdxJcFsq
+bmsJtxjHbffhXpXHvUYed1kf7jV7ca/Vh3vtLtzlVbjLF3GvM4F73X7c643hrujAvX497g3AvcEk7g3HcFeWcP9vBPdGQ7g3HsZdVcS9SQfuTXO4N0vjTmVx
+b16He4tK3FsauLdcxF1dwL1VFvfWdbi3acJdY+CuKeLetg/3dl24t6/AXQvuHcC9wyTuHbtw7zSCO13AvXMa9y4a7l2rcNcJ3LuV4d5tCffuBdx7DOOu78C9
+Zwr3ngu495rDvfcS7oZF3PuCez8V937TuDN9uPdvxn1AN+4De3A31uE+KI37oBXch5i4m0zch8q4DwP34c24m9twH5HFfWQb7qPacLdU4z5axX10EfcxPbiP
+bcLdmsN9XAfu4/twn1DAnS3iPnES90kjuE8ext02inv1BO5TSrhP7cHd3oH7tB7cp7fhPqMXd24I95mDuM/K4z57EHdHHvc5I7jP7cV9Xj3uzjTu8wXu85dx
+X7CA+8Ih3F153BdlcF9cifuSStzdVbgvrcV9WQvuyxtx93ThvmIA95XduK/qwd07hPvqKdzXDOC+tgd3Xxb3df24r+/BfUMP7v4h3DfJuG8uw33zMu58Cfct
+y7hvG8Q9MI/79iLuO3px39mFe7AN911luO+uw31PGe5CNe57Ddz3leO+vwH3UBb3AxncD7bjfqgd93Aj7odbcT+Swv1oDe6RatyP1eN+XOB+og53sR73kz24
+nxrC/fQk7tFJ3M9W4H6uHvdYG+7nG3C/UI37RYH7xSXcLwncL9fjfiWNe0LH/aqJ+7UM7tdrcJcqcL+Rxf1mO+63crgn87jfnsD9ziLuqRrc77Xifr8X9wc9
+uKcLuD8cxf1REffHk7hnpnB/MoH701ncn03hnp3F/UUK95dNuOfacX/Vi/vrMdzzAve3Bu7vUri/z+JeyOH+oQP3j324fyriXizh/nkW968y7iUZ928p3L9X
+4P6jCvdyM+4/23H/1Y3771bcK1nc/4zi/reE+79ZVEcFqtSC6pxDlZdRXQaqux5VbUD1tKJ6+1G1FKq/GjVQiRrMooZU1HAGNdKDakyhxkCNC1SzBjXRg5pc
+QRXVqHYL6qou1DVmUNcEda121LVHUctHUdeZQV13FHW9UdSKWdT151E3WEatFKj/y6Fu1Iu6cTdqVQl10wbUzTpQU0XULepRtxxArS6hbm2gbmOi1rSjbltA
+3W4MdfsF1B0yqDvmUdMy6s4NqLvkUHddRN3NRN29HnWPbtT6UdQ9F1H3rkFtGELdV0PdrxY10426fzXqASbqAYuoB86iNo6hHjSJevAg6iH9qE1LqIfpqIeD
+2lyDekQ/6pEdqEcNoLYMox49hXpsDWprB+pxs6gn6KjZctQTK1FPSqOe3Iza1om6eg71lEXUdhX1tArU09tQzxhDPbMa9aw+1LOzqB3lqB1LqOeaqOdpqOdN
+onZOoF5goF7QiXphC2pXG+pFBdSL86iXDKF266jdedRL+1Avq0e9HNTLJ1B7+lCvaEO9Mod61SBqbxXq1fWo16RRrzVR+zTUvgXU60ZQrx9CvWEItX8I9cZR
+1JtqUG8uR725FfXmEdS8iprvQs3Pot7SgXqrjnprB+ptBuptvagDMurANOrtWdQ7ZNQ7+lHvrEa9s4A6WI86uIR61xTq3U2ody+i3tOBWkij3tuMel8Z6n3T
+qPc3ow4J1KEJ1AeyqA9Woj44hfrQIOpwE+rDBurDBdRHmlEflVEfHUEdyaI+ZqI+DurjI6hP5FGLadQn61CfXEB9ahb16QLqaC/qMw2oz6qoz5ZQn+tDHatG
+fV5HfX4S9YVh1Be7UcebUF8qR325DPXlWdRXBlEnOlFfrUZ9rRH19QrUUjnqGzLqm2Wob2moby2gTo6jvt2P+k4L6rudqFNF1PcKqO/3o36QR53Oon6YQf1o
+DPXjUdSZadRPZdTPBOpsA+rnNahfdKB+MYb6ZSPqXDnq3AzqV4OoXzegfqOjfjOGOt+K+q1A/U5H/d5AXdBQF7pQfyhH/WEe9ccZ1J+mUBdnUH8uof4yiPpr
+F+pSDvW3MdTfp1H/6EBdNlGXR1D/zKL+uYz6Vx717wzqioy6MoD6Twvqvyrqv6Oo/43jYRiPYwyP1IDH2YJHLscjT+BRevC4avC4DTzuZTzqIB5PCo+nhMfb
+iccHHt8KHm0Aj78ZT6AVT7ARj27i0WfwhKbwhAfwROrwRFbwGHk80TSemIknXoXHrMKTyOJJZvBYZXisIh7Rg8euwWOv4Fk1h2eNUTxlY3jW7MSzVhbP2rV4
+yqvwrGPiWbcMz3oqnvWG8FS04lm/Gs8GZXg2mMez4Qieyl48/2vFs9EAno3r8VSpeKrG8GxSxLPpNJ7NxvCkOvBsXoln8wU8W3Th2bIRTzV4qifwbDWCZ+ss
+nm1a8dSk8dQs4dk2j2e7Ljzbt+CpTeOpXcKzQw+eHdN4dqrGkxZ4dq7As0sZnl1lPHUCz24mnt0m8ew+iWePCTz1fXj2bMazVzeevcfxNOTw7NOJZ98WPPu1
+4MlU49m/Gc8BaTwHtuJp7MJzUB7PwR14DqnE01SF59AKPIdO4TmsiOfwVjzNZXiOqMVzxCKeI/N4jhrD0zKE5+gCnmOyeI5txNPajee4Ip7jc3hOyOLJluM5
+sRXPST14Tu7E09aBZ3UnnlPq8ZyawtNeiee0DJ7TZTynL+A5YwpPbg7PmQN4zirg6TDwnFOJ59waPOcZeDor8ZyfwnOBiudCA09XGs9FVXgu1vBcouG5ZAlP
+9yKeS5fxXLaA5/J5PD0lPFdM4rmyiOeqBTxXV+K5Jo3n2jY8fT14ruvCc309nhvK8fTX4bmxBc9NDXhubsSTL8eTX8Fzq4nn1ik8t83guT2F544qPHdW4Rms
+w3NXDs/dWTz3dOMptOG5tx/Pfd147u/DM5TF80A3ngfb8TzUime4Dc/D9XgeSeF5VMbz6AqekRU8j83heXwOzxMLeIoLeJ5S8Txdh2e0Dc8zHXieLeB5bgTP
+2Bie52fwvFiFZ7wez0vNeF5uxfNKL56JHJ5XW/G81oXn9Qk8pUU8b8zieXMRz1slPG+beN6pxfNuGs9UC573cnjez+L5YBjP9DCeDwfxfDSOZwY8n6TwfFqP
+57MMntksns+LeL6YxzOXw/NVAc/Xy3jmTTzfCjzfpfF8r+P5fgnPD+D5sR7PT414Ftvw/JzD88sQnl/n8PxWjuf3Sjx/VONZbsHzZx+evybx/D2NZ2Uaz78y
+nv9SeBnAK4FXWsErG3iVMryuKrzuCrzuZbyeNF7vIF7fCF5tGK9/DG9gGq8u8Ia68YY78UZKeI1xvNFhvLFpvGY53kQV3mQGr5XBK7rw2lm8q/J41xjEWzaF
+d80VvGs34y3vwLvOGN515/FWyHjXb8S7QR5vZTne/5Xh3agW78Z9eKs68W7SjXfTBbwpE+/mnXi3GMG75SzerWrxbp3Bu00/3poevNvO4N2+CW9tP94dlvDu
+lMa7UyfenSbwpjN408t4dx7Cu0sn3l3TeHedw1s3hHe3cby7z+LdYxJv/TTePYt492rHu3cN3r3n8e4j8O5r4N0PvPvN4s1M4z0AvAcs4m2sxXtQK96D5/E2
+CbyHduE9bADv4QW8zVN4j2zHe9Qw3pYFvMfU4z02h7d1AO/x5XhPqMZ7whLebD/eE+vxnqTjPWkSb1sV3rYRvKtr8Z6SxnuqhvfUUbztQ3hPK+I9vQLv6WN4
+z8jhzfXgPXMJ79kCb0c13nNMvOcM4T23Be+5K3jPG8HbmcJ7fiXeCyrwXliOt6sCb9c83otm8V48gveSIbzdObyXtuK9rAHv5Qbenlq8V5ThvWIe75WTeK8a
+xNvbiffqVrzXCLzXjOC9tgZvXwpvXxHvddN4r5/Be8MA3v4C3hur8N44jvemLN6bK/DePI03P4P3liLeW9N4b9Pw3jaBd2AK7+1teO9Q8d4p4x2sw3uXjPdu
+Ge89Jt6Cibcwi/c+Fe99c3jv78M71I33gVq8Dwq8D67gfWgK73Af3ofr8T5SgfdRHe9IM97HqvA+toT38QG8T3ThLXbhfbIW71M1eJ9uxjvagPeZDN5nm/A+
+14p3rAfv83V4X6jC+6KG98Ui3vF+vC/V4325Ge8rFXgnNLwTC3hfHcD7Wh3e13W8r8/hLeXxvpHF+2YD3rdUvJMpvG+X4X17Ge87c3jfHcQ7lcf7Xi/e95vx
+fpDHO92G98MC3o868H7chHdGxzszhveTAbyf5vF+1ot3dhTv50N4v+jF++Uo3rlJvF/14P26Ce83LXjny/F+C95vZ/F+b+L9fgrvQhHvD314fyzg/WkI72IO
+78+9eH+px/trBd4lDe9vJt7fVby/F/H+0Y53uRzvn5V4/6rB+3c93pUM3n/a8P47i/e/BXyM4XOM4JNG8TkL+ORJfEoJn7sen1qPz6Ph82r4vFP4fFP4tAF8
+/iK+wBI+XccXMvCF6/FFBvEZTfiiOXyxVnzxLD5zAF8ijy85gs/qwSdG8dlT+FbN41tjBV/ZCr61KvCtXYGvXMO3TiW+ddvxrZfBV1GNb/0Mvg3q8G1Yha+y
+Et//VHwbpfBtnMZXpePbpAzfph34NuvEl5rDt3kJ3xaL+LZcwbeVjG+rRXzbmPhqqvFtq+HbDnzbl+GrrcO3Qze+nQx86WZ8O/fg22UI365z+OoW8e22gm/3
+GXx7LOOrn8O3ZxHfXgP49i7ia1jEt285vv3AlwHf/jq+A8rxHViBr7Ec30GV+A6uxHdIK76menyHduM7bBzf4RP4mpfxHZnBd1QDvpY+fEf34jtmDN+xY/ha
+B/Ad147v+E58J2TxZTvxndiK76QsvpN78LUN41s9gO+UQXynDuJrL+E7rR/f6W34zujCl+vAd2YLvrPa8J1dj6+jHt85OXzn5vCdl8HX2YHv/HF8F4zju3AY
+X9cAvosG8V28gK9bxndpK77LuvFd3o2vJ4fvihF8V87g6wXf1eX4rqnGd20OX18HvuvG8F0/ja8/g+/GXnw3FfDlK/HdUovv1hZ8t43gGxjBd/sSvjub8Q3W
+4burFt/d9fju6cV3L/ju0/HdL+MbqsT3QAbfgxl8D/XiGx7F9/A8vkfm8T26gu+xVnyPt+F7Io+vuIjvKQ3f02l8ozl8z0zie07DNybwPV+J74V6fC+24Bvv
+wPdSHt/LRXyvTON7tRzfa1X4Xi/HV+rA98YUvjcX8b01g29yAd87NfjeHcA3VcT33jK+DwbxfQi+j1L4Ps7gm+nE98kgvk9H8X02j292Ft8XFfi+FPjmUvi+
+qsb3dRW+bzL45pvxfduK77sCvu+X8P0g4/uxAd9PRXyLI/h+nsL3yxS+XxfwLc3j+70S3x9pfMslfH/J+P6uxrci8P1Th+/fAXz/TaE5atCkTjRnCU2pQnN1
+orln0DwGmrcGzZdG0+rQ/N1ogQG04BRaqAIt3IoWmUSLGmixQbT4ElpCRUtWo1m9aLaGtqoebY0+tLJJtLWa0NYuoJXPo61bhbZeJ1rFAtoGZWgb9qFVLqBt
+1IhWpaJtkkLbtBlts3G0zdNoWwygbbmCtlUBbesBtG1yaDUm2ragbTuLtl0H2vaDaLV5tB11tJ0E2k5FtHQH2s4jaLuCVqei1a2g7TaBtvsK2h7zaPVLaHvm
+0fbqRtu7F60hg7ZPDdq+oO07hLbfIFqmhLb/KNoBY2gHdqM1tqMd1Il2cDvaIQ1oTY1ohxbQDutBO7wdrTmPdsQg2pFDaEctoh3djHZMFu3YfrTWGbTjptGO
+H0I7YQwtO4B2YivaSa1oJw+itfWirZ5CO6WEduoEWvs42mljaGeUo+Wq0M5sRDurC+3sVrSOLrRzJtHOnUA7bxztfA3tgjK0C1vRutrQLsqiXdyDdkknWncB
+7dIBtMtKaJdPo12hoV2pol01gHa1hnYNaNfWovXl0a5bQruhAa2/DO3GdrSbVbR8L9otU2i3VaIN5NBun0C7YwltUEO7qwnt7i60e/rRChNo9y6h3d+ONjSP
+9qBAe6gMbbgZ7eE+tEcG0B6dR3usAu3xMrQncmjFBbSnytCebkIbXUR7Jof2rIH27Azac9NoYwtoL2hoL9agjZehvaSjvVyH9ko32sQQ2mugvZ5GK6XQSt1o
+b2TR3qxDe6sabTKF9nYF2tujaO+Uob0zgfZuC9pUDdrUBNp7zWjvt6B90Iw2LaNNj6B9mEf7qAnt40q0jxfRZkbRPulF+7Qd7bNWtNl2tM8b0L7oRPtyGG1u
+Ge1r0L5pQJuX0ebn0b4ton03gfZ9H9pCK9oPRbQfZ9B+KqEtzqP9Atovc2i/TqItzaD9LqP90Ym2nEf7cxjtr2W0v1fQ/qlC+zeH9l83fnrxO2bxOwV+OY1f
+acTv6sLv7savTuH36vh9dfi1Qfz+efyBefy6iT+Uxh/O4I/04zdm8cfAHzfwmxn8iWH8yUn8QsNvV+Ff1YN/jVH8a2r41+rEXy7jX6cZ/7oL+Cta8K+fw79h
+Gf7KOvz/m8S/UT/+jafwV03i36QK/yY5/Ju249/MwL/ZMv5UAf/m5fg3n8C/xTD+LVvwV6fwb9WIf2uBf+sC/m3G8Nc04q9Zxr/tHP7tVfzbj+Ovnca/QwH/
+jmn8O+n4d5rAn+7Hv3MB/y49+HetxV9Xh3+3DP7dU/j3qMVfX4t/zxz+vXL4987gb6jAv4+Of59p/PuO499vEH8mi3//MvwHCPwH6vgPnMXfOIj/oEH8h4D/
+kCn8TTP4D5PxHzaO//Bx/M3z+I+YxH/kGP6jZvC3jOM/ehT/MeP4jy3hbx3Bf9wA/uM78Z/QiD+bwX9iOf6TmvGfnMXflse/ug3/KSX87Wn8p1XjP70X/xmj
++HPT+M8qx392Cn9HCv85dfjP7cR/3iD+zjn85w/gv2AM/4WL+C+qx39xH/5LTPzdZfgvNfFfVov/8mr8PQL/FTr+K6vwX9WC/6pJ/L1t+K+uxH/1AP5r2vFf
+W4u/rw7/dRX4ryviv74R/w0a/htG8ffn8fcv478xi//GSfw31eC/qYj/5jb8+Qr8+SL+W6rx3zKL/9Z5/Lct4B8Ywn97D/470vjvlPHfOYF/sIj/rjz+u7P4
+72nHX9DwF0r4713Af18e//1d+Idq8T+g439gBP+Do/gf6sb/cAr/I5X4H+3GPzKA/7F+/I/343+iHH/RwP9kFf6nmvA/XY5/1MD/jIn/mXH8z7bhf64L/9g4
+/ueH8b+Qw/9iG/7xHvwv5fG/XMT/yjz+iSL+Vwv4X8vgf70Tf6mA/402/G/m8L/VhH8yg/9tHf87Bv53ZfxTKv736vC/X4n/gzL805X4PzTwfyTwf1yBf0bg
+/6QN/6c5/J/14p8dwf/5NP4vG/DPleP/Ssf/tYn/m3L88zr+b2X831Xi/74b/0I3/h+K+H8cw//TFP6fZfw/T+H/tQL/Ui3+3/rx/z6O/495/MtT+P9cwf/X
+Iv4VHf/KPP5/U/j/SxMgT0BSCUgrBORGAkonAdcAAfcsAU8tAW8HAd8oAb9KINBKIDhOIGQQCHcRiMwSiBoEYmkC8SwBc4ZAooZAYpBAsoxAco6A1UtA6ATE
+CgG7SGANlcAa4wTK2gmsWUlgLZPAWgsE1p4gUD5BYJ15AuvlCKwPgfUbCKw/TWCDHgIbGgQqVQL/+39zBDbqJLBxHYEqQaBqisAmPQQ2bSawWQuBlE5g8zIC
+W0BgS0GguobAVrUEtlYJbL1MYJsJAttCYNslAttNEaiVCdRmCNSWCOxQT2CHCQI7thPYySSQFgTSowR27iWwSzuBXXUCu04QqOsmsFs1gd2WCew+Q2CPcQL1
+BQJ71hDYq4zAXosE9l4g0DBKYJ9BAvu2EdgvQyCjE8jMENh/hsABswQOHCfQ2E/goAKBgzsJHNJAoMkk0DRB4NBRAoeVCBw+QqB5icARcwSOHCRwVIlAyzyB
+o0sEjhkkcGw3gdY2Asd1EDi+k8AJ/QSyWQIndhM4qUDg5H4CbSUCp5gETs0QaO8kcForgdObCZwxQiA3QODMTgJnjRM4e4pAxzCBc2YInJsncN4cgc4+Aue3
+E7igjMAFCwQu7CfQNUjgoi4CF48RuGSaQHeGwKUZApfJBC7LE7i8gUBPmsAVgsCV/2+OwFX9BHpbCVzdQOCaSgLXLBO4tp9AX4rAdYLAdbMEru8gcEM7gf4U
+gRsNAjdVEbhpnsDNfQTyTQRuqSdwaxWBW6cJ3DZKYKCZwO0pAndA4I4lAndOExicIXDXFIG7lwjcUyJQWCBwn0bgvkUC988TGBol8MAwgQf7CTw0SGC4k8DD
+XQQeaSPwaBOBkQYCj+UIPN5B4Il2AsUsgSerCTylE3hqkcCoTuCZSgLPmgSeUwmMQeB5k8AL9QRebCEw3kTgpQYCLzcTeKWOwEQDgVdrCLxWTeD1GgKlAQJv
+zBB4q5zAZC2Bt7ME3hkg8O48gfd0Au9XEfigm8D0PIEPewl8VE7go3ECH7cTmGkk8EktgU8FgU9nCHyWIzBbT+DzFgJfCAJfGgS+nCAwN0jgqyyBr1MEvl4m
+MK8TmF8i8O0Uge9rCCw0EfihnsCPzQR+6iGwOEjg53ECv0wS+LVIYGmEwG/9BH4fJ/DHEIHlYQJ/lgj8nSGwkiHwT57Av3UE/l0i8F+JIPMEHX0EpTaCziaC
+cgVBeYWg0k/QVUXQrRJUKwiqcwQ9BYLeYYK+cYJajqC/iWCgkmCwiqBeTjBUTjCsE4xUEIzME4yWEYxVEYw3EzS7CCbqCSZlgskVgkInaLcSXNVKcI06gmWd
+BNfsIrhWFcG1UwTLKwmu00Zw3RzB9QoE1zcJblBGcMMWgpVtBP+XIbjRIMGNxwlWLRDctIHgZh0EUx0EN+8juMUkwS2XCG5VSXDrZoLbZAnWFAhuB8HtawjW
+thLcoZ7gjrUEd0oTTJsE0xMEdx4iuMs4wV3nCNYNE9xtieDuSwT3mCJYXyC45xzBvXWCe88RbJgnuM8cwX0nCO43RjCTJ7j/CMEDlggeWCLYOEXwoBWCh6QJ
+NnUQPHSK4GGzBJsrCB6RI3hkjuBR0wRb2gge3ULwGJPgMdMEjx0g2JoleJxB8Phhgie0E8x2EDyxh+BJHQRPniLY1kpwtU5w9QzBU3oInpoh2F4geNoAwdML
+BM/oJJjLEDyzguBZEDxrmeDZMwQ7Zgies0Dw3AWC540R7OwneP4gwQumCV5YItg1SPCiHoIXjxK8ZJRg9zLBS8cJXlYiePkgwZ48wSuGCV5ZIHjVLMHeEsGr
+VwheW0Gwr5ngdR0Erx8jeEOBYP8gwRvHCN7UT/DmSYL5BYK3mgRvSxEcSBO83SB4RxnBOwXBQQjepRK8WyV4TxXBQj3Be/sI3jdA8P4hgkPdBB8YJvjgJMGH
+Zgk+XEbwkTTBRzsJjqQJPtZD8PFFgk8sEnyynOBTFQSfNgmOVhJ8ppLgs/UEn+snODZH8IUGgi/OEHxJJfhyDcFXUgQnqgi+ahB8rZzg680ES20E3+gk+OYy
+wckswbdLBN+tIjjVT/C9CYLvDxH8YIngh90EP1okOFNF8JMswU8nCM7KBD/PEfxiiuBcE8Gvqgl+nSb4TSXB+SqC30Lw2xWC39cQXEgR/EEj+KNK8MdZgj8N
+EVxsJ/hzjuAvrQR/rSe4lCb4WyXB32sI/tFEcHmU4J+zBP8aJ/j3EsF/BMF/ywn+V4NOA7qjH12aQHfOoismuqsD3d2Pro6heyvRfU3oWhu6vxc9MIUenEHX
+Z9FDU+jhEfTIBLqxiB4dRY8NosdL6OYSelJDt2rRRQO6XYu+qgZ9jQx62SD6mjPoay2il7ehrzONvu4c+nrT6BXL6Osvo29YgV5Zg/6/OvSNWtE3bkavakTf
+pIC+aQF9swJ6qoS++Rj6lir6lsvoW+XQtx5D32YQvWYYfTsTfXsDvbYJfYcC+k7V6OkB9J1H0HcFva4Ffbce9N2H0fdYRt+zBX2vfvQG0PcR6PtWoe/Xgp6Z
+RN9/Fv3AOvTGTvSDhtAPnkdvKkM/tBP9sFH05hr0I7LoR/ahH1VAb5lFP2YSvbUK/bgB9ONn0bMG+onl6Cd1o5+cR19dh35KHv3UTvTTKtBPb0c/o4ieW0I/
+y0Q/O4fe0Yl+zjL6eZXonQPo58+gX9iE3tWKflEX+sXT6N3V6Jc2ol82hN6joV9Rh37lBHov6Fc3o18ro/f1ol83i36Dgd5fiX5jF/pNM+j5ZvRbetFvXUIf
+aEa/fQL9TgN9sA79rhH0eyrQC0Po9y6g39+KPjSF/mAK/aEx9Icz6I8MoI/I6I+1oD8+jl7U0Z/sR39qDn20Gf2ZWfTnytDHWtCfL6C/WIY+3on+Uh/6y/Po
+E+3orw6jv16BXupCf2MB/a1a9Mk+9HdM9Hc70d+T0d/vQP8gjf7BEvr0GPqH0+gf16LPgP6Jjv6pjP7pHPqshv55GfoXJvqX9ehzHehfjaJ/XUT/Zgp9fhL9
+2wL6d0voC1XoCyvoP8yh/ziO/tMM+s+16L/UoP9ai76UQ/+tE/33XvQ/ZtCXR9D/nEX/uxp9pRn9n1b0f0fR/5sg5KgmJDUTcjYSkgcIKSuE3C2E1BwhzyAh
+b4mQZhDyy4QCOqFgBSG9klCoilA4TSjSQcgYJBSdIxQvEDJnCSVmCFnlhEQFIbub0KpFQmWC0Jq9hNYaJlRuElqnldC6M4TWWyS0fh2hDeoJbdhNqHKR0P/a
+CP1vmdBGc4Q2HiNUNUdokylCm84SSkEotURo8z5CW1QT2lIltGWRUHWaUPUyoa16CG1dSWjrBULb9BOqyRLaNkVouypC26cI1VYQ2iFHaMdBQjuVEdppkFC6
+idDOKUK7QGjXJkJ1FYR2ayC0ex2hPboI1ecJ7TlOaK9FQnsvEdpHI7SvTmi/SkIZg1BmkdABaUIH5gg1thE6aJzQwV2EDikQahondBiEDlsmdPgyoeZlQkdW
+ETqqnFBLLaGjuwkdM0Ho2CVCx6UIHd9P6IQ8oewioRNHCZ3US+jkLKG2KkJty4RWTxM6pZ7QqSahU0uE2lsInaYROm2B0OljhM7IE8o1EjrTJHSWTujsFKGO
+VkIds4TOGSB0bp7QeVWEOg1C5xuELigjdGEzoa56QheVEbpohdDFY4QumSDU3UHo0iyhy0YJXd5FqGeB0BVLhK6qINTbTOjqdkLXtBG6dpBQX5HQdcOErl8m
+1F9N6EaT0I0FQjflCN3cRSjfTOiWNKFb6wndJgjdtkBooJ/Q7WWEbp8gdEc3oTs1Qnd2Exo0Cd0lCN1dQegendA9i4QKA4TubSB0X4rQfdOE7h8iNFRJ6AGT
+0ANFQg/mCT2UITRcSWh4ltDDE4QeyRN6NEXo0SlCIzlCj6UIPW4QenyG0BN5QsUGQsVFQk/OEXqqSOjpLKHRNKFnIPTMNKFn5wk9N09obIzQ8zOEXugk9OIw
+ofEhQi8VCb1cIPRKgdBEJ6FXewi91kPo9XFCpWFCb4wQenOK0FsdhCbbCb2dIfROI6F3qwi9u0JoqkjovW5C7/cR+iBPaLqb0IdNhD6qI/RxjtBMP6FP+gh9
+miX0WTOhWUHo8xShL1KEvqwiNFdD6KtyQl+NE/p6mNA3JULz3YS+nSf03SSh78cJLYwT+mGK0I/ThH6aJfQzhH7RCf1aTmjJJPRbJaHfU4T+SBNazhD6s5rQ
+X9WE/loktKISWhkj9E8/oX/7CP03Q9hRTljKEHbWE5YbCCvVhF21hN1thNUBwp5Rwt5Bwr5JwtoC4UAT4WAPYb2fcGiCcEQjbAjC0RzhWIlwvJJwfIFwQhBO
+rBBOthC2yghbs4RFlrDdS3hVI+E18oTXWCJcNkx4zSzhtWoJr60SLtcIly8QXmeO8LqzhNebIFxRTXh9k/D6S4Q3GCe84Szh/6mEN6omvHE94Sqd8CYq4U3L
+CG86Q3izPsKpIuHNhwlvUSS85QrhrSC8dQ3hbeoJ11QR3raJ8Hb1hLfPEa7tJ7zDNOGdygmnM4R3VgnvPEF4lxnCdTLhuj7Cu2UI754jvMcs4foc4T0zhPec
+JrxXE+G9dcJ79xBuaCa8j0F4nxLhfYcJ75clnGknvL9G+AAIH9BF+MAM4cZKwo3LhA8aJXxwivDBs4QPyRJu6iF8aB3hQxcIH7ZE+PABws0Fwkf0ET6yn/BR
+zYRbGgm3rBA+uofwMYLwMQOEj60n3Arh1hzh4yoJHzdK+Ph2wscvEz5hknB2kPCJ9YRPnCV8UonwyQXCbW2EV6cJn2IQPmWK8Kk5wu1pwu0rhE8rED59hvAZ
+g4RzzYTPrCJ8VprwWSXCZ3cR7hghfE4D4XMrCZ87T/i8UcKdXYTP1wmfP034gh7CF7YQ7qoifFET4Yt1whevEO5OEb5UJ3zpFOHLlghfPke4Z4HwFQXCV+YI
+XzVGuHeW8NXDhK/pJnxtFeG+BsJ904SvGyZ8fSfhG1KE+yHcv0L4JoPwTZOEb+4nnO8gfEsb4VtLhG/rJjzQSfj2FOHb5wjfsUT4ziXCg8OE7+onfHcn4Xu6
+CRfKCd9rEL5PJXx/ivD9s4SHBgk/MEz4wUnCD2UJDzcRfriD8CNFwo/2ER5pJfxYFeHH04SfqCZcNAg/qRF+Sib81CLhp4cJjzYTfqaZ8LNpws81Eh6rIDw2
+R/j5POEX2gi/WEl4PEv4pQzhl1OEX6khPGESflUj/JpM+LVJwq/3Ey41E36jlvCbNYTfqic8mSf89gzhdwqE380QnkoRfq+W8PtVhD8wCE+nCH9YT/ijFsIf
+dxGemST8SZHwpy2EP+smPNtK+PMM4S/6CH+5QHiuSPirIuGv+wl/00l4vp7wt2nC37US/r6N8EIT4R8aCf84SPinUcKLecI/txH+pYvwrz2El5YI/7ZM+A+N
+8B/zhJfnCP8lE/47RXilkfA/ZYT/FYT/ayFCloijmohUR8SZJyJ3EVGGibgmiLgXiHhMIt5aIr42IlobEX8XkUAHkeAIEX2JSLicSEQmElkmYkwRiS4QiU0T
+iU8SMVeIJHUiVoqIaCRitxBZlSdSphIpWyCy5gqRtRaIrD1PpHyayDqLRNadJ1JRTWT9DiIbDBDZcJzI/2qIbNRBZONJIpukiGzaQGSzLJFUM5HNe4ls0U9k
+yzkiW+lEtk4R2aaRSE2OyLYlItvNEqmtIbJDF5Ed64nsVEZkpwki6WEiO/cQ2WWQyK5tROrKidQtE9ltjMjuJSJ7zBGpbyWyp05kzzyRvWqI7DVPZO8uIg3V
+RPbRieybJrKfRiSjEcmMENm/g8gBjUQOrCHSWEfkoDoiBy0QOXiIyCFZIk1lRJpmiBw6ROSwLJHDy4kcPk2kuUTkSJPIkaNEjuoj0tJF5OhmIsc0EjlWJ3Ls
+BJHWESLHtRM5fpTICSUi2REiJ+aJnFQkcvIwkbYCkdUlIqesEDl1hkj7ApHTxoicPkzkjH4iuV4iZ/YQOWuYyNlTRDrmiZwzTeTcKSLnLRLpnCFy/gyRCyaI
+XDhOpGuOyMUGkUsg0p0mcmmKyGU1RC7PEunpJHJFJ5ErJ4hctUikt0Tk6hKRa4pErp0m0lcict0EketniNwwQaR/gsiNi0RuFkTyOpFbBJFbK4jcliEyUCBy
++wCRO8aI3LlE5K5KInfXErmnkUhhhMi9eSL3zRIZKiPyQBmRB6uJPFRBZLiNyMMlIo9ME3l0lshjVUQezxJ5ooNIcZbIUwaRpwWR0QyRZ5qIPNtP5LkBIs+b
+RJ7vJPJCHZEXa4m8uEBkfIbIS9NEXp4m8sokkYkRIq+OEXltkkipjMgbTUTerCHy5hKRtyaJvC0TeXuKyDsTRN4dIzI1ROS9ViLvDxD5oIvIdDeRDzuJfNRD
+5ONhIjPDRD7JEvm0nshnNURm64h83k3ki2EiX5aIzC0R+Voj8k09kflmIt+2Evmuksj3BpEFlcgPZUR+bCTyUw+RxR4iP/cS+aWLyK8lIkvDRH4rEvl9jMgf
+U0SWp4n8OU7kr2kif08RWVkm8s88kX9LRP5bxGAFQ1IxnGUYchmGksVwdWG4RzHUKQyvhuFLY2jNGP4OjMAYhm5ghNowwm0YkSEMYxojVosRb8YwuzESCxhW
+NYYYwrCnMFY1YaxRhbHGJEbZEsZaKsZa/Rhrd2CUN2Csk8ZYtxJjvTKMihqM9fswNujA2LCIUTmG8b8SxkYrGFVlGJtUYGzajLHpIkZKxkjNYWxexNhiCGPL
+LozqHMZWDRhbt2NsU8SomcDYrhxj+1aM2gmMHaoxdihh7NiGsRMYO41jpBswdtYwdh7B2KUeY9cKjF2nMeqyGLvVY+y2grH7IMYefRj17Rh7dmDsVY2xdyVG
+g4bRsIixr4mxbwljv1GMzADG/s0YBwxjHJjFaFQxGkcxDmrHOLgV4xAT45AVjKZZjEMLGIdNYxzejdHchHFEF8aRWYyj6jBaUhhHd2Ic04JxbA1Gq4lxXCXG
+8SmM45cxTihinAjGibMYJ41hnLyIsboC4xSBcWo1RnsVxmkyxmlLGGdUYOQaMc40Mc6qxji7HaOjFeOcGoxzWzHO68HozGGc345xQRrjwmqMrgqMi2oxLm7B
+uCSH0Z3DuLQV47I2jMu7MHrSGFekMK6swbiqCqO3DOPqEYxrFjGuncXoW8S4bhnjBg2jX8e4sQ7jpgzGza0Y+XaMW7owbh3CuG0J4/YqjDvKMO40MQZNjLsM
+jLvLMO5pwSgMY9w7hHHfKMb94xhDRYwHxjEenMEYVjEersN4pA3j0XGMkRWMxysxnqjFKGYwnmzDeKoT4+l5jNF5jGdljGeXMcZqMJ7vwHhhBWO8EeOlPoyX
++zBeGcKYmMR4dQ7jdRnj9SWM0jLGmw0Ybw1jTM5hvGNivGtgTFVhvNeN8YGMMa1ifFiP8VEeYwaMT7IYn85hfJbB+KyEMZvB+Lwc4/NhjC/qMb4oYnzZgzFn
+YnxVgfHVGMbXtRhfL2B8M4Axn8WYX8H4toTx3RjG9zMYC6MYP5QwfuzF+KkN46dFjMV+jJ/LMX5exPilE+PXGoylFozfyjB+G8P4fRTjj16M5QaMPxsx/qrA
++KuE8fc8xso0xj+9GP+mMf7rJUo9UYdOVBJEpRWizgJRuYuokifq6iXq7iSqNhP1VBL1GkS9i0R9C0S1HFF/E9FABdHAMtHgDFE9TzSsEg1PEY2MEzVGiEaH
+icb6icZbiZqdRBN9RJOjRK0SUVEkao8TXbVMdI1JomXjRNdSia41R3TtOaLl00TXmSe67grRigLRDWSiG3QT3VAQrSwjWjlHdKNyohuniVZVEd0kT3TTQaKb
+TRBNLRPdoo7ollVEq0eJbi0T3aaOaE0n0W0niG63TLQ2Q3SHGaI7yUTTdUR37iS6yxjRXZuI7rpItK5IdLd+orvXEN2jgugei0T3lInuuUx0r2GiezcSbagj
+2rBCdJ9xovv2Ed2vlmimgej+KtH9F4geMEP0wDzRxj6iB3UTPbiF6CGCaFMj0UMriR5WQfTwHqLNeaJHNBA9sovoUXmiLQNEj14meswk0WNniR5nEj1eJXqC
+RjSrEz1RI3riBNGTK4i2VRBdDdFTDKKnVhJtzxI9bYDo6ctEc+VEz2whelYX0bNHiHbMEj1XJXqeRrSzmuj51UQv6CB64QDRrjzRi6aIXtxJ9JIU0W6daHeR
+6KVdRC+rJXp5DdGeeqJX1BG9spLoVRDtVYleXUX0mkqi16aJ9tUQ7Vsiet0Y0euHid4wRbS/k+iNaaI3pYne3EA030b0lizRW7uJ3tZIdCBN9PYKonekid6p
+ER1MEb2rk+jdbUTvyRAt5Ije20L0vh6i9+eIDg0RfRCiD9URHW4j+vAo0UemiT66SHRkmejjaaJPqESLVUSfbCT6VBvRp/uIjpYTHZ0g+kw70WezRJ/TiD5X
+JPo8RJ+fIPrCKNEXu4iOp4m+VEv05TKir1QQncgQnZgj+uoA0dfaiL62QPT1ItFSB9E3uoi+2Ur0rRTRt1aITs4RfXuJ6DsdRN9tIDpVSfS9JqLvVxL9oJLo
+tEn0Q0H0I4Pox2VEZ3SiM3NEPxkl+ukw0c+KRGfniX4+QfSLPqJf5ojO9RD9qp/o1wWi3wwTne8j+u0Q0e9GiH7fQ3ShgegPjUR/rCX6UyXRRZ3oz7VEf6kl
++msN0aVGor/VEv29nugf3USXe4n+WST6V5Ho31NE/9GI/ttA9L8GYnQRcwwSkwrEnKPE5BFiyjgx1wgx9yAxdZKYZ4GYL0VMayDmbycW6CcWbCSm1xILtRIL
+9xOLDBMzCsSi1cSic8RiBWLxBmKmTswcJZYoEEt2E7MKxEQ3MbuZ2KpKYmukiJVliK1ZR2ytQWJrjxMrHya2Ti+xdfuJrTdArGKK2PoLxDbMEKtsIva/VmIb
+p4hVpYhtUktsU4htVk0sVU5s8yZiWwwT23KQWPUMsa3miW1TRqwmRWzbZmLbdRLbfprYDiqxHduI7dRPbGed2M49xHYRxHaZI7ZrjlhdlthuHcR27yW2Rz+x
++hZie6aJ7dVAbO8MsYY+Yvt0Edu3k9h+NcQyKWL7lxM7QCV2wDyxxhSxgzLEDm4gdkiOWFOJ2KENxA5dInbYJLHD88Sax4kdqRM7qoZYSwuxoweJHbNCrLWL
+2HElYsd3EjuhkVi2jtiJgtiJU8ROaiJ2cpZYm0GsbY7Y6nZipxjEThkjdmo3sfYaYqeZxE6bJHZ6H7EzMsRy5cRyU8TOnCZ2VoHY2QViHVli5whi58wTO7dA
+7Lwcsc4aYudXEDt/htgFw8Qu7CbWlSd2UZrYRYvELp4kdskIse5mYpc2E7usltjlNcR6TGI9U8SuKBK7co7YVQPEeseIXd1J7JpmYtdWEOurI3ZdFbHr08Ru
+yBLrzxG7sZHYTf3Ebs4Ry08RuyVP7NYWYre1EhvoIHZ7jtgdlcTuLCN25xyxuyB21zSxe2Ri98wQK4wSu7dA7L4JYvfPExtaJvZAidhDGrGHFog9XEHsEZXY
+I/PEHl0kNjJD7LEZYo8PE3tikFhxkNiT7cSeaiL2dJrYaAWxZ8qJPVtP7Ll6YmPtxJ6fIvbCCrHxMmIvlRN7uZHYK23EJuqIvVpH7LVOYq/niJW6iL0xROzN
+CWKTKrG3y4i9kyL2bhOxqVpi7/UQe3+c2AfzxD6sIvZRmtjHOWIzc8Q+WST2WZbY7BixL3RiXzYRmxsl9rVO7OtOYt+0E5tvIfZtBbHvZGLfTRL7fpjYQhux
+HyqI/TBF7McRYj9liS02Efu5gtgvTcR+rSG2VE3styyx3zPE/qgjtpwm9qcg9uc0sb8miP09QGylm9g/XcT+7Sb23wRxxok7JohLReLOAeLyMnFXM3F3HXG1
+jbinjri3hrivjbhWIO5fJB4sJ643EA8NE4/IxCODxI0m4tFy4jFBPDZDPD5K3CwQT/QQTw4Qt8aIiwJxO098VQvxNeqIl6WJr9lOfK1h4mv3Ey/PEV8nR3zd
+SeLrLRNfv4L4BjrxDduJV/YS/1+J+EZTxDdeIl61QHyTWeKbzhHfbJr45lXEt2gjvuUQ8eoZ4lvNEt96hXhNivi27cS3GyS+/QLxHfLEd5wmnm4kvvMA8V1l
+4nW1xHfrJb57lvgeTcTrW4jv2UR8r37ie48Rb6gl3rBAfJ8Z4vt2Ed9vjHimRHz/ceIHjBA/cIh44xjxgwrED54kfsgo8aYh4ocOEz+sj3izTPyIGuJHNhE/
+qpV4yzDxYyqIH9tBvHWa+HFTxE8oJ56tIX7iNPGTpom31RBfPUz8lGnipy4Sb18ifrpM/IwG4rl64mcOEj9rhnhHFfFzaomfmyF+XhfxzgHi588Rv1AnfuEK
+8YvSxC9OE79kjvilGeKXDRC/fJb4FeXEr2wnflUX8d4R4tdoxK9NE+8bIH69SvyGBuL9ReI3GcRvzhLPdxG/ZZH4bTXEBwaI3z5D/I4F4oMm8bsaid9dJH7P
+LPF7y4jflyN+fz/xoVniD9YSfyhLfLiX+CMy8UdriY90En9snPjjy8SLg8SfXCH+dC3x0X7iz9YQf66N+Fie+As68RdbiI93En9phPgrBvGJFuKvDhN/vYx4
+KUP8jUnib8nEJzuJvyMTf7eT+NQS8fe7iH+wQPzDSuIf9RGfMYh/MkT8s1ris3niXxjEv6wnPjdA/KsV4t9UEZ9vI/7tGPHvq4gv9BD/EeI/NRJfnCL+SxXx
+X+uJLw0S/72S+B8txJdHif9VTvzvHuL/QPzfduL/9WMyiylVYjo7MRUD09WE6V7G9DRhekcwtTJMfxtmYAJT1zBDzZjhPKZhYEaHMOPNmOYKZjKLKcow7VHM
+Naowy2Yx1+rCLNcx12nHXFfFXLeAuV4jZkUF5voa5vqTmBv0Ym7Yi1nZgPm/SsyNyjE31jA3nsfcBMxNwdx0HnOzOcxUP+bm45hb9GJu2YFZXYVZvYS51QTm
+1iXMbXowayYxty1gbpfH3L4Js1Zg7mBi7rCCuVM5ZroOc+c85i6jmLvmMev6MHcbwtx9GHOPMcz6Ucw9FzD3msPcewpzHx1z3zLM/cDMqJj7V2IekMI8UMM8
+cAHzIBPz4BrMQ2oxm6oxDy3HPCyNeXgGs7kH84gc5pEdmEcNY7YsYh5Ti3lsI2ZrE+ZxBczji5gnFDCzU5gnLmCenMZsa8FcPYl5qol5agmzvRPzNBnztB7M
+0xsxz6jAPGMZMzePeWYf5lntmGc3YJ69gtmxhHnOKOa5o5jnDWF2DmKen8e8II95YTNml4nZNYF5URHz4k7MS5owuysxL1UxL13EvGwes6cS84oU5pUm5pUr
+mL3lmFcbmNdomNeWY/YZmNdVYl7XjXl9Neb1S5g35DD7KzD7i5g35jBvXMG8aQzz5nbMfCVmfgLzlnbMW8sxbxOYAyrmwALm7ZOYdxQx7+zEHGzCvKsa824D
+8+4ezHvaMQsG5r0G5r0zmPf1Y96fxRyqwxyaxXxgCPPBVsyH2jCHTczhEubDw5iPTGM+OoI50o/5WD3m402YT7RgFlOYxXnMJ+cwn5rAfHoac3QR85kS5nMa
+5nOLmGNTmM9PYL4wiPniPOb4NOZLE5gvL2O+Mo05MYX5mon5uoZZkjFLi5hvCsy3VMy3FjAnpzDfqcJ8twFzqhrzPRPz/RrMD+owpwcwP+zD/KgF8+NWzJlO
+zE8GMD/tw/ysB3O2gPn5DOaXGcy5GsyvWjG/LmLO65jf1mJ+N4z5/SzmDxrmDyuYP9VjLo5j/tyC+UsG81cdc0nD/E3H/G0R8w8Tc7kS888KzD+XMf9awPx7
+DnNlFPOfAua/E5j/jZNwQEKqI+FsJiFnSShDJNyVJNRuEl6ZhK+ahNZJwl9NIqCSCBRJBHMk9ByJUBeJcAOJSDMJo5JE1CARXSIRWyIRnyBh9pFIDJNI5klY
+fSREOwk7R2JVO4k1akiU1ZFYs5rEWo0k1m4lUd5GYp1eEusZJCoaSazfTWKDQRIbTpKoXCCxkUZi43oSVd0kNimR2HSBxGadJFIdJDbvIbFFP4ktSySqUySq
+50hstURi6w4S27STqGknsa1KYjuDxHaLJLZfIVE7SWKHfhI7DpLYaYREupfEziUSu4yT2HWGxG4Gid1bSOwxSmJPmcReFST2zpJomCGxb4bEvvMk9pslkRkl
+sf8MiQOaSBxYTaIREo1ZEgeZJA4aIXHwNIlDZkk0zZI4TCVxeIrE4SUSzT0kjsiQONIkceQMiZYyEi1LJI4RJI4tJ9GqkTiuisTxBokT0iSyXSROHCNx0hSJ
+k0dItHWTWD1E4pQpEqdOkDjNJHF6LYkzuknkCiTOnCNxtkaio5zEOdUkzk2ROK+PROcIiQsgcaFKoquOxEWNJC5uI3FJL4nuFRKXmSQuryfR00DiihESV86R
+6K0g0TtH4uoiiWv6SVzbTqIvQ+K6ERLXD5G4YZhE/xCJG2dI3KyTyFeRuMUgcWsbidvyJAYGSNw+TeKOMRJ3LpG4K0Xi7hYS93SSKHSQuHeUxP0aiSGTxAPl
+JB5sJ/FQNYnhOhIP15B4pILEo9UkRlpJPJYn8fgiiSemSDwJiacg8VQPiafzJEYHSDwzT+LZBRLPzZN4vorECx0kXpwh8ZJG4uUKEq9kSEw0kXh1hcTrtSRK
+rSTeaCfxZi+Jt+ZJvN1G4p1JElPNJN6bIvGBTmJ6kMSHUyQ+VknM9JD4ZJ7EZ2kSs6Mkvigj8eUgibk5El/3kPhmisS3ZSS+ayPx/QCJH0wSP4yR+HGcxE9T
+JBaXSPw8RuKXIRK/ZkgsGSR+EyR+byHxRxOJ5RyJP0dI/DVA4u9OEit9JP4ZI/HvCkk0kgySdLSQlAoknfMk5RJJZYGka46ke4mkB5KeRZK+bpLaAEn/MMnA
+NMngOEl9jGS4gmSkmqTRQDLaSDLWRTLeT9LsJpkokLQESdFK0p4luYZKsixFcs1ekmutkCxvJLlOF8l1R0mut0xy/RqSG3SQ3LBE8n8GyY1SJDfuIVnVSXKT
+AslNp0imWkluXiK5xRLJ6g6SW42T3KaKZE2B5LYaye3KSW4Pye2HSNb2kNyhh+SO8yR3KpBMF0ju3EtylwaSu9aSrJNJ1g2S3K1AcvdpkvXlJPc0Se7VSHLv
+GZINMyT3mSW5n0YykyK5fxfJAypJHlhOsrGZ5EFVJA/uJ3nINMmmZZKH1ZM8vJ9k8yTJIytIHpUj2TJE8ugVksf0kDy2m2RrN8nj8iSPHyF5wiTJ7BzJE0sk
+T1ok2ZYhubqd5Cl5kqeWSJ5WSfK0CZKnD5M8Y4jkma0kz1JJnjVL8uwFkh0rJM+F5HmCZGcdyfM7SV6wQrKrhuRFPSQvHiZ5yRDJ7gGSl46RvGyE5OXTJK9Q
+SV6ZInnVBMneEsmrF0le20Kyb4jkdWMkbygj2Z8heWMfyZuGSN68QPKWLpK3DpK8bZnk7SmSd3SQvLNE8q5yknfnSN5TInlvGcn7eknev0LygUqSDw6THC4j
++XA3yUchOTJA8rFlkk/UkSyOknyyheRTJsmnK0mO1pB8Jk3y2TaSzw2THFsm+UKO5ItpkuPlJMeXSb40SvLlMZKvzJKcmCf56jTJ1+ZIvj5D8o1Kkm9Wk3yr
+geRkiuTb5STfMUi+q5KcEiTfqyL5fj3JD2pJTreR/HCE5EdDJD8eJTmzQvJTg+RnZSRna0h+3k3yiymSc5D8qp7k130kvymQnC+Q/K6C5PdZkgtdJH8YIvmT
+SXKxmeTP8yR/6SL5aw/JpVqSv1WQ/L2K5B8GyT9WSP6pkvxLI/m3ILlikPwHkv/WkPyvCotqLEcDltSE5azFkkexXCqWu4ClLmN5ZrG8M1i+FSx/PVagEytY
+wNJLWOF6rEg3llGHFR3BitdgmXmsxBxWcgXLmscSy1irdKw1VKw1JrDK8lhrzmCtLbDK+7HWKWKtO4C1XhdWxQzWBhrWhk1YlR1Y/8thbTSCtXEBq6qAtckc
+1mblWKlerM0XsLaswNpyFqt6EWvraqxtmrBqWrC2bcHaLou1/TBWbSPWDgJrRw1rp3Ksnaax0hNYO89i7TKFVVeJtVs71u69WHsUsernsfZcwNobrIYGrH26
+sPatwtqvEyszhLX/ANYB/VgHDmI15rAO6sA6uBXrkAqsQ3qwDlnGamrBaprCOrQe69BprMPasA6bxzq8FatZYDUPYR3RjnUkWEf2YR1ViXXUIFZLJVbLGNbR
+VVhHF7COKcc6poB1bAXWsSNYrQ1Yx5VjHTeKdXwF1vF5rBM0rBN6sLLlWNki1onVWCcWsU6qwzppCOtkE+vkLqyTl7DaslirwVrdjrV6EeuUTqxT67BOncJq
+b8Vqn8U6rQXrtCWs01uxTp/DOqMN64xlrFw31pkVWGeBdVYe62wV6+wc1tkrWB1ZrI5ZrHOasc6Zxjq3CevcRazzRrE667E6S1jn12GdP4F1QSPWBYtYF/Zj
+XTiF1VWO1dWO1TWBdVE51kV5rIsrsS4uYl2iYV2SxbpkDqs7g9U9gXWpiXVpJ9al81iXNWFdtoh1eQvW5bNYPVmsniWsK+qxrhjFurIG68oxrKt6sXrLsXqz
+WL0lrKsrsa7ux7oGrGvasK5Zwrq2HevaUaw+A6uvA6tvDuu6FqzrBrCuW8C6vhrr+m6s62exbqjHumEMq78eq38A60awbmzHunEO66YM1k0FrJvBurkJ6+Zx
+rHwHVn4J65ZGrFvmsW5NYd3ai3XrAtZtGazbRrAGyrAGBrAG5rBur8a6PY91ewnrDh3rjgzWHXmsOxaw7mzAujOPdeci1mAT1uAY1uAK1l2NWHeNYt0tsO7u
+wrp7CeueTqx7FrAKzViFeax7s1j3LmPd1451v4Z1fz/WUDXW0DjWA01YDxpYDw5hPVSP9dAK1vAc1sO9WI9UYD1Swnq0BWsErJFBrMfSWI8tYj0+hPXEAFax
+Eau4jPVkD9ZTlVhPjWM93YD19ALWaA7rGR3rmUGsZ2uxnl3Eem4aa6wL6/lKrOeLWC+ksV6YxnqxG2s8hTU+hfVSC9bLMtbLRaxXBrEm0lgTS1iv1mK9Ooj1
+moz1Wh7rdQ3r9RzW6wtYpXas0jLWGx1Yb6pYb7ZhvbmA9VY71mQ91uQ81ttNWG/PYr3TjfXOPNa7TVjvzmBNZbCmprHey2K9N4H1von1fjvW+5NYH1RjfTCF
+NV2DNT2M9WEK68MRrI+qsT4awPrYwPp4GmumGWtmGuuTOqxPJrE+bcD6tIT1WQZrthxrtgtrdhHr81asz6exvmjA+mII64tFrC/TWF/2Y82VY811Y32lYn2V
+x/pqGevrZqyvZ7C+acD6ZgRrPo01P4H1bR3Wt9NY37VgfbeM9f0A1kIaa6EDa6GE9UMl1g8dWD+UsH6swPqxH+snHeunVqyfJrAWa7AW57F+bsT6uYT1Sxrr
+lwGsXw2sXxuxfh3BWtKxlnJYSzNYv9Vh/TaB9Xsn1u8rWH/UYv3Rh/XHPNZyHdZyAevPMqw/G7H+LGD9VYX1VwfWX9NYfzdg/T2JtVKBtdKNtbKC9U8B618T
+698erH+nsP5LYf3XhwAErQimEY4mhGQipH6ENINwViGcXQjnHEKuRcgFhNKEUKYQrhTC1YVwzSHcAuFuRbhHEKqGUDsR6hTCU4XwDCK8OsLbjvDOIXzVCF8/
+QlMR2gTCbyD8WYR/ChFIIQJ5RNBEBJsRwSJC1xF6O0KfQYQaEaFJRFhDhBsR4UFEeAkRqUdEBhCRFYTRhjBmENEqRHQBEWtAxPKI2DIi3oKITyDMaoTZiTCn
+EYkaRCKPSMqIZAciOYRILiKsKoTVibDmEKIBIfoRYgFhZxB2EbFKIFblEKuKiDU0xBpZxBp5xBpziLIKRFkHomwBsWYNYs0+xFog1mpFrDWBWFtGrN2IWHsU
+Ua4jylsQ5eOIdaoR64wg1q1GrDuJWK8SsV4vokJFVBQR61ci1h9EbFCG2KAfscEsYsNaxIYDiEoNUdmOqJxD/K8VsVE5YqNBxEYLiI0rEBt3IDaeRVRVIaq6
+EFVziE2aEZvMITatRWw6iNhMIDbrRaQ0RCqHSI0jNq9AbN6D2HwFsUUrYosZxJYtiOpyRHUBUb2I2KoOsdUQYusWxNaziG2qEdvkEdvMIGqqEDWdiJppxLbV
+iG1HENvVI7YrILbXENt3IbZfRtS2I2qnEDtUInboQ+yoIXZsQ+xUi9ipF7HTIiLdjEhPIHauRuw8ithFQ+zSgNhlELHLEmLXDGLXYUSdgajrRewmI3ZrQuxe
+hti9G7GHQOyRRexRQtTXIOqHEXtWI/YcReylIvZqRuxVQuydQuw9jNh7HtGQQjR0IBomEPuUIfbpQ+wzh9i3BrHvEGI/A7FfI2K/HsR+04hMGSLThshMIPYX
+iP1ziP3nEQf0Ig5YQRzYhjhwDNFYjmjsRjQuIw5qQRw0gzi4BXFIOeKQAqIphWjqRRwK4tA2xKELiMNaEIfNIQ7PIZrTiOYpxBHliCO6EUfMI45sRBw5iziq
+DXHUDKKlAdEyjTi6H3GMQBxTQByrIo7tQBy7iGhtQ7QuII5rQxyvIY6fQZzQhjihhMj2IbILiBMbESeOIU6qRpw0jjg5hTh5DNFWi2grIFaXIVZ3IFbPIU6p
+Q5wyiji1GXHqJKK9EdE+jTitFnHaCOL0asTpRcQZtYgzphG5CkSuG5GbQ5xZhzhzBHGWQJzVgzhbR5ydRZw9jejIIDomEOekEef0IM6ZQ5xbjzh3GHGeQJyX
+R3SaiM4WROcY4nyBOL8Lcf484oJGxAXTiAt7EV0aoqsHcRGIizoRF00hLq5BXFxAXCIQl/QgumVEdy/i0gzi0hnEZVWIy/oQly0jLu9E9MiIngZEzxDiChVx
+RRviiinElbWIK8cQV+UQVy0hetsRvSXE1VWIq/sQ18iIa9oQ18whrs0irl1C9NUh+oYQ12mI6zoQ180jrm9CXD+HuGEQ0V+B6O9B9K8gbuxC3CQQN7UibhpD
+3Gwibu5E3DyLyNcj8iXELXWIW4YQtwrErUOI27oQt80gBtKIgSLi9nLE7XnEHRWIO4YRd6qIO7OIO2cRg42IwTnEXa2Iu4qIu3XE3a2IuycR91Qh7skjCgJR
+6EfcC+LeCcR9tYj7BhD3a4j72xH3LyCGmhFDi4gHOhAPTCEerEY82I94SEM8lEM8tIAYziGGVxAP1yAe7kE8PIN4pBrxSC/ikUXEo62IR6cRI/WIkSHEYwbi
+sQzisQLisWXE442Ix0cQT5iIJ3oRRRlRrEcUhxBPaognBxBPyYin2hBPLSKeziGenkWMNiBGpxDPZBDPzCCerUM8W0I8V4Z4rhvx3BJiLIsYm0U834R4fh7x
+whDixRTixXHEuIkY70CMLyJeyiFeGkO8LBAv5xAvzyFeySJemUJMpBETE4hX04hXJxCv1SNeG0S8biBe70KUQJRyiNIy4o0exJuNiDfnEG9VI97KIyZBTLYj
+JlcQb48i3qlFvDOJeLcK8W474t0JxFQGMVVAvKci3utGvDeHeL8e8f4E4oMaxAd9iA9WENPjiA8bEB8OID6SER+1Iz6aR3zchvh4CTFTh5gpID4xEJ90Iz7V
+EZ82Ij4dRnxmIj7LIj4bR8yWI2bziM9NxOc5xOdziC9aEF/MIL6sQXw5hJjTEHPtiLkFxFdZxFcriK+ziK/HEd9UIr4ZRHyzjJjPIObHEd9WIb4tIr5dQnxX
+i/iuH/HdAuL7ZsT3A4jvlxALdYiFAcQPMuKHTsQPM4gf04gfRxE/ZRE/zSIWmxGL44ifdcTPbYif5xC/dCJ+mUX8mkb8OoxYSiGWxhC/ZRC/jSF+TyF+H0X8
+UYv4YxixrCKW2xHLc4g/mxB/TiL+akD8NYv4u4BYSSFWhhH/qIh/2hD/TCP+zSD+HUD8B+K/ZsR/49hUYVPEdpRhO3qxJQNb6sN2CmxnDts5jy03YsslbKUe
+W5nAdtVju2ax3UPYag222oetrmB7mrE9U9jeemzvNLYvj62VY2t5bD/Y/nZs/yh2QGAHerAD89jBDHZwFFuvxNaHsUNV2KFe7LCMHZ7CjqSxjTJsI4dtzGFH
+G7CjE9ixGuxYETuuY8dz2PEJbLMC2+zGNuexEw3YiUnsZCN2cgzbSmFbo9iiBlsMYttl2HY39ioZe9Uk9hp12GsUsMtM7LIu7DXBXrMXe80F7LUy2GuVsNeu
+xV57Cru8Gbt8ALt8CXudOux1hrDX1bDXbcdedxF7vRT2ej3Y681iV6SxK8ax1y/HXr8HewMVe4NO7A3B3rALe8M57MoMduUE9v/qsP83h71RM/ZGc9gbd2BX
+1WNXzWJvksPeZBZ70ybsTWewN2vB3mweO5XD3tzE3nwRe4se7C2WsLfMYm+5gF1dj109ir1VJfZWA9hbl2Fv3Y+9TSX2NsvYNd3YNUvY2/Zib7uMvV0b9naL
+2NvnsGsN7Np57B06sXeYxd4xg73jBPZOddg7jWOn67HTs9g712PvPIq9SxP2LmPYu6awdy1g11Vg1xWwd0tj7zaDvXs99u4L2HuksfcYxq6vwK7PY+9pYu+Z
+xd5zCnuvauy9hrD3Fth792E3lGE3FLD3qcDeZwB73wz2viXs/eqw9ythZ1qx99ew9+/FPkBgHzCKfWAfdmMlduMw9kE12AeNYx9cj33wDPYhrdhNYDcNYR/a
+g31YFfZho9iH12AfPordXIfdPIl9RAv2kSr2kQXso9LYR01it2Sxj5axjx7BPiaFfcwQ9rHV2MfOY7c2Y7fOYB/XjH082McXsE+owD5hGDtbj31iGfaJReyT
+6rBPmsY+uQX75AXstk7s1Qb26mHsU5qxT63DPnURu70Du30F+7Qc9uky9und2GeY2GeMYuf6sM9MYZ85iX1WI/ZZS9hnD2B3lGF39GOfU459Th773DLsc8ex
+z6vDPm8Ku7Md+3wN+/w+7AuqsC8Yw76wAfvCBeyuPPZFaeyLZrEv7sa+pBL7kgXs7jnsS3PYl8nYl+WxL6/EvnwCu6cd+woZ+4pO7CtWsK/swr5Kw74qj91b
+i907g311M/bVU9jX1GFfM459bR32tRPYfRnsviXs63qwr6/Cvn4E+4Y09g1z2P0T2DfmsG/SsW8awb65EfvmFez8CPYtddi3TGLf2oh96yL2bZ3YAyb2wDj2
+7Vns21ew7+jEvlPHvjOPPZjGHpzHvqsZ+64S9t3V2HePYN+Txr5nFLtQg12YxL63GfveGez7GrDvG8O+P4V9/wj2UBZ7aA77gRbsB1awH+zFfsjEfqiIPVyP
+/bDAfngY+5EG7EcWsR/txx7pxn6sBvuxJezHB7CfqMV+Yg672Iv9ZDX2k/PYT81hPz2IPdqM/QzYz/RhP1uB/ewE9nNd2GNl2GPj2M+PYL/Qiv2igf1iEXu8
+FfslDfulQeyXG7BfXsF+ZRh7Iov9ahr71RXs10axX+/BLtVgvwH2G+PYb85jv1XAnpzEfjuH/U4K+12w3x3HnurDfq8a+71p7Pe7sD+oxv5gEXu6gP1hFvuj
+GuyPlrFndOyZGexPerE/zWB/VoX92Tj2bBv25wL782nsL4axv8xjz2WwvzKwvypif53D/iaNPa9hzw9if1uF/e0o9ncZ7O/msb/vwl5IYS/MYv/Qgv3DFPaP
+ddg/zmH/1IO9qGEvDmD/XIP98yL2L1nsXxaxf+3GXmrEXlrA/i2L/dsy9u/d2H+o2H90Yy8b2Mt57D8rsf+cxP5rCPvvOuy/p7BX6rFXxrH/qcP+Zwn73xz2
+fzL2fwOsoppVjLLK0cQqxwqrpE5WOU1WOUdYJVezSi6wSjFZpYywylXxf3SUAVikVdXHf7DoYuJKSkpK5/+2Yo7JAstisittrIu7uLIru7KKSgrLjDA5DLOz
+L7ugqKSkaKOhYqKiomJikqFiYWJRUWFSYVFRUWGSoaFRkaKN+j2M8+1TPt93n+ed93fvuffcc/7nvIMd2oetLMRWTmLJpVjyBHZYO/ahDOxDc9jhTVhKKpYS
+wY5Iw47ow1Z5sFV92JH52JHDWGoplrqAfTiCHZWOHdWBHZ2OHR3B0lKxtAHsIxXYR6awY6qwYx3s2GEsvQP7qAf7aD92XCF23BR2vIsdv4hltGMfS8c+NoBZ
+BaYCTEOY42BOO+ZEsY+3YavTsNUj2Ale7IRJLLMUy5zGTvRiJy5hn+jETkrDTurEPA7m6cNOLsROnsE+OYydUollJWFZHdiaDGzNAJZdheWkYDk9WG46ltuB
+rU3D1nZieRlY3hC2rgxbN4Pll2L5Q9ipudipvdinHOxTA9hphdhpI1iBByvowNYnYesj2IYMbEMHdnoKdno3VpiJFY5iny7EPj2IbSzENo5gnynFihysaAjb
+VIdtWsDOaMM2Z2CbB7DiMqx4CTuzA9uSgm1pw7amYFs7sJIMrGQYO6sCO2sO21aJbRvHzi7Fzh7HSsux0gVsexu2IxPb0Y3tiGLnVGLnjGFlVVjZGLazANs5
+gu0qwnaNYueWYecOYeUerLwX252F7R7CzvNg5/Vh5zvY+YNYRS5W0YVdAHZBE3ZhEnZhF3ZRMXbREFaZj1WOYp+NYBenYRf3YpdkYpd0YFXJWFUrVp2FVfdi
+ezzYnjGspgSrmcC8AcxXhPkmsUtD2KVzWG0Aq13A6lowfxLm78I+V4Rd5mCXjWCBAiwwhNXnY/VzWDCEBRexhg4slI6FerC9BdjeXiycgYU7sH2p2L5+zPVg
+bj/WWII1TmP7m7D9i9iBFqwpCWvqwpo9WPMIdnkAuyINuyKCtSRhLRHsylTsym7sKhe7OhW7ugtrTcJam7DWeezzAeyaZOyaNuzaFOzaXqytAGubwL4QwK5L
+wq6LYNenY9cPYO1l2A1gN/RjNxZhX8zFvjiHReqwyDx2Uwt2czJ2czf2pWLsS3NYRxXWMY3dUoHdsoTdWofdOo3dVordNo51VmGdS9jtLnZ7FPtyF3ZHFnbH
+INbVid2Zhd3Zj92Vi901gN1dhN09jnVXYfckY/e0YfdEsXvbsftSsftc7L5prKcY6xnC7s/C7u/DHijAHhjBHszEHuzEesF6Q1jvHPZQC/bQAvaVAPYw2MMR
+rM/B+rqxRzKxR8axr4awR9OwR+ex/m7sayHssQzssQns613YQCf2eDn2RDL2xBD2ZB02mIkNTmNPdWPfCGHfbMGGSrChKPZ0L/atAPZMGvbMEDZcgT2bjD07
+iH27DvtOLjaSi40sYt/tx77Xin0/HxstwEansR90Yj8sxX6Uiv1oEhvrwZ5rxX5cgT1fgD2/iI0PYT9px37ah/3MxSa6sBdKsZ8nYz+fxH7RjU0GsF+WYb+M
+Yr8awH7txaY82NQc9ptB7LcR7Hfl2HQT9vsq7A8Z2B8msT+62EwW9iLYi+PYnwLYS8nYSz3YbAk2O4/9uQt7uRz7Sxr2l0lsrgSbG8VeKcJeWcBebcJejWJ/
+bcfmPdhrYK91Y68XYq8PY38rxv42ji1UYQsL2N/bsX/kY/9Mx/45jC0WYovD2L9c7A2wNyLYm1nYmz3YUga21Im9lY691YW9nYm9PYr9uxuLFmDRYeydEuyd
+CezdOuzdKPZeF6IEsYgSXJSwgBLH0YpKtGIOJXWhQ1LQIW3okCg6tA2tTEYrO1FyPkoeR4dVoMPm0Yci6PAidPgYSilDKXPoiCa0Kg2tGkFHBtCR8yi1DqXO
+oA9XoA+Po6PK0FET6OhKdHQUpXWij2SiY1LQMf3o2DJ07BRKr0TpC+ijrei4dHTcKDo+gI5fQBkBlBFFH2tH5kHWiZSEFEKaR44XObPo401odTJa7aLVc+iE
+CnTCOMosRZkz6MQKdOI0+oSLTkpGJ7nopFnkqUCeKXRyOTp5Cn0yhE4BneKiUxZRlovWgNZEULaDskdRTg/KLUK5g2htFlo7ivJyUV4PWpeK1rWj/GSU34ZO
+TUGn9qJPuei0JHRaCyoAFbSh9WlofS/aUIg2zKLT29HpUVQYQoWL6NNNaGMS2tiDPuOiomRUFEFFE2iTB21qRZtm0RnF6Ix+tDkLbe5Fm6OoOICKp9GZFejM
+SbTFi7aMoq1VaGs/KslEJS4qGUZnpaKzvOisUbTNQdva0dlJ6OwmdPYUKi1CpQNoez7a3ot25KMdEbRjAZ1Tgc4ZR2UFqKwf7cxHO4fQrhS0qw7tmkTnFqJz
+Q+jcYVSejMq9qHwI7U5Du9vQ7ll0Xhk6bwidn4vO70MVHlQxhC4oRhdE0AVz6MJidGE/usiDLupAlaDKNvTZIvTZYXRxKrq4CV08iy6pQJeMoapiVDWJqrvR
+nky0pwntiaKaYlTTg2qiyOtF3jnkK0W+IXRpIbp0CdU2odoJVFeE6vqRPxP5u9HnMtDnBtBlReiyTnTZIgoMovo0VN+G6sdR0IOCrSg4hxpKUcMQChWg0CDa
+60F7I2hvFIUDKDyG9uWifcPILUZuN3LnUGMxauxG+5PQ/jq0fxIdqEAHJlFTAWpOQs1NqHkSXV6ELu9DVzjoik7UkopaulHLIrqyHF05hq4qQFcNo6ui6Ooy
+dHUPunoRtZaj1gn0eQ/6fDu6BnRNGbpmEF2bia6NoDZQWxFq60Rtc+gLJegLvei6ZHRdG7ouiq4vRdcPoHYPao+g9ii6IYJuiKIbXXRjFH1xGEWKUGQA3ZSK
+bmpFNy2im1108zz6Ugh1JKOOKXRLHbplFN2ai25tRbfOottK0G1DqDMTdXah2x10+wz6shd9eRTdUYjuGEBd+ahrEN1ZiO6cQneF0F3j6O58dHc/6s5A3V3o
+nip0zzy6txLdO4vuC6GeFNRThXpG0P0Our8D3R9FD4TQA0vowRb04DzqbUe9C+ghL3poDH0lH32lHz2chR4eQX3pqK8J9U2hRwrRI33oq/noqz3o0TT0aDfq
+d1B/J/paFvpaF3osBT3Wgb6eir7ejwZS0UATGlhEj9ehx6PoiXb0xCR6Mgs92YGeXECDlWhwGj3lQU+1oadm0DdK0TcG0Dcz0Dd70JCDhrrR0yno6Rb09BL6
+Vid6JgU9E0HDDhruR8/mo2cH0Lez0LcH0XcK0HdG0YgXfbcQfXcKfa8SfW8BfT+Avj+NRkvQ6Cj6QSX6wQT6YRn64Sz6kYvGQGOt6DnQcxH04zT04170fAl6
+fg6Nh9D4HPpJJfrJDPppJfrpDPpZE5ooQRPT6IUQemER/bwd/cJBv4igSdCkiyaX0C+70a8y0K960a+L0K+n0FQlmppBvwmg3yyi30bQ7wrQ72bQtIt+n4J+
+34P+UIH+mIL+GEEzqWimA73ooBcH0Z8q0J+i6KUQemkOzYbQbBT9uRu9nIJebkUvL6K/uGguHc11oldy0Stj6NVy9Ook+qsX/XUBzXeg17LQa8Po9Qr0+iL6
+Wy9a8KCFPvR3D/r7IPpHIfrHOPpnCC2mosUI+lcK+lc7eiMNvdGN3ixGb86ipRK0NIjectBbI+jtXPR2H/p3Pvp3H4p6UHQYvVOC3hlB7xagdwfQe/novUEc
+CnGYxEkowUkYwElMw0lsx0lcxFkRwFmxhJPUSgKceHsCZAOQCCQsr1HE/4403iAhzjcmHBPnBFYmPB7nRFYmPBfnFaxOPDbOSaxM3BbnQzgyMRznQzki8d44
+H44S5+N8BDtXDMR5FauTSlhBQtJhkJAGcU5gVcL9cU5kVcLTcV5BccILcU5iVeKGOB+CJe6K86Ecl3hNnA9nY2J/nI/ggRUpcV5FcVIim2kgRDNh/NRSh4vD
+IzisZT3rKWANeeTGHoddNFBPNUEcNnM5zeyhgTBhqsnCoYxqGgngUEI1Ybw0x9Z34o+d2ht/O2yJvWtj1k0E8RLGxwEctuMnQAAf4Zh1Gw3UESSIHx8O5+Oj
+mv0H7cWxmR9vLKblaPbgw8WNWXfhi81rqKEahzPiNh9BamigkXB8py+WeTUBsmFzQ6g57K+tc51HnLXr1xesycvNy3V2NdRXB53NlzfvaQiHq7OcsurGgFNS
+HfY2Zzk7/dXBvf7qoLOlOlib5WwKesO+A852fyDgC2c52xrqgkG/zznfV71/eV5cvd/vdTY3hPf4XDfL2eXb46upqXbO8LmuL1jT0Bh2sxyf61QHsmF3LPVL
+aSCIC7uD/ksbgi6x1Gpjci9LzS5fbWOgOgxb4ju3xMSojYmWR3asgBtwPuDv/bV1rOG0/yg1WxqC7paGcK3PycvOdTY48VudDc66NafF1Pj/AzsvVpt9+GOW
+5U5aF7t++VkH5/nC+/wNQWftuuzc7Nx1H/SzHR9e/DRSf9Dndp/X31hPrDtdQuxjAznk0HjwZGO8h5rJjtW1/r+sy35zoM51Q/s25OQ0LnttDHrDzdk1DfXv
+T4NuDpTipybWG/tism2ljFL2k8cpMZm2soPdB9cd9n8g0zycmOhOrChuzOpwAD8udTixX99/+NlysAhnUh/rU28s9+UvY3mtKRZNCDd+QzaU+mt8wX0+Z2tZ
+6f68UzY4W3fsXmZnf1zVPKch7ASqXV/YOeB36xy3zhfbs1xQ58z6PT6v1x+sdc5sqvGFXH9DMBsKD+r6vqq1BGkkO94+OQT+S5V9sR2h2HeyfK6eABuhcFnc
+DTk5tcHG7IZwbU7g/Tj35dSGAtl1bn1gY/w/Mzbea8fD/zH+ZwA/E3qVMFMiAA==
+`
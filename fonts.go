@@ -0,0 +1,162 @@
+package main
+
+import (
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"image"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcalandro/prosu-twitter/fontdata"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/math/fixed"
+)
+
+// Optional extra fonts. Every .ttf file in here is tried in name order, before the bundled fallback fonts, so they can be replaced
+// with nicer ones without a new build
+const fallbackFontDir = "./assets/fonts"
+
+// The fonts compiled in for scripts the Go fonts don't cover, in the order they're tried. Each is cut down to the letters it's
+// there for by fontdata/genFonts.go
+var bundledFallbackFonts = []struct {
+	Name string
+	Data string
+}{
+	{Name: "M+ 1p", Data: fontdata.MPlus1pRegular}, // Japanese kana
+	{Name: "FreeSerif", Data: fontdata.FreeSerif},  // Thai
+	{Name: "Unifont", Data: fontdata.Unifont},      // Korean, and the common Han characters for Japanese and Chinese
+}
+
+// fontChain - Fonts tried in order for each rune, so a name mixing scripts is drawn with whichever font has each letter
+type fontChain []*truetype.Font
+
+// Face returns a face of the size that draws each rune with the first font in the chain that has it
+func (c fontChain) Face(size float64) font.Face {
+	face := &fallbackFace{fonts: c}
+	for _, f := range c {
+		face.faces = append(face.faces, truetype.NewFace(f, &truetype.Options{Size: size}))
+	}
+	return face
+}
+
+// fallbackFace - A font.Face made of one face per font in a chain
+type fallbackFace struct {
+	fonts []*truetype.Font
+	faces []font.Face
+}
+
+// faceFor returns the face of the first font that has a glyph for the rune. If none do, the first font's missing glyph box is used
+func (f *fallbackFace) faceFor(r rune) font.Face {
+	for i, fnt := range f.fonts {
+		if fnt.Index(r) != 0 {
+			return f.faces[i]
+		}
+	}
+	return f.faces[0]
+}
+
+func (f *fallbackFace) Close() error {
+	for _, face := range f.faces {
+		face.Close()
+	}
+	return nil
+}
+
+func (f *fallbackFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	return f.faceFor(r).Glyph(dot, r)
+}
+
+func (f *fallbackFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return f.faceFor(r).GlyphBounds(r)
+}
+
+func (f *fallbackFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	return f.faceFor(r).GlyphAdvance(r)
+}
+
+// Kern only applies between two runes drawn by the same font
+func (f *fallbackFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	face := f.faceFor(r0)
+	if face != f.faceFor(r1) {
+		return 0
+	}
+	return face.Kern(r0, r1)
+}
+
+// Metrics are the first font's, so lines are spaced the same whatever script a name is in
+func (f *fallbackFace) Metrics() font.Metrics {
+	return f.faces[0].Metrics()
+}
+
+// loadFonts builds the regular and bold font chains. Every font in them is compiled in, so cards can be drawn on a host without any
+// fonts installed. Bold text falls back to the regular fallback fonts
+func loadFonts() (regular fontChain, bold fontChain, err error) {
+	goRegular, err := truetype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, nil, err
+	}
+	goBold, err := truetype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, nil, err
+	}
+	fallbacks, err := loadFallbackFonts(fallbackFontDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, bundled := range bundledFallbackFonts {
+		f, err := unpackFont(bundled.Data)
+		if err != nil {
+			return nil, nil, errors.New("Failed to load the bundled " + bundled.Name + " font: " + err.Error())
+		}
+		fallbacks = append(fallbacks, f)
+	}
+	regular = append(fontChain{goRegular}, fallbacks...)
+	bold = append(fontChain{goBold}, fallbacks...)
+	return regular, bold, nil
+}
+
+// unpackFont parses a font made by fontdata/genFonts.go
+func unpackFont(data string) (*truetype.Font, error) {
+	zr, err := gzip.NewReader(base64.NewDecoder(base64.StdEncoding, strings.NewReader(data)))
+	if err != nil {
+		return nil, err
+	}
+	ttf, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	return truetype.Parse(ttf)
+}
+
+// loadFallbackFonts parses every .ttf file in the directory, in name order. A missing directory means there are no fallback fonts
+func loadFallbackFonts(dir string) ([]*truetype.Font, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	fonts := []*truetype.Font{}
+	for _, path := range paths {
+		if strings.ToLower(filepath.Ext(path)) != ".ttf" {
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		f, err := truetype.Parse(data)
+		if err != nil {
+			gError("Skipping fallback font " + path + ", it isn't a font we can read: " + err.Error())
+			continue
+		}
+		gLog("Loaded fallback font " + path)
+		fonts = append(fonts, f)
+	}
+	return fonts, nil
+}
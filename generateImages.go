@@ -5,11 +5,9 @@ import (
 	"encoding/base64"
 	"errors"
 	"image"
-	"image/color"
 	"image/png"
 	"io/ioutil"
 	"math"
-	"net/http"
 	"net/url"
 	"os"
 	"strconv"
@@ -19,17 +17,15 @@ import (
 	"github.com/ChimeraCoder/anaconda"
 	"github.com/dustin/go-humanize"
 
-	"golang.org/x/image/font"
-
-	findfont "github.com/flopp/go-findfont"
 	"github.com/globalsign/mgo/bson"
-	"github.com/golang/freetype/truetype"
+	"github.com/go-bongo/bongo"
 	"github.com/nfnt/resize"
 	osuapi "github.com/wcalandro/osuapi-go"
 	"gopkg.in/fogleman/gg.v1"
 )
 
-var arialFont20, arialFont12, arialFont18, arialBold20 font.Face
+// The font chains, for themes to make faces of whatever size they need
+var regularFonts, boldFonts fontChain
 var guestAvatar image.Image
 
 func gLog(msg string) {
@@ -45,70 +41,117 @@ func gDebug(msg string) {
 }
 
 func init() {
-	fontPath, err := findfont.Find("arial.ttf")
+	var err error
+	regularFonts, boldFonts, err = loadFonts()
 	if err != nil {
 		panic(err)
 	}
-	fontData, err := ioutil.ReadFile(fontPath)
+
+	// Load guest avatar
+	guestAvatarFile, err := ioutil.ReadFile("./assets/modes/avatar-guest.png")
 	if err != nil {
 		panic(err)
 	}
-	arial, err := truetype.Parse(fontData)
+
+	guestAvatar, _, err = image.Decode(bytes.NewReader(guestAvatarFile))
 	if err != nil {
 		panic(err)
 	}
-	arialFont20 = truetype.NewFace(arial, &truetype.Options{
-		Size: 20,
-	})
-	arialFont12 = truetype.NewFace(arial, &truetype.Options{
-		Size: 12,
-	})
-	arialFont18 = truetype.NewFace(arial, &truetype.Options{
-		Size: 18,
-	})
-	// Bold font for player name
-	fontPath, err = findfont.Find("arial bold.ttf")
-	if err != nil {
-		fontPath, err = findfont.Find("arial_bold.ttf")
-		if err != nil {
-			panic(err)
-		}
-		err = nil
-	}
-	fontData, err = ioutil.ReadFile(fontPath)
+}
+
+// dueUser - A user whose tweet is due, along with the slot it was due for
+type dueUser struct {
+	ID     bson.ObjectId
+	Handle string
+	Slot   int64
+}
+
+// dueUsers - The users findDueUsers picked out, grouped by what should happen to them
+type dueUsers struct {
+	Due      []dueUser // Tweets that should be posted
+	Stale    []dueUser // Tweets that were due too long ago to still be worth posting
+	NoPlayer []dueUser // Users who haven't set an osu! player
+}
+
+// Find and generate finds all users whose next tweet is due by period and queues their tweets. Tweets that were due before staleBefore
+// are skipped and rescheduled, so a long outage doesn't end in a burst of stale tweets. Each run is saved with what happened to every user
+func findAndGenerate(period time.Time, staleBefore time.Time) (runErr error) {
+	hour := period.Hour()
+	gLog("Time to post tweets for hour " + strconv.Itoa(hour))
+	run, err := startSchedulerRun(period)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	arial, err = truetype.Parse(fontData)
+	defer func() {
+		finishSchedulerRun(run, runErr)
+	}()
+	users, err := findDueUsers(period, staleBefore)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	run.Candidates = len(users.Due) + len(users.Stale) + len(users.NoPlayer)
+	run.Filtered = len(users.Stale) + len(users.NoPlayer)
+	// Move users without a player on to their next slot, otherwise they would be reported again every hour
+	for _, noPlayer := range users.NoPlayer {
+		if err := rescheduleUser(noPlayer.ID, period); err != nil {
+			gError("Failed to reschedule @" + noPlayer.Handle)
+			captureError(err)
+			recordRunOutcome(run, noPlayer, outcomeNoPlayer, "the user hasn't set an osu! player, and rescheduling failed: "+err.Error())
+			continue
+		}
+		recordRunOutcome(run, noPlayer, outcomeNoPlayer, "the user hasn't set an osu! player, rescheduled")
 	}
-	arialBold20 = truetype.NewFace(arial, &truetype.Options{
-		Size: 20,
-	})
-
-	// Load guest avatar
-	guestAvatarFile, err := ioutil.ReadFile("./assets/modes/avatar-guest.png")
-	if err != nil {
-		panic(err)
+	for _, stale := range users.Stale {
+		if err := rescheduleUser(stale.ID, period); err != nil {
+			gError("Failed to reschedule @" + stale.Handle)
+			captureError(err)
+			recordRunOutcome(run, stale, outcomeStale, "failed to reschedule: "+err.Error())
+			continue
+		}
+		recordRunOutcome(run, stale, outcomeStale, "the tweet was due too long ago, rescheduled")
 	}
+	list := users.Due
+	gLog("Finished filtering users. We now only have " + strconv.Itoa(len(list)) + " users to post tweets for")
 
-	guestAvatar, _, err = image.Decode(bytes.NewReader(guestAvatarFile))
-	if err != nil {
-		panic(err)
+	// Queue a job for each user's slot. Users stay due until their tweet is posted, so queueing the same slot again does nothing
+	for _, due := range list {
+		// Queueing is idempotent, but there's no point carrying on if another replica has taken over
+		if !leader.IsLeader() {
+			return errors.New("lost the scheduler lease while queueing tweets")
+		}
+		// Recorded first, so a worker that picks the job up straight away has an outcome to update
+		recordRunOutcome(run, due, outcomeQueued, "")
+		created, err := enqueuePostJob(due.ID, due.Slot, run.GetId())
+		if err != nil {
+			gError("Failed to queue tweet for user " + due.ID.Hex())
+			captureError(err)
+			recordRunOutcome(run, due, outcomeQueueFailed, err.Error())
+			continue
+		}
+		if created {
+			run.Queued++
+		} else {
+			recordRunOutcome(run, due, outcomeAlreadyQueued, "a job for this slot was queued by an earlier run")
+		}
 	}
+	gLog("Queued " + strconv.Itoa(run.Queued) + " new tweets for hour " + strconv.Itoa(hour))
+	return nil
 }
 
-// Find and generate finds all users that we should be generating for and generates and posts images for them
-func findAndGenerate() {
-	list := []bson.ObjectId{}
-	hour := time.Now().UTC().Hour()
-	if isMaintenance {
-		gLog("Ignoring tweet posting because we are in maintenance mode")
-		return
+// findDueUsers finds all users whose next tweet is due by period, without changing anything
+func findDueUsers(period time.Time, staleBefore time.Time) (dueUsers, error) {
+	users := dueUsers{
+		Due:      []dueUser{},
+		Stale:    []dueUser{},
+		NoPlayer: []dueUser{},
 	}
-	gLog("Time to post tweets for hour " + strconv.Itoa(hour))
-	resultSet := connection.Collection("usermodels").Find(bson.M{"osuSettings.hourToPost": hour, "osuSettings.enabled": true})
+	openJobs, err := usersWithOpenJobs()
+	if err != nil {
+		gError("Error getting the users whose tweets are still queued")
+		captureError(err)
+		return users, err
+	}
+	resultSet := connection.Collection("usermodels").Find(bson.M{"osuSettings.enabled": true, "nextPostAt": bson.M{"$lte": period.Unix()}})
 	number, err := CountResults(resultSet)
 
 	if err != nil {
@@ -117,66 +160,78 @@ func findAndGenerate() {
 	}
 	gLog(strconv.Itoa(number) + " preliminary results. Time to filter")
 	user := &User{}
-	handle := ""
 	for resultSet.Next(user) {
-		handle = "@" + user.Twitter.Profile.Handle
-		gDebug("Checking user " + handle)
-		// Check to see if they have a player set in their settings
-		if user.OsuSettings.Player == "" {
-			gDebug(handle + " doesn't have a valid player object in their osu! settings, skipping user.")
-			continue
-		}
-		// Check to see if they have a recent post that doesn't fit their frequency settings
-		// First check to see if they have ever had a tweet posted
-		if len(user.TweetHistory) != 0 {
-			lastTweet := user.TweetHistory[len(user.TweetHistory)-1]
-			// If they post daily, check if they have had a tweet posted within the last 24 hours
-
-			// The old timestamps used MS since last tweet, so we need to check for a bigger gap
-			if lastTweet.DatePosted > 1500000000000 {
-				if user.OsuSettings.PostFrequency == 0 && (time.Now().Unix()*1000)-lastTweet.DatePosted < 72000*1000 {
-					gDebug(handle + " has had a tweet posted within the last 24 hours when their account is set to post daily. Skipping...")
-					continue
-				}
-				// If they post weekly, check if they have had a tweet posted within the last 7 days
-				if user.OsuSettings.PostFrequency == 1 && (time.Now().Unix()*1000)-lastTweet.DatePosted < 600000*1000 {
-					gDebug(handle + " has had a tweet posted within the last 7 days when their account is set to post weekly. Skipping...")
-					continue
-				}
-				// If they post monthly, check if they have had a tweet posted within the last 28 days
-				if user.OsuSettings.PostFrequency == 2 && (time.Now().Unix()*1000)-lastTweet.DatePosted < 2418900*1000 {
-					gDebug(handle + " has had a tweet posted within the last 28 days when their account is set to post monthly. Skipping...")
-					continue
-				}
-			} else {
-				if user.OsuSettings.PostFrequency == 0 && time.Now().Unix()-lastTweet.DatePosted < 72000 {
-					gDebug(handle + " has had a tweet posted within the last 24 hours when their account is set to post daily. Skipping...")
-					continue
-				}
-				// If they post weekly, check if they have had a tweet posted within the last 7 days
-				if user.OsuSettings.PostFrequency == 1 && time.Now().Unix()-lastTweet.DatePosted < 600000 {
-					gDebug(handle + " has had a tweet posted within the last 7 days when their account is set to post weekly. Skipping...")
-					continue
-				}
-				// If they post monthly, check if they have had a tweet posted within the last 28 days
-				if user.OsuSettings.PostFrequency == 2 && time.Now().Unix()-lastTweet.DatePosted < 2418900 {
-					gDebug(handle + " has had a tweet posted within the last 28 days when their account is set to post monthly. Skipping...")
-					continue
-				}
-			}
-		}
-		list = append(list, user.GetId())
+		users.add(user, staleBefore, openJobs[user.GetId()])
 	}
-	gLog("Finished filtering users. We now only have " + strconv.Itoa(len(list)) + " users to post tweets for")
+	if resultSet.Error != nil {
+		gError("Error getting users to post tweets for")
+		captureError(resultSet.Error)
+		return users, resultSet.Error
+	}
+	return users, nil
+}
+
+// add puts a due user in the right group. hasOpenJob is whether the user's scheduled tweet is still queued or waiting for a retry
+func (users *dueUsers) add(user *User, staleBefore time.Time, hasOpenJob bool) {
+	handle := "@" + user.Twitter.Profile.Handle
+	gDebug("Checking user " + handle)
+	due := dueUser{ID: user.GetId(), Handle: user.Twitter.Profile.Handle, Slot: user.NextPostAt}
+	// Check to see if they have a player set in their settings
+	if user.OsuSettings.Player == "" {
+		gDebug(handle + " doesn't have a valid player object in their osu! settings, skipping user.")
+		users.NoPlayer = append(users.NoPlayer, due)
+		return
+	}
+	// A queued tweet moves the user on once it's posted, so rescheduling them as stale would move them on twice. Queueing the slot
+	// again does nothing
+	if hasOpenJob {
+		gDebug(handle + "'s tweet is already queued")
+		users.Due = append(users.Due, due)
+		return
+	}
+	// Check to see if their tweet has been due for too long to still be worth posting
+	if user.NextPostAt < staleBefore.Unix() {
+		gDebug(handle + "'s tweet was due at " + time.Unix(user.NextPostAt, 0).UTC().String() + ", which is too long ago. Skipping...")
+		users.Stale = append(users.Stale, due)
+		return
+	}
+	users.Due = append(users.Due, due)
+}
+
+// usersWithOpenJobs returns the users who have a scheduled tweet that is waiting to be posted, being posted, or waiting for a retry
+func usersWithOpenJobs() (map[bson.ObjectId]bool, error) {
+	ids := []bson.ObjectId{}
+	err := connection.Collection("postjobmodels").Collection().Find(bson.M{
+		"kind":  jobKindScheduled,
+		"state": bson.M{"$in": []string{jobStatePending, jobStateRunning}},
+	}).Distinct("user", &ids)
+	if err != nil {
+		return nil, err
+	}
+	openJobs := map[bson.ObjectId]bool{}
+	for _, id := range ids {
+		openJobs[id] = true
+	}
+	return openJobs, nil
+}
 
-	// Time to generate
-	for _, uID := range list {
-		updateAndPost(uID)
-		time.Sleep(time.Duration(100) * time.Millisecond) // Sleep for 100ms after each tweet is posted
+// rescheduleUser moves the user's next tweet to the first slot after the given time, without posting anything
+func rescheduleUser(userID bson.ObjectId, after time.Time) error {
+	user := &User{}
+	err := connection.Collection("usermodels").FindById(userID, user)
+	if err != nil {
+		return err
+	}
+	if err := scheduleNextPost(user, after); err != nil {
+		return err
 	}
+	return connection.Collection("usermodels").Collection().UpdateId(userID, bson.M{"$set": bson.M{"nextPostAt": user.NextPostAt}})
 }
 
-func updateAndPost(userID bson.ObjectId) {
+// updateAndPost fetches new data for the user's player and posts their stats for the slot. Returns an error if the attempt should be
+// retried, or a permanentError if it shouldn't. Retrying a slot whose tweet already went up records it instead of posting it again.
+// kind is the kind of job posting the tweet. Tweets the user asked for aren't skipped when their stats haven't changed
+func updateAndPost(userID bson.ObjectId, slot int64, kind string) (postErr error) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Critical("Recovering from failed generateImage for user " + userID.Hex())
@@ -191,6 +246,7 @@ func updateAndPost(userID bson.ObjectId) {
 			}
 
 			log.Critical(err.Error())
+			postErr = err
 			captureError(err)
 		}
 	}()
@@ -203,106 +259,81 @@ func updateAndPost(userID bson.ObjectId) {
 	err := connection.Collection("usermodels").FindById(userID, prosuUser)
 	if err != nil {
 		l.Error("Failed to grab Prosu user from the database")
+		if _, ok := err.(*bongo.DocumentNotFoundError); ok {
+			return permanentError{err}
+		}
 		captureError(err)
-		return
+		return err
 	}
 	l.Log("Successfully grabbed Prosu user from the database")
 
-	// We need to run a new data check on the player that is associated with the use
-	// First we grab the player from the database
-	l.Log("Grabbing associated osu! player from the database")
-	dbOsuPlayer := &OsuPlayer{}
-	err = connection.Collection("osuplayermodels").FindById(prosuUser.OsuSettings.Player, dbOsuPlayer)
-	if err != nil {
-		l.Error("Failed to grab associated osu! player from the database")
-		captureError(err)
-		return
-	}
-	l.Log("Successfully grabbed associated osu! player " + dbOsuPlayer.PlayerName + " from the database")
-	l.Log("Getting last check for the user's preferred game mode: " + allOsuModes[prosuUser.OsuSettings.Mode])
-
-	// Then we need to see if one was run in the past 3 hours. This will help in case two or more people are both tracking the same person for some stupid reaosn
-	lastCheck := &OsuRequest{}
-	checks := []bson.ObjectId{}
-	if prosuUser.OsuSettings.Mode == 0 {
-		checks = dbOsuPlayer.Modes.Standard.Checks
-	} else if prosuUser.OsuSettings.Mode == 1 {
-		checks = dbOsuPlayer.Modes.Taiko.Checks
-	} else if prosuUser.OsuSettings.Mode == 2 {
-		checks = dbOsuPlayer.Modes.CTB.Checks
-	} else if prosuUser.OsuSettings.Mode == 3 {
-		checks = dbOsuPlayer.Modes.Mania.Checks
+	// Record that we are about to post before anything is uploaded, so a retry knows to look for the tweet first
+	intentKey := postIntentKey(userID, slot)
+	if kind == jobKindManual {
+		intentKey = manualIntentKey(userID, slot)
 	}
-
-	err = connection.Collection("osurequestmodels").FindById(checks[len(checks)-1], lastCheck)
+	intent, intentCreated, err := beginPostIntent(intentKey, userID, slot)
 	if err != nil {
-		l.Error("Failed to grab last check for user's preferred game mode")
+		l.Error("Failed to record the posting intent")
 		captureError(err)
-		return
+		return err
 	}
-
-	l.Log("Determining if the last check was done within the last 3 hours")
-
-	if time.Now().Unix()-lastCheck.DateChecked > 10800 || len(checks) == 1 || lastCheck.DateChecked > 1500000000000 {
-		if time.Now().Unix()-lastCheck.DateChecked > 10800 {
-			l.Log("Last check was made more than 3 hours ago, fetching new data")
-		} else if lastCheck.DateChecked > 1500000000000 {
-			l.Log("The last check was done on the old site")
-		} else {
-			l.Log("We only have one set of data, grabbing data again.")
-		}
-		data, err := postingAPI.GetUser(osuapi.M{"u": dbOsuPlayer.UserID, "m": strconv.Itoa(prosuUser.OsuSettings.Mode)})
-		if err != nil {
-			l.Error("Failed to grab new data")
-			captureError(err)
-			return
-		}
-		if data == nil {
-			l.Error("No data was returned for user " + dbOsuPlayer.UserID)
-			return
-		}
-		request := createRequest(dbOsuPlayer.GetId(), data)
-
-		// Save the request
-		l.Log("We got the data, now we have to save the request to the database")
-		err = connection.Collection("osurequestmodels").Save(request)
-		if err != nil {
-			l.Error("Failed to save the request")
+	if intent.State == intentStatePosted {
+		l.Log("Tweet " + intent.TweetID + " was already posted for this slot. Recording it instead of posting again")
+		if err := recordTweet(prosuUser, intent.TweetID, "", l); err != nil {
+			l.Error("Failed to add the tweet to the database")
 			captureError(err)
-			return
-		}
-
-		// Now we add the ID of the request to the checks field
-		checks = append(checks, request.GetId())
-		if prosuUser.OsuSettings.Mode == 0 {
-			dbOsuPlayer.Modes.Standard.Checks = checks
-		} else if prosuUser.OsuSettings.Mode == 1 {
-			dbOsuPlayer.Modes.Taiko.Checks = checks
-		} else if prosuUser.OsuSettings.Mode == 2 {
-			dbOsuPlayer.Modes.CTB.Checks = checks
-		} else if prosuUser.OsuSettings.Mode == 3 {
-			dbOsuPlayer.Modes.Mania.Checks = checks
+			return err
 		}
+		return nil
+	}
 
-		// Now we save the updated osuPlayer
-		l.Log("Appended new data to the player's document. Saving")
-		err = connection.Collection("osuplayermodels").Save(dbOsuPlayer)
-		if err != nil {
-			l.Error("Failed to the updated player document")
+	// We need to run a new data check on the player that is associated with the use
+	dbOsuPlayer, previousRequest, newRequest, err := refreshPlayerChecks(prosuUser, l, false)
+	if err != nil {
+		return err
+	}
+	previousRequest, skipReason := compareToLastPost(prosuUser, previousRequest, newRequest, l)
+	if skipReason != "" && kind == jobKindScheduled {
+		if err := skipPost(prosuUser, skipReason, l); err != nil {
+			l.Error("Failed to reschedule the skipped tweet")
 			captureError(err)
-			return
+			return err
 		}
-		l.Log("Saved the updated player document. Now we can generate the image.")
-	} else {
-		l.Log("Last check was made less than 3 hours ago, we don't need new data. Now we can generate the image")
+		return skippedPost{skipReason}
 	}
-	postImage, err := generateImage(prosuUser, dbOsuPlayer, checks, l)
+	postImage, err := generateImage(prosuUser, dbOsuPlayer, previousRequest, newRequest, l)
 	if err != nil {
 		l.Error("Failed to generate image for user")
 		captureError(err)
-		return
+		return err
+	}
+
+	caption, captionMarker := tweetCaption(dbOsuPlayer), tweetCaptionMarker
+	if kind == jobKindManual {
+		caption, captionMarker = postNowCaption(dbOsuPlayer), postNowCaptionMarker
+	}
+	tweetID, err := postImageTweet(prosuUser, postImage, caption, captionMarker, intent, intentCreated, l)
+	if err != nil {
+		return err
+	}
+	l.Log("Adding to database")
+
+	err = recordTweet(prosuUser, tweetID, newRequest.GetId(), l)
+	if err != nil {
+		l.Error("Failed to add new tweet to database")
+		captureError(err)
+		// The intent knows the tweet is up, so a retry only records it
+		return err
 	}
+	l.Log("Successfully added new tweet to user's profile. Tweet posting complete!")
+	return nil
+}
 
+// postImageTweet uploads the image and tweets it with the caption from the user's account, unless an earlier attempt for the intent
+// already did. captionMarker is a part of the caption that tells this kind of tweet apart from our others on the user's timeline.
+// Returns the ID of the tweet
+func postImageTweet(prosuUser *User, postImage image.Image, caption string, captionMarker string, intent *PostIntent, intentCreated bool, l pLogger) (string, error) {
 	var postImageBuffer bytes.Buffer
 	png.Encode(&postImageBuffer, postImage)
 	postImageBase64 := base64.StdEncoding.EncodeToString(postImageBuffer.Bytes())
@@ -312,121 +343,128 @@ func updateAndPost(userID bson.ObjectId) {
 	ok, err := prosuTwitter.VerifyCredentials()
 	if err != nil {
 		l.Error("Failed to check validity of Twitter credentials")
-		credErr := err.Error()
-		l.Error("CREDENTIAL ERROR: " + credErr)
-		if strings.Contains(credErr, "\"code\":326") || strings.Contains(credErr, "To protect our users from spam and other malicious activity") {
-			// Error: "To protect our users from spam and other malicious activity, this account is temporarily locked. Please log in to https://twitter.com to unlock your account."
-			l.Error("The user's account is currently locked. For now, we will just give up. In the future we should consider disabling tweets for user's who have their accounts locked too long.")
-			return
-		} else if strings.Contains(credErr, "\"code\":89") {
-			// Error: Invalid or expired token.
-			l.Error("The user's tokens have expired. Disabling tweet posting.")
-			prosuUser.OsuSettings.Enabled = false
-			saveErr := connection.Collection("usermodels").Save(prosuUser)
-			if saveErr == nil {
-				l.Error("Disabled tweeting on the user's account.")
-				return
-			}
-			l.Error("Failed to disable tweeting on the user's account")
-			captureError(saveErr)
-			return
-		} else if strings.Contains(credErr, "\"code\":64") || strings.Contains(credErr, "suspended") {
-			// Error: Your account is suspended and is not permitted to access this feature.
-			l.Error("The user's Twitter account is suspended. Disabling tweet posting.")
-			prosuUser.OsuSettings.Enabled = false
-			saveErr := connection.Collection("usermodels").Save(prosuUser)
-			if saveErr == nil {
-				l.Error("Disabled tweeting on the user's account.")
-				return
-			}
-			l.Error("Failed to disable tweeting on the user's account")
-			captureError(saveErr)
-			return
-		}
-
-		captureError(err)
-		return
+		return "", handleTwitterError(prosuUser, "verify credentials", err, l)
 	}
 	if !ok {
 		l.Error("Twitter credentials were not valid. Disabling tweets for user")
-		prosuUser.OsuSettings.Enabled = false
-		err = connection.Collection("usermodels").Save(prosuUser)
+		err = disableUserTweets(prosuUser, disabledReasonInvalidCredentials)
 		if err != nil {
 			l.Error("Failed to disable user's tweets")
 			captureError(err)
-			return
+			return "", permanentError{err}
 		}
 		l.Log("Successfully disabled user's tweets after realizing their credentials are invalid")
-		return
+		return "", permanentError{errInvalidCredentials}
+	}
+	l.Log("User's credentials are valid")
+	if err := clearCredentialFailures(prosuUser); err != nil {
+		l.Error("Failed to clear the user's credential failures")
+		captureError(err)
 	}
-	l.Log("User's credentials are valid. Uploading media")
-
-	media, err := prosuTwitter.UploadMedia(postImageBase64)
-	if err != nil {
-		l.Error("Failed to upload image to Twitter")
 
-		imageErr := err.Error()
-
-		if strings.Contains(imageErr, "\"code\":326") || strings.Contains(imageErr, "To protect our users from spam and other malicious activity") {
-			// Error: "To protect our users from spam and other malicious activity, this account is temporarily locked. Please log in to https://twitter.com to unlock your account."
-			l.Error("The user's account is currently locked. For now, we will just give up. In the future we should consider disabling tweets for user's who have their accounts locked too long.")
-			return
-		} else if strings.Contains(imageErr, "\"code\":89") {
-			// Error: Invalid or expired token.
-			l.Error("The user's tokens have expired. Disabling tweet posting.")
-			prosuUser.OsuSettings.Enabled = false
-			saveErr := connection.Collection("usermodels").Save(prosuUser)
-			if saveErr == nil {
-				l.Error("Disabled tweeting on the user's account.")
-				return
-			}
-			l.Error("Failed to disable tweeting on the user's account")
-			captureError(saveErr)
-			return
-		} else if strings.Contains(imageErr, "\"code\":64") || strings.Contains(imageErr, "suspended") {
-			// Error: Your account is suspended and is not permitted to access this feature.
-			l.Error("The user's Twitter account is suspended. Disabling tweet posting.")
-			prosuUser.OsuSettings.Enabled = false
-			saveErr := connection.Collection("usermodels").Save(prosuUser)
-			if saveErr == nil {
-				l.Error("Disabled tweeting on the user's account.")
-				return
+	// An earlier attempt may have posted the tweet and died before recording it
+	if !intentCreated {
+		l.Log("Checking the user's timeline for a tweet from an earlier attempt")
+		tweetID, found, err := findIntentTweet(prosuTwitter, prosuUser, intent, captionMarker)
+		if err != nil {
+			l.Error("Failed to check the user's timeline")
+			return "", handleTwitterError(prosuUser, "get user timeline", err, l)
+		}
+		if found {
+			l.Log("Found tweet " + tweetID + " from an earlier attempt. Recording it instead of posting again")
+			if err := markPostIntentPosted(intent, tweetID); err != nil {
+				l.Error("Failed to mark the posting intent as posted")
+				captureError(err)
 			}
-			l.Error("Failed to disable tweeting on the user's account")
-			captureError(saveErr)
-			return
+			return tweetID, nil
 		}
+	}
+	l.Log("Uploading media")
 
-		captureError(err)
-		return
+	media, err := prosuTwitter.UploadMedia(postImageBase64)
+	if err != nil {
+		l.Error("Failed to upload image to Twitter")
+		return "", handleTwitterError(prosuUser, "upload media", err, l)
 	}
 	l.Log("Successfully uploaded image to Twitter. Creating Tweet")
 	urlVals := url.Values{}
 	urlVals.Add("media_ids", media.MediaIDString)
-	tweet, err := prosuTwitter.PostTweet("osu! stats for player "+dbOsuPlayer.PlayerName+" automatically generated by https://prosu.xyz #ProsuTweetPoster", urlVals)
+	tweet, err := prosuTwitter.PostTweet(caption, urlVals)
+	if err != nil && classifyTwitterError("post tweet", err).Category == twitterErrorDuplicate {
+		// Twitter already has this tweet, most likely from an attempt whose response never reached us
+		l.Log("Twitter says the tweet is a duplicate. Looking for it on the user's timeline")
+		tweetID, found, findErr := findIntentTweet(prosuTwitter, prosuUser, intent, captionMarker)
+		if findErr != nil {
+			l.Error("Failed to check the user's timeline")
+			return "", handleTwitterError(prosuUser, "get user timeline", findErr, l)
+		}
+		if found {
+			l.Log("Found tweet " + tweetID + " on the user's timeline. Recording it instead of posting again")
+			if err := markPostIntentPosted(intent, tweetID); err != nil {
+				l.Error("Failed to mark the posting intent as posted")
+				captureError(err)
+			}
+			return tweetID, nil
+		}
+		l.Error("Couldn't find the duplicate tweet on the user's timeline")
+	}
 	if err != nil {
 		l.Error("Error posting tweet")
-		captureError(err)
-		return
+		return "", handleTwitterError(prosuUser, "post tweet", err, l)
 	}
 	l.Log("Tweet successfully posted: https://twitter.com/" + prosuUser.Twitter.Profile.Handle + "/status/" + tweet.IdStr)
-	l.Log("Adding to database")
-
-	dbTweet := UserTweet{
-		DatePosted: time.Now().Unix(),
-		TweetObject: TweetObject{
-			ID: tweet.IdStr,
-		},
+	if err := markPostIntentPosted(intent, tweet.IdStr); err != nil {
+		// A retry will still find the tweet on the user's timeline
+		l.Error("Failed to mark the posting intent as posted")
+		captureError(err)
 	}
+	return tweet.IdStr, nil
+}
+
+// Part of every scheduled tweet's caption
+const tweetCaptionMarker = "osu! stats for player"
+
+// Part of every post now tweet's caption. It mustn't contain tweetCaptionMarker, or the two kinds of tweet would be mistaken for
+// each other on the user's timeline
+const postNowCaptionMarker = "osu! stats on request for player"
+
+// tweetCaption is the text posted along with the player's stats
+func tweetCaption(player *OsuPlayer) string {
+	return tweetCaptionMarker + " " + player.PlayerName + " automatically generated by https://prosu.xyz " + tweetHashtag
+}
 
-	prosuUser.TweetHistory = append(prosuUser.TweetHistory, dbTweet)
-	err = connection.Collection("usermodels").Save(prosuUser)
+// postNowCaption is the text posted along with stats the user asked for with post now
+func postNowCaption(player *OsuPlayer) string {
+	return postNowCaptionMarker + " " + player.PlayerName + " generated by https://prosu.xyz " + tweetHashtag
+}
+
+// refreshPlayerChecks grabs the user's osu! player and makes sure it has a recent check for the user's game mode. It returns the
+// previous and newest checks to compare. In a dry run new data is still fetched, but nothing is saved
+func refreshPlayerChecks(user *User, l pLogger, dryRun bool) (*OsuPlayer, *OsuRequest, *OsuRequest, error) {
+	l.Log("Getting recent data for the user's osu! player in " + allOsuModes[user.OsuSettings.Mode])
+	snap, err := snapshots.Latest(postingAPI, user.OsuSettings.Player, user.OsuSettings.Mode, snapshotMaxAge, dryRun)
 	if err != nil {
-		l.Error("Failed to add new tweet to database")
+		l.Error("Failed to get recent data for the player")
+		if _, ok := err.(*bongo.DocumentNotFoundError); ok {
+			return nil, nil, nil, permanentError{err}
+		}
+		if err == errSnapshotNotFound {
+			return nil, nil, nil, permanentError{err}
+		}
 		captureError(err)
-		return
+		return nil, nil, nil, err
 	}
-	l.Log("Successfully added new tweet to user's profile. Tweet posting complete!")
+	if snap.Previous == nil {
+		// The first check for the mode was only just saved, so there is nothing to compare it to yet
+		l.Error("The player doesn't have any checks to compare to for the user's preferred game mode")
+		return nil, nil, nil, permanentError{errors.New("no checks for " + allOsuModes[user.OsuSettings.Mode])}
+	}
+	if snap.Fetched {
+		l.Log("Fetched new data for player " + snap.Player.PlayerName)
+	} else {
+		l.Log("Reusing data for player " + snap.Player.PlayerName + " from less than " + strconv.Itoa(int(snapshotMaxAge.Hours())) + " hours ago")
+	}
+	return snap.Player, snap.Previous, snap.Latest, nil
 }
 
 // For logging during posting
@@ -477,24 +515,7 @@ func createRequest(dbID bson.ObjectId, data *osuapi.User) *OsuRequest {
 	}
 }
 
-// Grab the user's avatar
-func getAvatar(userID string) (image.Image, error) {
-	res, err := http.Get("https://a.ppy.sh/" + userID)
-	if err != nil {
-		log.Critical("Couldn't get users' avatar! Returning guest avatar. URL: %s", "https://a.ppy.sh/"+userID)
-		return guestAvatar, nil
-	}
-	defer res.Body.Close()
-	img, _, err := image.Decode(res.Body)
-	if err != nil {
-		// TODO: Rather than just returning an error, we should be returning the guest avatar!
-		log.Critical("Failed to decode user's avatar! Links: https://a.ppy.sh/" + userID)
-		return guestAvatar, nil
-	}
-	return img, nil
-}
-
-func generateImage(user *User, player *OsuPlayer, checks []bson.ObjectId, l pLogger) (finalImage image.Image, funcErr error) {
+func generateImage(user *User, player *OsuPlayer, previousRequest *OsuRequest, newRequest *OsuRequest, l pLogger) (finalImage image.Image, funcErr error) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Critical("Recovering from failed generateImage for " + user.Twitter.Profile.Handle)
@@ -512,21 +533,7 @@ func generateImage(user *User, player *OsuPlayer, checks []bson.ObjectId, l pLog
 			finalImage = nil
 		}
 	}()
-	previousRequest := &OsuRequest{}
-	newRequest := &OsuRequest{}
-	l.Log("Grabbing previous requests")
-	err := connection.Collection("osurequestmodels").FindById(checks[len(checks)-2], previousRequest)
-	if err != nil {
-		l.Error("Failed to grab old request")
-		return nil, err
-	}
-	err = connection.Collection("osurequestmodels").FindById(checks[len(checks)-1], newRequest)
-	if err != nil {
-		l.Error("Failed to grab new request")
-		return nil, err
-	}
-
-	l.Log("Successfully grabbed previous requests. Grabbing avatar")
+	l.Log("Grabbing avatar")
 	avatar, err := getAvatar(player.UserID)
 	if err != nil {
 		l.Error("Failed to grab avatar")
@@ -580,20 +587,36 @@ func generateImage(user *User, player *OsuPlayer, checks []bson.ObjectId, l pLog
 	}
 
 	// Create the context
-	dc := gg.NewContext(440, 220)
+	theme := themeByID(user.OsuSettings.Theme)
+	layout := theme.Layout
+	palette := theme.Palette
+	width := theme.Width
+
+	// The history chart is nice to have, so a tweet still goes out without it
+	var history []OsuRequest
+	if user.OsuSettings.ShowHistory {
+		history, err = loadHistory(player, user.OsuSettings.Mode, newRequest)
+		if err != nil {
+			l.Error("Failed to load the player's history, leaving the chart off: " + err.Error())
+			history = nil
+		} else {
+			width += int(layout.ChartWidth)
+		}
+	}
+	dc := gg.NewContext(width, theme.Height)
 
 	// Create background
-	dc.SetRGB(0, 0, 0)
+	dc.SetColor(theme.Background)
 	dc.Clear()
 
 	// Draw the avatar
-	dc.DrawImage(resize.Resize(100, 100, avatar, resize.Lanczos3), 0, 0)
+	dc.DrawImage(resize.Resize(uint(layout.AvatarSize), uint(layout.AvatarSize), avatar, resize.Lanczos3), int(layout.AvatarX), int(layout.AvatarY))
 
 	// Draw mode
-	dc.DrawImage(resize.Resize(45, 45, modeImage, resize.Lanczos3), 25, 160)
+	dc.DrawImage(resize.Resize(uint(layout.ModeSize), uint(layout.ModeSize), modeImage, resize.Lanczos3), int(layout.ModeX), int(layout.ModeY))
 
 	// Draw country flag
-	dc.DrawImage(resize.Resize(45, 30, flagImage, resize.Lanczos3), 25, 115)
+	dc.DrawImage(resize.Resize(uint(layout.FlagW), uint(layout.FlagH), flagImage, resize.Lanczos3), int(layout.FlagX), int(layout.FlagY))
 
 	// We don't need these anymore
 	avatar = nil
@@ -603,234 +626,60 @@ func generateImage(user *User, player *OsuPlayer, checks []bson.ObjectId, l pLog
 	/* Draw player info */
 
 	// Stats For:
-	dc.SetFontFace(arialFont20)
-	dc.SetFillStyle(gg.NewSolidPattern(color.White))
-	dc.DrawString("Stats For: ", 110, 24)
+	dc.SetFontFace(regularFonts.Face(theme.Fonts.Header))
+	dc.SetColor(palette.Text)
+	dc.DrawString("Stats For: ", layout.TextX, layout.HeaderY)
 	statsStringSizeW, _ := dc.MeasureString("Stats For: ")
 
 	// Player Name
-	dc.SetFontFace(arialBold20)
-	dc.DrawString(player.PlayerName, 110+statsStringSizeW, 24)
+	dc.SetFontFace(boldFonts.Face(theme.Fonts.Header))
+	dc.SetColor(palette.Name)
+	dc.DrawString(player.PlayerName, layout.TextX+statsStringSizeW, layout.HeaderY)
 
 	// Updated On:
-	dc.SetFontFace(arialFont12)
+	dc.SetFontFace(regularFonts.Face(theme.Fonts.Updated))
+	dc.SetColor(palette.Text)
 	updatedTime := time.Unix(newRequest.DateChecked, 0)
-	dc.DrawString("Updated On: "+updatedTime.Month().String()+" "+strconv.Itoa(updatedTime.Day())+", "+strconv.Itoa(updatedTime.Year()), 110, 40)
+	dc.DrawString("Updated On: "+updatedTime.Month().String()+" "+strconv.Itoa(updatedTime.Day())+", "+strconv.Itoa(updatedTime.Year()), layout.TextX, layout.UpdatedY)
 
 	// Create line under date
-	dc.SetStrokeStyle(colorGray)
-	dc.MoveTo(100, 45)
-	dc.LineTo(440, 45)
+	dc.SetColor(palette.Muted)
+	dc.MoveTo(layout.LineX, layout.LineY)
+	dc.LineTo(float64(width), layout.LineY)
 	dc.Stroke()
 
 	/* Start drawing the actual data */
-	vert := 63.00
-	dc.SetFontFace(arialFont18)
-	// Rank
-	newRankData := newRequest.Data.PP.Rank
-	oldRankData := previousRequest.Data.PP.Rank
-	difference := float64(newRankData - oldRankData)
-	arrow := 0
-	if difference < 0 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0 {
-		arrow = 1
-	} else {
-		arrow = 0
-	}
-	dc.SetFillStyle(colorWhite)
-	str := "Rank: " + formatDecimal(float64(newRankData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ := dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, true)
-	vert += 18
-
-	// Country Rank
-	newRankData = newRequest.Data.PP.CountryRank
-	oldRankData = previousRequest.Data.PP.CountryRank
-	difference = float64(newRankData - oldRankData)
-	if difference < 0 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0 {
-		arrow = 1
-	} else {
-		arrow = 0
-	}
-	dc.SetFillStyle(colorWhite)
-	str = "Country Rank: " + formatDecimal(float64(newRankData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ = dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, true)
-	vert += 18
-
-	// PP
-	newPPData := newRequest.Data.PP.Raw
-	oldPPData := previousRequest.Data.PP.Raw
-	difference = float64(newPPData - oldPPData)
-	if difference < -0.01 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0.01 {
-		arrow = 1
-	} else {
-		difference = 0
-		arrow = 0
-	}
-	dc.SetFillStyle(colorWhite)
-	str = "PP: " + formatDecimal(float64(newPPData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ = dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, false)
-	vert += 18
-
-	// Play Count
-	newPlayCountData := newRequest.Data.Counts.Plays
-	oldPlayCountData := previousRequest.Data.Counts.Plays
-	difference = float64(newPlayCountData - oldPlayCountData)
-	if difference < 0 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0 {
-		arrow = 1
-	} else {
-		arrow = 0
-	}
-	dc.SetFillStyle(colorWhite)
-	str = "Play Count: " + formatDecimal(float64(newPlayCountData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ = dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, false)
-	vert += 18
-
-	// Level
-	newLevelData := newRequest.Data.Level
-	oldLevelData := previousRequest.Data.Level
-	difference = float64(newLevelData - oldLevelData)
-	if difference < -0.01 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0.01 {
-		arrow = 1
-	} else {
-		difference = 0
-		arrow = 0
-	}
-	dc.SetFillStyle(colorWhite)
-	str = "Level: " + formatDecimal(float64(newLevelData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ = dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, false)
-	vert += 18
-
-	//Accuracy
-	newAccData := newRequest.Data.Accuracy
-	oldAccData := previousRequest.Data.Accuracy
-	difference = float64(newAccData - oldAccData)
-	if difference < 0 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0 {
-		arrow = 1
-	} else {
-		arrow = 0
-	}
-	dc.SetFillStyle(colorWhite)
-	str = "Accuracy: " + formatDecimal(float64(newAccData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ = dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, false)
-	vert += 18
-
-	// SS
-	newSSData := newRequest.Data.Counts.SS + newRequest.Data.Counts.SSH
-	oldSSData := previousRequest.Data.Counts.SS + previousRequest.Data.Counts.SSH
-	difference = float64(newSSData - oldSSData)
-	if difference < 0 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0 {
-		arrow = 1
-	} else {
-		arrow = 0
-	}
-	dc.SetFillStyle(colorWhite)
-	str = "SS: " + formatDecimal(float64(newSSData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ = dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, false)
-	vert += 18
-
-	// S
-	newSData := newRequest.Data.Counts.S + newRequest.Data.Counts.SH
-	oldSData := previousRequest.Data.Counts.S + previousRequest.Data.Counts.SH
-	difference = float64(newSData - oldSData)
-	if difference < 0 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0 {
-		arrow = 1
-	} else {
-		arrow = 0
-	}
-	dc.SetFillStyle(colorWhite)
-	str = "S: " + formatDecimal(float64(newSData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ = dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, false)
-	vert += 18
-
-	// A
-	newAData := newRequest.Data.Counts.A
-	oldAData := previousRequest.Data.Counts.A
-	difference = float64(newAData - oldAData)
-	if difference < -0.01 {
-		difference *= -1
-		arrow = -1
-	} else if difference > 0.01 {
-		arrow = 1
-	} else {
-		arrow = 0
+	vert := layout.RowsY
+	rowFace := regularFonts.Face(theme.Fonts.Row)
+	dc.SetFontFace(rowFace)
+	for _, row := range cardRows(user, theme) {
+		newData := row.Value(newRequest.Data)
+		difference := newData - row.Value(previousRequest.Data)
+		arrow := 0
+		if math.Abs(difference) <= row.Epsilon {
+			difference = 0
+		} else if difference < 0 {
+			difference *= -1
+			arrow = -1
+		} else {
+			arrow = 1
+		}
+		dc.SetColor(palette.Text)
+		str, diffString := statRowText(rowFace, row, newData, difference, statRowMaxWidth(theme))
+		dc.DrawString(str, layout.TextX, vert)
+		width, _ := dc.MeasureString(str)
+		drawDifference(dc, palette, diffString, layout.TextX+width, vert, arrow, row.LowerIsBetter)
+		vert += layout.RowHeight
+	}
+
+	if history != nil {
+		dc.SetFontFace(regularFonts.Face(theme.Fonts.Updated))
+		drawHistoryChart(dc, theme, history, float64(theme.Width))
 	}
-	dc.SetFillStyle(colorWhite)
-	str = "A: " + formatDecimal(float64(newAData))
-	dc.DrawString(str, 110.00, vert)
-	width, _ = dc.MeasureString(str)
-	drawDifference(dc, difference, vert, arrow, width, false)
-	vert += 18
 
 	return dc.Image(), nil
 }
 
-var colorRed = gg.NewSolidPattern(color.RGBA{
-	R: 255,
-	G: 0,
-	B: 0,
-	A: 255,
-})
-
-var colorGreen = gg.NewSolidPattern(color.RGBA{
-	R: 0,
-	G: 255,
-	B: 0,
-	A: 255,
-})
-
-var colorGray = gg.NewSolidPattern(color.RGBA{
-	R: 128,
-	G: 128,
-	B: 128,
-	A: 255,
-})
-
-var colorWhite = gg.NewSolidPattern(color.RGBA{
-	R: 255,
-	G: 255,
-	B: 255,
-	A: 255,
-})
-
 // Round to 0.10
 func formatDecimal(x float64) string {
 	rounded := math.Floor(x)
@@ -849,49 +698,47 @@ func formatDecimal(x float64) string {
 	return str
 }
 
-// Draws the specified color arrow
-func drawDifference(dc *gg.Context, difference, height float64, arrow int, textWidth float64, isRank bool) {
-	diffString := formatDecimal(difference)
-
+// Draws the specified color arrow after the text ending at x
+func drawDifference(dc *gg.Context, palette cardPalette, diffString string, x, height float64, arrow int, lowerIsBetter bool) {
 	if arrow == -1 {
-		if isRank {
-			dc.SetFillStyle(colorGreen)
+		if lowerIsBetter {
+			dc.SetColor(palette.Better)
 		} else {
-			dc.SetFillStyle(colorRed)
+			dc.SetColor(palette.Worse)
 		}
 
-		dc.MoveTo(textWidth+110+5, height-8.5)
-		dc.LineTo(textWidth+110+20, height-8.5)
-		dc.LineTo(textWidth+110+12.5, height)
+		dc.MoveTo(x+5, height-8.5)
+		dc.LineTo(x+20, height-8.5)
+		dc.LineTo(x+12.5, height)
 		dc.Fill()
 
-		dc.DrawString(diffString, 110+textWidth+22, height)
+		dc.DrawString(diffString, x+22, height)
 	} else if arrow == 0 {
-		dc.SetFillStyle(colorGray)
+		dc.SetColor(palette.Muted)
 
-		dc.MoveTo(110+textWidth+5, height-5.5)
-		dc.LineTo(110+textWidth+20, height-5.5)
-		dc.LineTo(110+textWidth+12.5, height-13)
+		dc.MoveTo(x+5, height-5.5)
+		dc.LineTo(x+20, height-5.5)
+		dc.LineTo(x+12.5, height-13)
 		dc.Fill()
 
-		dc.MoveTo(110+textWidth+5, height-5.5)
-		dc.LineTo(110+textWidth+20, height-5.5)
-		dc.LineTo(110+textWidth+12.5, height+2)
+		dc.MoveTo(x+5, height-5.5)
+		dc.LineTo(x+20, height-5.5)
+		dc.LineTo(x+12.5, height+2)
 		dc.Fill()
 
-		dc.DrawString(diffString, 110+textWidth+20, height)
+		dc.DrawString(diffString, x+20, height)
 	} else {
-		if isRank {
-			dc.SetFillStyle(colorRed)
+		if lowerIsBetter {
+			dc.SetColor(palette.Worse)
 		} else {
-			dc.SetFillStyle(colorGreen)
+			dc.SetColor(palette.Better)
 		}
 
-		dc.MoveTo(110+textWidth+5, height-2.5)
-		dc.LineTo(110+textWidth+20, height-2.5)
-		dc.LineTo(110+textWidth+12.5, height-11)
+		dc.MoveTo(x+5, height-2.5)
+		dc.LineTo(x+20, height-2.5)
+		dc.LineTo(x+12.5, height-11)
 		dc.Fill()
 
-		dc.DrawString(diffString, 110+textWidth+20, height)
+		dc.DrawString(diffString, x+20, height)
 	}
 }
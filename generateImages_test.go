@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+func TestDueUsersAdd(t *testing.T) {
+	staleBefore := mustParseTime(t, "2021-06-10T06:00:00Z")
+	recent := mustParseTime(t, "2021-06-10T11:00:00Z").Unix()
+	old := mustParseTime(t, "2021-06-10T02:00:00Z").Unix()
+	player := bson.NewObjectId()
+	tests := []struct {
+		name       string
+		player     bson.ObjectId
+		nextPostAt int64
+		hasOpenJob bool
+		want       string
+	}{
+		{name: "due", player: player, nextPostAt: recent, want: "due"},
+		{name: "due at the edge of the look-back", player: player, nextPostAt: staleBefore.Unix(), want: "due"},
+		{name: "stale", player: player, nextPostAt: old, want: "stale"},
+		{name: "stale but still queued for a retry", player: player, nextPostAt: old, hasOpenJob: true, want: "due"},
+		{name: "queued", player: player, nextPostAt: recent, hasOpenJob: true, want: "due"},
+		{name: "no player", nextPostAt: recent, want: "no player"},
+		{name: "no player and stale", nextPostAt: old, want: "no player"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			user := &User{OsuSettings: OsuSettings{Player: test.player}, NextPostAt: test.nextPostAt}
+			user.SetId(bson.NewObjectId())
+			users := dueUsers{}
+			users.add(user, staleBefore, test.hasOpenJob)
+
+			groups := map[string][]dueUser{"due": users.Due, "stale": users.Stale, "no player": users.NoPlayer}
+			for group, list := range groups {
+				want := 0
+				if group == test.want {
+					want = 1
+				}
+				if len(list) != want {
+					t.Errorf("%d user(s) in the %s group, want %d", len(list), group, want)
+				}
+			}
+			if list := groups[test.want]; len(list) == 1 && (list[0].ID != user.GetId() || list[0].Slot != test.nextPostAt) {
+				t.Errorf("got %+v, want the user's ID and slot", list[0])
+			}
+		})
+	}
+}
+
+func TestLookbackStart(t *testing.T) {
+	defer func(lookback int) { schedulerMaxLookback = lookback }(schedulerMaxLookback)
+	schedulerMaxLookback = 6
+	now := mustParseTime(t, "2021-06-10T12:00:00Z")
+	tests := []struct {
+		name          string
+		lastCompleted int64
+		want          time.Time
+	}{
+		{"never run", 0, now.Add(-6 * time.Hour)},
+		{"ran last hour", now.Add(-time.Hour).Unix(), now.Add(-time.Hour)},
+		{"short outage", now.Add(-3 * time.Hour).Unix(), now.Add(-3 * time.Hour)},
+		{"outage longer than the look-back", now.Add(-48 * time.Hour).Unix(), now.Add(-6 * time.Hour)},
+		{"already ran this hour", now.Unix(), now},
+		{"clock went backwards", now.Add(time.Hour).Unix(), now},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := lookbackStart(now, test.lastCompleted); !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got.Format(time.RFC3339), test.want.Format(time.RFC3339))
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/globalsign/mgo/bson"
+	"gopkg.in/fogleman/gg.v1"
+)
+
+// How many of the player's latest checks the history chart plots
+const historyChartPoints = 30
+
+// loadHistory returns up to the last historyChartPoints checks of the player in the mode, oldest first, ending with newRequest. newRequest
+// may not be saved yet, like in a dry run
+func loadHistory(player *OsuPlayer, mode int, newRequest *OsuRequest) ([]OsuRequest, error) {
+	checks := player.ChecksForMode(mode)
+	ids := []bson.ObjectId{}
+	for _, id := range checks {
+		if id != newRequest.GetId() {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > historyChartPoints-1 {
+		ids = ids[len(ids)-(historyChartPoints-1):]
+	}
+
+	history := []OsuRequest{}
+	if len(ids) != 0 {
+		err := connection.Collection("osurequestmodels").Collection().Find(bson.M{"_id": bson.M{"$in": ids}}).All(&history)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Checks are pushed in the order they're made, which is more reliable than the dates on checks from the old site
+	order := map[bson.ObjectId]int{}
+	for i, id := range ids {
+		order[id] = i
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return order[history[i].GetId()] < order[history[j].GetId()]
+	})
+	return append(history, *newRequest), nil
+}
+
+// drawHistoryChart draws the panel of history charts from x to the right edge of the card: pp on top, and global rank under it with
+// better ranks higher up
+func drawHistoryChart(dc *gg.Context, theme *cardTheme, history []OsuRequest, x float64) {
+	layout := theme.Layout
+	pp := []float64{}
+	rank := []float64{}
+	for _, request := range history {
+		pp = append(pp, float64(request.Data.PP.Raw))
+		// Players who haven't played in a while have no rank, which would squash the rest of the chart
+		if request.Data.PP.Rank > 0 {
+			rank = append(rank, float64(request.Data.PP.Rank))
+		}
+	}
+
+	top := layout.LineY + 8
+	chartWidth := float64(dc.Width()) - x - 10
+	chartHeight := (float64(dc.Height())-top)/2 - 20
+	drawSparkline(dc, theme, "PP", pp, x, top, chartWidth, chartHeight, false)
+	drawSparkline(dc, theme, "Rank", rank, x, top+chartHeight+20, chartWidth, chartHeight, true)
+}
+
+// drawSparkline draws a labelled line chart of the values in the box below the label. With invert set, lower values are drawn higher
+func drawSparkline(dc *gg.Context, theme *cardTheme, label string, values []float64, x, y, width, height float64, invert bool) {
+	dc.SetColor(theme.Palette.Muted)
+	dc.DrawString(label, x, y+theme.Fonts.Updated)
+	y += theme.Fonts.Updated + 6
+
+	dc.DrawRectangle(x, y, width, height)
+	dc.SetLineWidth(1)
+	dc.Stroke()
+	if len(values) < 2 {
+		dc.DrawStringAnchored("Not enough checks yet", x+width/2, y+height/2, 0.5, 0.5)
+		return
+	}
+
+	min, max := values[0], values[0]
+	for _, value := range values {
+		if value < min {
+			min = value
+		}
+		if value > max {
+			max = value
+		}
+	}
+	pointY := func(value float64) float64 {
+		// A flat line goes through the middle
+		if max == min {
+			return y + height/2
+		}
+		fraction := (value - min) / (max - min)
+		if invert {
+			fraction = 1 - fraction
+		}
+		return y + height - 3 - fraction*(height-6)
+	}
+	step := (width - 6) / float64(len(values)-1)
+	for i, value := range values {
+		dc.LineTo(x+3+float64(i)*step, pointY(value))
+	}
+	dc.SetColor(theme.Palette.Name)
+	dc.SetLineWidth(2)
+	dc.Stroke()
+	dc.DrawCircle(x+3+float64(len(values)-1)*step, pointY(values[len(values)-1]), 2.5)
+	dc.Fill()
+	dc.SetLineWidth(1)
+}
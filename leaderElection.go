@@ -0,0 +1,158 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// Only the replica holding this lease runs the hourly posting function
+const schedulerLeaseKey = "prosu:scheduler:leader"
+
+// How long the lease lasts without being renewed. A replica takes over at most this long (plus one renewal interval) after the leader dies
+var schedulerLeaseTTL = 30 * time.Second
+
+var leader *leaderLease
+
+// Only extend the lease if we still own it
+var renewLeaseScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Only delete the lease if we still own it
+var releaseLeaseScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// setupLeaderLeaseTTL reads how long the scheduler lease lasts. It runs from main, after the .env file is loaded
+func setupLeaderLeaseTTL() {
+	if ttl := os.Getenv("LEADER_LEASE_SECONDS"); ttl != "" {
+		n, err := strconv.Atoi(ttl)
+		if err != nil || n < 3 {
+			panic(errors.New("LEADER_LEASE_SECONDS must be a number of at least 3"))
+		}
+		schedulerLeaseTTL = time.Duration(n) * time.Second
+	}
+}
+
+// leaderLease - A lease in Redis that at most one replica holds at a time
+type leaderLease struct {
+	pool *redis.Pool
+	key  string
+	id   string
+	ttl  time.Duration
+
+	// Called every time this replica takes over the lease
+	OnAcquire func()
+
+	mu         sync.Mutex
+	validUntil time.Time
+	stop       chan bool
+}
+
+func newLeaderLease(pool *redis.Pool, key string, ttl time.Duration) *leaderLease {
+	hostname, _ := os.Hostname()
+	random := make([]byte, 8)
+	rand.Read(random)
+	return &leaderLease{
+		pool: pool,
+		key:  key,
+		id:   hostname + "-" + strconv.Itoa(os.Getpid()) + "-" + hex.EncodeToString(random),
+		ttl:  ttl,
+	}
+}
+
+// Start keeps trying to acquire the lease, and renews it once we have it
+func (l *leaderLease) Start() {
+	l.refresh()
+	l.stop = setInterval(l.refresh, int(l.ttl/time.Millisecond)/3, false)
+}
+
+// IsLeader reports whether we hold the lease. We stop trusting the lease a little before it expires in Redis, so two replicas never both think they lead
+func (l *leaderLease) IsLeader() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return time.Now().Before(l.validUntil)
+}
+
+// Release stops renewing the lease and gives it up so another replica can take over immediately
+func (l *leaderLease) Release() {
+	if l.stop != nil {
+		l.stop <- true
+	}
+	l.mu.Lock()
+	l.validUntil = time.Time{}
+	l.mu.Unlock()
+
+	conn := l.pool.Get()
+	defer conn.Close()
+	if _, err := releaseLeaseScript.Do(conn, l.key, l.id); err != nil {
+		log.Error("[LEADER] Failed to release the scheduler lease")
+		log.Error(err.Error())
+	}
+}
+
+func (l *leaderLease) refresh() {
+	wasLeader := l.IsLeader()
+	started := time.Now()
+	acquired, err := l.acquireOrRenew()
+	if err != nil {
+		log.Error("[LEADER] Failed to acquire or renew the scheduler lease")
+		log.Error(err.Error())
+		return
+	}
+
+	l.mu.Lock()
+	if acquired {
+		// Measured from before the request, with a margin for clock drift between us and Redis
+		l.validUntil = started.Add(l.ttl - l.ttl/10)
+	} else {
+		l.validUntil = time.Time{}
+	}
+	l.mu.Unlock()
+
+	if acquired && !wasLeader {
+		log.Info("[LEADER] This replica is now running the scheduler (" + l.id + ")")
+		if l.OnAcquire != nil {
+			l.OnAcquire()
+		}
+	} else if !acquired && wasLeader {
+		log.Warning("[LEADER] This replica lost the scheduler lease")
+	}
+}
+
+func (l *leaderLease) acquireOrRenew() (bool, error) {
+	conn := l.pool.Get()
+	defer conn.Close()
+	ttl := int64(l.ttl / time.Millisecond)
+
+	renewed, err := redis.Int(renewLeaseScript.Do(conn, l.key, l.id, ttl))
+	if err != nil {
+		return false, err
+	}
+	if renewed == 1 {
+		return true, nil
+	}
+
+	_, err = redis.String(conn.Do("SET", l.key, l.id, "NX", "PX", ttl))
+	if err == redis.ErrNil {
+		// Somebody else holds the lease
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -65,10 +65,14 @@ var bundle *i18n.Bundle
 // osu! api
 var api osuRateLimiter
 var postingAPI osuRateLimiter
+var collectorAPI osuRateLimiter
 
 // Is maintenance
 var isMaintenance = false
 
+// Is a dry run of the posting pipeline instead of the website
+var isDryRun = false
+
 type blankData struct {
 }
 
@@ -126,6 +130,7 @@ func init() {
 	}
 
 	connection = conn
+	runMigrations()
 
 	osuAPIKey := os.Getenv("OSU_API_KEY")
 	if len(osuAPIKey) == 0 {
@@ -133,15 +138,35 @@ func init() {
 	}
 	api = newOsuLimiter(osuapi.NewAPI(osuAPIKey), 250)
 	postingAPI = newOsuLimiter(osuapi.NewAPI(osuAPIKey), 250)
+	collectorAPI = newOsuLimiter(osuapi.NewAPI(osuAPIKey), 60)
 
 	// Check if maintenance mode
 	if os.Getenv("MAINTENANCE") == "true" {
 		isMaintenance = true
 		log.Debug("Starting in maintenance mode")
 	}
+
+	// Check if dry run
+	if os.Getenv("DRY_RUN") == "true" {
+		isDryRun = true
+	}
 }
 
 func main() {
+	// Settings that can come from the .env file, which init has loaded by now
+	setupAvatars()
+
+	// A dry run goes through one posting run and exits, without starting the website or the scheduler
+	if isDryRun {
+		if err := runDryRun(); err != nil {
+			log.Critical("Dry run failed: " + err.Error())
+			connection.Session.Close()
+			os.Exit(1)
+		}
+		connection.Session.Close()
+		return
+	}
+
 	/* Set up chi router */
 	// Initialize the router
 	r := chi.NewRouter()
@@ -176,6 +201,13 @@ func main() {
 	r.Post(relicHandle("/settings/enable", enableTweetPosting))
 	r.Post(relicHandle("/settings/disable", disableTweetPosting))
 	r.Post(relicHandle("/settings/update", updateSettings))
+	r.Post(relicHandle("/settings/post-now", postNow))
+	r.Get(relicHandle("/settings/preview.png", routePreview))
+	r.Post(relicHandle("/settings/notices/dismiss", dismissNoticeRoute))
+
+	r.Get(relicHandle("/admin/runs", routeAdminRuns))
+	r.Get(relicHandle("/admin/runs/{runID}", routeAdminRun))
+	r.Get(relicHandle("/admin/users", routeAdminUser))
 	//FileServer(r, "/assets", http.Dir("./static"))
 
 	r.Get("/favicon.ico", ServeFavicon)
@@ -195,6 +227,7 @@ func main() {
 	pr.HandleFunc("/debug/pprof/profile/", pprof.Profile)
 	pr.HandleFunc("/debug/pprof/symbol/", pprof.Symbol)
 	pr.HandleFunc("/debug/pprof/trace/", pprof.Trace)
+	pr.HandleFunc("/debug/posting", servePostingStats)
 
 	r.NotFound(notFound)
 
@@ -204,25 +237,50 @@ func main() {
 
 	bundle.MustLoadMessageFile("./translations/active.en.toml")
 
+	// Leader election, so only one replica posts tweets
+	// Whenever this replica becomes the leader, it catches up on any hours that were missed while nobody was posting
+	setupLeaderLeaseTTL()
+	leader = newLeaderLease(sessionStore.Pool, schedulerLeaseKey, schedulerLeaseTTL)
+	leader.OnAcquire = func() {
+		go runScheduledPosting()
+	}
+	leader.Start()
+
 	// Cron job
 	c := cron.New()
 	c.AddFunc("0 0 * * * *", func() {
+		if !leader.IsLeader() {
+			log.Info("Another replica holds the scheduler lease, not running posting function")
+			return
+		}
 		log.Info("Running posting function")
-		findAndGenerate()
+		runScheduledPosting()
 	})
 	c.Start()
 
+	// Posting queue worker
+	setupPostingQueue()
+
+	// Background stat collection, so every tracked player has regular checks
+	setupCollector()
+
 	/* Listen */
 	port := "5000"
 	if envPort := os.Getenv("PORT"); envPort != "" {
 		port = envPort
 	}
-	defer connection.Session.Close()
-	go (func() {
-		fmt.Println(http.ListenAndServe("localhost:5001", pr))
-	})()
+	debugServer := &http.Server{Addr: "localhost:5001", Handler: pr}
+	server := &http.Server{Addr: ":" + port, Handler: context.ClearHandler(r)}
+	for _, s := range []*http.Server{debugServer, server} {
+		go (func(s *http.Server) {
+			if err := s.ListenAndServe(); err != http.ErrServerClosed {
+				fmt.Println(err)
+			}
+		})(s)
+	}
 
-	fmt.Println(http.ListenAndServe(":"+port, context.ClearHandler(r)))
+	waitForShutdown()
+	shutdown([]*http.Server{server, debugServer}, c)
 }
 
 func getLoggedInValue(next http.Handler) http.Handler {
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+// runMigrations brings documents saved by older versions of Prosu up to date. Every migration must be safe to run more than once
+func runMigrations() {
+	// Users from before time zones were added picked their hour in UTC
+	info, err := connection.Collection("usermodels").Collection().UpdateAll(
+		bson.M{"$or": []bson.M{
+			{"osuSettings.timeZone": bson.M{"$exists": false}},
+			{"osuSettings.timeZone": ""},
+		}},
+		bson.M{"$set": bson.M{"osuSettings.timeZone": "UTC"}},
+	)
+	if err != nil {
+		panic(err)
+	}
+	if info.Updated > 0 {
+		log.Info("[MIGRATION] Set the time zone of " + strconv.Itoa(info.Updated) + " user(s) to UTC")
+	}
+
+	// Users from before NextPostAt was added need their next tweet scheduled. Weekly and monthly users keep posting on the day of their last tweet
+	now := time.Now()
+	resultSet := connection.Collection("usermodels").Find(bson.M{"nextPostAt": bson.M{"$exists": false}})
+	user := &User{}
+	scheduled := 0
+	for resultSet.Next(user) {
+		lastPost := lastPostTime(user)
+		loc, err := loadTimeZone(user.OsuSettings.TimeZone)
+		if err != nil {
+			loc = time.UTC
+		}
+		reference := now
+		if !lastPost.IsZero() {
+			reference = lastPost
+		}
+		user.OsuSettings.PostWeekday = int(reference.In(loc).Weekday())
+		user.OsuSettings.PostMonthDay = reference.In(loc).Day()
+
+		next, err := computeNextPostAt(user.OsuSettings, reference)
+		if err != nil || next.Before(now) {
+			next, err = computeNextPostAt(user.OsuSettings, now)
+		}
+		if err != nil {
+			log.Error("[MIGRATION] Failed to schedule the next tweet for user " + user.GetId().Hex())
+			continue
+		}
+		err = connection.Collection("usermodels").Collection().UpdateId(user.GetId(), bson.M{"$set": bson.M{
+			"nextPostAt":               next.Unix(),
+			"osuSettings.postWeekday":  user.OsuSettings.PostWeekday,
+			"osuSettings.postMonthDay": user.OsuSettings.PostMonthDay,
+		}})
+		if err != nil {
+			panic(err)
+		}
+		scheduled++
+	}
+	if resultSet.Error != nil {
+		panic(resultSet.Error)
+	}
+	if scheduled > 0 {
+		log.Info("[MIGRATION] Scheduled the next tweet for " + strconv.Itoa(scheduled) + " user(s)")
+	}
+
+	// Jobs from before milestone jobs were added are all scheduled tweets, and were only unique per user and period
+	info, err = connection.Collection("postjobmodels").Collection().UpdateAll(
+		bson.M{"kind": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"kind": jobKindScheduled}},
+	)
+	if err != nil {
+		panic(err)
+	}
+	if info.Updated > 0 {
+		log.Info("[MIGRATION] Marked " + strconv.Itoa(info.Updated) + " job(s) as scheduled")
+	}
+	// The index is gone after the first run, so there's nothing to do if it can't be dropped
+	connection.Collection("postjobmodels").Collection().DropIndex("user", "period")
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-bongo/bongo"
+	"github.com/nfnt/resize"
+	"gopkg.in/fogleman/gg.v1"
+)
+
+// The kinds of MilestoneRule
+const (
+	milestoneKindRank = "rank" // Global rank reaches Value or better
+	milestoneKindPP   = "pp"   // pp reaches Value
+	milestoneKindSS   = "ss"   // SS ranks, silver included, reach Value
+)
+
+// How many milestone rules a user can have
+const maxMilestoneRules = 10
+
+// The mode images in ./assets/modes, by mode number
+var modeImageNames = [4]string{"osu", "taiko", "ctb", "mania"}
+
+// Part of every milestone tweet's caption
+const milestoneCaptionMarker = "osu! milestone for player"
+
+func mLog(msg string) {
+	log.Info("[MILESTONES] " + msg)
+}
+
+func mError(msg string) {
+	log.Error("[MILESTONES] " + msg)
+}
+
+// setupMilestones creates the index that makes sure each milestone only fires once
+func setupMilestones() {
+	err := connection.Collection("milestonemodels").Collection().EnsureIndex(mgo.Index{
+		Key:    []string{"key"},
+		Unique: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// milestoneKey identifies a milestone for a user, player and mode
+func milestoneKey(userID bson.ObjectId, playerID bson.ObjectId, mode int, rule MilestoneRule) string {
+	return userID.Hex() + ":" + playerID.Hex() + ":" + strconv.Itoa(mode) + ":" + rule.Kind + ":" + strconv.Itoa(rule.Value)
+}
+
+// milestoneReached reports whether the data meets the rule
+func milestoneReached(rule MilestoneRule, data OsuRequestData) bool {
+	switch rule.Kind {
+	case milestoneKindRank:
+		// A rank of 0 means the player is inactive and doesn't have one
+		return data.PP.Rank > 0 && data.PP.Rank <= rule.Value
+	case milestoneKindPP:
+		return float64(data.PP.Raw) >= float64(rule.Value)
+	case milestoneKindSS:
+		return data.Counts.SS+data.Counts.SSH >= rule.Value
+	}
+	return false
+}
+
+// describeMilestone is how a milestone is written on the card and in the caption
+func describeMilestone(kind string, value int) string {
+	switch kind {
+	case milestoneKindRank:
+		return "Reached the top " + formatDecimal(float64(value))
+	case milestoneKindPP:
+		return "Reached " + formatDecimal(float64(value)) + "pp"
+	case milestoneKindSS:
+		return "Got " + formatDecimal(float64(value)) + " SS ranks"
+	}
+	return ""
+}
+
+// parseMilestoneRules turns the milestone fields on the settings page into rules. Each field is a comma separated list of values.
+// Repeated values are only kept once
+func parseMilestoneRules(rank string, pp string, ss string) ([]MilestoneRule, error) {
+	rules := []MilestoneRule{}
+	fields := []struct {
+		kind  string
+		value string
+	}{
+		{milestoneKindRank, rank},
+		{milestoneKindPP, pp},
+		{milestoneKindSS, ss},
+	}
+	seen := map[MilestoneRule]bool{}
+	for _, field := range fields {
+		for _, value := range strings.Split(field.value, ",") {
+			value = strings.TrimSpace(strings.Replace(value, "pp", "", 1))
+			if value == "" {
+				continue
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, errors.New("Milestones must be whole numbers above 0")
+			}
+			rule := MilestoneRule{Kind: field.kind, Value: n}
+			// A value typed twice is the same milestone, and can only fire once
+			if seen[rule] {
+				continue
+			}
+			seen[rule] = true
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) > maxMilestoneRules {
+		return nil, errors.New("You can have at most " + strconv.Itoa(maxMilestoneRules) + " milestones")
+	}
+	return rules, nil
+}
+
+// milestoneValues lists the values of the user's rules of one kind, the way they are typed on the settings page
+func milestoneValues(rules []MilestoneRule, kind string) string {
+	values := []string{}
+	for _, rule := range rules {
+		if rule.Kind == kind {
+			values = append(values, strconv.Itoa(rule.Value))
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+// saveOsuRequest saves a new check of the player in the mode. previous is the check before it, if there is one, and is used to
+// fire any milestones the new check crossed. Every new OsuRequest should be saved through here
+func saveOsuRequest(request *OsuRequest, mode int, previous *OsuRequest) error {
+	if err := connection.Collection("osurequestmodels").Save(request); err != nil {
+		return err
+	}
+	if previous != nil {
+		checkMilestones(request, mode, previous)
+	}
+	return nil
+}
+
+// checkMilestones fires the milestones the new check crossed for every user following the player in the mode, and queues a card
+// for each user whose milestones fired. A milestone is crossed when the previous check didn't meet it and the new one does
+func checkMilestones(request *OsuRequest, mode int, previous *OsuRequest) {
+	resultSet := connection.Collection("usermodels").Find(bson.M{
+		"osuSettings.enabled":      true,
+		"osuSettings.player":       request.OsuPlayer,
+		"osuSettings.mode":         mode,
+		"osuSettings.milestones.0": bson.M{"$exists": true},
+	})
+	user := &User{}
+	for resultSet.Next(user) {
+		fired := []string{}
+		for _, rule := range user.OsuSettings.Milestones {
+			if !milestoneReached(rule, request.Data) || milestoneReached(rule, previous.Data) {
+				continue
+			}
+			milestone := &Milestone{
+				Key:    milestoneKey(user.GetId(), request.OsuPlayer, mode, rule),
+				User:   user.GetId(),
+				Player: request.OsuPlayer,
+				Mode:   mode,
+				Kind:   rule.Kind,
+				Value:  rule.Value,
+				Check:  request.GetId(),
+			}
+			err := connection.Collection("milestonemodels").Save(milestone)
+			if mgo.IsDup(err) {
+				// The milestone already fired once, eg. the player dropped back below it and crossed it again
+				continue
+			}
+			if err != nil {
+				mError("Failed to save milestone " + milestone.Key)
+				captureError(err)
+				continue
+			}
+			mLog("@" + user.Twitter.Profile.Handle + "'s player " + request.Data.PlayerName + " crossed a milestone: " + describeMilestone(rule.Kind, rule.Value))
+			fired = append(fired, milestone.Key)
+		}
+		if len(fired) == 0 {
+			continue
+		}
+		if _, err := enqueueMilestoneJob(user.GetId(), request); err != nil {
+			mError("Failed to queue the milestone card for user " + user.GetId().Hex())
+			captureError(err)
+			// Without a card the milestones were never posted, so they mustn't count as fired the next time the player crosses them
+			if _, err := connection.Collection("milestonemodels").Collection().RemoveAll(bson.M{"key": bson.M{"$in": fired}}); err != nil {
+				mError("Failed to remove the milestones that couldn't be queued for user " + user.GetId().Hex())
+				captureError(err)
+			}
+		}
+	}
+	if resultSet.Error != nil {
+		mError("Failed to find the users following the player")
+		captureError(resultSet.Error)
+	}
+}
+
+// postMilestones posts the card for the milestones the job's check crossed. Returns an error if the attempt should be retried, or a
+// permanentError if it shouldn't
+func postMilestones(job *PostJob) error {
+	l := pLogger{
+		UserID: job.User.Hex(),
+	}
+	prosuUser := &User{}
+	err := connection.Collection("usermodels").FindById(job.User, prosuUser)
+	if err != nil {
+		if _, ok := err.(*bongo.DocumentNotFoundError); ok {
+			return permanentError{err}
+		}
+		captureError(err)
+		return err
+	}
+	if !prosuUser.OsuSettings.Enabled {
+		return permanentError{errors.New("the user turned off tweet posting")}
+	}
+
+	milestones := []Milestone{}
+	err = connection.Collection("milestonemodels").Collection().Find(bson.M{"user": job.User, "check": job.Check}).Sort("_created").All(&milestones)
+	if err != nil {
+		captureError(err)
+		return err
+	}
+	if len(milestones) == 0 {
+		return permanentError{errors.New("no milestones were fired by check " + job.Check.Hex())}
+	}
+
+	intent, intentCreated, err := beginPostIntent(milestoneIntentKey(job.User, job.Check), job.User, job.Period)
+	if err != nil {
+		l.Error("Failed to record the posting intent")
+		captureError(err)
+		return err
+	}
+	tweetID := intent.TweetID
+	if intent.State != intentStatePosted {
+		check := &OsuRequest{}
+		if err := connection.Collection("osurequestmodels").FindById(job.Check, check); err != nil {
+			captureError(err)
+			return err
+		}
+		player := &OsuPlayer{}
+		if err := connection.Collection("osuplayermodels").FindById(check.OsuPlayer, player); err != nil {
+			captureError(err)
+			return err
+		}
+		card, err := generateMilestoneCard(prosuUser, player, check, milestones, l)
+		if err != nil {
+			l.Error("Failed to generate the milestone card")
+			captureError(err)
+			return err
+		}
+		tweetID, err = postImageTweet(prosuUser, card, milestoneCaption(player, milestones), milestoneCaptionMarker, intent, intentCreated, l)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = connection.Collection("milestonemodels").Collection().UpdateAll(
+		bson.M{"user": job.User, "check": job.Check},
+		bson.M{"$set": bson.M{"tweetId": tweetID, "_modified": time.Now()}},
+	)
+	if err != nil {
+		l.Error("Failed to record the milestone tweet")
+		captureError(err)
+		return err
+	}
+	l.Log("Posted milestone card https://twitter.com/" + prosuUser.Twitter.Profile.Handle + "/status/" + tweetID)
+	return nil
+}
+
+// milestoneCaption is the text posted along with a milestone card. Only the first milestone is written out, so the caption stays well
+// under Twitter's limit however many were reached. The card lists more of them
+func milestoneCaption(player *OsuPlayer, milestones []Milestone) string {
+	reached := describeMilestone(milestones[0].Kind, milestones[0].Value)
+	if len(milestones) > 1 {
+		reached += " and " + strconv.Itoa(len(milestones)-1) + " more"
+	}
+	return milestoneCaptionMarker + " " + player.PlayerName + ": " + reached + "! Generated by https://prosu.xyz " + tweetHashtag
+}
+
+func generateMilestoneCard(user *User, player *OsuPlayer, check *OsuRequest, milestones []Milestone, l pLogger) (finalImage image.Image, funcErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Critical("Recovering from failed generateMilestoneCard for " + user.Twitter.Profile.Handle)
+			funcErr = errors.New("panic while drawing the milestone card")
+			finalImage = nil
+		}
+	}()
+	avatar, err := getAvatar(player.UserID)
+	if err != nil {
+		l.Error("Failed to grab avatar")
+		return nil, err
+	}
+	file, err := ioutil.ReadFile("./assets/modes/" + modeImageNames[user.OsuSettings.Mode] + ".png")
+	if err != nil {
+		l.Error("Failed to load mode image")
+		return nil, err
+	}
+	modeImage, _, err := image.Decode(bytes.NewReader(file))
+	if err != nil {
+		l.Error("Failed to decode mode image")
+		return nil, err
+	}
+
+	theme := themeByID(user.OsuSettings.Theme)
+	layout := theme.Layout
+	palette := theme.Palette
+	dc := gg.NewContext(theme.Width, theme.Height)
+	dc.SetColor(theme.Background)
+	dc.Clear()
+	dc.DrawImage(resize.Resize(uint(layout.AvatarSize), uint(layout.AvatarSize), avatar, resize.Lanczos3), int(layout.AvatarX), int(layout.AvatarY))
+	dc.DrawImage(resize.Resize(uint(layout.ModeSize), uint(layout.ModeSize), modeImage, resize.Lanczos3), int(layout.ModeX), int(layout.ModeY))
+
+	// Milestone!
+	dc.SetFontFace(regularFonts.Face(theme.Fonts.Header))
+	dc.SetColor(palette.Highlight)
+	dc.DrawString("Milestone! ", layout.TextX, layout.HeaderY)
+	milestoneStringSizeW, _ := dc.MeasureString("Milestone! ")
+
+	// Player Name
+	dc.SetFontFace(boldFonts.Face(theme.Fonts.Header))
+	dc.SetColor(palette.Name)
+	dc.DrawString(player.PlayerName, layout.TextX+milestoneStringSizeW, layout.HeaderY)
+
+	// Reached On:
+	dc.SetFontFace(regularFonts.Face(theme.Fonts.Updated))
+	dc.SetColor(palette.Text)
+	checkedTime := time.Unix(check.DateChecked, 0)
+	dc.DrawString("Reached On: "+checkedTime.Month().String()+" "+strconv.Itoa(checkedTime.Day())+", "+strconv.Itoa(checkedTime.Year()), layout.TextX, layout.UpdatedY)
+
+	dc.SetColor(palette.Muted)
+	dc.MoveTo(layout.LineX, layout.LineY)
+	dc.LineTo(float64(theme.Width), layout.LineY)
+	dc.Stroke()
+
+	// One line per milestone that fits above the player's current stats, with the last line counting the rest
+	footerY := float64(theme.Height) - 15
+	lineHeight := layout.RowHeight + 4
+	fits := int((footerY-theme.Fonts.Row-layout.RowsY)/lineHeight) + 1
+	if fits < 1 {
+		fits = 1
+	}
+	vert := layout.RowsY + 5
+	dc.SetFontFace(regularFonts.Face(theme.Fonts.Row))
+	dc.SetColor(palette.Highlight)
+	for i, milestone := range milestones {
+		if i == fits-1 && len(milestones) > fits {
+			dc.DrawString("and "+strconv.Itoa(len(milestones)-i)+" more", layout.TextX, vert)
+			break
+		}
+		dc.DrawString(describeMilestone(milestone.Kind, milestone.Value), layout.TextX, vert)
+		vert += lineHeight
+	}
+	dc.SetFontFace(regularFonts.Face(theme.Fonts.Updated))
+	dc.SetColor(palette.Text)
+	dc.DrawString("Rank: #"+formatDecimal(float64(check.Data.PP.Rank))+"   PP: "+formatDecimal(float64(check.Data.PP.Raw))+"   SS: "+formatDecimal(float64(check.Data.Counts.SS+check.Data.Counts.SSH)), layout.TextX, footerY)
+
+	return dc.Image(), nil
+}
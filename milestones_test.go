@@ -0,0 +1,114 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMilestoneRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rank    string
+		pp      string
+		ss      string
+		want    []MilestoneRule
+		wantErr bool
+	}{
+		{name: "nothing typed", want: []MilestoneRule{}},
+		{name: "only commas and spaces", rank: " , ,", want: []MilestoneRule{}},
+		{
+			name: "one of each kind",
+			rank: "1000", pp: "5000", ss: "100",
+			want: []MilestoneRule{{milestoneKindRank, 1000}, {milestoneKindPP, 5000}, {milestoneKindSS, 100}},
+		},
+		{
+			name: "lists keep their order",
+			rank: "10000, 1000,100",
+			want: []MilestoneRule{{milestoneKindRank, 10000}, {milestoneKindRank, 1000}, {milestoneKindRank, 100}},
+		},
+		{name: "pp suffix", pp: "4000pp, 5000 pp", want: []MilestoneRule{{milestoneKindPP, 4000}, {milestoneKindPP, 5000}}},
+		{name: "smallest value", ss: "1", want: []MilestoneRule{{milestoneKindSS, 1}}},
+		{name: "repeated value", rank: "1000, 1000", want: []MilestoneRule{{milestoneKindRank, 1000}}},
+		{
+			name: "same value for different kinds",
+			rank: "1000", pp: "1000",
+			want: []MilestoneRule{{milestoneKindRank, 1000}, {milestoneKindPP, 1000}},
+		},
+		{name: "zero", rank: "0", wantErr: true},
+		{name: "negative", pp: "-100", wantErr: true},
+		{name: "decimal", pp: "4000.5", wantErr: true},
+		{name: "not a number", ss: "lots", wantErr: true},
+		{name: "thousands separator", rank: "1,000", wantErr: true},
+		{name: "most rules allowed", rank: "1,2,3,4,5", pp: "1,2,3,4,5", want: []MilestoneRule{
+			{milestoneKindRank, 1}, {milestoneKindRank, 2}, {milestoneKindRank, 3}, {milestoneKindRank, 4}, {milestoneKindRank, 5},
+			{milestoneKindPP, 1}, {milestoneKindPP, 2}, {milestoneKindPP, 3}, {milestoneKindPP, 4}, {milestoneKindPP, 5},
+		}},
+		{name: "too many rules", rank: "1,2,3,4,5", pp: "1,2,3,4,5", ss: "1", wantErr: true},
+		{name: "repeats don't count towards the limit", rank: "1,2,3,4,5,5,5", pp: "1,2,3,4,5", want: []MilestoneRule{
+			{milestoneKindRank, 1}, {milestoneKindRank, 2}, {milestoneKindRank, 3}, {milestoneKindRank, 4}, {milestoneKindRank, 5},
+			{milestoneKindPP, 1}, {milestoneKindPP, 2}, {milestoneKindPP, 3}, {milestoneKindPP, 4}, {milestoneKindPP, 5},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseMilestoneRules(test.rank, test.pp, test.ss)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMilestoneReached(t *testing.T) {
+	withRank := func(rank int) OsuRequestData {
+		data := OsuRequestData{}
+		data.PP.Rank = rank
+		return data
+	}
+	withPP := func(pp float32) OsuRequestData {
+		data := OsuRequestData{}
+		data.PP.Raw = pp
+		return data
+	}
+	withSS := func(ss int, ssh int) OsuRequestData {
+		data := OsuRequestData{}
+		data.Counts.SS = ss
+		data.Counts.SSH = ssh
+		return data
+	}
+	tests := []struct {
+		name string
+		rule MilestoneRule
+		data OsuRequestData
+		want bool
+	}{
+		{"rank better than the threshold", MilestoneRule{milestoneKindRank, 1000}, withRank(999), true},
+		{"rank at the threshold", MilestoneRule{milestoneKindRank, 1000}, withRank(1000), true},
+		{"rank just outside the threshold", MilestoneRule{milestoneKindRank, 1000}, withRank(1001), false},
+		{"rank 1", MilestoneRule{milestoneKindRank, 1}, withRank(1), true},
+		{"inactive player has no rank", MilestoneRule{milestoneKindRank, 1000}, withRank(0), false},
+		{"pp above the threshold", MilestoneRule{milestoneKindPP, 5000}, withPP(5000.5), true},
+		{"pp at the threshold", MilestoneRule{milestoneKindPP, 5000}, withPP(5000), true},
+		{"pp just under the threshold", MilestoneRule{milestoneKindPP, 5000}, withPP(4999.99), false},
+		{"SS ranks at the threshold", MilestoneRule{milestoneKindSS, 100}, withSS(100, 0), true},
+		{"silver SS ranks count", MilestoneRule{milestoneKindSS, 100}, withSS(60, 40), true},
+		{"SS ranks one short", MilestoneRule{milestoneKindSS, 100}, withSS(60, 39), false},
+		{"unknown kind", MilestoneRule{"accuracy", 1}, withPP(10000), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := milestoneReached(test.rule, test.data); got != test.want {
+				t.Errorf("got %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/mrjones/oauth"
+)
+
+// The app account's tokens, used to send direct messages. Leaving them unset turns direct messages off
+var appTwitterToken string
+var appTwitterTokenSecret string
+
+// The most notices kept on a user. The oldest are dropped first
+const maxUserNotices = 10
+
+// The direct message sent for each kind of notice
+var noticeMessages = map[string]string{
+	twitterErrorLocked:               "Your Twitter account has been locked for a while, so we've turned off your Prosu tweets. Once it's unlocked you can turn them back on at https://prosu.xyz/settings",
+	twitterErrorExpiredToken:         "Twitter says your sign in to Prosu has expired, so we've turned off your tweets. Sign in again at https://prosu.xyz/settings to turn them back on",
+	twitterErrorSuspended:            "Your Twitter account is suspended, so we've turned off your Prosu tweets.",
+	disabledReasonInvalidCredentials: "Twitter says your Prosu credentials aren't valid, so we've turned off your tweets. Sign in again at https://prosu.xyz/settings to turn them back on",
+}
+
+func nLog(msg string) {
+	log.Info("[NOTIFICATIONS] " + msg)
+}
+
+func nError(msg string) {
+	log.Error("[NOTIFICATIONS] " + msg)
+}
+
+func init() {
+	appTwitterToken = os.Getenv("APP_TWITTER_TOKEN")
+	appTwitterTokenSecret = os.Getenv("APP_TWITTER_TOKEN_SECRET")
+}
+
+// pushNotice adds a notice of the kind to the user, to be shown on the settings page until they dismiss it. It returns the update
+// that saves the notice, dropping the oldest past maxUserNotices
+func pushNotice(user *User, kind string) bson.M {
+	notice := Notice{
+		ID:        bson.NewObjectId(),
+		Kind:      kind,
+		CreatedAt: time.Now().Unix(),
+	}
+	user.Notices = append(user.Notices, notice)
+	if len(user.Notices) > maxUserNotices {
+		user.Notices = user.Notices[len(user.Notices)-maxUserNotices:]
+	}
+	return bson.M{"notices": bson.M{"$each": []Notice{notice}, "$slice": -maxUserNotices}}
+}
+
+// dismissNotice removes the notice from the user
+func dismissNotice(userID bson.ObjectId, noticeID bson.ObjectId) error {
+	return connection.Collection("usermodels").Collection().UpdateId(userID, bson.M{
+		"$pull": bson.M{"notices": bson.M{"id": noticeID}},
+		"$set":  bson.M{"_modified": time.Now()},
+	})
+}
+
+// sendNoticeMessage tells the user about the notice with a direct message from the app account. Twitter only delivers it if the user
+// accepts messages from the app account, so failing to send it is logged and otherwise ignored
+func sendNoticeMessage(user *User, kind string) {
+	message, ok := noticeMessages[kind]
+	if !ok || appTwitterToken == "" || appTwitterTokenSecret == "" {
+		return
+	}
+	if _, err := strconv.ParseInt(user.Twitter.Profile.TwitterID, 10, 64); err != nil {
+		nError("@" + user.Twitter.Profile.Handle + " has an invalid Twitter ID: " + user.Twitter.Profile.TwitterID)
+		return
+	}
+	if err := postDirectMessage(user.Twitter.Profile.TwitterID, message); err != nil {
+		nError("Failed to send a direct message to @" + user.Twitter.Profile.Handle + ": " + err.Error())
+		return
+	}
+	nLog("Sent a " + kind + " direct message to @" + user.Twitter.Profile.Handle)
+}
+
+// Where direct messages are sent. anaconda only knows direct_messages/new.json, which Twitter has retired
+var directMessageURL = "https://api.twitter.com/1.1/direct_messages/events/new.json"
+
+// directMessageEvent - The body of a request to send a direct message
+type directMessageEvent struct {
+	Event struct {
+		Type          string `json:"type"`
+		MessageCreate struct {
+			Target struct {
+				RecipientID string `json:"recipient_id"`
+			} `json:"target"`
+			MessageData struct {
+				Text string `json:"text"`
+			} `json:"message_data"`
+		} `json:"message_create"`
+	} `json:"event"`
+}
+
+// postDirectMessage sends the text to the Twitter user from the app account
+func postDirectMessage(recipientID string, text string) error {
+	event := directMessageEvent{}
+	event.Event.Type = "message_create"
+	event.Event.MessageCreate.Target.RecipientID = recipientID
+	event.Event.MessageCreate.MessageData.Text = text
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	client, err := twitterConsumer.MakeHttpClient(&oauth.AccessToken{Token: appTwitterToken, Secret: appTwitterTokenSecret})
+	if err != nil {
+		return err
+	}
+	res, err := client.Post(directMessageURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		response, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+		return errors.New("Twitter responded with status " + strconv.Itoa(res.StatusCode) + ": " + string(response))
+	}
+	return nil
+}
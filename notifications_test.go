@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mrjones/oauth"
+)
+
+func TestPostDirectMessage(t *testing.T) {
+	var got directMessageEvent
+	var authorization string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		if r.Method != "POST" || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("got a %s with content type %q, want a JSON POST", r.Method, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(`{"errors":[{"code":349,"message":"You cannot send messages to this user."}]}`))
+	}))
+	defer server.Close()
+
+	defer func(url string, consumer *oauth.Consumer, token string, secret string) {
+		directMessageURL, twitterConsumer, appTwitterToken, appTwitterTokenSecret = url, consumer, token, secret
+	}(directMessageURL, twitterConsumer, appTwitterToken, appTwitterTokenSecret)
+	directMessageURL = server.URL
+	twitterConsumer = oauth.NewConsumer("consumer key", "consumer secret", oauth.ServiceProvider{})
+	appTwitterToken, appTwitterTokenSecret = "app token", "app secret"
+
+	if err := postDirectMessage("12345", "Your tweets are off"); err != nil {
+		t.Fatal(err)
+	}
+	if got.Event.Type != "message_create" || got.Event.MessageCreate.Target.RecipientID != "12345" || got.Event.MessageCreate.MessageData.Text != "Your tweets are off" {
+		t.Errorf("got %+v, want a message_create event for the user", got)
+	}
+	if !strings.HasPrefix(authorization, "OAuth ") || !strings.Contains(authorization, `oauth_token="app%20token"`) {
+		t.Errorf("got Authorization %q, want it signed with the app account's token", authorization)
+	}
+
+	status = http.StatusForbidden
+	if err := postDirectMessage("12345", "Your tweets are off"); err == nil || !strings.Contains(err.Error(), "349") {
+		t.Errorf("got error %v, want Twitter's response", err)
+	}
+}
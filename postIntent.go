@@ -0,0 +1,160 @@
+package main
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ChimeraCoder/anaconda"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// Every tweet we post contains this, so we can recognise our own tweets on a user's timeline
+const tweetHashtag = "#ProsuTweetPoster"
+
+// How many of the user's latest tweets are searched for one we may already have posted
+const postIntentTimelineCount = 20
+
+// setupPostIntents creates the index that makes sure there is only one intent per user and slot
+func setupPostIntents() {
+	err := connection.Collection("postintentmodels").Collection().EnsureIndex(mgo.Index{
+		Key:    []string{"key"},
+		Unique: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// postIntentKey is the idempotency key for a user's tweet in a slot
+func postIntentKey(userID bson.ObjectId, slot int64) string {
+	return userID.Hex() + ":" + strconv.FormatInt(slot, 10)
+}
+
+// manualIntentKey is the idempotency key for a tweet the user asked for at the given time
+func manualIntentKey(userID bson.ObjectId, requestedAt int64) string {
+	return "manual:" + postIntentKey(userID, requestedAt)
+}
+
+// milestoneIntentKey is the idempotency key for the card posted for the milestones a check crossed
+func milestoneIntentKey(userID bson.ObjectId, check bson.ObjectId) string {
+	return "milestone:" + userID.Hex() + ":" + check.Hex()
+}
+
+// beginPostIntent records that we are about to post the tweet with the key and returns the intent. created is false if an earlier
+// attempt already recorded one, in which case the tweet may already be up
+func beginPostIntent(key string, userID bson.ObjectId, slot int64) (intent *PostIntent, created bool, err error) {
+	now := time.Now()
+	intents := connection.Collection("postintentmodels").Collection()
+	info, err := intents.Upsert(
+		bson.M{"key": key},
+		bson.M{"$setOnInsert": bson.M{
+			"_created":  now,
+			"_modified": now,
+			"user":      userID,
+			"slot":      slot,
+			"state":     intentStatePending,
+			"startedAt": now.Unix(),
+			"tweetId":   "",
+		}},
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	intent = &PostIntent{}
+	if err := intents.Find(bson.M{"key": key}).One(intent); err != nil {
+		return nil, false, err
+	}
+	return intent, info.UpsertedId != nil, nil
+}
+
+// markPostIntentPosted records which tweet was posted for the intent
+func markPostIntentPosted(intent *PostIntent, tweetID string) error {
+	intent.State = intentStatePosted
+	intent.TweetID = tweetID
+	return connection.Collection("postintentmodels").Collection().Update(
+		bson.M{"key": intent.Key},
+		bson.M{"$set": bson.M{"state": intentStatePosted, "tweetId": tweetID, "_modified": time.Now()}},
+	)
+}
+
+// findIntentTweet looks through the user's latest tweets for one containing captionMarker that we posted after the intent was started
+func findIntentTweet(twitterAPI *anaconda.TwitterApi, user *User, intent *PostIntent, captionMarker string) (string, bool, error) {
+	urlVals := url.Values{}
+	urlVals.Add("user_id", user.Twitter.Profile.TwitterID)
+	urlVals.Add("count", strconv.Itoa(postIntentTimelineCount))
+	urlVals.Add("exclude_replies", "true")
+	urlVals.Add("include_rts", "false")
+	urlVals.Add("trim_user", "true")
+	// Without this, the text of tweets over 140 characters is cut short, which can cut off the hashtag
+	urlVals.Add("tweet_mode", "extended")
+	tweets, err := twitterAPI.GetUserTimeline(urlVals)
+	if err != nil {
+		return "", false, err
+	}
+	tweetID, found := matchIntentTweet(tweets, intent, captionMarker)
+	return tweetID, found, nil
+}
+
+// matchIntentTweet returns the ID of the first tweet containing our hashtag and captionMarker that was posted after the intent started
+func matchIntentTweet(tweets []anaconda.Tweet, intent *PostIntent, captionMarker string) (string, bool) {
+	// Allow for our clock and Twitter's disagreeing a little
+	startedAt := time.Unix(intent.StartedAt, 0).Add(-time.Minute)
+	for _, tweet := range tweets {
+		text := tweet.FullText
+		if text == "" {
+			text = tweet.Text
+		}
+		if !strings.Contains(text, tweetHashtag) || !strings.Contains(text, captionMarker) {
+			continue
+		}
+		createdAt, err := tweet.CreatedAtTime()
+		if err != nil || createdAt.Before(startedAt) {
+			continue
+		}
+		return tweet.IdStr, true
+	}
+	return "", false
+}
+
+// recordTweet adds the tweet to the user's history, unless it's already there, and schedules their next tweet. check is the OsuRequest
+// the tweet showed, if we know it. Only those two fields are written, so settings the user changed while we were posting are kept
+func recordTweet(user *User, tweetID string, check bson.ObjectId, l pLogger) error {
+	users := connection.Collection("usermodels").Collection()
+	tweet := UserTweet{
+		DatePosted: time.Now().Unix(),
+		TweetObject: TweetObject{
+			ID: tweetID,
+		},
+		Check:  check,
+		Player: user.OsuSettings.Player,
+		Mode:   user.OsuSettings.Mode,
+	}
+	// Doesn't match a user who already has the tweet, so recording it twice only adds it once
+	err := users.Update(
+		bson.M{"_id": user.GetId(), "tweetHistory.tweetObject.id": bson.M{"$ne": tweetID}},
+		bson.M{"$push": bson.M{"tweetHistory": tweet}},
+	)
+	if err == mgo.ErrNotFound {
+		l.Log("Tweet " + tweetID + " is already in the user's history")
+	} else if err != nil {
+		return err
+	} else {
+		user.TweetHistory = append(user.TweetHistory, tweet)
+	}
+
+	// The user may have changed their schedule while we were posting, so the next tweet follows their latest settings
+	latest := &User{}
+	if err := connection.Collection("usermodels").FindById(user.GetId(), latest); err != nil {
+		return err
+	}
+	if err := scheduleNextPost(latest, time.Now()); err != nil {
+		l.Error("Failed to work out when the user's next tweet is due")
+		captureError(err)
+		return nil
+	}
+	user.NextPostAt = latest.NextPostAt
+	return users.UpdateId(user.GetId(), bson.M{"$set": bson.M{"nextPostAt": latest.NextPostAt}})
+}
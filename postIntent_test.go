@@ -0,0 +1,108 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ChimeraCoder/anaconda"
+)
+
+func TestMatchIntentTweet(t *testing.T) {
+	startedAt := mustParseTime(t, "2021-06-10T12:00:00Z")
+	intent := &PostIntent{StartedAt: startedAt.Unix()}
+	player := &OsuPlayer{PlayerName: "cookiezi"}
+	// timelineTweet builds a tweet the way the timeline returns it, created the given time after the intent started
+	timelineTweet := func(id string, text string, after time.Duration) anaconda.Tweet {
+		return anaconda.Tweet{IdStr: id, FullText: text, CreatedAt: startedAt.Add(after).Format(time.RubyDate)}
+	}
+	tests := []struct {
+		name   string
+		tweets []anaconda.Tweet
+		marker string
+		want   string
+	}{
+		{name: "empty timeline", marker: tweetCaptionMarker},
+		{
+			name:   "our scheduled tweet",
+			tweets: []anaconda.Tweet{timelineTweet("1", tweetCaption(player), 10*time.Second)},
+			marker: tweetCaptionMarker,
+			want:   "1",
+		},
+		{
+			name:   "our post now tweet",
+			tweets: []anaconda.Tweet{timelineTweet("1", postNowCaption(player), 10*time.Second)},
+			marker: postNowCaptionMarker,
+			want:   "1",
+		},
+		{
+			name:   "a post now tweet isn't taken for a scheduled one",
+			tweets: []anaconda.Tweet{timelineTweet("1", postNowCaption(player), 10*time.Second)},
+			marker: tweetCaptionMarker,
+		},
+		{
+			name:   "a scheduled tweet isn't taken for a post now one",
+			tweets: []anaconda.Tweet{timelineTweet("1", tweetCaption(player), 10*time.Second)},
+			marker: postNowCaptionMarker,
+		},
+		{
+			name:   "the user's own tweet with the same words but no hashtag",
+			tweets: []anaconda.Tweet{timelineTweet("1", tweetCaptionMarker+" cookiezi, look at this", 10*time.Second)},
+			marker: tweetCaptionMarker,
+		},
+		{
+			name:   "posted before the intent started",
+			tweets: []anaconda.Tweet{timelineTweet("1", tweetCaption(player), -time.Hour)},
+			marker: tweetCaptionMarker,
+		},
+		{
+			name:   "clocks a little apart",
+			tweets: []anaconda.Tweet{timelineTweet("1", tweetCaption(player), -30*time.Second)},
+			marker: tweetCaptionMarker,
+			want:   "1",
+		},
+		{
+			name: "newest match wins",
+			tweets: []anaconda.Tweet{
+				timelineTweet("3", "just a normal tweet", 2*time.Minute),
+				timelineTweet("2", tweetCaption(player), time.Minute),
+				timelineTweet("1", tweetCaption(player), -time.Hour),
+			},
+			marker: tweetCaptionMarker,
+			want:   "2",
+		},
+		{
+			name: "text without tweet_mode extended",
+			tweets: []anaconda.Tweet{{
+				IdStr:     "1",
+				Text:      tweetCaption(player),
+				CreatedAt: startedAt.Add(10 * time.Second).Format(time.RubyDate),
+			}},
+			marker: tweetCaptionMarker,
+			want:   "1",
+		},
+		{
+			name: "the full text is searched when the short text is cut off",
+			tweets: []anaconda.Tweet{{
+				IdStr:     "1",
+				Text:      tweetCaptionMarker + " cookiezi…",
+				FullText:  tweetCaption(player),
+				CreatedAt: startedAt.Add(10 * time.Second).Format(time.RubyDate),
+			}},
+			marker: tweetCaptionMarker,
+			want:   "1",
+		},
+		{
+			name:   "unreadable date",
+			tweets: []anaconda.Tweet{{IdStr: "1", FullText: tweetCaption(player), CreatedAt: "yesterday"}},
+			marker: tweetCaptionMarker,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, found := matchIntentTweet(test.tweets, intent, test.marker)
+			if found != (test.want != "") || got != test.want {
+				t.Errorf("got %q, %t, want %q", got, found, test.want)
+			}
+		})
+	}
+}
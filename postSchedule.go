@@ -0,0 +1,110 @@
+package main
+
+import (
+	"time"
+)
+
+// The values OsuSettings.PostFrequency can take
+const (
+	postFrequencyDaily   = 0
+	postFrequencyWeekly  = 1
+	postFrequencyMonthly = 2
+	// Posts on every day in PostSchedule.Weekdays
+	postFrequencyWeekdays = 3
+	// Posts once every PostSchedule.EveryDays days, counting from PostSchedule.StartDate
+	postFrequencyInterval = 4
+)
+
+// The longest gap allowed between tweets when posting every few days. computeNextPostAt only looks this far ahead
+const maxPostEveryDays = 30
+
+// computeNextPostAt returns the first posting slot strictly after the given time, following the user's hour, time zone and frequency
+func computeNextPostAt(settings OsuSettings, after time.Time) (time.Time, error) {
+	loc, err := loadTimeZone(settings.TimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	local := after.In(loc)
+	// A slot is always found within two months, even for monthly posts on the 31st
+	for i := 0; i < 62; i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+i, 0, 0, 0, 0, loc)
+		if !postsOnDay(settings, day) {
+			continue
+		}
+		slot := time.Date(day.Year(), day.Month(), day.Day(), settings.HourToPost, 0, 0, 0, loc)
+		// An hour skipped by DST can come back from time.Date on the clock before the gap. Move it to the end of the gap, so the
+		// tweet is never posted before the user's hour
+		want := time.Date(day.Year(), day.Month(), day.Day(), settings.HourToPost, 0, 0, 0, time.UTC)
+		got := time.Date(slot.Year(), slot.Month(), slot.Day(), slot.Hour(), slot.Minute(), 0, 0, time.UTC)
+		if got.Before(want) {
+			slot = slot.Add(want.Sub(got))
+		}
+		if slot.After(after) {
+			return slot.UTC(), nil
+		}
+	}
+	// Unreachable unless the settings are invalid
+	return after.Add(24 * time.Hour).UTC(), nil
+}
+
+// postsOnDay reports whether the user's frequency has them posting on the given local day
+func postsOnDay(settings OsuSettings, day time.Time) bool {
+	switch settings.PostFrequency {
+	case postFrequencyWeekly:
+		return int(day.Weekday()) == settings.PostWeekday
+	case postFrequencyMonthly:
+		// Months without the chosen day post on their last day instead
+		lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+		postDay := settings.PostMonthDay
+		if postDay > lastDay {
+			postDay = lastDay
+		}
+		return day.Day() == postDay
+	case postFrequencyWeekdays:
+		for _, weekday := range settings.PostSchedule.Weekdays {
+			if int(day.Weekday()) == weekday {
+				return true
+			}
+		}
+		return false
+	case postFrequencyInterval:
+		if settings.PostSchedule.EveryDays < 2 {
+			return true
+		}
+		start := time.Unix(settings.PostSchedule.StartDate, 0).In(day.Location())
+		days := daysBetween(start, day)
+		return days >= 0 && days%settings.PostSchedule.EveryDays == 0
+	default:
+		return true
+	}
+}
+
+// daysBetween returns the number of calendar days from one local date to another, ignoring the time of day and DST changes
+func daysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// scheduleNextPost sets the user's NextPostAt to the first slot after the given time. It doesn't save the user
+func scheduleNextPost(user *User, after time.Time) error {
+	next, err := computeNextPostAt(user.OsuSettings, after)
+	if err != nil {
+		return err
+	}
+	user.NextPostAt = next.Unix()
+	return nil
+}
+
+// lastPostTime returns when the user's last tweet was posted, or the zero time if they have never had one
+func lastPostTime(user *User) time.Time {
+	if len(user.TweetHistory) == 0 {
+		return time.Time{}
+	}
+	datePosted := user.TweetHistory[len(user.TweetHistory)-1].DatePosted
+	// The old site saved timestamps in milliseconds
+	if datePosted > 1500000000000 {
+		datePosted /= 1000
+	}
+	return time.Unix(datePosted, 0)
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestComputeNextPostAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings OsuSettings
+		after    string
+		want     string
+	}{
+		{
+			name:     "daily later the same day",
+			settings: OsuSettings{HourToPost: 18},
+			after:    "2021-06-10T12:00:00Z",
+			want:     "2021-06-10T18:00:00Z",
+		},
+		{
+			name:     "daily slot is strictly after",
+			settings: OsuSettings{HourToPost: 18},
+			after:    "2021-06-10T18:00:00Z",
+			want:     "2021-06-11T18:00:00Z",
+		},
+		{
+			name:     "daily in a time zone ahead of UTC",
+			settings: OsuSettings{HourToPost: 9, TimeZone: "Asia/Tokyo"},
+			after:    "2021-06-10T12:00:00Z",
+			want:     "2021-06-11T00:00:00Z",
+		},
+		{
+			name:     "weekly on the chosen weekday",
+			settings: OsuSettings{HourToPost: 12, PostFrequency: postFrequencyWeekly, PostWeekday: int(time.Monday)},
+			after:    "2021-06-10T12:00:00Z", // A Thursday
+			want:     "2021-06-14T12:00:00Z",
+		},
+		{
+			name:     "DST gap moves the missing hour forward",
+			settings: OsuSettings{HourToPost: 2, TimeZone: "America/New_York"},
+			after:    "2021-03-14T05:00:00Z", // Midnight, the night clocks skip from 2:00 to 3:00
+			want:     "2021-03-14T07:00:00Z", // 3:00 EDT
+		},
+		{
+			name:     "DST gap of half an hour",
+			settings: OsuSettings{HourToPost: 2, TimeZone: "Australia/Lord_Howe"},
+			after:    "2021-10-02T13:30:00Z", // Midnight, the night clocks skip from 2:00 to 2:30
+			want:     "2021-10-02T15:30:00Z", // 2:30 +11
+		},
+		{
+			name:     "DST gap only shifts the day it happens",
+			settings: OsuSettings{HourToPost: 2, TimeZone: "America/New_York"},
+			after:    "2021-03-14T07:00:00Z",
+			want:     "2021-03-15T06:00:00Z", // 2:00 EDT
+		},
+		{
+			name:     "DST overlap posts at the first of the repeated hours",
+			settings: OsuSettings{HourToPost: 1, TimeZone: "America/New_York"},
+			after:    "2021-11-07T04:00:00Z", // Midnight EDT, the night clocks go from 2:00 back to 1:00
+			want:     "2021-11-07T05:00:00Z", // 1:00 EDT
+		},
+		{
+			name:     "DST overlap doesn't post again in the repeated hour",
+			settings: OsuSettings{HourToPost: 1, TimeZone: "America/New_York"},
+			after:    "2021-11-07T05:30:00Z", // 1:30 EDT, before the clocks go back
+			want:     "2021-11-08T06:00:00Z", // 1:00 EST the next day
+		},
+		{
+			name:     "monthly on a day every month has",
+			settings: OsuSettings{HourToPost: 12, PostFrequency: postFrequencyMonthly, PostMonthDay: 15},
+			after:    "2021-06-15T12:00:00Z",
+			want:     "2021-07-15T12:00:00Z",
+		},
+		{
+			name:     "monthly on the 31st falls back to the end of February",
+			settings: OsuSettings{HourToPost: 12, PostFrequency: postFrequencyMonthly, PostMonthDay: 31},
+			after:    "2021-01-31T12:00:00Z",
+			want:     "2021-02-28T12:00:00Z",
+		},
+		{
+			name:     "monthly on the 30th falls back to the 29th in a leap year",
+			settings: OsuSettings{HourToPost: 12, PostFrequency: postFrequencyMonthly, PostMonthDay: 30},
+			after:    "2024-02-01T00:00:00Z",
+			want:     "2024-02-29T12:00:00Z",
+		},
+		{
+			name:     "monthly on the 31st falls back to the end of a 30 day month",
+			settings: OsuSettings{HourToPost: 12, PostFrequency: postFrequencyMonthly, PostMonthDay: 31},
+			after:    "2021-03-31T12:00:00Z",
+			want:     "2021-04-30T12:00:00Z",
+		},
+		{
+			name:     "monthly falls back by the user's local month",
+			settings: OsuSettings{HourToPost: 8, TimeZone: "Pacific/Auckland", PostFrequency: postFrequencyMonthly, PostMonthDay: 31},
+			after:    "2021-04-01T00:00:00Z", // Already April 1st in Auckland
+			want:     "2021-04-29T20:00:00Z", // April 30th 8:00 NZST
+		},
+		{
+			name: "chosen weekdays",
+			settings: OsuSettings{
+				HourToPost:    12,
+				PostFrequency: postFrequencyWeekdays,
+				PostSchedule:  PostSchedule{Weekdays: []int{int(time.Tuesday), int(time.Saturday)}},
+			},
+			after: "2021-06-10T12:00:00Z", // A Thursday
+			want:  "2021-06-12T12:00:00Z",
+		},
+		{
+			name: "every few days counts from the start date in a time zone ahead of UTC",
+			settings: OsuSettings{
+				HourToPost:    9,
+				TimeZone:      "Asia/Tokyo",
+				PostFrequency: postFrequencyInterval,
+				PostSchedule:  PostSchedule{EveryDays: 3, StartDate: mustParseTime(t, "2021-03-01T20:00:00Z").Unix()}, // March 2nd in Tokyo
+			},
+			after: "2021-03-01T20:00:00Z",
+			want:  "2021-03-02T00:00:00Z", // March 2nd 9:00 JST
+		},
+		{
+			name: "every few days counts from the start date in a time zone behind UTC",
+			settings: OsuSettings{
+				HourToPost:    9,
+				TimeZone:      "America/Los_Angeles",
+				PostFrequency: postFrequencyInterval,
+				PostSchedule:  PostSchedule{EveryDays: 3, StartDate: mustParseTime(t, "2021-03-01T20:00:00Z").Unix()}, // March 1st in Los Angeles
+			},
+			after: "2021-03-01T20:00:00Z",
+			want:  "2021-03-04T17:00:00Z", // March 4th 9:00 PST
+		},
+		{
+			name: "every few days keeps the local hour across a DST change",
+			settings: OsuSettings{
+				HourToPost:    9,
+				TimeZone:      "America/Los_Angeles",
+				PostFrequency: postFrequencyInterval,
+				PostSchedule:  PostSchedule{EveryDays: 7, StartDate: mustParseTime(t, "2021-03-08T17:00:00Z").Unix()},
+			},
+			after: "2021-03-08T17:00:00Z",
+			want:  "2021-03-15T16:00:00Z", // March 15th 9:00 PDT
+		},
+		{
+			name: "every few days before the start date waits for it",
+			settings: OsuSettings{
+				HourToPost:    12,
+				PostFrequency: postFrequencyInterval,
+				PostSchedule:  PostSchedule{EveryDays: 5, StartDate: mustParseTime(t, "2021-06-20T00:00:00Z").Unix()},
+			},
+			after: "2021-06-10T12:00:00Z",
+			want:  "2021-06-20T12:00:00Z",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := computeNextPostAt(test.settings, mustParseTime(t, test.after))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := mustParseTime(t, test.want); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got.Format(time.RFC3339), want.Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestComputeNextPostAtUnknownTimeZone(t *testing.T) {
+	for _, zone := range []string{"Not/AZone", "Local"} {
+		if _, err := computeNextPostAt(OsuSettings{TimeZone: zone}, time.Now()); err == nil {
+			t.Errorf("expected an error for time zone %q", zone)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// How many tweets are generated and posted at the same time
+var postingWorkers = 4
+
+// Stop two workers from posting for the same user, or from appending checks to the same osu! player, at once
+var userLocks = newKeyedMutex()
+var playerLocks = newKeyedMutex()
+
+var pool *postingPool
+
+// postingPool - A fixed number of workers running claimed jobs
+type postingPool struct {
+	slots    chan struct{} // Holds one value for every job that is claimed but not finished
+	queue    chan *PostJob
+	inFlight int64
+	draining int32     // Set once the pool has stopped claiming jobs
+	stop     chan bool // Stops the interval that calls dispatch
+
+	statsMutex sync.Mutex
+	started    int64
+	totalWait  time.Duration
+	lastWait   time.Duration
+}
+
+// postingPoolStats - A snapshot of what the pool is doing
+type postingPoolStats struct {
+	Workers         int     `json:"workers"`
+	InFlight        int64   `json:"inFlight"`
+	Queued          int     `json:"queued"`
+	Started         int64   `json:"started"`
+	LastWaitSeconds float64 `json:"lastWaitSeconds"`
+	AvgWaitSeconds  float64 `json:"avgWaitSeconds"`
+}
+
+func init() {
+	if workers := os.Getenv("POSTING_WORKERS"); workers != "" {
+		n, err := strconv.Atoi(workers)
+		if err != nil || n < 1 {
+			panic(errors.New("POSTING_WORKERS must be a positive number"))
+		}
+		postingWorkers = n
+	}
+}
+
+func newPostingPool(workers int) *postingPool {
+	p := &postingPool{
+		slots: make(chan struct{}, workers),
+		queue: make(chan *PostJob, workers),
+	}
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// dispatch claims due jobs for as long as there are free workers to run them. Jobs are only claimed once a worker is free,
+// so nothing sits in memory long enough to be mistaken for a stale job
+func (p *postingPool) dispatch() {
+	requeueStalePostJobs()
+	for {
+		p.slots <- struct{}{}
+		if atomic.LoadInt32(&p.draining) == 1 {
+			<-p.slots
+			return
+		}
+		job, err := claimPostJob()
+		if err != nil {
+			<-p.slots
+			qError("Failed to claim a job")
+			captureError(err)
+			return
+		}
+		if job == nil {
+			<-p.slots
+			return
+		}
+		p.queue <- job
+	}
+}
+
+// Drain stops the pool claiming jobs and waits for the jobs it already claimed to finish, until the deadline. Returns false if some
+// were still running. Their locks go stale and another replica picks them up, and their posting intents stop them tweeting twice
+func (p *postingPool) Drain(deadline time.Time) bool {
+	atomic.StoreInt32(&p.draining, 1)
+	if p.stop != nil {
+		// dispatch may be waiting for a free worker, so don't wait for the interval to notice
+		go func() {
+			p.stop <- true
+		}()
+	}
+	// Every slot being free means nothing is in flight. Holding them stops anything new being claimed
+	timeout := time.After(time.Until(deadline))
+	for i := 0; i < cap(p.slots); i++ {
+		select {
+		case p.slots <- struct{}{}:
+		case <-timeout:
+			return false
+		}
+	}
+	return true
+}
+
+func (p *postingPool) work() {
+	for job := range p.queue {
+		// Time between the job becoming due and a worker starting it
+		wait := time.Since(time.Unix(job.NextAttemptAt, 0))
+		if wait < 0 {
+			wait = 0
+		}
+		p.statsMutex.Lock()
+		p.started++
+		p.totalWait += wait
+		p.lastWait = wait
+		p.statsMutex.Unlock()
+
+		atomic.AddInt64(&p.inFlight, 1)
+		finishPostJob(job, runPostJob(job))
+		atomic.AddInt64(&p.inFlight, -1)
+		<-p.slots
+	}
+}
+
+func (p *postingPool) Stats() postingPoolStats {
+	p.statsMutex.Lock()
+	defer p.statsMutex.Unlock()
+	stats := postingPoolStats{
+		Workers:         cap(p.slots),
+		InFlight:        atomic.LoadInt64(&p.inFlight),
+		Queued:          len(p.queue),
+		Started:         p.started,
+		LastWaitSeconds: p.lastWait.Seconds(),
+	}
+	if p.started > 0 {
+		stats.AvgWaitSeconds = (p.totalWait / time.Duration(p.started)).Seconds()
+	}
+	return stats
+}
+
+// servePostingStats writes the pool's stats as JSON, for the debug server
+func servePostingStats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(pool.Stats())
+}
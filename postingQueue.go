@@ -0,0 +1,238 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// How many times a job is attempted before it is marked as failed
+var postJobMaxAttempts = 5
+
+const postJobBaseBackoff = 60     // Seconds to wait before the first retry, doubled after every failed attempt
+const postJobMaxBackoff = 3600    // Never wait more than an hour between attempts
+const postJobStaleAfter = 15 * 60 // A job that has been running this long belongs to a process that died
+
+func qLog(msg string) {
+	log.Info("[POSTING QUEUE] " + msg)
+}
+
+func qError(msg string) {
+	log.Error("[POSTING QUEUE] " + msg)
+}
+
+func init() {
+	if maxAttempts := os.Getenv("POSTING_MAX_ATTEMPTS"); maxAttempts != "" {
+		n, err := strconv.Atoi(maxAttempts)
+		if err != nil || n < 1 {
+			panic(errors.New("POSTING_MAX_ATTEMPTS must be a positive number"))
+		}
+		postJobMaxAttempts = n
+	}
+}
+
+// permanentError - A posting failure that retrying won't fix
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+// skippedPost - Returned instead of posting when the user's stats haven't changed enough. The job still succeeds
+type skippedPost struct {
+	reason string
+}
+
+func (e skippedPost) Error() string {
+	return "skipped: " + e.reason
+}
+
+// setupPostingQueue creates the indexes the queue relies on and starts the worker pool
+func setupPostingQueue() {
+	jobs := connection.Collection("postjobmodels").Collection()
+	// Only one job of each kind per user for each slot
+	err := jobs.EnsureIndex(mgo.Index{
+		Key:    []string{"user", "period", "kind"},
+		Unique: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = jobs.EnsureIndex(mgo.Index{
+		Key: []string{"state", "nextAttemptAt"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	setupPostIntents()
+	setupMilestones()
+	setupSchedulerRuns()
+
+	pool = newPostingPool(postingWorkers)
+	pool.stop = setInterval(pool.dispatch, 10*1000, false)
+}
+
+// enqueuePostJob saves a pending job for the user's slot, queued by the scheduler run, unless one already exists for that period
+func enqueuePostJob(userID bson.ObjectId, period int64, runID bson.ObjectId) (bool, error) {
+	return enqueueJob(bson.M{"user": userID, "period": period, "kind": jobKindScheduled}, bson.M{"run": runID})
+}
+
+// enqueueMilestoneJob saves a pending job to post a card for the milestones the check crossed, unless one already exists
+func enqueueMilestoneJob(userID bson.ObjectId, check *OsuRequest) (bool, error) {
+	return enqueueJob(bson.M{"user": userID, "period": check.DateChecked, "kind": jobKindMilestone}, bson.M{"check": check.GetId()})
+}
+
+// enqueueManualJob saves a pending job to post the user's stats right away, and returns it
+func enqueueManualJob(userID bson.ObjectId, now int64) (*PostJob, error) {
+	selector := bson.M{"user": userID, "period": now, "kind": jobKindManual}
+	if _, err := enqueueJob(selector, bson.M{}); err != nil {
+		return nil, err
+	}
+	job := &PostJob{}
+	if err := connection.Collection("postjobmodels").Collection().Find(selector).One(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// enqueueJob saves a pending job matching the selector, with the extra fields set, unless one already exists
+func enqueueJob(selector bson.M, fields bson.M) (bool, error) {
+	now := time.Now()
+	insert := bson.M{
+		"_created":      now,
+		"_modified":     now,
+		"state":         jobStatePending,
+		"attempts":      0,
+		"nextAttemptAt": now.Unix(),
+		"lockedAt":      int64(0),
+		"lastError":     "",
+	}
+	for field, value := range fields {
+		insert[field] = value
+	}
+	info, err := connection.Collection("postjobmodels").Collection().Upsert(selector, bson.M{"$setOnInsert": insert})
+	if err != nil {
+		return false, err
+	}
+	return info.UpsertedId != nil, nil
+}
+
+// claimPostJob atomically marks the next due job as running and returns it. Returns nil if nothing is due
+func claimPostJob() (*PostJob, error) {
+	job := &PostJob{}
+	now := time.Now()
+	_, err := connection.Collection("postjobmodels").Collection().Find(bson.M{
+		"state":         jobStatePending,
+		"nextAttemptAt": bson.M{"$lte": now.Unix()},
+	}).Sort("nextAttemptAt").Apply(mgo.Change{
+		Update: bson.M{
+			"$set": bson.M{"state": jobStateRunning, "lockedAt": now.Unix(), "_modified": now},
+			"$inc": bson.M{"attempts": 1},
+		},
+		ReturnNew: true,
+	}, job)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// finishPostJob records the outcome of an attempt, scheduling a retry if the failure might be temporary
+func finishPostJob(job *PostJob, postErr error) {
+	now := time.Now()
+	update := bson.M{"lockedAt": int64(0), "_modified": now}
+	if skip, ok := postErr.(skippedPost); ok {
+		update["state"] = jobStateSucceeded
+		update["lastError"] = ""
+		updateRunOutcome(job, outcomeSkipped, skip.reason)
+	} else if postErr == nil {
+		update["state"] = jobStateSucceeded
+		update["lastError"] = ""
+		updateRunOutcome(job, outcomePosted, "")
+	} else {
+		update["lastError"] = postErr.Error()
+		if _, ok := postErr.(permanentError); ok || job.Attempts >= postJobMaxAttempts || job.Kind == jobKindManual {
+			qError("Job " + job.GetId().Hex() + " for user " + job.User.Hex() + " failed after " + strconv.Itoa(job.Attempts) + " attempt(s): " + postErr.Error())
+			update["state"] = jobStateFailed
+			updateRunOutcome(job, outcomeFailed, postErr.Error())
+			// Move on to the user's next slot, otherwise they would stay due forever
+			if job.Kind == jobKindScheduled {
+				if err := rescheduleUser(job.User, now); err != nil {
+					qError("Failed to reschedule user " + job.User.Hex())
+					captureError(err)
+				}
+			}
+			// Manual jobs aren't retried, so a failure that might go away on its own gives the user their post now button back
+			if _, ok := postErr.(permanentError); !ok && job.Kind == jobKindManual {
+				if err := clearPostNowCooldown(job.User, job.Period); err != nil {
+					qError("Failed to clear the post now cooldown for user " + job.User.Hex())
+					captureError(err)
+				}
+			}
+		} else {
+			backoff := postJobBackoff(job.Attempts)
+			qLog("Job " + job.GetId().Hex() + " for user " + job.User.Hex() + " failed, retrying in " + strconv.FormatInt(backoff, 10) + " seconds: " + postErr.Error())
+			update["state"] = jobStatePending
+			update["nextAttemptAt"] = now.Unix() + backoff
+			updateRunOutcome(job, outcomeRetrying, postErr.Error())
+		}
+	}
+	err := connection.Collection("postjobmodels").Collection().UpdateId(job.GetId(), bson.M{"$set": update})
+	if err != nil {
+		qError("Failed to save the result of job " + job.GetId().Hex())
+		captureError(err)
+	}
+}
+
+// postJobBackoff returns how many seconds to wait before trying again after the given number of attempts
+func postJobBackoff(attempts int) int64 {
+	backoff := float64(postJobBaseBackoff) * math.Pow(2, float64(attempts-1))
+	if backoff > postJobMaxBackoff {
+		return postJobMaxBackoff
+	}
+	return int64(backoff)
+}
+
+// requeueStalePostJobs puts jobs left running by a process that died back in the queue
+func requeueStalePostJobs() {
+	now := time.Now()
+	info, err := connection.Collection("postjobmodels").Collection().UpdateAll(
+		bson.M{"state": jobStateRunning, "lockedAt": bson.M{"$lt": now.Unix() - postJobStaleAfter}},
+		bson.M{"$set": bson.M{"state": jobStatePending, "nextAttemptAt": now.Unix(), "lockedAt": int64(0), "_modified": now}},
+	)
+	if err != nil {
+		qError("Failed to requeue stale jobs")
+		captureError(err)
+		return
+	}
+	if info.Updated > 0 {
+		qLog("Requeued " + strconv.Itoa(info.Updated) + " stale job(s)")
+	}
+}
+
+// runPostJob makes sure a panic while posting counts as a failed attempt instead of killing the worker
+func runPostJob(job *PostJob) (postErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			postErr = fmt.Errorf("panic while posting: %v", r)
+		}
+	}()
+	unlock := userLocks.Lock(job.User.Hex())
+	defer unlock()
+	if job.Kind == jobKindMilestone {
+		return postMilestones(job)
+	}
+	return updateAndPost(job.User, job.Period, job.Kind)
+}
@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestPostJobBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     int64
+	}{
+		{1, 60},
+		{2, 120},
+		{3, 240},
+		{6, 1920},
+		{7, 3600}, // 3840, capped at an hour
+		{50, 3600},
+	}
+	for _, test := range tests {
+		if got := postJobBackoff(test.attempts); got != test.want {
+			t.Errorf("postJobBackoff(%d) = %d, want %d", test.attempts, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+)
+
+// The Twitter IDs of the users who can see the admin pages
+var adminTwitterIDs = map[string]bool{}
+
+func init() {
+	for _, id := range strings.Split(os.Getenv("ADMIN_TWITTER_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			adminTwitterIDs[id] = true
+		}
+	}
+}
+
+// How many runs the runs page lists
+const adminRunsShown = 50
+
+type adminRunsPageData struct {
+	Runs []adminRun
+}
+
+type adminRunPageData struct {
+	Run      adminRun
+	Outcome  string
+	Outcomes []adminOutcome
+}
+
+type adminUserPageData struct {
+	Handle   string
+	Outcomes []adminOutcome
+}
+
+// adminRun - A SchedulerRun with its times formatted for the admin pages
+type adminRun struct {
+	ID         string
+	Hour       string
+	StartedAt  string
+	FinishedAt string
+	Run        SchedulerRun
+}
+
+// adminOutcome - A RunOutcome with its times formatted for the admin pages
+type adminOutcome struct {
+	RunID   string
+	Slot    string
+	Outcome RunOutcome
+}
+
+// isAdmin checks the request is from a signed in admin. Everyone else gets the 404 page, so the admin pages can't be found
+func isAdmin(w http.ResponseWriter, r *http.Request) bool {
+	ctx := r.Context()
+	sessionError := ctx.Value("session_error").(string)
+	if sessionError != "" {
+		log.Error("There was an error getting the user's session")
+		log.Error(sessionError)
+		reqID := middleware.GetReqID(ctx)
+		routeError(w, "Error getting user session", errors.New(sessionError), reqID, 500)
+		return false
+	}
+	if ctx.Value("isAuthenticated").(bool) == false || ctx.Value("user_error").(string) != "" {
+		notFound(w, r)
+		return false
+	}
+	user := ctx.Value("user").(*User)
+	if !adminTwitterIDs[user.Twitter.Profile.TwitterID] {
+		notFound(w, r)
+		return false
+	}
+	return true
+}
+
+// routeAdminRuns lists the most recent scheduler runs
+func routeAdminRuns(w http.ResponseWriter, r *http.Request) {
+	if !isAdmin(w, r) {
+		return
+	}
+	runs := []SchedulerRun{}
+	err := connection.Collection("schedulerrunmodels").Collection().Find(bson.M{}).Sort("-hour", "-startedAt").Limit(adminRunsShown).All(&runs)
+	if err != nil {
+		routeError(w, "Error getting scheduler runs", err, middleware.GetReqID(r.Context()), 500)
+		return
+	}
+	pageData := adminRunsPageData{
+		Runs: []adminRun{},
+	}
+	for _, run := range runs {
+		pageData.Runs = append(pageData.Runs, newAdminRun(run))
+	}
+	templates.ExecuteTemplate(w, "adminRuns.html", pageData)
+}
+
+// routeAdminRun shows what happened to every user in a run. The outcome query parameter only shows users with that outcome
+func routeAdminRun(w http.ResponseWriter, r *http.Request) {
+	if !isAdmin(w, r) {
+		return
+	}
+	runID := chi.URLParam(r, "runID")
+	if !bson.IsObjectIdHex(runID) {
+		notFound(w, r)
+		return
+	}
+	run := SchedulerRun{}
+	err := connection.Collection("schedulerrunmodels").FindById(bson.ObjectIdHex(runID), &run)
+	if err != nil {
+		notFound(w, r)
+		return
+	}
+
+	outcome := r.URL.Query().Get("outcome")
+	query := bson.M{"run": run.GetId()}
+	if outcome != "" {
+		query["outcome"] = outcome
+	}
+	outcomes := []RunOutcome{}
+	err = connection.Collection("schedulerrunoutcomemodels").Collection().Find(query).Sort("handle").All(&outcomes)
+	if err != nil {
+		routeError(w, "Error getting the run's outcomes", err, middleware.GetReqID(r.Context()), 500)
+		return
+	}
+	pageData := adminRunPageData{
+		Run:      newAdminRun(run),
+		Outcome:  outcome,
+		Outcomes: newAdminOutcomes(outcomes),
+	}
+	templates.ExecuteTemplate(w, "adminRun.html", pageData)
+}
+
+// routeAdminUser shows what happened to a user, by Twitter handle, in the most recent runs
+func routeAdminUser(w http.ResponseWriter, r *http.Request) {
+	if !isAdmin(w, r) {
+		return
+	}
+	handle := strings.TrimPrefix(strings.TrimSpace(r.URL.Query().Get("handle")), "@")
+	outcomes := []RunOutcome{}
+	if handle != "" {
+		err := connection.Collection("schedulerrunoutcomemodels").Collection().Find(bson.M{"handle": handle}).Sort("-_created").Limit(adminRunsShown).All(&outcomes)
+		if err != nil {
+			routeError(w, "Error getting the user's outcomes", err, middleware.GetReqID(r.Context()), 500)
+			return
+		}
+	}
+	pageData := adminUserPageData{
+		Handle:   handle,
+		Outcomes: newAdminOutcomes(outcomes),
+	}
+	templates.ExecuteTemplate(w, "adminUser.html", pageData)
+}
+
+func newAdminRun(run SchedulerRun) adminRun {
+	finishedAt := "Running"
+	if run.FinishedAt != 0 {
+		finishedAt = formatAdminTime(run.FinishedAt)
+	}
+	return adminRun{
+		ID:         run.GetId().Hex(),
+		Hour:       formatAdminTime(run.Hour),
+		StartedAt:  formatAdminTime(run.StartedAt),
+		FinishedAt: finishedAt,
+		Run:        run,
+	}
+}
+
+func newAdminOutcomes(outcomes []RunOutcome) []adminOutcome {
+	formatted := []adminOutcome{}
+	for _, outcome := range outcomes {
+		formatted = append(formatted, adminOutcome{
+			RunID:   outcome.Run.Hex(),
+			Slot:    formatAdminTime(outcome.Slot),
+			Outcome: outcome,
+		})
+	}
+	return formatted
+}
+
+func formatAdminTime(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format("2006-01-02 15:04 MST")
+}
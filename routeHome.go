@@ -32,9 +32,12 @@ type homePageTranslations struct {
 var currentUsers string
 var totalTweets string
 
+// Stop the intervals that update the home page totals, so they don't use Mongo after shutdown closes it
+var homeTotalsStops []chan bool
+
 func init() {
-	setInterval(updateCurrentUsers, 60*1000, true)
-	setInterval(updateTotalTweets, 60*60*1000, true)
+	homeTotalsStops = append(homeTotalsStops, setInterval(updateCurrentUsers, 60*1000, true))
+	homeTotalsStops = append(homeTotalsStops, setInterval(updateTotalTweets, 60*60*1000, true))
 	setTimeout(updateCurrentUsers, 5000)
 	setTimeout(updateTotalTweets, 5000)
 }
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-chi/chi/middleware"
+	"github.com/gorilla/sessions"
+)
+
+// How long a user has to wait between using the post now button
+var postNowCooldown = 6 * time.Hour
+
+func init() {
+	if cooldown := os.Getenv("POST_NOW_COOLDOWN_HOURS"); cooldown != "" {
+		n, err := strconv.Atoi(cooldown)
+		if err != nil || n < 0 {
+			panic(errors.New("POST_NOW_COOLDOWN_HOURS must be a number that isn't negative"))
+		}
+		postNowCooldown = time.Duration(n) * time.Hour
+	}
+}
+
+// postNow queues a tweet for the user right away. The result is shown on the settings page once the tweet has been posted
+func postNow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sessionError := ctx.Value("session_error").(string)
+	if sessionError != "" {
+		log.Error("There was an error getting the user's session")
+		log.Error(sessionError)
+		reqID := middleware.GetReqID(ctx)
+		routeError(w, "Error getting user session", errors.New(sessionError), reqID, 500)
+		return
+	}
+	session := ctx.Value("session").(*sessions.Session)
+	isAuthenticated := ctx.Value("isAuthenticated").(bool)
+
+	// Privileged page. If the user isn't authenticated, we need to redirect the user to login
+	if isAuthenticated == false {
+		http.Redirect(w, r, "/connect/twitter", 302)
+		return
+	}
+
+	var user User
+	userError := ctx.Value("user_error").(string)
+	if userError != "" {
+		log.Error("There was an error getting the user's account info")
+		log.Error(userError)
+		reqID := middleware.GetReqID(ctx)
+		routeError(w, "Error getting user account info", errors.New(userError), reqID, 500)
+		return
+	}
+	user = *ctx.Value("user").(*User)
+	if user.OsuSettings.Enabled == false {
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+	if user.OsuSettings.Player == "" {
+		session.AddFlash("Save an osu! username before posting a tweet", "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+
+	// Start the cooldown, unless the user is still in it. Doing both in one update stops two quick clicks from both getting through
+	now := time.Now()
+	err := connection.Collection("usermodels").Collection().Update(
+		bson.M{"_id": user.GetId(), "lastPostNowAt": bson.M{"$not": bson.M{"$gt": now.Add(-postNowCooldown).Unix()}}},
+		bson.M{"$set": bson.M{"lastPostNowAt": now.Unix()}},
+	)
+	if err == mgo.ErrNotFound {
+		availableAt := time.Unix(user.LastPostNowAt, 0).Add(postNowCooldown)
+		session.AddFlash("You can only post a tweet yourself once every "+strconv.Itoa(int(postNowCooldown.Hours()))+" hours. Try again in "+formatWait(availableAt.Sub(now)), "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+	if err != nil {
+		captureError(err)
+		session.AddFlash("Error starting your tweet", "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+
+	job, err := enqueueManualJob(user.GetId(), now.Unix())
+	if err != nil {
+		captureError(err)
+		// Nothing was posted, so the user shouldn't have to wait to try again
+		if err := clearPostNowCooldown(user.GetId(), now.Unix()); err != nil {
+			captureError(err)
+		}
+		session.AddFlash("Error queueing your tweet", "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+	log.Debug("User " + user.Twitter.Profile.Handle + " queued a tweet with the post now button")
+
+	// Remember the job, so the settings page can show how it went
+	session.Values["post_now_job"] = job.GetId().Hex()
+	session.AddFlash("Your tweet is on its way. Refresh this page in a minute to see how it went", "settings_success")
+	session.Save(r, w)
+	http.Redirect(w, r, "/settings", 302)
+}
+
+// clearPostNowCooldown lets the user use the post now button again, if the cooldown is still the one started at startedAt
+func clearPostNowCooldown(userID bson.ObjectId, startedAt int64) error {
+	err := connection.Collection("usermodels").Collection().Update(
+		bson.M{"_id": userID, "lastPostNowAt": startedAt},
+		bson.M{"$set": bson.M{"lastPostNowAt": int64(0)}},
+	)
+	if err == mgo.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// reportPostNowResult adds a flash with the result of the user's post now tweet once it has finished. Returns true if there is no
+// tweet left to report on. The session isn't saved
+func reportPostNowResult(session *sessions.Session) bool {
+	jobID, ok := session.Values["post_now_job"].(string)
+	if !ok || !bson.IsObjectIdHex(jobID) {
+		return true
+	}
+	job := &PostJob{}
+	err := connection.Collection("postjobmodels").FindById(bson.ObjectIdHex(jobID), job)
+	if err != nil {
+		// The job is gone, so there is nothing to report
+		delete(session.Values, "post_now_job")
+		return true
+	}
+	switch job.State {
+	case jobStateSucceeded:
+		session.AddFlash("Your tweet was posted!", "settings_success")
+	case jobStateFailed:
+		session.AddFlash("Your tweet couldn't be posted: "+job.LastError, "settings_error")
+	default:
+		return false
+	}
+	delete(session.Values, "post_now_job")
+	return true
+}
+
+// formatWait writes a duration in hours and minutes, rounded up to the minute
+func formatWait(d time.Duration) string {
+	minutes := int((d + time.Minute - 1) / time.Minute)
+	if minutes < 60 {
+		return strconv.Itoa(minutes) + " minute(s)"
+	}
+	return strconv.Itoa(minutes/60) + " hour(s) and " + strconv.Itoa(minutes%60) + " minute(s)"
+}
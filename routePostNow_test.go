@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatWait(t *testing.T) {
+	tests := []struct {
+		wait time.Duration
+		want string
+	}{
+		{time.Second, "1 minute(s)"},
+		{time.Minute, "1 minute(s)"},
+		{time.Minute + time.Second, "2 minute(s)"},
+		{59 * time.Minute, "59 minute(s)"},
+		{59*time.Minute + time.Second, "1 hour(s) and 0 minute(s)"},
+		{5*time.Hour + 59*time.Minute + 30*time.Second, "6 hour(s) and 0 minute(s)"},
+		{2*time.Hour + 5*time.Minute, "2 hour(s) and 5 minute(s)"},
+	}
+	for _, test := range tests {
+		if got := formatWait(test.wait); got != test.want {
+			t.Errorf("formatWait(%s) = %q, want %q", test.wait, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/middleware"
+)
+
+// routePreview renders the card the user's next tweet would have, without touching Twitter. The caption is sent in the
+// X-Tweet-Caption header, and the theme query parameter overrides the user's theme. Like a real tweet, it reuses the player's
+// latest check if it's recent enough and fetches new data otherwise, but nothing is saved
+func routePreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sessionError := ctx.Value("session_error").(string)
+	if sessionError != "" {
+		log.Error("There was an error getting the user's session")
+		log.Error(sessionError)
+		reqID := middleware.GetReqID(ctx)
+		routeError(w, "Error getting user session", errors.New(sessionError), reqID, 500)
+		return
+	}
+	isAuthenticated := ctx.Value("isAuthenticated").(bool)
+
+	// Privileged page. If the user isn't authenticated, we need to redirect the user to login
+	if isAuthenticated == false {
+		http.Redirect(w, r, "/connect/twitter", 302)
+		return
+	}
+
+	var user User
+	userError := ctx.Value("user_error").(string)
+	if userError != "" {
+		log.Error("There was an error getting the user's account info")
+		log.Error(userError)
+		reqID := middleware.GetReqID(ctx)
+		routeError(w, "Error getting user account info", errors.New(userError), reqID, 500)
+		return
+	}
+	user = *ctx.Value("user").(*User)
+	if user.OsuSettings.Player == "" {
+		http.Error(w, "Save an osu! username to preview your card", 404)
+		return
+	}
+
+	// Lets the theme picked on the settings page be previewed before it's saved
+	if theme := r.URL.Query().Get("theme"); isThemeID(theme) {
+		user.OsuSettings.Theme = theme
+	}
+	// The same goes for the stat rows. Rows that don't fit the theme are cut off, like on a tweet
+	if _, ok := r.URL.Query()["stat_row"]; ok {
+		rows, err := parseStatRows(r.URL.Query()["stat_row"])
+		if err != nil {
+			http.Error(w, err.Error(), 400)
+			return
+		}
+		user.OsuSettings.StatRows = rows
+	}
+	if showHistory := r.URL.Query().Get("show_history"); showHistory != "" {
+		user.OsuSettings.ShowHistory = showHistory == "on"
+	}
+
+	l := pLogger{
+		UserID: user.GetId().Hex(),
+	}
+	l.Log("Rendering a preview of the user's card")
+	// Read only, like a dry run, so loading a preview never saves a check or fires milestones
+	player, previousRequest, newRequest, err := refreshPlayerChecks(&user, l, true)
+	if err != nil {
+		if _, ok := err.(permanentError); ok {
+			http.Error(w, "There isn't enough osu! data to preview your card yet", 404)
+			return
+		}
+		routeError(w, "Error getting osu! data for the preview", err, middleware.GetReqID(ctx), 500)
+		return
+	}
+	previousRequest, _ = compareToLastPost(&user, previousRequest, newRequest, l)
+	card, err := generateImage(&user, player, previousRequest, newRequest, l)
+	if err != nil {
+		routeError(w, "Error generating the preview", err, middleware.GetReqID(ctx), 500)
+		return
+	}
+
+	var cardBuffer bytes.Buffer
+	if err := png.Encode(&cardBuffer, card); err != nil {
+		routeError(w, "Error encoding the preview", err, middleware.GetReqID(ctx), 500)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Content-Length", strconv.Itoa(cardBuffer.Len()))
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.Header().Set("X-Tweet-Caption", tweetCaption(player))
+	w.Write(cardBuffer.Bytes())
+}
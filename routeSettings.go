@@ -2,15 +2,12 @@ package main
 
 import (
 	"errors"
+	"math"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
-	"github.com/go-bongo/bongo"
-
 	"github.com/globalsign/mgo/bson"
-	osuapi "github.com/wcalandro/osuapi-go"
 
 	"github.com/go-chi/chi/middleware"
 	"github.com/gorilla/sessions"
@@ -18,15 +15,38 @@ import (
 )
 
 type settingsPageData struct {
-	User            User
-	Translations    settingsPageTranslations
-	IsAuthenticated bool
-	OsuPlayer       OsuPlayer
-	Modes           [4]string
-	ErrorFlash      []interface{}
-	SuccessFlash    []interface{}
-	Frequencies     [3]string
-	Hours           [24]string
+	User               User
+	Translations       settingsPageTranslations
+	IsAuthenticated    bool
+	OsuPlayer          OsuPlayer
+	Modes              [4]string
+	ErrorFlash         []interface{}
+	SuccessFlash       []interface{}
+	Frequencies        [5]string
+	Hours              [24]string
+	Weekdays           [7]string
+	MonthDays          []int
+	ChosenWeekdays     [7]bool
+	PostEveryDays      int
+	SkipMinPlays       int
+	MilestoneRank      string
+	MilestonePP        string
+	MilestoneSS        string
+	PostNowAvailableAt string
+	NextPostAt         string
+	DisabledReason     string
+	Notices            []settingsNotice
+	Themes             []*cardTheme
+	Theme              string
+	StatRows           []statRow
+	StatRowSlots       []string // The key picked for each row on the card, in order. Empty for rows left blank
+}
+
+// settingsNotice - A notice on the user, ready to be shown on the settings page
+type settingsNotice struct {
+	ID   string
+	Text string
+	Date string
 }
 
 type settingsPageTranslations struct {
@@ -47,10 +67,39 @@ type settingsPageTranslations struct {
 	PostFrequencyDaily         string
 	PostFrequencyWeekly        string
 	PostFrequencyMonthly       string
-	CurrentUTCTimeLabel        string
+	PostFrequencyWeekdays      string
+	PostFrequencyInterval      string
+	PostWeekdayLabel           string
+	PostWeekdaysLabel          string
+	PostEveryDaysLabel         string
+	PostMonthDayLabel          string
+	Weekdays                   [7]string
+	NextPostAtLabel            string
+	SkipUnchangedLabel         string
+	SkipMinPlaysLabel          string
+	SkipMinPPLabel             string
+	MilestonesLabel            string
+	MilestonesHelp             string
+	MilestoneRankLabel         string
+	MilestonePPLabel           string
+	MilestoneSSLabel           string
+	PostNowButton              string
+	PreviewCardButton          string
+	PostNowAvailableAtLabel    string
+	TimeZoneLabel              string
+	UseBrowserTimeZone         string
+	CurrentLocalTimeLabel      string
+	DisabledReasons            map[string]string
+	DismissNoticeButton        string
+	ThemeLabel                 string
+	StatRowsLabel              string
+	StatRowsHelp               string
+	StatRowNone                string
+	ShowHistoryLabel           string
 }
 
 var allOsuModes = [4]string{"osu!standard", "osu!taiko", "osu!catch", "osu!mania"}
+var monthDays = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31}
 var hours = [24]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23"}
 
 func routeSettings(w http.ResponseWriter, r *http.Request) {
@@ -100,19 +149,100 @@ func routeSettings(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Show when the next tweet is due in the user's own time zone
+	nextPostAt := ""
+	if user.NextPostAt != 0 {
+		loc, err := loadTimeZone(user.OsuSettings.TimeZone)
+		if err != nil {
+			loc = time.UTC
+		}
+		nextPostAt = time.Unix(user.NextPostAt, 0).In(loc).Format("Monday, January 2 2006, 15:04 MST")
+	}
+
+	// Notices use the same text as the reason they're about
+	notices := []settingsNotice{}
+	noticeLoc, err := loadTimeZone(user.OsuSettings.TimeZone)
+	if err != nil {
+		noticeLoc = time.UTC
+	}
+	for _, notice := range user.Notices {
+		text, ok := translations.DisabledReasons[notice.Kind]
+		if !ok {
+			continue
+		}
+		notices = append(notices, settingsNotice{
+			ID:   notice.ID.Hex(),
+			Text: text,
+			Date: time.Unix(notice.CreatedAt, 0).In(noticeLoc).Format("January 2 2006"),
+		})
+	}
+
+	// Tick the days already picked for posting on chosen weekdays
+	var chosenWeekdays [7]bool
+	for _, weekday := range user.OsuSettings.PostSchedule.Weekdays {
+		if weekday >= 0 && weekday <= 6 {
+			chosenWeekdays[weekday] = true
+		}
+	}
+	postEveryDays := user.OsuSettings.PostSchedule.EveryDays
+	if postEveryDays < 2 {
+		postEveryDays = 2
+	}
+
+	// One dropdown per row that could fit, with the rows on the card now picked. Users who haven't picked any see their theme's
+	statRowSlots := make([]string, maxStatRows)
+	chosenRows := user.OsuSettings.StatRows
+	if len(chosenRows) == 0 {
+		chosenRows = themeByID(user.OsuSettings.Theme).Rows
+	}
+	copy(statRowSlots, chosenRows)
+
+	skipMinPlays := user.OsuSettings.SkipUnchanged.MinPlays
+	if skipMinPlays < 1 {
+		skipMinPlays = 1
+	}
+
+	// Show when the post now button can be used again, in the user's own time zone
+	postNowAvailableAt := ""
+	if availableAt := time.Unix(user.LastPostNowAt, 0).Add(postNowCooldown); user.LastPostNowAt != 0 && availableAt.After(time.Now()) {
+		loc, err := loadTimeZone(user.OsuSettings.TimeZone)
+		if err != nil {
+			loc = time.UTC
+		}
+		postNowAvailableAt = availableAt.In(loc).Format("Monday, January 2 2006, 15:04 MST")
+	}
+
+	// The result of a post now tweet that finished after we stopped waiting for it
+	reportPostNowResult(session)
 	errorFlashes := session.Flashes("settings_error")
 	successFlashes := session.Flashes("settings_success")
 	session.Save(r, w)
 	pageData := settingsPageData{
-		User:            user,
-		OsuPlayer:       player,
-		IsAuthenticated: true,
-		Translations:    translations,
-		Modes:           allOsuModes,
-		ErrorFlash:      errorFlashes,
-		SuccessFlash:    successFlashes,
-		Frequencies:     [3]string{translations.PostFrequencyDaily, translations.PostFrequencyWeekly, translations.PostFrequencyMonthly},
-		Hours:           hours,
+		User:               user,
+		OsuPlayer:          player,
+		IsAuthenticated:    true,
+		Translations:       translations,
+		Modes:              allOsuModes,
+		ErrorFlash:         errorFlashes,
+		SuccessFlash:       successFlashes,
+		Frequencies:        [5]string{translations.PostFrequencyDaily, translations.PostFrequencyWeekly, translations.PostFrequencyMonthly, translations.PostFrequencyWeekdays, translations.PostFrequencyInterval},
+		Hours:              hours,
+		Weekdays:           translations.Weekdays,
+		MonthDays:          monthDays,
+		ChosenWeekdays:     chosenWeekdays,
+		PostEveryDays:      postEveryDays,
+		SkipMinPlays:       skipMinPlays,
+		MilestoneRank:      milestoneValues(user.OsuSettings.Milestones, milestoneKindRank),
+		MilestonePP:        milestoneValues(user.OsuSettings.Milestones, milestoneKindPP),
+		MilestoneSS:        milestoneValues(user.OsuSettings.Milestones, milestoneKindSS),
+		PostNowAvailableAt: postNowAvailableAt,
+		NextPostAt:         nextPostAt,
+		DisabledReason:     translations.DisabledReasons[user.DisabledReason],
+		Notices:            notices,
+		Themes:             cardThemes,
+		Theme:              themeByID(user.OsuSettings.Theme).ID,
+		StatRows:           statRows,
+		StatRowSlots:       statRowSlots,
 	}
 
 	templates.ExecuteTemplate(w, "settings.html", pageData)
@@ -152,6 +282,11 @@ func enableTweetPosting(w http.ResponseWriter, r *http.Request) {
 	}
 
 	user.OsuSettings.Enabled = true
+	user.DisabledReason = ""
+	if err := scheduleNextPost(&user, time.Now()); err != nil {
+		routeError(w, "Error scheduling the next tweet when enabling tweets", err, middleware.GetReqID(ctx), 500)
+		return
+	}
 	log.Debug("User " + user.Twitter.Profile.Handle + " just enabled Tweet posting!")
 	err := connection.Collection("usermodels").Save(&user)
 	if err != nil {
@@ -195,6 +330,7 @@ func disableTweetPosting(w http.ResponseWriter, r *http.Request) {
 	}
 
 	user.OsuSettings.Enabled = false
+	user.DisabledReason = ""
 	log.Debug("User " + user.Twitter.Profile.Handle + " just disabled Tweet posting!")
 	err := connection.Collection("usermodels").Save(&user)
 	if err != nil {
@@ -204,6 +340,48 @@ func disableTweetPosting(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, "/settings", 302)
 }
 
+// dismissNoticeRoute removes a notice from the settings page
+func dismissNoticeRoute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sessionError := ctx.Value("session_error").(string)
+	if sessionError != "" {
+		log.Error("There was an error getting the user's session")
+		log.Error(sessionError)
+		reqID := middleware.GetReqID(ctx)
+		routeError(w, "Error getting user session", errors.New(sessionError), reqID, 500)
+		return
+	}
+	isAuthenticated := ctx.Value("isAuthenticated").(bool)
+
+	// Privileged page. If the user isn't authenticated, we need to redirect the user to login
+	if isAuthenticated == false {
+		http.Redirect(w, r, "/connect/twitter", 302)
+		return
+	}
+
+	var user User
+	userError := ctx.Value("user_error").(string)
+	if userError != "" {
+		log.Error("There was an error getting the user's account info")
+		log.Error(userError)
+		reqID := middleware.GetReqID(ctx)
+		routeError(w, "Error getting user account info", errors.New(userError), reqID, 500)
+		return
+	}
+	user = *ctx.Value("user").(*User)
+
+	noticeID := r.FormValue("notice")
+	if !bson.IsObjectIdHex(noticeID) {
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+	if err := dismissNotice(user.GetId(), bson.ObjectIdHex(noticeID)); err != nil {
+		routeError(w, "Error dismissing the notice", err, middleware.GetReqID(ctx), 500)
+		return
+	}
+	http.Redirect(w, r, "/settings", 302)
+}
+
 func updateSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	sessionError := ctx.Value("session_error").(string)
@@ -290,6 +468,16 @@ func updateSettings(w http.ResponseWriter, r *http.Request) {
 	}
 	user.OsuSettings.HourToPost = hourToPostValue
 
+	// Check the time zone is one we know about
+	timeZone := r.Form.Get("time_zone")
+	if _, err := loadTimeZone(timeZone); err != nil || timeZone == "" {
+		session.AddFlash("Invalid time zone", "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+	user.OsuSettings.TimeZone = timeZone
+
 	// Check the post frequency field is valid
 	postFrequencyValue, err := strconv.Atoi(r.Form.Get("post_frequency"))
 	if err != nil {
@@ -298,501 +486,182 @@ func updateSettings(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, "/settings", 302)
 		return
 	}
-	if postFrequencyValue < 0 || postFrequencyValue > 2 {
+	if postFrequencyValue < postFrequencyDaily || postFrequencyValue > postFrequencyInterval {
 		session.AddFlash("Invalid frequency", "settings_error")
 		session.Save(r, w)
 		http.Redirect(w, r, "/settings", 302)
 		return
 	}
+	previousFrequency := user.OsuSettings.PostFrequency
 	user.OsuSettings.PostFrequency = postFrequencyValue
 
-	// Get osu! player information
-	osuPlayer, err := api.GetUser(osuapi.M{"u": playerName, "m": strconv.Itoa(modeNumber)})
-
-	if err != nil || osuPlayer == nil {
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error getting user information", "settings_error")
-		} else {
-			session.AddFlash("Couldn't find a user with the specified name and game mode", "settings_error")
-		}
+	// Check the day weekly tweets are posted on is valid
+	postWeekdayValue, err := strconv.Atoi(r.Form.Get("post_weekday"))
+	if err != nil || postWeekdayValue < 0 || postWeekdayValue > 6 {
+		session.AddFlash("Invalid day of the week", "settings_error")
 		session.Save(r, w)
 		http.Redirect(w, r, "/settings", 302)
 		return
 	}
+	user.OsuSettings.PostWeekday = postWeekdayValue
 
-	// Check if the user already exists in our database
-	dbOsuPlayer := &OsuPlayer{}
-	err = connection.Collection("osuplayermodels").FindOne(bson.M{"userid": osuPlayer.UserID}, dbOsuPlayer)
-
-	// We got some kind of error, either a database error or the player couldn't be found in our database
-	if err != nil {
-		if _, ok := err.(*bongo.DocumentNotFoundError); ok {
-			log.Debug("User " + user.Twitter.Profile.Handle + " osu!player " + playerName + "(" + osuPlayer.UserID + ") doesn't exist in the database, adding...")
-			// Player isn't in the database yet.
-			dbOsuPlayer.UserID = osuPlayer.UserID
-			dbOsuPlayer.PlayerName = osuPlayer.Username
-			dbOsuPlayer.LastChecked = time.Now().Unix()
-			dbOsuPlayer.Modes = OsuModes{
-				Standard: OsuModeChecks{
-					Checks: []bson.ObjectId{},
-				},
-				Mania: OsuModeChecks{
-					Checks: []bson.ObjectId{},
-				},
-				Taiko: OsuModeChecks{
-					Checks: []bson.ObjectId{},
-				},
-				CTB: OsuModeChecks{
-					Checks: []bson.ObjectId{},
-				},
-			}
-			err := connection.Collection("osuplayermodels").Save(dbOsuPlayer)
-			if err != nil {
-				captureError(err)
-				session.AddFlash("Error saving new osu! player", "settings_error")
-				session.Save(r, w)
-				http.Redirect(w, r, "/settings", 302)
-				return
-			}
-			osuRequest := &OsuRequest{
-				OsuPlayer:   dbOsuPlayer.GetId(),
-				DateChecked: time.Now().Unix(),
-				Data: OsuRequestData{
-					PlayerID:   osuPlayer.UserID,
-					PlayerName: osuPlayer.Username,
-					Counts: requestDataCounts{
-						Count50s:  osuPlayer.Count50,
-						Count100s: osuPlayer.Count100,
-						Count300s: osuPlayer.Count300,
-						SS:        osuPlayer.CountRankSS,
-						SSH:       osuPlayer.CountRankSSH,
-						S:         osuPlayer.CountRankS,
-						SH:        osuPlayer.CountRankSH,
-						A:         osuPlayer.CountRankA,
-						Plays:     osuPlayer.Playcount,
-					},
-					Scores: requestDataScores{
-						Ranked: osuPlayer.RankedScore,
-						Total:  osuPlayer.TotalScore,
-					},
-					PP: requestDataPP{
-						Raw:         osuPlayer.PP,
-						Rank:        osuPlayer.GlobalRank,
-						CountryRank: osuPlayer.CountryRank,
-					},
-					Country:  osuPlayer.Country,
-					Level:    osuPlayer.Level,
-					Accuracy: osuPlayer.Accuracy,
-				},
-			}
+	// Check the day of the month monthly tweets are posted on is valid
+	postMonthDayValue, err := strconv.Atoi(r.Form.Get("post_month_day"))
+	if err != nil || postMonthDayValue < 1 || postMonthDayValue > 31 {
+		session.AddFlash("Invalid day of the month", "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+	user.OsuSettings.PostMonthDay = postMonthDayValue
 
-			err = connection.Collection("osurequestmodels").Save(osuRequest)
-			if err != nil {
-				captureError(err)
-				session.AddFlash("Error saving osu! data request to database", "settings_error")
+	// Check the days tweets are posted on when posting on chosen weekdays
+	if postFrequencyValue == postFrequencyWeekdays {
+		var chosen [7]bool
+		postWeekdays := []int{}
+		for _, value := range r.Form["post_weekdays"] {
+			weekday, err := strconv.Atoi(value)
+			if err != nil || weekday < 0 || weekday > 6 {
+				session.AddFlash("Invalid day of the week", "settings_error")
 				session.Save(r, w)
 				http.Redirect(w, r, "/settings", 302)
 				return
 			}
-			if modeNumber == 0 {
-				// osu! standard
-				dbOsuPlayer.Modes.Standard.Checks = append(dbOsuPlayer.Modes.Standard.Checks, osuRequest.GetId())
-			} else if modeNumber == 1 {
-				// osu! taiko
-				dbOsuPlayer.Modes.Taiko.Checks = append(dbOsuPlayer.Modes.Taiko.Checks, osuRequest.GetId())
-			} else if modeNumber == 2 {
-				// osu! catch
-				dbOsuPlayer.Modes.CTB.Checks = append(dbOsuPlayer.Modes.CTB.Checks, osuRequest.GetId())
-			} else if modeNumber == 3 {
-				// osu! mania
-				dbOsuPlayer.Modes.Mania.Checks = append(dbOsuPlayer.Modes.Mania.Checks, osuRequest.GetId())
-			}
-			err = connection.Collection("osuplayermodels").Save(dbOsuPlayer)
-			if err != nil {
-				captureError(err)
-				session.AddFlash("Error re-saving osu! player to database", "settings_error")
-				session.Save(r, w)
-				http.Redirect(w, r, "/settings", 302)
-				return
-			}
-			user.OsuSettings.Player = dbOsuPlayer.GetId()
-			user.OsuSettings.Mode = modeNumber
-			err = connection.Collection("usermodels").Save(&user)
-			if err != nil {
-				captureError(err)
-				session.AddFlash("Error saving final settings", "settings_error")
-				session.Save(r, w)
-				http.Redirect(w, r, "/settings", 302)
-				return
+			chosen[weekday] = true
+		}
+		// Keep the days in order and without duplicates
+		for weekday, isChosen := range chosen {
+			if isChosen {
+				postWeekdays = append(postWeekdays, weekday)
 			}
-			log.Debug("User " + user.Twitter.Profile.Handle + " successfully added user to database and changed their mode to " + allOsuModes[modeNumber])
-			session.AddFlash("Successfully updated settings", "settings_success")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
 		}
-		captureError(err)
-		session.AddFlash("Error checking if the user already exists in the database", "settings_error")
-		session.Save(r, w)
-		http.Redirect(w, r, "/settings", 302)
-		return
-	}
-	// The player could be found in the database, now we have to check if they have a recent osu! API request saved to their user for their selected game mode. If so, we don't want to save more data.
-	log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " exists in the database, checking to see if we have recent data for mode " + strconv.Itoa(modeNumber))
-
-	// Check to see if the name has changed, if so, update it
-	if osuPlayer.Username != strings.ToLower(dbOsuPlayer.PlayerName) {
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + "'s name has changed. Updating")
-		dbOsuPlayer.PlayerName = osuPlayer.Username
-		err = connection.Collection("osuplayermodels").Save(dbOsuPlayer)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error updating player name in database", "settings_error")
+		if len(postWeekdays) == 0 {
+			session.AddFlash("Pick at least one day of the week to post on", "settings_error")
 			session.Save(r, w)
 			http.Redirect(w, r, "/settings", 302)
 			return
 		}
+		user.OsuSettings.PostSchedule.Weekdays = postWeekdays
 	}
 
-	if modeNumber == 0 {
-		// Standard
-
-		// First check if they have any requests for this game mode at all
-		if len(dbOsuPlayer.Modes.Standard.Checks) != 0 {
-			log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " has data for mode " + allOsuModes[modeNumber] + ". Saving settings and returning")
-			user.OsuSettings.Player = dbOsuPlayer.GetId()
-			err = connection.Collection("usermodels").Save(&user)
-			if err != nil {
-				captureError(err)
-				session.AddFlash("Error saving user after updating player info and mode, while not grabbing new data for osu!standard", "settings_error")
-				session.Save(r, w)
-				http.Redirect(w, r, "/settings", 302)
-				return
-			}
-			log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
-			session.AddFlash("Successfully updated settings", "settings_success")
+	// Check the number of days between tweets when posting every few days
+	if postFrequencyValue == postFrequencyInterval {
+		postEveryDaysValue, err := strconv.Atoi(r.Form.Get("post_every_days"))
+		if err != nil || postEveryDaysValue < 2 || postEveryDaysValue > maxPostEveryDays {
+			session.AddFlash("The number of days between tweets must be between 2 and "+strconv.Itoa(maxPostEveryDays), "settings_error")
 			session.Save(r, w)
 			http.Redirect(w, r, "/settings", 302)
 			return
 		}
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " doesn't have data for mode " + allOsuModes[modeNumber] + ". Saving data, settings, and returning")
-		// They don't have any recent checks saved, we have to save one
-		osuRequest := &OsuRequest{
-			OsuPlayer:   dbOsuPlayer.GetId(),
-			DateChecked: time.Now().Unix(),
-			Data: OsuRequestData{
-				PlayerID:   osuPlayer.UserID,
-				PlayerName: osuPlayer.Username,
-				Counts: requestDataCounts{
-					Count50s:  osuPlayer.Count50,
-					Count100s: osuPlayer.Count100,
-					Count300s: osuPlayer.Count300,
-					SS:        osuPlayer.CountRankSS,
-					SSH:       osuPlayer.CountRankSSH,
-					S:         osuPlayer.CountRankS,
-					SH:        osuPlayer.CountRankSH,
-					A:         osuPlayer.CountRankA,
-					Plays:     osuPlayer.Playcount,
-				},
-				Scores: requestDataScores{
-					Ranked: osuPlayer.RankedScore,
-					Total:  osuPlayer.TotalScore,
-				},
-				PP: requestDataPP{
-					Raw:         osuPlayer.PP,
-					Rank:        osuPlayer.GlobalRank,
-					CountryRank: osuPlayer.CountryRank,
-				},
-				Country:  osuPlayer.Country,
-				Level:    osuPlayer.Level,
-				Accuracy: osuPlayer.Accuracy,
-			},
+		// Only restart the cycle when the rule changes, so saving other settings doesn't push the next tweet back
+		if previousFrequency != postFrequencyInterval || user.OsuSettings.PostSchedule.EveryDays != postEveryDaysValue || user.OsuSettings.PostSchedule.StartDate == 0 {
+			user.OsuSettings.PostSchedule.StartDate = time.Now().Unix()
 		}
-		err = connection.Collection("osurequestmodels").Save(osuRequest)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error saving new data entry for osu!standard", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		dbOsuPlayer.Modes.Standard.Checks = append(dbOsuPlayer.Modes.Standard.Checks, osuRequest.GetId())
-		err = connection.Collection("osuplayermodels").Save(dbOsuPlayer)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error osu! player with updated data", "settings_error")
+		user.OsuSettings.PostSchedule.EveryDays = postEveryDaysValue
+	}
+
+	// Check the thresholds for only posting when the player's stats changed
+	user.OsuSettings.SkipUnchanged.Enabled = r.Form.Get("skip_unchanged") == "on"
+	if user.OsuSettings.SkipUnchanged.Enabled {
+		minPlaysValue, err := strconv.Atoi(r.Form.Get("skip_min_plays"))
+		if err != nil || minPlaysValue < 1 {
+			session.AddFlash("The number of plays must be at least 1", "settings_error")
 			session.Save(r, w)
 			http.Redirect(w, r, "/settings", 302)
 			return
 		}
-		user.OsuSettings.Player = dbOsuPlayer.GetId()
-		err = connection.Collection("usermodels").Save(&user)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error updating information for user", "settings_error")
+		minPPValue, err := strconv.ParseFloat(r.Form.Get("skip_min_pp"), 64)
+		if err != nil || minPPValue < 0 || math.IsNaN(minPPValue) || math.IsInf(minPPValue, 0) {
+			session.AddFlash("The pp change can't be negative", "settings_error")
 			session.Save(r, w)
 			http.Redirect(w, r, "/settings", 302)
 			return
 		}
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
-		session.AddFlash("Successfully updated settings", "settings_success")
+		user.OsuSettings.SkipUnchanged.MinPlays = minPlaysValue
+		user.OsuSettings.SkipUnchanged.MinPP = minPPValue
+	}
+
+	// Check the milestones that get their own tweets
+	milestones, err := parseMilestoneRules(r.Form.Get("milestone_rank"), r.Form.Get("milestone_pp"), r.Form.Get("milestone_ss"))
+	if err != nil {
+		session.AddFlash(err.Error(), "settings_error")
 		session.Save(r, w)
 		http.Redirect(w, r, "/settings", 302)
 		return
-	} else if modeNumber == 1 {
-		// Taiko
-		// First check if they have any requests for this game mode at all
-		if len(dbOsuPlayer.Modes.Taiko.Checks) != 0 {
-			log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " has data for mode " + allOsuModes[modeNumber] + ". Saving settings and returning")
-			user.OsuSettings.Player = dbOsuPlayer.GetId()
-			err = connection.Collection("usermodels").Save(&user)
-			if err != nil {
-				captureError(err)
-				session.AddFlash("Error saving user after updating player info and mode, while not grabbing new data for osu!taiko", "settings_error")
-				session.Save(r, w)
-				http.Redirect(w, r, "/settings", 302)
-				return
-			}
-			log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
-			session.AddFlash("Successfully updated settings", "settings_success")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " doesn't have recent data for mode " + strconv.Itoa(modeNumber) + ". Saving data, settings, and returning")
-		// They don't have any recent checks saved, we have to save one
-		osuRequest := &OsuRequest{
-			OsuPlayer:   dbOsuPlayer.GetId(),
-			DateChecked: time.Now().Unix(),
-			Data: OsuRequestData{
-				PlayerID:   osuPlayer.UserID,
-				PlayerName: osuPlayer.Username,
-				Counts: requestDataCounts{
-					Count50s:  osuPlayer.Count50,
-					Count100s: osuPlayer.Count100,
-					Count300s: osuPlayer.Count300,
-					SS:        osuPlayer.CountRankSS,
-					SSH:       osuPlayer.CountRankSSH,
-					S:         osuPlayer.CountRankS,
-					SH:        osuPlayer.CountRankSH,
-					A:         osuPlayer.CountRankA,
-					Plays:     osuPlayer.Playcount,
-				},
-				Scores: requestDataScores{
-					Ranked: osuPlayer.RankedScore,
-					Total:  osuPlayer.TotalScore,
-				},
-				PP: requestDataPP{
-					Raw:         osuPlayer.PP,
-					Rank:        osuPlayer.GlobalRank,
-					CountryRank: osuPlayer.CountryRank,
-				},
-				Country:  osuPlayer.Country,
-				Level:    osuPlayer.Level,
-				Accuracy: osuPlayer.Accuracy,
-			},
-		}
-		err = connection.Collection("osurequestmodels").Save(osuRequest)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error saving new data entry for osu!taiko", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		dbOsuPlayer.Modes.Taiko.Checks = append(dbOsuPlayer.Modes.Taiko.Checks, osuRequest.GetId())
-		err = connection.Collection("osuplayermodels").Save(dbOsuPlayer)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error osu! player with updated data", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		user.OsuSettings.Player = dbOsuPlayer.GetId()
-		err = connection.Collection("usermodels").Save(&user)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error updating information for user", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
-		session.AddFlash("Successfully updated settings", "settings_success")
+	}
+	user.OsuSettings.Milestones = milestones
+
+	// Check the card theme
+	theme := r.Form.Get("theme")
+	if theme == "" {
+		theme = defaultThemeID
+	}
+	if !isThemeID(theme) {
+		session.AddFlash("Invalid card theme", "settings_error")
 		session.Save(r, w)
 		http.Redirect(w, r, "/settings", 302)
 		return
-	} else if modeNumber == 2 {
-		// CTB
-		// First check if they have any requests for this game mode at all
-		if len(dbOsuPlayer.Modes.CTB.Checks) != 0 {
-			log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " has data for mode " + allOsuModes[modeNumber] + ". Saving settings and returning")
-			user.OsuSettings.Player = dbOsuPlayer.GetId()
-			err = connection.Collection("usermodels").Save(&user)
-			if err != nil {
-				captureError(err)
-				session.AddFlash("Error saving user after updating player info and mode, while not grabbing new data for osu!catch", "settings_error")
-				session.Save(r, w)
-				http.Redirect(w, r, "/settings", 302)
-				return
-			}
-			log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
-			session.AddFlash("Successfully updated settings", "settings_success")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " doesn't have data for mode " + allOsuModes[modeNumber] + ". Saving data, settings, and returning")
-		// They don't have any recent checks saved, we have to save one
-		osuRequest := &OsuRequest{
-			OsuPlayer:   dbOsuPlayer.GetId(),
-			DateChecked: time.Now().Unix(),
-			Data: OsuRequestData{
-				PlayerID:   osuPlayer.UserID,
-				PlayerName: osuPlayer.Username,
-				Counts: requestDataCounts{
-					Count50s:  osuPlayer.Count50,
-					Count100s: osuPlayer.Count100,
-					Count300s: osuPlayer.Count300,
-					SS:        osuPlayer.CountRankSS,
-					SSH:       osuPlayer.CountRankSSH,
-					S:         osuPlayer.CountRankS,
-					SH:        osuPlayer.CountRankSH,
-					A:         osuPlayer.CountRankA,
-					Plays:     osuPlayer.Playcount,
-				},
-				Scores: requestDataScores{
-					Ranked: osuPlayer.RankedScore,
-					Total:  osuPlayer.TotalScore,
-				},
-				PP: requestDataPP{
-					Raw:         osuPlayer.PP,
-					Rank:        osuPlayer.GlobalRank,
-					CountryRank: osuPlayer.CountryRank,
-				},
-				Country:  osuPlayer.Country,
-				Level:    osuPlayer.Level,
-				Accuracy: osuPlayer.Accuracy,
-			},
-		}
-		err = connection.Collection("osurequestmodels").Save(osuRequest)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error saving new data entry for osu!catch", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		dbOsuPlayer.Modes.CTB.Checks = append(dbOsuPlayer.Modes.CTB.Checks, osuRequest.GetId())
-		err = connection.Collection("osuplayermodels").Save(dbOsuPlayer)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error osu! player with updated data", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		user.OsuSettings.Player = dbOsuPlayer.GetId()
-		err = connection.Collection("usermodels").Save(&user)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error updating information for user", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
-		session.AddFlash("Successfully updated settings", "settings_success")
+	}
+	user.OsuSettings.Theme = theme
+
+	// Check the stat rows, which have to fit on the theme
+	rows, err := parseStatRows(r.Form["stat_row"])
+	if err != nil {
+		session.AddFlash(err.Error(), "settings_error")
 		session.Save(r, w)
 		http.Redirect(w, r, "/settings", 302)
 		return
-	} else if modeNumber == 3 {
-		// Mania
-		// First check if they have any requests for this game mode at all
-		if len(dbOsuPlayer.Modes.Mania.Checks) != 0 {
-			log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " has data for mode " + allOsuModes[modeNumber] + ". Saving settings and returning")
-			user.OsuSettings.Player = dbOsuPlayer.GetId()
-			err = connection.Collection("usermodels").Save(&user)
-			if err != nil {
-				captureError(err)
-				session.AddFlash("Error saving user after updating player info and mode, while not grabbing new data for osu!mania", "settings_error")
-				session.Save(r, w)
-				http.Redirect(w, r, "/settings", 302)
-				return
-			}
-			log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
-			session.AddFlash("Successfully updated settings", "settings_success")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + dbOsuPlayer.PlayerName + " doesn't have data for mode " + allOsuModes[modeNumber] + ". Saving data, settings, and returning")
-		// They don't have any recent checks saved, we have to save one
-		osuRequest := &OsuRequest{
-			OsuPlayer:   dbOsuPlayer.GetId(),
-			DateChecked: time.Now().Unix(),
-			Data: OsuRequestData{
-				PlayerID:   osuPlayer.UserID,
-				PlayerName: osuPlayer.Username,
-				Counts: requestDataCounts{
-					Count50s:  osuPlayer.Count50,
-					Count100s: osuPlayer.Count100,
-					Count300s: osuPlayer.Count300,
-					SS:        osuPlayer.CountRankSS,
-					SSH:       osuPlayer.CountRankSSH,
-					S:         osuPlayer.CountRankS,
-					SH:        osuPlayer.CountRankSH,
-					A:         osuPlayer.CountRankA,
-					Plays:     osuPlayer.Playcount,
-				},
-				Scores: requestDataScores{
-					Ranked: osuPlayer.RankedScore,
-					Total:  osuPlayer.TotalScore,
-				},
-				PP: requestDataPP{
-					Raw:         osuPlayer.PP,
-					Rank:        osuPlayer.GlobalRank,
-					CountryRank: osuPlayer.CountryRank,
-				},
-				Country:  osuPlayer.Country,
-				Level:    osuPlayer.Level,
-				Accuracy: osuPlayer.Accuracy,
-			},
-		}
-		err = connection.Collection("osurequestmodels").Save(osuRequest)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error saving new data entry for osu!mania", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		dbOsuPlayer.Modes.Mania.Checks = append(dbOsuPlayer.Modes.Mania.Checks, osuRequest.GetId())
-		err = connection.Collection("osuplayermodels").Save(dbOsuPlayer)
-		if err != nil {
-			captureError(err)
-			session.AddFlash("Error osu! player with updated data", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
-		}
-		user.OsuSettings.Player = dbOsuPlayer.GetId()
-		err = connection.Collection("usermodels").Save(&user)
-		if err != nil {
+	}
+	if cardTheme := themeByID(theme); len(rows) > cardTheme.MaxRows() {
+		session.AddFlash("The "+cardTheme.Name+" theme fits up to "+strconv.Itoa(cardTheme.MaxRows())+" stats", "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+	user.OsuSettings.StatRows = rows
+
+	user.OsuSettings.ShowHistory = r.Form.Get("show_history") == "on"
+
+	// The schedule may have changed, so work out when the next tweet is due. Every branch below saves the user
+	if err := scheduleNextPost(&user, time.Now()); err != nil {
+		captureError(err)
+		session.AddFlash("Error scheduling your next tweet", "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+
+	// Get osu! player information. Recent data for the player is reused, otherwise it's fetched and saved so the first tweet has
+	// something to compare to
+	snap, err := snapshots.ForName(api, playerName, modeNumber, snapshotMaxAge)
+	if err != nil {
+		if err == errSnapshotNotFound {
+			session.AddFlash("Couldn't find a user with the specified name and game mode", "settings_error")
+		} else {
 			captureError(err)
-			session.AddFlash("Error updating information for user", "settings_error")
-			session.Save(r, w)
-			http.Redirect(w, r, "/settings", 302)
-			return
+			session.AddFlash("Error getting user information", "settings_error")
 		}
-		log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
-		session.AddFlash("Successfully updated settings", "settings_success")
 		session.Save(r, w)
 		http.Redirect(w, r, "/settings", 302)
 		return
 	}
+	if snap.Fetched {
+		log.Debug("User " + user.Twitter.Profile.Handle + "'s player " + snap.Player.PlayerName + " didn't have recent data for mode " + allOsuModes[modeNumber] + ", saved new data")
+	}
+
+	user.OsuSettings.Player = snap.Player.GetId()
+	err = connection.Collection("usermodels").Save(&user)
+	if err != nil {
+		captureError(err)
+		session.AddFlash("Error saving final settings", "settings_error")
+		session.Save(r, w)
+		http.Redirect(w, r, "/settings", 302)
+		return
+	}
+	log.Debug("User " + user.Twitter.Profile.Handle + "'s settings are now updated, returning")
+	session.AddFlash("Successfully updated settings", "settings_success")
+	session.Save(r, w)
+	http.Redirect(w, r, "/settings", 302)
 }
 
 func translateSettingsPage(localizer *i18n.Localizer, isAuthenticated bool, user User) settingsPageTranslations {
@@ -862,8 +731,135 @@ func translateSettingsPage(localizer *i18n.Localizer, isAuthenticated bool, user
 		MessageID: "SettingsPostFrequencyMonthly",
 	})
 
-	currentUTCTimeLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
-		MessageID: "SettingsCurrentUTCTimeLabel",
+	postFrequencyWeekdays := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPostFrequencyWeekdays",
+	})
+
+	postFrequencyInterval := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPostFrequencyInterval",
+	})
+
+	postWeekdayLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPostWeekdayLabel",
+	})
+
+	postWeekdaysLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPostWeekdaysLabel",
+	})
+
+	postEveryDaysLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPostEveryDaysLabel",
+	})
+
+	postMonthDayLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPostMonthDayLabel",
+	})
+
+	var weekdays [7]string
+	for i, weekday := range []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"} {
+		weekdays[i] = localizer.MustLocalize(&i18n.LocalizeConfig{
+			MessageID: "SettingsWeekday" + weekday,
+		})
+	}
+
+	nextPostAtLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsNextPostAtLabel",
+	})
+
+	skipUnchangedLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsSkipUnchangedLabel",
+	})
+
+	skipMinPlaysLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsSkipMinPlaysLabel",
+	})
+
+	skipMinPPLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsSkipMinPPLabel",
+	})
+
+	milestonesLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsMilestonesLabel",
+	})
+
+	milestonesHelp := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsMilestonesHelp",
+	})
+
+	milestoneRankLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsMilestoneRankLabel",
+	})
+
+	milestonePPLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsMilestonePPLabel",
+	})
+
+	milestoneSSLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsMilestoneSSLabel",
+	})
+
+	postNowButton := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPostNowButton",
+	})
+
+	previewCardButton := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPreviewCardButton",
+	})
+
+	postNowAvailableAtLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsPostNowAvailableAtLabel",
+	})
+
+	timeZoneLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsTimeZoneLabel",
+	})
+
+	useBrowserTimeZone := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsUseBrowserTimeZone",
+	})
+
+	currentLocalTimeLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsCurrentLocalTimeLabel",
+	})
+
+	// Why we turned the user's tweets off, by the DisabledReason stored on the user
+	disabledReasons := map[string]string{
+		twitterErrorLocked: localizer.MustLocalize(&i18n.LocalizeConfig{
+			MessageID: "SettingsDisabledReasonLocked",
+		}),
+		twitterErrorExpiredToken: localizer.MustLocalize(&i18n.LocalizeConfig{
+			MessageID: "SettingsDisabledReasonExpiredToken",
+		}),
+		twitterErrorSuspended: localizer.MustLocalize(&i18n.LocalizeConfig{
+			MessageID: "SettingsDisabledReasonSuspended",
+		}),
+		disabledReasonInvalidCredentials: localizer.MustLocalize(&i18n.LocalizeConfig{
+			MessageID: "SettingsDisabledReasonInvalidCredentials",
+		}),
+	}
+
+	dismissNoticeButton := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsDismissNoticeButton",
+	})
+
+	themeLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsThemeLabel",
+	})
+
+	statRowsLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsStatRowsLabel",
+	})
+
+	statRowsHelp := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsStatRowsHelp",
+	})
+
+	statRowNone := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsStatRowNone",
+	})
+
+	showHistoryLabel := localizer.MustLocalize(&i18n.LocalizeConfig{
+		MessageID: "SettingsShowHistoryLabel",
 	})
 
 	return settingsPageTranslations{
@@ -884,6 +880,34 @@ func translateSettingsPage(localizer *i18n.Localizer, isAuthenticated bool, user
 		PostFrequencyDaily:         postFrequencyDaily,
 		PostFrequencyWeekly:        postFrequencyWeekly,
 		PostFrequencyMonthly:       postFrequencyMonthly,
-		CurrentUTCTimeLabel:        currentUTCTimeLabel,
+		PostFrequencyWeekdays:      postFrequencyWeekdays,
+		PostFrequencyInterval:      postFrequencyInterval,
+		PostWeekdayLabel:           postWeekdayLabel,
+		PostWeekdaysLabel:          postWeekdaysLabel,
+		PostEveryDaysLabel:         postEveryDaysLabel,
+		PostMonthDayLabel:          postMonthDayLabel,
+		Weekdays:                   weekdays,
+		NextPostAtLabel:            nextPostAtLabel,
+		SkipUnchangedLabel:         skipUnchangedLabel,
+		SkipMinPlaysLabel:          skipMinPlaysLabel,
+		SkipMinPPLabel:             skipMinPPLabel,
+		MilestonesLabel:            milestonesLabel,
+		MilestonesHelp:             milestonesHelp,
+		MilestoneRankLabel:         milestoneRankLabel,
+		MilestonePPLabel:           milestonePPLabel,
+		MilestoneSSLabel:           milestoneSSLabel,
+		PostNowButton:              postNowButton,
+		PreviewCardButton:          previewCardButton,
+		PostNowAvailableAtLabel:    postNowAvailableAtLabel,
+		TimeZoneLabel:              timeZoneLabel,
+		UseBrowserTimeZone:         useBrowserTimeZone,
+		CurrentLocalTimeLabel:      currentLocalTimeLabel,
+		DisabledReasons:            disabledReasons,
+		DismissNoticeButton:        dismissNoticeButton,
+		ThemeLabel:                 themeLabel,
+		StatRowsLabel:              statRowsLabel,
+		StatRowsHelp:               statRowsHelp,
+		StatRowNone:                statRowNone,
+		ShowHistoryLabel:           showHistoryLabel,
 	}
 }
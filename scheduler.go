@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+const postingSchedulerName = "posting"
+
+// Hours older than this are not caught up after downtime, so a long outage doesn't end in a burst of stale tweets
+var schedulerMaxLookback = 6
+
+// Makes sure the cron and a freshly elected leader never run the posting function at the same time
+var schedulerMutex sync.Mutex
+
+func init() {
+	if lookback := os.Getenv("SCHEDULER_MAX_LOOKBACK_HOURS"); lookback != "" {
+		n, err := strconv.Atoi(lookback)
+		if err != nil || n < 0 {
+			panic(errors.New("SCHEDULER_MAX_LOOKBACK_HOURS must be a number that isn't negative"))
+		}
+		schedulerMaxLookback = n
+	}
+}
+
+// runScheduledPosting queues every tweet that is due, including ones that were missed while nobody was posting
+func runScheduledPosting() {
+	schedulerMutex.Lock()
+	defer schedulerMutex.Unlock()
+
+	if isMaintenance {
+		gLog("Ignoring tweet posting because we are in maintenance mode")
+		return
+	}
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	lastCompleted, err := getLastCompletedHour(postingSchedulerName)
+	if err != nil {
+		gError("Failed to get the last hour tweets were posted for")
+		captureError(err)
+		return
+	}
+
+	staleBefore := lookbackStart(now, lastCompleted)
+	if lastCompleted != 0 && lastCompleted < now.Add(-time.Hour).Unix() {
+		// Users whose tweets were due while nobody was posting are still due, so this run picks them up
+		missed := int(now.Sub(time.Unix(lastCompleted, 0))/time.Hour) - 1
+		gLog("Catching up on " + strconv.Itoa(missed) + " missed hour(s), back to " + staleBefore.Format(time.RFC3339))
+	}
+
+	if err := findAndGenerate(now, staleBefore); err != nil {
+		gError("Stopped posting tweets for hour " + strconv.Itoa(now.Hour()) + ": " + err.Error())
+		return
+	}
+	if err := setLastCompletedHour(postingSchedulerName, now.Unix()); err != nil {
+		gError("Failed to save the last hour tweets were posted for")
+		captureError(err)
+	}
+}
+
+// lookbackStart returns the earliest slot a run for the hour still posts. Slots after the last completed hour were missed and are
+// caught up, but never more than schedulerMaxLookback hours of them. Slots up to the last completed hour were already handled by
+// that run, so anything still due from before it is stale
+func lookbackStart(now time.Time, lastCompleted int64) time.Time {
+	start := now.Add(-time.Duration(schedulerMaxLookback) * time.Hour)
+	if lastCompleted != 0 && lastCompleted > start.Unix() {
+		completed := time.Unix(lastCompleted, 0).UTC()
+		if completed.After(now) {
+			return now
+		}
+		return completed
+	}
+	return start
+}
+
+func getLastCompletedHour(name string) (int64, error) {
+	state := &SchedulerState{}
+	err := connection.Collection("schedulerstatemodels").Collection().Find(bson.M{"name": name}).One(state)
+	if err == mgo.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return state.LastCompletedHour, nil
+}
+
+func setLastCompletedHour(name string, hour int64) error {
+	now := time.Now()
+	_, err := connection.Collection("schedulerstatemodels").Collection().Upsert(
+		bson.M{"name": name},
+		bson.M{
+			"$set":         bson.M{"lastCompletedHour": hour, "_modified": now},
+			"$setOnInsert": bson.M{"_created": now},
+		},
+	)
+	return err
+}
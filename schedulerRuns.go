@@ -0,0 +1,114 @@
+package main
+
+import (
+	"time"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// setupSchedulerRuns creates the indexes the admin pages rely on
+func setupSchedulerRuns() {
+	err := connection.Collection("schedulerrunmodels").Collection().EnsureIndex(mgo.Index{
+		Key: []string{"-hour"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	outcomes := connection.Collection("schedulerrunoutcomemodels").Collection()
+	err = outcomes.EnsureIndex(mgo.Index{
+		Key: []string{"run", "outcome"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = outcomes.EnsureIndex(mgo.Index{
+		Key: []string{"handle", "-_created"},
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// startSchedulerRun saves a new run for the hour
+func startSchedulerRun(hour time.Time) (*SchedulerRun, error) {
+	run := &SchedulerRun{
+		Hour:      hour.Unix(),
+		StartedAt: time.Now().Unix(),
+	}
+	err := connection.Collection("schedulerrunmodels").Save(run)
+	return run, err
+}
+
+// finishSchedulerRun saves the run's counts once every job has been queued. runErr is why the run stopped early, if it did
+func finishSchedulerRun(run *SchedulerRun, runErr error) {
+	run.FinishedAt = time.Now().Unix()
+	if runErr != nil {
+		run.Error = runErr.Error()
+	}
+	// The job counts are updated by workers while the run is going, so they are left alone
+	err := connection.Collection("schedulerrunmodels").Collection().UpdateId(run.GetId(), bson.M{"$set": bson.M{
+		"finishedAt": run.FinishedAt,
+		"candidates": run.Candidates,
+		"filtered":   run.Filtered,
+		"queued":     run.Queued,
+		"error":      run.Error,
+		"_modified":  time.Now(),
+	}})
+	if err != nil {
+		gError("Failed to save the scheduler run")
+		captureError(err)
+	}
+}
+
+// recordRunOutcome saves what happened to the user in the run, replacing anything recorded for them earlier in the run
+func recordRunOutcome(run *SchedulerRun, due dueUser, outcome string, reason string) {
+	now := time.Now()
+	_, err := connection.Collection("schedulerrunoutcomemodels").Collection().Upsert(
+		bson.M{"run": run.GetId(), "user": due.ID},
+		bson.M{
+			"$set":         bson.M{"outcome": outcome, "reason": reason, "_modified": now},
+			"$setOnInsert": bson.M{"handle": due.Handle, "slot": due.Slot, "_created": now},
+		},
+	)
+	if err != nil {
+		gError("Failed to save the outcome for @" + due.Handle)
+		captureError(err)
+	}
+}
+
+// updateRunOutcome records the result of an attempt at a job queued by a scheduler run. Final outcomes also count towards the run
+func updateRunOutcome(job *PostJob, outcome string, reason string) {
+	if job.Run == "" {
+		return
+	}
+	now := time.Now()
+	err := connection.Collection("schedulerrunoutcomemodels").Collection().Update(
+		bson.M{"run": job.Run, "user": job.User},
+		bson.M{"$set": bson.M{"outcome": outcome, "reason": reason, "_modified": now}},
+	)
+	if err != nil && err != mgo.ErrNotFound {
+		qError("Failed to update the run outcome for job " + job.GetId().Hex())
+		captureError(err)
+	}
+
+	counter := ""
+	switch outcome {
+	case outcomePosted:
+		counter = "posted"
+	case outcomeSkipped:
+		counter = "skipped"
+	case outcomeFailed:
+		counter = "failed"
+	default:
+		return
+	}
+	err = connection.Collection("schedulerrunmodels").Collection().UpdateId(job.Run, bson.M{
+		"$inc": bson.M{counter: 1},
+		"$set": bson.M{"_modified": now},
+	})
+	if err != nil && err != mgo.ErrNotFound {
+		qError("Failed to update the counts for run " + job.Run.Hex())
+		captureError(err)
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+// How long shutting down can take before we stop waiting for in-flight work. Heroku kills the process 30 seconds after SIGTERM
+var shutdownTimeout = 25 * time.Second
+
+func init() {
+	if timeout := os.Getenv("SHUTDOWN_TIMEOUT_SECONDS"); timeout != "" {
+		n, err := strconv.Atoi(timeout)
+		if err != nil || n < 1 {
+			panic(errors.New("SHUTDOWN_TIMEOUT_SECONDS must be a positive number"))
+		}
+		shutdownTimeout = time.Duration(n) * time.Second
+	}
+}
+
+func sLog(msg string) {
+	log.Info("[SHUTDOWN] " + msg)
+}
+
+func sError(msg string) {
+	log.Error("[SHUTDOWN] " + msg)
+}
+
+// waitForShutdown blocks until the process is asked to stop
+func waitForShutdown() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	sig := <-signals
+	sLog("Received " + sig.String() + ", shutting down")
+}
+
+// shutdown stops taking new work, waits until the deadline for posts and stat collection that are in flight, then closes the
+// connections to Mongo and Redis. Anything still running at the deadline is left for another replica, and the connections are left open
+// for it until the process exits
+func shutdown(servers []*http.Server, c *cron.Cron) {
+	deadline := time.Now().Add(shutdownTimeout)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	// Stop accepting requests, letting the ones in progress finish
+	for _, server := range servers {
+		if err := server.Shutdown(ctx); err != nil {
+			sError("Failed to stop the HTTP server on " + server.Addr + ": " + err.Error())
+		}
+	}
+	sLog("Stopped accepting HTTP requests")
+
+	// Stop new posting runs, and wait for one that already started to finish queueing
+	c.Stop()
+	if lockBefore(&schedulerMutex, deadline) {
+		sLog("Stopped the scheduler")
+	} else {
+		sError("Gave up waiting for the posting run to finish")
+	}
+	leader.Release()
+
+	for _, stop := range homeTotalsStops {
+		close(stop)
+	}
+
+	drained := pool.Drain(deadline)
+	if drained {
+		sLog("Finished in-flight posts")
+	} else {
+		sError("Gave up waiting for in-flight posts, they will be retried")
+	}
+	collectorStopped := stopCollector(deadline)
+	if collectorStopped {
+		sLog("Stopped the stat collector")
+	} else {
+		sError("Gave up waiting for the stat collector to stop")
+	}
+
+	// Work that is still running would panic on closed connections. The process exits soon anyway, which closes them
+	if !drained || !collectorStopped {
+		sError("Leaving the connections to Mongo and Redis open for work that is still running")
+		return
+	}
+	if err := sessionStore.Close(); err != nil {
+		sError("Failed to close the Redis pool: " + err.Error())
+	}
+	connection.Session.Close()
+	sLog("Closed connections to Mongo and Redis")
+}
+
+// lockBefore locks the mutex, unless that takes until the deadline. Returns whether it was locked. If it wasn't, the mutex is
+// locked whenever it frees up, so nothing else gets it
+func lockBefore(mutex *sync.Mutex, deadline time.Time) bool {
+	locked := make(chan bool)
+	go func() {
+		mutex.Lock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		return true
+	case <-time.After(time.Until(deadline)):
+		return false
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockBefore(t *testing.T) {
+	t.Run("free", func(t *testing.T) {
+		var mutex sync.Mutex
+		if !lockBefore(&mutex, time.Now().Add(time.Second)) {
+			t.Fatal("a free mutex wasn't locked")
+		}
+	})
+	t.Run("freed before the deadline", func(t *testing.T) {
+		var mutex sync.Mutex
+		mutex.Lock()
+		time.AfterFunc(20*time.Millisecond, mutex.Unlock)
+		if !lockBefore(&mutex, time.Now().Add(5*time.Second)) {
+			t.Fatal("the mutex wasn't locked once it was freed")
+		}
+	})
+	t.Run("held past the deadline", func(t *testing.T) {
+		var mutex sync.Mutex
+		mutex.Lock()
+		if lockBefore(&mutex, time.Now().Add(20*time.Millisecond)) {
+			t.Fatal("got a mutex that was never freed")
+		}
+		// Whoever held it finishes after the deadline. The mutex is taken then, so nothing new can start
+		mutex.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		locked := make(chan bool)
+		go func() {
+			mutex.Lock()
+			close(locked)
+		}()
+		select {
+		case <-locked:
+			t.Fatal("the mutex was free after the deadline")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+}
+
+func TestPostingPoolDrain(t *testing.T) {
+	// A pool without workers, with slots filled by hand to stand in for jobs that are running
+	newPool := func(running int) *postingPool {
+		p := &postingPool{slots: make(chan struct{}, 3), queue: make(chan *PostJob, 3)}
+		for i := 0; i < running; i++ {
+			p.slots <- struct{}{}
+		}
+		return p
+	}
+	t.Run("nothing running", func(t *testing.T) {
+		if !newPool(0).Drain(time.Now().Add(time.Second)) {
+			t.Fatal("an idle pool didn't drain")
+		}
+	})
+	t.Run("jobs finish before the deadline", func(t *testing.T) {
+		p := newPool(2)
+		time.AfterFunc(20*time.Millisecond, func() {
+			<-p.slots
+			<-p.slots
+		})
+		if !p.Drain(time.Now().Add(5 * time.Second)) {
+			t.Fatal("the pool didn't drain once its jobs finished")
+		}
+	})
+	t.Run("job still running at the deadline", func(t *testing.T) {
+		p := newPool(1)
+		if p.Drain(time.Now().Add(20 * time.Millisecond)) {
+			t.Fatal("the pool drained with a job still running")
+		}
+	})
+	t.Run("stops claiming jobs", func(t *testing.T) {
+		p := newPool(0)
+		p.Drain(time.Now().Add(time.Second))
+		select {
+		case p.slots <- struct{}{}:
+			t.Fatal("a drained pool had a free slot to claim a job with")
+		default:
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+// lastPostedCheck returns the OsuRequest the user's last tweet showed, as long as it was for the player and mode they have now
+func lastPostedCheck(user *User) bson.ObjectId {
+	if len(user.TweetHistory) == 0 {
+		return ""
+	}
+	// Tweets from before checks were recorded don't have one
+	last := user.TweetHistory[len(user.TweetHistory)-1]
+	if last.Player != user.OsuSettings.Player || last.Mode != user.OsuSettings.Mode {
+		return ""
+	}
+	return last.Check
+}
+
+// compareToLastPost decides whether a user who only wants tweets when their stats changed should be posted for. Skipped tweets
+// don't count as posts, so the stats are compared to the last tweet that was actually posted rather than the last check. The
+// collector saves checks between tweets too, so every tweet compares to the last one. It returns the snapshot to compare against,
+// and a reason if the tweet should be skipped
+func compareToLastPost(user *User, previousRequest *OsuRequest, newRequest *OsuRequest, l pLogger) (*OsuRequest, string) {
+	baseline := previousRequest
+	if check := lastPostedCheck(user); check != "" && check != newRequest.GetId() {
+		lastPosted := &OsuRequest{}
+		err := connection.Collection("osurequestmodels").FindById(check, lastPosted)
+		if err != nil {
+			l.Error("Failed to grab the check from the user's last tweet, comparing to the previous check instead")
+		} else {
+			baseline = lastPosted
+		}
+	}
+	settings := user.OsuSettings.SkipUnchanged
+	if !settings.Enabled {
+		return baseline, ""
+	}
+
+	playsGained := newRequest.Data.Counts.Plays - baseline.Data.Counts.Plays
+	ppChange := math.Abs(float64(newRequest.Data.PP.Raw - baseline.Data.PP.Raw))
+	minPlays := settings.MinPlays
+	if minPlays < 1 {
+		minPlays = 1
+	}
+	if playsGained >= minPlays {
+		return baseline, ""
+	}
+	if settings.MinPP > 0 && ppChange >= settings.MinPP {
+		return baseline, ""
+	}
+	reason := "play count went up by " + strconv.Itoa(playsGained) + " (needs " + strconv.Itoa(minPlays) + ")"
+	if settings.MinPP > 0 {
+		reason += " and pp changed by " + strconv.FormatFloat(ppChange, 'f', 2, 64) + " (needs " + strconv.FormatFloat(settings.MinPP, 'f', 2, 64) + ")"
+	}
+	return baseline, reason
+}
+
+// skipPost tries the user's tweet again at their next slot, following their own frequency so it's only ever posted on a day they
+// picked. The user is saved
+func skipPost(user *User, reason string, l pLogger) error {
+	l.Log("Not posting because the player's stats haven't changed enough: " + reason)
+	if err := scheduleNextPost(user, time.Now()); err != nil {
+		return err
+	}
+	return connection.Collection("usermodels").Collection().UpdateId(user.GetId(), bson.M{"$set": bson.M{"nextPostAt": user.NextPostAt}})
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/go-bongo/bongo"
+	osuapi "github.com/wcalandro/osuapi-go"
+)
+
+// Checks newer than this are reused instead of asking the osu! API again
+const snapshotMaxAge = 3 * time.Hour
+
+// errSnapshotNotFound is returned when the osu! API has no player with the name or ID in the mode
+var errSnapshotNotFound = errors.New("the osu! API didn't return a player")
+
+// Every fetch of osu! data goes through this, so that players tracked by several users are only fetched once
+var snapshots = newSnapshotService()
+
+// snapshot - A player's newest check in a mode, and the one before it
+type snapshot struct {
+	Player   *OsuPlayer
+	Previous *OsuRequest // nil if the player had no checks in the mode
+	Latest   *OsuRequest
+	Fetched  bool // Whether Latest was fetched just now, rather than reused
+}
+
+// snapshotService - Fetches osu! data for a player and mode only when the saved data is too old, and makes callers who ask for the
+// same player and mode at the same time share one fetch
+type snapshotService struct {
+	mu    sync.Mutex
+	calls map[string]*snapshotCall
+}
+
+// snapshotCall - A fetch that is in flight, which later callers wait for
+type snapshotCall struct {
+	done   chan struct{}
+	result *snapshot
+	err    error
+}
+
+func newSnapshotService() *snapshotService {
+	return &snapshotService{
+		calls: map[string]*snapshotCall{},
+	}
+}
+
+// do runs fn, unless it is already running for key, in which case it waits for that run and returns its result
+func (s *snapshotService) do(key string, fn func() (*snapshot, error)) (*snapshot, error) {
+	s.mu.Lock()
+	if call, ok := s.calls[key]; ok {
+		s.mu.Unlock()
+		<-call.done
+		return call.result, call.err
+	}
+	call := &snapshotCall{done: make(chan struct{})}
+	s.calls[key] = call
+	s.mu.Unlock()
+
+	// Deferred so the waiters are let go and the key is freed whatever happens in fn
+	defer func() {
+		s.mu.Lock()
+		delete(s.calls, key)
+		s.mu.Unlock()
+		close(call.done)
+	}()
+	call.result, call.err = runSnapshotFunc(fn)
+	return call.result, call.err
+}
+
+// runSnapshotFunc runs fn, turning a panic in the osu! API or image code into an error that every caller waiting on it gets
+func runSnapshotFunc(fn func() (*snapshot, error)) (result *snapshot, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = errors.New("fetching the snapshot panicked: " + fmt.Sprint(r))
+		}
+	}()
+	return fn()
+}
+
+// Latest returns the player's newest check in the mode, fetching and saving new data through the limiter if it is older than maxAge.
+// In a dry run new data is still fetched, but nothing is saved
+func (s *snapshotService) Latest(limiter osuRateLimiter, playerID bson.ObjectId, mode int, maxAge time.Duration, dryRun bool) (*snapshot, error) {
+	player := &OsuPlayer{}
+	if err := connection.Collection("osuplayermodels").FindById(playerID, player); err != nil {
+		return nil, err
+	}
+	key := player.UserID + ":" + strconv.Itoa(mode)
+	if dryRun {
+		key += ":dry run"
+	}
+	return s.do(key, func() (*snapshot, error) {
+		return s.refresh(limiter, playerID, mode, maxAge, dryRun, nil)
+	})
+}
+
+// ForName returns the newest check in the mode for the player with the name, adding the player if we haven't seen them before. Names
+// can change hands, so the osu! API always decides who the name belongs to
+func (s *snapshotService) ForName(limiter osuRateLimiter, name string, mode int, maxAge time.Duration) (*snapshot, error) {
+	return s.do("name:"+strings.ToLower(name)+":"+strconv.Itoa(mode), func() (*snapshot, error) {
+		data, err := limiter.GetUser(osuapi.M{"u": name, "m": strconv.Itoa(mode)})
+		if err != nil {
+			return nil, err
+		}
+		if data == nil {
+			return nil, errSnapshotNotFound
+		}
+		player, err := findOrCreatePlayer(data)
+		if err != nil {
+			return nil, err
+		}
+		// The user ID is the same key Latest uses, so this waits for a fetch of the same player that's already going
+		return s.do(player.UserID+":"+strconv.Itoa(mode), func() (*snapshot, error) {
+			return s.refresh(limiter, player.GetId(), mode, maxAge, false, data)
+		})
+	})
+}
+
+// refresh does the work for Latest. If data was already fetched it is saved instead of fetching again, unless another caller saved
+// something recent enough first
+func (s *snapshotService) refresh(limiter osuRateLimiter, playerID bson.ObjectId, mode int, maxAge time.Duration, dryRun bool, data *osuapi.User) (*snapshot, error) {
+	// Fetches for other keys, like the player's old name, may be saving checks for the same player
+	unlock := playerLocks.Lock(playerID.Hex())
+	defer unlock()
+
+	player := &OsuPlayer{}
+	if err := connection.Collection("osuplayermodels").FindById(playerID, player); err != nil {
+		return nil, err
+	}
+	checks := player.ChecksForMode(mode)
+	var lastCheck *OsuRequest
+	if len(checks) != 0 {
+		lastCheck = &OsuRequest{}
+		if err := connection.Collection("osurequestmodels").FindById(checks[len(checks)-1], lastCheck); err != nil {
+			return nil, err
+		}
+	}
+
+	// A tweet needs two checks to compare, so a check on its own is never recent enough. Checks from the old site are in milliseconds
+	if lastCheck != nil && len(checks) > 1 && time.Now().Unix()-lastCheck.DateChecked <= int64(maxAge/time.Second) && lastCheck.DateChecked < 1500000000000 {
+		previous := &OsuRequest{}
+		if err := connection.Collection("osurequestmodels").FindById(checks[len(checks)-2], previous); err != nil {
+			return nil, err
+		}
+		return &snapshot{Player: player, Previous: previous, Latest: lastCheck}, nil
+	}
+
+	if data == nil {
+		var err error
+		data, err = limiter.GetUser(osuapi.M{"u": player.UserID, "m": strconv.Itoa(mode)})
+		if err != nil {
+			return nil, err
+		}
+		if data == nil {
+			return nil, errSnapshotNotFound
+		}
+	}
+	request := createRequest(player.GetId(), data)
+	if dryRun {
+		return &snapshot{Player: player, Previous: lastCheck, Latest: request, Fetched: true}, nil
+	}
+	if err := saveOsuRequest(request, mode, lastCheck); err != nil {
+		return nil, err
+	}
+	update := bson.M{"lastChecked": request.DateChecked, "_modified": time.Now()}
+	if data.Username != "" && data.Username != player.PlayerName {
+		update["name"] = data.Username
+		player.PlayerName = data.Username
+	}
+	err := connection.Collection("osuplayermodels").Collection().UpdateId(player.GetId(), bson.M{
+		"$push": bson.M{modeCheckFields[mode]: request.GetId()},
+		"$set":  update,
+	})
+	if err != nil {
+		return nil, err
+	}
+	player.SetChecksForMode(mode, append(checks, request.GetId()))
+	player.LastChecked = request.DateChecked
+	return &snapshot{Player: player, Previous: lastCheck, Latest: request, Fetched: true}, nil
+}
+
+// findOrCreatePlayer finds the player the osu! data is for, adding them if they're new
+func findOrCreatePlayer(data *osuapi.User) (*OsuPlayer, error) {
+	player := &OsuPlayer{}
+	err := connection.Collection("osuplayermodels").FindOne(bson.M{"userid": data.UserID}, player)
+	if err == nil {
+		return player, nil
+	}
+	if _, ok := err.(*bongo.DocumentNotFoundError); !ok {
+		return nil, err
+	}
+	log.Debug("osu! player " + data.Username + "(" + data.UserID + ") doesn't exist in the database, adding...")
+	player = &OsuPlayer{
+		UserID:      data.UserID,
+		PlayerName:  data.Username,
+		LastChecked: time.Now().Unix(),
+		Modes: OsuModes{
+			Standard: OsuModeChecks{Checks: []bson.ObjectId{}},
+			Mania:    OsuModeChecks{Checks: []bson.ObjectId{}},
+			Taiko:    OsuModeChecks{Checks: []bson.ObjectId{}},
+			CTB:      OsuModeChecks{Checks: []bson.ObjectId{}},
+		},
+	}
+	if err := connection.Collection("osuplayermodels").Save(player); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSnapshotServiceSharesCalls(t *testing.T) {
+	s := newSnapshotService()
+	release := make(chan struct{})
+	var runs int32
+	fn := func() (*snapshot, error) {
+		atomic.AddInt32(&runs, 1)
+		<-release
+		return &snapshot{Fetched: true}, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make(chan *snapshot, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := s.do("player:0", fn)
+			if err != nil {
+				t.Error(err)
+			}
+			results <- result
+		}()
+	}
+	// Give every caller time to join the first call before it finishes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	if runs != 1 {
+		t.Errorf("fn ran %d times, want 1", runs)
+	}
+	for result := range results {
+		if result == nil || !result.Fetched {
+			t.Errorf("got %v, want the shared result", result)
+		}
+	}
+	if len(s.calls) != 0 {
+		t.Errorf("%d calls are still in flight", len(s.calls))
+	}
+}
+
+func TestSnapshotServiceSeparateKeys(t *testing.T) {
+	s := newSnapshotService()
+	var runs int32
+	fn := func() (*snapshot, error) {
+		atomic.AddInt32(&runs, 1)
+		return nil, errSnapshotNotFound
+	}
+	for _, key := range []string{"player:0", "player:1", "player:0"} {
+		if _, err := s.do(key, fn); err != errSnapshotNotFound {
+			t.Errorf("got error %v, want %v", err, errSnapshotNotFound)
+		}
+	}
+	if runs != 3 {
+		t.Errorf("fn ran %d times, want 3, once per call that wasn't in flight", runs)
+	}
+}
+
+func TestSnapshotServicePanic(t *testing.T) {
+	s := newSnapshotService()
+	started := make(chan struct{})
+	waiterErr := make(chan error, 1)
+	go func() {
+		<-started
+		_, err := s.do("player:0", func() (*snapshot, error) {
+			return nil, errors.New("the waiter's own fn shouldn't run")
+		})
+		waiterErr <- err
+	}()
+
+	_, err := s.do("player:0", func() (*snapshot, error) {
+		close(started)
+		// Give the waiter time to join before panicking
+		time.Sleep(50 * time.Millisecond)
+		panic("bad image")
+	})
+	if err == nil {
+		t.Fatal("expected the panic to come back as an error")
+	}
+	select {
+	case err := <-waiterErr:
+		if err == nil || err.Error() != "fetching the snapshot panicked: bad image" {
+			t.Errorf("waiter got %v, want the panic as an error", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the waiter was never let go")
+	}
+	if len(s.calls) != 0 {
+		t.Errorf("%d calls are still in flight", len(s.calls))
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"golang.org/x/image/font"
+)
+
+// statRow - A stat that can be shown as a row on the card, with how much it changed since the previous check
+type statRow struct {
+	Key           string
+	Label         string
+	Value         func(data OsuRequestData) float64
+	Epsilon       float64 // Changes this small are drawn as no change
+	LowerIsBetter bool    // Ranks get better as they go down
+}
+
+// Every stat row the card can show
+var statRows = []statRow{
+	{
+		Key:           "rank",
+		Label:         "Rank",
+		Value:         func(data OsuRequestData) float64 { return float64(data.PP.Rank) },
+		LowerIsBetter: true,
+	},
+	{
+		Key:           "countryRank",
+		Label:         "Country Rank",
+		Value:         func(data OsuRequestData) float64 { return float64(data.PP.CountryRank) },
+		LowerIsBetter: true,
+	},
+	{
+		Key:     "pp",
+		Label:   "PP",
+		Value:   func(data OsuRequestData) float64 { return float64(data.PP.Raw) },
+		Epsilon: 0.01,
+	},
+	{
+		Key:   "plays",
+		Label: "Play Count",
+		Value: func(data OsuRequestData) float64 { return float64(data.Counts.Plays) },
+	},
+	{
+		Key:     "level",
+		Label:   "Level",
+		Value:   func(data OsuRequestData) float64 { return float64(data.Level) },
+		Epsilon: 0.01,
+	},
+	{
+		Key:   "accuracy",
+		Label: "Accuracy",
+		Value: func(data OsuRequestData) float64 { return float64(data.Accuracy) },
+	},
+	{
+		Key:   "ss",
+		Label: "SS",
+		Value: func(data OsuRequestData) float64 { return float64(data.Counts.SS + data.Counts.SSH) },
+	},
+	{
+		Key:   "s",
+		Label: "S",
+		Value: func(data OsuRequestData) float64 { return float64(data.Counts.S + data.Counts.SH) },
+	},
+	{
+		Key:   "a",
+		Label: "A",
+		Value: func(data OsuRequestData) float64 { return float64(data.Counts.A) },
+	},
+	{
+		Key:   "rankedScore",
+		Label: "Ranked Score",
+		Value: func(data OsuRequestData) float64 { return float64(data.Scores.Ranked) },
+	},
+	{
+		Key:   "totalScore",
+		Label: "Total Score",
+		Value: func(data OsuRequestData) float64 { return float64(data.Scores.Total) },
+	},
+	{
+		Key:   "count300",
+		Label: "300s",
+		Value: func(data OsuRequestData) float64 { return float64(data.Counts.Count300s) },
+	},
+	{
+		Key:   "count100",
+		Label: "100s",
+		Value: func(data OsuRequestData) float64 { return float64(data.Counts.Count100s) },
+	},
+	{
+		Key:   "count50",
+		Label: "50s",
+		Value: func(data OsuRequestData) float64 { return float64(data.Counts.Count50s) },
+	},
+}
+
+// The space drawDifference leaves between a row's value and its difference, for the arrow
+const statRowArrowWidth = 22
+
+// Space kept clear between the end of a row and the edge of the card, or the history chart next to it
+const statRowMargin = 5
+
+// The most stat rows any theme fits, which is how many rows can be picked on the settings page
+const maxStatRows = 9
+
+// statRowByKey returns the stat row with the key, and whether there is one
+func statRowByKey(key string) (statRow, bool) {
+	for _, row := range statRows {
+		if row.Key == key {
+			return row, true
+		}
+	}
+	return statRow{}, false
+}
+
+// cardRows returns the stat rows to draw on the user's card in the theme. The user's own rows are used if they picked any, otherwise
+// the theme's, cut down to what fits
+func cardRows(user *User, theme *cardTheme) []statRow {
+	keys := user.OsuSettings.StatRows
+	if len(keys) == 0 {
+		keys = theme.Rows
+	}
+	rows := []statRow{}
+	for _, key := range keys {
+		if len(rows) == theme.MaxRows() {
+			break
+		}
+		if row, ok := statRowByKey(key); ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// parseStatRows checks the stat rows picked on the settings page, in order. Rows left empty are skipped. No rows means the theme's
+func parseStatRows(keys []string) ([]string, error) {
+	rows := []string{}
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if _, ok := statRowByKey(key); !ok {
+			return nil, errors.New("Invalid stat row")
+		}
+		if seen[key] {
+			return nil, errors.New("Each stat can only be shown once")
+		}
+		seen[key] = true
+		rows = append(rows, key)
+	}
+	return rows, nil
+}
+
+// statRowMaxWidth returns how wide a stat row can be on the theme's card, from where the rows start to the edge of the stats
+func statRowMaxWidth(theme *cardTheme) float64 {
+	return float64(theme.Width) - theme.Layout.TextX - statRowMargin
+}
+
+// statRowText returns the text of a row, like "PP: 4,321.5", and its difference as drawn on the card. Numbers are shortened, like
+// 1.23B, when the row wouldn't fit in maxWidth otherwise. The difference is shortened first, as it's the less important of the two
+func statRowText(face font.Face, row statRow, value float64, difference float64, maxWidth float64) (string, string) {
+	formats := []struct {
+		value      func(float64) string
+		difference func(float64) string
+	}{
+		{formatDecimal, formatDecimal},
+		{formatDecimal, formatShort},
+		{formatShort, formatShort},
+	}
+	var text, diffText string
+	for _, format := range formats {
+		text = row.Label + ": " + format.value(value)
+		diffText = format.difference(difference)
+		if statRowWidth(face, text, diffText) <= maxWidth {
+			break
+		}
+	}
+	return text, diffText
+}
+
+// statRowWidth measures a row drawn with its difference
+func statRowWidth(face font.Face, text string, diffText string) float64 {
+	// MeasureString is in 26.6 fixed point, so there are 64 units to a pixel
+	return float64(font.MeasureString(face, text))/64 + statRowArrowWidth + float64(font.MeasureString(face, diffText))/64
+}
+
+// formatShort writes big numbers with three figures and a suffix, like 1.23B or 456K. Smaller numbers are written like formatDecimal
+func formatShort(x float64) string {
+	units := []struct {
+		size   float64
+		suffix string
+	}{
+		{1e12, "T"},
+		{1e9, "B"},
+		{1e6, "M"},
+		{1e3, "K"},
+	}
+	for _, unit := range units {
+		if math.Abs(x) < unit.size {
+			continue
+		}
+		scaled := x / unit.size
+		decimals := 2
+		if math.Abs(scaled) >= 100 {
+			decimals = 0
+		} else if math.Abs(scaled) >= 10 {
+			decimals = 1
+		}
+		// Round down like formatDecimal, so 999,999 is 999K rather than 1000K
+		shift := math.Pow(10, float64(decimals))
+		scaled = math.Trunc(scaled*shift) / shift
+		return strconv.FormatFloat(scaled, 'f', decimals, 64) + unit.suffix
+	}
+	return formatDecimal(x)
+}
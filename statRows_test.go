@@ -0,0 +1,156 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStatRows(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "nothing picked", keys: nil, want: []string{}},
+		{name: "only empty rows", keys: []string{"", ""}, want: []string{}},
+		{name: "keeps the order", keys: []string{"pp", "rank", "accuracy"}, want: []string{"pp", "rank", "accuracy"}},
+		{name: "skips empty rows", keys: []string{"", "pp", "", "plays"}, want: []string{"pp", "plays"}},
+		{name: "unknown key", keys: []string{"pp", "notAStat"}, wantErr: true},
+		{name: "keys are case sensitive", keys: []string{"PP"}, wantErr: true},
+		{name: "padded key", keys: []string{" pp"}, wantErr: true},
+		{name: "duplicate key", keys: []string{"pp", "rank", "pp"}, wantErr: true},
+		{name: "duplicate key after an empty row", keys: []string{"pp", "", "pp"}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseStatRows(test.keys)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCardRows(t *testing.T) {
+	// Fits three rows: 40, 60 and 80, with the bottom at 96
+	theme := &cardTheme{
+		Height: 100,
+		Layout: cardLayout{RowsY: 40, RowHeight: 20},
+		Rows:   []string{"rank", "pp", "plays", "accuracy"},
+	}
+	tests := []struct {
+		name     string
+		statRows []string
+		want     []string
+	}{
+		{name: "theme's rows cut down to what fits", statRows: nil, want: []string{"rank", "pp", "plays"}},
+		{name: "user's rows", statRows: []string{"accuracy", "level"}, want: []string{"accuracy", "level"}},
+		{name: "user's rows cut down to what fits", statRows: []string{"a", "s", "ss", "pp"}, want: []string{"a", "s", "ss"}},
+		{name: "keys that no longer exist are skipped", statRows: []string{"removed", "pp"}, want: []string{"pp"}},
+		{name: "skipped keys don't take a row", statRows: []string{"removed", "a", "s", "ss"}, want: []string{"a", "s", "ss"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			user := &User{OsuSettings: OsuSettings{StatRows: test.statRows}}
+			got := []string{}
+			for _, row := range cardRows(user, theme) {
+				got = append(got, row.Key)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestThemesFitTheirRows(t *testing.T) {
+	for _, theme := range cardThemes {
+		if theme.MaxRows() > maxStatRows {
+			t.Errorf("theme %s fits %d rows, but only %d can be picked", theme.ID, theme.MaxRows(), maxStatRows)
+		}
+		if len(theme.Rows) > theme.MaxRows() {
+			t.Errorf("theme %s has %d rows, but only fits %d", theme.ID, len(theme.Rows), theme.MaxRows())
+		}
+		if _, err := parseStatRows(theme.Rows); err != nil {
+			t.Errorf("theme %s has invalid rows: %s", theme.ID, err)
+		}
+	}
+}
+
+func TestFormatShort(t *testing.T) {
+	tests := []struct {
+		x    float64
+		want string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{98.76, "98.76"},
+		{1000, "1.00K"},
+		{1234, "1.23K"},
+		{45678, "45.6K"},
+		{999999, "999K"},
+		{1234567, "1.23M"},
+		{1239999999, "1.23B"},
+		{476123456789, "476B"},
+		{1500000000000, "1.50T"},
+	}
+	for _, test := range tests {
+		if got := formatShort(test.x); got != test.want {
+			t.Errorf("formatShort(%v) = %q, want %q", test.x, got, test.want)
+		}
+	}
+}
+
+func TestStatRowText(t *testing.T) {
+	theme := themeByID("classic")
+	face := regularFonts.Face(theme.Fonts.Row)
+	maxWidth := statRowMaxWidth(theme)
+	pp, _ := statRowByKey("pp")
+	totalScore, _ := statRowByKey("totalScore")
+	tests := []struct {
+		name       string
+		row        statRow
+		value      float64
+		difference float64
+		wantText   string
+		wantDiff   string
+	}{
+		{"numbers that fit are written in full", pp, 4321.5, 12.25, "PP: 4,321.50", "12.25"},
+		{"the difference is shortened first", totalScore, 476123456789, 1234567, "Total Score: 476,123,456,789", "1.23M"},
+		{"both are shortened when that isn't enough", totalScore, 12345678901234, 123456789012, "Total Score: 12.3T", "123B"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text, diff := statRowText(face, test.row, test.value, test.difference, maxWidth)
+			if text != test.wantText || diff != test.wantDiff {
+				t.Errorf("got %q and %q, want %q and %q", text, diff, test.wantText, test.wantDiff)
+			}
+		})
+	}
+}
+
+func TestStatRowsFitTheirThemes(t *testing.T) {
+	// The biggest numbers a card should ever have to show
+	const biggestValue = 999999999999
+	const biggestDifference = 99999999999
+	for _, theme := range cardThemes {
+		face := regularFonts.Face(theme.Fonts.Row)
+		maxWidth := statRowMaxWidth(theme)
+		for _, row := range statRows {
+			text, diff := statRowText(face, row, biggestValue, biggestDifference, maxWidth)
+			if width := statRowWidth(face, text, diff); width > maxWidth {
+				t.Errorf("the %s row is %.0fpx wide on theme %s, which only has room for %.0fpx", row.Key, width, theme.ID, maxWidth)
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"image/color"
+)
+
+// The theme used for users who haven't picked one, or picked one that no longer exists
+const defaultThemeID = "classic"
+
+// cardTheme - Describes how a stats card looks: its size, colors, fonts, where everything goes, and which stat rows it shows
+type cardTheme struct {
+	ID         string // Stored on OsuSettings.Theme
+	Name       string
+	Width      int
+	Height     int
+	Background color.Color
+	Palette    cardPalette
+	Fonts      cardFonts
+	Layout     cardLayout
+	Rows       []string // Keys of the stat rows shown, in order
+}
+
+// cardPalette - The colors a theme draws with
+type cardPalette struct {
+	Text      color.Color // Stat rows and the header
+	Name      color.Color // The player's name
+	Muted     color.Color // The line under the header, and stats that didn't change
+	Better    color.Color // Stats that improved
+	Worse     color.Color // Stats that got worse
+	Highlight color.Color // The headline and milestones on milestone cards
+}
+
+// cardFonts - The font sizes a theme uses
+type cardFonts struct {
+	Header  float64 // "Stats For:" and the player's name
+	Updated float64 // The date under the header
+	Row     float64 // Stat rows
+}
+
+// cardLayout - Where things are drawn on the card, in pixels from the top left. Text positions are baselines
+type cardLayout struct {
+	AvatarX, AvatarY, AvatarSize float64
+	FlagX, FlagY, FlagW, FlagH   float64
+	ModeX, ModeY, ModeSize       float64
+	TextX                        float64 // Where the header and stat rows start
+	HeaderY                      float64
+	UpdatedY                     float64
+	LineX, LineY                 float64 // The line under the header runs from here to the right edge
+	RowsY                        float64 // The first stat row
+	RowHeight                    float64
+	ChartWidth                   float64 // How much wider the card gets to fit the history chart panel, for users who turn it on
+}
+
+// The stat rows on the card before themes existed
+var classicRows = []string{"rank", "countryRank", "pp", "plays", "level", "accuracy", "ss", "s", "a"}
+
+var classicLayout = cardLayout{
+	AvatarX: 0, AvatarY: 0, AvatarSize: 100,
+	FlagX: 25, FlagY: 115, FlagW: 45, FlagH: 30,
+	ModeX: 25, ModeY: 160, ModeSize: 45,
+	TextX:    110,
+	HeaderY:  24,
+	UpdatedY: 40,
+	LineX:    100, LineY: 45,
+	RowsY:      63,
+	RowHeight:  18,
+	ChartWidth: 180,
+}
+
+var classicFonts = cardFonts{
+	Header:  20,
+	Updated: 12,
+	Row:     18,
+}
+
+// The built-in themes, in the order they are offered on the settings page
+var cardThemes = []*cardTheme{
+	{
+		ID:         "classic",
+		Name:       "Classic",
+		Width:      440,
+		Height:     220,
+		Background: color.Black,
+		Palette: cardPalette{
+			Text:      color.White,
+			Name:      color.White,
+			Muted:     color.RGBA{R: 128, G: 128, B: 128, A: 255},
+			Better:    color.RGBA{R: 0, G: 255, B: 0, A: 255},
+			Worse:     color.RGBA{R: 255, G: 0, B: 0, A: 255},
+			Highlight: color.RGBA{R: 255, G: 204, B: 34, A: 255},
+		},
+		Fonts:  classicFonts,
+		Layout: classicLayout,
+		Rows:   classicRows,
+	},
+	{
+		ID:         "light",
+		Name:       "Light",
+		Width:      440,
+		Height:     220,
+		Background: color.RGBA{R: 250, G: 250, B: 250, A: 255},
+		Palette: cardPalette{
+			Text:      color.RGBA{R: 34, G: 34, B: 34, A: 255},
+			Name:      color.RGBA{R: 34, G: 34, B: 34, A: 255},
+			Muted:     color.RGBA{R: 160, G: 160, B: 160, A: 255},
+			Better:    color.RGBA{R: 0, G: 150, B: 60, A: 255},
+			Worse:     color.RGBA{R: 200, G: 30, B: 30, A: 255},
+			Highlight: color.RGBA{R: 190, G: 130, B: 0, A: 255},
+		},
+		Fonts:  classicFonts,
+		Layout: classicLayout,
+		Rows:   classicRows,
+	},
+	{
+		ID:         "midnight",
+		Name:       "Midnight",
+		Width:      440,
+		Height:     220,
+		Background: color.RGBA{R: 20, G: 24, B: 48, A: 255},
+		Palette: cardPalette{
+			Text:      color.RGBA{R: 230, G: 230, B: 255, A: 255},
+			Name:      color.RGBA{R: 255, G: 102, B: 170, A: 255},
+			Muted:     color.RGBA{R: 110, G: 115, B: 160, A: 255},
+			Better:    color.RGBA{R: 102, G: 255, B: 170, A: 255},
+			Worse:     color.RGBA{R: 255, G: 110, B: 110, A: 255},
+			Highlight: color.RGBA{R: 255, G: 214, B: 102, A: 255},
+		},
+		Fonts:  classicFonts,
+		Layout: classicLayout,
+		Rows:   classicRows,
+	},
+	{
+		ID:         "compact",
+		Name:       "Compact",
+		Width:      440,
+		Height:     160,
+		Background: color.Black,
+		Palette: cardPalette{
+			Text:      color.White,
+			Name:      color.RGBA{R: 255, G: 102, B: 170, A: 255},
+			Muted:     color.RGBA{R: 128, G: 128, B: 128, A: 255},
+			Better:    color.RGBA{R: 0, G: 255, B: 0, A: 255},
+			Worse:     color.RGBA{R: 255, G: 0, B: 0, A: 255},
+			Highlight: color.RGBA{R: 255, G: 204, B: 34, A: 255},
+		},
+		Fonts: classicFonts,
+		Layout: cardLayout{
+			AvatarX: 0, AvatarY: 0, AvatarSize: 100,
+			FlagX: 5, FlagY: 115, FlagW: 45, FlagH: 30,
+			ModeX: 55, ModeY: 110, ModeSize: 40,
+			TextX:    110,
+			HeaderY:  24,
+			UpdatedY: 40,
+			LineX:    100, LineY: 45,
+			RowsY:      63,
+			RowHeight:  18,
+			ChartWidth: 160,
+		},
+		Rows: []string{"rank", "countryRank", "pp", "plays", "accuracy"},
+	},
+}
+
+// MaxRows returns how many stat rows fit on the card
+func (t *cardTheme) MaxRows() int {
+	// Leave room under the last row for letters that hang below the line
+	bottom := float64(t.Height) - 4
+	if bottom < t.Layout.RowsY {
+		return 0
+	}
+	return int((bottom-t.Layout.RowsY)/t.Layout.RowHeight) + 1
+}
+
+// themeByID returns the built-in theme with the ID, or the default theme if there isn't one
+func themeByID(id string) *cardTheme {
+	for _, theme := range cardThemes {
+		if theme.ID == id {
+			return theme
+		}
+	}
+	return themeByID(defaultThemeID)
+}
+
+// isThemeID checks that a built-in theme has the ID
+func isThemeID(id string) bool {
+	for _, theme := range cardThemes {
+		if theme.ID == id {
+			return true
+		}
+	}
+	return false
+}
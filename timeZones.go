@@ -0,0 +1,34 @@
+package main
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Time zones are looked up for every user every hour, so keep the ones we've already loaded
+var timeZoneCache = map[string]*time.Location{}
+var timeZoneCacheMutex sync.Mutex
+
+// loadTimeZone returns the location for an IANA time zone name such as "America/New_York". An empty name is UTC
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	// "Local" would be the server's time zone, which means nothing to our users
+	if name == "Local" {
+		return nil, errors.New("unknown time zone Local")
+	}
+
+	timeZoneCacheMutex.Lock()
+	defer timeZoneCacheMutex.Unlock()
+	if loc, ok := timeZoneCache[name]; ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	timeZoneCache[name] = loc
+	return loc, nil
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ChimeraCoder/anaconda"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+// How long a user's account can stay locked before their tweets are turned off
+var lockedGracePeriod = 7 * 24 * time.Hour
+
+func init() {
+	if days := os.Getenv("LOCKED_DISABLE_AFTER_DAYS"); days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			panic(errors.New("LOCKED_DISABLE_AFTER_DAYS must be a number that isn't negative"))
+		}
+		lockedGracePeriod = time.Duration(n) * 24 * time.Hour
+	}
+}
+
+// The categories Twitter errors are sorted into
+const (
+	twitterErrorLocked       = "locked"        // The account is temporarily locked
+	twitterErrorExpiredToken = "expired token" // The user's tokens are invalid or have expired
+	twitterErrorAuthFailed   = "auth failed"   // Twitter couldn't authenticate the call, which can be our keys or clock as much as the user
+	twitterErrorSuspended    = "suspended"     // The account is suspended
+	twitterErrorRateLimited  = "rate limited"  // We, or the user, have hit a limit
+	twitterErrorDuplicate    = "duplicate"     // The same tweet was already posted
+	twitterErrorTransient    = "transient"     // Twitter or the network had a problem
+	twitterErrorUnknown      = "unknown"       // Anything else
+)
+
+// What to do after a Twitter call fails
+const (
+	twitterPolicyDisable = iota // Turn off the user's tweets, they have to sign in again to turn them back on
+	twitterPolicyRetry          // Try again later
+	twitterPolicyGiveUp         // Don't try again, but leave the user's tweets on
+	twitterPolicyGrace          // Don't try again, and turn off the user's tweets if it keeps happening for lockedGracePeriod
+)
+
+// The policy for each category
+var twitterErrorPolicies = map[string]int{
+	twitterErrorLocked:       twitterPolicyGrace,
+	twitterErrorExpiredToken: twitterPolicyDisable,
+	twitterErrorAuthFailed:   twitterPolicyRetry,
+	twitterErrorSuspended:    twitterPolicyDisable,
+	twitterErrorRateLimited:  twitterPolicyRetry,
+	twitterErrorDuplicate:    twitterPolicyGiveUp,
+	twitterErrorTransient:    twitterPolicyRetry,
+	twitterErrorUnknown:      twitterPolicyRetry,
+}
+
+// Twitter's error codes for each category. https://developer.twitter.com/en/docs/basics/response-codes
+var twitterErrorCodes = map[int]string{
+	32:  twitterErrorAuthFailed,   // Could not authenticate you
+	64:  twitterErrorSuspended,    // Your account is suspended and is not permitted to access this feature
+	88:  twitterErrorRateLimited,  // Rate limit exceeded
+	89:  twitterErrorExpiredToken, // Invalid or expired token
+	130: twitterErrorTransient,    // Over capacity
+	131: twitterErrorTransient,    // Internal error
+	185: twitterErrorRateLimited,  // User is over daily status update limit
+	187: twitterErrorDuplicate,    // Status is a duplicate
+	326: twitterErrorLocked,       // To protect our users from spam and other malicious activity, this account is temporarily locked
+}
+
+// twitterError - A failed Twitter call, sorted into a category
+type twitterError struct {
+	Category string
+	Step     string // What we were doing, like "upload media"
+	err      error
+}
+
+func (e twitterError) Error() string {
+	return e.Step + " failed (" + e.Category + "): " + e.err.Error()
+}
+
+// Policy returns what to do about the error
+func (e twitterError) Policy() int {
+	return twitterErrorPolicies[e.Category]
+}
+
+// classifyTwitterError sorts an error from a Twitter call into a category. It doesn't change anything, so it's safe for dry runs
+func classifyTwitterError(step string, err error) twitterError {
+	classified := twitterError{
+		Category: twitterErrorUnknown,
+		Step:     step,
+		err:      err,
+	}
+	var apiErr *anaconda.ApiError
+	switch e := err.(type) {
+	case *anaconda.ApiError:
+		apiErr = e
+	case anaconda.ApiError:
+		apiErr = &e
+	default:
+		// Anything that isn't from Twitter's API means we never got a response
+		classified.Category = twitterErrorTransient
+		return classified
+	}
+
+	for _, twitterErr := range apiErr.Decoded.Errors {
+		if category, ok := twitterErrorCodes[twitterErr.Code]; ok {
+			classified.Category = category
+			return classified
+		}
+	}
+	// Some errors only come with a message
+	if strings.Contains(apiErr.Body, "To protect our users from spam and other malicious activity") {
+		classified.Category = twitterErrorLocked
+	} else if strings.Contains(apiErr.Body, "suspended") {
+		classified.Category = twitterErrorSuspended
+	} else if apiErr.StatusCode == 429 {
+		classified.Category = twitterErrorRateLimited
+	} else if apiErr.StatusCode >= 500 {
+		classified.Category = twitterErrorTransient
+	}
+	return classified
+}
+
+// handleTwitterError classifies an error from a Twitter call made for the user and applies its policy. Returns a permanentError if
+// the call shouldn't be retried
+func handleTwitterError(prosuUser *User, step string, err error, l pLogger) error {
+	classified := classifyTwitterError(step, err)
+	l.Error("Twitter error: " + classified.Error())
+	switch classified.Policy() {
+	case twitterPolicyDisable:
+		l.Error("Disabling tweet posting because the user's Twitter account is " + classified.Category)
+		if saveErr := disableUserTweets(prosuUser, classified.Category); saveErr != nil {
+			l.Error("Failed to disable tweeting on the user's account")
+			captureError(saveErr)
+			return permanentError{saveErr}
+		}
+		l.Error("Disabled tweeting on the user's account.")
+		return permanentError{classified}
+	case twitterPolicyGrace:
+		if saveErr := recordCredentialFailure(prosuUser, classified.Category, l); saveErr != nil {
+			l.Error("Failed to record the credential failure")
+			captureError(saveErr)
+		}
+		return permanentError{classified}
+	case twitterPolicyGiveUp:
+		l.Error("Giving up on this tweet")
+		return permanentError{classified}
+	}
+	// Failed authentication for every user at once means our consumer keys or clock are wrong, so it needs to be seen
+	if classified.Category == twitterErrorUnknown || classified.Category == twitterErrorAuthFailed {
+		captureError(err)
+	}
+	return classified
+}
+
+// recordCredentialFailure counts a failure because of the user's account, and turns their tweets off once the failures have gone on
+// for lockedGracePeriod
+func recordCredentialFailure(prosuUser *User, category string, l pLogger) error {
+	now := time.Now()
+	users := connection.Collection("usermodels").Collection()
+	// Only the first failure in a row starts the clock
+	err := users.Update(
+		bson.M{"_id": prosuUser.GetId(), "credentialFailingSince": bson.M{"$in": []interface{}{0, nil}}},
+		bson.M{"$set": bson.M{"credentialFailingSince": now.Unix()}},
+	)
+	if err != nil && err != mgo.ErrNotFound {
+		return err
+	}
+	latest := &User{}
+	_, err = users.FindId(prosuUser.GetId()).Apply(mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"credentialFailures": 1}},
+		ReturnNew: true,
+	}, latest)
+	if err != nil {
+		return err
+	}
+	prosuUser.CredentialFailures = latest.CredentialFailures
+	prosuUser.CredentialFailingSince = latest.CredentialFailingSince
+
+	failingFor := now.Sub(time.Unix(prosuUser.CredentialFailingSince, 0))
+	if failingFor >= lockedGracePeriod {
+		l.Error("The user's Twitter account has been " + category + " for " + strconv.Itoa(int(failingFor.Hours()/24)) + " day(s). Disabling tweet posting.")
+		return disableUserTweets(prosuUser, category)
+	}
+	l.Error("The user's Twitter account is " + category + ". Giving up on this tweet, " + strconv.Itoa(prosuUser.CredentialFailures) + " failure(s) in a row")
+	return nil
+}
+
+// clearCredentialFailures forgets the user's credential failures once a Twitter call works again
+func clearCredentialFailures(prosuUser *User) error {
+	prosuUser.CredentialFailures = 0
+	prosuUser.CredentialFailingSince = 0
+	err := connection.Collection("usermodels").Collection().Update(
+		bson.M{"_id": prosuUser.GetId(), "credentialFailures": bson.M{"$gt": 0}},
+		bson.M{"$set": bson.M{"credentialFailures": 0}, "$unset": bson.M{"credentialFailingSince": ""}},
+	)
+	if err == mgo.ErrNotFound {
+		// There were no failures to forget
+		return nil
+	}
+	return err
+}
+
+// disableUserTweets turns off the user's tweets, saving why so the settings page can tell them, and lets them know with a notice
+// and a direct message. Only those fields are written, so nothing else the user changed in the meantime is lost
+func disableUserTweets(prosuUser *User, reason string) error {
+	prosuUser.OsuSettings.Enabled = false
+	prosuUser.DisabledReason = reason
+	prosuUser.CredentialFailures = 0
+	prosuUser.CredentialFailingSince = 0
+	err := connection.Collection("usermodels").Collection().UpdateId(prosuUser.GetId(), bson.M{
+		"$set": bson.M{
+			"osuSettings.enabled": false,
+			"disabledReason":      reason,
+			"credentialFailures":  0,
+			"_modified":           time.Now(),
+		},
+		"$unset": bson.M{"credentialFailingSince": ""},
+		"$push":  pushNotice(prosuUser, reason),
+	})
+	if err != nil {
+		return err
+	}
+	sendNoticeMessage(prosuUser, reason)
+	return nil
+}
+
+// Stored as the DisabledReason when Twitter says the user's credentials aren't valid, alongside the categories above
+const disabledReasonInvalidCredentials = "invalid credentials"
+
+// errInvalidCredentials is returned when Twitter says the user's credentials aren't valid without returning an error
+var errInvalidCredentials = errors.New("the user's Twitter credentials are invalid")
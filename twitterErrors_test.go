@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChimeraCoder/anaconda"
+)
+
+// twitterAPIError builds an error like the ones anaconda returns when Twitter answers with error codes
+func twitterAPIError(status int, codes ...int) *anaconda.ApiError {
+	apiErr := &anaconda.ApiError{StatusCode: status}
+	for _, code := range codes {
+		apiErr.Decoded.Errors = append(apiErr.Decoded.Errors, anaconda.TwitterError{Code: code})
+	}
+	return apiErr
+}
+
+func TestClassifyTwitterError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		category string
+		policy   int
+	}{
+		{"could not authenticate", twitterAPIError(401, 32), twitterErrorAuthFailed, twitterPolicyRetry},
+		{"invalid or expired token", twitterAPIError(401, 89), twitterErrorExpiredToken, twitterPolicyDisable},
+		{"suspended", twitterAPIError(403, 64), twitterErrorSuspended, twitterPolicyDisable},
+		{"rate limit exceeded", twitterAPIError(429, 88), twitterErrorRateLimited, twitterPolicyRetry},
+		{"over daily status limit", twitterAPIError(403, 185), twitterErrorRateLimited, twitterPolicyRetry},
+		{"over capacity", twitterAPIError(503, 130), twitterErrorTransient, twitterPolicyRetry},
+		{"internal error", twitterAPIError(500, 131), twitterErrorTransient, twitterPolicyRetry},
+		{"duplicate status", twitterAPIError(403, 187), twitterErrorDuplicate, twitterPolicyGiveUp},
+		{"locked", twitterAPIError(403, 326), twitterErrorLocked, twitterPolicyGrace},
+		{"first known code wins", twitterAPIError(403, 999, 326, 187), twitterErrorLocked, twitterPolicyGrace},
+		{"unknown code", twitterAPIError(403, 999), twitterErrorUnknown, twitterPolicyRetry},
+		{"no codes", twitterAPIError(400), twitterErrorUnknown, twitterPolicyRetry},
+		{
+			"locked message without a code",
+			&anaconda.ApiError{StatusCode: 403, Body: "To protect our users from spam and other malicious activity, this account is temporarily locked."},
+			twitterErrorLocked,
+			twitterPolicyGrace,
+		},
+		{
+			"suspended message without a code",
+			&anaconda.ApiError{StatusCode: 403, Body: "Your account is suspended"},
+			twitterErrorSuspended,
+			twitterPolicyDisable,
+		},
+		{"too many requests without a code", twitterAPIError(429), twitterErrorRateLimited, twitterPolicyRetry},
+		{"server error without a code", twitterAPIError(502), twitterErrorTransient, twitterPolicyRetry},
+		{"error as a value", *twitterAPIError(403, 187), twitterErrorDuplicate, twitterPolicyGiveUp},
+		{"no response", errors.New("connection reset by peer"), twitterErrorTransient, twitterPolicyRetry},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			classified := classifyTwitterError("post tweet", test.err)
+			if classified.Category != test.category {
+				t.Errorf("got category %q, want %q", classified.Category, test.category)
+			}
+			if classified.Policy() != test.policy {
+				t.Errorf("got policy %d, want %d", classified.Policy(), test.policy)
+			}
+			if classified.Step != "post tweet" {
+				t.Errorf("got step %q, want %q", classified.Step, "post tweet")
+			}
+		})
+	}
+}